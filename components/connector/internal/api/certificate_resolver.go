@@ -72,8 +72,9 @@ func (r *certificateResolver) Configuration(ctx context.Context) (*externalschem
 	}
 
 	csrInfo := &externalschema.CertificateSigningRequestInfo{
-		Subject:      r.csrSubjectConsts.ToString(clientId),
-		KeyAlgorithm: "rsa2048",
+		Subject:                r.csrSubjectConsts.ToString(clientId),
+		KeyAlgorithm:           certificates.KeyAlgorithmRSA2048,
+		SupportedKeyAlgorithms: certificates.SupportedKeyAlgorithms,
 	}
 
 	log.C(ctx).Infof("Configuration for client with id %s successfully fetched.", clientId)
@@ -239,6 +239,7 @@ func TestCertificateResolver_Configuration(t *testing.T) {
 		assert.Equal(t, &certSecuredConnectorURL, configurationResult.ManagementPlaneInfo.CertificateSecuredConnectorURL)
 		assert.Equal(t, expectedSubject(subject.CSRSubjectConsts, subject.CommonName), configurationResult.CertificateSigningRequestInfo.Subject)
 		assert.Equal(t, "rsa2048", configurationResult.CertificateSigningRequestInfo.KeyAlgorithm)
+		assert.Equal(t, []string{"rsa2048", "ecdsa-p256", "ecdsa-p384", "ed25519"}, configurationResult.CertificateSigningRequestInfo.SupportedKeyAlgorithms)
 		mock.AssertExpectationsForObjects(t, tokenService, authenticator)
 	})
 
@@ -1,8 +1,8 @@
 package certificates
 
 import (
+	"crypto"
 	"crypto/rand"
-	"crypto/rsa"
 	"crypto/x509"
 	"encoding/pem"
 	"math/big"
@@ -14,10 +14,10 @@ import (
 //go:generate mockery --name=CertificateUtility --disable-version-string
 type CertificateUtility interface {
 	LoadCert(encodedData []byte) (*x509.Certificate, apperrors.AppError)
-	LoadKey(encodedData []byte) (*rsa.PrivateKey, apperrors.AppError)
+	LoadKey(encodedData []byte) (crypto.Signer, apperrors.AppError)
 	LoadCSR(encodedData []byte) (*x509.CertificateRequest, apperrors.AppError)
 	CheckCSRValues(csr *x509.CertificateRequest, subject CSRSubject) apperrors.AppError
-	SignCSR(caCrt *x509.Certificate, csr *x509.CertificateRequest, caKey *rsa.PrivateKey) ([]byte, apperrors.AppError)
+	SignCSR(caCrt *x509.Certificate, csr *x509.CertificateRequest, caKey crypto.Signer) ([]byte, apperrors.AppError)
 	AddCertificateHeaderAndFooter(crtRaw []byte) []byte
 }
 
@@ -45,7 +45,7 @@ func (cu *certificateUtility) LoadCert(encodedData []byte) (*x509.Certificate, a
 	return caCRT, nil
 }
 
-func (cu *certificateUtility) LoadKey(encodedData []byte) (*rsa.PrivateKey, apperrors.AppError) {
+func (cu *certificateUtility) LoadKey(encodedData []byte) (crypto.Signer, apperrors.AppError) {
 	pemBlock, _ := pem.Decode(encodedData)
 	if pemBlock == nil {
 		return nil, apperrors.Internal("Error while decoding pem block.")
@@ -55,12 +55,21 @@ func (cu *certificateUtility) LoadKey(encodedData []byte) (*rsa.PrivateKey, appe
 		return caPrivateKey, nil
 	}
 
+	if caPrivateKey, err := x509.ParseECPrivateKey(pemBlock.Bytes); err == nil {
+		return checkPrivateKey(caPrivateKey)
+	}
+
 	caPrivateKey, err := x509.ParsePKCS8PrivateKey(pemBlock.Bytes)
 	if err != nil {
 		return nil, apperrors.Internal("Error while parsing private key: %s", err)
 	}
 
-	return caPrivateKey.(*rsa.PrivateKey), nil
+	signer, ok := caPrivateKey.(crypto.Signer)
+	if !ok {
+		return nil, apperrors.Internal("Unsupported private key type: %T", caPrivateKey)
+	}
+
+	return checkPrivateKey(signer)
 }
 
 func (cu *certificateUtility) LoadCSR(encodedData []byte) (*x509.CertificateRequest, apperrors.AppError) {
@@ -79,6 +88,10 @@ func (cu *certificateUtility) LoadCSR(encodedData []byte) (*x509.CertificateRequ
 		return nil, apperrors.BadRequest("CSR signature invalid: %s", err)
 	}
 
+	if _, err := KeyAlgorithm(clientCSR.PublicKey); err != nil {
+		return nil, apperrors.BadRequest("CSR: %s", err)
+	}
+
 	return clientCSR, nil
 }
 
@@ -119,8 +132,8 @@ func (cu *certificateUtility) CheckCSRValues(csr *x509.CertificateRequest, subje
 	return nil
 }
 
-func (cu *certificateUtility) SignCSR(caCrt *x509.Certificate, csr *x509.CertificateRequest, caKey *rsa.PrivateKey) ([]byte, apperrors.AppError) {
-	clientCRTTemplate := cu.prepareCRTTemplate(csr)
+func (cu *certificateUtility) SignCSR(caCrt *x509.Certificate, csr *x509.CertificateRequest, caKey crypto.Signer) ([]byte, apperrors.AppError) {
+	clientCRTTemplate := cu.prepareCRTTemplate(csr, caKey)
 
 	clientCrtRaw, err := x509.CreateCertificate(rand.Reader, &clientCRTTemplate, caCrt, csr.PublicKey, caKey)
 	if err != nil {
@@ -130,9 +143,9 @@ func (cu *certificateUtility) SignCSR(caCrt *x509.Certificate, csr *x509.Certifi
 	return clientCrtRaw, nil
 }
 
-func (cu *certificateUtility) prepareCRTTemplate(csr *x509.CertificateRequest) x509.Certificate {
+func (cu *certificateUtility) prepareCRTTemplate(csr *x509.CertificateRequest, caKey crypto.Signer) x509.Certificate {
 	return x509.Certificate{
-		SignatureAlgorithm: csr.SignatureAlgorithm,
+		SignatureAlgorithm: signatureAlgorithm(csr, caKey),
 
 		SerialNumber: big.NewInt(2),
 		Subject:      csr.Subject,
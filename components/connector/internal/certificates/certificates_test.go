@@ -1,10 +1,16 @@
 package certificates
 
 import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
 	"crypto/rsa"
 	"crypto/x509"
 	"crypto/x509/pkix"
 	"encoding/pem"
+	"math/big"
 	"testing"
 	"time"
 
@@ -299,6 +305,58 @@ func TestCertificateUtility_LoadKey(t *testing.T) {
 		assert.NotNil(t, key)
 	})
 
+	t.Run("should load EC key", func(t *testing.T) {
+		// given
+		certificateUtility := NewCertificateUtility(validityTime)
+		ecKey := generateECDSAKey(t, elliptic.P256())
+
+		// when
+		key, err := certificateUtility.LoadKey(encodeECKey(t, ecKey))
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, ecKey.Public(), key.Public())
+	})
+
+	t.Run("should load PKCS8 ECDSA key", func(t *testing.T) {
+		// given
+		certificateUtility := NewCertificateUtility(validityTime)
+		ecKey := generateECDSAKey(t, elliptic.P384())
+
+		// when
+		key, err := certificateUtility.LoadKey(encodePKCS8Key(t, ecKey))
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, ecKey.Public(), key.Public())
+	})
+
+	t.Run("should load PKCS8 Ed25519 key", func(t *testing.T) {
+		// given
+		certificateUtility := NewCertificateUtility(validityTime)
+		edKey := generateEd25519Key(t)
+
+		// when
+		key, err := certificateUtility.LoadKey(encodePKCS8Key(t, edKey))
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, edKey.Public(), key.Public())
+	})
+
+	t.Run("should fail loading key with unsupported curve", func(t *testing.T) {
+		// given
+		certificateUtility := NewCertificateUtility(validityTime)
+
+		// when
+		key, err := certificateUtility.LoadKey(encodeECKey(t, generateECDSAKey(t, elliptic.P224())))
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeInternal, err.Code())
+		assert.Nil(t, key)
+	})
+
 	t.Run("should fail decoding key", func(t *testing.T) {
 		// given
 		certificateUtility := NewCertificateUtility(validityTime)
@@ -340,6 +398,43 @@ func TestCertificateUtility_LoadCSR(t *testing.T) {
 		assert.NotNil(t, key)
 	})
 
+	t.Run("should load ECDSA CSR", func(t *testing.T) {
+		// given
+		certificateUtility := NewCertificateUtility(validityTime)
+
+		// when
+		csr, err := certificateUtility.LoadCSR(createCSR(t, generateECDSAKey(t, elliptic.P256())))
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, x509.ECDSA, csr.PublicKeyAlgorithm)
+	})
+
+	t.Run("should load Ed25519 CSR", func(t *testing.T) {
+		// given
+		certificateUtility := NewCertificateUtility(validityTime)
+
+		// when
+		csr, err := certificateUtility.LoadCSR(createCSR(t, generateEd25519Key(t)))
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, x509.Ed25519, csr.PublicKeyAlgorithm)
+	})
+
+	t.Run("should fail loading CSR with unsupported curve", func(t *testing.T) {
+		// given
+		certificateUtility := NewCertificateUtility(validityTime)
+
+		// when
+		csr, err := certificateUtility.LoadCSR(createCSR(t, generateECDSAKey(t, elliptic.P224())))
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeBadRequest, err.Code())
+		assert.Nil(t, csr)
+	})
+
 	t.Run("should fail decoding CSR", func(t *testing.T) {
 		// given
 		certificateUtility := NewCertificateUtility(validityTime)
@@ -592,6 +687,46 @@ func TestCertificateUtility_SignCSR(t *testing.T) {
 		assert.Equal(t, validityTime, certificateValidityTime)
 	})
 
+	t.Run("should sign ECDSA client certificate with RSA CA", func(t *testing.T) {
+		// given
+		certificateUtility := NewCertificateUtility(validityTime)
+		caCrt, _, caKey := prepareCrtAndKey(certificateUtility)
+		csr, apperr := certificateUtility.LoadCSR(createCSR(t, generateECDSAKey(t, elliptic.P256())))
+		require.NoError(t, apperr)
+
+		// when
+		rawClientCRT, apperr := certificateUtility.SignCSR(caCrt, csr, caKey)
+
+		// then
+		require.NoError(t, apperr)
+
+		decodedCrt, err := x509.ParseCertificate(rawClientCRT)
+		require.NoError(t, err)
+		assert.Equal(t, x509.ECDSA, decodedCrt.PublicKeyAlgorithm)
+		assert.NoError(t, decodedCrt.CheckSignatureFrom(caCrt))
+	})
+
+	t.Run("should sign Ed25519 client certificate with ECDSA CA", func(t *testing.T) {
+		// given
+		certificateUtility := NewCertificateUtility(validityTime)
+		caKey := generateECDSAKey(t, elliptic.P384())
+		caCrt := createCACert(t, caKey)
+		csr, apperr := certificateUtility.LoadCSR(createCSR(t, generateEd25519Key(t)))
+		require.NoError(t, apperr)
+
+		// when
+		rawClientCRT, apperr := certificateUtility.SignCSR(caCrt, csr, caKey)
+
+		// then
+		require.NoError(t, apperr)
+
+		decodedCrt, err := x509.ParseCertificate(rawClientCRT)
+		require.NoError(t, err)
+		assert.Equal(t, x509.Ed25519, decodedCrt.PublicKeyAlgorithm)
+		assert.Equal(t, x509.ECDSAWithSHA384, decodedCrt.SignatureAlgorithm)
+		assert.NoError(t, decodedCrt.CheckSignatureFrom(caCrt))
+	})
+
 	t.Run("should return when failed to create certificate", func(t *testing.T) {
 		// given
 		caCrt := &x509.Certificate{}
@@ -645,7 +780,7 @@ func calculateValidityTime(certificate *x509.Certificate) time.Duration {
 	return difference
 }
 
-func prepareCrtAndKey(certificateUtility CertificateUtility) (*x509.Certificate, *x509.CertificateRequest, *rsa.PrivateKey) {
+func prepareCrtAndKey(certificateUtility CertificateUtility) (*x509.Certificate, *x509.CertificateRequest, crypto.Signer) {
 	caCrt, err := certificateUtility.LoadCert(encodedCert)
 	if err != nil {
 	}
@@ -657,3 +792,53 @@ func prepareCrtAndKey(certificateUtility CertificateUtility) (*x509.Certificate,
 	}
 	return caCrt, csr, key
 }
+
+func generateECDSAKey(t *testing.T, curve elliptic.Curve) *ecdsa.PrivateKey {
+	key, err := ecdsa.GenerateKey(curve, rand.Reader)
+	require.NoError(t, err)
+	return key
+}
+
+func generateEd25519Key(t *testing.T) ed25519.PrivateKey {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	return key
+}
+
+func encodeECKey(t *testing.T, key *ecdsa.PrivateKey) []byte {
+	der, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der})
+}
+
+func encodePKCS8Key(t *testing.T, key crypto.Signer) []byte {
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+}
+
+func createCSR(t *testing.T, key crypto.Signer) []byte {
+	template := &x509.CertificateRequest{
+		Subject: pkix.Name{CommonName: "commonName"},
+	}
+	der, err := x509.CreateCertificateRequest(rand.Reader, template, key)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: der})
+}
+
+func createCACert(t *testing.T, key crypto.Signer) *x509.Certificate {
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "ca"},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	require.NoError(t, err)
+	crt, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return crt
+}
@@ -0,0 +1,76 @@
+package certificates
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/x509"
+
+	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
+	"github.com/pkg/errors"
+)
+
+const (
+	KeyAlgorithmRSA2048   = "rsa2048"
+	KeyAlgorithmECDSAP256 = "ecdsa-p256"
+	KeyAlgorithmECDSAP384 = "ecdsa-p384"
+	KeyAlgorithmEd25519   = "ed25519"
+)
+
+// SupportedKeyAlgorithms are the key algorithms accepted both for the client CSRs and for the CA key
+var SupportedKeyAlgorithms = []string{KeyAlgorithmRSA2048, KeyAlgorithmECDSAP256, KeyAlgorithmECDSAP384, KeyAlgorithmEd25519}
+
+// KeyAlgorithm returns the name of the key algorithm of the provided public key, or an error if the algorithm is not supported.
+// RSA keys are reported as rsa2048 regardless of their size, as the size of the RSA keys has never been enforced.
+func KeyAlgorithm(publicKey crypto.PublicKey) (string, error) {
+	switch key := publicKey.(type) {
+	case *rsa.PublicKey:
+		return KeyAlgorithmRSA2048, nil
+	case *ecdsa.PublicKey:
+		switch key.Curve {
+		case elliptic.P256():
+			return KeyAlgorithmECDSAP256, nil
+		case elliptic.P384():
+			return KeyAlgorithmECDSAP384, nil
+		default:
+			return "", errors.Errorf("Unsupported ECDSA curve: %s", key.Curve.Params().Name)
+		}
+	case ed25519.PublicKey:
+		return KeyAlgorithmEd25519, nil
+	default:
+		return "", errors.Errorf("Unsupported public key algorithm: %T", publicKey)
+	}
+}
+
+func checkPrivateKey(key crypto.Signer) (crypto.Signer, apperrors.AppError) {
+	if _, err := KeyAlgorithm(key.Public()); err != nil {
+		return nil, apperrors.Internal("Error while loading private key: %s", err)
+	}
+
+	return key, nil
+}
+
+// signatureAlgorithm keeps the signature algorithm of the CSR when it can be produced with the CA key.
+// Otherwise, the default signature algorithm for the CA key is used.
+func signatureAlgorithm(csr *x509.CertificateRequest, caKey crypto.Signer) x509.SignatureAlgorithm {
+	if caKey != nil && publicKeyAlgorithm(caKey.Public()) == csr.PublicKeyAlgorithm {
+		return csr.SignatureAlgorithm
+	}
+
+	return x509.UnknownSignatureAlgorithm
+}
+
+func publicKeyAlgorithm(publicKey crypto.PublicKey) x509.PublicKeyAlgorithm {
+	switch publicKey.(type) {
+	case *rsa.PublicKey:
+		return x509.RSA
+	case *ecdsa.PublicKey:
+		return x509.ECDSA
+	case ed25519.PublicKey:
+		return x509.Ed25519
+	default:
+		return x509.UnknownPublicKeyAlgorithm
+	}
+}
@@ -6,9 +6,9 @@ import (
 	apperrors "github.com/kyma-incubator/compass/components/connector/internal/apperrors"
 	certificates "github.com/kyma-incubator/compass/components/connector/internal/certificates"
 
-	mock "github.com/stretchr/testify/mock"
+	crypto "crypto"
 
-	rsa "crypto/rsa"
+	mock "github.com/stretchr/testify/mock"
 
 	x509 "crypto/x509"
 )
@@ -101,15 +101,15 @@ func (_m *CertificateUtility) LoadCert(encodedData []byte) (*x509.Certificate, a
 }
 
 // LoadKey provides a mock function with given fields: encodedData
-func (_m *CertificateUtility) LoadKey(encodedData []byte) (*rsa.PrivateKey, apperrors.AppError) {
+func (_m *CertificateUtility) LoadKey(encodedData []byte) (crypto.Signer, apperrors.AppError) {
 	ret := _m.Called(encodedData)
 
-	var r0 *rsa.PrivateKey
-	if rf, ok := ret.Get(0).(func([]byte) *rsa.PrivateKey); ok {
+	var r0 crypto.Signer
+	if rf, ok := ret.Get(0).(func([]byte) crypto.Signer); ok {
 		r0 = rf(encodedData)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rsa.PrivateKey)
+			r0 = ret.Get(0).(crypto.Signer)
 		}
 	}
 
@@ -126,11 +126,11 @@ func (_m *CertificateUtility) LoadKey(encodedData []byte) (*rsa.PrivateKey, appe
 }
 
 // SignCSR provides a mock function with given fields: caCrt, csr, caKey
-func (_m *CertificateUtility) SignCSR(caCrt *x509.Certificate, csr *x509.CertificateRequest, caKey *rsa.PrivateKey) ([]byte, apperrors.AppError) {
+func (_m *CertificateUtility) SignCSR(caCrt *x509.Certificate, csr *x509.CertificateRequest, caKey crypto.Signer) ([]byte, apperrors.AppError) {
 	ret := _m.Called(caCrt, csr, caKey)
 
 	var r0 []byte
-	if rf, ok := ret.Get(0).(func(*x509.Certificate, *x509.CertificateRequest, *rsa.PrivateKey) []byte); ok {
+	if rf, ok := ret.Get(0).(func(*x509.Certificate, *x509.CertificateRequest, crypto.Signer) []byte); ok {
 		r0 = rf(caCrt, csr, caKey)
 	} else {
 		if ret.Get(0) != nil {
@@ -139,7 +139,7 @@ func (_m *CertificateUtility) SignCSR(caCrt *x509.Certificate, csr *x509.Certifi
 	}
 
 	var r1 apperrors.AppError
-	if rf, ok := ret.Get(1).(func(*x509.Certificate, *x509.CertificateRequest, *rsa.PrivateKey) apperrors.AppError); ok {
+	if rf, ok := ret.Get(1).(func(*x509.Certificate, *x509.CertificateRequest, crypto.Signer) apperrors.AppError); ok {
 		r1 = rf(caCrt, csr, caKey)
 	} else {
 		if ret.Get(1) != nil {
@@ -3,8 +3,9 @@
 package externalschema
 
 type CertificateSigningRequestInfo struct {
-	Subject      string `json:"subject"`
-	KeyAlgorithm string `json:"keyAlgorithm"`
+	Subject                string   `json:"subject"`
+	KeyAlgorithm           string   `json:"keyAlgorithm"`
+	SupportedKeyAlgorithms []string `json:"supportedKeyAlgorithms"`
 }
 
 type CertificationResult struct {
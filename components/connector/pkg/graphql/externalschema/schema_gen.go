@@ -43,8 +43,9 @@ type DirectiveRoot struct {
 
 type ComplexityRoot struct {
 	CertificateSigningRequestInfo struct {
-		KeyAlgorithm func(childComplexity int) int
-		Subject      func(childComplexity int) int
+		KeyAlgorithm           func(childComplexity int) int
+		Subject                func(childComplexity int) int
+		SupportedKeyAlgorithms func(childComplexity int) int
 	}
 
 	CertificationResult struct {
@@ -115,6 +116,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.CertificateSigningRequestInfo.Subject(childComplexity), true
 
+	case "CertificateSigningRequestInfo.supportedKeyAlgorithms":
+		if e.complexity.CertificateSigningRequestInfo.SupportedKeyAlgorithms == nil {
+			break
+		}
+
+		return e.complexity.CertificateSigningRequestInfo.SupportedKeyAlgorithms(childComplexity), true
+
 	case "CertificationResult.caCertificate":
 		if e.complexity.CertificationResult.CaCertificate == nil {
 			break
@@ -297,6 +305,7 @@ type Configuration {
 type CertificateSigningRequestInfo {
     subject: String! # eg.: "OU=Test,O=Test,L=Blacksburg,ST=Virginia,C=US,CN={ID}"
     keyAlgorithm: String! # eg.: rsa2048
+    supportedKeyAlgorithms: [String!]! # eg.: ["rsa2048", "ecdsa-p256", "ecdsa-p384", "ed25519"]
 }
 
 type Query {
@@ -453,6 +462,40 @@ func (ec *executionContext) _CertificateSigningRequestInfo_keyAlgorithm(ctx cont
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
+func (ec *executionContext) _CertificateSigningRequestInfo_supportedKeyAlgorithms(ctx context.Context, field graphql.CollectedField, obj *CertificateSigningRequestInfo) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "CertificateSigningRequestInfo",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.SupportedKeyAlgorithms, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	fc.Result = res
+	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
+}
+
 func (ec *executionContext) _CertificationResult_certificateChain(ctx context.Context, field graphql.CollectedField, obj *CertificationResult) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
@@ -2006,6 +2049,11 @@ func (ec *executionContext) _CertificateSigningRequestInfo(ctx context.Context,
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
+		case "supportedKeyAlgorithms":
+			out.Values[i] = ec._CertificateSigningRequestInfo_supportedKeyAlgorithms(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -2516,6 +2564,35 @@ func (ec *executionContext) marshalNString2string(ctx context.Context, sel ast.S
 	return res
 }
 
+func (ec *executionContext) unmarshalNString2ᚕstringᚄ(ctx context.Context, v interface{}) ([]string, error) {
+	var vSlice []interface{}
+	if v != nil {
+		if tmp1, ok := v.([]interface{}); ok {
+			vSlice = tmp1
+		} else {
+			vSlice = []interface{}{v}
+		}
+	}
+	var err error
+	res := make([]string, len(vSlice))
+	for i := range vSlice {
+		res[i], err = ec.unmarshalNString2string(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) marshalNString2ᚕstringᚄ(ctx context.Context, sel ast.SelectionSet, v []string) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	for i := range v {
+		ret[i] = ec.marshalNString2string(ctx, sel, v[i])
+	}
+
+	return ret
+}
+
 func (ec *executionContext) marshalN__Directive2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirective(ctx context.Context, sel ast.SelectionSet, v introspection.Directive) graphql.Marshaler {
 	return ec.___Directive(ctx, sel, &v)
 }
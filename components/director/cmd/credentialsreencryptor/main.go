@@ -0,0 +1,68 @@
+package main
+
+import (
+	"context"
+	"os"
+
+	reencryption "github.com/kyma-incubator/compass/components/director/internal/credentials_reencryption"
+	"github.com/kyma-incubator/compass/components/director/internal/uid"
+	"github.com/kyma-incubator/compass/components/director/pkg/correlation"
+	"github.com/kyma-incubator/compass/components/director/pkg/encryption"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/kyma-incubator/compass/components/director/pkg/signal"
+	"github.com/vrischmann/envconfig"
+)
+
+const envPrefix = "APP"
+
+type config struct {
+	Database              persistence.DatabaseConfig
+	CredentialsEncryption encryption.Config
+	PageSize              int `envconfig:"default=200,APP_CREDENTIALS_REENCRYPTION_PAGE_SIZE"`
+}
+
+func main() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ctx = withCorrelationID(ctx, uid.NewService().Generate())
+
+	term := make(chan os.Signal)
+	signal.HandleInterrupts(ctx, cancel, term)
+
+	cfg := config{}
+	err := envconfig.InitWithPrefix(&cfg, envPrefix)
+	exitOnError(ctx, err, "Error while loading app config")
+
+	if !cfg.CredentialsEncryption.Enabled {
+		log.C(ctx).Info("Credentials encryption is not enabled. There is nothing to re-encrypt")
+		return
+	}
+
+	credentialsEncryptor, err := encryption.NewCredentialsEncryptor(cfg.CredentialsEncryption)
+	exitOnError(ctx, err, "Error while creating credentials encryptor")
+
+	transact, closeFunc, err := persistence.Configure(ctx, cfg.Database)
+	exitOnError(ctx, err, "Error while establishing the connection to the database")
+	defer func() {
+		err := closeFunc()
+		exitOnError(ctx, err, "Error while closing the connection to the database")
+	}()
+
+	reEncryptionSvc := reencryption.NewService(transact, reencryption.NewRepository(), credentialsEncryptor, cfg.PageSize)
+	err = reEncryptionSvc.ReEncryptCredentials(ctx)
+	exitOnError(ctx, err, "Error while re-encrypting credentials")
+}
+
+func exitOnError(ctx context.Context, err error, context string) {
+	if err != nil {
+		log.C(ctx).WithError(err).Errorf("%s: %v", context, err)
+		os.Exit(1)
+	}
+}
+
+func withCorrelationID(ctx context.Context, id string) context.Context {
+	correlationIDKey := correlation.RequestIDHeaderKey
+	return correlation.SaveCorrelationIDHeaderToContext(ctx, &correlationIDKey, &id)
+}
@@ -41,6 +41,7 @@ import (
 	configprovider "github.com/kyma-incubator/compass/components/director/pkg/config"
 	"github.com/kyma-incubator/compass/components/director/pkg/correlation"
 	"github.com/kyma-incubator/compass/components/director/pkg/cronjob"
+	"github.com/kyma-incubator/compass/components/director/pkg/encryption"
 	"github.com/kyma-incubator/compass/components/director/pkg/executor"
 	timeouthandler "github.com/kyma-incubator/compass/components/director/pkg/handler"
 	httputil "github.com/kyma-incubator/compass/components/director/pkg/http"
@@ -68,6 +69,7 @@ type config struct {
 	DestinationServiceAPIConfig destinationfetcher.DestinationServiceAPIConfig
 	DestinationsConfig          configprovider.DestinationsConfig
 	Database                    persistence.DatabaseConfig
+	CredentialsEncryption       encryption.Config
 	Log                         log.Config
 	SecurityConfig              securityConfig
 	ElectionConfig              cronjob.ElectionConfig
@@ -96,6 +98,9 @@ func main() {
 	ctx, err = log.Configure(ctx, &cfg.Log)
 	exitOnError(err, "Failed to configure Logger")
 
+	credentialsEncryptor, err := encryption.NewCredentialsEncryptor(cfg.CredentialsEncryption)
+	exitOnError(err, "Error while creating credentials encryptor")
+
 	transactioner, closeFunc, err := persistence.Configure(ctx, cfg.Database)
 	exitOnError(err, "Error while establishing the connection to the database")
 
@@ -111,7 +116,7 @@ func main() {
 		},
 	}
 
-	destinationService := getDestinationService(cfg, transactioner)
+	destinationService := getDestinationService(cfg, transactioner, credentialsEncryptor)
 	handler := initAPIHandler(ctx, httpClient, cfg, destinationService)
 	runMainSrv, shutdownMainSrv := createServer(ctx, cfg, handler, "main")
 
@@ -159,11 +164,11 @@ func exitOnError(err error, context string) {
 	}
 }
 
-func getDestinationService(cfg config, transact persistence.Transactioner) *destinationfetcher.DestinationService {
+func getDestinationService(cfg config, transact persistence.Transactioner, credentialsEncryptor encryption.Encryptor) *destinationfetcher.DestinationService {
 	uuidSvc := uuid.NewService()
 	destConv := destination.NewConverter()
 	destRepo := destination.NewRepository(destConv)
-	bundleRepo := bundleRepo()
+	bundleRepo := bundleRepo(credentialsEncryptor)
 
 	labelConverter := label.NewConverter()
 	labelRepo := label.NewRepository(labelConverter)
@@ -223,16 +228,16 @@ func initAPIHandler(ctx context.Context, httpClient *http.Client,
 	return mainRouter
 }
 
-func bundleRepo() destinationfetcher.BundleRepo {
+func bundleRepo(credentialsEncryptor encryption.Encryptor) destinationfetcher.BundleRepo {
 	authConverter := auth.NewConverter()
-	frConverter := fetchrequest.NewConverter(authConverter)
+	frConverter := fetchrequest.NewConverter(authConverter, credentialsEncryptor)
 	versionConverter := version.NewConverter()
 	specConverter := spec.NewConverter(frConverter)
 	eventAPIConverter := eventdef.NewConverter(versionConverter, specConverter)
 	docConverter := document.NewConverter(frConverter)
 	apiConverter := api.NewConverter(versionConverter, specConverter)
 
-	return bundle.NewRepository(bundle.NewConverter(authConverter, apiConverter, eventAPIConverter, docConverter))
+	return bundle.NewRepository(bundle.NewConverter(authConverter, apiConverter, eventAPIConverter, docConverter, credentialsEncryptor))
 }
 
 func newReadinessHandler() func(writer http.ResponseWriter, request *http.Request) {
@@ -48,6 +48,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/domain/integrationsystem"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/label"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/labeldef"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/operation"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/runtime"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/scenarioassignment"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/spec"
@@ -59,10 +60,12 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/nsadapter/handler"
 	"github.com/kyma-incubator/compass/components/director/internal/nsadapter/httputil"
 	"github.com/kyma-incubator/compass/components/director/internal/nsadapter/nsmodel"
+	operationsmanager "github.com/kyma-incubator/compass/components/director/internal/operations_manager"
 	"github.com/kyma-incubator/compass/components/director/internal/uid"
 	"github.com/kyma-incubator/compass/components/director/pkg/accessstrategy"
 	"github.com/kyma-incubator/compass/components/director/pkg/certloader"
 	"github.com/kyma-incubator/compass/components/director/pkg/correlation"
+	"github.com/kyma-incubator/compass/components/director/pkg/encryption"
 	directorHandler "github.com/kyma-incubator/compass/components/director/pkg/handler"
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
 	"github.com/kyma-incubator/compass/components/director/pkg/normalizer"
@@ -90,6 +93,9 @@ func main() {
 	ordWebhookMapping, err := application.UnmarshalMappings(conf.ORDWebhookMappings)
 	exitOnError(err, "failed while unmarshalling ord webhook mappings")
 
+	credentialsEncryptor, err := encryption.NewCredentialsEncryptor(conf.CredentialsEncryption)
+	exitOnError(err, "Error while creating credentials encryptor")
+
 	transact, closeDBConn, err := persistence.Configure(ctx, conf.Database)
 	exitOnError(err, "Error while establishing the connection to the database")
 	defer func() {
@@ -106,17 +112,17 @@ func main() {
 
 	tenantConv := tenant.NewConverter()
 	authConverter := auth.NewConverter()
-	frConverter := fetchrequest.NewConverter(authConverter)
+	frConverter := fetchrequest.NewConverter(authConverter, credentialsEncryptor)
 	versionConverter := version.NewConverter()
 	docConverter := document.NewConverter(frConverter)
-	webhookConverter := webhook.NewConverter(authConverter)
+	webhookConverter := webhook.NewConverter(authConverter, credentialsEncryptor)
 	specConverter := spec.NewConverter(frConverter)
 	apiConverter := api.NewConverter(versionConverter, specConverter)
 	eventAPIConverter := eventdef.NewConverter(versionConverter, specConverter)
 	labelDefConverter := labeldef.NewConverter()
 	labelConverter := label.NewConverter()
 	intSysConverter := integrationsystem.NewConverter()
-	bundleConverter := bundleutil.NewConverter(authConverter, apiConverter, eventAPIConverter, docConverter)
+	bundleConverter := bundleutil.NewConverter(authConverter, apiConverter, eventAPIConverter, docConverter, credentialsEncryptor)
 	appConverter := application.NewConverter(webhookConverter, bundleConverter)
 	runtimeConverter := runtime.NewConverter(webhookConverter)
 	bundleReferenceConverter := bundlereferences.NewConverter()
@@ -147,7 +153,7 @@ func main() {
 	formationRepo := formation.NewRepository(formationConv)
 	formationTemplateRepo := formationtemplate.NewRepository(formationTemplateConverter)
 	scenarioAssignmentRepo := scenarioassignment.NewRepository(assignmentConv)
-	bundleInstanceAuthRepo := bundleinstanceauth.NewRepository(bundleinstanceauth.NewConverter(authConverter))
+	bundleInstanceAuthRepo := bundleinstanceauth.NewRepository(bundleinstanceauth.NewConverter(authConverter, credentialsEncryptor))
 	appTemplateRepo := apptemplate.NewRepository(appTemplateConverter)
 	formationConstraintRepo := formationconstraint.NewRepository(formationConstraintConverter)
 	formationTemplateConstraintReferencesRepo := formationtemplateconstraintreferences.NewRepository(formationTemplateConstraintReferencesConverter)
@@ -167,7 +173,7 @@ func main() {
 	scenarioAssignmentSvc := scenarioassignment.NewService(scenarioAssignmentRepo, scenariosSvc)
 	tntSvc := tenant.NewServiceWithLabels(tenantRepo, uidSvc, labelRepo, labelSvc, tenantConv)
 	webhookClient := webhookclient.NewClient(securedHTTPClient, mtlsHTTPClient, extSvcMtlsHTTPClient)
-	appTemplateSvc := apptemplate.NewService(appTemplateRepo, webhookRepo, uidSvc, labelSvc, labelRepo, applicationRepo)
+	appTemplateSvc := apptemplate.NewService(appTemplateRepo, webhookRepo, uidSvc, labelSvc, labelRepo, applicationRepo, credentialsEncryptor)
 
 	webhookLabelBuilder := databuilder.NewWebhookLabelBuilder(labelRepo)
 	webhookTenantBuilder := databuilder.NewWebhookTenantBuilder(webhookLabelBuilder, tenantRepo)
@@ -178,7 +184,7 @@ func main() {
 	notificationsGenerator := formation.NewNotificationsGenerator(applicationRepo, appTemplateRepo, runtimeRepo, runtimeContextRepo, labelRepo, webhookRepo, webhookDataInputBuilder, notificationsBuilder)
 	notificationSvc := formation.NewNotificationService(tenantRepo, webhookClient, notificationsGenerator, constraintEngine, webhookConverter, formationTemplateRepo)
 	faNotificationSvc := formationassignment.NewFormationAssignmentNotificationService(formationAssignmentRepo, webhookConverter, webhookRepo, tenantRepo, webhookDataInputBuilder, formationRepo, notificationsBuilder, runtimeContextRepo, labelSvc, conf.RuntimeTypeLabelKey, conf.ApplicationTypeLabelKey)
-	formationAssignmentStatusSvc := formationassignment.NewFormationAssignmentStatusService(formationAssignmentRepo, constraintEngine, faNotificationSvc)
+	formationAssignmentStatusSvc := formationassignment.NewFormationAssignmentStatusService(formationAssignmentRepo, uidSvc, constraintEngine, faNotificationSvc)
 	formationAssignmentSvc := formationassignment.NewService(formationAssignmentRepo, uidSvc, applicationRepo, runtimeRepo, runtimeContextRepo, notificationSvc, faNotificationSvc, labelSvc, formationRepo, formationAssignmentStatusSvc, conf.RuntimeTypeLabelKey, conf.ApplicationTypeLabelKey)
 	formationStatusSvc := formation.NewFormationStatusService(formationRepo, labelDefRepo, scenariosSvc, notificationSvc, constraintEngine)
 	formationSvc := formation.NewService(transact, applicationRepo, labelDefRepo, labelRepo, formationRepo, formationTemplateRepo, labelSvc, uidSvc, scenariosSvc, scenarioAssignmentRepo, scenarioAssignmentSvc, tntSvc, runtimeRepo, runtimeContextRepo, formationAssignmentSvc, faNotificationSvc, notificationSvc, constraintEngine, webhookRepo, formationStatusSvc, conf.RuntimeTypeLabelKey, conf.ApplicationTypeLabelKey)
@@ -187,10 +193,14 @@ func main() {
 	err = registerAppTemplate(ctx, transact, appTemplateSvc)
 	exitOnError(err, "while registering application template")
 
-	err = calculateTemplateMappings(ctx, conf, transact)
+	err = calculateTemplateMappings(ctx, conf, transact, credentialsEncryptor)
 	exitOnError(err, "while calculating template mappings")
 
-	h := handler.NewHandler(appSvc, appConverter, appTemplateSvc, tntSvc, transact)
+	opSvc := operation.NewService(operation.NewRepository(operation.NewConverter()), uidSvc)
+	opManager := operationsmanager.NewSequentialOperationsManager(transact, opSvc, operationsmanager.NsAdapterReportOpType, conf.OperationsWorkerConfig.MaxAttempts)
+	startReportWorker(ctx, opManager, handler.NewReportProcessor(appSvc, appConverter, appTemplateSvc, tntSvc, opSvc, transact), conf)
+
+	h := handler.NewHandler(opSvc, transact, conf.ReportDeduplicationPeriod)
 
 	handlerWithTimeout, err := directorHandler.WithTimeoutWithErrorMessage(h, conf.ServerTimeout, httputil.GetTimeoutMessage())
 	exitOnError(err, "Failed configuring timeout on handler")
@@ -213,6 +223,9 @@ func main() {
 	subrouter.Methods(http.MethodPut).
 		Path("/v1/notifications").
 		Handler(handlerWithTimeout)
+	subrouter.Methods(http.MethodGet).
+		Path("/v1/notifications/{jobID}").
+		HandlerFunc(h.JobStatus)
 
 	setValidationMessages()
 
@@ -228,6 +241,14 @@ func main() {
 	exitOnError(server.ListenAndServe(), "on starting HTTP server")
 }
 
+// startReportWorker starts a single report worker. Reports are applied in the order they were received,
+// because a report may override the systems stored by the previous ones.
+func startReportWorker(ctx context.Context, opManager operationsmanager.OperationsManager, processor operationsmanager.OperationProcessor, conf adapter.Configuration) {
+	log.C(ctx).Info("Starting report worker...")
+	worker := operationsmanager.NewWorker(0, conf.OperationsWorkerConfig, opManager, processor)
+	go worker.Run(ctx)
+}
+
 func registerAppTemplate(ctx context.Context, transact persistence.Transactioner, appTemplateSvc apptemplate.ApplicationTemplateService) error {
 	tx, err := transact.Begin()
 	if err != nil {
@@ -316,7 +337,7 @@ func exitOnError(err error, context string) {
 	}
 }
 
-func calculateTemplateMappings(ctx context.Context, cfg adapter.Configuration, transact persistence.Transactioner) error {
+func calculateTemplateMappings(ctx context.Context, cfg adapter.Configuration, transact persistence.Transactioner, credentialsEncryptor encryption.Encryptor) error {
 	log.C(ctx).Infof("Starting calculation of template mappings")
 
 	var systemToTemplateMappings []nsmodel.TemplateMapping
@@ -326,13 +347,13 @@ func calculateTemplateMappings(ctx context.Context, cfg adapter.Configuration, t
 
 	authConverter := auth.NewConverter()
 	versionConverter := version.NewConverter()
-	frConverter := fetchrequest.NewConverter(authConverter)
-	webhookConverter := webhook.NewConverter(authConverter)
+	frConverter := fetchrequest.NewConverter(authConverter, credentialsEncryptor)
+	webhookConverter := webhook.NewConverter(authConverter, credentialsEncryptor)
 	specConverter := spec.NewConverter(frConverter)
 	apiConverter := api.NewConverter(versionConverter, specConverter)
 	eventAPIConverter := eventdef.NewConverter(versionConverter, specConverter)
 	docConverter := document.NewConverter(frConverter)
-	bundleConverter := bundleutil.NewConverter(authConverter, apiConverter, eventAPIConverter, docConverter)
+	bundleConverter := bundleutil.NewConverter(authConverter, apiConverter, eventAPIConverter, docConverter, credentialsEncryptor)
 	appConverter := application.NewConverter(webhookConverter, bundleConverter)
 	appTemplateConv := apptemplate.NewConverter(appConverter, webhookConverter)
 	appTemplateRepo := apptemplate.NewRepository(appTemplateConv)
@@ -345,7 +366,7 @@ func calculateTemplateMappings(ctx context.Context, cfg adapter.Configuration, t
 
 	uidSvc := uid.NewService()
 	labelSvc := label.NewLabelService(labelRepo, labelDefRepo, uidSvc)
-	appTemplateSvc := apptemplate.NewService(appTemplateRepo, webhookRepo, uidSvc, labelSvc, labelRepo, appRepo)
+	appTemplateSvc := apptemplate.NewService(appTemplateRepo, webhookRepo, uidSvc, labelSvc, labelRepo, appRepo, credentialsEncryptor)
 
 	tx, err := transact.Begin()
 	if err != nil {
@@ -25,6 +25,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/domain/formationconstraint"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/formationtemplate"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/formationtemplateconstraintreferences"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/healthcheck"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/integrationsystem"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/label"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/labeldef"
@@ -55,6 +56,7 @@ import (
 
 	"github.com/gorilla/mux"
 	"github.com/kyma-incubator/compass/components/director/pkg/correlation"
+	"github.com/kyma-incubator/compass/components/director/pkg/encryption"
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
 	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
 	"github.com/kyma-incubator/compass/components/director/pkg/signal"
@@ -68,10 +70,22 @@ type config struct {
 	ReadHeadersTimeout time.Duration `envconfig:"APP_READ_REQUEST_HEADERS_TIMEOUT,default=30s"`
 	ClientTimeout      time.Duration `envconfig:"APP_CLIENT_TIMEOUT,default=30s"`
 
-	ORDOpCreationJobSchedulePeriod  time.Duration `envconfig:"APP_ORD_OPERATIONS_CREATION_JOB_SCHEDULE_PERIOD,default=168h"`
-	ORDOpDeletionJobSchedulePeriod  time.Duration `envconfig:"APP_ORD_OPERATIONS_DELETION_JOB_SCHEDULE_PERIOD,default=24h"`
-	DeleteCompletedOpsOlderThanDays int           `envconfig:"APP_DELETE_COMPLETED_OPERATIONS_OLDER_THAN_DAYS,default=5"`
-	DeleteFailedOpsOlderThanDays    int           `envconfig:"APP_DELETE_FAILED_OPERATIONS_OLDER_THAN_DAYS,default=10"`
+	ORDOpCreationJobSchedulePeriod   time.Duration `envconfig:"APP_ORD_OPERATIONS_CREATION_JOB_SCHEDULE_PERIOD,default=168h"`
+	ORDOpDeletionJobSchedulePeriod   time.Duration `envconfig:"APP_ORD_OPERATIONS_DELETION_JOB_SCHEDULE_PERIOD,default=24h"`
+	DeleteCompletedOpsOlderThanDays  int           `envconfig:"APP_DELETE_COMPLETED_OPERATIONS_OLDER_THAN_DAYS,default=5"`
+	DeleteFailedOpsOlderThanDays     int           `envconfig:"APP_DELETE_FAILED_OPERATIONS_OLDER_THAN_DAYS,default=10"`
+	ORDOpRescheduleJobSchedulePeriod time.Duration `envconfig:"APP_ORD_OPERATIONS_RESCHEDULE_JOB_SCHEDULE_PERIOD,default=5m"`
+	OperationHangPeriod              time.Duration `envconfig:"APP_OPERATION_HANG_PERIOD,default=15m"`
+
+	NsAdapterReportOpDeletionJobSchedulePeriod   time.Duration `envconfig:"APP_NS_ADAPTER_REPORT_OPERATIONS_DELETION_JOB_SCHEDULE_PERIOD,default=24h"`
+	NsAdapterReportOpRescheduleJobSchedulePeriod time.Duration `envconfig:"APP_NS_ADAPTER_REPORT_OPERATIONS_RESCHEDULE_JOB_SCHEDULE_PERIOD,default=5m"`
+
+	HealthChecksJobSchedulePeriod time.Duration `envconfig:"APP_HEALTH_CHECKS_JOB_SCHEDULE_PERIOD,default=5m"`
+	HealthChecksRetentionPeriod   time.Duration `envconfig:"APP_HEALTH_CHECKS_RETENTION_PERIOD,default=168h"`
+	HealthChecksMaxParallelProbes int           `envconfig:"APP_HEALTH_CHECKS_MAX_PARALLEL_PROBES,default=10"`
+
+	FormationAssignmentTransitionsDeletionJobSchedulePeriod time.Duration `envconfig:"APP_FORMATION_ASSIGNMENT_TRANSITIONS_DELETION_JOB_SCHEDULE_PERIOD,default=24h"`
+	FormationAssignmentTransitionsRetentionPeriod           time.Duration `envconfig:"APP_FORMATION_ASSIGNMENT_TRANSITIONS_RETENTION_PERIOD,default=2160h"`
 
 	SkipSSLValidation               bool          `envconfig:"default=false"`
 	ConfigurationFileReload         time.Duration `envconfig:"default=1m"`
@@ -86,14 +100,15 @@ type config struct {
 	ExternalClientCertSecretName string `envconfig:"APP_EXTERNAL_CLIENT_CERT_SECRET_NAME"`
 	ExtSvcClientCertSecretName   string `envconfig:"APP_EXT_SVC_CLIENT_CERT_SECRET_NAME"`
 
-	Log               *log.Config
-	Database          persistence.DatabaseConfig
-	CertLoaderConfig  certloader.Config
-	ReadyConfig       healthz.ReadyConfig
-	RetryConfig       retry.Config
-	ConfigurationFile string
-	Features          features.Config
-	ElectionConfig    cronjob.ElectionConfig
+	Log                   *log.Config
+	Database              persistence.DatabaseConfig
+	CredentialsEncryption encryption.Config
+	CertLoaderConfig      certloader.Config
+	ReadyConfig           healthz.ReadyConfig
+	RetryConfig           retry.Config
+	ConfigurationFile     string
+	Features              features.Config
+	ElectionConfig        cronjob.ElectionConfig
 }
 
 func main() {
@@ -110,6 +125,9 @@ func main() {
 	ctx, err = log.Configure(ctx, conf.Log)
 	exitOnError(err, "while configuring logger")
 
+	credentialsEncryptor, err := encryption.NewCredentialsEncryptor(conf.CredentialsEncryption)
+	exitOnError(err, "Error while creating credentials encryptor")
+
 	transact, closeDBConn, err := persistence.Configure(ctx, conf.Database)
 	exitOnError(err, "Error while establishing the connection to the database")
 	defer func() {
@@ -138,10 +156,12 @@ func main() {
 	tenantMappingConfig, err := apptemplate.UnmarshalTenantMappingConfig(conf.TenantMappingConfigPath)
 	exitOnError(err, "Error while loading Tenant mapping config")
 
-	svc, err := createOperationsManagerService(ctx, cfgProvider, transact, ordWebhookMapping, conf, tenantMappingConfig, conf.TenantMappingCallbackURL)
-	if err != nil {
-		exitOnError(err, "failed while creating operations manager service")
-	}
+	certCache, err := certloader.StartCertLoader(ctx, conf.CertLoaderConfig)
+	exitOnError(err, "failed while starting the certificate loader")
+
+	svc := createOperationsManagerService(cfgProvider, transact, certCache, ordWebhookMapping, conf, tenantMappingConfig, conf.TenantMappingCallbackURL, credentialsEncryptor)
+	healthChecksProber := createHealthChecksProber(transact, certCache, conf, credentialsEncryptor)
+	transitionsCleaner := formationassignment.NewTransitionsCleaner(transact, formationassignment.NewRepository(formationassignment.NewConverter()), conf.FormationAssignmentTransitionsRetentionPeriod)
 
 	runMainSrv, shutdownMainSrv := createServer(ctx, conf, router, "main")
 
@@ -165,6 +185,41 @@ func main() {
 		cancel()
 	}()
 
+	go func() {
+		if err := startRescheduleHangedORDOperationsJob(ctx, svc, conf); err != nil {
+			log.C(ctx).WithError(err).Error("Failed to start reschedule hanged ORD operations cronjob. Stopping app...")
+		}
+		cancel()
+	}()
+
+	go func() {
+		if err := startDeleteOldNsAdapterReportOperationsJob(ctx, svc, conf); err != nil {
+			log.C(ctx).WithError(err).Error("Failed to start delete old ns-adapter report operations cronjob. Stopping app...")
+		}
+		cancel()
+	}()
+
+	go func() {
+		if err := startRescheduleHangedNsAdapterReportOperationsJob(ctx, svc, conf); err != nil {
+			log.C(ctx).WithError(err).Error("Failed to start reschedule hanged ns-adapter report operations cronjob. Stopping app...")
+		}
+		cancel()
+	}()
+
+	go func() {
+		if err := startHealthChecksJob(ctx, healthChecksProber, conf); err != nil {
+			log.C(ctx).WithError(err).Error("Failed to start health checks cronjob. Stopping app...")
+		}
+		cancel()
+	}()
+
+	go func() {
+		if err := startDeleteOldFormationAssignmentTransitionsJob(ctx, transitionsCleaner, conf); err != nil {
+			log.C(ctx).WithError(err).Error("Failed to start formation assignment transitions deletion cronjob. Stopping app...")
+		}
+		cancel()
+	}()
+
 	log.C(ctx).Infof("Operations Manager has started")
 	runMainSrv()
 }
@@ -174,7 +229,7 @@ func startCreateORDOperationsJob(ctx context.Context, opManager *operationsmanag
 		Name: "CreateORDOperations",
 		Fn: func(jobCtx context.Context) {
 			log.C(jobCtx).Infof("Starting creation of ORD operations...")
-			if err := opManager.CreateORDOperations(ctx); err != nil {
+			if err := opManager.CreateORDOperations(jobCtx); err != nil {
 				log.C(jobCtx).WithError(err).Errorf("error occurred while creating Open Resource Discovery operations")
 			}
 			log.C(jobCtx).Infof("Creation of ORD operations finished.")
@@ -189,7 +244,7 @@ func startDeleteOldORDOperationsJob(ctx context.Context, opManager *operationsma
 		Name: "DeleteOldORDOperations",
 		Fn: func(jobCtx context.Context) {
 			log.C(jobCtx).Infof("Starting deletion of old ORD operations...")
-			if err := opManager.DeleteOldOperations(ctx, operationsmanager.OrdAggregationOpType, cfg.DeleteCompletedOpsOlderThanDays, cfg.DeleteFailedOpsOlderThanDays); err != nil {
+			if err := opManager.DeleteOldOperations(jobCtx, operationsmanager.OrdAggregationOpType, cfg.DeleteCompletedOpsOlderThanDays, cfg.DeleteFailedOpsOlderThanDays); err != nil {
 				log.C(jobCtx).WithError(err).Errorf("error occurred while deleting old Open Resource Discovery operations")
 			}
 			log.C(jobCtx).Infof("Deletion of old ORD operations finished.")
@@ -199,9 +254,82 @@ func startDeleteOldORDOperationsJob(ctx context.Context, opManager *operationsma
 	return cronjob.RunCronJob(ctx, cfg.ElectionConfig, job)
 }
 
-func createOperationsManagerService(ctx context.Context, cfgProvider *configprovider.Provider, transact persistence.Transactioner, ordWebhookMapping []application.ORDWebhookMapping, conf config, tenantMappingConfig map[string]interface{}, callbackURL string) (*operationsmanager.Service, error) {
-	retryHTTPExecutor := retry.NewHTTPExecutor(&conf.RetryConfig)
+func startRescheduleHangedORDOperationsJob(ctx context.Context, opManager *operationsmanager.Service, cfg config) error {
+	job := cronjob.CronJob{
+		Name: "RescheduleHangedORDOperations",
+		Fn: func(jobCtx context.Context) {
+			log.C(jobCtx).Infof("Starting rescheduling of hanged ORD operations...")
+			if err := opManager.RescheduleHangedOperations(jobCtx, operationsmanager.OrdAggregationOpType, cfg.OperationHangPeriod); err != nil {
+				log.C(jobCtx).WithError(err).Errorf("error occurred while rescheduling hanged Open Resource Discovery operations")
+			}
+			log.C(jobCtx).Infof("Rescheduling of hanged ORD operations finished.")
+		},
+		SchedulePeriod: cfg.ORDOpRescheduleJobSchedulePeriod,
+	}
+	return cronjob.RunCronJob(ctx, cfg.ElectionConfig, job)
+}
+
+func startDeleteOldNsAdapterReportOperationsJob(ctx context.Context, opManager *operationsmanager.Service, cfg config) error {
+	job := cronjob.CronJob{
+		Name: "DeleteOldNsAdapterReportOperations",
+		Fn: func(jobCtx context.Context) {
+			log.C(jobCtx).Infof("Starting deletion of old ns-adapter report operations...")
+			if err := opManager.DeleteOldOperations(jobCtx, operationsmanager.NsAdapterReportOpType, cfg.DeleteCompletedOpsOlderThanDays, cfg.DeleteFailedOpsOlderThanDays); err != nil {
+				log.C(jobCtx).WithError(err).Errorf("error occurred while deleting old ns-adapter report operations")
+			}
+			log.C(jobCtx).Infof("Deletion of old ns-adapter report operations finished.")
+		},
+		SchedulePeriod: cfg.NsAdapterReportOpDeletionJobSchedulePeriod,
+	}
+	return cronjob.RunCronJob(ctx, cfg.ElectionConfig, job)
+}
+
+func startRescheduleHangedNsAdapterReportOperationsJob(ctx context.Context, opManager *operationsmanager.Service, cfg config) error {
+	job := cronjob.CronJob{
+		Name: "RescheduleHangedNsAdapterReportOperations",
+		Fn: func(jobCtx context.Context) {
+			log.C(jobCtx).Infof("Starting rescheduling of hanged ns-adapter report operations...")
+			if err := opManager.RescheduleHangedOperations(jobCtx, operationsmanager.NsAdapterReportOpType, cfg.OperationHangPeriod); err != nil {
+				log.C(jobCtx).WithError(err).Errorf("error occurred while rescheduling hanged ns-adapter report operations")
+			}
+			log.C(jobCtx).Infof("Rescheduling of hanged ns-adapter report operations finished.")
+		},
+		SchedulePeriod: cfg.NsAdapterReportOpRescheduleJobSchedulePeriod,
+	}
+	return cronjob.RunCronJob(ctx, cfg.ElectionConfig, job)
+}
 
+func startHealthChecksJob(ctx context.Context, prober *healthcheck.Prober, cfg config) error {
+	job := cronjob.CronJob{
+		Name: "ApplicationHealthChecks",
+		Fn: func(jobCtx context.Context) {
+			log.C(jobCtx).Infof("Starting health checks of applications...")
+			if err := prober.ProbeApplications(jobCtx); err != nil {
+				log.C(jobCtx).WithError(err).Errorf("error occurred while performing health checks of applications")
+			}
+			log.C(jobCtx).Infof("Health checks of applications finished.")
+		},
+		SchedulePeriod: cfg.HealthChecksJobSchedulePeriod,
+	}
+	return cronjob.RunCronJob(ctx, cfg.ElectionConfig, job)
+}
+
+func startDeleteOldFormationAssignmentTransitionsJob(ctx context.Context, cleaner *formationassignment.TransitionsCleaner, cfg config) error {
+	job := cronjob.CronJob{
+		Name: "DeleteOldFormationAssignmentTransitions",
+		Fn: func(jobCtx context.Context) {
+			log.C(jobCtx).Infof("Starting deletion of old formation assignment transitions...")
+			if err := cleaner.DeleteExpiredTransitions(jobCtx); err != nil {
+				log.C(jobCtx).WithError(err).Errorf("error occurred while deleting old formation assignment transitions")
+			}
+			log.C(jobCtx).Infof("Deletion of old formation assignment transitions finished.")
+		},
+		SchedulePeriod: cfg.FormationAssignmentTransitionsDeletionJobSchedulePeriod,
+	}
+	return cronjob.RunCronJob(ctx, cfg.ElectionConfig, job)
+}
+
+func createHealthChecksProber(transact persistence.Transactioner, certCache certloader.Cache, conf config, credentialsEncryptor encryption.Encryptor) *healthcheck.Prober {
 	httpClient := &http.Client{
 		Timeout: conf.ClientTimeout,
 		Transport: &http.Transport{
@@ -210,11 +338,45 @@ func createOperationsManagerService(ctx context.Context, cfgProvider *configprov
 			},
 		},
 	}
+	accessStrategyExecutorProvider := accessstrategy.NewDefaultExecutorProvider(certCache, conf.ExternalClientCertSecretName, conf.ExtSvcClientCertSecretName)
 
-	certCache, err := certloader.StartCertLoader(ctx, conf.CertLoaderConfig)
-	if err != nil {
-		return nil, err
+	authConverter := auth.NewConverter()
+	versionConverter := version.NewConverter()
+	frConverter := fetchrequest.NewConverter(authConverter, credentialsEncryptor)
+	webhookConverter := webhook.NewConverter(authConverter, credentialsEncryptor)
+	specConverter := spec.NewConverter(frConverter)
+	apiConverter := api.NewConverter(versionConverter, specConverter)
+	eventAPIConverter := eventdef.NewConverter(versionConverter, specConverter)
+	docConverter := document.NewConverter(frConverter)
+	bundleConverter := bundleutil.NewConverter(authConverter, apiConverter, eventAPIConverter, docConverter, credentialsEncryptor)
+	appConverter := application.NewConverter(webhookConverter, bundleConverter)
+	healthCheckConverter := healthcheck.NewConverter()
+
+	applicationRepo := application.NewRepository(appConverter)
+	webhookRepo := webhook.NewRepository(webhookConverter)
+	healthCheckRepo := healthcheck.NewRepository(healthCheckConverter)
+
+	healthCheckSvc := healthcheck.NewService(healthCheckRepo, uid.NewService())
+	healthCheckClient := healthcheck.NewClient(httpClient, accessStrategyExecutorProvider)
+
+	return healthcheck.NewProber(transact, applicationRepo, webhookRepo, healthCheckSvc, healthCheckClient, healthcheck.ProberConfig{
+		RetentionPeriod:   conf.HealthChecksRetentionPeriod,
+		MaxParallelProbes: conf.HealthChecksMaxParallelProbes,
+	})
+}
+
+func createOperationsManagerService(cfgProvider *configprovider.Provider, transact persistence.Transactioner, certCache certloader.Cache, ordWebhookMapping []application.ORDWebhookMapping, conf config, tenantMappingConfig map[string]interface{}, callbackURL string, credentialsEncryptor encryption.Encryptor) *operationsmanager.Service {
+	retryHTTPExecutor := retry.NewHTTPExecutor(&conf.RetryConfig)
+
+	httpClient := &http.Client{
+		Timeout: conf.ClientTimeout,
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{
+				InsecureSkipVerify: conf.SkipSSLValidation,
+			},
+		},
 	}
+
 	accessStrategyExecutorProviderWithoutTenant := accessstrategy.NewDefaultExecutorProvider(certCache, conf.ExternalClientCertSecretName, conf.ExtSvcClientCertSecretName)
 
 	securedHTTPClient := httputil.PrepareHTTPClientWithSSLValidation(conf.ClientTimeout, conf.SkipSSLValidation)
@@ -225,14 +387,14 @@ func createOperationsManagerService(ctx context.Context, cfgProvider *configprov
 	opConv := operation.NewConverter()
 	tenantConverter := tenant.NewConverter()
 	authConverter := auth.NewConverter()
-	frConverter := fetchrequest.NewConverter(authConverter)
+	frConverter := fetchrequest.NewConverter(authConverter, credentialsEncryptor)
 	versionConverter := version.NewConverter()
 	docConverter := document.NewConverter(frConverter)
-	webhookConverter := webhook.NewConverter(authConverter)
+	webhookConverter := webhook.NewConverter(authConverter, credentialsEncryptor)
 	specConverter := spec.NewConverter(frConverter)
 	apiConverter := api.NewConverter(versionConverter, specConverter)
 	eventAPIConverter := eventdef.NewConverter(versionConverter, specConverter)
-	bundleConverter := bundleutil.NewConverter(authConverter, apiConverter, eventAPIConverter, docConverter)
+	bundleConverter := bundleutil.NewConverter(authConverter, apiConverter, eventAPIConverter, docConverter, credentialsEncryptor)
 	appConverter := application.NewConverter(webhookConverter, bundleConverter)
 	runtimeConverter := runtime.NewConverter(webhookConverter)
 	labelConverter := label.NewConverter()
@@ -247,7 +409,7 @@ func createOperationsManagerService(ctx context.Context, cfgProvider *configprov
 	assignmentConv := scenarioassignment.NewConverter()
 	formationAssignmentConv := formationassignment.NewConverter()
 	formationTemplateConstraintReferencesConverter := formationtemplateconstraintreferences.NewConverter()
-	bundleInstanceAuthConv := bundleinstanceauth.NewConverter(authConverter)
+	bundleInstanceAuthConv := bundleinstanceauth.NewConverter(authConverter, credentialsEncryptor)
 
 	opRepo := operation.NewRepository(opConv)
 	applicationRepo := application.NewRepository(appConverter)
@@ -299,14 +461,14 @@ func createOperationsManagerService(ctx context.Context, cfgProvider *configprov
 	notificationsGenerator := formation.NewNotificationsGenerator(applicationRepo, appTemplateRepo, runtimeRepo, runtimeContextRepo, labelRepo, webhookRepo, webhookDataInputBuilder, notificationsBuilder)
 	notificationSvc := formation.NewNotificationService(tenantRepo, webhookClient, notificationsGenerator, constraintEngine, webhookConverter, formationTemplateRepo)
 	faNotificationSvc := formationassignment.NewFormationAssignmentNotificationService(formationAssignmentRepo, webhookConverter, webhookRepo, tenantRepo, webhookDataInputBuilder, formationRepo, notificationsBuilder, runtimeContextRepo, labelSvc, conf.Features.RuntimeTypeLabelKey, conf.Features.ApplicationTypeLabelKey)
-	formationAssignmentStatusSvc := formationassignment.NewFormationAssignmentStatusService(formationAssignmentRepo, constraintEngine, faNotificationSvc)
+	formationAssignmentStatusSvc := formationassignment.NewFormationAssignmentStatusService(formationAssignmentRepo, uidSvc, constraintEngine, faNotificationSvc)
 	formationAssignmentSvc := formationassignment.NewService(formationAssignmentRepo, uidSvc, applicationRepo, runtimeRepo, runtimeContextRepo, notificationSvc, faNotificationSvc, labelSvc, formationRepo, formationAssignmentStatusSvc, conf.Features.RuntimeTypeLabelKey, conf.Features.ApplicationTypeLabelKey)
 	formationStatusSvc := formation.NewFormationStatusService(formationRepo, labelDefRepo, scenariosSvc, notificationSvc, constraintEngine)
 	formationSvc := formation.NewService(transact, applicationRepo, labelDefRepo, labelRepo, formationRepo, formationTemplateRepo, labelSvc, uidSvc, scenariosSvc, scenarioAssignmentRepo, scenarioAssignmentSvc, tntSvc, runtimeRepo, runtimeContextRepo, formationAssignmentSvc, faNotificationSvc, notificationSvc, constraintEngine, webhookRepo, formationStatusSvc, conf.Features.RuntimeTypeLabelKey, conf.Features.ApplicationTypeLabelKey)
 	appSvc := application.NewService(&normalizer.DefaultNormalizator{}, cfgProvider, applicationRepo, webhookRepo, runtimeRepo, labelRepo, intSysRepo, labelSvc, bundleSvc, uidSvc, formationSvc, conf.SelfRegisterDistinguishLabelKey, ordWebhookMapping)
 
 	ordOpCreator := operationsmanager.NewOperationCreator(operationsmanager.OrdCreatorType, transact, opSvc, webhookSvc, appSvc)
-	return operationsmanager.NewOperationService(transact, opSvc, ordOpCreator), nil
+	return operationsmanager.NewOperationService(transact, opSvc, ordOpCreator)
 }
 
 func exitOnError(err error, context string) {
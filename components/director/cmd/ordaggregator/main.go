@@ -59,12 +59,17 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/domain/application"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/auth"
 	bundleutil "github.com/kyma-incubator/compass/components/director/internal/domain/bundle"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/capability"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/document"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/entitytype"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/eventdef"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/fetchrequest"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/fetchvalidator"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/integrationdependency"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/integrationsystem"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/label"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/labeldef"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/operation"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/ordvendor"
 	ordpackage "github.com/kyma-incubator/compass/components/director/internal/domain/package"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/product"
@@ -75,12 +80,15 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/domain/webhook"
 	"github.com/kyma-incubator/compass/components/director/internal/features"
 	ord "github.com/kyma-incubator/compass/components/director/internal/open_resource_discovery"
+	operationsmanager "github.com/kyma-incubator/compass/components/director/internal/operations_manager"
 	"github.com/kyma-incubator/compass/components/director/internal/uid"
 	configprovider "github.com/kyma-incubator/compass/components/director/pkg/config"
+	"github.com/kyma-incubator/compass/components/director/pkg/encryption"
 	"github.com/kyma-incubator/compass/components/director/pkg/executor"
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
 	"github.com/kyma-incubator/compass/components/director/pkg/normalizer"
 	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/kyma-incubator/compass/components/director/pkg/tracing"
 	"github.com/pkg/errors"
 	"github.com/vrischmann/envconfig"
 )
@@ -92,10 +100,12 @@ type config struct {
 	ServerTimeout   time.Duration `envconfig:"default=110s"`
 	ShutdownTimeout time.Duration `envconfig:"default=10s"`
 
-	SecurityConfig securityConfig
-	Database       persistence.DatabaseConfig
-	Log            log.Config
-	Features       features.Config
+	SecurityConfig        securityConfig
+	Database              persistence.DatabaseConfig
+	CredentialsEncryption encryption.Config
+	Log                   log.Config
+	Tracing               tracing.Config
+	Features              features.Config
 
 	ConfigurationFile       string
 	ConfigurationFileReload time.Duration `envconfig:"default=1m"`
@@ -114,6 +124,10 @@ type config struct {
 	MaxParallelDocumentsPerApplication int `envconfig:"APP_MAX_PARALLEL_DOCUMENTS_PER_APPLICATION"`
 	MaxParallelSpecificationProcessors int `envconfig:"APP_MAX_PARALLEL_SPECIFICATION_PROCESSORS,default=100"`
 
+	OperationsProcessingEnabled     bool `envconfig:"APP_ORD_OPERATIONS_PROCESSING_ENABLED,default=false"`
+	MaxParallelOperationsProcessors int  `envconfig:"APP_MAX_PARALLEL_OPERATIONS_PROCESSORS,default=1"`
+	OperationsWorkerConfig          operationsmanager.WorkerConfig
+
 	OrdWebhookPartialProcessURL     string `envconfig:"optional,APP_ORD_WEBHOOK_PARTIAL_PROCESS_URL"`
 	OrdWebhookPartialProcessMaxDays int    `envconfig:"APP_ORD_WEBHOOK_PARTIAL_PROCESS_MAX_DAYS,default=0"`
 	OrdWebhookPartialProcessing     bool   `envconfig:"APP_ORD_WEBHOOK_PARTIAL_PROCESSING,default=false"`
@@ -164,11 +178,22 @@ func main() {
 	ctx, err = log.Configure(ctx, &cfg.Log)
 	exitOnError(err, "Error while configuring logger")
 
+	shutdownTracing, err := tracing.Configure(ctx, &cfg.Tracing, "ord-aggregator")
+	exitOnError(err, "Error while configuring tracing")
+
+	defer func() {
+		err := shutdownTracing(context.Background())
+		exitOnError(err, "Error while shutting down tracing")
+	}()
+
 	ordWebhookMapping, err := application.UnmarshalMappings(cfg.ORDWebhookMappings)
 	exitOnError(err, "failed while unmarshalling ord webhook mappings")
 
 	cfgProvider := createAndRunConfigProvider(ctx, cfg)
 
+	credentialsEncryptor, err := encryption.NewCredentialsEncryptor(cfg.CredentialsEncryption)
+	exitOnError(err, "Error while creating credentials encryptor")
+
 	transact, closeFunc, err := persistence.Configure(ctx, cfg.Database)
 	exitOnError(err, "Error while establishing the connection to the database")
 
@@ -182,11 +207,11 @@ func main() {
 
 	httpClient := &http.Client{
 		Timeout: cfg.ClientTimeout,
-		Transport: &http.Transport{
+		Transport: httputilpkg.NewTracingTransport(httputilpkg.NewHTTPTransportWrapper(&http.Transport{
 			TLSClientConfig: &tls.Config{
 				InsecureSkipVerify: cfg.SkipSSLValidation,
 			},
-		},
+		})),
 	}
 
 	securedHTTPClient := httputil.PrepareHTTPClientWithSSLValidation(cfg.ClientTimeout, cfg.SkipSSLValidation)
@@ -197,7 +222,7 @@ func main() {
 	accessStrategyExecutorProviderWithoutTenant := accessstrategy.NewDefaultExecutorProvider(certCache, cfg.ExternalClientCertSecretName, cfg.ExtSvcClientCertSecretName)
 	retryHTTPExecutor := retry.NewHTTPExecutor(&cfg.RetryConfig)
 
-	ordAggregator := createORDAggregatorSvc(cfgProvider, cfg, transact, httpClient, securedHTTPClient, mtlsClient, extSvcMtlsClient, accessStrategyExecutorProviderWithTenant, accessStrategyExecutorProviderWithoutTenant, retryHTTPExecutor, ordWebhookMapping, tenantMappingConfig, cfg.TenantMappingCallbackURL, credentialExchangeStrategyTenantMappings)
+	ordAggregator := createORDAggregatorSvc(cfgProvider, cfg, transact, httpClient, securedHTTPClient, mtlsClient, extSvcMtlsClient, accessStrategyExecutorProviderWithTenant, accessStrategyExecutorProviderWithoutTenant, retryHTTPExecutor, ordWebhookMapping, tenantMappingConfig, cfg.TenantMappingCallbackURL, credentialExchangeStrategyTenantMappings, credentialsEncryptor)
 
 	jwtHTTPClient := &http.Client{
 		Transport: httputilpkg.NewCorrelationIDTransport(httputilpkg.NewHTTPTransportWrapper(http.DefaultTransport.(*http.Transport))),
@@ -224,9 +249,25 @@ func main() {
 		}()
 	}
 
+	if cfg.OperationsProcessingEnabled {
+		startORDOperationsWorkers(ctx, ordAggregator, transact, cfg)
+	}
+
 	runMainSrv()
 }
 
+func startORDOperationsWorkers(ctx context.Context, ordAggregator *ord.Service, transact persistence.Transactioner, cfg config) {
+	opSvc := operation.NewService(operation.NewRepository(operation.NewConverter()), uid.NewService())
+	opManager := operationsmanager.NewOperationsManager(transact, opSvc, operationsmanager.OrdAggregationOpType, cfg.OperationsWorkerConfig.MaxAttempts)
+	processor := ord.NewOperationsProcessor(ordAggregator, cfg.MetricsConfig)
+
+	log.C(ctx).Infof("Starting %d parallel ORD operations workers...", cfg.MaxParallelOperationsProcessors)
+	for i := 0; i < cfg.MaxParallelOperationsProcessors; i++ {
+		worker := operationsmanager.NewWorker(i, cfg.OperationsWorkerConfig, opManager, processor)
+		go worker.Run(ctx)
+	}
+}
+
 func startSyncORDDocumentsJob(ctx context.Context, ordAggregator *ord.Service, cfg config) error {
 	resyncJob := cronjob.CronJob{
 		Name: "SyncORDDocuments",
@@ -251,19 +292,19 @@ func ctxTenantProvider(ctx context.Context) (string, error) {
 	return localTenantID, nil
 }
 
-func createORDAggregatorSvc(cfgProvider *configprovider.Provider, config config, transact persistence.Transactioner, httpClient, securedHTTPClient, mtlsClient, extSvcMtlsClient *http.Client, accessStrategyExecutorProviderWithTenant *accessstrategy.Provider, accessStrategyExecutorProviderWithoutTenant *accessstrategy.Provider, retryHTTPExecutor *retry.HTTPExecutor, ordWebhookMapping []application.ORDWebhookMapping, tenantMappingConfig map[string]interface{}, tenantMappingCallbackURL string, credentialExchangeStrategyTenantMappings map[string]ord.CredentialExchangeStrategyTenantMapping) *ord.Service {
+func createORDAggregatorSvc(cfgProvider *configprovider.Provider, config config, transact persistence.Transactioner, httpClient, securedHTTPClient, mtlsClient, extSvcMtlsClient *http.Client, accessStrategyExecutorProviderWithTenant *accessstrategy.Provider, accessStrategyExecutorProviderWithoutTenant *accessstrategy.Provider, retryHTTPExecutor *retry.HTTPExecutor, ordWebhookMapping []application.ORDWebhookMapping, tenantMappingConfig map[string]interface{}, tenantMappingCallbackURL string, credentialExchangeStrategyTenantMappings map[string]ord.CredentialExchangeStrategyTenantMapping, credentialsEncryptor encryption.Encryptor) *ord.Service {
 	authConverter := auth.NewConverter()
-	frConverter := fetchrequest.NewConverter(authConverter)
+	frConverter := fetchrequest.NewConverter(authConverter, credentialsEncryptor)
 	versionConverter := version.NewConverter()
 	docConverter := document.NewConverter(frConverter)
-	webhookConverter := webhook.NewConverter(authConverter)
+	webhookConverter := webhook.NewConverter(authConverter, credentialsEncryptor)
 	specConverter := spec.NewConverter(frConverter)
 	apiConverter := api.NewConverter(versionConverter, specConverter)
 	eventAPIConverter := eventdef.NewConverter(versionConverter, specConverter)
 	labelDefConverter := labeldef.NewConverter()
 	labelConverter := label.NewConverter()
 	intSysConverter := integrationsystem.NewConverter()
-	bundleConverter := bundleutil.NewConverter(authConverter, apiConverter, eventAPIConverter, docConverter)
+	bundleConverter := bundleutil.NewConverter(authConverter, apiConverter, eventAPIConverter, docConverter, credentialsEncryptor)
 	appConverter := application.NewConverter(webhookConverter, bundleConverter)
 	appTemplateConverter := apptemplate.NewConverter(appConverter, webhookConverter)
 	pkgConverter := ordpackage.NewConverter()
@@ -281,7 +322,11 @@ func createORDAggregatorSvc(cfgProvider *configprovider.Provider, config config,
 	tenantConverter := tenant.NewConverter()
 	appTemplateVersionConv := apptemplateversion.NewConverter()
 	formationAssignmentConv := formationassignment.NewConverter()
-	bundleInstanceAuthConv := bundleinstanceauth.NewConverter(authConverter)
+	bundleInstanceAuthConv := bundleinstanceauth.NewConverter(authConverter, credentialsEncryptor)
+	fetchValidatorConv := fetchvalidator.NewConverter()
+	entityTypeConverter := entitytype.NewConverter()
+	capabilityConverter := capability.NewConverter()
+	integrationDependencyConverter := integrationdependency.NewConverter()
 
 	runtimeRepo := runtime.NewRepository(runtimeConverter)
 	applicationRepo := application.NewRepository(appConverter)
@@ -311,6 +356,10 @@ func createORDAggregatorSvc(cfgProvider *configprovider.Provider, config config,
 	appTemplateVersionRepo := apptemplateversion.NewRepository(appTemplateVersionConv)
 	formationAssignmentRepo := formationassignment.NewRepository(formationAssignmentConv)
 	bundleInstanceAuthRepo := bundleinstanceauth.NewRepository(bundleInstanceAuthConv)
+	fetchValidatorRepo := fetchvalidator.NewRepository(fetchValidatorConv)
+	entityTypeRepo := entitytype.NewRepository(entityTypeConverter)
+	capabilityRepo := capability.NewRepository(capabilityConverter)
+	integrationDependencyRepo := integrationdependency.NewRepository(integrationDependencyConverter)
 
 	timeSvc := directorTime.NewService()
 	uidSvc := uid.NewService()
@@ -338,7 +387,7 @@ func createORDAggregatorSvc(cfgProvider *configprovider.Provider, config config,
 	notificationsGenerator := formation.NewNotificationsGenerator(applicationRepo, appTemplateRepo, runtimeRepo, runtimeContextRepo, labelRepo, webhookRepo, webhookDataInputBuilder, notificationsBuilder)
 	notificationSvc := formation.NewNotificationService(tenantRepo, webhookClient, notificationsGenerator, constraintEngine, webhookConverter, formationTemplateRepo)
 	faNotificationSvc := formationassignment.NewFormationAssignmentNotificationService(formationAssignmentRepo, webhookConverter, webhookRepo, tenantRepo, webhookDataInputBuilder, formationRepo, notificationsBuilder, runtimeContextRepo, labelSvc, config.Features.RuntimeTypeLabelKey, config.Features.ApplicationTypeLabelKey)
-	formationAssignmentStatusSvc := formationassignment.NewFormationAssignmentStatusService(formationAssignmentRepo, constraintEngine, faNotificationSvc)
+	formationAssignmentStatusSvc := formationassignment.NewFormationAssignmentStatusService(formationAssignmentRepo, uidSvc, constraintEngine, faNotificationSvc)
 	formationAssignmentSvc := formationassignment.NewService(formationAssignmentRepo, uidSvc, applicationRepo, runtimeRepo, runtimeContextRepo, notificationSvc, faNotificationSvc, labelSvc, formationRepo, formationAssignmentStatusSvc, config.Features.RuntimeTypeLabelKey, config.Features.ApplicationTypeLabelKey)
 	formationStatusSvc := formation.NewFormationStatusService(formationRepo, labelDefRepo, scenariosSvc, notificationSvc, constraintEngine)
 	formationSvc := formation.NewService(transact, applicationRepo, labelDefRepo, labelRepo, formationRepo, formationTemplateRepo, labelSvc, uidSvc, scenariosSvc, scenarioAssignmentRepo, scenarioAssignmentSvc, tntSvc, runtimeRepo, runtimeContextRepo, formationAssignmentSvc, faNotificationSvc, notificationSvc, constraintEngine, webhookRepo, formationStatusSvc, config.Features.RuntimeTypeLabelKey, config.Features.ApplicationTypeLabelKey)
@@ -347,8 +396,12 @@ func createORDAggregatorSvc(cfgProvider *configprovider.Provider, config config,
 	productSvc := product.NewService(productRepo, uidSvc)
 	vendorSvc := ordvendor.NewService(vendorRepo, uidSvc)
 	tombstoneSvc := tombstone.NewService(tombstoneRepo, uidSvc)
-	appTemplateSvc := apptemplate.NewService(appTemplateRepo, webhookRepo, uidSvc, labelSvc, labelRepo, applicationRepo)
+	appTemplateSvc := apptemplate.NewService(appTemplateRepo, webhookRepo, uidSvc, labelSvc, labelRepo, applicationRepo, credentialsEncryptor)
 	appTemplateVersionSvc := apptemplateversion.NewService(appTemplateVersionRepo, appTemplateSvc, uidSvc, timeSvc)
+	fetchValidatorSvc := fetchvalidator.NewService(fetchValidatorRepo, uidSvc)
+	entityTypeSvc := entitytype.NewService(entityTypeRepo, uidSvc)
+	capabilitySvc := capability.NewService(capabilityRepo, uidSvc)
+	integrationDependencySvc := integrationdependency.NewService(integrationDependencyRepo, apiRepo, eventAPIRepo, uidSvc)
 
 	clientConfig := ord.NewClientConfig(config.MaxParallelDocumentsPerApplication)
 
@@ -358,7 +411,7 @@ func createORDAggregatorSvc(cfgProvider *configprovider.Provider, config config,
 	globalRegistrySvc := ord.NewGlobalRegistryService(transact, config.GlobalRegistryConfig, vendorSvc, productSvc, ordClientWithoutTenantExecutor, credentialExchangeStrategyTenantMappings)
 
 	ordConfig := ord.NewServiceConfig(config.MaxParallelWebhookProcessors, config.MaxParallelSpecificationProcessors, config.OrdWebhookPartialProcessMaxDays, config.OrdWebhookPartialProcessURL, config.OrdWebhookPartialProcessing, credentialExchangeStrategyTenantMappings)
-	return ord.NewAggregatorService(ordConfig, transact, appSvc, webhookSvc, bundleSvc, bundleReferenceSvc, apiSvc, eventAPISvc, specSvc, fetchRequestSvc, packageSvc, productSvc, vendorSvc, tombstoneSvc, tenantSvc, globalRegistrySvc, ordClientWithTenantExecutor, webhookConverter, appTemplateVersionSvc, appTemplateSvc)
+	return ord.NewAggregatorService(ordConfig, transact, appSvc, webhookSvc, bundleSvc, bundleReferenceSvc, apiSvc, eventAPISvc, entityTypeSvc, capabilitySvc, integrationDependencySvc, specSvc, fetchRequestSvc, packageSvc, productSvc, vendorSvc, tombstoneSvc, tenantSvc, globalRegistrySvc, ordClientWithTenantExecutor, webhookConverter, appTemplateVersionSvc, appTemplateSvc, fetchValidatorSvc)
 }
 
 func createAndRunConfigProvider(ctx context.Context, cfg config) *configprovider.Provider {
@@ -421,7 +474,7 @@ func initHandler(ctx context.Context, httpClient *http.Client, svc *ord.Service,
 	logger := log.C(ctx)
 
 	mainRouter := mux.NewRouter()
-	mainRouter.Use(correlation.AttachCorrelationIDToContext(), log.RequestLogger(
+	mainRouter.Use(correlation.AttachCorrelationIDToContext(), tracing.NewHTTPMiddleware("ord-aggregator"), log.RequestLogger(
 		cfg.AggregatorRootAPI+healthzEndpoint, cfg.AggregatorRootAPI+readyzEndpoint))
 
 	handler := ord.NewORDAggregatorHTTPHandler(svc, cfg.MetricsConfig)
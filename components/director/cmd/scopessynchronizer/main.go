@@ -14,6 +14,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/uid"
 	configprovider "github.com/kyma-incubator/compass/components/director/pkg/config"
 	"github.com/kyma-incubator/compass/components/director/pkg/correlation"
+	"github.com/kyma-incubator/compass/components/director/pkg/encryption"
 	httputil "github.com/kyma-incubator/compass/components/director/pkg/http"
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
 	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
@@ -25,9 +26,10 @@ import (
 const envPrefix = "APP"
 
 type config struct {
-	Database          persistence.DatabaseConfig
-	ConfigurationFile string
-	OAuth20           oauth20.Config
+	Database              persistence.DatabaseConfig
+	CredentialsEncryption encryption.Config
+	ConfigurationFile     string
+	OAuth20               oauth20.Config
 }
 
 func main() {
@@ -58,6 +60,9 @@ func main() {
 	cfgProvider := configProvider(ctx, cfg)
 	oAuth20Svc := oauth20.NewService(cfgProvider, uidSvc, cfg.OAuth20.PublicAccessTokenEndpoint, hydra.Admin)
 
+	credentialsEncryptor, err := encryption.NewCredentialsEncryptor(cfg.CredentialsEncryption)
+	exitOnError(ctx, err, "Error while creating credentials encryptor")
+
 	transact, closeFunc, err := persistence.Configure(ctx, cfg.Database)
 	exitOnError(ctx, err, "Error while establishing the connection to the database")
 	defer func() {
@@ -66,7 +71,7 @@ func main() {
 	}()
 
 	authConverter := auth.NewConverter()
-	systemAuthConverter := systemauth.NewConverter(authConverter)
+	systemAuthConverter := systemauth.NewConverter(authConverter, credentialsEncryptor)
 	syncService := scopes.NewService(oAuth20Svc, transact, systemauth.NewRepository(systemAuthConverter))
 	err = syncService.SynchronizeClientScopes(ctx)
 	exitOnError(ctx, err, "Error while updating client scopes")
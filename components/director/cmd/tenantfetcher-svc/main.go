@@ -37,6 +37,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/tenantfetchersvc/resync"
 	configprovider "github.com/kyma-incubator/compass/components/director/pkg/config"
 	"github.com/kyma-incubator/compass/components/director/pkg/correlation"
+	"github.com/kyma-incubator/compass/components/director/pkg/encryption"
 	"github.com/kyma-incubator/compass/components/director/pkg/executor"
 	graphqlclient "github.com/kyma-incubator/compass/components/director/pkg/graphql_client"
 	timeouthandler "github.com/kyma-incubator/compass/components/director/pkg/handler"
@@ -93,7 +94,10 @@ func main() {
 	ctx, err = log.Configure(ctx, &cfg.Log)
 	exitOnError(err, "Failed to configure Logger")
 
-	tenantSynchronizers, dbCloseFuncs := tenantSynchronizers(ctx, cfg.Handler, cfg.Features)
+	credentialsEncryptor, err := encryption.NewCredentialsEncryptor(cfg.Handler.CredentialsEncryption)
+	exitOnError(err, "Error while creating credentials encryptor")
+
+	tenantSynchronizers, dbCloseFuncs := tenantSynchronizers(ctx, cfg.Handler, cfg.Features, credentialsEncryptor)
 	defer func() {
 		for _, fn := range dbCloseFuncs {
 			if err := fn(); err != nil {
@@ -238,7 +242,7 @@ func registerTenantsHandler(ctx context.Context, router *mux.Router, cfg tenantf
 	router.HandleFunc(cfg.DependenciesEndpoint, tenantHandler.Dependencies).Methods(http.MethodGet)
 }
 
-func tenantSynchronizers(ctx context.Context, taConfig tenantfetcher.HandlerConfig, featuresConfig features.Config) ([]*resync.TenantsSynchronizer, []func() error) {
+func tenantSynchronizers(ctx context.Context, taConfig tenantfetcher.HandlerConfig, featuresConfig features.Config, credentialsEncryptor encryption.Encryptor) ([]*resync.TenantsSynchronizer, []func() error) {
 	envVars := resync.ReadFromEnvironment(os.Environ())
 	jobNames := resync.GetJobNames(envVars)
 	log.C(ctx).Infof("Tenant fetcher jobs are: %s", strings.Join(jobNames, ","))
@@ -268,7 +272,7 @@ func tenantSynchronizers(ctx context.Context, taConfig tenantfetcher.HandlerConf
 			Labels:     []string{metrics.ErrorMetricLabel},
 		}
 		metricsPusher := metrics.NewAggregationFailurePusher(metricsCfg)
-		builder := resync.NewSynchronizerBuilder(jobConfig, featuresConfig, transact, directorClient, metricsPusher)
+		builder := resync.NewSynchronizerBuilder(jobConfig, featuresConfig, transact, directorClient, metricsPusher, credentialsEncryptor)
 		log.C(ctx).Infof("Creating tenant synchronizer %s for tenants of type %s", jobConfig.JobName, jobConfig.TenantType)
 		synchronizer, err := builder.Build(ctx)
 		exitOnError(err, fmt.Sprintf("Error while creating tenant synchronizer %s for tenants of type %s", jobConfig.JobName, jobConfig.TenantType))
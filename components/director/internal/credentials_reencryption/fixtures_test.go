@@ -0,0 +1,37 @@
+package reencryption_test
+
+import (
+	"encoding/base64"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/encryption"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	firstID  = "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa"
+	secondID = "bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb"
+	minID    = "00000000-0000-0000-0000-000000000000"
+	password = "password"
+)
+
+func fixEncryptor(t *testing.T, activeKeyVersion string) encryption.Encryptor {
+	keys := fmt.Sprintf(`{"activeKeyVersion": %q, "keys": {"1": %q, "2": %q}}`, activeKeyVersion,
+		base64.StdEncoding.EncodeToString([]byte(strings.Repeat("a", 32))), base64.StdEncoding.EncodeToString([]byte(strings.Repeat("b", 32))))
+
+	path := filepath.Join(t.TempDir(), "keys.json")
+	require.NoError(t, os.WriteFile(path, []byte(keys), 0600))
+
+	keyProvider, err := encryption.NewFileKeyProvider(path)
+	require.NoError(t, err)
+
+	return encryption.NewEnvelopeEncryptor(keyProvider)
+}
+
+func fixBasicAuthValue(password string) string {
+	return fmt.Sprintf(`{"Credential":{"Basic":{"Username":"user","Password":%q},"Oauth":null,"CertificateOAuth":null},"AccessStrategy":null,"AdditionalHeaders":null,"AdditionalQueryParams":null,"RequestAuth":null,"OneTimeToken":null,"CertCommonName":""}`, password)
+}
@@ -0,0 +1,74 @@
+package reencryption
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+)
+
+// CredentialsColumn is a database column holding JSON serialized credentials
+type CredentialsColumn struct {
+	Table  string
+	Column string
+}
+
+// CredentialsColumns are all database columns in which credentials are stored
+var CredentialsColumns = []CredentialsColumn{
+	{Table: "public.webhooks", Column: "auth"},
+	{Table: "public.system_auths", Column: "value"},
+	{Table: "public.bundle_instance_auths", Column: "auth_value"},
+	{Table: "public.bundles", Column: "default_instance_auth"},
+	{Table: "public.fetch_requests", Column: "auth"},
+}
+
+// StoredCredentials represents the credentials stored in a single row
+type StoredCredentials struct {
+	ID    string `db:"id"`
+	Value string `db:"value"`
+}
+
+type pgRepository struct{}
+
+// NewRepository creates a new repository for reading and writing raw credentials regardless of the tenant they belong to
+func NewRepository() *pgRepository {
+	return &pgRepository{}
+}
+
+// ListPage lists up to `pageSize` non-empty credentials from the provided column ordered by the row id, starting after `afterID`.
+// The listed rows are locked until the end of the transaction, so that they are not changed concurrently before they are re-encrypted.
+func (r *pgRepository) ListPage(ctx context.Context, column CredentialsColumn, afterID string, pageSize int) ([]StoredCredentials, error) {
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := fmt.Sprintf(`SELECT id, %s AS value FROM %s WHERE %s IS NOT NULL AND id > $1 ORDER BY id ASC LIMIT $2 FOR UPDATE`, column.Column, column.Table, column.Column)
+
+	log.C(ctx).Debugf("Executing DB query: %s", query)
+	var credentials []StoredCredentials
+	if err := persist.SelectContext(ctx, &credentials, query, afterID, pageSize); err != nil {
+		return nil, persistence.MapSQLError(ctx, err, resource.Credentials, resource.List, "while listing credentials from '%s' table", column.Table)
+	}
+
+	return credentials, nil
+}
+
+// Update overrides the credentials stored in the provided column of the row with the provided id
+func (r *pgRepository) Update(ctx context.Context, column CredentialsColumn, id, value string) error {
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return err
+	}
+
+	stmt := fmt.Sprintf(`UPDATE %s SET %s = $1 WHERE id = $2`, column.Table, column.Column)
+
+	log.C(ctx).Debugf("Executing DB query: %s", stmt)
+	if _, err := persist.ExecContext(ctx, stmt, value, id); err != nil {
+		return persistence.MapSQLError(ctx, err, resource.Credentials, resource.Update, "while updating credentials in '%s' table", column.Table)
+	}
+
+	return nil
+}
@@ -0,0 +1,109 @@
+package reencryption_test
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	reencryption "github.com/kyma-incubator/compass/components/director/internal/credentials_reencryption"
+	"github.com/kyma-incubator/compass/components/director/internal/repo/testdb"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPgRepository_ListPage(t *testing.T) {
+	column := reencryption.CredentialsColumn{Table: "public.webhooks", Column: "auth"}
+	query := regexp.QuoteMeta(`SELECT id, auth AS value FROM public.webhooks WHERE auth IS NOT NULL AND id > $1 ORDER BY id ASC LIMIT $2 FOR UPDATE`)
+
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectQuery(query).
+			WithArgs(minID, 2).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "value"}).AddRow(firstID, fixBasicAuthValue(password)))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		// WHEN
+		credentials, err := reencryption.NewRepository().ListPage(ctx, column, minID, 2)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, []reencryption.StoredCredentials{{ID: firstID, Value: fixBasicAuthValue(password)}}, credentials)
+	})
+
+	t.Run("Error when executing db query", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectQuery(query).
+			WithArgs(minID, 2).
+			WillReturnError(errors.New("test error"))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		// WHEN
+		_, err := reencryption.NewRepository().ListPage(ctx, column, minID, 2)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Unexpected error while executing SQL query")
+	})
+
+	t.Run("Error when persistence is missing in the context", func(t *testing.T) {
+		// WHEN
+		_, err := reencryption.NewRepository().ListPage(context.TODO(), column, minID, 2)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "unable to fetch database from context")
+	})
+}
+
+func TestPgRepository_Update(t *testing.T) {
+	column := reencryption.CredentialsColumn{Table: "public.system_auths", Column: "value"}
+	query := regexp.QuoteMeta(`UPDATE public.system_auths SET value = $1 WHERE id = $2`)
+
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectExec(query).
+			WithArgs(fixBasicAuthValue(password), firstID).
+			WillReturnResult(sqlmock.NewResult(-1, 1))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		// WHEN
+		err := reencryption.NewRepository().Update(ctx, column, firstID, fixBasicAuthValue(password))
+
+		// THEN
+		require.NoError(t, err)
+	})
+
+	t.Run("Error when executing db query", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectExec(query).
+			WithArgs(fixBasicAuthValue(password), firstID).
+			WillReturnError(errors.New("test error"))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		// WHEN
+		err := reencryption.NewRepository().Update(ctx, column, firstID, fixBasicAuthValue(password))
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Unexpected error while executing SQL query")
+	})
+}
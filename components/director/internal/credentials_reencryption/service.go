@@ -0,0 +1,143 @@
+package reencryption
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/auth"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/encryption"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/pkg/errors"
+)
+
+const minID = "00000000-0000-0000-0000-000000000000"
+
+// CredentialsRepository is responsible for reading and writing raw credentials
+//
+//go:generate mockery --name=CredentialsRepository --output=automock --outpkg=automock --case=underscore --disable-version-string
+type CredentialsRepository interface {
+	ListPage(ctx context.Context, column CredentialsColumn, afterID string, pageSize int) ([]StoredCredentials, error)
+	Update(ctx context.Context, column CredentialsColumn, id, value string) error
+}
+
+type service struct {
+	transact  persistence.Transactioner
+	repo      CredentialsRepository
+	encryptor encryption.Encryptor
+	pageSize  int
+}
+
+// NewService creates a new credentials re-encryption service which re-encrypts the credentials with the provided encryptor
+func NewService(transact persistence.Transactioner, repo CredentialsRepository, encryptor encryption.Encryptor, pageSize int) *service {
+	return &service{
+		transact:  transact,
+		repo:      repo,
+		encryptor: encryptor,
+		pageSize:  pageSize,
+	}
+}
+
+// ReEncryptCredentials encrypts all stored credentials which are either not encrypted yet or encrypted with an old key version
+// with the active key of the credentials encryptor. Every page of credentials is re-encrypted in a separate transaction.
+func (s *service) ReEncryptCredentials(ctx context.Context) error {
+	for _, column := range CredentialsColumns {
+		if err := s.reEncryptColumn(ctx, column); err != nil {
+			return errors.Wrapf(err, "while re-encrypting credentials in column %q of table %q", column.Column, column.Table)
+		}
+	}
+
+	log.C(ctx).Info("Finished re-encryption of credentials")
+	return nil
+}
+
+func (s *service) reEncryptColumn(ctx context.Context, column CredentialsColumn) error {
+	log.C(ctx).Infof("Re-encrypting credentials in column %q of table %q...", column.Column, column.Table)
+
+	afterID := minID
+	total := 0
+	for {
+		lastID, listed, reEncrypted, err := s.reEncryptPage(ctx, column, afterID)
+		if err != nil {
+			return err
+		}
+		total += reEncrypted
+
+		if listed < s.pageSize {
+			break
+		}
+		afterID = lastID
+	}
+
+	log.C(ctx).Infof("Re-encrypted %d credentials in column %q of table %q", total, column.Column, column.Table)
+	return nil
+}
+
+func (s *service) reEncryptPage(ctx context.Context, column CredentialsColumn, afterID string) (string, int, int, error) {
+	tx, err := s.transact.Begin()
+	if err != nil {
+		return "", 0, 0, errors.Wrap(err, "while opening database transaction")
+	}
+	defer s.transact.RollbackUnlessCommitted(ctx, tx)
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	credentials, err := s.repo.ListPage(ctx, column, afterID, s.pageSize)
+	if err != nil {
+		return "", 0, 0, err
+	}
+
+	lastID := afterID
+	reEncrypted := 0
+	for _, c := range credentials {
+		lastID = c.ID
+
+		value, changed, err := s.reEncrypt(c.Value)
+		if err != nil {
+			return "", 0, 0, errors.Wrapf(err, "while re-encrypting credentials with id %s", c.ID)
+		}
+
+		if !changed {
+			continue
+		}
+
+		if err := s.repo.Update(ctx, column, c.ID, value); err != nil {
+			return "", 0, 0, err
+		}
+		reEncrypted++
+	}
+
+	if err := tx.Commit(); err != nil {
+		return "", 0, 0, errors.Wrap(err, "while committing database transaction")
+	}
+
+	return lastID, len(credentials), reEncrypted, nil
+}
+
+func (s *service) reEncrypt(value string) (string, bool, error) {
+	var storedAuth model.Auth
+	if err := json.Unmarshal([]byte(value), &storedAuth); err != nil {
+		return "", false, errors.Wrap(err, "while unmarshalling credentials")
+	}
+
+	if !auth.CredentialsNeedReEncryption(s.encryptor, &storedAuth) {
+		return "", false, nil
+	}
+
+	decryptedAuth, err := auth.DecryptCredentials(s.encryptor, &storedAuth)
+	if err != nil {
+		return "", false, err
+	}
+
+	encryptedAuth, err := auth.EncryptCredentials(s.encryptor, decryptedAuth)
+	if err != nil {
+		return "", false, err
+	}
+
+	marshalled, err := json.Marshal(encryptedAuth)
+	if err != nil {
+		return "", false, errors.Wrap(err, "while marshalling credentials")
+	}
+
+	return string(marshalled), true, nil
+}
@@ -0,0 +1,165 @@
+package reencryption_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	reencryption "github.com/kyma-incubator/compass/components/director/internal/credentials_reencryption"
+	"github.com/kyma-incubator/compass/components/director/internal/credentials_reencryption/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	persistenceautomock "github.com/kyma-incubator/compass/components/director/pkg/persistence/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_ReEncryptCredentials(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	oldEncryptor := fixEncryptor(t, "1")
+	activeEncryptor := fixEncryptor(t, "2")
+
+	encryptedWithOldKey, err := oldEncryptor.Encrypt(password)
+	require.NoError(t, err)
+	encryptedWithActiveKey, err := activeEncryptor.Encrypt(password)
+	require.NoError(t, err)
+
+	webhooksColumn := reencryption.CredentialsColumns[0]
+	columnsCount := len(reencryption.CredentialsColumns)
+
+	reEncryptedValueMatcher := mock.MatchedBy(func(value string) bool {
+		var auth model.Auth
+		if err := json.Unmarshal([]byte(value), &auth); err != nil {
+			return false
+		}
+		decrypted, err := activeEncryptor.Decrypt(auth.Credential.Basic.Password)
+		return err == nil && decrypted == password && !activeEncryptor.NeedsReEncryption(auth.Credential.Basic.Password)
+	})
+
+	expectEmptyColumns := func(repo *automock.CredentialsRepository, columns []reencryption.CredentialsColumn) {
+		for _, column := range columns {
+			repo.On("ListPage", txtest.CtxWithDBMatcher(), column, minID, 2).Return([]reencryption.StoredCredentials{}, nil).Once()
+		}
+	}
+
+	testCases := []struct {
+		Name            string
+		TransactionerFn func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		RepositoryFn    func() *automock.CredentialsRepository
+		ExpectedErr     error
+	}{
+		{
+			Name: "Success re-encrypting plaintext credentials and credentials encrypted with old key in multiple pages",
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimes(columnsCount + 1)
+			},
+			RepositoryFn: func() *automock.CredentialsRepository {
+				repo := &automock.CredentialsRepository{}
+				repo.On("ListPage", txtest.CtxWithDBMatcher(), webhooksColumn, minID, 2).Return([]reencryption.StoredCredentials{
+					{ID: firstID, Value: fixBasicAuthValue(password)},
+					{ID: secondID, Value: fixBasicAuthValue(encryptedWithOldKey)},
+				}, nil).Once()
+				repo.On("ListPage", txtest.CtxWithDBMatcher(), webhooksColumn, secondID, 2).Return([]reencryption.StoredCredentials{}, nil).Once()
+				repo.On("Update", txtest.CtxWithDBMatcher(), webhooksColumn, firstID, reEncryptedValueMatcher).Return(nil).Once()
+				repo.On("Update", txtest.CtxWithDBMatcher(), webhooksColumn, secondID, reEncryptedValueMatcher).Return(nil).Once()
+				expectEmptyColumns(repo, reencryption.CredentialsColumns[1:])
+				return repo
+			},
+		},
+		{
+			Name: "Success skipping credentials encrypted with the active key",
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimes(columnsCount)
+			},
+			RepositoryFn: func() *automock.CredentialsRepository {
+				repo := &automock.CredentialsRepository{}
+				repo.On("ListPage", txtest.CtxWithDBMatcher(), webhooksColumn, minID, 2).Return([]reencryption.StoredCredentials{
+					{ID: firstID, Value: fixBasicAuthValue(encryptedWithActiveKey)},
+				}, nil).Once()
+				expectEmptyColumns(repo, reencryption.CredentialsColumns[1:])
+				return repo
+			},
+		},
+		{
+			Name:            "Error when beginning transaction fails",
+			TransactionerFn: txGen.ThatFailsOnBegin,
+			RepositoryFn: func() *automock.CredentialsRepository {
+				return &automock.CredentialsRepository{}
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name:            "Error when listing credentials fails",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			RepositoryFn: func() *automock.CredentialsRepository {
+				repo := &automock.CredentialsRepository{}
+				repo.On("ListPage", txtest.CtxWithDBMatcher(), webhooksColumn, minID, 2).Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name:            "Error when stored credentials are not a valid JSON",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			RepositoryFn: func() *automock.CredentialsRepository {
+				repo := &automock.CredentialsRepository{}
+				repo.On("ListPage", txtest.CtxWithDBMatcher(), webhooksColumn, minID, 2).Return([]reencryption.StoredCredentials{
+					{ID: firstID, Value: "invalid"},
+				}, nil).Once()
+				return repo
+			},
+			ExpectedErr: errors.New("while unmarshalling credentials"),
+		},
+		{
+			Name:            "Error when updating credentials fails",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			RepositoryFn: func() *automock.CredentialsRepository {
+				repo := &automock.CredentialsRepository{}
+				repo.On("ListPage", txtest.CtxWithDBMatcher(), webhooksColumn, minID, 2).Return([]reencryption.StoredCredentials{
+					{ID: firstID, Value: fixBasicAuthValue(password)},
+				}, nil).Once()
+				repo.On("Update", txtest.CtxWithDBMatcher(), webhooksColumn, firstID, reEncryptedValueMatcher).Return(testErr).Once()
+				return repo
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name:            "Error when committing transaction fails",
+			TransactionerFn: txGen.ThatFailsOnCommit,
+			RepositoryFn: func() *automock.CredentialsRepository {
+				repo := &automock.CredentialsRepository{}
+				repo.On("ListPage", txtest.CtxWithDBMatcher(), webhooksColumn, minID, 2).Return([]reencryption.StoredCredentials{}, nil).Once()
+				return repo
+			},
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			persist, transact := testCase.TransactionerFn()
+			repo := testCase.RepositoryFn()
+
+			svc := reencryption.NewService(transact, repo, activeEncryptor, 2)
+
+			// WHEN
+			err := svc.ReEncryptCredentials(context.TODO())
+
+			// THEN
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+
+			mock.AssertExpectationsForObjects(t, persist, transact, repo)
+		})
+	}
+}
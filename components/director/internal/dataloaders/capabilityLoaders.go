@@ -0,0 +1,52 @@
+//go:generate go run github.com/vektah/dataloaden CapabilityLoader ParamCapability []*github.com/kyma-incubator/compass/components/director/pkg/graphql.Capability
+
+package dataloader
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+)
+
+const loadersKeyCapability contextKey = "dataloadersCapability"
+
+// CapabilityLoaders contains the dataloaders for the Capabilities of Applications and of Packages
+type CapabilityLoaders struct {
+	CapabilityByApplicationID CapabilityLoader
+	CapabilityByPackageID     CapabilityLoader
+}
+
+// ParamCapability is the key of the Capabilities dataloaders
+type ParamCapability struct {
+	ID  string
+	Ctx context.Context
+}
+
+// HandlerCapability prepares the Capabilities dataloaders for each request
+func HandlerCapability(fetchByApplicationIDsFunc, fetchByPackageIDsFunc func(keys []ParamCapability) ([][]*graphql.Capability, []error), maxBatch int, wait time.Duration) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := context.WithValue(r.Context(), loadersKeyCapability, &CapabilityLoaders{
+				CapabilityByApplicationID: CapabilityLoader{
+					maxBatch: maxBatch,
+					wait:     wait,
+					fetch:    fetchByApplicationIDsFunc,
+				},
+				CapabilityByPackageID: CapabilityLoader{
+					maxBatch: maxBatch,
+					wait:     wait,
+					fetch:    fetchByPackageIDsFunc,
+				},
+			})
+			r = r.WithContext(ctx)
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// CapabilitiesFor returns the Capabilities dataloaders of the request
+func CapabilitiesFor(ctx context.Context) *CapabilityLoaders {
+	return ctx.Value(loadersKeyCapability).(*CapabilityLoaders)
+}
@@ -0,0 +1,52 @@
+//go:generate go run github.com/vektah/dataloaden EntityTypeLoader ParamEntityType []*github.com/kyma-incubator/compass/components/director/pkg/graphql.EntityType
+
+package dataloader
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+)
+
+const loadersKeyEntityType contextKey = "dataloadersEntityType"
+
+// EntityTypeLoaders contains the dataloaders for the Entity Types of Applications and of Packages
+type EntityTypeLoaders struct {
+	EntityTypeByApplicationID EntityTypeLoader
+	EntityTypeByPackageID     EntityTypeLoader
+}
+
+// ParamEntityType is the key of the Entity Types dataloaders
+type ParamEntityType struct {
+	ID  string
+	Ctx context.Context
+}
+
+// HandlerEntityType prepares the Entity Types dataloaders for each request
+func HandlerEntityType(fetchByApplicationIDsFunc, fetchByPackageIDsFunc func(keys []ParamEntityType) ([][]*graphql.EntityType, []error), maxBatch int, wait time.Duration) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := context.WithValue(r.Context(), loadersKeyEntityType, &EntityTypeLoaders{
+				EntityTypeByApplicationID: EntityTypeLoader{
+					maxBatch: maxBatch,
+					wait:     wait,
+					fetch:    fetchByApplicationIDsFunc,
+				},
+				EntityTypeByPackageID: EntityTypeLoader{
+					maxBatch: maxBatch,
+					wait:     wait,
+					fetch:    fetchByPackageIDsFunc,
+				},
+			})
+			r = r.WithContext(ctx)
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// EntityTypesFor returns the Entity Types dataloaders of the request
+func EntityTypesFor(ctx context.Context) *EntityTypeLoaders {
+	return ctx.Value(loadersKeyEntityType).(*EntityTypeLoaders)
+}
@@ -19,6 +19,7 @@ var (
 	bundleColumn  = "bundle_id"
 	idColumn      = "id"
 	appIDColumn   = "app_id"
+	ordIDColumn   = "ord_id"
 	apiDefColumns = []string{"id", "app_id", "app_template_version_id", "package_id", "name", "description", "group_name", "ord_id", "local_tenant_id",
 		"short_description", "system_instance_aware", "policy_level", "custom_policy_level", "api_protocol", "tags", "countries", "links", "api_resource_links", "release_status",
 		"sunset_date", "changelog_entries", "labels", "visibility", "disabled", "part_of_products", "line_of_business",
@@ -160,6 +161,26 @@ func (r *pgRepository) ListByResourceID(ctx context.Context, tenantID string, re
 	return apis, nil
 }
 
+// ListByOrdIDs lists all APIDefinitions visible to the given tenant which have one of the provided ORD IDs.
+func (r *pgRepository) ListByOrdIDs(ctx context.Context, tenantID string, ordIDs []string) ([]*model.APIDefinition, error) {
+	apiCollection := APIDefCollection{}
+	if len(ordIDs) == 0 {
+		return []*model.APIDefinition{}, nil
+	}
+
+	if err := r.lister.List(ctx, resource.API, tenantID, &apiCollection, repo.NewInConditionForStringValues(ordIDColumn, ordIDs)); err != nil {
+		return nil, err
+	}
+
+	apis := make([]*model.APIDefinition, 0, apiCollection.Len())
+	for _, api := range apiCollection {
+		apiModel := r.conv.FromEntity(&api)
+		apis = append(apis, apiModel)
+	}
+
+	return apis, nil
+}
+
 // ListByApplicationIDPage lists all APIDefinitions for a given application ID with paging.
 func (r *pgRepository) ListByApplicationIDPage(ctx context.Context, tenantID string, appID string, pageSize int, cursor string) (*model.APIDefinitionPage, error) {
 	var apiDefCollection APIDefCollection
@@ -186,6 +186,49 @@ func TestPgRepository_ListByResourceID(t *testing.T) {
 	suiteForApplicationTemplateVersion.Run(t)
 }
 
+func TestPgRepository_ListByOrdIDs(t *testing.T) {
+	ordID := "ordID"
+	entity1App := fixFullEntityAPIDefinitionWithAppID(apiDefID, "placeholder")
+	apiDefModel1App, _, _ := fixFullAPIDefinitionModelWithAppID("placeholder")
+
+	suite := testdb.RepoListTestSuite{
+		Name: "List APIs by ORD IDs",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:    regexp.QuoteMeta(`SELECT id, app_id, app_template_version_id, package_id, name, description, group_name, ord_id, local_tenant_id, short_description, system_instance_aware, policy_level, custom_policy_level, api_protocol, tags, countries, links, api_resource_links, release_status, sunset_date, changelog_entries, labels, visibility, disabled, part_of_products, line_of_business, industry, version_value, version_deprecated, version_deprecated_since, version_for_removal, ready, created_at, updated_at, deleted_at, error, implementation_standard, custom_implementation_standard, custom_implementation_standard_description, target_urls, extensible, successors, resource_hash, hierarchy, supported_use_cases, documentation_labels FROM "public"."api_definitions" WHERE ord_id IN ($1) AND (id IN (SELECT id FROM api_definitions_tenants WHERE tenant_id = $2))`),
+				Args:     []driver.Value{ordID, tenantID},
+				IsSelect: true,
+				ValidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixAPIDefinitionColumns()).AddRow(fixAPIDefinitionRow(apiDefID, "placeholder")...)}
+				},
+				InvalidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixAPIDefinitionColumns())}
+				},
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.APIDefinitionConverter{}
+		},
+		RepoConstructorFunc:       api.NewRepository,
+		ExpectedModelEntities:     []interface{}{&apiDefModel1App},
+		ExpectedDBEntities:        []interface{}{&entity1App},
+		MethodArgs:                []interface{}{tenantID, []string{ordID}},
+		MethodName:                "ListByOrdIDs",
+		DisableConverterErrorTest: true,
+	}
+
+	suite.Run(t)
+
+	t.Run("Returns empty slice when no ORD IDs are provided", func(t *testing.T) {
+		pgRepository := api.NewRepository(&automock.APIDefinitionConverter{})
+
+		apis, err := pgRepository.ListByOrdIDs(context.TODO(), tenantID, nil)
+
+		require.NoError(t, err)
+		require.Empty(t, apis)
+	})
+}
+
 func TestPgRepository_ListByApplicationIDPage(t *testing.T) {
 	pageSize := 1
 	cursor := ""
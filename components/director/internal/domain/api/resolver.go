@@ -475,7 +475,7 @@ func (r *Resolver) FetchRequestAPIDefDataLoader(keys []dataloader.ParamFetchRequ
 		specIDs = append(specIDs, key.ID)
 	}
 
-	tx, err := r.transact.Begin()
+	tx, err := persistence.BeginForCtx(ctx, r.transact)
 	if err != nil {
 		return nil, []error{err}
 	}
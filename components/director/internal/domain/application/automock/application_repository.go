@@ -5,6 +5,8 @@ package automock
 import (
 	context "context"
 
+	json "encoding/json"
+
 	labelfilter "github.com/kyma-incubator/compass/components/director/internal/labelfilter"
 	mock "github.com/stretchr/testify/mock"
 
@@ -500,6 +502,32 @@ func (_m *ApplicationRepository) ListListeningApplications(ctx context.Context,
 	return r0, r1
 }
 
+// ListTemplateValuesByApplicationTemplateID provides a mock function with given fields: ctx, applicationTemplateID
+func (_m *ApplicationRepository) ListTemplateValuesByApplicationTemplateID(ctx context.Context, applicationTemplateID string) (map[string]json.RawMessage, error) {
+	ret := _m.Called(ctx, applicationTemplateID)
+
+	var r0 map[string]json.RawMessage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (map[string]json.RawMessage, error)); ok {
+		return rf(ctx, applicationTemplateID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) map[string]json.RawMessage); ok {
+		r0 = rf(ctx, applicationTemplateID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]json.RawMessage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, applicationTemplateID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // OwnerExists provides a mock function with given fields: ctx, tenant, id
 func (_m *ApplicationRepository) OwnerExists(ctx context.Context, tenant string, id string) (bool, error) {
 	ret := _m.Called(ctx, tenant, id)
@@ -576,6 +604,20 @@ func (_m *ApplicationRepository) Update(ctx context.Context, tenant string, item
 	return r0
 }
 
+// UpdateTemplateValuesGlobal provides a mock function with given fields: ctx, id, values
+func (_m *ApplicationRepository) UpdateTemplateValuesGlobal(ctx context.Context, id string, values json.RawMessage) error {
+	ret := _m.Called(ctx, id, values)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, json.RawMessage) error); ok {
+		r0 = rf(ctx, id, values)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Upsert provides a mock function with given fields: ctx, tenant, _a2
 func (_m *ApplicationRepository) Upsert(ctx context.Context, tenant string, _a2 *model.Application) (string, error) {
 	ret := _m.Called(ctx, tenant, _a2)
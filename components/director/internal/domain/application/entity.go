@@ -49,3 +49,17 @@ func (e *Entity) DecorateWithTenantID(tenant string) interface{} {
 		TenantID: tenant,
 	}
 }
+
+// TemplateValuesEntity represents the placeholder values an application was created with from its application template.
+type TemplateValuesEntity struct {
+	ID             string         `db:"id"`
+	TemplateValues sql.NullString `db:"template_values"`
+}
+
+// TemplateValuesCollection is a collection of TemplateValuesEntity.
+type TemplateValuesCollection []TemplateValuesEntity
+
+// Len returns the length of the collection.
+func (t TemplateValuesCollection) Len() int {
+	return len(t)
+}
@@ -2,6 +2,7 @@ package application
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"time"
 
@@ -31,6 +32,7 @@ var (
 	updatableColumns      = []string{"name", "description", "status_condition", "status_timestamp", "system_status", "healthcheck_url", "integration_system_id", "provider_name", "base_url", "application_namespace", "labels", "ready", "created_at", "updated_at", "deleted_at", "error", "correlation_ids", "tags", "documentation_labels", "system_number", "local_tenant_id"}
 	upsertableColumns     = []string{"name", "description", "status_condition", "system_status", "provider_name", "base_url", "local_tenant_id", "application_namespace", "labels", "tenant_business_type_id"}
 	matchingSystemColumns = []string{"system_number"}
+	templateValuesColumns = []string{"id", "template_values"}
 )
 
 // EntityConverter missing godoc
@@ -58,6 +60,8 @@ type pgRepository struct {
 	globalUpdater         repo.UpdaterGlobal
 	upserter              repo.Upserter
 	trustedUpserter       repo.Upserter
+	templateValuesLister  repo.ListerGlobal
+	templateValuesUpdater repo.UpdaterGlobal
 	conv                  EntityConverter
 }
 
@@ -80,6 +84,8 @@ func NewRepository(conv EntityConverter) *pgRepository {
 		globalUpdater:         repo.NewUpdaterGlobal(resource.Application, applicationTable, updatableColumns, []string{"id"}),
 		upserter:              repo.NewUpserter(applicationTable, applicationColumns, matchingSystemColumns, upsertableColumns),
 		trustedUpserter:       repo.NewTrustedUpserter(applicationTable, applicationColumns, matchingSystemColumns, upsertableColumns),
+		templateValuesLister:  repo.NewListerGlobal(resource.Application, applicationTable, templateValuesColumns),
+		templateValuesUpdater: repo.NewUpdaterGlobal(resource.Application, applicationTable, []string{"template_values"}, []string{"id"}),
 		conv:                  conv,
 	}
 }
@@ -200,6 +206,16 @@ func (r *pgRepository) GetGlobalByID(ctx context.Context, id string) (*model.App
 	return appModel, nil
 }
 
+// GetGlobalByIDForUpdate returns the application with matching ID regardless of its tenant and locks it exclusively until the transaction is finished.
+func (r *pgRepository) GetGlobalByIDForUpdate(ctx context.Context, id string) (*model.Application, error) {
+	var appEnt Entity
+	if err := r.globalGetter.GetGlobalForUpdate(ctx, repo.Conditions{repo.NewEqualCondition("id", id)}, repo.NoOrderBy, &appEnt); err != nil {
+		return nil, err
+	}
+
+	return r.conv.FromEntity(&appEnt), nil
+}
+
 // GetByFilter retrieves Application matching on the given label filters
 func (r *pgRepository) GetByFilter(ctx context.Context, tenant string, filter []*labelfilter.LabelFilter) (*model.Application, error) {
 	var appEnt Entity
@@ -288,6 +304,58 @@ func (r *pgRepository) ListAllByApplicationTemplateID(ctx context.Context, appli
 	return items, nil
 }
 
+// ListTemplateValuesByApplicationTemplateID retrieves the stored placeholder values of the applications created from the given app template, mapped by application ID.
+// Applications without stored placeholder values are omitted.
+func (r *pgRepository) ListTemplateValuesByApplicationTemplateID(ctx context.Context, applicationTemplateID string) (map[string]json.RawMessage, error) {
+	var entities TemplateValuesCollection
+
+	conditions := repo.Conditions{
+		repo.NewEqualCondition("app_template_id", applicationTemplateID),
+		repo.NewNotNullCondition("template_values"),
+	}
+	if err := r.templateValuesLister.ListGlobal(ctx, &entities, conditions...); err != nil {
+		return nil, err
+	}
+
+	values := make(map[string]json.RawMessage, len(entities))
+	for _, entity := range entities {
+		values[entity.ID] = repo.JSONRawMessageFromNullableString(entity.TemplateValues)
+	}
+
+	return values, nil
+}
+
+// UpdateTemplateValuesGlobal stores the placeholder values the application with the given ID was created with
+func (r *pgRepository) UpdateTemplateValuesGlobal(ctx context.Context, id string, values json.RawMessage) error {
+	entity := &TemplateValuesEntity{
+		ID:             id,
+		TemplateValues: repo.NewNullableStringFromJSONRawMessage(values),
+	}
+
+	return r.templateValuesUpdater.UpdateSingleGlobal(ctx, entity)
+}
+
+// ListAllWithHealthCheckURLGlobal retrieves all applications which have a health check URL configured regardless of their tenant
+func (r *pgRepository) ListAllWithHealthCheckURLGlobal(ctx context.Context) ([]*model.Application, error) {
+	var appsCollection EntityCollection
+
+	conditions := repo.Conditions{
+		repo.NewNotNullCondition("healthcheck_url"),
+	}
+	if err := r.listerGlobal.ListGlobal(ctx, &appsCollection, conditions...); err != nil {
+		return nil, err
+	}
+
+	items := make([]*model.Application, 0, len(appsCollection))
+
+	for _, appEnt := range appsCollection {
+		m := r.conv.FromEntity(&appEnt)
+		items = append(items, m)
+	}
+
+	return items, nil
+}
+
 // List missing godoc
 func (r *pgRepository) List(ctx context.Context, tenant string, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (*model.ApplicationPage, error) {
 	var appsCollection EntityCollection
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"database/sql/driver"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"regexp"
@@ -567,6 +568,44 @@ func TestRepository_GetGlobalByIDForUpdate(t *testing.T) {
 	suite.Run(t)
 }
 
+func TestPgRepository_GetGlobalByIDForUpdate(t *testing.T) {
+	entity := fixDetailedEntityApplication(t, givenID(), givenTenant(), "Test app", "Test app description")
+	suite := testdb.RepoGetTestSuite{
+		Name: "Get Application globally for update",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:    regexp.QuoteMeta(`SELECT id, app_template_id, system_number, local_tenant_id, name, description, status_condition, status_timestamp, system_status, healthcheck_url, integration_system_id, provider_name, base_url, application_namespace, labels, ready, created_at, updated_at, deleted_at, error, correlation_ids, tags, documentation_labels, tenant_business_type_id FROM public.applications WHERE id = $1 FOR UPDATE`),
+				Args:     []driver.Value{givenID()},
+				IsSelect: true,
+				ValidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{
+						sqlmock.NewRows(fixAppColumns()).
+							AddRow(entity.ID, entity.ApplicationTemplateID, entity.SystemNumber, entity.LocalTenantID, entity.Name, entity.Description, entity.StatusCondition,
+								entity.StatusTimestamp, entity.SystemStatus, entity.HealthCheckURL, entity.IntegrationSystemID, entity.ProviderName, entity.BaseURL, entity.ApplicationNamespace,
+								entity.OrdLabels, entity.Ready, entity.CreatedAt, entity.UpdatedAt, entity.DeletedAt, entity.Error, entity.CorrelationIDs, entity.Tags, entity.DocumentationLabels, entity.TenantBusinessTypeID),
+					}
+				},
+				InvalidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{
+						sqlmock.NewRows(fixAppColumns()),
+					}
+				},
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		RepoConstructorFunc:       application.NewRepository,
+		ExpectedModelEntity:       fixDetailedModelApplication(t, givenID(), givenTenant(), "Test app", "Test app description"),
+		ExpectedDBEntity:          entity,
+		MethodArgs:                []interface{}{givenID()},
+		DisableConverterErrorTest: true,
+		MethodName:                "GetGlobalByIDForUpdate",
+	}
+
+	suite.Run(t)
+}
+
 func TestPgRepository_ListAllByApplicationTemplateID(t *testing.T) {
 	appID := givenID()
 	appTemplateID := "ccdbef8f-b97a-490c-86e2-2bab2862a6e4"
@@ -609,6 +648,123 @@ func TestPgRepository_ListAllByApplicationTemplateID(t *testing.T) {
 	suite.Run(t)
 }
 
+func TestPgRepository_ListTemplateValuesByApplicationTemplateID(t *testing.T) {
+	appTemplateID := "ccdbef8f-b97a-490c-86e2-2bab2862a6e4"
+	values := `[{"Placeholder":"name","Value":"app"}]`
+	selectStmt := regexp.QuoteMeta(`SELECT id, template_values FROM public.applications WHERE app_template_id = $1 AND template_values IS NOT NULL`)
+
+	t.Run("Success", func(t *testing.T) {
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		rows := sqlmock.NewRows([]string{"id", "template_values"}).AddRow(givenID(), values)
+		dbMock.ExpectQuery(selectStmt).WithArgs(appTemplateID).WillReturnRows(rows)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		appRepo := application.NewRepository(nil)
+
+		// WHEN
+		result, err := appRepo.ListTemplateValuesByApplicationTemplateID(ctx, appTemplateID)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, map[string]json.RawMessage{givenID(): json.RawMessage(values)}, result)
+	})
+
+	t.Run("Error when listing fails", func(t *testing.T) {
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectQuery(selectStmt).WithArgs(appTemplateID).WillReturnError(givenError())
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		appRepo := application.NewRepository(nil)
+
+		// WHEN
+		result, err := appRepo.ListTemplateValuesByApplicationTemplateID(ctx, appTemplateID)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Unexpected error while executing SQL query")
+		assert.Nil(t, result)
+	})
+}
+
+func TestPgRepository_UpdateTemplateValuesGlobal(t *testing.T) {
+	values := json.RawMessage(`[{"Placeholder":"name","Value":"app"}]`)
+	updateStmt := regexp.QuoteMeta(`UPDATE public.applications SET template_values = ? WHERE id = ?`)
+
+	t.Run("Success", func(t *testing.T) {
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectExec(updateStmt).WithArgs(repo.NewNullableStringFromJSONRawMessage(values), givenID()).WillReturnResult(sqlmock.NewResult(-1, 1))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		appRepo := application.NewRepository(nil)
+
+		// WHEN
+		err := appRepo.UpdateTemplateValuesGlobal(ctx, givenID(), values)
+
+		// THEN
+		require.NoError(t, err)
+	})
+
+	t.Run("Error when application does not exist", func(t *testing.T) {
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectExec(updateStmt).WithArgs(repo.NewNullableStringFromJSONRawMessage(values), givenID()).WillReturnResult(sqlmock.NewResult(-1, 0))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		appRepo := application.NewRepository(nil)
+
+		// WHEN
+		err := appRepo.UpdateTemplateValuesGlobal(ctx, givenID(), values)
+
+		// THEN
+		require.Error(t, err)
+	})
+}
+
+func TestPgRepository_ListAllWithHealthCheckURLGlobal(t *testing.T) {
+	appID := givenID()
+	entity := fixDetailedEntityApplication(t, appID, givenTenant(), "App", "App desc")
+	appModel := fixDetailedModelApplication(t, appID, givenTenant(), "App", "App desc")
+
+	suite := testdb.RepoListTestSuite{
+		Name: "List applications with health check URL globally",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:    regexp.QuoteMeta(`SELECT id, app_template_id, system_number, local_tenant_id, name, description, status_condition, status_timestamp, system_status, healthcheck_url, integration_system_id, provider_name, base_url, application_namespace, labels, ready, created_at, updated_at, deleted_at, error, correlation_ids, tags, documentation_labels, tenant_business_type_id FROM public.applications WHERE healthcheck_url IS NOT NULL`),
+				Args:     []driver.Value{},
+				IsSelect: true,
+				ValidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{
+						sqlmock.NewRows(fixAppColumns()).
+							AddRow(entity.ID, entity.ApplicationTemplateID, entity.SystemNumber, entity.LocalTenantID, entity.Name, entity.Description, entity.StatusCondition, entity.StatusTimestamp, entity.SystemStatus, entity.HealthCheckURL, entity.IntegrationSystemID, entity.ProviderName, entity.BaseURL, entity.ApplicationNamespace, entity.OrdLabels, entity.Ready, entity.CreatedAt, entity.UpdatedAt, entity.DeletedAt, entity.Error, entity.CorrelationIDs, entity.Tags, entity.DocumentationLabels, entity.TenantBusinessTypeID),
+					}
+				},
+				InvalidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{
+						sqlmock.NewRows(fixAppColumns()),
+					}
+				},
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		RepoConstructorFunc:       application.NewRepository,
+		ExpectedModelEntities:     []interface{}{appModel},
+		ExpectedDBEntities:        []interface{}{entity},
+		MethodName:                "ListAllWithHealthCheckURLGlobal",
+		DisableConverterErrorTest: true,
+	}
+
+	suite.Run(t)
+}
+
 func TestPgRepository_List(t *testing.T) {
 	app1ID := "aec0e9c5-06da-4625-9f8a-bda17ab8c3b9"
 	app2ID := "ccdbef8f-b97a-490c-86e2-2bab2862a6e4"
@@ -211,6 +211,48 @@ type TenantBusinessTypeConverter interface {
 	ToGraphQL(in *model.TenantBusinessType) *graphql.TenantBusinessType
 }
 
+// PackageService is responsible for the service-layer Package operations.
+//
+//go:generate mockery --name=PackageService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type PackageService interface {
+	ListByApplicationIDs(ctx context.Context, appIDs []string) ([][]*model.Package, error)
+}
+
+// PackageConverter converts Packages between the model.Package service-layer representation and the graphql-layer representation graphql.Package.
+//
+//go:generate mockery --name=PackageConverter --output=automock --outpkg=automock --case=underscore --disable-version-string
+type PackageConverter interface {
+	MultipleToGraphQL(in []*model.Package) []*graphql.Package
+}
+
+// EntityTypeService is responsible for the service-layer Entity Type operations.
+//
+//go:generate mockery --name=EntityTypeService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type EntityTypeService interface {
+	ListByApplicationIDs(ctx context.Context, appIDs []string) ([][]*model.EntityType, error)
+}
+
+// EntityTypeConverter converts Entity Types between the model.EntityType service-layer representation and the graphql-layer representation graphql.EntityType.
+//
+//go:generate mockery --name=EntityTypeConverter --output=automock --outpkg=automock --case=underscore --disable-version-string
+type EntityTypeConverter interface {
+	MultipleToGraphQL(in []*model.EntityType) []*graphql.EntityType
+}
+
+// CapabilityService is responsible for the service-layer Capability operations.
+//
+//go:generate mockery --name=CapabilityService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type CapabilityService interface {
+	ListByApplicationIDs(ctx context.Context, appIDs []string) ([][]*model.Capability, error)
+}
+
+// CapabilityConverter converts Capabilities between the model.Capability service-layer representation and the graphql-layer representation graphql.Capability.
+//
+//go:generate mockery --name=CapabilityConverter --output=automock --outpkg=automock --case=underscore --disable-version-string
+type CapabilityConverter interface {
+	MultipleToGraphQL(in []*model.Capability) []*graphql.Capability
+}
+
 // Resolver missing godoc
 type Resolver struct {
 	transact persistence.Transactioner
@@ -224,6 +266,13 @@ type Resolver struct {
 	tenantBusinessTypeSvc       TenantBusinessTypeService
 	tenantBusinessTypeConverter TenantBusinessTypeConverter
 
+	packageSvc     PackageService
+	packageConv    PackageConverter
+	entityTypeSvc  EntityTypeService
+	entityTypeConv EntityTypeConverter
+	capabilitySvc  CapabilityService
+	capabilityConv CapabilityConverter
+
 	webhookSvc WebhookService
 	oAuth20Svc OAuth20Service
 	sysAuthSvc SystemAuthService
@@ -266,6 +315,12 @@ func NewResolver(transact persistence.Transactioner,
 	appTemplateConverter ApplicationTemplateConverter,
 	tenantBusinessTypeSvc TenantBusinessTypeService,
 	tenantBusinessTypeConverter TenantBusinessTypeConverter,
+	packageSvc PackageService,
+	packageConverter PackageConverter,
+	entityTypeSvc EntityTypeService,
+	entityTypeConverter EntityTypeConverter,
+	capabilitySvc CapabilityService,
+	capabilityConverter CapabilityConverter,
 	selfRegisterDistinguishLabelKey, tokenPrefix string) *Resolver {
 	return &Resolver{
 		transact:                        transact,
@@ -288,6 +343,12 @@ func NewResolver(transact persistence.Transactioner,
 		appTemplateConverter:            appTemplateConverter,
 		tenantBusinessTypeSvc:           tenantBusinessTypeSvc,
 		tenantBusinessTypeConverter:     tenantBusinessTypeConverter,
+		packageSvc:                      packageSvc,
+		packageConv:                     packageConverter,
+		entityTypeSvc:                   entityTypeSvc,
+		entityTypeConv:                  entityTypeConverter,
+		capabilitySvc:                   capabilitySvc,
+		capabilityConv:                  capabilityConverter,
 		selfRegisterDistinguishLabelKey: selfRegisterDistinguishLabelKey,
 		tokenPrefix:                     tokenPrefix,
 	}
@@ -303,7 +364,7 @@ func (r *Resolver) Applications(ctx context.Context, filter []*graphql.LabelFilt
 		return nil, err
 	}
 
-	tx, err := r.transact.Begin()
+	tx, err := r.transact.BeginReadOnly()
 	if err != nil {
 		return nil, err
 	}
@@ -395,7 +456,7 @@ func (r *Resolver) ApplicationsForRuntime(ctx context.Context, runtimeID string,
 		cursor = string(*after)
 	}
 
-	tx, err := r.transact.Begin()
+	tx, err := r.transact.BeginReadOnly()
 	if err != nil {
 		return nil, err
 	}
@@ -821,7 +882,7 @@ func (r *Resolver) BundlesDataLoader(keys []dataloader.ParamBundle) ([]*graphql.
 		return nil, []error{apperrors.NewInvalidDataError("missing required parameter 'first'")}
 	}
 
-	tx, err := r.transact.Begin()
+	tx, err := persistence.BeginForCtx(ctx, r.transact)
 	if err != nil {
 		return nil, []error{err}
 	}
@@ -1076,7 +1137,7 @@ func (r *Resolver) getApplicationProviderTenant(ctx context.Context, consumerInf
 }
 
 func (r *Resolver) getApplication(ctx context.Context, get func(context.Context) (*model.Application, error)) (*graphql.Application, error) {
-	tx, err := r.transact.Begin()
+	tx, err := r.transact.BeginReadOnly()
 	if err != nil {
 		return nil, err
 	}
@@ -1099,3 +1160,144 @@ func (r *Resolver) getApplication(ctx context.Context, get func(context.Context)
 
 	return r.appConverter.ToGraphQL(app), nil
 }
+
+// Packages retrieves all ORD Packages for the given Application
+func (r *Resolver) Packages(ctx context.Context, obj *graphql.Application) ([]*graphql.Package, error) {
+	if obj == nil {
+		return nil, apperrors.NewInternalError("Application cannot be empty")
+	}
+
+	param := dataloader.ParamPackage{ID: obj.ID, Ctx: ctx}
+	return dataloader.PackagesFor(ctx).PackageByApplicationID.Load(param)
+}
+
+// PackagesDataLoader lists the ORD Packages of all Applications requested in a batch
+func (r *Resolver) PackagesDataLoader(keys []dataloader.ParamPackage) ([][]*graphql.Package, []error) {
+	if len(keys) == 0 {
+		return nil, []error{apperrors.NewInternalError("No Applications found")}
+	}
+
+	ctx := keys[0].Ctx
+	applicationIDs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		applicationIDs = append(applicationIDs, key.ID)
+	}
+
+	tx, err := persistence.BeginForCtx(ctx, r.transact)
+	if err != nil {
+		return nil, []error{err}
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	packagesPerApplication, err := r.packageSvc.ListByApplicationIDs(ctx, applicationIDs)
+	if err != nil {
+		return nil, []error{errors.Wrapf(err, "while listing packages for applications with ids %v", applicationIDs)}
+	}
+
+	gqlPackages := make([][]*graphql.Package, 0, len(packagesPerApplication))
+	for _, packages := range packagesPerApplication {
+		gqlPackages = append(gqlPackages, r.packageConv.MultipleToGraphQL(packages))
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, []error{err}
+	}
+
+	return gqlPackages, nil
+}
+
+// EntityTypes retrieves all ORD Entity Types for the given Application
+func (r *Resolver) EntityTypes(ctx context.Context, obj *graphql.Application) ([]*graphql.EntityType, error) {
+	if obj == nil {
+		return nil, apperrors.NewInternalError("Application cannot be empty")
+	}
+
+	param := dataloader.ParamEntityType{ID: obj.ID, Ctx: ctx}
+	return dataloader.EntityTypesFor(ctx).EntityTypeByApplicationID.Load(param)
+}
+
+// EntityTypesDataLoader lists the ORD Entity Types of all Applications requested in a batch
+func (r *Resolver) EntityTypesDataLoader(keys []dataloader.ParamEntityType) ([][]*graphql.EntityType, []error) {
+	if len(keys) == 0 {
+		return nil, []error{apperrors.NewInternalError("No Applications found")}
+	}
+
+	ctx := keys[0].Ctx
+	applicationIDs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		applicationIDs = append(applicationIDs, key.ID)
+	}
+
+	tx, err := persistence.BeginForCtx(ctx, r.transact)
+	if err != nil {
+		return nil, []error{err}
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	entityTypesPerApplication, err := r.entityTypeSvc.ListByApplicationIDs(ctx, applicationIDs)
+	if err != nil {
+		return nil, []error{errors.Wrapf(err, "while listing entity types for applications with ids %v", applicationIDs)}
+	}
+
+	gqlEntityTypes := make([][]*graphql.EntityType, 0, len(entityTypesPerApplication))
+	for _, entityTypes := range entityTypesPerApplication {
+		gqlEntityTypes = append(gqlEntityTypes, r.entityTypeConv.MultipleToGraphQL(entityTypes))
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, []error{err}
+	}
+
+	return gqlEntityTypes, nil
+}
+
+// Capabilities retrieves all ORD Capabilities for the given Application
+func (r *Resolver) Capabilities(ctx context.Context, obj *graphql.Application) ([]*graphql.Capability, error) {
+	if obj == nil {
+		return nil, apperrors.NewInternalError("Application cannot be empty")
+	}
+
+	param := dataloader.ParamCapability{ID: obj.ID, Ctx: ctx}
+	return dataloader.CapabilitiesFor(ctx).CapabilityByApplicationID.Load(param)
+}
+
+// CapabilitiesDataLoader lists the ORD Capabilities of all Applications requested in a batch
+func (r *Resolver) CapabilitiesDataLoader(keys []dataloader.ParamCapability) ([][]*graphql.Capability, []error) {
+	if len(keys) == 0 {
+		return nil, []error{apperrors.NewInternalError("No Applications found")}
+	}
+
+	ctx := keys[0].Ctx
+	applicationIDs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		applicationIDs = append(applicationIDs, key.ID)
+	}
+
+	tx, err := persistence.BeginForCtx(ctx, r.transact)
+	if err != nil {
+		return nil, []error{err}
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	capabilitiesPerApplication, err := r.capabilitySvc.ListByApplicationIDs(ctx, applicationIDs)
+	if err != nil {
+		return nil, []error{errors.Wrapf(err, "while listing capabilities for applications with ids %v", applicationIDs)}
+	}
+
+	gqlCapabilities := make([][]*graphql.Capability, 0, len(capabilitiesPerApplication))
+	for _, capabilities := range capabilitiesPerApplication {
+		gqlCapabilities = append(gqlCapabilities, r.capabilityConv.MultipleToGraphQL(capabilities))
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, []error{err}
+	}
+
+	return gqlCapabilities, nil
+}
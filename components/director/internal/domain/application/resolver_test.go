@@ -132,7 +132,7 @@ func TestResolver_RegisterApplication(t *testing.T) {
 			persistTx, transact := testCase.TransactionerFn()
 			svc := testCase.ServiceFn()
 			converter := testCase.ConverterFn()
-			resolver := application.NewResolver(transact, svc, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", "")
+			resolver := application.NewResolver(transact, svc, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", "")
 			resolver.SetConverter(converter)
 
 			// WHEN
@@ -242,7 +242,7 @@ func TestResolver_UpdateApplication(t *testing.T) {
 			svc := testCase.ServiceFn()
 			converter := testCase.ConverterFn()
 
-			resolver := application.NewResolver(transact, svc, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", "")
+			resolver := application.NewResolver(transact, svc, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", "")
 			resolver.SetConverter(converter)
 
 			// WHEN
@@ -474,7 +474,7 @@ func TestResolver_UnregisterApplication(t *testing.T) {
 			persistTx, transact := testCase.TransactionerFn()
 			sysAuthSvc := testCase.SysAuthServiceFn()
 			oAuth20Svc := testCase.OAuth20ServiceFn()
-			resolver := application.NewResolver(transact, svc, nil, oAuth20Svc, sysAuthSvc, nil, nil, nil, eventingSvc, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", "")
+			resolver := application.NewResolver(transact, svc, nil, oAuth20Svc, sysAuthSvc, nil, nil, nil, eventingSvc, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", "")
 			resolver.SetConverter(converter)
 
 			// WHEN
@@ -698,7 +698,7 @@ func TestResolver_UnpairApplication(t *testing.T) {
 			persistTx, transact := testCase.TransactionerFn()
 			sysAuthSvc := testCase.SysAuthServiceFn()
 			oAuth20Svc := testCase.OAuth20ServiceFn()
-			resolver := application.NewResolver(transact, svc, nil, oAuth20Svc, sysAuthSvc, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", "")
+			resolver := application.NewResolver(transact, svc, nil, oAuth20Svc, sysAuthSvc, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", "")
 			resolver.SetConverter(converter)
 
 			// WHEN
@@ -829,7 +829,7 @@ func TestResolver_MergeApplications(t *testing.T) {
 			mockPersistence := testCase.PersistenceFn()
 			mockTransactioner := testCase.TransactionerFn(mockPersistence)
 
-			resolver := application.NewResolver(mockTransactioner, svc, nil, nil, nil, converter, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", "")
+			resolver := application.NewResolver(mockTransactioner, svc, nil, nil, nil, converter, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", "")
 
 			// WHEN
 			result, err := resolver.MergeApplications(context.TODO(), destAppID, srcAppID)
@@ -865,7 +865,7 @@ func TestResolver_ApplicationBySystemNumber(t *testing.T) {
 		{
 			Name:            "Success",
 			PersistenceFn:   txtest.PersistenceContextThatExpectsCommit,
-			TransactionerFn: txtest.TransactionerThatSucceeds,
+			TransactionerFn: txtest.ReadOnlyTransactionerThatSucceeds,
 			ServiceFn: func() *automock.ApplicationService {
 				svc := &automock.ApplicationService{}
 				svc.On("GetBySystemNumber", contextParam, systemNumber).Return(modelApplication, nil).Once()
@@ -883,7 +883,7 @@ func TestResolver_ApplicationBySystemNumber(t *testing.T) {
 		{
 			Name:            "GetBySystemNumber returns NotFound error",
 			PersistenceFn:   txtest.PersistenceContextThatExpectsCommit,
-			TransactionerFn: txtest.TransactionerThatSucceeds,
+			TransactionerFn: txtest.ReadOnlyTransactionerThatSucceeds,
 			ServiceFn: func() *automock.ApplicationService {
 				svc := &automock.ApplicationService{}
 				svc.On("GetBySystemNumber", contextParam, systemNumber).Return(nil, apperrors.NewNotFoundError(resource.Application, "foo")).Once()
@@ -901,7 +901,7 @@ func TestResolver_ApplicationBySystemNumber(t *testing.T) {
 		{
 			Name:            "GetBySystemNumber returns error",
 			PersistenceFn:   txtest.PersistenceContextThatExpectsCommit,
-			TransactionerFn: txtest.TransactionerThatSucceeds,
+			TransactionerFn: txtest.ReadOnlyTransactionerThatSucceeds,
 			ServiceFn: func() *automock.ApplicationService {
 				svc := &automock.ApplicationService{}
 				svc.On("GetBySystemNumber", contextParam, systemNumber).Return(nil, testErr).Once()
@@ -930,7 +930,7 @@ func TestResolver_ApplicationBySystemNumber(t *testing.T) {
 			},
 			TransactionerFn: func(persistTx *persistenceautomock.PersistenceTx) *persistenceautomock.Transactioner {
 				transact := &persistenceautomock.Transactioner{}
-				transact.On("Begin").Return(nil, testErr).Once()
+				transact.On("BeginReadOnly").Return(nil, testErr).Once()
 				return transact
 			},
 			SystemNumber:        systemNumber,
@@ -954,7 +954,7 @@ func TestResolver_ApplicationBySystemNumber(t *testing.T) {
 				conv.AssertNotCalled(t, "ToGraphQL")
 				return conv
 			},
-			TransactionerFn:     txtest.TransactionerThatSucceeds,
+			TransactionerFn:     txtest.ReadOnlyTransactionerThatSucceeds,
 			SystemNumber:        systemNumber,
 			ExpectedApplication: nil,
 			ExpectedErr:         testErr,
@@ -968,7 +968,7 @@ func TestResolver_ApplicationBySystemNumber(t *testing.T) {
 			svc := testCase.ServiceFn()
 			converter := testCase.ConverterFn()
 
-			resolver := application.NewResolver(transact, svc, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", "")
+			resolver := application.NewResolver(transact, svc, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", "")
 			resolver.SetConverter(converter)
 
 			// WHEN
@@ -1004,7 +1004,7 @@ func TestResolver_ApplicationByLocalTenantIDAndAppTemplateID(t *testing.T) {
 		{
 			Name:            "Success",
 			PersistenceFn:   txtest.PersistenceContextThatExpectsCommit,
-			TransactionerFn: txtest.TransactionerThatSucceeds,
+			TransactionerFn: txtest.ReadOnlyTransactionerThatSucceeds,
 			ServiceFn: func() *automock.ApplicationService {
 				svc := &automock.ApplicationService{}
 				svc.On("GetByLocalTenantIDAndAppTemplateID", contextParam, localTenantID, appTemplateID).Return(modelApplication, nil).Once()
@@ -1023,7 +1023,7 @@ func TestResolver_ApplicationByLocalTenantIDAndAppTemplateID(t *testing.T) {
 		{
 			Name:            "GetByLocalTenantIDAndAppTemplateID returns NotFound error",
 			PersistenceFn:   txtest.PersistenceContextThatExpectsCommit,
-			TransactionerFn: txtest.TransactionerThatSucceeds,
+			TransactionerFn: txtest.ReadOnlyTransactionerThatSucceeds,
 			ServiceFn: func() *automock.ApplicationService {
 				svc := &automock.ApplicationService{}
 				svc.On("GetByLocalTenantIDAndAppTemplateID", contextParam, localTenantID, appTemplateID).Return(nil, apperrors.NewNotFoundError(resource.Application, "foo")).Once()
@@ -1042,7 +1042,7 @@ func TestResolver_ApplicationByLocalTenantIDAndAppTemplateID(t *testing.T) {
 		{
 			Name:            "GetByLocalTenantIDAndAppTemplateID returns error",
 			PersistenceFn:   txtest.PersistenceContextThatExpectsCommit,
-			TransactionerFn: txtest.TransactionerThatSucceeds,
+			TransactionerFn: txtest.ReadOnlyTransactionerThatSucceeds,
 			ServiceFn: func() *automock.ApplicationService {
 				svc := &automock.ApplicationService{}
 				svc.On("GetByLocalTenantIDAndAppTemplateID", contextParam, localTenantID, appTemplateID).Return(nil, testErr).Once()
@@ -1072,7 +1072,7 @@ func TestResolver_ApplicationByLocalTenantIDAndAppTemplateID(t *testing.T) {
 			},
 			TransactionerFn: func(persistTx *persistenceautomock.PersistenceTx) *persistenceautomock.Transactioner {
 				transact := &persistenceautomock.Transactioner{}
-				transact.On("Begin").Return(nil, testErr).Once()
+				transact.On("BeginReadOnly").Return(nil, testErr).Once()
 				return transact
 			},
 			LocalTenantID:       localTenantID,
@@ -1097,7 +1097,7 @@ func TestResolver_ApplicationByLocalTenantIDAndAppTemplateID(t *testing.T) {
 				conv.AssertNotCalled(t, "ToGraphQL")
 				return conv
 			},
-			TransactionerFn:     txtest.TransactionerThatSucceeds,
+			TransactionerFn:     txtest.ReadOnlyTransactionerThatSucceeds,
 			LocalTenantID:       localTenantID,
 			AppTemplateID:       appTemplateID,
 			ExpectedApplication: nil,
@@ -1112,7 +1112,7 @@ func TestResolver_ApplicationByLocalTenantIDAndAppTemplateID(t *testing.T) {
 			svc := testCase.ServiceFn()
 			converter := testCase.ConverterFn()
 
-			resolver := application.NewResolver(transact, svc, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", "")
+			resolver := application.NewResolver(transact, svc, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", "")
 			resolver.SetConverter(converter)
 
 			// WHEN
@@ -1147,7 +1147,7 @@ func TestResolver_Application(t *testing.T) {
 		{
 			Name:            "Success",
 			PersistenceFn:   txtest.PersistenceContextThatExpectsCommit,
-			TransactionerFn: txtest.TransactionerThatSucceeds,
+			TransactionerFn: txtest.ReadOnlyTransactionerThatSucceeds,
 			ServiceFn: func() *automock.ApplicationService {
 				svc := &automock.ApplicationService{}
 				svc.On("Get", contextParam, "foo").Return(modelApplication, nil).Once()
@@ -1166,7 +1166,7 @@ func TestResolver_Application(t *testing.T) {
 		{
 			Name:            "Success returns nil when application not found",
 			PersistenceFn:   txtest.PersistenceContextThatExpectsCommit,
-			TransactionerFn: txtest.TransactionerThatSucceeds,
+			TransactionerFn: txtest.ReadOnlyTransactionerThatSucceeds,
 			ServiceFn: func() *automock.ApplicationService {
 				svc := &automock.ApplicationService{}
 				svc.On("Get", contextParam, "foo").Return(nil, apperrors.NewNotFoundError(resource.Application, "foo")).Once()
@@ -1184,7 +1184,7 @@ func TestResolver_Application(t *testing.T) {
 		{
 			Name:            "Returns error when application retrieval failed",
 			PersistenceFn:   txtest.PersistenceContextThatExpectsCommit,
-			TransactionerFn: txtest.TransactionerThatSucceeds,
+			TransactionerFn: txtest.ReadOnlyTransactionerThatSucceeds,
 			ServiceFn: func() *automock.ApplicationService {
 				svc := &automock.ApplicationService{}
 				svc.On("Get", contextParam, "foo").Return(nil, testErr).Once()
@@ -1208,7 +1208,7 @@ func TestResolver_Application(t *testing.T) {
 			svc := testCase.ServiceFn()
 			converter := testCase.ConverterFn()
 
-			resolver := application.NewResolver(transact, svc, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", "")
+			resolver := application.NewResolver(transact, svc, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", "")
 			resolver.SetConverter(converter)
 
 			// WHEN
@@ -1261,7 +1261,7 @@ func TestResolver_Applications(t *testing.T) {
 		{
 			Name:            "Success",
 			PersistenceFn:   txtest.PersistenceContextThatExpectsCommit,
-			TransactionerFn: txtest.TransactionerThatSucceeds,
+			TransactionerFn: txtest.ReadOnlyTransactionerThatSucceeds,
 			ServiceFn: func() *automock.ApplicationService {
 				svc := &automock.ApplicationService{}
 				svc.On("List", contextParam, filter, first, after).Return(fixApplicationPage(modelApplications), nil).Once()
@@ -1279,7 +1279,7 @@ func TestResolver_Applications(t *testing.T) {
 		{
 			Name:            "Returns error when application listing failed",
 			PersistenceFn:   txtest.PersistenceContextThatExpectsCommit,
-			TransactionerFn: txtest.TransactionerThatSucceeds,
+			TransactionerFn: txtest.ReadOnlyTransactionerThatSucceeds,
 			ServiceFn: func() *automock.ApplicationService {
 				svc := &automock.ApplicationService{}
 				svc.On("List", contextParam, filter, first, after).Return(nil, testErr).Once()
@@ -1302,7 +1302,7 @@ func TestResolver_Applications(t *testing.T) {
 			svc := testCase.ServiceFn()
 			converter := testCase.ConverterFn()
 
-			resolver := application.NewResolver(transact, svc, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", "")
+			resolver := application.NewResolver(transact, svc, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", "")
 			resolver.SetConverter(converter)
 
 			// WHEN
@@ -1377,7 +1377,7 @@ func TestResolver_Applications_DoubleAuthFlow(t *testing.T) {
 		{
 			Name:            "Success",
 			PersistenceFn:   txtest.PersistenceContextThatExpectsCommit,
-			TransactionerFn: txtest.TransactionerThatSucceeds,
+			TransactionerFn: txtest.ReadOnlyTransactionerThatSucceeds,
 			ServiceFn: func() *automock.ApplicationService {
 				svc := &automock.ApplicationService{}
 				svc.On("ListAll", contextParam).Return(modelApplicationList, nil).Once()
@@ -1434,7 +1434,7 @@ func TestResolver_Applications_DoubleAuthFlow(t *testing.T) {
 		{
 			Name:            "Error when getting application template",
 			PersistenceFn:   txtest.PersistenceContextThatDoesntExpectCommit,
-			TransactionerFn: txtest.TransactionerThatSucceeds,
+			TransactionerFn: txtest.ReadOnlyTransactionerThatSucceeds,
 			ServiceFn: func() *automock.ApplicationService {
 				svc := &automock.ApplicationService{}
 				svc.AssertNotCalled(t, "ListAll")
@@ -1458,7 +1458,7 @@ func TestResolver_Applications_DoubleAuthFlow(t *testing.T) {
 		{
 			Name:            "Error when listing applications template",
 			PersistenceFn:   txtest.PersistenceContextThatDoesntExpectCommit,
-			TransactionerFn: txtest.TransactionerThatSucceeds,
+			TransactionerFn: txtest.ReadOnlyTransactionerThatSucceeds,
 			ServiceFn: func() *automock.ApplicationService {
 				svc := &automock.ApplicationService{}
 				svc.On("ListAll", contextParam).Return(nil, testErr).Once()
@@ -1482,7 +1482,7 @@ func TestResolver_Applications_DoubleAuthFlow(t *testing.T) {
 		{
 			Name:            "Error when no application found",
 			PersistenceFn:   txtest.PersistenceContextThatDoesntExpectCommit,
-			TransactionerFn: txtest.TransactionerThatSucceeds,
+			TransactionerFn: txtest.ReadOnlyTransactionerThatSucceeds,
 			ServiceFn: func() *automock.ApplicationService {
 				svc := &automock.ApplicationService{}
 				svc.On("ListAll", contextParam).Return(modelApplicationListWithNoMatchingRecord, nil).Once()
@@ -1510,7 +1510,7 @@ func TestResolver_Applications_DoubleAuthFlow(t *testing.T) {
 				persistTx.On("Commit").Return(testErr).Once()
 				return persistTx
 			},
-			TransactionerFn: txtest.TransactionerThatSucceeds,
+			TransactionerFn: txtest.ReadOnlyTransactionerThatSucceeds,
 			ServiceFn: func() *automock.ApplicationService {
 				svc := &automock.ApplicationService{}
 				svc.On("ListAll", contextParam).Return(modelApplicationList, nil).Once()
@@ -1541,7 +1541,7 @@ func TestResolver_Applications_DoubleAuthFlow(t *testing.T) {
 			appTemplateSvc := testCase.AppTemplateServiceFn()
 			converter := testCase.ConverterFn()
 
-			resolver := application.NewResolver(transact, svc, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, appTemplateSvc, nil, nil, nil, selfRegisterDistinguishLabelKey, "sb-")
+			resolver := application.NewResolver(transact, svc, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, appTemplateSvc, nil, nil, nil, nil, nil, nil, nil, nil, nil, selfRegisterDistinguishLabelKey, "sb-")
 			resolver.SetConverter(converter)
 
 			first := 2
@@ -1583,7 +1583,7 @@ func TestResolver_ApplicationsForRuntime(t *testing.T) {
 	after := "test"
 	gqlAfter := graphql.PageCursor(after)
 
-	txGen := txtest.NewTransactionContextGenerator(testError)
+	txGen := txtest.NewReadOnlyTransactionContextGenerator(testError)
 
 	runtimeUUID := uuid.New()
 	runtimeID := runtimeUUID.String()
@@ -1684,7 +1684,7 @@ func TestResolver_ApplicationsForRuntime(t *testing.T) {
 			applicationConverter := testCase.AppConverterFn()
 			persistTx, transact := testCase.TransactionerFn()
 
-			resolver := application.NewResolver(transact, applicationSvc, nil, nil, nil, applicationConverter, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", "")
+			resolver := application.NewResolver(transact, applicationSvc, nil, nil, nil, applicationConverter, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", "")
 
 			// WHEN
 			result, err := resolver.ApplicationsForRuntime(context.TODO(), testCase.InputRuntimeID, &first, &gqlAfter)
@@ -1780,7 +1780,7 @@ func TestResolver_SetApplicationLabel(t *testing.T) {
 			persistTx := testCase.PersistenceFn()
 			transactioner := testCase.TransactionerFn(persistTx)
 
-			resolver := application.NewResolver(transactioner, svc, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", "")
+			resolver := application.NewResolver(transactioner, svc, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", "")
 			resolver.SetConverter(converter)
 
 			// WHEN
@@ -1797,7 +1797,7 @@ func TestResolver_SetApplicationLabel(t *testing.T) {
 	}
 
 	t.Run("Returns error when Label input validation failed", func(t *testing.T) {
-		resolver := application.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", "")
+		resolver := application.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", "")
 
 		// WHEN
 		result, err := resolver.SetApplicationLabel(context.TODO(), "", "", "")
@@ -1909,7 +1909,7 @@ func TestResolver_DeleteApplicationLabel(t *testing.T) {
 			persistTx := testCase.PersistenceFn()
 			transactioner := testCase.TransactionerFn(persistTx)
 
-			resolver := application.NewResolver(transactioner, svc, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", "")
+			resolver := application.NewResolver(transactioner, svc, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", "")
 			resolver.SetConverter(converter)
 
 			// WHEN
@@ -2064,7 +2064,7 @@ func TestResolver_Webhooks(t *testing.T) {
 			mockPersistence := testCase.PersistenceFn()
 			mockTransactioner := testCase.TransactionerFn(mockPersistence)
 
-			resolver := application.NewResolver(mockTransactioner, nil, svc, nil, nil, nil, converter, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", "")
+			resolver := application.NewResolver(mockTransactioner, nil, svc, nil, nil, nil, converter, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", "")
 
 			// WHEN
 			result, err := resolver.Webhooks(context.TODO(), app)
@@ -2180,7 +2180,7 @@ func TestResolver_Labels(t *testing.T) {
 			persistTx := testCase.PersistenceFn()
 			transact := testCase.TransactionerFn(persistTx)
 
-			resolver := application.NewResolver(transact, svc, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", "")
+			resolver := application.NewResolver(transact, svc, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", "")
 
 			// WHEN
 			result, err := resolver.Labels(context.TODO(), gqlApp, testCase.InputKey)
@@ -2350,7 +2350,7 @@ func TestResolver_Auths(t *testing.T) {
 			persist, transact := testCase.TransactionerFn()
 			conv := testCase.SysAuthConvFn()
 
-			resolver := application.NewResolver(transact, nil, nil, nil, svc, nil, nil, conv, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", "")
+			resolver := application.NewResolver(transact, nil, nil, nil, svc, nil, nil, conv, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", "")
 
 			// WHEN
 			result, err := resolver.Auths(context.TODO(), testCase.InputApp)
@@ -2367,7 +2367,7 @@ func TestResolver_Auths(t *testing.T) {
 	}
 
 	t.Run("Returns error when application is nil", func(t *testing.T) {
-		resolver := application.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", "")
+		resolver := application.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", "")
 		// WHEN
 		_, err := resolver.Auths(context.TODO(), nil)
 		// THEN
@@ -2461,7 +2461,7 @@ func TestResolver_EventingConfiguration(t *testing.T) {
 			eventingSvc := testCase.EventingSvcFn()
 			converter := testCase.ConverterFn()
 
-			resolver := application.NewResolver(transact, nil, nil, nil, nil, converter, nil, nil, eventingSvc, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", "")
+			resolver := application.NewResolver(transact, nil, nil, nil, nil, converter, nil, nil, eventingSvc, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", "")
 
 			// WHEN
 			result, err := resolver.EventingConfiguration(ctx, gqlApp)
@@ -2481,7 +2481,7 @@ func TestResolver_EventingConfiguration(t *testing.T) {
 
 	t.Run("Error when tenant not in context", func(t *testing.T) {
 		// GIVEN
-		resolver := application.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", "")
+		resolver := application.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", "")
 
 		// WHEN
 		_, err := resolver.EventingConfiguration(context.TODO(), &graphql.Application{})
@@ -2493,7 +2493,7 @@ func TestResolver_EventingConfiguration(t *testing.T) {
 
 	t.Run("Error when parent object is nil", func(t *testing.T) {
 		// GIVEN
-		resolver := application.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", "")
+		resolver := application.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", "")
 
 		// WHEN
 		result, err := resolver.EventingConfiguration(context.TODO(), nil)
@@ -2635,7 +2635,7 @@ func TestResolver_Bundles(t *testing.T) {
 			svc := testCase.ServiceFn()
 			converter := testCase.ConverterFn()
 
-			resolver := application.NewResolver(transact, nil, nil, nil, nil, nil, nil, nil, nil, svc, converter, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", "")
+			resolver := application.NewResolver(transact, nil, nil, nil, nil, nil, nil, nil, nil, svc, converter, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", "")
 			firstAppParams := dataloader.ParamBundle{ID: firstAppID, Ctx: context.TODO(), First: &first, After: &gqlAfter}
 			secondAppParams := dataloader.ParamBundle{ID: secondAppID, Ctx: context.TODO(), First: &first, After: &gqlAfter}
 			keys := []dataloader.ParamBundle{firstAppParams, secondAppParams}
@@ -2655,7 +2655,7 @@ func TestResolver_Bundles(t *testing.T) {
 	}
 
 	t.Run("Returns error when there are no Applications", func(t *testing.T) {
-		resolver := application.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", "")
+		resolver := application.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", "")
 		// WHEN
 		_, err := resolver.BundlesDataLoader([]dataloader.ParamBundle{})
 		// THEN
@@ -2667,7 +2667,7 @@ func TestResolver_Bundles(t *testing.T) {
 		firstAppParams := dataloader.ParamBundle{ID: firstAppID, Ctx: context.TODO(), First: nil, After: &gqlAfter}
 		keys := []dataloader.ParamBundle{firstAppParams}
 
-		resolver := application.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", "")
+		resolver := application.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", "")
 		// WHEN
 		_, err := resolver.BundlesDataLoader(keys)
 		// THEN
@@ -2812,7 +2812,7 @@ func TestResolver_Bundle(t *testing.T) {
 			svc := testCase.ServiceFn()
 			converter := testCase.ConverterFn()
 
-			resolver := application.NewResolver(transact, nil, nil, nil, nil, nil, nil, nil, nil, svc, converter, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", "")
+			resolver := application.NewResolver(transact, nil, nil, nil, nil, nil, nil, nil, nil, svc, converter, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", "")
 
 			// WHEN
 			result, err := resolver.Bundle(context.TODO(), testCase.Application, testCase.InputID)
@@ -2829,7 +2829,7 @@ func TestResolver_Bundle(t *testing.T) {
 	}
 
 	t.Run("Returns error when application is nil", func(t *testing.T) {
-		resolver := application.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", "")
+		resolver := application.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", "")
 		// WHEN
 		_, err := resolver.Bundle(context.TODO(), nil, "")
 		// THEN
@@ -2997,7 +2997,7 @@ func TestResolver_APIDefinition(t *testing.T) {
 			converter := testCase.ConverterFn()
 			defer mock.AssertExpectationsForObjects(t, persist, apiDefSvc, specSvc, converter)
 
-			resolver := application.NewResolver(transact, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, specSvc, apiDefSvc, nil, converter, nil, nil, nil, nil, nil, "", "")
+			resolver := application.NewResolver(transact, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, specSvc, apiDefSvc, nil, converter, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", "")
 
 			// WHEN
 			result, err := resolver.APIDefinition(context.TODO(), testCase.Application, testCase.InputID)
@@ -3013,7 +3013,7 @@ func TestResolver_APIDefinition(t *testing.T) {
 	}
 
 	t.Run("Returns error when application is nil", func(t *testing.T) {
-		resolver := application.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", "")
+		resolver := application.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", "")
 		// WHEN
 		_, err := resolver.APIDefinition(context.TODO(), nil, "")
 		// THEN
@@ -3182,7 +3182,7 @@ func TestResolver_EventDefinition(t *testing.T) {
 			converter := testCase.ConverterFn()
 			defer mock.AssertExpectationsForObjects(t, persist, eventDefSvc, specSvc, converter)
 
-			resolver := application.NewResolver(transact, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, specSvc, nil, eventDefSvc, nil, converter, nil, nil, nil, nil, "", "")
+			resolver := application.NewResolver(transact, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, specSvc, nil, eventDefSvc, nil, converter, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", "")
 
 			// WHEN
 			result, err := resolver.EventDefinition(context.TODO(), testCase.Application, testCase.InputID)
@@ -3198,7 +3198,7 @@ func TestResolver_EventDefinition(t *testing.T) {
 	}
 
 	t.Run("Returns error when application is nil", func(t *testing.T) {
-		resolver := application.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", "")
+		resolver := application.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", "")
 		// WHEN
 		_, err := resolver.EventDefinition(context.TODO(), nil, "")
 		// THEN
@@ -3244,6 +3244,387 @@ func fixOAuths() []pkgmodel.SystemAuth {
 	}
 }
 
+func TestResolver_Packages(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("test error")
+
+	firstAppID := "appID"
+	secondAppID := "appID2"
+	appIDs := []string{firstAppID, secondAppID}
+
+	packageModels := []*model.Package{{ID: "pkgID", ApplicationID: &firstAppID, OrdID: "ns:package:PACKAGE_ID:v1", Title: "title"}}
+	gqlPackages := []*graphql.Package{{ID: "pkgID", OrdID: "ns:package:PACKAGE_ID:v1", Title: "title"}}
+
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	testCases := []struct {
+		Name            string
+		TransactionerFn func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ServiceFn       func() *automock.PackageService
+		ConverterFn     func() *automock.PackageConverter
+		ExpectedResult  [][]*graphql.Package
+		ExpectedErr     error
+	}{
+		{
+			Name:            "Success",
+			TransactionerFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.PackageService {
+				svc := &automock.PackageService{}
+				svc.On("ListByApplicationIDs", txtest.CtxWithDBMatcher(), appIDs).Return([][]*model.Package{packageModels, nil}, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.PackageConverter {
+				conv := &automock.PackageConverter{}
+				conv.On("MultipleToGraphQL", packageModels).Return(gqlPackages).Once()
+				conv.On("MultipleToGraphQL", []*model.Package(nil)).Return([]*graphql.Package{}).Once()
+				return conv
+			},
+			ExpectedResult: [][]*graphql.Package{gqlPackages, {}},
+		},
+		{
+			Name:            "Returns error when transaction begin failed",
+			TransactionerFn: txGen.ThatFailsOnBegin,
+			ServiceFn: func() *automock.PackageService {
+				return &automock.PackageService{}
+			},
+			ConverterFn: func() *automock.PackageConverter {
+				return &automock.PackageConverter{}
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name:            "Returns error when Packages listing failed",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.PackageService {
+				svc := &automock.PackageService{}
+				svc.On("ListByApplicationIDs", txtest.CtxWithDBMatcher(), appIDs).Return(nil, testErr).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.PackageConverter {
+				return &automock.PackageConverter{}
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name:            "Returns error when transaction commit failed",
+			TransactionerFn: txGen.ThatFailsOnCommit,
+			ServiceFn: func() *automock.PackageService {
+				svc := &automock.PackageService{}
+				svc.On("ListByApplicationIDs", txtest.CtxWithDBMatcher(), appIDs).Return([][]*model.Package{packageModels, nil}, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.PackageConverter {
+				conv := &automock.PackageConverter{}
+				conv.On("MultipleToGraphQL", packageModels).Return(gqlPackages).Once()
+				conv.On("MultipleToGraphQL", []*model.Package(nil)).Return([]*graphql.Package{}).Once()
+				return conv
+			},
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			persist, transact := testCase.TransactionerFn()
+			svc := testCase.ServiceFn()
+			converter := testCase.ConverterFn()
+
+			resolver := application.NewResolver(transact, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, svc, converter, nil, nil, nil, nil, "", "")
+
+			firstAppParams := dataloader.ParamPackage{ID: firstAppID, Ctx: context.TODO()}
+			secondAppParams := dataloader.ParamPackage{ID: secondAppID, Ctx: context.TODO()}
+			keys := []dataloader.ParamPackage{firstAppParams, secondAppParams}
+
+			// WHEN
+			result, errs := resolver.PackagesDataLoader(keys)
+
+			// THEN
+			if testCase.ExpectedErr != nil {
+				require.Len(t, errs, 1)
+				assert.Contains(t, errs[0].Error(), testCase.ExpectedErr.Error())
+			} else {
+				require.Nil(t, errs)
+			}
+			assert.Equal(t, testCase.ExpectedResult, result)
+
+			mock.AssertExpectationsForObjects(t, persist, transact, svc, converter)
+		})
+	}
+
+	t.Run("Returns error when there are no Applications", func(t *testing.T) {
+		resolver := application.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", "")
+		// WHEN
+		_, errs := resolver.PackagesDataLoader([]dataloader.ParamPackage{})
+		// THEN
+		require.Error(t, errs[0])
+		assert.EqualError(t, errs[0], apperrors.NewInternalError("No Applications found").Error())
+	})
+
+	t.Run("Returns error when application is nil", func(t *testing.T) {
+		resolver := application.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", "")
+		// WHEN
+		_, err := resolver.Packages(context.TODO(), nil)
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Application cannot be empty")
+	})
+}
+
+func TestResolver_EntityTypes(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("test error")
+
+	firstAppID := "appID"
+	secondAppID := "appID2"
+	appIDs := []string{firstAppID, secondAppID}
+
+	entityTypeModels := []*model.EntityType{{ID: "entityTypeID", ApplicationID: &firstAppID, OrdID: "ns:entityType:ENTITY_TYPE_ID:v1", Title: "title"}}
+	gqlEntityTypes := []*graphql.EntityType{{ID: "entityTypeID", OrdID: "ns:entityType:ENTITY_TYPE_ID:v1", Title: "title"}}
+
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	testCases := []struct {
+		Name            string
+		TransactionerFn func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ServiceFn       func() *automock.EntityTypeService
+		ConverterFn     func() *automock.EntityTypeConverter
+		ExpectedResult  [][]*graphql.EntityType
+		ExpectedErr     error
+	}{
+		{
+			Name:            "Success",
+			TransactionerFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.EntityTypeService {
+				svc := &automock.EntityTypeService{}
+				svc.On("ListByApplicationIDs", txtest.CtxWithDBMatcher(), appIDs).Return([][]*model.EntityType{entityTypeModels, nil}, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.EntityTypeConverter {
+				conv := &automock.EntityTypeConverter{}
+				conv.On("MultipleToGraphQL", entityTypeModels).Return(gqlEntityTypes).Once()
+				conv.On("MultipleToGraphQL", []*model.EntityType(nil)).Return([]*graphql.EntityType{}).Once()
+				return conv
+			},
+			ExpectedResult: [][]*graphql.EntityType{gqlEntityTypes, {}},
+		},
+		{
+			Name:            "Returns error when transaction begin failed",
+			TransactionerFn: txGen.ThatFailsOnBegin,
+			ServiceFn: func() *automock.EntityTypeService {
+				return &automock.EntityTypeService{}
+			},
+			ConverterFn: func() *automock.EntityTypeConverter {
+				return &automock.EntityTypeConverter{}
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name:            "Returns error when Entity Types listing failed",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.EntityTypeService {
+				svc := &automock.EntityTypeService{}
+				svc.On("ListByApplicationIDs", txtest.CtxWithDBMatcher(), appIDs).Return(nil, testErr).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.EntityTypeConverter {
+				return &automock.EntityTypeConverter{}
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name:            "Returns error when transaction commit failed",
+			TransactionerFn: txGen.ThatFailsOnCommit,
+			ServiceFn: func() *automock.EntityTypeService {
+				svc := &automock.EntityTypeService{}
+				svc.On("ListByApplicationIDs", txtest.CtxWithDBMatcher(), appIDs).Return([][]*model.EntityType{entityTypeModels, nil}, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.EntityTypeConverter {
+				conv := &automock.EntityTypeConverter{}
+				conv.On("MultipleToGraphQL", entityTypeModels).Return(gqlEntityTypes).Once()
+				conv.On("MultipleToGraphQL", []*model.EntityType(nil)).Return([]*graphql.EntityType{}).Once()
+				return conv
+			},
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			persist, transact := testCase.TransactionerFn()
+			svc := testCase.ServiceFn()
+			converter := testCase.ConverterFn()
+
+			resolver := application.NewResolver(transact, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, svc, converter, nil, nil, "", "")
+
+			firstAppParams := dataloader.ParamEntityType{ID: firstAppID, Ctx: context.TODO()}
+			secondAppParams := dataloader.ParamEntityType{ID: secondAppID, Ctx: context.TODO()}
+			keys := []dataloader.ParamEntityType{firstAppParams, secondAppParams}
+
+			// WHEN
+			result, errs := resolver.EntityTypesDataLoader(keys)
+
+			// THEN
+			if testCase.ExpectedErr != nil {
+				require.Len(t, errs, 1)
+				assert.Contains(t, errs[0].Error(), testCase.ExpectedErr.Error())
+			} else {
+				require.Nil(t, errs)
+			}
+			assert.Equal(t, testCase.ExpectedResult, result)
+
+			mock.AssertExpectationsForObjects(t, persist, transact, svc, converter)
+		})
+	}
+
+	t.Run("Returns error when there are no Applications", func(t *testing.T) {
+		resolver := application.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", "")
+		// WHEN
+		_, errs := resolver.EntityTypesDataLoader([]dataloader.ParamEntityType{})
+		// THEN
+		require.Error(t, errs[0])
+		assert.EqualError(t, errs[0], apperrors.NewInternalError("No Applications found").Error())
+	})
+
+	t.Run("Returns error when application is nil", func(t *testing.T) {
+		resolver := application.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", "")
+		// WHEN
+		_, err := resolver.EntityTypes(context.TODO(), nil)
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Application cannot be empty")
+	})
+}
+
+func TestResolver_Capabilities(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("test error")
+
+	firstAppID := "appID"
+	secondAppID := "appID2"
+	appIDs := []string{firstAppID, secondAppID}
+
+	capabilityModels := []*model.Capability{{ID: "capabilityID", ApplicationID: &firstAppID, OrdID: "ns:capability:CAPABILITY_ID:v1", Title: "title"}}
+	gqlCapabilities := []*graphql.Capability{{ID: "capabilityID", OrdID: "ns:capability:CAPABILITY_ID:v1", Title: "title"}}
+
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	testCases := []struct {
+		Name            string
+		TransactionerFn func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ServiceFn       func() *automock.CapabilityService
+		ConverterFn     func() *automock.CapabilityConverter
+		ExpectedResult  [][]*graphql.Capability
+		ExpectedErr     error
+	}{
+		{
+			Name:            "Success",
+			TransactionerFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.CapabilityService {
+				svc := &automock.CapabilityService{}
+				svc.On("ListByApplicationIDs", txtest.CtxWithDBMatcher(), appIDs).Return([][]*model.Capability{capabilityModels, nil}, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.CapabilityConverter {
+				conv := &automock.CapabilityConverter{}
+				conv.On("MultipleToGraphQL", capabilityModels).Return(gqlCapabilities).Once()
+				conv.On("MultipleToGraphQL", []*model.Capability(nil)).Return([]*graphql.Capability{}).Once()
+				return conv
+			},
+			ExpectedResult: [][]*graphql.Capability{gqlCapabilities, {}},
+		},
+		{
+			Name:            "Returns error when transaction begin failed",
+			TransactionerFn: txGen.ThatFailsOnBegin,
+			ServiceFn: func() *automock.CapabilityService {
+				return &automock.CapabilityService{}
+			},
+			ConverterFn: func() *automock.CapabilityConverter {
+				return &automock.CapabilityConverter{}
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name:            "Returns error when Capabilities listing failed",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.CapabilityService {
+				svc := &automock.CapabilityService{}
+				svc.On("ListByApplicationIDs", txtest.CtxWithDBMatcher(), appIDs).Return(nil, testErr).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.CapabilityConverter {
+				return &automock.CapabilityConverter{}
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name:            "Returns error when transaction commit failed",
+			TransactionerFn: txGen.ThatFailsOnCommit,
+			ServiceFn: func() *automock.CapabilityService {
+				svc := &automock.CapabilityService{}
+				svc.On("ListByApplicationIDs", txtest.CtxWithDBMatcher(), appIDs).Return([][]*model.Capability{capabilityModels, nil}, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.CapabilityConverter {
+				conv := &automock.CapabilityConverter{}
+				conv.On("MultipleToGraphQL", capabilityModels).Return(gqlCapabilities).Once()
+				conv.On("MultipleToGraphQL", []*model.Capability(nil)).Return([]*graphql.Capability{}).Once()
+				return conv
+			},
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			persist, transact := testCase.TransactionerFn()
+			svc := testCase.ServiceFn()
+			converter := testCase.ConverterFn()
+
+			resolver := application.NewResolver(transact, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, svc, converter, "", "")
+
+			firstAppParams := dataloader.ParamCapability{ID: firstAppID, Ctx: context.TODO()}
+			secondAppParams := dataloader.ParamCapability{ID: secondAppID, Ctx: context.TODO()}
+			keys := []dataloader.ParamCapability{firstAppParams, secondAppParams}
+
+			// WHEN
+			result, errs := resolver.CapabilitiesDataLoader(keys)
+
+			// THEN
+			if testCase.ExpectedErr != nil {
+				require.Len(t, errs, 1)
+				assert.Contains(t, errs[0].Error(), testCase.ExpectedErr.Error())
+			} else {
+				require.Nil(t, errs)
+			}
+			assert.Equal(t, testCase.ExpectedResult, result)
+
+			mock.AssertExpectationsForObjects(t, persist, transact, svc, converter)
+		})
+	}
+
+	t.Run("Returns error when there are no Applications", func(t *testing.T) {
+		resolver := application.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", "")
+		// WHEN
+		_, errs := resolver.CapabilitiesDataLoader([]dataloader.ParamCapability{})
+		// THEN
+		require.Error(t, errs[0])
+		assert.EqualError(t, errs[0], apperrors.NewInternalError("No Applications found").Error())
+	})
+
+	t.Run("Returns error when application is nil", func(t *testing.T) {
+		resolver := application.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", "")
+		// WHEN
+		_, err := resolver.Capabilities(context.TODO(), nil)
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Application cannot be empty")
+	})
+}
+
 func TestResolver_ApplicationTemplate(t *testing.T) {
 	// GIVEN
 
@@ -3393,7 +3774,7 @@ func TestResolver_ApplicationTemplate(t *testing.T) {
 			persistTx := testCase.PersistenceFn()
 			transact := testCase.TransactionerFn(persistTx)
 
-			resolver := application.NewResolver(transact, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, svc, converter, nil, nil, "", "")
+			resolver := application.NewResolver(transact, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, svc, converter, nil, nil, nil, nil, nil, nil, nil, nil, "", "")
 			// WHEN
 			result, err := resolver.ApplicationTemplate(context.TODO(), testCase.Application)
 
@@ -3562,7 +3943,7 @@ func TestResolver_TenantBusinessType(t *testing.T) {
 			persistTx := testCase.PersistenceFn()
 			transact := testCase.TransactionerFn(persistTx)
 
-			resolver := application.NewResolver(transact, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, svc, converter, "", "")
+			resolver := application.NewResolver(transact, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, svc, converter, nil, nil, nil, nil, nil, nil, "", "")
 			// WHEN
 			result, err := resolver.TenantBusinessType(context.TODO(), testCase.Application)
 
@@ -70,6 +70,7 @@ type ApplicationRepository interface {
 	ListAllByFilter(ctx context.Context, tenant string, filter []*labelfilter.LabelFilter) ([]*model.Application, error)
 	ListGlobal(ctx context.Context, pageSize int, cursor string) (*model.ApplicationPage, error)
 	ListAllByApplicationTemplateID(ctx context.Context, applicationTemplateID string) ([]*model.Application, error)
+	ListTemplateValuesByApplicationTemplateID(ctx context.Context, applicationTemplateID string) (map[string]json.RawMessage, error)
 	ListByScenarios(ctx context.Context, tenantID uuid.UUID, scenarios []string, pageSize int, cursor string, hidingSelectors map[string][]string) (*model.ApplicationPage, error)
 	ListByScenariosNoPaging(ctx context.Context, tenant string, scenarios []string) ([]*model.Application, error)
 	ListListeningApplications(ctx context.Context, tenant string, whType model.WebhookType) ([]*model.Application, error)
@@ -80,6 +81,7 @@ type ApplicationRepository interface {
 	Upsert(ctx context.Context, tenant string, model *model.Application) (string, error)
 	TrustedUpsert(ctx context.Context, tenant string, model *model.Application) (string, error)
 	TechnicalUpdate(ctx context.Context, item *model.Application) error
+	UpdateTemplateValuesGlobal(ctx context.Context, id string, values json.RawMessage) error
 	Delete(ctx context.Context, tenant, id string) error
 	DeleteGlobal(ctx context.Context, id string) error
 }
@@ -5,6 +5,8 @@ package automock
 import (
 	context "context"
 
+	json "encoding/json"
+
 	model "github.com/kyma-incubator/compass/components/director/internal/model"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -14,6 +16,29 @@ type ApplicationRepository struct {
 	mock.Mock
 }
 
+// GetGlobalByIDForUpdate provides a mock function with given fields: ctx, id
+func (_m *ApplicationRepository) GetGlobalByIDForUpdate(ctx context.Context, id string) (*model.Application, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *model.Application
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.Application); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Application)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListAllByApplicationTemplateID provides a mock function with given fields: ctx, applicationTemplateID
 func (_m *ApplicationRepository) ListAllByApplicationTemplateID(ctx context.Context, applicationTemplateID string) ([]*model.Application, error) {
 	ret := _m.Called(ctx, applicationTemplateID)
@@ -42,6 +67,60 @@ type mockConstructorTestingTNewApplicationRepository interface {
 	Cleanup(func())
 }
 
+// ListTemplateValuesByApplicationTemplateID provides a mock function with given fields: ctx, applicationTemplateID
+func (_m *ApplicationRepository) ListTemplateValuesByApplicationTemplateID(ctx context.Context, applicationTemplateID string) (map[string]json.RawMessage, error) {
+	ret := _m.Called(ctx, applicationTemplateID)
+
+	var r0 map[string]json.RawMessage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (map[string]json.RawMessage, error)); ok {
+		return rf(ctx, applicationTemplateID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) map[string]json.RawMessage); ok {
+		r0 = rf(ctx, applicationTemplateID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]json.RawMessage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, applicationTemplateID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TechnicalUpdate provides a mock function with given fields: ctx, item
+func (_m *ApplicationRepository) TechnicalUpdate(ctx context.Context, item *model.Application) error {
+	ret := _m.Called(ctx, item)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Application) error); ok {
+		r0 = rf(ctx, item)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateTemplateValuesGlobal provides a mock function with given fields: ctx, id, values
+func (_m *ApplicationRepository) UpdateTemplateValuesGlobal(ctx context.Context, id string, values json.RawMessage) error {
+	ret := _m.Called(ctx, id, values)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, json.RawMessage) error); ok {
+		r0 = rf(ctx, id, values)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewApplicationRepository creates a new instance of ApplicationRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 func NewApplicationRepository(t mockConstructorTestingTNewApplicationRepository) *ApplicationRepository {
 	mock := &ApplicationRepository{}
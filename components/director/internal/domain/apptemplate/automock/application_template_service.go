@@ -72,6 +72,29 @@ func (_m *ApplicationTemplateService) Delete(ctx context.Context, id string) err
 	return r0
 }
 
+// DiffApplication provides a mock function with given fields: ctx, app, in
+func (_m *ApplicationTemplateService) DiffApplication(ctx context.Context, app *model.Application, in model.ApplicationRegisterInput) (*model.ApplicationUpgradeDiff, error) {
+	ret := _m.Called(ctx, app, in)
+
+	var r0 *model.ApplicationUpgradeDiff
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Application, model.ApplicationRegisterInput) *model.ApplicationUpgradeDiff); ok {
+		r0 = rf(ctx, app, in)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ApplicationUpgradeDiff)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *model.Application, model.ApplicationRegisterInput) error); ok {
+		r1 = rf(ctx, app, in)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Get provides a mock function with given fields: ctx, id
 func (_m *ApplicationTemplateService) Get(ctx context.Context, id string) (*model.ApplicationTemplate, error) {
 	ret := _m.Called(ctx, id)
@@ -185,6 +208,38 @@ func (_m *ApplicationTemplateService) List(ctx context.Context, filter []*labelf
 	return r0, r1
 }
 
+// ListApplicationsWithTemplateValues provides a mock function with given fields: ctx, appTemplateID
+func (_m *ApplicationTemplateService) ListApplicationsWithTemplateValues(ctx context.Context, appTemplateID string) ([]*model.Application, map[string]model.ApplicationFromTemplateInputValues, error) {
+	ret := _m.Called(ctx, appTemplateID)
+
+	var r0 []*model.Application
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*model.Application); ok {
+		r0 = rf(ctx, appTemplateID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Application)
+		}
+	}
+
+	var r1 map[string]model.ApplicationFromTemplateInputValues
+	if rf, ok := ret.Get(1).(func(context.Context, string) map[string]model.ApplicationFromTemplateInputValues); ok {
+		r1 = rf(ctx, appTemplateID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(map[string]model.ApplicationFromTemplateInputValues)
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string) error); ok {
+		r2 = rf(ctx, appTemplateID)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // ListByFilters provides a mock function with given fields: ctx, filter
 func (_m *ApplicationTemplateService) ListByFilters(ctx context.Context, filter []*labelfilter.LabelFilter) ([]*model.ApplicationTemplate, error) {
 	ret := _m.Called(ctx, filter)
@@ -275,6 +330,20 @@ func (_m *ApplicationTemplateService) PrepareApplicationCreateInputJSON(appTempl
 	return r0, r1
 }
 
+// StoreApplicationTemplateValues provides a mock function with given fields: ctx, appID, values
+func (_m *ApplicationTemplateService) StoreApplicationTemplateValues(ctx context.Context, appID string, values model.ApplicationFromTemplateInputValues) error {
+	ret := _m.Called(ctx, appID, values)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.ApplicationFromTemplateInputValues) error); ok {
+		r0 = rf(ctx, appID, values)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Update provides a mock function with given fields: ctx, id, in
 func (_m *ApplicationTemplateService) Update(ctx context.Context, id string, in model.ApplicationTemplateUpdateInput) error {
 	ret := _m.Called(ctx, id, in)
@@ -289,6 +358,29 @@ func (_m *ApplicationTemplateService) Update(ctx context.Context, id string, in
 	return r0
 }
 
+// UpgradeApplication provides a mock function with given fields: ctx, appID, in
+func (_m *ApplicationTemplateService) UpgradeApplication(ctx context.Context, appID string, in model.ApplicationRegisterInput) (*model.ApplicationUpgradeDiff, error) {
+	ret := _m.Called(ctx, appID, in)
+
+	var r0 *model.ApplicationUpgradeDiff
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.ApplicationRegisterInput) *model.ApplicationUpgradeDiff); ok {
+		r0 = rf(ctx, appID, in)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ApplicationUpgradeDiff)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, model.ApplicationRegisterInput) error); ok {
+		r1 = rf(ctx, appID, in)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 type mockConstructorTestingTNewApplicationTemplateService interface {
 	mock.TestingT
 	Cleanup(func())
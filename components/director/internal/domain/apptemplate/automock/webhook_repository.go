@@ -28,6 +28,20 @@ func (_m *WebhookRepository) CreateMany(ctx context.Context, tenant string, item
 	return r0
 }
 
+// Delete provides a mock function with given fields: ctx, id
+func (_m *WebhookRepository) Delete(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteAllByApplicationTemplateID provides a mock function with given fields: ctx, applicationTemplateID
 func (_m *WebhookRepository) DeleteAllByApplicationTemplateID(ctx context.Context, applicationTemplateID string) error {
 	ret := _m.Called(ctx, applicationTemplateID)
@@ -42,6 +56,29 @@ func (_m *WebhookRepository) DeleteAllByApplicationTemplateID(ctx context.Contex
 	return r0
 }
 
+// ListByReferenceObjectIDGlobal provides a mock function with given fields: ctx, objID, objType
+func (_m *WebhookRepository) ListByReferenceObjectIDGlobal(ctx context.Context, objID string, objType model.WebhookReferenceObjectType) ([]*model.Webhook, error) {
+	ret := _m.Called(ctx, objID, objType)
+
+	var r0 []*model.Webhook
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.WebhookReferenceObjectType) []*model.Webhook); ok {
+		r0 = rf(ctx, objID, objType)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Webhook)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, model.WebhookReferenceObjectType) error); ok {
+		r1 = rf(ctx, objID, objType)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 type mockConstructorTestingTNewWebhookRepository interface {
 	mock.TestingT
 	Cleanup(func())
@@ -291,11 +291,23 @@ func (c *converter) placeholdersModelToJSON(in []model.ApplicationTemplatePlaceh
 func (c *converter) placeholdersFromGraphql(in []*graphql.PlaceholderDefinitionInput) []model.ApplicationTemplatePlaceholder {
 	placeholders := make([]model.ApplicationTemplatePlaceholder, 0, len(in))
 	for _, p := range in {
+		var placeholderType *model.ApplicationTemplatePlaceholderType
+		if p.Type != nil {
+			t := model.ApplicationTemplatePlaceholderType(*p.Type)
+			placeholderType = &t
+		}
+
 		np := model.ApplicationTemplatePlaceholder{
-			Name:        p.Name,
-			Description: p.Description,
-			JSONPath:    p.JSONPath,
-			Optional:    p.Optional,
+			Name:         p.Name,
+			Description:  p.Description,
+			JSONPath:     p.JSONPath,
+			Optional:     p.Optional,
+			Type:         placeholderType,
+			EnumValues:   p.EnumValues,
+			Pattern:      p.Pattern,
+			MinLength:    p.MinLength,
+			MaxLength:    p.MaxLength,
+			DefaultValue: p.DefaultValue,
 		}
 		placeholders = append(placeholders, np)
 	}
@@ -305,11 +317,23 @@ func (c *converter) placeholdersFromGraphql(in []*graphql.PlaceholderDefinitionI
 func (c *converter) placeholdersToGraphql(in []model.ApplicationTemplatePlaceholder) []*graphql.PlaceholderDefinition {
 	placeholders := make([]*graphql.PlaceholderDefinition, 0, len(in))
 	for _, p := range in {
+		var placeholderType *graphql.PlaceholderType
+		if p.Type != nil {
+			t := graphql.PlaceholderType(*p.Type)
+			placeholderType = &t
+		}
+
 		np := graphql.PlaceholderDefinition{
-			Name:        p.Name,
-			Description: p.Description,
-			JSONPath:    p.JSONPath,
-			Optional:    p.Optional,
+			Name:         p.Name,
+			Description:  p.Description,
+			JSONPath:     p.JSONPath,
+			Optional:     p.Optional,
+			Type:         placeholderType,
+			EnumValues:   p.EnumValues,
+			Pattern:      p.Pattern,
+			MinLength:    p.MinLength,
+			MaxLength:    p.MaxLength,
+			DefaultValue: p.DefaultValue,
 		}
 		placeholders = append(placeholders, &np)
 	}
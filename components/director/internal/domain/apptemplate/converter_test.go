@@ -482,6 +482,9 @@ func TestConverter_FromEntity(t *testing.T) {
 
 	appTemplateEntity := fixEntityApplicationTemplate(t, id, name)
 	appTemplateModel := fixModelApplicationTemplate(id, name, nil)
+	enumPlaceholderType := model.EnumPlaceholderType
+	minLength := 1
+	defaultValue := "eu-1"
 
 	testCases := []struct {
 		Name               string
@@ -507,6 +510,27 @@ func TestConverter_FromEntity(t *testing.T) {
 			Expected:           nil,
 			ExpectedErrMessage: "",
 		},
+		{
+			Name: "Typed placeholders",
+			Input: &apptemplate.Entity{
+				PlaceholdersJSON: sql.NullString{
+					String: `[{"Name":"region","Type":"ENUM","EnumValues":["eu-1","us-1"],"MinLength":1,"DefaultValue":"eu-1"}]`,
+					Valid:  true,
+				},
+			},
+			Expected: &model.ApplicationTemplate{
+				Placeholders: []model.ApplicationTemplatePlaceholder{
+					{
+						Name:         "region",
+						Type:         &enumPlaceholderType,
+						EnumValues:   []string{"eu-1", "us-1"},
+						MinLength:    &minLength,
+						DefaultValue: &defaultValue,
+					},
+				},
+			},
+			ExpectedErrMessage: "",
+		},
 		{
 			Name: "PlaceholdersJSON Unmarshall Error",
 			Input: &apptemplate.Entity{
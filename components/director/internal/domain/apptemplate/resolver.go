@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"regexp"
+	"sort"
 	"strings"
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/application"
@@ -38,6 +39,8 @@ const (
 	globalSubaccountIDLabelKey = "global_subaccount_id"
 	sapProviderName            = "SAP"
 	displayNameLabelKey        = "displayName"
+
+	applicationUpgradeBatchSize = 50
 )
 
 // ApplicationTemplateService missing godoc
@@ -57,6 +60,10 @@ type ApplicationTemplateService interface {
 	PrepareApplicationCreateInputJSON(appTemplate *model.ApplicationTemplate, values model.ApplicationFromTemplateInputValues) (string, error)
 	ListLabels(ctx context.Context, appTemplateID string) (map[string]*model.Label, error)
 	GetLabel(ctx context.Context, appTemplateID string, key string) (*model.Label, error)
+	StoreApplicationTemplateValues(ctx context.Context, appID string, values model.ApplicationFromTemplateInputValues) error
+	ListApplicationsWithTemplateValues(ctx context.Context, appTemplateID string) ([]*model.Application, map[string]model.ApplicationFromTemplateInputValues, error)
+	DiffApplication(ctx context.Context, app *model.Application, in model.ApplicationRegisterInput) (*model.ApplicationUpgradeDiff, error)
+	UpgradeApplication(ctx context.Context, appID string, in model.ApplicationRegisterInput) (*model.ApplicationUpgradeDiff, error)
 }
 
 // ApplicationTemplateConverter missing godoc
@@ -443,6 +450,10 @@ func (r *Resolver) RegisterApplicationFromTemplate(ctx context.Context, in graph
 	}
 	log.C(ctx).Infof("Application with name %s and id %s successfully created from Application Template with name %s", applicationName, id, in.TemplateName)
 
+	if err := r.appTemplateSvc.StoreApplicationTemplateValues(ctx, id, convertedIn.Values); err != nil {
+		return nil, err
+	}
+
 	app, err := r.appSvc.Get(ctx, id)
 	if err != nil {
 		return nil, err
@@ -626,6 +637,176 @@ func (r *Resolver) Webhooks(ctx context.Context, obj *graphql.ApplicationTemplat
 	return r.webhookConverter.MultipleToGraphQL(webhooks)
 }
 
+// UpgradeApplicationsFromTemplate re-renders the applications created from the given Application Template with the placeholder values they were created with
+// and applies the resulting changes in batches. Each batch is applied in a separate transaction - if an application of a batch fails, the whole batch is rolled back.
+func (r *Resolver) UpgradeApplicationsFromTemplate(ctx context.Context, templateID string, dryRun *bool) (*graphql.ApplicationTemplateUpgradeReport, error) {
+	isDryRun := dryRun != nil && *dryRun
+
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	appTemplate, err := r.appTemplateSvc.Get(ctx, templateID)
+	if err != nil {
+		return nil, err
+	}
+
+	apps, values, err := r.appTemplateSvc.ListApplicationsWithTemplateValues(ctx, templateID)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*graphql.ApplicationUpgradeResult, 0, len(apps))
+	pending := make([]*applicationUpgrade, 0, len(apps))
+	for _, app := range apps {
+		appValues, ok := values[app.ID]
+		if !ok {
+			log.C(ctx).Infof("Skipping upgrade of application with id %s as it has no stored placeholder values", app.ID)
+			results = append(results, newApplicationUpgradeResult(app.ID, graphql.ApplicationUpgradeStatusSkipped, nil, str.Ptr("application has no stored placeholder values")))
+			continue
+		}
+
+		diff, err := r.diffApplication(ctx, appTemplate, app, appValues)
+		if err != nil {
+			log.C(ctx).WithError(err).Errorf("An error occurred while computing the upgrade of application with id %s", app.ID)
+			results = append(results, newApplicationUpgradeResult(app.ID, graphql.ApplicationUpgradeStatusFailed, nil, str.Ptr(err.Error())))
+			continue
+		}
+
+		if diff.IsEmpty() {
+			results = append(results, newApplicationUpgradeResult(app.ID, graphql.ApplicationUpgradeStatusUpToDate, nil, nil))
+			continue
+		}
+
+		upgrade := &applicationUpgrade{appID: app.ID, input: diff.Input, result: newApplicationUpgradeResult(app.ID, graphql.ApplicationUpgradeStatusUpgradeAvailable, diff, nil)}
+		results = append(results, upgrade.result)
+		pending = append(pending, upgrade)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	if !isDryRun {
+		for start := 0; start < len(pending); start += applicationUpgradeBatchSize {
+			end := start + applicationUpgradeBatchSize
+			if end > len(pending) {
+				end = len(pending)
+			}
+			r.upgradeApplicationsBatch(ctx, pending[start:end])
+		}
+	}
+
+	return &graphql.ApplicationTemplateUpgradeReport{
+		TemplateID: templateID,
+		DryRun:     isDryRun,
+		Results:    results,
+	}, nil
+}
+
+type applicationUpgrade struct {
+	appID  string
+	input  model.ApplicationRegisterInput
+	result *graphql.ApplicationUpgradeResult
+}
+
+func (r *Resolver) diffApplication(ctx context.Context, appTemplate *model.ApplicationTemplate, app *model.Application, values model.ApplicationFromTemplateInputValues) (*model.ApplicationUpgradeDiff, error) {
+	appCreateInputJSON, err := r.appTemplateSvc.PrepareApplicationCreateInputJSON(appTemplate, values)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while preparing ApplicationCreateInput JSON from Application Template with name %s", appTemplate.Name)
+	}
+
+	appCreateInputGQL, err := r.appConverter.CreateRegisterInputJSONToGQL(appCreateInputJSON)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while converting ApplicationCreateInput JSON to GraphQL ApplicationRegistrationInput from Application Template with name %s", appTemplate.Name)
+	}
+
+	if err := inputvalidation.Validate(appCreateInputGQL); err != nil {
+		return nil, errors.Wrapf(err, "while validating application input from Application Template with name %s", appTemplate.Name)
+	}
+
+	appCreateInputModel, err := r.appConverter.CreateInputFromGraphQL(ctx, appCreateInputGQL)
+	if err != nil {
+		return nil, errors.Wrap(err, "while converting ApplicationFromTemplate input")
+	}
+
+	return r.appTemplateSvc.DiffApplication(ctx, app, appCreateInputModel)
+}
+
+func (r *Resolver) upgradeApplicationsBatch(ctx context.Context, batch []*applicationUpgrade) {
+	markFailed := func(message string) {
+		for _, upgrade := range batch {
+			upgrade.result.Status = graphql.ApplicationUpgradeStatusFailed
+			upgrade.result.Message = str.Ptr(message)
+		}
+	}
+
+	tx, err := r.transact.Begin()
+	if err != nil {
+		markFailed(err.Error())
+		return
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	appliedDiffs := make([]*model.ApplicationUpgradeDiff, 0, len(batch))
+	for _, upgrade := range batch {
+		log.C(ctx).Infof("Upgrading application with id %s from its Application Template", upgrade.appID)
+		diff, err := r.appTemplateSvc.UpgradeApplication(ctx, upgrade.appID, upgrade.input)
+		if err != nil {
+			log.C(ctx).WithError(err).Errorf("An error occurred while upgrading application with id %s", upgrade.appID)
+			markFailed(fmt.Sprintf("batch rolled back due to failed upgrade of application with id %s: %s", upgrade.appID, err.Error()))
+			upgrade.result.Message = str.Ptr(err.Error())
+			return
+		}
+		appliedDiffs = append(appliedDiffs, diff)
+	}
+
+	if err := tx.Commit(); err != nil {
+		markFailed(err.Error())
+		return
+	}
+
+	// the results report the diffs computed under the lock, which may differ from the planned ones if the applications changed meanwhile
+	for i, upgrade := range batch {
+		status := graphql.ApplicationUpgradeStatusUpgraded
+		if appliedDiffs[i].IsEmpty() {
+			status = graphql.ApplicationUpgradeStatusUpToDate
+		}
+		*upgrade.result = *newApplicationUpgradeResult(upgrade.appID, status, appliedDiffs[i], nil)
+	}
+}
+
+func newApplicationUpgradeResult(appID string, status graphql.ApplicationUpgradeStatus, diff *model.ApplicationUpgradeDiff, message *string) *graphql.ApplicationUpgradeResult {
+	result := &graphql.ApplicationUpgradeResult{
+		ApplicationID:   appID,
+		Status:          status,
+		ChangedFields:   []string{},
+		ChangedLabels:   []string{},
+		ChangedWebhooks: []graphql.WebhookType{},
+		Message:         message,
+	}
+	if diff == nil {
+		return result
+	}
+
+	result.ChangedFields = append(result.ChangedFields, diff.Fields...)
+	for key := range diff.Labels {
+		result.ChangedLabels = append(result.ChangedLabels, key)
+	}
+	sort.Strings(result.ChangedLabels)
+	for _, wh := range diff.Webhooks {
+		result.ChangedWebhooks = append(result.ChangedWebhooks, graphql.WebhookType(wh.Type))
+	}
+
+	return result
+}
+
 func extractApplicationNameFromTemplateInput(applicationInputJSON string) (string, error) {
 	b := []byte(applicationInputJSON)
 	data := make(map[string]interface{})
@@ -1303,6 +1303,7 @@ func TestResolver_RegisterApplicationFromTemplate(t *testing.T) {
 				appTemplateSvc.On("ListByName", txtest.CtxWithDBMatcher(), testName).Return([]*model.ApplicationTemplate{modelAppTemplate}, nil).Once()
 				appTemplateSvc.On("GetLabel", txtest.CtxWithDBMatcher(), testID, globalSubaccountIDLabelKey).Return(nil, apperrors.NewNotFoundError(resource.Label, "id")).Once()
 				appTemplateSvc.On("PrepareApplicationCreateInputJSON", modelAppTemplate, modelAppFromTemplateInput.Values).Return(jsonAppCreateInput, nil).Once()
+				appTemplateSvc.On("StoreApplicationTemplateValues", txtest.CtxWithDBMatcher(), testID, modelAppFromTemplateInput.Values).Return(nil).Once()
 				return appTemplateSvc
 			},
 			AppTemplateConvFn: func() *automock.ApplicationTemplateConverter {
@@ -1339,6 +1340,7 @@ func TestResolver_RegisterApplicationFromTemplate(t *testing.T) {
 				appTemplateSvc.On("GetLabel", txtest.CtxWithDBMatcher(), customID, globalSubaccountIDLabelKey).Return(nil, apperrors.NewNotFoundError(resource.Label, "id")).Once()
 				appTemplateSvc.On("GetLabel", txtest.CtxWithDBMatcher(), testID, globalSubaccountIDLabelKey).Return(nil, apperrors.NewNotFoundError(resource.Label, "id")).Once()
 				appTemplateSvc.On("PrepareApplicationCreateInputJSON", modelAppTemplateCustomID, modelAppFromTemplateWithIDInput.Values).Return(jsonAppCreateInput, nil).Once()
+				appTemplateSvc.On("StoreApplicationTemplateValues", txtest.CtxWithDBMatcher(), testID, modelAppFromTemplateWithIDInput.Values).Return(nil).Once()
 				return appTemplateSvc
 			},
 			AppTemplateConvFn: func() *automock.ApplicationTemplateConverter {
@@ -1374,6 +1376,7 @@ func TestResolver_RegisterApplicationFromTemplate(t *testing.T) {
 				appTemplateSvc.On("ListByName", txtest.CtxWithDBMatcher(), testName).Return([]*model.ApplicationTemplate{modelAppTemplate}, nil).Once()
 				appTemplateSvc.On("GetLabel", txtest.CtxWithDBMatcher(), testID, globalSubaccountIDLabelKey).Return(nil, apperrors.NewNotFoundError(resource.Label, "id")).Once()
 				appTemplateSvc.On("PrepareApplicationCreateInputJSON", modelAppTemplate, modelAppFromTemplateInput.Values).Return(jsonAppCreateInput, nil).Once()
+				appTemplateSvc.On("StoreApplicationTemplateValues", txtest.CtxWithDBMatcher(), testID, modelAppFromTemplateInput.Values).Return(nil).Once()
 				return appTemplateSvc
 			},
 			AppTemplateConvFn: func() *automock.ApplicationTemplateConverter {
@@ -1623,6 +1626,38 @@ func TestResolver_RegisterApplicationFromTemplate(t *testing.T) {
 			ExpectedOutput: nil,
 			ExpectedError:  testError,
 		},
+		{
+			Name:                 "Returns error when storing placeholder values fails",
+			AppFromTemplateInput: gqlAppFromTemplateInput,
+			TxFn:                 txGen.ThatDoesntExpectCommit,
+			AppTemplateSvcFn: func() *automock.ApplicationTemplateService {
+				appTemplateSvc := &automock.ApplicationTemplateService{}
+				appTemplateSvc.On("ListByFilters", txtest.CtxWithDBMatcher(), filters).Return([]*model.ApplicationTemplate{modelAppTemplate}, nil).Once()
+				appTemplateSvc.On("PrepareApplicationCreateInputJSON", modelAppTemplate, modelAppFromTemplateInput.Values).Return(jsonAppCreateInput, nil).Once()
+				appTemplateSvc.On("StoreApplicationTemplateValues", txtest.CtxWithDBMatcher(), testID, modelAppFromTemplateInput.Values).Return(testError).Once()
+				return appTemplateSvc
+			},
+			AppTemplateConvFn: func() *automock.ApplicationTemplateConverter {
+				appTemplateConv := &automock.ApplicationTemplateConverter{}
+				appTemplateConv.On("ApplicationFromTemplateInputFromGraphQL", modelAppTemplate, gqlAppFromTemplateInput).Return(modelAppFromTemplateInput, nil).Once()
+				return appTemplateConv
+			},
+			AppSvcFn: func() *automock.ApplicationService {
+				appSvc := &automock.ApplicationService{}
+				appSvc.On("CreateFromTemplate", txtest.CtxWithDBMatcher(), modelAppWithLabelCreateInput, str.Ptr(testID)).Return(testID, nil).Once()
+				return appSvc
+			},
+			AppConvFn: func() *automock.ApplicationConverter {
+				appConv := &automock.ApplicationConverter{}
+				appConv.On("CreateInputFromGraphQL", mock.Anything, gqlAppCreateInput).Return(modelAppCreateInput, nil).Once()
+				appConv.On("CreateRegisterInputJSONToGQL", jsonAppCreateInput).Return(gqlAppCreateInput, nil).Once()
+				return appConv
+			},
+			WebhookConvFn:  UnusedWebhookConv,
+			WebhookSvcFn:   UnusedWebhookSvc,
+			ExpectedOutput: nil,
+			ExpectedError:  testError,
+		},
 		{
 			Name:                 "Returns error when getting Application fails",
 			AppFromTemplateInput: gqlAppFromTemplateInput,
@@ -1631,6 +1666,7 @@ func TestResolver_RegisterApplicationFromTemplate(t *testing.T) {
 				appTemplateSvc := &automock.ApplicationTemplateService{}
 				appTemplateSvc.On("ListByFilters", txtest.CtxWithDBMatcher(), filters).Return([]*model.ApplicationTemplate{modelAppTemplate}, nil).Once()
 				appTemplateSvc.On("PrepareApplicationCreateInputJSON", modelAppTemplate, modelAppFromTemplateInput.Values).Return(jsonAppCreateInput, nil).Once()
+				appTemplateSvc.On("StoreApplicationTemplateValues", txtest.CtxWithDBMatcher(), testID, modelAppFromTemplateInput.Values).Return(nil).Once()
 				return appTemplateSvc
 			},
 			AppTemplateConvFn: func() *automock.ApplicationTemplateConverter {
@@ -1663,6 +1699,7 @@ func TestResolver_RegisterApplicationFromTemplate(t *testing.T) {
 				appTemplateSvc := &automock.ApplicationTemplateService{}
 				appTemplateSvc.On("ListByFilters", txtest.CtxWithDBMatcher(), filters).Return([]*model.ApplicationTemplate{modelAppTemplate}, nil).Once()
 				appTemplateSvc.On("PrepareApplicationCreateInputJSON", modelAppTemplate, modelAppFromTemplateInput.Values).Return(jsonAppCreateInput, nil).Once()
+				appTemplateSvc.On("StoreApplicationTemplateValues", txtest.CtxWithDBMatcher(), testID, modelAppFromTemplateInput.Values).Return(nil).Once()
 				return appTemplateSvc
 			},
 			AppTemplateConvFn: func() *automock.ApplicationTemplateConverter {
@@ -2154,6 +2191,245 @@ func TestResolver_UpdateApplicationTemplate(t *testing.T) {
 	})
 }
 
+func TestResolver_UpgradeApplicationsFromTemplate(t *testing.T) {
+	// GIVEN
+	ctx := context.TODO()
+	txGen := txtest.NewTransactionContextGenerator(testError)
+	dryRun := true
+
+	jsonAppCreateInput := fixJSONApplicationCreateInput(testName)
+	modelAppCreateInput := fixModelApplicationCreateInput(testName)
+	gqlAppCreateInput := fixGQLApplicationCreateInput(testName)
+	modelAppTemplate := fixModelAppTemplateWithAppInputJSON(testID, testName, jsonAppCreateInput, nil)
+
+	outdatedAppID := "outdated-app-id"
+	secondOutdatedAppID := "second-outdated-app-id"
+	upToDateAppID := "up-to-date-app-id"
+	outdatedApp := fixModelApplication(outdatedAppID, testName)
+	secondOutdatedApp := fixModelApplication(secondOutdatedAppID, testName)
+	upToDateApp := fixModelApplication(upToDateAppID, testName)
+	appWithoutValues := fixModelApplication(testAppID, testName)
+
+	values := model.ApplicationFromTemplateInputValues{{Placeholder: "name", Value: testName}}
+	diff := &model.ApplicationUpgradeDiff{
+		Input:    modelAppCreateInput,
+		Fields:   []string{"description"},
+		Labels:   map[string]interface{}{"newLabel": "value"},
+		Webhooks: []*model.WebhookInput{{Type: model.WebhookTypeConfigurationChanged, URL: str.Ptr("https://new.webhook")}},
+	}
+	emptyDiff := &model.ApplicationUpgradeDiff{Input: modelAppCreateInput, Labels: map[string]interface{}{}}
+
+	fixResult := func(appID string, status graphql.ApplicationUpgradeStatus, withChanges bool, message *string) *graphql.ApplicationUpgradeResult {
+		result := &graphql.ApplicationUpgradeResult{
+			ApplicationID:   appID,
+			Status:          status,
+			ChangedFields:   []string{},
+			ChangedLabels:   []string{},
+			ChangedWebhooks: []graphql.WebhookType{},
+			Message:         message,
+		}
+		if withChanges {
+			result.ChangedFields = []string{"description"}
+			result.ChangedLabels = []string{"newLabel"}
+			result.ChangedWebhooks = []graphql.WebhookType{graphql.WebhookTypeConfigurationChanged}
+		}
+		return result
+	}
+
+	appConvThatRenders := func(times int) func() *automock.ApplicationConverter {
+		return func() *automock.ApplicationConverter {
+			appConv := &automock.ApplicationConverter{}
+			appConv.On("CreateRegisterInputJSONToGQL", jsonAppCreateInput).Return(gqlAppCreateInput, nil).Times(times)
+			appConv.On("CreateInputFromGraphQL", txtest.CtxWithDBMatcher(), gqlAppCreateInput).Return(modelAppCreateInput, nil).Times(times)
+			return appConv
+		}
+	}
+
+	testCases := []struct {
+		Name             string
+		DryRun           *bool
+		TxFn             func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		AppTemplateSvcFn func() *automock.ApplicationTemplateService
+		AppConvFn        func() *automock.ApplicationConverter
+		ExpectedOutput   *graphql.ApplicationTemplateUpgradeReport
+		ExpectedError    error
+	}{
+		{
+			Name: "Success",
+			TxFn: txGen.ThatSucceedsTwice,
+			AppTemplateSvcFn: func() *automock.ApplicationTemplateService {
+				appTemplateSvc := &automock.ApplicationTemplateService{}
+				appTemplateSvc.On("Get", txtest.CtxWithDBMatcher(), testID).Return(modelAppTemplate, nil).Once()
+				appTemplateSvc.On("ListApplicationsWithTemplateValues", txtest.CtxWithDBMatcher(), testID).Return([]*model.Application{&outdatedApp, &appWithoutValues, &upToDateApp}, map[string]model.ApplicationFromTemplateInputValues{outdatedAppID: values, upToDateAppID: values}, nil).Once()
+				appTemplateSvc.On("PrepareApplicationCreateInputJSON", modelAppTemplate, values).Return(jsonAppCreateInput, nil).Twice()
+				appTemplateSvc.On("DiffApplication", txtest.CtxWithDBMatcher(), &outdatedApp, modelAppCreateInput).Return(diff, nil).Once()
+				appTemplateSvc.On("DiffApplication", txtest.CtxWithDBMatcher(), &upToDateApp, modelAppCreateInput).Return(emptyDiff, nil).Once()
+				appTemplateSvc.On("UpgradeApplication", txtest.CtxWithDBMatcher(), outdatedAppID, modelAppCreateInput).Return(diff, nil).Once()
+				return appTemplateSvc
+			},
+			AppConvFn: appConvThatRenders(2),
+			ExpectedOutput: &graphql.ApplicationTemplateUpgradeReport{
+				TemplateID: testID,
+				Results: []*graphql.ApplicationUpgradeResult{
+					fixResult(outdatedAppID, graphql.ApplicationUpgradeStatusUpgraded, true, nil),
+					fixResult(testAppID, graphql.ApplicationUpgradeStatusSkipped, false, str.Ptr("application has no stored placeholder values")),
+					fixResult(upToDateAppID, graphql.ApplicationUpgradeStatusUpToDate, false, nil),
+				},
+			},
+		},
+		{
+			Name: "Success when application is brought up to date before it is locked",
+			TxFn: txGen.ThatSucceedsTwice,
+			AppTemplateSvcFn: func() *automock.ApplicationTemplateService {
+				appTemplateSvc := &automock.ApplicationTemplateService{}
+				appTemplateSvc.On("Get", txtest.CtxWithDBMatcher(), testID).Return(modelAppTemplate, nil).Once()
+				appTemplateSvc.On("ListApplicationsWithTemplateValues", txtest.CtxWithDBMatcher(), testID).Return([]*model.Application{&outdatedApp}, map[string]model.ApplicationFromTemplateInputValues{outdatedAppID: values}, nil).Once()
+				appTemplateSvc.On("PrepareApplicationCreateInputJSON", modelAppTemplate, values).Return(jsonAppCreateInput, nil).Once()
+				appTemplateSvc.On("DiffApplication", txtest.CtxWithDBMatcher(), &outdatedApp, modelAppCreateInput).Return(diff, nil).Once()
+				appTemplateSvc.On("UpgradeApplication", txtest.CtxWithDBMatcher(), outdatedAppID, modelAppCreateInput).Return(emptyDiff, nil).Once()
+				return appTemplateSvc
+			},
+			AppConvFn: appConvThatRenders(1),
+			ExpectedOutput: &graphql.ApplicationTemplateUpgradeReport{
+				TemplateID: testID,
+				Results: []*graphql.ApplicationUpgradeResult{
+					fixResult(outdatedAppID, graphql.ApplicationUpgradeStatusUpToDate, false, nil),
+				},
+			},
+		},
+		{
+			Name:   "Success with dry run",
+			DryRun: &dryRun,
+			TxFn:   txGen.ThatSucceeds,
+			AppTemplateSvcFn: func() *automock.ApplicationTemplateService {
+				appTemplateSvc := &automock.ApplicationTemplateService{}
+				appTemplateSvc.On("Get", txtest.CtxWithDBMatcher(), testID).Return(modelAppTemplate, nil).Once()
+				appTemplateSvc.On("ListApplicationsWithTemplateValues", txtest.CtxWithDBMatcher(), testID).Return([]*model.Application{&outdatedApp}, map[string]model.ApplicationFromTemplateInputValues{outdatedAppID: values}, nil).Once()
+				appTemplateSvc.On("PrepareApplicationCreateInputJSON", modelAppTemplate, values).Return(jsonAppCreateInput, nil).Once()
+				appTemplateSvc.On("DiffApplication", txtest.CtxWithDBMatcher(), &outdatedApp, modelAppCreateInput).Return(diff, nil).Once()
+				return appTemplateSvc
+			},
+			AppConvFn: appConvThatRenders(1),
+			ExpectedOutput: &graphql.ApplicationTemplateUpgradeReport{
+				TemplateID: testID,
+				DryRun:     true,
+				Results: []*graphql.ApplicationUpgradeResult{
+					fixResult(outdatedAppID, graphql.ApplicationUpgradeStatusUpgradeAvailable, true, nil),
+				},
+			},
+		},
+		{
+			Name: "Reports application as failed when rendering it fails",
+			TxFn: txGen.ThatSucceeds,
+			AppTemplateSvcFn: func() *automock.ApplicationTemplateService {
+				appTemplateSvc := &automock.ApplicationTemplateService{}
+				appTemplateSvc.On("Get", txtest.CtxWithDBMatcher(), testID).Return(modelAppTemplate, nil).Once()
+				appTemplateSvc.On("ListApplicationsWithTemplateValues", txtest.CtxWithDBMatcher(), testID).Return([]*model.Application{&outdatedApp}, map[string]model.ApplicationFromTemplateInputValues{outdatedAppID: values}, nil).Once()
+				appTemplateSvc.On("PrepareApplicationCreateInputJSON", modelAppTemplate, values).Return("", testError).Once()
+				return appTemplateSvc
+			},
+			AppConvFn: UnusedAppConv,
+			ExpectedOutput: &graphql.ApplicationTemplateUpgradeReport{
+				TemplateID: testID,
+				Results: []*graphql.ApplicationUpgradeResult{
+					fixResult(outdatedAppID, graphql.ApplicationUpgradeStatusFailed, false, str.Ptr("while preparing ApplicationCreateInput JSON from Application Template with name bar: test error")),
+				},
+			},
+		},
+		{
+			Name: "Reports the whole batch as failed when upgrading an application fails",
+			TxFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimesAndCommitsMultipleTimes(2, 1)
+			},
+			AppTemplateSvcFn: func() *automock.ApplicationTemplateService {
+				appTemplateSvc := &automock.ApplicationTemplateService{}
+				appTemplateSvc.On("Get", txtest.CtxWithDBMatcher(), testID).Return(modelAppTemplate, nil).Once()
+				appTemplateSvc.On("ListApplicationsWithTemplateValues", txtest.CtxWithDBMatcher(), testID).Return([]*model.Application{&outdatedApp, &secondOutdatedApp}, map[string]model.ApplicationFromTemplateInputValues{outdatedAppID: values, secondOutdatedAppID: values}, nil).Once()
+				appTemplateSvc.On("PrepareApplicationCreateInputJSON", modelAppTemplate, values).Return(jsonAppCreateInput, nil).Twice()
+				appTemplateSvc.On("DiffApplication", txtest.CtxWithDBMatcher(), &outdatedApp, modelAppCreateInput).Return(diff, nil).Once()
+				appTemplateSvc.On("DiffApplication", txtest.CtxWithDBMatcher(), &secondOutdatedApp, modelAppCreateInput).Return(diff, nil).Once()
+				appTemplateSvc.On("UpgradeApplication", txtest.CtxWithDBMatcher(), outdatedAppID, modelAppCreateInput).Return(diff, nil).Once()
+				appTemplateSvc.On("UpgradeApplication", txtest.CtxWithDBMatcher(), secondOutdatedAppID, modelAppCreateInput).Return(nil, testError).Once()
+				return appTemplateSvc
+			},
+			AppConvFn: appConvThatRenders(2),
+			ExpectedOutput: &graphql.ApplicationTemplateUpgradeReport{
+				TemplateID: testID,
+				Results: []*graphql.ApplicationUpgradeResult{
+					fixResult(outdatedAppID, graphql.ApplicationUpgradeStatusFailed, true, str.Ptr("batch rolled back due to failed upgrade of application with id second-outdated-app-id: test error")),
+					fixResult(secondOutdatedAppID, graphql.ApplicationUpgradeStatusFailed, true, str.Ptr(testError.Error())),
+				},
+			},
+		},
+		{
+			Name: "Returns error when getting application template fails",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			AppTemplateSvcFn: func() *automock.ApplicationTemplateService {
+				appTemplateSvc := &automock.ApplicationTemplateService{}
+				appTemplateSvc.On("Get", txtest.CtxWithDBMatcher(), testID).Return(nil, testError).Once()
+				return appTemplateSvc
+			},
+			AppConvFn:     UnusedAppConv,
+			ExpectedError: testError,
+		},
+		{
+			Name: "Returns error when listing applications fails",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			AppTemplateSvcFn: func() *automock.ApplicationTemplateService {
+				appTemplateSvc := &automock.ApplicationTemplateService{}
+				appTemplateSvc.On("Get", txtest.CtxWithDBMatcher(), testID).Return(modelAppTemplate, nil).Once()
+				appTemplateSvc.On("ListApplicationsWithTemplateValues", txtest.CtxWithDBMatcher(), testID).Return(nil, nil, testError).Once()
+				return appTemplateSvc
+			},
+			AppConvFn:     UnusedAppConv,
+			ExpectedError: testError,
+		},
+		{
+			Name:             "Returns error when beginning transaction fails",
+			TxFn:             txGen.ThatFailsOnBegin,
+			AppTemplateSvcFn: UnusedAppTemplateSvc,
+			AppConvFn:        UnusedAppConv,
+			ExpectedError:    testError,
+		},
+		{
+			Name: "Returns error when committing transaction fails",
+			TxFn: txGen.ThatFailsOnCommit,
+			AppTemplateSvcFn: func() *automock.ApplicationTemplateService {
+				appTemplateSvc := &automock.ApplicationTemplateService{}
+				appTemplateSvc.On("Get", txtest.CtxWithDBMatcher(), testID).Return(modelAppTemplate, nil).Once()
+				appTemplateSvc.On("ListApplicationsWithTemplateValues", txtest.CtxWithDBMatcher(), testID).Return([]*model.Application{}, map[string]model.ApplicationFromTemplateInputValues{}, nil).Once()
+				return appTemplateSvc
+			},
+			AppConvFn:     UnusedAppConv,
+			ExpectedError: testError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TxFn()
+			appTemplateSvc := testCase.AppTemplateSvcFn()
+			appConv := testCase.AppConvFn()
+
+			resolver := apptemplate.NewResolver(transact, nil, appConv, appTemplateSvc, nil, nil, nil, nil, nil, nil, "")
+
+			// WHEN
+			result, err := resolver.UpgradeApplicationsFromTemplate(ctx, testID, testCase.DryRun)
+
+			// THEN
+			if testCase.ExpectedError != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedError.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, testCase.ExpectedOutput, result)
+
+			mock.AssertExpectationsForObjects(t, persist, transact, appTemplateSvc, appConv)
+		})
+	}
+}
+
 func TestResolver_DeleteApplicationTemplate(t *testing.T) {
 	// GIVEN
 	ctx := tenant.SaveToContext(context.TODO(), testTenant, testExternalTenant)
@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"regexp"
+	"sort"
 	"strings"
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
@@ -12,6 +14,8 @@ import (
 
 	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
 
+	"github.com/kyma-incubator/compass/components/director/pkg/encryption"
+	"github.com/kyma-incubator/compass/components/director/pkg/inputvalidation"
 	"github.com/kyma-incubator/compass/components/director/pkg/str"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
@@ -28,6 +32,8 @@ const otherSystemType = "Other System Type"
 const providerSAP = "SAP"
 const labelsKey = "labels"
 
+var placeholderRegex = regexp.MustCompile(`{{([^{}]+)}}`)
+
 // ApplicationTemplateRepository missing godoc
 //
 //go:generate mockery --name=ApplicationTemplateRepository --output=automock --outpkg=automock --case=underscore --disable-version-string
@@ -55,6 +61,8 @@ type UIDService interface {
 //go:generate mockery --name=WebhookRepository --output=automock --outpkg=automock --case=underscore --disable-version-string
 type WebhookRepository interface {
 	CreateMany(ctx context.Context, tenant string, items []*model.Webhook) error
+	ListByReferenceObjectIDGlobal(ctx context.Context, objID string, objType model.WebhookReferenceObjectType) ([]*model.Webhook, error)
+	Delete(ctx context.Context, id string) error
 	DeleteAllByApplicationTemplateID(ctx context.Context, applicationTemplateID string) error
 }
 
@@ -86,26 +94,32 @@ type LabelRepository interface {
 //go:generate mockery --name=ApplicationRepository --output=automock --outpkg=automock --case=underscore --disable-version-string
 type ApplicationRepository interface {
 	ListAllByApplicationTemplateID(ctx context.Context, applicationTemplateID string) ([]*model.Application, error)
+	ListTemplateValuesByApplicationTemplateID(ctx context.Context, applicationTemplateID string) (map[string]json.RawMessage, error)
+	GetGlobalByIDForUpdate(ctx context.Context, id string) (*model.Application, error)
+	TechnicalUpdate(ctx context.Context, item *model.Application) error
+	UpdateTemplateValuesGlobal(ctx context.Context, id string, values json.RawMessage) error
 }
 
 type service struct {
-	appTemplateRepo    ApplicationTemplateRepository
-	webhookRepo        WebhookRepository
-	uidService         UIDService
-	labelUpsertService LabelUpsertService
-	labelRepo          LabelRepository
-	appRepo            ApplicationRepository
+	appTemplateRepo      ApplicationTemplateRepository
+	webhookRepo          WebhookRepository
+	uidService           UIDService
+	labelUpsertService   LabelUpsertService
+	labelRepo            LabelRepository
+	appRepo              ApplicationRepository
+	credentialsEncryptor encryption.Encryptor
 }
 
 // NewService missing godoc
-func NewService(appTemplateRepo ApplicationTemplateRepository, webhookRepo WebhookRepository, uidService UIDService, labelUpsertService LabelUpsertService, labelRepo LabelRepository, appRepo ApplicationRepository) *service {
+func NewService(appTemplateRepo ApplicationTemplateRepository, webhookRepo WebhookRepository, uidService UIDService, labelUpsertService LabelUpsertService, labelRepo LabelRepository, appRepo ApplicationRepository, credentialsEncryptor encryption.Encryptor) *service {
 	return &service{
-		appTemplateRepo:    appTemplateRepo,
-		webhookRepo:        webhookRepo,
-		uidService:         uidService,
-		labelUpsertService: labelUpsertService,
-		labelRepo:          labelRepo,
-		appRepo:            appRepo,
+		appTemplateRepo:      appTemplateRepo,
+		webhookRepo:          webhookRepo,
+		uidService:           uidService,
+		labelUpsertService:   labelUpsertService,
+		labelRepo:            labelRepo,
+		appRepo:              appRepo,
+		credentialsEncryptor: credentialsEncryptor,
 	}
 }
 
@@ -374,47 +388,264 @@ func (s *service) Delete(ctx context.Context, id string) error {
 	return nil
 }
 
-// PrepareApplicationCreateInputJSON missing godoc
-func (s *service) PrepareApplicationCreateInputJSON(appTemplate *model.ApplicationTemplate, values model.ApplicationFromTemplateInputValues) (string, error) {
-	appCreateInputJSON := appTemplate.ApplicationInputJSON
-	for _, placeholder := range appTemplate.Placeholders {
-		newValue, err := values.FindPlaceholderValue(placeholder.Name)
-		isOptional := false
-		if placeholder.Optional != nil {
-			isOptional = *placeholder.Optional
+// StoreApplicationTemplateValues stores the placeholder values the application with the given ID was created with,
+// so that the application can later be re-rendered from its Application Template.
+// The values are encrypted like the other stored credentials, as placeholders may be used to pass credentials of the application.
+func (s *service) StoreApplicationTemplateValues(ctx context.Context, appID string, values model.ApplicationFromTemplateInputValues) error {
+	values, err := transformTemplateValues(values, s.credentialsEncryptor.Encrypt)
+	if err != nil {
+		return errors.Wrapf(err, "while encrypting placeholder values of application with id %s", appID)
+	}
+
+	valuesJSON, err := json.Marshal(values)
+	if err != nil {
+		return errors.Wrapf(err, "while marshalling placeholder values of application with id %s", appID)
+	}
+
+	if err := s.appRepo.UpdateTemplateValuesGlobal(ctx, appID, valuesJSON); err != nil {
+		return errors.Wrapf(err, "while storing placeholder values of application with id %s", appID)
+	}
+
+	return nil
+}
+
+// ListApplicationsWithTemplateValues lists the applications created from the given Application Template along with the placeholder values they were created with.
+// Applications without stored placeholder values are not present in the returned map.
+func (s *service) ListApplicationsWithTemplateValues(ctx context.Context, appTemplateID string) ([]*model.Application, map[string]model.ApplicationFromTemplateInputValues, error) {
+	apps, err := s.appRepo.ListAllByApplicationTemplateID(ctx, appTemplateID)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "while listing applications for app template with id %s", appTemplateID)
+	}
+
+	storedValues, err := s.appRepo.ListTemplateValuesByApplicationTemplateID(ctx, appTemplateID)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "while listing placeholder values of applications for app template with id %s", appTemplateID)
+	}
+
+	values := make(map[string]model.ApplicationFromTemplateInputValues, len(storedValues))
+	for appID, valuesJSON := range storedValues {
+		var appValues model.ApplicationFromTemplateInputValues
+		if err := json.Unmarshal(valuesJSON, &appValues); err != nil {
+			return nil, nil, errors.Wrapf(err, "while unmarshalling placeholder values of application with id %s", appID)
+		}
+		if values[appID], err = transformTemplateValues(appValues, s.credentialsEncryptor.Decrypt); err != nil {
+			return nil, nil, errors.Wrapf(err, "while decrypting placeholder values of application with id %s", appID)
 		}
+	}
+
+	return apps, values, nil
+}
+
+// DiffApplication computes the changes needed to bring the given application in line with the ApplicationRegisterInput rendered from its Application Template.
+// Only fields, labels and webhooks defined by the rendered input are considered - nothing is removed from the application.
+func (s *service) DiffApplication(ctx context.Context, app *model.Application, in model.ApplicationRegisterInput) (*model.ApplicationUpgradeDiff, error) {
+	diff := &model.ApplicationUpgradeDiff{
+		Input:  in,
+		Labels: make(map[string]interface{}),
+	}
+
+	diff.Fields = diffApplicationFields(app, in)
+
+	labels, err := s.labelRepo.ListForGlobalObject(ctx, model.ApplicationLabelableObject, app.ID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while listing labels of application with id %s", app.ID)
+	}
 
-		if err != nil && !isOptional {
-			return "", errors.Wrap(err, "required placeholder not provided")
+	for key, value := range in.Labels {
+		if key == model.ScenariosKey {
+			continue
 		}
+		if current, ok := labels[key]; ok && labelValuesEqual(current.Value, value) {
+			continue
+		}
+		diff.Labels[key] = value
+	}
 
-		err = validatePlaceholderValue(placeholder, newValue)
+	webhooks, err := s.webhookRepo.ListByReferenceObjectIDGlobal(ctx, app.ID, model.ApplicationWebhookReference)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while listing webhooks of application with id %s", app.ID)
+	}
+
+	webhooksByType := make(map[model.WebhookType]*model.Webhook, len(webhooks))
+	for _, wh := range webhooks {
+		webhooksByType[wh.Type] = wh
+	}
+
+	for _, wh := range in.Webhooks {
+		current, ok := webhooksByType[wh.Type]
+		if ok && webhooksEqual(current, wh.ToWebhook(current.ID, app.ID, model.ApplicationWebhookReference)) {
+			continue
+		}
+		if ok {
+			diff.ReplacedWebhookIDs = append(diff.ReplacedWebhookIDs, current.ID)
+		}
+		diff.Webhooks = append(diff.Webhooks, wh)
+	}
+
+	return diff, nil
+}
+
+// UpgradeApplication locks the application with the given ID and brings it in line with the ApplicationRegisterInput rendered from its Application Template.
+// The diff is computed again under the lock, as the application may have changed since the upgrade was planned. The applied diff is returned.
+func (s *service) UpgradeApplication(ctx context.Context, appID string, in model.ApplicationRegisterInput) (*model.ApplicationUpgradeDiff, error) {
+	app, err := s.appRepo.GetGlobalByIDForUpdate(ctx, appID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while getting application with id %s", appID)
+	}
+
+	diff, err := s.DiffApplication(ctx, app, in)
+	if err != nil {
+		return nil, err
+	}
+	if diff.IsEmpty() {
+		return diff, nil
+	}
+
+	if err := s.applyApplicationUpgrade(ctx, app, diff); err != nil {
+		return nil, err
+	}
+
+	return diff, nil
+}
+
+func (s *service) applyApplicationUpgrade(ctx context.Context, app *model.Application, diff *model.ApplicationUpgradeDiff) error {
+	if len(diff.Fields) > 0 {
+		setApplicationFields(app, diff.Input, diff.Fields)
+		if err := s.appRepo.TechnicalUpdate(ctx, app); err != nil {
+			return errors.Wrapf(err, "while updating application with id %s", app.ID)
+		}
+	}
+
+	labelKeys := make([]string, 0, len(diff.Labels))
+	for key := range diff.Labels {
+		labelKeys = append(labelKeys, key)
+	}
+	sort.Strings(labelKeys)
+
+	for _, key := range labelKeys {
+		err := s.labelUpsertService.UpsertLabelGlobal(ctx, &model.LabelInput{
+			Key:        key,
+			Value:      diff.Labels[key],
+			ObjectID:   app.ID,
+			ObjectType: model.ApplicationLabelableObject,
+		})
 		if err != nil {
-			return "", errors.Wrap(err, "value of placeholder is invalid")
+			return errors.Wrapf(err, "while upserting %s label of application with id %s", key, app.ID)
 		}
+	}
+
+	for _, id := range diff.ReplacedWebhookIDs {
+		if err := s.webhookRepo.Delete(ctx, id); err != nil {
+			return errors.Wrapf(err, "while deleting webhook with id %s of application with id %s", id, app.ID)
+		}
+	}
 
-		appCreateInputJSON = strings.ReplaceAll(appCreateInputJSON, fmt.Sprintf("{{%s}}", placeholder.Name), newValue)
-		appCreateInputJSON, err = removeEmptyKeyFromLabels(appCreateInputJSON, placeholder.Name)
+	webhooks := make([]*model.Webhook, 0, len(diff.Webhooks))
+	for _, wh := range diff.Webhooks {
+		webhooks = append(webhooks, wh.ToWebhook(s.uidService.Generate(), app.ID, model.ApplicationWebhookReference))
+	}
+	if err := s.webhookRepo.CreateMany(ctx, "", webhooks); err != nil {
+		return errors.Wrapf(err, "while creating webhooks for application with id %s", app.ID)
+	}
+
+	return nil
+}
+
+// PrepareApplicationCreateInputJSON missing godoc
+func (s *service) PrepareApplicationCreateInputJSON(appTemplate *model.ApplicationTemplate, values model.ApplicationFromTemplateInputValues) (string, error) {
+	if len(appTemplate.Placeholders) == 0 {
+		return appTemplate.ApplicationInputJSON, nil
+	}
+
+	placeholderValues := make(map[string]interface{}, len(appTemplate.Placeholders))
+	for _, placeholder := range appTemplate.Placeholders {
+		value, err := resolvePlaceholderValue(placeholder, values)
 		if err != nil {
-			return "", errors.Wrap(err, "error while clear optional empty value")
+			return "", err
 		}
+		placeholderValues[placeholder.Name] = value
+	}
+
+	var appCreateInput map[string]interface{}
+	decoder := json.NewDecoder(strings.NewReader(appTemplate.ApplicationInputJSON))
+	decoder.UseNumber()
+	if err := decoder.Decode(&appCreateInput); err != nil {
+		return "", errors.Wrap(err, "while unmarshalling application input JSON")
 	}
-	return appCreateInputJSON, nil
+
+	appCreateInput = substitutePlaceholders(appCreateInput, placeholderValues).(map[string]interface{})
+	for _, placeholder := range appTemplate.Placeholders {
+		processMap(&appCreateInput, placeholder.Name, true)
+	}
+
+	appCreateInputJSON, err := json.Marshal(appCreateInput)
+	if err != nil {
+		return "", errors.Wrap(err, "while marshalling application input JSON")
+	}
+	return string(appCreateInputJSON), nil
 }
 
-func removeEmptyKeyFromLabels(stringInput string, keyName string) (string, error) {
-	var objMap map[string]interface{}
-	err := json.Unmarshal([]byte(stringInput), &objMap)
+// resolvePlaceholderValue returns the validated value of the placeholder. Values of NUMBER and BOOLEAN placeholders are returned as json.Number and bool respectively.
+func resolvePlaceholderValue(placeholder model.ApplicationTemplatePlaceholder, values model.ApplicationFromTemplateInputValues) (interface{}, error) {
+	value, err := values.FindPlaceholderValue(placeholder.Name)
 	if err != nil {
-		return "", errors.Wrap(err, "error while unmarshal input")
+		if placeholder.DefaultValue != nil {
+			value = *placeholder.DefaultValue
+		} else if placeholder.Optional != nil && *placeholder.Optional {
+			return "", nil
+		} else {
+			return nil, errors.Wrap(err, "required placeholder not provided")
+		}
+	}
+
+	if err = validatePlaceholderValue(placeholder, value); err != nil {
+		return nil, apperrors.NewInvalidDataError(fmt.Sprintf("value of placeholder %q is invalid: %s", placeholder.Name, err.Error()))
 	}
-	processMap(&objMap, keyName, true)
 
-	output, err := json.Marshal(objMap)
+	typedValue, err := typedPlaceholderValue(placeholder, value)
 	if err != nil {
-		return "", errors.Wrap(err, "error while marshal output")
+		return nil, apperrors.NewInvalidDataError(fmt.Sprintf("value of placeholder %q is invalid: %s", placeholder.Name, err.Error()))
 	}
-	return string(output), nil
+	return typedValue, nil
+}
+
+// substitutePlaceholders replaces the placeholders in all string values and keys of the parsed application input.
+// A string consisting only of a NUMBER or BOOLEAN placeholder is replaced by the typed value. The placeholder values are inserted
+// as they are and are not searched for placeholders again.
+func substitutePlaceholders(in interface{}, placeholderValues map[string]interface{}) interface{} {
+	switch v := in.(type) {
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(v))
+		for key, value := range v {
+			out[substituteInString(key, placeholderValues)] = substitutePlaceholders(value, placeholderValues)
+		}
+		return out
+	case []interface{}:
+		out := make([]interface{}, 0, len(v))
+		for _, value := range v {
+			out = append(out, substitutePlaceholders(value, placeholderValues))
+		}
+		return out
+	case string:
+		if match := placeholderRegex.FindStringSubmatch(v); match != nil && match[0] == v {
+			if value, ok := placeholderValues[match[1]]; ok {
+				return value
+			}
+		}
+		return substituteInString(v, placeholderValues)
+	default:
+		return in
+	}
+}
+
+func substituteInString(in string, placeholderValues map[string]interface{}) string {
+	return placeholderRegex.ReplaceAllStringFunc(in, func(match string) string {
+		value, ok := placeholderValues[placeholderRegex.FindStringSubmatch(match)[1]]
+		if !ok {
+			return match
+		}
+		return fmt.Sprint(value)
+	})
 }
 
 func processMap(input *map[string]interface{}, keyName string, rootObject bool) {
@@ -469,6 +700,105 @@ func validatePlaceholderValue(placeholder model.ApplicationTemplatePlaceholder,
 	return nil
 }
 
+func typedPlaceholderValue(placeholder model.ApplicationTemplatePlaceholder, value string) (interface{}, error) {
+	constraints := inputvalidation.PlaceholderConstraints{
+		EnumValues: placeholder.EnumValues,
+		Pattern:    placeholder.Pattern,
+		MinLength:  placeholder.MinLength,
+		MaxLength:  placeholder.MaxLength,
+	}
+	if placeholder.Type != nil {
+		constraints.Type = string(*placeholder.Type)
+	}
+	if err := inputvalidation.ValidatePlaceholderValue(constraints, value); err != nil {
+		return nil, err
+	}
+
+	if placeholder.Type == nil {
+		return value, nil
+	}
+
+	switch *placeholder.Type {
+	case model.NumberPlaceholderType:
+		return json.Number(value), nil
+	case model.BooleanPlaceholderType:
+		return value == "true", nil
+	default:
+		return value, nil
+	}
+}
+
+func diffApplicationFields(app *model.Application, in model.ApplicationRegisterInput) []string {
+	var fields []string
+	if app.Name != in.Name {
+		fields = append(fields, "name")
+	}
+	if in.ProviderName != nil && str.PtrStrToStr(app.ProviderName) != *in.ProviderName {
+		fields = append(fields, "providerName")
+	}
+	if in.Description != nil && str.PtrStrToStr(app.Description) != *in.Description {
+		fields = append(fields, "description")
+	}
+	if in.HealthCheckURL != nil && str.PtrStrToStr(app.HealthCheckURL) != *in.HealthCheckURL {
+		fields = append(fields, "healthCheckURL")
+	}
+	if in.BaseURL != nil && str.PtrStrToStr(app.BaseURL) != *in.BaseURL {
+		fields = append(fields, "baseUrl")
+	}
+	if in.ApplicationNamespace != nil && str.PtrStrToStr(app.ApplicationNamespace) != *in.ApplicationNamespace {
+		fields = append(fields, "applicationNamespace")
+	}
+	return fields
+}
+
+func setApplicationFields(app *model.Application, in model.ApplicationRegisterInput, fields []string) {
+	for _, field := range fields {
+		switch field {
+		case "name":
+			app.Name = in.Name
+		case "providerName":
+			app.ProviderName = in.ProviderName
+		case "description":
+			app.Description = in.Description
+		case "healthCheckURL":
+			app.HealthCheckURL = in.HealthCheckURL
+		case "baseUrl":
+			app.BaseURL = in.BaseURL
+		case "applicationNamespace":
+			app.ApplicationNamespace = in.ApplicationNamespace
+		}
+	}
+}
+
+func labelValuesEqual(current, desired interface{}) bool {
+	currentJSON, err := json.Marshal(current)
+	if err != nil {
+		return false
+	}
+	desiredJSON, err := json.Marshal(desired)
+	if err != nil {
+		return false
+	}
+	return string(currentJSON) == string(desiredJSON)
+}
+
+func webhooksEqual(current, desired *model.Webhook) bool {
+	return str.PtrStrToStr(current.URL) == str.PtrStrToStr(desired.URL) &&
+		webhookModeOrEmpty(current.Mode) == webhookModeOrEmpty(desired.Mode) &&
+		str.PtrStrToStr(current.URLTemplate) == str.PtrStrToStr(desired.URLTemplate) &&
+		str.PtrStrToStr(current.InputTemplate) == str.PtrStrToStr(desired.InputTemplate) &&
+		str.PtrStrToStr(current.HeaderTemplate) == str.PtrStrToStr(desired.HeaderTemplate) &&
+		str.PtrStrToStr(current.OutputTemplate) == str.PtrStrToStr(desired.OutputTemplate) &&
+		str.PtrStrToStr(current.StatusTemplate) == str.PtrStrToStr(desired.StatusTemplate)
+}
+
+func webhookModeOrEmpty(mode *model.WebhookMode) model.WebhookMode {
+	if mode == nil {
+		return ""
+	}
+	return *mode
+}
+
 func enrichWithApplicationTypeLabel(applicationInputJSON, applicationType string) (string, error) {
 	var appInput map[string]interface{}
 
@@ -506,3 +836,20 @@ func enrichWithApplicationTypeLabel(applicationInputJSON, applicationType string
 	}
 	return string(inputJSON), nil
 }
+
+func transformTemplateValues(values model.ApplicationFromTemplateInputValues, transform func(string) (string, error)) (model.ApplicationFromTemplateInputValues, error) {
+	out := make(model.ApplicationFromTemplateInputValues, 0, len(values))
+	for _, value := range values {
+		if value == nil {
+			continue
+		}
+
+		transformed, err := transform(value.Value)
+		if err != nil {
+			return nil, errors.Wrapf(err, "for placeholder %s", value.Placeholder)
+		}
+		out = append(out, &model.ApplicationTemplateValueInput{Placeholder: value.Placeholder, Value: transformed})
+	}
+
+	return out, nil
+}
@@ -2,10 +2,13 @@ package apptemplate_test
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/encryption"
+	encryptionautomock "github.com/kyma-incubator/compass/components/director/pkg/encryption/automock"
 	"github.com/kyma-incubator/compass/components/director/pkg/resource"
 
 	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
@@ -301,7 +304,7 @@ func TestService_Create(t *testing.T) {
 			labelUpsertSvc := testCase.LabelUpsertSvcFn()
 			labelRepo := testCase.LabelRepoFn()
 			idSvc := uidSvcFn()
-			svc := apptemplate.NewService(appTemplateRepo, webhookRepo, idSvc, labelUpsertSvc, labelRepo, nil)
+			svc := apptemplate.NewService(appTemplateRepo, webhookRepo, idSvc, labelUpsertSvc, labelRepo, nil, encryption.NewNoopEncryptor())
 
 			// WHEN
 			result, err := svc.Create(ctx, *testCase.Input())
@@ -390,7 +393,7 @@ func TestService_CreateWithLabels(t *testing.T) {
 			webhookRepo := testCase.WebhookRepoFn()
 			labelUpsertSvc := testCase.LabelUpsertSvcFn()
 			idSvc := uidSvcFn()
-			svc := apptemplate.NewService(appTemplateRepo, webhookRepo, idSvc, labelUpsertSvc, nil, nil)
+			svc := apptemplate.NewService(appTemplateRepo, webhookRepo, idSvc, labelUpsertSvc, nil, nil, encryption.NewNoopEncryptor())
 
 			defer mock.AssertExpectationsForObjects(t, appTemplateRepo, labelUpsertSvc, idSvc)
 
@@ -448,7 +451,7 @@ func TestService_Get(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			appTemplateRepo := testCase.AppTemplateRepoFn()
 			webhookRepo := testCase.WebhookRepoFn()
-			svc := apptemplate.NewService(appTemplateRepo, webhookRepo, nil, nil, nil, nil)
+			svc := apptemplate.NewService(appTemplateRepo, webhookRepo, nil, nil, nil, nil, encryption.NewNoopEncryptor())
 
 			// WHEN
 			result, err := svc.Get(ctx, testID)
@@ -558,7 +561,7 @@ func TestService_ListLabels(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			appTemplateRepo := testCase.AppTemplateRepoFn()
 			labelRepo := testCase.LabelRepoFn()
-			svc := apptemplate.NewService(appTemplateRepo, nil, nil, nil, labelRepo, nil)
+			svc := apptemplate.NewService(appTemplateRepo, nil, nil, nil, labelRepo, nil, encryption.NewNoopEncryptor())
 
 			// WHEN
 			result, err := svc.ListLabels(ctx, testID)
@@ -657,7 +660,7 @@ func TestService_GetLabel(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			appTemplateRepo := testCase.AppTemplateRepoFn()
 			labelRepo := testCase.LabelRepoFn()
-			svc := apptemplate.NewService(appTemplateRepo, nil, nil, nil, labelRepo, nil)
+			svc := apptemplate.NewService(appTemplateRepo, nil, nil, nil, labelRepo, nil, encryption.NewNoopEncryptor())
 
 			// WHEN
 			result, err := svc.GetLabel(ctx, testID, testCase.Key)
@@ -712,7 +715,7 @@ func TestService_ListByName(t *testing.T) {
 	for _, testCase := range testCases {
 		t.Run(testCase.Name, func(t *testing.T) {
 			appTemplateRepo := testCase.AppTemplateRepoFn()
-			svc := apptemplate.NewService(appTemplateRepo, nil, nil, nil, nil, nil)
+			svc := apptemplate.NewService(appTemplateRepo, nil, nil, nil, nil, nil, encryption.NewNoopEncryptor())
 
 			// WHEN
 			result, err := svc.ListByName(ctx, testName)
@@ -767,7 +770,7 @@ func TestService_ListByFilters(t *testing.T) {
 	for _, testCase := range testCases {
 		t.Run(testCase.Name, func(t *testing.T) {
 			appTemplateRepo := testCase.AppTemplateRepoFn()
-			svc := apptemplate.NewService(appTemplateRepo, nil, nil, nil, nil, nil)
+			svc := apptemplate.NewService(appTemplateRepo, nil, nil, nil, nil, nil, encryption.NewNoopEncryptor())
 
 			// WHEN
 			result, err := svc.ListByFilters(ctx, filters)
@@ -873,7 +876,7 @@ func TestService_GetByNameAndRegion(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			appTemplateRepo := testCase.AppTemplateRepoFn()
 			labelRepo := testCase.LabelRepoFn()
-			svc := apptemplate.NewService(appTemplateRepo, nil, nil, nil, labelRepo, nil)
+			svc := apptemplate.NewService(appTemplateRepo, nil, nil, nil, labelRepo, nil, encryption.NewNoopEncryptor())
 
 			// WHEN
 			result, err := svc.GetByNameAndRegion(ctx, testName, testCase.Region)
@@ -929,7 +932,7 @@ func TestService_GetByFilters(t *testing.T) {
 	for _, testCase := range testCases {
 		t.Run(testCase.Name, func(t *testing.T) {
 			appTemplateRepo := testCase.AppTemplateRepoFn()
-			svc := apptemplate.NewService(appTemplateRepo, nil, nil, nil, nil, nil)
+			svc := apptemplate.NewService(appTemplateRepo, nil, nil, nil, nil, nil, encryption.NewNoopEncryptor())
 
 			// WHEN
 			result, err := svc.GetByFilters(ctx, filters)
@@ -986,7 +989,7 @@ func TestService_Exists(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			appTemplateRepo := testCase.AppTemplateRepoFn()
 			webhookRepo := testCase.WebhookRepoFn()
-			svc := apptemplate.NewService(appTemplateRepo, webhookRepo, nil, nil, nil, nil)
+			svc := apptemplate.NewService(appTemplateRepo, webhookRepo, nil, nil, nil, nil, encryption.NewNoopEncryptor())
 
 			// WHEN
 			result, err := svc.Exists(ctx, testID)
@@ -1073,7 +1076,7 @@ func TestService_List(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			appTemplateRepo := testCase.AppTemplateRepoFn()
 			webhookRepo := testCase.WebhookRepoFn()
-			svc := apptemplate.NewService(appTemplateRepo, webhookRepo, nil, nil, nil, nil)
+			svc := apptemplate.NewService(appTemplateRepo, webhookRepo, nil, nil, nil, nil, encryption.NewNoopEncryptor())
 
 			// WHEN
 			result, err := svc.List(ctx, labelFilters, testCase.InputPageSize, testCursor)
@@ -1554,7 +1557,7 @@ func TestService_Update(t *testing.T) {
 			labelRepo := testCase.LabelRepoFn()
 			labelUpsertService := testCase.LabelUpsertSvcFn()
 			appRepo := testCase.AppRepoFn()
-			svc := apptemplate.NewService(appTemplateRepo, webhookRepo, nil, labelUpsertService, labelRepo, appRepo)
+			svc := apptemplate.NewService(appTemplateRepo, webhookRepo, nil, labelUpsertService, labelRepo, appRepo, encryption.NewNoopEncryptor())
 
 			// WHEN
 			err := svc.Update(ctx, testID, *testCase.Input())
@@ -1610,7 +1613,7 @@ func TestService_Delete(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			appTemplateRepo := testCase.AppTemplateRepoFn()
 			webhookRepo := testCase.WebhookRepoFn()
-			svc := apptemplate.NewService(appTemplateRepo, webhookRepo, nil, nil, nil, nil)
+			svc := apptemplate.NewService(appTemplateRepo, webhookRepo, nil, nil, nil, nil, encryption.NewNoopEncryptor())
 
 			// WHEN
 			err := svc.Delete(ctx, testID)
@@ -1630,7 +1633,7 @@ func TestService_Delete(t *testing.T) {
 
 func TestService_PrepareApplicationCreateInputJSON(t *testing.T) {
 	// GIVEN
-	svc := apptemplate.NewService(nil, nil, nil, nil, nil, nil)
+	svc := apptemplate.NewService(nil, nil, nil, nil, nil, nil, encryption.NewNoopEncryptor())
 	placeholderNotOptional := false
 	placeholderIsOptional := true
 
@@ -1716,7 +1719,7 @@ func TestService_PrepareApplicationCreateInputJSON(t *testing.T) {
 				{Placeholder: "nonOptionalLabel", Value: ""},
 			},
 			ExpectedOutput: "",
-			ExpectedError:  errors.New("error while clear optional empty value"),
+			ExpectedError:  errors.New("while unmarshalling application input JSON"),
 		},
 		{
 			Name: "Returns error when required placeholder value not provided in labels",
@@ -1754,7 +1757,7 @@ func TestService_PrepareApplicationCreateInputJSON(t *testing.T) {
 				{Placeholder: "provider", Value: "invalid SAP provider"},
 			},
 			ExpectedOutput: "",
-			ExpectedError:  errors.New("value of placeholder is invalid: provider cannot contain \"SAP\""),
+			ExpectedError:  errors.New("value of placeholder \"provider\" is invalid: provider cannot contain \"SAP\""),
 		},
 		{
 			Name: "Returns error when application type placeholder value starts with \"SAP\"",
@@ -1768,7 +1771,131 @@ func TestService_PrepareApplicationCreateInputJSON(t *testing.T) {
 				{Placeholder: "application-type", Value: "SAP type"},
 			},
 			ExpectedOutput: "",
-			ExpectedError:  errors.New("value of placeholder is invalid: your application type cannot start with \"SAP\""),
+			ExpectedError:  errors.New("value of placeholder \"application-type\" is invalid: your application type cannot start with \"SAP\""),
+		},
+		{
+			Name: "Success when placeholder value contains JSON special characters",
+			InputAppTemplate: &model.ApplicationTemplate{
+				ApplicationInputJSON: `{"Name": "{{name}}", "Description": "Lorem ipsum {{name}}"}`,
+				Placeholders: []model.ApplicationTemplatePlaceholder{
+					{Name: "name", Description: str.Ptr("Application name"), JSONPath: str.Ptr("displayName"), Optional: &placeholderNotOptional},
+				},
+			},
+			InputValues: []*model.ApplicationTemplateValueInput{
+				{Placeholder: "name", Value: `my-app", "Labels": {"injected": "{{name}}"}, "x": "\`},
+			},
+			ExpectedOutput: `{"Name": "my-app\", \"Labels\": {\"injected\": \"{{name}}\"}, \"x\": \"\\", "Description": "Lorem ipsum my-app\", \"Labels\": {\"injected\": \"{{name}}\"}, \"x\": \"\\"}`,
+		},
+		{
+			Name: "Success when typed placeholders are substituted with typed values",
+			InputAppTemplate: &model.ApplicationTemplate{
+				ApplicationInputJSON: `{"Name": "app-{{port}}", "labels": {"port": "{{port}}", "enabled": "{{enabled}}", "region": "{{region}}", "url": "{{url}}"}}`,
+				Placeholders: []model.ApplicationTemplatePlaceholder{
+					{Name: "port", Type: placeholderTypePtr(model.NumberPlaceholderType)},
+					{Name: "enabled", Type: placeholderTypePtr(model.BooleanPlaceholderType)},
+					{Name: "region", Type: placeholderTypePtr(model.EnumPlaceholderType), EnumValues: []string{"eu-1", "us-1"}},
+					{Name: "url", Type: placeholderTypePtr(model.URLPlaceholderType)},
+				},
+			},
+			InputValues: []*model.ApplicationTemplateValueInput{
+				{Placeholder: "port", Value: "8080"},
+				{Placeholder: "enabled", Value: "true"},
+				{Placeholder: "region", Value: "eu-1"},
+				{Placeholder: "url", Value: "https://example.com/path"},
+			},
+			ExpectedOutput: `{"Name": "app-8080", "labels": {"port": 8080, "enabled": true, "region": "eu-1", "url": "https://example.com/path"}}`,
+		},
+		{
+			Name: "Success when default value is used for placeholder that is not provided",
+			InputAppTemplate: &model.ApplicationTemplate{
+				ApplicationInputJSON: `{"Name": "{{name}}", "Description": "{{description}}"}`,
+				Placeholders: []model.ApplicationTemplatePlaceholder{
+					{Name: "name"},
+					{Name: "description", DefaultValue: str.Ptr("default description")},
+				},
+			},
+			InputValues: []*model.ApplicationTemplateValueInput{
+				{Placeholder: "name", Value: "my-application"},
+			},
+			ExpectedOutput: `{"Name": "my-application", "Description": "default description"}`,
+		},
+		{
+			Name: "Returns error when number placeholder value is not a number",
+			InputAppTemplate: &model.ApplicationTemplate{
+				ApplicationInputJSON: `{"labels": {"port": "{{port}}"}}`,
+				Placeholders: []model.ApplicationTemplatePlaceholder{
+					{Name: "port", Type: placeholderTypePtr(model.NumberPlaceholderType)},
+				},
+			},
+			InputValues: []*model.ApplicationTemplateValueInput{
+				{Placeholder: "port", Value: "80, \"injected\": 1"},
+			},
+			ExpectedError: errors.New("value of placeholder \"port\" is invalid: must be a number"),
+		},
+		{
+			Name: "Returns error when boolean placeholder value is not a boolean",
+			InputAppTemplate: &model.ApplicationTemplate{
+				ApplicationInputJSON: `{"labels": {"enabled": "{{enabled}}"}}`,
+				Placeholders: []model.ApplicationTemplatePlaceholder{
+					{Name: "enabled", Type: placeholderTypePtr(model.BooleanPlaceholderType)},
+				},
+			},
+			InputValues: []*model.ApplicationTemplateValueInput{
+				{Placeholder: "enabled", Value: "yes"},
+			},
+			ExpectedError: errors.New("value of placeholder \"enabled\" is invalid: must be either \"true\" or \"false\""),
+		},
+		{
+			Name: "Returns error when enum placeholder value is not allowed",
+			InputAppTemplate: &model.ApplicationTemplate{
+				ApplicationInputJSON: `{"labels": {"region": "{{region}}"}}`,
+				Placeholders: []model.ApplicationTemplatePlaceholder{
+					{Name: "region", Type: placeholderTypePtr(model.EnumPlaceholderType), EnumValues: []string{"eu-1", "us-1"}},
+				},
+			},
+			InputValues: []*model.ApplicationTemplateValueInput{
+				{Placeholder: "region", Value: "ap-1"},
+			},
+			ExpectedError: errors.New("value of placeholder \"region\" is invalid: must be one of [eu-1 us-1]"),
+		},
+		{
+			Name: "Returns error when URL placeholder value is not an absolute URL",
+			InputAppTemplate: &model.ApplicationTemplate{
+				ApplicationInputJSON: `{"BaseURL": "{{url}}"}`,
+				Placeholders: []model.ApplicationTemplatePlaceholder{
+					{Name: "url", Type: placeholderTypePtr(model.URLPlaceholderType)},
+				},
+			},
+			InputValues: []*model.ApplicationTemplateValueInput{
+				{Placeholder: "url", Value: "example.com"},
+			},
+			ExpectedError: errors.New("value of placeholder \"url\" is invalid: must be an absolute HTTP or HTTPS URL"),
+		},
+		{
+			Name: "Returns error when placeholder value does not match the pattern",
+			InputAppTemplate: &model.ApplicationTemplate{
+				ApplicationInputJSON: `{"Name": "{{name}}"}`,
+				Placeholders: []model.ApplicationTemplatePlaceholder{
+					{Name: "name", Pattern: str.Ptr("^[a-z-]+$")},
+				},
+			},
+			InputValues: []*model.ApplicationTemplateValueInput{
+				{Placeholder: "name", Value: "My App"},
+			},
+			ExpectedError: errors.New("value of placeholder \"name\" is invalid: must match pattern \"^[a-z-]+$\""),
+		},
+		{
+			Name: "Returns error when placeholder value is too long",
+			InputAppTemplate: &model.ApplicationTemplate{
+				ApplicationInputJSON: `{"Name": "{{name}}"}`,
+				Placeholders: []model.ApplicationTemplatePlaceholder{
+					{Name: "name", MinLength: intPtr(1), MaxLength: intPtr(5)},
+				},
+			},
+			InputValues: []*model.ApplicationTemplateValueInput{
+				{Placeholder: "name", Value: "my-application"},
+			},
+			ExpectedError: errors.New("value of placeholder \"name\" is invalid: must be at most 5 characters long"),
 		},
 	}
 
@@ -1790,6 +1917,580 @@ func TestService_PrepareApplicationCreateInputJSON(t *testing.T) {
 	}
 }
 
+func TestService_StoreApplicationTemplateValues(t *testing.T) {
+	// GIVEN
+	ctx := context.TODO()
+	values := model.ApplicationFromTemplateInputValues{{Placeholder: "name", Value: testAppName}}
+	valuesJSON := json.RawMessage(`[{"Placeholder":"name","Value":"enc(app-name)"}]`)
+
+	encryptorThatEncrypts := func() *encryptionautomock.Encryptor {
+		encryptor := &encryptionautomock.Encryptor{}
+		encryptor.On("Encrypt", testAppName).Return("enc(app-name)", nil).Once()
+		return encryptor
+	}
+
+	testCases := []struct {
+		Name          string
+		Values        model.ApplicationFromTemplateInputValues
+		EncryptorFn   func() *encryptionautomock.Encryptor
+		AppRepoFn     func() *automock.ApplicationRepository
+		ExpectedError error
+	}{
+		{
+			Name:        "Success",
+			Values:      values,
+			EncryptorFn: encryptorThatEncrypts,
+			AppRepoFn: func() *automock.ApplicationRepository {
+				appRepo := &automock.ApplicationRepository{}
+				appRepo.On("UpdateTemplateValuesGlobal", ctx, testAppID, valuesJSON).Return(nil).Once()
+				return appRepo
+			},
+		},
+		{
+			Name:        "Success when there are no values",
+			Values:      nil,
+			EncryptorFn: func() *encryptionautomock.Encryptor { return &encryptionautomock.Encryptor{} },
+			AppRepoFn: func() *automock.ApplicationRepository {
+				appRepo := &automock.ApplicationRepository{}
+				appRepo.On("UpdateTemplateValuesGlobal", ctx, testAppID, json.RawMessage(`[]`)).Return(nil).Once()
+				return appRepo
+			},
+		},
+		{
+			Name:   "Error when encrypting values fails",
+			Values: values,
+			EncryptorFn: func() *encryptionautomock.Encryptor {
+				encryptor := &encryptionautomock.Encryptor{}
+				encryptor.On("Encrypt", testAppName).Return("", testError).Once()
+				return encryptor
+			},
+			AppRepoFn:     func() *automock.ApplicationRepository { return &automock.ApplicationRepository{} },
+			ExpectedError: errors.New("while encrypting placeholder values of application with id app-id"),
+		},
+		{
+			Name:        "Error when storing values fails",
+			Values:      values,
+			EncryptorFn: encryptorThatEncrypts,
+			AppRepoFn: func() *automock.ApplicationRepository {
+				appRepo := &automock.ApplicationRepository{}
+				appRepo.On("UpdateTemplateValuesGlobal", ctx, testAppID, valuesJSON).Return(testError).Once()
+				return appRepo
+			},
+			ExpectedError: testError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			encryptor := testCase.EncryptorFn()
+			appRepo := testCase.AppRepoFn()
+			svc := apptemplate.NewService(nil, nil, nil, nil, nil, appRepo, encryptor)
+
+			// WHEN
+			err := svc.StoreApplicationTemplateValues(ctx, testAppID, testCase.Values)
+
+			// THEN
+			if testCase.ExpectedError != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedError.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+
+			mock.AssertExpectationsForObjects(t, appRepo, encryptor)
+		})
+	}
+}
+
+func TestService_ListApplicationsWithTemplateValues(t *testing.T) {
+	// GIVEN
+	ctx := context.TODO()
+	app := fixModelApplication(testAppID, testAppName)
+	otherApp := fixModelApplication("other-app-id", testAppName)
+	apps := []*model.Application{&app, &otherApp}
+	encryptedValues := map[string]json.RawMessage{testAppID: json.RawMessage(`[{"Placeholder":"name","Value":"enc(app-name)"}]`)}
+
+	testCases := []struct {
+		Name           string
+		EncryptorFn    func() *encryptionautomock.Encryptor
+		AppRepoFn      func() *automock.ApplicationRepository
+		ExpectedApps   []*model.Application
+		ExpectedValues map[string]model.ApplicationFromTemplateInputValues
+		ExpectedError  string
+	}{
+		{
+			Name: "Success",
+			EncryptorFn: func() *encryptionautomock.Encryptor {
+				encryptor := &encryptionautomock.Encryptor{}
+				encryptor.On("Decrypt", "enc(app-name)").Return(testAppName, nil).Once()
+				return encryptor
+			},
+			AppRepoFn: func() *automock.ApplicationRepository {
+				appRepo := &automock.ApplicationRepository{}
+				appRepo.On("ListAllByApplicationTemplateID", ctx, testID).Return(apps, nil).Once()
+				appRepo.On("ListTemplateValuesByApplicationTemplateID", ctx, testID).Return(encryptedValues, nil).Once()
+				return appRepo
+			},
+			ExpectedApps:   apps,
+			ExpectedValues: map[string]model.ApplicationFromTemplateInputValues{testAppID: {{Placeholder: "name", Value: testAppName}}},
+		},
+		{
+			Name:        "Error when listing applications fails",
+			EncryptorFn: func() *encryptionautomock.Encryptor { return &encryptionautomock.Encryptor{} },
+			AppRepoFn: func() *automock.ApplicationRepository {
+				appRepo := &automock.ApplicationRepository{}
+				appRepo.On("ListAllByApplicationTemplateID", ctx, testID).Return(nil, testError).Once()
+				return appRepo
+			},
+			ExpectedError: testError.Error(),
+		},
+		{
+			Name:        "Error when listing values fails",
+			EncryptorFn: func() *encryptionautomock.Encryptor { return &encryptionautomock.Encryptor{} },
+			AppRepoFn: func() *automock.ApplicationRepository {
+				appRepo := &automock.ApplicationRepository{}
+				appRepo.On("ListAllByApplicationTemplateID", ctx, testID).Return(apps, nil).Once()
+				appRepo.On("ListTemplateValuesByApplicationTemplateID", ctx, testID).Return(nil, testError).Once()
+				return appRepo
+			},
+			ExpectedError: testError.Error(),
+		},
+		{
+			Name:        "Error when values are not valid JSON",
+			EncryptorFn: func() *encryptionautomock.Encryptor { return &encryptionautomock.Encryptor{} },
+			AppRepoFn: func() *automock.ApplicationRepository {
+				appRepo := &automock.ApplicationRepository{}
+				appRepo.On("ListAllByApplicationTemplateID", ctx, testID).Return(apps, nil).Once()
+				appRepo.On("ListTemplateValuesByApplicationTemplateID", ctx, testID).Return(map[string]json.RawMessage{testAppID: json.RawMessage(`{`)}, nil).Once()
+				return appRepo
+			},
+			ExpectedError: "while unmarshalling placeholder values of application with id app-id",
+		},
+		{
+			Name: "Error when decrypting values fails",
+			EncryptorFn: func() *encryptionautomock.Encryptor {
+				encryptor := &encryptionautomock.Encryptor{}
+				encryptor.On("Decrypt", "enc(app-name)").Return("", testError).Once()
+				return encryptor
+			},
+			AppRepoFn: func() *automock.ApplicationRepository {
+				appRepo := &automock.ApplicationRepository{}
+				appRepo.On("ListAllByApplicationTemplateID", ctx, testID).Return(apps, nil).Once()
+				appRepo.On("ListTemplateValuesByApplicationTemplateID", ctx, testID).Return(encryptedValues, nil).Once()
+				return appRepo
+			},
+			ExpectedError: "while decrypting placeholder values of application with id app-id",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			encryptor := testCase.EncryptorFn()
+			appRepo := testCase.AppRepoFn()
+			svc := apptemplate.NewService(nil, nil, nil, nil, nil, appRepo, encryptor)
+
+			// WHEN
+			resultApps, resultValues, err := svc.ListApplicationsWithTemplateValues(ctx, testID)
+
+			// THEN
+			if testCase.ExpectedError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedApps, resultApps)
+				assert.Equal(t, testCase.ExpectedValues, resultValues)
+			}
+
+			mock.AssertExpectationsForObjects(t, appRepo, encryptor)
+		})
+	}
+}
+
+func TestService_DiffApplication(t *testing.T) {
+	// GIVEN
+	ctx := context.TODO()
+	newDescription := "new description"
+	newWebhookURL := "https://new.webhook"
+
+	currentLabels := map[string]*model.Label{
+		"displayName":      {Key: "displayName", Value: testAppName},
+		"test":             {Key: "test", Value: []interface{}{"val", "val2"}},
+		model.ScenariosKey: {Key: model.ScenariosKey, Value: []interface{}{"DEFAULT"}},
+	}
+	currentWebhooks := fixModelApplicationWebhooks(testWebhookID, testAppID)
+
+	upToDateInput := model.ApplicationRegisterInput{
+		Name:           testAppName,
+		Description:    &testDescription,
+		HealthCheckURL: &testURL,
+		Labels: map[string]interface{}{
+			"displayName":      testAppName,
+			"test":             []interface{}{"val", "val2"},
+			model.ScenariosKey: []interface{}{"other"},
+		},
+		Webhooks: []*model.WebhookInput{{Type: model.WebhookTypeConfigurationChanged, URL: str.Ptr("foourl")}},
+	}
+
+	changedInput := model.ApplicationRegisterInput{
+		Name:           testAppName,
+		Description:    &newDescription,
+		HealthCheckURL: &testURL,
+		Labels: map[string]interface{}{
+			"displayName": testAppName,
+			"test":        []interface{}{"val"},
+			"newLabel":    "value",
+		},
+		Webhooks: []*model.WebhookInput{
+			{Type: model.WebhookTypeConfigurationChanged, URL: &newWebhookURL},
+			{Type: model.WebhookTypeOpenResourceDiscovery, URL: &newWebhookURL},
+		},
+	}
+
+	testCases := []struct {
+		Name          string
+		Input         model.ApplicationRegisterInput
+		LabelRepoFn   func() *automock.LabelRepository
+		WebhookRepoFn func() *automock.WebhookRepository
+		ExpectedDiff  *model.ApplicationUpgradeDiff
+		ExpectedError error
+	}{
+		{
+			Name:  "Success when application is up to date",
+			Input: upToDateInput,
+			LabelRepoFn: func() *automock.LabelRepository {
+				labelRepo := &automock.LabelRepository{}
+				labelRepo.On("ListForGlobalObject", ctx, model.ApplicationLabelableObject, testAppID).Return(currentLabels, nil).Once()
+				return labelRepo
+			},
+			WebhookRepoFn: func() *automock.WebhookRepository {
+				webhookRepo := &automock.WebhookRepository{}
+				webhookRepo.On("ListByReferenceObjectIDGlobal", ctx, testAppID, model.ApplicationWebhookReference).Return(currentWebhooks, nil).Once()
+				return webhookRepo
+			},
+			ExpectedDiff: &model.ApplicationUpgradeDiff{
+				Input:  upToDateInput,
+				Labels: map[string]interface{}{},
+			},
+		},
+		{
+			Name:  "Success when fields, labels and webhooks have changed",
+			Input: changedInput,
+			LabelRepoFn: func() *automock.LabelRepository {
+				labelRepo := &automock.LabelRepository{}
+				labelRepo.On("ListForGlobalObject", ctx, model.ApplicationLabelableObject, testAppID).Return(currentLabels, nil).Once()
+				return labelRepo
+			},
+			WebhookRepoFn: func() *automock.WebhookRepository {
+				webhookRepo := &automock.WebhookRepository{}
+				webhookRepo.On("ListByReferenceObjectIDGlobal", ctx, testAppID, model.ApplicationWebhookReference).Return(currentWebhooks, nil).Once()
+				return webhookRepo
+			},
+			ExpectedDiff: &model.ApplicationUpgradeDiff{
+				Input:              changedInput,
+				Fields:             []string{"description"},
+				Labels:             map[string]interface{}{"test": []interface{}{"val"}, "newLabel": "value"},
+				Webhooks:           changedInput.Webhooks,
+				ReplacedWebhookIDs: []string{testWebhookID},
+			},
+		},
+		{
+			Name:  "Error when listing labels fails",
+			Input: changedInput,
+			LabelRepoFn: func() *automock.LabelRepository {
+				labelRepo := &automock.LabelRepository{}
+				labelRepo.On("ListForGlobalObject", ctx, model.ApplicationLabelableObject, testAppID).Return(nil, testError).Once()
+				return labelRepo
+			},
+			WebhookRepoFn: UnusedWebhookRepo,
+			ExpectedError: testError,
+		},
+		{
+			Name:  "Error when listing webhooks fails",
+			Input: changedInput,
+			LabelRepoFn: func() *automock.LabelRepository {
+				labelRepo := &automock.LabelRepository{}
+				labelRepo.On("ListForGlobalObject", ctx, model.ApplicationLabelableObject, testAppID).Return(currentLabels, nil).Once()
+				return labelRepo
+			},
+			WebhookRepoFn: func() *automock.WebhookRepository {
+				webhookRepo := &automock.WebhookRepository{}
+				webhookRepo.On("ListByReferenceObjectIDGlobal", ctx, testAppID, model.ApplicationWebhookReference).Return(nil, testError).Once()
+				return webhookRepo
+			},
+			ExpectedError: testError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			labelRepo := testCase.LabelRepoFn()
+			webhookRepo := testCase.WebhookRepoFn()
+			svc := apptemplate.NewService(nil, webhookRepo, nil, nil, labelRepo, nil, encryption.NewNoopEncryptor())
+			app := fixModelApplication(testAppID, testAppName)
+
+			// WHEN
+			diff, err := svc.DiffApplication(ctx, &app, testCase.Input)
+
+			// THEN
+			if testCase.ExpectedError != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedError.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedDiff, diff)
+			}
+
+			mock.AssertExpectationsForObjects(t, labelRepo, webhookRepo)
+		})
+	}
+}
+
+func TestService_UpgradeApplication(t *testing.T) {
+	// GIVEN
+	ctx := context.TODO()
+	newDescription := "new description"
+	webhookInput := &model.WebhookInput{Type: model.WebhookTypeConfigurationChanged, URL: str.Ptr("https://new.webhook")}
+
+	currentLabels := map[string]*model.Label{
+		"displayName": {Key: "displayName", Value: testAppName},
+	}
+	currentWebhooks := fixModelApplicationWebhooks(testWebhookID, testAppID)
+
+	in := model.ApplicationRegisterInput{
+		Name:           testAppName,
+		Description:    &newDescription,
+		HealthCheckURL: &testURL,
+		Labels:         map[string]interface{}{"newLabel": "value"},
+		Webhooks:       []*model.WebhookInput{webhookInput},
+	}
+
+	diff := &model.ApplicationUpgradeDiff{
+		Input:              in,
+		Fields:             []string{"description"},
+		Labels:             map[string]interface{}{"newLabel": "value"},
+		Webhooks:           []*model.WebhookInput{webhookInput},
+		ReplacedWebhookIDs: []string{testWebhookID},
+	}
+
+	upToDateIn := model.ApplicationRegisterInput{
+		Name:           testAppName,
+		Description:    &testDescription,
+		HealthCheckURL: &testURL,
+		Labels:         map[string]interface{}{"displayName": testAppName},
+	}
+
+	upgradedApp := fixModelApplication(testAppID, testAppName)
+	upgradedApp.Description = &newDescription
+	newWebhooks := []*model.Webhook{webhookInput.ToWebhook(testUUID, testAppID, model.ApplicationWebhookReference)}
+
+	lockedAppRepo := func(updateErr error) func() *automock.ApplicationRepository {
+		return func() *automock.ApplicationRepository {
+			app := fixModelApplication(testAppID, testAppName)
+			appRepo := &automock.ApplicationRepository{}
+			appRepo.On("GetGlobalByIDForUpdate", ctx, testAppID).Return(&app, nil).Once()
+			appRepo.On("TechnicalUpdate", ctx, &upgradedApp).Return(updateErr).Once()
+			return appRepo
+		}
+	}
+	labelRepoFn := func() *automock.LabelRepository {
+		labelRepo := &automock.LabelRepository{}
+		labelRepo.On("ListForGlobalObject", ctx, model.ApplicationLabelableObject, testAppID).Return(currentLabels, nil).Once()
+		return labelRepo
+	}
+	listingWebhookRepo := func() *automock.WebhookRepository {
+		webhookRepo := &automock.WebhookRepository{}
+		webhookRepo.On("ListByReferenceObjectIDGlobal", ctx, testAppID, model.ApplicationWebhookReference).Return(currentWebhooks, nil).Once()
+		return webhookRepo
+	}
+
+	testCases := []struct {
+		Name             string
+		Input            model.ApplicationRegisterInput
+		AppRepoFn        func() *automock.ApplicationRepository
+		LabelRepoFn      func() *automock.LabelRepository
+		LabelUpsertSvcFn func() *automock.LabelUpsertService
+		WebhookRepoFn    func() *automock.WebhookRepository
+		UIDSvcFn         func() *automock.UIDService
+		ExpectedDiff     *model.ApplicationUpgradeDiff
+		ExpectedError    error
+	}{
+		{
+			Name:        "Success",
+			Input:       in,
+			AppRepoFn:   lockedAppRepo(nil),
+			LabelRepoFn: labelRepoFn,
+			LabelUpsertSvcFn: func() *automock.LabelUpsertService {
+				labelUpsertSvc := &automock.LabelUpsertService{}
+				labelUpsertSvc.On("UpsertLabelGlobal", ctx, fixLabelInput("newLabel", "value", testAppID, model.ApplicationLabelableObject)).Return(nil).Once()
+				return labelUpsertSvc
+			},
+			WebhookRepoFn: func() *automock.WebhookRepository {
+				webhookRepo := listingWebhookRepo()
+				webhookRepo.On("Delete", ctx, testWebhookID).Return(nil).Once()
+				webhookRepo.On("CreateMany", ctx, "", newWebhooks).Return(nil).Once()
+				return webhookRepo
+			},
+			UIDSvcFn: func() *automock.UIDService {
+				uidSvc := &automock.UIDService{}
+				uidSvc.On("Generate").Return(testUUID).Once()
+				return uidSvc
+			},
+			ExpectedDiff: diff,
+		},
+		{
+			Name:  "Success when application has been brought up to date in the meantime",
+			Input: upToDateIn,
+			AppRepoFn: func() *automock.ApplicationRepository {
+				app := fixModelApplication(testAppID, testAppName)
+				appRepo := &automock.ApplicationRepository{}
+				appRepo.On("GetGlobalByIDForUpdate", ctx, testAppID).Return(&app, nil).Once()
+				return appRepo
+			},
+			LabelRepoFn:      labelRepoFn,
+			LabelUpsertSvcFn: UnusedLabelUpsertSvc,
+			WebhookRepoFn:    listingWebhookRepo,
+			UIDSvcFn:         func() *automock.UIDService { return &automock.UIDService{} },
+			ExpectedDiff: &model.ApplicationUpgradeDiff{
+				Input:  upToDateIn,
+				Labels: map[string]interface{}{},
+			},
+		},
+		{
+			Name:  "Error when getting application fails",
+			Input: in,
+			AppRepoFn: func() *automock.ApplicationRepository {
+				appRepo := &automock.ApplicationRepository{}
+				appRepo.On("GetGlobalByIDForUpdate", ctx, testAppID).Return(nil, testError).Once()
+				return appRepo
+			},
+			LabelRepoFn:      func() *automock.LabelRepository { return &automock.LabelRepository{} },
+			LabelUpsertSvcFn: UnusedLabelUpsertSvc,
+			WebhookRepoFn:    UnusedWebhookRepo,
+			UIDSvcFn:         func() *automock.UIDService { return &automock.UIDService{} },
+			ExpectedError:    testError,
+		},
+		{
+			Name:  "Error when computing the diff fails",
+			Input: in,
+			AppRepoFn: func() *automock.ApplicationRepository {
+				app := fixModelApplication(testAppID, testAppName)
+				appRepo := &automock.ApplicationRepository{}
+				appRepo.On("GetGlobalByIDForUpdate", ctx, testAppID).Return(&app, nil).Once()
+				return appRepo
+			},
+			LabelRepoFn: func() *automock.LabelRepository {
+				labelRepo := &automock.LabelRepository{}
+				labelRepo.On("ListForGlobalObject", ctx, model.ApplicationLabelableObject, testAppID).Return(nil, testError).Once()
+				return labelRepo
+			},
+			LabelUpsertSvcFn: UnusedLabelUpsertSvc,
+			WebhookRepoFn:    UnusedWebhookRepo,
+			UIDSvcFn:         func() *automock.UIDService { return &automock.UIDService{} },
+			ExpectedError:    testError,
+		},
+		{
+			Name:             "Error when updating application fails",
+			Input:            in,
+			AppRepoFn:        lockedAppRepo(testError),
+			LabelRepoFn:      labelRepoFn,
+			LabelUpsertSvcFn: UnusedLabelUpsertSvc,
+			WebhookRepoFn:    listingWebhookRepo,
+			UIDSvcFn:         func() *automock.UIDService { return &automock.UIDService{} },
+			ExpectedError:    testError,
+		},
+		{
+			Name:        "Error when upserting label fails",
+			Input:       in,
+			AppRepoFn:   lockedAppRepo(nil),
+			LabelRepoFn: labelRepoFn,
+			LabelUpsertSvcFn: func() *automock.LabelUpsertService {
+				labelUpsertSvc := &automock.LabelUpsertService{}
+				labelUpsertSvc.On("UpsertLabelGlobal", ctx, fixLabelInput("newLabel", "value", testAppID, model.ApplicationLabelableObject)).Return(testError).Once()
+				return labelUpsertSvc
+			},
+			WebhookRepoFn: listingWebhookRepo,
+			UIDSvcFn:      func() *automock.UIDService { return &automock.UIDService{} },
+			ExpectedError: testError,
+		},
+		{
+			Name:        "Error when deleting replaced webhook fails",
+			Input:       in,
+			AppRepoFn:   lockedAppRepo(nil),
+			LabelRepoFn: labelRepoFn,
+			LabelUpsertSvcFn: func() *automock.LabelUpsertService {
+				labelUpsertSvc := &automock.LabelUpsertService{}
+				labelUpsertSvc.On("UpsertLabelGlobal", ctx, fixLabelInput("newLabel", "value", testAppID, model.ApplicationLabelableObject)).Return(nil).Once()
+				return labelUpsertSvc
+			},
+			WebhookRepoFn: func() *automock.WebhookRepository {
+				webhookRepo := listingWebhookRepo()
+				webhookRepo.On("Delete", ctx, testWebhookID).Return(testError).Once()
+				return webhookRepo
+			},
+			UIDSvcFn:      func() *automock.UIDService { return &automock.UIDService{} },
+			ExpectedError: testError,
+		},
+		{
+			Name:        "Error when creating webhooks fails",
+			Input:       in,
+			AppRepoFn:   lockedAppRepo(nil),
+			LabelRepoFn: labelRepoFn,
+			LabelUpsertSvcFn: func() *automock.LabelUpsertService {
+				labelUpsertSvc := &automock.LabelUpsertService{}
+				labelUpsertSvc.On("UpsertLabelGlobal", ctx, fixLabelInput("newLabel", "value", testAppID, model.ApplicationLabelableObject)).Return(nil).Once()
+				return labelUpsertSvc
+			},
+			WebhookRepoFn: func() *automock.WebhookRepository {
+				webhookRepo := listingWebhookRepo()
+				webhookRepo.On("Delete", ctx, testWebhookID).Return(nil).Once()
+				webhookRepo.On("CreateMany", ctx, "", newWebhooks).Return(testError).Once()
+				return webhookRepo
+			},
+			UIDSvcFn: func() *automock.UIDService {
+				uidSvc := &automock.UIDService{}
+				uidSvc.On("Generate").Return(testUUID).Once()
+				return uidSvc
+			},
+			ExpectedError: testError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			appRepo := testCase.AppRepoFn()
+			labelRepo := testCase.LabelRepoFn()
+			labelUpsertSvc := testCase.LabelUpsertSvcFn()
+			webhookRepo := testCase.WebhookRepoFn()
+			uidSvc := testCase.UIDSvcFn()
+			svc := apptemplate.NewService(nil, webhookRepo, uidSvc, labelUpsertSvc, labelRepo, appRepo, encryption.NewNoopEncryptor())
+
+			// WHEN
+			result, err := svc.UpgradeApplication(ctx, testAppID, testCase.Input)
+
+			// THEN
+			if testCase.ExpectedError != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedError.Error())
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedDiff, result)
+			}
+
+			mock.AssertExpectationsForObjects(t, appRepo, labelRepo, labelUpsertSvc, webhookRepo, uidSvc)
+		})
+	}
+}
+
+func placeholderTypePtr(placeholderType model.ApplicationTemplatePlaceholderType) *model.ApplicationTemplatePlaceholderType {
+	return &placeholderType
+}
+
+func intPtr(i int) *int {
+	return &i
+}
+
 func UnusedLabelRepo() *automock.LabelRepository {
 	return &automock.LabelRepository{}
 }
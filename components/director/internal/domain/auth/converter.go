@@ -310,6 +310,7 @@ func (c *converter) credentialInputFromGraphQL(in *graphql.CredentialDataInput)
 	var basic *model.BasicCredentialDataInput
 	var oauth *model.OAuthCredentialDataInput
 	var certOAuth *model.CertificateOAuthCredentialDataInput
+	var hmac *model.HMACCredentialDataInput
 
 	if in.Basic != nil {
 		basic = &model.BasicCredentialDataInput{
@@ -328,12 +329,18 @@ func (c *converter) credentialInputFromGraphQL(in *graphql.CredentialDataInput)
 			Certificate: in.CertificateOAuth.Certificate,
 			URL:         in.CertificateOAuth.URL,
 		}
+	} else if in.Hmac != nil {
+		hmac = &model.HMACCredentialDataInput{
+			PrimarySecret:   in.Hmac.PrimarySecret,
+			SecondarySecret: str.PtrStrToStr(in.Hmac.SecondarySecret),
+		}
 	}
 
 	return &model.CredentialDataInput{
 		Basic:            basic,
 		Oauth:            oauth,
 		CertificateOAuth: certOAuth,
+		HMAC:             hmac,
 	}
 }
 
@@ -345,6 +352,7 @@ func (c *converter) credentialModelFromGraphQL(in *graphql.CredentialDataInput)
 	var basic *model.BasicCredentialData
 	var oauth *model.OAuthCredentialData
 	var certOAuth *model.CertificateOAuthCredentialData
+	var hmac *model.HMACCredentialData
 
 	if in.Basic != nil {
 		basic = &model.BasicCredentialData{
@@ -363,12 +371,18 @@ func (c *converter) credentialModelFromGraphQL(in *graphql.CredentialDataInput)
 			Certificate: in.CertificateOAuth.Certificate,
 			URL:         in.CertificateOAuth.URL,
 		}
+	} else if in.Hmac != nil {
+		hmac = &model.HMACCredentialData{
+			PrimarySecret:   in.Hmac.PrimarySecret,
+			SecondarySecret: str.PtrStrToStr(in.Hmac.SecondarySecret),
+		}
 	}
 
 	return model.CredentialData{
 		Basic:            basic,
 		Oauth:            oauth,
 		CertificateOAuth: certOAuth,
+		HMAC:             hmac,
 	}
 }
 
@@ -391,6 +405,15 @@ func (c *converter) credentialToGraphQL(in model.CredentialData) graphql.Credent
 			Certificate: in.CertificateOAuth.Certificate,
 			URL:         in.CertificateOAuth.URL,
 		}
+	} else if in.HMAC != nil {
+		var secondarySecret *string
+		if in.HMAC.SecondarySecret != "" {
+			secondarySecret = &in.HMAC.SecondarySecret
+		}
+		credential = graphql.HMACCredentialData{
+			PrimarySecret:   in.HMAC.PrimarySecret,
+			SecondarySecret: secondarySecret,
+		}
 	}
 
 	return credential
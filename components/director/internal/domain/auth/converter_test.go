@@ -34,6 +34,11 @@ func TestConverter_ToGraphQL(t *testing.T) {
 			Input:    fixDetailedOAuthCredentials(),
 			Expected: fixDetailedOAuthCredentialsGQLAuth(),
 		},
+		{
+			Name:     "HMAC credentials",
+			Input:    fixAuthHMACCredentials(),
+			Expected: fixHMACCredentialsGQLAuth(),
+		},
 		{
 			Name:  "Empty",
 			Input: &model.Auth{},
@@ -78,6 +83,11 @@ func TestConverter_InputFromGraphQL(t *testing.T) {
 			Input:    fixDetailedCertificateOAuthGQLAuthInput(),
 			Expected: fixDetailedCertificateOAuthAuthInput(),
 		},
+		{
+			Name:     "HMAC credentials",
+			Input:    fixHMACGQLAuthInput(),
+			Expected: fixHMACAuthInput(),
+		},
 		{
 			Name:     "All properties given - deprecated",
 			Input:    fixDetailedGQLAuthInputDeprecated(),
@@ -127,6 +137,11 @@ func TestConverter_ModelFromGraphQLInput(t *testing.T) {
 			Input:    *fixDetailedCertificateOAuthGQLAuthInput(),
 			Expected: fixDetailedAuthCertificateOAuthCredentials(),
 		},
+		{
+			Name:     "HMAC credentials",
+			Input:    *fixHMACGQLAuthInput(),
+			Expected: fixAuthHMACCredentials(),
+		},
 		{
 			Name:     "Empty",
 			Input:    graphql.AuthInput{},
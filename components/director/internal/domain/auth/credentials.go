@@ -0,0 +1,105 @@
+package auth
+
+import (
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/encryption"
+	"github.com/pkg/errors"
+)
+
+// EncryptCredentials returns a copy of the provided Auth in which all secret credential fields (passwords, client secrets, certificates and HMAC secrets)
+// are encrypted with the provided credentials encryptor. It is meant to be used right before the Auth is persisted.
+func EncryptCredentials(encryptor encryption.Encryptor, in *model.Auth) (*model.Auth, error) {
+	out, err := transformCredentials(in, encryptor.Encrypt)
+	if err != nil {
+		return nil, errors.Wrap(err, "while encrypting credentials")
+	}
+
+	return out, nil
+}
+
+// DecryptCredentials returns a copy of the provided Auth in which all secret credential fields are decrypted with the provided credentials encryptor.
+// It is meant to be used right after the Auth is read from the database.
+func DecryptCredentials(encryptor encryption.Encryptor, in *model.Auth) (*model.Auth, error) {
+	out, err := transformCredentials(in, encryptor.Decrypt)
+	if err != nil {
+		return nil, errors.Wrap(err, "while decrypting credentials")
+	}
+
+	return out, nil
+}
+
+// CredentialsNeedReEncryption checks whether any of the secret credential fields of the provided persisted Auth is either not encrypted or encrypted with an old key version of the provided credentials encryptor
+func CredentialsNeedReEncryption(encryptor encryption.Encryptor, in *model.Auth) bool {
+	needsReEncryption := false
+	_, _ = transformCredentials(in, func(value string) (string, error) {
+		needsReEncryption = needsReEncryption || encryptor.NeedsReEncryption(value)
+		return value, nil
+	})
+
+	return needsReEncryption
+}
+
+func transformCredentials(in *model.Auth, transform func(string) (string, error)) (*model.Auth, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	out := *in
+	credential, err := transformCredentialData(in.Credential, transform)
+	if err != nil {
+		return nil, err
+	}
+	out.Credential = credential
+
+	if in.RequestAuth != nil && in.RequestAuth.Csrf != nil {
+		csrf := *in.RequestAuth.Csrf
+		if csrf.Credential, err = transformCredentialData(csrf.Credential, transform); err != nil {
+			return nil, err
+		}
+		out.RequestAuth = &model.CredentialRequestAuth{Csrf: &csrf}
+	}
+
+	return &out, nil
+}
+
+func transformCredentialData(in model.CredentialData, transform func(string) (string, error)) (model.CredentialData, error) {
+	out := in
+	var err error
+
+	if in.Basic != nil {
+		basic := *in.Basic
+		if basic.Password, err = transform(basic.Password); err != nil {
+			return model.CredentialData{}, err
+		}
+		out.Basic = &basic
+	}
+
+	if in.Oauth != nil {
+		oauth := *in.Oauth
+		if oauth.ClientSecret, err = transform(oauth.ClientSecret); err != nil {
+			return model.CredentialData{}, err
+		}
+		out.Oauth = &oauth
+	}
+
+	if in.CertificateOAuth != nil {
+		certOAuth := *in.CertificateOAuth
+		if certOAuth.Certificate, err = transform(certOAuth.Certificate); err != nil {
+			return model.CredentialData{}, err
+		}
+		out.CertificateOAuth = &certOAuth
+	}
+
+	if in.HMAC != nil {
+		hmac := *in.HMAC
+		if hmac.PrimarySecret, err = transform(hmac.PrimarySecret); err != nil {
+			return model.CredentialData{}, err
+		}
+		if hmac.SecondarySecret, err = transform(hmac.SecondarySecret); err != nil {
+			return model.CredentialData{}, err
+		}
+		out.HMAC = &hmac
+	}
+
+	return out, nil
+}
@@ -0,0 +1,193 @@
+package auth_test
+
+import (
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/auth"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/encryption/automock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncryptCredentials(t *testing.T) {
+	// GIVEN
+	encryptFn := func(value string) string {
+		if value == "" {
+			return ""
+		}
+		return "enc(" + value + ")"
+	}
+
+	testCases := []struct {
+		Name        string
+		InputFn     func() *model.Auth
+		EncryptorFn func() *automock.Encryptor
+		ExpectedFn  func() *model.Auth
+		ExpectedErr error
+	}{
+		{
+			Name:    "Success encrypting basic credentials and CSRF credentials",
+			InputFn: fixDetailedAuthBasicCredentials,
+			EncryptorFn: func() *automock.Encryptor {
+				encryptor := &automock.Encryptor{}
+				encryptor.On("Encrypt", authPassword).Return(encryptFn, nil).Twice()
+				return encryptor
+			},
+			ExpectedFn: func() *model.Auth {
+				expected := fixDetailedAuthBasicCredentials()
+				expected.Credential.Basic.Password = encryptFn(authPassword)
+				expected.RequestAuth.Csrf.Credential.Basic.Password = encryptFn(authPassword)
+				return expected
+			},
+		},
+		{
+			Name:    "Success encrypting oauth credentials",
+			InputFn: fixDetailedOAuthCredentials,
+			EncryptorFn: func() *automock.Encryptor {
+				encryptor := &automock.Encryptor{}
+				encryptor.On("Encrypt", authClientSecret).Return(encryptFn, nil).Once()
+				encryptor.On("Encrypt", authPassword).Return(encryptFn, nil).Once()
+				return encryptor
+			},
+			ExpectedFn: func() *model.Auth {
+				expected := fixDetailedOAuthCredentials()
+				expected.Credential.Oauth.ClientSecret = encryptFn(authClientSecret)
+				expected.RequestAuth.Csrf.Credential.Basic.Password = encryptFn(authPassword)
+				return expected
+			},
+		},
+		{
+			Name:    "Success encrypting certificate oauth credentials",
+			InputFn: fixDetailedAuthCertificateOAuthCredentials,
+			EncryptorFn: func() *automock.Encryptor {
+				encryptor := &automock.Encryptor{}
+				encryptor.On("Encrypt", authCertificate).Return(encryptFn, nil).Once()
+				encryptor.On("Encrypt", authPassword).Return(encryptFn, nil).Once()
+				return encryptor
+			},
+			ExpectedFn: func() *model.Auth {
+				expected := fixDetailedAuthCertificateOAuthCredentials()
+				expected.Credential.CertificateOAuth.Certificate = encryptFn(authCertificate)
+				expected.RequestAuth.Csrf.Credential.Basic.Password = encryptFn(authPassword)
+				return expected
+			},
+		},
+		{
+			Name:    "Success encrypting HMAC secrets",
+			InputFn: fixAuthHMACCredentials,
+			EncryptorFn: func() *automock.Encryptor {
+				encryptor := &automock.Encryptor{}
+				encryptor.On("Encrypt", hmacPrimary).Return(encryptFn, nil).Once()
+				encryptor.On("Encrypt", hmacSecondary).Return(encryptFn, nil).Once()
+				return encryptor
+			},
+			ExpectedFn: func() *model.Auth {
+				expected := fixAuthHMACCredentials()
+				expected.Credential.HMAC.PrimarySecret = encryptFn(hmacPrimary)
+				expected.Credential.HMAC.SecondarySecret = encryptFn(hmacSecondary)
+				return expected
+			},
+		},
+		{
+			Name:        "Success for nil auth",
+			InputFn:     func() *model.Auth { return nil },
+			EncryptorFn: func() *automock.Encryptor { return &automock.Encryptor{} },
+			ExpectedFn:  func() *model.Auth { return nil },
+		},
+		{
+			Name:    "Error when encryption fails",
+			InputFn: fixDetailedAuthBasicCredentials,
+			EncryptorFn: func() *automock.Encryptor {
+				encryptor := &automock.Encryptor{}
+				encryptor.On("Encrypt", authPassword).Return("", errors.New("test error")).Once()
+				return encryptor
+			},
+			ExpectedErr: errors.New("while encrypting credentials: test error"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			encryptor := testCase.EncryptorFn()
+
+			input := testCase.InputFn()
+
+			// WHEN
+			result, err := auth.EncryptCredentials(encryptor, input)
+
+			// THEN
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+				assert.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedFn(), result)
+				assert.Equal(t, testCase.InputFn(), input)
+			}
+
+			mock.AssertExpectationsForObjects(t, encryptor)
+		})
+	}
+}
+
+func TestDecryptCredentials(t *testing.T) {
+	// GIVEN
+	encryptor := &automock.Encryptor{}
+	encryptor.On("Decrypt", "enc(primary)").Return("primary", nil).Once()
+	encryptor.On("Decrypt", "").Return("", nil).Once()
+
+	input := &model.Auth{Credential: model.CredentialData{HMAC: &model.HMACCredentialData{PrimarySecret: "enc(primary)"}}}
+
+	// WHEN
+	result, err := auth.DecryptCredentials(encryptor, input)
+
+	// THEN
+	require.NoError(t, err)
+	assert.Equal(t, &model.Auth{Credential: model.CredentialData{HMAC: &model.HMACCredentialData{PrimarySecret: "primary"}}}, result)
+	assert.Equal(t, "enc(primary)", input.Credential.HMAC.PrimarySecret)
+	mock.AssertExpectationsForObjects(t, encryptor)
+}
+
+func TestCredentialsNeedReEncryption(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Input    *model.Auth
+		Expected bool
+	}{
+		{
+			Name:     "Returns true when a secret needs re-encryption",
+			Input:    &model.Auth{Credential: model.CredentialData{Basic: &model.BasicCredentialData{Username: authUsername, Password: authPassword}}},
+			Expected: true,
+		},
+		{
+			Name:     "Returns false when no secret needs re-encryption",
+			Input:    &model.Auth{Credential: model.CredentialData{Oauth: &model.OAuthCredentialData{ClientID: authClientID, ClientSecret: "enc(secret)"}}},
+			Expected: false,
+		},
+		{
+			Name:     "Returns false for nil auth",
+			Input:    nil,
+			Expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			encryptor := &automock.Encryptor{}
+			encryptor.On("NeedsReEncryption", authPassword).Return(true).Maybe()
+			encryptor.On("NeedsReEncryption", "enc(secret)").Return(false).Maybe()
+
+			// WHEN
+			result := auth.CredentialsNeedReEncryption(encryptor, testCase.Input)
+
+			// THEN
+			assert.Equal(t, testCase.Expected, result)
+		})
+	}
+}
@@ -16,6 +16,8 @@ var (
 	authClientSecret = "client-secret"
 	authCertificate  = "certificate-here"
 	authURL          = "http://test.com"
+	hmacPrimary      = "primary-secret-primary-secret-primary"
+	hmacSecondary    = "secondary-secret-secondary-secret-secondary"
 	connectorURL     = "connectorURL"
 	modelTokenType   = tokens.ApplicationToken
 	gqlTokenType     = graphql.OneTimeTokenTypeApplication
@@ -371,3 +373,47 @@ func fixOneTimeTokenGQLInput() *graphql.OneTimeTokenInput {
 		UsedAt:       graphql.Timestamp{},
 	}
 }
+
+func fixAuthHMACCredentials() *model.Auth {
+	return &model.Auth{
+		Credential: model.CredentialData{
+			HMAC: &model.HMACCredentialData{
+				PrimarySecret:   hmacPrimary,
+				SecondarySecret: hmacSecondary,
+			},
+		},
+	}
+}
+
+func fixHMACCredentialsGQLAuth() *graphql.Auth {
+	emptyCertCommonName := ""
+	return &graphql.Auth{
+		Credential: graphql.HMACCredentialData{
+			PrimarySecret:   hmacPrimary,
+			SecondarySecret: &hmacSecondary,
+		},
+		CertCommonName: &emptyCertCommonName,
+	}
+}
+
+func fixHMACAuthInput() *model.AuthInput {
+	return &model.AuthInput{
+		Credential: &model.CredentialDataInput{
+			HMAC: &model.HMACCredentialDataInput{
+				PrimarySecret:   hmacPrimary,
+				SecondarySecret: hmacSecondary,
+			},
+		},
+	}
+}
+
+func fixHMACGQLAuthInput() *graphql.AuthInput {
+	return &graphql.AuthInput{
+		Credential: &graphql.CredentialDataInput{
+			Hmac: &graphql.HMACCredentialDataInput{
+				PrimarySecret:   hmacPrimary,
+				SecondarySecret: &hmacSecondary,
+			},
+		},
+	}
+}
@@ -7,8 +7,10 @@ import (
 
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 
+	"github.com/kyma-incubator/compass/components/director/pkg/encryption"
 	"github.com/kyma-incubator/compass/components/director/pkg/str"
 
+	"github.com/kyma-incubator/compass/components/director/internal/domain/auth"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/internal/repo"
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
@@ -24,19 +26,21 @@ type AuthConverter interface {
 }
 
 type converter struct {
-	auth     AuthConverter
-	api      APIConverter
-	event    EventConverter
-	document DocumentConverter
+	auth                 AuthConverter
+	api                  APIConverter
+	event                EventConverter
+	document             DocumentConverter
+	credentialsEncryptor encryption.Encryptor
 }
 
 // NewConverter missing godoc
-func NewConverter(auth AuthConverter, api APIConverter, event EventConverter, document DocumentConverter) *converter {
+func NewConverter(auth AuthConverter, api APIConverter, event EventConverter, document DocumentConverter, credentialsEncryptor encryption.Encryptor) *converter {
 	return &converter{
-		auth:     auth,
-		api:      api,
-		event:    event,
-		document: document,
+		auth:                 auth,
+		api:                  api,
+		event:                event,
+		document:             document,
+		credentialsEncryptor: credentialsEncryptor,
 	}
 }
 
@@ -250,7 +254,12 @@ func (c *converter) marshalDefaultInstanceAuth(defaultInstanceAuth *model.Auth)
 		return nil, nil
 	}
 
-	output, err := json.Marshal(defaultInstanceAuth)
+	encryptedAuth, err := auth.EncryptCredentials(c.credentialsEncryptor, defaultInstanceAuth)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := json.Marshal(encryptedAuth)
 	if err != nil {
 		return nil, errors.Wrap(err, "while marshaling default auth")
 	}
@@ -267,7 +276,7 @@ func (c *converter) unmarshalDefaultInstanceAuth(defaultInstanceAuthSQL sql.Null
 		}
 	}
 
-	return defaultInstanceAuth, nil
+	return auth.DecryptCredentials(c.credentialsEncryptor, defaultInstanceAuth)
 }
 
 func (c *converter) strPtrToJSONSchemaPtr(in *string) *graphql.JSONSchema {
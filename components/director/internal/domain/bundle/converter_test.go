@@ -18,6 +18,7 @@ import (
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/bundle/automock"
 
+	"github.com/kyma-incubator/compass/components/director/pkg/encryption"
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 	"github.com/stretchr/testify/assert"
 )
@@ -32,7 +33,7 @@ func TestEntityConverter_ToEntity(t *testing.T) {
 		bndlModel.Error = &testErrMsg
 		require.NotNil(t, bndlModel)
 		authConv := auth.NewConverter()
-		conv := bundle.NewConverter(authConv, nil, nil, nil)
+		conv := bundle.NewConverter(authConv, nil, nil, nil, encryption.NewNoopEncryptor())
 		// WHEN
 		entity, err := conv.ToEntity(bndlModel)
 		// THEN
@@ -68,7 +69,7 @@ func TestEntityConverter_ToEntity(t *testing.T) {
 
 		require.NotNil(t, bndlModel)
 		authConv := auth.NewConverter()
-		conv := bundle.NewConverter(authConv, nil, nil, nil)
+		conv := bundle.NewConverter(authConv, nil, nil, nil, encryption.NewNoopEncryptor())
 		// WHEN
 		entity, err := conv.ToEntity(bndlModel)
 		// THEN
@@ -89,7 +90,7 @@ func TestEntityConverter_FromEntity(t *testing.T) {
 			Valid:  true,
 		}
 		authConv := auth.NewConverter()
-		conv := bundle.NewConverter(authConv, nil, nil, nil)
+		conv := bundle.NewConverter(authConv, nil, nil, nil, encryption.NewNoopEncryptor())
 		// WHEN
 		bndlModel, err := conv.FromEntity(entity)
 		// THEN
@@ -118,7 +119,7 @@ func TestEntityConverter_FromEntity(t *testing.T) {
 			BaseEntity:                     &model.BaseEntity{ID: bundleID},
 		}
 		authConv := auth.NewConverter()
-		conv := bundle.NewConverter(authConv, nil, nil, nil)
+		conv := bundle.NewConverter(authConv, nil, nil, nil, encryption.NewNoopEncryptor())
 		// WHEN
 		bndlModel, err := conv.FromEntity(entity)
 		// THEN
@@ -182,7 +183,7 @@ func TestConverter_ToGraphQL(t *testing.T) {
 			authConverter := testCase.AuthConverterFn()
 
 			// WHEN
-			converter := bundle.NewConverter(authConverter, nil, nil, nil)
+			converter := bundle.NewConverter(authConverter, nil, nil, nil, encryption.NewNoopEncryptor())
 			res, err := converter.ToGraphQL(testCase.Input)
 
 			// then
@@ -227,7 +228,7 @@ func TestConverter_MultipleToGraphQL(t *testing.T) {
 	}
 
 	// WHEN
-	converter := bundle.NewConverter(authConverter, nil, nil, nil)
+	converter := bundle.NewConverter(authConverter, nil, nil, nil, encryption.NewNoopEncryptor())
 	res, err := converter.MultipleToGraphQL(input)
 
 	// then
@@ -315,7 +316,7 @@ func TestConverter_CreateInputFromGraphQL(t *testing.T) {
 			authConverter := testCase.AuthConverterFn()
 
 			// WHEN
-			converter := bundle.NewConverter(authConverter, apiConverter, eventConverter, documentConverter)
+			converter := bundle.NewConverter(authConverter, apiConverter, eventConverter, documentConverter, encryption.NewNoopEncryptor())
 			res, err := converter.CreateInputFromGraphQL(testCase.Input)
 
 			// then
@@ -357,7 +358,7 @@ func TestConverter_MultipleCreateInputFromGraphQL(t *testing.T) {
 	authConv.On("InputFromGraphQL", gqlBndl1.DefaultInstanceAuth).Return(modBndl1.DefaultInstanceAuth, nil).Once()
 	authConv.On("InputFromGraphQL", gqlBndl2.DefaultInstanceAuth).Return(modBndl2.DefaultInstanceAuth, nil).Once()
 
-	converter := bundle.NewConverter(authConv, apiConv, eventConv, docConv)
+	converter := bundle.NewConverter(authConv, apiConv, eventConv, docConv, encryption.NewNoopEncryptor())
 
 	// WHEN
 	res, err := converter.MultipleCreateInputFromGraphQL(input)
@@ -410,7 +411,7 @@ func TestConverter_UpdateInputFromGraphQL(t *testing.T) {
 			authConverter := testCase.AuthConverterFn()
 
 			// WHEN
-			converter := bundle.NewConverter(authConverter, nil, nil, nil)
+			converter := bundle.NewConverter(authConverter, nil, nil, nil, encryption.NewNoopEncryptor())
 			res, err := converter.UpdateInputFromGraphQL(*testCase.Input)
 
 			// then
@@ -459,7 +459,7 @@ func (r *Resolver) APIDefinitionsDataLoader(keys []dataloader.ParamAPIDef) ([]*g
 		cursor = string(*keys[0].After)
 	}
 
-	tx, err := r.transact.Begin()
+	tx, err := persistence.BeginForCtx(ctx, r.transact)
 	if err != nil {
 		return nil, []error{err}
 	}
@@ -603,7 +603,7 @@ func (r *Resolver) EventDefinitionsDataLoader(keys []dataloader.ParamEventDef) (
 		cursor = string(*keys[0].After)
 	}
 
-	tx, err := r.transact.Begin()
+	tx, err := persistence.BeginForCtx(ctx, r.transact)
 	if err != nil {
 		return nil, []error{err}
 	}
@@ -728,7 +728,7 @@ func (r *Resolver) DocumentsDataLoader(keys []dataloader.ParamDocument) ([]*grap
 		cursor = string(*keys[0].After)
 	}
 
-	tx, err := r.transact.Begin()
+	tx, err := persistence.BeginForCtx(ctx, r.transact)
 	if err != nil {
 		return nil, []error{err}
 	}
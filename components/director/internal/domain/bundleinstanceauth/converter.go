@@ -6,7 +6,9 @@ import (
 
 	"github.com/kyma-incubator/compass/components/director/internal/repo"
 
+	"github.com/kyma-incubator/compass/components/director/internal/domain/auth"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/encryption"
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 	"github.com/pkg/errors"
 )
@@ -19,13 +21,15 @@ type AuthConverter interface {
 }
 
 type converter struct {
-	authConverter AuthConverter
+	authConverter        AuthConverter
+	credentialsEncryptor encryption.Encryptor
 }
 
 // NewConverter missing godoc
-func NewConverter(authConverter AuthConverter) *converter {
+func NewConverter(authConverter AuthConverter, credentialsEncryptor encryption.Encryptor) *converter {
 	return &converter{
-		authConverter: authConverter,
+		authConverter:        authConverter,
+		credentialsEncryptor: credentialsEncryptor,
 	}
 }
 
@@ -214,7 +218,12 @@ func (c *converter) nullStringFromAuthPtr(in *model.Auth) (sql.NullString, error
 	if in == nil {
 		return sql.NullString{}, nil
 	}
-	valueMarshalled, err := json.Marshal(*in)
+	encryptedAuth, err := auth.EncryptCredentials(c.credentialsEncryptor, in)
+	if err != nil {
+		return sql.NullString{}, err
+	}
+
+	valueMarshalled, err := json.Marshal(*encryptedAuth)
 	if err != nil {
 		return sql.NullString{}, errors.Wrap(err, "while marshalling Auth")
 	}
@@ -228,10 +237,10 @@ func (c *converter) authPtrFromNullString(in sql.NullString) (*model.Auth, error
 	if !in.Valid {
 		return nil, nil
 	}
-	var auth model.Auth
-	err := json.Unmarshal([]byte(in.String), &auth)
+	var authValue model.Auth
+	err := json.Unmarshal([]byte(in.String), &authValue)
 	if err != nil {
 		return nil, err
 	}
-	return &auth, nil
+	return auth.DecryptCredentials(c.credentialsEncryptor, &authValue)
 }
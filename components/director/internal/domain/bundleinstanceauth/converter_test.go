@@ -8,6 +8,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/domain/bundleinstanceauth"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/bundleinstanceauth/automock"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/encryption"
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -80,7 +81,7 @@ func TestConverter_ToGraphQL(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			authConv := testCase.AuthConverterFn()
 
-			conv := bundleinstanceauth.NewConverter(authConv)
+			conv := bundleinstanceauth.NewConverter(authConv, encryption.NewNoopEncryptor())
 			// WHEN
 			result, err := conv.ToGraphQL(testCase.Input)
 
@@ -138,7 +139,7 @@ func TestConverter_MultipleToGraphQL(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			authConv := testCase.AuthConverterFn()
 
-			conv := bundleinstanceauth.NewConverter(authConv)
+			conv := bundleinstanceauth.NewConverter(authConv, encryption.NewNoopEncryptor())
 			// WHEN
 			result, err := conv.MultipleToGraphQL(testCase.Input)
 
@@ -184,7 +185,7 @@ func TestConverter_RequestInputFromGraphQL(t *testing.T) {
 
 	for _, testCase := range testCases {
 		t.Run(testCase.Name, func(t *testing.T) {
-			conv := bundleinstanceauth.NewConverter(nil)
+			conv := bundleinstanceauth.NewConverter(nil, encryption.NewNoopEncryptor())
 
 			// WHEN
 			result := conv.RequestInputFromGraphQL(testCase.Input)
@@ -245,7 +246,7 @@ func TestConverter_CreateInputFromGraphQL(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			authConv := testCase.AuthConverterFn()
 
-			conv := bundleinstanceauth.NewConverter(authConv)
+			conv := bundleinstanceauth.NewConverter(authConv, encryption.NewNoopEncryptor())
 
 			// WHEN
 			result, err := conv.CreateInputFromGraphQL(testCase.Input)
@@ -310,7 +311,7 @@ func TestConverter_UpdateInputFromGraphQL(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			authConv := testCase.AuthConverterFn()
 
-			conv := bundleinstanceauth.NewConverter(authConv)
+			conv := bundleinstanceauth.NewConverter(authConv, encryption.NewNoopEncryptor())
 
 			// WHEN
 			result, err := conv.UpdateInputFromGraphQL(testCase.Input)
@@ -390,7 +391,7 @@ func TestConverter_SetInputFromGraphQL(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			authConv := testCase.AuthConverterFn()
 
-			conv := bundleinstanceauth.NewConverter(authConv)
+			conv := bundleinstanceauth.NewConverter(authConv, encryption.NewNoopEncryptor())
 			// WHEN
 			result, err := conv.SetInputFromGraphQL(testCase.Input)
 
@@ -409,7 +410,7 @@ func TestConverter_ToEntity(t *testing.T) {
 		piaModel := fixModelBundleInstanceAuth(testID, testBundleID, testTenant, fixModelAuth(), fixModelStatusSucceeded(), &testRuntimeID)
 		piaEntity := fixEntityBundleInstanceAuth(t, testID, testBundleID, testTenant, fixModelAuth(), fixModelStatusSucceeded(), &testRuntimeID)
 
-		conv := bundleinstanceauth.NewConverter(nil)
+		conv := bundleinstanceauth.NewConverter(nil, encryption.NewNoopEncryptor())
 
 		// WHEN
 		entity, err := conv.ToEntity(piaModel)
@@ -424,7 +425,7 @@ func TestConverter_ToEntity(t *testing.T) {
 		piaModel := fixModelBundleInstanceAuthWithoutContextAndInputParams(testID, testBundleID, testTenant, nil, nil, nil)
 		piaEntity := fixEntityBundleInstanceAuthWithoutContextAndInputParams(t, testID, testBundleID, testTenant, nil, nil, nil)
 
-		conv := bundleinstanceauth.NewConverter(nil)
+		conv := bundleinstanceauth.NewConverter(nil, encryption.NewNoopEncryptor())
 
 		// WHEN
 		entity, err := conv.ToEntity(piaModel)
@@ -441,7 +442,7 @@ func TestConverter_FromEntity(t *testing.T) {
 		piaModel := fixModelBundleInstanceAuth(testID, testBundleID, testTenant, fixModelAuth(), fixModelStatusSucceeded(), &testRuntimeID)
 		piaEntity := fixEntityBundleInstanceAuth(t, testID, testBundleID, testTenant, fixModelAuth(), fixModelStatusSucceeded(), &testRuntimeID)
 
-		conv := bundleinstanceauth.NewConverter(nil)
+		conv := bundleinstanceauth.NewConverter(nil, encryption.NewNoopEncryptor())
 
 		// WHEN
 		result, err := conv.FromEntity(piaEntity)
@@ -456,7 +457,7 @@ func TestConverter_FromEntity(t *testing.T) {
 		piaModel := fixModelBundleInstanceAuthWithoutContextAndInputParams(testID, testBundleID, testTenant, nil, fixModelStatusPending(), nil)
 		piaEntity := fixEntityBundleInstanceAuthWithoutContextAndInputParams(t, testID, testBundleID, testTenant, nil, fixModelStatusPending(), nil)
 
-		conv := bundleinstanceauth.NewConverter(nil)
+		conv := bundleinstanceauth.NewConverter(nil, encryption.NewNoopEncryptor())
 
 		// WHEN
 		result, err := conv.FromEntity(piaEntity)
@@ -0,0 +1,111 @@
+package capability
+
+import (
+	"github.com/kyma-incubator/compass/components/director/internal/domain/ordresource"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+)
+
+type converter struct {
+}
+
+// NewConverter returns a new Converter that can later be used to make the conversions between the GraphQL, service, and repository layer representations of an ORD Capability.
+func NewConverter() *converter {
+	return &converter{}
+}
+
+// ToEntity converts the provided service-layer representation of a Capability to the repository-layer one.
+func (c *converter) ToEntity(in *model.Capability) *Entity {
+	if in == nil {
+		return nil
+	}
+
+	return &Entity{
+		ID:                           in.ID,
+		ApplicationID:                repo.NewNullableString(in.ApplicationID),
+		ApplicationTemplateVersionID: repo.NewNullableString(in.ApplicationTemplateVersionID),
+		PackageID:                    in.PackageID,
+		OrdID:                        in.OrdID,
+		LocalID:                      repo.NewNullableString(in.LocalID),
+		Title:                        in.Title,
+		ShortDescription:             repo.NewNullableString(in.ShortDescription),
+		Description:                  repo.NewNullableString(in.Description),
+		Type:                         in.Type,
+		CustomType:                   repo.NewNullableString(in.CustomType),
+		Version:                      in.Version,
+		Visibility:                   in.Visibility,
+		ReleaseStatus:                in.ReleaseStatus,
+		Definitions:                  repo.NewNullableStringFromJSONRawMessage(in.Definitions),
+		RelatedEntityTypes:           repo.NewNullableStringFromJSONRawMessage(in.RelatedEntityTypes),
+		Links:                        repo.NewNullableStringFromJSONRawMessage(in.Links),
+		Tags:                         repo.NewNullableStringFromJSONRawMessage(in.Tags),
+		Labels:                       repo.NewNullableStringFromJSONRawMessage(in.Labels),
+		DocumentationLabels:          repo.NewNullableStringFromJSONRawMessage(in.DocumentationLabels),
+		LastUpdate:                   repo.NewNullableString(in.LastUpdate),
+		ResourceHash:                 repo.NewNullableString(in.ResourceHash),
+	}
+}
+
+// FromEntity converts the provided Entity repo-layer representation of a Capability to the service-layer representation model.Capability.
+func (c *converter) FromEntity(entity *Entity) (*model.Capability, error) {
+	if entity == nil {
+		return nil, apperrors.NewInternalError("the Capability entity is nil")
+	}
+
+	return &model.Capability{
+		ID:                           entity.ID,
+		ApplicationID:                repo.StringPtrFromNullableString(entity.ApplicationID),
+		ApplicationTemplateVersionID: repo.StringPtrFromNullableString(entity.ApplicationTemplateVersionID),
+		PackageID:                    entity.PackageID,
+		OrdID:                        entity.OrdID,
+		LocalID:                      repo.StringPtrFromNullableString(entity.LocalID),
+		Title:                        entity.Title,
+		ShortDescription:             repo.StringPtrFromNullableString(entity.ShortDescription),
+		Description:                  repo.StringPtrFromNullableString(entity.Description),
+		Type:                         entity.Type,
+		CustomType:                   repo.StringPtrFromNullableString(entity.CustomType),
+		Version:                      entity.Version,
+		Visibility:                   entity.Visibility,
+		ReleaseStatus:                entity.ReleaseStatus,
+		Definitions:                  repo.JSONRawMessageFromNullableString(entity.Definitions),
+		RelatedEntityTypes:           repo.JSONRawMessageFromNullableString(entity.RelatedEntityTypes),
+		Links:                        repo.JSONRawMessageFromNullableString(entity.Links),
+		Tags:                         repo.JSONRawMessageFromNullableString(entity.Tags),
+		Labels:                       repo.JSONRawMessageFromNullableString(entity.Labels),
+		DocumentationLabels:          repo.JSONRawMessageFromNullableString(entity.DocumentationLabels),
+		LastUpdate:                   repo.StringPtrFromNullableString(entity.LastUpdate),
+		ResourceHash:                 repo.StringPtrFromNullableString(entity.ResourceHash),
+	}, nil
+}
+
+// ToGraphQL converts the provided service-layer representation of a Capability to the graphql-layer one.
+func (c *converter) ToGraphQL(in *model.Capability) *graphql.Capability {
+	if in == nil {
+		return nil
+	}
+
+	return &graphql.Capability{
+		ID:                 in.ID,
+		OrdID:              in.OrdID,
+		LocalID:            in.LocalID,
+		PackageID:          in.PackageID,
+		Title:              in.Title,
+		ShortDescription:   in.ShortDescription,
+		Description:        in.Description,
+		Type:               in.Type,
+		CustomType:         in.CustomType,
+		Version:            in.Version,
+		Visibility:         in.Visibility,
+		ReleaseStatus:      in.ReleaseStatus,
+		Definitions:        ordresource.JSONPtrFromRawMessage(in.Definitions),
+		RelatedEntityTypes: ordresource.JSONPtrFromRawMessage(in.RelatedEntityTypes),
+		LastUpdate:         in.LastUpdate,
+	}
+}
+
+// MultipleToGraphQL converts the provided service-layer representations of Capabilities to the graphql-layer ones.
+func (c *converter) MultipleToGraphQL(in []*model.Capability) []*graphql.Capability {
+	return ordresource.MultipleToGraphQL(in, c.ToGraphQL)
+}
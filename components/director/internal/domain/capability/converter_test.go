@@ -0,0 +1,69 @@
+package capability_test
+
+import (
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/capability"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEntityConverter_ToEntity(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		capabilityModel := fixCapabilityModelForApp()
+		require.NotNil(t, capabilityModel)
+		conv := capability.NewConverter()
+
+		entity := conv.ToEntity(capabilityModel)
+
+		assert.Equal(t, fixCapabilityEntityForApp(), entity)
+	})
+
+	t.Run("Returns nil if capability model is nil", func(t *testing.T) {
+		conv := capability.NewConverter()
+
+		ent := conv.ToEntity(nil)
+
+		require.Nil(t, ent)
+	})
+}
+
+func TestEntityConverter_FromEntity(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		entity := fixCapabilityEntityForApp()
+		conv := capability.NewConverter()
+
+		capabilityModel, err := conv.FromEntity(entity)
+
+		require.NoError(t, err)
+		assert.Equal(t, fixCapabilityModelForApp(), capabilityModel)
+	})
+
+	t.Run("Returns error if Entity is nil", func(t *testing.T) {
+		conv := capability.NewConverter()
+
+		_, err := conv.FromEntity(nil)
+
+		require.Error(t, err)
+	})
+}
+
+func TestConverter_MultipleToGraphQL(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		conv := capability.NewConverter()
+
+		gqlCapabilities := conv.MultipleToGraphQL([]*model.Capability{fixCapabilityModelForApp(), nil})
+
+		assert.Equal(t, []*graphql.Capability{fixGQLCapability()}, gqlCapabilities)
+	})
+
+	t.Run("Returns empty slice if input is empty", func(t *testing.T) {
+		conv := capability.NewConverter()
+
+		gqlCapabilities := conv.MultipleToGraphQL(nil)
+
+		assert.Empty(t, gqlCapabilities)
+	})
+}
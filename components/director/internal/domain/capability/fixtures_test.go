@@ -0,0 +1,179 @@
+package capability_test
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/capability"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+)
+
+const (
+	capabilityID     = "capabilityID"
+	packageID        = "packageID"
+	tenantID         = "b91b59f7-2563-40b2-aba9-fef726037aa3"
+	ordID            = "sap.s4:capability:MasterDataIntegration:v1"
+	capabilityType   = "sap.mdo:mdi-capability:v1"
+	definitions      = `[{"type":"sap.mdo:mdi-capability-definition:v1","mediaType":"application/json","url":"/definitions/mdi.json"}]`
+	externalTenantID = "externalTenantID"
+	resourceHash     = "123456"
+)
+
+var (
+	appID                = "appID"
+	appTemplateVersionID = "appTemplateVersionID"
+)
+
+func fixCapabilityEntityForApp() *capability.Entity {
+	return fixCapabilityEntityWithTitleForApp("title")
+}
+
+func fixCapabilityEntityForAppTemplateVersion() *capability.Entity {
+	return fixCapabilityEntityWithTitleForAppTemplateVersion("title")
+}
+
+func fixCapabilityEntityWithTitleForApp(title string) *capability.Entity {
+	entity := fixCapabilityEntityWithTitle(title)
+	entity.ApplicationID = repo.NewValidNullableString(appID)
+	return entity
+}
+
+func fixCapabilityEntityWithTitleForAppTemplateVersion(title string) *capability.Entity {
+	entity := fixCapabilityEntityWithTitle(title)
+	entity.ApplicationTemplateVersionID = repo.NewValidNullableString(appTemplateVersionID)
+	return entity
+}
+
+func fixCapabilityEntityWithTitle(title string) *capability.Entity {
+	return &capability.Entity{
+		ID:                  capabilityID,
+		PackageID:           packageID,
+		OrdID:               ordID,
+		Title:               title,
+		ShortDescription:    repo.NewValidNullableString("short desc"),
+		Description:         repo.NewValidNullableString("desc"),
+		Type:                capabilityType,
+		Version:             "1.0.0",
+		Visibility:          "public",
+		ReleaseStatus:       "active",
+		Definitions:         repo.NewValidNullableString(definitions),
+		RelatedEntityTypes:  repo.NewValidNullableString("[]"),
+		Links:               repo.NewValidNullableString("[]"),
+		Tags:                repo.NewValidNullableString("[]"),
+		Labels:              repo.NewValidNullableString("{}"),
+		DocumentationLabels: repo.NewValidNullableString("{}"),
+		ResourceHash:        repo.NewValidNullableString(resourceHash),
+	}
+}
+
+func fixNilModelCapability() *model.Capability {
+	return nil
+}
+
+func fixCapabilityModelForApp() *model.Capability {
+	return fixCapabilityModelWithTitleForApp("title")
+}
+
+func fixCapabilityModelForAppTemplateVersion() *model.Capability {
+	return fixCapabilityModelWithTitleForAppTemplateVersion("title")
+}
+
+func fixCapabilityModelWithTitleForApp(title string) *model.Capability {
+	c := fixCapabilityModelWithTitle(title)
+	c.ApplicationID = &appID
+	return c
+}
+
+func fixCapabilityModelWithTitleForAppTemplateVersion(title string) *model.Capability {
+	c := fixCapabilityModelWithTitle(title)
+	c.ApplicationTemplateVersionID = &appTemplateVersionID
+	return c
+}
+
+func fixCapabilityModelWithTitle(title string) *model.Capability {
+	return &model.Capability{
+		ID:                  capabilityID,
+		PackageID:           packageID,
+		OrdID:               ordID,
+		Title:               title,
+		ShortDescription:    str.Ptr("short desc"),
+		Description:         str.Ptr("desc"),
+		Type:                capabilityType,
+		Version:             "1.0.0",
+		Visibility:          "public",
+		ReleaseStatus:       "active",
+		Definitions:         json.RawMessage(definitions),
+		RelatedEntityTypes:  json.RawMessage("[]"),
+		Links:               json.RawMessage("[]"),
+		Tags:                json.RawMessage("[]"),
+		Labels:              json.RawMessage("{}"),
+		DocumentationLabels: json.RawMessage("{}"),
+		ResourceHash:        str.Ptr(resourceHash),
+	}
+}
+
+func fixCapabilityModelInput() *model.CapabilityInput {
+	return &model.CapabilityInput{
+		OrdID:               ordID,
+		Title:               "title",
+		ShortDescription:    str.Ptr("short desc"),
+		Description:         str.Ptr("desc"),
+		Type:                capabilityType,
+		OrdPackageID:        "sap.s4:package:S4HANA:v1",
+		Version:             "1.0.0",
+		Visibility:          "public",
+		ReleaseStatus:       "active",
+		Definitions:         json.RawMessage(definitions),
+		RelatedEntityTypes:  json.RawMessage("[]"),
+		Links:               json.RawMessage("[]"),
+		Tags:                json.RawMessage("[]"),
+		Labels:              json.RawMessage("{}"),
+		DocumentationLabels: json.RawMessage("{}"),
+	}
+}
+
+func fixGQLCapability() *graphql.Capability {
+	gqlDefinitions := graphql.JSON(definitions)
+	gqlRelatedEntityTypes := graphql.JSON("[]")
+	return &graphql.Capability{
+		ID:                 capabilityID,
+		OrdID:              ordID,
+		PackageID:          packageID,
+		Title:              "title",
+		ShortDescription:   str.Ptr("short desc"),
+		Description:        str.Ptr("desc"),
+		Type:               capabilityType,
+		Version:            "1.0.0",
+		Visibility:         "public",
+		ReleaseStatus:      "active",
+		Definitions:        &gqlDefinitions,
+		RelatedEntityTypes: &gqlRelatedEntityTypes,
+	}
+}
+
+func fixCapabilityColumns() []string {
+	return []string{"id", "app_id", "app_template_version_id", "package_id", "ord_id", "local_id", "title", "short_description",
+		"description", "type", "custom_type", "version", "visibility", "release_status", "definitions", "related_entity_types", "links", "tags",
+		"labels", "documentation_labels", "last_update", "resource_hash"}
+}
+
+func fixCapabilityRowWithTitleForApp(title string) []driver.Value {
+	return append([]driver.Value{capabilityID, appID, nil}, fixCapabilityRowWithTitle(title)...)
+}
+
+func fixCapabilityRowWithTitleForAppTemplateVersion(title string) []driver.Value {
+	return append([]driver.Value{capabilityID, nil, appTemplateVersionID}, fixCapabilityRowWithTitle(title)...)
+}
+
+func fixCapabilityRowWithTitle(title string) []driver.Value {
+	return []driver.Value{packageID, ordID, nil, title, "short desc", "desc", capabilityType, nil, "1.0.0", "public", "active", definitions, "[]",
+		"[]", "[]", "{}", "{}", nil, resourceHash}
+}
+
+func fixCapabilityUpdateArgs() []driver.Value {
+	return []driver.Value{packageID, nil, "title", "short desc", "desc", capabilityType, nil, "1.0.0", "public", "active", definitions, "[]",
+		"[]", "[]", "{}", "{}", nil, resourceHash}
+}
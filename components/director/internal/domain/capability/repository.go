@@ -0,0 +1,30 @@
+package capability
+
+import (
+	"github.com/kyma-incubator/compass/components/director/internal/domain/ordresource"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+)
+
+const capabilityTable = `public.capabilities`
+
+var (
+	capabilityColumns = []string{"id", "app_id", "app_template_version_id", "package_id", "ord_id", "local_id", "title", "short_description",
+		"description", "type", "custom_type", "version", "visibility", "release_status", "definitions", "related_entity_types", "links", "tags",
+		"labels", "documentation_labels", "last_update", "resource_hash"}
+	updatableColumns = []string{"package_id", "local_id", "title", "short_description", "description", "type", "custom_type", "version",
+		"visibility", "release_status", "definitions", "related_entity_types", "links", "tags", "labels", "documentation_labels", "last_update", "resource_hash"}
+)
+
+// EntityConverter converts Capabilities between the model.Capability service-layer representation and the repo-layer representation Entity.
+//
+//go:generate mockery --name=EntityConverter --output=automock --outpkg=automock --case=underscore --disable-version-string
+type EntityConverter interface {
+	ToEntity(in *model.Capability) *Entity
+	FromEntity(entity *Entity) (*model.Capability, error)
+}
+
+// NewRepository returns a new entity responsible for repo-layer Capability operations.
+func NewRepository(conv EntityConverter) *ordresource.Repository[model.Capability, Entity] {
+	return ordresource.NewRepository[model.Capability, Entity](resource.Capability, "Capability", capabilityTable, capabilityColumns, updatableColumns, conv)
+}
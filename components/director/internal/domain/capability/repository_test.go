@@ -0,0 +1,394 @@
+package capability_test
+
+import (
+	"context"
+	"database/sql/driver"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/capability"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/capability/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo/testdb"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/kyma-incubator/compass/components/director/pkg/scope"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPgRepository_Create(t *testing.T) {
+	suite := testdb.RepoCreateTestSuite{
+		Name: "Create Capability",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:    regexp.QuoteMeta("SELECT 1 FROM tenant_applications WHERE tenant_id = $1 AND id = $2 AND owner = $3"),
+				Args:     []driver.Value{tenantID, appID, true},
+				IsSelect: true,
+				ValidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{testdb.RowWhenObjectExist()}
+				},
+				InvalidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{testdb.RowWhenObjectDoesNotExist()}
+				},
+			},
+			{
+				Query:       `^INSERT INTO public.capabilities \(.+\) VALUES \(.+\)$`,
+				Args:        fixCapabilityRowWithTitleForApp("title"),
+				ValidResult: sqlmock.NewResult(-1, 1),
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		RepoConstructorFunc:       capability.NewRepository,
+		ModelEntity:               fixCapabilityModelForApp(),
+		DBEntity:                  fixCapabilityEntityForApp(),
+		NilModelEntity:            fixNilModelCapability(),
+		TenantID:                  tenantID,
+		DisableConverterErrorTest: true,
+	}
+
+	suite.Run(t)
+}
+
+func TestPgRepository_CreateGlobal(t *testing.T) {
+	suite := testdb.RepoCreateTestSuite{
+		Name: "Create Capability Global",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:       `^INSERT INTO public.capabilities \(.+\) VALUES \(.+\)$`,
+				Args:        fixCapabilityRowWithTitleForAppTemplateVersion("title"),
+				ValidResult: sqlmock.NewResult(-1, 1),
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		RepoConstructorFunc:       capability.NewRepository,
+		ModelEntity:               fixCapabilityModelForAppTemplateVersion(),
+		DBEntity:                  fixCapabilityEntityForAppTemplateVersion(),
+		NilModelEntity:            fixNilModelCapability(),
+		DisableConverterErrorTest: true,
+		IsGlobal:                  true,
+		MethodName:                "CreateGlobal",
+	}
+
+	suite.Run(t)
+}
+
+func TestPgRepository_Update(t *testing.T) {
+	entity := fixCapabilityEntityForApp()
+
+	suite := testdb.RepoUpdateTestSuite{
+		Name: "Update Capability",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:         regexp.QuoteMeta(`UPDATE public.capabilities SET package_id = ?, local_id = ?, title = ?, short_description = ?, description = ?, type = ?, custom_type = ?, version = ?, visibility = ?, release_status = ?, definitions = ?, related_entity_types = ?, links = ?, tags = ?, labels = ?, documentation_labels = ?, last_update = ?, resource_hash = ? WHERE id = ? AND (id IN (SELECT id FROM capabilities_tenants WHERE tenant_id = ? AND owner = true))`),
+				Args:          append(fixCapabilityUpdateArgs(), entity.ID, tenantID),
+				ValidResult:   sqlmock.NewResult(-1, 1),
+				InvalidResult: sqlmock.NewResult(-1, 0),
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		RepoConstructorFunc:       capability.NewRepository,
+		ModelEntity:               fixCapabilityModelForApp(),
+		DBEntity:                  entity,
+		NilModelEntity:            fixNilModelCapability(),
+		TenantID:                  tenantID,
+		DisableConverterErrorTest: true,
+	}
+
+	suite.Run(t)
+}
+
+func TestPgRepository_UpdateGlobal(t *testing.T) {
+	entity := fixCapabilityEntityForAppTemplateVersion()
+
+	suite := testdb.RepoUpdateTestSuite{
+		Name: "Update Capability Global",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:         regexp.QuoteMeta(`UPDATE public.capabilities SET package_id = ?, local_id = ?, title = ?, short_description = ?, description = ?, type = ?, custom_type = ?, version = ?, visibility = ?, release_status = ?, definitions = ?, related_entity_types = ?, links = ?, tags = ?, labels = ?, documentation_labels = ?, last_update = ?, resource_hash = ? WHERE id = ?`),
+				Args:          append(fixCapabilityUpdateArgs(), entity.ID),
+				ValidResult:   sqlmock.NewResult(-1, 1),
+				InvalidResult: sqlmock.NewResult(-1, 0),
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		RepoConstructorFunc:       capability.NewRepository,
+		ModelEntity:               fixCapabilityModelForAppTemplateVersion(),
+		DBEntity:                  entity,
+		NilModelEntity:            fixNilModelCapability(),
+		DisableConverterErrorTest: true,
+		IsGlobal:                  true,
+		UpdateMethodName:          "UpdateGlobal",
+	}
+
+	suite.Run(t)
+}
+
+func TestPgRepository_Delete(t *testing.T) {
+	suite := testdb.RepoDeleteTestSuite{
+		Name: "Capability Delete",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:         regexp.QuoteMeta(`DELETE FROM public.capabilities WHERE id = $1 AND (id IN (SELECT id FROM capabilities_tenants WHERE tenant_id = $2 AND owner = true))`),
+				Args:          []driver.Value{capabilityID, tenantID},
+				ValidResult:   sqlmock.NewResult(-1, 1),
+				InvalidResult: sqlmock.NewResult(-1, 2),
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		RepoConstructorFunc: capability.NewRepository,
+		MethodArgs:          []interface{}{tenantID, capabilityID},
+	}
+
+	suite.Run(t)
+}
+
+func TestPgRepository_DeleteGlobal(t *testing.T) {
+	suite := testdb.RepoDeleteTestSuite{
+		Name: "Capability Delete Global",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:         regexp.QuoteMeta(`DELETE FROM public.capabilities WHERE id = $1`),
+				Args:          []driver.Value{capabilityID},
+				ValidResult:   sqlmock.NewResult(-1, 1),
+				InvalidResult: sqlmock.NewResult(-1, 2),
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		RepoConstructorFunc: capability.NewRepository,
+		MethodArgs:          []interface{}{capabilityID},
+		IsGlobal:            true,
+		MethodName:          "DeleteGlobal",
+	}
+
+	suite.Run(t)
+}
+
+func TestPgRepository_GetByID(t *testing.T) {
+	suite := testdb.RepoGetTestSuite{
+		Name: "Get Capability",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:    regexp.QuoteMeta(`SELECT id, app_id, app_template_version_id, package_id, ord_id, local_id, title, short_description, description, type, custom_type, version, visibility, release_status, definitions, related_entity_types, links, tags, labels, documentation_labels, last_update, resource_hash FROM public.capabilities WHERE id = $1 AND (id IN (SELECT id FROM capabilities_tenants WHERE tenant_id = $2))`),
+				Args:     []driver.Value{capabilityID, tenantID},
+				IsSelect: true,
+				ValidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixCapabilityColumns()).AddRow(fixCapabilityRowWithTitleForApp("title")...)}
+				},
+				InvalidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixCapabilityColumns())}
+				},
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		RepoConstructorFunc: capability.NewRepository,
+		ExpectedModelEntity: fixCapabilityModelForApp(),
+		ExpectedDBEntity:    fixCapabilityEntityForApp(),
+		MethodArgs:          []interface{}{tenantID, capabilityID},
+	}
+
+	suite.Run(t)
+}
+
+func TestPgRepository_GetByIDGlobal(t *testing.T) {
+	suite := testdb.RepoGetTestSuite{
+		Name: "Get Capability Global",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:    regexp.QuoteMeta(`SELECT id, app_id, app_template_version_id, package_id, ord_id, local_id, title, short_description, description, type, custom_type, version, visibility, release_status, definitions, related_entity_types, links, tags, labels, documentation_labels, last_update, resource_hash FROM public.capabilities WHERE id = $1`),
+				Args:     []driver.Value{capabilityID},
+				IsSelect: true,
+				ValidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixCapabilityColumns()).AddRow(fixCapabilityRowWithTitleForAppTemplateVersion("title")...)}
+				},
+				InvalidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixCapabilityColumns())}
+				},
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		RepoConstructorFunc: capability.NewRepository,
+		ExpectedModelEntity: fixCapabilityModelForAppTemplateVersion(),
+		ExpectedDBEntity:    fixCapabilityEntityForAppTemplateVersion(),
+		MethodArgs:          []interface{}{capabilityID},
+		MethodName:          "GetByIDGlobal",
+	}
+
+	suite.Run(t)
+}
+
+func TestPgRepository_ListByResourceID(t *testing.T) {
+	suiteForApp := testdb.RepoListTestSuite{
+		Name: "List Capabilities For Application",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:    regexp.QuoteMeta(`SELECT id, app_id, app_template_version_id, package_id, ord_id, local_id, title, short_description, description, type, custom_type, version, visibility, release_status, definitions, related_entity_types, links, tags, labels, documentation_labels, last_update, resource_hash FROM public.capabilities WHERE app_id = $1 AND (id IN (SELECT id FROM capabilities_tenants WHERE tenant_id = $2)) FOR UPDATE`),
+				Args:     []driver.Value{appID, tenantID},
+				IsSelect: true,
+				ValidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixCapabilityColumns()).AddRow(fixCapabilityRowWithTitleForApp("title1")...).AddRow(fixCapabilityRowWithTitleForApp("title2")...)}
+				},
+				InvalidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixCapabilityColumns())}
+				},
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		RepoConstructorFunc:   capability.NewRepository,
+		ExpectedModelEntities: []interface{}{fixCapabilityModelWithTitleForApp("title1"), fixCapabilityModelWithTitleForApp("title2")},
+		ExpectedDBEntities:    []interface{}{fixCapabilityEntityWithTitleForApp("title1"), fixCapabilityEntityWithTitleForApp("title2")},
+		MethodArgs:            []interface{}{tenantID, appID, resource.Application},
+		MethodName:            "ListByResourceID",
+	}
+
+	suiteForAppTemplateVersion := testdb.RepoListTestSuite{
+		Name: "List Capabilities for Application Template Version",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:    regexp.QuoteMeta(`SELECT id, app_id, app_template_version_id, package_id, ord_id, local_id, title, short_description, description, type, custom_type, version, visibility, release_status, definitions, related_entity_types, links, tags, labels, documentation_labels, last_update, resource_hash FROM public.capabilities WHERE app_template_version_id = $1 FOR UPDATE`),
+				Args:     []driver.Value{appTemplateVersionID},
+				IsSelect: true,
+				ValidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixCapabilityColumns()).AddRow(fixCapabilityRowWithTitleForAppTemplateVersion("title1")...).AddRow(fixCapabilityRowWithTitleForAppTemplateVersion("title2")...)}
+				},
+				InvalidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixCapabilityColumns())}
+				},
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		RepoConstructorFunc:   capability.NewRepository,
+		ExpectedModelEntities: []interface{}{fixCapabilityModelWithTitleForAppTemplateVersion("title1"), fixCapabilityModelWithTitleForAppTemplateVersion("title2")},
+		ExpectedDBEntities:    []interface{}{fixCapabilityEntityWithTitleForAppTemplateVersion("title1"), fixCapabilityEntityWithTitleForAppTemplateVersion("title2")},
+		MethodArgs:            []interface{}{tenantID, appTemplateVersionID, resource.ApplicationTemplateVersion},
+		MethodName:            "ListByResourceID",
+	}
+
+	suiteForApp.Run(t)
+	suiteForAppTemplateVersion.Run(t)
+}
+
+func TestPgRepository_ListByApplicationIDs(t *testing.T) {
+	secondAppID := "secondAppID"
+
+	suite := testdb.RepoListTestSuite{
+		Name: "List Capabilities For Applications",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:    regexp.QuoteMeta(`SELECT id, app_id, app_template_version_id, package_id, ord_id, local_id, title, short_description, description, type, custom_type, version, visibility, release_status, definitions, related_entity_types, links, tags, labels, documentation_labels, last_update, resource_hash FROM public.capabilities WHERE app_id IN ($1, $2) AND (id IN (SELECT id FROM capabilities_tenants WHERE tenant_id = $3))`),
+				Args:     []driver.Value{appID, secondAppID, tenantID},
+				IsSelect: true,
+				ValidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixCapabilityColumns()).AddRow(fixCapabilityRowWithTitleForApp("title1")...).AddRow(fixCapabilityRowWithTitleForApp("title2")...)}
+				},
+				InvalidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixCapabilityColumns())}
+				},
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		RepoConstructorFunc:   capability.NewRepository,
+		ExpectedModelEntities: []interface{}{fixCapabilityModelWithTitleForApp("title1"), fixCapabilityModelWithTitleForApp("title2")},
+		ExpectedDBEntities:    []interface{}{fixCapabilityEntityWithTitleForApp("title1"), fixCapabilityEntityWithTitleForApp("title2")},
+		MethodArgs:            []interface{}{tenantID, []string{appID, secondAppID}},
+		MethodName:            "ListByApplicationIDs",
+	}
+
+	suite.Run(t)
+
+	t.Run("lists only public Capabilities when there is no internal_visibility scope", func(t *testing.T) {
+		sqlxDB, sqlMock := testdb.MockDatabase(t)
+		sqlMock.ExpectQuery(regexp.QuoteMeta(`SELECT id, app_id, app_template_version_id, package_id, ord_id, local_id, title, short_description, description, type, custom_type, version, visibility, release_status, definitions, related_entity_types, links, tags, labels, documentation_labels, last_update, resource_hash FROM public.capabilities WHERE app_id IN ($1) AND visibility = $2 AND (id IN (SELECT id FROM capabilities_tenants WHERE tenant_id = $3))`)).
+			WithArgs(appID, "public", tenantID).
+			WillReturnRows(sqlmock.NewRows(fixCapabilityColumns()).AddRow(fixCapabilityRowWithTitleForApp("title1")...))
+
+		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+		ctx = scope.SaveToContext(ctx, []string{"application:read"})
+		convMock := &automock.EntityConverter{}
+		convMock.On("FromEntity", fixCapabilityEntityWithTitleForApp("title1")).Return(fixCapabilityModelWithTitleForApp("title1"), nil).Once()
+
+		// WHEN
+		result, err := capability.NewRepository(convMock).ListByApplicationIDs(ctx, tenantID, []string{appID})
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, []*model.Capability{fixCapabilityModelWithTitleForApp("title1")}, result)
+		sqlMock.AssertExpectations(t)
+		convMock.AssertExpectations(t)
+	})
+
+	t.Run("lists all Capabilities when there is internal_visibility scope", func(t *testing.T) {
+		sqlxDB, sqlMock := testdb.MockDatabase(t)
+		sqlMock.ExpectQuery(regexp.QuoteMeta(`SELECT id, app_id, app_template_version_id, package_id, ord_id, local_id, title, short_description, description, type, custom_type, version, visibility, release_status, definitions, related_entity_types, links, tags, labels, documentation_labels, last_update, resource_hash FROM public.capabilities WHERE app_id IN ($1) AND (id IN (SELECT id FROM capabilities_tenants WHERE tenant_id = $2))`)).
+			WithArgs(appID, tenantID).
+			WillReturnRows(sqlmock.NewRows(fixCapabilityColumns()).AddRow(fixCapabilityRowWithTitleForApp("title1")...))
+
+		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+		ctx = scope.SaveToContext(ctx, []string{"application:read", "internal_visibility:read"})
+		convMock := &automock.EntityConverter{}
+		convMock.On("FromEntity", fixCapabilityEntityWithTitleForApp("title1")).Return(fixCapabilityModelWithTitleForApp("title1"), nil).Once()
+
+		// WHEN
+		result, err := capability.NewRepository(convMock).ListByApplicationIDs(ctx, tenantID, []string{appID})
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, []*model.Capability{fixCapabilityModelWithTitleForApp("title1")}, result)
+		sqlMock.AssertExpectations(t)
+		convMock.AssertExpectations(t)
+	})
+}
+
+func TestPgRepository_ListByPackageIDs(t *testing.T) {
+	secondPackageID := "secondPackageID"
+
+	suite := testdb.RepoListTestSuite{
+		Name: "List Capabilities For Packages",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:    regexp.QuoteMeta(`SELECT id, app_id, app_template_version_id, package_id, ord_id, local_id, title, short_description, description, type, custom_type, version, visibility, release_status, definitions, related_entity_types, links, tags, labels, documentation_labels, last_update, resource_hash FROM public.capabilities WHERE package_id IN ($1, $2) AND (id IN (SELECT id FROM capabilities_tenants WHERE tenant_id = $3))`),
+				Args:     []driver.Value{packageID, secondPackageID, tenantID},
+				IsSelect: true,
+				ValidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixCapabilityColumns()).AddRow(fixCapabilityRowWithTitleForApp("title1")...).AddRow(fixCapabilityRowWithTitleForApp("title2")...)}
+				},
+				InvalidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixCapabilityColumns())}
+				},
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		RepoConstructorFunc:   capability.NewRepository,
+		ExpectedModelEntities: []interface{}{fixCapabilityModelWithTitleForApp("title1"), fixCapabilityModelWithTitleForApp("title2")},
+		ExpectedDBEntities:    []interface{}{fixCapabilityEntityWithTitleForApp("title1"), fixCapabilityEntityWithTitleForApp("title2")},
+		MethodArgs:            []interface{}{tenantID, []string{packageID, secondPackageID}},
+		MethodName:            "ListByPackageIDs",
+	}
+
+	suite.Run(t)
+}
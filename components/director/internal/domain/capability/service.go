@@ -0,0 +1,218 @@
+package capability
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/pkg/errors"
+)
+
+// CapabilityRepository is responsible for the repo-layer Capability operations.
+//
+//go:generate mockery --name=CapabilityRepository --output=automock --outpkg=automock --case=underscore --disable-version-string
+type CapabilityRepository interface {
+	Create(ctx context.Context, tenant string, item *model.Capability) error
+	CreateGlobal(ctx context.Context, model *model.Capability) error
+	Update(ctx context.Context, tenant string, item *model.Capability) error
+	UpdateGlobal(ctx context.Context, model *model.Capability) error
+	Delete(ctx context.Context, tenant, id string) error
+	DeleteGlobal(ctx context.Context, id string) error
+	GetByID(ctx context.Context, tenant, id string) (*model.Capability, error)
+	GetByIDGlobal(ctx context.Context, id string) (*model.Capability, error)
+	ListByResourceID(ctx context.Context, tenantID, resourceID string, resourceType resource.Type) ([]*model.Capability, error)
+	ListByApplicationIDs(ctx context.Context, tenantID string, appIDs []string) ([]*model.Capability, error)
+	ListByPackageIDs(ctx context.Context, tenantID string, packageIDs []string) ([]*model.Capability, error)
+}
+
+// UIDService is responsible for generating GUIDs, which will be used as internal Capability IDs.
+//
+//go:generate mockery --name=UIDService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type UIDService interface {
+	Generate() string
+}
+
+type service struct {
+	capabilityRepo CapabilityRepository
+	uidService     UIDService
+}
+
+// NewService returns a new object responsible for service-layer Capability operations.
+func NewService(capabilityRepo CapabilityRepository, uidService UIDService) *service {
+	return &service{
+		capabilityRepo: capabilityRepo,
+		uidService:     uidService,
+	}
+}
+
+// Create creates a Capability which is part of the given Package for a given resource.Type
+func (s *service) Create(ctx context.Context, resourceType resource.Type, resourceID, packageID string, in model.CapabilityInput, capabilityHash uint64) (string, error) {
+	id := s.uidService.Generate()
+	capability := in.ToCapability(id, resourceType, resourceID, packageID, capabilityHash)
+
+	if err := s.createCapability(ctx, capability, resourceType); err != nil {
+		return "", errors.Wrapf(err, "error occurred while creating a Capability with id %s and title %s for %s with id %s", id, capability.Title, resourceType, resourceID)
+	}
+
+	log.C(ctx).Debugf("Successfully created a Capability with id %s and title %s for %s with id %s", id, capability.Title, resourceType, resourceID)
+
+	return id, nil
+}
+
+// Update updates a Capability by ID for a given resource.Type
+func (s *service) Update(ctx context.Context, resourceType resource.Type, id, packageID string, in model.CapabilityInput, capabilityHash uint64) error {
+	capability, err := s.getCapability(ctx, id, resourceType)
+	if err != nil {
+		return errors.Wrapf(err, "while getting Capability with id %s", id)
+	}
+
+	capability.SetFromUpdateInput(in, packageID, capabilityHash)
+
+	if err = s.updateCapability(ctx, capability, resourceType); err != nil {
+		return errors.Wrapf(err, "while updating Capability with id %s", id)
+	}
+
+	return nil
+}
+
+// Delete deletes a Capability by ID for a given resource.Type
+func (s *service) Delete(ctx context.Context, resourceType resource.Type, id string) error {
+	if err := s.deleteCapability(ctx, id, resourceType); err != nil {
+		return errors.Wrapf(err, "while deleting Capability with id %s", id)
+	}
+
+	log.C(ctx).Infof("Successfully deleted Capability with id %s", id)
+
+	return nil
+}
+
+// Get returns a Capability by ID
+func (s *service) Get(ctx context.Context, id string) (*model.Capability, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "while loading tenant from context")
+	}
+
+	capability, err := s.capabilityRepo.GetByID(ctx, tnt, id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while getting Capability with ID: %q", id)
+	}
+
+	return capability, nil
+}
+
+// ListByApplicationID lists Capabilities by Application ID
+func (s *service) ListByApplicationID(ctx context.Context, appID string) ([]*model.Capability, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.capabilityRepo.ListByResourceID(ctx, tnt, appID, resource.Application)
+}
+
+// ListByApplicationTemplateVersionID lists Capabilities by Application Template Version ID without tenant isolation
+func (s *service) ListByApplicationTemplateVersionID(ctx context.Context, appTemplateVersionID string) ([]*model.Capability, error) {
+	return s.capabilityRepo.ListByResourceID(ctx, "", appTemplateVersionID, resource.ApplicationTemplateVersion)
+}
+
+// ListByApplicationIDs lists the Capabilities of each of the given Applications which are visible to the caller, in the order of the Application IDs
+func (s *service) ListByApplicationIDs(ctx context.Context, appIDs []string) ([][]*model.Capability, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	capabilities, err := s.capabilityRepo.ListByApplicationIDs(ctx, tnt, appIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	capabilitiesPerApplication := make(map[string][]*model.Capability, len(appIDs))
+	for _, item := range capabilities {
+		if item.ApplicationID != nil {
+			capabilitiesPerApplication[*item.ApplicationID] = append(capabilitiesPerApplication[*item.ApplicationID], item)
+		}
+	}
+
+	result := make([][]*model.Capability, 0, len(appIDs))
+	for _, appID := range appIDs {
+		result = append(result, capabilitiesPerApplication[appID])
+	}
+
+	return result, nil
+}
+
+// ListByPackageIDs lists the Capabilities which are part of each of the given Packages and are visible to the caller, in the order of the Package IDs
+func (s *service) ListByPackageIDs(ctx context.Context, packageIDs []string) ([][]*model.Capability, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	capabilities, err := s.capabilityRepo.ListByPackageIDs(ctx, tnt, packageIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	capabilitiesPerPackage := make(map[string][]*model.Capability, len(packageIDs))
+	for _, item := range capabilities {
+		capabilitiesPerPackage[item.PackageID] = append(capabilitiesPerPackage[item.PackageID], item)
+	}
+
+	result := make([][]*model.Capability, 0, len(packageIDs))
+	for _, packageID := range packageIDs {
+		result = append(result, capabilitiesPerPackage[packageID])
+	}
+
+	return result, nil
+}
+
+func (s *service) createCapability(ctx context.Context, capability *model.Capability, resourceType resource.Type) error {
+	if resourceType.IsTenantIgnorable() {
+		return s.capabilityRepo.CreateGlobal(ctx, capability)
+	}
+
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	return s.capabilityRepo.Create(ctx, tnt, capability)
+}
+
+func (s *service) getCapability(ctx context.Context, id string, resourceType resource.Type) (*model.Capability, error) {
+	if resourceType.IsTenantIgnorable() {
+		return s.capabilityRepo.GetByIDGlobal(ctx, id)
+	}
+
+	return s.Get(ctx, id)
+}
+
+func (s *service) updateCapability(ctx context.Context, capability *model.Capability, resourceType resource.Type) error {
+	if resourceType.IsTenantIgnorable() {
+		return s.capabilityRepo.UpdateGlobal(ctx, capability)
+	}
+
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	return s.capabilityRepo.Update(ctx, tnt, capability)
+}
+
+func (s *service) deleteCapability(ctx context.Context, id string, resourceType resource.Type) error {
+	if resourceType.IsTenantIgnorable() {
+		return s.capabilityRepo.DeleteGlobal(ctx, id)
+	}
+
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return errors.Wrap(err, "while loading tenant from context")
+	}
+
+	return s.capabilityRepo.Delete(ctx, tnt, id)
+}
@@ -0,0 +1,725 @@
+package capability_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/capability"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/capability/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_Create(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("Test error")
+
+	ctx := context.TODO()
+	ctx = tenant.SaveToContext(ctx, tenantID, externalTenantID)
+
+	modelCapabilityForApp := fixCapabilityModelForApp()
+	modelCapabilityForAppTemplateVersion := fixCapabilityModelForAppTemplateVersion()
+	modelInput := *fixCapabilityModelInput()
+
+	testCases := []struct {
+		Name         string
+		RepositoryFn func() *automock.CapabilityRepository
+		UIDServiceFn func() *automock.UIDService
+		Input        model.CapabilityInput
+		ResourceType resource.Type
+		ResourceID   string
+		ExpectedErr  error
+	}{
+		{
+			Name: "Success for Application",
+			RepositoryFn: func() *automock.CapabilityRepository {
+				repo := &automock.CapabilityRepository{}
+				repo.On("Create", ctx, tenantID, modelCapabilityForApp).Return(nil).Once()
+				return repo
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				svc := &automock.UIDService{}
+				svc.On("Generate").Return(capabilityID)
+				return svc
+			},
+			Input:        modelInput,
+			ResourceType: resource.Application,
+			ResourceID:   appID,
+			ExpectedErr:  nil,
+		},
+		{
+			Name: "Success for Application Template Version",
+			RepositoryFn: func() *automock.CapabilityRepository {
+				repo := &automock.CapabilityRepository{}
+				repo.On("CreateGlobal", ctx, modelCapabilityForAppTemplateVersion).Return(nil).Once()
+				return repo
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				svc := &automock.UIDService{}
+				svc.On("Generate").Return(capabilityID)
+				return svc
+			},
+			Input:        modelInput,
+			ResourceType: resource.ApplicationTemplateVersion,
+			ResourceID:   appTemplateVersionID,
+			ExpectedErr:  nil,
+		},
+		{
+			Name: "Error - Capability creation for Application",
+			RepositoryFn: func() *automock.CapabilityRepository {
+				repo := &automock.CapabilityRepository{}
+				repo.On("Create", ctx, tenantID, modelCapabilityForApp).Return(testErr).Once()
+				return repo
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				svc := &automock.UIDService{}
+				svc.On("Generate").Return(capabilityID).Once()
+				return svc
+			},
+			Input:        modelInput,
+			ResourceType: resource.Application,
+			ResourceID:   appID,
+			ExpectedErr:  testErr,
+		},
+		{
+			Name: "Error - Capability creation for Application Template Version",
+			RepositoryFn: func() *automock.CapabilityRepository {
+				repo := &automock.CapabilityRepository{}
+				repo.On("CreateGlobal", ctx, modelCapabilityForAppTemplateVersion).Return(testErr).Once()
+				return repo
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				svc := &automock.UIDService{}
+				svc.On("Generate").Return(capabilityID).Once()
+				return svc
+			},
+			Input:        modelInput,
+			ResourceType: resource.ApplicationTemplateVersion,
+			ResourceID:   appTemplateVersionID,
+			ExpectedErr:  testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			repo := testCase.RepositoryFn()
+			uidService := testCase.UIDServiceFn()
+
+			svc := capability.NewService(repo, uidService)
+
+			// WHEN
+			result, err := svc.Create(ctx, testCase.ResourceType, testCase.ResourceID, packageID, testCase.Input, uint64(123456))
+
+			// THEN
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				assert.IsType(t, "string", result)
+			}
+
+			mock.AssertExpectationsForObjects(t, repo, uidService)
+		})
+	}
+	t.Run("Error when tenant not in context", func(t *testing.T) {
+		svc := capability.NewService(nil, fixUIDService())
+		// WHEN
+		_, err := svc.Create(context.TODO(), resource.Application, "", "", model.CapabilityInput{}, 0)
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot read tenant from context")
+	})
+}
+
+func TestService_Update(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("Test error")
+
+	modelCapabilityForApp := fixCapabilityModelForApp()
+	modelCapabilityForAppTemplateVersion := fixCapabilityModelForAppTemplateVersion()
+	modelInput := *fixCapabilityModelInput()
+
+	inputCapabilityModel := mock.MatchedBy(func(c *model.Capability) bool {
+		return c.Title == modelInput.Title
+	})
+
+	ctx := context.TODO()
+	ctx = tenant.SaveToContext(ctx, tenantID, externalTenantID)
+
+	testCases := []struct {
+		Name         string
+		RepositoryFn func() *automock.CapabilityRepository
+		Input        model.CapabilityInput
+		InputID      string
+		ResourceType resource.Type
+		ExpectedErr  error
+	}{
+		{
+			Name: "Success for Application",
+			RepositoryFn: func() *automock.CapabilityRepository {
+				repo := &automock.CapabilityRepository{}
+				repo.On("GetByID", ctx, tenantID, capabilityID).Return(modelCapabilityForApp, nil).Once()
+				repo.On("Update", ctx, tenantID, inputCapabilityModel).Return(nil).Once()
+				return repo
+			},
+			InputID:      capabilityID,
+			Input:        modelInput,
+			ResourceType: resource.Application,
+			ExpectedErr:  nil,
+		},
+		{
+			Name: "Success for Application Template Version",
+			RepositoryFn: func() *automock.CapabilityRepository {
+				repo := &automock.CapabilityRepository{}
+				repo.On("GetByIDGlobal", ctx, capabilityID).Return(modelCapabilityForAppTemplateVersion, nil).Once()
+				repo.On("UpdateGlobal", ctx, inputCapabilityModel).Return(nil).Once()
+				return repo
+			},
+			InputID:      capabilityID,
+			Input:        modelInput,
+			ResourceType: resource.ApplicationTemplateVersion,
+			ExpectedErr:  nil,
+		},
+		{
+			Name: "Update Error for Application",
+			RepositoryFn: func() *automock.CapabilityRepository {
+				repo := &automock.CapabilityRepository{}
+				repo.On("GetByID", ctx, tenantID, capabilityID).Return(modelCapabilityForApp, nil).Once()
+				repo.On("Update", ctx, tenantID, inputCapabilityModel).Return(testErr).Once()
+				return repo
+			},
+			InputID:      capabilityID,
+			Input:        modelInput,
+			ResourceType: resource.Application,
+			ExpectedErr:  testErr,
+		},
+		{
+			Name: "Update Error for Application Template Version",
+			RepositoryFn: func() *automock.CapabilityRepository {
+				repo := &automock.CapabilityRepository{}
+				repo.On("GetByIDGlobal", ctx, capabilityID).Return(modelCapabilityForAppTemplateVersion, nil).Once()
+				repo.On("UpdateGlobal", ctx, inputCapabilityModel).Return(testErr).Once()
+				return repo
+			},
+			InputID:      capabilityID,
+			Input:        modelInput,
+			ResourceType: resource.ApplicationTemplateVersion,
+			ExpectedErr:  testErr,
+		},
+		{
+			Name: "Get Error for Application",
+			RepositoryFn: func() *automock.CapabilityRepository {
+				repo := &automock.CapabilityRepository{}
+				repo.On("GetByID", ctx, tenantID, capabilityID).Return(nil, testErr).Once()
+				return repo
+			},
+			InputID:      capabilityID,
+			Input:        modelInput,
+			ResourceType: resource.Application,
+			ExpectedErr:  testErr,
+		},
+		{
+			Name: "Get Error for Application Template Version",
+			RepositoryFn: func() *automock.CapabilityRepository {
+				repo := &automock.CapabilityRepository{}
+				repo.On("GetByIDGlobal", ctx, capabilityID).Return(nil, testErr).Once()
+				return repo
+			},
+			InputID:      capabilityID,
+			Input:        modelInput,
+			ResourceType: resource.ApplicationTemplateVersion,
+			ExpectedErr:  testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			repo := testCase.RepositoryFn()
+
+			svc := capability.NewService(repo, nil)
+
+			// WHEN
+			err := svc.Update(ctx, testCase.ResourceType, testCase.InputID, packageID, testCase.Input, 0)
+
+			// THEN
+			if testCase.ExpectedErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+	t.Run("Error when tenant not in context", func(t *testing.T) {
+		svc := capability.NewService(nil, fixUIDService())
+		// WHEN
+		err := svc.Update(context.TODO(), resource.Application, "", "", model.CapabilityInput{}, 0)
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot read tenant from context")
+	})
+}
+
+func TestService_Delete(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("Test error")
+
+	ctx := context.TODO()
+	ctx = tenant.SaveToContext(ctx, tenantID, externalTenantID)
+
+	testCases := []struct {
+		Name         string
+		RepositoryFn func() *automock.CapabilityRepository
+		Input        model.CapabilityInput
+		InputID      string
+		ResourceType resource.Type
+		ExpectedErr  error
+	}{
+		{
+			Name: "Success for Application",
+			RepositoryFn: func() *automock.CapabilityRepository {
+				repo := &automock.CapabilityRepository{}
+				repo.On("Delete", ctx, tenantID, capabilityID).Return(nil).Once()
+				return repo
+			},
+			InputID:      capabilityID,
+			ResourceType: resource.Application,
+			ExpectedErr:  nil,
+		},
+		{
+			Name: "Success for Application",
+			RepositoryFn: func() *automock.CapabilityRepository {
+				repo := &automock.CapabilityRepository{}
+				repo.On("DeleteGlobal", ctx, capabilityID).Return(nil).Once()
+				return repo
+			},
+			InputID:      capabilityID,
+			ResourceType: resource.ApplicationTemplateVersion,
+			ExpectedErr:  nil,
+		},
+		{
+			Name: "Delete Error for Application",
+			RepositoryFn: func() *automock.CapabilityRepository {
+				repo := &automock.CapabilityRepository{}
+				repo.On("Delete", ctx, tenantID, capabilityID).Return(testErr).Once()
+				return repo
+			},
+			InputID:      capabilityID,
+			ResourceType: resource.Application,
+			ExpectedErr:  testErr,
+		},
+		{
+			Name: "Delete Error for Application Template Version",
+			RepositoryFn: func() *automock.CapabilityRepository {
+				repo := &automock.CapabilityRepository{}
+				repo.On("DeleteGlobal", ctx, capabilityID).Return(testErr).Once()
+				return repo
+			},
+			InputID:      capabilityID,
+			ResourceType: resource.ApplicationTemplateVersion,
+			ExpectedErr:  testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			repo := testCase.RepositoryFn()
+
+			svc := capability.NewService(repo, nil)
+
+			// WHEN
+			err := svc.Delete(ctx, testCase.ResourceType, testCase.InputID)
+
+			// THEN
+			if testCase.ExpectedErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+	t.Run("Error when tenant not in context", func(t *testing.T) {
+		svc := capability.NewService(nil, nil)
+		// WHEN
+		err := svc.Delete(context.TODO(), resource.Application, "")
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot read tenant from context")
+	})
+}
+
+func TestService_Get(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("Test error")
+
+	capabilityModel := fixCapabilityModelForApp()
+
+	ctx := context.TODO()
+	ctx = tenant.SaveToContext(ctx, tenantID, externalTenantID)
+
+	testCases := []struct {
+		Name               string
+		RepositoryFn       func() *automock.CapabilityRepository
+		Input              model.CapabilityInput
+		InputID            string
+		ExpectedCapability *model.Capability
+		ExpectedErrMessage string
+	}{
+		{
+			Name: "Success",
+			RepositoryFn: func() *automock.CapabilityRepository {
+				repo := &automock.CapabilityRepository{}
+				repo.On("GetByID", ctx, tenantID, capabilityID).Return(capabilityModel, nil).Once()
+				return repo
+			},
+			InputID:            capabilityID,
+			ExpectedCapability: capabilityModel,
+			ExpectedErrMessage: "",
+		},
+		{
+			Name: "Returns error when Capability retrieval failed",
+			RepositoryFn: func() *automock.CapabilityRepository {
+				repo := &automock.CapabilityRepository{}
+				repo.On("GetByID", ctx, tenantID, capabilityID).Return(nil, testErr).Once()
+				return repo
+			},
+			InputID:            capabilityID,
+			ExpectedCapability: capabilityModel,
+			ExpectedErrMessage: testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepositoryFn()
+			svc := capability.NewService(repo, nil)
+
+			// WHEN
+			capabilityModel, err := svc.Get(ctx, testCase.InputID)
+
+			// then
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedCapability, capabilityModel)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+	t.Run("Error when tenant not in context", func(t *testing.T) {
+		svc := capability.NewService(nil, nil)
+		// WHEN
+		_, err := svc.Get(context.TODO(), "")
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot read tenant from context")
+	})
+}
+
+func TestService_ListByApplicationID(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("Test error")
+
+	capabilities := []*model.Capability{
+		fixCapabilityModelForApp(),
+		fixCapabilityModelForApp(),
+		fixCapabilityModelForApp(),
+	}
+
+	ctx := context.TODO()
+	ctx = tenant.SaveToContext(ctx, tenantID, externalTenantID)
+
+	testCases := []struct {
+		Name               string
+		PageSize           int
+		RepositoryFn       func() *automock.CapabilityRepository
+		ExpectedResult     []*model.Capability
+		ExpectedErrMessage string
+	}{
+		{
+			Name: "Success",
+			RepositoryFn: func() *automock.CapabilityRepository {
+				repo := &automock.CapabilityRepository{}
+				repo.On("ListByResourceID", ctx, tenantID, appID, resource.Application).Return(capabilities, nil).Once()
+				return repo
+			},
+			PageSize:           2,
+			ExpectedResult:     capabilities,
+			ExpectedErrMessage: "",
+		},
+		{
+			Name: "Returns error when Capability listing failed",
+			RepositoryFn: func() *automock.CapabilityRepository {
+				repo := &automock.CapabilityRepository{}
+				repo.On("ListByResourceID", ctx, tenantID, appID, resource.Application).Return(nil, testErr).Once()
+				return repo
+			},
+			PageSize:           2,
+			ExpectedResult:     nil,
+			ExpectedErrMessage: testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepositoryFn()
+
+			svc := capability.NewService(repo, nil)
+
+			// WHEN
+			capabilities, err := svc.ListByApplicationID(ctx, appID)
+
+			// then
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedResult, capabilities)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+	t.Run("Error when tenant not in context", func(t *testing.T) {
+		svc := capability.NewService(nil, nil)
+		// WHEN
+		_, err := svc.ListByApplicationID(context.TODO(), "")
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot read tenant from context")
+	})
+}
+
+func TestService_ListByApplicationTemplateVersionID(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("Test error")
+
+	capabilities := []*model.Capability{
+		fixCapabilityModelForApp(),
+		fixCapabilityModelForApp(),
+		fixCapabilityModelForApp(),
+	}
+
+	ctx := context.TODO()
+
+	testCases := []struct {
+		Name               string
+		PageSize           int
+		RepositoryFn       func() *automock.CapabilityRepository
+		ExpectedResult     []*model.Capability
+		ExpectedErrMessage string
+	}{
+		{
+			Name: "Success",
+			RepositoryFn: func() *automock.CapabilityRepository {
+				repo := &automock.CapabilityRepository{}
+				repo.On("ListByResourceID", ctx, "", appTemplateVersionID, resource.ApplicationTemplateVersion).Return(capabilities, nil).Once()
+				return repo
+			},
+			PageSize:           2,
+			ExpectedResult:     capabilities,
+			ExpectedErrMessage: "",
+		},
+		{
+			Name: "Returns error when Capability listing failed",
+			RepositoryFn: func() *automock.CapabilityRepository {
+				repo := &automock.CapabilityRepository{}
+				repo.On("ListByResourceID", ctx, "", appTemplateVersionID, resource.ApplicationTemplateVersion).Return(nil, testErr).Once()
+				return repo
+			},
+			PageSize:           2,
+			ExpectedResult:     nil,
+			ExpectedErrMessage: testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepositoryFn()
+
+			svc := capability.NewService(repo, nil)
+
+			// WHEN
+			capabilities, err := svc.ListByApplicationTemplateVersionID(ctx, appTemplateVersionID)
+
+			// then
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedResult, capabilities)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_ListByApplicationIDs(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("Test error")
+	secondAppID := "secondAppID"
+
+	capabilities := []*model.Capability{
+		fixCapabilityModelWithTitleForApp("title1"),
+		fixCapabilityModelWithTitleForApp("title2"),
+	}
+
+	ctx := context.TODO()
+	ctx = tenant.SaveToContext(ctx, tenantID, externalTenantID)
+
+	testCases := []struct {
+		Name               string
+		RepositoryFn       func() *automock.CapabilityRepository
+		ExpectedResult     [][]*model.Capability
+		ExpectedErrMessage string
+	}{
+		{
+			Name: "Success",
+			RepositoryFn: func() *automock.CapabilityRepository {
+				repo := &automock.CapabilityRepository{}
+				repo.On("ListByApplicationIDs", ctx, tenantID, []string{appID, secondAppID}).Return(capabilities, nil).Once()
+				return repo
+			},
+			ExpectedResult:     [][]*model.Capability{capabilities, nil},
+			ExpectedErrMessage: "",
+		},
+		{
+			Name: "Returns error when Capability listing failed",
+			RepositoryFn: func() *automock.CapabilityRepository {
+				repo := &automock.CapabilityRepository{}
+				repo.On("ListByApplicationIDs", ctx, tenantID, []string{appID, secondAppID}).Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedResult:     nil,
+			ExpectedErrMessage: testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepositoryFn()
+
+			svc := capability.NewService(repo, nil)
+
+			// WHEN
+			result, err := svc.ListByApplicationIDs(ctx, []string{appID, secondAppID})
+
+			// THEN
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedResult, result)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+	t.Run("Error when tenant not in context", func(t *testing.T) {
+		svc := capability.NewService(nil, nil)
+		// WHEN
+		_, err := svc.ListByApplicationIDs(context.TODO(), []string{appID})
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot read tenant from context")
+	})
+}
+
+func TestService_ListByPackageIDs(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("Test error")
+	secondPackageID := "secondPackageID"
+
+	capabilities := []*model.Capability{
+		fixCapabilityModelWithTitleForApp("title1"),
+		fixCapabilityModelWithTitleForApp("title2"),
+	}
+
+	ctx := context.TODO()
+	ctx = tenant.SaveToContext(ctx, tenantID, externalTenantID)
+
+	testCases := []struct {
+		Name               string
+		RepositoryFn       func() *automock.CapabilityRepository
+		ExpectedResult     [][]*model.Capability
+		ExpectedErrMessage string
+	}{
+		{
+			Name: "Success",
+			RepositoryFn: func() *automock.CapabilityRepository {
+				repo := &automock.CapabilityRepository{}
+				repo.On("ListByPackageIDs", ctx, tenantID, []string{packageID, secondPackageID}).Return(capabilities, nil).Once()
+				return repo
+			},
+			ExpectedResult:     [][]*model.Capability{capabilities, nil},
+			ExpectedErrMessage: "",
+		},
+		{
+			Name: "Returns error when Capability listing failed",
+			RepositoryFn: func() *automock.CapabilityRepository {
+				repo := &automock.CapabilityRepository{}
+				repo.On("ListByPackageIDs", ctx, tenantID, []string{packageID, secondPackageID}).Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedResult:     nil,
+			ExpectedErrMessage: testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepositoryFn()
+
+			svc := capability.NewService(repo, nil)
+
+			// WHEN
+			result, err := svc.ListByPackageIDs(ctx, []string{packageID, secondPackageID})
+
+			// THEN
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedResult, result)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+	t.Run("Error when tenant not in context", func(t *testing.T) {
+		svc := capability.NewService(nil, nil)
+		// WHEN
+		_, err := svc.ListByPackageIDs(context.TODO(), []string{packageID})
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot read tenant from context")
+	})
+}
+
+func fixUIDService() *automock.UIDService {
+	uidSvc := &automock.UIDService{}
+	uidSvc.On("Generate").Return(capabilityID)
+	return uidSvc
+}
@@ -176,7 +176,7 @@ func (r *Resolver) FetchRequestDocumentDataLoader(keys []dataloader.ParamFetchRe
 		documentIDs = append(documentIDs, key.ID)
 	}
 
-	tx, err := r.transact.Begin()
+	tx, err := persistence.BeginForCtx(ctx, r.transact)
 	if err != nil {
 		return nil, []error{err}
 	}
@@ -0,0 +1,121 @@
+package entitytype
+
+import (
+	"github.com/kyma-incubator/compass/components/director/internal/domain/ordresource"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+)
+
+type converter struct {
+}
+
+// NewConverter returns a new Converter that can later be used to make the conversions between the GraphQL, service, and repository layer representations of an ORD Entity Type.
+func NewConverter() *converter {
+	return &converter{}
+}
+
+// ToEntity converts the provided service-layer representation of an Entity Type to the repository-layer one.
+func (c *converter) ToEntity(in *model.EntityType) *Entity {
+	if in == nil {
+		return nil
+	}
+
+	return &Entity{
+		ID:                           in.ID,
+		ApplicationID:                repo.NewNullableString(in.ApplicationID),
+		ApplicationTemplateVersionID: repo.NewNullableString(in.ApplicationTemplateVersionID),
+		PackageID:                    in.PackageID,
+		OrdID:                        in.OrdID,
+		LocalID:                      in.LocalID,
+		CorrelationIDs:               repo.NewNullableStringFromJSONRawMessage(in.CorrelationIDs),
+		Level:                        in.Level,
+		Title:                        in.Title,
+		ShortDescription:             repo.NewNullableString(in.ShortDescription),
+		Description:                  repo.NewNullableString(in.Description),
+		Version:                      in.Version,
+		ChangeLogEntries:             repo.NewNullableStringFromJSONRawMessage(in.ChangeLogEntries),
+		Visibility:                   in.Visibility,
+		Links:                        repo.NewNullableStringFromJSONRawMessage(in.Links),
+		PartOfProducts:               repo.NewNullableStringFromJSONRawMessage(in.PartOfProducts),
+		PolicyLevel:                  repo.NewNullableString(in.PolicyLevel),
+		CustomPolicyLevel:            repo.NewNullableString(in.CustomPolicyLevel),
+		ReleaseStatus:                in.ReleaseStatus,
+		SunsetDate:                   repo.NewNullableString(in.SunsetDate),
+		Successors:                   repo.NewNullableStringFromJSONRawMessage(in.Successors),
+		Extensible:                   repo.NewNullableStringFromJSONRawMessage(in.Extensible),
+		Tags:                         repo.NewNullableStringFromJSONRawMessage(in.Tags),
+		Labels:                       repo.NewNullableStringFromJSONRawMessage(in.Labels),
+		DocumentationLabels:          repo.NewNullableStringFromJSONRawMessage(in.DocumentationLabels),
+		LastUpdate:                   repo.NewNullableString(in.LastUpdate),
+		ResourceHash:                 repo.NewNullableString(in.ResourceHash),
+	}
+}
+
+// FromEntity converts the provided Entity repo-layer representation of an Entity Type to the service-layer representation model.EntityType.
+func (c *converter) FromEntity(entity *Entity) (*model.EntityType, error) {
+	if entity == nil {
+		return nil, apperrors.NewInternalError("the Entity Type entity is nil")
+	}
+
+	return &model.EntityType{
+		ID:                           entity.ID,
+		ApplicationID:                repo.StringPtrFromNullableString(entity.ApplicationID),
+		ApplicationTemplateVersionID: repo.StringPtrFromNullableString(entity.ApplicationTemplateVersionID),
+		PackageID:                    entity.PackageID,
+		OrdID:                        entity.OrdID,
+		LocalID:                      entity.LocalID,
+		CorrelationIDs:               repo.JSONRawMessageFromNullableString(entity.CorrelationIDs),
+		Level:                        entity.Level,
+		Title:                        entity.Title,
+		ShortDescription:             repo.StringPtrFromNullableString(entity.ShortDescription),
+		Description:                  repo.StringPtrFromNullableString(entity.Description),
+		Version:                      entity.Version,
+		ChangeLogEntries:             repo.JSONRawMessageFromNullableString(entity.ChangeLogEntries),
+		Visibility:                   entity.Visibility,
+		Links:                        repo.JSONRawMessageFromNullableString(entity.Links),
+		PartOfProducts:               repo.JSONRawMessageFromNullableString(entity.PartOfProducts),
+		PolicyLevel:                  repo.StringPtrFromNullableString(entity.PolicyLevel),
+		CustomPolicyLevel:            repo.StringPtrFromNullableString(entity.CustomPolicyLevel),
+		ReleaseStatus:                entity.ReleaseStatus,
+		SunsetDate:                   repo.StringPtrFromNullableString(entity.SunsetDate),
+		Successors:                   repo.JSONRawMessageFromNullableString(entity.Successors),
+		Extensible:                   repo.JSONRawMessageFromNullableString(entity.Extensible),
+		Tags:                         repo.JSONRawMessageFromNullableString(entity.Tags),
+		Labels:                       repo.JSONRawMessageFromNullableString(entity.Labels),
+		DocumentationLabels:          repo.JSONRawMessageFromNullableString(entity.DocumentationLabels),
+		LastUpdate:                   repo.StringPtrFromNullableString(entity.LastUpdate),
+		ResourceHash:                 repo.StringPtrFromNullableString(entity.ResourceHash),
+	}, nil
+}
+
+// ToGraphQL converts the provided service-layer representation of an Entity Type to the graphql-layer one.
+func (c *converter) ToGraphQL(in *model.EntityType) *graphql.EntityType {
+	if in == nil {
+		return nil
+	}
+
+	return &graphql.EntityType{
+		ID:                in.ID,
+		OrdID:             in.OrdID,
+		LocalID:           in.LocalID,
+		PackageID:         in.PackageID,
+		Level:             in.Level,
+		Title:             in.Title,
+		ShortDescription:  in.ShortDescription,
+		Description:       in.Description,
+		Version:           in.Version,
+		Visibility:        in.Visibility,
+		ReleaseStatus:     in.ReleaseStatus,
+		SunsetDate:        in.SunsetDate,
+		PolicyLevel:       in.PolicyLevel,
+		CustomPolicyLevel: in.CustomPolicyLevel,
+		LastUpdate:        in.LastUpdate,
+	}
+}
+
+// MultipleToGraphQL converts the provided service-layer representations of Entity Types to the graphql-layer ones.
+func (c *converter) MultipleToGraphQL(in []*model.EntityType) []*graphql.EntityType {
+	return ordresource.MultipleToGraphQL(in, c.ToGraphQL)
+}
@@ -0,0 +1,69 @@
+package entitytype_test
+
+import (
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/entitytype"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEntityConverter_ToEntity(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		entityTypeModel := fixEntityTypeModelForApp()
+		require.NotNil(t, entityTypeModel)
+		conv := entitytype.NewConverter()
+
+		entity := conv.ToEntity(entityTypeModel)
+
+		assert.Equal(t, fixEntityTypeEntityForApp(), entity)
+	})
+
+	t.Run("Returns nil if entity type model is nil", func(t *testing.T) {
+		conv := entitytype.NewConverter()
+
+		ent := conv.ToEntity(nil)
+
+		require.Nil(t, ent)
+	})
+}
+
+func TestEntityConverter_FromEntity(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		entity := fixEntityTypeEntityForApp()
+		conv := entitytype.NewConverter()
+
+		entityTypeModel, err := conv.FromEntity(entity)
+
+		require.NoError(t, err)
+		assert.Equal(t, fixEntityTypeModelForApp(), entityTypeModel)
+	})
+
+	t.Run("Returns error if Entity is nil", func(t *testing.T) {
+		conv := entitytype.NewConverter()
+
+		_, err := conv.FromEntity(nil)
+
+		require.Error(t, err)
+	})
+}
+
+func TestConverter_MultipleToGraphQL(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		conv := entitytype.NewConverter()
+
+		gqlEntityTypes := conv.MultipleToGraphQL([]*model.EntityType{fixEntityTypeModelForApp(), nil})
+
+		assert.Equal(t, []*graphql.EntityType{fixGQLEntityType()}, gqlEntityTypes)
+	})
+
+	t.Run("Returns empty slice if input is empty", func(t *testing.T) {
+		conv := entitytype.NewConverter()
+
+		gqlEntityTypes := conv.MultipleToGraphQL(nil)
+
+		assert.Empty(t, gqlEntityTypes)
+	})
+}
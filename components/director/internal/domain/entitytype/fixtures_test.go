@@ -0,0 +1,191 @@
+package entitytype_test
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/entitytype"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+)
+
+const (
+	entityTypeID     = "entityTypeID"
+	packageID        = "packageID"
+	tenantID         = "b91b59f7-2563-40b2-aba9-fef726037aa3"
+	ordID            = "sap.s4:entityType:CostCenter:v1"
+	localID          = "CostCenter"
+	externalTenantID = "externalTenantID"
+	resourceHash     = "123456"
+)
+
+var (
+	appID                = "appID"
+	appTemplateVersionID = "appTemplateVersionID"
+)
+
+func fixEntityTypeEntityForApp() *entitytype.Entity {
+	return fixEntityTypeEntityWithTitleForApp("title")
+}
+
+func fixEntityTypeEntityForAppTemplateVersion() *entitytype.Entity {
+	return fixEntityTypeEntityWithTitleForAppTemplateVersion("title")
+}
+
+func fixEntityTypeEntityWithTitleForApp(title string) *entitytype.Entity {
+	entity := fixEntityTypeEntityWithTitle(title)
+	entity.ApplicationID = repo.NewValidNullableString(appID)
+	return entity
+}
+
+func fixEntityTypeEntityWithTitleForAppTemplateVersion(title string) *entitytype.Entity {
+	entity := fixEntityTypeEntityWithTitle(title)
+	entity.ApplicationTemplateVersionID = repo.NewValidNullableString(appTemplateVersionID)
+	return entity
+}
+
+func fixEntityTypeEntityWithTitle(title string) *entitytype.Entity {
+	return &entitytype.Entity{
+		ID:                  entityTypeID,
+		PackageID:           packageID,
+		OrdID:               ordID,
+		LocalID:             localID,
+		CorrelationIDs:      repo.NewValidNullableString("[]"),
+		Level:               "aggregate",
+		Title:               title,
+		ShortDescription:    repo.NewValidNullableString("short desc"),
+		Description:         repo.NewValidNullableString("desc"),
+		Version:             "1.0.0",
+		ChangeLogEntries:    repo.NewValidNullableString("[]"),
+		Visibility:          "public",
+		Links:               repo.NewValidNullableString("[]"),
+		PartOfProducts:      repo.NewValidNullableString("[\"test\"]"),
+		PolicyLevel:         repo.NewValidNullableString("sap:core:v1"),
+		ReleaseStatus:       "active",
+		Successors:          repo.NewValidNullableString("[]"),
+		Extensible:          repo.NewValidNullableString("{\"supported\":\"no\"}"),
+		Tags:                repo.NewValidNullableString("[]"),
+		Labels:              repo.NewValidNullableString("{}"),
+		DocumentationLabels: repo.NewValidNullableString("{}"),
+		ResourceHash:        repo.NewValidNullableString(resourceHash),
+	}
+}
+
+func fixNilModelEntityType() *model.EntityType {
+	return nil
+}
+
+func fixEntityTypeModelForApp() *model.EntityType {
+	return fixEntityTypeModelWithTitleForApp("title")
+}
+
+func fixEntityTypeModelForAppTemplateVersion() *model.EntityType {
+	return fixEntityTypeModelWithTitleForAppTemplateVersion("title")
+}
+
+func fixEntityTypeModelWithTitleForApp(title string) *model.EntityType {
+	entityType := fixEntityTypeModelWithTitle(title)
+	entityType.ApplicationID = &appID
+	return entityType
+}
+
+func fixEntityTypeModelWithTitleForAppTemplateVersion(title string) *model.EntityType {
+	entityType := fixEntityTypeModelWithTitle(title)
+	entityType.ApplicationTemplateVersionID = &appTemplateVersionID
+	return entityType
+}
+
+func fixEntityTypeModelWithTitle(title string) *model.EntityType {
+	return &model.EntityType{
+		ID:                  entityTypeID,
+		PackageID:           packageID,
+		OrdID:               ordID,
+		LocalID:             localID,
+		CorrelationIDs:      json.RawMessage("[]"),
+		Level:               "aggregate",
+		Title:               title,
+		ShortDescription:    str.Ptr("short desc"),
+		Description:         str.Ptr("desc"),
+		Version:             "1.0.0",
+		ChangeLogEntries:    json.RawMessage("[]"),
+		Visibility:          "public",
+		Links:               json.RawMessage("[]"),
+		PartOfProducts:      json.RawMessage("[\"test\"]"),
+		PolicyLevel:         str.Ptr("sap:core:v1"),
+		ReleaseStatus:       "active",
+		Successors:          json.RawMessage("[]"),
+		Extensible:          json.RawMessage("{\"supported\":\"no\"}"),
+		Tags:                json.RawMessage("[]"),
+		Labels:              json.RawMessage("{}"),
+		DocumentationLabels: json.RawMessage("{}"),
+		ResourceHash:        str.Ptr(resourceHash),
+	}
+}
+
+func fixEntityTypeModelInput() *model.EntityTypeInput {
+	return &model.EntityTypeInput{
+		OrdID:               ordID,
+		LocalID:             localID,
+		CorrelationIDs:      json.RawMessage("[]"),
+		Level:               "aggregate",
+		Title:               "title",
+		ShortDescription:    str.Ptr("short desc"),
+		Description:         str.Ptr("desc"),
+		OrdPackageID:        "sap.s4:package:S4HANA:v1",
+		Version:             "1.0.0",
+		ChangeLogEntries:    json.RawMessage("[]"),
+		Visibility:          "public",
+		Links:               json.RawMessage("[]"),
+		PartOfProducts:      json.RawMessage("[\"test\"]"),
+		PolicyLevel:         str.Ptr("sap:core:v1"),
+		ReleaseStatus:       "active",
+		Successors:          json.RawMessage("[]"),
+		Extensible:          json.RawMessage("{\"supported\":\"no\"}"),
+		Tags:                json.RawMessage("[]"),
+		Labels:              json.RawMessage("{}"),
+		DocumentationLabels: json.RawMessage("{}"),
+	}
+}
+
+func fixGQLEntityType() *graphql.EntityType {
+	return &graphql.EntityType{
+		ID:               entityTypeID,
+		OrdID:            ordID,
+		LocalID:          localID,
+		PackageID:        packageID,
+		Level:            "aggregate",
+		Title:            "title",
+		ShortDescription: str.Ptr("short desc"),
+		Description:      str.Ptr("desc"),
+		Version:          "1.0.0",
+		Visibility:       "public",
+		ReleaseStatus:    "active",
+		PolicyLevel:      str.Ptr("sap:core:v1"),
+	}
+}
+
+func fixEntityTypeColumns() []string {
+	return []string{"id", "app_id", "app_template_version_id", "package_id", "ord_id", "local_id", "correlation_ids", "level",
+		"title", "short_description", "description", "version", "changelog_entries", "visibility", "links", "part_of_products", "policy_level",
+		"custom_policy_level", "release_status", "sunset_date", "successors", "extensible", "tags", "labels", "documentation_labels", "last_update", "resource_hash"}
+}
+
+func fixEntityTypeRowWithTitleForApp(title string) []driver.Value {
+	return append([]driver.Value{entityTypeID, appID, nil}, fixEntityTypeRowWithTitle(title)...)
+}
+
+func fixEntityTypeRowWithTitleForAppTemplateVersion(title string) []driver.Value {
+	return append([]driver.Value{entityTypeID, nil, appTemplateVersionID}, fixEntityTypeRowWithTitle(title)...)
+}
+
+func fixEntityTypeRowWithTitle(title string) []driver.Value {
+	return []driver.Value{packageID, ordID, localID, "[]", "aggregate", title, "short desc", "desc", "1.0.0", "[]", "public", "[]", "[\"test\"]",
+		"sap:core:v1", nil, "active", nil, "[]", "{\"supported\":\"no\"}", "[]", "{}", "{}", nil, resourceHash}
+}
+
+func fixEntityTypeUpdateArgs() []driver.Value {
+	return []driver.Value{packageID, localID, "[]", "aggregate", "title", "short desc", "desc", "1.0.0", "[]", "public", "[]", "[\"test\"]",
+		"sap:core:v1", nil, "active", nil, "[]", "{\"supported\":\"no\"}", "[]", "{}", "{}", nil, resourceHash}
+}
@@ -0,0 +1,31 @@
+package entitytype
+
+import (
+	"github.com/kyma-incubator/compass/components/director/internal/domain/ordresource"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+)
+
+const entityTypeTable = `public.entity_types`
+
+var (
+	entityTypeColumns = []string{"id", "app_id", "app_template_version_id", "package_id", "ord_id", "local_id", "correlation_ids", "level",
+		"title", "short_description", "description", "version", "changelog_entries", "visibility", "links", "part_of_products", "policy_level",
+		"custom_policy_level", "release_status", "sunset_date", "successors", "extensible", "tags", "labels", "documentation_labels", "last_update", "resource_hash"}
+	updatableColumns = []string{"package_id", "local_id", "correlation_ids", "level", "title", "short_description", "description", "version",
+		"changelog_entries", "visibility", "links", "part_of_products", "policy_level", "custom_policy_level", "release_status", "sunset_date",
+		"successors", "extensible", "tags", "labels", "documentation_labels", "last_update", "resource_hash"}
+)
+
+// EntityConverter converts Entity Types between the model.EntityType service-layer representation and the repo-layer representation Entity.
+//
+//go:generate mockery --name=EntityConverter --output=automock --outpkg=automock --case=underscore --disable-version-string
+type EntityConverter interface {
+	ToEntity(in *model.EntityType) *Entity
+	FromEntity(entity *Entity) (*model.EntityType, error)
+}
+
+// NewRepository returns a new entity responsible for repo-layer Entity Type operations.
+func NewRepository(conv EntityConverter) *ordresource.Repository[model.EntityType, Entity] {
+	return ordresource.NewRepository[model.EntityType, Entity](resource.EntityType, "Entity Type", entityTypeTable, entityTypeColumns, updatableColumns, conv)
+}
@@ -0,0 +1,394 @@
+package entitytype_test
+
+import (
+	"context"
+	"database/sql/driver"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/entitytype"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/entitytype/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo/testdb"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/kyma-incubator/compass/components/director/pkg/scope"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPgRepository_Create(t *testing.T) {
+	suite := testdb.RepoCreateTestSuite{
+		Name: "Create Entity Type",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:    regexp.QuoteMeta("SELECT 1 FROM tenant_applications WHERE tenant_id = $1 AND id = $2 AND owner = $3"),
+				Args:     []driver.Value{tenantID, appID, true},
+				IsSelect: true,
+				ValidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{testdb.RowWhenObjectExist()}
+				},
+				InvalidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{testdb.RowWhenObjectDoesNotExist()}
+				},
+			},
+			{
+				Query:       `^INSERT INTO public.entity_types \(.+\) VALUES \(.+\)$`,
+				Args:        fixEntityTypeRowWithTitleForApp("title"),
+				ValidResult: sqlmock.NewResult(-1, 1),
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		RepoConstructorFunc:       entitytype.NewRepository,
+		ModelEntity:               fixEntityTypeModelForApp(),
+		DBEntity:                  fixEntityTypeEntityForApp(),
+		NilModelEntity:            fixNilModelEntityType(),
+		TenantID:                  tenantID,
+		DisableConverterErrorTest: true,
+	}
+
+	suite.Run(t)
+}
+
+func TestPgRepository_CreateGlobal(t *testing.T) {
+	suite := testdb.RepoCreateTestSuite{
+		Name: "Create Entity Type Global",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:       `^INSERT INTO public.entity_types \(.+\) VALUES \(.+\)$`,
+				Args:        fixEntityTypeRowWithTitleForAppTemplateVersion("title"),
+				ValidResult: sqlmock.NewResult(-1, 1),
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		RepoConstructorFunc:       entitytype.NewRepository,
+		ModelEntity:               fixEntityTypeModelForAppTemplateVersion(),
+		DBEntity:                  fixEntityTypeEntityForAppTemplateVersion(),
+		NilModelEntity:            fixNilModelEntityType(),
+		DisableConverterErrorTest: true,
+		IsGlobal:                  true,
+		MethodName:                "CreateGlobal",
+	}
+
+	suite.Run(t)
+}
+
+func TestPgRepository_Update(t *testing.T) {
+	entity := fixEntityTypeEntityForApp()
+
+	suite := testdb.RepoUpdateTestSuite{
+		Name: "Update Entity Type",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:         regexp.QuoteMeta(`UPDATE public.entity_types SET package_id = ?, local_id = ?, correlation_ids = ?, level = ?, title = ?, short_description = ?, description = ?, version = ?, changelog_entries = ?, visibility = ?, links = ?, part_of_products = ?, policy_level = ?, custom_policy_level = ?, release_status = ?, sunset_date = ?, successors = ?, extensible = ?, tags = ?, labels = ?, documentation_labels = ?, last_update = ?, resource_hash = ? WHERE id = ? AND (id IN (SELECT id FROM entity_types_tenants WHERE tenant_id = ? AND owner = true))`),
+				Args:          append(fixEntityTypeUpdateArgs(), entity.ID, tenantID),
+				ValidResult:   sqlmock.NewResult(-1, 1),
+				InvalidResult: sqlmock.NewResult(-1, 0),
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		RepoConstructorFunc:       entitytype.NewRepository,
+		ModelEntity:               fixEntityTypeModelForApp(),
+		DBEntity:                  entity,
+		NilModelEntity:            fixNilModelEntityType(),
+		TenantID:                  tenantID,
+		DisableConverterErrorTest: true,
+	}
+
+	suite.Run(t)
+}
+
+func TestPgRepository_UpdateGlobal(t *testing.T) {
+	entity := fixEntityTypeEntityForAppTemplateVersion()
+
+	suite := testdb.RepoUpdateTestSuite{
+		Name: "Update Entity Type Global",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:         regexp.QuoteMeta(`UPDATE public.entity_types SET package_id = ?, local_id = ?, correlation_ids = ?, level = ?, title = ?, short_description = ?, description = ?, version = ?, changelog_entries = ?, visibility = ?, links = ?, part_of_products = ?, policy_level = ?, custom_policy_level = ?, release_status = ?, sunset_date = ?, successors = ?, extensible = ?, tags = ?, labels = ?, documentation_labels = ?, last_update = ?, resource_hash = ? WHERE id = ?`),
+				Args:          append(fixEntityTypeUpdateArgs(), entity.ID),
+				ValidResult:   sqlmock.NewResult(-1, 1),
+				InvalidResult: sqlmock.NewResult(-1, 0),
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		RepoConstructorFunc:       entitytype.NewRepository,
+		ModelEntity:               fixEntityTypeModelForAppTemplateVersion(),
+		DBEntity:                  entity,
+		NilModelEntity:            fixNilModelEntityType(),
+		DisableConverterErrorTest: true,
+		IsGlobal:                  true,
+		UpdateMethodName:          "UpdateGlobal",
+	}
+
+	suite.Run(t)
+}
+
+func TestPgRepository_Delete(t *testing.T) {
+	suite := testdb.RepoDeleteTestSuite{
+		Name: "Entity Type Delete",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:         regexp.QuoteMeta(`DELETE FROM public.entity_types WHERE id = $1 AND (id IN (SELECT id FROM entity_types_tenants WHERE tenant_id = $2 AND owner = true))`),
+				Args:          []driver.Value{entityTypeID, tenantID},
+				ValidResult:   sqlmock.NewResult(-1, 1),
+				InvalidResult: sqlmock.NewResult(-1, 2),
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		RepoConstructorFunc: entitytype.NewRepository,
+		MethodArgs:          []interface{}{tenantID, entityTypeID},
+	}
+
+	suite.Run(t)
+}
+
+func TestPgRepository_DeleteGlobal(t *testing.T) {
+	suite := testdb.RepoDeleteTestSuite{
+		Name: "Entity Type Delete Global",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:         regexp.QuoteMeta(`DELETE FROM public.entity_types WHERE id = $1`),
+				Args:          []driver.Value{entityTypeID},
+				ValidResult:   sqlmock.NewResult(-1, 1),
+				InvalidResult: sqlmock.NewResult(-1, 2),
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		RepoConstructorFunc: entitytype.NewRepository,
+		MethodArgs:          []interface{}{entityTypeID},
+		IsGlobal:            true,
+		MethodName:          "DeleteGlobal",
+	}
+
+	suite.Run(t)
+}
+
+func TestPgRepository_GetByID(t *testing.T) {
+	suite := testdb.RepoGetTestSuite{
+		Name: "Get Entity Type",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:    regexp.QuoteMeta(`SELECT id, app_id, app_template_version_id, package_id, ord_id, local_id, correlation_ids, level, title, short_description, description, version, changelog_entries, visibility, links, part_of_products, policy_level, custom_policy_level, release_status, sunset_date, successors, extensible, tags, labels, documentation_labels, last_update, resource_hash FROM public.entity_types WHERE id = $1 AND (id IN (SELECT id FROM entity_types_tenants WHERE tenant_id = $2))`),
+				Args:     []driver.Value{entityTypeID, tenantID},
+				IsSelect: true,
+				ValidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixEntityTypeColumns()).AddRow(fixEntityTypeRowWithTitleForApp("title")...)}
+				},
+				InvalidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixEntityTypeColumns())}
+				},
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		RepoConstructorFunc: entitytype.NewRepository,
+		ExpectedModelEntity: fixEntityTypeModelForApp(),
+		ExpectedDBEntity:    fixEntityTypeEntityForApp(),
+		MethodArgs:          []interface{}{tenantID, entityTypeID},
+	}
+
+	suite.Run(t)
+}
+
+func TestPgRepository_GetByIDGlobal(t *testing.T) {
+	suite := testdb.RepoGetTestSuite{
+		Name: "Get Entity Type Global",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:    regexp.QuoteMeta(`SELECT id, app_id, app_template_version_id, package_id, ord_id, local_id, correlation_ids, level, title, short_description, description, version, changelog_entries, visibility, links, part_of_products, policy_level, custom_policy_level, release_status, sunset_date, successors, extensible, tags, labels, documentation_labels, last_update, resource_hash FROM public.entity_types WHERE id = $1`),
+				Args:     []driver.Value{entityTypeID},
+				IsSelect: true,
+				ValidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixEntityTypeColumns()).AddRow(fixEntityTypeRowWithTitleForAppTemplateVersion("title")...)}
+				},
+				InvalidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixEntityTypeColumns())}
+				},
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		RepoConstructorFunc: entitytype.NewRepository,
+		ExpectedModelEntity: fixEntityTypeModelForAppTemplateVersion(),
+		ExpectedDBEntity:    fixEntityTypeEntityForAppTemplateVersion(),
+		MethodArgs:          []interface{}{entityTypeID},
+		MethodName:          "GetByIDGlobal",
+	}
+
+	suite.Run(t)
+}
+
+func TestPgRepository_ListByResourceID(t *testing.T) {
+	suiteForApp := testdb.RepoListTestSuite{
+		Name: "List Entity Types For Application",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:    regexp.QuoteMeta(`SELECT id, app_id, app_template_version_id, package_id, ord_id, local_id, correlation_ids, level, title, short_description, description, version, changelog_entries, visibility, links, part_of_products, policy_level, custom_policy_level, release_status, sunset_date, successors, extensible, tags, labels, documentation_labels, last_update, resource_hash FROM public.entity_types WHERE app_id = $1 AND (id IN (SELECT id FROM entity_types_tenants WHERE tenant_id = $2)) FOR UPDATE`),
+				Args:     []driver.Value{appID, tenantID},
+				IsSelect: true,
+				ValidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixEntityTypeColumns()).AddRow(fixEntityTypeRowWithTitleForApp("title1")...).AddRow(fixEntityTypeRowWithTitleForApp("title2")...)}
+				},
+				InvalidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixEntityTypeColumns())}
+				},
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		RepoConstructorFunc:   entitytype.NewRepository,
+		ExpectedModelEntities: []interface{}{fixEntityTypeModelWithTitleForApp("title1"), fixEntityTypeModelWithTitleForApp("title2")},
+		ExpectedDBEntities:    []interface{}{fixEntityTypeEntityWithTitleForApp("title1"), fixEntityTypeEntityWithTitleForApp("title2")},
+		MethodArgs:            []interface{}{tenantID, appID, resource.Application},
+		MethodName:            "ListByResourceID",
+	}
+
+	suiteForAppTemplateVersion := testdb.RepoListTestSuite{
+		Name: "List Entity Types for Application Template Version",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:    regexp.QuoteMeta(`SELECT id, app_id, app_template_version_id, package_id, ord_id, local_id, correlation_ids, level, title, short_description, description, version, changelog_entries, visibility, links, part_of_products, policy_level, custom_policy_level, release_status, sunset_date, successors, extensible, tags, labels, documentation_labels, last_update, resource_hash FROM public.entity_types WHERE app_template_version_id = $1 FOR UPDATE`),
+				Args:     []driver.Value{appTemplateVersionID},
+				IsSelect: true,
+				ValidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixEntityTypeColumns()).AddRow(fixEntityTypeRowWithTitleForAppTemplateVersion("title1")...).AddRow(fixEntityTypeRowWithTitleForAppTemplateVersion("title2")...)}
+				},
+				InvalidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixEntityTypeColumns())}
+				},
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		RepoConstructorFunc:   entitytype.NewRepository,
+		ExpectedModelEntities: []interface{}{fixEntityTypeModelWithTitleForAppTemplateVersion("title1"), fixEntityTypeModelWithTitleForAppTemplateVersion("title2")},
+		ExpectedDBEntities:    []interface{}{fixEntityTypeEntityWithTitleForAppTemplateVersion("title1"), fixEntityTypeEntityWithTitleForAppTemplateVersion("title2")},
+		MethodArgs:            []interface{}{tenantID, appTemplateVersionID, resource.ApplicationTemplateVersion},
+		MethodName:            "ListByResourceID",
+	}
+
+	suiteForApp.Run(t)
+	suiteForAppTemplateVersion.Run(t)
+}
+
+func TestPgRepository_ListByApplicationIDs(t *testing.T) {
+	secondAppID := "secondAppID"
+
+	suite := testdb.RepoListTestSuite{
+		Name: "List Entity Types For Applications",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:    regexp.QuoteMeta(`SELECT id, app_id, app_template_version_id, package_id, ord_id, local_id, correlation_ids, level, title, short_description, description, version, changelog_entries, visibility, links, part_of_products, policy_level, custom_policy_level, release_status, sunset_date, successors, extensible, tags, labels, documentation_labels, last_update, resource_hash FROM public.entity_types WHERE app_id IN ($1, $2) AND (id IN (SELECT id FROM entity_types_tenants WHERE tenant_id = $3))`),
+				Args:     []driver.Value{appID, secondAppID, tenantID},
+				IsSelect: true,
+				ValidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixEntityTypeColumns()).AddRow(fixEntityTypeRowWithTitleForApp("title1")...).AddRow(fixEntityTypeRowWithTitleForApp("title2")...)}
+				},
+				InvalidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixEntityTypeColumns())}
+				},
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		RepoConstructorFunc:   entitytype.NewRepository,
+		ExpectedModelEntities: []interface{}{fixEntityTypeModelWithTitleForApp("title1"), fixEntityTypeModelWithTitleForApp("title2")},
+		ExpectedDBEntities:    []interface{}{fixEntityTypeEntityWithTitleForApp("title1"), fixEntityTypeEntityWithTitleForApp("title2")},
+		MethodArgs:            []interface{}{tenantID, []string{appID, secondAppID}},
+		MethodName:            "ListByApplicationIDs",
+	}
+
+	suite.Run(t)
+
+	t.Run("lists only public Entity Types when there is no internal_visibility scope", func(t *testing.T) {
+		sqlxDB, sqlMock := testdb.MockDatabase(t)
+		sqlMock.ExpectQuery(regexp.QuoteMeta(`SELECT id, app_id, app_template_version_id, package_id, ord_id, local_id, correlation_ids, level, title, short_description, description, version, changelog_entries, visibility, links, part_of_products, policy_level, custom_policy_level, release_status, sunset_date, successors, extensible, tags, labels, documentation_labels, last_update, resource_hash FROM public.entity_types WHERE app_id IN ($1) AND visibility = $2 AND (id IN (SELECT id FROM entity_types_tenants WHERE tenant_id = $3))`)).
+			WithArgs(appID, "public", tenantID).
+			WillReturnRows(sqlmock.NewRows(fixEntityTypeColumns()).AddRow(fixEntityTypeRowWithTitleForApp("title1")...))
+
+		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+		ctx = scope.SaveToContext(ctx, []string{"application:read"})
+		convMock := &automock.EntityConverter{}
+		convMock.On("FromEntity", fixEntityTypeEntityWithTitleForApp("title1")).Return(fixEntityTypeModelWithTitleForApp("title1"), nil).Once()
+
+		// WHEN
+		result, err := entitytype.NewRepository(convMock).ListByApplicationIDs(ctx, tenantID, []string{appID})
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, []*model.EntityType{fixEntityTypeModelWithTitleForApp("title1")}, result)
+		sqlMock.AssertExpectations(t)
+		convMock.AssertExpectations(t)
+	})
+
+	t.Run("lists all Entity Types when there is internal_visibility scope", func(t *testing.T) {
+		sqlxDB, sqlMock := testdb.MockDatabase(t)
+		sqlMock.ExpectQuery(regexp.QuoteMeta(`SELECT id, app_id, app_template_version_id, package_id, ord_id, local_id, correlation_ids, level, title, short_description, description, version, changelog_entries, visibility, links, part_of_products, policy_level, custom_policy_level, release_status, sunset_date, successors, extensible, tags, labels, documentation_labels, last_update, resource_hash FROM public.entity_types WHERE app_id IN ($1) AND (id IN (SELECT id FROM entity_types_tenants WHERE tenant_id = $2))`)).
+			WithArgs(appID, tenantID).
+			WillReturnRows(sqlmock.NewRows(fixEntityTypeColumns()).AddRow(fixEntityTypeRowWithTitleForApp("title1")...))
+
+		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+		ctx = scope.SaveToContext(ctx, []string{"application:read", "internal_visibility:read"})
+		convMock := &automock.EntityConverter{}
+		convMock.On("FromEntity", fixEntityTypeEntityWithTitleForApp("title1")).Return(fixEntityTypeModelWithTitleForApp("title1"), nil).Once()
+
+		// WHEN
+		result, err := entitytype.NewRepository(convMock).ListByApplicationIDs(ctx, tenantID, []string{appID})
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, []*model.EntityType{fixEntityTypeModelWithTitleForApp("title1")}, result)
+		sqlMock.AssertExpectations(t)
+		convMock.AssertExpectations(t)
+	})
+}
+
+func TestPgRepository_ListByPackageIDs(t *testing.T) {
+	secondPackageID := "secondPackageID"
+
+	suite := testdb.RepoListTestSuite{
+		Name: "List Entity Types For Packages",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:    regexp.QuoteMeta(`SELECT id, app_id, app_template_version_id, package_id, ord_id, local_id, correlation_ids, level, title, short_description, description, version, changelog_entries, visibility, links, part_of_products, policy_level, custom_policy_level, release_status, sunset_date, successors, extensible, tags, labels, documentation_labels, last_update, resource_hash FROM public.entity_types WHERE package_id IN ($1, $2) AND (id IN (SELECT id FROM entity_types_tenants WHERE tenant_id = $3))`),
+				Args:     []driver.Value{packageID, secondPackageID, tenantID},
+				IsSelect: true,
+				ValidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixEntityTypeColumns()).AddRow(fixEntityTypeRowWithTitleForApp("title1")...).AddRow(fixEntityTypeRowWithTitleForApp("title2")...)}
+				},
+				InvalidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixEntityTypeColumns())}
+				},
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		RepoConstructorFunc:   entitytype.NewRepository,
+		ExpectedModelEntities: []interface{}{fixEntityTypeModelWithTitleForApp("title1"), fixEntityTypeModelWithTitleForApp("title2")},
+		ExpectedDBEntities:    []interface{}{fixEntityTypeEntityWithTitleForApp("title1"), fixEntityTypeEntityWithTitleForApp("title2")},
+		MethodArgs:            []interface{}{tenantID, []string{packageID, secondPackageID}},
+		MethodName:            "ListByPackageIDs",
+	}
+
+	suite.Run(t)
+}
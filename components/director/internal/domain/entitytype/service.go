@@ -0,0 +1,218 @@
+package entitytype
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/pkg/errors"
+)
+
+// EntityTypeRepository is responsible for the repo-layer Entity Type operations.
+//
+//go:generate mockery --name=EntityTypeRepository --output=automock --outpkg=automock --case=underscore --disable-version-string
+type EntityTypeRepository interface {
+	Create(ctx context.Context, tenant string, item *model.EntityType) error
+	CreateGlobal(ctx context.Context, model *model.EntityType) error
+	Update(ctx context.Context, tenant string, item *model.EntityType) error
+	UpdateGlobal(ctx context.Context, model *model.EntityType) error
+	Delete(ctx context.Context, tenant, id string) error
+	DeleteGlobal(ctx context.Context, id string) error
+	GetByID(ctx context.Context, tenant, id string) (*model.EntityType, error)
+	GetByIDGlobal(ctx context.Context, id string) (*model.EntityType, error)
+	ListByResourceID(ctx context.Context, tenantID, resourceID string, resourceType resource.Type) ([]*model.EntityType, error)
+	ListByApplicationIDs(ctx context.Context, tenantID string, appIDs []string) ([]*model.EntityType, error)
+	ListByPackageIDs(ctx context.Context, tenantID string, packageIDs []string) ([]*model.EntityType, error)
+}
+
+// UIDService is responsible for generating GUIDs, which will be used as internal Entity Type IDs.
+//
+//go:generate mockery --name=UIDService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type UIDService interface {
+	Generate() string
+}
+
+type service struct {
+	entityTypeRepo EntityTypeRepository
+	uidService     UIDService
+}
+
+// NewService returns a new object responsible for service-layer Entity Type operations.
+func NewService(entityTypeRepo EntityTypeRepository, uidService UIDService) *service {
+	return &service{
+		entityTypeRepo: entityTypeRepo,
+		uidService:     uidService,
+	}
+}
+
+// Create creates an Entity Type which is part of the given Package for a given resource.Type
+func (s *service) Create(ctx context.Context, resourceType resource.Type, resourceID, packageID string, in model.EntityTypeInput, entityTypeHash uint64) (string, error) {
+	id := s.uidService.Generate()
+	entityType := in.ToEntityType(id, resourceType, resourceID, packageID, entityTypeHash)
+
+	if err := s.createEntityType(ctx, entityType, resourceType); err != nil {
+		return "", errors.Wrapf(err, "error occurred while creating an Entity Type with id %s and title %s for %s with id %s", id, entityType.Title, resourceType, resourceID)
+	}
+
+	log.C(ctx).Debugf("Successfully created an Entity Type with id %s and title %s for %s with id %s", id, entityType.Title, resourceType, resourceID)
+
+	return id, nil
+}
+
+// Update updates an Entity Type by ID for a given resource.Type
+func (s *service) Update(ctx context.Context, resourceType resource.Type, id, packageID string, in model.EntityTypeInput, entityTypeHash uint64) error {
+	entityType, err := s.getEntityType(ctx, id, resourceType)
+	if err != nil {
+		return errors.Wrapf(err, "while getting Entity Type with id %s", id)
+	}
+
+	entityType.SetFromUpdateInput(in, packageID, entityTypeHash)
+
+	if err = s.updateEntityType(ctx, entityType, resourceType); err != nil {
+		return errors.Wrapf(err, "while updating Entity Type with id %s", id)
+	}
+
+	return nil
+}
+
+// Delete deletes an Entity Type by ID for a given resource.Type
+func (s *service) Delete(ctx context.Context, resourceType resource.Type, id string) error {
+	if err := s.deleteEntityType(ctx, id, resourceType); err != nil {
+		return errors.Wrapf(err, "while deleting Entity Type with id %s", id)
+	}
+
+	log.C(ctx).Infof("Successfully deleted Entity Type with id %s", id)
+
+	return nil
+}
+
+// Get returns an Entity Type by ID
+func (s *service) Get(ctx context.Context, id string) (*model.EntityType, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "while loading tenant from context")
+	}
+
+	entityType, err := s.entityTypeRepo.GetByID(ctx, tnt, id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while getting Entity Type with ID: %q", id)
+	}
+
+	return entityType, nil
+}
+
+// ListByApplicationID lists Entity Types by Application ID
+func (s *service) ListByApplicationID(ctx context.Context, appID string) ([]*model.EntityType, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.entityTypeRepo.ListByResourceID(ctx, tnt, appID, resource.Application)
+}
+
+// ListByApplicationTemplateVersionID lists Entity Types by Application Template Version ID without tenant isolation
+func (s *service) ListByApplicationTemplateVersionID(ctx context.Context, appTemplateVersionID string) ([]*model.EntityType, error) {
+	return s.entityTypeRepo.ListByResourceID(ctx, "", appTemplateVersionID, resource.ApplicationTemplateVersion)
+}
+
+// ListByApplicationIDs lists the Entity Types of each of the given Applications which are visible to the caller, in the order of the Application IDs
+func (s *service) ListByApplicationIDs(ctx context.Context, appIDs []string) ([][]*model.EntityType, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	entityTypes, err := s.entityTypeRepo.ListByApplicationIDs(ctx, tnt, appIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	entityTypesPerApplication := make(map[string][]*model.EntityType, len(appIDs))
+	for _, item := range entityTypes {
+		if item.ApplicationID != nil {
+			entityTypesPerApplication[*item.ApplicationID] = append(entityTypesPerApplication[*item.ApplicationID], item)
+		}
+	}
+
+	result := make([][]*model.EntityType, 0, len(appIDs))
+	for _, appID := range appIDs {
+		result = append(result, entityTypesPerApplication[appID])
+	}
+
+	return result, nil
+}
+
+// ListByPackageIDs lists the Entity Types which are part of each of the given Packages and are visible to the caller, in the order of the Package IDs
+func (s *service) ListByPackageIDs(ctx context.Context, packageIDs []string) ([][]*model.EntityType, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	entityTypes, err := s.entityTypeRepo.ListByPackageIDs(ctx, tnt, packageIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	entityTypesPerPackage := make(map[string][]*model.EntityType, len(packageIDs))
+	for _, item := range entityTypes {
+		entityTypesPerPackage[item.PackageID] = append(entityTypesPerPackage[item.PackageID], item)
+	}
+
+	result := make([][]*model.EntityType, 0, len(packageIDs))
+	for _, packageID := range packageIDs {
+		result = append(result, entityTypesPerPackage[packageID])
+	}
+
+	return result, nil
+}
+
+func (s *service) createEntityType(ctx context.Context, entityType *model.EntityType, resourceType resource.Type) error {
+	if resourceType.IsTenantIgnorable() {
+		return s.entityTypeRepo.CreateGlobal(ctx, entityType)
+	}
+
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	return s.entityTypeRepo.Create(ctx, tnt, entityType)
+}
+
+func (s *service) getEntityType(ctx context.Context, id string, resourceType resource.Type) (*model.EntityType, error) {
+	if resourceType.IsTenantIgnorable() {
+		return s.entityTypeRepo.GetByIDGlobal(ctx, id)
+	}
+
+	return s.Get(ctx, id)
+}
+
+func (s *service) updateEntityType(ctx context.Context, entityType *model.EntityType, resourceType resource.Type) error {
+	if resourceType.IsTenantIgnorable() {
+		return s.entityTypeRepo.UpdateGlobal(ctx, entityType)
+	}
+
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	return s.entityTypeRepo.Update(ctx, tnt, entityType)
+}
+
+func (s *service) deleteEntityType(ctx context.Context, id string, resourceType resource.Type) error {
+	if resourceType.IsTenantIgnorable() {
+		return s.entityTypeRepo.DeleteGlobal(ctx, id)
+	}
+
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return errors.Wrap(err, "while loading tenant from context")
+	}
+
+	return s.entityTypeRepo.Delete(ctx, tnt, id)
+}
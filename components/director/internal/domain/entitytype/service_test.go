@@ -0,0 +1,725 @@
+package entitytype_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/entitytype"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/entitytype/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_Create(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("Test error")
+
+	ctx := context.TODO()
+	ctx = tenant.SaveToContext(ctx, tenantID, externalTenantID)
+
+	modelEntityTypeForApp := fixEntityTypeModelForApp()
+	modelEntityTypeForAppTemplateVersion := fixEntityTypeModelForAppTemplateVersion()
+	modelInput := *fixEntityTypeModelInput()
+
+	testCases := []struct {
+		Name         string
+		RepositoryFn func() *automock.EntityTypeRepository
+		UIDServiceFn func() *automock.UIDService
+		Input        model.EntityTypeInput
+		ResourceType resource.Type
+		ResourceID   string
+		ExpectedErr  error
+	}{
+		{
+			Name: "Success for Application",
+			RepositoryFn: func() *automock.EntityTypeRepository {
+				repo := &automock.EntityTypeRepository{}
+				repo.On("Create", ctx, tenantID, modelEntityTypeForApp).Return(nil).Once()
+				return repo
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				svc := &automock.UIDService{}
+				svc.On("Generate").Return(entityTypeID)
+				return svc
+			},
+			Input:        modelInput,
+			ResourceType: resource.Application,
+			ResourceID:   appID,
+			ExpectedErr:  nil,
+		},
+		{
+			Name: "Success for Application Template Version",
+			RepositoryFn: func() *automock.EntityTypeRepository {
+				repo := &automock.EntityTypeRepository{}
+				repo.On("CreateGlobal", ctx, modelEntityTypeForAppTemplateVersion).Return(nil).Once()
+				return repo
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				svc := &automock.UIDService{}
+				svc.On("Generate").Return(entityTypeID)
+				return svc
+			},
+			Input:        modelInput,
+			ResourceType: resource.ApplicationTemplateVersion,
+			ResourceID:   appTemplateVersionID,
+			ExpectedErr:  nil,
+		},
+		{
+			Name: "Error - Entity Type creation for Application",
+			RepositoryFn: func() *automock.EntityTypeRepository {
+				repo := &automock.EntityTypeRepository{}
+				repo.On("Create", ctx, tenantID, modelEntityTypeForApp).Return(testErr).Once()
+				return repo
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				svc := &automock.UIDService{}
+				svc.On("Generate").Return(entityTypeID).Once()
+				return svc
+			},
+			Input:        modelInput,
+			ResourceType: resource.Application,
+			ResourceID:   appID,
+			ExpectedErr:  testErr,
+		},
+		{
+			Name: "Error - Entity Type creation for Application Template Version",
+			RepositoryFn: func() *automock.EntityTypeRepository {
+				repo := &automock.EntityTypeRepository{}
+				repo.On("CreateGlobal", ctx, modelEntityTypeForAppTemplateVersion).Return(testErr).Once()
+				return repo
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				svc := &automock.UIDService{}
+				svc.On("Generate").Return(entityTypeID).Once()
+				return svc
+			},
+			Input:        modelInput,
+			ResourceType: resource.ApplicationTemplateVersion,
+			ResourceID:   appTemplateVersionID,
+			ExpectedErr:  testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			repo := testCase.RepositoryFn()
+			uidService := testCase.UIDServiceFn()
+
+			svc := entitytype.NewService(repo, uidService)
+
+			// WHEN
+			result, err := svc.Create(ctx, testCase.ResourceType, testCase.ResourceID, packageID, testCase.Input, uint64(123456))
+
+			// THEN
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				assert.IsType(t, "string", result)
+			}
+
+			mock.AssertExpectationsForObjects(t, repo, uidService)
+		})
+	}
+	t.Run("Error when tenant not in context", func(t *testing.T) {
+		svc := entitytype.NewService(nil, fixUIDService())
+		// WHEN
+		_, err := svc.Create(context.TODO(), resource.Application, "", "", model.EntityTypeInput{}, 0)
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot read tenant from context")
+	})
+}
+
+func TestService_Update(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("Test error")
+
+	modelEntityTypeForApp := fixEntityTypeModelForApp()
+	modelEntityTypeForAppTemplateVersion := fixEntityTypeModelForAppTemplateVersion()
+	modelInput := *fixEntityTypeModelInput()
+
+	inputEntityTypeModel := mock.MatchedBy(func(entityType *model.EntityType) bool {
+		return entityType.Title == modelInput.Title
+	})
+
+	ctx := context.TODO()
+	ctx = tenant.SaveToContext(ctx, tenantID, externalTenantID)
+
+	testCases := []struct {
+		Name         string
+		RepositoryFn func() *automock.EntityTypeRepository
+		Input        model.EntityTypeInput
+		InputID      string
+		ResourceType resource.Type
+		ExpectedErr  error
+	}{
+		{
+			Name: "Success for Application",
+			RepositoryFn: func() *automock.EntityTypeRepository {
+				repo := &automock.EntityTypeRepository{}
+				repo.On("GetByID", ctx, tenantID, entityTypeID).Return(modelEntityTypeForApp, nil).Once()
+				repo.On("Update", ctx, tenantID, inputEntityTypeModel).Return(nil).Once()
+				return repo
+			},
+			InputID:      entityTypeID,
+			Input:        modelInput,
+			ResourceType: resource.Application,
+			ExpectedErr:  nil,
+		},
+		{
+			Name: "Success for Application Template Version",
+			RepositoryFn: func() *automock.EntityTypeRepository {
+				repo := &automock.EntityTypeRepository{}
+				repo.On("GetByIDGlobal", ctx, entityTypeID).Return(modelEntityTypeForAppTemplateVersion, nil).Once()
+				repo.On("UpdateGlobal", ctx, inputEntityTypeModel).Return(nil).Once()
+				return repo
+			},
+			InputID:      entityTypeID,
+			Input:        modelInput,
+			ResourceType: resource.ApplicationTemplateVersion,
+			ExpectedErr:  nil,
+		},
+		{
+			Name: "Update Error for Application",
+			RepositoryFn: func() *automock.EntityTypeRepository {
+				repo := &automock.EntityTypeRepository{}
+				repo.On("GetByID", ctx, tenantID, entityTypeID).Return(modelEntityTypeForApp, nil).Once()
+				repo.On("Update", ctx, tenantID, inputEntityTypeModel).Return(testErr).Once()
+				return repo
+			},
+			InputID:      entityTypeID,
+			Input:        modelInput,
+			ResourceType: resource.Application,
+			ExpectedErr:  testErr,
+		},
+		{
+			Name: "Update Error for Application Template Version",
+			RepositoryFn: func() *automock.EntityTypeRepository {
+				repo := &automock.EntityTypeRepository{}
+				repo.On("GetByIDGlobal", ctx, entityTypeID).Return(modelEntityTypeForAppTemplateVersion, nil).Once()
+				repo.On("UpdateGlobal", ctx, inputEntityTypeModel).Return(testErr).Once()
+				return repo
+			},
+			InputID:      entityTypeID,
+			Input:        modelInput,
+			ResourceType: resource.ApplicationTemplateVersion,
+			ExpectedErr:  testErr,
+		},
+		{
+			Name: "Get Error for Application",
+			RepositoryFn: func() *automock.EntityTypeRepository {
+				repo := &automock.EntityTypeRepository{}
+				repo.On("GetByID", ctx, tenantID, entityTypeID).Return(nil, testErr).Once()
+				return repo
+			},
+			InputID:      entityTypeID,
+			Input:        modelInput,
+			ResourceType: resource.Application,
+			ExpectedErr:  testErr,
+		},
+		{
+			Name: "Get Error for Application Template Version",
+			RepositoryFn: func() *automock.EntityTypeRepository {
+				repo := &automock.EntityTypeRepository{}
+				repo.On("GetByIDGlobal", ctx, entityTypeID).Return(nil, testErr).Once()
+				return repo
+			},
+			InputID:      entityTypeID,
+			Input:        modelInput,
+			ResourceType: resource.ApplicationTemplateVersion,
+			ExpectedErr:  testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			repo := testCase.RepositoryFn()
+
+			svc := entitytype.NewService(repo, nil)
+
+			// WHEN
+			err := svc.Update(ctx, testCase.ResourceType, testCase.InputID, packageID, testCase.Input, 0)
+
+			// THEN
+			if testCase.ExpectedErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+	t.Run("Error when tenant not in context", func(t *testing.T) {
+		svc := entitytype.NewService(nil, fixUIDService())
+		// WHEN
+		err := svc.Update(context.TODO(), resource.Application, "", "", model.EntityTypeInput{}, 0)
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot read tenant from context")
+	})
+}
+
+func TestService_Delete(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("Test error")
+
+	ctx := context.TODO()
+	ctx = tenant.SaveToContext(ctx, tenantID, externalTenantID)
+
+	testCases := []struct {
+		Name         string
+		RepositoryFn func() *automock.EntityTypeRepository
+		Input        model.EntityTypeInput
+		InputID      string
+		ResourceType resource.Type
+		ExpectedErr  error
+	}{
+		{
+			Name: "Success for Application",
+			RepositoryFn: func() *automock.EntityTypeRepository {
+				repo := &automock.EntityTypeRepository{}
+				repo.On("Delete", ctx, tenantID, entityTypeID).Return(nil).Once()
+				return repo
+			},
+			InputID:      entityTypeID,
+			ResourceType: resource.Application,
+			ExpectedErr:  nil,
+		},
+		{
+			Name: "Success for Application",
+			RepositoryFn: func() *automock.EntityTypeRepository {
+				repo := &automock.EntityTypeRepository{}
+				repo.On("DeleteGlobal", ctx, entityTypeID).Return(nil).Once()
+				return repo
+			},
+			InputID:      entityTypeID,
+			ResourceType: resource.ApplicationTemplateVersion,
+			ExpectedErr:  nil,
+		},
+		{
+			Name: "Delete Error for Application",
+			RepositoryFn: func() *automock.EntityTypeRepository {
+				repo := &automock.EntityTypeRepository{}
+				repo.On("Delete", ctx, tenantID, entityTypeID).Return(testErr).Once()
+				return repo
+			},
+			InputID:      entityTypeID,
+			ResourceType: resource.Application,
+			ExpectedErr:  testErr,
+		},
+		{
+			Name: "Delete Error for Application Template Version",
+			RepositoryFn: func() *automock.EntityTypeRepository {
+				repo := &automock.EntityTypeRepository{}
+				repo.On("DeleteGlobal", ctx, entityTypeID).Return(testErr).Once()
+				return repo
+			},
+			InputID:      entityTypeID,
+			ResourceType: resource.ApplicationTemplateVersion,
+			ExpectedErr:  testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			repo := testCase.RepositoryFn()
+
+			svc := entitytype.NewService(repo, nil)
+
+			// WHEN
+			err := svc.Delete(ctx, testCase.ResourceType, testCase.InputID)
+
+			// THEN
+			if testCase.ExpectedErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+	t.Run("Error when tenant not in context", func(t *testing.T) {
+		svc := entitytype.NewService(nil, nil)
+		// WHEN
+		err := svc.Delete(context.TODO(), resource.Application, "")
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot read tenant from context")
+	})
+}
+
+func TestService_Get(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("Test error")
+
+	entityType := fixEntityTypeModelForApp()
+
+	ctx := context.TODO()
+	ctx = tenant.SaveToContext(ctx, tenantID, externalTenantID)
+
+	testCases := []struct {
+		Name               string
+		RepositoryFn       func() *automock.EntityTypeRepository
+		Input              model.EntityTypeInput
+		InputID            string
+		ExpectedEntityType *model.EntityType
+		ExpectedErrMessage string
+	}{
+		{
+			Name: "Success",
+			RepositoryFn: func() *automock.EntityTypeRepository {
+				repo := &automock.EntityTypeRepository{}
+				repo.On("GetByID", ctx, tenantID, entityTypeID).Return(entityType, nil).Once()
+				return repo
+			},
+			InputID:            entityTypeID,
+			ExpectedEntityType: entityType,
+			ExpectedErrMessage: "",
+		},
+		{
+			Name: "Returns error when Entity Type retrieval failed",
+			RepositoryFn: func() *automock.EntityTypeRepository {
+				repo := &automock.EntityTypeRepository{}
+				repo.On("GetByID", ctx, tenantID, entityTypeID).Return(nil, testErr).Once()
+				return repo
+			},
+			InputID:            entityTypeID,
+			ExpectedEntityType: entityType,
+			ExpectedErrMessage: testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepositoryFn()
+			svc := entitytype.NewService(repo, nil)
+
+			// WHEN
+			entityType, err := svc.Get(ctx, testCase.InputID)
+
+			// then
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedEntityType, entityType)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+	t.Run("Error when tenant not in context", func(t *testing.T) {
+		svc := entitytype.NewService(nil, nil)
+		// WHEN
+		_, err := svc.Get(context.TODO(), "")
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot read tenant from context")
+	})
+}
+
+func TestService_ListByApplicationID(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("Test error")
+
+	entityTypes := []*model.EntityType{
+		fixEntityTypeModelForApp(),
+		fixEntityTypeModelForApp(),
+		fixEntityTypeModelForApp(),
+	}
+
+	ctx := context.TODO()
+	ctx = tenant.SaveToContext(ctx, tenantID, externalTenantID)
+
+	testCases := []struct {
+		Name               string
+		PageSize           int
+		RepositoryFn       func() *automock.EntityTypeRepository
+		ExpectedResult     []*model.EntityType
+		ExpectedErrMessage string
+	}{
+		{
+			Name: "Success",
+			RepositoryFn: func() *automock.EntityTypeRepository {
+				repo := &automock.EntityTypeRepository{}
+				repo.On("ListByResourceID", ctx, tenantID, appID, resource.Application).Return(entityTypes, nil).Once()
+				return repo
+			},
+			PageSize:           2,
+			ExpectedResult:     entityTypes,
+			ExpectedErrMessage: "",
+		},
+		{
+			Name: "Returns error when Entity Type listing failed",
+			RepositoryFn: func() *automock.EntityTypeRepository {
+				repo := &automock.EntityTypeRepository{}
+				repo.On("ListByResourceID", ctx, tenantID, appID, resource.Application).Return(nil, testErr).Once()
+				return repo
+			},
+			PageSize:           2,
+			ExpectedResult:     nil,
+			ExpectedErrMessage: testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepositoryFn()
+
+			svc := entitytype.NewService(repo, nil)
+
+			// WHEN
+			entityTypes, err := svc.ListByApplicationID(ctx, appID)
+
+			// then
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedResult, entityTypes)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+	t.Run("Error when tenant not in context", func(t *testing.T) {
+		svc := entitytype.NewService(nil, nil)
+		// WHEN
+		_, err := svc.ListByApplicationID(context.TODO(), "")
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot read tenant from context")
+	})
+}
+
+func TestService_ListByApplicationTemplateVersionID(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("Test error")
+
+	entityTypes := []*model.EntityType{
+		fixEntityTypeModelForApp(),
+		fixEntityTypeModelForApp(),
+		fixEntityTypeModelForApp(),
+	}
+
+	ctx := context.TODO()
+
+	testCases := []struct {
+		Name               string
+		PageSize           int
+		RepositoryFn       func() *automock.EntityTypeRepository
+		ExpectedResult     []*model.EntityType
+		ExpectedErrMessage string
+	}{
+		{
+			Name: "Success",
+			RepositoryFn: func() *automock.EntityTypeRepository {
+				repo := &automock.EntityTypeRepository{}
+				repo.On("ListByResourceID", ctx, "", appTemplateVersionID, resource.ApplicationTemplateVersion).Return(entityTypes, nil).Once()
+				return repo
+			},
+			PageSize:           2,
+			ExpectedResult:     entityTypes,
+			ExpectedErrMessage: "",
+		},
+		{
+			Name: "Returns error when Entity Type listing failed",
+			RepositoryFn: func() *automock.EntityTypeRepository {
+				repo := &automock.EntityTypeRepository{}
+				repo.On("ListByResourceID", ctx, "", appTemplateVersionID, resource.ApplicationTemplateVersion).Return(nil, testErr).Once()
+				return repo
+			},
+			PageSize:           2,
+			ExpectedResult:     nil,
+			ExpectedErrMessage: testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepositoryFn()
+
+			svc := entitytype.NewService(repo, nil)
+
+			// WHEN
+			entityTypes, err := svc.ListByApplicationTemplateVersionID(ctx, appTemplateVersionID)
+
+			// then
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedResult, entityTypes)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_ListByApplicationIDs(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("Test error")
+	secondAppID := "secondAppID"
+
+	entityTypes := []*model.EntityType{
+		fixEntityTypeModelWithTitleForApp("title1"),
+		fixEntityTypeModelWithTitleForApp("title2"),
+	}
+
+	ctx := context.TODO()
+	ctx = tenant.SaveToContext(ctx, tenantID, externalTenantID)
+
+	testCases := []struct {
+		Name               string
+		RepositoryFn       func() *automock.EntityTypeRepository
+		ExpectedResult     [][]*model.EntityType
+		ExpectedErrMessage string
+	}{
+		{
+			Name: "Success",
+			RepositoryFn: func() *automock.EntityTypeRepository {
+				repo := &automock.EntityTypeRepository{}
+				repo.On("ListByApplicationIDs", ctx, tenantID, []string{appID, secondAppID}).Return(entityTypes, nil).Once()
+				return repo
+			},
+			ExpectedResult:     [][]*model.EntityType{entityTypes, nil},
+			ExpectedErrMessage: "",
+		},
+		{
+			Name: "Returns error when Entity Type listing failed",
+			RepositoryFn: func() *automock.EntityTypeRepository {
+				repo := &automock.EntityTypeRepository{}
+				repo.On("ListByApplicationIDs", ctx, tenantID, []string{appID, secondAppID}).Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedResult:     nil,
+			ExpectedErrMessage: testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepositoryFn()
+
+			svc := entitytype.NewService(repo, nil)
+
+			// WHEN
+			result, err := svc.ListByApplicationIDs(ctx, []string{appID, secondAppID})
+
+			// THEN
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedResult, result)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+	t.Run("Error when tenant not in context", func(t *testing.T) {
+		svc := entitytype.NewService(nil, nil)
+		// WHEN
+		_, err := svc.ListByApplicationIDs(context.TODO(), []string{appID})
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot read tenant from context")
+	})
+}
+
+func TestService_ListByPackageIDs(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("Test error")
+	secondPackageID := "secondPackageID"
+
+	entityTypes := []*model.EntityType{
+		fixEntityTypeModelWithTitleForApp("title1"),
+		fixEntityTypeModelWithTitleForApp("title2"),
+	}
+
+	ctx := context.TODO()
+	ctx = tenant.SaveToContext(ctx, tenantID, externalTenantID)
+
+	testCases := []struct {
+		Name               string
+		RepositoryFn       func() *automock.EntityTypeRepository
+		ExpectedResult     [][]*model.EntityType
+		ExpectedErrMessage string
+	}{
+		{
+			Name: "Success",
+			RepositoryFn: func() *automock.EntityTypeRepository {
+				repo := &automock.EntityTypeRepository{}
+				repo.On("ListByPackageIDs", ctx, tenantID, []string{packageID, secondPackageID}).Return(entityTypes, nil).Once()
+				return repo
+			},
+			ExpectedResult:     [][]*model.EntityType{entityTypes, nil},
+			ExpectedErrMessage: "",
+		},
+		{
+			Name: "Returns error when Entity Type listing failed",
+			RepositoryFn: func() *automock.EntityTypeRepository {
+				repo := &automock.EntityTypeRepository{}
+				repo.On("ListByPackageIDs", ctx, tenantID, []string{packageID, secondPackageID}).Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedResult:     nil,
+			ExpectedErrMessage: testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepositoryFn()
+
+			svc := entitytype.NewService(repo, nil)
+
+			// WHEN
+			result, err := svc.ListByPackageIDs(ctx, []string{packageID, secondPackageID})
+
+			// THEN
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedResult, result)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+	t.Run("Error when tenant not in context", func(t *testing.T) {
+		svc := entitytype.NewService(nil, nil)
+		// WHEN
+		_, err := svc.ListByPackageIDs(context.TODO(), []string{packageID})
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot read tenant from context")
+	})
+}
+
+func fixUIDService() *automock.UIDService {
+	uidSvc := &automock.UIDService{}
+	uidSvc.On("Generate").Return(entityTypeID)
+	return uidSvc
+}
@@ -24,6 +24,7 @@ var (
 	appColumn                = "app_id"
 	appTemplateVersionColumn = "app_template_version_id"
 	bundleColumn             = "bundle_id"
+	ordIDColumn              = "ord_id"
 	eventDefColumns          = []string{idColumn, appColumn, "app_template_version_id", "package_id", "name", "description", "group_name", "ord_id", "local_tenant_id",
 		"short_description", "system_instance_aware", "policy_level", "custom_policy_level", "changelog_entries", "links", "tags", "countries", "release_status",
 		"sunset_date", "labels", "visibility", "disabled", "part_of_products", "line_of_business", "industry", "version_value", "version_deprecated", "version_deprecated_since",
@@ -228,6 +229,26 @@ func (r *pgRepository) ListByResourceID(ctx context.Context, tenantID, resourceI
 	return events, nil
 }
 
+// ListByOrdIDs lists all EventDefinitions visible to the given tenant which have one of the provided ORD IDs.
+func (r *pgRepository) ListByOrdIDs(ctx context.Context, tenantID string, ordIDs []string) ([]*model.EventDefinition, error) {
+	eventCollection := EventAPIDefCollection{}
+	if len(ordIDs) == 0 {
+		return []*model.EventDefinition{}, nil
+	}
+
+	if err := r.lister.List(ctx, resource.EventDefinition, tenantID, &eventCollection, repo.NewInConditionForStringValues(ordIDColumn, ordIDs)); err != nil {
+		return nil, err
+	}
+
+	events := make([]*model.EventDefinition, 0, eventCollection.Len())
+	for _, event := range eventCollection {
+		eventModel := r.conv.FromEntity(&event)
+		events = append(events, eventModel)
+	}
+
+	return events, nil
+}
+
 // Create creates an EventDefinition.
 func (r *pgRepository) Create(ctx context.Context, tenant string, item *model.EventDefinition) error {
 	if item == nil {
@@ -170,6 +170,49 @@ func TestPgRepository_ListByResourceID(t *testing.T) {
 	suite.Run(t)
 }
 
+func TestPgRepository_ListByOrdIDs(t *testing.T) {
+	ordID := "ordID"
+	eventDefModel := fixEventDefinitionModel(eventID, "placeholder")
+	eventDefEntity := fixFullEntityEventDefinition(eventID, "placeholder")
+
+	suite := testdb.RepoListTestSuite{
+		Name: "List Events by ORD IDs",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:    regexp.QuoteMeta(`SELECT id, app_id, app_template_version_id, package_id, name, description, group_name, ord_id, local_tenant_id, short_description, system_instance_aware, policy_level, custom_policy_level, changelog_entries, links, tags, countries, release_status, sunset_date, labels, visibility, disabled, part_of_products, line_of_business, industry, version_value, version_deprecated, version_deprecated_since, version_for_removal, ready, created_at, updated_at, deleted_at, error, extensible, successors, resource_hash, hierarchy, documentation_labels FROM "public"."event_api_definitions" WHERE ord_id IN ($1) AND (id IN (SELECT id FROM event_api_definitions_tenants WHERE tenant_id = $2))`),
+				Args:     []driver.Value{ordID, tenantID},
+				IsSelect: true,
+				ValidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixEventDefinitionColumns()).AddRow(fixEventDefinitionRow(eventID, "placeholder")...)}
+				},
+				InvalidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixEventDefinitionColumns())}
+				},
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EventAPIDefinitionConverter{}
+		},
+		RepoConstructorFunc:       event.NewRepository,
+		ExpectedModelEntities:     []interface{}{eventDefModel},
+		ExpectedDBEntities:        []interface{}{eventDefEntity},
+		MethodArgs:                []interface{}{tenantID, []string{ordID}},
+		MethodName:                "ListByOrdIDs",
+		DisableConverterErrorTest: true,
+	}
+
+	suite.Run(t)
+
+	t.Run("Returns empty slice when no ORD IDs are provided", func(t *testing.T) {
+		pgRepository := event.NewRepository(&automock.EventAPIDefinitionConverter{})
+
+		events, err := pgRepository.ListByOrdIDs(context.TODO(), tenantID, nil)
+
+		require.NoError(t, err)
+		require.Empty(t, events)
+	})
+}
+
 func TestPgRepository_ListByApplicationIDPage(t *testing.T) {
 	pageSize := 1
 	cursor := ""
@@ -450,7 +450,7 @@ func (r *Resolver) FetchRequestEventDefDataLoader(keys []dataloader.ParamFetchRe
 		specIDs = append(specIDs, key.ID)
 	}
 
-	tx, err := r.transact.Begin()
+	tx, err := persistence.BeginForCtx(ctx, r.transact)
 	if err != nil {
 		return nil, []error{err}
 	}
@@ -7,8 +7,10 @@ import (
 
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 
+	"github.com/kyma-incubator/compass/components/director/internal/domain/auth"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/kyma-incubator/compass/components/director/pkg/encryption"
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 	"github.com/pkg/errors"
 )
@@ -21,12 +23,13 @@ type AuthConverter interface {
 }
 
 type converter struct {
-	authConverter AuthConverter
+	authConverter        AuthConverter
+	credentialsEncryptor encryption.Encryptor
 }
 
 // NewConverter missing godoc
-func NewConverter(authConverter AuthConverter) *converter {
-	return &converter{authConverter: authConverter}
+func NewConverter(authConverter AuthConverter, credentialsEncryptor encryption.Encryptor) *converter {
+	return &converter{authConverter: authConverter, credentialsEncryptor: credentialsEncryptor}
 }
 
 // ToGraphQL missing godoc
@@ -111,6 +114,8 @@ func (c *converter) ToEntity(in *model.FetchRequest) (*Entity, error) {
 		StatusCondition: string(in.Status.Condition),
 		StatusMessage:   message,
 		StatusTimestamp: in.Status.Timestamp,
+		ETag:            repo.NewNullableString(in.ETag),
+		LastModified:    repo.NewNullableString(in.LastModified),
 	}, nil
 }
 
@@ -137,8 +142,10 @@ func (c *converter) FromEntity(in *Entity, objectType model.FetchRequestReferenc
 		},
 		URL:    in.URL,
 		Mode:   model.FetchMode(in.Mode),
-		Filter: repo.StringPtrFromNullableString(in.Filter),
-		Auth:   auth,
+		Filter:       repo.StringPtrFromNullableString(in.Filter),
+		Auth:         auth,
+		ETag:         repo.StringPtrFromNullableString(in.ETag),
+		LastModified: repo.StringPtrFromNullableString(in.LastModified),
 	}, nil
 }
 
@@ -169,18 +176,21 @@ func (c *converter) statusToGraphQL(in *model.FetchRequestStatus) *graphql.Fetch
 }
 
 func (c *converter) authToEntity(in *model.Auth) (sql.NullString, error) {
-	var auth sql.NullString
 	if in == nil {
 		return sql.NullString{}, nil
 	}
 
-	authMarshalled, err := json.Marshal(in)
+	encryptedAuth, err := auth.EncryptCredentials(c.credentialsEncryptor, in)
+	if err != nil {
+		return sql.NullString{}, err
+	}
+
+	authMarshalled, err := json.Marshal(encryptedAuth)
 	if err != nil {
 		return sql.NullString{}, errors.Wrap(err, "while marshalling Auth")
 	}
 
-	auth = repo.NewValidNullableString(string(authMarshalled))
-	return auth, nil
+	return repo.NewValidNullableString(string(authMarshalled)), nil
 }
 
 func (c *converter) authToModel(in sql.NullString) (*model.Auth, error) {
@@ -188,13 +198,13 @@ func (c *converter) authToModel(in sql.NullString) (*model.Auth, error) {
 		return nil, nil
 	}
 
-	var auth model.Auth
-	err := json.Unmarshal([]byte(in.String), &auth)
+	var authValue model.Auth
+	err := json.Unmarshal([]byte(in.String), &authValue)
 	if err != nil {
 		return nil, errors.Wrap(err, "while unmarshalling Auth")
 	}
 
-	return &auth, nil
+	return auth.DecryptCredentials(c.credentialsEncryptor, &authValue)
 }
 
 func (c *converter) objectIDFromEntity(in Entity) (string, error) {
@@ -14,6 +14,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/domain/fetchrequest"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/fetchrequest/automock"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/encryption"
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 	"github.com/stretchr/testify/assert"
 )
@@ -52,7 +53,7 @@ func TestConverter_ToGraphQL(t *testing.T) {
 			if testCase.Input != nil {
 				authConv.On("ToGraphQL", testCase.Input.Auth).Return(testCase.Expected.Auth, nil)
 			}
-			converter := fetchrequest.NewConverter(authConv)
+			converter := fetchrequest.NewConverter(authConv, encryption.NewNoopEncryptor())
 
 			// WHEN
 			res, err := converter.ToGraphQL(testCase.Input)
@@ -95,7 +96,7 @@ func TestConverter_InputFromGraphQL(t *testing.T) {
 			if testCase.Input != nil {
 				authConv.On("InputFromGraphQL", testCase.Input.Auth).Return(testCase.Expected.Auth, nil)
 			}
-			converter := fetchrequest.NewConverter(authConv)
+			converter := fetchrequest.NewConverter(authConv, encryption.NewNoopEncryptor())
 
 			// WHEN
 			res, err := converter.InputFromGraphQL(testCase.Input)
@@ -148,7 +149,7 @@ func TestConverter_FromEntity(t *testing.T) {
 	for _, testCase := range testCases {
 		t.Run(testCase.Name, func(t *testing.T) {
 			authConv := &automock.AuthConverter{}
-			conv := fetchrequest.NewConverter(authConv)
+			conv := fetchrequest.NewConverter(authConv, encryption.NewNoopEncryptor())
 
 			// WHEN
 			res, err := conv.FromEntity(testCase.Input, model.APISpecFetchRequestReference)
@@ -216,7 +217,7 @@ func TestConverter_ToEntity(t *testing.T) {
 	for _, testCase := range testCases {
 		t.Run(testCase.Name, func(t *testing.T) {
 			authConv := &automock.AuthConverter{}
-			conv := fetchrequest.NewConverter(authConv)
+			conv := fetchrequest.NewConverter(authConv, encryption.NewNoopEncryptor())
 
 			// WHEN
 			res, err := conv.ToEntity(testCase.Input)
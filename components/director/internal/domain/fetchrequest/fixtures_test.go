@@ -10,6 +10,7 @@ import (
 
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
 	"github.com/stretchr/testify/require"
 )
 
@@ -17,6 +18,8 @@ const (
 	tenantID      = "b91b59f7-2563-40b2-aba9-fef726037aa3"
 	localTenantID = "local-tenant-id"
 	refID         = "refID"
+	etag          = `"33a64df551425fcc55e4d42a148795d9f25f89d4"`
+	lastModified  = "Wed, 21 Oct 2015 07:28:00 GMT"
 )
 
 func fixModelFetchRequest(t *testing.T, url, filter string) *model.FetchRequest {
@@ -96,8 +99,10 @@ func fixFullFetchRequestModelWithRefID(id string, timestamp time.Time, objectTyp
 				},
 			},
 		},
-		ObjectType: objectType,
-		ObjectID:   objectID,
+		ObjectType:   objectType,
+		ObjectID:     objectID,
+		ETag:         str.Ptr(etag),
+		LastModified: str.Ptr(lastModified),
 	}
 }
 
@@ -144,8 +149,10 @@ func fixFullFetchRequestEntityWithRefID(t *testing.T, id string, timestamp time.
 			Valid:  true,
 			String: string(bytes),
 		},
-		SpecID:     specID,
-		DocumentID: documentID,
+		SpecID:       specID,
+		DocumentID:   documentID,
+		ETag:         sql.NullString{Valid: true, String: etag},
+		LastModified: sql.NullString{Valid: true, String: lastModified},
 	}
 }
 
@@ -184,5 +191,5 @@ func fixFetchRequestEntityWithReferences(id string, timestamp time.Time, specID,
 }
 
 func fixColumns() []string {
-	return []string{"id", "document_id", "url", "auth", "mode", "filter", "status_condition", "status_message", "status_timestamp", "spec_id"}
+	return []string{"id", "document_id", "url", "auth", "mode", "filter", "status_condition", "status_message", "status_timestamp", "spec_id", "etag", "last_modified"}
 }
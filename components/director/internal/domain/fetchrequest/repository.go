@@ -18,7 +18,8 @@ const documentIDColumn = "document_id"
 const specIDColumn = "spec_id"
 
 var (
-	fetchRequestColumns = []string{"id", documentIDColumn, "url", "auth", "mode", "filter", "status_condition", "status_message", "status_timestamp", specIDColumn}
+	fetchRequestColumns = []string{"id", documentIDColumn, "url", "auth", "mode", "filter", "status_condition", "status_message", "status_timestamp", specIDColumn, "etag", "last_modified"}
+	updatableColumns    = []string{"status_condition", "status_message", "status_timestamp", "etag", "last_modified"}
 )
 
 // Converter missing godoc
@@ -52,8 +53,8 @@ func NewRepository(conv Converter) *repository {
 		listerGlobal:  repo.NewListerGlobal(resource.FetchRequest, fetchRequestTable, fetchRequestColumns),
 		deleter:       repo.NewDeleter(fetchRequestTable),
 		deleterGlobal: repo.NewDeleterGlobal(resource.FetchRequest, fetchRequestTable),
-		updater:       repo.NewUpdater(fetchRequestTable, []string{"status_condition", "status_message", "status_timestamp"}, []string{"id"}),
-		updaterGlobal: repo.NewUpdaterGlobal(resource.FetchRequest, fetchRequestTable, []string{"status_condition", "status_message", "status_timestamp"}, []string{"id"}),
+		updater:       repo.NewUpdater(fetchRequestTable, updatableColumns, []string{"id"}),
+		updaterGlobal: repo.NewUpdaterGlobal(resource.FetchRequest, fetchRequestTable, updatableColumns, []string{"id"}),
 		conv:          conv,
 	}
 }
@@ -108,6 +108,9 @@ func (s *service) UpdateGlobal(ctx context.Context, fr *model.FetchRequest) erro
 	return nil
 }
 
+// FetchSpec executes the fetch request and returns the fetched specification.
+// If the fetch request holds validators from a previous successful execution, a conditional request is made and in case
+// the specification is not modified, nil data with a succeeded status is returned. The validators of the fetch request are updated accordingly.
 func (s *service) FetchSpec(ctx context.Context, fr *model.FetchRequest) (*string, *model.FetchRequestStatus) {
 	err := s.validateFetchRequest(fr)
 	if err != nil {
@@ -115,6 +118,10 @@ func (s *service) FetchSpec(ctx context.Context, fr *model.FetchRequest) (*strin
 		return nil, FixStatus(model.FetchRequestStatusConditionInitial, str.Ptr(err.Error()), s.timestampGen())
 	}
 
+	etag, lastModified := fr.ETag, fr.LastModified
+	conditionalHeaders := httputil.ConditionalRequestHeaders(etag, lastModified)
+	fr.ETag, fr.LastModified = nil, nil
+
 	localTenantID, err := tenant.LoadLocalTenantIDFromContext(ctx)
 	if err != nil {
 		log.C(ctx).Warnf("An error has occurred while getting local tenant id: %v", err)
@@ -131,15 +138,15 @@ func (s *service) FetchSpec(ctx context.Context, fr *model.FetchRequest) (*strin
 		}
 
 		doRequest = func() (*http.Response, error) {
-			return executor.Execute(ctx, s.client, fr.URL, localTenantID)
+			return executor.Execute(ctx, s.client, fr.URL, localTenantID, conditionalHeaders)
 		}
 	} else if fr.Auth != nil {
 		doRequest = func() (*http.Response, error) {
-			return httputil.GetRequestWithCredentials(ctx, s.client, fr.URL, localTenantID, fr.Auth)
+			return httputil.GetRequestWithCredentials(ctx, s.client, fr.URL, localTenantID, conditionalHeaders, fr.Auth)
 		}
 	} else {
 		doRequest = func() (*http.Response, error) {
-			return httputil.GetRequestWithoutCredentials(s.client, fr.URL, localTenantID)
+			return httputil.GetRequestWithoutCredentials(s.client, fr.URL, localTenantID, conditionalHeaders)
 		}
 	}
 
@@ -170,11 +177,19 @@ func (s *service) FetchSpec(ctx context.Context, fr *model.FetchRequest) (*strin
 		return nil, FixStatus(model.FetchRequestStatusConditionFailed, str.Ptr(fmt.Sprintf("While reading Spec: %s", err.Error())), s.timestampGen())
 	}
 
+	if resp.StatusCode == http.StatusNotModified && conditionalHeaders != nil {
+		log.C(ctx).Infof("Spec for %s with id %q is not modified since it was last fetched", fr.ObjectType, fr.ObjectID)
+		fr.ETag, fr.LastModified = etag, lastModified
+		return nil, FixStatus(model.FetchRequestStatusConditionSucceeded, nil, s.timestampGen())
+	}
+
 	if resp.StatusCode != http.StatusOK {
 		log.C(ctx).Errorf("Failed to execute fetch request for %s with id %q: status code: %d body: %s", fr.ObjectType, fr.ObjectID, resp.StatusCode, string(body))
 		return nil, FixStatus(model.FetchRequestStatusConditionFailed, str.Ptr(fmt.Sprintf("While fetching Spec status code: %d", resp.StatusCode)), s.timestampGen())
 	}
 
+	fr.ETag, fr.LastModified = httputil.ResponseValidators(resp)
+
 	spec := string(body)
 	return &spec, FixStatus(model.FetchRequestStatusConditionSucceeded, nil, s.timestampGen())
 }
@@ -192,6 +192,16 @@ func TestService_HandleSpec(t *testing.T) {
 		Auth: &model.Auth{AccessStrategy: &testAccessStrategy},
 	}
 
+	etag := `"33a64df551425fcc55e4d42a148795d9f25f89d4"`
+	lastModified := "Wed, 21 Oct 2015 07:28:00 GMT"
+
+	modelInputWithValidators := model.FetchRequest{
+		ID:           "test",
+		Mode:         model.FetchModeSingle,
+		ETag:         &etag,
+		LastModified: &lastModified,
+	}
+
 	modelInputBasicCredentials := model.FetchRequest{
 		ID: "test",
 		Auth: &model.Auth{
@@ -240,6 +250,8 @@ func TestService_HandleSpec(t *testing.T) {
 		ExecutorProviderFunc func() accessstrategy.ExecutorProvider
 		ExpectedResult       *string
 		ExpectedStatus       *model.FetchRequestStatus
+		ExpectedETag         *string
+		ExpectedLastModified *string
 	}{
 
 		{
@@ -257,6 +269,61 @@ func TestService_HandleSpec(t *testing.T) {
 			ExpectedResult: &mockSpec,
 			ExpectedStatus: fetchrequest.FixStatus(model.FetchRequestStatusConditionSucceeded, nil, timestamp),
 		},
+		{
+			Name: "Success stores the validators of the response",
+			Client: func(t *testing.T) *http.Client {
+				return NewTestClient(func(req *http.Request) *http.Response {
+					assert.Empty(t, req.Header.Get("If-None-Match"))
+					assert.Empty(t, req.Header.Get("If-Modified-Since"))
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Header:     http.Header{"Etag": []string{etag}, "Last-Modified": []string{lastModified}},
+						Body:       io.NopCloser(bytes.NewBufferString(mockSpec)),
+					}
+				})
+			},
+			InputFr:              modelInput,
+			localTenantID:        localTenantID,
+			ExpectedResult:       &mockSpec,
+			ExpectedStatus:       fetchrequest.FixStatus(model.FetchRequestStatusConditionSucceeded, nil, timestamp),
+			ExpectedETag:         &etag,
+			ExpectedLastModified: &lastModified,
+		},
+		{
+			Name: "Success with conditional request when the spec is not modified",
+			Client: func(t *testing.T) *http.Client {
+				return NewTestClient(func(req *http.Request) *http.Response {
+					assert.Equal(t, etag, req.Header.Get("If-None-Match"))
+					assert.Equal(t, lastModified, req.Header.Get("If-Modified-Since"))
+					return &http.Response{
+						StatusCode: http.StatusNotModified,
+						Body:       io.NopCloser(bytes.NewBufferString("")),
+					}
+				})
+			},
+			InputFr:              modelInputWithValidators,
+			localTenantID:        localTenantID,
+			ExpectedResult:       nil,
+			ExpectedStatus:       fetchrequest.FixStatus(model.FetchRequestStatusConditionSucceeded, nil, timestamp),
+			ExpectedETag:         &etag,
+			ExpectedLastModified: &lastModified,
+		},
+		{
+			Name: "Fails to execute conditional request and clears the validators",
+			Client: func(t *testing.T) *http.Client {
+				return NewTestClient(func(req *http.Request) *http.Response {
+					assert.Equal(t, etag, req.Header.Get("If-None-Match"))
+					return &http.Response{
+						StatusCode: http.StatusInternalServerError,
+						Body:       io.NopCloser(bytes.NewBufferString("")),
+					}
+				})
+			},
+			InputFr:        modelInputWithValidators,
+			localTenantID:  localTenantID,
+			ExpectedResult: nil,
+			ExpectedStatus: fetchrequest.FixStatus(model.FetchRequestStatusConditionFailed, str.Ptr("While fetching Spec status code: 500"), timestamp),
+		},
 		{
 			Name: "Success when local tenant id is missing",
 			Client: func(t *testing.T) *http.Client {
@@ -301,7 +368,7 @@ func TestService_HandleSpec(t *testing.T) {
 			Name: "Success with access strategy",
 			ExecutorProviderFunc: func() accessstrategy.ExecutorProvider {
 				executor := &accessstrategyautomock.Executor{}
-				executor.On("Execute", mock.Anything, mock.Anything, modelInputAccessStrategy.URL, localTenantID).Return(&http.Response{
+				executor.On("Execute", mock.Anything, mock.Anything, modelInputAccessStrategy.URL, localTenantID, http.Header(nil)).Return(&http.Response{
 					StatusCode: http.StatusOK,
 					Body:       io.NopCloser(bytes.NewBufferString(mockSpec)),
 				}, nil).Once()
@@ -336,7 +403,7 @@ func TestService_HandleSpec(t *testing.T) {
 			Name: "Fails when access strategy execution fail",
 			ExecutorProviderFunc: func() accessstrategy.ExecutorProvider {
 				executor := &accessstrategyautomock.Executor{}
-				executor.On("Execute", mock.Anything, mock.Anything, modelInputAccessStrategy.URL, localTenantID).Return(nil, testErr).Once()
+				executor.On("Execute", mock.Anything, mock.Anything, modelInputAccessStrategy.URL, localTenantID, http.Header(nil)).Return(nil, testErr).Once()
 
 				executorProvider := &accessstrategyautomock.ExecutorProvider{}
 				executorProvider.On("Provide", accessstrategy.Type(testAccessStrategy)).Return(executor, nil).Once()
@@ -495,6 +562,8 @@ func TestService_HandleSpec(t *testing.T) {
 
 			assert.Equal(t, testCase.ExpectedStatus, testCase.InputFr.Status)
 			assert.Equal(t, testCase.ExpectedResult, result)
+			assert.Equal(t, testCase.ExpectedETag, testCase.InputFr.ETag)
+			assert.Equal(t, testCase.ExpectedLastModified, testCase.InputFr.LastModified)
 
 			if testCase.ExecutorProviderFunc != nil {
 				mock.AssertExpectationsForObjects(t, executorProviderMock)
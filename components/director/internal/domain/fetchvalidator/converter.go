@@ -0,0 +1,47 @@
+package fetchvalidator
+
+import (
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo"
+)
+
+type converter struct{}
+
+// NewConverter returns a new Converter that can later be used to make the conversions between the service and repository layer representations of a fetch validator.
+func NewConverter() *converter {
+	return &converter{}
+}
+
+// FromEntity converts the provided Entity repo-layer representation of a fetch validator to the service-layer representation model.FetchValidator.
+func (c *converter) FromEntity(entity *Entity) *model.FetchValidator {
+	if entity == nil {
+		return nil
+	}
+
+	return &model.FetchValidator{
+		ID:           entity.ID,
+		WebhookID:    entity.WebhookID,
+		ResourceID:   entity.ResourceID,
+		URL:          entity.URL,
+		ETag:         repo.StringPtrFromNullableString(entity.ETag),
+		LastModified: repo.StringPtrFromNullableString(entity.LastModified),
+		Content:      repo.StringPtrFromNullableString(entity.Content),
+	}
+}
+
+// ToEntity converts the provided service-layer representation of a fetch validator to the repository-layer one.
+func (c *converter) ToEntity(in *model.FetchValidator) *Entity {
+	if in == nil {
+		return nil
+	}
+
+	return &Entity{
+		ID:           in.ID,
+		WebhookID:    in.WebhookID,
+		ResourceID:   in.ResourceID,
+		URL:          in.URL,
+		ETag:         repo.NewNullableString(in.ETag),
+		LastModified: repo.NewNullableString(in.LastModified),
+		Content:      repo.NewNullableString(in.Content),
+	}
+}
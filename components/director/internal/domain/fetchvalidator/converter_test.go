@@ -0,0 +1,77 @@
+package fetchvalidator_test
+
+import (
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/fetchvalidator"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConverter_ToEntity(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Input    *model.FetchValidator
+		Expected *fetchvalidator.Entity
+	}{
+		{
+			Name:     "All properties given",
+			Input:    fixFetchValidatorModel(),
+			Expected: fixFetchValidatorEntity(),
+		},
+		{
+			Name:     "Empty",
+			Input:    &model.FetchValidator{},
+			Expected: &fetchvalidator.Entity{},
+		},
+		{
+			Name:     "Nil",
+			Input:    nil,
+			Expected: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// WHEN
+			result := fetchvalidator.NewConverter().ToEntity(testCase.Input)
+
+			// THEN
+			assert.Equal(t, testCase.Expected, result)
+		})
+	}
+}
+
+func TestConverter_FromEntity(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Input    *fetchvalidator.Entity
+		Expected *model.FetchValidator
+	}{
+		{
+			Name:     "All properties given",
+			Input:    fixFetchValidatorEntity(),
+			Expected: fixFetchValidatorModel(),
+		},
+		{
+			Name:     "Empty",
+			Input:    &fetchvalidator.Entity{},
+			Expected: &model.FetchValidator{},
+		},
+		{
+			Name:     "Nil",
+			Input:    nil,
+			Expected: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// WHEN
+			result := fetchvalidator.NewConverter().FromEntity(testCase.Input)
+
+			// THEN
+			assert.Equal(t, testCase.Expected, result)
+		})
+	}
+}
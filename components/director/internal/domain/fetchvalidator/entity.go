@@ -0,0 +1,24 @@
+package fetchvalidator
+
+import (
+	"database/sql"
+)
+
+// Entity is a representation of a fetch validator in the database.
+type Entity struct {
+	ID           string         `db:"id"`
+	WebhookID    string         `db:"webhook_id"`
+	ResourceID   string         `db:"resource_id"`
+	URL          string         `db:"url"`
+	ETag         sql.NullString `db:"etag"`
+	LastModified sql.NullString `db:"last_modified"`
+	Content      sql.NullString `db:"content"`
+}
+
+// EntityCollection is a collection of fetch validator entities.
+type EntityCollection []Entity
+
+// Len is implementation of a repo.Collection interface
+func (s EntityCollection) Len() int {
+	return len(s)
+}
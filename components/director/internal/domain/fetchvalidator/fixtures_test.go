@@ -0,0 +1,61 @@
+package fetchvalidator_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/fetchvalidator"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+)
+
+const (
+	validatorID  = "cc2ea4d8-9a8d-4d3c-b8d3-1e8ba63f0e42"
+	webhookID    = "0cb2e6a8-e2d4-4b51-9a5f-0a4c9db1f2b6"
+	resourceID   = "2b6ff0ac-9c7d-4a1e-b7a7-5b3f5c2d8e11"
+	validatorURL = "http://test.com/.well-known/open-resource-discovery"
+	etag         = `"33a64df551425fcc55e4d42a148795d9f25f89d4"`
+	lastModified = "Wed, 21 Oct 2015 07:28:00 GMT"
+	content      = `{"openResourceDiscoveryV1":{"documents":[]}}`
+)
+
+func fixFetchValidatorModel() *model.FetchValidator {
+	return &model.FetchValidator{
+		ID:           validatorID,
+		WebhookID:    webhookID,
+		ResourceID:   resourceID,
+		URL:          validatorURL,
+		ETag:         str.Ptr(etag),
+		LastModified: str.Ptr(lastModified),
+		Content:      str.Ptr(content),
+	}
+}
+
+func fixFetchValidatorInput() *model.FetchValidatorInput {
+	return &model.FetchValidatorInput{
+		URL:          validatorURL,
+		ETag:         str.Ptr(etag),
+		LastModified: str.Ptr(lastModified),
+		Content:      str.Ptr(content),
+	}
+}
+
+func fixFetchValidatorEntity() *fetchvalidator.Entity {
+	return &fetchvalidator.Entity{
+		ID:           validatorID,
+		WebhookID:    webhookID,
+		ResourceID:   resourceID,
+		URL:          validatorURL,
+		ETag:         sql.NullString{String: etag, Valid: true},
+		LastModified: sql.NullString{String: lastModified, Valid: true},
+		Content:      sql.NullString{String: content, Valid: true},
+	}
+}
+
+func fixColumns() []string {
+	return []string{"id", "webhook_id", "resource_id", "url", "etag", "last_modified", "content"}
+}
+
+func fixFetchValidatorCreateArgs(entity *fetchvalidator.Entity) []driver.Value {
+	return []driver.Value{entity.ID, entity.WebhookID, entity.ResourceID, entity.URL, entity.ETag, entity.LastModified, entity.Content}
+}
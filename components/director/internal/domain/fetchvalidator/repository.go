@@ -0,0 +1,77 @@
+package fetchvalidator
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+)
+
+const fetchValidatorTable = `public.fetch_validators`
+
+var (
+	webhookIDColumn       = "webhook_id"
+	resourceIDColumn      = "resource_id"
+	fetchValidatorColumns = []string{"id", webhookIDColumn, resourceIDColumn, "url", "etag", "last_modified", "content"}
+)
+
+// EntityConverter converts between the service-layer and repository-layer representations of a fetch validator
+//
+//go:generate mockery --name=EntityConverter --output=automock --outpkg=automock --case=underscore --disable-version-string
+type EntityConverter interface {
+	ToEntity(in *model.FetchValidator) *Entity
+	FromEntity(entity *Entity) *model.FetchValidator
+}
+
+type pgRepository struct {
+	globalCreator repo.CreatorGlobal
+	globalLister  repo.ListerGlobal
+	globalDeleter repo.DeleterGlobal
+	conv          EntityConverter
+}
+
+// NewRepository creates a new fetch validator repository
+func NewRepository(conv EntityConverter) *pgRepository {
+	return &pgRepository{
+		globalCreator: repo.NewCreatorGlobal(resource.FetchValidator, fetchValidatorTable, fetchValidatorColumns),
+		globalLister:  repo.NewListerGlobal(resource.FetchValidator, fetchValidatorTable, fetchValidatorColumns),
+		globalDeleter: repo.NewDeleterGlobal(resource.FetchValidator, fetchValidatorTable),
+		conv:          conv,
+	}
+}
+
+// Create persists the provided fetch validator
+func (r *pgRepository) Create(ctx context.Context, item *model.FetchValidator) error {
+	if item == nil {
+		return apperrors.NewInternalError("model can not be empty")
+	}
+
+	log.C(ctx).Debugf("Persisting fetch validator entity with id %s for URL %q", item.ID, item.URL)
+	return r.globalCreator.Create(ctx, r.conv.ToEntity(item))
+}
+
+// ListByWebhookIDAndResourceID lists the fetch validators stored while processing webhook with ID `webhookID` for the resource with ID `resourceID`
+func (r *pgRepository) ListByWebhookIDAndResourceID(ctx context.Context, webhookID, resourceID string) ([]*model.FetchValidator, error) {
+	var entities EntityCollection
+	if err := r.globalLister.ListGlobal(ctx, &entities, repo.NewEqualCondition(webhookIDColumn, webhookID), repo.NewEqualCondition(resourceIDColumn, resourceID)); err != nil {
+		return nil, err
+	}
+
+	items := make([]*model.FetchValidator, 0, len(entities))
+	for _, entity := range entities {
+		items = append(items, r.conv.FromEntity(&entity))
+	}
+
+	return items, nil
+}
+
+// DeleteByWebhookIDAndResourceID deletes the fetch validators stored while processing webhook with ID `webhookID` for the resource with ID `resourceID`
+func (r *pgRepository) DeleteByWebhookIDAndResourceID(ctx context.Context, webhookID, resourceID string) error {
+	return r.globalDeleter.DeleteManyGlobal(ctx, repo.Conditions{
+		repo.NewEqualCondition(webhookIDColumn, webhookID),
+		repo.NewEqualCondition(resourceIDColumn, resourceID),
+	})
+}
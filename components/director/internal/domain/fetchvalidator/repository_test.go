@@ -0,0 +1,99 @@
+package fetchvalidator_test
+
+import (
+	"database/sql/driver"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/fetchvalidator"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/fetchvalidator/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo/testdb"
+)
+
+func TestPgRepository_Create(t *testing.T) {
+	// GIVEN
+	var nilValidatorModel *model.FetchValidator
+	validatorModel := fixFetchValidatorModel()
+	validatorEntity := fixFetchValidatorEntity()
+
+	suite := testdb.RepoCreateTestSuite{
+		Name: "Create fetch validator",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:       `^INSERT INTO public.fetch_validators \(.+\) VALUES \(.+\)$`,
+				Args:        fixFetchValidatorCreateArgs(validatorEntity),
+				ValidResult: sqlmock.NewResult(-1, 1),
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		RepoConstructorFunc:       fetchvalidator.NewRepository,
+		ModelEntity:               validatorModel,
+		DBEntity:                  validatorEntity,
+		NilModelEntity:            nilValidatorModel,
+		DisableConverterErrorTest: true,
+		IsGlobal:                  true,
+	}
+
+	suite.Run(t)
+}
+
+func TestPgRepository_ListByWebhookIDAndResourceID(t *testing.T) {
+	validatorModel := fixFetchValidatorModel()
+	validatorEntity := fixFetchValidatorEntity()
+
+	suite := testdb.RepoListTestSuite{
+		Name:       "List fetch validators by webhook and resource",
+		MethodName: "ListByWebhookIDAndResourceID",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:    regexp.QuoteMeta(`SELECT id, webhook_id, resource_id, url, etag, last_modified, content FROM public.fetch_validators WHERE webhook_id = $1 AND resource_id = $2`),
+				Args:     []driver.Value{webhookID, resourceID},
+				IsSelect: true,
+				ValidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixColumns()).AddRow(validatorEntity.ID, validatorEntity.WebhookID, validatorEntity.ResourceID, validatorEntity.URL, validatorEntity.ETag, validatorEntity.LastModified, validatorEntity.Content)}
+				},
+				InvalidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixColumns())}
+				},
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		RepoConstructorFunc:       fetchvalidator.NewRepository,
+		ExpectedModelEntities:     []interface{}{validatorModel},
+		ExpectedDBEntities:        []interface{}{validatorEntity},
+		MethodArgs:                []interface{}{webhookID, resourceID},
+		DisableConverterErrorTest: true,
+	}
+
+	suite.Run(t)
+}
+
+func TestPgRepository_DeleteByWebhookIDAndResourceID(t *testing.T) {
+	suite := testdb.RepoDeleteTestSuite{
+		Name: "Delete fetch validators by webhook and resource",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:         regexp.QuoteMeta(`DELETE FROM public.fetch_validators WHERE webhook_id = $1 AND resource_id = $2`),
+				Args:          []driver.Value{webhookID, resourceID},
+				ValidResult:   sqlmock.NewResult(-1, 1),
+				InvalidResult: sqlmock.NewResult(-1, 2),
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		RepoConstructorFunc: fetchvalidator.NewRepository,
+		MethodName:          "DeleteByWebhookIDAndResourceID",
+		MethodArgs:          []interface{}{webhookID, resourceID},
+		IsDeleteMany:        true,
+		IsGlobal:            true,
+	}
+
+	suite.Run(t)
+}
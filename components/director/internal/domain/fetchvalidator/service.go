@@ -0,0 +1,69 @@
+package fetchvalidator
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/pkg/errors"
+)
+
+// FetchValidatorRepository is responsible for repository-layer fetch validator operations
+//
+//go:generate mockery --name=FetchValidatorRepository --output=automock --outpkg=automock --case=underscore --disable-version-string
+type FetchValidatorRepository interface {
+	Create(ctx context.Context, item *model.FetchValidator) error
+	ListByWebhookIDAndResourceID(ctx context.Context, webhookID, resourceID string) ([]*model.FetchValidator, error)
+	DeleteByWebhookIDAndResourceID(ctx context.Context, webhookID, resourceID string) error
+}
+
+// UIDService is responsible for service-layer uid operations
+//
+//go:generate mockery --name=UIDService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type UIDService interface {
+	Generate() string
+}
+
+type service struct {
+	repo       FetchValidatorRepository
+	uidService UIDService
+}
+
+// NewService creates a new fetch validator service
+func NewService(repo FetchValidatorRepository, uidService UIDService) *service {
+	return &service{
+		repo:       repo,
+		uidService: uidService,
+	}
+}
+
+// ListByWebhookIDAndResourceID lists the fetch validators stored while processing webhook with ID `webhookID` for the resource with ID `resourceID`
+func (s *service) ListByWebhookIDAndResourceID(ctx context.Context, webhookID, resourceID string) ([]*model.FetchValidator, error) {
+	validators, err := s.repo.ListByWebhookIDAndResourceID(ctx, webhookID, resourceID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while listing fetch validators for webhook with id %q and resource with id %q", webhookID, resourceID)
+	}
+
+	return validators, nil
+}
+
+// Replace replaces all fetch validators stored for webhook with ID `webhookID` and resource with ID `resourceID` with the provided ones
+func (s *service) Replace(ctx context.Context, webhookID, resourceID string, in []*model.FetchValidatorInput) error {
+	if err := s.repo.DeleteByWebhookIDAndResourceID(ctx, webhookID, resourceID); err != nil {
+		return errors.Wrapf(err, "while deleting fetch validators for webhook with id %q and resource with id %q", webhookID, resourceID)
+	}
+
+	for _, validator := range in {
+		if validator == nil {
+			continue
+		}
+
+		id := s.uidService.Generate()
+		if err := s.repo.Create(ctx, validator.ToFetchValidator(id, webhookID, resourceID)); err != nil {
+			return errors.Wrapf(err, "while creating fetch validator for URL %q", validator.URL)
+		}
+	}
+
+	log.C(ctx).Debugf("Successfully stored %d fetch validators for webhook with id %q and resource with id %q", len(in), webhookID, resourceID)
+	return nil
+}
@@ -0,0 +1,145 @@
+package fetchvalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/fetchvalidator"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/fetchvalidator/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var testErr = errors.New("test error")
+
+func TestService_ListByWebhookIDAndResourceID(t *testing.T) {
+	// GIVEN
+	ctx := context.TODO()
+	validators := []*model.FetchValidator{fixFetchValidatorModel()}
+
+	testCases := []struct {
+		Name               string
+		RepositoryFn       func() *automock.FetchValidatorRepository
+		ExpectedValidators []*model.FetchValidator
+		ExpectedErrMessage string
+	}{
+		{
+			Name: "Success",
+			RepositoryFn: func() *automock.FetchValidatorRepository {
+				repo := &automock.FetchValidatorRepository{}
+				repo.On("ListByWebhookIDAndResourceID", ctx, webhookID, resourceID).Return(validators, nil).Once()
+				return repo
+			},
+			ExpectedValidators: validators,
+		},
+		{
+			Name: "Error when listing fails",
+			RepositoryFn: func() *automock.FetchValidatorRepository {
+				repo := &automock.FetchValidatorRepository{}
+				repo.On("ListByWebhookIDAndResourceID", ctx, webhookID, resourceID).Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedErrMessage: testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepositoryFn()
+			svc := fetchvalidator.NewService(repo, nil)
+
+			// WHEN
+			result, err := svc.ListByWebhookIDAndResourceID(ctx, webhookID, resourceID)
+
+			// THEN
+			if testCase.ExpectedErrMessage != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, testCase.ExpectedValidators, result)
+
+			mock.AssertExpectationsForObjects(t, repo)
+		})
+	}
+}
+
+func TestService_Replace(t *testing.T) {
+	// GIVEN
+	ctx := context.TODO()
+	input := []*model.FetchValidatorInput{fixFetchValidatorInput(), nil}
+
+	testCases := []struct {
+		Name               string
+		RepositoryFn       func() *automock.FetchValidatorRepository
+		UIDServiceFn       func() *automock.UIDService
+		ExpectedErrMessage string
+	}{
+		{
+			Name: "Success",
+			RepositoryFn: func() *automock.FetchValidatorRepository {
+				repo := &automock.FetchValidatorRepository{}
+				repo.On("DeleteByWebhookIDAndResourceID", ctx, webhookID, resourceID).Return(nil).Once()
+				repo.On("Create", ctx, fixFetchValidatorModel()).Return(nil).Once()
+				return repo
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				uidSvc := &automock.UIDService{}
+				uidSvc.On("Generate").Return(validatorID).Once()
+				return uidSvc
+			},
+		},
+		{
+			Name: "Error when deleting the stored validators fails",
+			RepositoryFn: func() *automock.FetchValidatorRepository {
+				repo := &automock.FetchValidatorRepository{}
+				repo.On("DeleteByWebhookIDAndResourceID", ctx, webhookID, resourceID).Return(testErr).Once()
+				return repo
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				return &automock.UIDService{}
+			},
+			ExpectedErrMessage: testErr.Error(),
+		},
+		{
+			Name: "Error when creating validator fails",
+			RepositoryFn: func() *automock.FetchValidatorRepository {
+				repo := &automock.FetchValidatorRepository{}
+				repo.On("DeleteByWebhookIDAndResourceID", ctx, webhookID, resourceID).Return(nil).Once()
+				repo.On("Create", ctx, fixFetchValidatorModel()).Return(testErr).Once()
+				return repo
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				uidSvc := &automock.UIDService{}
+				uidSvc.On("Generate").Return(validatorID).Once()
+				return uidSvc
+			},
+			ExpectedErrMessage: testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepositoryFn()
+			uidSvc := testCase.UIDServiceFn()
+			svc := fetchvalidator.NewService(repo, uidSvc)
+
+			// WHEN
+			err := svc.Replace(ctx, webhookID, resourceID, input)
+
+			// THEN
+			if testCase.ExpectedErrMessage != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			} else {
+				require.NoError(t, err)
+			}
+
+			mock.AssertExpectationsForObjects(t, repo, uidSvc)
+		})
+	}
+}
@@ -9,12 +9,15 @@ import (
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/formation"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/formation/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/formationassignment"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/formationconstraint"
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 	"github.com/kyma-incubator/compass/components/director/pkg/resource"
 	"github.com/kyma-incubator/compass/components/director/pkg/str"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -76,6 +79,7 @@ func TestServiceAssignFormation(t *testing.T) {
 		State:               model.ReadyFormationState,
 	}
 	formationInInitialState := fixFormationModelWithState(model.InitialFormationState)
+	formationInDraftState := fixFormationModelWithState(model.DraftFormationState)
 	formationInDeletingState := fixFormationModelWithState(model.DeletingFormationState)
 
 	applicationLblNoFormations := &model.Label{
@@ -1755,6 +1759,59 @@ func TestServiceAssignFormation(t *testing.T) {
 			InputFormation:    inputFormation,
 			ExpectedFormation: formationInInitialState,
 		},
+		{
+			Name: "success for application when formation is in draft state without generating assignments",
+			UIDServiceFn: func() *automock.UuidService {
+				uidService := &automock.UuidService{}
+				uidService.On("Generate").Return(fixUUID())
+				return uidService
+			},
+			LabelServiceFn: func() *automock.LabelService {
+				labelService := &automock.LabelService{}
+				labelService.On("GetLabel", ctx, TntInternalID, &applicationTypeLblInput).Return(applicationTypeLbl, nil)
+				labelService.On("GetLabel", ctx, TntInternalID, &applicationLblInput).Return(nil, apperrors.NewNotFoundError(resource.Label, ""))
+				labelService.On("CreateLabel", ctx, TntInternalID, fixUUID(), &applicationLblInput).Return(nil)
+				return labelService
+			},
+			FormationRepositoryFn: func() *automock.FormationRepository {
+				formationRepo := &automock.FormationRepository{}
+				formationRepo.On("GetByName", ctx, testFormationName, TntInternalID).Return(formationInDraftState, nil).Once()
+				formationRepo.On("GetForUpdate", ctx, formationInDraftState.ID, TntInternalID).Return(formationInDraftState, nil).Once()
+				return formationRepo
+			},
+			FormationTemplateRepositoryFn: func() *automock.FormationTemplateRepository {
+				repo := &automock.FormationTemplateRepository{}
+				repo.On("Get", ctx, FormationTemplateID).Return(expectedFormationTemplate, nil).Once()
+				return repo
+			},
+			ConstraintEngineFn: func() *automock.ConstraintEngine {
+				engine := &automock.ConstraintEngine{}
+				engine.On("EnforceConstraints", ctx, preAssignLocation, fixAssignAppDetails(testFormationName), FormationTemplateID).Return(nil).Once()
+				return engine
+			},
+			ObjectType:        graphql.FormationObjectTypeApplication,
+			ObjectID:          ApplicationID,
+			InputFormation:    inputFormation,
+			ExpectedFormation: formationInDraftState,
+		},
+		{
+			Name: "error for application when locking formation in draft state fails",
+			FormationRepositoryFn: func() *automock.FormationRepository {
+				formationRepo := &automock.FormationRepository{}
+				formationRepo.On("GetByName", ctx, testFormationName, TntInternalID).Return(formationInDraftState, nil).Once()
+				formationRepo.On("GetForUpdate", ctx, formationInDraftState.ID, TntInternalID).Return(nil, testErr).Once()
+				return formationRepo
+			},
+			FormationTemplateRepositoryFn: func() *automock.FormationTemplateRepository {
+				repo := &automock.FormationTemplateRepository{}
+				repo.On("Get", ctx, FormationTemplateID).Return(expectedFormationTemplate, nil).Once()
+				return repo
+			},
+			ObjectType:         graphql.FormationObjectTypeApplication,
+			ObjectID:           ApplicationID,
+			InputFormation:     inputFormation,
+			ExpectedErrMessage: testErr.Error(),
+		},
 		{
 			Name: "error for application when formation is in deleting state",
 			LabelServiceFn: func() *automock.LabelService {
@@ -2717,3 +2774,369 @@ func TestServiceAssignFormation(t *testing.T) {
 		})
 	}
 }
+
+func TestServiceAssignFormationBulk(t *testing.T) {
+	ctx := context.TODO()
+	ctx = tenant.SaveToContext(ctx, TntInternalID, TntExternalID)
+
+	inputFormation := model.Formation{
+		Name: testFormationName,
+	}
+	formationInReadyState := fixFormationModelWithState(model.ReadyFormationState)
+	formationInDraftState := fixFormationModelWithState(model.DraftFormationState)
+	formationInDeletingState := fixFormationModelWithState(model.DeletingFormationState)
+	formationTemplate := &model.FormationTemplate{
+		ID:               FormationTemplateID,
+		Name:             testFormationTemplateName,
+		RuntimeTypes:     []string{},
+		ApplicationTypes: []string{applicationType},
+	}
+
+	notifications := []*webhookclient.FormationAssignmentNotificationRequest{{
+		Webhook: graphql.Webhook{
+			ID: "wid1",
+		},
+	}}
+	formationAssignments := []*model.FormationAssignment{{
+		ID: "faid1",
+	}}
+
+	applicationObject := formation.FormationObject{ID: ApplicationID, Type: graphql.FormationObjectTypeApplication}
+	runtimeObject := formation.FormationObject{ID: RuntimeID, Type: graphql.FormationObjectTypeRuntime}
+
+	applicationLblInput := &model.LabelInput{
+		Key:        model.ScenariosKey,
+		Value:      []string{testFormationName},
+		ObjectID:   ApplicationID,
+		ObjectType: model.ApplicationLabelableObject,
+	}
+	applicationTypeLblInput := &model.LabelInput{
+		Key:        applicationType,
+		ObjectID:   ApplicationID,
+		ObjectType: model.ApplicationLabelableObject,
+	}
+	applicationTypeLbl := &model.Label{
+		ID:         "123",
+		Key:        applicationType,
+		Value:      applicationType,
+		Tenant:     str.Ptr(TntInternalID),
+		ObjectID:   ApplicationID,
+		ObjectType: model.ApplicationLabelableObject,
+	}
+
+	testCases := []struct {
+		Name                          string
+		Objects                       []formation.FormationObject
+		UIDServiceFn                  func() *automock.UuidService
+		ApplicationRepoFn             func() *automock.ApplicationRepository
+		LabelServiceFn                func() *automock.LabelService
+		RuntimeContextRepoFn          func() *automock.RuntimeContextRepository
+		FormationRepositoryFn         func() *automock.FormationRepository
+		FormationTemplateRepositoryFn func() *automock.FormationTemplateRepository
+		NotificationServiceFn         func() *automock.NotificationsService
+		FormationAssignmentServiceFn  func() *automock.FormationAssignmentService
+		ConstraintEngineFn            func() *automock.ConstraintEngine
+		ExpectedResults               []*formation.FormationObjectAssignmentResult
+		ExpectedErrMessage            string
+	}{
+		{
+			Name:    "success for application",
+			Objects: []formation.FormationObject{applicationObject},
+			UIDServiceFn: func() *automock.UuidService {
+				uidService := &automock.UuidService{}
+				uidService.On("Generate").Return(fixUUID())
+				return uidService
+			},
+			ApplicationRepoFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("ListAllByIDs", ctx, TntInternalID, []string{}).Return([]*model.Application{}, nil).Once()
+				return repo
+			},
+			LabelServiceFn: func() *automock.LabelService {
+				labelService := &automock.LabelService{}
+				labelService.On("GetLabel", ctx, TntInternalID, applicationTypeLblInput).Return(applicationTypeLbl, nil)
+				labelService.On("GetLabel", ctx, TntInternalID, applicationLblInput).Return(nil, apperrors.NewNotFoundError(resource.Label, ""))
+				labelService.On("CreateLabel", ctx, TntInternalID, fixUUID(), applicationLblInput).Return(nil)
+				return labelService
+			},
+			RuntimeContextRepoFn: expectEmptySliceRuntimeContextRepo,
+			FormationRepositoryFn: func() *automock.FormationRepository {
+				formationRepo := &automock.FormationRepository{}
+				formationRepo.On("GetByName", ctx, testFormationName, TntInternalID).Return(formationInReadyState, nil).Once()
+				return formationRepo
+			},
+			FormationTemplateRepositoryFn: func() *automock.FormationTemplateRepository {
+				repo := &automock.FormationTemplateRepository{}
+				repo.On("Get", ctx, FormationTemplateID).Return(formationTemplate, nil).Once()
+				return repo
+			},
+			NotificationServiceFn: func() *automock.NotificationsService {
+				notificationSvc := &automock.NotificationsService{}
+				notificationSvc.On("GenerateFormationAssignmentNotifications", ctx, TntInternalID, ApplicationID, formationInReadyState, model.AssignFormation, graphql.FormationObjectTypeApplication).Return(notifications, nil).Once()
+				return notificationSvc
+			},
+			FormationAssignmentServiceFn: func() *automock.FormationAssignmentService {
+				formationAssignmentSvc := &automock.FormationAssignmentService{}
+				formationAssignmentSvc.On("GenerateAssignmentsForObjects", ctx, TntInternalID, []formationassignment.FormationObject{{ID: ApplicationID, Type: graphql.FormationObjectTypeApplication}}, formationInReadyState).Return([][]*model.FormationAssignment{formationAssignments}, nil).Once()
+				formationAssignmentSvc.On("ProcessFormationAssignments", ctx, formationAssignments, map[string]string{}, map[string]string{}, notifications, mock.Anything, model.AssignFormation).Return(nil).Once()
+				return formationAssignmentSvc
+			},
+			ConstraintEngineFn: func() *automock.ConstraintEngine {
+				engine := &automock.ConstraintEngine{}
+				engine.On("EnforceConstraints", ctx, preAssignLocation, fixAssignAppDetails(testFormationName), FormationTemplateID).Return(nil).Once()
+				engine.On("EnforceConstraints", ctx, postAssignLocation, fixAssignAppDetails(testFormationName), FormationTemplateID).Return(nil).Once()
+				return engine
+			},
+			ExpectedResults: []*formation.FormationObjectAssignmentResult{{FormationObject: applicationObject}},
+		},
+		{
+			Name:    "success for application when formation is in draft state without enforcing post-assign constraints",
+			Objects: []formation.FormationObject{applicationObject},
+			UIDServiceFn: func() *automock.UuidService {
+				uidService := &automock.UuidService{}
+				uidService.On("Generate").Return(fixUUID())
+				return uidService
+			},
+			LabelServiceFn: func() *automock.LabelService {
+				labelService := &automock.LabelService{}
+				labelService.On("GetLabel", ctx, TntInternalID, applicationTypeLblInput).Return(applicationTypeLbl, nil)
+				labelService.On("GetLabel", ctx, TntInternalID, applicationLblInput).Return(nil, apperrors.NewNotFoundError(resource.Label, ""))
+				labelService.On("CreateLabel", ctx, TntInternalID, fixUUID(), applicationLblInput).Return(nil)
+				return labelService
+			},
+			FormationRepositoryFn: func() *automock.FormationRepository {
+				formationRepo := &automock.FormationRepository{}
+				formationRepo.On("GetByName", ctx, testFormationName, TntInternalID).Return(formationInDraftState, nil).Once()
+				formationRepo.On("GetForUpdate", ctx, formationInDraftState.ID, TntInternalID).Return(formationInDraftState, nil).Once()
+				return formationRepo
+			},
+			FormationTemplateRepositoryFn: func() *automock.FormationTemplateRepository {
+				repo := &automock.FormationTemplateRepository{}
+				repo.On("Get", ctx, FormationTemplateID).Return(formationTemplate, nil).Once()
+				return repo
+			},
+			ConstraintEngineFn: func() *automock.ConstraintEngine {
+				engine := &automock.ConstraintEngine{}
+				engine.On("EnforceConstraints", ctx, preAssignLocation, fixAssignAppDetails(testFormationName), FormationTemplateID).Return(nil).Once()
+				return engine
+			},
+			ExpectedResults: []*formation.FormationObjectAssignmentResult{{FormationObject: applicationObject}},
+		},
+		{
+			Name:    "does not assign any object when some of the objects can not be assigned",
+			Objects: []formation.FormationObject{applicationObject, runtimeObject},
+			LabelServiceFn: func() *automock.LabelService {
+				labelService := &automock.LabelService{}
+				labelService.On("GetLabel", ctx, TntInternalID, applicationTypeLblInput).Return(applicationTypeLbl, nil)
+				return labelService
+			},
+			FormationRepositoryFn: func() *automock.FormationRepository {
+				formationRepo := &automock.FormationRepository{}
+				formationRepo.On("GetByName", ctx, testFormationName, TntInternalID).Return(formationInReadyState, nil).Once()
+				return formationRepo
+			},
+			FormationTemplateRepositoryFn: func() *automock.FormationTemplateRepository {
+				repo := &automock.FormationTemplateRepository{}
+				repo.On("Get", ctx, FormationTemplateID).Return(formationTemplate, nil).Once()
+				return repo
+			},
+			ConstraintEngineFn: func() *automock.ConstraintEngine {
+				engine := &automock.ConstraintEngine{}
+				engine.On("EnforceConstraints", ctx, preAssignLocation, fixAssignAppDetails(testFormationName), FormationTemplateID).Return(testErr).Once()
+				return engine
+			},
+			ExpectedResults: []*formation.FormationObjectAssignmentResult{
+				{FormationObject: applicationObject, Error: testErr},
+				{FormationObject: runtimeObject, Error: fmt.Errorf("does not support resources of type %q", graphql.FormationObjectTypeRuntime)},
+			},
+		},
+		{
+			Name:               "error when the same object is provided more than once",
+			Objects:            []formation.FormationObject{applicationObject, runtimeObject, applicationObject},
+			ExpectedErrMessage: fmt.Sprintf("%s with ID %q is provided more than once", graphql.FormationObjectTypeApplication, ApplicationID),
+		},
+		{
+			Name:    "error when formation is in deleting state",
+			Objects: []formation.FormationObject{applicationObject},
+			FormationRepositoryFn: func() *automock.FormationRepository {
+				formationRepo := &automock.FormationRepository{}
+				formationRepo.On("GetByName", ctx, testFormationName, TntInternalID).Return(formationInDeletingState, nil).Once()
+				return formationRepo
+			},
+			FormationTemplateRepositoryFn: func() *automock.FormationTemplateRepository {
+				repo := &automock.FormationTemplateRepository{}
+				repo.On("Get", ctx, FormationTemplateID).Return(formationTemplate, nil).Once()
+				return repo
+			},
+			ExpectedErrMessage: "cannot assign to formation with ID",
+		},
+		{
+			Name:    "error when getting formation fails",
+			Objects: []formation.FormationObject{applicationObject},
+			FormationRepositoryFn: func() *automock.FormationRepository {
+				formationRepo := &automock.FormationRepository{}
+				formationRepo.On("GetByName", ctx, testFormationName, TntInternalID).Return(nil, testErr).Once()
+				return formationRepo
+			},
+			ExpectedErrMessage: testErr.Error(),
+		},
+		{
+			Name:    "error when preparing join point details fails",
+			Objects: []formation.FormationObject{applicationObject},
+			LabelServiceFn: func() *automock.LabelService {
+				labelService := &automock.LabelService{}
+				labelService.On("GetLabel", ctx, TntInternalID, applicationTypeLblInput).Return(nil, testErr)
+				return labelService
+			},
+			FormationRepositoryFn: func() *automock.FormationRepository {
+				formationRepo := &automock.FormationRepository{}
+				formationRepo.On("GetByName", ctx, testFormationName, TntInternalID).Return(formationInReadyState, nil).Once()
+				return formationRepo
+			},
+			FormationTemplateRepositoryFn: func() *automock.FormationTemplateRepository {
+				repo := &automock.FormationTemplateRepository{}
+				repo.On("Get", ctx, FormationTemplateID).Return(formationTemplate, nil).Once()
+				return repo
+			},
+			ExpectedErrMessage: testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			uidService := unusedUUIDService()
+			if testCase.UIDServiceFn != nil {
+				uidService = testCase.UIDServiceFn()
+			}
+			applicationRepository := unusedApplicationRepository()
+			if testCase.ApplicationRepoFn != nil {
+				applicationRepository = testCase.ApplicationRepoFn()
+			}
+			labelService := unusedLabelService()
+			if testCase.LabelServiceFn != nil {
+				labelService = testCase.LabelServiceFn()
+			}
+			runtimeContextRepo := unusedRuntimeContextRepo()
+			if testCase.RuntimeContextRepoFn != nil {
+				runtimeContextRepo = testCase.RuntimeContextRepoFn()
+			}
+			formationRepo := unusedFormationRepo()
+			if testCase.FormationRepositoryFn != nil {
+				formationRepo = testCase.FormationRepositoryFn()
+			}
+			formationTemplateRepo := unusedFormationTemplateRepo()
+			if testCase.FormationTemplateRepositoryFn != nil {
+				formationTemplateRepo = testCase.FormationTemplateRepositoryFn()
+			}
+			notificationSvc := unusedNotificationsService()
+			if testCase.NotificationServiceFn != nil {
+				notificationSvc = testCase.NotificationServiceFn()
+			}
+			formationAssignmentSvc := unusedFormationAssignmentService()
+			if testCase.FormationAssignmentServiceFn != nil {
+				formationAssignmentSvc = testCase.FormationAssignmentServiceFn()
+			}
+			constraintEngine := unusedConstraintEngine()
+			if testCase.ConstraintEngineFn != nil {
+				constraintEngine = testCase.ConstraintEngineFn()
+			}
+
+			svc := formation.NewServiceWithAsaEngine(nil, applicationRepository, nil, nil, formationRepo, formationTemplateRepo, labelService, uidService, nil, nil, nil, nil, nil, runtimeContextRepo, formationAssignmentSvc, nil, nil, notificationSvc, constraintEngine, runtimeType, applicationType, nil, nil)
+
+			// WHEN
+			actual, err := svc.AssignFormationBulk(ctx, TntInternalID, testCase.Objects, inputFormation)
+
+			// THEN
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+				require.Len(t, actual, len(testCase.ExpectedResults))
+				for i, expected := range testCase.ExpectedResults {
+					assert.Equal(t, expected.FormationObject, actual[i].FormationObject)
+					if expected.Error == nil {
+						assert.NoError(t, actual[i].Error)
+					} else {
+						require.Error(t, actual[i].Error)
+						assert.Contains(t, actual[i].Error.Error(), expected.Error.Error())
+					}
+				}
+			} else {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+				require.Nil(t, actual)
+			}
+
+			mock.AssertExpectationsForObjects(t, uidService, applicationRepository, labelService, runtimeContextRepo, formationRepo, formationTemplateRepo, notificationSvc, formationAssignmentSvc, constraintEngine)
+		})
+	}
+}
+
+func TestServiceAssignFormationBulk_EnforcesPreAssignConstraintsAfterEachObjectOfTheBatch(t *testing.T) {
+	// GIVEN
+	ctx := context.TODO()
+	ctx = tenant.SaveToContext(ctx, TntInternalID, TntExternalID)
+
+	// The batch contains one application more than the constraint allows
+	const maxApplications = 2
+	applicationIDs := []string{"app-id-1", "app-id-2", "app-id-3"}
+	formationTemplate := &model.FormationTemplate{
+		ID:               FormationTemplateID,
+		Name:             testFormationTemplateName,
+		RuntimeTypes:     []string{},
+		ApplicationTypes: []string{applicationType},
+	}
+
+	objects := make([]formation.FormationObject, 0, len(applicationIDs))
+	for _, id := range applicationIDs {
+		objects = append(objects, formation.FormationObject{ID: id, Type: graphql.FormationObjectTypeApplication})
+	}
+
+	// assignedApplications simulates the applications in the formation as seen by a constraint
+	// which allows at most `maxApplications` applications in the formation
+	assignedApplications := 0
+
+	labelService := &automock.LabelService{}
+	labelService.On("GetLabel", ctx, TntInternalID, mock.MatchedBy(func(input *model.LabelInput) bool {
+		return input.Key == applicationType
+	})).Return(&model.Label{Key: applicationType, Value: applicationType}, nil)
+	labelService.On("GetLabel", ctx, TntInternalID, mock.MatchedBy(func(input *model.LabelInput) bool {
+		return input.Key == model.ScenariosKey
+	})).Return(nil, apperrors.NewNotFoundError(resource.Label, ""))
+	labelService.On("CreateLabel", ctx, TntInternalID, fixUUID(), mock.AnythingOfType("*model.LabelInput")).Run(func(args mock.Arguments) {
+		assignedApplications++
+	}).Return(nil).Times(maxApplications)
+
+	uidService := &automock.UuidService{}
+	uidService.On("Generate").Return(fixUUID())
+
+	formationRepo := &automock.FormationRepository{}
+	formationRepo.On("GetByName", ctx, testFormationName, TntInternalID).Return(fixFormationModelWithState(model.ReadyFormationState), nil).Once()
+
+	formationTemplateRepo := &automock.FormationTemplateRepository{}
+	formationTemplateRepo.On("Get", ctx, FormationTemplateID).Return(formationTemplate, nil).Once()
+
+	constraintEngine := &automock.ConstraintEngine{}
+	constraintEngine.On("EnforceConstraints", ctx, preAssignLocation, mock.Anything, FormationTemplateID).Return(func(context.Context, formationconstraint.JoinPointLocation, formationconstraint.JoinPointDetails, string) error {
+		if assignedApplications >= maxApplications {
+			return errors.Errorf("formation can contain at most %d applications", maxApplications)
+		}
+		return nil
+	}).Times(len(applicationIDs))
+
+	svc := formation.NewServiceWithAsaEngine(nil, nil, nil, nil, formationRepo, formationTemplateRepo, labelService, uidService, nil, nil, nil, nil, nil, nil, unusedFormationAssignmentService(), nil, nil, unusedNotificationsService(), constraintEngine, runtimeType, applicationType, nil, nil)
+
+	// WHEN
+	actual, err := svc.AssignFormationBulk(ctx, TntInternalID, objects, model.Formation{Name: testFormationName})
+
+	// THEN
+	require.NoError(t, err)
+	require.Len(t, actual, maxApplications+1)
+	for i := 0; i < maxApplications; i++ {
+		require.Error(t, actual[i].Error)
+		assert.Contains(t, actual[i].Error.Error(), fmt.Sprintf("not assigned: batch rolled back because of %s with ID %q", graphql.FormationObjectTypeApplication, applicationIDs[maxApplications]))
+	}
+	require.Error(t, actual[maxApplications].Error)
+	assert.Contains(t, actual[maxApplications].Error.Error(), fmt.Sprintf("at most %d applications", maxApplications))
+
+	mock.AssertExpectationsForObjects(t, labelService, uidService, formationRepo, formationTemplateRepo, constraintEngine)
+}
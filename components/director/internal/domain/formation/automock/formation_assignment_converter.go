@@ -60,6 +60,22 @@ func (_m *FormationAssignmentConverter) ToGraphQL(in *model.FormationAssignment)
 	return r0, r1
 }
 
+// TransitionsToGraphQL provides a mock function with given fields: in
+func (_m *FormationAssignmentConverter) TransitionsToGraphQL(in []*model.FormationAssignmentTransition) []*graphql.FormationAssignmentTransition {
+	ret := _m.Called(in)
+
+	var r0 []*graphql.FormationAssignmentTransition
+	if rf, ok := ret.Get(0).(func([]*model.FormationAssignmentTransition) []*graphql.FormationAssignmentTransition); ok {
+		r0 = rf(in)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*graphql.FormationAssignmentTransition)
+		}
+	}
+
+	return r0
+}
+
 type mockConstructorTestingTNewFormationAssignmentConverter interface {
 	mock.TestingT
 	Cleanup(func())
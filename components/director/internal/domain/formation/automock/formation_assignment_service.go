@@ -93,6 +93,29 @@ func (_m *FormationAssignmentService) GenerateAssignments(ctx context.Context, t
 	return r0, r1
 }
 
+// GenerateAssignmentsForObjects provides a mock function with given fields: ctx, tnt, objects, _a3
+func (_m *FormationAssignmentService) GenerateAssignmentsForObjects(ctx context.Context, tnt string, objects []formationassignment.FormationObject, _a3 *model.Formation) ([][]*model.FormationAssignment, error) {
+	ret := _m.Called(ctx, tnt, objects, _a3)
+
+	var r0 [][]*model.FormationAssignment
+	if rf, ok := ret.Get(0).(func(context.Context, string, []formationassignment.FormationObject, *model.Formation) [][]*model.FormationAssignment); ok {
+		r0 = rf(ctx, tnt, objects, _a3)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([][]*model.FormationAssignment)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, []formationassignment.FormationObject, *model.Formation) error); ok {
+		r1 = rf(ctx, tnt, objects, _a3)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetAssignmentsForFormation provides a mock function with given fields: ctx, tenantID, formationID
 func (_m *FormationAssignmentService) GetAssignmentsForFormation(ctx context.Context, tenantID string, formationID string) ([]*model.FormationAssignment, error) {
 	ret := _m.Called(ctx, tenantID, formationID)
@@ -254,6 +277,29 @@ func (_m *FormationAssignmentService) ListFormationAssignmentsForObjectID(ctx co
 	return r0, r1
 }
 
+// ListTransitions provides a mock function with given fields: ctx, id
+func (_m *FormationAssignmentService) ListTransitions(ctx context.Context, id string) ([]*model.FormationAssignmentTransition, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 []*model.FormationAssignmentTransition
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*model.FormationAssignmentTransition); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.FormationAssignmentTransition)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ProcessFormationAssignmentPair provides a mock function with given fields: ctx, mappingPair
 func (_m *FormationAssignmentService) ProcessFormationAssignmentPair(ctx context.Context, mappingPair *formationassignment.AssignmentMappingPairWithOperation) (bool, error) {
 	ret := _m.Called(ctx, mappingPair)
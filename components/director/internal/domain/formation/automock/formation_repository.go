@@ -89,6 +89,29 @@ func (_m *FormationRepository) GetByName(ctx context.Context, name string, tenan
 	return r0, r1
 }
 
+// GetForUpdate provides a mock function with given fields: ctx, id, tenantID
+func (_m *FormationRepository) GetForUpdate(ctx context.Context, id string, tenantID string) (*model.Formation, error) {
+	ret := _m.Called(ctx, id, tenantID)
+
+	var r0 *model.Formation
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *model.Formation); ok {
+		r0 = rf(ctx, id, tenantID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Formation)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, id, tenantID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetGlobalByID provides a mock function with given fields: ctx, id
 func (_m *FormationRepository) GetGlobalByID(ctx context.Context, id string) (*model.Formation, error) {
 	ret := _m.Called(ctx, id)
@@ -5,6 +5,7 @@ package automock
 import (
 	context "context"
 
+	formation "github.com/kyma-incubator/compass/components/director/internal/domain/formation"
 	graphql "github.com/kyma-incubator/compass/components/director/pkg/graphql"
 
 	mock "github.com/stretchr/testify/mock"
@@ -40,6 +41,29 @@ func (_m *Service) AssignFormation(ctx context.Context, tnt string, objectID str
 	return r0, r1
 }
 
+// AssignFormationBulk provides a mock function with given fields: ctx, tnt, objects, _a3
+func (_m *Service) AssignFormationBulk(ctx context.Context, tnt string, objects []formation.FormationObject, _a3 model.Formation) ([]*formation.FormationObjectAssignmentResult, error) {
+	ret := _m.Called(ctx, tnt, objects, _a3)
+
+	var r0 []*formation.FormationObjectAssignmentResult
+	if rf, ok := ret.Get(0).(func(context.Context, string, []formation.FormationObject, model.Formation) []*formation.FormationObjectAssignmentResult); ok {
+		r0 = rf(ctx, tnt, objects, _a3)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*formation.FormationObjectAssignmentResult)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, []formation.FormationObject, model.Formation) error); ok {
+		r1 = rf(ctx, tnt, objects, _a3)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateFormation provides a mock function with given fields: ctx, tnt, _a2, templateName
 func (_m *Service) CreateFormation(ctx context.Context, tnt string, _a2 model.Formation, templateName string) (*model.Formation, error) {
 	ret := _m.Called(ctx, tnt, _a2, templateName)
@@ -86,6 +110,29 @@ func (_m *Service) DeleteFormation(ctx context.Context, tnt string, _a2 model.Fo
 	return r0, r1
 }
 
+// FinalizeFormation provides a mock function with given fields: ctx, formationID
+func (_m *Service) FinalizeFormation(ctx context.Context, formationID string) (*model.Formation, error) {
+	ret := _m.Called(ctx, formationID)
+
+	var r0 *model.Formation
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.Formation); ok {
+		r0 = rf(ctx, formationID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Formation)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, formationID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Get provides a mock function with given fields: ctx, id
 func (_m *Service) Get(ctx context.Context, id string) (*model.Formation, error) {
 	ret := _m.Called(ctx, id)
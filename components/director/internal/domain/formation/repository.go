@@ -81,6 +81,17 @@ func (r *repository) Get(ctx context.Context, id, tenantID string) (*model.Forma
 	return r.conv.FromEntity(&entity), nil
 }
 
+// GetForUpdate returns a Formation by a given id and locks it until the transaction is finished
+func (r *repository) GetForUpdate(ctx context.Context, id, tenantID string) (*model.Formation, error) {
+	var entity Entity
+	if err := r.getter.GetForUpdate(ctx, resource.Formations, tenantID, repo.Conditions{repo.NewEqualCondition("id", id)}, repo.NoOrderBy, &entity); err != nil {
+		log.C(ctx).Errorf("An error occurred while getting formation with id: %q for update", id)
+		return nil, errors.Wrapf(err, "An error occurred while getting formation with id: %q for update", id)
+	}
+
+	return r.conv.FromEntity(&entity), nil
+}
+
 // GetGlobalByID retrieves formation matching ID `id` globally without tenant parameter
 func (r *repository) GetGlobalByID(ctx context.Context, id string) (*model.Formation, error) {
 	log.C(ctx).Debugf("Getting formation with ID: %q globally", id)
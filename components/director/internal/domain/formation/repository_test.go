@@ -74,6 +74,36 @@ func TestRepository_Get(t *testing.T) {
 	suite.Run(t)
 }
 
+func TestRepository_GetForUpdate(t *testing.T) {
+	suite := testdb.RepoGetTestSuite{
+		Name:       "Get Formation by ID for update",
+		MethodName: "GetForUpdate",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:    regexp.QuoteMeta(`SELECT id, tenant_id, formation_template_id, name, state, error FROM public.formations WHERE tenant_id = $1 AND id = $2 FOR UPDATE`),
+				Args:     []driver.Value{TntInternalID, FormationID},
+				IsSelect: true,
+				ValidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixColumns()).AddRow(FormationID, TntInternalID, FormationTemplateID, testFormationName, testFormationState, testFormationEmptyError)}
+				},
+				InvalidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixColumns())}
+				},
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		RepoConstructorFunc:       formation.NewRepository,
+		ExpectedModelEntity:       formationModel,
+		ExpectedDBEntity:          formationEntity,
+		MethodArgs:                []interface{}{FormationID, TntInternalID},
+		DisableConverterErrorTest: true,
+	}
+
+	suite.Run(t)
+}
+
 func TestRepository_GetGlobalByID(t *testing.T) {
 	suite := testdb.RepoGetTestSuite{
 		Name:       "Get Formation Globally by ID",
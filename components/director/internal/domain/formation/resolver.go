@@ -17,6 +17,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
 	"github.com/pkg/errors"
 )
 
@@ -30,8 +31,10 @@ type Service interface {
 	CreateFormation(ctx context.Context, tnt string, formation model.Formation, templateName string) (*model.Formation, error)
 	DeleteFormation(ctx context.Context, tnt string, formation model.Formation) (*model.Formation, error)
 	AssignFormation(ctx context.Context, tnt, objectID string, objectType graphql.FormationObjectType, formation model.Formation) (*model.Formation, error)
+	AssignFormationBulk(ctx context.Context, tnt string, objects []FormationObject, formation model.Formation) ([]*FormationObjectAssignmentResult, error)
 	UnassignFormation(ctx context.Context, tnt, objectID string, objectType graphql.FormationObjectType, formation model.Formation) (*model.Formation, error)
 	ResynchronizeFormationNotifications(ctx context.Context, formationID string, reset bool) (*model.Formation, error)
+	FinalizeFormation(ctx context.Context, formationID string) (*model.Formation, error)
 }
 
 // Converter missing godoc
@@ -54,11 +57,13 @@ type formationAssignmentService interface {
 	ProcessFormationAssignments(ctx context.Context, formationAssignmentsForObject []*model.FormationAssignment, runtimeContextIDToRuntimeIDMapping map[string]string, applicationIDToApplicationTemplateIDMapping map[string]string, requests []*webhookclient.FormationAssignmentNotificationRequest, operation func(context.Context, *formationassignment.AssignmentMappingPairWithOperation) (bool, error), formationOperation model.FormationOperation) error
 	ProcessFormationAssignmentPair(ctx context.Context, mappingPair *formationassignment.AssignmentMappingPairWithOperation) (bool, error)
 	GenerateAssignments(ctx context.Context, tnt, objectID string, objectType graphql.FormationObjectType, formation *model.Formation) ([]*model.FormationAssignment, error)
+	GenerateAssignmentsForObjects(ctx context.Context, tnt string, objects []formationassignment.FormationObject, formation *model.Formation) ([][]*model.FormationAssignment, error)
 	CleanupFormationAssignment(ctx context.Context, mappingPair *formationassignment.AssignmentMappingPairWithOperation) (bool, error)
 	GetAssignmentsForFormation(ctx context.Context, tenantID, formationID string) ([]*model.FormationAssignment, error)
 	Update(ctx context.Context, id string, fa *model.FormationAssignment) error
 	GetAssignmentsForFormationWithStates(ctx context.Context, tenantID, formationID string, states []string) ([]*model.FormationAssignment, error)
 	GetReverseBySourceAndTarget(ctx context.Context, formationID, sourceID, targetID string) (*model.FormationAssignment, error)
+	ListTransitions(ctx context.Context, id string) ([]*model.FormationAssignmentTransition, error)
 }
 
 // FormationAssignmentConverter converts FormationAssignment between the model.FormationAssignment service-layer representation and graphql.FormationAssignment.
@@ -67,6 +72,7 @@ type formationAssignmentService interface {
 type FormationAssignmentConverter interface {
 	MultipleToGraphQL(in []*model.FormationAssignment) ([]*graphql.FormationAssignment, error)
 	ToGraphQL(in *model.FormationAssignment) (*graphql.FormationAssignment, error)
+	TransitionsToGraphQL(in []*model.FormationAssignmentTransition) []*graphql.FormationAssignmentTransition
 }
 
 // TenantFetcher calls an API which fetches details for the given tenant from an external tenancy service, stores the tenant in the Compass DB and returns 200 OK if the tenant was successfully created.
@@ -180,8 +186,8 @@ func (r *Resolver) Formations(ctx context.Context, first *int, after *graphql.Pa
 	}, nil
 }
 
-// CreateFormation creates new formation for the caller tenant
-func (r *Resolver) CreateFormation(ctx context.Context, formationInput graphql.FormationInput) (*graphql.Formation, error) {
+// CreateFormation creates new formation for the caller tenant. Draft formations only record the assignments done to them until they are finalized.
+func (r *Resolver) CreateFormation(ctx context.Context, formationInput graphql.FormationInput, draft *bool) (*graphql.Formation, error) {
 	tnt, err := tenant.LoadFromContext(ctx)
 	if err != nil {
 		return nil, err
@@ -200,7 +206,15 @@ func (r *Resolver) CreateFormation(ctx context.Context, formationInput graphql.F
 		templateName = *formationInput.TemplateName
 	}
 
-	newFormation, err := r.service.CreateFormation(ctx, tnt, r.conv.FromGraphQL(formationInput), templateName)
+	formation := r.conv.FromGraphQL(formationInput)
+	if draft != nil && *draft {
+		if len(formation.State) > 0 {
+			return nil, apperrors.NewInvalidDataError("formation state cannot be provided for a draft formation")
+		}
+		formation.State = model.DraftFormationState
+	}
+
+	newFormation, err := r.service.CreateFormation(ctx, tnt, formation, templateName)
 	if err != nil {
 		return nil, err
 	}
@@ -272,6 +286,67 @@ func (r *Resolver) AssignFormation(ctx context.Context, objectID string, objectT
 	return r.conv.ToGraphQL(newFormation)
 }
 
+// AssignFormationBulk assigns all the objects to the provided formation
+func (r *Resolver) AssignFormationBulk(ctx context.Context, objects []*graphql.FormationObjectInput, formation graphql.FormationInput) ([]*graphql.FormationObjectAssignmentResult, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	formationObjects := make([]FormationObject, 0, len(objects))
+	for _, object := range objects {
+		if object.ObjectType == graphql.FormationObjectTypeTenant {
+			if err := r.fetcher.FetchOnDemand(object.ObjectID, tnt); err != nil {
+				return nil, errors.Wrapf(err, "while trying to create if not exists subaccount %s", object.ObjectID)
+			}
+		}
+		formationObjects = append(formationObjects, FormationObject{ID: object.ObjectID, Type: object.ObjectType})
+	}
+
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	results, err := r.service.AssignFormationBulk(ctx, tnt, formationObjects, r.conv.FromGraphQL(formation))
+	if err != nil {
+		return nil, err
+	}
+
+	// The objects are assigned one after another in the transaction. If any of them can not be assigned, the transaction is rolled back, so that none of them is assigned
+	if !hasFailedAssignments(results) {
+		if err = tx.Commit(); err != nil {
+			return nil, errors.Wrap(err, "while committing transaction")
+		}
+	}
+
+	gqlResults := make([]*graphql.FormationObjectAssignmentResult, 0, len(results))
+	for _, result := range results {
+		gqlResult := &graphql.FormationObjectAssignmentResult{
+			ObjectID:   result.ID,
+			ObjectType: result.Type,
+		}
+		if result.Error != nil {
+			gqlResult.Error = str.Ptr(result.Error.Error())
+		}
+		gqlResults = append(gqlResults, gqlResult)
+	}
+
+	return gqlResults, nil
+}
+
+func hasFailedAssignments(results []*FormationObjectAssignmentResult) bool {
+	for _, result := range results {
+		if result.Error != nil {
+			return true
+		}
+	}
+	return false
+}
+
 // UnassignFormation unassigns the object from the provided formation
 func (r *Resolver) UnassignFormation(ctx context.Context, objectID string, objectType graphql.FormationObjectType, formation graphql.FormationInput) (*graphql.Formation, error) {
 	tnt, err := tenant.LoadFromContext(ctx)
@@ -333,7 +408,7 @@ func (r *Resolver) FormationAssignmentsDataLoader(keys []dataloader.ParamFormati
 		return nil, []error{apperrors.NewInvalidDataError("missing required parameter 'first'")}
 	}
 
-	tx, err := r.transact.Begin()
+	tx, err := persistence.BeginForCtx(ctx, r.transact)
 	if err != nil {
 		return nil, []error{err}
 	}
@@ -396,6 +471,32 @@ func (r *Resolver) FormationAssignment(ctx context.Context, obj *graphql.Formati
 	return r.formationAssignmentConv.ToGraphQL(formationAssignment)
 }
 
+// FormationAssignmentHistory retrieves the recorded state transitions of the specified FormationAssignment ordered by their creation time
+func (r *Resolver) FormationAssignmentHistory(ctx context.Context, obj *graphql.FormationAssignment) ([]*graphql.FormationAssignmentTransition, error) {
+	if obj == nil {
+		return nil, apperrors.NewInternalError("Formation Assignment cannot be empty")
+	}
+
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	transitions, err := r.formationAssignmentSvc.ListTransitions(ctx, obj.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.formationAssignmentConv.TransitionsToGraphQL(transitions), nil
+}
+
 // Status retrieves a Status for the specified Formation
 func (r *Resolver) Status(ctx context.Context, obj *graphql.Formation) (*graphql.FormationStatus, error) {
 	param := dataloader.ParamFormationStatus{ID: obj.ID, State: obj.State, Message: obj.Error.Message, ErrorCode: obj.Error.ErrorCode, Ctx: ctx}
@@ -414,7 +515,7 @@ func (r *Resolver) StatusDataLoader(keys []dataloader.ParamFormationStatus) ([]*
 		formationIDs = append(formationIDs, key.ID)
 	}
 
-	tx, err := r.transact.Begin()
+	tx, err := persistence.BeginForCtx(ctx, r.transact)
 	if err != nil {
 		return nil, []error{err}
 	}
@@ -446,6 +547,8 @@ func (r *Resolver) StatusDataLoader(keys []dataloader.ParamFormationStatus) ([]*
 		case string(model.CreateErrorFormationState), string(model.DeleteErrorFormationState):
 			condition = graphql.FormationStatusConditionError
 			formationStatusErrors = append(formationStatusErrors, &graphql.FormationStatusError{Message: keys[i].Message, ErrorCode: keys[i].ErrorCode})
+		case string(model.DraftFormationState):
+			condition = graphql.FormationStatusConditionDraft
 		}
 
 		for _, fa := range formationAssignments {
@@ -508,6 +611,28 @@ func (r *Resolver) ResynchronizeFormationNotifications(ctx context.Context, form
 	return r.conv.ToGraphQL(updatedFormation)
 }
 
+// FinalizeFormation generates the formation assignments for a formation in DRAFT state and sends the notifications for them
+func (r *Resolver) FinalizeFormation(ctx context.Context, formationID string) (*graphql.Formation, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	finalizedFormation, err := r.service.FinalizeFormation(ctx, formationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.conv.ToGraphQL(finalizedFormation)
+}
+
 func (r *Resolver) deleteSelfReferencedFormationAssignment(ctx context.Context, tnt, formationName, objectID string) error {
 	selfFATx, err := r.transact.Begin()
 	if err != nil {
@@ -19,6 +19,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -55,7 +56,7 @@ func TestCreateFormation(t *testing.T) {
 		sut := formation.NewResolver(transact, mockService, mockConverter, nil, nil, nil)
 
 		// WHEN
-		actual, err := sut.CreateFormation(ctx, formationInputWithTemplateName)
+		actual, err := sut.CreateFormation(ctx, formationInputWithTemplateName, nil)
 
 		// THEN
 		require.NoError(t, err)
@@ -78,7 +79,7 @@ func TestCreateFormation(t *testing.T) {
 		sut := formation.NewResolver(transact, mockService, mockConverter, nil, nil, nil)
 
 		// WHEN
-		actual, err := sut.CreateFormation(ctx, formationInput)
+		actual, err := sut.CreateFormation(ctx, formationInput, nil)
 
 		// THEN
 		require.NoError(t, err)
@@ -93,7 +94,7 @@ func TestCreateFormation(t *testing.T) {
 		sut := formation.NewResolver(nil, nil, nil, nil, nil, nil)
 
 		// WHEN
-		_, err := sut.CreateFormation(ctx, formationInput)
+		_, err := sut.CreateFormation(ctx, formationInput, nil)
 
 		// THEN
 		require.Error(t, err)
@@ -108,7 +109,7 @@ func TestCreateFormation(t *testing.T) {
 		sut := formation.NewResolver(transact, nil, nil, nil, nil, nil)
 
 		// WHEN
-		_, err := sut.CreateFormation(ctx, formationInput)
+		_, err := sut.CreateFormation(ctx, formationInput, nil)
 
 		// THEN
 		require.Error(t, err)
@@ -130,7 +131,7 @@ func TestCreateFormation(t *testing.T) {
 		sut := formation.NewResolver(transact, mockService, mockConverter, nil, nil, nil)
 
 		// WHEN
-		_, err := sut.CreateFormation(ctx, formationInput)
+		_, err := sut.CreateFormation(ctx, formationInput, nil)
 
 		// THEN
 		require.Error(t, err)
@@ -152,7 +153,7 @@ func TestCreateFormation(t *testing.T) {
 		sut := formation.NewResolver(transact, mockService, mockConverter, nil, nil, nil)
 
 		// WHEN
-		actual, err := sut.CreateFormation(ctx, formationInput)
+		actual, err := sut.CreateFormation(ctx, formationInput, nil)
 
 		// THEN
 		require.Error(t, err)
@@ -160,6 +161,52 @@ func TestCreateFormation(t *testing.T) {
 		require.Nil(t, actual)
 		mock.AssertExpectationsForObjects(t, persist, transact, mockService, mockConverter)
 	})
+	t.Run("successfully created draft formation", func(t *testing.T) {
+		// GIVEN
+		persist, transact := txGen.ThatSucceeds()
+		draft := true
+		draftFormation := model.Formation{Name: testFormationName, State: model.DraftFormationState}
+
+		mockService := &automock.Service{}
+		mockConverter := &automock.Converter{}
+		mockService.On("CreateFormation", contextThatHasTenant(tnt), tnt, draftFormation, model.DefaultTemplateName).Return(&draftFormation, nil)
+
+		mockConverter.On("FromGraphQL", formationInput).Return(model.Formation{Name: testFormationName})
+		mockConverter.On("ToGraphQL", &draftFormation).Return(&graphql.Formation{Name: testFormationName, State: string(model.DraftFormationState)}, nil)
+
+		ctx := tenant.SaveToContext(context.TODO(), tnt, externalTnt)
+		sut := formation.NewResolver(transact, mockService, mockConverter, nil, nil, nil)
+
+		// WHEN
+		actual, err := sut.CreateFormation(ctx, formationInput, &draft)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, string(model.DraftFormationState), actual.State)
+		mock.AssertExpectationsForObjects(t, persist, transact, mockService, mockConverter)
+	})
+
+	t.Run("returns error when state is provided for draft formation", func(t *testing.T) {
+		// GIVEN
+		persist, transact := txGen.ThatDoesntExpectCommit()
+		draft := true
+		initialState := string(model.InitialFormationState)
+		formationInputWithState := graphql.FormationInput{Name: testFormationName, State: &initialState}
+
+		mockConverter := &automock.Converter{}
+		mockConverter.On("FromGraphQL", formationInputWithState).Return(model.Formation{Name: testFormationName, State: model.InitialFormationState})
+
+		ctx := tenant.SaveToContext(context.TODO(), tnt, externalTnt)
+		sut := formation.NewResolver(transact, nil, mockConverter, nil, nil, nil)
+
+		// WHEN
+		_, err := sut.CreateFormation(ctx, formationInputWithState, &draft)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "formation state cannot be provided for a draft formation")
+		mock.AssertExpectationsForObjects(t, persist, transact, mockConverter)
+	})
 }
 
 func TestDeleteFormation(t *testing.T) {
@@ -404,6 +451,182 @@ func TestAssignFormation(t *testing.T) {
 	})
 }
 
+func TestAssignFormationBulk(t *testing.T) {
+	formationInput := graphql.FormationInput{
+		Name: testFormationName,
+	}
+	tnt := "tenant"
+	externalTnt := "external-tenant"
+	testErr := errors.New("test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	objects := []*graphql.FormationObjectInput{
+		{ObjectID: ApplicationID, ObjectType: graphql.FormationObjectTypeApplication},
+		{ObjectID: TargetTenantID, ObjectType: graphql.FormationObjectTypeTenant},
+	}
+	formationObjects := []formation.FormationObject{
+		{ID: ApplicationID, Type: graphql.FormationObjectTypeApplication},
+		{ID: TargetTenantID, Type: graphql.FormationObjectTypeTenant},
+	}
+
+	t.Run("successfully assigned objects to formation", func(t *testing.T) {
+		// GIVEN
+		persist, transact := txGen.ThatSucceeds()
+
+		mockService := &automock.Service{}
+		mockService.On("AssignFormationBulk", contextThatHasTenant(tnt), tnt, formationObjects, modelFormation).Return([]*formation.FormationObjectAssignmentResult{
+			{FormationObject: formationObjects[0]},
+			{FormationObject: formationObjects[1]},
+		}, nil)
+
+		mockConverter := &automock.Converter{}
+		mockConverter.On("FromGraphQL", formationInput).Return(modelFormation)
+
+		fetcherSvc := &automock.TenantFetcher{}
+		fetcherSvc.On("FetchOnDemand", TargetTenantID, tnt).Return(nil)
+
+		ctx := tenant.SaveToContext(context.TODO(), tnt, externalTnt)
+		sut := formation.NewResolver(transact, mockService, mockConverter, nil, nil, fetcherSvc)
+
+		// WHEN
+		actual, err := sut.AssignFormationBulk(ctx, objects, formationInput)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, []*graphql.FormationObjectAssignmentResult{
+			{ObjectID: ApplicationID, ObjectType: graphql.FormationObjectTypeApplication},
+			{ObjectID: TargetTenantID, ObjectType: graphql.FormationObjectTypeTenant},
+		}, actual)
+		mock.AssertExpectationsForObjects(t, persist, transact, mockService, mockConverter, fetcherSvc)
+	})
+	t.Run("returns the errors of the objects that can not be assigned without committing the transaction", func(t *testing.T) {
+		// GIVEN
+		persist, transact := txGen.ThatDoesntExpectCommit()
+
+		mockService := &automock.Service{}
+		mockService.On("AssignFormationBulk", contextThatHasTenant(tnt), tnt, formationObjects, modelFormation).Return([]*formation.FormationObjectAssignmentResult{
+			{FormationObject: formationObjects[0], Error: testErr},
+			{FormationObject: formationObjects[1]},
+		}, nil)
+
+		mockConverter := &automock.Converter{}
+		mockConverter.On("FromGraphQL", formationInput).Return(modelFormation)
+
+		fetcherSvc := &automock.TenantFetcher{}
+		fetcherSvc.On("FetchOnDemand", TargetTenantID, tnt).Return(nil)
+
+		ctx := tenant.SaveToContext(context.TODO(), tnt, externalTnt)
+		sut := formation.NewResolver(transact, mockService, mockConverter, nil, nil, fetcherSvc)
+
+		// WHEN
+		actual, err := sut.AssignFormationBulk(ctx, objects, formationInput)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, []*graphql.FormationObjectAssignmentResult{
+			{ObjectID: ApplicationID, ObjectType: graphql.FormationObjectTypeApplication, Error: str.Ptr(testErr.Error())},
+			{ObjectID: TargetTenantID, ObjectType: graphql.FormationObjectTypeTenant},
+		}, actual)
+		mock.AssertExpectationsForObjects(t, persist, transact, mockService, mockConverter, fetcherSvc)
+	})
+	t.Run("returns error when tenant object cannot be fetched", func(t *testing.T) {
+		// GIVEN
+		persist, transact := txGen.ThatDoesntStartTransaction()
+
+		fetcherSvc := &automock.TenantFetcher{}
+		fetcherSvc.On("FetchOnDemand", TargetTenantID, tnt).Return(testErr)
+
+		ctx := tenant.SaveToContext(context.TODO(), tnt, externalTnt)
+		sut := formation.NewResolver(transact, nil, nil, nil, nil, fetcherSvc)
+
+		// WHEN
+		_, err := sut.AssignFormationBulk(ctx, objects, formationInput)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), testErr.Error())
+		mock.AssertExpectationsForObjects(t, persist, transact, fetcherSvc)
+	})
+	t.Run("returns error when can not load tenant from context", func(t *testing.T) {
+		// GIVEN
+		sut := formation.NewResolver(nil, nil, nil, nil, nil, nil)
+
+		// WHEN
+		_, err := sut.AssignFormationBulk(context.Background(), objects, formationInput)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), apperrors.NewCannotReadTenantError().Error())
+	})
+	t.Run("returns error when can not start db transaction", func(t *testing.T) {
+		// GIVEN
+		persist, transact := txGen.ThatFailsOnBegin()
+
+		fetcherSvc := &automock.TenantFetcher{}
+		fetcherSvc.On("FetchOnDemand", TargetTenantID, tnt).Return(nil)
+
+		ctx := tenant.SaveToContext(context.TODO(), tnt, externalTnt)
+		sut := formation.NewResolver(transact, nil, nil, nil, nil, fetcherSvc)
+
+		// WHEN
+		_, err := sut.AssignFormationBulk(ctx, objects, formationInput)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), testErr.Error())
+		mock.AssertExpectationsForObjects(t, persist, transact, fetcherSvc)
+	})
+	t.Run("returns error when bulk assign fails", func(t *testing.T) {
+		// GIVEN
+		persist, transact := txGen.ThatDoesntExpectCommit()
+
+		mockService := &automock.Service{}
+		mockService.On("AssignFormationBulk", contextThatHasTenant(tnt), tnt, formationObjects, modelFormation).Return(nil, testErr)
+
+		mockConverter := &automock.Converter{}
+		mockConverter.On("FromGraphQL", formationInput).Return(modelFormation)
+
+		fetcherSvc := &automock.TenantFetcher{}
+		fetcherSvc.On("FetchOnDemand", TargetTenantID, tnt).Return(nil)
+
+		ctx := tenant.SaveToContext(context.TODO(), tnt, externalTnt)
+		sut := formation.NewResolver(transact, mockService, mockConverter, nil, nil, fetcherSvc)
+
+		// WHEN
+		actual, err := sut.AssignFormationBulk(ctx, objects, formationInput)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), testErr.Error())
+		require.Nil(t, actual)
+		mock.AssertExpectationsForObjects(t, persist, transact, mockService, mockConverter, fetcherSvc)
+	})
+	t.Run("returns error when commit fails", func(t *testing.T) {
+		// GIVEN
+		persist, transact := txGen.ThatFailsOnCommit()
+
+		mockService := &automock.Service{}
+		mockService.On("AssignFormationBulk", contextThatHasTenant(tnt), tnt, formationObjects, modelFormation).Return([]*formation.FormationObjectAssignmentResult{}, nil)
+
+		mockConverter := &automock.Converter{}
+		mockConverter.On("FromGraphQL", formationInput).Return(modelFormation)
+
+		fetcherSvc := &automock.TenantFetcher{}
+		fetcherSvc.On("FetchOnDemand", TargetTenantID, tnt).Return(nil)
+
+		ctx := tenant.SaveToContext(context.TODO(), tnt, externalTnt)
+		sut := formation.NewResolver(transact, mockService, mockConverter, nil, nil, fetcherSvc)
+
+		// WHEN
+		_, err := sut.AssignFormationBulk(ctx, objects, formationInput)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), testErr.Error())
+		mock.AssertExpectationsForObjects(t, persist, transact, mockService, mockConverter, fetcherSvc)
+	})
+}
+
 func TestUnassignFormation(t *testing.T) {
 	formationInput := graphql.FormationInput{
 		Name: testFormationName,
@@ -1139,6 +1362,126 @@ func TestResolver_FormationAssignment(t *testing.T) {
 	}
 }
 
+func TestResolver_FormationAssignmentHistory(t *testing.T) {
+	// GIVEN
+	ctx := context.TODO()
+
+	testErr := errors.New("test error")
+
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	gqlFormationAssignment := fixGqlFormationAssignment(FormationAssignmentState, &TestConfigValueStr)
+	transitions := []*model.FormationAssignmentTransition{
+		{
+			ID:                    "transition-id",
+			FormationAssignmentID: FormationAssignmentID,
+			OldState:              string(model.InitialAssignmentState),
+			NewState:              FormationAssignmentState,
+			Actor:                 model.NotificationResponseTransitionActor,
+		},
+	}
+	gqlTransitions := []*graphql.FormationAssignmentTransition{
+		{
+			OldState: string(model.InitialAssignmentState),
+			NewState: FormationAssignmentState,
+			Actor:    graphql.FormationAssignmentTransitionActorNotificationResponse,
+		},
+	}
+
+	testCases := []struct {
+		Name                string
+		TransactionerFn     func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ServiceFn           func() *automock.FormationAssignmentService
+		ConverterFn         func() *automock.FormationAssignmentConverter
+		FormationAssignment *graphql.FormationAssignment
+		ExpectedTransitions []*graphql.FormationAssignmentTransition
+		ExpectedErrMsg      string
+	}{
+		{
+			Name:            "Success",
+			TransactionerFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.FormationAssignmentService {
+				faSvc := &automock.FormationAssignmentService{}
+				faSvc.On("ListTransitions", txtest.CtxWithDBMatcher(), FormationAssignmentID).Return(transitions, nil).Once()
+				return faSvc
+			},
+			ConverterFn: func() *automock.FormationAssignmentConverter {
+				faConv := &automock.FormationAssignmentConverter{}
+				faConv.On("TransitionsToGraphQL", transitions).Return(gqlTransitions).Once()
+				return faConv
+			},
+			FormationAssignment: gqlFormationAssignment,
+			ExpectedTransitions: gqlTransitions,
+		},
+		{
+			Name:            "Return error when formation assignment object is nil",
+			TransactionerFn: txGen.ThatDoesntStartTransaction,
+			ExpectedErrMsg:  "Formation Assignment cannot be empty",
+		},
+		{
+			Name:                "Returns error when transaction begin fails",
+			TransactionerFn:     txGen.ThatFailsOnBegin,
+			FormationAssignment: gqlFormationAssignment,
+			ExpectedErrMsg:      testErr.Error(),
+		},
+		{
+			Name:            "Returns error when listing transitions fails",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.FormationAssignmentService {
+				faSvc := &automock.FormationAssignmentService{}
+				faSvc.On("ListTransitions", txtest.CtxWithDBMatcher(), FormationAssignmentID).Return(nil, testErr).Once()
+				return faSvc
+			},
+			FormationAssignment: gqlFormationAssignment,
+			ExpectedErrMsg:      testErr.Error(),
+		},
+		{
+			Name:            "Returns error when commit failed",
+			TransactionerFn: txGen.ThatFailsOnCommit,
+			ServiceFn: func() *automock.FormationAssignmentService {
+				faSvc := &automock.FormationAssignmentService{}
+				faSvc.On("ListTransitions", txtest.CtxWithDBMatcher(), FormationAssignmentID).Return(transitions, nil).Once()
+				return faSvc
+			},
+			FormationAssignment: gqlFormationAssignment,
+			ExpectedErrMsg:      testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			persist, transact := testCase.TransactionerFn()
+
+			faSvc := &automock.FormationAssignmentService{}
+			if testCase.ServiceFn != nil {
+				faSvc = testCase.ServiceFn()
+			}
+
+			faConv := &automock.FormationAssignmentConverter{}
+			if testCase.ConverterFn != nil {
+				faConv = testCase.ConverterFn()
+			}
+
+			resolver := formation.NewResolver(transact, nil, nil, faSvc, faConv, nil)
+
+			// WHEN
+			history, err := resolver.FormationAssignmentHistory(ctx, testCase.FormationAssignment)
+
+			// THEN
+			require.Equal(t, testCase.ExpectedTransitions, history)
+			if testCase.ExpectedErrMsg != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), testCase.ExpectedErrMsg)
+			} else {
+				require.NoError(t, err)
+			}
+
+			mock.AssertExpectationsForObjects(t, faSvc, faConv, transact, persist)
+		})
+	}
+}
+
 func TestResolver_FormationAssignments(t *testing.T) {
 	// GIVEN
 	ctx := context.TODO()
@@ -1409,6 +1752,18 @@ func TestResolver_Status(t *testing.T) {
 			}},
 			ExpectedErr: nil,
 		},
+		{
+			Name:            "Success when the formation is in draft state",
+			TransactionerFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.FormationAssignmentService {
+				faSvc := &automock.FormationAssignmentService{}
+				faSvc.On("ListByFormationIDsNoPaging", txtest.CtxWithDBMatcher(), []string{FormationID}).Return(emptyFaPage, nil).Once()
+				return faSvc
+			},
+			Params:         []dataloader.ParamFormationStatus{{ID: FormationID, State: string(model.DraftFormationState)}},
+			ExpectedResult: []*graphql.FormationStatus{{Condition: graphql.FormationStatusConditionDraft}},
+			ExpectedErr:    nil,
+		},
 		{
 			Name:            "Returns error when transaction begin failed",
 			TransactionerFn: txGen.ThatFailsOnBegin,
@@ -1627,6 +1982,90 @@ func TestResynchronizeFormationNotifications(t *testing.T) {
 	}
 }
 
+func TestFinalizeFormation(t *testing.T) {
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	ctx := tenant.SaveToContext(context.TODO(), TntInternalID, TntExternalID)
+
+	testCases := []struct {
+		Name              string
+		TxFn              func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		FormationService  func() *automock.Service
+		Converter         func() *automock.Converter
+		ExpectedFormation *graphql.Formation
+		ExpectedErrorMsg  string
+	}{
+		{
+			Name: "successfully finalized formation",
+			TxFn: txGen.ThatSucceeds,
+			FormationService: func() *automock.Service {
+				svc := &automock.Service{}
+				svc.On("FinalizeFormation", contextThatHasTenant(TntInternalID), FormationID).Return(&modelFormation, nil).Once()
+				return svc
+			},
+			Converter: func() *automock.Converter {
+				conv := &automock.Converter{}
+				conv.On("ToGraphQL", &modelFormation).Return(&graphqlFormation, nil).Once()
+				return conv
+			},
+			ExpectedFormation: &graphqlFormation,
+		},
+		{
+			Name: "failed during finalizing",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			FormationService: func() *automock.Service {
+				svc := &automock.Service{}
+				svc.On("FinalizeFormation", contextThatHasTenant(TntInternalID), FormationID).Return(nil, testErr).Once()
+				return svc
+			},
+			ExpectedErrorMsg: testErr.Error(),
+		},
+		{
+			Name: "failed to commit after finalizing",
+			TxFn: txGen.ThatFailsOnCommit,
+			FormationService: func() *automock.Service {
+				svc := &automock.Service{}
+				svc.On("FinalizeFormation", contextThatHasTenant(TntInternalID), FormationID).Return(&modelFormation, nil).Once()
+				return svc
+			},
+			ExpectedErrorMsg: testErr.Error(),
+		},
+		{
+			Name:             "returns error when can not start db transaction",
+			TxFn:             txGen.ThatFailsOnBegin,
+			ExpectedErrorMsg: testErr.Error(),
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			conv := unusedConverter()
+			if testCase.Converter != nil {
+				conv = testCase.Converter()
+			}
+			formationService := unusedService()
+			if testCase.FormationService != nil {
+				formationService = testCase.FormationService()
+			}
+			persist, transact := testCase.TxFn()
+
+			resolver := formation.NewResolver(transact, formationService, conv, nil, nil, nil)
+
+			// WHEN
+			result, err := resolver.FinalizeFormation(ctx, FormationID)
+
+			if testCase.ExpectedErrorMsg != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), testCase.ExpectedErrorMsg)
+				require.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, testCase.ExpectedFormation, result)
+			}
+			mock.AssertExpectationsForObjects(t, conv, formationService, persist, transact)
+		})
+	}
+}
+
 func contextThatHasTenant(expectedTenant string) interface{} {
 	return mock.MatchedBy(func(actual context.Context) bool {
 		actualTenant, err := tenant.LoadFromContext(actual)
@@ -66,6 +66,7 @@ type runtimeContextRepository interface {
 //go:generate mockery --name=FormationRepository --output=automock --outpkg=automock --case=underscore --disable-version-string
 type FormationRepository interface {
 	Get(ctx context.Context, id, tenantID string) (*model.Formation, error)
+	GetForUpdate(ctx context.Context, id, tenantID string) (*model.Formation, error)
 	GetByName(ctx context.Context, name, tenantID string) (*model.Formation, error)
 	GetGlobalByID(ctx context.Context, id string) (*model.Formation, error)
 	List(ctx context.Context, tenant string, pageSize int, cursor string) (*model.FormationPage, error)
@@ -364,16 +365,19 @@ func (s *service) CreateFormation(ctx context.Context, tnt string, formation mod
 		return nil, err
 	}
 
-	formationReqs, err := s.notificationsService.GenerateFormationNotifications(ctx, formationTemplateWebhooks, tnt, newFormation, formationTemplateName, formationTemplateID, model.CreateFormation)
-	if err != nil {
-		return nil, errors.Wrapf(err, "while generating notifications for formation with ID: %q and name: %q", newFormation.ID, newFormation.Name)
-	}
+	// The formation lifecycle notifications for a draft formation are sent when the formation is finalized
+	if formationState != model.DraftFormationState {
+		formationReqs, err := s.notificationsService.GenerateFormationNotifications(ctx, formationTemplateWebhooks, tnt, newFormation, formationTemplateName, formationTemplateID, model.CreateFormation)
+		if err != nil {
+			return nil, errors.Wrapf(err, "while generating notifications for formation with ID: %q and name: %q", newFormation.ID, newFormation.Name)
+		}
 
-	for _, formationReq := range formationReqs {
-		if err := s.processFormationNotifications(ctx, newFormation, formationReq, model.CreateErrorFormationState); err != nil {
-			processErr := errors.Wrapf(err, "while processing notifications for formation with ID: %q and name: %q", newFormation.ID, newFormation.Name)
-			log.C(ctx).Error(processErr)
-			return nil, processErr
+		for _, formationReq := range formationReqs {
+			if err := s.processFormationNotifications(ctx, newFormation, formationReq, model.CreateErrorFormationState); err != nil {
+				processErr := errors.Wrapf(err, "while processing notifications for formation with ID: %q and name: %q", newFormation.ID, newFormation.Name)
+				log.C(ctx).Error(processErr)
+				return nil, processErr
+			}
 		}
 	}
 
@@ -410,6 +414,19 @@ func (s *service) DeleteFormation(ctx context.Context, tnt string, formation mod
 		return nil, errors.Wrapf(err, "while enforcing constraints for target operation %q and constraint type %q", model.DeleteFormationOperation, model.PreOperation)
 	}
 
+	// A draft formation was never announced to the formation lifecycle webhooks, so it is deleted without sending notifications
+	if ft.formation.State == model.DraftFormationState {
+		if err := s.DeleteFormationEntityAndScenarios(ctx, tnt, formationName); err != nil {
+			return nil, errors.Wrapf(err, "An error occurred while deleting formation entity with name: %q and its scenarios label", formationName)
+		}
+
+		if err = s.constraintEngine.EnforceConstraints(ctx, formationconstraint.PostDelete, joinPointDetails, formationTemplateID); err != nil {
+			return nil, errors.Wrapf(err, "while enforcing constraints for target operation %q and constraint type %q", model.DeleteFormationOperation, model.PostOperation)
+		}
+
+		return ft.formation, nil
+	}
+
 	formationTemplateWebhooks, err := s.webhookRepository.ListByReferenceObjectIDGlobal(ctx, formationTemplateID, model.FormationTemplateWebhookReference)
 	if err != nil {
 		return nil, errors.Wrapf(err, "when listing formation lifecycle webhooks for formation template with ID: %q", formationTemplateID)
@@ -483,6 +500,9 @@ func (s *service) DeleteFormationEntityAndScenarios(ctx context.Context, tnt, fo
 // After the assigning there may be formationAssignments in CREATE_ERROR state. They can be fixed by assigning the object to the same formation again. This will result in retrying only
 // the formationAssignments that are in state different from READY.
 //
+// If the formation is in DRAFT state only the scenario label of the object is updated. The formationAssignments are generated and the notifications
+// are sent for all objects in the formation at once when the formation is finalized.
+//
 // If the graphql.FormationObjectType is graphql.FormationObjectTypeTenant it will
 // create automatic scenario assignment with the caller and target tenant which then will assign the right Runtime / RuntimeContexts based on the formation template's runtimeType.
 func (s *service) AssignFormation(ctx context.Context, tnt, objectID string, objectType graphql.FormationObjectType, formation model.Formation) (*model.Formation, error) {
@@ -493,6 +513,10 @@ func (s *service) AssignFormation(ctx context.Context, tnt, objectID string, obj
 		return nil, errors.Wrapf(err, "while assigning formation with name %q", formation.Name)
 	}
 
+	if ft.formation, err = s.lockDraftFormation(ctx, tnt, ft.formation); err != nil {
+		return nil, errors.Wrapf(err, "while assigning formation with name %q", formation.Name)
+	}
+
 	if !isObjectTypeSupported(ft.formationTemplate, objectType) {
 		return nil, errors.Errorf("Formation %q of type %q does not support resources of type %q", ft.formation.Name, ft.formationTemplate.Name, objectType)
 	}
@@ -506,70 +530,258 @@ func (s *service) AssignFormation(ctx context.Context, tnt, objectID string, obj
 		return nil, errors.Wrapf(err, "while enforcing constraints for target operation %q and constraint type %q", model.AssignFormationOperation, model.PreOperation)
 	}
 
-	formationFromDB := ft.formation
-	switch objectType {
-	case graphql.FormationObjectTypeApplication, graphql.FormationObjectTypeRuntime, graphql.FormationObjectTypeRuntimeContext:
-		// If we assign it to the label definitions when it is in deleting state we risk leaving incorrect data
-		// in the LabelDefinition and formation assignments and failing to delete the formation later on
-		if formationFromDB.State == model.DeletingFormationState || formationFromDB.State == model.DeleteErrorFormationState {
-			return nil, fmt.Errorf("cannot assign to formation with ID %q as it is in %q state", formationFromDB.ID, formationFromDB.State)
+	completed, err := s.assignObject(ctx, tnt, objectID, objectType, ft.formation, ft.formationTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	if !completed {
+		return ft.formation, nil
+	}
+
+	if err = s.constraintEngine.EnforceConstraints(ctx, formationconstraint.PostAssign, joinPointDetails, ft.formationTemplate.ID); err != nil {
+		return nil, errors.Wrapf(err, "while enforcing constraints for target operation %q and constraint type %q", model.AssignFormationOperation, model.PostOperation)
+	}
+
+	return ft.formation, nil
+}
+
+// FormationObject is an object that is assigned to a formation as part of a bulk assignment
+type FormationObject struct {
+	ID   string
+	Type graphql.FormationObjectType
+}
+
+// FormationObjectAssignmentResult is the outcome of the bulk assignment for a single object
+type FormationObjectAssignmentResult struct {
+	FormationObject
+	Error error
+}
+
+// AssignFormationBulk assigns all the objects to the formation with the provided name.
+// The objects are processed in the provided order. The pre-assign constraints for an object are enforced after the preceding objects are assigned,
+// so they see the same state as if the objects were assigned one at a time. The formation assignments for all objects are generated at once after the batch.
+// If some of the objects can not be assigned, the reason is returned in the results of the respective objects and the caller must not commit the transaction,
+// so that none of the objects is assigned. The results of the other objects then contain an error stating that they are not assigned because of the rollback.
+func (s *service) AssignFormationBulk(ctx context.Context, tnt string, objects []FormationObject, formation model.Formation) ([]*FormationObjectAssignmentResult, error) {
+	log.C(ctx).Infof("Assigning %d objects to formation %q", len(objects), formation.Name)
+
+	seenObjects := make(map[FormationObject]bool, len(objects))
+	for _, object := range objects {
+		if seenObjects[object] {
+			return nil, apperrors.NewInvalidDataError(fmt.Sprintf("%s with ID %q is provided more than once", object.Type, object.ID))
 		}
-		err := s.assign(ctx, tnt, objectID, objectType, formationFromDB, ft.formationTemplate)
-		if err != nil {
-			return nil, err
+		seenObjects[object] = true
+	}
+
+	ft, err := s.getFormationWithTemplate(ctx, formation.Name, tnt)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while assigning formation with name %q", formation.Name)
+	}
+
+	if ft.formation, err = s.lockDraftFormation(ctx, tnt, ft.formation); err != nil {
+		return nil, errors.Wrapf(err, "while assigning formation with name %q", formation.Name)
+	}
+
+	// If we assign it to the label definitions when it is in deleting state we risk leaving incorrect data
+	// in the LabelDefinition and formation assignments and failing to delete the formation later on
+	if ft.formation.State == model.DeletingFormationState || ft.formation.State == model.DeleteErrorFormationState {
+		return nil, fmt.Errorf("cannot assign to formation with ID %q as it is in %q state", ft.formation.ID, ft.formation.State)
+	}
+
+	results := make([]*FormationObjectAssignmentResult, 0, len(objects))
+	joinPointDetails := make([]*formationconstraint.AssignFormationOperationDetails, 0, len(objects))
+	participants := make([]formationassignment.FormationObject, 0, len(objects))
+	hasInvalidObjects := false
+	for _, object := range objects {
+		result := &FormationObjectAssignmentResult{FormationObject: object}
+		results = append(results, result)
+
+		if !isObjectTypeSupported(ft.formationTemplate, object.Type) {
+			result.Error = errors.Errorf("Formation %q of type %q does not support resources of type %q", ft.formation.Name, ft.formationTemplate.Name, object.Type)
+			hasInvalidObjects = true
+			continue
 		}
 
-		assignments, err := s.formationAssignmentService.GenerateAssignments(ctx, tnt, objectID, objectType, formationFromDB)
+		details, err := s.prepareDetailsForAssign(ctx, tnt, object.ID, object.Type, ft.formation, ft.formationTemplate)
 		if err != nil {
-			return nil, err
+			return nil, errors.Wrapf(err, "while preparing joinpoint details for target operation %q and constraint type %q", model.AssignFormationOperation, model.PreOperation)
 		}
 
-		// When it is in initial state, the notification generation will be handled by the async API via resynchronizing the formation later
-		// If we are in create error state, the formation is not ready, and we should not send notifications
-		if formationFromDB.State == model.InitialFormationState || formationFromDB.State == model.CreateErrorFormationState {
-			log.C(ctx).Infof("Formation with id %q is not in %q state. Waiting for response on status API before sending notifications...", formationFromDB.ID, model.ReadyFormationState)
-			return ft.formation, nil
+		if err = s.constraintEngine.EnforceConstraints(ctx, formationconstraint.PreAssign, details, ft.formationTemplate.ID); err != nil {
+			result.Error = errors.Wrapf(err, "while enforcing constraints for target operation %q and constraint type %q", model.AssignFormationOperation, model.PreOperation)
+			hasInvalidObjects = true
+			continue
 		}
+		joinPointDetails = append(joinPointDetails, details)
 
-		rtmContextIDsMapping, err := s.getRuntimeContextIDToRuntimeIDMapping(ctx, tnt, assignments)
-		if err != nil {
-			return nil, err
+		if object.Type == graphql.FormationObjectTypeTenant {
+			if _, err = s.assignObject(ctx, tnt, object.ID, object.Type, ft.formation, ft.formationTemplate); err != nil {
+				return nil, errors.Wrapf(err, "while assigning %s with ID %q to formation %q", object.Type, object.ID, ft.formation.Name)
+			}
+			continue
+		}
+
+		if err = s.assign(ctx, tnt, object.ID, object.Type, ft.formation, ft.formationTemplate); err != nil {
+			return nil, errors.Wrapf(err, "while assigning %s with ID %q to formation %q", object.Type, object.ID, ft.formation.Name)
 		}
+		participants = append(participants, formationassignment.FormationObject{ID: object.ID, Type: object.Type})
+	}
+
+	if hasInvalidObjects {
+		log.C(ctx).Infof("Not all objects can be assigned to formation %q. None of the objects will be assigned", ft.formation.Name)
+		markRolledBackResults(results)
+		return results, nil
+	}
+
+	completed, err := s.processBulkAssignments(ctx, tnt, participants, ft.formation)
+	if err != nil {
+		return nil, err
+	}
+
+	if !completed {
+		return results, nil
+	}
+
+	for _, details := range joinPointDetails {
+		if err = s.constraintEngine.EnforceConstraints(ctx, formationconstraint.PostAssign, details, ft.formationTemplate.ID); err != nil {
+			return nil, errors.Wrapf(err, "while enforcing constraints for target operation %q and constraint type %q", model.AssignFormationOperation, model.PostOperation)
+		}
+	}
+
+	return results, nil
+}
 
-		applicationIDToApplicationTemplateIDMapping, err := s.getApplicationIDToApplicationTemplateIDMapping(ctx, tnt, assignments)
+// markRolledBackResults sets an error on the results of the objects which could be assigned, as they are not assigned either when the batch is rolled back.
+// The error refers to the first object which can not be assigned.
+func markRolledBackResults(results []*FormationObjectAssignmentResult) {
+	var failedObject *FormationObject
+	for _, result := range results {
+		if result.Error != nil {
+			failedObject = &result.FormationObject
+			break
+		}
+	}
+	if failedObject == nil {
+		return
+	}
+
+	for _, result := range results {
+		if result.Error == nil {
+			result.Error = errors.Errorf("not assigned: batch rolled back because of %s with ID %q", failedObject.Type, failedObject.ID)
+		}
+	}
+}
+
+// processBulkAssignments generates the formation assignments for all objects assigned in a single batch and processes them.
+// It returns false if the formation is not in READY state yet and the processing of the assignments is postponed.
+func (s *service) processBulkAssignments(ctx context.Context, tnt string, objects []formationassignment.FormationObject, formation *model.Formation) (bool, error) {
+	if formation.State == model.DraftFormationState {
+		log.C(ctx).Infof("Formation with id %q is in %q state. Formation assignments for the assigned objects will be generated when the formation is finalized", formation.ID, model.DraftFormationState)
+		return false, nil
+	}
+
+	if len(objects) == 0 {
+		return true, nil
+	}
+
+	assignmentsPerObject, err := s.formationAssignmentService.GenerateAssignmentsForObjects(ctx, tnt, objects, formation)
+	if err != nil {
+		return false, err
+	}
+
+	// When it is in initial state, the notification generation will be handled by the async API via resynchronizing the formation later
+	// If we are in create error state, the formation is not ready, and we should not send notifications
+	if formation.State == model.InitialFormationState || formation.State == model.CreateErrorFormationState {
+		log.C(ctx).Infof("Formation with id %q is not in %q state. Waiting for response on status API before sending notifications...", formation.ID, model.ReadyFormationState)
+		return false, nil
+	}
+
+	for i, object := range objects {
+		if err = s.processAssignments(ctx, tnt, object.ID, object.Type, formation, assignmentsPerObject[i]); err != nil {
+			return false, errors.Wrapf(err, "while processing formation assignments for %s with ID %q", object.Type, object.ID)
+		}
+	}
+
+	return true, nil
+}
+
+// assignObject assigns the object to the formation and processes the generated formation assignments.
+// It returns false if the formation is not in READY state yet and the processing of the assignment is postponed.
+func (s *service) assignObject(ctx context.Context, tnt, objectID string, objectType graphql.FormationObjectType, formation *model.Formation, formationTemplate *model.FormationTemplate) (bool, error) {
+	switch objectType {
+	case graphql.FormationObjectTypeApplication, graphql.FormationObjectTypeRuntime, graphql.FormationObjectTypeRuntimeContext:
+		// If we assign it to the label definitions when it is in deleting state we risk leaving incorrect data
+		// in the LabelDefinition and formation assignments and failing to delete the formation later on
+		if formation.State == model.DeletingFormationState || formation.State == model.DeleteErrorFormationState {
+			return false, fmt.Errorf("cannot assign to formation with ID %q as it is in %q state", formation.ID, formation.State)
+		}
+		err := s.assign(ctx, tnt, objectID, objectType, formation, formationTemplate)
 		if err != nil {
-			return nil, err
+			return false, err
+		}
+
+		if formation.State == model.DraftFormationState {
+			log.C(ctx).Infof("Formation with id %q is in %q state. Formation assignments for object with ID %q will be generated when the formation is finalized", formation.ID, model.DraftFormationState, objectID)
+			return false, nil
 		}
 
-		requests, err := s.notificationsService.GenerateFormationAssignmentNotifications(ctx, tnt, objectID, formationFromDB, model.AssignFormation, objectType)
+		assignments, err := s.formationAssignmentService.GenerateAssignments(ctx, tnt, objectID, objectType, formation)
 		if err != nil {
-			return nil, errors.Wrapf(err, "while generating notifications for %s assignment", objectType)
+			return false, err
 		}
 
-		if err = s.formationAssignmentService.ProcessFormationAssignments(ctx, assignments, rtmContextIDsMapping, applicationIDToApplicationTemplateIDMapping, requests, s.formationAssignmentService.ProcessFormationAssignmentPair, model.AssignFormation); err != nil {
-			log.C(ctx).Errorf("Error occurred while processing formationAssignments %s", err.Error())
-			return nil, err
+		// When it is in initial state, the notification generation will be handled by the async API via resynchronizing the formation later
+		// If we are in create error state, the formation is not ready, and we should not send notifications
+		if formation.State == model.InitialFormationState || formation.State == model.CreateErrorFormationState {
+			log.C(ctx).Infof("Formation with id %q is not in %q state. Waiting for response on status API before sending notifications...", formation.ID, model.ReadyFormationState)
+			return false, nil
+		}
+
+		if err = s.processAssignments(ctx, tnt, objectID, objectType, formation, assignments); err != nil {
+			return false, err
 		}
 
 	case graphql.FormationObjectTypeTenant:
 		targetTenantID, err := s.tenantSvc.GetInternalTenant(ctx, objectID)
 		if err != nil {
-			return nil, err
+			return false, err
 		}
 
-		if _, err = s.CreateAutomaticScenarioAssignment(ctx, newAutomaticScenarioAssignmentModel(formationFromDB.Name, tnt, targetTenantID)); err != nil {
-			return nil, err
+		if _, err = s.CreateAutomaticScenarioAssignment(ctx, newAutomaticScenarioAssignmentModel(formation.Name, tnt, targetTenantID)); err != nil {
+			return false, err
 		}
 
 	default:
-		return nil, fmt.Errorf("unknown formation type %s", objectType)
+		return false, fmt.Errorf("unknown formation type %s", objectType)
 	}
 
-	if err = s.constraintEngine.EnforceConstraints(ctx, formationconstraint.PostAssign, joinPointDetails, ft.formationTemplate.ID); err != nil {
-		return nil, errors.Wrapf(err, "while enforcing constraints for target operation %q and constraint type %q", model.AssignFormationOperation, model.PostOperation)
+	return true, nil
+}
+
+// processAssignments sends the notifications for the formation assignments generated for the assigned object and updates the assignments based on the responses
+func (s *service) processAssignments(ctx context.Context, tnt, objectID string, objectType graphql.FormationObjectType, formation *model.Formation, assignments []*model.FormationAssignment) error {
+	rtmContextIDsMapping, err := s.getRuntimeContextIDToRuntimeIDMapping(ctx, tnt, assignments)
+	if err != nil {
+		return err
 	}
 
-	return formationFromDB, nil
+	applicationIDToApplicationTemplateIDMapping, err := s.getApplicationIDToApplicationTemplateIDMapping(ctx, tnt, assignments)
+	if err != nil {
+		return err
+	}
+
+	requests, err := s.notificationsService.GenerateFormationAssignmentNotifications(ctx, tnt, objectID, formation, model.AssignFormation, objectType)
+	if err != nil {
+		return errors.Wrapf(err, "while generating notifications for %s assignment", objectType)
+	}
+
+	if err = s.formationAssignmentService.ProcessFormationAssignments(ctx, assignments, rtmContextIDsMapping, applicationIDToApplicationTemplateIDMapping, requests, s.formationAssignmentService.ProcessFormationAssignmentPair, model.AssignFormation); err != nil {
+		log.C(ctx).Errorf("Error occurred while processing formationAssignments %s", err.Error())
+		return err
+	}
+
+	return nil
 }
 
 func (s *service) prepareDetailsForAssign(ctx context.Context, tnt, objectID string, objectType graphql.FormationObjectType, formation *model.Formation, formationTemplate *model.FormationTemplate) (*formationconstraint.AssignFormationOperationDetails, error) {
@@ -790,6 +1002,10 @@ func (s *service) UnassignFormation(ctx context.Context, tnt, objectID string, o
 		return nil, errors.Wrapf(err, "while unassigning formation with name %q", formationName)
 	}
 
+	if ft.formation, err = s.lockDraftFormation(ctx, tnt, ft.formation); err != nil {
+		return nil, errors.Wrapf(err, "while unassigning formation with name %q", formationName)
+	}
+
 	joinPointDetails, err := s.prepareDetailsForUnassign(ctx, tnt, objectID, objectType, ft.formation, ft.formationTemplate)
 	if err != nil {
 		return nil, errors.Wrapf(err, "while preparing joinpoint details for target operation %q and constraint type %q", model.UnassignFormationOperation, model.PreOperation)
@@ -980,6 +1196,9 @@ func (s *service) ResynchronizeFormationNotifications(ctx context.Context, forma
 	if err != nil {
 		return nil, errors.Wrapf(err, "while getting formation with ID %q for tenant %q", tenantID, formationID)
 	}
+	if formation.State == model.DraftFormationState {
+		return nil, apperrors.NewInvalidOperationError(fmt.Sprintf("formation %q is in %q state and should be finalized instead", formation.Name, model.DraftFormationState))
+	}
 	if shouldReset {
 		formationTemplate, err := s.formationTemplateRepository.Get(ctx, formation.FormationTemplateID)
 		if err != nil {
@@ -992,10 +1211,11 @@ func (s *service) ResynchronizeFormationNotifications(ctx context.Context, forma
 		if err != nil {
 			return nil, errors.Wrapf(err, "while getting formation assignments for formation with ID %q", formationID)
 		}
+		resetCtx := formationassignment.SaveTransitionActorToContext(ctx, model.ResetTransitionActor)
 		for _, assignment := range assignmentsForFormation {
 			assignment.State = string(model.InitialAssignmentState)
 			formationassignment.ResetAssignmentConfigAndError(assignment) // reset the assignments
-			err = s.formationAssignmentService.Update(ctx, assignment.ID, assignment)
+			err = s.formationAssignmentService.Update(resetCtx, assignment.ID, assignment)
 			if err != nil {
 				return nil, err
 			}
@@ -1023,6 +1243,126 @@ func (s *service) ResynchronizeFormationNotifications(ctx context.Context, forma
 	return s.resynchronizeFormationAssignmentNotifications(ctx, tenantID, formation)
 }
 
+// FinalizeFormation moves a formation out of DRAFT state:
+//   - Generates the formationAssignments between all objects that were assigned to the formation while it was in DRAFT state
+//   - Sends the formation lifecycle notifications if the formation template has webhooks attached
+//   - Sends the notifications for all generated formationAssignments in a single pass and enforces the "post" assign constraints for the assigned objects.
+//     If the formation is waiting for an asynchronous formation lifecycle notification, this is done when the status is reported on the status API
+func (s *service) FinalizeFormation(ctx context.Context, formationID string) (*model.Formation, error) {
+	log.C(ctx).Infof("Finalizing formation with ID: %q", formationID)
+	tenantID, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while loading tenant from context")
+	}
+
+	// The formation is locked so that objects can not be assigned to or unassigned from it while the participants are being processed
+	formation, err := s.formationRepository.GetForUpdate(ctx, formationID, tenantID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while getting formation with ID %q for tenant %q", formationID, tenantID)
+	}
+	if formation.State != model.DraftFormationState {
+		return nil, apperrors.NewInvalidOperationError(fmt.Sprintf("formation %q is in %q state and only formations in %q state can be finalized", formation.Name, formation.State, model.DraftFormationState))
+	}
+
+	formationTemplate, err := s.formationTemplateRepository.Get(ctx, formation.FormationTemplateID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while getting formation template with ID %q", formation.FormationTemplateID)
+	}
+
+	participants, err := s.listFormationParticipants(ctx, tenantID, formation.Name)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while listing the participants in formation with name %q", formation.Name)
+	}
+
+	for _, participant := range participants {
+		if _, err = s.formationAssignmentService.GenerateAssignments(ctx, tenantID, participant.objectID, participant.objectType, formation); err != nil {
+			return nil, errors.Wrapf(err, "while generating formation assignments for %s with ID %q", participant.objectType, participant.objectID)
+		}
+	}
+
+	formationTemplateWebhooks, err := s.webhookRepository.ListByReferenceObjectIDGlobal(ctx, formationTemplate.ID, model.FormationTemplateWebhookReference)
+	if err != nil {
+		return nil, errors.Wrapf(err, "when listing formation lifecycle webhooks for formation template with ID: %q", formationTemplate.ID)
+	}
+
+	formation.State = determineFormationState(ctx, formationTemplate.ID, formationTemplate.Name, formationTemplateWebhooks, "")
+	if err = s.formationRepository.Update(ctx, formation); err != nil {
+		return nil, errors.Wrapf(err, "while updating formation with ID: %q to: %q state", formation.ID, formation.State)
+	}
+
+	formationReqs, err := s.notificationsService.GenerateFormationNotifications(ctx, formationTemplateWebhooks, tenantID, formation, formationTemplate.Name, formationTemplate.ID, model.CreateFormation)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while generating notifications for formation with ID: %q and name: %q", formation.ID, formation.Name)
+	}
+
+	for _, formationReq := range formationReqs {
+		if err = s.processFormationNotifications(ctx, formation, formationReq, model.CreateErrorFormationState); err != nil {
+			processErr := errors.Wrapf(err, "while processing notifications for formation with ID: %q and name: %q", formation.ID, formation.Name)
+			log.C(ctx).Error(processErr)
+			return nil, processErr
+		}
+	}
+
+	if formation.State != model.ReadyFormationState {
+		log.C(ctx).Infof("Formation with id %q is not in %q state. Waiting for response on status API before sending notifications...", formation.ID, model.ReadyFormationState)
+		return formation, nil
+	}
+
+	if _, err = s.resynchronizeFormationAssignmentNotifications(ctx, tenantID, formation); err != nil {
+		return nil, errors.Wrapf(err, "while sending formation assignment notifications for formation with ID %q", formation.ID)
+	}
+
+	for _, participant := range participants {
+		joinPointDetails, err := s.prepareDetailsForAssign(ctx, tenantID, participant.objectID, participant.objectType, formation, formationTemplate)
+		if err != nil {
+			return nil, errors.Wrapf(err, "while preparing joinpoint details for target operation %q and constraint type %q", model.AssignFormationOperation, model.PostOperation)
+		}
+
+		if err = s.constraintEngine.EnforceConstraints(ctx, formationconstraint.PostAssign, joinPointDetails, formationTemplate.ID); err != nil {
+			return nil, errors.Wrapf(err, "while enforcing constraints for target operation %q and constraint type %q", model.AssignFormationOperation, model.PostOperation)
+		}
+	}
+
+	return formation, nil
+}
+
+type formationParticipant struct {
+	objectID   string
+	objectType graphql.FormationObjectType
+}
+
+// listFormationParticipants returns the objects that have the formation in their scenario label.
+// Runtimes are listed before runtime contexts, so that no formation assignments are generated between a runtime context and its parent runtime.
+func (s *service) listFormationParticipants(ctx context.Context, tnt, formationName string) ([]formationParticipant, error) {
+	applications, err := s.applicationRepository.ListByScenariosNoPaging(ctx, tnt, []string{formationName})
+	if err != nil {
+		return nil, err
+	}
+
+	runtimes, err := s.runtimeRepo.ListByScenarios(ctx, tnt, []string{formationName})
+	if err != nil {
+		return nil, err
+	}
+
+	runtimeContexts, err := s.runtimeContextRepo.ListByScenarios(ctx, tnt, []string{formationName})
+	if err != nil {
+		return nil, err
+	}
+
+	participants := make([]formationParticipant, 0, len(applications)+len(runtimes)+len(runtimeContexts))
+	for _, app := range applications {
+		participants = append(participants, formationParticipant{objectID: app.ID, objectType: graphql.FormationObjectTypeApplication})
+	}
+	for _, rt := range runtimes {
+		participants = append(participants, formationParticipant{objectID: rt.ID, objectType: graphql.FormationObjectTypeRuntime})
+	}
+	for _, rtCtx := range runtimeContexts {
+		participants = append(participants, formationParticipant{objectID: rtCtx.ID, objectType: graphql.FormationObjectTypeRuntimeContext})
+	}
+
+	return participants, nil
+}
+
 func (s *service) resynchronizeFormationAssignmentNotifications(ctx context.Context, tenantID string, formation *model.Formation) (*model.Formation, error) {
 	formationID := formation.ID
 
@@ -1542,6 +1882,22 @@ func (s *service) getFormationWithTemplate(ctx context.Context, formationName, t
 	return &formationWithTemplate{formation: formation, formationTemplate: template}, nil
 }
 
+// lockDraftFormation locks the formation if it is in DRAFT state, so that the assignment or unassignment of an object
+// can not interleave with the finalization of the formation. It returns the formation read under the lock, as it may
+// have been finalized in the meantime.
+func (s *service) lockDraftFormation(ctx context.Context, tnt string, formation *model.Formation) (*model.Formation, error) {
+	if formation.State != model.DraftFormationState {
+		return formation, nil
+	}
+
+	lockedFormation, err := s.formationRepository.GetForUpdate(ctx, formation.ID, tnt)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while locking formation with ID %q", formation.ID)
+	}
+
+	return lockedFormation, nil
+}
+
 func (s *service) isValidRuntimeType(ctx context.Context, tnt string, runtimeID string, formationTemplate *model.FormationTemplate) error {
 	runtimeTypeLabel, err := s.labelService.GetLabel(ctx, tnt, &model.LabelInput{
 		Key:        s.runtimeTypeLabelKey,
@@ -1657,6 +2013,11 @@ func (s *service) processFormationNotifications(ctx context.Context, formation *
 }
 
 func determineFormationState(ctx context.Context, formationTemplateID, formationTemplateName string, formationTemplateWebhooks []*model.Webhook, externallyProvidedFormationState model.FormationState) model.FormationState {
+	if externallyProvidedFormationState == model.DraftFormationState {
+		log.C(ctx).Infof("The formation will be created with %s state. No notifications will be sent until it is finalized", model.DraftFormationState)
+		return model.DraftFormationState
+	}
+
 	if len(formationTemplateWebhooks) == 0 {
 		if len(externallyProvidedFormationState) > 0 {
 			log.C(ctx).Infof("Formation template with ID: %q and name: %q does not have any webhooks. The formation will be created with %s state as it was provided externally", formationTemplateID, formationTemplateName, externallyProvidedFormationState)
@@ -521,6 +521,53 @@ func TestServiceCreateFormation(t *testing.T) {
 			TemplateName:      testFormationTemplateName,
 			ExpectedFormation: expectedFormationInInitialState,
 		},
+		{
+			Name: "success for draft formation without sending formation notifications",
+			UUIDServiceFn: func() *automock.UuidService {
+				uuidService := &automock.UuidService{}
+				uuidService.On("Generate").Return(fixUUID())
+				return uuidService
+			},
+			LabelDefRepositoryFn: func() *automock.LabelDefRepository {
+				labelDefRepo := &automock.LabelDefRepository{}
+				labelDefRepo.On("GetByKey", ctx, TntInternalID, model.ScenariosKey).Return(&testSchemaLblDef, nil)
+				labelDefRepo.On("UpdateWithVersion", ctx, newSchemaLblDef).Return(nil)
+				return labelDefRepo
+			},
+			LabelDefServiceFn: func() *automock.LabelDefService {
+				labelDefService := &automock.LabelDefService{}
+				labelDefService.On("ValidateExistingLabelsAgainstSchema", ctx, newSchema, TntInternalID, model.ScenariosKey).Return(nil)
+				labelDefService.On("ValidateAutomaticScenarioAssignmentAgainstSchema", ctx, newSchema, TntInternalID, model.ScenariosKey).Return(nil)
+				return labelDefService
+			},
+			FormationTemplateRepoFn: func() *automock.FormationTemplateRepository {
+				formationTemplateRepoMock := &automock.FormationTemplateRepository{}
+				formationTemplateRepoMock.On("GetByNameAndTenant", ctx, testFormationTemplateName, TntInternalID).Return(fixFormationTemplateModel(), nil).Once()
+				return formationTemplateRepoMock
+			},
+			FormationRepoFn: func() *automock.FormationRepository {
+				formationRepoMock := &automock.FormationRepository{}
+				formationRepoMock.On("Create", ctx, fixFormationModelWithState(model.DraftFormationState)).Return(nil).Once()
+				return formationRepoMock
+			},
+			ConstraintEngineFn: func() *automock.ConstraintEngine {
+				engine := &automock.ConstraintEngine{}
+				engine.On("EnforceConstraints", ctx, preCreateLocation, createFormationDetails, FormationTemplateID).Return(nil).Once()
+				engine.On("EnforceConstraints", ctx, postCreateLocation, createFormationDetails, FormationTemplateID).Return(nil).Once()
+				return engine
+			},
+			webhookRepoFn: func() *automock.WebhookRepository {
+				webhookRepo := &automock.WebhookRepository{}
+				webhookRepo.On("ListByReferenceObjectIDGlobal", ctx, FormationTemplateID, model.FormationTemplateWebhookReference).Return(formationLifecycleSyncWebhooks, nil).Once()
+				return webhookRepo
+			},
+			FormationInput: &model.Formation{
+				Name:  testFormationName,
+				State: model.DraftFormationState,
+			},
+			TemplateName:      testFormationTemplateName,
+			ExpectedFormation: fixFormationModelWithState(model.DraftFormationState),
+		},
 		{
 			Name: "error when labeldef is missing and can not create it",
 			LabelDefRepositoryFn: func() *automock.LabelDefRepository {
@@ -1416,6 +1463,40 @@ func TestServiceDeleteFormation(t *testing.T) {
 			InputFormation:    in,
 			ExpectedFormation: expectedFormation,
 		},
+		{
+			Name: "success for draft formation without sending formation notifications",
+			LabelDefRepositoryFn: func() *automock.LabelDefRepository {
+				labelDefRepo := &automock.LabelDefRepository{}
+				labelDefRepo.On("GetByKey", ctx, TntInternalID, model.ScenariosKey).Return(&testSchemaLblDef, nil)
+				labelDefRepo.On("UpdateWithVersion", ctx, newSchemaLblDef).Return(nil)
+				return labelDefRepo
+			},
+			LabelDefServiceFn: func() *automock.LabelDefService {
+				labelDefService := &automock.LabelDefService{}
+				labelDefService.On("ValidateExistingLabelsAgainstSchema", ctx, newSchema, TntInternalID, model.ScenariosKey).Return(nil)
+				labelDefService.On("ValidateAutomaticScenarioAssignmentAgainstSchema", ctx, newSchema, TntInternalID, model.ScenariosKey).Return(nil)
+				return labelDefService
+			},
+			FormationRepoFn: func() *automock.FormationRepository {
+				formationRepoMock := &automock.FormationRepository{}
+				formationRepoMock.On("DeleteByName", ctx, TntInternalID, testFormationName).Return(nil).Once()
+				formationRepoMock.On("GetByName", ctx, testFormationName, TntInternalID).Return(fixFormationModelWithState(model.DraftFormationState), nil).Once()
+				return formationRepoMock
+			},
+			FormationTemplateRepoFn: func() *automock.FormationTemplateRepository {
+				repo := &automock.FormationTemplateRepository{}
+				repo.On("Get", ctx, FormationTemplateID).Return(fixFormationTemplateModel(), nil).Once()
+				return repo
+			},
+			ConstraintEngineFn: func() *automock.ConstraintEngine {
+				engine := &automock.ConstraintEngine{}
+				engine.On("EnforceConstraints", ctx, preDeleteLocation, deleteFormationDetails, FormationTemplateID).Return(nil).Once()
+				engine.On("EnforceConstraints", ctx, postDeleteLocation, deleteFormationDetails, FormationTemplateID).Return(nil).Once()
+				return engine
+			},
+			InputFormation:    in,
+			ExpectedFormation: fixFormationModelWithState(model.DraftFormationState),
+		},
 		{
 			Name: "success when formation has async webhook",
 			NotificationsSvcFn: func() *automock.NotificationsService {
@@ -2586,6 +2667,7 @@ func TestService_GetFormationsForObject(t *testing.T) {
 func TestServiceResynchronizeFormationNotifications(t *testing.T) {
 	ctx := context.TODO()
 	ctx = tenant.SaveToContext(ctx, TntInternalID, TntExternalID)
+	resetCtx := formationassignment.SaveTransitionActorToContext(ctx, model.ResetTransitionActor)
 
 	allStates := []string{string(model.InitialAssignmentState),
 		string(model.DeletingAssignmentState),
@@ -3232,10 +3314,10 @@ func TestServiceResynchronizeFormationNotifications(t *testing.T) {
 				svc := &automock.FormationAssignmentService{}
 				svc.On("GetAssignmentsForFormationWithStates", ctx, TntInternalID, FormationID, allStates).Return(formationAssignmentsInInitialState, nil).Once()
 				svc.On("GetAssignmentsForFormation", ctx, TntInternalID, FormationID).Return(cloneFormationAssignments(formationAssignments), nil).Once()
-				svc.On("Update", ctx, formationAssignments[0].ID, formationAssignmentsInInitialState[0]).Return(nil).Once()
-				svc.On("Update", ctx, formationAssignments[1].ID, formationAssignmentsInInitialState[1]).Return(nil).Once()
-				svc.On("Update", ctx, formationAssignments[2].ID, formationAssignmentsInInitialState[2]).Return(nil).Once()
-				svc.On("Update", ctx, formationAssignments[3].ID, formationAssignmentsInInitialState[3]).Return(nil).Once()
+				svc.On("Update", resetCtx, formationAssignments[0].ID, formationAssignmentsInInitialState[0]).Return(nil).Once()
+				svc.On("Update", resetCtx, formationAssignments[1].ID, formationAssignmentsInInitialState[1]).Return(nil).Once()
+				svc.On("Update", resetCtx, formationAssignments[2].ID, formationAssignmentsInInitialState[2]).Return(nil).Once()
+				svc.On("Update", resetCtx, formationAssignments[3].ID, formationAssignmentsInInitialState[3]).Return(nil).Once()
 
 				for _, fa := range formationAssignments {
 					svc.On("GetReverseBySourceAndTarget", ctx, FormationID, fa.Source, fa.Target).Return(nil, apperrors.NewNotFoundError(resource.FormationAssignment, "")).Once()
@@ -3273,7 +3355,7 @@ func TestServiceResynchronizeFormationNotifications(t *testing.T) {
 			FormationAssignmentServiceFn: func() *automock.FormationAssignmentService {
 				svc := &automock.FormationAssignmentService{}
 				svc.On("GetAssignmentsForFormation", ctx, TntInternalID, FormationID).Return(cloneFormationAssignments([]*model.FormationAssignment{formationAssignments[0]}), nil).Once()
-				svc.On("Update", ctx, formationAssignments[0].ID, formationAssignmentsInInitialState[0]).Return(testErr).Once()
+				svc.On("Update", resetCtx, formationAssignments[0].ID, formationAssignmentsInInitialState[0]).Return(testErr).Once()
 
 				return svc
 			},
@@ -3420,6 +3502,303 @@ func TestServiceResynchronizeFormationNotifications(t *testing.T) {
 	})
 }
 
+func TestServiceFinalizeFormation(t *testing.T) {
+	ctx := context.TODO()
+	ctx = tenant.SaveToContext(ctx, TntInternalID, TntExternalID)
+
+	resyncableStates := []string{string(model.InitialAssignmentState),
+		string(model.DeletingAssignmentState),
+		string(model.CreateErrorAssignmentState),
+		string(model.DeleteErrorAssignmentState)}
+
+	formationAssignment := fixFormationAssignmentModelWithParameters("id1", FormationID, ApplicationID, RuntimeID, model.FormationAssignmentTypeApplication, model.FormationAssignmentTypeRuntime, model.InitialFormationState)
+	notificationForAssignment := &webhookclient.FormationAssignmentNotificationRequest{
+		Webhook: graphql.Webhook{
+			ID: WebhookID,
+		},
+	}
+	formationAssignmentPair := fixFormationAssignmentPairWithNoReverseAssignment(notificationForAssignment, formationAssignment)
+
+	applicationTypeLblInput := &model.LabelInput{
+		Key:        applicationType,
+		ObjectID:   ApplicationID,
+		ObjectType: model.ApplicationLabelableObject,
+	}
+	applicationTypeLbl := &model.Label{
+		Key:        applicationType,
+		Value:      applicationType,
+		ObjectID:   ApplicationID,
+		ObjectType: model.ApplicationLabelableObject,
+	}
+
+	participantsFn := func() (*automock.ApplicationRepository, *automock.RuntimeRepository, *automock.RuntimeContextRepository) {
+		appRepo := &automock.ApplicationRepository{}
+		appRepo.On("ListByScenariosNoPaging", ctx, TntInternalID, []string{testFormationName}).Return([]*model.Application{fixApplicationModel(ApplicationID)}, nil).Once()
+		runtimeRepo := &automock.RuntimeRepository{}
+		runtimeRepo.On("ListByScenarios", ctx, TntInternalID, []string{testFormationName}).Return([]*model.Runtime{}, nil).Once()
+		runtimeContextRepo := &automock.RuntimeContextRepository{}
+		runtimeContextRepo.On("ListByScenarios", ctx, TntInternalID, []string{testFormationName}).Return([]*model.RuntimeContext{}, nil).Once()
+		return appRepo, runtimeRepo, runtimeContextRepo
+	}
+
+	testCases := []struct {
+		Name                                     string
+		ParticipantsFn                           func() (*automock.ApplicationRepository, *automock.RuntimeRepository, *automock.RuntimeContextRepository)
+		LabelServiceFn                           func() *automock.LabelService
+		FormationRepositoryFn                    func() *automock.FormationRepository
+		FormationTemplateRepositoryFn            func() *automock.FormationTemplateRepository
+		FormationAssignmentServiceFn             func() *automock.FormationAssignmentService
+		FormationAssignmentNotificationServiceFn func() *automock.FormationAssignmentNotificationsService
+		NotificationServiceFn                    func() *automock.NotificationsService
+		WebhookRepoFn                            func() *automock.WebhookRepository
+		ConstraintEngineFn                       func() *automock.ConstraintEngine
+		ExpectedFormation                        *model.Formation
+		ExpectedErrMessage                       string
+	}{
+		{
+			Name:           "success when there are no formation lifecycle webhooks",
+			ParticipantsFn: participantsFn,
+			LabelServiceFn: func() *automock.LabelService {
+				labelService := &automock.LabelService{}
+				labelService.On("GetLabel", ctx, TntInternalID, applicationTypeLblInput).Return(applicationTypeLbl, nil).Once()
+				return labelService
+			},
+			FormationRepositoryFn: func() *automock.FormationRepository {
+				repo := &automock.FormationRepository{}
+				repo.On("GetForUpdate", ctx, FormationID, TntInternalID).Return(fixFormationModelWithState(model.DraftFormationState), nil).Once()
+				repo.On("Update", ctx, fixFormationModelWithState(model.ReadyFormationState)).Return(nil).Once()
+				return repo
+			},
+			FormationTemplateRepositoryFn: func() *automock.FormationTemplateRepository {
+				repo := &automock.FormationTemplateRepository{}
+				repo.On("Get", ctx, FormationTemplateID).Return(fixFormationTemplateModel(), nil).Once()
+				return repo
+			},
+			FormationAssignmentServiceFn: func() *automock.FormationAssignmentService {
+				svc := &automock.FormationAssignmentService{}
+				svc.On("GenerateAssignments", ctx, TntInternalID, ApplicationID, graphql.FormationObjectTypeApplication, fixFormationModelWithState(model.DraftFormationState)).Return([]*model.FormationAssignment{formationAssignment}, nil).Once()
+				svc.On("GetAssignmentsForFormationWithStates", ctx, TntInternalID, FormationID, resyncableStates).Return([]*model.FormationAssignment{formationAssignment}, nil).Once()
+				svc.On("GetReverseBySourceAndTarget", ctx, FormationID, ApplicationID, RuntimeID).Return(nil, apperrors.NewNotFoundError(resource.FormationAssignment, "")).Once()
+				svc.On("ProcessFormationAssignmentPair", ctx, formationAssignmentPair).Return(false, nil).Once()
+				return svc
+			},
+			FormationAssignmentNotificationServiceFn: func() *automock.FormationAssignmentNotificationsService {
+				svc := &automock.FormationAssignmentNotificationsService{}
+				svc.On("GenerateFormationAssignmentNotification", ctx, formationAssignment, model.AssignFormation).Return(notificationForAssignment, nil).Once()
+				return svc
+			},
+			NotificationServiceFn: func() *automock.NotificationsService {
+				svc := &automock.NotificationsService{}
+				svc.On("GenerateFormationNotifications", ctx, emptyFormationLifecycleWebhooks, TntInternalID, fixFormationModelWithState(model.ReadyFormationState), testFormationTemplateName, FormationTemplateID, model.CreateFormation).Return(emptyFormationNotificationRequests, nil).Once()
+				return svc
+			},
+			WebhookRepoFn: func() *automock.WebhookRepository {
+				repo := &automock.WebhookRepository{}
+				repo.On("ListByReferenceObjectIDGlobal", ctx, FormationTemplateID, model.FormationTemplateWebhookReference).Return(emptyFormationLifecycleWebhooks, nil).Once()
+				return repo
+			},
+			ConstraintEngineFn: func() *automock.ConstraintEngine {
+				engine := &automock.ConstraintEngine{}
+				engine.On("EnforceConstraints", ctx, postAssignLocation, fixAssignAppDetails(testFormationName), FormationTemplateID).Return(nil).Once()
+				return engine
+			},
+			ExpectedFormation: fixFormationModelWithState(model.ReadyFormationState),
+		},
+		{
+			Name:           "success when formation lifecycle webhook is async and formation stays in initial state",
+			ParticipantsFn: participantsFn,
+			FormationRepositoryFn: func() *automock.FormationRepository {
+				repo := &automock.FormationRepository{}
+				repo.On("GetForUpdate", ctx, FormationID, TntInternalID).Return(fixFormationModelWithState(model.DraftFormationState), nil).Once()
+				repo.On("Update", ctx, fixFormationModelWithState(model.InitialFormationState)).Return(nil).Twice()
+				return repo
+			},
+			FormationTemplateRepositoryFn: func() *automock.FormationTemplateRepository {
+				repo := &automock.FormationTemplateRepository{}
+				repo.On("Get", ctx, FormationTemplateID).Return(fixFormationTemplateModel(), nil).Once()
+				return repo
+			},
+			FormationAssignmentServiceFn: func() *automock.FormationAssignmentService {
+				svc := &automock.FormationAssignmentService{}
+				svc.On("GenerateAssignments", ctx, TntInternalID, ApplicationID, graphql.FormationObjectTypeApplication, fixFormationModelWithState(model.DraftFormationState)).Return([]*model.FormationAssignment{formationAssignment}, nil).Once()
+				return svc
+			},
+			NotificationServiceFn: func() *automock.NotificationsService {
+				svc := &automock.NotificationsService{}
+				svc.On("GenerateFormationNotifications", ctx, formationLifecycleAsyncWebhooks, TntInternalID, fixFormationModelWithState(model.InitialFormationState), testFormationTemplateName, FormationTemplateID, model.CreateFormation).Return(formationNotificationAsyncCreateRequests, nil).Once()
+				svc.On("SendNotification", ctx, formationNotificationAsyncCreateRequest).Return(formationNotificationWebhookSuccessResponse, nil).Once()
+				return svc
+			},
+			WebhookRepoFn: func() *automock.WebhookRepository {
+				repo := &automock.WebhookRepository{}
+				repo.On("ListByReferenceObjectIDGlobal", ctx, FormationTemplateID, model.FormationTemplateWebhookReference).Return(formationLifecycleAsyncWebhooks, nil).Once()
+				return repo
+			},
+			ExpectedFormation: fixFormationModelWithState(model.InitialFormationState),
+		},
+		{
+			Name: "error when formation is not in draft state",
+			FormationRepositoryFn: func() *automock.FormationRepository {
+				repo := &automock.FormationRepository{}
+				repo.On("GetForUpdate", ctx, FormationID, TntInternalID).Return(fixFormationModelWithState(model.ReadyFormationState), nil).Once()
+				return repo
+			},
+			ExpectedErrMessage: "only formations in \"DRAFT\" state can be finalized",
+		},
+		{
+			Name: "error when getting formation fails",
+			FormationRepositoryFn: func() *automock.FormationRepository {
+				repo := &automock.FormationRepository{}
+				repo.On("GetForUpdate", ctx, FormationID, TntInternalID).Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedErrMessage: testErr.Error(),
+		},
+		{
+			Name: "error when getting formation template fails",
+			FormationRepositoryFn: func() *automock.FormationRepository {
+				repo := &automock.FormationRepository{}
+				repo.On("GetForUpdate", ctx, FormationID, TntInternalID).Return(fixFormationModelWithState(model.DraftFormationState), nil).Once()
+				return repo
+			},
+			FormationTemplateRepositoryFn: func() *automock.FormationTemplateRepository {
+				repo := &automock.FormationTemplateRepository{}
+				repo.On("Get", ctx, FormationTemplateID).Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedErrMessage: testErr.Error(),
+		},
+		{
+			Name: "error when listing formation participants fails",
+			ParticipantsFn: func() (*automock.ApplicationRepository, *automock.RuntimeRepository, *automock.RuntimeContextRepository) {
+				appRepo := &automock.ApplicationRepository{}
+				appRepo.On("ListByScenariosNoPaging", ctx, TntInternalID, []string{testFormationName}).Return(nil, testErr).Once()
+				return appRepo, unusedRuntimeRepo(), unusedRuntimeContextRepo()
+			},
+			FormationRepositoryFn: func() *automock.FormationRepository {
+				repo := &automock.FormationRepository{}
+				repo.On("GetForUpdate", ctx, FormationID, TntInternalID).Return(fixFormationModelWithState(model.DraftFormationState), nil).Once()
+				return repo
+			},
+			FormationTemplateRepositoryFn: func() *automock.FormationTemplateRepository {
+				repo := &automock.FormationTemplateRepository{}
+				repo.On("Get", ctx, FormationTemplateID).Return(fixFormationTemplateModel(), nil).Once()
+				return repo
+			},
+			ExpectedErrMessage: testErr.Error(),
+		},
+		{
+			Name:           "error when generating formation assignments fails",
+			ParticipantsFn: participantsFn,
+			FormationRepositoryFn: func() *automock.FormationRepository {
+				repo := &automock.FormationRepository{}
+				repo.On("GetForUpdate", ctx, FormationID, TntInternalID).Return(fixFormationModelWithState(model.DraftFormationState), nil).Once()
+				return repo
+			},
+			FormationTemplateRepositoryFn: func() *automock.FormationTemplateRepository {
+				repo := &automock.FormationTemplateRepository{}
+				repo.On("Get", ctx, FormationTemplateID).Return(fixFormationTemplateModel(), nil).Once()
+				return repo
+			},
+			FormationAssignmentServiceFn: func() *automock.FormationAssignmentService {
+				svc := &automock.FormationAssignmentService{}
+				svc.On("GenerateAssignments", ctx, TntInternalID, ApplicationID, graphql.FormationObjectTypeApplication, fixFormationModelWithState(model.DraftFormationState)).Return(nil, testErr).Once()
+				return svc
+			},
+			ExpectedErrMessage: testErr.Error(),
+		},
+		{
+			Name:           "error when updating formation fails",
+			ParticipantsFn: participantsFn,
+			FormationRepositoryFn: func() *automock.FormationRepository {
+				repo := &automock.FormationRepository{}
+				repo.On("GetForUpdate", ctx, FormationID, TntInternalID).Return(fixFormationModelWithState(model.DraftFormationState), nil).Once()
+				repo.On("Update", ctx, fixFormationModelWithState(model.ReadyFormationState)).Return(testErr).Once()
+				return repo
+			},
+			FormationTemplateRepositoryFn: func() *automock.FormationTemplateRepository {
+				repo := &automock.FormationTemplateRepository{}
+				repo.On("Get", ctx, FormationTemplateID).Return(fixFormationTemplateModel(), nil).Once()
+				return repo
+			},
+			FormationAssignmentServiceFn: func() *automock.FormationAssignmentService {
+				svc := &automock.FormationAssignmentService{}
+				svc.On("GenerateAssignments", ctx, TntInternalID, ApplicationID, graphql.FormationObjectTypeApplication, fixFormationModelWithState(model.DraftFormationState)).Return([]*model.FormationAssignment{formationAssignment}, nil).Once()
+				return svc
+			},
+			WebhookRepoFn: func() *automock.WebhookRepository {
+				repo := &automock.WebhookRepository{}
+				repo.On("ListByReferenceObjectIDGlobal", ctx, FormationTemplateID, model.FormationTemplateWebhookReference).Return(emptyFormationLifecycleWebhooks, nil).Once()
+				return repo
+			},
+			ExpectedErrMessage: testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			appRepo, runtimeRepo, runtimeContextRepo := unusedApplicationRepository(), unusedRuntimeRepo(), unusedRuntimeContextRepo()
+			if testCase.ParticipantsFn != nil {
+				appRepo, runtimeRepo, runtimeContextRepo = testCase.ParticipantsFn()
+			}
+			labelService := unusedLabelService()
+			if testCase.LabelServiceFn != nil {
+				labelService = testCase.LabelServiceFn()
+			}
+			formationRepo := unusedFormationRepo()
+			if testCase.FormationRepositoryFn != nil {
+				formationRepo = testCase.FormationRepositoryFn()
+			}
+			formationTemplateRepo := unusedFormationTemplateRepo()
+			if testCase.FormationTemplateRepositoryFn != nil {
+				formationTemplateRepo = testCase.FormationTemplateRepositoryFn()
+			}
+			formationAssignmentSvc := unusedFormationAssignmentService()
+			if testCase.FormationAssignmentServiceFn != nil {
+				formationAssignmentSvc = testCase.FormationAssignmentServiceFn()
+			}
+			formationAssignmentNotificationService := unusedFormationAssignmentNotificationService()
+			if testCase.FormationAssignmentNotificationServiceFn != nil {
+				formationAssignmentNotificationService = testCase.FormationAssignmentNotificationServiceFn()
+			}
+			notificationsSvc := unusedNotificationsService()
+			if testCase.NotificationServiceFn != nil {
+				notificationsSvc = testCase.NotificationServiceFn()
+			}
+			webhookRepo := unusedWebhookRepository()
+			if testCase.WebhookRepoFn != nil {
+				webhookRepo = testCase.WebhookRepoFn()
+			}
+			constraintEngine := unusedConstraintEngine()
+			if testCase.ConstraintEngineFn != nil {
+				constraintEngine = testCase.ConstraintEngineFn()
+			}
+
+			svc := formation.NewServiceWithAsaEngine(nil, appRepo, nil, nil, formationRepo, formationTemplateRepo, labelService, nil, nil, nil, nil, nil, runtimeRepo, runtimeContextRepo, formationAssignmentSvc, webhookRepo, formationAssignmentNotificationService, notificationsSvc, constraintEngine, runtimeType, applicationType, nil, nil)
+
+			// WHEN
+			actual, err := svc.FinalizeFormation(ctx, FormationID)
+
+			// THEN
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+				require.Equal(t, testCase.ExpectedFormation, actual)
+			} else {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+				require.Nil(t, actual)
+			}
+			mock.AssertExpectationsForObjects(t, appRepo, runtimeRepo, runtimeContextRepo, labelService, formationRepo, formationTemplateRepo, formationAssignmentSvc, formationAssignmentNotificationService, notificationsSvc, webhookRepo, constraintEngine)
+		})
+	}
+	t.Run("returns error when empty tenant", func(t *testing.T) {
+		svc := formation.NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, runtimeType, applicationType)
+		_, err := svc.FinalizeFormation(context.TODO(), FormationID)
+		require.Contains(t, err.Error(), "cannot read tenant from context")
+	})
+}
+
 func cloneFormationAssignments(assignments []*model.FormationAssignment) []*model.FormationAssignment {
 	clonedAssignments := make([]*model.FormationAssignment, 0, len(assignments))
 	for _, assignment := range assignments {
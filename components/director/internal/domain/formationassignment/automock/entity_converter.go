@@ -46,6 +46,38 @@ func (_m *EntityConverter) ToEntity(in *model.FormationAssignment) *formationass
 	return r0
 }
 
+// TransitionFromEntity provides a mock function with given fields: e
+func (_m *EntityConverter) TransitionFromEntity(e *formationassignment.TransitionEntity) *model.FormationAssignmentTransition {
+	ret := _m.Called(e)
+
+	var r0 *model.FormationAssignmentTransition
+	if rf, ok := ret.Get(0).(func(*formationassignment.TransitionEntity) *model.FormationAssignmentTransition); ok {
+		r0 = rf(e)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.FormationAssignmentTransition)
+		}
+	}
+
+	return r0
+}
+
+// TransitionToEntity provides a mock function with given fields: in
+func (_m *EntityConverter) TransitionToEntity(in *model.FormationAssignmentTransition) *formationassignment.TransitionEntity {
+	ret := _m.Called(in)
+
+	var r0 *formationassignment.TransitionEntity
+	if rf, ok := ret.Get(0).(func(*model.FormationAssignmentTransition) *formationassignment.TransitionEntity); ok {
+		r0 = rf(in)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*formationassignment.TransitionEntity)
+		}
+	}
+
+	return r0
+}
+
 type mockConstructorTestingTNewEntityConverter interface {
 	mock.TestingT
 	Cleanup(func())
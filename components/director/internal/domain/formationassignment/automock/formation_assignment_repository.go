@@ -29,6 +29,20 @@ func (_m *FormationAssignmentRepository) Create(ctx context.Context, item *model
 	return r0
 }
 
+// CreateTransition provides a mock function with given fields: ctx, item
+func (_m *FormationAssignmentRepository) CreateTransition(ctx context.Context, item *model.FormationAssignmentTransition) error {
+	ret := _m.Called(ctx, item)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.FormationAssignmentTransition) error); ok {
+		r0 = rf(ctx, item)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Delete provides a mock function with given fields: ctx, id, tenantID
 func (_m *FormationAssignmentRepository) Delete(ctx context.Context, id string, tenantID string) error {
 	ret := _m.Called(ctx, id, tenantID)
@@ -400,6 +414,29 @@ func (_m *FormationAssignmentRepository) ListForIDs(ctx context.Context, tenant
 	return r0, r1
 }
 
+// ListTransitions provides a mock function with given fields: ctx, tenantID, formationAssignmentID
+func (_m *FormationAssignmentRepository) ListTransitions(ctx context.Context, tenantID string, formationAssignmentID string) ([]*model.FormationAssignmentTransition, error) {
+	ret := _m.Called(ctx, tenantID, formationAssignmentID)
+
+	var r0 []*model.FormationAssignmentTransition
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []*model.FormationAssignmentTransition); ok {
+		r0 = rf(ctx, tenantID, formationAssignmentID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.FormationAssignmentTransition)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, tenantID, formationAssignmentID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Update provides a mock function with given fields: ctx, _a1
 func (_m *FormationAssignmentRepository) Update(ctx context.Context, _a1 *model.FormationAssignment) error {
 	ret := _m.Called(ctx, _a1)
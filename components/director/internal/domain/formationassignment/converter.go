@@ -160,3 +160,69 @@ func (c *converter) FromEntity(e *Entity) *model.FormationAssignment {
 		Error:       repo.JSONRawMessageFromNullableString(e.Error),
 	}
 }
+
+// TransitionsToGraphQL converts multiple internal formation assignment transition models to GraphQL models
+func (c *converter) TransitionsToGraphQL(in []*model.FormationAssignmentTransition) []*graphql.FormationAssignmentTransition {
+	transitions := make([]*graphql.FormationAssignmentTransition, 0, len(in))
+	for _, t := range in {
+		if t == nil {
+			continue
+		}
+
+		transitions = append(transitions, &graphql.FormationAssignmentTransition{
+			OldState:   t.OldState,
+			NewState:   t.NewState,
+			Actor:      graphql.FormationAssignmentTransitionActor(t.Actor),
+			ConfigDiff: t.ConfigDiff,
+			Timestamp:  graphql.Timestamp(t.Timestamp),
+		})
+	}
+
+	return transitions
+}
+
+// TransitionToEntity converts from internal formation assignment transition model to entity
+func (c *converter) TransitionToEntity(in *model.FormationAssignmentTransition) *TransitionEntity {
+	if in == nil {
+		return nil
+	}
+
+	return &TransitionEntity{
+		ID:                    in.ID,
+		FormationAssignmentID: in.FormationAssignmentID,
+		FormationID:           in.FormationID,
+		TenantID:              in.TenantID,
+		Source:                in.Source,
+		SourceType:            string(in.SourceType),
+		Target:                in.Target,
+		TargetType:            string(in.TargetType),
+		OldState:              in.OldState,
+		NewState:              in.NewState,
+		Actor:                 string(in.Actor),
+		ConfigDiff:            repo.NewNullableString(in.ConfigDiff),
+		CreatedAt:             in.Timestamp,
+	}
+}
+
+// TransitionFromEntity converts from formation assignment transition entity to internal model
+func (c *converter) TransitionFromEntity(e *TransitionEntity) *model.FormationAssignmentTransition {
+	if e == nil {
+		return nil
+	}
+
+	return &model.FormationAssignmentTransition{
+		ID:                    e.ID,
+		FormationAssignmentID: e.FormationAssignmentID,
+		FormationID:           e.FormationID,
+		TenantID:              e.TenantID,
+		Source:                e.Source,
+		SourceType:            model.FormationAssignmentType(e.SourceType),
+		Target:                e.Target,
+		TargetType:            model.FormationAssignmentType(e.TargetType),
+		OldState:              e.OldState,
+		NewState:              e.NewState,
+		Actor:                 model.FormationAssignmentTransitionActor(e.Actor),
+		ConfigDiff:            repo.StringPtrFromNullableString(e.ConfigDiff),
+		Timestamp:             e.CreatedAt,
+	}
+}
@@ -277,3 +277,29 @@ func TestConverter_FromInput(t *testing.T) {
 		})
 	}
 }
+
+func TestConverter_TransitionsToGraphQL(t *testing.T) {
+	// WHEN
+	r := converter.TransitionsToGraphQL([]*model.FormationAssignmentTransition{fixFormationAssignmentTransitionModel(), nil})
+
+	// THEN
+	require.Equal(t, []*graphql.FormationAssignmentTransition{fixFormationAssignmentTransitionGQLModel()}, r)
+}
+
+func TestConverter_TransitionToEntity(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		require.Equal(t, fixFormationAssignmentTransitionEntity(), converter.TransitionToEntity(fixFormationAssignmentTransitionModel()))
+	})
+	t.Run("Returns nil when input is nil", func(t *testing.T) {
+		require.Nil(t, converter.TransitionToEntity(nil))
+	})
+}
+
+func TestConverter_TransitionFromEntity(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		require.Equal(t, fixFormationAssignmentTransitionModel(), converter.TransitionFromEntity(fixFormationAssignmentTransitionEntity()))
+	})
+	t.Run("Returns nil when input is nil", func(t *testing.T) {
+		require.Nil(t, converter.TransitionFromEntity(nil))
+	})
+}
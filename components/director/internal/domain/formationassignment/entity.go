@@ -1,6 +1,9 @@
 package formationassignment
 
-import "database/sql"
+import (
+	"database/sql"
+	"time"
+)
 
 // Entity represents the formation assignments entity
 type Entity struct {
@@ -23,3 +26,28 @@ type EntityCollection []*Entity
 func (s EntityCollection) Len() int {
 	return len(s)
 }
+
+// TransitionEntity represents the formation assignment transitions entity
+type TransitionEntity struct {
+	ID                    string         `db:"id"`
+	FormationAssignmentID string         `db:"formation_assignment_id"`
+	FormationID           string         `db:"formation_id"`
+	TenantID              string         `db:"tenant_id"`
+	Source                string         `db:"source"`
+	SourceType            string         `db:"source_type"`
+	Target                string         `db:"target"`
+	TargetType            string         `db:"target_type"`
+	OldState              string         `db:"old_state"`
+	NewState              string         `db:"new_state"`
+	Actor                 string         `db:"actor"`
+	ConfigDiff            sql.NullString `db:"config_diff"`
+	CreatedAt             time.Time      `db:"created_at"`
+}
+
+// TransitionEntityCollection is a collection of formation assignment transition entities.
+type TransitionEntityCollection []*TransitionEntity
+
+// Len is implementation of a repo.Collection interface
+func (s TransitionEntityCollection) Len() int {
+	return len(s)
+}
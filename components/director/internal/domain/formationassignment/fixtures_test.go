@@ -3,6 +3,7 @@ package formationassignment_test
 import (
 	"database/sql"
 	"encoding/json"
+	"time"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/str"
 
@@ -36,6 +37,8 @@ const (
 	TestWebhookID           = "eca98d44-aac0-4e44-898b-c394beab2e94"
 	TestReverseWebhookID    = "aecec253-b4d8-416a-be5c-a27677ee5157"
 	TntParentID             = "2d11035a-72e4-4a78-9025-bbcb1f87760b"
+	TestTransitionID        = "f5b9f3a6-3b7c-4a3b-9a7e-7d1b7e2c4c11"
+	TestConfigDiff          = "+configKey"
 )
 
 var (
@@ -46,6 +49,8 @@ var (
 	TestConfigValueStr            = "{\"configKey\":\"configValue\"}"
 	TestErrorValueStr             = "{\"error\":\"error message\"}"
 	fixColumns                    = []string{"id", "formation_id", "tenant_id", "source", "source_type", "target", "target_type", "state", "value", "error"}
+	fixTransitionColumns          = []string{"id", "formation_assignment_id", "formation_id", "tenant_id", "source", "source_type", "target", "target_type", "old_state", "new_state", "actor", "config_diff", "created_at"}
+	TestTransitionTimestamp       = time.Date(2023, time.October, 18, 12, 0, 0, 0, time.UTC)
 
 	nilFormationAssignmentModel *model.FormationAssignment
 
@@ -187,6 +192,54 @@ func fixFormationAssignmentModelWithIDAndTenantID(fa *model.FormationAssignment)
 	}
 }
 
+func fixFormationAssignmentTransitionModel() *model.FormationAssignmentTransition {
+	configDiff := TestConfigDiff
+	return &model.FormationAssignmentTransition{
+		ID:                    TestTransitionID,
+		FormationAssignmentID: TestID,
+		FormationID:           TestFormationID,
+		TenantID:              TestTenantID,
+		Source:                TestSource,
+		SourceType:            TestSourceType,
+		Target:                TestTarget,
+		TargetType:            TestTargetType,
+		OldState:              TestStateInitial,
+		NewState:              TestReadyState,
+		Actor:                 model.NotificationResponseTransitionActor,
+		ConfigDiff:            &configDiff,
+		Timestamp:             TestTransitionTimestamp,
+	}
+}
+
+func fixFormationAssignmentTransitionEntity() *formationassignment.TransitionEntity {
+	return &formationassignment.TransitionEntity{
+		ID:                    TestTransitionID,
+		FormationAssignmentID: TestID,
+		FormationID:           TestFormationID,
+		TenantID:              TestTenantID,
+		Source:                TestSource,
+		SourceType:            TestSourceType,
+		Target:                TestTarget,
+		TargetType:            TestTargetType,
+		OldState:              TestStateInitial,
+		NewState:              TestReadyState,
+		Actor:                 string(model.NotificationResponseTransitionActor),
+		ConfigDiff:            sql.NullString{String: TestConfigDiff, Valid: true},
+		CreatedAt:             TestTransitionTimestamp,
+	}
+}
+
+func fixFormationAssignmentTransitionGQLModel() *graphql.FormationAssignmentTransition {
+	configDiff := TestConfigDiff
+	return &graphql.FormationAssignmentTransition{
+		OldState:   TestStateInitial,
+		NewState:   TestReadyState,
+		Actor:      graphql.FormationAssignmentTransitionActorNotificationResponse,
+		ConfigDiff: &configDiff,
+		Timestamp:  graphql.Timestamp(TestTransitionTimestamp),
+	}
+}
+
 func fixFormationAssignmentModelInput(configValue json.RawMessage) *model.FormationAssignmentInput {
 	return &model.FormationAssignmentInput{
 		FormationID: TestFormationID,
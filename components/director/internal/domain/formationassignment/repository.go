@@ -2,6 +2,7 @@ package formationassignment
 
 import (
 	"context"
+	"time"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
 
@@ -14,13 +15,18 @@ import (
 	"github.com/pkg/errors"
 )
 
-const tableName string = `public.formation_assignments`
+const (
+	tableName           string = `public.formation_assignments`
+	transitionTableName string = `public.formation_assignment_transitions`
+)
 
 var (
 	idTableColumns        = []string{"id"}
 	updatableTableColumns = []string{"state", "value", "error"}
 	tableColumns          = []string{"id", "formation_id", "tenant_id", "source", "source_type", "target", "target_type", "state", "value", "error"}
 	tenantColumn          = "tenant_id"
+
+	transitionTableColumns = []string{"id", "formation_assignment_id", "formation_id", "tenant_id", "source", "source_type", "target", "target_type", "old_state", "new_state", "actor", "config_diff", "created_at"}
 )
 
 // EntityConverter converts between the internal model and entity
@@ -29,6 +35,8 @@ var (
 type EntityConverter interface {
 	ToEntity(in *model.FormationAssignment) *Entity
 	FromEntity(entity *Entity) *model.FormationAssignment
+	TransitionToEntity(in *model.FormationAssignmentTransition) *TransitionEntity
+	TransitionFromEntity(e *TransitionEntity) *model.FormationAssignmentTransition
 }
 
 type repository struct {
@@ -43,6 +51,9 @@ type repository struct {
 	deleter               repo.Deleter
 	deleteConditionTree   repo.DeleterConditionTree
 	existQuerier          repo.ExistQuerier
+	transitionCreator     repo.CreatorGlobal
+	transitionLister      repo.Lister
+	transitionDeleter     repo.DeleterGlobal
 	conv                  EntityConverter
 }
 
@@ -60,6 +71,9 @@ func NewRepository(conv EntityConverter) *repository {
 		deleter:               repo.NewDeleterWithEmbeddedTenant(tableName, tenantColumn),
 		deleteConditionTree:   repo.NewDeleterConditionTreeWithEmbeddedTenant(tableName, tenantColumn),
 		existQuerier:          repo.NewExistQuerierWithEmbeddedTenant(tableName, tenantColumn),
+		transitionCreator:     repo.NewCreatorGlobal(resource.FormationAssignmentTransition, transitionTableName, transitionTableColumns),
+		transitionLister:      repo.NewListerWithEmbeddedTenantAndOrderBy(transitionTableName, tenantColumn, transitionTableColumns, repo.OrderByParams{repo.NewAscOrderBy("created_at")}),
+		transitionDeleter:     repo.NewDeleterGlobal(resource.FormationAssignmentTransition, transitionTableName),
 		conv:                  conv,
 	}
 }
@@ -350,6 +364,39 @@ func (r *repository) Exists(ctx context.Context, id, tenantID string) (bool, err
 	return r.existQuerier.Exists(ctx, resource.FormationAssignment, tenantID, repo.Conditions{repo.NewEqualCondition("id", id)})
 }
 
+// CreateTransition appends a new entry to the state history of a Formation Assignment
+func (r *repository) CreateTransition(ctx context.Context, item *model.FormationAssignmentTransition) error {
+	if item == nil {
+		return apperrors.NewInternalError("model can not be empty")
+	}
+
+	log.C(ctx).Debugf("Persisting transition of Formation Assignment with ID: %q from state %q to state %q", item.FormationAssignmentID, item.OldState, item.NewState)
+	return r.transitionCreator.Create(ctx, r.conv.TransitionToEntity(item))
+}
+
+// ListTransitions retrieves the state history of the Formation Assignment with ID `formationAssignmentID` ordered from the oldest to the newest transition
+func (r *repository) ListTransitions(ctx context.Context, tenantID, formationAssignmentID string) ([]*model.FormationAssignmentTransition, error) {
+	var entities TransitionEntityCollection
+	if err := r.transitionLister.List(ctx, resource.FormationAssignmentTransition, tenantID, &entities, repo.NewEqualCondition("formation_assignment_id", formationAssignmentID)); err != nil {
+		return nil, err
+	}
+
+	transitions := make([]*model.FormationAssignmentTransition, 0, len(entities))
+	for _, e := range entities {
+		transitions = append(transitions, r.conv.TransitionFromEntity(e))
+	}
+
+	return transitions, nil
+}
+
+// DeleteTransitionsOlderThan deletes the transitions of all Formation Assignments recorded before `date`
+func (r *repository) DeleteTransitionsOlderThan(ctx context.Context, date time.Time) error {
+	log.C(ctx).Infof("Deleting all formation assignment transitions older than %v", date)
+	return r.transitionDeleter.DeleteManyGlobal(ctx, repo.Conditions{
+		repo.NewLessThanCondition("created_at", date),
+	})
+}
+
 func (r *repository) multipleFromEntities(entities EntityCollection) []*model.FormationAssignment {
 	items := make([]*model.FormationAssignment, 0, len(entities))
 	for _, ent := range entities {
@@ -769,3 +769,132 @@ func TestRepository_GetAssignmentsForFormation(t *testing.T) {
 		assert.Nil(t, actual)
 	})
 }
+
+func TestRepository_CreateTransition(t *testing.T) {
+	testErr := errors.New("test error")
+	transitionModel := fixFormationAssignmentTransitionModel()
+	transitionEntity := fixFormationAssignmentTransitionEntity()
+
+	t.Run("success", func(t *testing.T) {
+		converterMock := &automock.EntityConverter{}
+		defer converterMock.AssertExpectations(t)
+		converterMock.On("TransitionToEntity", transitionModel).Return(transitionEntity).Once()
+
+		sqlxDB, sqlMock := testdb.MockDatabase(t)
+		defer sqlMock.AssertExpectations(t)
+		sqlMock.ExpectExec(regexp.QuoteMeta(`INSERT INTO public.formation_assignment_transitions ( id, formation_assignment_id, formation_id, tenant_id, source, source_type, target, target_type, old_state, new_state, actor, config_diff, created_at ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? )`)).
+			WithArgs(TestTransitionID, TestID, TestFormationID, TestTenantID, TestSource, TestSourceType, TestTarget, TestTargetType, TestStateInitial, TestReadyState, string(model.NotificationResponseTransitionActor), TestConfigDiff, TestTransitionTimestamp).
+			WillReturnResult(sqlmock.NewResult(-1, 1))
+
+		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+		repository := formationassignment.NewRepository(converterMock)
+
+		// WHEN
+		err := repository.CreateTransition(ctx, transitionModel)
+
+		// THEN
+		assert.NoError(t, err)
+	})
+
+	t.Run("returns error when the model is nil", func(t *testing.T) {
+		repository := formationassignment.NewRepository(nil)
+
+		// WHEN
+		err := repository.CreateTransition(context.TODO(), nil)
+
+		// THEN
+		assert.EqualError(t, err, "Internal Server Error: model can not be empty")
+	})
+
+	t.Run("returns error when insert fails", func(t *testing.T) {
+		converterMock := &automock.EntityConverter{}
+		defer converterMock.AssertExpectations(t)
+		converterMock.On("TransitionToEntity", transitionModel).Return(transitionEntity).Once()
+
+		sqlxDB, sqlMock := testdb.MockDatabase(t)
+		defer sqlMock.AssertExpectations(t)
+		sqlMock.ExpectExec(regexp.QuoteMeta(`INSERT INTO public.formation_assignment_transitions ( id, formation_assignment_id, formation_id, tenant_id, source, source_type, target, target_type, old_state, new_state, actor, config_diff, created_at ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? )`)).
+			WillReturnError(testErr)
+
+		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+		repository := formationassignment.NewRepository(converterMock)
+
+		// WHEN
+		err := repository.CreateTransition(ctx, transitionModel)
+
+		// THEN
+		assert.EqualError(t, err, "Internal Server Error: Unexpected error while executing SQL query")
+	})
+}
+
+func TestRepository_ListTransitions(t *testing.T) {
+	testErr := errors.New("test error")
+	transitionModel := fixFormationAssignmentTransitionModel()
+	transitionEntity := fixFormationAssignmentTransitionEntity()
+
+	t.Run("success", func(t *testing.T) {
+		converterMock := &automock.EntityConverter{}
+		defer converterMock.AssertExpectations(t)
+		converterMock.On("TransitionFromEntity", transitionEntity).Return(transitionModel).Once()
+
+		sqlxDB, sqlMock := testdb.MockDatabase(t)
+		defer sqlMock.AssertExpectations(t)
+		sqlMock.ExpectQuery(regexp.QuoteMeta(`SELECT id, formation_assignment_id, formation_id, tenant_id, source, source_type, target, target_type, old_state, new_state, actor, config_diff, created_at FROM public.formation_assignment_transitions WHERE tenant_id = $1 AND formation_assignment_id = $2 ORDER BY created_at ASC`)).
+			WithArgs(TestTenantID, TestID).WillReturnRows(sqlmock.NewRows(fixTransitionColumns).
+			AddRow(TestTransitionID, TestID, TestFormationID, TestTenantID, TestSource, TestSourceType, TestTarget, TestTargetType, TestStateInitial, TestReadyState, string(model.NotificationResponseTransitionActor), TestConfigDiff, TestTransitionTimestamp))
+
+		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+		repository := formationassignment.NewRepository(converterMock)
+
+		// WHEN
+		actual, err := repository.ListTransitions(ctx, TestTenantID, TestID)
+
+		// THEN
+		assert.NoError(t, err)
+		assert.Equal(t, []*model.FormationAssignmentTransition{transitionModel}, actual)
+	})
+
+	t.Run("returns error when listing fails", func(t *testing.T) {
+		converterMock := &automock.EntityConverter{}
+		defer converterMock.AssertExpectations(t)
+
+		sqlxDB, sqlMock := testdb.MockDatabase(t)
+		defer sqlMock.AssertExpectations(t)
+		sqlMock.ExpectQuery(regexp.QuoteMeta(`SELECT id, formation_assignment_id, formation_id, tenant_id, source, source_type, target, target_type, old_state, new_state, actor, config_diff, created_at FROM public.formation_assignment_transitions WHERE tenant_id = $1 AND formation_assignment_id = $2 ORDER BY created_at ASC`)).
+			WithArgs(TestTenantID, TestID).WillReturnError(testErr)
+
+		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+		repository := formationassignment.NewRepository(converterMock)
+
+		// WHEN
+		actual, err := repository.ListTransitions(ctx, TestTenantID, TestID)
+
+		// THEN
+		assert.EqualError(t, err, "Internal Server Error: Unexpected error while executing SQL query")
+		assert.Nil(t, actual)
+	})
+}
+
+func TestRepository_DeleteTransitionsOlderThan(t *testing.T) {
+	suite := testdb.RepoDeleteTestSuite{
+		Name: "Delete Formation Assignment transitions older than date",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:         regexp.QuoteMeta(`DELETE FROM public.formation_assignment_transitions WHERE created_at < $1`),
+				Args:          []driver.Value{TestTransitionTimestamp},
+				ValidResult:   sqlmock.NewResult(-1, 1),
+				InvalidResult: sqlmock.NewResult(-1, 2),
+			},
+		},
+		RepoConstructorFunc: formationassignment.NewRepository,
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		MethodName:   "DeleteTransitionsOlderThan",
+		MethodArgs:   []interface{}{TestTransitionTimestamp},
+		IsDeleteMany: true,
+		IsGlobal:     true,
+	}
+
+	suite.Run(t)
+}
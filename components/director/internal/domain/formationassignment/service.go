@@ -38,6 +38,8 @@ type FormationAssignmentRepository interface {
 	ListAllForObjectIDs(ctx context.Context, tenant, formationID string, objectIDs []string) ([]*model.FormationAssignment, error)
 	ListForIDs(ctx context.Context, tenant string, ids []string) ([]*model.FormationAssignment, error)
 	Update(ctx context.Context, model *model.FormationAssignment) error
+	CreateTransition(ctx context.Context, item *model.FormationAssignmentTransition) error
+	ListTransitions(ctx context.Context, tenantID, formationAssignmentID string) ([]*model.FormationAssignmentTransition, error)
 	Delete(ctx context.Context, id, tenantID string) error
 	DeleteAssignmentsForObjectID(ctx context.Context, tnt, formationID, objectID string) error
 	Exists(ctx context.Context, id, tenantID string) (bool, error)
@@ -364,16 +366,34 @@ func (s *service) Update(ctx context.Context, id string, fa *model.FormationAssi
 		return errors.Wrapf(err, "while loading tenant from context")
 	}
 
-	if exists, err := s.repo.Exists(ctx, id, tenantID); err != nil {
+	previous, err := s.repo.Get(ctx, id, tenantID)
+	if err != nil {
+		if apperrors.IsNotFoundError(err) {
+			return apperrors.NewNotFoundError(resource.FormationAssignment, id)
+		}
 		return errors.Wrapf(err, "while ensuring formation assignment with ID: %q exists", id)
-	} else if !exists {
-		return apperrors.NewNotFoundError(resource.FormationAssignment, id)
 	}
 
 	if err = s.repo.Update(ctx, fa); err != nil {
 		return errors.Wrapf(err, "while updating formation assignment with ID: %q", id)
 	}
-	return nil
+
+	return recordTransition(ctx, s.repo, s.uidSvc, previous, fa)
+}
+
+// ListTransitions returns the state history of the Formation Assignment with ID `id` ordered from the oldest to the newest transition
+func (s *service) ListTransitions(ctx context.Context, id string) ([]*model.FormationAssignmentTransition, error) {
+	tenantID, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while loading tenant from context")
+	}
+
+	transitions, err := s.repo.ListTransitions(ctx, tenantID, id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while listing transitions of formation assignment with ID: %q", id)
+	}
+
+	return transitions, nil
 }
 
 // Delete deletes a Formation Assignment matching ID `id`
@@ -413,6 +433,63 @@ func (s *service) Exists(ctx context.Context, id string) (bool, error) {
 //
 // In case of objectType==RUNTIME_CONTEXT formationAssignments for the object and it's parent runtime are not generated.
 func (s *service) GenerateAssignments(ctx context.Context, tnt, objectID string, objectType graphql.FormationObjectType, formation *model.Formation) ([]*model.FormationAssignment, error) {
+	participants, err := s.listAssignedParticipants(ctx, tnt, formation)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.generateAssignmentsForObject(ctx, tnt, objectID, objectType, formation, participants)
+}
+
+// FormationObject is an object which is assigned to a formation
+type FormationObject struct {
+	ID   string
+	Type graphql.FormationObjectType
+}
+
+// GenerateAssignmentsForObjects creates and persists the formation assignments for multiple objects which are assigned to the formation `formation` at once.
+// The participants in the formation are listed only once. Each object is paired with the participants that were already in the formation and with the objects
+// preceding it in `objects`, which results in the same formation assignments as calling GenerateAssignments for the objects one after another.
+// The returned formation assignments are grouped per object in the order of `objects`.
+func (s *service) GenerateAssignmentsForObjects(ctx context.Context, tnt string, objects []FormationObject, formation *model.Formation) ([][]*model.FormationAssignment, error) {
+	participants, err := s.listAssignedParticipants(ctx, tnt, formation)
+	if err != nil {
+		return nil, err
+	}
+
+	assignmentsPerObject := make([][]*model.FormationAssignment, 0, len(objects))
+	for _, object := range objects {
+		assignments, err := s.generateAssignmentsForObject(ctx, tnt, object.ID, object.Type, formation, participants)
+		if err != nil {
+			return nil, errors.Wrapf(err, "while generating formation assignments for %s with ID %q", object.Type, object.ID)
+		}
+		assignmentsPerObject = append(assignmentsPerObject, assignments)
+		participants.markAsAssigned(object.ID, object.Type)
+	}
+
+	return assignmentsPerObject, nil
+}
+
+// assignedParticipants contains the IDs of the objects with the formation in their scenario label per object type.
+// The value for an ID is true if the object is fully assigned to the formation, i.e. it has a formation assignment to itself.
+type assignedParticipants struct {
+	applications    map[string]bool
+	runtimes        map[string]bool
+	runtimeContexts map[string]bool
+}
+
+func (p *assignedParticipants) markAsAssigned(objectID string, objectType graphql.FormationObjectType) {
+	switch objectType {
+	case graphql.FormationObjectTypeApplication:
+		p.applications[objectID] = true
+	case graphql.FormationObjectTypeRuntime:
+		p.runtimes[objectID] = true
+	case graphql.FormationObjectTypeRuntimeContext:
+		p.runtimeContexts[objectID] = true
+	}
+}
+
+func (s *service) listAssignedParticipants(ctx context.Context, tnt string, formation *model.Formation) (*assignedParticipants, error) {
 	applications, err := s.applicationRepository.ListByScenariosNoPaging(ctx, tnt, []string{formation.Name})
 	if err != nil {
 		return nil, err
@@ -429,20 +506,22 @@ func (s *service) GenerateAssignments(ctx context.Context, tnt, objectID string,
 	}
 
 	allIDs := make([]string, 0, len(applications)+len(runtimes)+len(runtimeContexts))
-	appIDs := make(map[string]bool, len(applications))
-	rtIDs := make(map[string]bool, len(runtimes))
-	rtCtxIDs := make(map[string]bool, len(runtimeContexts))
+	participants := &assignedParticipants{
+		applications:    make(map[string]bool, len(applications)),
+		runtimes:        make(map[string]bool, len(runtimes)),
+		runtimeContexts: make(map[string]bool, len(runtimeContexts)),
+	}
 	for _, app := range applications {
 		allIDs = append(allIDs, app.ID)
-		appIDs[app.ID] = false
+		participants.applications[app.ID] = false
 	}
 	for _, rt := range runtimes {
 		allIDs = append(allIDs, rt.ID)
-		rtIDs[rt.ID] = false
+		participants.runtimes[rt.ID] = false
 	}
 	for _, rtCtx := range runtimeContexts {
 		allIDs = append(allIDs, rtCtx.ID)
-		rtCtxIDs[rtCtx.ID] = false
+		participants.runtimeContexts[rtCtx.ID] = false
 	}
 
 	allAssignments, err := s.ListFormationAssignmentsForObjectIDs(ctx, formation.ID, allIDs)
@@ -453,21 +532,18 @@ func (s *service) GenerateAssignments(ctx context.Context, tnt, objectID string,
 	// We should not generate notifications for formation participants that are being unassigned asynchronously
 	for _, assignment := range allAssignments {
 		if assignment.Source == assignment.Target && assignment.SourceType == assignment.TargetType {
-			switch assignment.SourceType {
-			case model.FormationAssignmentTypeApplication:
-				appIDs[assignment.Source] = true
-			case model.FormationAssignmentTypeRuntime:
-				rtIDs[assignment.Source] = true
-			case model.FormationAssignmentTypeRuntimeContext:
-				rtCtxIDs[assignment.Source] = true
-			}
+			participants.markAsAssigned(assignment.Source, graphql.FormationObjectType(assignment.SourceType))
 		}
 	}
 
+	return participants, nil
+}
+
+func (s *service) generateAssignmentsForObject(ctx context.Context, tnt, objectID string, objectType graphql.FormationObjectType, formation *model.Formation, participants *assignedParticipants) ([]*model.FormationAssignment, error) {
 	// When assigning an object to a formation we need to create two formation assignments per participant.
 	// In the first formation assignment the object we're assigning will be the source and in the second it will be the target
-	assignments := make([]*model.FormationAssignmentInput, 0, (len(applications)+len(runtimes)+len(runtimeContexts))*2+1)
-	for appID, isAssigned := range appIDs {
+	assignments := make([]*model.FormationAssignmentInput, 0, (len(participants.applications)+len(participants.runtimes)+len(participants.runtimeContexts))*2+1)
+	for appID, isAssigned := range participants.applications {
 		if !isAssigned || appID == objectID {
 			continue
 		}
@@ -487,14 +563,14 @@ func (s *service) GenerateAssignments(ctx context.Context, tnt, objectID string,
 		}
 		parentID = rtmCtx.RuntimeID
 	}
-	for runtimeID, isAssigned := range rtIDs {
+	for runtimeID, isAssigned := range participants.runtimes {
 		if !isAssigned || runtimeID == objectID || runtimeID == parentID {
 			continue
 		}
 		assignments = append(assignments, s.GenerateAssignmentsForParticipant(objectID, objectType, formation, model.FormationAssignmentTypeRuntime, runtimeID)...)
 	}
 
-	for runtimeCtxID, isAssigned := range rtCtxIDs {
+	for runtimeCtxID, isAssigned := range participants.runtimeContexts {
 		if !isAssigned || runtimeCtxID == objectID {
 			continue
 		}
@@ -646,8 +722,11 @@ func (s *service) processFormationAssignmentsWithReverseNotification(ctx context
 		return nil
 	}
 
+	// The following state changes of the assignment are based on the received notification response
+	responseCtx := SaveTransitionActorToContext(ctx, model.NotificationResponseTransitionActor)
+
 	if response.Error != nil && *response.Error != "" {
-		err = s.statusService.SetAssignmentToErrorStateWithConstraints(ctx, assignment, *response.Error, ClientError, model.CreateErrorAssignmentState, mappingPair.Operation)
+		err = s.statusService.SetAssignmentToErrorStateWithConstraints(responseCtx, assignment, *response.Error, ClientError, model.CreateErrorAssignmentState, mappingPair.Operation)
 		if err != nil {
 			return errors.Wrapf(err, "while updating error state for formation with ID %q", assignment.ID)
 		}
@@ -662,7 +741,7 @@ func (s *service) processFormationAssignmentsWithReverseNotification(ctx context
 		assignment.State = string(model.InitialFormationState)
 		// Cleanup the error if present as new notification has been sent. The previous configuration should be left intact.
 		assignment.Error = nil
-		if err := s.Update(ctx, assignment.ID, assignment); err != nil {
+		if err := s.Update(responseCtx, assignment.ID, assignment); err != nil {
 			return errors.Wrapf(err, "While updating formation assignment with id %q", assignment.ID)
 		}
 
@@ -696,7 +775,7 @@ func (s *service) processFormationAssignmentsWithReverseNotification(ctx context
 		shouldSendReverseNotification = true
 	}
 
-	if err = s.statusService.UpdateWithConstraints(ctx, assignment, mappingPair.Operation); err != nil {
+	if err = s.statusService.UpdateWithConstraints(responseCtx, assignment, mappingPair.Operation); err != nil {
 		return errors.Wrapf(err, "while updating formation assignment with constraints for formation %q with source %q and target %q", assignment.FormationID, assignment.Source, assignment.Target)
 	}
 	log.C(ctx).Infof("Assignment with ID: %q was updated with %q state", assignment.ID, assignment.State)
@@ -785,6 +864,9 @@ func (s *service) CleanupFormationAssignment(ctx context.Context, mappingPair *A
 		return false, errors.Wrapf(err, "while sending notification for formation assignment with ID %q", assignment.ID)
 	}
 
+	// The following state changes of the assignment are based on the received notification response
+	ctx = SaveTransitionActorToContext(ctx, model.NotificationResponseTransitionActor)
+
 	if response.Error != nil && *response.Error != "" {
 		if err = s.statusService.SetAssignmentToErrorStateWithConstraints(ctx, assignment, *response.Error, ClientError, model.DeleteErrorAssignmentState, mappingPair.Operation); err != nil {
 			return false, errors.Wrapf(err, "while updating error state for formation with ID %q", assignment.ID)
@@ -29,6 +29,8 @@ var (
 	emptyCtx      = context.TODO()
 	externalTnt   = "externalTenant"
 	ctxWithTenant = tenant.SaveToContext(emptyCtx, TestTenantID, externalTnt)
+	// ctxWithResponseActor is the context used for the formation assignment changes based on a received notification response
+	ctxWithResponseActor = formationassignment.SaveTransitionActorToContext(ctxWithTenant, model.NotificationResponseTransitionActor)
 
 	testErr       = errors.New("Test Error")
 	notFoundError = apperrors.NewNotFoundError(resource.FormationAssignment, TestID)
@@ -1034,12 +1036,18 @@ func TestService_ListFormationAssignmentsForObjectIDs(t *testing.T) {
 }
 
 func TestService_Update(t *testing.T) {
+	previousFA := fixFormationAssignmentModel(json.RawMessage(`{"configKey":"oldValue","removedKey":"value"}`))
+	previousFA.State = string(model.ConfigPendingAssignmentState)
+
+	ctxWithTenantAndActor := formationassignment.SaveTransitionActorToContext(ctxWithTenant, model.StatusAPITransitionActor)
+
 	// GIVEN
 	testCases := []struct {
 		Name                    string
 		Context                 context.Context
 		FormationAssignment     *model.FormationAssignment
 		FormationAssignmentRepo func() *automock.FormationAssignmentRepository
+		UIDService              func() *automock.UIDService
 		ExpectedErrorMsg        string
 	}{
 		{
@@ -1048,7 +1056,40 @@ func TestService_Update(t *testing.T) {
 			FormationAssignment: fa,
 			FormationAssignmentRepo: func() *automock.FormationAssignmentRepository {
 				repo := &automock.FormationAssignmentRepository{}
-				repo.On("Exists", ctxWithTenant, TestID, TestTenantID).Return(true, nil).Once()
+				repo.On("Get", ctxWithTenant, TestID, TestTenantID).Return(previousFA, nil).Once()
+				repo.On("Update", ctxWithTenant, faModel).Return(nil).Once()
+				repo.On("CreateTransition", ctxWithTenant, mock.MatchedBy(func(transition *model.FormationAssignmentTransition) bool {
+					return transition.ID == TestID && transition.FormationAssignmentID == TestID && transition.FormationID == TestFormationID && transition.TenantID == TestTenantID &&
+						transition.Source == TestSource && transition.Target == TestTarget &&
+						transition.OldState == string(model.ConfigPendingAssignmentState) && transition.NewState == TestStateInitial &&
+						transition.Actor == model.SystemTransitionActor && *transition.ConfigDiff == "-removedKey ~configKey"
+				})).Return(nil).Once()
+				return repo
+			},
+			UIDService: fixUUIDService,
+		},
+		{
+			Name:                "Success records the actor from the context",
+			Context:             ctxWithTenantAndActor,
+			FormationAssignment: fa,
+			FormationAssignmentRepo: func() *automock.FormationAssignmentRepository {
+				repo := &automock.FormationAssignmentRepository{}
+				repo.On("Get", ctxWithTenantAndActor, TestID, TestTenantID).Return(previousFA, nil).Once()
+				repo.On("Update", ctxWithTenantAndActor, faModel).Return(nil).Once()
+				repo.On("CreateTransition", ctxWithTenantAndActor, mock.MatchedBy(func(transition *model.FormationAssignmentTransition) bool {
+					return transition.Actor == model.StatusAPITransitionActor
+				})).Return(nil).Once()
+				return repo
+			},
+			UIDService: fixUUIDService,
+		},
+		{
+			Name:                "Success does not record a transition when the formation assignment did not change",
+			Context:             ctxWithTenant,
+			FormationAssignment: fa,
+			FormationAssignmentRepo: func() *automock.FormationAssignmentRepository {
+				repo := &automock.FormationAssignmentRepository{}
+				repo.On("Get", ctxWithTenant, TestID, TestTenantID).Return(faModel, nil).Once()
 				repo.On("Update", ctxWithTenant, faModel).Return(nil).Once()
 				return repo
 			},
@@ -1059,12 +1100,12 @@ func TestService_Update(t *testing.T) {
 			ExpectedErrorMsg: "while loading tenant from context: cannot read tenant from context",
 		},
 		{
-			Name:                "Error when checking for formation assignment existence",
+			Name:                "Error when getting formation assignment",
 			Context:             ctxWithTenant,
 			FormationAssignment: fa,
 			FormationAssignmentRepo: func() *automock.FormationAssignmentRepository {
 				repo := &automock.FormationAssignmentRepository{}
-				repo.On("Exists", ctxWithTenant, TestID, TestTenantID).Return(false, testErr).Once()
+				repo.On("Get", ctxWithTenant, TestID, TestTenantID).Return(nil, testErr).Once()
 				return repo
 			},
 			ExpectedErrorMsg: fmt.Sprintf("while ensuring formation assignment with ID: %q exists", TestID),
@@ -1075,7 +1116,7 @@ func TestService_Update(t *testing.T) {
 			FormationAssignment: fa,
 			FormationAssignmentRepo: func() *automock.FormationAssignmentRepository {
 				repo := &automock.FormationAssignmentRepository{}
-				repo.On("Exists", ctxWithTenant, TestID, TestTenantID).Return(false, nil).Once()
+				repo.On("Get", ctxWithTenant, TestID, TestTenantID).Return(nil, apperrors.NewNotFoundError(resource.FormationAssignment, TestID)).Once()
 				return repo
 			},
 			ExpectedErrorMsg: "Object not found",
@@ -1086,12 +1127,26 @@ func TestService_Update(t *testing.T) {
 			FormationAssignment: fa,
 			FormationAssignmentRepo: func() *automock.FormationAssignmentRepository {
 				repo := &automock.FormationAssignmentRepository{}
-				repo.On("Exists", ctxWithTenant, TestID, TestTenantID).Return(true, nil).Once()
+				repo.On("Get", ctxWithTenant, TestID, TestTenantID).Return(previousFA, nil).Once()
 				repo.On("Update", ctxWithTenant, faModel).Return(testErr).Once()
 				return repo
 			},
 			ExpectedErrorMsg: testErr.Error(),
 		},
+		{
+			Name:                "Error when recording the formation assignment transition",
+			Context:             ctxWithTenant,
+			FormationAssignment: fa,
+			FormationAssignmentRepo: func() *automock.FormationAssignmentRepository {
+				repo := &automock.FormationAssignmentRepository{}
+				repo.On("Get", ctxWithTenant, TestID, TestTenantID).Return(previousFA, nil).Once()
+				repo.On("Update", ctxWithTenant, faModel).Return(nil).Once()
+				repo.On("CreateTransition", ctxWithTenant, mock.AnythingOfType("*model.FormationAssignmentTransition")).Return(testErr).Once()
+				return repo
+			},
+			UIDService:       fixUUIDService,
+			ExpectedErrorMsg: fmt.Sprintf("while recording transition of formation assignment with ID: %q", TestID),
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -1100,8 +1155,12 @@ func TestService_Update(t *testing.T) {
 			if testCase.FormationAssignmentRepo != nil {
 				faRepo = testCase.FormationAssignmentRepo()
 			}
+			uidSvc := unusedUIDService()
+			if testCase.UIDService != nil {
+				uidSvc = testCase.UIDService()
+			}
 
-			svc := formationassignment.NewService(faRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", "")
+			svc := formationassignment.NewService(faRepo, uidSvc, nil, nil, nil, nil, nil, nil, nil, nil, "", "")
 
 			// WHEN
 			err := svc.Update(testCase.Context, TestID, testCase.FormationAssignment)
@@ -1113,6 +1172,68 @@ func TestService_Update(t *testing.T) {
 				require.NoError(t, err)
 			}
 
+			mock.AssertExpectationsForObjects(t, faRepo, uidSvc)
+		})
+	}
+}
+
+func TestService_ListTransitions(t *testing.T) {
+	transitions := []*model.FormationAssignmentTransition{fixFormationAssignmentTransitionModel()}
+
+	testCases := []struct {
+		Name                    string
+		Context                 context.Context
+		FormationAssignmentRepo func() *automock.FormationAssignmentRepository
+		ExpectedOutput          []*model.FormationAssignmentTransition
+		ExpectedErrorMsg        string
+	}{
+		{
+			Name:    "Success",
+			Context: ctxWithTenant,
+			FormationAssignmentRepo: func() *automock.FormationAssignmentRepository {
+				repo := &automock.FormationAssignmentRepository{}
+				repo.On("ListTransitions", ctxWithTenant, TestTenantID, TestID).Return(transitions, nil).Once()
+				return repo
+			},
+			ExpectedOutput: transitions,
+		},
+		{
+			Name:             "Error when loading tenant from context",
+			Context:          emptyCtx,
+			ExpectedErrorMsg: "while loading tenant from context: cannot read tenant from context",
+		},
+		{
+			Name:    "Error when listing transitions",
+			Context: ctxWithTenant,
+			FormationAssignmentRepo: func() *automock.FormationAssignmentRepository {
+				repo := &automock.FormationAssignmentRepository{}
+				repo.On("ListTransitions", ctxWithTenant, TestTenantID, TestID).Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedErrorMsg: testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			faRepo := unusedFormationAssignmentRepository()
+			if testCase.FormationAssignmentRepo != nil {
+				faRepo = testCase.FormationAssignmentRepo()
+			}
+
+			svc := formationassignment.NewService(faRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", "")
+
+			// WHEN
+			r, err := svc.ListTransitions(testCase.Context, TestID)
+
+			if testCase.ExpectedErrorMsg != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), testCase.ExpectedErrorMsg)
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, testCase.ExpectedOutput, r)
 			mock.AssertExpectationsForObjects(t, faRepo)
 		})
 	}
@@ -1785,6 +1906,97 @@ func TestService_GenerateAssignments(t *testing.T) {
 	}
 }
 
+func TestService_GenerateAssignmentsForObjects(t *testing.T) {
+	// GIVEN
+	assignedAppID := "assigned-app"
+	firstAppID := "first-app"
+	secondAppID := "second-app"
+	formation := &model.Formation{
+		Name: "testFormation",
+		ID:   "ID",
+	}
+	objects := []formationassignment.FormationObject{
+		{ID: firstAppID, Type: graphql.FormationObjectTypeApplication},
+		{ID: secondAppID, Type: graphql.FormationObjectTypeApplication},
+	}
+	applications := []*model.Application{
+		{BaseEntity: &model.BaseEntity{ID: assignedAppID}},
+		{BaseEntity: &model.BaseEntity{ID: firstAppID}},
+		{BaseEntity: &model.BaseEntity{ID: secondAppID}},
+	}
+	formationParticipantsIDs := []string{assignedAppID, firstAppID, secondAppID}
+	selfAssignment := &model.FormationAssignment{ID: "self", FormationID: formation.ID, Source: assignedAppID, SourceType: model.FormationAssignmentTypeApplication, Target: assignedAppID, TargetType: model.FormationAssignmentTypeApplication}
+
+	t.Run("Success pairs each object with the participants and the preceding objects", func(t *testing.T) {
+		created := make([]*model.FormationAssignment, 0)
+		formationAssignmentRepo := &automock.FormationAssignmentRepository{}
+		formationAssignmentRepo.On("ListAllForObjectIDs", ctxWithTenant, TestTenantID, formation.ID, formationParticipantsIDs).Return([]*model.FormationAssignment{selfAssignment}, nil).Once()
+		formationAssignmentRepo.On("GetByTargetAndSource", ctxWithTenant, mock.AnythingOfType("string"), mock.AnythingOfType("string"), TestTenantID, formation.ID).Return(nil, apperrors.NewNotFoundErrorWithType(resource.FormationAssignment)).Times(8)
+		formationAssignmentRepo.On("Create", ctxWithTenant, mock.AnythingOfType("*model.FormationAssignment")).Run(func(args mock.Arguments) {
+			created = append(created, args.Get(1).(*model.FormationAssignment))
+		}).Return(nil).Times(8)
+		formationAssignmentRepo.On("ListForIDs", ctxWithTenant, TestTenantID, mock.AnythingOfType("[]string")).Return(func(_ context.Context, _ string, ids []string) []*model.FormationAssignment {
+			assignments := make([]*model.FormationAssignment, 0, len(ids))
+			for _, id := range ids {
+				assignments = append(assignments, &model.FormationAssignment{ID: id})
+			}
+			return assignments
+		}, nil).Twice()
+
+		uidSvc := &automock.UIDService{}
+		uidSvc.On("Generate").Return("fa-id").Times(8)
+
+		appRepo := &automock.ApplicationRepository{}
+		appRepo.On("ListByScenariosNoPaging", ctxWithTenant, TestTenantID, []string{formation.Name}).Return(applications, nil).Once()
+		runtimeRepo := &automock.RuntimeRepository{}
+		runtimeRepo.On("ListByScenarios", ctxWithTenant, TestTenantID, []string{formation.Name}).Return(nil, nil).Once()
+		runtimeContextRepo := &automock.RuntimeContextRepository{}
+		runtimeContextRepo.On("ListByScenarios", ctxWithTenant, TestTenantID, []string{formation.Name}).Return(nil, nil).Once()
+
+		svc := formationassignment.NewService(formationAssignmentRepo, uidSvc, appRepo, runtimeRepo, runtimeContextRepo, nil, nil, nil, nil, nil, "", "")
+
+		// WHEN
+		r, err := svc.GenerateAssignmentsForObjects(ctxWithTenant, TestTenantID, objects, formation)
+
+		// THEN
+		require.NoError(t, err)
+		require.Len(t, r, len(objects))
+		// The first object is paired with the assigned application and the second one additionally with the first object
+		require.Len(t, r[0], 3)
+		require.Len(t, r[1], 5)
+
+		pairs := make(map[string]bool, len(created))
+		for _, fa := range created {
+			pairs[fa.Source+"->"+fa.Target] = true
+		}
+		for _, pair := range []string{
+			firstAppID + "->" + assignedAppID, assignedAppID + "->" + firstAppID, firstAppID + "->" + firstAppID,
+			secondAppID + "->" + assignedAppID, assignedAppID + "->" + secondAppID, secondAppID + "->" + firstAppID, firstAppID + "->" + secondAppID, secondAppID + "->" + secondAppID,
+		} {
+			require.True(t, pairs[pair], "missing formation assignment %s", pair)
+		}
+
+		mock.AssertExpectationsForObjects(t, formationAssignmentRepo, uidSvc, appRepo, runtimeRepo, runtimeContextRepo)
+	})
+
+	t.Run("Error when listing the participants fails", func(t *testing.T) {
+		appRepo := &automock.ApplicationRepository{}
+		appRepo.On("ListByScenariosNoPaging", ctxWithTenant, TestTenantID, []string{formation.Name}).Return(nil, testErr).Once()
+
+		svc := formationassignment.NewService(nil, nil, appRepo, nil, nil, nil, nil, nil, nil, nil, "", "")
+
+		// WHEN
+		r, err := svc.GenerateAssignmentsForObjects(ctxWithTenant, TestTenantID, objects, formation)
+
+		// THEN
+		require.Error(t, err)
+		require.Contains(t, err.Error(), testErr.Error())
+		require.Nil(t, r)
+
+		mock.AssertExpectationsForObjects(t, appRepo)
+	})
+}
+
 func TestService_ProcessFormationAssignments(t *testing.T) {
 	// GIVEN
 	operationContainer := &operationContainer{content: []*formationassignment.AssignmentMappingPairWithOperation{}, err: testErr}
@@ -2246,8 +2458,9 @@ func TestService_ProcessFormationAssignmentPair(t *testing.T) {
 			},
 			FormationAssignmentRepo: func() *automock.FormationAssignmentRepository {
 				repo := &automock.FormationAssignmentRepository{}
-				repo.On("Exists", ctxWithTenant, TestID, TestTenantID).Return(true, nil).Once()
+				repo.On("Get", ctxWithTenant, TestID, TestTenantID).Return(initialStateAssignment.Clone(), nil).Once()
 				repo.On("Update", ctxWithTenant, readyStateAssignment).Return(nil).Once()
+				repo.On("CreateTransition", ctxWithTenant, mock.AnythingOfType("*model.FormationAssignmentTransition")).Return(nil).Once()
 				return repo
 			},
 		},
@@ -2266,7 +2479,7 @@ func TestService_ProcessFormationAssignmentPair(t *testing.T) {
 			},
 			FormationAssignmentRepo: func() *automock.FormationAssignmentRepository {
 				repo := &automock.FormationAssignmentRepository{}
-				repo.On("Exists", ctxWithTenant, TestID, TestTenantID).Return(true, nil).Once()
+				repo.On("Get", ctxWithTenant, TestID, TestTenantID).Return(readyStateAssignment, nil).Once()
 				repo.On("Update", ctxWithTenant, readyStateAssignment).Return(testErr).Once()
 				return repo
 			},
@@ -2293,7 +2506,7 @@ func TestService_ProcessFormationAssignmentPair(t *testing.T) {
 			},
 			FAStatusService: func() *automock.StatusService {
 				updater := &automock.StatusService{}
-				updater.On("UpdateWithConstraints", ctxWithTenant, configPendingStateAssignment, assignOperation).Return(nil).Once()
+				updater.On("UpdateWithConstraints", ctxWithResponseActor, configPendingStateAssignment, assignOperation).Return(nil).Once()
 				return updater
 			},
 			FormationAssignmentPairWithOperation: fixAssignmentMappingPairWithAssignmentAndRequest(initialStateAssignment, reqWebhook),
@@ -2318,7 +2531,7 @@ func TestService_ProcessFormationAssignmentPair(t *testing.T) {
 			},
 			FAStatusService: func() *automock.StatusService {
 				updater := &automock.StatusService{}
-				updater.On("UpdateWithConstraints", ctxWithTenant, configPendingStateAssignment, assignOperation).Return(nil).Once()
+				updater.On("UpdateWithConstraints", ctxWithResponseActor, configPendingStateAssignment, assignOperation).Return(nil).Once()
 				return updater
 			},
 			FormationAssignmentPairWithOperation: fixAssignmentMappingPairWithAssignmentAndRequest(initialStateAssignment, reqWebhook),
@@ -2328,8 +2541,9 @@ func TestService_ProcessFormationAssignmentPair(t *testing.T) {
 			Context: ctxWithTenant,
 			FormationAssignmentRepo: func() *automock.FormationAssignmentRepository {
 				repo := &automock.FormationAssignmentRepository{}
-				repo.On("Exists", ctxWithTenant, TestID, TestTenantID).Return(true, nil).Once()
+				repo.On("Get", ctxWithTenant, TestID, TestTenantID).Return(initialStateSelfReferencingAssignment.Clone(), nil).Once()
 				repo.On("Update", ctxWithTenant, readyStateSelfReferencingAssignment).Return(nil).Once()
+				repo.On("CreateTransition", ctxWithTenant, mock.AnythingOfType("*model.FormationAssignmentTransition")).Return(nil).Once()
 				return repo
 			},
 			FormationAssignmentPairWithOperation: fixAssignmentMappingPairWithAssignmentAndRequest(initialStateSelfReferencingAssignment.Clone(), reqWebhook),
@@ -2339,7 +2553,7 @@ func TestService_ProcessFormationAssignmentPair(t *testing.T) {
 			Context: ctxWithTenant,
 			FormationAssignmentRepo: func() *automock.FormationAssignmentRepository {
 				repo := &automock.FormationAssignmentRepository{}
-				repo.On("Exists", ctxWithTenant, TestID, TestTenantID).Return(true, nil).Once()
+				repo.On("Get", ctxWithTenant, TestID, TestTenantID).Return(readyStateSelfReferencingAssignment, nil).Once()
 				repo.On("Update", ctxWithTenant, readyStateSelfReferencingAssignment).Return(testErr).Once()
 				return repo
 			},
@@ -2445,7 +2659,7 @@ func TestService_ProcessFormationAssignmentPair(t *testing.T) {
 			FormationAssignmentPairWithOperation: fixAssignmentMappingPairWithAssignmentAndRequest(initialStateAssignment, reqWebhook),
 			FAStatusService: func() *automock.StatusService {
 				updater := &automock.StatusService{}
-				updater.On("UpdateWithConstraints", ctxWithTenant, readyStateAssignment, assignOperation).Return(nil).Once()
+				updater.On("UpdateWithConstraints", ctxWithResponseActor, readyStateAssignment, assignOperation).Return(nil).Once()
 				return updater
 			},
 		},
@@ -2469,7 +2683,7 @@ func TestService_ProcessFormationAssignmentPair(t *testing.T) {
 			},
 			FAStatusService: func() *automock.StatusService {
 				updater := &automock.StatusService{}
-				updater.On("UpdateWithConstraints", ctxWithTenant, configPendingStateAssignment, assignOperation).Return(testErr).Once()
+				updater.On("UpdateWithConstraints", ctxWithResponseActor, configPendingStateAssignment, assignOperation).Return(testErr).Once()
 				return updater
 			},
 			FormationAssignmentPairWithOperation: fixAssignmentMappingPairWithAssignmentAndRequest(initialStateAssignment, reqWebhook),
@@ -2494,7 +2708,7 @@ func TestService_ProcessFormationAssignmentPair(t *testing.T) {
 			},
 			FAStatusService: func() *automock.StatusService {
 				updater := &automock.StatusService{}
-				updater.On("SetAssignmentToErrorStateWithConstraints", ctxWithTenant, initialStateAssignment, testErr.Error(), formationassignment.AssignmentErrorCode(2), model.CreateErrorAssignmentState, assignOperation).Return(nil).Once()
+				updater.On("SetAssignmentToErrorStateWithConstraints", ctxWithResponseActor, initialStateAssignment, testErr.Error(), formationassignment.AssignmentErrorCode(2), model.CreateErrorAssignmentState, assignOperation).Return(nil).Once()
 				return updater
 			},
 			FormationAssignmentPairWithOperation: fixAssignmentMappingPairWithAssignmentAndRequest(initialStateAssignment, reqWebhook),
@@ -2518,7 +2732,7 @@ func TestService_ProcessFormationAssignmentPair(t *testing.T) {
 			},
 			FAStatusService: func() *automock.StatusService {
 				updater := &automock.StatusService{}
-				updater.On("SetAssignmentToErrorStateWithConstraints", ctxWithTenant, initialStateAssignment, testErr.Error(), formationassignment.AssignmentErrorCode(2), model.CreateErrorAssignmentState, assignOperation).Return(testErr).Once()
+				updater.On("SetAssignmentToErrorStateWithConstraints", ctxWithResponseActor, initialStateAssignment, testErr.Error(), formationassignment.AssignmentErrorCode(2), model.CreateErrorAssignmentState, assignOperation).Return(testErr).Once()
 				return updater
 			},
 			FormationAssignmentPairWithOperation: fixAssignmentMappingPairWithAssignmentAndRequest(initialStateAssignment, reqWebhook),
@@ -2529,8 +2743,9 @@ func TestService_ProcessFormationAssignmentPair(t *testing.T) {
 			Context: ctxWithTenant,
 			FormationAssignmentRepo: func() *automock.FormationAssignmentRepository {
 				repo := &automock.FormationAssignmentRepository{}
-				repo.On("Exists", ctxWithTenant, TestID, TestTenantID).Return(true, nil).Once()
+				repo.On("Get", ctxWithTenant, TestID, TestTenantID).Return(initialStateAssignment.Clone(), nil).Once()
 				repo.On("Update", ctxWithTenant, createErrorStateAssignment).Return(nil).Once()
+				repo.On("CreateTransition", ctxWithTenant, mock.AnythingOfType("*model.FormationAssignmentTransition")).Return(nil).Once()
 				return repo
 			},
 			NotificationService: func() *automock.NotificationService {
@@ -2551,7 +2766,7 @@ func TestService_ProcessFormationAssignmentPair(t *testing.T) {
 			Context: ctxWithTenant,
 			FormationAssignmentRepo: func() *automock.FormationAssignmentRepository {
 				repo := &automock.FormationAssignmentRepository{}
-				repo.On("Exists", ctxWithTenant, TestID, TestTenantID).Return(true, nil).Once()
+				repo.On("Get", ctxWithTenant, TestID, TestTenantID).Return(createErrorStateAssignment, nil).Once()
 				repo.On("Update", ctxWithTenant, createErrorStateAssignment).Return(testErr).Once()
 				return repo
 			},
@@ -2574,8 +2789,8 @@ func TestService_ProcessFormationAssignmentPair(t *testing.T) {
 			Context: ctxWithTenant,
 			FormationAssignmentRepo: func() *automock.FormationAssignmentRepository {
 				repo := &automock.FormationAssignmentRepository{}
-				repo.On("Exists", ctxWithTenant, TestID, TestTenantID).Return(true, nil).Once()
-				repo.On("Update", ctxWithTenant, initialStateAssignment).Return(nil).Once()
+				repo.On("Get", ctxWithResponseActor, TestID, TestTenantID).Return(initialStateAssignment, nil).Once()
+				repo.On("Update", ctxWithResponseActor, initialStateAssignment).Return(nil).Once()
 				return repo
 			},
 			NotificationService: func() *automock.NotificationService {
@@ -2600,8 +2815,8 @@ func TestService_ProcessFormationAssignmentPair(t *testing.T) {
 			Context: ctxWithTenant,
 			FormationAssignmentRepo: func() *automock.FormationAssignmentRepository {
 				repo := &automock.FormationAssignmentRepository{}
-				repo.On("Exists", ctxWithTenant, TestID, TestTenantID).Return(true, nil).Once()
-				repo.On("Update", ctxWithTenant, initialStateAssignment).Return(testErr).Once()
+				repo.On("Get", ctxWithResponseActor, TestID, TestTenantID).Return(initialStateAssignment, nil).Once()
+				repo.On("Update", ctxWithResponseActor, initialStateAssignment).Return(testErr).Once()
 				return repo
 			},
 			NotificationService: func() *automock.NotificationService {
@@ -2643,7 +2858,7 @@ func TestService_ProcessFormationAssignmentPair(t *testing.T) {
 			},
 			FAStatusService: func() *automock.StatusService {
 				updater := &automock.StatusService{}
-				updater.On("UpdateWithConstraints", ctxWithTenant, configAssignment, assignOperation).Return(nil).Once()
+				updater.On("UpdateWithConstraints", ctxWithResponseActor, configAssignment, assignOperation).Return(nil).Once()
 				return updater
 			},
 			FormationAssignmentPairWithOperation: fixAssignmentMappingPairWithAssignmentAndRequest(initialStateAssignment, reqWebhook),
@@ -2673,7 +2888,7 @@ func TestService_ProcessFormationAssignmentPair(t *testing.T) {
 				faNotificationSvc = testCase.FANotificationSvc()
 			}
 
-			svc := formationassignment.NewService(repo, nil, nil, nil, nil, notificationSvc, faNotificationSvc, nil, formationRepo, faStatusService, rtmTypeLabelKey, appTypeLabelKey)
+			svc := formationassignment.NewService(repo, fixUUIDService(), nil, nil, nil, notificationSvc, faNotificationSvc, nil, formationRepo, faStatusService, rtmTypeLabelKey, appTypeLabelKey)
 
 			///WHEN
 			isReverseProcessed, err := svc.ProcessFormationAssignmentPair(testCase.Context, testCase.FormationAssignmentPairWithOperation)
@@ -2773,8 +2988,8 @@ func TestService_ProcessFormationAssignmentPair(t *testing.T) {
 		formationRepo.On("Get", ctxWithTenant, TestFormationID, TestTenantID).Return(formation, nil).Times(2)
 
 		faStatusService := &automock.StatusService{}
-		faStatusService.On("UpdateWithConstraints", ctxWithTenant, configAssignment, assignOperation).Return(nil).Once()
-		faStatusService.On("UpdateWithConstraints", ctxWithTenant, reverseConfigAssignment, assignOperation).Return(nil).Once()
+		faStatusService.On("UpdateWithConstraints", ctxWithResponseActor, configAssignment, assignOperation).Return(nil).Once()
+		faStatusService.On("UpdateWithConstraints", ctxWithResponseActor, reverseConfigAssignment, assignOperation).Return(nil).Once()
 
 		faNotificationSvc := &automock.FaNotificationService{}
 		assignmentMapping := fixAssignmentMappingPairWithAssignmentAndRequestWithReverse(initialStateAssignment, reverseInitialStateAssignment, mappingRequest, reverseMappingRequest)
@@ -2868,8 +3083,8 @@ func TestService_ProcessFormationAssignmentPair(t *testing.T) {
 		formationRepo.On("Get", ctxWithTenant, TestFormationID, TestTenantID).Return(formation, nil).Times(2)
 
 		faStatusService := &automock.StatusService{}
-		faStatusService.On("UpdateWithConstraints", ctxWithTenant, configAssignment, assignOperation).Return(nil).Once()
-		faStatusService.On("UpdateWithConstraints", ctxWithTenant, reverseConfigAssignment, assignOperation).Return(testErr).Once()
+		faStatusService.On("UpdateWithConstraints", ctxWithResponseActor, configAssignment, assignOperation).Return(nil).Once()
+		faStatusService.On("UpdateWithConstraints", ctxWithResponseActor, reverseConfigAssignment, assignOperation).Return(testErr).Once()
 
 		faNotificationSvc := &automock.FaNotificationService{}
 		assignmentMapping := fixAssignmentMappingPairWithAssignmentAndRequestWithReverse(initialStateAssignment, reverseInitialStateAssignment, mappingRequest, reverseMappingRequest)
@@ -2988,8 +3203,8 @@ func TestService_ProcessFormationAssignmentPair(t *testing.T) {
 		formationRepo.On("Get", ctxWithTenant, TestFormationID, TestTenantID).Return(formation, nil)
 
 		faStatusService := &automock.StatusService{}
-		faStatusService.On("UpdateWithConstraints", ctxWithTenant, configPendingStateWithConfigAssignment, assignOperation).Return(nil)
-		faStatusService.On("UpdateWithConstraints", ctxWithTenant, reverseConfigPendingAssignment, assignOperation).Return(nil)
+		faStatusService.On("UpdateWithConstraints", ctxWithResponseActor, configPendingStateWithConfigAssignment, assignOperation).Return(nil)
+		faStatusService.On("UpdateWithConstraints", ctxWithResponseActor, reverseConfigPendingAssignment, assignOperation).Return(nil)
 
 		faNotificationSvc := &automock.FaNotificationService{}
 		assignmentMapping := fixAssignmentMappingPairWithAssignmentAndRequestWithReverse(initialStateAssignment, reverseInitialStateAssignment, mappingRequest, reverseMappingRequest)
@@ -3144,7 +3359,7 @@ func TestService_CleanupFormationAssignment(t *testing.T) {
 			},
 			FAStatusService: func() *automock.StatusService {
 				svc := &automock.StatusService{}
-				svc.On("DeleteWithConstraints", ctxWithTenant, TestID).Return(nil).Once()
+				svc.On("DeleteWithConstraints", ctxWithResponseActor, TestID).Return(nil).Once()
 				return svc
 			},
 			FormationAssignmentMappingPairWithOperation: fixAssignmentMappingPairWithAssignmentAndRequest(configAssignmentWithTenantAndID, req),
@@ -3159,7 +3374,7 @@ func TestService_CleanupFormationAssignment(t *testing.T) {
 			},
 			FAStatusService: func() *automock.StatusService {
 				svc := &automock.StatusService{}
-				svc.On("DeleteWithConstraints", ctxWithTenant, TestID).Return(nil).Once()
+				svc.On("DeleteWithConstraints", ctxWithResponseActor, TestID).Return(nil).Once()
 				return svc
 			},
 			FANotificationSvc: func() *automock.FaNotificationService {
@@ -3175,8 +3390,9 @@ func TestService_CleanupFormationAssignment(t *testing.T) {
 			Context: ctxWithTenant,
 			FormationAssignmentRepo: func() *automock.FormationAssignmentRepository {
 				repo := &automock.FormationAssignmentRepository{}
-				repo.On("Exists", ctxWithTenant, TestID, TestTenantID).Return(true, nil).Once()
-				repo.On("Update", ctxWithTenant, assignmentWithTenantAndIDInDeletingState).Return(nil).Once()
+				repo.On("Get", ctxWithResponseActor, TestID, TestTenantID).Return(configAssignmentWithTenantAndID.Clone(), nil).Once()
+				repo.On("Update", ctxWithResponseActor, assignmentWithTenantAndIDInDeletingState).Return(nil).Once()
+				repo.On("CreateTransition", ctxWithResponseActor, mock.AnythingOfType("*model.FormationAssignmentTransition")).Return(nil).Once()
 				return repo
 			},
 			NotificationService: func() *automock.NotificationService {
@@ -3197,8 +3413,9 @@ func TestService_CleanupFormationAssignment(t *testing.T) {
 			Context: ctxWithTenant,
 			FormationAssignmentRepo: func() *automock.FormationAssignmentRepository {
 				repo := &automock.FormationAssignmentRepository{}
-				repo.On("Exists", ctxWithTenant, TestID, TestTenantID).Return(true, nil).Once()
-				repo.On("Update", ctxWithTenant, assignmentWithTenantAndIDInDeleteError).Return(nil).Once()
+				repo.On("Get", ctxWithResponseActor, TestID, TestTenantID).Return(configAssignmentWithoutConfig.Clone(), nil).Once()
+				repo.On("Update", ctxWithResponseActor, assignmentWithTenantAndIDInDeleteError).Return(nil).Once()
+				repo.On("CreateTransition", ctxWithResponseActor, mock.AnythingOfType("*model.FormationAssignmentTransition")).Return(nil).Once()
 				return repo
 			},
 			NotificationService: func() *automock.NotificationService {
@@ -3232,8 +3449,8 @@ func TestService_CleanupFormationAssignment(t *testing.T) {
 			Context: ctxWithTenant,
 			FormationAssignmentRepo: func() *automock.FormationAssignmentRepository {
 				repo := &automock.FormationAssignmentRepository{}
-				repo.On("Exists", ctxWithTenant, TestID, TestTenantID).Return(true, nil).Once()
-				repo.On("Update", ctxWithTenant, assignmentWithTenantAndIDInDeletingState).Return(testErr).Once()
+				repo.On("Get", ctxWithResponseActor, TestID, TestTenantID).Return(assignmentWithTenantAndIDInDeletingState, nil).Once()
+				repo.On("Update", ctxWithResponseActor, assignmentWithTenantAndIDInDeletingState).Return(testErr).Once()
 				return repo
 			},
 			NotificationService: func() *automock.NotificationService {
@@ -3266,7 +3483,7 @@ func TestService_CleanupFormationAssignment(t *testing.T) {
 			},
 			FAStatusService: func() *automock.StatusService {
 				updater := &automock.StatusService{}
-				updater.On("SetAssignmentToErrorStateWithConstraints", ctxWithTenant, configAssignmentWithTenantAndID.Clone(), testErr.Error(), formationassignment.AssignmentErrorCode(2), model.DeleteErrorAssignmentState, assignOperation).Return(nil).Once()
+				updater.On("SetAssignmentToErrorStateWithConstraints", ctxWithResponseActor, configAssignmentWithTenantAndID.Clone(), testErr.Error(), formationassignment.AssignmentErrorCode(2), model.DeleteErrorAssignmentState, assignOperation).Return(nil).Once()
 				return updater
 			},
 			FormationAssignmentMappingPairWithOperation: fixAssignmentMappingPairWithAssignmentAndRequest(configAssignmentWithTenantAndID.Clone(), req),
@@ -3277,8 +3494,8 @@ func TestService_CleanupFormationAssignment(t *testing.T) {
 			Context: ctxWithTenant,
 			FormationAssignmentRepo: func() *automock.FormationAssignmentRepository {
 				repo := &automock.FormationAssignmentRepository{}
-				repo.On("Exists", ctxWithTenant, TestID, TestTenantID).Return(true, nil).Once()
-				repo.On("Update", ctxWithTenant, assignmentWithTenantAndIDInDeletingState).Return(notFoundError).Once()
+				repo.On("Get", ctxWithResponseActor, TestID, TestTenantID).Return(assignmentWithTenantAndIDInDeletingState, nil).Once()
+				repo.On("Update", ctxWithResponseActor, assignmentWithTenantAndIDInDeletingState).Return(notFoundError).Once()
 				return repo
 			},
 			NotificationService: func() *automock.NotificationService {
@@ -3299,8 +3516,9 @@ func TestService_CleanupFormationAssignment(t *testing.T) {
 			Context: ctxWithTenant,
 			FormationAssignmentRepo: func() *automock.FormationAssignmentRepository {
 				repo := &automock.FormationAssignmentRepository{}
-				repo.On("Exists", ctxWithTenant, TestID, TestTenantID).Return(true, nil).Once()
+				repo.On("Get", ctxWithTenant, TestID, TestTenantID).Return(configAssignmentWithoutConfig.Clone(), nil).Once()
 				repo.On("Update", ctxWithTenant, deleteErrorStateAssignmentDeleteErr).Return(nil).Once()
+				repo.On("CreateTransition", ctxWithTenant, mock.AnythingOfType("*model.FormationAssignmentTransition")).Return(nil).Once()
 				repo.On("Delete", ctxWithTenant, TestID, TestTenantID).Return(testErr).Once()
 				return repo
 			},
@@ -3312,7 +3530,7 @@ func TestService_CleanupFormationAssignment(t *testing.T) {
 			Context: ctxWithTenant,
 			FormationAssignmentRepo: func() *automock.FormationAssignmentRepository {
 				repo := &automock.FormationAssignmentRepository{}
-				repo.On("Exists", ctxWithTenant, TestID, TestTenantID).Return(true, nil).Once()
+				repo.On("Get", ctxWithTenant, TestID, TestTenantID).Return(deleteErrorStateAssignmentDeleteErr, nil).Once()
 				repo.On("Update", ctxWithTenant, deleteErrorStateAssignmentDeleteErr).Return(testErr).Once()
 				repo.On("Delete", ctxWithTenant, TestID, TestTenantID).Return(testErr).Once()
 				return repo
@@ -3335,8 +3553,9 @@ func TestService_CleanupFormationAssignment(t *testing.T) {
 			Context: ctxWithTenant,
 			FormationAssignmentRepo: func() *automock.FormationAssignmentRepository {
 				repo := &automock.FormationAssignmentRepository{}
-				repo.On("Exists", ctxWithTenant, TestID, TestTenantID).Return(true, nil).Once()
+				repo.On("Get", ctxWithTenant, TestID, TestTenantID).Return(configAssignmentWithTenantAndID.Clone(), nil).Once()
 				repo.On("Update", ctxWithTenant, deleteErrorStateAssignmentTechnicalErr).Return(nil).Once()
+				repo.On("CreateTransition", ctxWithTenant, mock.AnythingOfType("*model.FormationAssignmentTransition")).Return(nil).Once()
 				return repo
 			},
 			NotificationService: func() *automock.NotificationService {
@@ -3358,7 +3577,7 @@ func TestService_CleanupFormationAssignment(t *testing.T) {
 			Context: ctxWithTenant,
 			FormationAssignmentRepo: func() *automock.FormationAssignmentRepository {
 				repo := &automock.FormationAssignmentRepository{}
-				repo.On("Exists", ctxWithTenant, TestID, TestTenantID).Return(true, nil).Once()
+				repo.On("Get", ctxWithTenant, TestID, TestTenantID).Return(deleteErrorStateAssignmentTechnicalErr, nil).Once()
 				repo.On("Update", ctxWithTenant, deleteErrorStateAssignmentTechnicalErr).Return(testErr).Once()
 				return repo
 			},
@@ -3381,8 +3600,8 @@ func TestService_CleanupFormationAssignment(t *testing.T) {
 			Context: ctxWithTenant,
 			FormationAssignmentRepo: func() *automock.FormationAssignmentRepository {
 				repo := &automock.FormationAssignmentRepository{}
-				repo.On("Exists", ctxWithTenant, TestID, TestTenantID).Return(true, nil).Once()
-				repo.On("Update", ctxWithTenant, assignmentWithTenantAndIDInDeleteError).Return(testErr).Once()
+				repo.On("Get", ctxWithResponseActor, TestID, TestTenantID).Return(assignmentWithTenantAndIDInDeleteError, nil).Once()
+				repo.On("Update", ctxWithResponseActor, assignmentWithTenantAndIDInDeleteError).Return(testErr).Once()
 				return repo
 			},
 			NotificationService: func() *automock.NotificationService {
@@ -3415,7 +3634,7 @@ func TestService_CleanupFormationAssignment(t *testing.T) {
 			},
 			FAStatusService: func() *automock.StatusService {
 				updater := &automock.StatusService{}
-				updater.On("SetAssignmentToErrorStateWithConstraints", ctxWithTenant, configAssignmentWithTenantAndID.Clone(), testErr.Error(), formationassignment.AssignmentErrorCode(2), model.DeleteErrorAssignmentState, assignOperation).Return(testErr).Once()
+				updater.On("SetAssignmentToErrorStateWithConstraints", ctxWithResponseActor, configAssignmentWithTenantAndID.Clone(), testErr.Error(), formationassignment.AssignmentErrorCode(2), model.DeleteErrorAssignmentState, assignOperation).Return(testErr).Once()
 				return updater
 			},
 			FormationAssignmentMappingPairWithOperation: fixAssignmentMappingPairWithAssignmentAndRequest(configAssignmentWithTenantAndID.Clone(), req),
@@ -3426,8 +3645,9 @@ func TestService_CleanupFormationAssignment(t *testing.T) {
 			Context: ctxWithTenant,
 			FormationAssignmentRepo: func() *automock.FormationAssignmentRepository {
 				repo := &automock.FormationAssignmentRepository{}
-				repo.On("Exists", ctxWithTenant, TestID, TestTenantID).Return(true, nil).Once()
-				repo.On("Update", ctxWithTenant, deleteErrorStateAssignmentWhileDeletingErr).Return(nil).Once()
+				repo.On("Get", ctxWithResponseActor, TestID, TestTenantID).Return(configAssignmentWithTenantAndID.Clone(), nil).Once()
+				repo.On("Update", ctxWithResponseActor, deleteErrorStateAssignmentWhileDeletingErr).Return(nil).Once()
+				repo.On("CreateTransition", ctxWithResponseActor, mock.AnythingOfType("*model.FormationAssignmentTransition")).Return(nil).Once()
 				return repo
 			},
 			NotificationService: func() *automock.NotificationService {
@@ -3443,7 +3663,7 @@ func TestService_CleanupFormationAssignment(t *testing.T) {
 			},
 			FAStatusService: func() *automock.StatusService {
 				updater := &automock.StatusService{}
-				updater.On("DeleteWithConstraints", ctxWithTenant, TestID).Return(testErr).Once()
+				updater.On("DeleteWithConstraints", ctxWithResponseActor, TestID).Return(testErr).Once()
 				return updater
 			},
 			FormationAssignmentMappingPairWithOperation: fixAssignmentMappingPairWithAssignmentAndRequest(configAssignmentWithTenantAndID.Clone(), req),
@@ -3465,7 +3685,7 @@ func TestService_CleanupFormationAssignment(t *testing.T) {
 			},
 			FAStatusService: func() *automock.StatusService {
 				updater := &automock.StatusService{}
-				updater.On("DeleteWithConstraints", ctxWithTenant, TestID).Return(notFoundError).Once()
+				updater.On("DeleteWithConstraints", ctxWithResponseActor, TestID).Return(notFoundError).Once()
 				return updater
 			},
 			FormationAssignmentMappingPairWithOperation: fixAssignmentMappingPairWithAssignmentAndRequest(configAssignmentWithTenantAndID.Clone(), req),
@@ -3475,8 +3695,8 @@ func TestService_CleanupFormationAssignment(t *testing.T) {
 			Context: ctxWithTenant,
 			FormationAssignmentRepo: func() *automock.FormationAssignmentRepository {
 				repo := &automock.FormationAssignmentRepository{}
-				repo.On("Exists", ctxWithTenant, TestID, TestTenantID).Return(true, nil).Once()
-				repo.On("Update", ctxWithTenant, deleteErrorStateAssignmentWhileDeletingErr).Return(testErr).Once()
+				repo.On("Get", ctxWithResponseActor, TestID, TestTenantID).Return(deleteErrorStateAssignmentWhileDeletingErr, nil).Once()
+				repo.On("Update", ctxWithResponseActor, deleteErrorStateAssignmentWhileDeletingErr).Return(testErr).Once()
 				return repo
 			},
 			NotificationService: func() *automock.NotificationService {
@@ -3492,7 +3712,7 @@ func TestService_CleanupFormationAssignment(t *testing.T) {
 			},
 			FAStatusService: func() *automock.StatusService {
 				updater := &automock.StatusService{}
-				updater.On("DeleteWithConstraints", ctxWithTenant, TestID).Return(testErr).Once()
+				updater.On("DeleteWithConstraints", ctxWithResponseActor, TestID).Return(testErr).Once()
 				return updater
 			},
 			FormationAssignmentMappingPairWithOperation: fixAssignmentMappingPairWithAssignmentAndRequest(configAssignmentWithTenantAndID.Clone(), req),
@@ -3503,8 +3723,8 @@ func TestService_CleanupFormationAssignment(t *testing.T) {
 			Context: ctxWithTenant,
 			FormationAssignmentRepo: func() *automock.FormationAssignmentRepository {
 				repo := &automock.FormationAssignmentRepository{}
-				repo.On("Exists", ctxWithTenant, TestID, TestTenantID).Return(true, nil).Once()
-				repo.On("Update", ctxWithTenant, deleteErrorStateAssignmentWhileDeletingErr).Return(notFoundError).Once()
+				repo.On("Get", ctxWithResponseActor, TestID, TestTenantID).Return(deleteErrorStateAssignmentWhileDeletingErr, nil).Once()
+				repo.On("Update", ctxWithResponseActor, deleteErrorStateAssignmentWhileDeletingErr).Return(notFoundError).Once()
 				return repo
 			},
 			NotificationService: func() *automock.NotificationService {
@@ -3520,7 +3740,7 @@ func TestService_CleanupFormationAssignment(t *testing.T) {
 			},
 			FAStatusService: func() *automock.StatusService {
 				updater := &automock.StatusService{}
-				updater.On("DeleteWithConstraints", ctxWithTenant, TestID).Return(testErr).Once()
+				updater.On("DeleteWithConstraints", ctxWithResponseActor, TestID).Return(testErr).Once()
 				return updater
 			},
 			FormationAssignmentMappingPairWithOperation: fixAssignmentMappingPairWithAssignmentAndRequest(configAssignmentWithTenantAndID.Clone(), req),
@@ -3558,7 +3778,7 @@ func TestService_CleanupFormationAssignment(t *testing.T) {
 			},
 			FAStatusService: func() *automock.StatusService {
 				updater := &automock.StatusService{}
-				updater.On("SetAssignmentToErrorStateWithConstraints", ctxWithTenant, configAssignmentWithTenantAndID.Clone(), "", formationassignment.AssignmentErrorCode(2), model.DeleteErrorAssignmentState, assignOperation).Return(notFoundError).Once()
+				updater.On("SetAssignmentToErrorStateWithConstraints", ctxWithResponseActor, configAssignmentWithTenantAndID.Clone(), "", formationassignment.AssignmentErrorCode(2), model.DeleteErrorAssignmentState, assignOperation).Return(notFoundError).Once()
 				return updater
 			},
 			FormationAssignmentMappingPairWithOperation: fixAssignmentMappingPairWithAssignmentAndRequest(configAssignmentWithTenantAndID.Clone(), req),
@@ -3579,7 +3799,7 @@ func TestService_CleanupFormationAssignment(t *testing.T) {
 			},
 			FAStatusService: func() *automock.StatusService {
 				updater := &automock.StatusService{}
-				updater.On("SetAssignmentToErrorStateWithConstraints", ctxWithTenant, configAssignmentWithTenantAndID.Clone(), "", formationassignment.AssignmentErrorCode(2), model.DeleteErrorAssignmentState, assignOperation).Return(testErr).Once()
+				updater.On("SetAssignmentToErrorStateWithConstraints", ctxWithResponseActor, configAssignmentWithTenantAndID.Clone(), "", formationassignment.AssignmentErrorCode(2), model.DeleteErrorAssignmentState, assignOperation).Return(testErr).Once()
 				return updater
 			},
 			FormationAssignmentMappingPairWithOperation: fixAssignmentMappingPairWithAssignmentAndRequest(configAssignmentWithTenantAndID.Clone(), req),
@@ -3618,7 +3838,7 @@ func TestService_CleanupFormationAssignment(t *testing.T) {
 				faNotificationSvc = testCase.FANotificationSvc()
 			}
 
-			svc := formationassignment.NewService(repo, nil, nil, nil, rtmCtxRepo, notificationSvc, faNotificationSvc, lblSvc, formationRepo, updater, rtmTypeLabelKey, appTypeLabelKey)
+			svc := formationassignment.NewService(repo, fixUUIDService(), nil, nil, rtmCtxRepo, notificationSvc, faNotificationSvc, lblSvc, formationRepo, updater, rtmTypeLabelKey, appTypeLabelKey)
 
 			// WHEN
 			isReverseProcessed, err := svc.CleanupFormationAssignment(testCase.Context, testCase.FormationAssignmentMappingPairWithOperation)
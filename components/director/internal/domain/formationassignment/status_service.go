@@ -17,14 +17,16 @@ import (
 // formationAssignmentStatusService service encapsulates all the specifics around persisting the state reported by notification receiver for a formation assignment
 type formationAssignmentStatusService struct {
 	repo                  FormationAssignmentRepository
+	uidSvc                UIDService
 	constraintEngine      constraintEngine
 	faNotificationService faNotificationService
 }
 
 // NewFormationAssignmentStatusService creates formation assignment status service
-func NewFormationAssignmentStatusService(repo FormationAssignmentRepository, constraintEngine constraintEngine, faNotificationService faNotificationService) *formationAssignmentStatusService {
+func NewFormationAssignmentStatusService(repo FormationAssignmentRepository, uidSvc UIDService, constraintEngine constraintEngine, faNotificationService faNotificationService) *formationAssignmentStatusService {
 	return &formationAssignmentStatusService{
 		repo:                  repo,
+		uidSvc:                uidSvc,
 		constraintEngine:      constraintEngine,
 		faNotificationService: faNotificationService,
 	}
@@ -41,10 +43,12 @@ func (fau *formationAssignmentStatusService) UpdateWithConstraints(ctx context.C
 		return errors.Wrapf(err, "while loading tenant from context")
 	}
 
-	if exists, err := fau.repo.Exists(ctx, id, tenantID); err != nil {
+	previous, err := fau.repo.Get(ctx, id, tenantID)
+	if err != nil {
+		if apperrors.IsNotFoundError(err) {
+			return apperrors.NewNotFoundError(resource.FormationAssignment, id)
+		}
 		return errors.Wrapf(err, "while ensuring formation assignment with ID: %q exists", id)
-	} else if !exists {
-		return apperrors.NewNotFoundError(resource.FormationAssignment, id)
 	}
 
 	joinPointDetails, err := fau.faNotificationService.PrepareDetailsForNotificationStatusReturned(ctx, tenantID, fa, operation)
@@ -60,6 +64,10 @@ func (fau *formationAssignmentStatusService) UpdateWithConstraints(ctx context.C
 		return errors.Wrapf(err, "while updating formation assignment with ID: %q", id)
 	}
 
+	if err = recordTransition(ctx, fau.repo, fau.uidSvc, previous, fa); err != nil {
+		return err
+	}
+
 	joinPointDetails.Location = formationconstraint.PostNotificationStatusReturned
 	if err := fau.constraintEngine.EnforceConstraints(ctx, formationconstraint.PostNotificationStatusReturned, joinPointDetails, joinPointDetails.Formation.FormationTemplateID); err != nil {
 		return errors.Wrapf(err, "while enforcing constraints for target operation %q and constraint type %q", model.NotificationStatusReturned, model.PostOperation)
@@ -102,12 +110,17 @@ func (fau *formationAssignmentStatusService) DeleteWithConstraints(ctx context.C
 		return errors.Wrapf(err, "while getting formation assignment with id %q for tenant with id %q", id, tenantID)
 	}
 
+	previous := fa.Clone()
 	fa.State = string(model.ReadyAssignmentState)
 	fa.Value = nil
 	if err := fau.repo.Update(ctx, fa); err != nil {
 		return errors.Wrapf(err, "while updating formation asssignment with ID: %s to: %q state", id, model.ReadyAssignmentState)
 	}
 
+	if err = recordTransition(ctx, fau.repo, fau.uidSvc, previous, fa); err != nil {
+		return err
+	}
+
 	joinPointDetails, err := fau.faNotificationService.PrepareDetailsForNotificationStatusReturned(ctx, tenantID, fa, model.UnassignFormation)
 	if err != nil {
 		return errors.Wrap(err, "while preparing details for NotificationStatusReturned")
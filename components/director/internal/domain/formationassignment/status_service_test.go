@@ -19,12 +19,16 @@ func TestStatusService_UpdateWithConstraints(t *testing.T) {
 	preJoinPointDetails := fixNotificationStatusReturnedDetails(model.ApplicationResourceType, appSubtype, fa, reverseFa, formationconstraint.PreNotificationStatusReturned)
 	postJoinPointDetails := fixNotificationStatusReturnedDetails(model.ApplicationResourceType, appSubtype, fa, reverseFa, formationconstraint.PostNotificationStatusReturned)
 
+	previousFa := fa.Clone()
+	previousFa.State = string(model.ConfigPendingAssignmentState)
+
 	// GIVEN
 	testCases := []struct {
 		Name                    string
 		Context                 context.Context
 		FormationAssignment     *model.FormationAssignment
 		FormationAssignmentRepo func() *automock.FormationAssignmentRepository
+		UIDService              func() *automock.UIDService
 		NotificationSvc         func() *automock.FaNotificationService
 		ConstraintEngine        func() *automock.ConstraintEngine
 		ExpectedErrorMsg        string
@@ -35,10 +39,12 @@ func TestStatusService_UpdateWithConstraints(t *testing.T) {
 			FormationAssignment: fa,
 			FormationAssignmentRepo: func() *automock.FormationAssignmentRepository {
 				repo := &automock.FormationAssignmentRepository{}
-				repo.On("Exists", ctxWithTenant, TestID, TestTenantID).Return(true, nil).Once()
+				repo.On("Get", ctxWithTenant, TestID, TestTenantID).Return(previousFa, nil).Once()
 				repo.On("Update", ctxWithTenant, fa).Return(nil).Once()
+				repo.On("CreateTransition", ctxWithTenant, mock.AnythingOfType("*model.FormationAssignmentTransition")).Return(nil).Once()
 				return repo
 			},
+			UIDService: fixUUIDService,
 			ConstraintEngine: func() *automock.ConstraintEngine {
 				constraintEngine := &automock.ConstraintEngine{}
 				constraintEngine.On("EnforceConstraints", ctxWithTenant, formationconstraint.PreNotificationStatusReturned, preJoinPointDetails, formation.FormationTemplateID).Return(nil).Once()
@@ -57,7 +63,7 @@ func TestStatusService_UpdateWithConstraints(t *testing.T) {
 			FormationAssignment: fa,
 			FormationAssignmentRepo: func() *automock.FormationAssignmentRepository {
 				repo := &automock.FormationAssignmentRepository{}
-				repo.On("Exists", ctxWithTenant, TestID, TestTenantID).Return(true, nil).Once()
+				repo.On("Get", ctxWithTenant, TestID, TestTenantID).Return(previousFa, nil).Once()
 				return repo
 			},
 			NotificationSvc: func() *automock.FaNotificationService {
@@ -73,7 +79,7 @@ func TestStatusService_UpdateWithConstraints(t *testing.T) {
 			FormationAssignment: fa,
 			FormationAssignmentRepo: func() *automock.FormationAssignmentRepository {
 				repo := &automock.FormationAssignmentRepository{}
-				repo.On("Exists", ctxWithTenant, TestID, TestTenantID).Return(true, nil).Once()
+				repo.On("Get", ctxWithTenant, TestID, TestTenantID).Return(previousFa, nil).Once()
 				return repo
 			},
 			ConstraintEngine: func() *automock.ConstraintEngine {
@@ -89,12 +95,12 @@ func TestStatusService_UpdateWithConstraints(t *testing.T) {
 			ExpectedErrorMsg: testErr.Error(),
 		},
 		{
-			Name:                "Error when checking for formation assignment existence",
+			Name:                "Error when getting formation assignment",
 			Context:             ctxWithTenant,
 			FormationAssignment: fa,
 			FormationAssignmentRepo: func() *automock.FormationAssignmentRepository {
 				repo := &automock.FormationAssignmentRepository{}
-				repo.On("Exists", ctxWithTenant, TestID, TestTenantID).Return(false, testErr).Once()
+				repo.On("Get", ctxWithTenant, TestID, TestTenantID).Return(nil, testErr).Once()
 				return repo
 			},
 			ExpectedErrorMsg: testErr.Error(),
@@ -105,7 +111,7 @@ func TestStatusService_UpdateWithConstraints(t *testing.T) {
 			FormationAssignment: fa,
 			FormationAssignmentRepo: func() *automock.FormationAssignmentRepository {
 				repo := &automock.FormationAssignmentRepository{}
-				repo.On("Exists", ctxWithTenant, TestID, TestTenantID).Return(false, nil).Once()
+				repo.On("Get", ctxWithTenant, TestID, TestTenantID).Return(nil, apperrors.NewNotFoundError(resource.FormationAssignment, TestID)).Once()
 				return repo
 			},
 			ExpectedErrorMsg: apperrors.NewNotFoundError(resource.FormationAssignment, fa.ID).Error(),
@@ -116,7 +122,7 @@ func TestStatusService_UpdateWithConstraints(t *testing.T) {
 			FormationAssignment: fa,
 			FormationAssignmentRepo: func() *automock.FormationAssignmentRepository {
 				repo := &automock.FormationAssignmentRepository{}
-				repo.On("Exists", ctxWithTenant, TestID, TestTenantID).Return(true, nil).Once()
+				repo.On("Get", ctxWithTenant, TestID, TestTenantID).Return(previousFa, nil).Once()
 				repo.On("Update", ctxWithTenant, fa).Return(testErr).Once()
 				return repo
 			},
@@ -132,16 +138,42 @@ func TestStatusService_UpdateWithConstraints(t *testing.T) {
 			},
 			ExpectedErrorMsg: testErr.Error(),
 		},
+		{
+			Name:                "Error when recording the formation assignment transition",
+			Context:             ctxWithTenant,
+			FormationAssignment: fa,
+			FormationAssignmentRepo: func() *automock.FormationAssignmentRepository {
+				repo := &automock.FormationAssignmentRepository{}
+				repo.On("Get", ctxWithTenant, TestID, TestTenantID).Return(previousFa, nil).Once()
+				repo.On("Update", ctxWithTenant, fa).Return(nil).Once()
+				repo.On("CreateTransition", ctxWithTenant, mock.AnythingOfType("*model.FormationAssignmentTransition")).Return(testErr).Once()
+				return repo
+			},
+			UIDService: fixUUIDService,
+			ConstraintEngine: func() *automock.ConstraintEngine {
+				constraintEngine := &automock.ConstraintEngine{}
+				constraintEngine.On("EnforceConstraints", ctxWithTenant, formationconstraint.PreNotificationStatusReturned, preJoinPointDetails, formation.FormationTemplateID).Return(nil).Once()
+				return constraintEngine
+			},
+			NotificationSvc: func() *automock.FaNotificationService {
+				notificationSvc := &automock.FaNotificationService{}
+				notificationSvc.On("PrepareDetailsForNotificationStatusReturned", ctxWithTenant, TestTenantID, fa, model.AssignFormation).Return(preJoinPointDetails, nil).Once()
+				return notificationSvc
+			},
+			ExpectedErrorMsg: testErr.Error(),
+		},
 		{
 			Name:                "Error when enforcing POST constraints",
 			Context:             ctxWithTenant,
 			FormationAssignment: fa,
 			FormationAssignmentRepo: func() *automock.FormationAssignmentRepository {
 				repo := &automock.FormationAssignmentRepository{}
-				repo.On("Exists", ctxWithTenant, TestID, TestTenantID).Return(true, nil).Once()
+				repo.On("Get", ctxWithTenant, TestID, TestTenantID).Return(previousFa, nil).Once()
 				repo.On("Update", ctxWithTenant, fa).Return(nil).Once()
+				repo.On("CreateTransition", ctxWithTenant, mock.AnythingOfType("*model.FormationAssignmentTransition")).Return(nil).Once()
 				return repo
 			},
+			UIDService: fixUUIDService,
 			ConstraintEngine: func() *automock.ConstraintEngine {
 				constraintEngine := &automock.ConstraintEngine{}
 				constraintEngine.On("EnforceConstraints", ctxWithTenant, formationconstraint.PreNotificationStatusReturned, preJoinPointDetails, formation.FormationTemplateID).Return(nil).Once()
@@ -163,6 +195,10 @@ func TestStatusService_UpdateWithConstraints(t *testing.T) {
 			if testCase.FormationAssignmentRepo != nil {
 				faRepo = testCase.FormationAssignmentRepo()
 			}
+			uidSvc := unusedUIDService()
+			if testCase.UIDService != nil {
+				uidSvc = testCase.UIDService()
+			}
 			constraintEngine := &automock.ConstraintEngine{}
 			if testCase.ConstraintEngine != nil {
 				constraintEngine = testCase.ConstraintEngine()
@@ -172,7 +208,7 @@ func TestStatusService_UpdateWithConstraints(t *testing.T) {
 				notificationSvc = testCase.NotificationSvc()
 			}
 
-			svc := formationassignment.NewFormationAssignmentStatusService(faRepo, constraintEngine, notificationSvc)
+			svc := formationassignment.NewFormationAssignmentStatusService(faRepo, uidSvc, constraintEngine, notificationSvc)
 
 			// WHEN
 			err := svc.UpdateWithConstraints(testCase.Context, testCase.FormationAssignment, assignOperation)
@@ -184,7 +220,7 @@ func TestStatusService_UpdateWithConstraints(t *testing.T) {
 				require.NoError(t, err)
 			}
 
-			mock.AssertExpectationsForObjects(t, faRepo, constraintEngine, notificationSvc)
+			mock.AssertExpectationsForObjects(t, faRepo, uidSvc, constraintEngine, notificationSvc)
 		})
 	}
 }
@@ -235,6 +271,7 @@ func TestUpdater_SetAssignmentToErrorState(t *testing.T) {
 		Context                 context.Context
 		FormationAssignment     *model.FormationAssignment
 		FormationAssignmentRepo func() *automock.FormationAssignmentRepository
+		UIDService              func() *automock.UIDService
 		FormationRepo           func() *automock.FormationRepository
 		ConstraintEngine        func() *automock.ConstraintEngine
 		NotificationSvc         func() *automock.FaNotificationService
@@ -247,10 +284,12 @@ func TestUpdater_SetAssignmentToErrorState(t *testing.T) {
 			FormationAssignment: fa.Clone(),
 			FormationAssignmentRepo: func() *automock.FormationAssignmentRepository {
 				repo := &automock.FormationAssignmentRepository{}
-				repo.On("Exists", ctxWithTenant, TestID, TestTenantID).Return(true, nil).Once()
+				repo.On("Get", ctxWithTenant, TestID, TestTenantID).Return(fa, nil).Once()
 				repo.On("Update", ctxWithTenant, faErrorState).Return(nil).Once()
+				repo.On("CreateTransition", ctxWithTenant, mock.AnythingOfType("*model.FormationAssignmentTransition")).Return(nil).Once()
 				return repo
 			},
+			UIDService: fixUUIDService,
 			ConstraintEngine: func() *automock.ConstraintEngine {
 				constraintEngine := &automock.ConstraintEngine{}
 				constraintEngine.On("EnforceConstraints", ctxWithTenant, formationconstraint.PreNotificationStatusReturned, preJoinPointDetails, formation.FormationTemplateID).Return(nil).Once()
@@ -270,7 +309,7 @@ func TestUpdater_SetAssignmentToErrorState(t *testing.T) {
 			FormationAssignment: fa.Clone(),
 			FormationAssignmentRepo: func() *automock.FormationAssignmentRepository {
 				repo := &automock.FormationAssignmentRepository{}
-				repo.On("Exists", ctxWithTenant, TestID, TestTenantID).Return(true, nil).Once()
+				repo.On("Get", ctxWithTenant, TestID, TestTenantID).Return(fa, nil).Once()
 				repo.On("Update", ctxWithTenant, faErrorState).Return(testErr).Once()
 				return repo
 			},
@@ -295,6 +334,10 @@ func TestUpdater_SetAssignmentToErrorState(t *testing.T) {
 			if testCase.FormationAssignmentRepo != nil {
 				faRepo = testCase.FormationAssignmentRepo()
 			}
+			uidSvc := unusedUIDService()
+			if testCase.UIDService != nil {
+				uidSvc = testCase.UIDService()
+			}
 			constraintEngine := &automock.ConstraintEngine{}
 			if testCase.ConstraintEngine != nil {
 				constraintEngine = testCase.ConstraintEngine()
@@ -308,7 +351,7 @@ func TestUpdater_SetAssignmentToErrorState(t *testing.T) {
 				notificationSvc = testCase.NotificationSvc()
 			}
 
-			svc := formationassignment.NewFormationAssignmentStatusService(faRepo, constraintEngine, notificationSvc)
+			svc := formationassignment.NewFormationAssignmentStatusService(faRepo, uidSvc, constraintEngine, notificationSvc)
 
 			// WHEN
 			err := svc.SetAssignmentToErrorStateWithConstraints(testCase.Context, testCase.FormationAssignment, errorMsg, formationassignment.TechnicalError, model.DeleteErrorAssignmentState, assignOperation)
@@ -320,7 +363,7 @@ func TestUpdater_SetAssignmentToErrorState(t *testing.T) {
 				require.NoError(t, err)
 			}
 
-			mock.AssertExpectationsForObjects(t, faRepo, constraintEngine, formationRepo, notificationSvc)
+			mock.AssertExpectationsForObjects(t, faRepo, uidSvc, constraintEngine, formationRepo, notificationSvc)
 		})
 	}
 }
@@ -338,6 +381,7 @@ func TestStatusService_DeleteWithConstraints(t *testing.T) {
 		Context                 context.Context
 		InputID                 string
 		FormationAssignmentRepo func() *automock.FormationAssignmentRepository
+		UIDService              func() *automock.UIDService
 		NotificationSvc         func() *automock.FaNotificationService
 		ConstraintEngine        func() *automock.ConstraintEngine
 		ExpectedErrorMsg        string
@@ -348,11 +392,13 @@ func TestStatusService_DeleteWithConstraints(t *testing.T) {
 			InputID: TestID,
 			FormationAssignmentRepo: func() *automock.FormationAssignmentRepository {
 				repo := &automock.FormationAssignmentRepository{}
-				repo.On("Get", ctxWithTenant, TestID, TestTenantID).Return(fa, nil).Once()
+				repo.On("Get", ctxWithTenant, TestID, TestTenantID).Return(fa.Clone(), nil).Once()
 				repo.On("Delete", ctxWithTenant, TestID, TestTenantID).Return(nil).Once()
 				repo.On("Update", ctxWithTenant, faWithReadyStateAndNoConfig).Return(nil).Once()
+				repo.On("CreateTransition", ctxWithTenant, mock.AnythingOfType("*model.FormationAssignmentTransition")).Return(nil).Once()
 				return repo
 			},
+			UIDService: fixUUIDService,
 			ConstraintEngine: func() *automock.ConstraintEngine {
 				constraintEngine := &automock.ConstraintEngine{}
 				constraintEngine.On("EnforceConstraints", ctxWithTenant, formationconstraint.PreNotificationStatusReturned, preJoinPointDetails, formation.FormationTemplateID).Return(nil).Once()
@@ -361,7 +407,7 @@ func TestStatusService_DeleteWithConstraints(t *testing.T) {
 			},
 			NotificationSvc: func() *automock.FaNotificationService {
 				notificationSvc := &automock.FaNotificationService{}
-				notificationSvc.On("PrepareDetailsForNotificationStatusReturned", ctxWithTenant, TestTenantID, fa, model.UnassignFormation).Return(preJoinPointDetails, nil).Once()
+				notificationSvc.On("PrepareDetailsForNotificationStatusReturned", ctxWithTenant, TestTenantID, faWithReadyStateAndNoConfig, model.UnassignFormation).Return(preJoinPointDetails, nil).Once()
 				return notificationSvc
 			},
 		},
@@ -388,7 +434,7 @@ func TestStatusService_DeleteWithConstraints(t *testing.T) {
 			InputID: TestID,
 			FormationAssignmentRepo: func() *automock.FormationAssignmentRepository {
 				repo := &automock.FormationAssignmentRepository{}
-				repo.On("Get", ctxWithTenant, TestID, TestTenantID).Return(fa, nil).Once()
+				repo.On("Get", ctxWithTenant, TestID, TestTenantID).Return(fa.Clone(), nil).Once()
 				repo.On("Update", ctxWithTenant, faWithReadyStateAndNoConfig).Return(testErr).Once()
 				return repo
 			},
@@ -400,11 +446,13 @@ func TestStatusService_DeleteWithConstraints(t *testing.T) {
 			InputID: TestID,
 			FormationAssignmentRepo: func() *automock.FormationAssignmentRepository {
 				repo := &automock.FormationAssignmentRepository{}
-				repo.On("Get", ctxWithTenant, TestID, TestTenantID).Return(fa, nil).Once()
+				repo.On("Get", ctxWithTenant, TestID, TestTenantID).Return(fa.Clone(), nil).Once()
 				repo.On("Update", ctxWithTenant, faWithReadyStateAndNoConfig).Return(nil).Once()
+				repo.On("CreateTransition", ctxWithTenant, mock.AnythingOfType("*model.FormationAssignmentTransition")).Return(nil).Once()
 				repo.On("Delete", ctxWithTenant, TestID, TestTenantID).Return(nil).Once()
 				return repo
 			},
+			UIDService: fixUUIDService,
 			ConstraintEngine: func() *automock.ConstraintEngine {
 				constraintEngine := &automock.ConstraintEngine{}
 				constraintEngine.On("EnforceConstraints", ctxWithTenant, formationconstraint.PreNotificationStatusReturned, preJoinPointDetails, formation.FormationTemplateID).Return(nil).Once()
@@ -413,7 +461,7 @@ func TestStatusService_DeleteWithConstraints(t *testing.T) {
 			},
 			NotificationSvc: func() *automock.FaNotificationService {
 				notificationSvc := &automock.FaNotificationService{}
-				notificationSvc.On("PrepareDetailsForNotificationStatusReturned", ctxWithTenant, TestTenantID, fa, model.UnassignFormation).Return(preJoinPointDetails, nil).Once()
+				notificationSvc.On("PrepareDetailsForNotificationStatusReturned", ctxWithTenant, TestTenantID, faWithReadyStateAndNoConfig, model.UnassignFormation).Return(preJoinPointDetails, nil).Once()
 				return notificationSvc
 			},
 			ExpectedErrorMsg: testErr.Error(),
@@ -424,11 +472,13 @@ func TestStatusService_DeleteWithConstraints(t *testing.T) {
 			InputID: TestID,
 			FormationAssignmentRepo: func() *automock.FormationAssignmentRepository {
 				repo := &automock.FormationAssignmentRepository{}
-				repo.On("Get", ctxWithTenant, TestID, TestTenantID).Return(fa, nil).Once()
+				repo.On("Get", ctxWithTenant, TestID, TestTenantID).Return(fa.Clone(), nil).Once()
 				repo.On("Update", ctxWithTenant, faWithReadyStateAndNoConfig).Return(nil).Once()
+				repo.On("CreateTransition", ctxWithTenant, mock.AnythingOfType("*model.FormationAssignmentTransition")).Return(nil).Once()
 				repo.On("Delete", ctxWithTenant, TestID, TestTenantID).Return(testErr).Once()
 				return repo
 			},
+			UIDService: fixUUIDService,
 			ConstraintEngine: func() *automock.ConstraintEngine {
 				constraintEngine := &automock.ConstraintEngine{}
 				constraintEngine.On("EnforceConstraints", ctxWithTenant, formationconstraint.PreNotificationStatusReturned, preJoinPointDetails, formation.FormationTemplateID).Return(nil).Once()
@@ -436,7 +486,7 @@ func TestStatusService_DeleteWithConstraints(t *testing.T) {
 			},
 			NotificationSvc: func() *automock.FaNotificationService {
 				notificationSvc := &automock.FaNotificationService{}
-				notificationSvc.On("PrepareDetailsForNotificationStatusReturned", ctxWithTenant, TestTenantID, fa, model.UnassignFormation).Return(preJoinPointDetails, nil).Once()
+				notificationSvc.On("PrepareDetailsForNotificationStatusReturned", ctxWithTenant, TestTenantID, faWithReadyStateAndNoConfig, model.UnassignFormation).Return(preJoinPointDetails, nil).Once()
 				return notificationSvc
 			},
 			ExpectedErrorMsg: testErr.Error(),
@@ -447,10 +497,12 @@ func TestStatusService_DeleteWithConstraints(t *testing.T) {
 			InputID: TestID,
 			FormationAssignmentRepo: func() *automock.FormationAssignmentRepository {
 				repo := &automock.FormationAssignmentRepository{}
-				repo.On("Get", ctxWithTenant, TestID, TestTenantID).Return(fa, nil).Once()
+				repo.On("Get", ctxWithTenant, TestID, TestTenantID).Return(fa.Clone(), nil).Once()
 				repo.On("Update", ctxWithTenant, faWithReadyStateAndNoConfig).Return(nil).Once()
+				repo.On("CreateTransition", ctxWithTenant, mock.AnythingOfType("*model.FormationAssignmentTransition")).Return(nil).Once()
 				return repo
 			},
+			UIDService: fixUUIDService,
 			ConstraintEngine: func() *automock.ConstraintEngine {
 				constraintEngine := &automock.ConstraintEngine{}
 				constraintEngine.On("EnforceConstraints", ctxWithTenant, formationconstraint.PreNotificationStatusReturned, preJoinPointDetails, formation.FormationTemplateID).Return(testErr).Once()
@@ -458,7 +510,7 @@ func TestStatusService_DeleteWithConstraints(t *testing.T) {
 			},
 			NotificationSvc: func() *automock.FaNotificationService {
 				notificationSvc := &automock.FaNotificationService{}
-				notificationSvc.On("PrepareDetailsForNotificationStatusReturned", ctxWithTenant, TestTenantID, fa, model.UnassignFormation).Return(preJoinPointDetails, nil).Once()
+				notificationSvc.On("PrepareDetailsForNotificationStatusReturned", ctxWithTenant, TestTenantID, faWithReadyStateAndNoConfig, model.UnassignFormation).Return(preJoinPointDetails, nil).Once()
 				return notificationSvc
 			},
 			ExpectedErrorMsg: testErr.Error(),
@@ -469,13 +521,15 @@ func TestStatusService_DeleteWithConstraints(t *testing.T) {
 			InputID: TestID,
 			FormationAssignmentRepo: func() *automock.FormationAssignmentRepository {
 				repo := &automock.FormationAssignmentRepository{}
-				repo.On("Get", ctxWithTenant, TestID, TestTenantID).Return(fa, nil).Once()
+				repo.On("Get", ctxWithTenant, TestID, TestTenantID).Return(fa.Clone(), nil).Once()
 				repo.On("Update", ctxWithTenant, faWithReadyStateAndNoConfig).Return(nil).Once()
+				repo.On("CreateTransition", ctxWithTenant, mock.AnythingOfType("*model.FormationAssignmentTransition")).Return(nil).Once()
 				return repo
 			},
+			UIDService: fixUUIDService,
 			NotificationSvc: func() *automock.FaNotificationService {
 				notificationSvc := &automock.FaNotificationService{}
-				notificationSvc.On("PrepareDetailsForNotificationStatusReturned", ctxWithTenant, TestTenantID, fa, model.UnassignFormation).Return(nil, testErr).Once()
+				notificationSvc.On("PrepareDetailsForNotificationStatusReturned", ctxWithTenant, TestTenantID, faWithReadyStateAndNoConfig, model.UnassignFormation).Return(nil, testErr).Once()
 				return notificationSvc
 			},
 			ExpectedErrorMsg: testErr.Error(),
@@ -488,6 +542,10 @@ func TestStatusService_DeleteWithConstraints(t *testing.T) {
 			if testCase.FormationAssignmentRepo != nil {
 				faRepo = testCase.FormationAssignmentRepo()
 			}
+			uidSvc := unusedUIDService()
+			if testCase.UIDService != nil {
+				uidSvc = testCase.UIDService()
+			}
 			constraintEngine := &automock.ConstraintEngine{}
 			if testCase.ConstraintEngine != nil {
 				constraintEngine = testCase.ConstraintEngine()
@@ -497,7 +555,7 @@ func TestStatusService_DeleteWithConstraints(t *testing.T) {
 				notificationSvc = testCase.NotificationSvc()
 			}
 
-			svc := formationassignment.NewFormationAssignmentStatusService(faRepo, constraintEngine, notificationSvc)
+			svc := formationassignment.NewFormationAssignmentStatusService(faRepo, uidSvc, constraintEngine, notificationSvc)
 
 			// WHEN
 			err := svc.DeleteWithConstraints(testCase.Context, testCase.InputID)
@@ -509,7 +567,7 @@ func TestStatusService_DeleteWithConstraints(t *testing.T) {
 				require.NoError(t, err)
 			}
 
-			mock.AssertExpectationsForObjects(t, faRepo, constraintEngine, notificationSvc)
+			mock.AssertExpectationsForObjects(t, faRepo, uidSvc, constraintEngine, notificationSvc)
 		})
 	}
 }
@@ -0,0 +1,133 @@
+package formationassignment
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/pkg/errors"
+)
+
+// maxConfigDiffLength is the maximum length of the configuration diff persisted for a single transition
+const maxConfigDiffLength = 1024
+
+type transitionActorCtxKey struct{}
+
+// SaveTransitionActorToContext returns a child context that attributes the formation assignment transitions made with it to the provided actor
+func SaveTransitionActorToContext(ctx context.Context, actor model.FormationAssignmentTransitionActor) context.Context {
+	return context.WithValue(ctx, transitionActorCtxKey{}, actor)
+}
+
+// TransitionActorFromContext returns the actor of the formation assignment transitions made with the provided context.
+// If no actor is present in the context, SystemTransitionActor is returned.
+func TransitionActorFromContext(ctx context.Context) model.FormationAssignmentTransitionActor {
+	actor, ok := ctx.Value(transitionActorCtxKey{}).(model.FormationAssignmentTransitionActor)
+	if !ok {
+		return model.SystemTransitionActor
+	}
+	return actor
+}
+
+// recordTransition appends a transition from the previous to the current state of the formation assignment to its state history.
+// No transition is recorded if the state, the error and the configuration of the formation assignment did not change.
+func recordTransition(ctx context.Context, repo FormationAssignmentRepository, uidSvc UIDService, previous, current *model.FormationAssignment) error {
+	diff := configDiff(previous.Value, current.Value)
+	if previous.State == current.State && bytes.Equal(previous.Error, current.Error) && diff == nil {
+		log.C(ctx).Debugf("Formation assignment with ID: %q did not change, no transition will be recorded", current.ID)
+		return nil
+	}
+
+	transition := &model.FormationAssignmentTransition{
+		ID:                    uidSvc.Generate(),
+		FormationAssignmentID: current.ID,
+		FormationID:           current.FormationID,
+		TenantID:              current.TenantID,
+		Source:                current.Source,
+		SourceType:            current.SourceType,
+		Target:                current.Target,
+		TargetType:            current.TargetType,
+		OldState:              previous.State,
+		NewState:              current.State,
+		Actor:                 TransitionActorFromContext(ctx),
+		ConfigDiff:            diff,
+		Timestamp:             time.Now().UTC(),
+	}
+
+	log.C(ctx).Infof("Recording transition of formation assignment with ID: %q from state %q to state %q by %q", transition.FormationAssignmentID, transition.OldState, transition.NewState, transition.Actor)
+	if err := repo.CreateTransition(ctx, transition); err != nil {
+		return errors.Wrapf(err, "while recording transition of formation assignment with ID: %q", current.ID)
+	}
+
+	return nil
+}
+
+// configDiff returns a description of the top-level configuration keys that were added, removed or changed. Only the keys are
+// included, as the configuration values may contain credentials. If the configurations are not JSON objects, only the fact that
+// the configuration changed is recorded. Nil is returned if the configuration did not change.
+func configDiff(previous, current json.RawMessage) *string {
+	if string(previous) == string(current) {
+		return nil
+	}
+
+	var previousObj, currentObj map[string]interface{}
+	if err := json.Unmarshal(nonEmptyJSON(previous), &previousObj); err != nil {
+		return truncateConfigDiff("configuration changed")
+	}
+	if err := json.Unmarshal(nonEmptyJSON(current), &currentObj); err != nil {
+		return truncateConfigDiff("configuration changed")
+	}
+
+	var added, removed, changed []string
+	for key, value := range currentObj {
+		previousValue, ok := previousObj[key]
+		if !ok {
+			added = append(added, key)
+		} else if !reflect.DeepEqual(previousValue, value) {
+			changed = append(changed, key)
+		}
+	}
+	for key := range previousObj {
+		if _, ok := currentObj[key]; !ok {
+			removed = append(removed, key)
+		}
+	}
+
+	if len(added) == 0 && len(removed) == 0 && len(changed) == 0 {
+		return nil
+	}
+
+	parts := make([]string, 0, 3)
+	for _, p := range []struct {
+		prefix string
+		keys   []string
+	}{{"+", added}, {"-", removed}, {"~", changed}} {
+		if len(p.keys) == 0 {
+			continue
+		}
+		sort.Strings(p.keys)
+		parts = append(parts, fmt.Sprintf("%s%s", p.prefix, strings.Join(p.keys, ","+p.prefix)))
+	}
+
+	return truncateConfigDiff(strings.Join(parts, " "))
+}
+
+func nonEmptyJSON(message json.RawMessage) json.RawMessage {
+	if len(message) == 0 || string(message) == "\"\"" {
+		return json.RawMessage("null")
+	}
+	return message
+}
+
+func truncateConfigDiff(diff string) *string {
+	if len(diff) > maxConfigDiffLength {
+		diff = diff[:maxConfigDiffLength-3] + "..."
+	}
+	return &diff
+}
@@ -0,0 +1,51 @@
+package formationassignment
+
+import (
+	"context"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/pkg/errors"
+)
+
+// TransitionRepository is responsible for the repo-layer formation assignment transition operations needed by the TransitionsCleaner
+//
+//go:generate mockery --name=TransitionRepository --output=automock --outpkg=automock --case=underscore --disable-version-string
+type TransitionRepository interface {
+	DeleteTransitionsOlderThan(ctx context.Context, date time.Time) error
+}
+
+// TransitionsCleaner deletes the formation assignment transitions older than the retention period. The transitions are kept
+// after their formation assignment is deleted, so this is the only place where they are removed.
+type TransitionsCleaner struct {
+	transact        persistence.Transactioner
+	repo            TransitionRepository
+	retentionPeriod time.Duration
+}
+
+// NewTransitionsCleaner creates a new TransitionsCleaner
+func NewTransitionsCleaner(transact persistence.Transactioner, repo TransitionRepository, retentionPeriod time.Duration) *TransitionsCleaner {
+	return &TransitionsCleaner{
+		transact:        transact,
+		repo:            repo,
+		retentionPeriod: retentionPeriod,
+	}
+}
+
+// DeleteExpiredTransitions deletes the formation assignment transitions recorded before the retention period
+func (c *TransitionsCleaner) DeleteExpiredTransitions(ctx context.Context) error {
+	tx, err := c.transact.Begin()
+	if err != nil {
+		return errors.Wrap(err, "while beginning transaction")
+	}
+	defer c.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	date := time.Now().Add(-c.retentionPeriod)
+	if err := c.repo.DeleteTransitionsOlderThan(ctx, date); err != nil {
+		return errors.Wrapf(err, "while deleting formation assignment transitions older than %v", date)
+	}
+
+	return errors.Wrap(tx.Commit(), "while committing transaction")
+}
@@ -0,0 +1,90 @@
+package formationassignment_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/formationassignment"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/formationassignment/automock"
+	persistenceautomock "github.com/kyma-incubator/compass/components/director/pkg/persistence/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransitionsCleaner_DeleteExpiredTransitions(t *testing.T) {
+	testErr := errors.New("test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+	retentionPeriod := time.Hour
+
+	expiredBefore := mock.MatchedBy(func(date time.Time) bool {
+		return date.Before(time.Now().Add(-retentionPeriod).Add(time.Second))
+	})
+
+	testCases := []struct {
+		Name             string
+		TransactionerFn  func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		TransitionRepoFn func() *automock.TransitionRepository
+		ExpectedErrorMsg string
+	}{
+		{
+			Name:            "Success",
+			TransactionerFn: txGen.ThatSucceeds,
+			TransitionRepoFn: func() *automock.TransitionRepository {
+				repo := &automock.TransitionRepository{}
+				repo.On("DeleteTransitionsOlderThan", txtest.CtxWithDBMatcher(), expiredBefore).Return(nil).Once()
+				return repo
+			},
+		},
+		{
+			Name:             "Error when beginning transaction fails",
+			TransactionerFn:  txGen.ThatFailsOnBegin,
+			TransitionRepoFn: func() *automock.TransitionRepository { return &automock.TransitionRepository{} },
+			ExpectedErrorMsg: testErr.Error(),
+		},
+		{
+			Name:            "Error when deleting the transitions fails",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			TransitionRepoFn: func() *automock.TransitionRepository {
+				repo := &automock.TransitionRepository{}
+				repo.On("DeleteTransitionsOlderThan", txtest.CtxWithDBMatcher(), expiredBefore).Return(testErr).Once()
+				return repo
+			},
+			ExpectedErrorMsg: "while deleting formation assignment transitions older than",
+		},
+		{
+			Name:            "Error when committing transaction fails",
+			TransactionerFn: txGen.ThatFailsOnCommit,
+			TransitionRepoFn: func() *automock.TransitionRepository {
+				repo := &automock.TransitionRepository{}
+				repo.On("DeleteTransitionsOlderThan", txtest.CtxWithDBMatcher(), expiredBefore).Return(nil).Once()
+				return repo
+			},
+			ExpectedErrorMsg: "while committing transaction",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			persist, transact := testCase.TransactionerFn()
+			transitionRepo := testCase.TransitionRepoFn()
+			defer mock.AssertExpectationsForObjects(t, persist, transact, transitionRepo)
+
+			cleaner := formationassignment.NewTransitionsCleaner(transact, transitionRepo, retentionPeriod)
+
+			// WHEN
+			err := cleaner.DeleteExpiredTransitions(context.TODO())
+
+			// THEN
+			if testCase.ExpectedErrorMsg != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), testCase.ExpectedErrorMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -14,6 +14,22 @@ type FormationConstraintConverter struct {
 	mock.Mock
 }
 
+// EvaluationsToGraphQL provides a mock function with given fields: in
+func (_m *FormationConstraintConverter) EvaluationsToGraphQL(in []*model.FormationConstraintEvaluation) []*graphql.FormationConstraintEvaluation {
+	ret := _m.Called(in)
+
+	var r0 []*graphql.FormationConstraintEvaluation
+	if rf, ok := ret.Get(0).(func([]*model.FormationConstraintEvaluation) []*graphql.FormationConstraintEvaluation); ok {
+		r0 = rf(in)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*graphql.FormationConstraintEvaluation)
+		}
+	}
+
+	return r0
+}
+
 // FromInputGraphQL provides a mock function with given fields: in
 func (_m *FormationConstraintConverter) FromInputGraphQL(in *graphql.FormationConstraintInput) *model.FormationConstraintInput {
 	ret := _m.Called(in)
@@ -3,6 +3,7 @@ package formationconstraint
 import (
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
 )
 
 // NewConverter creates a new formation constraint converter
@@ -49,6 +50,34 @@ func (c *converter) MultipleToGraphQL(in []*model.FormationConstraint) []*graphq
 	return formationConstraints
 }
 
+// EvaluationsToGraphQL converts multiple internal formation constraint evaluations to GraphQL models
+func (c *converter) EvaluationsToGraphQL(in []*model.FormationConstraintEvaluation) []*graphql.FormationConstraintEvaluation {
+	if in == nil {
+		return nil
+	}
+	evaluations := make([]*graphql.FormationConstraintEvaluation, 0, len(in))
+	for _, e := range in {
+		if e == nil {
+			continue
+		}
+
+		evaluation := &graphql.FormationConstraintEvaluation{
+			Constraint: c.ToGraphQL(e.Constraint),
+			Satisfied:  e.Satisfied,
+			Skipped:    e.Skipped,
+		}
+		if e.Reason != "" {
+			evaluation.Reason = str.Ptr(e.Reason)
+		}
+		if e.RenderedInput != "" {
+			evaluation.RenderedInput = str.Ptr(e.RenderedInput)
+		}
+		evaluations = append(evaluations, evaluation)
+	}
+
+	return evaluations
+}
+
 // ToEntity converts from internal model to entity
 func (c *converter) ToEntity(in *model.FormationConstraint) *Entity {
 	if in == nil {
@@ -45,6 +45,23 @@ func TestMultipleToGraphQL(t *testing.T) {
 	})
 }
 
+func TestEvaluationsToGraphQL(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		// WHEN
+		actual := converter.EvaluationsToGraphQL([]*model.FormationConstraintEvaluation{satisfiedEvaluation, skippedEvaluation, nil})
+
+		// THEN
+		require.Equal(t, []*graphql.FormationConstraintEvaluation{gqlSatisfiedEvaluation, gqlSkippedEvaluation}, actual)
+	})
+	t.Run("Nil input", func(t *testing.T) {
+		// WHEN
+		actual := converter.EvaluationsToGraphQL(nil)
+
+		// THEN
+		require.Nil(t, actual)
+	})
+}
+
 func TestToEntity(t *testing.T) {
 	t.Run("Success", func(t *testing.T) {
 		// WHEN
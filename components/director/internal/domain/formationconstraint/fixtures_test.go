@@ -7,6 +7,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	formationconstraintpkg "github.com/kyma-incubator/compass/components/director/pkg/formationconstraint"
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
 )
 
 const (
@@ -148,6 +149,30 @@ var (
 	}
 	matchingDetails = details.GetMatchingDetails()
 
+	renderedInput       = `{"resource_type":"APPLICATION"}`
+	satisfiedEvaluation = &model.FormationConstraintEvaluation{
+		Constraint:    formationConstraintModel,
+		Satisfied:     true,
+		RenderedInput: renderedInput,
+	}
+	skippedEvaluation = &model.FormationConstraintEvaluation{
+		Constraint:    formationConstraintModel,
+		Skipped:       true,
+		Reason:        "skipped",
+		RenderedInput: renderedInput,
+	}
+	gqlSatisfiedEvaluation = &graphql.FormationConstraintEvaluation{
+		Constraint:    gqlFormationConstraint,
+		Satisfied:     true,
+		RenderedInput: str.Ptr(renderedInput),
+	}
+	gqlSkippedEvaluation = &graphql.FormationConstraintEvaluation{
+		Constraint:    gqlFormationConstraint,
+		Skipped:       true,
+		Reason:        str.Ptr("skipped"),
+		RenderedInput: str.Ptr(renderedInput),
+	}
+
 	gqlInput       = &graphql.FormationConstraintInput{Name: testName}
 	modelInput     = &model.FormationConstraintInput{Name: testName}
 	modelFromInput = &model.FormationConstraint{ID: testID, Name: testName}
@@ -209,3 +234,7 @@ func UnusedFormationConstraintConverter() *automock.FormationConstraintConverter
 func fixColumns() []string {
 	return []string{"id", "name", "constraint_type", "target_operation", "operator", "resource_type", "resource_subtype", "input_template", "constraint_scope"}
 }
+
+func UnusedConstraintEvaluator() *automock.ConstraintEvaluator {
+	return &automock.ConstraintEvaluator{}
+}
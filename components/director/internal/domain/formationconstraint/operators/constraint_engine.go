@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
 
 	"github.com/hashicorp/go-multierror"
@@ -12,7 +14,9 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	formationconstraintpkg "github.com/kyma-incubator/compass/components/director/pkg/formationconstraint"
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/tracing"
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 //go:generate mockery --exported --name=formationConstraintSvc --output=automock --outpkg=automock --case=underscore --disable-version-string
@@ -23,6 +27,7 @@ type formationConstraintSvc interface {
 //go:generate mockery --exported --name=tenantService --output=automock --outpkg=automock --case=underscore --disable-version-string
 type tenantService interface {
 	GetInternalTenant(ctx context.Context, externalTenant string) (string, error)
+	GetTenantByExternalID(ctx context.Context, id string) (*model.BusinessTenantMapping, error)
 }
 
 //go:generate mockery --exported --name=automaticScenarioAssignmentService --output=automock --outpkg=automock --case=underscore --disable-version-string
@@ -84,6 +89,18 @@ type formationAssignmentRepository interface {
 	Update(ctx context.Context, model *model.FormationAssignment) error
 }
 
+// readOnlyOperators are the only operators executed when the constraints are only evaluated.
+// Any other operator, including newly added ones, might have side effects and is reported as skipped.
+var readOnlyOperators = map[OperatorName]struct{}{
+	IsNotAssignedToAnyFormationOfTypeOperator:           {},
+	DoesNotContainResourceOfSubtypeOperator:             {},
+	ContainsAtMostNResourcesOfSubtypeOperator:           {},
+	IsTenantOfTypeOperator:                              {},
+	HasLabelWithValueOperator:                           {},
+	HaveSameLabelValueOperator:                          {},
+	DoNotGenerateFormationAssignmentNotificationOperator: {},
+}
+
 // OperatorInput represents the input needed by the constraint operator
 type OperatorInput interface{}
 
@@ -138,6 +155,10 @@ func NewConstraintEngine(transact persistence.Transactioner, constraintSvc forma
 			DoesNotContainResourceOfSubtypeOperator:              NewDoesNotContainResourceOfSubtypeInput,
 			DoNotGenerateFormationAssignmentNotificationOperator: NewDoNotGenerateFormationAssignmentNotificationInput,
 			DestinationCreatorOperator:                           NewDestinationCreatorInput,
+			ContainsAtMostNResourcesOfSubtypeOperator:            NewContainsAtMostNResourcesOfSubtypeInput,
+			HasLabelWithValueOperator:                            NewHasLabelWithValueInput,
+			HaveSameLabelValueOperator:                           NewHaveSameLabelValueInput,
+			IsTenantOfTypeOperator:                               NewIsTenantOfTypeInput,
 		},
 		runtimeTypeLabelKey:     runtimeTypeLabelKey,
 		applicationTypeLabelKey: applicationTypeLabelKey,
@@ -147,6 +168,10 @@ func NewConstraintEngine(transact persistence.Transactioner, constraintSvc forma
 		DoesNotContainResourceOfSubtypeOperator:              c.DoesNotContainResourceOfSubtype,
 		DoNotGenerateFormationAssignmentNotificationOperator: c.DoNotGenerateFormationAssignmentNotification,
 		DestinationCreatorOperator:                           c.DestinationCreator,
+		ContainsAtMostNResourcesOfSubtypeOperator:            c.ContainsAtMostNResourcesOfSubtype,
+		HasLabelWithValueOperator:                            c.HasLabelWithValue,
+		HaveSameLabelValueOperator:                           c.HaveSameLabelValue,
+		IsTenantOfTypeOperator:                               c.IsTenantOfType,
 	}
 	return c
 }
@@ -155,6 +180,18 @@ func NewConstraintEngine(transact persistence.Transactioner, constraintSvc forma
 // If any constraint is not satisfied this information is stored and the engine proceeds with enforcing the next constraint if such exists. In the end if
 // any constraint was not satisfied an error is returned.
 func (e *ConstraintEngine) EnforceConstraints(ctx context.Context, location formationconstraintpkg.JoinPointLocation, details formationconstraintpkg.JoinPointDetails, formationTemplateID string) error {
+	ctx, span := tracing.StartSpan(ctx, "constraint engine enforce constraints",
+		attribute.String("constraint.operation", string(location.OperationName)),
+		attribute.String("constraint.type", string(location.ConstraintType)))
+	defer span.End()
+
+	err := e.enforceConstraints(ctx, location, details, formationTemplateID)
+	tracing.RecordError(span, err)
+
+	return err
+}
+
+func (e *ConstraintEngine) enforceConstraints(ctx context.Context, location formationconstraintpkg.JoinPointLocation, details formationconstraintpkg.JoinPointDetails, formationTemplateID string) error {
 	matchingDetails := details.GetMatchingDetails()
 	log.C(ctx).Infof("Enforcing constraints for target operation %q, constraint type %q, resource type %q and resource subtype %q", location.OperationName, location.ConstraintType, matchingDetails.ResourceType, matchingDetails.ResourceSubtype)
 
@@ -172,31 +209,9 @@ func (e *ConstraintEngine) EnforceConstraints(ctx context.Context, location form
 
 	var errs *multierror.Error
 	for _, mc := range constraints {
-		operatorFunc, ok := e.operators[OperatorName(mc.Operator)]
-		if !ok {
-			errs = multierror.Append(errs, formationconstraint.ConstraintError{
-				ConstraintName: mc.Name,
-				Reason:         fmt.Sprintf("Operator %q not found", mc.Operator),
-			})
-			continue
-		}
-
-		operatorInputConstructor, ok := e.operatorInputConstructors[OperatorName(mc.Operator)]
-		if !ok {
-			errs = multierror.Append(errs, formationconstraint.ConstraintError{
-				ConstraintName: mc.Name,
-				Reason:         fmt.Sprintf("Operator input constructor for operator %q not found", mc.Operator),
-			})
-			continue
-		}
-
-		operatorInput := operatorInputConstructor()
-		if err := formationconstraintpkg.ParseInputTemplate(mc.InputTemplate, details, operatorInput); err != nil {
-			log.C(ctx).Errorf("An error occurred while parsing input template for formation constraint %q: %s", mc.Name, err.Error())
-			errs = multierror.Append(errs, formationconstraint.ConstraintError{
-				ConstraintName: mc.Name,
-				Reason:         fmt.Sprintf("Failed to parse operator input template for operator %q", mc.Operator),
-			})
+		operatorFunc, operatorInput, constraintErr := e.prepareOperator(ctx, mc, details)
+		if constraintErr != nil {
+			errs = multierror.Append(errs, *constraintErr)
 			continue
 		}
 
@@ -218,3 +233,164 @@ func (e *ConstraintEngine) EnforceConstraints(ctx context.Context, location form
 
 	return errs.ErrorOrNil()
 }
+
+// EvaluateConstraints finds the constraints of the formation template applicable to the JoinPointLocation and evaluates them against join point details
+// synthesized for the provided object, without enforcing them. Only read-only operators are executed, the others are reported as skipped.
+func (e *ConstraintEngine) EvaluateConstraints(ctx context.Context, formationTemplateID string, location formationconstraintpkg.JoinPointLocation, objectID string, objectType model.ResourceType) ([]*model.FormationConstraintEvaluation, error) {
+	details, err := e.syntheticJoinPointDetails(ctx, formationTemplateID, location, objectID, objectType)
+	if err != nil {
+		return nil, err
+	}
+
+	matchingDetails := details.GetMatchingDetails()
+	log.C(ctx).Infof("Evaluating constraints for target operation %q, constraint type %q, resource type %q and resource subtype %q", location.OperationName, location.ConstraintType, matchingDetails.ResourceType, matchingDetails.ResourceSubtype)
+
+	constraints, err := e.constraintSvc.ListMatchingConstraints(ctx, formationTemplateID, location, matchingDetails)
+	if err != nil {
+		return nil, errors.Wrapf(err, "While listing matching constraints for target operation %q, constraint type %q, resource type %q and resource subtype %q", location.OperationName, location.ConstraintType, matchingDetails.ResourceType, matchingDetails.ResourceSubtype)
+	}
+
+	evaluations := make([]*model.FormationConstraintEvaluation, 0, len(constraints))
+	for _, mc := range constraints {
+		evaluations = append(evaluations, e.evaluateConstraint(ctx, mc, details))
+	}
+
+	return evaluations, nil
+}
+
+func (e *ConstraintEngine) evaluateConstraint(ctx context.Context, mc *model.FormationConstraint, details formationconstraintpkg.JoinPointDetails) *model.FormationConstraintEvaluation {
+	evaluation := &model.FormationConstraintEvaluation{Constraint: mc}
+
+	operatorFunc, operatorInput, constraintErr := e.prepareOperator(ctx, mc, details)
+	if constraintErr != nil {
+		evaluation.Reason = constraintErr.Reason
+		return evaluation
+	}
+
+	renderedInput, err := json.Marshal(operatorInput)
+	if err != nil {
+		evaluation.Reason = fmt.Sprintf("Failed to marshal operator input for operator %q: %v", mc.Operator, err)
+		return evaluation
+	}
+	evaluation.RenderedInput = string(renderedInput)
+
+	if _, ok := readOnlyOperators[OperatorName(mc.Operator)]; !ok {
+		evaluation.Skipped = true
+		evaluation.Reason = fmt.Sprintf("Operator %q might have side effects and is not executed", mc.Operator)
+		return evaluation
+	}
+
+	operatorResult, err := operatorFunc(ctx, operatorInput)
+	if err != nil {
+		evaluation.Reason = fmt.Sprintf("An error occurred while executing operator %q for formation constraint %q: %v", mc.Operator, mc.Name, err)
+		return evaluation
+	}
+
+	evaluation.Satisfied = operatorResult
+	if !operatorResult {
+		evaluation.Reason = fmt.Sprintf("Operator %q is not satisfied", mc.Operator)
+	}
+
+	return evaluation
+}
+
+// prepareOperator finds the operator of the constraint and renders its input template with the join point details
+func (e *ConstraintEngine) prepareOperator(ctx context.Context, mc *model.FormationConstraint, details formationconstraintpkg.JoinPointDetails) (OperatorFunc, OperatorInput, *formationconstraint.ConstraintError) {
+	operatorFunc, ok := e.operators[OperatorName(mc.Operator)]
+	if !ok {
+		return nil, nil, &formationconstraint.ConstraintError{
+			ConstraintName: mc.Name,
+			Reason:         fmt.Sprintf("Operator %q not found", mc.Operator),
+		}
+	}
+
+	operatorInputConstructor, ok := e.operatorInputConstructors[OperatorName(mc.Operator)]
+	if !ok {
+		return nil, nil, &formationconstraint.ConstraintError{
+			ConstraintName: mc.Name,
+			Reason:         fmt.Sprintf("Operator input constructor for operator %q not found", mc.Operator),
+		}
+	}
+
+	operatorInput := operatorInputConstructor()
+	if err := formationconstraintpkg.ParseInputTemplate(mc.InputTemplate, details, operatorInput); err != nil {
+		log.C(ctx).Errorf("An error occurred while parsing input template for formation constraint %q: %s", mc.Name, err.Error())
+		return nil, nil, &formationconstraint.ConstraintError{
+			ConstraintName: mc.Name,
+			Reason:         fmt.Sprintf("Failed to parse operator input template for operator %q", mc.Operator),
+		}
+	}
+
+	return operatorFunc, operatorInput, nil
+}
+
+// syntheticJoinPointDetails builds the join point details which the operation on the object would produce. The formation related fields
+// which are not known before the operation, such as the formation ID, are left empty. For the formation operations the object ID is the formation name.
+func (e *ConstraintEngine) syntheticJoinPointDetails(ctx context.Context, formationTemplateID string, location formationconstraintpkg.JoinPointLocation, objectID string, objectType model.ResourceType) (formationconstraintpkg.JoinPointDetails, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while loading tenant from context")
+	}
+
+	formationTemplate, err := e.formationTemplateRepo.Get(ctx, formationTemplateID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while getting formation template with ID %q", formationTemplateID)
+	}
+
+	switch location.OperationName {
+	case model.AssignFormationOperation, model.UnassignFormationOperation:
+		if objectType == model.FormationResourceType {
+			return nil, apperrors.NewInvalidDataError("object type %q is not supported for target operation %q", objectType, location.OperationName)
+		}
+
+		resourceSubtype, err := e.getSyntheticObjectSubtype(ctx, tnt, objectType, objectID)
+		if err != nil {
+			return nil, err
+		}
+
+		if location.OperationName == model.UnassignFormationOperation {
+			return &formationconstraintpkg.UnassignFormationOperationDetails{
+				ResourceType:        objectType,
+				ResourceSubtype:     resourceSubtype,
+				ResourceID:          objectID,
+				FormationType:       formationTemplate.Name,
+				FormationTemplateID: formationTemplate.ID,
+				TenantID:            tnt,
+			}, nil
+		}
+
+		return &formationconstraintpkg.AssignFormationOperationDetails{
+			ResourceType:        objectType,
+			ResourceSubtype:     resourceSubtype,
+			ResourceID:          objectID,
+			FormationType:       formationTemplate.Name,
+			FormationTemplateID: formationTemplate.ID,
+			TenantID:            tnt,
+		}, nil
+	case model.CreateFormationOperation, model.DeleteFormationOperation:
+		if objectType != model.FormationResourceType {
+			return nil, apperrors.NewInvalidDataError("object type %q is not supported for target operation %q", objectType, location.OperationName)
+		}
+
+		return &formationconstraintpkg.CRUDFormationOperationDetails{
+			FormationType:       formationTemplate.Name,
+			FormationTemplateID: formationTemplate.ID,
+			FormationName:       objectID,
+			TenantID:            tnt,
+		}, nil
+	default:
+		return nil, apperrors.NewInvalidDataError("evaluation of constraints is not supported for target operation %q", location.OperationName)
+	}
+}
+
+func (e *ConstraintEngine) getSyntheticObjectSubtype(ctx context.Context, tnt string, objectType model.ResourceType, objectID string) (string, error) {
+	if objectType != model.TenantResourceType {
+		return e.getObjectSubtype(ctx, tnt, objectType, objectID)
+	}
+
+	t, err := e.tenantSvc.GetTenantByExternalID(ctx, objectID)
+	if err != nil {
+		return "", errors.Wrapf(err, "while getting tenant with external ID %q", objectID)
+	}
+	return string(t.Type), nil
+}
@@ -6,6 +6,8 @@ import (
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/formationconstraint/operators"
 
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
+
 	"github.com/kyma-incubator/compass/components/director/internal/domain/formationconstraint/operators/automock"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	formationconstraintpkg "github.com/kyma-incubator/compass/components/director/pkg/formationconstraint"
@@ -34,7 +36,7 @@ func TestConstraintEngine_EnforceConstraints(t *testing.T) {
 			},
 			FormationConstraintService: func() *automock.FormationConstraintSvc {
 				svc := &automock.FormationConstraintSvc{}
-				svc.On("ListMatchingConstraints", ctx, formationTemplateID, location, details.GetMatchingDetails()).Return([]*model.FormationConstraint{formationConstraintModel}, nil).Once()
+				svc.On("ListMatchingConstraints", mock.Anything, formationTemplateID, location, details.GetMatchingDetails()).Return([]*model.FormationConstraint{formationConstraintModel}, nil).Once()
 				return svc
 			},
 			ExpectedErrorMsg: "",
@@ -45,7 +47,7 @@ func TestConstraintEngine_EnforceConstraints(t *testing.T) {
 			Details:  &details,
 			FormationConstraintService: func() *automock.FormationConstraintSvc {
 				svc := &automock.FormationConstraintSvc{}
-				svc.On("ListMatchingConstraints", ctx, formationTemplateID, location, details.GetMatchingDetails()).Return(nil, testErr).Once()
+				svc.On("ListMatchingConstraints", mock.Anything, formationTemplateID, location, details.GetMatchingDetails()).Return(nil, testErr).Once()
 				return svc
 			},
 			ExpectedErrorMsg: "While listing matching constraints for target operation",
@@ -56,7 +58,7 @@ func TestConstraintEngine_EnforceConstraints(t *testing.T) {
 			Details:  &details,
 			FormationConstraintService: func() *automock.FormationConstraintSvc {
 				svc := &automock.FormationConstraintSvc{}
-				svc.On("ListMatchingConstraints", ctx, formationTemplateID, location, details.GetMatchingDetails()).Return([]*model.FormationConstraint{formationConstraintUnsupportedOperatorModel}, nil).Once()
+				svc.On("ListMatchingConstraints", mock.Anything, formationTemplateID, location, details.GetMatchingDetails()).Return([]*model.FormationConstraint{formationConstraintUnsupportedOperatorModel}, nil).Once()
 				return svc
 			},
 			ExpectedErrorMsg: "Operator \"unsupported\" not found",
@@ -67,7 +69,7 @@ func TestConstraintEngine_EnforceConstraints(t *testing.T) {
 			Details:  &details,
 			FormationConstraintService: func() *automock.FormationConstraintSvc {
 				svc := &automock.FormationConstraintSvc{}
-				svc.On("ListMatchingConstraints", ctx, formationTemplateID, location, details.GetMatchingDetails()).Return([]*model.FormationConstraint{formationConstraintModel}, nil).Once()
+				svc.On("ListMatchingConstraints", mock.Anything, formationTemplateID, location, details.GetMatchingDetails()).Return([]*model.FormationConstraint{formationConstraintModel}, nil).Once()
 				return svc
 			},
 			OperatorFunc: func(ctx context.Context, input operators.OperatorInput) (bool, error) {
@@ -82,7 +84,7 @@ func TestConstraintEngine_EnforceConstraints(t *testing.T) {
 			Details:  &details,
 			FormationConstraintService: func() *automock.FormationConstraintSvc {
 				svc := &automock.FormationConstraintSvc{}
-				svc.On("ListMatchingConstraints", ctx, formationTemplateID, location, details.GetMatchingDetails()).Return([]*model.FormationConstraint{formationConstraintModel}, nil).Once()
+				svc.On("ListMatchingConstraints", mock.Anything, formationTemplateID, location, details.GetMatchingDetails()).Return([]*model.FormationConstraint{formationConstraintModel}, nil).Once()
 				return svc
 			},
 			OperatorFunc: func(ctx context.Context, input operators.OperatorInput) (bool, error) {
@@ -96,7 +98,7 @@ func TestConstraintEngine_EnforceConstraints(t *testing.T) {
 			Details:  &details,
 			FormationConstraintService: func() *automock.FormationConstraintSvc {
 				svc := &automock.FormationConstraintSvc{}
-				svc.On("ListMatchingConstraints", ctx, formationTemplateID, location, details.GetMatchingDetails()).Return([]*model.FormationConstraint{formationConstraintModel}, nil).Once()
+				svc.On("ListMatchingConstraints", mock.Anything, formationTemplateID, location, details.GetMatchingDetails()).Return([]*model.FormationConstraint{formationConstraintModel}, nil).Once()
 				return svc
 			},
 			OperatorFunc: func(ctx context.Context, input operators.OperatorInput) (bool, error) {
@@ -110,7 +112,7 @@ func TestConstraintEngine_EnforceConstraints(t *testing.T) {
 			Details:  &details,
 			FormationConstraintService: func() *automock.FormationConstraintSvc {
 				svc := &automock.FormationConstraintSvc{}
-				svc.On("ListMatchingConstraints", ctx, formationTemplateID, location, details.GetMatchingDetails()).Return([]*model.FormationConstraint{{Operator: operatorName, InputTemplate: "{invalid template"}}, nil).Once()
+				svc.On("ListMatchingConstraints", mock.Anything, formationTemplateID, location, details.GetMatchingDetails()).Return([]*model.FormationConstraint{{Operator: operatorName, InputTemplate: "{invalid template"}}, nil).Once()
 				return svc
 			},
 			OperatorFunc: func(ctx context.Context, input operators.OperatorInput) (bool, error) {
@@ -150,3 +152,213 @@ func TestConstraintEngine_EnforceConstraints(t *testing.T) {
 		})
 	}
 }
+
+func TestConstraintEngine_EvaluateConstraints(t *testing.T) {
+	// GIVEN
+	ctxWithTenant := tenant.SaveToContext(ctx, testTenantID, testTenantID)
+	formationTemplate := &model.FormationTemplate{ID: formationTemplateID, Name: formationType}
+	assignMatchingDetails := formationconstraintpkg.MatchingDetails{ResourceType: model.ApplicationResourceType, ResourceSubtype: inputAppType}
+	createMatchingDetails := formationconstraintpkg.MatchingDetails{ResourceType: model.FormationResourceType, ResourceSubtype: formationType}
+
+	formationConstraint := &model.FormationConstraint{
+		Name:          formationConstraintName,
+		Operator:      operators.IsNotAssignedToAnyFormationOfTypeOperator,
+		InputTemplate: `{"formation_template_id": "{{.FormationTemplateID}}","tenant": "{{.TenantID}}"}`,
+	}
+	destinationCreatorConstraint := &model.FormationConstraint{
+		Name:          formationConstraintName,
+		Operator:      operators.DestinationCreatorOperator,
+		InputTemplate: `{"resource_type": "{{.ResourceType}}","resource_subtype": "{{.ResourceSubtype}}"}`,
+	}
+
+	testCases := []struct {
+		Name                        string
+		Context                     context.Context
+		Location                    formationconstraintpkg.JoinPointLocation
+		ObjectID                    string
+		ObjectType                  model.ResourceType
+		OperatorFunc                func(ctx context.Context, input operators.OperatorInput) (bool, error)
+		FormationConstraintService  func() *automock.FormationConstraintSvc
+		FormationTemplateRepository func() *automock.FormationTemplateRepo
+		LabelService                func() *automock.LabelService
+		ExpectedEvaluations         []*model.FormationConstraintEvaluation
+		ExpectedErrorMsg            string
+	}{
+		{
+			Name:       "Success when constraint is satisfied",
+			Context:    ctxWithTenant,
+			Location:   formationconstraintpkg.PreAssign,
+			ObjectID:   appID,
+			ObjectType: model.ApplicationResourceType,
+			OperatorFunc: func(ctx context.Context, input operators.OperatorInput) (bool, error) {
+				return true, nil
+			},
+			FormationConstraintService: func() *automock.FormationConstraintSvc {
+				svc := &automock.FormationConstraintSvc{}
+				svc.On("ListMatchingConstraints", ctxWithTenant, formationTemplateID, formationconstraintpkg.PreAssign, assignMatchingDetails).Return([]*model.FormationConstraint{formationConstraintModel}, nil).Once()
+				return svc
+			},
+			FormationTemplateRepository: fixFormationTemplateRepoThatReturns(ctxWithTenant, formationTemplate),
+			LabelService: func() *automock.LabelService {
+				svc := &automock.LabelService{}
+				svc.On("GetByKey", ctxWithTenant, testTenantID, model.ApplicationLabelableObject, appID, applicationTypeLabel).Return(&model.Label{Value: inputAppType}, nil).Once()
+				return svc
+			},
+			ExpectedEvaluations: []*model.FormationConstraintEvaluation{
+				{
+					Constraint:    formationConstraintModel,
+					Satisfied:     true,
+					RenderedInput: `{"formation_template_id":"b87631c4-ca3a-11ed-afa1-0242ac120002","resource_type":"APPLICATION","resource_subtype":"input-type","resource_id":"b55131c4-ca3a-11ed-afa1-0242ac120002","tenant":"d9fddec6-5456-4a1e-9ae0-74447f5d6ae9","exceptSystemTypes":null}`,
+				},
+			},
+		},
+		{
+			Name:       "Success when constraint is not satisfied",
+			Context:    ctxWithTenant,
+			Location:   formationconstraintpkg.PreCreate,
+			ObjectID:   scenario,
+			ObjectType: model.FormationResourceType,
+			OperatorFunc: func(ctx context.Context, input operators.OperatorInput) (bool, error) {
+				return false, nil
+			},
+			FormationConstraintService: func() *automock.FormationConstraintSvc {
+				svc := &automock.FormationConstraintSvc{}
+				svc.On("ListMatchingConstraints", ctxWithTenant, formationTemplateID, formationconstraintpkg.PreCreate, createMatchingDetails).Return([]*model.FormationConstraint{formationConstraint}, nil).Once()
+				return svc
+			},
+			FormationTemplateRepository: fixFormationTemplateRepoThatReturns(ctxWithTenant, formationTemplate),
+			LabelService:                UnusedLabelService,
+			ExpectedEvaluations: []*model.FormationConstraintEvaluation{
+				{
+					Constraint:    formationConstraint,
+					Reason:        `Operator "IsNotAssignedToAnyFormationOfType" is not satisfied`,
+					RenderedInput: `{"formation_template_id":"b87631c4-ca3a-11ed-afa1-0242ac120002","resource_type":"","resource_subtype":"","resource_id":"","tenant":"d9fddec6-5456-4a1e-9ae0-74447f5d6ae9","exceptSystemTypes":null}`,
+				},
+			},
+		},
+		{
+			Name:       "Success when operator is not read-only",
+			Context:    ctxWithTenant,
+			Location:   formationconstraintpkg.PreAssign,
+			ObjectID:   appID,
+			ObjectType: model.ApplicationResourceType,
+			FormationConstraintService: func() *automock.FormationConstraintSvc {
+				svc := &automock.FormationConstraintSvc{}
+				svc.On("ListMatchingConstraints", ctxWithTenant, formationTemplateID, formationconstraintpkg.PreAssign, assignMatchingDetails).Return([]*model.FormationConstraint{destinationCreatorConstraint}, nil).Once()
+				return svc
+			},
+			FormationTemplateRepository: fixFormationTemplateRepoThatReturns(ctxWithTenant, formationTemplate),
+			LabelService: func() *automock.LabelService {
+				svc := &automock.LabelService{}
+				svc.On("GetByKey", ctxWithTenant, testTenantID, model.ApplicationLabelableObject, appID, applicationTypeLabel).Return(&model.Label{Value: inputAppType}, nil).Once()
+				return svc
+			},
+			ExpectedEvaluations: []*model.FormationConstraintEvaluation{
+				{
+					Constraint:    destinationCreatorConstraint,
+					Skipped:       true,
+					Reason:        `Operator "DestinationCreator" might have side effects and is not executed`,
+					RenderedInput: `{"operation":"","resource_type":"APPLICATION","resource_subtype":"input-type","details_formation_assignment_memory_address":0,"details_reverse_formation_assignment_memory_address":0,"join_point_location":{"OperationName":"","ConstraintType":""}}`,
+				},
+			},
+		},
+		{
+			Name:                        "Error when tenant is missing in context",
+			Context:                     ctx,
+			Location:                    formationconstraintpkg.PreAssign,
+			ObjectID:                    appID,
+			ObjectType:                  model.ApplicationResourceType,
+			FormationConstraintService:  UnusedFormationConstraintSvc,
+			FormationTemplateRepository: UnusedFormationTemplateRepo,
+			LabelService:                UnusedLabelService,
+			ExpectedErrorMsg:            "while loading tenant from context",
+		},
+		{
+			Name:                        "Error when target operation is not supported",
+			Context:                     ctxWithTenant,
+			Location:                    formationconstraintpkg.PreSendNotification,
+			ObjectID:                    appID,
+			ObjectType:                  model.ApplicationResourceType,
+			FormationConstraintService:  UnusedFormationConstraintSvc,
+			FormationTemplateRepository: fixFormationTemplateRepoThatReturns(ctxWithTenant, formationTemplate),
+			LabelService:                UnusedLabelService,
+			ExpectedErrorMsg:            "evaluation of constraints is not supported for target operation",
+		},
+		{
+			Name:                        "Error when object type does not match the target operation",
+			Context:                     ctxWithTenant,
+			Location:                    formationconstraintpkg.PreCreate,
+			ObjectID:                    appID,
+			ObjectType:                  model.ApplicationResourceType,
+			FormationConstraintService:  UnusedFormationConstraintSvc,
+			FormationTemplateRepository: fixFormationTemplateRepoThatReturns(ctxWithTenant, formationTemplate),
+			LabelService:                UnusedLabelService,
+			ExpectedErrorMsg:            "is not supported for target operation",
+		},
+		{
+			Name:                       "Error when getting formation template fails",
+			Context:                    ctxWithTenant,
+			Location:                   formationconstraintpkg.PreAssign,
+			ObjectID:                   appID,
+			ObjectType:                 model.ApplicationResourceType,
+			FormationConstraintService: UnusedFormationConstraintSvc,
+			FormationTemplateRepository: func() *automock.FormationTemplateRepo {
+				repo := &automock.FormationTemplateRepo{}
+				repo.On("Get", ctxWithTenant, formationTemplateID).Return(nil, testErr).Once()
+				return repo
+			},
+			LabelService:     UnusedLabelService,
+			ExpectedErrorMsg: "while getting formation template",
+		},
+		{
+			Name:       "Error when listing matching constraints fails",
+			Context:    ctxWithTenant,
+			Location:   formationconstraintpkg.PreCreate,
+			ObjectID:   scenario,
+			ObjectType: model.FormationResourceType,
+			FormationConstraintService: func() *automock.FormationConstraintSvc {
+				svc := &automock.FormationConstraintSvc{}
+				svc.On("ListMatchingConstraints", ctxWithTenant, formationTemplateID, formationconstraintpkg.PreCreate, createMatchingDetails).Return(nil, testErr).Once()
+				return svc
+			},
+			FormationTemplateRepository: fixFormationTemplateRepoThatReturns(ctxWithTenant, formationTemplate),
+			LabelService:                UnusedLabelService,
+			ExpectedErrorMsg:            "While listing matching constraints for target operation",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			formationConstraintSvc := testCase.FormationConstraintService()
+			formationTemplateRepo := testCase.FormationTemplateRepository()
+			labelSvc := testCase.LabelService()
+
+			engine := operators.NewConstraintEngine(nil, formationConstraintSvc, nil, nil, nil, nil, nil, nil, labelSvc, nil, nil, formationTemplateRepo, nil, runtimeType, applicationType)
+			if testCase.OperatorFunc != nil {
+				engine.SetOperator(testCase.OperatorFunc)
+			}
+
+			// WHEN
+			evaluations, err := engine.EvaluateConstraints(testCase.Context, formationTemplateID, testCase.Location, testCase.ObjectID, testCase.ObjectType)
+
+			// THEN
+			if testCase.ExpectedErrorMsg != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrorMsg)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedEvaluations, evaluations)
+			}
+
+			mock.AssertExpectationsForObjects(t, formationConstraintSvc, formationTemplateRepo, labelSvc)
+		})
+	}
+}
+
+func fixFormationTemplateRepoThatReturns(ctx context.Context, formationTemplate *model.FormationTemplate) func() *automock.FormationTemplateRepo {
+	return func() *automock.FormationTemplateRepo {
+		repo := &automock.FormationTemplateRepo{}
+		repo.On("Get", ctx, formationTemplate.ID).Return(formationTemplate, nil).Once()
+		return repo
+	}
+}
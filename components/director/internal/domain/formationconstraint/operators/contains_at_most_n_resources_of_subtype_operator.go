@@ -0,0 +1,65 @@
+package operators
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/formationconstraint"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/pkg/errors"
+)
+
+const (
+	// ContainsAtMostNResourcesOfSubtypeOperator represents the ContainsAtMostNResourcesOfSubtype operator
+	ContainsAtMostNResourcesOfSubtypeOperator = "ContainsAtMostNResourcesOfSubtype"
+)
+
+// NewContainsAtMostNResourcesOfSubtypeInput is input constructor for ContainsAtMostNResourcesOfSubtypeOperator operator. It returns empty OperatorInput
+func NewContainsAtMostNResourcesOfSubtypeInput() OperatorInput {
+	return &formationconstraint.ContainsAtMostNResourcesOfSubtypeInput{}
+}
+
+// ContainsAtMostNResourcesOfSubtype is a constraint operator. It checks if adding the resource from the OperatorInput to the formation
+// would result in more than MaxCount resources with the same subtype as the resource subtype from the OperatorInput
+func (e *ConstraintEngine) ContainsAtMostNResourcesOfSubtype(ctx context.Context, input OperatorInput) (bool, error) {
+	log.C(ctx).Infof("Executing operator: %q", ContainsAtMostNResourcesOfSubtypeOperator)
+
+	i, ok := input.(*formationconstraint.ContainsAtMostNResourcesOfSubtypeInput)
+	if !ok {
+		return false, errors.Errorf("Incompatible input for operator %q", ContainsAtMostNResourcesOfSubtypeOperator)
+	}
+
+	log.C(ctx).Infof("Enforcing %q constraint on resource of type: %q, subtype: %q and ID: %q with max count: %d", ContainsAtMostNResourcesOfSubtypeOperator, i.ResourceType, i.ResourceSubtype, i.ResourceID, i.MaxCount)
+
+	if i.MaxCount < 0 {
+		return false, errors.Errorf("Max count for operator %q cannot be negative", ContainsAtMostNResourcesOfSubtypeOperator)
+	}
+
+	switch i.ResourceType {
+	case model.ApplicationResourceType:
+		applications, err := e.applicationRepository.ListByScenariosNoPaging(ctx, i.Tenant, []string{i.FormationName})
+		if err != nil {
+			return false, errors.Wrapf(err, "while listing applications in scenario %q", i.FormationName)
+		}
+
+		count := 1 // the resource that is being added
+		for _, application := range applications {
+			if application.ID == i.ResourceID {
+				continue
+			}
+
+			appSubtype, err := e.getObjectSubtype(ctx, i.Tenant, model.ApplicationResourceType, application.ID)
+			if err != nil {
+				return false, errors.Wrapf(err, "while getting subtype of resource with type: %q and id: %q", model.ApplicationResourceType, application.ID)
+			}
+
+			if i.ResourceSubtype == appSubtype {
+				count++
+			}
+		}
+
+		return count <= i.MaxCount, nil
+	default:
+		return false, errors.Errorf("Unsupported resource type %q", i.ResourceType)
+	}
+}
@@ -0,0 +1,160 @@
+package operators_test
+
+import (
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/formationconstraint/operators"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/formationconstraint/operators/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	formationconstraintpkg "github.com/kyma-incubator/compass/components/director/pkg/formationconstraint"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConstraintOperators_ContainsAtMostNResourcesOfSubtype(t *testing.T) {
+	otherAppID := "a7a3b6c2-ca3a-11ed-afa1-0242ac120002"
+
+	in := &formationconstraintpkg.ContainsAtMostNResourcesOfSubtypeInput{
+		FormationName:   scenario,
+		ResourceType:    model.ApplicationResourceType,
+		ResourceSubtype: inputAppType,
+		ResourceID:      inputAppID,
+		Tenant:          testTenantID,
+		MaxCount:        2,
+	}
+
+	applications := []*model.Application{{BaseEntity: &model.BaseEntity{ID: appID}}, {BaseEntity: &model.BaseEntity{ID: otherAppID}}}
+
+	testCases := []struct {
+		Name             string
+		Input            operators.OperatorInput
+		LabelSvc         func() *automock.LabelService
+		ApplicationRepo  func() *automock.ApplicationRepository
+		ExpectedResult   bool
+		ExpectedErrorMsg string
+	}{
+		{
+			Name:  "Success when the limit is not reached",
+			Input: in,
+			LabelSvc: func() *automock.LabelService {
+				svc := &automock.LabelService{}
+				svc.On("GetByKey", ctx, testTenantID, model.ApplicationLabelableObject, appID, applicationTypeLabel).Return(&model.Label{Value: inputAppType}, nil).Once()
+				svc.On("GetByKey", ctx, testTenantID, model.ApplicationLabelableObject, otherAppID, applicationTypeLabel).Return(&model.Label{Value: "different-type"}, nil).Once()
+				return svc
+			},
+			ApplicationRepo: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("ListByScenariosNoPaging", ctx, testTenantID, []string{scenario}).Return(applications, nil).Once()
+				return repo
+			},
+			ExpectedResult: true,
+		},
+		{
+			Name:  "Success when the limit would be exceeded",
+			Input: in,
+			LabelSvc: func() *automock.LabelService {
+				svc := &automock.LabelService{}
+				svc.On("GetByKey", ctx, testTenantID, model.ApplicationLabelableObject, appID, applicationTypeLabel).Return(&model.Label{Value: inputAppType}, nil).Once()
+				svc.On("GetByKey", ctx, testTenantID, model.ApplicationLabelableObject, otherAppID, applicationTypeLabel).Return(&model.Label{Value: inputAppType}, nil).Once()
+				return svc
+			},
+			ApplicationRepo: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("ListByScenariosNoPaging", ctx, testTenantID, []string{scenario}).Return(applications, nil).Once()
+				return repo
+			},
+			ExpectedResult: false,
+		},
+		{
+			Name:  "Success when the resource is already part of the formation",
+			Input: in,
+			LabelSvc: func() *automock.LabelService {
+				svc := &automock.LabelService{}
+				svc.On("GetByKey", ctx, testTenantID, model.ApplicationLabelableObject, appID, applicationTypeLabel).Return(&model.Label{Value: inputAppType}, nil).Once()
+				return svc
+			},
+			ApplicationRepo: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("ListByScenariosNoPaging", ctx, testTenantID, []string{scenario}).Return([]*model.Application{{BaseEntity: &model.BaseEntity{ID: appID}}, {BaseEntity: &model.BaseEntity{ID: inputAppID}}}, nil).Once()
+				return repo
+			},
+			ExpectedResult: true,
+		},
+		{
+			Name:  "Returns error when can't get the label of another system in the formation",
+			Input: in,
+			LabelSvc: func() *automock.LabelService {
+				svc := &automock.LabelService{}
+				svc.On("GetByKey", ctx, testTenantID, model.ApplicationLabelableObject, appID, applicationTypeLabel).Return(nil, testErr).Once()
+				return svc
+			},
+			ApplicationRepo: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("ListByScenariosNoPaging", ctx, testTenantID, []string{scenario}).Return(applications, nil).Once()
+				return repo
+			},
+			ExpectedErrorMsg: testErr.Error(),
+		},
+		{
+			Name:     "Returns error when can't get the applications in the formation",
+			Input:    in,
+			LabelSvc: UnusedLabelService,
+			ApplicationRepo: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("ListByScenariosNoPaging", ctx, testTenantID, []string{scenario}).Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedErrorMsg: testErr.Error(),
+		},
+		{
+			Name: "Returns error when the max count is negative",
+			Input: &formationconstraintpkg.ContainsAtMostNResourcesOfSubtypeInput{
+				FormationName: scenario,
+				ResourceType:  model.ApplicationResourceType,
+				Tenant:        testTenantID,
+				MaxCount:      -1,
+			},
+			LabelSvc:         UnusedLabelService,
+			ApplicationRepo:  UnusedApplicationRepo,
+			ExpectedErrorMsg: "cannot be negative",
+		},
+		{
+			Name:             "Returns error when the operator input is incompatible",
+			Input:            "incompatible",
+			LabelSvc:         UnusedLabelService,
+			ApplicationRepo:  UnusedApplicationRepo,
+			ExpectedErrorMsg: "Incompatible input",
+		},
+		{
+			Name: "Returns error when the resource type is unknown",
+			Input: &formationconstraintpkg.ContainsAtMostNResourcesOfSubtypeInput{
+				FormationName: scenario,
+				ResourceType:  "Unknown",
+				Tenant:        testTenantID,
+			},
+			LabelSvc:         UnusedLabelService,
+			ApplicationRepo:  UnusedApplicationRepo,
+			ExpectedErrorMsg: "Unsupported resource type",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			labelSvc := testCase.LabelSvc()
+			appRepo := testCase.ApplicationRepo()
+			engine := operators.NewConstraintEngine(nil, nil, nil, nil, nil, nil, nil, nil, labelSvc, appRepo, nil, nil, nil, runtimeType, applicationType)
+
+			result, err := engine.ContainsAtMostNResourcesOfSubtype(ctx, testCase.Input)
+
+			if testCase.ExpectedErrorMsg != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrorMsg)
+			} else {
+				assert.Equal(t, testCase.ExpectedResult, result)
+				assert.NoError(t, err)
+			}
+
+			mock.AssertExpectationsForObjects(t, labelSvc, appRepo)
+		})
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/domain/formationconstraint/operators/automock"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	formationconstraintpkg "github.com/kyma-incubator/compass/components/director/pkg/formationconstraint"
+	"github.com/kyma-incubator/compass/components/director/pkg/tenant"
 	"github.com/pkg/errors"
 )
 
@@ -39,6 +40,9 @@ const (
 	applicationTypeLabel    = "applicationType"
 	runtimeTypeLabel        = "runtimeType"
 	inputAppType            = "input-type"
+	regionLabelKey          = "region"
+	region                  = "eu-1"
+	otherRegion             = "us-1"
 
 	testFileName   = "test-file-name"
 	testCommonName = "test-common-name"
@@ -380,6 +384,22 @@ func fixCertificateData() *operators.CertificateData {
 	}
 }
 
+func fixLabelServiceThatReturns(objectType model.LabelableObject, objectID string, value interface{}) func() *automock.LabelService {
+	return func() *automock.LabelService {
+		svc := &automock.LabelService{}
+		svc.On("GetByKey", ctx, testTenantID, objectType, objectID, regionLabelKey).Return(&model.Label{Key: regionLabelKey, Value: value}, nil).Once()
+		return svc
+	}
+}
+
+func fixTenantServiceThatReturnsTenantOfType(tenantType tenant.Type) func() *automock.TenantService {
+	return func() *automock.TenantService {
+		svc := &automock.TenantService{}
+		svc.On("GetTenantByExternalID", ctx, testTenantID).Return(&model.BusinessTenantMapping{ExternalTenant: testTenantID, Type: tenantType}, nil).Once()
+		return svc
+	}
+}
+
 func UnusedTenantService() *automock.TenantService {
 	return &automock.TenantService{}
 }
@@ -404,6 +424,14 @@ func UnusedLabelService() *automock.LabelService {
 	return &automock.LabelService{}
 }
 
+func UnusedFormationConstraintSvc() *automock.FormationConstraintSvc {
+	return &automock.FormationConstraintSvc{}
+}
+
+func UnusedFormationTemplateRepo() *automock.FormationTemplateRepo {
+	return &automock.FormationTemplateRepo{}
+}
+
 func UnusedDestinationService() *automock.DestinationService {
 	return &automock.DestinationService{}
 }
@@ -0,0 +1,98 @@
+package operators
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/formationconstraint"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/pkg/errors"
+)
+
+const (
+	// HasLabelWithValueOperator represents the HasLabelWithValue operator
+	HasLabelWithValueOperator = "HasLabelWithValue"
+)
+
+// NewHasLabelWithValueInput is input constructor for HasLabelWithValueOperator operator. It returns empty OperatorInput
+func NewHasLabelWithValueInput() OperatorInput {
+	return &formationconstraint.HasLabelWithValueInput{}
+}
+
+// HasLabelWithValue is a constraint operator. It checks if the resource from the OperatorInput has a label with the provided key and value.
+// If the label value is a list, it is enough for the list to contain the provided value
+func (e *ConstraintEngine) HasLabelWithValue(ctx context.Context, input OperatorInput) (bool, error) {
+	log.C(ctx).Infof("Executing operator: %q", HasLabelWithValueOperator)
+
+	i, ok := input.(*formationconstraint.HasLabelWithValueInput)
+	if !ok {
+		return false, errors.Errorf("Incompatible input for operator %q", HasLabelWithValueOperator)
+	}
+
+	log.C(ctx).Infof("Enforcing %q constraint on resource of type: %q and ID: %q for label with key: %q", HasLabelWithValueOperator, i.ResourceType, i.ResourceID, i.LabelKey)
+
+	lbl, err := e.getResourceLabel(ctx, i.Tenant, i.ResourceType, i.ResourceID, i.LabelKey)
+	if err != nil {
+		return false, err
+	}
+	if lbl == nil {
+		return false, nil
+	}
+
+	return labelValueContains(lbl.Value, i.LabelValue), nil
+}
+
+// getResourceLabel returns the label with the given key of the resource with the given type and ID. If the resource does not have such label nil is returned.
+func (e *ConstraintEngine) getResourceLabel(ctx context.Context, tenant string, resourceType model.ResourceType, resourceID, key string) (*model.Label, error) {
+	objectType, err := labelableObjectForResourceType(resourceType)
+	if err != nil {
+		return nil, err
+	}
+
+	lbl, err := e.labelService.GetByKey(ctx, tenant, objectType, resourceID, key)
+	if err != nil {
+		if apperrors.IsNotFoundError(err) {
+			log.C(ctx).Infof("Resource of type: %q and ID: %q does not have label with key: %q", resourceType, resourceID, key)
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "while getting label with key %q of resource with type %q and ID %q in tenant %q", key, resourceType, resourceID, tenant)
+	}
+
+	return lbl, nil
+}
+
+func labelableObjectForResourceType(resourceType model.ResourceType) (model.LabelableObject, error) {
+	switch resourceType {
+	case model.ApplicationResourceType:
+		return model.ApplicationLabelableObject, nil
+	case model.RuntimeResourceType:
+		return model.RuntimeLabelableObject, nil
+	case model.RuntimeContextResourceType:
+		return model.RuntimeContextLabelableObject, nil
+	default:
+		return "", errors.Errorf("Unsupported resource type %q", resourceType)
+	}
+}
+
+func labelValueContains(labelValue interface{}, value string) bool {
+	switch v := labelValue.(type) {
+	case []interface{}:
+		for _, item := range v {
+			if reflect.DeepEqual(item, value) {
+				return true
+			}
+		}
+		return false
+	case []string:
+		for _, item := range v {
+			if item == value {
+				return true
+			}
+		}
+		return false
+	default:
+		return reflect.DeepEqual(labelValue, value)
+	}
+}
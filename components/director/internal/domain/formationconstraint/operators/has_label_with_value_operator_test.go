@@ -0,0 +1,106 @@
+package operators_test
+
+import (
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/formationconstraint/operators"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/formationconstraint/operators/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	formationconstraintpkg "github.com/kyma-incubator/compass/components/director/pkg/formationconstraint"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConstraintOperators_HasLabelWithValue(t *testing.T) {
+	in := &formationconstraintpkg.HasLabelWithValueInput{
+		ResourceType: model.RuntimeResourceType,
+		ResourceID:   runtimeID,
+		Tenant:       testTenantID,
+		LabelKey:     regionLabelKey,
+		LabelValue:   region,
+	}
+
+	testCases := []struct {
+		Name             string
+		Input            operators.OperatorInput
+		LabelSvc         func() *automock.LabelService
+		ExpectedResult   bool
+		ExpectedErrorMsg string
+	}{
+		{
+			Name:           "Success when the label has the expected value",
+			Input:          in,
+			LabelSvc:       fixLabelServiceThatReturns(model.RuntimeLabelableObject, runtimeID, region),
+			ExpectedResult: true,
+		},
+		{
+			Name:           "Success when the label is a list containing the expected value",
+			Input:          in,
+			LabelSvc:       fixLabelServiceThatReturns(model.RuntimeLabelableObject, runtimeID, []interface{}{otherRegion, region}),
+			ExpectedResult: true,
+		},
+		{
+			Name:           "Success when the label has different value",
+			Input:          in,
+			LabelSvc:       fixLabelServiceThatReturns(model.RuntimeLabelableObject, runtimeID, otherRegion),
+			ExpectedResult: false,
+		},
+		{
+			Name:  "Success when the resource does not have the label",
+			Input: in,
+			LabelSvc: func() *automock.LabelService {
+				svc := &automock.LabelService{}
+				svc.On("GetByKey", ctx, testTenantID, model.RuntimeLabelableObject, runtimeID, regionLabelKey).Return(nil, apperrors.NewNotFoundError(resource.Label, regionLabelKey)).Once()
+				return svc
+			},
+			ExpectedResult: false,
+		},
+		{
+			Name:  "Returns error when getting the label fails",
+			Input: in,
+			LabelSvc: func() *automock.LabelService {
+				svc := &automock.LabelService{}
+				svc.On("GetByKey", ctx, testTenantID, model.RuntimeLabelableObject, runtimeID, regionLabelKey).Return(nil, testErr).Once()
+				return svc
+			},
+			ExpectedErrorMsg: testErr.Error(),
+		},
+		{
+			Name:             "Returns error when the operator input is incompatible",
+			Input:            "incompatible",
+			LabelSvc:         UnusedLabelService,
+			ExpectedErrorMsg: "Incompatible input",
+		},
+		{
+			Name: "Returns error when the resource type is unknown",
+			Input: &formationconstraintpkg.HasLabelWithValueInput{
+				ResourceType: model.TenantResourceType,
+				ResourceID:   testTenantID,
+				LabelKey:     regionLabelKey,
+			},
+			LabelSvc:         UnusedLabelService,
+			ExpectedErrorMsg: "Unsupported resource type",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			labelSvc := testCase.LabelSvc()
+			engine := operators.NewConstraintEngine(nil, nil, nil, nil, nil, nil, nil, nil, labelSvc, nil, nil, nil, nil, runtimeType, applicationType)
+
+			result, err := engine.HasLabelWithValue(ctx, testCase.Input)
+
+			if testCase.ExpectedErrorMsg != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrorMsg)
+			} else {
+				assert.Equal(t, testCase.ExpectedResult, result)
+				assert.NoError(t, err)
+			}
+
+			mock.AssertExpectationsForObjects(t, labelSvc)
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package operators
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/formationconstraint"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/pkg/errors"
+)
+
+const (
+	// HaveSameLabelValueOperator represents the HaveSameLabelValue operator
+	HaveSameLabelValueOperator = "HaveSameLabelValue"
+)
+
+// NewHaveSameLabelValueInput is input constructor for HaveSameLabelValueOperator operator. It returns empty OperatorInput
+func NewHaveSameLabelValueInput() OperatorInput {
+	return &formationconstraint.HaveSameLabelValueInput{}
+}
+
+// HaveSameLabelValue is a constraint operator. It checks if the resource and the source resource from the OperatorInput
+// both have a label with the provided key and if the values of the two labels are equal
+func (e *ConstraintEngine) HaveSameLabelValue(ctx context.Context, input OperatorInput) (bool, error) {
+	log.C(ctx).Infof("Executing operator: %q", HaveSameLabelValueOperator)
+
+	i, ok := input.(*formationconstraint.HaveSameLabelValueInput)
+	if !ok {
+		return false, errors.Errorf("Incompatible input for operator %q", HaveSameLabelValueOperator)
+	}
+
+	log.C(ctx).Infof("Enforcing %q constraint on resource of type: %q and ID: %q and source resource of type: %q and ID: %q for label with key: %q", HaveSameLabelValueOperator, i.ResourceType, i.ResourceID, i.SourceResourceType, i.SourceResourceID, i.LabelKey)
+
+	lbl, err := e.getResourceLabel(ctx, i.Tenant, i.ResourceType, i.ResourceID, i.LabelKey)
+	if err != nil {
+		return false, err
+	}
+	if lbl == nil {
+		return false, nil
+	}
+
+	sourceLbl, err := e.getResourceLabel(ctx, i.Tenant, i.SourceResourceType, i.SourceResourceID, i.LabelKey)
+	if err != nil {
+		return false, err
+	}
+	if sourceLbl == nil {
+		return false, nil
+	}
+
+	return reflect.DeepEqual(lbl.Value, sourceLbl.Value), nil
+}
@@ -0,0 +1,123 @@
+package operators_test
+
+import (
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/formationconstraint/operators"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/formationconstraint/operators/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	formationconstraintpkg "github.com/kyma-incubator/compass/components/director/pkg/formationconstraint"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConstraintOperators_HaveSameLabelValue(t *testing.T) {
+	in := &formationconstraintpkg.HaveSameLabelValueInput{
+		ResourceType:       model.ApplicationResourceType,
+		ResourceID:         appID,
+		SourceResourceType: model.RuntimeResourceType,
+		SourceResourceID:   runtimeID,
+		Tenant:             testTenantID,
+		LabelKey:           regionLabelKey,
+	}
+
+	testCases := []struct {
+		Name             string
+		Input            operators.OperatorInput
+		LabelSvc         func() *automock.LabelService
+		ExpectedResult   bool
+		ExpectedErrorMsg string
+	}{
+		{
+			Name:  "Success when both resources have the same label value",
+			Input: in,
+			LabelSvc: func() *automock.LabelService {
+				svc := &automock.LabelService{}
+				svc.On("GetByKey", ctx, testTenantID, model.ApplicationLabelableObject, appID, regionLabelKey).Return(&model.Label{Value: region}, nil).Once()
+				svc.On("GetByKey", ctx, testTenantID, model.RuntimeLabelableObject, runtimeID, regionLabelKey).Return(&model.Label{Value: region}, nil).Once()
+				return svc
+			},
+			ExpectedResult: true,
+		},
+		{
+			Name:  "Success when the resources have different label values",
+			Input: in,
+			LabelSvc: func() *automock.LabelService {
+				svc := &automock.LabelService{}
+				svc.On("GetByKey", ctx, testTenantID, model.ApplicationLabelableObject, appID, regionLabelKey).Return(&model.Label{Value: region}, nil).Once()
+				svc.On("GetByKey", ctx, testTenantID, model.RuntimeLabelableObject, runtimeID, regionLabelKey).Return(&model.Label{Value: otherRegion}, nil).Once()
+				return svc
+			},
+			ExpectedResult: false,
+		},
+		{
+			Name:  "Success when the resource does not have the label",
+			Input: in,
+			LabelSvc: func() *automock.LabelService {
+				svc := &automock.LabelService{}
+				svc.On("GetByKey", ctx, testTenantID, model.ApplicationLabelableObject, appID, regionLabelKey).Return(nil, apperrors.NewNotFoundError(resource.Label, regionLabelKey)).Once()
+				return svc
+			},
+			ExpectedResult: false,
+		},
+		{
+			Name:  "Success when the source resource does not have the label",
+			Input: in,
+			LabelSvc: func() *automock.LabelService {
+				svc := &automock.LabelService{}
+				svc.On("GetByKey", ctx, testTenantID, model.ApplicationLabelableObject, appID, regionLabelKey).Return(&model.Label{Value: region}, nil).Once()
+				svc.On("GetByKey", ctx, testTenantID, model.RuntimeLabelableObject, runtimeID, regionLabelKey).Return(nil, apperrors.NewNotFoundError(resource.Label, regionLabelKey)).Once()
+				return svc
+			},
+			ExpectedResult: false,
+		},
+		{
+			Name:  "Returns error when getting the label of the resource fails",
+			Input: in,
+			LabelSvc: func() *automock.LabelService {
+				svc := &automock.LabelService{}
+				svc.On("GetByKey", ctx, testTenantID, model.ApplicationLabelableObject, appID, regionLabelKey).Return(nil, testErr).Once()
+				return svc
+			},
+			ExpectedErrorMsg: testErr.Error(),
+		},
+		{
+			Name:  "Returns error when getting the label of the source resource fails",
+			Input: in,
+			LabelSvc: func() *automock.LabelService {
+				svc := &automock.LabelService{}
+				svc.On("GetByKey", ctx, testTenantID, model.ApplicationLabelableObject, appID, regionLabelKey).Return(&model.Label{Value: region}, nil).Once()
+				svc.On("GetByKey", ctx, testTenantID, model.RuntimeLabelableObject, runtimeID, regionLabelKey).Return(nil, testErr).Once()
+				return svc
+			},
+			ExpectedErrorMsg: testErr.Error(),
+		},
+		{
+			Name:             "Returns error when the operator input is incompatible",
+			Input:            "incompatible",
+			LabelSvc:         UnusedLabelService,
+			ExpectedErrorMsg: "Incompatible input",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			labelSvc := testCase.LabelSvc()
+			engine := operators.NewConstraintEngine(nil, nil, nil, nil, nil, nil, nil, nil, labelSvc, nil, nil, nil, nil, runtimeType, applicationType)
+
+			result, err := engine.HaveSameLabelValue(ctx, testCase.Input)
+
+			if testCase.ExpectedErrorMsg != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrorMsg)
+			} else {
+				assert.Equal(t, testCase.ExpectedResult, result)
+				assert.NoError(t, err)
+			}
+
+			mock.AssertExpectationsForObjects(t, labelSvc)
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package operators
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/formationconstraint"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/tenant"
+	"github.com/pkg/errors"
+)
+
+const (
+	// IsTenantOfTypeOperator represents the IsTenantOfType operator
+	IsTenantOfTypeOperator = "IsTenantOfType"
+)
+
+// NewIsTenantOfTypeInput is input constructor for IsTenantOfTypeOperator operator. It returns empty OperatorInput
+func NewIsTenantOfTypeInput() OperatorInput {
+	return &formationconstraint.IsTenantOfTypeInput{}
+}
+
+// IsTenantOfType is a constraint operator. It checks if the tenant from the OperatorInput is of any of the provided tenant types
+func (e *ConstraintEngine) IsTenantOfType(ctx context.Context, input OperatorInput) (bool, error) {
+	log.C(ctx).Infof("Executing operator: %q", IsTenantOfTypeOperator)
+
+	i, ok := input.(*formationconstraint.IsTenantOfTypeInput)
+	if !ok {
+		return false, errors.Errorf("Incompatible input for operator %q", IsTenantOfTypeOperator)
+	}
+
+	log.C(ctx).Infof("Enforcing %q constraint on resource of type: %q and ID: %q for tenant types: %v", IsTenantOfTypeOperator, i.ResourceType, i.ResourceID, i.TenantTypes)
+
+	if i.ResourceType != model.TenantResourceType {
+		return false, errors.Errorf("Unsupported resource type %q", i.ResourceType)
+	}
+
+	t, err := e.tenantSvc.GetTenantByExternalID(ctx, i.ResourceID)
+	if err != nil {
+		return false, errors.Wrapf(err, "while getting tenant with external ID %q", i.ResourceID)
+	}
+
+	tenantType := tenant.TypeToStr(t.Type)
+	for _, allowedType := range i.TenantTypes {
+		if tenantType == allowedType {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
@@ -0,0 +1,86 @@
+package operators_test
+
+import (
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/formationconstraint/operators"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/formationconstraint/operators/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	formationconstraintpkg "github.com/kyma-incubator/compass/components/director/pkg/formationconstraint"
+	"github.com/kyma-incubator/compass/components/director/pkg/tenant"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConstraintOperators_IsTenantOfType(t *testing.T) {
+	in := &formationconstraintpkg.IsTenantOfTypeInput{
+		ResourceType: model.TenantResourceType,
+		ResourceID:   testTenantID,
+		TenantTypes:  []string{string(tenant.Subaccount)},
+	}
+
+	testCases := []struct {
+		Name             string
+		Input            operators.OperatorInput
+		TenantSvc        func() *automock.TenantService
+		ExpectedResult   bool
+		ExpectedErrorMsg string
+	}{
+		{
+			Name:           "Success when the tenant is of allowed type",
+			Input:          in,
+			TenantSvc:      fixTenantServiceThatReturnsTenantOfType(tenant.Subaccount),
+			ExpectedResult: true,
+		},
+		{
+			Name:           "Success when the tenant is not of allowed type",
+			Input:          in,
+			TenantSvc:      fixTenantServiceThatReturnsTenantOfType(tenant.Account),
+			ExpectedResult: false,
+		},
+		{
+			Name:  "Returns error when getting the tenant fails",
+			Input: in,
+			TenantSvc: func() *automock.TenantService {
+				svc := &automock.TenantService{}
+				svc.On("GetTenantByExternalID", ctx, testTenantID).Return(nil, testErr).Once()
+				return svc
+			},
+			ExpectedErrorMsg: testErr.Error(),
+		},
+		{
+			Name:             "Returns error when the operator input is incompatible",
+			Input:            "incompatible",
+			TenantSvc:        UnusedTenantService,
+			ExpectedErrorMsg: "Incompatible input",
+		},
+		{
+			Name: "Returns error when the resource type is not tenant",
+			Input: &formationconstraintpkg.IsTenantOfTypeInput{
+				ResourceType: model.ApplicationResourceType,
+				ResourceID:   appID,
+			},
+			TenantSvc:        UnusedTenantService,
+			ExpectedErrorMsg: "Unsupported resource type",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			tenantSvc := testCase.TenantSvc()
+			engine := operators.NewConstraintEngine(nil, nil, tenantSvc, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, runtimeType, applicationType)
+
+			result, err := engine.IsTenantOfType(ctx, testCase.Input)
+
+			if testCase.ExpectedErrorMsg != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrorMsg)
+			} else {
+				assert.Equal(t, testCase.ExpectedResult, result)
+				assert.NoError(t, err)
+			}
+
+			mock.AssertExpectationsForObjects(t, tenantSvc)
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/formationconstraint"
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
 	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
@@ -16,6 +17,7 @@ type formationConstraintConverter interface {
 	ToGraphQL(in *model.FormationConstraint) *graphql.FormationConstraint
 	MultipleToGraphQL(in []*model.FormationConstraint) []*graphql.FormationConstraint
 	FromModelInputToModel(in *model.FormationConstraintInput, id string) *model.FormationConstraint
+	EvaluationsToGraphQL(in []*model.FormationConstraintEvaluation) []*graphql.FormationConstraintEvaluation
 }
 
 //go:generate mockery --exported --name=formationConstraintService --output=automock --outpkg=automock --case=underscore --disable-version-string
@@ -28,20 +30,27 @@ type formationConstraintService interface {
 	Update(ctx context.Context, id string, in *model.FormationConstraintInput) error
 }
 
+//go:generate mockery --exported --name=constraintEvaluator --output=automock --outpkg=automock --case=underscore --disable-version-string
+type constraintEvaluator interface {
+	EvaluateConstraints(ctx context.Context, formationTemplateID string, location formationconstraint.JoinPointLocation, objectID string, objectType model.ResourceType) ([]*model.FormationConstraintEvaluation, error)
+}
+
 // Resolver is the FormationConstraint resolver
 type Resolver struct {
 	transact persistence.Transactioner
 
 	svc       formationConstraintService
 	converter formationConstraintConverter
+	evaluator constraintEvaluator
 }
 
 // NewResolver creates FormationConstraint resolver
-func NewResolver(transact persistence.Transactioner, converter formationConstraintConverter, svc formationConstraintService) *Resolver {
+func NewResolver(transact persistence.Transactioner, converter formationConstraintConverter, svc formationConstraintService, evaluator constraintEvaluator) *Resolver {
 	return &Resolver{
 		transact:  transact,
 		converter: converter,
 		svc:       svc,
+		evaluator: evaluator,
 	}
 }
 
@@ -91,6 +100,30 @@ func (r *Resolver) FormationConstraintsByFormationType(ctx context.Context, form
 	return r.converter.MultipleToGraphQL(formationConstraints), nil
 }
 
+// EvaluateFormationConstraints evaluates the constraints of the FormationTemplate applicable to the join point location against the object without enforcing them.
+// The transaction is always rolled back, so that the evaluation leaves no changes behind.
+func (r *Resolver) EvaluateFormationConstraints(ctx context.Context, formationTemplateID string, location graphql.FormationConstraintJoinPointLocationInput, objectID string, objectType graphql.ResourceType) ([]*graphql.FormationConstraintEvaluation, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	joinPointLocation := formationconstraint.JoinPointLocation{
+		OperationName:  model.TargetOperation(location.TargetOperation),
+		ConstraintType: model.FormationConstraintType(location.ConstraintType),
+	}
+
+	evaluations, err := r.evaluator.EvaluateConstraints(ctx, formationTemplateID, joinPointLocation, objectID, model.ResourceType(objectType))
+	if err != nil {
+		return nil, err
+	}
+
+	return r.converter.EvaluationsToGraphQL(evaluations), nil
+}
+
 // FormationConstraint queries the FormationConstraint matching ID `id`
 func (r *Resolver) FormationConstraint(ctx context.Context, id string) (*graphql.FormationConstraint, error) {
 	tx, err := r.transact.Begin()
@@ -8,6 +8,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/domain/formationconstraint/automock"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	formationconstraintpkg "github.com/kyma-incubator/compass/components/director/pkg/formationconstraint"
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 	persistenceautomock "github.com/kyma-incubator/compass/components/director/pkg/persistence/automock"
 	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
@@ -104,7 +105,7 @@ func TestResolver_FormationConstraints(t *testing.T) {
 			formationConstraintSvc := testCase.FormationConstraintService()
 			formationConstraintConverter := testCase.FormationConstraintConverter()
 
-			resolver := formationconstraint.NewResolver(transact, formationConstraintConverter, formationConstraintSvc)
+			resolver := formationconstraint.NewResolver(transact, formationConstraintConverter, formationConstraintSvc, nil)
 
 			// WHEN
 			result, err := resolver.FormationConstraints(ctx)
@@ -209,7 +210,7 @@ func TestResolver_FormationConstraintsByFormationType(t *testing.T) {
 			formationConstraintSvc := testCase.FormationConstraintService()
 			formationConstraintConverter := testCase.FormationConstraintConverter()
 
-			resolver := formationconstraint.NewResolver(transact, formationConstraintConverter, formationConstraintSvc)
+			resolver := formationconstraint.NewResolver(transact, formationConstraintConverter, formationConstraintSvc, nil)
 
 			// WHEN
 			result, err := resolver.FormationConstraintsByFormationType(ctx, formationTemplateID)
@@ -228,6 +229,95 @@ func TestResolver_FormationConstraintsByFormationType(t *testing.T) {
 	}
 }
 
+func TestResolver_EvaluateFormationConstraints(t *testing.T) {
+	// GIVEN
+	ctx := context.TODO()
+
+	testErr := errors.New("test error")
+
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	objectID := "object-id"
+	gqlLocation := graphql.FormationConstraintJoinPointLocationInput{
+		ConstraintType:  graphql.ConstraintTypePre,
+		TargetOperation: graphql.TargetOperationAssignFormation,
+	}
+	joinPointLocation := formationconstraintpkg.PreAssign
+
+	evaluations := []*model.FormationConstraintEvaluation{satisfiedEvaluation, skippedEvaluation}
+	gqlEvaluations := []*graphql.FormationConstraintEvaluation{gqlSatisfiedEvaluation, gqlSkippedEvaluation}
+
+	testCases := []struct {
+		Name                         string
+		TxFn                         func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		FormationConstraintConverter func() *automock.FormationConstraintConverter
+		ConstraintEvaluator          func() *automock.ConstraintEvaluator
+		ExpectedOutput               []*graphql.FormationConstraintEvaluation
+		ExpectedError                error
+	}{
+		{
+			Name: "Success without committing the transaction",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			ConstraintEvaluator: func() *automock.ConstraintEvaluator {
+				evaluator := &automock.ConstraintEvaluator{}
+				evaluator.On("EvaluateConstraints", txtest.CtxWithDBMatcher(), formationTemplateID, joinPointLocation, objectID, model.ApplicationResourceType).Return(evaluations, nil)
+
+				return evaluator
+			},
+			FormationConstraintConverter: func() *automock.FormationConstraintConverter {
+				converter := &automock.FormationConstraintConverter{}
+				converter.On("EvaluationsToGraphQL", evaluations).Return(gqlEvaluations)
+
+				return converter
+			},
+			ExpectedOutput: gqlEvaluations,
+		},
+		{
+			Name: "Error when evaluating constraints fails",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			ConstraintEvaluator: func() *automock.ConstraintEvaluator {
+				evaluator := &automock.ConstraintEvaluator{}
+				evaluator.On("EvaluateConstraints", txtest.CtxWithDBMatcher(), formationTemplateID, joinPointLocation, objectID, model.ApplicationResourceType).Return(nil, testErr)
+
+				return evaluator
+			},
+			FormationConstraintConverter: UnusedFormationConstraintConverter,
+			ExpectedError:                testErr,
+		},
+		{
+			Name:                         "Returns error when failing on the beginning of a transaction",
+			TxFn:                         txGen.ThatFailsOnBegin,
+			ConstraintEvaluator:          UnusedConstraintEvaluator,
+			FormationConstraintConverter: UnusedFormationConstraintConverter,
+			ExpectedError:                testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TxFn()
+			evaluator := testCase.ConstraintEvaluator()
+			formationConstraintConverter := testCase.FormationConstraintConverter()
+
+			resolver := formationconstraint.NewResolver(transact, formationConstraintConverter, nil, evaluator)
+
+			// WHEN
+			result, err := resolver.EvaluateFormationConstraints(ctx, formationTemplateID, gqlLocation, objectID, graphql.ResourceTypeApplication)
+
+			// THEN
+			if testCase.ExpectedError != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedError.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, testCase.ExpectedOutput, result)
+
+			mock.AssertExpectationsForObjects(t, persist, evaluator, formationConstraintConverter)
+		})
+	}
+}
+
 func TestResolver_FormationConstraint(t *testing.T) {
 	// GIVEN
 	ctx := context.TODO()
@@ -317,7 +407,7 @@ func TestResolver_FormationConstraint(t *testing.T) {
 			formationConstraintSvc := testCase.FormationConstraintService()
 			formationConstraintConverter := testCase.FormationConstraintConverter()
 
-			resolver := formationconstraint.NewResolver(transact, formationConstraintConverter, formationConstraintSvc)
+			resolver := formationconstraint.NewResolver(transact, formationConstraintConverter, formationConstraintSvc, nil)
 
 			// WHEN
 			result, err := resolver.FormationConstraint(ctx, testID)
@@ -459,7 +549,7 @@ func TestResolver_CreateFormationConstraint(t *testing.T) {
 			formationConstraintSvc := testCase.FormationConstraintService()
 			formationConstraintConverter := testCase.FormationConstraintConverter()
 
-			resolver := formationconstraint.NewResolver(transact, formationConstraintConverter, formationConstraintSvc)
+			resolver := formationconstraint.NewResolver(transact, formationConstraintConverter, formationConstraintSvc, nil)
 
 			// WHEN
 			result, err := resolver.CreateFormationConstraint(ctx, testCase.Input)
@@ -570,7 +660,7 @@ func TestResolver_DeleteFormationTemplate(t *testing.T) {
 			formationConstraintSvc := testCase.FormationConstraintService()
 			formationConstraintConverter := testCase.FormationConstraintConverter()
 
-			resolver := formationconstraint.NewResolver(transact, formationConstraintConverter, formationConstraintSvc)
+			resolver := formationconstraint.NewResolver(transact, formationConstraintConverter, formationConstraintSvc, nil)
 
 			// WHEN
 			result, err := resolver.DeleteFormationConstraint(ctx, testID)
@@ -740,7 +830,7 @@ func TestResolver_UpdateFormationConstraint(t *testing.T) {
 				formationConstraintConverter = testCase.FormationConstraintConverter()
 			}
 
-			resolver := formationconstraint.NewResolver(transact, formationConstraintConverter, formationConstraintSvc)
+			resolver := formationconstraint.NewResolver(transact, formationConstraintConverter, formationConstraintSvc, nil)
 
 			// WHEN
 			result, err := resolver.UpdateFormationConstraint(ctx, testID, testCase.Input)
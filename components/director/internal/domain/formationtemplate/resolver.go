@@ -317,7 +317,7 @@ func (r *Resolver) FormationConstraintsDataLoader(keys []dataloader.ParamFormati
 		formationTemplateIDs = append(formationTemplateIDs, key.ID)
 	}
 
-	tx, err := r.transact.Begin()
+	tx, err := persistence.BeginForCtx(ctx, r.transact)
 	if err != nil {
 		return nil, []error{err}
 	}
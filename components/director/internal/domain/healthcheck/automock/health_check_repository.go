@@ -2,13 +2,72 @@
 
 package automock
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+
+	time "time"
+)
 
 // HealthCheckRepository is an autogenerated mock type for the HealthCheckRepository type
 type HealthCheckRepository struct {
 	mock.Mock
 }
 
+// Create provides a mock function with given fields: ctx, item
+func (_m *HealthCheckRepository) Create(ctx context.Context, item *model.HealthCheck) error {
+	ret := _m.Called(ctx, item)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.HealthCheck) error); ok {
+		r0 = rf(ctx, item)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteOlderThan provides a mock function with given fields: ctx, date
+func (_m *HealthCheckRepository) DeleteOlderThan(ctx context.Context, date time.Time) error {
+	ret := _m.Called(ctx, date)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) error); ok {
+		r0 = rf(ctx, date)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// List provides a mock function with given fields: ctx, tenant, types, origin, pageSize, cursor
+func (_m *HealthCheckRepository) List(ctx context.Context, tenant string, types []model.HealthCheckType, origin string, pageSize int, cursor string) (*model.HealthCheckPage, error) {
+	ret := _m.Called(ctx, tenant, types, origin, pageSize, cursor)
+
+	var r0 *model.HealthCheckPage
+	if rf, ok := ret.Get(0).(func(context.Context, string, []model.HealthCheckType, string, int, string) *model.HealthCheckPage); ok {
+		r0 = rf(ctx, tenant, types, origin, pageSize, cursor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.HealthCheckPage)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, []model.HealthCheckType, string, int, string) error); ok {
+		r1 = rf(ctx, tenant, types, origin, pageSize, cursor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 type mockConstructorTestingTNewHealthCheckRepository interface {
 	mock.TestingT
 	Cleanup(func())
@@ -2,13 +2,79 @@
 
 package automock
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+
+	time "time"
+)
 
 // HealthCheckService is an autogenerated mock type for the HealthCheckService type
 type HealthCheckService struct {
 	mock.Mock
 }
 
+// Create provides a mock function with given fields: ctx, in
+func (_m *HealthCheckService) Create(ctx context.Context, in *model.HealthCheckInput) (string, error) {
+	ret := _m.Called(ctx, in)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(context.Context, *model.HealthCheckInput) string); ok {
+		r0 = rf(ctx, in)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *model.HealthCheckInput) error); ok {
+		r1 = rf(ctx, in)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteOlderThan provides a mock function with given fields: ctx, date
+func (_m *HealthCheckService) DeleteOlderThan(ctx context.Context, date time.Time) error {
+	ret := _m.Called(ctx, date)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) error); ok {
+		r0 = rf(ctx, date)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// List provides a mock function with given fields: ctx, types, origin, pageSize, cursor
+func (_m *HealthCheckService) List(ctx context.Context, types []model.HealthCheckType, origin string, pageSize int, cursor string) (*model.HealthCheckPage, error) {
+	ret := _m.Called(ctx, types, origin, pageSize, cursor)
+
+	var r0 *model.HealthCheckPage
+	if rf, ok := ret.Get(0).(func(context.Context, []model.HealthCheckType, string, int, string) *model.HealthCheckPage); ok {
+		r0 = rf(ctx, types, origin, pageSize, cursor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.HealthCheckPage)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []model.HealthCheckType, string, int, string) error); ok {
+		r1 = rf(ctx, types, origin, pageSize, cursor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 type mockConstructorTestingTNewHealthCheckService interface {
 	mock.TestingT
 	Cleanup(func())
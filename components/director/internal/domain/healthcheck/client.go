@@ -0,0 +1,84 @@
+package healthcheck
+
+import (
+	"context"
+	"io"
+	"net/http"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/accessstrategy"
+	httputil "github.com/kyma-incubator/compass/components/director/pkg/http"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/pkg/errors"
+)
+
+// Client calls the health endpoints of the applications
+//
+//go:generate mockery --name=Client --output=automock --outpkg=automock --case=underscore --disable-version-string
+type Client interface {
+	Probe(ctx context.Context, url string, auth *model.Auth) error
+}
+
+type client struct {
+	*http.Client
+	accessStrategyExecutorProvider accessstrategy.ExecutorProvider
+}
+
+// NewClient creates a new health check Client via a provided http.Client
+func NewClient(httpClient *http.Client, accessStrategyExecutorProvider accessstrategy.ExecutorProvider) *client {
+	return &client{
+		Client:                         httpClient,
+		accessStrategyExecutorProvider: accessStrategyExecutorProvider,
+	}
+}
+
+// Probe calls the health endpoint `url` using either the access strategy or the credentials from `auth`.
+// An error is returned if the endpoint can not be reached or responds with a non-successful status code.
+func (c *client) Probe(ctx context.Context, url string, auth *model.Auth) error {
+	resp, err := c.call(ctx, url, auth)
+	if err != nil {
+		return err
+	}
+	defer closeBody(ctx, resp.Body)
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return errors.Errorf("health endpoint %q responded with status code %d", url, resp.StatusCode)
+	}
+
+	return nil
+}
+
+func (c *client) call(ctx context.Context, url string, auth *model.Auth) (*http.Response, error) {
+	if auth != nil && auth.AccessStrategy != nil && len(*auth.AccessStrategy) > 0 {
+		executor, err := c.accessStrategyExecutorProvider.Provide(accessstrategy.Type(*auth.AccessStrategy))
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot find executor for access strategy %q", *auth.AccessStrategy)
+		}
+
+		resp, err := executor.Execute(ctx, c.Client, url, "", nil)
+		if err != nil {
+			return nil, errors.Wrapf(err, "while calling health endpoint %q with access strategy %q", url, *auth.AccessStrategy)
+		}
+		return resp, nil
+	}
+
+	if auth != nil {
+		resp, err := httputil.GetRequestWithCredentials(ctx, c.Client, url, "", nil, auth)
+		if err != nil {
+			return nil, errors.Wrapf(err, "while calling health endpoint %q with credentials", url)
+		}
+		return resp, nil
+	}
+
+	resp, err := httputil.GetRequestWithoutCredentials(c.Client, url, "", nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while calling health endpoint %q", url)
+	}
+	return resp, nil
+}
+
+func closeBody(ctx context.Context, body io.ReadCloser) {
+	if err := body.Close(); err != nil {
+		log.C(ctx).WithError(err).Warnf("Got error on closing response body")
+	}
+}
@@ -0,0 +1,106 @@
+package healthcheck_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/healthcheck"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/accessstrategy"
+	accessstrategyautomock "github.com/kyma-incubator/compass/components/director/pkg/accessstrategy/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_Probe(t *testing.T) {
+	username, password := "user", "pass"
+
+	testCases := []struct {
+		Name               string
+		Auth               *model.Auth
+		StatusCode         int
+		ExecutorProviderFn func() *accessstrategyautomock.ExecutorProvider
+		ExpectedErr        string
+	}{
+		{
+			Name:       "Success without auth",
+			StatusCode: http.StatusOK,
+		},
+		{
+			Name:       "Success with basic credentials",
+			Auth:       &model.Auth{Credential: model.CredentialData{Basic: &model.BasicCredentialData{Username: username, Password: password}}},
+			StatusCode: http.StatusOK,
+		},
+		{
+			Name:       "Success with access strategy",
+			Auth:       &model.Auth{AccessStrategy: str.Ptr(string(accessstrategy.CMPmTLSAccessStrategy))},
+			StatusCode: http.StatusOK,
+			ExecutorProviderFn: func() *accessstrategyautomock.ExecutorProvider {
+				executor := &accessstrategyautomock.Executor{}
+				executor.On("Execute", mock.Anything, mock.Anything, healthURL, "", http.Header(nil)).Return(&http.Response{StatusCode: http.StatusNoContent, Body: io.NopCloser(strings.NewReader(""))}, nil).Once()
+
+				provider := &accessstrategyautomock.ExecutorProvider{}
+				provider.On("Provide", accessstrategy.CMPmTLSAccessStrategy).Return(executor, nil).Once()
+				return provider
+			},
+		},
+		{
+			Name:       "Error when access strategy is not supported",
+			Auth:       &model.Auth{AccessStrategy: str.Ptr("unknown")},
+			StatusCode: http.StatusOK,
+			ExecutorProviderFn: func() *accessstrategyautomock.ExecutorProvider {
+				provider := &accessstrategyautomock.ExecutorProvider{}
+				provider.On("Provide", accessstrategy.Type("unknown")).Return(nil, testErr).Once()
+				return provider
+			},
+			ExpectedErr: testErr.Error(),
+		},
+		{
+			Name:        "Error when health endpoint responds with unsuccessful status code",
+			StatusCode:  http.StatusInternalServerError,
+			ExpectedErr: "responded with status code 500",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if testCase.Auth != nil && testCase.Auth.Credential.Basic != nil {
+					user, pass, ok := r.BasicAuth()
+					require.True(t, ok)
+					require.Equal(t, username, user)
+					require.Equal(t, password, pass)
+				}
+				w.WriteHeader(testCase.StatusCode)
+			}))
+			defer server.Close()
+
+			url := server.URL
+			provider := &accessstrategyautomock.ExecutorProvider{}
+			if testCase.ExecutorProviderFn != nil {
+				provider = testCase.ExecutorProviderFn()
+				url = healthURL
+			}
+			defer mock.AssertExpectationsForObjects(t, provider)
+
+			client := healthcheck.NewClient(server.Client(), provider)
+
+			// WHEN
+			err := client.Probe(context.TODO(), url, testCase.Auth)
+
+			// THEN
+			if testCase.ExpectedErr != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), testCase.ExpectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
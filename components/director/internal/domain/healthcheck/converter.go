@@ -1,4 +1,69 @@
 package healthcheck
 
+import (
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+)
+
 type converter struct {
 }
+
+// NewConverter returns a new Converter that can later be used to make the conversions between the service, repository and graphql layer representations of a HealthCheck.
+func NewConverter() *converter {
+	return &converter{}
+}
+
+// FromEntity converts the provided Entity repo-layer representation of a HealthCheck to the service-layer representation model.HealthCheck.
+func (c *converter) FromEntity(entity *Entity) *model.HealthCheck {
+	return &model.HealthCheck{
+		ID:        entity.ID,
+		Type:      model.HealthCheckType(entity.Type),
+		Condition: model.HealthCheckStatusCondition(entity.Condition),
+		Origin:    entity.AppID,
+		Message:   repo.StringPtrFromNullableString(entity.Message),
+		Timestamp: entity.Timestamp,
+	}
+}
+
+// ToEntity converts the provided service-layer representation of a HealthCheck to the repository-layer one.
+func (c *converter) ToEntity(in *model.HealthCheck) *Entity {
+	return &Entity{
+		ID:        in.ID,
+		AppID:     in.Origin,
+		Type:      string(in.Type),
+		Condition: string(in.Condition),
+		Message:   repo.NewNullableString(in.Message),
+		Timestamp: in.Timestamp,
+	}
+}
+
+// ToGraphQL converts the provided service-layer representation of a HealthCheck to the graphql-layer one.
+func (c *converter) ToGraphQL(in *model.HealthCheck) *graphql.HealthCheck {
+	if in == nil {
+		return nil
+	}
+
+	origin := in.Origin
+	return &graphql.HealthCheck{
+		Type:      graphql.HealthCheckType(in.Type),
+		Condition: graphql.HealthCheckStatusCondition(in.Condition),
+		Origin:    &origin,
+		Message:   in.Message,
+		Timestamp: graphql.Timestamp(in.Timestamp),
+	}
+}
+
+// MultipleToGraphQL converts multiple service-layer representations of a HealthCheck to the graphql-layer ones.
+func (c *converter) MultipleToGraphQL(in []*model.HealthCheck) []*graphql.HealthCheck {
+	healthChecks := make([]*graphql.HealthCheck, 0, len(in))
+	for _, hc := range in {
+		if hc == nil {
+			continue
+		}
+
+		healthChecks = append(healthChecks, c.ToGraphQL(hc))
+	}
+
+	return healthChecks
+}
@@ -0,0 +1,75 @@
+package healthcheck_test
+
+import (
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/healthcheck"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConverter_ToEntity(t *testing.T) {
+	// GIVEN
+	conv := healthcheck.NewConverter()
+
+	// WHEN
+	entity := conv.ToEntity(fixHealthCheckModel())
+
+	// THEN
+	require.Equal(t, fixHealthCheckEntity(), entity)
+}
+
+func TestConverter_FromEntity(t *testing.T) {
+	// GIVEN
+	conv := healthcheck.NewConverter()
+
+	// WHEN
+	hc := conv.FromEntity(fixHealthCheckEntity())
+
+	// THEN
+	require.Equal(t, fixHealthCheckModel(), hc)
+}
+
+func TestConverter_ToGraphQL(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Input    *model.HealthCheck
+		Expected *graphql.HealthCheck
+	}{
+		{
+			Name:     "Success",
+			Input:    fixHealthCheckModel(),
+			Expected: fixGQLHealthCheck(),
+		},
+		{
+			Name:     "Nil",
+			Input:    nil,
+			Expected: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			conv := healthcheck.NewConverter()
+
+			// WHEN
+			result := conv.ToGraphQL(testCase.Input)
+
+			// THEN
+			require.Equal(t, testCase.Expected, result)
+		})
+	}
+}
+
+func TestConverter_MultipleToGraphQL(t *testing.T) {
+	// GIVEN
+	conv := healthcheck.NewConverter()
+
+	// WHEN
+	result := conv.MultipleToGraphQL([]*model.HealthCheck{fixHealthCheckModel(), nil})
+
+	// THEN
+	require.Equal(t, []*graphql.HealthCheck{fixGQLHealthCheck()}, result)
+}
@@ -0,0 +1,24 @@
+package healthcheck
+
+import (
+	"database/sql"
+	"time"
+)
+
+// Entity is a representation of a HealthCheck in the database.
+type Entity struct {
+	ID        string         `db:"id"`
+	AppID     string         `db:"app_id"`
+	Type      string         `db:"type"`
+	Condition string         `db:"condition"`
+	Message   sql.NullString `db:"message"`
+	Timestamp time.Time      `db:"timestamp"`
+}
+
+// EntityCollection is a collection of HealthCheck entities.
+type EntityCollection []Entity
+
+// Len is implementation of a repo.Collection interface
+func (s EntityCollection) Len() int {
+	return len(s)
+}
@@ -0,0 +1,72 @@
+package healthcheck_test
+
+import (
+	"database/sql/driver"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/healthcheck"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+)
+
+const (
+	healthCheckID = "aaaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa"
+	appID         = "bbbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb"
+	tenantID      = "ccccccccc-cccc-cccc-cccc-cccccccccccc"
+	healthURL     = "https://app.com/health"
+	failedMessage = "health endpoint responded with status code 500"
+)
+
+var timestamp = time.Date(2023, 10, 19, 9, 0, 0, 0, time.UTC)
+
+func fixHealthCheckModel() *model.HealthCheck {
+	return &model.HealthCheck{
+		ID:        healthCheckID,
+		Type:      model.HealthCheckTypeManagementPlaneApplication,
+		Condition: model.HealthCheckStatusConditionFailed,
+		Origin:    appID,
+		Message:   str.Ptr(failedMessage),
+		Timestamp: timestamp,
+	}
+}
+
+func fixHealthCheckInput() *model.HealthCheckInput {
+	return &model.HealthCheckInput{
+		Type:      model.HealthCheckTypeManagementPlaneApplication,
+		Condition: model.HealthCheckStatusConditionFailed,
+		Origin:    appID,
+		Message:   str.Ptr(failedMessage),
+		Timestamp: timestamp,
+	}
+}
+
+func fixHealthCheckEntity() *healthcheck.Entity {
+	return &healthcheck.Entity{
+		ID:        healthCheckID,
+		AppID:     appID,
+		Type:      string(model.HealthCheckTypeManagementPlaneApplication),
+		Condition: string(model.HealthCheckStatusConditionFailed),
+		Message:   repo.NewValidNullableString(failedMessage),
+		Timestamp: timestamp,
+	}
+}
+
+func fixGQLHealthCheck() *graphql.HealthCheck {
+	return &graphql.HealthCheck{
+		Type:      graphql.HealthCheckTypeManagementPlaneApplicationHealthcheck,
+		Condition: graphql.HealthCheckStatusConditionFailed,
+		Origin:    str.Ptr(appID),
+		Message:   str.Ptr(failedMessage),
+		Timestamp: graphql.Timestamp(timestamp),
+	}
+}
+
+func fixHealthCheckCreateArgs(hc *model.HealthCheck) []driver.Value {
+	return []driver.Value{hc.ID, hc.Origin, hc.Type, hc.Condition, repo.NewNullableString(hc.Message), hc.Timestamp}
+}
+
+func fixColumns() []string {
+	return []string{"id", "app_id", "type", "condition", "message", "timestamp"}
+}
@@ -0,0 +1,191 @@
+package healthcheck
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/pkg/errors"
+)
+
+// ApplicationRepository is responsible for the repo-layer Application operations needed by the Prober
+//
+//go:generate mockery --name=ApplicationRepository --output=automock --outpkg=automock --case=underscore --disable-version-string
+type ApplicationRepository interface {
+	ListAllWithHealthCheckURLGlobal(ctx context.Context) ([]*model.Application, error)
+}
+
+// WebhookRepository is responsible for the repo-layer Webhook operations needed by the Prober
+//
+//go:generate mockery --name=WebhookRepository --output=automock --outpkg=automock --case=underscore --disable-version-string
+type WebhookRepository interface {
+	ListByReferenceObjectIDGlobal(ctx context.Context, objID string, objType model.WebhookReferenceObjectType) ([]*model.Webhook, error)
+}
+
+// ProberConfig contains the configuration of the health checks Prober
+type ProberConfig struct {
+	RetentionPeriod   time.Duration
+	MaxParallelProbes int
+}
+
+// Prober periodically calls the health endpoints of the applications and records the results as HealthChecks
+type Prober struct {
+	transact    persistence.Transactioner
+	appRepo     ApplicationRepository
+	webhookRepo WebhookRepository
+	svc         HealthCheckService
+	client      Client
+	config      ProberConfig
+}
+
+// NewProber creates a new health checks Prober
+func NewProber(transact persistence.Transactioner, appRepo ApplicationRepository, webhookRepo WebhookRepository, svc HealthCheckService, client Client, config ProberConfig) *Prober {
+	return &Prober{
+		transact:    transact,
+		appRepo:     appRepo,
+		webhookRepo: webhookRepo,
+		svc:         svc,
+		client:      client,
+		config:      config,
+	}
+}
+
+// ProbeApplications calls the health endpoint of every application with a configured health check URL, records the results
+// and deletes the HealthChecks older than the configured retention period. The endpoints are called with the auth of the
+// Open Resource Discovery webhook of the application, if there is such.
+func (p *Prober) ProbeApplications(ctx context.Context) error {
+	apps, err := p.listApplications(ctx)
+	if err != nil {
+		return err
+	}
+
+	log.C(ctx).Infof("Probing the health endpoints of %d applications", len(apps))
+
+	wg := sync.WaitGroup{}
+	workers := make(chan struct{}, p.maxParallelProbes())
+	for _, app := range apps {
+		wg.Add(1)
+		workers <- struct{}{}
+		go func(app *model.Application) {
+			defer func() {
+				wg.Done()
+				<-workers
+			}()
+
+			if err := p.probeApplication(ctx, app); err != nil {
+				log.C(ctx).WithError(err).Errorf("Failed to probe the health endpoint of application with id %q", app.ID)
+			}
+		}(app)
+	}
+	wg.Wait()
+
+	return p.deleteExpired(ctx)
+}
+
+func (p *Prober) listApplications(ctx context.Context) ([]*model.Application, error) {
+	tx, err := p.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer p.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	apps, err := p.appRepo.ListAllWithHealthCheckURLGlobal(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "while listing applications with health check URL")
+	}
+
+	return apps, tx.Commit()
+}
+
+func (p *Prober) probeApplication(ctx context.Context, app *model.Application) error {
+	if app.HealthCheckURL == nil || *app.HealthCheckURL == "" {
+		return nil
+	}
+
+	auth, err := p.getAuth(ctx, app.ID)
+	if err != nil {
+		return err
+	}
+
+	in := &model.HealthCheckInput{
+		Type:      model.HealthCheckTypeManagementPlaneApplication,
+		Condition: model.HealthCheckStatusConditionSucceeded,
+		Origin:    app.ID,
+	}
+	if err := p.client.Probe(ctx, *app.HealthCheckURL, auth); err != nil {
+		log.C(ctx).WithError(err).Warnf("Health check of application with id %q failed", app.ID)
+		message := err.Error()
+		in.Condition = model.HealthCheckStatusConditionFailed
+		in.Message = &message
+	}
+	in.Timestamp = time.Now()
+
+	tx, err := p.transact.Begin()
+	if err != nil {
+		return err
+	}
+	defer p.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	if _, err := p.svc.Create(ctx, in); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (p *Prober) getAuth(ctx context.Context, appID string) (*model.Auth, error) {
+	tx, err := p.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer p.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	webhooks, err := p.webhookRepo.ListByReferenceObjectIDGlobal(ctx, appID, model.ApplicationWebhookReference)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while listing webhooks of application with id %q", appID)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	for _, wh := range webhooks {
+		if wh.Type == model.WebhookTypeOpenResourceDiscovery {
+			return wh.Auth, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (p *Prober) deleteExpired(ctx context.Context) error {
+	tx, err := p.transact.Begin()
+	if err != nil {
+		return err
+	}
+	defer p.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	if err := p.svc.DeleteOlderThan(ctx, time.Now().Add(-p.config.RetentionPeriod)); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (p *Prober) maxParallelProbes() int {
+	if p.config.MaxParallelProbes < 1 {
+		return 1
+	}
+	return p.config.MaxParallelProbes
+}
@@ -0,0 +1,182 @@
+package healthcheck_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/healthcheck"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/healthcheck/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	persistenceautomock "github.com/kyma-incubator/compass/components/director/pkg/persistence/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProber_ProbeApplications(t *testing.T) {
+	app := &model.Application{HealthCheckURL: str.Ptr(healthURL), BaseEntity: &model.BaseEntity{ID: appID}}
+	auth := &model.Auth{Credential: model.CredentialData{Basic: &model.BasicCredentialData{Username: "user", Password: "pass"}}}
+	webhooks := []*model.Webhook{
+		{Type: model.WebhookTypeConfigurationChanged},
+		{Type: model.WebhookTypeOpenResourceDiscovery, Auth: auth},
+	}
+	retentionPeriod := time.Hour
+
+	healthCheckWithCondition := func(condition model.HealthCheckStatusCondition) interface{} {
+		return mock.MatchedBy(func(in *model.HealthCheckInput) bool {
+			return in.Origin == appID && in.Type == model.HealthCheckTypeManagementPlaneApplication && in.Condition == condition && !in.Timestamp.IsZero()
+		})
+	}
+	expiredBefore := mock.MatchedBy(func(date time.Time) bool {
+		return date.Before(time.Now().Add(-retentionPeriod).Add(time.Second))
+	})
+
+	testCases := []struct {
+		Name            string
+		TransactionerFn func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		AppRepoFn       func() *automock.ApplicationRepository
+		WebhookRepoFn   func() *automock.WebhookRepository
+		SvcFn           func() *automock.HealthCheckService
+		ClientFn        func() *automock.Client
+		ExpectedErr     error
+	}{
+		{
+			Name: "Success records succeeded health check",
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimes(4)
+			},
+			AppRepoFn: func() *automock.ApplicationRepository {
+				appRepo := &automock.ApplicationRepository{}
+				appRepo.On("ListAllWithHealthCheckURLGlobal", txtest.CtxWithDBMatcher()).Return([]*model.Application{app}, nil).Once()
+				return appRepo
+			},
+			WebhookRepoFn: func() *automock.WebhookRepository {
+				webhookRepo := &automock.WebhookRepository{}
+				webhookRepo.On("ListByReferenceObjectIDGlobal", txtest.CtxWithDBMatcher(), appID, model.ApplicationWebhookReference).Return(webhooks, nil).Once()
+				return webhookRepo
+			},
+			ClientFn: func() *automock.Client {
+				client := &automock.Client{}
+				client.On("Probe", mock.Anything, healthURL, auth).Return(nil).Once()
+				return client
+			},
+			SvcFn: func() *automock.HealthCheckService {
+				svc := &automock.HealthCheckService{}
+				svc.On("Create", txtest.CtxWithDBMatcher(), healthCheckWithCondition(model.HealthCheckStatusConditionSucceeded)).Return(healthCheckID, nil).Once()
+				svc.On("DeleteOlderThan", txtest.CtxWithDBMatcher(), expiredBefore).Return(nil).Once()
+				return svc
+			},
+		},
+		{
+			Name: "Success records failed health check",
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimes(4)
+			},
+			AppRepoFn: func() *automock.ApplicationRepository {
+				appRepo := &automock.ApplicationRepository{}
+				appRepo.On("ListAllWithHealthCheckURLGlobal", txtest.CtxWithDBMatcher()).Return([]*model.Application{app}, nil).Once()
+				return appRepo
+			},
+			WebhookRepoFn: func() *automock.WebhookRepository {
+				webhookRepo := &automock.WebhookRepository{}
+				webhookRepo.On("ListByReferenceObjectIDGlobal", txtest.CtxWithDBMatcher(), appID, model.ApplicationWebhookReference).Return(nil, nil).Once()
+				return webhookRepo
+			},
+			ClientFn: func() *automock.Client {
+				client := &automock.Client{}
+				client.On("Probe", mock.Anything, healthURL, (*model.Auth)(nil)).Return(testErr).Once()
+				return client
+			},
+			SvcFn: func() *automock.HealthCheckService {
+				svc := &automock.HealthCheckService{}
+				svc.On("Create", txtest.CtxWithDBMatcher(), healthCheckWithCondition(model.HealthCheckStatusConditionFailed)).Return(healthCheckID, nil).Once()
+				svc.On("DeleteOlderThan", txtest.CtxWithDBMatcher(), expiredBefore).Return(nil).Once()
+				return svc
+			},
+		},
+		{
+			Name: "Success when listing the webhooks of an application fails",
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimesAndCommitsMultipleTimes(3, 2)
+			},
+			AppRepoFn: func() *automock.ApplicationRepository {
+				appRepo := &automock.ApplicationRepository{}
+				appRepo.On("ListAllWithHealthCheckURLGlobal", txtest.CtxWithDBMatcher()).Return([]*model.Application{app}, nil).Once()
+				return appRepo
+			},
+			WebhookRepoFn: func() *automock.WebhookRepository {
+				webhookRepo := &automock.WebhookRepository{}
+				webhookRepo.On("ListByReferenceObjectIDGlobal", txtest.CtxWithDBMatcher(), appID, model.ApplicationWebhookReference).Return(nil, testErr).Once()
+				return webhookRepo
+			},
+			SvcFn: func() *automock.HealthCheckService {
+				svc := &automock.HealthCheckService{}
+				svc.On("DeleteOlderThan", txtest.CtxWithDBMatcher(), expiredBefore).Return(nil).Once()
+				return svc
+			},
+		},
+		{
+			Name:            "Error when listing applications fails",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			AppRepoFn: func() *automock.ApplicationRepository {
+				appRepo := &automock.ApplicationRepository{}
+				appRepo.On("ListAllWithHealthCheckURLGlobal", txtest.CtxWithDBMatcher()).Return(nil, testErr).Once()
+				return appRepo
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name: "Error when deleting expired health checks fails",
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimesAndCommitsMultipleTimes(2, 1)
+			},
+			AppRepoFn: func() *automock.ApplicationRepository {
+				appRepo := &automock.ApplicationRepository{}
+				appRepo.On("ListAllWithHealthCheckURLGlobal", txtest.CtxWithDBMatcher()).Return([]*model.Application{}, nil).Once()
+				return appRepo
+			},
+			SvcFn: func() *automock.HealthCheckService {
+				svc := &automock.HealthCheckService{}
+				svc.On("DeleteOlderThan", txtest.CtxWithDBMatcher(), expiredBefore).Return(testErr).Once()
+				return svc
+			},
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			persist, transact := testCase.TransactionerFn()
+			appRepo := testCase.AppRepoFn()
+			webhookRepo := &automock.WebhookRepository{}
+			if testCase.WebhookRepoFn != nil {
+				webhookRepo = testCase.WebhookRepoFn()
+			}
+			svc := &automock.HealthCheckService{}
+			if testCase.SvcFn != nil {
+				svc = testCase.SvcFn()
+			}
+			client := &automock.Client{}
+			if testCase.ClientFn != nil {
+				client = testCase.ClientFn()
+			}
+			defer mock.AssertExpectationsForObjects(t, persist, transact, appRepo, webhookRepo, svc, client)
+
+			prober := healthcheck.NewProber(transact, appRepo, webhookRepo, svc, client, healthcheck.ProberConfig{RetentionPeriod: retentionPeriod, MaxParallelProbes: 1})
+
+			// WHEN
+			err := prober.ProbeApplications(context.TODO())
+
+			// THEN
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
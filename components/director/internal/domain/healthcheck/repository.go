@@ -1,14 +1,98 @@
 package healthcheck
 
 import (
+	"context"
+	"time"
+
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+)
+
+const healthCheckTable = `public.health_checks`
+
+var (
+	typeColumn       = "type"
+	appIDColumn      = "app_id"
+	timestampColumn  = "timestamp"
+	healthCheckOrder = timestampColumn + " DESC, id"
+	healthCheckCols  = []string{"id", appIDColumn, typeColumn, "condition", "message", timestampColumn}
 )
 
-type inMemoryRepository struct {
-	store map[string]*model.Runtime
+// EntityConverter converts HealthChecks between the service-layer and the repository-layer representations
+//
+//go:generate mockery --name=EntityConverter --output=automock --outpkg=automock --case=underscore --disable-version-string
+type EntityConverter interface {
+	ToEntity(in *model.HealthCheck) *Entity
+	FromEntity(entity *Entity) *model.HealthCheck
+}
+
+type pgRepository struct {
+	globalCreator   repo.CreatorGlobal
+	pageableQuerier repo.PageableQuerier
+	globalDeleter   repo.DeleterGlobal
+	conv            EntityConverter
+}
+
+// NewRepository creates a new HealthCheck repository
+func NewRepository(conv EntityConverter) *pgRepository {
+	return &pgRepository{
+		globalCreator:   repo.NewCreatorGlobal(resource.HealthCheck, healthCheckTable, healthCheckCols),
+		pageableQuerier: repo.NewPageableQuerier(healthCheckTable, healthCheckCols),
+		globalDeleter:   repo.NewDeleterGlobal(resource.HealthCheck, healthCheckTable),
+		conv:            conv,
+	}
+}
+
+// Create persists the provided HealthCheck
+func (r *pgRepository) Create(ctx context.Context, item *model.HealthCheck) error {
+	if item == nil {
+		return apperrors.NewInternalError("model can not be empty")
+	}
+
+	log.C(ctx).Debugf("Persisting HealthCheck entity with id %s for %q", item.ID, item.Origin)
+	return r.globalCreator.Create(ctx, r.conv.ToEntity(item))
+}
+
+// List returns a page of the HealthChecks visible in tenant `tenant` ordered from the newest to the oldest one.
+// If `types` or `origin` are provided only the HealthChecks matching them are returned.
+func (r *pgRepository) List(ctx context.Context, tenant string, types []model.HealthCheckType, origin string, pageSize int, cursor string) (*model.HealthCheckPage, error) {
+	var conditions repo.Conditions
+	if len(types) > 0 {
+		typeValues := make([]string, 0, len(types))
+		for _, t := range types {
+			typeValues = append(typeValues, string(t))
+		}
+		conditions = append(conditions, repo.NewInConditionForStringValues(typeColumn, typeValues))
+	}
+	if origin != "" {
+		conditions = append(conditions, repo.NewEqualCondition(appIDColumn, origin))
+	}
+
+	var entities EntityCollection
+	page, totalCount, err := r.pageableQuerier.List(ctx, resource.HealthCheck, tenant, pageSize, cursor, healthCheckOrder, &entities, conditions...)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*model.HealthCheck, 0, len(entities))
+	for _, entity := range entities {
+		items = append(items, r.conv.FromEntity(&entity))
+	}
+
+	return &model.HealthCheckPage{
+		Data:       items,
+		TotalCount: totalCount,
+		PageInfo:   page,
+	}, nil
 }
 
-// NewRepository missing godoc
-func NewRepository() *inMemoryRepository {
-	return &inMemoryRepository{store: make(map[string]*model.Runtime)}
+// DeleteOlderThan deletes all HealthChecks performed before `date`
+func (r *pgRepository) DeleteOlderThan(ctx context.Context, date time.Time) error {
+	log.C(ctx).Infof("Deleting all health checks older than %v", date)
+	return r.globalDeleter.DeleteManyGlobal(ctx, repo.Conditions{
+		repo.NewLessThanCondition(timestampColumn, date),
+	})
 }
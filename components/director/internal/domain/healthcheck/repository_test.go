@@ -0,0 +1,122 @@
+package healthcheck_test
+
+import (
+	"database/sql/driver"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/healthcheck"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/healthcheck/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo/testdb"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
+)
+
+func TestPgRepository_Create(t *testing.T) {
+	// GIVEN
+	var nilHealthCheckModel *model.HealthCheck
+	healthCheckModel := fixHealthCheckModel()
+	healthCheckEntity := fixHealthCheckEntity()
+
+	suite := testdb.RepoCreateTestSuite{
+		Name: "Create HealthCheck",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:       `^INSERT INTO public.health_checks \(.+\) VALUES \(.+\)$`,
+				Args:        fixHealthCheckCreateArgs(healthCheckModel),
+				ValidResult: sqlmock.NewResult(-1, 1),
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		RepoConstructorFunc:       healthcheck.NewRepository,
+		ModelEntity:               healthCheckModel,
+		DBEntity:                  healthCheckEntity,
+		NilModelEntity:            nilHealthCheckModel,
+		DisableConverterErrorTest: true,
+		IsGlobal:                  true,
+	}
+
+	suite.Run(t)
+}
+
+func TestPgRepository_List(t *testing.T) {
+	healthCheckModel := fixHealthCheckModel()
+	healthCheckEntity := fixHealthCheckEntity()
+
+	suite := testdb.RepoListPageableTestSuite{
+		Name:       "List HealthChecks with filter and paging",
+		MethodName: "List",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:    regexp.QuoteMeta(`SELECT id, app_id, type, condition, message, timestamp FROM public.health_checks WHERE (type IN ($1) AND app_id = $2 AND (id IN (SELECT id FROM health_checks_tenants WHERE tenant_id = $3))) ORDER BY timestamp DESC, id LIMIT 3 OFFSET 0`),
+				Args:     []driver.Value{string(model.HealthCheckTypeManagementPlaneApplication), appID, tenantID},
+				IsSelect: true,
+				ValidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixColumns()).AddRow(healthCheckEntity.ID, healthCheckEntity.AppID, healthCheckEntity.Type, healthCheckEntity.Condition, healthCheckEntity.Message, healthCheckEntity.Timestamp)}
+				},
+				InvalidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixColumns())}
+				},
+			},
+			{
+				Query:    regexp.QuoteMeta(`SELECT COUNT(*) FROM public.health_checks WHERE (type IN ($1) AND app_id = $2 AND (id IN (SELECT id FROM health_checks_tenants WHERE tenant_id = $3)))`),
+				Args:     []driver.Value{string(model.HealthCheckTypeManagementPlaneApplication), appID, tenantID},
+				IsSelect: true,
+				ValidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows([]string{"count"}).AddRow(1)}
+				},
+			},
+		},
+		Pages: []testdb.PageDetails{
+			{
+				ExpectedModelEntities: []interface{}{healthCheckModel},
+				ExpectedDBEntities:    []interface{}{healthCheckEntity},
+				ExpectedPage: &model.HealthCheckPage{
+					Data: []*model.HealthCheck{healthCheckModel},
+					PageInfo: &pagination.Page{
+						StartCursor: "",
+						EndCursor:   "",
+						HasNextPage: false,
+					},
+					TotalCount: 1,
+				},
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		RepoConstructorFunc:       healthcheck.NewRepository,
+		MethodArgs:                []interface{}{tenantID, []model.HealthCheckType{model.HealthCheckTypeManagementPlaneApplication}, appID, 3, ""},
+		DisableConverterErrorTest: true,
+	}
+
+	suite.Run(t)
+}
+
+func TestPgRepository_DeleteOlderThan(t *testing.T) {
+	suite := testdb.RepoDeleteTestSuite{
+		Name: "DeleteOlderThan HealthCheck",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:         regexp.QuoteMeta(`DELETE FROM public.health_checks WHERE timestamp < $1`),
+				Args:          []driver.Value{time.Time{}},
+				ValidResult:   sqlmock.NewResult(-1, 1),
+				InvalidResult: sqlmock.NewResult(-1, 2),
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		RepoConstructorFunc: healthcheck.NewRepository,
+		MethodName:          "DeleteOlderThan",
+		MethodArgs:          []interface{}{time.Time{}},
+		IsDeleteMany:        true,
+		IsGlobal:            true,
+	}
+
+	suite.Run(t)
+}
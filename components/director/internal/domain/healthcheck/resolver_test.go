@@ -0,0 +1,141 @@
+package healthcheck_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/healthcheck"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/healthcheck/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
+	persistenceautomock "github.com/kyma-incubator/compass/components/director/pkg/persistence/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testErr = errors.New("test error")
+	txGen   = txtest.NewTransactionContextGenerator(testErr)
+)
+
+func TestResolver_HealthChecks(t *testing.T) {
+	first := 2
+	gqlAfter := graphql.PageCursor("test")
+	gqlTypes := []graphql.HealthCheckType{graphql.HealthCheckTypeManagementPlaneApplicationHealthcheck}
+	types := []model.HealthCheckType{model.HealthCheckTypeManagementPlaneApplication}
+
+	hcPage := &model.HealthCheckPage{
+		Data:       []*model.HealthCheck{fixHealthCheckModel()},
+		TotalCount: 1,
+		PageInfo: &pagination.Page{
+			StartCursor: "start",
+			EndCursor:   "end",
+			HasNextPage: false,
+		},
+	}
+	gqlPage := &graphql.HealthCheckPage{
+		Data:       []*graphql.HealthCheck{fixGQLHealthCheck()},
+		TotalCount: 1,
+		PageInfo: &graphql.PageInfo{
+			StartCursor: "start",
+			EndCursor:   "end",
+			HasNextPage: false,
+		},
+	}
+
+	testCases := []struct {
+		Name            string
+		TransactionerFn func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ConverterFn     func() *automock.HealthCheckConverter
+		SvcFn           func() *automock.HealthCheckService
+		First           *int
+		ExpectedOutput  *graphql.HealthCheckPage
+		ExpectedError   error
+	}{
+		{
+			Name:            "Success",
+			TransactionerFn: txGen.ThatSucceeds,
+			ConverterFn: func() *automock.HealthCheckConverter {
+				conv := &automock.HealthCheckConverter{}
+				conv.On("MultipleToGraphQL", hcPage.Data).Return(gqlPage.Data).Once()
+				return conv
+			},
+			SvcFn: func() *automock.HealthCheckService {
+				svc := &automock.HealthCheckService{}
+				svc.On("List", txtest.CtxWithDBMatcher(), types, appID, first, string(gqlAfter)).Return(hcPage, nil).Once()
+				return svc
+			},
+			First:          &first,
+			ExpectedOutput: gqlPage,
+		},
+		{
+			Name:          "Error when first is missing",
+			ExpectedError: errors.New("missing required parameter 'first'"),
+		},
+		{
+			Name:            "Error when transaction fails to begin",
+			TransactionerFn: txGen.ThatFailsOnBegin,
+			First:           &first,
+			ExpectedError:   testErr,
+		},
+		{
+			Name:            "Error when listing HealthChecks fails",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			SvcFn: func() *automock.HealthCheckService {
+				svc := &automock.HealthCheckService{}
+				svc.On("List", txtest.CtxWithDBMatcher(), types, appID, first, string(gqlAfter)).Return(nil, testErr).Once()
+				return svc
+			},
+			First:         &first,
+			ExpectedError: testErr,
+		},
+		{
+			Name:            "Error when committing the transaction fails",
+			TransactionerFn: txGen.ThatFailsOnCommit,
+			SvcFn: func() *automock.HealthCheckService {
+				svc := &automock.HealthCheckService{}
+				svc.On("List", txtest.CtxWithDBMatcher(), types, appID, first, string(gqlAfter)).Return(hcPage, nil).Once()
+				return svc
+			},
+			First:         &first,
+			ExpectedError: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			persist, transact := &persistenceautomock.PersistenceTx{}, &persistenceautomock.Transactioner{}
+			if testCase.TransactionerFn != nil {
+				persist, transact = testCase.TransactionerFn()
+			}
+			conv := &automock.HealthCheckConverter{}
+			if testCase.ConverterFn != nil {
+				conv = testCase.ConverterFn()
+			}
+			svc := &automock.HealthCheckService{}
+			if testCase.SvcFn != nil {
+				svc = testCase.SvcFn()
+			}
+			defer mock.AssertExpectationsForObjects(t, persist, transact, conv, svc)
+
+			resolver := healthcheck.NewResolver(transact, svc, conv)
+
+			// WHEN
+			result, err := resolver.HealthChecks(context.TODO(), gqlTypes, str.Ptr(appID), testCase.First, &gqlAfter)
+
+			// THEN
+			if testCase.ExpectedError != nil {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), testCase.ExpectedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, testCase.ExpectedOutput, result)
+		})
+	}
+}
@@ -1,15 +1,82 @@
 package healthcheck
 
-// HealthCheckRepository missing godoc
+import (
+	"context"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/pkg/errors"
+)
+
+// HealthCheckRepository is responsible for repository-layer HealthCheck operations
+//
 //go:generate mockery --name=HealthCheckRepository --output=automock --outpkg=automock --case=underscore --disable-version-string
 type HealthCheckRepository interface {
+	Create(ctx context.Context, item *model.HealthCheck) error
+	List(ctx context.Context, tenant string, types []model.HealthCheckType, origin string, pageSize int, cursor string) (*model.HealthCheckPage, error)
+	DeleteOlderThan(ctx context.Context, date time.Time) error
+}
+
+// UIDService is responsible for service-layer uid operations
+//
+//go:generate mockery --name=UIDService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type UIDService interface {
+	Generate() string
 }
 
 type service struct {
-	repo HealthCheckRepository
+	repo       HealthCheckRepository
+	uidService UIDService
+}
+
+// NewService creates a new HealthCheck service
+func NewService(repo HealthCheckRepository, uidService UIDService) *service {
+	return &service{
+		repo:       repo,
+		uidService: uidService,
+	}
+}
+
+// Create records the result of a health check and returns its id
+func (s *service) Create(ctx context.Context, in *model.HealthCheckInput) (string, error) {
+	id := s.uidService.Generate()
+	healthCheck := in.ToHealthCheck(id)
+
+	if err := s.repo.Create(ctx, healthCheck); err != nil {
+		return "", errors.Wrapf(err, "while creating HealthCheck for %q", in.Origin)
+	}
+
+	log.C(ctx).Debugf("Successfully created HealthCheck with id %s for %q", id, in.Origin)
+	return id, nil
+}
+
+// List returns a page of the HealthChecks in the tenant from the context optionally filtered by types and origin
+func (s *service) List(ctx context.Context, types []model.HealthCheckType, origin string, pageSize int, cursor string) (*model.HealthCheckPage, error) {
+	if pageSize < 1 || pageSize > 200 {
+		return nil, apperrors.NewInvalidDataError("page size must be between 1 and 200")
+	}
+
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := s.repo.List(ctx, tnt, types, origin, pageSize, cursor)
+	if err != nil {
+		return nil, errors.Wrap(err, "while listing HealthChecks")
+	}
+
+	return page, nil
 }
 
-// NewService missing godoc
-func NewService(repo HealthCheckRepository) *service {
-	return &service{repo: repo}
+// DeleteOlderThan deletes all HealthChecks performed before `date`
+func (s *service) DeleteOlderThan(ctx context.Context, date time.Time) error {
+	if err := s.repo.DeleteOlderThan(ctx, date); err != nil {
+		return errors.Wrapf(err, "while deleting HealthChecks older than %v", date)
+	}
+
+	return nil
 }
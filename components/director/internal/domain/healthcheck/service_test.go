@@ -0,0 +1,197 @@
+package healthcheck_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/healthcheck"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/healthcheck/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_Create(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("test error")
+	ctx := context.TODO()
+
+	testCases := []struct {
+		Name         string
+		RepositoryFn func() *automock.HealthCheckRepository
+		ExpectedErr  error
+	}{
+		{
+			Name: "Success",
+			RepositoryFn: func() *automock.HealthCheckRepository {
+				repo := &automock.HealthCheckRepository{}
+				repo.On("Create", ctx, fixHealthCheckModel()).Return(nil).Once()
+				return repo
+			},
+		},
+		{
+			Name: "Error when creating HealthCheck fails",
+			RepositoryFn: func() *automock.HealthCheckRepository {
+				repo := &automock.HealthCheckRepository{}
+				repo.On("Create", ctx, fixHealthCheckModel()).Return(testErr).Once()
+				return repo
+			},
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			repo := testCase.RepositoryFn()
+			uidService := &automock.UIDService{}
+			uidService.On("Generate").Return(healthCheckID).Once()
+			defer mock.AssertExpectationsForObjects(t, repo, uidService)
+
+			svc := healthcheck.NewService(repo, uidService)
+
+			// WHEN
+			id, err := svc.Create(ctx, fixHealthCheckInput())
+
+			// THEN
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+				require.Empty(t, id)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, healthCheckID, id)
+			}
+		})
+	}
+}
+
+func TestService_List(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("test error")
+	ctx := tenant.SaveToContext(context.TODO(), tenantID, "")
+	types := []model.HealthCheckType{model.HealthCheckTypeManagementPlaneApplication}
+	pageSize := 2
+	cursor := "cursor"
+	page := &model.HealthCheckPage{
+		Data:       []*model.HealthCheck{fixHealthCheckModel()},
+		PageInfo:   &pagination.Page{},
+		TotalCount: 1,
+	}
+
+	testCases := []struct {
+		Name           string
+		Context        context.Context
+		PageSize       int
+		RepositoryFn   func() *automock.HealthCheckRepository
+		ExpectedOutput *model.HealthCheckPage
+		ExpectedErr    error
+	}{
+		{
+			Name:     "Success",
+			Context:  ctx,
+			PageSize: pageSize,
+			RepositoryFn: func() *automock.HealthCheckRepository {
+				repo := &automock.HealthCheckRepository{}
+				repo.On("List", ctx, tenantID, types, appID, pageSize, cursor).Return(page, nil).Once()
+				return repo
+			},
+			ExpectedOutput: page,
+		},
+		{
+			Name:         "Error when page size is out of range",
+			Context:      ctx,
+			PageSize:     201,
+			RepositoryFn: func() *automock.HealthCheckRepository { return &automock.HealthCheckRepository{} },
+			ExpectedErr:  errors.New("page size must be between 1 and 200"),
+		},
+		{
+			Name:         "Error when tenant is missing in the context",
+			Context:      context.TODO(),
+			PageSize:     pageSize,
+			RepositoryFn: func() *automock.HealthCheckRepository { return &automock.HealthCheckRepository{} },
+			ExpectedErr:  errors.New("cannot read tenant from context"),
+		},
+		{
+			Name:     "Error when listing HealthChecks fails",
+			Context:  ctx,
+			PageSize: pageSize,
+			RepositoryFn: func() *automock.HealthCheckRepository {
+				repo := &automock.HealthCheckRepository{}
+				repo.On("List", ctx, tenantID, types, appID, pageSize, cursor).Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			repo := testCase.RepositoryFn()
+			defer mock.AssertExpectationsForObjects(t, repo)
+
+			svc := healthcheck.NewService(repo, nil)
+
+			// WHEN
+			result, err := svc.List(testCase.Context, types, appID, testCase.PageSize, cursor)
+
+			// THEN
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, testCase.ExpectedOutput, result)
+		})
+	}
+}
+
+func TestService_DeleteOlderThan(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("test error")
+	ctx := context.TODO()
+	date := time.Now()
+
+	testCases := []struct {
+		Name        string
+		RepoErr     error
+		ExpectedErr error
+	}{
+		{
+			Name: "Success",
+		},
+		{
+			Name:        "Error when deleting HealthChecks fails",
+			RepoErr:     testErr,
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			repo := &automock.HealthCheckRepository{}
+			repo.On("DeleteOlderThan", ctx, date).Return(testCase.RepoErr).Once()
+			defer mock.AssertExpectationsForObjects(t, repo)
+
+			svc := healthcheck.NewService(repo, nil)
+
+			// WHEN
+			err := svc.DeleteOlderThan(ctx, date)
+
+			// THEN
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
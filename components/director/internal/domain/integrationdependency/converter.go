@@ -0,0 +1,116 @@
+package integrationdependency
+
+import (
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+)
+
+type converter struct {
+}
+
+// NewConverter returns a new Converter that can later be used to make the conversions between the GraphQL, service, and repository layer representations of an ORD Integration Dependency.
+func NewConverter() *converter {
+	return &converter{}
+}
+
+// ToEntity converts the provided service-layer representation of an Integration Dependency to the repository-layer one.
+func (c *converter) ToEntity(in *model.IntegrationDependency) *Entity {
+	if in == nil {
+		return nil
+	}
+
+	return &Entity{
+		ID:                             in.ID,
+		ApplicationID:                  repo.NewNullableString(in.ApplicationID),
+		ApplicationTemplateVersionID:   repo.NewNullableString(in.ApplicationTemplateVersionID),
+		PackageID:                      in.PackageID,
+		OrdID:                          in.OrdID,
+		LocalID:                        repo.NewNullableString(in.LocalID),
+		CorrelationIDs:                 repo.NewNullableStringFromJSONRawMessage(in.CorrelationIDs),
+		Title:                          in.Title,
+		ShortDescription:               repo.NewNullableString(in.ShortDescription),
+		Description:                    repo.NewNullableString(in.Description),
+		Version:                        in.Version,
+		Visibility:                     in.Visibility,
+		ReleaseStatus:                  in.ReleaseStatus,
+		SunsetDate:                     repo.NewNullableString(in.SunsetDate),
+		Successors:                     repo.NewNullableStringFromJSONRawMessage(in.Successors),
+		Mandatory:                      in.Mandatory,
+		Aspects:                        repo.NewNullableStringFromJSONRawMessage(in.Aspects),
+		RelatedIntegrationDependencies: repo.NewNullableStringFromJSONRawMessage(in.RelatedIntegrationDependencies),
+		Links:                          repo.NewNullableStringFromJSONRawMessage(in.Links),
+		Tags:                           repo.NewNullableStringFromJSONRawMessage(in.Tags),
+		Labels:                         repo.NewNullableStringFromJSONRawMessage(in.Labels),
+		DocumentationLabels:            repo.NewNullableStringFromJSONRawMessage(in.DocumentationLabels),
+		LastUpdate:                     repo.NewNullableString(in.LastUpdate),
+		ResourceHash:                   repo.NewNullableString(in.ResourceHash),
+	}
+}
+
+// FromEntity converts the provided Entity repo-layer representation of an Integration Dependency to the service-layer representation model.IntegrationDependency.
+func (c *converter) FromEntity(entity *Entity) (*model.IntegrationDependency, error) {
+	if entity == nil {
+		return nil, apperrors.NewInternalError("the Integration Dependency entity is nil")
+	}
+
+	return &model.IntegrationDependency{
+		ID:                             entity.ID,
+		ApplicationID:                  repo.StringPtrFromNullableString(entity.ApplicationID),
+		ApplicationTemplateVersionID:   repo.StringPtrFromNullableString(entity.ApplicationTemplateVersionID),
+		PackageID:                      entity.PackageID,
+		OrdID:                          entity.OrdID,
+		LocalID:                        repo.StringPtrFromNullableString(entity.LocalID),
+		CorrelationIDs:                 repo.JSONRawMessageFromNullableString(entity.CorrelationIDs),
+		Title:                          entity.Title,
+		ShortDescription:               repo.StringPtrFromNullableString(entity.ShortDescription),
+		Description:                    repo.StringPtrFromNullableString(entity.Description),
+		Version:                        entity.Version,
+		Visibility:                     entity.Visibility,
+		ReleaseStatus:                  entity.ReleaseStatus,
+		SunsetDate:                     repo.StringPtrFromNullableString(entity.SunsetDate),
+		Successors:                     repo.JSONRawMessageFromNullableString(entity.Successors),
+		Mandatory:                      entity.Mandatory,
+		Aspects:                        repo.JSONRawMessageFromNullableString(entity.Aspects),
+		RelatedIntegrationDependencies: repo.JSONRawMessageFromNullableString(entity.RelatedIntegrationDependencies),
+		Links:                          repo.JSONRawMessageFromNullableString(entity.Links),
+		Tags:                           repo.JSONRawMessageFromNullableString(entity.Tags),
+		Labels:                         repo.JSONRawMessageFromNullableString(entity.Labels),
+		DocumentationLabels:            repo.JSONRawMessageFromNullableString(entity.DocumentationLabels),
+		LastUpdate:                     repo.StringPtrFromNullableString(entity.LastUpdate),
+		ResourceHash:                   repo.StringPtrFromNullableString(entity.ResourceHash),
+	}, nil
+}
+
+// ProviderToGraphQL converts the provided service-layer representation of an Integration Dependency provider to the graphql-layer one.
+func (c *converter) ProviderToGraphQL(in *model.IntegrationDependencyProvider) *graphql.IntegrationDependencyProvider {
+	if in == nil {
+		return nil
+	}
+
+	return &graphql.IntegrationDependencyProvider{
+		IntegrationDependencyID:    in.IntegrationDependencyID,
+		IntegrationDependencyOrdID: in.IntegrationDependencyOrdID,
+		AspectTitle:                in.AspectTitle,
+		Mandatory:                  in.Mandatory,
+		APIDefinitionID:            in.APIDefinitionID,
+		APIDefinitionOrdID:         in.APIDefinitionOrdID,
+		EventDefinitionID:          in.EventDefinitionID,
+		EventDefinitionOrdID:       in.EventDefinitionOrdID,
+		ApplicationID:              in.ApplicationID,
+	}
+}
+
+// MultipleProvidersToGraphQL converts the provided service-layer representations of Integration Dependency providers to the graphql-layer ones.
+func (c *converter) MultipleProvidersToGraphQL(in []*model.IntegrationDependencyProvider) []*graphql.IntegrationDependencyProvider {
+	providers := make([]*graphql.IntegrationDependencyProvider, 0, len(in))
+	for _, provider := range in {
+		if provider == nil {
+			continue
+		}
+		providers = append(providers, c.ProviderToGraphQL(provider))
+	}
+
+	return providers
+}
@@ -0,0 +1,69 @@
+package integrationdependency_test
+
+import (
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/integrationdependency"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEntityConverter_ToEntity(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		integrationDependencyModel := fixIntegrationDependencyModelForApp()
+		require.NotNil(t, integrationDependencyModel)
+		conv := integrationdependency.NewConverter()
+
+		entity := conv.ToEntity(integrationDependencyModel)
+
+		assert.Equal(t, fixIntegrationDependencyEntityForApp(), entity)
+	})
+
+	t.Run("Returns nil if integration dependency model is nil", func(t *testing.T) {
+		conv := integrationdependency.NewConverter()
+
+		ent := conv.ToEntity(nil)
+
+		require.Nil(t, ent)
+	})
+}
+
+func TestEntityConverter_FromEntity(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		entity := fixIntegrationDependencyEntityForApp()
+		conv := integrationdependency.NewConverter()
+
+		integrationDependencyModel, err := conv.FromEntity(entity)
+
+		require.NoError(t, err)
+		assert.Equal(t, fixIntegrationDependencyModelForApp(), integrationDependencyModel)
+	})
+
+	t.Run("Returns error if Entity is nil", func(t *testing.T) {
+		conv := integrationdependency.NewConverter()
+
+		_, err := conv.FromEntity(nil)
+
+		require.Error(t, err)
+	})
+}
+
+func TestConverter_MultipleProvidersToGraphQL(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		conv := integrationdependency.NewConverter()
+
+		gqlProviders := conv.MultipleProvidersToGraphQL([]*model.IntegrationDependencyProvider{fixIntegrationDependencyProviderModel(), nil})
+
+		assert.Equal(t, []*graphql.IntegrationDependencyProvider{fixGQLIntegrationDependencyProvider()}, gqlProviders)
+	})
+
+	t.Run("Returns empty slice if input is empty", func(t *testing.T) {
+		conv := integrationdependency.NewConverter()
+
+		gqlProviders := conv.MultipleProvidersToGraphQL(nil)
+
+		assert.Empty(t, gqlProviders)
+	})
+}
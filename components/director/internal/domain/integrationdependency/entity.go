@@ -0,0 +1,62 @@
+package integrationdependency
+
+import (
+	"database/sql"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+)
+
+// Entity represents the ORD integration dependency entity.
+type Entity struct {
+	ID                             string         `db:"id"`
+	ApplicationID                  sql.NullString `db:"app_id"`
+	ApplicationTemplateVersionID   sql.NullString `db:"app_template_version_id"`
+	PackageID                      string         `db:"package_id"`
+	OrdID                          string         `db:"ord_id"`
+	LocalID                        sql.NullString `db:"local_id"`
+	CorrelationIDs                 sql.NullString `db:"correlation_ids"`
+	Title                          string         `db:"title"`
+	ShortDescription               sql.NullString `db:"short_description"`
+	Description                    sql.NullString `db:"description"`
+	Version                        string         `db:"version"`
+	Visibility                     string         `db:"visibility"`
+	ReleaseStatus                  string         `db:"release_status"`
+	SunsetDate                     sql.NullString `db:"sunset_date"`
+	Successors                     sql.NullString `db:"successors"`
+	Mandatory                      bool           `db:"mandatory"`
+	Aspects                        sql.NullString `db:"aspects"`
+	RelatedIntegrationDependencies sql.NullString `db:"related_integration_dependencies"`
+	Links                          sql.NullString `db:"links"`
+	Tags                           sql.NullString `db:"tags"`
+	Labels                         sql.NullString `db:"labels"`
+	DocumentationLabels            sql.NullString `db:"documentation_labels"`
+	LastUpdate                     sql.NullString `db:"last_update"`
+	ResourceHash                   sql.NullString `db:"resource_hash"`
+}
+
+// GetID returns the ID of the entity.
+func (e *Entity) GetID() string {
+	return e.ID
+}
+
+// GetParent returns the parent type and the parent ID of the entity.
+func (e *Entity) GetParent(_ resource.Type) (resource.Type, string) {
+	if e.ApplicationID.Valid {
+		return resource.Application, e.ApplicationID.String
+	} else if e.ApplicationTemplateVersionID.Valid {
+		return resource.ApplicationTemplateVersion, e.ApplicationTemplateVersionID.String
+	}
+
+	return "", ""
+}
+
+// DecorateWithTenantID decorates the entity with the given tenant ID.
+func (e *Entity) DecorateWithTenantID(tenant string) interface{} {
+	return struct {
+		*Entity
+		TenantID string `db:"tenant_id"`
+	}{
+		Entity:   e,
+		TenantID: tenant,
+	}
+}
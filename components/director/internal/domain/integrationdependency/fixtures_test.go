@@ -0,0 +1,239 @@
+package integrationdependency_test
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/integrationdependency"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+)
+
+const (
+	integrationDependencyID = "integrationDependencyID"
+	packageID               = "packageID"
+	tenantID                = "b91b59f7-2563-40b2-aba9-fef726037aa3"
+	ordID                   = "sap.s4:integrationDependency:CostCenter:v1"
+	localID                 = "CostCenter"
+	externalTenantID        = "externalTenantID"
+	resourceHash            = "123456"
+	apiOrdID                = "sap.s4:apiResource:API_BILL_OF_MATERIAL_SRV:v1"
+	apiID                   = "apiID"
+	eventOrdID              = "sap.s4:eventResource:CE_BILLOFMATERIALEVENTS:v1"
+	eventID                 = "eventID"
+	providerAppID           = "providerAppID"
+	aspects                 = `[{"title":"Bill of materials","mandatory":true,"apiResources":[{"ordId":"sap.s4:apiResource:API_BILL_OF_MATERIAL_SRV:v1"}]}]`
+	eventAspects            = `[{"title":"Bill of materials events","mandatory":false,"eventResources":[{"ordId":"sap.s4:eventResource:CE_BILLOFMATERIALEVENTS:v1"}]}]`
+	mixedAspects            = `[{"title":"Bill of materials","mandatory":true,"apiResources":[{"ordId":"sap.s4:apiResource:API_BILL_OF_MATERIAL_SRV:v1"}],"eventResources":[{"ordId":"sap.s4:eventResource:CE_BILLOFMATERIALEVENTS:v1"}]}]`
+)
+
+var (
+	appID                = "appID"
+	appTemplateVersionID = "appTemplateVersionID"
+)
+
+func fixIntegrationDependencyEntityForApp() *integrationdependency.Entity {
+	return fixIntegrationDependencyEntityWithTitleForApp("title")
+}
+
+func fixIntegrationDependencyEntityForAppTemplateVersion() *integrationdependency.Entity {
+	return fixIntegrationDependencyEntityWithTitleForAppTemplateVersion("title")
+}
+
+func fixIntegrationDependencyEntityWithTitleForApp(title string) *integrationdependency.Entity {
+	entity := fixIntegrationDependencyEntityWithTitle(title)
+	entity.ApplicationID = repo.NewValidNullableString(appID)
+	return entity
+}
+
+func fixIntegrationDependencyEntityWithTitleForAppTemplateVersion(title string) *integrationdependency.Entity {
+	entity := fixIntegrationDependencyEntityWithTitle(title)
+	entity.ApplicationTemplateVersionID = repo.NewValidNullableString(appTemplateVersionID)
+	return entity
+}
+
+func fixIntegrationDependencyEntityWithTitle(title string) *integrationdependency.Entity {
+	return &integrationdependency.Entity{
+		ID:                             integrationDependencyID,
+		PackageID:                      packageID,
+		OrdID:                          ordID,
+		LocalID:                        repo.NewValidNullableString(localID),
+		CorrelationIDs:                 repo.NewValidNullableString("[]"),
+		Title:                          title,
+		ShortDescription:               repo.NewValidNullableString("short desc"),
+		Description:                    repo.NewValidNullableString("desc"),
+		Version:                        "1.0.0",
+		Visibility:                     "public",
+		ReleaseStatus:                  "active",
+		Successors:                     repo.NewValidNullableString("[]"),
+		Mandatory:                      true,
+		Aspects:                        repo.NewValidNullableString(aspects),
+		RelatedIntegrationDependencies: repo.NewValidNullableString("[]"),
+		Links:                          repo.NewValidNullableString("[]"),
+		Tags:                           repo.NewValidNullableString("[]"),
+		Labels:                         repo.NewValidNullableString("{}"),
+		DocumentationLabels:            repo.NewValidNullableString("{}"),
+		ResourceHash:                   repo.NewValidNullableString(resourceHash),
+	}
+}
+
+func fixNilModelIntegrationDependency() *model.IntegrationDependency {
+	return nil
+}
+
+func fixIntegrationDependencyModelForApp() *model.IntegrationDependency {
+	return fixIntegrationDependencyModelWithTitleForApp("title")
+}
+
+func fixIntegrationDependencyModelForAppTemplateVersion() *model.IntegrationDependency {
+	return fixIntegrationDependencyModelWithTitleForAppTemplateVersion("title")
+}
+
+func fixIntegrationDependencyModelWithTitleForApp(title string) *model.IntegrationDependency {
+	integrationDependency := fixIntegrationDependencyModelWithTitle(title)
+	integrationDependency.ApplicationID = &appID
+	return integrationDependency
+}
+
+func fixIntegrationDependencyModelWithTitleForAppTemplateVersion(title string) *model.IntegrationDependency {
+	integrationDependency := fixIntegrationDependencyModelWithTitle(title)
+	integrationDependency.ApplicationTemplateVersionID = &appTemplateVersionID
+	return integrationDependency
+}
+
+func fixIntegrationDependencyModelWithTitle(title string) *model.IntegrationDependency {
+	return &model.IntegrationDependency{
+		ID:                             integrationDependencyID,
+		PackageID:                      packageID,
+		OrdID:                          ordID,
+		LocalID:                        str.Ptr(localID),
+		CorrelationIDs:                 json.RawMessage("[]"),
+		Title:                          title,
+		ShortDescription:               str.Ptr("short desc"),
+		Description:                    str.Ptr("desc"),
+		Version:                        "1.0.0",
+		Visibility:                     "public",
+		ReleaseStatus:                  "active",
+		Successors:                     json.RawMessage("[]"),
+		Mandatory:                      true,
+		Aspects:                        json.RawMessage(aspects),
+		RelatedIntegrationDependencies: json.RawMessage("[]"),
+		Links:                          json.RawMessage("[]"),
+		Tags:                           json.RawMessage("[]"),
+		Labels:                         json.RawMessage("{}"),
+		DocumentationLabels:            json.RawMessage("{}"),
+		ResourceHash:                   str.Ptr(resourceHash),
+	}
+}
+
+func fixIntegrationDependencyModelInput() *model.IntegrationDependencyInput {
+	mandatory := true
+	return &model.IntegrationDependencyInput{
+		OrdID:                          ordID,
+		LocalID:                        str.Ptr(localID),
+		CorrelationIDs:                 json.RawMessage("[]"),
+		Title:                          "title",
+		ShortDescription:               str.Ptr("short desc"),
+		Description:                    str.Ptr("desc"),
+		OrdPackageID:                   "sap.s4:package:S4HANA:v1",
+		Version:                        "1.0.0",
+		Visibility:                     "public",
+		ReleaseStatus:                  "active",
+		Successors:                     json.RawMessage("[]"),
+		Mandatory:                      &mandatory,
+		Aspects:                        json.RawMessage(aspects),
+		RelatedIntegrationDependencies: json.RawMessage("[]"),
+		Links:                          json.RawMessage("[]"),
+		Tags:                           json.RawMessage("[]"),
+		Labels:                         json.RawMessage("{}"),
+		DocumentationLabels:            json.RawMessage("{}"),
+	}
+}
+
+func fixAPIDefinitionModel(id, ordID, appID string) *model.APIDefinition {
+	return &model.APIDefinition{
+		ApplicationID: str.Ptr(appID),
+		OrdID:         str.Ptr(ordID),
+		BaseEntity: &model.BaseEntity{
+			ID: id,
+		},
+	}
+}
+
+func fixEventDefinitionModel(id, ordID, appID string) *model.EventDefinition {
+	return &model.EventDefinition{
+		ApplicationID: str.Ptr(appID),
+		OrdID:         str.Ptr(ordID),
+		BaseEntity: &model.BaseEntity{
+			ID: id,
+		},
+	}
+}
+
+func fixIntegrationDependencyModelWithAspectsForApp(aspects string) *model.IntegrationDependency {
+	integrationDependency := fixIntegrationDependencyModelForApp()
+	integrationDependency.Aspects = json.RawMessage(aspects)
+	return integrationDependency
+}
+
+func fixIntegrationDependencyProviderModel() *model.IntegrationDependencyProvider {
+	return &model.IntegrationDependencyProvider{
+		IntegrationDependencyID:    integrationDependencyID,
+		IntegrationDependencyOrdID: ordID,
+		AspectTitle:                "Bill of materials",
+		Mandatory:                  true,
+		APIDefinitionID:            str.Ptr(apiID),
+		APIDefinitionOrdID:         str.Ptr(apiOrdID),
+		ApplicationID:              providerAppID,
+	}
+}
+
+func fixIntegrationDependencyEventProviderModel(aspectTitle string, mandatory bool) *model.IntegrationDependencyProvider {
+	return &model.IntegrationDependencyProvider{
+		IntegrationDependencyID:    integrationDependencyID,
+		IntegrationDependencyOrdID: ordID,
+		AspectTitle:                aspectTitle,
+		Mandatory:                  mandatory,
+		EventDefinitionID:          str.Ptr(eventID),
+		EventDefinitionOrdID:       str.Ptr(eventOrdID),
+		ApplicationID:              providerAppID,
+	}
+}
+
+func fixGQLIntegrationDependencyProvider() *graphql.IntegrationDependencyProvider {
+	return &graphql.IntegrationDependencyProvider{
+		IntegrationDependencyID:    integrationDependencyID,
+		IntegrationDependencyOrdID: ordID,
+		AspectTitle:                "Bill of materials",
+		Mandatory:                  true,
+		APIDefinitionID:            str.Ptr(apiID),
+		APIDefinitionOrdID:         str.Ptr(apiOrdID),
+		ApplicationID:              providerAppID,
+	}
+}
+
+func fixIntegrationDependencyColumns() []string {
+	return []string{"id", "app_id", "app_template_version_id", "package_id", "ord_id", "local_id", "correlation_ids", "title",
+		"short_description", "description", "version", "visibility", "release_status", "sunset_date", "successors", "mandatory", "aspects",
+		"related_integration_dependencies", "links", "tags", "labels", "documentation_labels", "last_update", "resource_hash"}
+}
+
+func fixIntegrationDependencyRowWithTitleForApp(title string) []driver.Value {
+	return append([]driver.Value{integrationDependencyID, appID, nil}, fixIntegrationDependencyRowWithTitle(title)...)
+}
+
+func fixIntegrationDependencyRowWithTitleForAppTemplateVersion(title string) []driver.Value {
+	return append([]driver.Value{integrationDependencyID, nil, appTemplateVersionID}, fixIntegrationDependencyRowWithTitle(title)...)
+}
+
+func fixIntegrationDependencyRowWithTitle(title string) []driver.Value {
+	return []driver.Value{packageID, ordID, localID, "[]", title, "short desc", "desc", "1.0.0", "public", "active", nil, "[]", true, aspects,
+		"[]", "[]", "[]", "{}", "{}", nil, resourceHash}
+}
+
+func fixIntegrationDependencyUpdateArgs() []driver.Value {
+	return []driver.Value{packageID, localID, "[]", "title", "short desc", "desc", "1.0.0", "public", "active", nil, "[]", true, aspects,
+		"[]", "[]", "[]", "{}", "{}", nil, resourceHash}
+}
@@ -0,0 +1,189 @@
+package integrationdependency
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/pkg/errors"
+)
+
+const (
+	integrationDependencyTable = `public.integration_dependencies`
+	appTemplateVersionIDColumn = "app_template_version_id"
+	appIDColumn                = "app_id"
+)
+
+var (
+	integrationDependencyColumns = []string{"id", "app_id", "app_template_version_id", "package_id", "ord_id", "local_id", "correlation_ids", "title",
+		"short_description", "description", "version", "visibility", "release_status", "sunset_date", "successors", "mandatory", "aspects",
+		"related_integration_dependencies", "links", "tags", "labels", "documentation_labels", "last_update", "resource_hash"}
+	updatableColumns = []string{"package_id", "local_id", "correlation_ids", "title", "short_description", "description", "version", "visibility",
+		"release_status", "sunset_date", "successors", "mandatory", "aspects", "related_integration_dependencies", "links", "tags", "labels",
+		"documentation_labels", "last_update", "resource_hash"}
+)
+
+// EntityConverter converts Integration Dependencies between the model.IntegrationDependency service-layer representation and the repo-layer representation Entity.
+//
+//go:generate mockery --name=EntityConverter --output=automock --outpkg=automock --case=underscore --disable-version-string
+type EntityConverter interface {
+	ToEntity(in *model.IntegrationDependency) *Entity
+	FromEntity(entity *Entity) (*model.IntegrationDependency, error)
+}
+
+type pgRepository struct {
+	conv               EntityConverter
+	lister             repo.Lister
+	listerGlobal       repo.ListerGlobal
+	singleGetter       repo.SingleGetter
+	singleGetterGlobal repo.SingleGetterGlobal
+	deleter            repo.Deleter
+	deleterGlobal      repo.DeleterGlobal
+	creator            repo.Creator
+	creatorGlobal      repo.CreatorGlobal
+	updater            repo.Updater
+	updaterGlobal      repo.UpdaterGlobal
+}
+
+// NewRepository returns a new entity responsible for repo-layer Integration Dependency operations.
+func NewRepository(conv EntityConverter) *pgRepository {
+	return &pgRepository{
+		conv:               conv,
+		lister:             repo.NewLister(integrationDependencyTable, integrationDependencyColumns),
+		listerGlobal:       repo.NewListerGlobal(resource.IntegrationDependency, integrationDependencyTable, integrationDependencyColumns),
+		singleGetter:       repo.NewSingleGetter(integrationDependencyTable, integrationDependencyColumns),
+		singleGetterGlobal: repo.NewSingleGetterGlobal(resource.IntegrationDependency, integrationDependencyTable, integrationDependencyColumns),
+		deleter:            repo.NewDeleter(integrationDependencyTable),
+		deleterGlobal:      repo.NewDeleterGlobal(resource.IntegrationDependency, integrationDependencyTable),
+		creator:            repo.NewCreator(integrationDependencyTable, integrationDependencyColumns),
+		creatorGlobal:      repo.NewCreatorGlobal(resource.IntegrationDependency, integrationDependencyTable, integrationDependencyColumns),
+		updater:            repo.NewUpdater(integrationDependencyTable, updatableColumns, []string{"id"}),
+		updaterGlobal:      repo.NewUpdaterGlobal(resource.IntegrationDependency, integrationDependencyTable, updatableColumns, []string{"id"}),
+	}
+}
+
+// Create creates a new Integration Dependency.
+func (r *pgRepository) Create(ctx context.Context, tenant string, model *model.IntegrationDependency) error {
+	if model == nil {
+		return apperrors.NewInternalError("model can not be nil")
+	}
+
+	log.C(ctx).Debugf("Persisting Integration Dependency entity with id %q", model.ID)
+	return r.creator.Create(ctx, resource.IntegrationDependency, tenant, r.conv.ToEntity(model))
+}
+
+// CreateGlobal creates a new Integration Dependency without tenant isolation.
+func (r *pgRepository) CreateGlobal(ctx context.Context, model *model.IntegrationDependency) error {
+	if model == nil {
+		return apperrors.NewInternalError("model can not be nil")
+	}
+
+	log.C(ctx).Debugf("Persisting Integration Dependency entity with id %q", model.ID)
+	return r.creatorGlobal.Create(ctx, r.conv.ToEntity(model))
+}
+
+// Update updates an Integration Dependency.
+func (r *pgRepository) Update(ctx context.Context, tenant string, model *model.IntegrationDependency) error {
+	if model == nil {
+		return apperrors.NewInternalError("model can not be nil")
+	}
+
+	log.C(ctx).Debugf("Updating Integration Dependency entity with id %q", model.ID)
+	return r.updater.UpdateSingle(ctx, resource.IntegrationDependency, tenant, r.conv.ToEntity(model))
+}
+
+// UpdateGlobal updates an Integration Dependency without tenant isolation.
+func (r *pgRepository) UpdateGlobal(ctx context.Context, model *model.IntegrationDependency) error {
+	if model == nil {
+		return apperrors.NewInternalError("model can not be nil")
+	}
+
+	log.C(ctx).Debugf("Updating Integration Dependency entity with id %q", model.ID)
+	return r.updaterGlobal.UpdateSingleGlobal(ctx, r.conv.ToEntity(model))
+}
+
+// Delete deletes an Integration Dependency by its ID.
+func (r *pgRepository) Delete(ctx context.Context, tenant, id string) error {
+	log.C(ctx).Debugf("Deleting Integration Dependency entity with id %q", id)
+	return r.deleter.DeleteOne(ctx, resource.IntegrationDependency, tenant, repo.Conditions{repo.NewEqualCondition("id", id)})
+}
+
+// DeleteGlobal deletes an Integration Dependency by its ID without tenant isolation.
+func (r *pgRepository) DeleteGlobal(ctx context.Context, id string) error {
+	log.C(ctx).Debugf("Deleting Integration Dependency entity with id %q", id)
+	return r.deleterGlobal.DeleteOneGlobal(ctx, repo.Conditions{repo.NewEqualCondition("id", id)})
+}
+
+// GetByID retrieves an Integration Dependency by its ID.
+func (r *pgRepository) GetByID(ctx context.Context, tenant, id string) (*model.IntegrationDependency, error) {
+	log.C(ctx).Debugf("Getting Integration Dependency entity with id %q", id)
+	var integrationDependencyEnt Entity
+	if err := r.singleGetter.Get(ctx, resource.IntegrationDependency, tenant, repo.Conditions{repo.NewEqualCondition("id", id)}, repo.NoOrderBy, &integrationDependencyEnt); err != nil {
+		return nil, err
+	}
+
+	integrationDependencyModel, err := r.conv.FromEntity(&integrationDependencyEnt)
+	if err != nil {
+		return nil, errors.Wrap(err, "while converting Integration Dependency from Entity")
+	}
+
+	return integrationDependencyModel, nil
+}
+
+// GetByIDGlobal retrieves an Integration Dependency by its ID without tenant isolation.
+func (r *pgRepository) GetByIDGlobal(ctx context.Context, id string) (*model.IntegrationDependency, error) {
+	log.C(ctx).Debugf("Getting Integration Dependency entity with id %q", id)
+	var integrationDependencyEnt Entity
+	if err := r.singleGetterGlobal.GetGlobal(ctx, repo.Conditions{repo.NewEqualCondition("id", id)}, repo.NoOrderBy, &integrationDependencyEnt); err != nil {
+		return nil, err
+	}
+
+	integrationDependencyModel, err := r.conv.FromEntity(&integrationDependencyEnt)
+	if err != nil {
+		return nil, errors.Wrap(err, "while converting Integration Dependency from Entity")
+	}
+
+	return integrationDependencyModel, nil
+}
+
+// ListByResourceID lists Integration Dependencies by a given resource type and resource ID
+func (r *pgRepository) ListByResourceID(ctx context.Context, tenantID, resourceID string, resourceType resource.Type) ([]*model.IntegrationDependency, error) {
+	integrationDependencyCollection := integrationDependencyCollection{}
+
+	var condition repo.Condition
+	var err error
+	if resourceType == resource.Application {
+		condition = repo.NewEqualCondition(appIDColumn, resourceID)
+		err = r.lister.ListWithSelectForUpdate(ctx, resource.IntegrationDependency, tenantID, &integrationDependencyCollection, condition)
+	} else {
+		condition = repo.NewEqualCondition(appTemplateVersionIDColumn, resourceID)
+		err = r.listerGlobal.ListGlobalWithSelectForUpdate(ctx, &integrationDependencyCollection, condition)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return r.multipleFromEntities(integrationDependencyCollection)
+}
+
+func (r *pgRepository) multipleFromEntities(entities integrationDependencyCollection) ([]*model.IntegrationDependency, error) {
+	integrationDependencies := make([]*model.IntegrationDependency, 0, entities.Len())
+	for _, integrationDependency := range entities {
+		integrationDependencyModel, err := r.conv.FromEntity(&integrationDependency)
+		if err != nil {
+			return nil, err
+		}
+		integrationDependencies = append(integrationDependencies, integrationDependencyModel)
+	}
+	return integrationDependencies, nil
+}
+
+type integrationDependencyCollection []Entity
+
+// Len returns the length of the collection
+func (c integrationDependencyCollection) Len() int {
+	return len(c)
+}
@@ -0,0 +1,284 @@
+package integrationdependency_test
+
+import (
+	"database/sql/driver"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/integrationdependency"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/integrationdependency/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/repo/testdb"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+)
+
+func TestPgRepository_Create(t *testing.T) {
+	suite := testdb.RepoCreateTestSuite{
+		Name: "Create Integration Dependency",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:    regexp.QuoteMeta("SELECT 1 FROM tenant_applications WHERE tenant_id = $1 AND id = $2 AND owner = $3"),
+				Args:     []driver.Value{tenantID, appID, true},
+				IsSelect: true,
+				ValidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{testdb.RowWhenObjectExist()}
+				},
+				InvalidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{testdb.RowWhenObjectDoesNotExist()}
+				},
+			},
+			{
+				Query:       `^INSERT INTO public.integration_dependencies \(.+\) VALUES \(.+\)$`,
+				Args:        fixIntegrationDependencyRowWithTitleForApp("title"),
+				ValidResult: sqlmock.NewResult(-1, 1),
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		RepoConstructorFunc:       integrationdependency.NewRepository,
+		ModelEntity:               fixIntegrationDependencyModelForApp(),
+		DBEntity:                  fixIntegrationDependencyEntityForApp(),
+		NilModelEntity:            fixNilModelIntegrationDependency(),
+		TenantID:                  tenantID,
+		DisableConverterErrorTest: true,
+	}
+
+	suite.Run(t)
+}
+
+func TestPgRepository_CreateGlobal(t *testing.T) {
+	suite := testdb.RepoCreateTestSuite{
+		Name: "Create Integration Dependency Global",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:       `^INSERT INTO public.integration_dependencies \(.+\) VALUES \(.+\)$`,
+				Args:        fixIntegrationDependencyRowWithTitleForAppTemplateVersion("title"),
+				ValidResult: sqlmock.NewResult(-1, 1),
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		RepoConstructorFunc:       integrationdependency.NewRepository,
+		ModelEntity:               fixIntegrationDependencyModelForAppTemplateVersion(),
+		DBEntity:                  fixIntegrationDependencyEntityForAppTemplateVersion(),
+		NilModelEntity:            fixNilModelIntegrationDependency(),
+		DisableConverterErrorTest: true,
+		IsGlobal:                  true,
+		MethodName:                "CreateGlobal",
+	}
+
+	suite.Run(t)
+}
+
+func TestPgRepository_Update(t *testing.T) {
+	entity := fixIntegrationDependencyEntityForApp()
+
+	suite := testdb.RepoUpdateTestSuite{
+		Name: "Update Integration Dependency",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:         regexp.QuoteMeta(`UPDATE public.integration_dependencies SET package_id = ?, local_id = ?, correlation_ids = ?, title = ?, short_description = ?, description = ?, version = ?, visibility = ?, release_status = ?, sunset_date = ?, successors = ?, mandatory = ?, aspects = ?, related_integration_dependencies = ?, links = ?, tags = ?, labels = ?, documentation_labels = ?, last_update = ?, resource_hash = ? WHERE id = ? AND (id IN (SELECT id FROM integration_dependencies_tenants WHERE tenant_id = ? AND owner = true))`),
+				Args:          append(fixIntegrationDependencyUpdateArgs(), entity.ID, tenantID),
+				ValidResult:   sqlmock.NewResult(-1, 1),
+				InvalidResult: sqlmock.NewResult(-1, 0),
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		RepoConstructorFunc:       integrationdependency.NewRepository,
+		ModelEntity:               fixIntegrationDependencyModelForApp(),
+		DBEntity:                  entity,
+		NilModelEntity:            fixNilModelIntegrationDependency(),
+		TenantID:                  tenantID,
+		DisableConverterErrorTest: true,
+	}
+
+	suite.Run(t)
+}
+
+func TestPgRepository_UpdateGlobal(t *testing.T) {
+	entity := fixIntegrationDependencyEntityForAppTemplateVersion()
+
+	suite := testdb.RepoUpdateTestSuite{
+		Name: "Update Integration Dependency Global",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:         regexp.QuoteMeta(`UPDATE public.integration_dependencies SET package_id = ?, local_id = ?, correlation_ids = ?, title = ?, short_description = ?, description = ?, version = ?, visibility = ?, release_status = ?, sunset_date = ?, successors = ?, mandatory = ?, aspects = ?, related_integration_dependencies = ?, links = ?, tags = ?, labels = ?, documentation_labels = ?, last_update = ?, resource_hash = ? WHERE id = ?`),
+				Args:          append(fixIntegrationDependencyUpdateArgs(), entity.ID),
+				ValidResult:   sqlmock.NewResult(-1, 1),
+				InvalidResult: sqlmock.NewResult(-1, 0),
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		RepoConstructorFunc:       integrationdependency.NewRepository,
+		ModelEntity:               fixIntegrationDependencyModelForAppTemplateVersion(),
+		DBEntity:                  entity,
+		NilModelEntity:            fixNilModelIntegrationDependency(),
+		DisableConverterErrorTest: true,
+		IsGlobal:                  true,
+		UpdateMethodName:          "UpdateGlobal",
+	}
+
+	suite.Run(t)
+}
+
+func TestPgRepository_Delete(t *testing.T) {
+	suite := testdb.RepoDeleteTestSuite{
+		Name: "Integration Dependency Delete",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:         regexp.QuoteMeta(`DELETE FROM public.integration_dependencies WHERE id = $1 AND (id IN (SELECT id FROM integration_dependencies_tenants WHERE tenant_id = $2 AND owner = true))`),
+				Args:          []driver.Value{integrationDependencyID, tenantID},
+				ValidResult:   sqlmock.NewResult(-1, 1),
+				InvalidResult: sqlmock.NewResult(-1, 2),
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		RepoConstructorFunc: integrationdependency.NewRepository,
+		MethodArgs:          []interface{}{tenantID, integrationDependencyID},
+	}
+
+	suite.Run(t)
+}
+
+func TestPgRepository_DeleteGlobal(t *testing.T) {
+	suite := testdb.RepoDeleteTestSuite{
+		Name: "Integration Dependency Delete Global",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:         regexp.QuoteMeta(`DELETE FROM public.integration_dependencies WHERE id = $1`),
+				Args:          []driver.Value{integrationDependencyID},
+				ValidResult:   sqlmock.NewResult(-1, 1),
+				InvalidResult: sqlmock.NewResult(-1, 2),
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		RepoConstructorFunc: integrationdependency.NewRepository,
+		MethodArgs:          []interface{}{integrationDependencyID},
+		IsGlobal:            true,
+		MethodName:          "DeleteGlobal",
+	}
+
+	suite.Run(t)
+}
+
+func TestPgRepository_GetByID(t *testing.T) {
+	suite := testdb.RepoGetTestSuite{
+		Name: "Get Integration Dependency",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:    regexp.QuoteMeta(`SELECT id, app_id, app_template_version_id, package_id, ord_id, local_id, correlation_ids, title, short_description, description, version, visibility, release_status, sunset_date, successors, mandatory, aspects, related_integration_dependencies, links, tags, labels, documentation_labels, last_update, resource_hash FROM public.integration_dependencies WHERE id = $1 AND (id IN (SELECT id FROM integration_dependencies_tenants WHERE tenant_id = $2))`),
+				Args:     []driver.Value{integrationDependencyID, tenantID},
+				IsSelect: true,
+				ValidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixIntegrationDependencyColumns()).AddRow(fixIntegrationDependencyRowWithTitleForApp("title")...)}
+				},
+				InvalidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixIntegrationDependencyColumns())}
+				},
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		RepoConstructorFunc: integrationdependency.NewRepository,
+		ExpectedModelEntity: fixIntegrationDependencyModelForApp(),
+		ExpectedDBEntity:    fixIntegrationDependencyEntityForApp(),
+		MethodArgs:          []interface{}{tenantID, integrationDependencyID},
+	}
+
+	suite.Run(t)
+}
+
+func TestPgRepository_GetByIDGlobal(t *testing.T) {
+	suite := testdb.RepoGetTestSuite{
+		Name: "Get Integration Dependency Global",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:    regexp.QuoteMeta(`SELECT id, app_id, app_template_version_id, package_id, ord_id, local_id, correlation_ids, title, short_description, description, version, visibility, release_status, sunset_date, successors, mandatory, aspects, related_integration_dependencies, links, tags, labels, documentation_labels, last_update, resource_hash FROM public.integration_dependencies WHERE id = $1`),
+				Args:     []driver.Value{integrationDependencyID},
+				IsSelect: true,
+				ValidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixIntegrationDependencyColumns()).AddRow(fixIntegrationDependencyRowWithTitleForAppTemplateVersion("title")...)}
+				},
+				InvalidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixIntegrationDependencyColumns())}
+				},
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		RepoConstructorFunc: integrationdependency.NewRepository,
+		ExpectedModelEntity: fixIntegrationDependencyModelForAppTemplateVersion(),
+		ExpectedDBEntity:    fixIntegrationDependencyEntityForAppTemplateVersion(),
+		MethodArgs:          []interface{}{integrationDependencyID},
+		MethodName:          "GetByIDGlobal",
+	}
+
+	suite.Run(t)
+}
+
+func TestPgRepository_ListByResourceID(t *testing.T) {
+	suiteForApp := testdb.RepoListTestSuite{
+		Name: "List Integration Dependencies For Application",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:    regexp.QuoteMeta(`SELECT id, app_id, app_template_version_id, package_id, ord_id, local_id, correlation_ids, title, short_description, description, version, visibility, release_status, sunset_date, successors, mandatory, aspects, related_integration_dependencies, links, tags, labels, documentation_labels, last_update, resource_hash FROM public.integration_dependencies WHERE app_id = $1 AND (id IN (SELECT id FROM integration_dependencies_tenants WHERE tenant_id = $2)) FOR UPDATE`),
+				Args:     []driver.Value{appID, tenantID},
+				IsSelect: true,
+				ValidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixIntegrationDependencyColumns()).AddRow(fixIntegrationDependencyRowWithTitleForApp("title1")...).AddRow(fixIntegrationDependencyRowWithTitleForApp("title2")...)}
+				},
+				InvalidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixIntegrationDependencyColumns())}
+				},
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		RepoConstructorFunc:   integrationdependency.NewRepository,
+		ExpectedModelEntities: []interface{}{fixIntegrationDependencyModelWithTitleForApp("title1"), fixIntegrationDependencyModelWithTitleForApp("title2")},
+		ExpectedDBEntities:    []interface{}{fixIntegrationDependencyEntityWithTitleForApp("title1"), fixIntegrationDependencyEntityWithTitleForApp("title2")},
+		MethodArgs:            []interface{}{tenantID, appID, resource.Application},
+		MethodName:            "ListByResourceID",
+	}
+
+	suiteForAppTemplateVersion := testdb.RepoListTestSuite{
+		Name: "List Integration Dependencies for Application Template Version",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:    regexp.QuoteMeta(`SELECT id, app_id, app_template_version_id, package_id, ord_id, local_id, correlation_ids, title, short_description, description, version, visibility, release_status, sunset_date, successors, mandatory, aspects, related_integration_dependencies, links, tags, labels, documentation_labels, last_update, resource_hash FROM public.integration_dependencies WHERE app_template_version_id = $1 FOR UPDATE`),
+				Args:     []driver.Value{appTemplateVersionID},
+				IsSelect: true,
+				ValidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixIntegrationDependencyColumns()).AddRow(fixIntegrationDependencyRowWithTitleForAppTemplateVersion("title1")...).AddRow(fixIntegrationDependencyRowWithTitleForAppTemplateVersion("title2")...)}
+				},
+				InvalidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixIntegrationDependencyColumns())}
+				},
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		RepoConstructorFunc:   integrationdependency.NewRepository,
+		ExpectedModelEntities: []interface{}{fixIntegrationDependencyModelWithTitleForAppTemplateVersion("title1"), fixIntegrationDependencyModelWithTitleForAppTemplateVersion("title2")},
+		ExpectedDBEntities:    []interface{}{fixIntegrationDependencyEntityWithTitleForAppTemplateVersion("title1"), fixIntegrationDependencyEntityWithTitleForAppTemplateVersion("title2")},
+		MethodArgs:            []interface{}{tenantID, appTemplateVersionID, resource.ApplicationTemplateVersion},
+		MethodName:            "ListByResourceID",
+	}
+
+	suiteForApp.Run(t)
+	suiteForAppTemplateVersion.Run(t)
+}
@@ -0,0 +1,62 @@
+package integrationdependency
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/pkg/errors"
+)
+
+// IntegrationDependencyService is responsible for the service-layer Integration Dependency operations.
+//
+//go:generate mockery --name=IntegrationDependencyService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type IntegrationDependencyService interface {
+	ListProvidersByApplicationID(ctx context.Context, appID string) ([]*model.IntegrationDependencyProvider, error)
+}
+
+// IntegrationDependencyConverter converts Integration Dependency providers between the service-layer and the graphql-layer representations.
+//
+//go:generate mockery --name=IntegrationDependencyConverter --output=automock --outpkg=automock --case=underscore --disable-version-string
+type IntegrationDependencyConverter interface {
+	MultipleProvidersToGraphQL(in []*model.IntegrationDependencyProvider) []*graphql.IntegrationDependencyProvider
+}
+
+// Resolver is an object responsible for resolver-layer Integration Dependency operations.
+type Resolver struct {
+	transact  persistence.Transactioner
+	svc       IntegrationDependencyService
+	converter IntegrationDependencyConverter
+}
+
+// NewResolver returns a new object responsible for resolver-layer Integration Dependency operations.
+func NewResolver(transact persistence.Transactioner, svc IntegrationDependencyService, converter IntegrationDependencyConverter) *Resolver {
+	return &Resolver{
+		transact:  transact,
+		svc:       svc,
+		converter: converter,
+	}
+}
+
+// IntegrationDependencyProviders resolves the API Definitions of other Applications which satisfy the Integration Dependencies of the given Application
+func (r *Resolver) IntegrationDependencyProviders(ctx context.Context, applicationID string) ([]*graphql.IntegrationDependencyProvider, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	providers, err := r.svc.ListProvidersByApplicationID(ctx, applicationID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while listing integration dependency providers for application with id %s", applicationID)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.converter.MultipleProvidersToGraphQL(providers), nil
+}
@@ -0,0 +1,115 @@
+package integrationdependency_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/integrationdependency"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/integrationdependency/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	persistenceautomock "github.com/kyma-incubator/compass/components/director/pkg/persistence/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolver_IntegrationDependencyProviders(t *testing.T) {
+	// GIVEN
+	ctx := context.TODO()
+
+	testErr := errors.New("test error")
+
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	providers := []*model.IntegrationDependencyProvider{fixIntegrationDependencyProviderModel()}
+	gqlProviders := []*graphql.IntegrationDependencyProvider{fixGQLIntegrationDependencyProvider()}
+
+	testCases := []struct {
+		Name           string
+		TxFn           func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ServiceFn      func() *automock.IntegrationDependencyService
+		ConverterFn    func() *automock.IntegrationDependencyConverter
+		ExpectedOutput []*graphql.IntegrationDependencyProvider
+		ExpectedError  error
+	}{
+		{
+			Name: "Success",
+			TxFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.IntegrationDependencyService {
+				svc := &automock.IntegrationDependencyService{}
+				svc.On("ListProvidersByApplicationID", txtest.CtxWithDBMatcher(), appID).Return(providers, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.IntegrationDependencyConverter {
+				conv := &automock.IntegrationDependencyConverter{}
+				conv.On("MultipleProvidersToGraphQL", providers).Return(gqlProviders).Once()
+				return conv
+			},
+			ExpectedOutput: gqlProviders,
+		},
+		{
+			Name: "Returns error when listing providers fails",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.IntegrationDependencyService {
+				svc := &automock.IntegrationDependencyService{}
+				svc.On("ListProvidersByApplicationID", txtest.CtxWithDBMatcher(), appID).Return(nil, testErr).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.IntegrationDependencyConverter {
+				return &automock.IntegrationDependencyConverter{}
+			},
+			ExpectedError: testErr,
+		},
+		{
+			Name: "Returns error when failing on the committing of a transaction",
+			TxFn: txGen.ThatFailsOnCommit,
+			ServiceFn: func() *automock.IntegrationDependencyService {
+				svc := &automock.IntegrationDependencyService{}
+				svc.On("ListProvidersByApplicationID", txtest.CtxWithDBMatcher(), appID).Return(providers, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.IntegrationDependencyConverter {
+				return &automock.IntegrationDependencyConverter{}
+			},
+			ExpectedError: testErr,
+		},
+		{
+			Name: "Returns error when failing on the beginning of a transaction",
+			TxFn: txGen.ThatFailsOnBegin,
+			ServiceFn: func() *automock.IntegrationDependencyService {
+				return &automock.IntegrationDependencyService{}
+			},
+			ConverterFn: func() *automock.IntegrationDependencyConverter {
+				return &automock.IntegrationDependencyConverter{}
+			},
+			ExpectedError: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TxFn()
+			svc := testCase.ServiceFn()
+			conv := testCase.ConverterFn()
+
+			resolver := integrationdependency.NewResolver(transact, svc, conv)
+
+			// WHEN
+			result, err := resolver.IntegrationDependencyProviders(ctx, appID)
+
+			// THEN
+			if testCase.ExpectedError != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedError.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, testCase.ExpectedOutput, result)
+
+			mock.AssertExpectationsForObjects(t, persist, transact, svc, conv)
+		})
+	}
+}
@@ -0,0 +1,285 @@
+package integrationdependency
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+	"github.com/pkg/errors"
+)
+
+// IntegrationDependencyRepository is responsible for the repo-layer Integration Dependency operations.
+//
+//go:generate mockery --name=IntegrationDependencyRepository --output=automock --outpkg=automock --case=underscore --disable-version-string
+type IntegrationDependencyRepository interface {
+	Create(ctx context.Context, tenant string, item *model.IntegrationDependency) error
+	CreateGlobal(ctx context.Context, model *model.IntegrationDependency) error
+	Update(ctx context.Context, tenant string, item *model.IntegrationDependency) error
+	UpdateGlobal(ctx context.Context, model *model.IntegrationDependency) error
+	Delete(ctx context.Context, tenant, id string) error
+	DeleteGlobal(ctx context.Context, id string) error
+	GetByID(ctx context.Context, tenant, id string) (*model.IntegrationDependency, error)
+	GetByIDGlobal(ctx context.Context, id string) (*model.IntegrationDependency, error)
+	ListByResourceID(ctx context.Context, tenantID, resourceID string, resourceType resource.Type) ([]*model.IntegrationDependency, error)
+}
+
+// APIRepository is responsible for the repo-layer APIDefinition operations.
+//
+//go:generate mockery --name=APIRepository --output=automock --outpkg=automock --case=underscore --disable-version-string
+type APIRepository interface {
+	ListByOrdIDs(ctx context.Context, tenantID string, ordIDs []string) ([]*model.APIDefinition, error)
+}
+
+// EventRepository is responsible for the repo-layer EventDefinition operations.
+//
+//go:generate mockery --name=EventRepository --output=automock --outpkg=automock --case=underscore --disable-version-string
+type EventRepository interface {
+	ListByOrdIDs(ctx context.Context, tenantID string, ordIDs []string) ([]*model.EventDefinition, error)
+}
+
+// UIDService is responsible for generating GUIDs, which will be used as internal Integration Dependency IDs.
+//
+//go:generate mockery --name=UIDService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type UIDService interface {
+	Generate() string
+}
+
+type service struct {
+	integrationDependencyRepo IntegrationDependencyRepository
+	apiRepo                   APIRepository
+	eventRepo                 EventRepository
+	uidService                UIDService
+}
+
+// NewService returns a new object responsible for service-layer Integration Dependency operations.
+func NewService(integrationDependencyRepo IntegrationDependencyRepository, apiRepo APIRepository, eventRepo EventRepository, uidService UIDService) *service {
+	return &service{
+		integrationDependencyRepo: integrationDependencyRepo,
+		apiRepo:                   apiRepo,
+		eventRepo:                 eventRepo,
+		uidService:                uidService,
+	}
+}
+
+// Create creates an Integration Dependency which is part of the given Package for a given resource.Type
+func (s *service) Create(ctx context.Context, resourceType resource.Type, resourceID, packageID string, in model.IntegrationDependencyInput, integrationDependencyHash uint64) (string, error) {
+	id := s.uidService.Generate()
+	integrationDependency := in.ToIntegrationDependency(id, resourceType, resourceID, packageID, integrationDependencyHash)
+
+	if err := s.createIntegrationDependency(ctx, integrationDependency, resourceType); err != nil {
+		return "", errors.Wrapf(err, "error occurred while creating an Integration Dependency with id %s and title %s for %s with id %s", id, integrationDependency.Title, resourceType, resourceID)
+	}
+
+	log.C(ctx).Debugf("Successfully created an Integration Dependency with id %s and title %s for %s with id %s", id, integrationDependency.Title, resourceType, resourceID)
+
+	return id, nil
+}
+
+// Update updates an Integration Dependency by ID for a given resource.Type
+func (s *service) Update(ctx context.Context, resourceType resource.Type, id, packageID string, in model.IntegrationDependencyInput, integrationDependencyHash uint64) error {
+	integrationDependency, err := s.getIntegrationDependency(ctx, id, resourceType)
+	if err != nil {
+		return errors.Wrapf(err, "while getting Integration Dependency with id %s", id)
+	}
+
+	integrationDependency.SetFromUpdateInput(in, packageID, integrationDependencyHash)
+
+	if err = s.updateIntegrationDependency(ctx, integrationDependency, resourceType); err != nil {
+		return errors.Wrapf(err, "while updating Integration Dependency with id %s", id)
+	}
+
+	return nil
+}
+
+// Delete deletes an Integration Dependency by ID for a given resource.Type
+func (s *service) Delete(ctx context.Context, resourceType resource.Type, id string) error {
+	if err := s.deleteIntegrationDependency(ctx, id, resourceType); err != nil {
+		return errors.Wrapf(err, "while deleting Integration Dependency with id %s", id)
+	}
+
+	log.C(ctx).Infof("Successfully deleted Integration Dependency with id %s", id)
+
+	return nil
+}
+
+// Get returns an Integration Dependency by ID
+func (s *service) Get(ctx context.Context, id string) (*model.IntegrationDependency, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "while loading tenant from context")
+	}
+
+	integrationDependency, err := s.integrationDependencyRepo.GetByID(ctx, tnt, id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while getting Integration Dependency with ID: %q", id)
+	}
+
+	return integrationDependency, nil
+}
+
+// ListByApplicationID lists Integration Dependencies by Application ID
+func (s *service) ListByApplicationID(ctx context.Context, appID string) ([]*model.IntegrationDependency, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.integrationDependencyRepo.ListByResourceID(ctx, tnt, appID, resource.Application)
+}
+
+// ListByApplicationTemplateVersionID lists Integration Dependencies by Application Template Version ID without tenant isolation
+func (s *service) ListByApplicationTemplateVersionID(ctx context.Context, appTemplateVersionID string) ([]*model.IntegrationDependency, error) {
+	return s.integrationDependencyRepo.ListByResourceID(ctx, "", appTemplateVersionID, resource.ApplicationTemplateVersion)
+}
+
+// ListProvidersByApplicationID resolves the API and Event Definitions of other Applications which satisfy the Integration Dependencies declared by the given Application
+func (s *service) ListProvidersByApplicationID(ctx context.Context, appID string) ([]*model.IntegrationDependencyProvider, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	integrationDependencies, err := s.integrationDependencyRepo.ListByResourceID(ctx, tnt, appID, resource.Application)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while listing Integration Dependencies for Application with id %s", appID)
+	}
+
+	aspectsByIntegrationDependencyID := make(map[string][]*model.IntegrationDependencyAspect, len(integrationDependencies))
+	apiOrdIDs, eventOrdIDs := make([]string, 0), make([]string, 0)
+	seenAPIOrdIDs, seenEventOrdIDs := make(map[string]bool), make(map[string]bool)
+	for _, integrationDependency := range integrationDependencies {
+		aspects, err := integrationDependency.GetAspects()
+		if err != nil {
+			return nil, errors.Wrapf(err, "while parsing aspects of Integration Dependency with id %s", integrationDependency.ID)
+		}
+		aspectsByIntegrationDependencyID[integrationDependency.ID] = aspects
+
+		for _, aspect := range aspects {
+			apiOrdIDs = appendUniqueOrdIDs(apiOrdIDs, seenAPIOrdIDs, aspect.APIResources)
+			eventOrdIDs = appendUniqueOrdIDs(eventOrdIDs, seenEventOrdIDs, aspect.EventResources)
+		}
+	}
+
+	apis, err := s.apiRepo.ListByOrdIDs(ctx, tnt, apiOrdIDs)
+	if err != nil {
+		return nil, errors.Wrap(err, "while listing API Definitions referenced by Integration Dependencies")
+	}
+
+	events, err := s.eventRepo.ListByOrdIDs(ctx, tnt, eventOrdIDs)
+	if err != nil {
+		return nil, errors.Wrap(err, "while listing Event Definitions referenced by Integration Dependencies")
+	}
+
+	apisByOrdID := make(map[string][]*model.APIDefinition)
+	for _, api := range apis {
+		if api.OrdID == nil || api.ApplicationID == nil || *api.ApplicationID == appID {
+			continue
+		}
+		apisByOrdID[*api.OrdID] = append(apisByOrdID[*api.OrdID], api)
+	}
+
+	eventsByOrdID := make(map[string][]*model.EventDefinition)
+	for _, event := range events {
+		if event.OrdID == nil || event.ApplicationID == nil || *event.ApplicationID == appID {
+			continue
+		}
+		eventsByOrdID[*event.OrdID] = append(eventsByOrdID[*event.OrdID], event)
+	}
+
+	providers := make([]*model.IntegrationDependencyProvider, 0)
+	for _, integrationDependency := range integrationDependencies {
+		for _, aspect := range aspectsByIntegrationDependencyID[integrationDependency.ID] {
+			for _, apiResource := range aspect.APIResources {
+				for _, api := range apisByOrdID[apiResource.OrdID] {
+					providers = append(providers, &model.IntegrationDependencyProvider{
+						IntegrationDependencyID:    integrationDependency.ID,
+						IntegrationDependencyOrdID: integrationDependency.OrdID,
+						AspectTitle:                aspect.Title,
+						Mandatory:                  aspect.Mandatory,
+						APIDefinitionID:            str.Ptr(api.ID),
+						APIDefinitionOrdID:         str.Ptr(apiResource.OrdID),
+						ApplicationID:              *api.ApplicationID,
+					})
+				}
+			}
+
+			for _, eventResource := range aspect.EventResources {
+				for _, event := range eventsByOrdID[eventResource.OrdID] {
+					providers = append(providers, &model.IntegrationDependencyProvider{
+						IntegrationDependencyID:    integrationDependency.ID,
+						IntegrationDependencyOrdID: integrationDependency.OrdID,
+						AspectTitle:                aspect.Title,
+						Mandatory:                  aspect.Mandatory,
+						EventDefinitionID:          str.Ptr(event.ID),
+						EventDefinitionOrdID:       str.Ptr(eventResource.OrdID),
+						ApplicationID:              *event.ApplicationID,
+					})
+				}
+			}
+		}
+	}
+
+	log.C(ctx).Debugf("Resolved %d providers for the Integration Dependencies of Application with id %s", len(providers), appID)
+
+	return providers, nil
+}
+
+func (s *service) createIntegrationDependency(ctx context.Context, integrationDependency *model.IntegrationDependency, resourceType resource.Type) error {
+	if resourceType.IsTenantIgnorable() {
+		return s.integrationDependencyRepo.CreateGlobal(ctx, integrationDependency)
+	}
+
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	return s.integrationDependencyRepo.Create(ctx, tnt, integrationDependency)
+}
+
+func (s *service) getIntegrationDependency(ctx context.Context, id string, resourceType resource.Type) (*model.IntegrationDependency, error) {
+	if resourceType.IsTenantIgnorable() {
+		return s.integrationDependencyRepo.GetByIDGlobal(ctx, id)
+	}
+
+	return s.Get(ctx, id)
+}
+
+func (s *service) updateIntegrationDependency(ctx context.Context, integrationDependency *model.IntegrationDependency, resourceType resource.Type) error {
+	if resourceType.IsTenantIgnorable() {
+		return s.integrationDependencyRepo.UpdateGlobal(ctx, integrationDependency)
+	}
+
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	return s.integrationDependencyRepo.Update(ctx, tnt, integrationDependency)
+}
+
+func (s *service) deleteIntegrationDependency(ctx context.Context, id string, resourceType resource.Type) error {
+	if resourceType.IsTenantIgnorable() {
+		return s.integrationDependencyRepo.DeleteGlobal(ctx, id)
+	}
+
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return errors.Wrap(err, "while loading tenant from context")
+	}
+
+	return s.integrationDependencyRepo.Delete(ctx, tnt, id)
+}
+
+func appendUniqueOrdIDs(ordIDs []string, seen map[string]bool, resources []*model.IntegrationDependencyAspectResource) []string {
+	for _, r := range resources {
+		if !seen[r.OrdID] {
+			seen[r.OrdID] = true
+			ordIDs = append(ordIDs, r.OrdID)
+		}
+	}
+	return ordIDs
+}
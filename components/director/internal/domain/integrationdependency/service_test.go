@@ -0,0 +1,777 @@
+package integrationdependency_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/integrationdependency"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/integrationdependency/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_Create(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("Test error")
+
+	ctx := context.TODO()
+	ctx = tenant.SaveToContext(ctx, tenantID, externalTenantID)
+
+	modelIntegrationDependencyForApp := fixIntegrationDependencyModelForApp()
+	modelIntegrationDependencyForAppTemplateVersion := fixIntegrationDependencyModelForAppTemplateVersion()
+	modelInput := *fixIntegrationDependencyModelInput()
+
+	testCases := []struct {
+		Name         string
+		RepositoryFn func() *automock.IntegrationDependencyRepository
+		UIDServiceFn func() *automock.UIDService
+		Input        model.IntegrationDependencyInput
+		ResourceType resource.Type
+		ResourceID   string
+		ExpectedErr  error
+	}{
+		{
+			Name: "Success for Application",
+			RepositoryFn: func() *automock.IntegrationDependencyRepository {
+				repo := &automock.IntegrationDependencyRepository{}
+				repo.On("Create", ctx, tenantID, modelIntegrationDependencyForApp).Return(nil).Once()
+				return repo
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				svc := &automock.UIDService{}
+				svc.On("Generate").Return(integrationDependencyID)
+				return svc
+			},
+			Input:        modelInput,
+			ResourceType: resource.Application,
+			ResourceID:   appID,
+			ExpectedErr:  nil,
+		},
+		{
+			Name: "Success for Application Template Version",
+			RepositoryFn: func() *automock.IntegrationDependencyRepository {
+				repo := &automock.IntegrationDependencyRepository{}
+				repo.On("CreateGlobal", ctx, modelIntegrationDependencyForAppTemplateVersion).Return(nil).Once()
+				return repo
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				svc := &automock.UIDService{}
+				svc.On("Generate").Return(integrationDependencyID)
+				return svc
+			},
+			Input:        modelInput,
+			ResourceType: resource.ApplicationTemplateVersion,
+			ResourceID:   appTemplateVersionID,
+			ExpectedErr:  nil,
+		},
+		{
+			Name: "Error - Integration Dependency creation for Application",
+			RepositoryFn: func() *automock.IntegrationDependencyRepository {
+				repo := &automock.IntegrationDependencyRepository{}
+				repo.On("Create", ctx, tenantID, modelIntegrationDependencyForApp).Return(testErr).Once()
+				return repo
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				svc := &automock.UIDService{}
+				svc.On("Generate").Return(integrationDependencyID).Once()
+				return svc
+			},
+			Input:        modelInput,
+			ResourceType: resource.Application,
+			ResourceID:   appID,
+			ExpectedErr:  testErr,
+		},
+		{
+			Name: "Error - Integration Dependency creation for Application Template Version",
+			RepositoryFn: func() *automock.IntegrationDependencyRepository {
+				repo := &automock.IntegrationDependencyRepository{}
+				repo.On("CreateGlobal", ctx, modelIntegrationDependencyForAppTemplateVersion).Return(testErr).Once()
+				return repo
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				svc := &automock.UIDService{}
+				svc.On("Generate").Return(integrationDependencyID).Once()
+				return svc
+			},
+			Input:        modelInput,
+			ResourceType: resource.ApplicationTemplateVersion,
+			ResourceID:   appTemplateVersionID,
+			ExpectedErr:  testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			repo := testCase.RepositoryFn()
+			uidService := testCase.UIDServiceFn()
+
+			svc := integrationdependency.NewService(repo, nil, nil, uidService)
+
+			// WHEN
+			result, err := svc.Create(ctx, testCase.ResourceType, testCase.ResourceID, packageID, testCase.Input, uint64(123456))
+
+			// THEN
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				assert.IsType(t, "string", result)
+			}
+
+			mock.AssertExpectationsForObjects(t, repo, uidService)
+		})
+	}
+	t.Run("Error when tenant not in context", func(t *testing.T) {
+		svc := integrationdependency.NewService(nil, nil, nil, fixUIDService())
+		// WHEN
+		_, err := svc.Create(context.TODO(), resource.Application, "", "", model.IntegrationDependencyInput{}, 0)
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot read tenant from context")
+	})
+}
+
+func TestService_Update(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("Test error")
+
+	modelIntegrationDependencyForApp := fixIntegrationDependencyModelForApp()
+	modelIntegrationDependencyForAppTemplateVersion := fixIntegrationDependencyModelForAppTemplateVersion()
+	modelInput := *fixIntegrationDependencyModelInput()
+
+	inputIntegrationDependencyModel := mock.MatchedBy(func(integrationDependency *model.IntegrationDependency) bool {
+		return integrationDependency.Title == modelInput.Title
+	})
+
+	ctx := context.TODO()
+	ctx = tenant.SaveToContext(ctx, tenantID, externalTenantID)
+
+	testCases := []struct {
+		Name         string
+		RepositoryFn func() *automock.IntegrationDependencyRepository
+		Input        model.IntegrationDependencyInput
+		InputID      string
+		ResourceType resource.Type
+		ExpectedErr  error
+	}{
+		{
+			Name: "Success for Application",
+			RepositoryFn: func() *automock.IntegrationDependencyRepository {
+				repo := &automock.IntegrationDependencyRepository{}
+				repo.On("GetByID", ctx, tenantID, integrationDependencyID).Return(modelIntegrationDependencyForApp, nil).Once()
+				repo.On("Update", ctx, tenantID, inputIntegrationDependencyModel).Return(nil).Once()
+				return repo
+			},
+			InputID:      integrationDependencyID,
+			Input:        modelInput,
+			ResourceType: resource.Application,
+			ExpectedErr:  nil,
+		},
+		{
+			Name: "Success for Application Template Version",
+			RepositoryFn: func() *automock.IntegrationDependencyRepository {
+				repo := &automock.IntegrationDependencyRepository{}
+				repo.On("GetByIDGlobal", ctx, integrationDependencyID).Return(modelIntegrationDependencyForAppTemplateVersion, nil).Once()
+				repo.On("UpdateGlobal", ctx, inputIntegrationDependencyModel).Return(nil).Once()
+				return repo
+			},
+			InputID:      integrationDependencyID,
+			Input:        modelInput,
+			ResourceType: resource.ApplicationTemplateVersion,
+			ExpectedErr:  nil,
+		},
+		{
+			Name: "Update Error for Application",
+			RepositoryFn: func() *automock.IntegrationDependencyRepository {
+				repo := &automock.IntegrationDependencyRepository{}
+				repo.On("GetByID", ctx, tenantID, integrationDependencyID).Return(modelIntegrationDependencyForApp, nil).Once()
+				repo.On("Update", ctx, tenantID, inputIntegrationDependencyModel).Return(testErr).Once()
+				return repo
+			},
+			InputID:      integrationDependencyID,
+			Input:        modelInput,
+			ResourceType: resource.Application,
+			ExpectedErr:  testErr,
+		},
+		{
+			Name: "Update Error for Application Template Version",
+			RepositoryFn: func() *automock.IntegrationDependencyRepository {
+				repo := &automock.IntegrationDependencyRepository{}
+				repo.On("GetByIDGlobal", ctx, integrationDependencyID).Return(modelIntegrationDependencyForAppTemplateVersion, nil).Once()
+				repo.On("UpdateGlobal", ctx, inputIntegrationDependencyModel).Return(testErr).Once()
+				return repo
+			},
+			InputID:      integrationDependencyID,
+			Input:        modelInput,
+			ResourceType: resource.ApplicationTemplateVersion,
+			ExpectedErr:  testErr,
+		},
+		{
+			Name: "Get Error for Application",
+			RepositoryFn: func() *automock.IntegrationDependencyRepository {
+				repo := &automock.IntegrationDependencyRepository{}
+				repo.On("GetByID", ctx, tenantID, integrationDependencyID).Return(nil, testErr).Once()
+				return repo
+			},
+			InputID:      integrationDependencyID,
+			Input:        modelInput,
+			ResourceType: resource.Application,
+			ExpectedErr:  testErr,
+		},
+		{
+			Name: "Get Error for Application Template Version",
+			RepositoryFn: func() *automock.IntegrationDependencyRepository {
+				repo := &automock.IntegrationDependencyRepository{}
+				repo.On("GetByIDGlobal", ctx, integrationDependencyID).Return(nil, testErr).Once()
+				return repo
+			},
+			InputID:      integrationDependencyID,
+			Input:        modelInput,
+			ResourceType: resource.ApplicationTemplateVersion,
+			ExpectedErr:  testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			repo := testCase.RepositoryFn()
+
+			svc := integrationdependency.NewService(repo, nil, nil, nil)
+
+			// WHEN
+			err := svc.Update(ctx, testCase.ResourceType, testCase.InputID, packageID, testCase.Input, 0)
+
+			// THEN
+			if testCase.ExpectedErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+	t.Run("Error when tenant not in context", func(t *testing.T) {
+		svc := integrationdependency.NewService(nil, nil, nil, fixUIDService())
+		// WHEN
+		err := svc.Update(context.TODO(), resource.Application, "", "", model.IntegrationDependencyInput{}, 0)
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot read tenant from context")
+	})
+}
+
+func TestService_Delete(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("Test error")
+
+	ctx := context.TODO()
+	ctx = tenant.SaveToContext(ctx, tenantID, externalTenantID)
+
+	testCases := []struct {
+		Name         string
+		RepositoryFn func() *automock.IntegrationDependencyRepository
+		Input        model.IntegrationDependencyInput
+		InputID      string
+		ResourceType resource.Type
+		ExpectedErr  error
+	}{
+		{
+			Name: "Success for Application",
+			RepositoryFn: func() *automock.IntegrationDependencyRepository {
+				repo := &automock.IntegrationDependencyRepository{}
+				repo.On("Delete", ctx, tenantID, integrationDependencyID).Return(nil).Once()
+				return repo
+			},
+			InputID:      integrationDependencyID,
+			ResourceType: resource.Application,
+			ExpectedErr:  nil,
+		},
+		{
+			Name: "Success for Application",
+			RepositoryFn: func() *automock.IntegrationDependencyRepository {
+				repo := &automock.IntegrationDependencyRepository{}
+				repo.On("DeleteGlobal", ctx, integrationDependencyID).Return(nil).Once()
+				return repo
+			},
+			InputID:      integrationDependencyID,
+			ResourceType: resource.ApplicationTemplateVersion,
+			ExpectedErr:  nil,
+		},
+		{
+			Name: "Delete Error for Application",
+			RepositoryFn: func() *automock.IntegrationDependencyRepository {
+				repo := &automock.IntegrationDependencyRepository{}
+				repo.On("Delete", ctx, tenantID, integrationDependencyID).Return(testErr).Once()
+				return repo
+			},
+			InputID:      integrationDependencyID,
+			ResourceType: resource.Application,
+			ExpectedErr:  testErr,
+		},
+		{
+			Name: "Delete Error for Application Template Version",
+			RepositoryFn: func() *automock.IntegrationDependencyRepository {
+				repo := &automock.IntegrationDependencyRepository{}
+				repo.On("DeleteGlobal", ctx, integrationDependencyID).Return(testErr).Once()
+				return repo
+			},
+			InputID:      integrationDependencyID,
+			ResourceType: resource.ApplicationTemplateVersion,
+			ExpectedErr:  testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			repo := testCase.RepositoryFn()
+
+			svc := integrationdependency.NewService(repo, nil, nil, nil)
+
+			// WHEN
+			err := svc.Delete(ctx, testCase.ResourceType, testCase.InputID)
+
+			// THEN
+			if testCase.ExpectedErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+	t.Run("Error when tenant not in context", func(t *testing.T) {
+		svc := integrationdependency.NewService(nil, nil, nil, nil)
+		// WHEN
+		err := svc.Delete(context.TODO(), resource.Application, "")
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot read tenant from context")
+	})
+}
+
+func TestService_Get(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("Test error")
+
+	integrationDependency := fixIntegrationDependencyModelForApp()
+
+	ctx := context.TODO()
+	ctx = tenant.SaveToContext(ctx, tenantID, externalTenantID)
+
+	testCases := []struct {
+		Name                          string
+		RepositoryFn                  func() *automock.IntegrationDependencyRepository
+		Input                         model.IntegrationDependencyInput
+		InputID                       string
+		ExpectedIntegrationDependency *model.IntegrationDependency
+		ExpectedErrMessage            string
+	}{
+		{
+			Name: "Success",
+			RepositoryFn: func() *automock.IntegrationDependencyRepository {
+				repo := &automock.IntegrationDependencyRepository{}
+				repo.On("GetByID", ctx, tenantID, integrationDependencyID).Return(integrationDependency, nil).Once()
+				return repo
+			},
+			InputID:                       integrationDependencyID,
+			ExpectedIntegrationDependency: integrationDependency,
+			ExpectedErrMessage:            "",
+		},
+		{
+			Name: "Returns error when Integration Dependency retrieval failed",
+			RepositoryFn: func() *automock.IntegrationDependencyRepository {
+				repo := &automock.IntegrationDependencyRepository{}
+				repo.On("GetByID", ctx, tenantID, integrationDependencyID).Return(nil, testErr).Once()
+				return repo
+			},
+			InputID:                       integrationDependencyID,
+			ExpectedIntegrationDependency: integrationDependency,
+			ExpectedErrMessage:            testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepositoryFn()
+			svc := integrationdependency.NewService(repo, nil, nil, nil)
+
+			// WHEN
+			integrationDependency, err := svc.Get(ctx, testCase.InputID)
+
+			// then
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedIntegrationDependency, integrationDependency)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+	t.Run("Error when tenant not in context", func(t *testing.T) {
+		svc := integrationdependency.NewService(nil, nil, nil, nil)
+		// WHEN
+		_, err := svc.Get(context.TODO(), "")
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot read tenant from context")
+	})
+}
+
+func TestService_ListByApplicationID(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("Test error")
+
+	integrationDependencies := []*model.IntegrationDependency{
+		fixIntegrationDependencyModelForApp(),
+		fixIntegrationDependencyModelForApp(),
+		fixIntegrationDependencyModelForApp(),
+	}
+
+	ctx := context.TODO()
+	ctx = tenant.SaveToContext(ctx, tenantID, externalTenantID)
+
+	testCases := []struct {
+		Name               string
+		PageSize           int
+		RepositoryFn       func() *automock.IntegrationDependencyRepository
+		ExpectedResult     []*model.IntegrationDependency
+		ExpectedErrMessage string
+	}{
+		{
+			Name: "Success",
+			RepositoryFn: func() *automock.IntegrationDependencyRepository {
+				repo := &automock.IntegrationDependencyRepository{}
+				repo.On("ListByResourceID", ctx, tenantID, appID, resource.Application).Return(integrationDependencies, nil).Once()
+				return repo
+			},
+			PageSize:           2,
+			ExpectedResult:     integrationDependencies,
+			ExpectedErrMessage: "",
+		},
+		{
+			Name: "Returns error when Integration Dependency listing failed",
+			RepositoryFn: func() *automock.IntegrationDependencyRepository {
+				repo := &automock.IntegrationDependencyRepository{}
+				repo.On("ListByResourceID", ctx, tenantID, appID, resource.Application).Return(nil, testErr).Once()
+				return repo
+			},
+			PageSize:           2,
+			ExpectedResult:     nil,
+			ExpectedErrMessage: testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepositoryFn()
+
+			svc := integrationdependency.NewService(repo, nil, nil, nil)
+
+			// WHEN
+			integrationDependencies, err := svc.ListByApplicationID(ctx, appID)
+
+			// then
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedResult, integrationDependencies)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+	t.Run("Error when tenant not in context", func(t *testing.T) {
+		svc := integrationdependency.NewService(nil, nil, nil, nil)
+		// WHEN
+		_, err := svc.ListByApplicationID(context.TODO(), "")
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot read tenant from context")
+	})
+}
+
+func TestService_ListByApplicationTemplateVersionID(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("Test error")
+
+	integrationDependencies := []*model.IntegrationDependency{
+		fixIntegrationDependencyModelForApp(),
+		fixIntegrationDependencyModelForApp(),
+		fixIntegrationDependencyModelForApp(),
+	}
+
+	ctx := context.TODO()
+
+	testCases := []struct {
+		Name               string
+		PageSize           int
+		RepositoryFn       func() *automock.IntegrationDependencyRepository
+		ExpectedResult     []*model.IntegrationDependency
+		ExpectedErrMessage string
+	}{
+		{
+			Name: "Success",
+			RepositoryFn: func() *automock.IntegrationDependencyRepository {
+				repo := &automock.IntegrationDependencyRepository{}
+				repo.On("ListByResourceID", ctx, "", appTemplateVersionID, resource.ApplicationTemplateVersion).Return(integrationDependencies, nil).Once()
+				return repo
+			},
+			PageSize:           2,
+			ExpectedResult:     integrationDependencies,
+			ExpectedErrMessage: "",
+		},
+		{
+			Name: "Returns error when Integration Dependency listing failed",
+			RepositoryFn: func() *automock.IntegrationDependencyRepository {
+				repo := &automock.IntegrationDependencyRepository{}
+				repo.On("ListByResourceID", ctx, "", appTemplateVersionID, resource.ApplicationTemplateVersion).Return(nil, testErr).Once()
+				return repo
+			},
+			PageSize:           2,
+			ExpectedResult:     nil,
+			ExpectedErrMessage: testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepositoryFn()
+
+			svc := integrationdependency.NewService(repo, nil, nil, nil)
+
+			// WHEN
+			integrationDependencies, err := svc.ListByApplicationTemplateVersionID(ctx, appTemplateVersionID)
+
+			// then
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedResult, integrationDependencies)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_ListProvidersByApplicationID(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("Test error")
+
+	ctx := context.TODO()
+	ctx = tenant.SaveToContext(ctx, tenantID, externalTenantID)
+
+	integrationDependencyWithInvalidAspects := fixIntegrationDependencyModelForApp()
+	integrationDependencyWithInvalidAspects.Aspects = json.RawMessage(`{`)
+
+	testCases := []struct {
+		Name               string
+		RepositoryFn       func() *automock.IntegrationDependencyRepository
+		APIRepositoryFn    func() *automock.APIRepository
+		EventRepositoryFn  func() *automock.EventRepository
+		ExpectedResult     []*model.IntegrationDependencyProvider
+		ExpectedErrMessage string
+	}{
+		{
+			Name: "Success",
+			RepositoryFn: func() *automock.IntegrationDependencyRepository {
+				repo := &automock.IntegrationDependencyRepository{}
+				repo.On("ListByResourceID", ctx, tenantID, appID, resource.Application).Return([]*model.IntegrationDependency{fixIntegrationDependencyModelForApp()}, nil).Once()
+				return repo
+			},
+			APIRepositoryFn: func() *automock.APIRepository {
+				apiRepo := &automock.APIRepository{}
+				apiRepo.On("ListByOrdIDs", ctx, tenantID, []string{apiOrdID}).Return([]*model.APIDefinition{fixAPIDefinitionModel(apiID, apiOrdID, providerAppID), fixAPIDefinitionModel("ownAPIID", apiOrdID, appID)}, nil).Once()
+				return apiRepo
+			},
+			EventRepositoryFn: func() *automock.EventRepository {
+				eventRepo := &automock.EventRepository{}
+				eventRepo.On("ListByOrdIDs", ctx, tenantID, []string{}).Return([]*model.EventDefinition{}, nil).Once()
+				return eventRepo
+			},
+			ExpectedResult: []*model.IntegrationDependencyProvider{fixIntegrationDependencyProviderModel()},
+		},
+		{
+			Name: "Success when there are no providers for the referenced APIs",
+			RepositoryFn: func() *automock.IntegrationDependencyRepository {
+				repo := &automock.IntegrationDependencyRepository{}
+				repo.On("ListByResourceID", ctx, tenantID, appID, resource.Application).Return([]*model.IntegrationDependency{fixIntegrationDependencyModelForApp()}, nil).Once()
+				return repo
+			},
+			APIRepositoryFn: func() *automock.APIRepository {
+				apiRepo := &automock.APIRepository{}
+				apiRepo.On("ListByOrdIDs", ctx, tenantID, []string{apiOrdID}).Return([]*model.APIDefinition{}, nil).Once()
+				return apiRepo
+			},
+			EventRepositoryFn: func() *automock.EventRepository {
+				eventRepo := &automock.EventRepository{}
+				eventRepo.On("ListByOrdIDs", ctx, tenantID, []string{}).Return([]*model.EventDefinition{}, nil).Once()
+				return eventRepo
+			},
+			ExpectedResult: []*model.IntegrationDependencyProvider{},
+		},
+		{
+			Name: "Success for aspect with Event resources only",
+			RepositoryFn: func() *automock.IntegrationDependencyRepository {
+				repo := &automock.IntegrationDependencyRepository{}
+				repo.On("ListByResourceID", ctx, tenantID, appID, resource.Application).Return([]*model.IntegrationDependency{fixIntegrationDependencyModelWithAspectsForApp(eventAspects)}, nil).Once()
+				return repo
+			},
+			APIRepositoryFn: func() *automock.APIRepository {
+				apiRepo := &automock.APIRepository{}
+				apiRepo.On("ListByOrdIDs", ctx, tenantID, []string{}).Return([]*model.APIDefinition{}, nil).Once()
+				return apiRepo
+			},
+			EventRepositoryFn: func() *automock.EventRepository {
+				eventRepo := &automock.EventRepository{}
+				eventRepo.On("ListByOrdIDs", ctx, tenantID, []string{eventOrdID}).Return([]*model.EventDefinition{fixEventDefinitionModel(eventID, eventOrdID, providerAppID), fixEventDefinitionModel("ownEventID", eventOrdID, appID)}, nil).Once()
+				return eventRepo
+			},
+			ExpectedResult: []*model.IntegrationDependencyProvider{fixIntegrationDependencyEventProviderModel("Bill of materials events", false)},
+		},
+		{
+			Name: "Success for aspect with both API and Event resources",
+			RepositoryFn: func() *automock.IntegrationDependencyRepository {
+				repo := &automock.IntegrationDependencyRepository{}
+				repo.On("ListByResourceID", ctx, tenantID, appID, resource.Application).Return([]*model.IntegrationDependency{fixIntegrationDependencyModelWithAspectsForApp(mixedAspects)}, nil).Once()
+				return repo
+			},
+			APIRepositoryFn: func() *automock.APIRepository {
+				apiRepo := &automock.APIRepository{}
+				apiRepo.On("ListByOrdIDs", ctx, tenantID, []string{apiOrdID}).Return([]*model.APIDefinition{fixAPIDefinitionModel(apiID, apiOrdID, providerAppID)}, nil).Once()
+				return apiRepo
+			},
+			EventRepositoryFn: func() *automock.EventRepository {
+				eventRepo := &automock.EventRepository{}
+				eventRepo.On("ListByOrdIDs", ctx, tenantID, []string{eventOrdID}).Return([]*model.EventDefinition{fixEventDefinitionModel(eventID, eventOrdID, providerAppID)}, nil).Once()
+				return eventRepo
+			},
+			ExpectedResult: []*model.IntegrationDependencyProvider{fixIntegrationDependencyProviderModel(), fixIntegrationDependencyEventProviderModel("Bill of materials", true)},
+		},
+		{
+			Name: "Returns error when Integration Dependency listing failed",
+			RepositoryFn: func() *automock.IntegrationDependencyRepository {
+				repo := &automock.IntegrationDependencyRepository{}
+				repo.On("ListByResourceID", ctx, tenantID, appID, resource.Application).Return(nil, testErr).Once()
+				return repo
+			},
+			APIRepositoryFn: func() *automock.APIRepository {
+				return &automock.APIRepository{}
+			},
+			EventRepositoryFn: func() *automock.EventRepository {
+				return &automock.EventRepository{}
+			},
+			ExpectedErrMessage: testErr.Error(),
+		},
+		{
+			Name: "Returns error when aspects cannot be parsed",
+			RepositoryFn: func() *automock.IntegrationDependencyRepository {
+				repo := &automock.IntegrationDependencyRepository{}
+				repo.On("ListByResourceID", ctx, tenantID, appID, resource.Application).Return([]*model.IntegrationDependency{integrationDependencyWithInvalidAspects}, nil).Once()
+				return repo
+			},
+			APIRepositoryFn: func() *automock.APIRepository {
+				return &automock.APIRepository{}
+			},
+			EventRepositoryFn: func() *automock.EventRepository {
+				return &automock.EventRepository{}
+			},
+			ExpectedErrMessage: "while parsing aspects of Integration Dependency",
+		},
+		{
+			Name: "Returns error when API listing failed",
+			RepositoryFn: func() *automock.IntegrationDependencyRepository {
+				repo := &automock.IntegrationDependencyRepository{}
+				repo.On("ListByResourceID", ctx, tenantID, appID, resource.Application).Return([]*model.IntegrationDependency{fixIntegrationDependencyModelForApp()}, nil).Once()
+				return repo
+			},
+			APIRepositoryFn: func() *automock.APIRepository {
+				apiRepo := &automock.APIRepository{}
+				apiRepo.On("ListByOrdIDs", ctx, tenantID, []string{apiOrdID}).Return(nil, testErr).Once()
+				return apiRepo
+			},
+			EventRepositoryFn: func() *automock.EventRepository {
+				return &automock.EventRepository{}
+			},
+			ExpectedErrMessage: testErr.Error(),
+		},
+		{
+			Name: "Returns error when Event listing failed",
+			RepositoryFn: func() *automock.IntegrationDependencyRepository {
+				repo := &automock.IntegrationDependencyRepository{}
+				repo.On("ListByResourceID", ctx, tenantID, appID, resource.Application).Return([]*model.IntegrationDependency{fixIntegrationDependencyModelWithAspectsForApp(mixedAspects)}, nil).Once()
+				return repo
+			},
+			APIRepositoryFn: func() *automock.APIRepository {
+				apiRepo := &automock.APIRepository{}
+				apiRepo.On("ListByOrdIDs", ctx, tenantID, []string{apiOrdID}).Return([]*model.APIDefinition{}, nil).Once()
+				return apiRepo
+			},
+			EventRepositoryFn: func() *automock.EventRepository {
+				eventRepo := &automock.EventRepository{}
+				eventRepo.On("ListByOrdIDs", ctx, tenantID, []string{eventOrdID}).Return(nil, testErr).Once()
+				return eventRepo
+			},
+			ExpectedErrMessage: testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepositoryFn()
+			apiRepo := testCase.APIRepositoryFn()
+			eventRepo := testCase.EventRepositoryFn()
+
+			svc := integrationdependency.NewService(repo, apiRepo, eventRepo, nil)
+
+			// WHEN
+			providers, err := svc.ListProvidersByApplicationID(ctx, appID)
+
+			// THEN
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedResult, providers)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			}
+
+			mock.AssertExpectationsForObjects(t, repo, apiRepo, eventRepo)
+		})
+	}
+	t.Run("Error when tenant not in context", func(t *testing.T) {
+		svc := integrationdependency.NewService(nil, nil, nil, nil)
+		// WHEN
+		_, err := svc.ListProvidersByApplicationID(context.TODO(), appID)
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot read tenant from context")
+	})
+}
+
+func fixUIDService() *automock.UIDService {
+	uidSvc := &automock.UIDService{}
+	uidSvc.On("Generate").Return(integrationDependencyID)
+	return uidSvc
+}
@@ -3,8 +3,11 @@ package label
 import (
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"strings"
 
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+
 	"github.com/kyma-incubator/compass/components/director/internal/repo"
 
 	"github.com/pkg/errors"
@@ -29,6 +32,8 @@ const (
 	globalSubaccountIDLabelKey string         = "global_subaccount_id"
 	stmtPrefixFormat           string         = `SELECT "%s" FROM %s WHERE "%s" IS NOT NULL AND`
 	stmtPrefixGlobalFormat     string         = `SELECT "%s" FROM %s WHERE "%s" IS NOT NULL`
+	conditionSubqueryFormat    string         = `"%s" IN (SELECT "%s" FROM %s WHERE "%s" IS NOT NULL AND "key" = ?%s)`
+	likeEscapeChar             string         = `\`
 )
 
 type queryFilter struct {
@@ -64,7 +69,7 @@ func FilterQueryGlobal(queryFor model.LabelableObject, setCombination SetCombina
 
 	stmtPrefix := fmt.Sprintf(stmtPrefixGlobalFormat, objectField, tableName, objectField)
 
-	return buildFilterQuery(stmtPrefix, nil, setCombination, filters, false)
+	return buildFilterQuery(objectField, stmtPrefix, nil, setCombination, filters, false)
 }
 
 func filterQuery(queryFor model.LabelableObject, setCombination SetCombination, tenant uuid.UUID, filter []*labelfilter.LabelFilter, isSubQuery bool) (string, []interface{}, error) {
@@ -90,10 +95,10 @@ func filterQuery(queryFor model.LabelableObject, setCombination SetCombination,
 	var stmtPrefixArgs []interface{}
 	stmtPrefixArgs = append(stmtPrefixArgs, tenant)
 
-	return buildFilterQuery(stmtPrefix, stmtPrefixArgs, setCombination, filter, isSubQuery)
+	return buildFilterQuery(objectField, stmtPrefix, stmtPrefixArgs, setCombination, filter, isSubQuery)
 }
 
-func buildFilterQuery(stmtPrefix string, stmtPrefixArgs []interface{}, setCombination SetCombination, filters []*labelfilter.LabelFilter, isSubQuery bool) (string, []interface{}, error) {
+func buildFilterQuery(objectField, stmtPrefix string, stmtPrefixArgs []interface{}, setCombination SetCombination, filters []*labelfilter.LabelFilter, isSubQuery bool) (string, []interface{}, error) {
 	var queryBuilder strings.Builder
 
 	args := make([]interface{}, 0, len(filters))
@@ -107,6 +112,21 @@ func buildFilterQuery(stmtPrefix string, stmtPrefixArgs []interface{}, setCombin
 			args = append(args, stmtPrefixArgs...)
 		}
 
+		if lblFilter.Expression != nil {
+			if lblFilter.Query != nil {
+				return "", nil, apperrors.NewInvalidDataError("label filter for key %q can not have both query and expression", lblFilter.Key)
+			}
+
+			expressionQuery, expressionArgs, err := buildExpressionQuery(objectField, lblFilter.Key, lblFilter.Expression)
+			if err != nil {
+				return "", nil, errors.Wrapf(err, "while building expression for label filter with key %q", lblFilter.Key)
+			}
+
+			queryBuilder.WriteString(" AND " + expressionQuery)
+			args = append(args, expressionArgs...)
+			continue
+		}
+
 		// TODO: for optimization it can be detected if the given Key was already added to the query
 		// if so, it can be omitted
 
@@ -176,3 +196,124 @@ func shouldGlobalSubaccountExists(filter *string) (bool, error) {
 
 	return query.Exists, nil
 }
+
+// buildExpressionQuery translates the structured label filter expression into a parameterised SQL condition.
+// Every label condition is checked against the labels of the same object, so that conditions on different keys can be combined.
+func buildExpressionQuery(objectField, defaultKey string, expression *labelfilter.Expression) (string, []interface{}, error) {
+	setFields := 0
+	if len(expression.And) > 0 {
+		setFields++
+	}
+	if len(expression.Or) > 0 {
+		setFields++
+	}
+	if expression.Not != nil {
+		setFields++
+	}
+	if expression.Condition != nil {
+		setFields++
+	}
+	if setFields != 1 {
+		return "", nil, apperrors.NewInvalidDataError("label filter expression must have exactly one of and, or, not and condition")
+	}
+
+	switch {
+	case len(expression.And) > 0:
+		return buildExpressionGroupQuery(objectField, defaultKey, expression.And, "AND")
+	case len(expression.Or) > 0:
+		return buildExpressionGroupQuery(objectField, defaultKey, expression.Or, "OR")
+	case expression.Not != nil:
+		query, args, err := buildExpressionQuery(objectField, defaultKey, expression.Not)
+		if err != nil {
+			return "", nil, err
+		}
+		return fmt.Sprintf("NOT %s", query), args, nil
+	default:
+		return buildConditionQuery(objectField, defaultKey, expression.Condition)
+	}
+}
+
+func buildExpressionGroupQuery(objectField, defaultKey string, expressions []*labelfilter.Expression, operator string) (string, []interface{}, error) {
+	queries := make([]string, 0, len(expressions))
+	args := make([]interface{}, 0)
+	for _, expression := range expressions {
+		if expression == nil {
+			return "", nil, apperrors.NewInvalidDataError("label filter expression can not be empty")
+		}
+
+		query, exprArgs, err := buildExpressionQuery(objectField, defaultKey, expression)
+		if err != nil {
+			return "", nil, err
+		}
+		queries = append(queries, query)
+		args = append(args, exprArgs...)
+	}
+
+	return "(" + strings.Join(queries, fmt.Sprintf(" %s ", operator)) + ")", args, nil
+}
+
+func buildConditionQuery(objectField, defaultKey string, condition *labelfilter.Condition) (string, []interface{}, error) {
+	key := condition.Key
+	if key == "" {
+		key = defaultKey
+	}
+	if key == "" {
+		return "", nil, apperrors.NewInvalidDataError("label filter condition key can not be empty")
+	}
+
+	valueQuery, valueArgs, err := buildConditionValueQuery(condition)
+	if err != nil {
+		return "", nil, err
+	}
+
+	args := append([]interface{}{key}, valueArgs...)
+	return fmt.Sprintf(conditionSubqueryFormat, objectField, objectField, tableName, objectField, valueQuery), args, nil
+}
+
+func buildConditionValueQuery(condition *labelfilter.Condition) (string, []interface{}, error) {
+	switch condition.Operator {
+	case labelfilter.ExistsOperator:
+		if len(condition.Values) > 0 {
+			return "", nil, apperrors.NewInvalidDataError("operator %s does not accept values", condition.Operator)
+		}
+		return "", nil, nil
+	case labelfilter.InOperator:
+		if len(condition.Values) == 0 {
+			return "", nil, apperrors.NewInvalidDataError("operator %s requires at least one value", condition.Operator)
+		}
+	case labelfilter.EqOperator, labelfilter.StartsWithOperator, labelfilter.GtOperator, labelfilter.LtOperator:
+		if len(condition.Values) != 1 {
+			return "", nil, apperrors.NewInvalidDataError("operator %s requires exactly one value", condition.Operator)
+		}
+	default:
+		return "", nil, apperrors.NewInvalidDataError("unsupported label filter operator %q", condition.Operator)
+	}
+
+	switch condition.Operator {
+	case labelfilter.StartsWithOperator:
+		prefix := strings.NewReplacer(likeEscapeChar, likeEscapeChar+likeEscapeChar, "%", likeEscapeChar+"%", "_", likeEscapeChar+"_").Replace(condition.Values[0])
+		return ` AND jsonb_typeof("value") = 'string' AND "value" #>> '{}' LIKE ?`, []interface{}{prefix + "%"}, nil
+	case labelfilter.GtOperator, labelfilter.LtOperator:
+		number, err := strconv.ParseFloat(condition.Values[0], 64)
+		if err != nil {
+			return "", nil, apperrors.NewInvalidDataError("operator %s requires a numeric value but got %q", condition.Operator, condition.Values[0])
+		}
+		comparison := ">"
+		if condition.Operator == labelfilter.LtOperator {
+			comparison = "<"
+		}
+		return fmt.Sprintf(` AND CASE WHEN jsonb_typeof("value") = 'number' THEN ("value" #>> '{}')::numeric %s ? ELSE FALSE END`, comparison), []interface{}{number}, nil
+	default:
+		// String values and string elements of array values are matched with the ?| operator, which ignores numbers and booleans,
+		// so the scalar numeric and boolean values are matched by their text representation instead
+		args := make([]interface{}, 0, 2*len(condition.Values))
+		placeholders := make([]string, 0, len(condition.Values))
+		for _, value := range condition.Values {
+			args = append(args, value)
+			placeholders = append(placeholders, "?")
+		}
+		args = append(args, args...)
+		joinedPlaceholders := strings.Join(placeholders, ",")
+		return fmt.Sprintf(` AND ("value" ?| array[%s] OR (jsonb_typeof("value") IN ('number', 'boolean') AND "value" #>> '{}' IN (%s)))`, joinedPlaceholders, joinedPlaceholders), args, nil
+	}
+}
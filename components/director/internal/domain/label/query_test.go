@@ -1,6 +1,7 @@
 package label_test
 
 import (
+	"fmt"
 	"strings"
 	"testing"
 
@@ -9,6 +10,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func Test_FilterQuery(t *testing.T) {
@@ -624,6 +626,123 @@ func TestFilterSubquery(t *testing.T) {
 	}
 }
 
+func TestFilterQueryGlobal_WithExpression(t *testing.T) {
+	fooQuery := `["foo-value"]`
+
+	stmtPrefix := `SELECT "app_template_id" FROM public.labels WHERE "app_template_id" IS NOT NULL`
+	subqueryFormat := `"app_template_id" IN (SELECT "app_template_id" FROM public.labels WHERE "app_template_id" IS NOT NULL AND "key" = ?%s)`
+	valueInFormat := ` AND ("value" ?| array[%s] OR (jsonb_typeof("value") IN ('number', 'boolean') AND "value" #>> '{}' IN (%s)))`
+
+	regionInCondition := &labelfilter.Expression{Condition: &labelfilter.Condition{Operator: labelfilter.InOperator, Values: []string{"eu10", "eu20"}}}
+	deprecatedExistsCondition := &labelfilter.Expression{Condition: &labelfilter.Condition{Key: "deprecated", Operator: labelfilter.ExistsOperator}}
+
+	testCases := []struct {
+		Name                string
+		FilterInput         []*labelfilter.LabelFilter
+		ExpectedQueryFilter string
+		ExpectedArgs        []interface{}
+		ExpectedErrMsg      string
+	}{
+		{
+			Name:                "Query with IN condition on the filter key",
+			FilterInput:         []*labelfilter.LabelFilter{labelfilter.NewForKeyWithExpression("region", regionInCondition)},
+			ExpectedQueryFilter: stmtPrefix + ` AND ` + fmt.Sprintf(subqueryFormat, fmt.Sprintf(valueInFormat, "?,?", "?,?")),
+			ExpectedArgs:        []interface{}{"region", "eu10", "eu20", "eu10", "eu20"},
+		}, {
+			Name: "Query with EQ condition on a numeric value",
+			FilterInput: []*labelfilter.LabelFilter{labelfilter.NewForKeyWithExpression("size", &labelfilter.Expression{
+				Condition: &labelfilter.Condition{Operator: labelfilter.EqOperator, Values: []string{"42"}},
+			})},
+			ExpectedQueryFilter: stmtPrefix + ` AND ` + fmt.Sprintf(subqueryFormat, fmt.Sprintf(valueInFormat, "?", "?")),
+			ExpectedArgs:        []interface{}{"size", "42", "42"},
+		}, {
+			Name: "Query with IN condition on boolean values",
+			FilterInput: []*labelfilter.LabelFilter{labelfilter.NewForKeyWithExpression("deprecated", &labelfilter.Expression{
+				Condition: &labelfilter.Condition{Operator: labelfilter.InOperator, Values: []string{"true", "false"}},
+			})},
+			ExpectedQueryFilter: stmtPrefix + ` AND ` + fmt.Sprintf(subqueryFormat, fmt.Sprintf(valueInFormat, "?,?", "?,?")),
+			ExpectedArgs:        []interface{}{"deprecated", "true", "false", "true", "false"},
+		}, {
+			Name: "Query with AND and NOT groups",
+			FilterInput: []*labelfilter.LabelFilter{labelfilter.NewForKeyWithExpression("region", &labelfilter.Expression{
+				And: []*labelfilter.Expression{regionInCondition, {Not: deprecatedExistsCondition}},
+			})},
+			ExpectedQueryFilter: stmtPrefix + ` AND (` + fmt.Sprintf(subqueryFormat, fmt.Sprintf(valueInFormat, "?,?", "?,?")) + ` AND NOT ` + fmt.Sprintf(subqueryFormat, "") + `)`,
+			ExpectedArgs:        []interface{}{"region", "eu10", "eu20", "eu10", "eu20", "deprecated"},
+		}, {
+			Name: "Query with OR group of EQ and STARTS_WITH conditions",
+			FilterInput: []*labelfilter.LabelFilter{labelfilter.NewForKeyWithExpression("name", &labelfilter.Expression{
+				Or: []*labelfilter.Expression{
+					{Condition: &labelfilter.Condition{Operator: labelfilter.EqOperator, Values: []string{"foo"}}},
+					{Condition: &labelfilter.Condition{Operator: labelfilter.StartsWithOperator, Values: []string{"50%_off"}}},
+				},
+			})},
+			ExpectedQueryFilter: stmtPrefix + ` AND (` + fmt.Sprintf(subqueryFormat, fmt.Sprintf(valueInFormat, "?", "?")) + ` OR ` +
+				fmt.Sprintf(subqueryFormat, ` AND jsonb_typeof("value") = 'string' AND "value" #>> '{}' LIKE ?`) + `)`,
+			ExpectedArgs: []interface{}{"name", "foo", "foo", "name", `50\%\_off%`},
+		}, {
+			Name: "Query with GT and LT conditions",
+			FilterInput: []*labelfilter.LabelFilter{labelfilter.NewForKeyWithExpression("size", &labelfilter.Expression{
+				And: []*labelfilter.Expression{
+					{Condition: &labelfilter.Condition{Operator: labelfilter.GtOperator, Values: []string{"1"}}},
+					{Condition: &labelfilter.Condition{Operator: labelfilter.LtOperator, Values: []string{"10.5"}}},
+				},
+			})},
+			ExpectedQueryFilter: stmtPrefix + ` AND (` +
+				fmt.Sprintf(subqueryFormat, ` AND CASE WHEN jsonb_typeof("value") = 'number' THEN ("value" #>> '{}')::numeric > ? ELSE FALSE END`) + ` AND ` +
+				fmt.Sprintf(subqueryFormat, ` AND CASE WHEN jsonb_typeof("value") = 'number' THEN ("value" #>> '{}')::numeric < ? ELSE FALSE END`) + `)`,
+			ExpectedArgs: []interface{}{"size", float64(1), "size", 10.5},
+		}, {
+			Name: "Query with expression combined with query filter",
+			FilterInput: []*labelfilter.LabelFilter{
+				labelfilter.NewForKeyWithQuery("foo", fooQuery),
+				labelfilter.NewForKeyWithExpression("region", regionInCondition),
+			},
+			ExpectedQueryFilter: stmtPrefix + ` AND "key" = ? AND "value" @> ? INTERSECT ` + stmtPrefix + ` AND ` + fmt.Sprintf(subqueryFormat, fmt.Sprintf(valueInFormat, "?,?", "?,?")),
+			ExpectedArgs:        []interface{}{"foo", fooQuery, "region", "eu10", "eu20", "eu10", "eu20"},
+		}, {
+			Name:           "Error when both query and expression are provided",
+			FilterInput:    []*labelfilter.LabelFilter{{Key: "foo", Query: &fooQuery, Expression: regionInCondition}},
+			ExpectedErrMsg: "can not have both query and expression",
+		}, {
+			Name:           "Error when expression has more than one field",
+			FilterInput:    []*labelfilter.LabelFilter{labelfilter.NewForKeyWithExpression("foo", &labelfilter.Expression{Not: regionInCondition, Condition: regionInCondition.Condition})},
+			ExpectedErrMsg: "must have exactly one of and, or, not and condition",
+		}, {
+			Name:           "Error when EQ has more than one value",
+			FilterInput:    []*labelfilter.LabelFilter{labelfilter.NewForKeyWithExpression("foo", &labelfilter.Expression{Condition: &labelfilter.Condition{Operator: labelfilter.EqOperator, Values: []string{"a", "b"}}})},
+			ExpectedErrMsg: "operator EQ requires exactly one value",
+		}, {
+			Name:           "Error when EXISTS has values",
+			FilterInput:    []*labelfilter.LabelFilter{labelfilter.NewForKeyWithExpression("foo", &labelfilter.Expression{Condition: &labelfilter.Condition{Operator: labelfilter.ExistsOperator, Values: []string{"a"}}})},
+			ExpectedErrMsg: "operator EXISTS does not accept values",
+		}, {
+			Name:           "Error when GT value is not a number",
+			FilterInput:    []*labelfilter.LabelFilter{labelfilter.NewForKeyWithExpression("foo", &labelfilter.Expression{Condition: &labelfilter.Condition{Operator: labelfilter.GtOperator, Values: []string{"a"}}})},
+			ExpectedErrMsg: "operator GT requires a numeric value",
+		}, {
+			Name:           "Error when operator is not supported",
+			FilterInput:    []*labelfilter.LabelFilter{labelfilter.NewForKeyWithExpression("foo", &labelfilter.Expression{Condition: &labelfilter.Condition{Operator: "LIKE", Values: []string{"a"}}})},
+			ExpectedErrMsg: "unsupported label filter operator",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			queryFilter, args, err := label.FilterQueryGlobal(model.AppTemplateLabelableObject, label.IntersectSet, testCase.FilterInput)
+
+			if testCase.ExpectedErrMsg != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMsg)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.ExpectedQueryFilter, removeWhitespace(queryFilter))
+			assert.Equal(t, testCase.ExpectedArgs, args)
+		})
+	}
+}
+
 func removeWhitespace(s string) string {
 	return strings.Join(strings.Fields(s), " ")
 }
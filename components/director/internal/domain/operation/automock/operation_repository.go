@@ -44,6 +44,186 @@ func (_m *OperationRepository) DeleteOlderThan(ctx context.Context, opType strin
 	return r0
 }
 
+// Get provides a mock function with given fields: ctx, id
+func (_m *OperationRepository) Get(ctx context.Context, id string) (*model.Operation, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *model.Operation
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.Operation); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Operation)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetForUpdate provides a mock function with given fields: ctx, id
+func (_m *OperationRepository) GetForUpdate(ctx context.Context, id string) (*model.Operation, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *model.Operation
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.Operation); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Operation)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetLatest provides a mock function with given fields: ctx, opType
+func (_m *OperationRepository) GetLatest(ctx context.Context, opType string) (*model.Operation, error) {
+	ret := _m.Called(ctx, opType)
+
+	var r0 *model.Operation
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.Operation); ok {
+		r0 = rf(ctx, opType)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Operation)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, opType)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetNextScheduledForUpdate provides a mock function with given fields: ctx, opType
+func (_m *OperationRepository) GetNextScheduledForUpdate(ctx context.Context, opType string) (*model.Operation, error) {
+	ret := _m.Called(ctx, opType)
+
+	var r0 *model.Operation
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.Operation); ok {
+		r0 = rf(ctx, opType)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Operation)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, opType)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOldestUnfinishedForUpdate provides a mock function with given fields: ctx, opType
+func (_m *OperationRepository) GetOldestUnfinishedForUpdate(ctx context.Context, opType string) (*model.Operation, error) {
+	ret := _m.Called(ctx, opType)
+
+	var r0 *model.Operation
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.Operation); ok {
+		r0 = rf(ctx, opType)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Operation)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, opType)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// List provides a mock function with given fields: ctx, opType, status, pageSize, cursor
+func (_m *OperationRepository) List(ctx context.Context, opType string, status model.OperationStatus, pageSize int, cursor string) (*model.OperationPage, error) {
+	ret := _m.Called(ctx, opType, status, pageSize, cursor)
+
+	var r0 *model.OperationPage
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.OperationStatus, int, string) *model.OperationPage); ok {
+		r0 = rf(ctx, opType, status, pageSize, cursor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.OperationPage)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, model.OperationStatus, int, string) error); ok {
+		r1 = rf(ctx, opType, status, pageSize, cursor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LockType provides a mock function with given fields: ctx, opType
+func (_m *OperationRepository) LockType(ctx context.Context, opType string) error {
+	ret := _m.Called(ctx, opType)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, opType)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RescheduleHangedOperations provides a mock function with given fields: ctx, opType, lastUpdateBefore
+func (_m *OperationRepository) RescheduleHangedOperations(ctx context.Context, opType string, lastUpdateBefore time.Time) error {
+	ret := _m.Called(ctx, opType, lastUpdateBefore)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) error); ok {
+		r0 = rf(ctx, opType, lastUpdateBefore)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Update provides a mock function with given fields: ctx, _a1
+func (_m *OperationRepository) Update(ctx context.Context, _a1 *model.Operation) error {
+	ret := _m.Called(ctx, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Operation) error); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 type mockConstructorTestingTNewOperationRepository interface {
 	mock.TestingT
 	Cleanup(func())
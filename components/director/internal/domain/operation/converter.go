@@ -1,8 +1,12 @@
 package operation
 
 import (
+	"encoding/json"
+	"time"
+
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 )
 
 type converter struct {
@@ -23,7 +27,10 @@ func (c *converter) FromEntity(entity *Entity) *model.Operation {
 		Error:      repo.JSONRawMessageFromNullableString(entity.Error),
 		Priority:   entity.Priority,
 		CreatedAt:  entity.CreatedAt,
+		UpdatedAt:  entity.UpdatedAt,
 		FinishedAt: entity.FinishedAt,
+		ClaimToken: entity.ClaimToken.String,
+		Attempts:   entity.Attempts,
 	}
 }
 
@@ -37,6 +44,71 @@ func (c *converter) ToEntity(operationModel *model.Operation) *Entity {
 		Error:      repo.NewNullableStringFromJSONRawMessage(operationModel.Error),
 		Priority:   operationModel.Priority,
 		CreatedAt:  operationModel.CreatedAt,
+		UpdatedAt:  operationModel.UpdatedAt,
 		FinishedAt: operationModel.FinishedAt,
+		ClaimToken: repo.NewValidNullableString(operationModel.ClaimToken),
+		Attempts:   operationModel.Attempts,
+	}
+}
+
+// ToGraphQL converts the provided service-layer representation of an Operation to the graphql-layer one.
+func (c *converter) ToGraphQL(in *model.Operation) *graphql.Operation {
+	if in == nil {
+		return nil
+	}
+
+	var data *graphql.JSON
+	if len(in.Data) > 0 {
+		jsonData := graphql.JSON(in.Data)
+		data = &jsonData
+	}
+
+	return &graphql.Operation{
+		ID:         in.ID,
+		Type:       graphql.ScheduledOperationType(in.OpType),
+		Status:     graphql.OperationStatus(in.Status),
+		Data:       data,
+		Error:      errorMessage(in.Error),
+		Priority:   in.Priority,
+		CreatedAt:  timePtrToTimestampPtr(in.CreatedAt),
+		UpdatedAt:  timePtrToTimestampPtr(in.UpdatedAt),
+		FinishedAt: timePtrToTimestampPtr(in.FinishedAt),
+	}
+}
+
+// MultipleToGraphQL converts multiple service-layer representations of an Operation to the graphql-layer ones.
+func (c *converter) MultipleToGraphQL(in []*model.Operation) []*graphql.Operation {
+	operations := make([]*graphql.Operation, 0, len(in))
+	for _, op := range in {
+		if op == nil {
+			continue
+		}
+
+		operations = append(operations, c.ToGraphQL(op))
 	}
+
+	return operations
+}
+
+func errorMessage(opErr json.RawMessage) *string {
+	if len(opErr) == 0 {
+		return nil
+	}
+
+	var parsedErr OperationError
+	if err := json.Unmarshal(opErr, &parsedErr); err != nil || parsedErr.ErrorMsg == "" {
+		rawErr := string(opErr)
+		return &rawErr
+	}
+
+	return &parsedErr.ErrorMsg
+}
+
+func timePtrToTimestampPtr(t *time.Time) *graphql.Timestamp {
+	if t == nil {
+		return nil
+	}
+
+	timestamp := graphql.Timestamp(*t)
+	return &timestamp
 }
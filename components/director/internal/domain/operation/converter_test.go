@@ -2,10 +2,13 @@ package operation_test
 
 import (
 	"database/sql"
+	"encoding/json"
 	"testing"
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/operation"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -104,3 +107,82 @@ func TestEntityConverter_FromEntity(t *testing.T) {
 		assert.Equal(t, expectedModel, opModel)
 	})
 }
+
+func TestConverter_ToGraphQL(t *testing.T) {
+	t.Run("success all nullable properties filled", func(t *testing.T) {
+		// GIVEN
+		opModel := fixOperationModel(ordOpType, model.OperationStatusFailed)
+		opModel.Data = json.RawMessage(`{"applicationID":"app-id"}`)
+		opModel.Error = json.RawMessage(`{"error":"processing failed"}`)
+
+		conv := operation.NewConverter()
+
+		// WHEN
+		gqlOp := conv.ToGraphQL(opModel)
+
+		// THEN
+		assert.Equal(t, fixGQLOperation(`{"applicationID":"app-id"}`, str.Ptr("processing failed")), gqlOp)
+	})
+	t.Run("success all nullable properties empty", func(t *testing.T) {
+		// GIVEN
+		opModel := &model.Operation{
+			ID:       operationID,
+			OpType:   ordOpType,
+			Status:   model.OperationStatusScheduled,
+			Priority: 1,
+		}
+
+		expected := &graphql.Operation{
+			ID:       operationID,
+			Type:     graphql.ScheduledOperationTypeOrdAggregation,
+			Status:   graphql.OperationStatusScheduled,
+			Priority: 1,
+		}
+
+		conv := operation.NewConverter()
+
+		// WHEN
+		gqlOp := conv.ToGraphQL(opModel)
+
+		// THEN
+		assert.Equal(t, expected, gqlOp)
+	})
+	t.Run("error which is not in the expected format is returned as is", func(t *testing.T) {
+		// GIVEN
+		opModel := fixOperationModel(ordOpType, model.OperationStatusFailed)
+
+		conv := operation.NewConverter()
+
+		// WHEN
+		gqlOp := conv.ToGraphQL(opModel)
+
+		// THEN
+		require.NotNil(t, gqlOp.Error)
+		assert.Equal(t, "[]", *gqlOp.Error)
+	})
+	t.Run("nil", func(t *testing.T) {
+		// GIVEN
+		conv := operation.NewConverter()
+
+		// WHEN
+		gqlOp := conv.ToGraphQL(nil)
+
+		// THEN
+		assert.Nil(t, gqlOp)
+	})
+}
+
+func TestConverter_MultipleToGraphQL(t *testing.T) {
+	// GIVEN
+	opModel := fixOperationModel(ordOpType, model.OperationStatusFailed)
+	opModel.Data = json.RawMessage(`{"applicationID":"app-id"}`)
+	opModel.Error = json.RawMessage(`{"error":"processing failed"}`)
+
+	conv := operation.NewConverter()
+
+	// WHEN
+	gqlOps := conv.MultipleToGraphQL([]*model.Operation{opModel, nil})
+
+	// THEN
+	assert.Equal(t, []*graphql.Operation{fixGQLOperation(`{"applicationID":"app-id"}`, str.Ptr("processing failed"))}, gqlOps)
+}
@@ -14,5 +14,16 @@ type Entity struct {
 	Error      sql.NullString `db:"error"`
 	Priority   int            `db:"priority"`
 	CreatedAt  *time.Time     `db:"created_at"`
+	UpdatedAt  *time.Time     `db:"updated_at"`
 	FinishedAt *time.Time     `db:"finished_at"`
+	ClaimToken sql.NullString `db:"claim_token"`
+	Attempts   int            `db:"attempts"`
+}
+
+// EntityCollection is a collection of Operation entities.
+type EntityCollection []Entity
+
+// Len is implementation of a repo.Collection interface
+func (s EntityCollection) Len() int {
+	return len(s)
 }
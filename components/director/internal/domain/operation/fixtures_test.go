@@ -10,11 +10,13 @@ import (
 
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 )
 
 const (
 	ordOpType   = "ORD_AGGREGATION"
 	operationID = "aaaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa"
+	claimToken  = "bbbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb"
 )
 
 func fixOperationInput(opType string, opStatus model.OperationStatus) *model.OperationInput {
@@ -42,10 +44,34 @@ func fixOperationModelWithID(id, opType string, opStatus model.OperationStatus)
 		Error:      json.RawMessage("[]"),
 		Priority:   1,
 		CreatedAt:  &time.Time{},
+		UpdatedAt:  &time.Time{},
 		FinishedAt: &time.Time{},
 	}
 }
 
+func fixClaimedOperationModel(token string) *model.Operation {
+	op := fixOperationModel(ordOpType, model.OperationStatusInProgress)
+	op.ClaimToken = token
+	op.Attempts = 1
+	return op
+}
+
+func fixGQLOperation(data string, errMsg *string) *graphql.Operation {
+	gqlData := graphql.JSON(data)
+	timestamp := graphql.Timestamp(time.Time{})
+	return &graphql.Operation{
+		ID:         operationID,
+		Type:       graphql.ScheduledOperationTypeOrdAggregation,
+		Status:     graphql.OperationStatusFailed,
+		Data:       &gqlData,
+		Error:      errMsg,
+		Priority:   1,
+		CreatedAt:  &timestamp,
+		UpdatedAt:  &timestamp,
+		FinishedAt: &timestamp,
+	}
+}
+
 func fixEntityOperation(id, opType string, opStatus model.OperationStatus) *operation.Entity {
 	return &operation.Entity{
 		ID:         id,
@@ -55,10 +81,19 @@ func fixEntityOperation(id, opType string, opStatus model.OperationStatus) *oper
 		Error:      repo.NewValidNullableString("[]"),
 		Priority:   1,
 		CreatedAt:  &time.Time{},
+		UpdatedAt:  &time.Time{},
 		FinishedAt: &time.Time{},
 	}
 }
 
 func fixOperationCreateArgs(op *model.Operation) []driver.Value {
-	return []driver.Value{op.ID, op.OpType, op.Status, repo.NewNullableStringFromJSONRawMessage(op.Data), repo.NewNullableStringFromJSONRawMessage(op.Error), op.Priority, op.CreatedAt, op.FinishedAt}
+	return []driver.Value{op.ID, op.OpType, op.Status, repo.NewNullableStringFromJSONRawMessage(op.Data), repo.NewNullableStringFromJSONRawMessage(op.Error), op.Priority, op.CreatedAt, op.UpdatedAt, op.FinishedAt, repo.NewValidNullableString(op.ClaimToken), op.Attempts}
+}
+
+func fixOperationUpdateArgs(op *model.Operation) []driver.Value {
+	return []driver.Value{op.Status, repo.NewNullableStringFromJSONRawMessage(op.Data), repo.NewNullableStringFromJSONRawMessage(op.Error), op.Priority, op.UpdatedAt, op.FinishedAt, repo.NewValidNullableString(op.ClaimToken), op.Attempts, op.ID}
+}
+
+func fixColumns() []string {
+	return []string{"id", "op_type", "status", "data", "error", "priority", "created_at", "updated_at", "finished_at", "claim_token", "attempts"}
 }
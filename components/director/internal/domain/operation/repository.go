@@ -2,22 +2,31 @@ package operation
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/internal/repo"
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
 	"github.com/kyma-incubator/compass/components/director/pkg/resource"
 )
 
 const operationTable = `public.operation`
 
 var (
+	idColumn            = "id"
 	operationTypeColumn = "op_type"
 	statusColumn        = "status"
+	priorityColumn      = "priority"
+	createdAtColumn     = "created_at"
+	updatedAtColumn     = "updated_at"
 	finishedAtColumn    = "finished_at"
-	operationColumns    = []string{"id", operationTypeColumn, statusColumn, "data", "error", "priority", "created_at", finishedAtColumn}
+	operationColumns    = []string{idColumn, operationTypeColumn, statusColumn, "data", "error", priorityColumn, createdAtColumn, updatedAtColumn, finishedAtColumn, "claim_token", "attempts"}
+	updatableColumns    = []string{statusColumn, "data", "error", priorityColumn, updatedAtColumn, finishedAtColumn, "claim_token", "attempts"}
+	idColumns           = []string{idColumn}
 )
 
 // EntityConverter missing godoc
@@ -30,6 +39,9 @@ type EntityConverter interface {
 
 type pgRepository struct {
 	globalCreator repo.CreatorGlobal
+	globalGetter  repo.SingleGetterGlobal
+	globalPager   repo.PageableQuerierGlobal
+	globalUpdater repo.UpdaterGlobal
 	globalDeleter repo.DeleterGlobal
 	conv          EntityConverter
 }
@@ -38,6 +50,9 @@ type pgRepository struct {
 func NewRepository(conv EntityConverter) *pgRepository {
 	return &pgRepository{
 		globalCreator: repo.NewCreatorGlobal(resource.Operation, operationTable, operationColumns),
+		globalGetter:  repo.NewSingleGetterGlobal(resource.Operation, operationTable, operationColumns),
+		globalPager:   repo.NewPageableQuerierGlobal(resource.Operation, operationTable, operationColumns),
+		globalUpdater: repo.NewUpdaterGlobal(resource.Operation, operationTable, updatableColumns, idColumns),
 		globalDeleter: repo.NewDeleterGlobal(resource.Operation, operationTable),
 		conv:          conv,
 	}
@@ -56,6 +71,182 @@ func (r *pgRepository) Create(ctx context.Context, model *model.Operation) error
 	return r.globalCreator.Create(ctx, operationEnt)
 }
 
+// Get fetches the operation with the provided id
+func (r *pgRepository) Get(ctx context.Context, id string) (*model.Operation, error) {
+	var entity Entity
+	if err := r.globalGetter.GetGlobal(ctx, repo.Conditions{repo.NewEqualCondition(idColumn, id)}, repo.NoOrderBy, &entity); err != nil {
+		return nil, err
+	}
+
+	return r.conv.FromEntity(&entity), nil
+}
+
+// List returns a page of operations. If `opType` or `status` are provided only the operations matching them are returned.
+func (r *pgRepository) List(ctx context.Context, opType string, status model.OperationStatus, pageSize int, cursor string) (*model.OperationPage, error) {
+	var conditions repo.Conditions
+	if opType != "" {
+		conditions = append(conditions, repo.NewEqualCondition(operationTypeColumn, opType))
+	}
+	if status != "" {
+		conditions = append(conditions, repo.NewEqualCondition(statusColumn, string(status)))
+	}
+
+	var entities EntityCollection
+	page, totalCount, err := r.globalPager.ListGlobalWithAdditionalConditions(ctx, pageSize, cursor, idColumn, &entities, repo.And(repo.ConditionTreesFromConditions(conditions)...))
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*model.Operation, 0, len(entities))
+	for _, entity := range entities {
+		items = append(items, r.conv.FromEntity(&entity))
+	}
+
+	return &model.OperationPage{
+		Data:       items,
+		TotalCount: totalCount,
+		PageInfo:   page,
+	}, nil
+}
+
+// Update updates the status, data, error, priority and the timestamps of the provided operation
+func (r *pgRepository) Update(ctx context.Context, model *model.Operation) error {
+	if model == nil {
+		return apperrors.NewInternalError("model can not be empty")
+	}
+
+	log.C(ctx).Debugf("Updating Operation with id %s and status %s", model.ID, model.Status)
+	return r.globalUpdater.UpdateSingleGlobal(ctx, r.conv.ToEntity(model))
+}
+
+// GetForUpdate fetches the operation with the provided id and locks it until the end of the transaction
+func (r *pgRepository) GetForUpdate(ctx context.Context, id string) (*model.Operation, error) {
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := fmt.Sprintf(`SELECT %s FROM %s WHERE %s = $1 FOR UPDATE`, strings.Join(operationColumns, ", "), operationTable, idColumn)
+
+	log.C(ctx).Debugf("Executing DB query: %s", query)
+	var entity Entity
+	if err := persist.GetContext(ctx, &entity, query, id); err != nil {
+		return nil, persistence.MapSQLError(ctx, err, resource.Operation, resource.Get, "while getting operation with id %s for update", id)
+	}
+
+	return r.conv.FromEntity(&entity), nil
+}
+
+// GetLatest fetches the most recently created operation of type `opType`
+func (r *pgRepository) GetLatest(ctx context.Context, opType string) (*model.Operation, error) {
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := fmt.Sprintf(`SELECT %s FROM %s WHERE %s = $1 ORDER BY %s DESC LIMIT 1`, strings.Join(operationColumns, ", "), operationTable, operationTypeColumn, createdAtColumn)
+
+	log.C(ctx).Debugf("Executing DB query: %s", query)
+	var entity Entity
+	if err := persist.GetContext(ctx, &entity, query, opType); err != nil {
+		return nil, persistence.MapSQLError(ctx, err, resource.Operation, resource.Get, "while getting latest operation of type %s", opType)
+	}
+
+	return r.conv.FromEntity(&entity), nil
+}
+
+// GetNextScheduledForUpdate fetches the SCHEDULED operation of type `opType` with the highest priority and locks it until the end of the transaction.
+// Operations with equal priority are returned in the order of their creation. Operations already locked by other transactions are skipped,
+// which allows multiple consumers to claim operations concurrently. If there are no scheduled operations a NotFound error is returned.
+func (r *pgRepository) GetNextScheduledForUpdate(ctx context.Context, opType string) (*model.Operation, error) {
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := fmt.Sprintf(`SELECT %s FROM %s WHERE %s = $1 AND %s = $2 ORDER BY %s DESC, %s ASC LIMIT 1 FOR UPDATE SKIP LOCKED`,
+		strings.Join(operationColumns, ", "), operationTable, operationTypeColumn, statusColumn, priorityColumn, createdAtColumn)
+
+	log.C(ctx).Debugf("Executing DB query: %s", query)
+	var entities EntityCollection
+	if err := persist.SelectContext(ctx, &entities, query, opType, string(model.OperationStatusScheduled)); err != nil {
+		return nil, persistence.MapSQLError(ctx, err, resource.Operation, resource.List, "while getting next scheduled operation of type %s", opType)
+	}
+
+	if len(entities) == 0 {
+		return nil, apperrors.NewNotFoundErrorWithType(resource.Operation)
+	}
+
+	return r.conv.FromEntity(&entities[0]), nil
+}
+
+// GetOldestUnfinishedForUpdate fetches the SCHEDULED or IN_PROGRESS operation of type `opType` which was created first and locks it until the end of the transaction.
+// Locked operations are not skipped, so concurrent consumers wait for each other and always see the same operation at the head of the queue.
+// If there are no unfinished operations a NotFound error is returned.
+func (r *pgRepository) GetOldestUnfinishedForUpdate(ctx context.Context, opType string) (*model.Operation, error) {
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := fmt.Sprintf(`SELECT %s FROM %s WHERE %s = $1 AND %s IN ($2, $3) ORDER BY %s ASC LIMIT 1 FOR UPDATE`,
+		strings.Join(operationColumns, ", "), operationTable, operationTypeColumn, statusColumn, createdAtColumn)
+
+	log.C(ctx).Debugf("Executing DB query: %s", query)
+	var entities EntityCollection
+	if err := persist.SelectContext(ctx, &entities, query, opType, string(model.OperationStatusScheduled), string(model.OperationStatusInProgress)); err != nil {
+		return nil, persistence.MapSQLError(ctx, err, resource.Operation, resource.List, "while getting oldest unfinished operation of type %s", opType)
+	}
+
+	if len(entities) == 0 {
+		return nil, apperrors.NewNotFoundErrorWithType(resource.Operation)
+	}
+
+	return r.conv.FromEntity(&entities[0]), nil
+}
+
+// RescheduleHangedOperations moves all IN_PROGRESS operations of type `opType` which were not updated since `lastUpdateBefore` back to SCHEDULED status
+func (r *pgRepository) RescheduleHangedOperations(ctx context.Context, opType string, lastUpdateBefore time.Time) error {
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return err
+	}
+
+	query := fmt.Sprintf(`UPDATE %s SET %s = $1, %s = $2 WHERE %s = $3 AND %s = $4 AND (%s IS NULL OR %s < $5)`,
+		operationTable, statusColumn, updatedAtColumn, operationTypeColumn, statusColumn, updatedAtColumn, updatedAtColumn)
+
+	log.C(ctx).Debugf("Executing DB query: %s", query)
+	res, err := persist.ExecContext(ctx, query, string(model.OperationStatusScheduled), time.Now(), opType, string(model.OperationStatusInProgress), lastUpdateBefore)
+	if err != nil {
+		return persistence.MapSQLError(ctx, err, resource.Operation, resource.Update, "while rescheduling hanged operations of type %s", opType)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return persistence.MapSQLError(ctx, err, resource.Operation, resource.Update, "while checking affected rows")
+	}
+
+	log.C(ctx).Infof("Rescheduled %d hanged operations of type %s not updated since %v", affected, opType, lastUpdateBefore)
+	return nil
+}
+
+// LockType takes a transaction-scoped advisory lock for the operations of type `opType`. The lock is released when the transaction ends.
+func (r *pgRepository) LockType(ctx context.Context, opType string) error {
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return err
+	}
+
+	query := `SELECT pg_advisory_xact_lock(hashtext($1))`
+
+	log.C(ctx).Debugf("Executing DB query: %s", query)
+	if _, err := persist.ExecContext(ctx, query, operationTable+":"+opType); err != nil {
+		return persistence.MapSQLError(ctx, err, resource.Operation, resource.Update, "while locking operations of type %s", opType)
+	}
+
+	return nil
+}
+
 // DeleteOlderThan deletes all operations of type `opType` with status `status` older than `date`
 func (r *pgRepository) DeleteOlderThan(ctx context.Context, opType string, status model.OperationStatus, date time.Time) error {
 	log.C(ctx).Infof("Deleting all operations of type %s with status %s older than %v", opType, status, date)
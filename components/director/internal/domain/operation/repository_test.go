@@ -1,6 +1,7 @@
 package operation_test
 
 import (
+	"context"
 	"database/sql/driver"
 	"regexp"
 	"testing"
@@ -11,6 +12,10 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/domain/operation/automock"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/internal/repo/testdb"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
 )
 
 func TestPgRepository_Create(t *testing.T) {
@@ -65,3 +70,362 @@ func TestPgRepository_DeleteOlderThan(t *testing.T) {
 
 	suite.Run(t)
 }
+
+func TestPgRepository_Get(t *testing.T) {
+	operationModel := fixOperationModel(ordOpType, model.OperationStatusScheduled)
+	operationEntity := fixEntityOperation(operationID, ordOpType, model.OperationStatusScheduled)
+
+	suite := testdb.RepoGetTestSuite{
+		Name:       "Get Operation",
+		MethodName: "Get",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:    regexp.QuoteMeta(`SELECT id, op_type, status, data, error, priority, created_at, updated_at, finished_at, claim_token, attempts FROM public.operation WHERE id = $1`),
+				Args:     []driver.Value{operationID},
+				IsSelect: true,
+				ValidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixColumns()).AddRow(operationEntity.ID, operationEntity.Type, operationEntity.Status, operationEntity.Data, operationEntity.Error, operationEntity.Priority, operationEntity.CreatedAt, operationEntity.UpdatedAt, operationEntity.FinishedAt, operationEntity.ClaimToken, operationEntity.Attempts)}
+				},
+				InvalidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixColumns())}
+				},
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		RepoConstructorFunc:       operation.NewRepository,
+		ExpectedModelEntity:       operationModel,
+		ExpectedDBEntity:          operationEntity,
+		MethodArgs:                []interface{}{operationID},
+		DisableConverterErrorTest: true,
+	}
+
+	suite.Run(t)
+}
+
+func TestPgRepository_Update(t *testing.T) {
+	var nilOperationModel *model.Operation
+	operationModel := fixOperationModel(ordOpType, model.OperationStatusInProgress)
+	operationEntity := fixEntityOperation(operationID, ordOpType, model.OperationStatusInProgress)
+
+	suite := testdb.RepoUpdateTestSuite{
+		Name: "Update Operation",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:         regexp.QuoteMeta(`UPDATE public.operation SET status = ?, data = ?, error = ?, priority = ?, updated_at = ?, finished_at = ?, claim_token = ?, attempts = ? WHERE id = ?`),
+				Args:          fixOperationUpdateArgs(operationModel),
+				ValidResult:   sqlmock.NewResult(-1, 1),
+				InvalidResult: sqlmock.NewResult(-1, 0),
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		RepoConstructorFunc:       operation.NewRepository,
+		ModelEntity:               operationModel,
+		DBEntity:                  operationEntity,
+		NilModelEntity:            nilOperationModel,
+		DisableConverterErrorTest: true,
+		IsGlobal:                  true,
+	}
+
+	suite.Run(t)
+}
+
+func TestPgRepository_GetNextScheduledForUpdate(t *testing.T) {
+	operationModel := fixOperationModel(ordOpType, model.OperationStatusScheduled)
+	operationEntity := fixEntityOperation(operationID, ordOpType, model.OperationStatusScheduled)
+
+	suite := testdb.RepoGetTestSuite{
+		Name:       "Get next scheduled Operation for update",
+		MethodName: "GetNextScheduledForUpdate",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:    regexp.QuoteMeta(`SELECT id, op_type, status, data, error, priority, created_at, updated_at, finished_at, claim_token, attempts FROM public.operation WHERE op_type = $1 AND status = $2 ORDER BY priority DESC, created_at ASC LIMIT 1 FOR UPDATE SKIP LOCKED`),
+				Args:     []driver.Value{ordOpType, string(model.OperationStatusScheduled)},
+				IsSelect: true,
+				ValidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixColumns()).AddRow(operationEntity.ID, operationEntity.Type, operationEntity.Status, operationEntity.Data, operationEntity.Error, operationEntity.Priority, operationEntity.CreatedAt, operationEntity.UpdatedAt, operationEntity.FinishedAt, operationEntity.ClaimToken, operationEntity.Attempts)}
+				},
+				InvalidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixColumns())}
+				},
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		RepoConstructorFunc:       operation.NewRepository,
+		ExpectedModelEntity:       operationModel,
+		ExpectedDBEntity:          operationEntity,
+		MethodArgs:                []interface{}{ordOpType},
+		DisableConverterErrorTest: true,
+	}
+
+	suite.Run(t)
+}
+
+func TestPgRepository_GetForUpdate(t *testing.T) {
+	operationModel := fixOperationModel(ordOpType, model.OperationStatusScheduled)
+	operationEntity := fixEntityOperation(operationID, ordOpType, model.OperationStatusScheduled)
+
+	suite := testdb.RepoGetTestSuite{
+		Name:       "Get Operation for update",
+		MethodName: "GetForUpdate",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:    regexp.QuoteMeta(`SELECT id, op_type, status, data, error, priority, created_at, updated_at, finished_at, claim_token, attempts FROM public.operation WHERE id = $1 FOR UPDATE`),
+				Args:     []driver.Value{operationID},
+				IsSelect: true,
+				ValidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixColumns()).AddRow(operationEntity.ID, operationEntity.Type, operationEntity.Status, operationEntity.Data, operationEntity.Error, operationEntity.Priority, operationEntity.CreatedAt, operationEntity.UpdatedAt, operationEntity.FinishedAt, operationEntity.ClaimToken, operationEntity.Attempts)}
+				},
+				InvalidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixColumns())}
+				},
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		RepoConstructorFunc:       operation.NewRepository,
+		ExpectedModelEntity:       operationModel,
+		ExpectedDBEntity:          operationEntity,
+		MethodArgs:                []interface{}{operationID},
+		DisableConverterErrorTest: true,
+	}
+
+	suite.Run(t)
+}
+
+func TestPgRepository_GetLatest(t *testing.T) {
+	operationModel := fixOperationModel(ordOpType, model.OperationStatusScheduled)
+	operationEntity := fixEntityOperation(operationID, ordOpType, model.OperationStatusScheduled)
+
+	suite := testdb.RepoGetTestSuite{
+		Name:       "Get latest Operation",
+		MethodName: "GetLatest",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:    regexp.QuoteMeta(`SELECT id, op_type, status, data, error, priority, created_at, updated_at, finished_at, claim_token, attempts FROM public.operation WHERE op_type = $1 ORDER BY created_at DESC LIMIT 1`),
+				Args:     []driver.Value{ordOpType},
+				IsSelect: true,
+				ValidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixColumns()).AddRow(operationEntity.ID, operationEntity.Type, operationEntity.Status, operationEntity.Data, operationEntity.Error, operationEntity.Priority, operationEntity.CreatedAt, operationEntity.UpdatedAt, operationEntity.FinishedAt, operationEntity.ClaimToken, operationEntity.Attempts)}
+				},
+				InvalidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixColumns())}
+				},
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		RepoConstructorFunc:       operation.NewRepository,
+		ExpectedModelEntity:       operationModel,
+		ExpectedDBEntity:          operationEntity,
+		MethodArgs:                []interface{}{ordOpType},
+		DisableConverterErrorTest: true,
+	}
+
+	suite.Run(t)
+}
+
+func TestPgRepository_GetOldestUnfinishedForUpdate(t *testing.T) {
+	operationModel := fixOperationModel(ordOpType, model.OperationStatusScheduled)
+	operationEntity := fixEntityOperation(operationID, ordOpType, model.OperationStatusScheduled)
+
+	suite := testdb.RepoGetTestSuite{
+		Name:       "Get oldest unfinished Operation for update",
+		MethodName: "GetOldestUnfinishedForUpdate",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:    regexp.QuoteMeta(`SELECT id, op_type, status, data, error, priority, created_at, updated_at, finished_at, claim_token, attempts FROM public.operation WHERE op_type = $1 AND status IN ($2, $3) ORDER BY created_at ASC LIMIT 1 FOR UPDATE`),
+				Args:     []driver.Value{ordOpType, string(model.OperationStatusScheduled), string(model.OperationStatusInProgress)},
+				IsSelect: true,
+				ValidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixColumns()).AddRow(operationEntity.ID, operationEntity.Type, operationEntity.Status, operationEntity.Data, operationEntity.Error, operationEntity.Priority, operationEntity.CreatedAt, operationEntity.UpdatedAt, operationEntity.FinishedAt, operationEntity.ClaimToken, operationEntity.Attempts)}
+				},
+				InvalidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixColumns())}
+				},
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		RepoConstructorFunc:       operation.NewRepository,
+		ExpectedModelEntity:       operationModel,
+		ExpectedDBEntity:          operationEntity,
+		MethodArgs:                []interface{}{ordOpType},
+		DisableConverterErrorTest: true,
+	}
+
+	suite.Run(t)
+}
+
+func TestPgRepository_RescheduleHangedOperations(t *testing.T) {
+	lastUpdateBefore := time.Now()
+	query := regexp.QuoteMeta(`UPDATE public.operation SET status = $1, updated_at = $2 WHERE op_type = $3 AND status = $4 AND (updated_at IS NULL OR updated_at < $5)`)
+
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectExec(query).
+			WithArgs(string(model.OperationStatusScheduled), sqlmock.AnyArg(), ordOpType, string(model.OperationStatusInProgress), lastUpdateBefore).
+			WillReturnResult(sqlmock.NewResult(-1, 2))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repository := operation.NewRepository(nil)
+
+		// WHEN
+		err := repository.RescheduleHangedOperations(ctx, ordOpType, lastUpdateBefore)
+
+		// THEN
+		require.NoError(t, err)
+	})
+
+	t.Run("Error when executing db query", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectExec(query).
+			WithArgs(string(model.OperationStatusScheduled), sqlmock.AnyArg(), ordOpType, string(model.OperationStatusInProgress), lastUpdateBefore).
+			WillReturnError(errors.New("test error"))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repository := operation.NewRepository(nil)
+
+		// WHEN
+		err := repository.RescheduleHangedOperations(ctx, ordOpType, lastUpdateBefore)
+
+		// THEN
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "Unexpected error while executing SQL query")
+	})
+
+	t.Run("Error when persistence is missing in the context", func(t *testing.T) {
+		// GIVEN
+		repository := operation.NewRepository(nil)
+
+		// WHEN
+		err := repository.RescheduleHangedOperations(context.TODO(), ordOpType, lastUpdateBefore)
+
+		// THEN
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "unable to fetch database from context")
+	})
+}
+
+func TestPgRepository_LockType(t *testing.T) {
+	query := regexp.QuoteMeta(`SELECT pg_advisory_xact_lock(hashtext($1))`)
+
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectExec(query).
+			WithArgs("public.operation:" + ordOpType).
+			WillReturnResult(sqlmock.NewResult(-1, 1))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repository := operation.NewRepository(nil)
+
+		// WHEN
+		err := repository.LockType(ctx, ordOpType)
+
+		// THEN
+		require.NoError(t, err)
+	})
+
+	t.Run("Error when executing db query", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectExec(query).
+			WithArgs("public.operation:" + ordOpType).
+			WillReturnError(errors.New("test error"))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repository := operation.NewRepository(nil)
+
+		// WHEN
+		err := repository.LockType(ctx, ordOpType)
+
+		// THEN
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "Unexpected error while executing SQL query")
+	})
+
+	t.Run("Error when persistence is missing in the context", func(t *testing.T) {
+		// GIVEN
+		repository := operation.NewRepository(nil)
+
+		// WHEN
+		err := repository.LockType(context.TODO(), ordOpType)
+
+		// THEN
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "unable to fetch database from context")
+	})
+}
+
+func TestPgRepository_List(t *testing.T) {
+	operationModel := fixOperationModel(ordOpType, model.OperationStatusFailed)
+	operationEntity := fixEntityOperation(operationID, ordOpType, model.OperationStatusFailed)
+
+	suite := testdb.RepoListPageableTestSuite{
+		Name:       "List Operations with filter and paging",
+		MethodName: "List",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:    regexp.QuoteMeta(`SELECT id, op_type, status, data, error, priority, created_at, updated_at, finished_at, claim_token, attempts FROM public.operation WHERE (op_type = $1 AND status = $2) ORDER BY id LIMIT 3 OFFSET 0`),
+				Args:     []driver.Value{ordOpType, string(model.OperationStatusFailed)},
+				IsSelect: true,
+				ValidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixColumns()).AddRow(operationEntity.ID, operationEntity.Type, operationEntity.Status, operationEntity.Data, operationEntity.Error, operationEntity.Priority, operationEntity.CreatedAt, operationEntity.UpdatedAt, operationEntity.FinishedAt, operationEntity.ClaimToken, operationEntity.Attempts)}
+				},
+				InvalidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixColumns())}
+				},
+			},
+			{
+				Query:    regexp.QuoteMeta(`SELECT COUNT(*) FROM public.operation WHERE (op_type = $1 AND status = $2)`),
+				Args:     []driver.Value{ordOpType, string(model.OperationStatusFailed)},
+				IsSelect: true,
+				ValidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows([]string{"count"}).AddRow(1)}
+				},
+			},
+		},
+		Pages: []testdb.PageDetails{
+			{
+				ExpectedModelEntities: []interface{}{operationModel},
+				ExpectedDBEntities:    []interface{}{operationEntity},
+				ExpectedPage: &model.OperationPage{
+					Data: []*model.Operation{operationModel},
+					PageInfo: &pagination.Page{
+						StartCursor: "",
+						EndCursor:   "",
+						HasNextPage: false,
+					},
+					TotalCount: 1,
+				},
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		RepoConstructorFunc:       operation.NewRepository,
+		MethodArgs:                []interface{}{ordOpType, model.OperationStatusFailed, 3, ""},
+		DisableConverterErrorTest: true,
+	}
+
+	suite.Run(t)
+}
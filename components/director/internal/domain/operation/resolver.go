@@ -0,0 +1,192 @@
+package operation
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	operationsmanager "github.com/kyma-incubator/compass/components/director/internal/operations_manager"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+)
+
+// scheduledOperationPriority is the priority of the operations scheduled through the API.
+// It is higher than the priority of the periodically created operations, so that they are processed first.
+const scheduledOperationPriority = 100
+
+// OperationService is responsible for the service-layer Operation operations used by the resolver
+//
+//go:generate mockery --name=OperationService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type OperationService interface {
+	Create(ctx context.Context, in *model.OperationInput) (string, error)
+	Get(ctx context.Context, id string) (*model.Operation, error)
+	List(ctx context.Context, opType string, status model.OperationStatus, pageSize int, cursor string) (*model.OperationPage, error)
+	Reschedule(ctx context.Context, id string, priority *int) (*model.Operation, error)
+}
+
+// Converter converts Operations between the service-layer and the graphql-layer representations
+//
+//go:generate mockery --name=Converter --output=automock --outpkg=automock --case=underscore --disable-version-string
+type Converter interface {
+	ToGraphQL(in *model.Operation) *graphql.Operation
+	MultipleToGraphQL(in []*model.Operation) []*graphql.Operation
+}
+
+// Resolver is an object responsible for resolver-layer Operation operations.
+type Resolver struct {
+	transact persistence.Transactioner
+	opSvc    OperationService
+	conv     Converter
+}
+
+// NewResolver returns a new object responsible for resolver-layer Operation operations.
+func NewResolver(transact persistence.Transactioner, opSvc OperationService, conv Converter) *Resolver {
+	return &Resolver{
+		transact: transact,
+		opSvc:    opSvc,
+		conv:     conv,
+	}
+}
+
+// Operations lists the operations matching the provided `filter` with pagination based on `first` and `after`
+func (r *Resolver) Operations(ctx context.Context, filter *graphql.OperationFilter, first *int, after *graphql.PageCursor) (*graphql.OperationPage, error) {
+	var cursor string
+	if after != nil {
+		cursor = string(*after)
+	}
+	if first == nil {
+		return nil, apperrors.NewInvalidDataError("missing required parameter 'first'")
+	}
+
+	var opType string
+	var status model.OperationStatus
+	if filter != nil {
+		if filter.Type != nil {
+			opType = filter.Type.String()
+		}
+		if filter.Status != nil {
+			status = model.OperationStatus(*filter.Status)
+		}
+	}
+
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	opPage, err := r.opSvc.List(ctx, opType, status, *first, cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return &graphql.OperationPage{
+		Data:       r.conv.MultipleToGraphQL(opPage.Data),
+		TotalCount: opPage.TotalCount,
+		PageInfo: &graphql.PageInfo{
+			StartCursor: graphql.PageCursor(opPage.PageInfo.StartCursor),
+			EndCursor:   graphql.PageCursor(opPage.PageInfo.EndCursor),
+			HasNextPage: opPage.PageInfo.HasNextPage,
+		},
+	}, nil
+}
+
+// Operation queries the Operation with the provided `id`
+func (r *Resolver) Operation(ctx context.Context, id string) (*graphql.Operation, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	op, err := r.opSvc.Get(ctx, id)
+	if err != nil {
+		if apperrors.IsNotFoundError(err) {
+			return nil, tx.Commit()
+		}
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.conv.ToGraphQL(op), nil
+}
+
+// RescheduleOperation moves the Operation with the provided `id` back to SCHEDULED status and optionally changes its `priority`
+func (r *Resolver) RescheduleOperation(ctx context.Context, id string, priority *int) (*graphql.Operation, error) {
+	if priority != nil && *priority < 0 {
+		return nil, apperrors.NewInvalidDataError("priority can not be negative")
+	}
+
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	log.C(ctx).Infof("Rescheduling Operation with id %s", id)
+	op, err := r.opSvc.Reschedule(ctx, id, priority)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.conv.ToGraphQL(op), nil
+}
+
+// ScheduleORDAggregation schedules an ORD aggregation Operation for the application with id `appID`, for the application template with id `appTemplateID`
+// or for the application in the context of its application template when both are provided
+func (r *Resolver) ScheduleORDAggregation(ctx context.Context, appID *string, appTemplateID *string) (*graphql.Operation, error) {
+	applicationID := str.PtrStrToStr(appID)
+	applicationTemplateID := str.PtrStrToStr(appTemplateID)
+	if applicationID == "" && applicationTemplateID == "" {
+		return nil, apperrors.NewInvalidDataError("at least one of appID and appTemplateID has to be provided")
+	}
+
+	data, err := operationsmanager.NewOrdOperationData(applicationID, applicationTemplateID).GetData()
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	log.C(ctx).Infof("Scheduling ORD aggregation for application with id %q and application template with id %q", applicationID, applicationTemplateID)
+	id, err := r.opSvc.Create(ctx, operationsmanager.NewORDOperationInput(data, scheduledOperationPriority))
+	if err != nil {
+		return nil, err
+	}
+
+	op, err := r.opSvc.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.conv.ToGraphQL(op), nil
+}
@@ -0,0 +1,511 @@
+package operation_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/operation"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/operation/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	operationsmanager "github.com/kyma-incubator/compass/components/director/internal/operations_manager"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
+	persistenceautomock "github.com/kyma-incubator/compass/components/director/pkg/persistence/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	emptyCtx = context.Background()
+	testErr  = errors.New("test error")
+	txGen    = txtest.NewTransactionContextGenerator(testErr)
+)
+
+func TestResolver_Operations(t *testing.T) {
+	first := 2
+	gqlAfter := graphql.PageCursor("test")
+	opType := graphql.ScheduledOperationTypeOrdAggregation
+	opStatus := graphql.OperationStatusFailed
+	filter := &graphql.OperationFilter{Type: &opType, Status: &opStatus}
+
+	opModel := fixOperationModel(ordOpType, model.OperationStatusFailed)
+	gqlOp := fixGQLOperation("[]", str.Ptr("[]"))
+	opPage := &model.OperationPage{
+		Data:       []*model.Operation{opModel},
+		TotalCount: 1,
+		PageInfo: &pagination.Page{
+			StartCursor: "start",
+			EndCursor:   "end",
+			HasNextPage: false,
+		},
+	}
+	gqlPage := &graphql.OperationPage{
+		Data:       []*graphql.Operation{gqlOp},
+		TotalCount: 1,
+		PageInfo: &graphql.PageInfo{
+			StartCursor: "start",
+			EndCursor:   "end",
+			HasNextPage: false,
+		},
+	}
+
+	testCases := []struct {
+		Name            string
+		TransactionerFn func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ConverterFn     func() *automock.Converter
+		OpSvcFn         func() *automock.OperationService
+		Filter          *graphql.OperationFilter
+		First           *int
+		ExpectedOutput  *graphql.OperationPage
+		ExpectedError   error
+	}{
+		{
+			Name:            "Success",
+			TransactionerFn: txGen.ThatSucceeds,
+			ConverterFn: func() *automock.Converter {
+				conv := &automock.Converter{}
+				conv.On("MultipleToGraphQL", opPage.Data).Return(gqlPage.Data).Once()
+				return conv
+			},
+			OpSvcFn: func() *automock.OperationService {
+				opSvc := &automock.OperationService{}
+				opSvc.On("List", txtest.CtxWithDBMatcher(), ordOpType, model.OperationStatusFailed, first, string(gqlAfter)).Return(opPage, nil).Once()
+				return opSvc
+			},
+			Filter:         filter,
+			First:          &first,
+			ExpectedOutput: gqlPage,
+		},
+		{
+			Name:            "Success without filter",
+			TransactionerFn: txGen.ThatSucceeds,
+			ConverterFn: func() *automock.Converter {
+				conv := &automock.Converter{}
+				conv.On("MultipleToGraphQL", opPage.Data).Return(gqlPage.Data).Once()
+				return conv
+			},
+			OpSvcFn: func() *automock.OperationService {
+				opSvc := &automock.OperationService{}
+				opSvc.On("List", txtest.CtxWithDBMatcher(), "", model.OperationStatus(""), first, string(gqlAfter)).Return(opPage, nil).Once()
+				return opSvc
+			},
+			First:          &first,
+			ExpectedOutput: gqlPage,
+		},
+		{
+			Name:          "Error when first is missing",
+			Filter:        filter,
+			ExpectedError: errors.New("missing required parameter 'first'"),
+		},
+		{
+			Name:            "Error when transaction fails to begin",
+			TransactionerFn: txGen.ThatFailsOnBegin,
+			Filter:          filter,
+			First:           &first,
+			ExpectedError:   testErr,
+		},
+		{
+			Name:            "Error when listing operations fails",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			OpSvcFn: func() *automock.OperationService {
+				opSvc := &automock.OperationService{}
+				opSvc.On("List", txtest.CtxWithDBMatcher(), ordOpType, model.OperationStatusFailed, first, string(gqlAfter)).Return(nil, testErr).Once()
+				return opSvc
+			},
+			Filter:        filter,
+			First:         &first,
+			ExpectedError: testErr,
+		},
+		{
+			Name:            "Error when committing the transaction fails",
+			TransactionerFn: txGen.ThatFailsOnCommit,
+			OpSvcFn: func() *automock.OperationService {
+				opSvc := &automock.OperationService{}
+				opSvc.On("List", txtest.CtxWithDBMatcher(), ordOpType, model.OperationStatusFailed, first, string(gqlAfter)).Return(opPage, nil).Once()
+				return opSvc
+			},
+			Filter:        filter,
+			First:         &first,
+			ExpectedError: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			persist, transact, conv, opSvc := fixResolverDependencies(testCase.TransactionerFn, testCase.ConverterFn, testCase.OpSvcFn)
+			defer mock.AssertExpectationsForObjects(t, persist, transact, conv, opSvc)
+
+			resolver := operation.NewResolver(transact, opSvc, conv)
+
+			// WHEN
+			result, err := resolver.Operations(emptyCtx, testCase.Filter, testCase.First, &gqlAfter)
+
+			// THEN
+			if testCase.ExpectedError != nil {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), testCase.ExpectedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, testCase.ExpectedOutput, result)
+		})
+	}
+}
+
+func TestResolver_Operation(t *testing.T) {
+	opModel := fixOperationModel(ordOpType, model.OperationStatusFailed)
+	gqlOp := fixGQLOperation("[]", str.Ptr("[]"))
+
+	testCases := []struct {
+		Name            string
+		TransactionerFn func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ConverterFn     func() *automock.Converter
+		OpSvcFn         func() *automock.OperationService
+		ExpectedOutput  *graphql.Operation
+		ExpectedError   error
+	}{
+		{
+			Name:            "Success",
+			TransactionerFn: txGen.ThatSucceeds,
+			ConverterFn: func() *automock.Converter {
+				conv := &automock.Converter{}
+				conv.On("ToGraphQL", opModel).Return(gqlOp).Once()
+				return conv
+			},
+			OpSvcFn: func() *automock.OperationService {
+				opSvc := &automock.OperationService{}
+				opSvc.On("Get", txtest.CtxWithDBMatcher(), operationID).Return(opModel, nil).Once()
+				return opSvc
+			},
+			ExpectedOutput: gqlOp,
+		},
+		{
+			Name:            "Returns nil when operation is not found",
+			TransactionerFn: txGen.ThatSucceeds,
+			OpSvcFn: func() *automock.OperationService {
+				opSvc := &automock.OperationService{}
+				opSvc.On("Get", txtest.CtxWithDBMatcher(), operationID).Return(nil, apperrors.NewNotFoundError(resource.Operation, operationID)).Once()
+				return opSvc
+			},
+		},
+		{
+			Name:            "Error when transaction fails to begin",
+			TransactionerFn: txGen.ThatFailsOnBegin,
+			ExpectedError:   testErr,
+		},
+		{
+			Name:            "Error when getting operation fails",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			OpSvcFn: func() *automock.OperationService {
+				opSvc := &automock.OperationService{}
+				opSvc.On("Get", txtest.CtxWithDBMatcher(), operationID).Return(nil, testErr).Once()
+				return opSvc
+			},
+			ExpectedError: testErr,
+		},
+		{
+			Name:            "Error when committing the transaction fails",
+			TransactionerFn: txGen.ThatFailsOnCommit,
+			OpSvcFn: func() *automock.OperationService {
+				opSvc := &automock.OperationService{}
+				opSvc.On("Get", txtest.CtxWithDBMatcher(), operationID).Return(opModel, nil).Once()
+				return opSvc
+			},
+			ExpectedError: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			persist, transact, conv, opSvc := fixResolverDependencies(testCase.TransactionerFn, testCase.ConverterFn, testCase.OpSvcFn)
+			defer mock.AssertExpectationsForObjects(t, persist, transact, conv, opSvc)
+
+			resolver := operation.NewResolver(transact, opSvc, conv)
+
+			// WHEN
+			result, err := resolver.Operation(emptyCtx, operationID)
+
+			// THEN
+			if testCase.ExpectedError != nil {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), testCase.ExpectedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, testCase.ExpectedOutput, result)
+		})
+	}
+}
+
+func TestResolver_RescheduleOperation(t *testing.T) {
+	priority := 10
+	negativePriority := -1
+	opModel := fixOperationModel(ordOpType, model.OperationStatusScheduled)
+	gqlOp := fixGQLOperation("[]", str.Ptr("[]"))
+
+	testCases := []struct {
+		Name            string
+		TransactionerFn func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ConverterFn     func() *automock.Converter
+		OpSvcFn         func() *automock.OperationService
+		Priority        *int
+		ExpectedOutput  *graphql.Operation
+		ExpectedError   error
+	}{
+		{
+			Name:            "Success",
+			TransactionerFn: txGen.ThatSucceeds,
+			ConverterFn: func() *automock.Converter {
+				conv := &automock.Converter{}
+				conv.On("ToGraphQL", opModel).Return(gqlOp).Once()
+				return conv
+			},
+			OpSvcFn: func() *automock.OperationService {
+				opSvc := &automock.OperationService{}
+				opSvc.On("Reschedule", txtest.CtxWithDBMatcher(), operationID, &priority).Return(opModel, nil).Once()
+				return opSvc
+			},
+			Priority:       &priority,
+			ExpectedOutput: gqlOp,
+		},
+		{
+			Name:          "Error when priority is negative",
+			Priority:      &negativePriority,
+			ExpectedError: errors.New("priority can not be negative"),
+		},
+		{
+			Name:            "Error when transaction fails to begin",
+			TransactionerFn: txGen.ThatFailsOnBegin,
+			Priority:        &priority,
+			ExpectedError:   testErr,
+		},
+		{
+			Name:            "Error when rescheduling operation fails",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			OpSvcFn: func() *automock.OperationService {
+				opSvc := &automock.OperationService{}
+				opSvc.On("Reschedule", txtest.CtxWithDBMatcher(), operationID, &priority).Return(nil, testErr).Once()
+				return opSvc
+			},
+			Priority:      &priority,
+			ExpectedError: testErr,
+		},
+		{
+			Name:            "Error when committing the transaction fails",
+			TransactionerFn: txGen.ThatFailsOnCommit,
+			OpSvcFn: func() *automock.OperationService {
+				opSvc := &automock.OperationService{}
+				opSvc.On("Reschedule", txtest.CtxWithDBMatcher(), operationID, &priority).Return(opModel, nil).Once()
+				return opSvc
+			},
+			Priority:      &priority,
+			ExpectedError: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			persist, transact, conv, opSvc := fixResolverDependencies(testCase.TransactionerFn, testCase.ConverterFn, testCase.OpSvcFn)
+			defer mock.AssertExpectationsForObjects(t, persist, transact, conv, opSvc)
+
+			resolver := operation.NewResolver(transact, opSvc, conv)
+
+			// WHEN
+			result, err := resolver.RescheduleOperation(emptyCtx, operationID, testCase.Priority)
+
+			// THEN
+			if testCase.ExpectedError != nil {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), testCase.ExpectedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, testCase.ExpectedOutput, result)
+		})
+	}
+}
+
+func TestResolver_ScheduleORDAggregation(t *testing.T) {
+	appID := "app-id"
+	appTemplateID := "app-template-id"
+	opModel := fixOperationModel(ordOpType, model.OperationStatusScheduled)
+	gqlOp := fixGQLOperation("[]", str.Ptr("[]"))
+
+	inputMatcher := func(expectedData string) interface{} {
+		return mock.MatchedBy(func(in *model.OperationInput) bool {
+			return in.OpType == operationsmanager.OrdAggregationOpType && in.Status == model.OperationStatusScheduled &&
+				string(in.Data) == expectedData && in.Priority == 100
+		})
+	}
+
+	testCases := []struct {
+		Name            string
+		TransactionerFn func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ConverterFn     func() *automock.Converter
+		OpSvcFn         func() *automock.OperationService
+		AppID           *string
+		AppTemplateID   *string
+		ExpectedOutput  *graphql.Operation
+		ExpectedError   error
+	}{
+		{
+			Name:            "Success for application",
+			TransactionerFn: txGen.ThatSucceeds,
+			ConverterFn: func() *automock.Converter {
+				conv := &automock.Converter{}
+				conv.On("ToGraphQL", opModel).Return(gqlOp).Once()
+				return conv
+			},
+			OpSvcFn: func() *automock.OperationService {
+				opSvc := &automock.OperationService{}
+				opSvc.On("Create", txtest.CtxWithDBMatcher(), inputMatcher(fixORDOperationData(appID, ""))).Return(operationID, nil).Once()
+				opSvc.On("Get", txtest.CtxWithDBMatcher(), operationID).Return(opModel, nil).Once()
+				return opSvc
+			},
+			AppID:          &appID,
+			ExpectedOutput: gqlOp,
+		},
+		{
+			Name:            "Success for application template",
+			TransactionerFn: txGen.ThatSucceeds,
+			ConverterFn: func() *automock.Converter {
+				conv := &automock.Converter{}
+				conv.On("ToGraphQL", opModel).Return(gqlOp).Once()
+				return conv
+			},
+			OpSvcFn: func() *automock.OperationService {
+				opSvc := &automock.OperationService{}
+				opSvc.On("Create", txtest.CtxWithDBMatcher(), inputMatcher(fixORDOperationData("", appTemplateID))).Return(operationID, nil).Once()
+				opSvc.On("Get", txtest.CtxWithDBMatcher(), operationID).Return(opModel, nil).Once()
+				return opSvc
+			},
+			AppTemplateID:  &appTemplateID,
+			ExpectedOutput: gqlOp,
+		},
+		{
+			Name:            "Success for application in application template context",
+			TransactionerFn: txGen.ThatSucceeds,
+			ConverterFn: func() *automock.Converter {
+				conv := &automock.Converter{}
+				conv.On("ToGraphQL", opModel).Return(gqlOp).Once()
+				return conv
+			},
+			OpSvcFn: func() *automock.OperationService {
+				opSvc := &automock.OperationService{}
+				opSvc.On("Create", txtest.CtxWithDBMatcher(), inputMatcher(fixORDOperationData(appID, appTemplateID))).Return(operationID, nil).Once()
+				opSvc.On("Get", txtest.CtxWithDBMatcher(), operationID).Return(opModel, nil).Once()
+				return opSvc
+			},
+			AppID:          &appID,
+			AppTemplateID:  &appTemplateID,
+			ExpectedOutput: gqlOp,
+		},
+		{
+			Name:          "Error when neither application nor application template is provided",
+			ExpectedError: errors.New("at least one of appID and appTemplateID has to be provided"),
+		},
+		{
+			Name:            "Error when transaction fails to begin",
+			TransactionerFn: txGen.ThatFailsOnBegin,
+			AppID:           &appID,
+			ExpectedError:   testErr,
+		},
+		{
+			Name:            "Error when creating operation fails",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			OpSvcFn: func() *automock.OperationService {
+				opSvc := &automock.OperationService{}
+				opSvc.On("Create", txtest.CtxWithDBMatcher(), inputMatcher(fixORDOperationData(appID, ""))).Return("", testErr).Once()
+				return opSvc
+			},
+			AppID:         &appID,
+			ExpectedError: testErr,
+		},
+		{
+			Name:            "Error when getting created operation fails",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			OpSvcFn: func() *automock.OperationService {
+				opSvc := &automock.OperationService{}
+				opSvc.On("Create", txtest.CtxWithDBMatcher(), inputMatcher(fixORDOperationData(appID, ""))).Return(operationID, nil).Once()
+				opSvc.On("Get", txtest.CtxWithDBMatcher(), operationID).Return(nil, testErr).Once()
+				return opSvc
+			},
+			AppID:         &appID,
+			ExpectedError: testErr,
+		},
+		{
+			Name:            "Error when committing the transaction fails",
+			TransactionerFn: txGen.ThatFailsOnCommit,
+			OpSvcFn: func() *automock.OperationService {
+				opSvc := &automock.OperationService{}
+				opSvc.On("Create", txtest.CtxWithDBMatcher(), inputMatcher(fixORDOperationData(appID, ""))).Return(operationID, nil).Once()
+				opSvc.On("Get", txtest.CtxWithDBMatcher(), operationID).Return(opModel, nil).Once()
+				return opSvc
+			},
+			AppID:         &appID,
+			ExpectedError: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			persist, transact, conv, opSvc := fixResolverDependencies(testCase.TransactionerFn, testCase.ConverterFn, testCase.OpSvcFn)
+			defer mock.AssertExpectationsForObjects(t, persist, transact, conv, opSvc)
+
+			resolver := operation.NewResolver(transact, opSvc, conv)
+
+			// WHEN
+			result, err := resolver.ScheduleORDAggregation(emptyCtx, testCase.AppID, testCase.AppTemplateID)
+
+			// THEN
+			if testCase.ExpectedError != nil {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), testCase.ExpectedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, testCase.ExpectedOutput, result)
+		})
+	}
+}
+
+func fixResolverDependencies(transactionerFn func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner), converterFn func() *automock.Converter, opSvcFn func() *automock.OperationService) (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner, *automock.Converter, *automock.OperationService) {
+	persist, transact := &persistenceautomock.PersistenceTx{}, &persistenceautomock.Transactioner{}
+	if transactionerFn != nil {
+		persist, transact = transactionerFn()
+	}
+
+	conv := &automock.Converter{}
+	if converterFn != nil {
+		conv = converterFn()
+	}
+
+	opSvc := &automock.OperationService{}
+	if opSvcFn != nil {
+		opSvc = opSvcFn()
+	}
+
+	return persist, transact, conv, opSvc
+}
+
+func fixORDOperationData(appID, appTemplateID string) string {
+	data, err := json.Marshal(operationsmanager.NewOrdOperationData(appID, appTemplateID))
+	if err != nil {
+		panic(err)
+	}
+	return string(data)
+}
@@ -2,9 +2,13 @@ package operation
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"time"
 
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
 
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/pkg/errors"
@@ -15,6 +19,15 @@ import (
 //go:generate mockery --name=OperationRepository --output=automock --outpkg=automock --case=underscore --disable-version-string
 type OperationRepository interface {
 	Create(ctx context.Context, model *model.Operation) error
+	Get(ctx context.Context, id string) (*model.Operation, error)
+	GetForUpdate(ctx context.Context, id string) (*model.Operation, error)
+	List(ctx context.Context, opType string, status model.OperationStatus, pageSize int, cursor string) (*model.OperationPage, error)
+	Update(ctx context.Context, model *model.Operation) error
+	GetLatest(ctx context.Context, opType string) (*model.Operation, error)
+	LockType(ctx context.Context, opType string) error
+	GetNextScheduledForUpdate(ctx context.Context, opType string) (*model.Operation, error)
+	GetOldestUnfinishedForUpdate(ctx context.Context, opType string) (*model.Operation, error)
+	RescheduleHangedOperations(ctx context.Context, opType string, lastUpdateBefore time.Time) error
 	DeleteOlderThan(ctx context.Context, opType string, status model.OperationStatus, date time.Time) error
 }
 
@@ -25,6 +38,11 @@ type UIDService interface {
 	Generate() string
 }
 
+// OperationError represents the error details persisted for a failed operation
+type OperationError struct {
+	ErrorMsg string `json:"error"`
+}
+
 type service struct {
 	opRepo     OperationRepository
 	uidService UIDService
@@ -38,17 +56,17 @@ func NewService(opRepo OperationRepository, uidService UIDService) *service {
 	}
 }
 
-// Create creates new operation entity
-func (s *service) Create(ctx context.Context, in *model.OperationInput) error {
+// Create creates new operation entity and returns its id
+func (s *service) Create(ctx context.Context, in *model.OperationInput) (string, error) {
 	id := s.uidService.Generate()
 	op := in.ToOperation(id)
 
 	if err := s.opRepo.Create(ctx, op); err != nil {
-		return errors.Wrapf(err, "error occurred while creating an Operation with id %s and type %s", op.ID, op.OpType)
+		return "", errors.Wrapf(err, "error occurred while creating an Operation with id %s and type %s", op.ID, op.OpType)
 	}
 
 	log.C(ctx).Infof("Successfully created an Operation with id %s and type %s", op.ID, op.OpType)
-	return nil
+	return id, nil
 }
 
 // CreateMultiple creates multiple operations
@@ -62,7 +80,7 @@ func (s *service) CreateMultiple(ctx context.Context, in []*model.OperationInput
 			continue
 		}
 
-		if err := s.Create(ctx, op); err != nil {
+		if _, err := s.Create(ctx, op); err != nil {
 			return err
 		}
 	}
@@ -70,6 +88,174 @@ func (s *service) CreateMultiple(ctx context.Context, in []*model.OperationInput
 	return nil
 }
 
+// Get retrieves the operation with the provided id
+func (s *service) Get(ctx context.Context, id string) (*model.Operation, error) {
+	op, err := s.opRepo.Get(ctx, id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while getting Operation with id %s", id)
+	}
+
+	return op, nil
+}
+
+// GetLatest retrieves the most recently created operation of type `opType`. If there are no such operations a NotFound error is returned.
+func (s *service) GetLatest(ctx context.Context, opType string) (*model.Operation, error) {
+	op, err := s.opRepo.GetLatest(ctx, opType)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while getting latest Operation of type %s", opType)
+	}
+
+	return op, nil
+}
+
+// LockType takes a lock for the operations of type `opType` which is held until the end of the transaction.
+// It is used to serialize the callers which check the existing operations of the type before creating a new one.
+func (s *service) LockType(ctx context.Context, opType string) error {
+	if err := s.opRepo.LockType(ctx, opType); err != nil {
+		return errors.Wrapf(err, "while locking Operations of type %s", opType)
+	}
+
+	return nil
+}
+
+// List returns a page of operations optionally filtered by type and status
+func (s *service) List(ctx context.Context, opType string, status model.OperationStatus, pageSize int, cursor string) (*model.OperationPage, error) {
+	if pageSize < 1 || pageSize > 200 {
+		return nil, apperrors.NewInvalidDataError("page size must be between 1 and 200")
+	}
+
+	opPage, err := s.opRepo.List(ctx, opType, status, pageSize, cursor)
+	if err != nil {
+		return nil, errors.Wrap(err, "while listing Operations")
+	}
+
+	return opPage, nil
+}
+
+// Reschedule moves the operation with the provided id back to SCHEDULED status, so that it is processed again.
+// If `priority` is provided the priority of the operation is changed as well. Operations which are IN_PROGRESS can not be rescheduled.
+// It has to be called in a transaction, as the operation is locked until the end of it, so that it can not be claimed while it is rescheduled.
+func (s *service) Reschedule(ctx context.Context, id string, priority *int) (*model.Operation, error) {
+	op, err := s.opRepo.GetForUpdate(ctx, id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while getting Operation with id %s for update", id)
+	}
+
+	if op.Status == model.OperationStatusInProgress {
+		return nil, apperrors.NewInvalidOperationError(fmt.Sprintf("operation with id %s is in progress and can not be rescheduled", id))
+	}
+
+	now := time.Now()
+	op.Status = model.OperationStatusScheduled
+	op.Error = nil
+	op.UpdatedAt = &now
+	op.FinishedAt = nil
+	op.ClaimToken = ""
+	op.Attempts = 0
+	if priority != nil {
+		op.Priority = *priority
+	}
+
+	if err := s.opRepo.Update(ctx, op); err != nil {
+		return nil, errors.Wrapf(err, "while rescheduling Operation with id %s", id)
+	}
+
+	log.C(ctx).Infof("Operation with id %s and type %s rescheduled with priority %d", op.ID, op.OpType, op.Priority)
+	return op, nil
+}
+
+// ClaimNextScheduled takes the SCHEDULED operation of type `opType` with the highest priority and moves it to IN_PROGRESS status.
+// The claimed operation gets a new claim token which has to be provided when its lease is extended or when it is finished.
+// Operations which were already claimed `maxAttempts` times are moved to FAILED status instead of being claimed again. A non-positive `maxAttempts` disables the limit.
+// It has to be called in a transaction in order for the claim to be exclusive among concurrent consumers.
+func (s *service) ClaimNextScheduled(ctx context.Context, opType string, maxAttempts int) (*model.Operation, error) {
+	return s.claimNext(ctx, maxAttempts, func() (*model.Operation, error) {
+		return s.opRepo.GetNextScheduledForUpdate(ctx, opType)
+	})
+}
+
+// ClaimNextInOrder claims the operations of type `opType` strictly one at a time and in the order of their creation.
+// The oldest unfinished operation is claimed only if it is SCHEDULED. While it is IN_PROGRESS a NotFound error is returned,
+// so that the following operations are not processed before it is finished.
+// Operations which were already claimed `maxAttempts` times are moved to FAILED status and the next one is claimed instead.
+// It has to be called in a transaction in order for the claim to be exclusive among concurrent consumers.
+func (s *service) ClaimNextInOrder(ctx context.Context, opType string, maxAttempts int) (*model.Operation, error) {
+	return s.claimNext(ctx, maxAttempts, func() (*model.Operation, error) {
+		op, err := s.opRepo.GetOldestUnfinishedForUpdate(ctx, opType)
+		if err != nil {
+			return nil, err
+		}
+
+		if op.Status != model.OperationStatusScheduled {
+			log.C(ctx).Debugf("Operation with id %s and type %s is still in status %s", op.ID, op.OpType, op.Status)
+			return nil, apperrors.NewNotFoundErrorWithType(resource.Operation)
+		}
+
+		return op, nil
+	})
+}
+
+// Heartbeat extends the lease of an IN_PROGRESS operation by updating its last update time.
+// The lease can be extended only by the consumer holding the provided claim token.
+func (s *service) Heartbeat(ctx context.Context, id, claimToken string) error {
+	op, err := s.getClaimed(ctx, id, claimToken)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	op.UpdatedAt = &now
+
+	if err := s.opRepo.Update(ctx, op); err != nil {
+		return errors.Wrapf(err, "while updating heartbeat of Operation with id %s", id)
+	}
+
+	return nil
+}
+
+// SetData replaces the data of the operation with the provided id. It is used by consumers to store the result of the processing.
+// The data can be set only by the consumer holding the provided claim token.
+func (s *service) SetData(ctx context.Context, id, claimToken string, data json.RawMessage) error {
+	op, err := s.getClaimed(ctx, id, claimToken)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	op.Data = data
+	op.UpdatedAt = &now
+
+	if err := s.opRepo.Update(ctx, op); err != nil {
+		return errors.Wrapf(err, "while updating data of Operation with id %s", id)
+	}
+
+	return nil
+}
+
+// MarkAsCompleted moves the operation with the provided id to COMPLETED status if it is still claimed with the provided claim token
+func (s *service) MarkAsCompleted(ctx context.Context, id, claimToken string) error {
+	return s.finish(ctx, id, claimToken, model.OperationStatusCompleted, nil)
+}
+
+// MarkAsFailed moves the operation with the provided id to FAILED status and stores the provided error message if it is still claimed with the provided claim token
+func (s *service) MarkAsFailed(ctx context.Context, id, claimToken, errorMsg string) error {
+	opErr, err := json.Marshal(OperationError{ErrorMsg: errorMsg})
+	if err != nil {
+		return errors.Wrapf(err, "while marshalling error for Operation with id %s", id)
+	}
+
+	return s.finish(ctx, id, claimToken, model.OperationStatusFailed, opErr)
+}
+
+// RescheduleHangedOperations moves all IN_PROGRESS operations of type `opType` which were not updated during the last `hangPeriod` back to SCHEDULED status
+func (s *service) RescheduleHangedOperations(ctx context.Context, opType string, hangPeriod time.Duration) error {
+	if err := s.opRepo.RescheduleHangedOperations(ctx, opType, time.Now().Add(-1*hangPeriod)); err != nil {
+		return errors.Wrapf(err, "while rescheduling hanged Operations of type %s", opType)
+	}
+
+	return nil
+}
+
 // DeleteOlderThan deletes all operations of type `opType` with status `status` older than `days`
 func (s *service) DeleteOlderThan(ctx context.Context, opType string, status model.OperationStatus, days int) error {
 	if err := s.opRepo.DeleteOlderThan(ctx, opType, status, time.Now().AddDate(0, 0, -1*days)); err != nil {
@@ -78,3 +264,88 @@ func (s *service) DeleteOlderThan(ctx context.Context, opType string, status mod
 
 	return nil
 }
+
+func (s *service) finish(ctx context.Context, id, claimToken string, status model.OperationStatus, opErr json.RawMessage) error {
+	op, err := s.getClaimed(ctx, id, claimToken)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	op.Status = status
+	op.Error = opErr
+	op.UpdatedAt = &now
+	op.FinishedAt = &now
+
+	if err := s.opRepo.Update(ctx, op); err != nil {
+		return errors.Wrapf(err, "while moving Operation with id %s to status %s", id, status)
+	}
+
+	log.C(ctx).Infof("Operation with id %s and type %s moved to status %s", op.ID, op.OpType, status)
+	return nil
+}
+
+// getClaimed returns the operation with the provided id locked until the end of the transaction.
+// It fails if the operation is not IN_PROGRESS or is claimed with a different token, which happens when the lease of the caller expired
+// and the operation was rescheduled and claimed by another consumer.
+func (s *service) getClaimed(ctx context.Context, id, claimToken string) (*model.Operation, error) {
+	op, err := s.opRepo.GetForUpdate(ctx, id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while getting Operation with id %s", id)
+	}
+
+	if op.Status != model.OperationStatusInProgress || op.ClaimToken != claimToken {
+		return nil, apperrors.NewInvalidOperationError(fmt.Sprintf("operation with id %s is in status %s and is no longer claimed by the caller", id, op.Status))
+	}
+
+	return op, nil
+}
+
+func (s *service) claimNext(ctx context.Context, maxAttempts int, nextFn func() (*model.Operation, error)) (*model.Operation, error) {
+	for {
+		op, err := nextFn()
+		if err != nil {
+			return nil, err
+		}
+
+		now := time.Now()
+		op.UpdatedAt = &now
+
+		if maxAttempts > 0 && op.Attempts >= maxAttempts {
+			if err := s.failExhausted(ctx, op, maxAttempts); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		op.Status = model.OperationStatusInProgress
+		op.ClaimToken = s.uidService.Generate()
+		op.Attempts++
+
+		if err := s.opRepo.Update(ctx, op); err != nil {
+			return nil, errors.Wrapf(err, "while claiming Operation with id %s", op.ID)
+		}
+
+		log.C(ctx).Infof("Claimed Operation with id %s and type %s for attempt %d", op.ID, op.OpType, op.Attempts)
+		return op, nil
+	}
+}
+
+func (s *service) failExhausted(ctx context.Context, op *model.Operation, maxAttempts int) error {
+	opErr, err := json.Marshal(OperationError{ErrorMsg: fmt.Sprintf("operation was not completed after %d attempts", maxAttempts)})
+	if err != nil {
+		return errors.Wrapf(err, "while marshalling error for Operation with id %s", op.ID)
+	}
+
+	op.Status = model.OperationStatusFailed
+	op.Error = opErr
+	op.FinishedAt = op.UpdatedAt
+	op.ClaimToken = ""
+
+	if err := s.opRepo.Update(ctx, op); err != nil {
+		return errors.Wrapf(err, "while failing Operation with id %s", op.ID)
+	}
+
+	log.C(ctx).Warnf("Operation with id %s and type %s was moved to status %s after %d attempts", op.ID, op.OpType, op.Status, maxAttempts)
+	return nil
+}
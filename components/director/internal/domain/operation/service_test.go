@@ -2,11 +2,16 @@ package operation_test
 
 import (
 	"context"
+	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/operation"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/operation/automock"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -68,14 +73,16 @@ func TestService_Create(t *testing.T) {
 			svc := operation.NewService(repo, uidService)
 
 			// WHEN
-			err := svc.Create(ctx, &testCase.Input)
+			id, err := svc.Create(ctx, &testCase.Input)
 
 			// THEN
 			if testCase.ExpectedErr != nil {
 				require.Error(t, err)
 				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+				assert.Empty(t, id)
 			} else {
 				assert.Nil(t, err)
+				assert.Equal(t, operationID, id)
 			}
 
 			mock.AssertExpectationsForObjects(t, repo, uidService)
@@ -238,3 +245,920 @@ func TestService_DeleteOlderThan(t *testing.T) {
 		})
 	}
 }
+
+func TestService_Get(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("Test error")
+	ctx := context.TODO()
+	opModel := fixOperationModel(ordOpType, model.OperationStatusScheduled)
+
+	testCases := []struct {
+		Name         string
+		RepositoryFn func() *automock.OperationRepository
+		ExpectedOp   *model.Operation
+		ExpectedErr  error
+	}{
+		{
+			Name: "Success",
+			RepositoryFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("Get", ctx, operationID).Return(opModel, nil).Once()
+				return repo
+			},
+			ExpectedOp: opModel,
+		},
+		{
+			Name: "Error while getting operation",
+			RepositoryFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("Get", ctx, operationID).Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			repo := testCase.RepositoryFn()
+
+			svc := operation.NewService(repo, nil)
+
+			// WHEN
+			op, err := svc.Get(ctx, operationID)
+
+			// THEN
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+				assert.Nil(t, op)
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, testCase.ExpectedOp, op)
+			}
+
+			mock.AssertExpectationsForObjects(t, repo)
+		})
+	}
+}
+
+func TestService_GetLatest(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("Test error")
+	ctx := context.TODO()
+	opModel := fixOperationModel(ordOpType, model.OperationStatusScheduled)
+
+	testCases := []struct {
+		Name         string
+		RepositoryFn func() *automock.OperationRepository
+		ExpectedOp   *model.Operation
+		ExpectedErr  error
+	}{
+		{
+			Name: "Success",
+			RepositoryFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("GetLatest", ctx, ordOpType).Return(opModel, nil).Once()
+				return repo
+			},
+			ExpectedOp: opModel,
+		},
+		{
+			Name: "Error while getting operation",
+			RepositoryFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("GetLatest", ctx, ordOpType).Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			repo := testCase.RepositoryFn()
+
+			svc := operation.NewService(repo, nil)
+
+			// WHEN
+			op, err := svc.GetLatest(ctx, ordOpType)
+
+			// THEN
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+				assert.Nil(t, op)
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, testCase.ExpectedOp, op)
+			}
+
+			mock.AssertExpectationsForObjects(t, repo)
+		})
+	}
+}
+
+func TestService_LockType(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("Test error")
+	ctx := context.TODO()
+
+	testCases := []struct {
+		Name         string
+		RepositoryFn func() *automock.OperationRepository
+		ExpectedErr  error
+	}{
+		{
+			Name: "Success",
+			RepositoryFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("LockType", ctx, ordOpType).Return(nil).Once()
+				return repo
+			},
+		},
+		{
+			Name: "Error while locking operations",
+			RepositoryFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("LockType", ctx, ordOpType).Return(testErr).Once()
+				return repo
+			},
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			repo := testCase.RepositoryFn()
+
+			svc := operation.NewService(repo, nil)
+
+			// WHEN
+			err := svc.LockType(ctx, ordOpType)
+
+			// THEN
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				assert.Nil(t, err)
+			}
+
+			mock.AssertExpectationsForObjects(t, repo)
+		})
+	}
+}
+
+func TestService_ClaimNextScheduled(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("Test error")
+	ctx := context.TODO()
+	maxAttempts := 3
+
+	exhaustedOp := func() *model.Operation {
+		op := fixOperationModelWithID("exhausted", ordOpType, model.OperationStatusScheduled)
+		op.Attempts = maxAttempts
+		return op
+	}
+
+	testCases := []struct {
+		Name             string
+		RepositoryFn     func() *automock.OperationRepository
+		ExpectedID       string
+		ExpectedAttempts int
+		ExpectedErr      error
+	}{
+		{
+			Name: "Success",
+			RepositoryFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("GetNextScheduledForUpdate", ctx, ordOpType).Return(fixOperationModel(ordOpType, model.OperationStatusScheduled), nil).Once()
+				repo.On("Update", ctx, mock.MatchedBy(func(op *model.Operation) bool {
+					return op.Status == model.OperationStatusInProgress && op.ClaimToken == claimToken && op.Attempts == 1
+				})).Return(nil).Once()
+				return repo
+			},
+			ExpectedID:       operationID,
+			ExpectedAttempts: 1,
+		},
+		{
+			Name: "Success - operations which reached the max attempts are failed",
+			RepositoryFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("GetNextScheduledForUpdate", ctx, ordOpType).Return(exhaustedOp(), nil).Once()
+				repo.On("Update", ctx, mock.MatchedBy(func(op *model.Operation) bool {
+					return op.ID == "exhausted" && op.Status == model.OperationStatusFailed && op.FinishedAt != nil &&
+						string(op.Error) == `{"error":"operation was not completed after 3 attempts"}`
+				})).Return(nil).Once()
+				repo.On("GetNextScheduledForUpdate", ctx, ordOpType).Return(fixOperationModel(ordOpType, model.OperationStatusScheduled), nil).Once()
+				repo.On("Update", ctx, mock.MatchedBy(isOperationInStatus(model.OperationStatusInProgress))).Return(nil).Once()
+				return repo
+			},
+			ExpectedID:       operationID,
+			ExpectedAttempts: 1,
+		},
+		{
+			Name: "Error while getting next scheduled operation",
+			RepositoryFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("GetNextScheduledForUpdate", ctx, ordOpType).Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name: "Error while failing operation which reached the max attempts",
+			RepositoryFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("GetNextScheduledForUpdate", ctx, ordOpType).Return(exhaustedOp(), nil).Once()
+				repo.On("Update", ctx, mock.MatchedBy(isOperationInStatus(model.OperationStatusFailed))).Return(testErr).Once()
+				return repo
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name: "Error while updating operation",
+			RepositoryFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("GetNextScheduledForUpdate", ctx, ordOpType).Return(fixOperationModel(ordOpType, model.OperationStatusScheduled), nil).Once()
+				repo.On("Update", ctx, mock.MatchedBy(isOperationInStatus(model.OperationStatusInProgress))).Return(testErr).Once()
+				return repo
+			},
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			repo := testCase.RepositoryFn()
+			uidSvc := &automock.UIDService{}
+			uidSvc.On("Generate").Return(claimToken).Maybe()
+
+			svc := operation.NewService(repo, uidSvc)
+
+			// WHEN
+			op, err := svc.ClaimNextScheduled(ctx, ordOpType, maxAttempts)
+
+			// THEN
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+				assert.Nil(t, op)
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, testCase.ExpectedID, op.ID)
+				assert.Equal(t, model.OperationStatusInProgress, op.Status)
+				assert.Equal(t, claimToken, op.ClaimToken)
+				assert.Equal(t, testCase.ExpectedAttempts, op.Attempts)
+				assert.NotEqual(t, &time.Time{}, op.UpdatedAt)
+			}
+
+			mock.AssertExpectationsForObjects(t, repo)
+		})
+	}
+}
+
+func TestService_ClaimNextInOrder(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("Test error")
+	ctx := context.TODO()
+	maxAttempts := 3
+
+	exhaustedOp := func() *model.Operation {
+		op := fixOperationModelWithID("exhausted", ordOpType, model.OperationStatusScheduled)
+		op.Attempts = maxAttempts
+		return op
+	}
+
+	testCases := []struct {
+		Name         string
+		RepositoryFn func() *automock.OperationRepository
+		ExpectedID   string
+		ExpectedErr  error
+	}{
+		{
+			Name: "Success",
+			RepositoryFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("GetOldestUnfinishedForUpdate", ctx, ordOpType).Return(fixOperationModel(ordOpType, model.OperationStatusScheduled), nil).Once()
+				repo.On("Update", ctx, mock.MatchedBy(func(op *model.Operation) bool {
+					return op.Status == model.OperationStatusInProgress && op.ClaimToken == claimToken && op.Attempts == 1
+				})).Return(nil).Once()
+				return repo
+			},
+			ExpectedID: operationID,
+		},
+		{
+			Name: "Success - operations which reached the max attempts are failed",
+			RepositoryFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("GetOldestUnfinishedForUpdate", ctx, ordOpType).Return(exhaustedOp(), nil).Once()
+				repo.On("Update", ctx, mock.MatchedBy(func(op *model.Operation) bool {
+					return op.ID == "exhausted" && op.Status == model.OperationStatusFailed
+				})).Return(nil).Once()
+				repo.On("GetOldestUnfinishedForUpdate", ctx, ordOpType).Return(fixOperationModel(ordOpType, model.OperationStatusScheduled), nil).Once()
+				repo.On("Update", ctx, mock.MatchedBy(isOperationInStatus(model.OperationStatusInProgress))).Return(nil).Once()
+				return repo
+			},
+			ExpectedID: operationID,
+		},
+		{
+			Name: "Returns NotFound error while the oldest operation is in progress",
+			RepositoryFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("GetOldestUnfinishedForUpdate", ctx, ordOpType).Return(fixOperationModel(ordOpType, model.OperationStatusInProgress), nil).Once()
+				return repo
+			},
+			ExpectedErr: apperrors.NewNotFoundErrorWithType(resource.Operation),
+		},
+		{
+			Name: "Error while getting oldest unfinished operation",
+			RepositoryFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("GetOldestUnfinishedForUpdate", ctx, ordOpType).Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name: "Error while updating operation",
+			RepositoryFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("GetOldestUnfinishedForUpdate", ctx, ordOpType).Return(fixOperationModel(ordOpType, model.OperationStatusScheduled), nil).Once()
+				repo.On("Update", ctx, mock.MatchedBy(isOperationInStatus(model.OperationStatusInProgress))).Return(testErr).Once()
+				return repo
+			},
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			repo := testCase.RepositoryFn()
+			uidSvc := &automock.UIDService{}
+			uidSvc.On("Generate").Return(claimToken).Maybe()
+
+			svc := operation.NewService(repo, uidSvc)
+
+			// WHEN
+			op, err := svc.ClaimNextInOrder(ctx, ordOpType, maxAttempts)
+
+			// THEN
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+				assert.Nil(t, op)
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, testCase.ExpectedID, op.ID)
+				assert.Equal(t, model.OperationStatusInProgress, op.Status)
+				assert.Equal(t, claimToken, op.ClaimToken)
+			}
+
+			mock.AssertExpectationsForObjects(t, repo)
+		})
+	}
+}
+
+func TestService_Heartbeat(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("Test error")
+	ctx := context.TODO()
+
+	testCases := []struct {
+		Name         string
+		RepositoryFn func() *automock.OperationRepository
+		ExpectedErr  error
+	}{
+		{
+			Name: "Success",
+			RepositoryFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("GetForUpdate", ctx, operationID).Return(fixClaimedOperationModel(claimToken), nil).Once()
+				repo.On("Update", ctx, mock.MatchedBy(isOperationInStatus(model.OperationStatusInProgress))).Return(nil).Once()
+				return repo
+			},
+		},
+		{
+			Name: "Error while getting operation",
+			RepositoryFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("GetForUpdate", ctx, operationID).Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name: "Error when operation is not in progress",
+			RepositoryFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("GetForUpdate", ctx, operationID).Return(fixOperationModel(ordOpType, model.OperationStatusCompleted), nil).Once()
+				return repo
+			},
+			ExpectedErr: errors.New("is no longer claimed by the caller"),
+		},
+		{
+			Name: "Error when operation is claimed by another consumer",
+			RepositoryFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("GetForUpdate", ctx, operationID).Return(fixClaimedOperationModel("another-token"), nil).Once()
+				return repo
+			},
+			ExpectedErr: errors.New("is no longer claimed by the caller"),
+		},
+		{
+			Name: "Error while updating operation",
+			RepositoryFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("GetForUpdate", ctx, operationID).Return(fixClaimedOperationModel(claimToken), nil).Once()
+				repo.On("Update", ctx, mock.MatchedBy(isOperationInStatus(model.OperationStatusInProgress))).Return(testErr).Once()
+				return repo
+			},
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			repo := testCase.RepositoryFn()
+
+			svc := operation.NewService(repo, nil)
+
+			// WHEN
+			err := svc.Heartbeat(ctx, operationID, claimToken)
+
+			// THEN
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				assert.Nil(t, err)
+			}
+
+			mock.AssertExpectationsForObjects(t, repo)
+		})
+	}
+}
+
+func TestService_SetData(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("Test error")
+	ctx := context.TODO()
+	data := json.RawMessage(`{"key":"value"}`)
+
+	hasData := func(op *model.Operation) bool {
+		return string(op.Data) == string(data)
+	}
+
+	testCases := []struct {
+		Name         string
+		RepositoryFn func() *automock.OperationRepository
+		ExpectedErr  error
+	}{
+		{
+			Name: "Success",
+			RepositoryFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("GetForUpdate", ctx, operationID).Return(fixClaimedOperationModel(claimToken), nil).Once()
+				repo.On("Update", ctx, mock.MatchedBy(hasData)).Return(nil).Once()
+				return repo
+			},
+		},
+		{
+			Name: "Error while getting operation",
+			RepositoryFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("GetForUpdate", ctx, operationID).Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name: "Error when operation is claimed with a different token",
+			RepositoryFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("GetForUpdate", ctx, operationID).Return(fixClaimedOperationModel("other-token"), nil).Once()
+				return repo
+			},
+			ExpectedErr: errors.New("is no longer claimed by the caller"),
+		},
+		{
+			Name: "Error while updating operation",
+			RepositoryFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("GetForUpdate", ctx, operationID).Return(fixClaimedOperationModel(claimToken), nil).Once()
+				repo.On("Update", ctx, mock.MatchedBy(hasData)).Return(testErr).Once()
+				return repo
+			},
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			repo := testCase.RepositoryFn()
+
+			svc := operation.NewService(repo, nil)
+
+			// WHEN
+			err := svc.SetData(ctx, operationID, claimToken, data)
+
+			// THEN
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				assert.Nil(t, err)
+			}
+
+			mock.AssertExpectationsForObjects(t, repo)
+		})
+	}
+}
+
+func TestService_MarkAsCompleted(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("Test error")
+	ctx := context.TODO()
+
+	testCases := []struct {
+		Name         string
+		RepositoryFn func() *automock.OperationRepository
+		ExpectedErr  error
+	}{
+		{
+			Name: "Success",
+			RepositoryFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("GetForUpdate", ctx, operationID).Return(fixClaimedOperationModel(claimToken), nil).Once()
+				repo.On("Update", ctx, mock.MatchedBy(func(op *model.Operation) bool {
+					return op.Status == model.OperationStatusCompleted && op.Error == nil && op.FinishedAt != nil
+				})).Return(nil).Once()
+				return repo
+			},
+		},
+		{
+			Name: "Error when operation is no longer in progress",
+			RepositoryFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("GetForUpdate", ctx, operationID).Return(fixOperationModel(ordOpType, model.OperationStatusScheduled), nil).Once()
+				return repo
+			},
+			ExpectedErr: errors.New("is no longer claimed by the caller"),
+		},
+		{
+			Name: "Error when operation is claimed by another consumer",
+			RepositoryFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("GetForUpdate", ctx, operationID).Return(fixClaimedOperationModel("another-token"), nil).Once()
+				return repo
+			},
+			ExpectedErr: errors.New("is no longer claimed by the caller"),
+		},
+		{
+			Name: "Error while getting operation",
+			RepositoryFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("GetForUpdate", ctx, operationID).Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name: "Error while updating operation",
+			RepositoryFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("GetForUpdate", ctx, operationID).Return(fixClaimedOperationModel(claimToken), nil).Once()
+				repo.On("Update", ctx, mock.MatchedBy(isOperationInStatus(model.OperationStatusCompleted))).Return(testErr).Once()
+				return repo
+			},
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			repo := testCase.RepositoryFn()
+
+			svc := operation.NewService(repo, nil)
+
+			// WHEN
+			err := svc.MarkAsCompleted(ctx, operationID, claimToken)
+
+			// THEN
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				assert.Nil(t, err)
+			}
+
+			mock.AssertExpectationsForObjects(t, repo)
+		})
+	}
+}
+
+func TestService_MarkAsFailed(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("Test error")
+	ctx := context.TODO()
+	errorMsg := "processing failed"
+
+	testCases := []struct {
+		Name         string
+		RepositoryFn func() *automock.OperationRepository
+		ExpectedErr  error
+	}{
+		{
+			Name: "Success",
+			RepositoryFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("GetForUpdate", ctx, operationID).Return(fixClaimedOperationModel(claimToken), nil).Once()
+				repo.On("Update", ctx, mock.MatchedBy(func(op *model.Operation) bool {
+					return op.Status == model.OperationStatusFailed && string(op.Error) == `{"error":"processing failed"}` && op.FinishedAt != nil
+				})).Return(nil).Once()
+				return repo
+			},
+		},
+		{
+			Name: "Error while getting operation",
+			RepositoryFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("GetForUpdate", ctx, operationID).Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name: "Error while updating operation",
+			RepositoryFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("GetForUpdate", ctx, operationID).Return(fixClaimedOperationModel(claimToken), nil).Once()
+				repo.On("Update", ctx, mock.MatchedBy(isOperationInStatus(model.OperationStatusFailed))).Return(testErr).Once()
+				return repo
+			},
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			repo := testCase.RepositoryFn()
+
+			svc := operation.NewService(repo, nil)
+
+			// WHEN
+			err := svc.MarkAsFailed(ctx, operationID, claimToken, errorMsg)
+
+			// THEN
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				assert.Nil(t, err)
+			}
+
+			mock.AssertExpectationsForObjects(t, repo)
+		})
+	}
+}
+
+func TestService_RescheduleHangedOperations(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("Test error")
+	ctx := context.TODO()
+	hangPeriod := time.Hour
+
+	testCases := []struct {
+		Name         string
+		RepositoryFn func() *automock.OperationRepository
+		ExpectedErr  error
+	}{
+		{
+			Name: "Success",
+			RepositoryFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("RescheduleHangedOperations", ctx, ordOpType, mock.AnythingOfType("Time")).Return(nil).Once()
+				return repo
+			},
+		},
+		{
+			Name: "Error while rescheduling operations",
+			RepositoryFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("RescheduleHangedOperations", ctx, ordOpType, mock.AnythingOfType("Time")).Return(testErr).Once()
+				return repo
+			},
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			repo := testCase.RepositoryFn()
+
+			svc := operation.NewService(repo, nil)
+
+			// WHEN
+			err := svc.RescheduleHangedOperations(ctx, ordOpType, hangPeriod)
+
+			// THEN
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				assert.Nil(t, err)
+			}
+
+			mock.AssertExpectationsForObjects(t, repo)
+		})
+	}
+}
+
+func TestService_List(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("Test error")
+	ctx := context.TODO()
+	opPage := &model.OperationPage{
+		Data:       []*model.Operation{fixOperationModel(ordOpType, model.OperationStatusFailed)},
+		PageInfo:   &pagination.Page{},
+		TotalCount: 1,
+	}
+
+	testCases := []struct {
+		Name         string
+		RepositoryFn func() *automock.OperationRepository
+		PageSize     int
+		ExpectedPage *model.OperationPage
+		ExpectedErr  error
+	}{
+		{
+			Name: "Success",
+			RepositoryFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("List", ctx, ordOpType, model.OperationStatusFailed, 100, "").Return(opPage, nil).Once()
+				return repo
+			},
+			PageSize:     100,
+			ExpectedPage: opPage,
+		},
+		{
+			Name: "Error while listing operations",
+			RepositoryFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("List", ctx, ordOpType, model.OperationStatusFailed, 100, "").Return(nil, testErr).Once()
+				return repo
+			},
+			PageSize:    100,
+			ExpectedErr: testErr,
+		},
+		{
+			Name: "Error when page size is too big",
+			RepositoryFn: func() *automock.OperationRepository {
+				return &automock.OperationRepository{}
+			},
+			PageSize:    201,
+			ExpectedErr: errors.New("page size must be between 1 and 200"),
+		},
+		{
+			Name: "Error when page size is too small",
+			RepositoryFn: func() *automock.OperationRepository {
+				return &automock.OperationRepository{}
+			},
+			PageSize:    0,
+			ExpectedErr: errors.New("page size must be between 1 and 200"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			repo := testCase.RepositoryFn()
+
+			svc := operation.NewService(repo, nil)
+
+			// WHEN
+			page, err := svc.List(ctx, ordOpType, model.OperationStatusFailed, testCase.PageSize, "")
+
+			// THEN
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+				assert.Nil(t, page)
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, testCase.ExpectedPage, page)
+			}
+
+			mock.AssertExpectationsForObjects(t, repo)
+		})
+	}
+}
+
+func TestService_Reschedule(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("Test error")
+	ctx := context.TODO()
+	priority := 50
+
+	testCases := []struct {
+		Name             string
+		RepositoryFn     func() *automock.OperationRepository
+		Priority         *int
+		ExpectedPriority int
+		ExpectedErr      error
+	}{
+		{
+			Name: "Success with changed priority",
+			RepositoryFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("GetForUpdate", ctx, operationID).Return(fixOperationModel(ordOpType, model.OperationStatusFailed), nil).Once()
+				repo.On("Update", ctx, mock.MatchedBy(func(op *model.Operation) bool {
+					return op.Status == model.OperationStatusScheduled && op.Error == nil && op.FinishedAt == nil && op.Priority == priority
+				})).Return(nil).Once()
+				return repo
+			},
+			Priority:         &priority,
+			ExpectedPriority: priority,
+		},
+		{
+			Name: "Success without changed priority",
+			RepositoryFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("GetForUpdate", ctx, operationID).Return(fixOperationModel(ordOpType, model.OperationStatusCompleted), nil).Once()
+				repo.On("Update", ctx, mock.MatchedBy(isOperationInStatus(model.OperationStatusScheduled))).Return(nil).Once()
+				return repo
+			},
+			ExpectedPriority: 1,
+		},
+		{
+			Name: "Error when operation is in progress",
+			RepositoryFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("GetForUpdate", ctx, operationID).Return(fixOperationModel(ordOpType, model.OperationStatusInProgress), nil).Once()
+				return repo
+			},
+			ExpectedErr: errors.New("is in progress and can not be rescheduled"),
+		},
+		{
+			Name: "Error while getting operation",
+			RepositoryFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("GetForUpdate", ctx, operationID).Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name: "Error while updating operation",
+			RepositoryFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("GetForUpdate", ctx, operationID).Return(fixOperationModel(ordOpType, model.OperationStatusFailed), nil).Once()
+				repo.On("Update", ctx, mock.MatchedBy(isOperationInStatus(model.OperationStatusScheduled))).Return(testErr).Once()
+				return repo
+			},
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			repo := testCase.RepositoryFn()
+
+			svc := operation.NewService(repo, nil)
+
+			// WHEN
+			op, err := svc.Reschedule(ctx, operationID, testCase.Priority)
+
+			// THEN
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+				assert.Nil(t, op)
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, model.OperationStatusScheduled, op.Status)
+				assert.Equal(t, testCase.ExpectedPriority, op.Priority)
+			}
+
+			mock.AssertExpectationsForObjects(t, repo)
+		})
+	}
+}
+
+func isOperationInStatus(status model.OperationStatus) func(op *model.Operation) bool {
+	return func(op *model.Operation) bool {
+		return op.Status == status && op.UpdatedAt != nil
+	}
+}
@@ -0,0 +1,29 @@
+package ordresource
+
+import (
+	"encoding/json"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+)
+
+// MultipleToGraphQL converts the provided service-layer representations of ORD resources to the graphql-layer ones with the given conversion, skipping the nil ones.
+func MultipleToGraphQL[M any, G any](in []*M, toGraphQL func(*M) *G) []*G {
+	out := make([]*G, 0, len(in))
+	for _, item := range in {
+		if item == nil {
+			continue
+		}
+		out = append(out, toGraphQL(item))
+	}
+
+	return out
+}
+
+// JSONPtrFromRawMessage converts the provided raw JSON to its graphql-layer representation. Empty JSON is converted to nil.
+func JSONPtrFromRawMessage(in json.RawMessage) *graphql.JSON {
+	if len(in) == 0 {
+		return nil
+	}
+	out := graphql.JSON(in)
+	return &out
+}
@@ -0,0 +1,229 @@
+package ordresource
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/kyma-incubator/compass/components/director/pkg/scope"
+	"github.com/pkg/errors"
+)
+
+const (
+	appTemplateVersionIDColumn = "app_template_version_id"
+	appIDColumn                = "app_id"
+	packageIDColumn            = "package_id"
+	visibilityColumn           = "visibility"
+
+	internalVisibilityScope = "internal_visibility:read"
+	publicVisibilityValue   = "public"
+)
+
+// EntityConverter converts ORD resources between the service-layer representation M and the repo-layer representation E.
+type EntityConverter[M any, E any] interface {
+	ToEntity(in *M) *E
+	FromEntity(entity *E) (*M, error)
+}
+
+// Repository is responsible for the repo-layer operations of ORD resources which are part of a Package and are stored
+// either for an Application or for an Application Template Version, such as Entity Types and Capabilities.
+type Repository[M any, E any] struct {
+	resourceType       resource.Type
+	resourceName       string
+	conv               EntityConverter[M, E]
+	lister             repo.Lister
+	listerGlobal       repo.ListerGlobal
+	singleGetter       repo.SingleGetter
+	singleGetterGlobal repo.SingleGetterGlobal
+	deleter            repo.Deleter
+	deleterGlobal      repo.DeleterGlobal
+	creator            repo.Creator
+	creatorGlobal      repo.CreatorGlobal
+	updater            repo.Updater
+	updaterGlobal      repo.UpdaterGlobal
+}
+
+// NewRepository returns a new Repository for the ORD resources of the given type stored in the given table.
+// The resourceName is the human-readable name of the resource used in logs and errors.
+func NewRepository[M any, E any](resourceType resource.Type, resourceName, table string, columns, updatableColumns []string, conv EntityConverter[M, E]) *Repository[M, E] {
+	return &Repository[M, E]{
+		resourceType:       resourceType,
+		resourceName:       resourceName,
+		conv:               conv,
+		lister:             repo.NewLister(table, columns),
+		listerGlobal:       repo.NewListerGlobal(resourceType, table, columns),
+		singleGetter:       repo.NewSingleGetter(table, columns),
+		singleGetterGlobal: repo.NewSingleGetterGlobal(resourceType, table, columns),
+		deleter:            repo.NewDeleter(table),
+		deleterGlobal:      repo.NewDeleterGlobal(resourceType, table),
+		creator:            repo.NewCreator(table, columns),
+		creatorGlobal:      repo.NewCreatorGlobal(resourceType, table, columns),
+		updater:            repo.NewUpdater(table, updatableColumns, []string{"id"}),
+		updaterGlobal:      repo.NewUpdaterGlobal(resourceType, table, updatableColumns, []string{"id"}),
+	}
+}
+
+// Create creates a new ORD resource.
+func (r *Repository[M, E]) Create(ctx context.Context, tenant string, model *M) error {
+	if model == nil {
+		return apperrors.NewInternalError("model can not be nil")
+	}
+
+	entity := r.conv.ToEntity(model)
+	log.C(ctx).Debugf("Persisting %s entity with id %q", r.resourceName, entityID(entity))
+	return r.creator.Create(ctx, r.resourceType, tenant, entity)
+}
+
+// CreateGlobal creates a new ORD resource without tenant isolation.
+func (r *Repository[M, E]) CreateGlobal(ctx context.Context, model *M) error {
+	if model == nil {
+		return apperrors.NewInternalError("model can not be nil")
+	}
+
+	entity := r.conv.ToEntity(model)
+	log.C(ctx).Debugf("Persisting %s entity with id %q", r.resourceName, entityID(entity))
+	return r.creatorGlobal.Create(ctx, entity)
+}
+
+// Update updates an ORD resource.
+func (r *Repository[M, E]) Update(ctx context.Context, tenant string, model *M) error {
+	if model == nil {
+		return apperrors.NewInternalError("model can not be nil")
+	}
+
+	entity := r.conv.ToEntity(model)
+	log.C(ctx).Debugf("Updating %s entity with id %q", r.resourceName, entityID(entity))
+	return r.updater.UpdateSingle(ctx, r.resourceType, tenant, entity)
+}
+
+// UpdateGlobal updates an ORD resource without tenant isolation.
+func (r *Repository[M, E]) UpdateGlobal(ctx context.Context, model *M) error {
+	if model == nil {
+		return apperrors.NewInternalError("model can not be nil")
+	}
+
+	entity := r.conv.ToEntity(model)
+	log.C(ctx).Debugf("Updating %s entity with id %q", r.resourceName, entityID(entity))
+	return r.updaterGlobal.UpdateSingleGlobal(ctx, entity)
+}
+
+// Delete deletes an ORD resource by its ID.
+func (r *Repository[M, E]) Delete(ctx context.Context, tenant, id string) error {
+	log.C(ctx).Debugf("Deleting %s entity with id %q", r.resourceName, id)
+	return r.deleter.DeleteOne(ctx, r.resourceType, tenant, repo.Conditions{repo.NewEqualCondition("id", id)})
+}
+
+// DeleteGlobal deletes an ORD resource by its ID without tenant isolation.
+func (r *Repository[M, E]) DeleteGlobal(ctx context.Context, id string) error {
+	log.C(ctx).Debugf("Deleting %s entity with id %q", r.resourceName, id)
+	return r.deleterGlobal.DeleteOneGlobal(ctx, repo.Conditions{repo.NewEqualCondition("id", id)})
+}
+
+// GetByID retrieves an ORD resource by its ID.
+func (r *Repository[M, E]) GetByID(ctx context.Context, tenant, id string) (*M, error) {
+	log.C(ctx).Debugf("Getting %s entity with id %q", r.resourceName, id)
+	var entity E
+	if err := r.singleGetter.Get(ctx, r.resourceType, tenant, repo.Conditions{repo.NewEqualCondition("id", id)}, repo.NoOrderBy, &entity); err != nil {
+		return nil, err
+	}
+
+	model, err := r.conv.FromEntity(&entity)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while converting %s from Entity", r.resourceName)
+	}
+
+	return model, nil
+}
+
+// GetByIDGlobal retrieves an ORD resource by its ID without tenant isolation.
+func (r *Repository[M, E]) GetByIDGlobal(ctx context.Context, id string) (*M, error) {
+	log.C(ctx).Debugf("Getting %s entity with id %q", r.resourceName, id)
+	var entity E
+	if err := r.singleGetterGlobal.GetGlobal(ctx, repo.Conditions{repo.NewEqualCondition("id", id)}, repo.NoOrderBy, &entity); err != nil {
+		return nil, err
+	}
+
+	model, err := r.conv.FromEntity(&entity)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while converting %s from Entity", r.resourceName)
+	}
+
+	return model, nil
+}
+
+// ListByResourceID lists and locks the ORD resources of a given resource type and resource ID. It is meant to be used
+// when the resources are synchronized, so it does not hide the resources which are not public.
+func (r *Repository[M, E]) ListByResourceID(ctx context.Context, tenantID, resourceID string, resourceType resource.Type) ([]*M, error) {
+	entities := entityCollection[E]{}
+
+	var err error
+	if resourceType == resource.Application {
+		err = r.lister.ListWithSelectForUpdate(ctx, r.resourceType, tenantID, &entities, repo.NewEqualCondition(appIDColumn, resourceID))
+	} else {
+		err = r.listerGlobal.ListGlobalWithSelectForUpdate(ctx, &entities, repo.NewEqualCondition(appTemplateVersionIDColumn, resourceID))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return r.multipleFromEntities(entities)
+}
+
+// ListByApplicationIDs lists the ORD resources of the given Applications which are visible to the caller
+func (r *Repository[M, E]) ListByApplicationIDs(ctx context.Context, tenantID string, appIDs []string) ([]*M, error) {
+	return r.listVisible(ctx, tenantID, repo.NewInConditionForStringValues(appIDColumn, appIDs))
+}
+
+// ListByPackageIDs lists the ORD resources which are part of the given Packages and are visible to the caller
+func (r *Repository[M, E]) ListByPackageIDs(ctx context.Context, tenantID string, packageIDs []string) ([]*M, error) {
+	return r.listVisible(ctx, tenantID, repo.NewInConditionForStringValues(packageIDColumn, packageIDs))
+}
+
+func (r *Repository[M, E]) listVisible(ctx context.Context, tenantID string, condition repo.Condition) ([]*M, error) {
+	conditions := repo.Conditions{condition}
+
+	isInternalVisibilityScopePresent, err := scope.Contains(ctx, internalVisibilityScope)
+	if err != nil {
+		log.C(ctx).Infof("No scopes are present in the context meaning the flow is not user-initiated. Listing %ss without visibility check...", r.resourceType)
+		isInternalVisibilityScopePresent = true
+	}
+	if !isInternalVisibilityScopePresent {
+		log.C(ctx).Infof("No internal visibility scope is present in the context. Listing only public %ss...", r.resourceType)
+		conditions = append(conditions, repo.NewEqualCondition(visibilityColumn, publicVisibilityValue))
+	}
+
+	entities := entityCollection[E]{}
+	if err := r.lister.List(ctx, r.resourceType, tenantID, &entities, conditions...); err != nil {
+		return nil, err
+	}
+
+	return r.multipleFromEntities(entities)
+}
+
+func (r *Repository[M, E]) multipleFromEntities(entities entityCollection[E]) ([]*M, error) {
+	models := make([]*M, 0, entities.Len())
+	for i := range entities {
+		model, err := r.conv.FromEntity(&entities[i])
+		if err != nil {
+			return nil, err
+		}
+		models = append(models, model)
+	}
+	return models, nil
+}
+
+func entityID(entity interface{}) string {
+	if identifiable, ok := entity.(repo.Identifiable); ok {
+		return identifiable.GetID()
+	}
+	return ""
+}
+
+type entityCollection[E any] []E
+
+// Len returns the length of the collection
+func (c entityCollection[E]) Len() int {
+	return len(c)
+}
@@ -0,0 +1,61 @@
+package outbox
+
+import (
+	"encoding/json"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo"
+)
+
+type converter struct{}
+
+// NewConverter returns a new Converter that can later be used to make the conversions between the service and repository layer representations of an outbox entry.
+func NewConverter() *converter {
+	return &converter{}
+}
+
+// FromEntity converts the provided Entity repo-layer representation of an outbox entry to the service-layer representation model.OutboxEntry.
+func (c *converter) FromEntity(entity *Entity) *model.OutboxEntry {
+	if entity == nil {
+		return nil
+	}
+
+	return &model.OutboxEntry{
+		ID:             entity.ID,
+		IdempotencyKey: entity.IdempotencyKey,
+		Type:           model.OutboxEntryType(entity.Type),
+		TenantID:       entity.TenantID,
+		CorrelationID:  entity.CorrelationID.String,
+		Payload:        json.RawMessage(entity.Payload),
+		Status:         model.OutboxEntryStatus(entity.Status),
+		Attempts:       entity.Attempts,
+		NextAttemptAt:  entity.NextAttemptAt,
+		LastError:      entity.LastError.String,
+		CreatedAt:      entity.CreatedAt,
+		UpdatedAt:      entity.UpdatedAt,
+		ClaimToken:     entity.ClaimToken.String,
+	}
+}
+
+// ToEntity converts the provided service-layer representation of an outbox entry to the repository-layer one.
+func (c *converter) ToEntity(in *model.OutboxEntry) *Entity {
+	if in == nil {
+		return nil
+	}
+
+	return &Entity{
+		ID:             in.ID,
+		IdempotencyKey: in.IdempotencyKey,
+		Type:           string(in.Type),
+		TenantID:       in.TenantID,
+		CorrelationID:  repo.NewValidNullableString(in.CorrelationID),
+		Payload:        string(in.Payload),
+		Status:         string(in.Status),
+		Attempts:       in.Attempts,
+		NextAttemptAt:  in.NextAttemptAt,
+		LastError:      repo.NewValidNullableString(in.LastError),
+		CreatedAt:      in.CreatedAt,
+		UpdatedAt:      in.UpdatedAt,
+		ClaimToken:     repo.NewValidNullableString(in.ClaimToken),
+	}
+}
@@ -0,0 +1,78 @@
+package outbox_test
+
+import (
+	"database/sql"
+	"encoding/json"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/outbox"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConverter_ToEntity(t *testing.T) {
+	t.Run("success all nullable properties filled", func(t *testing.T) {
+		// GIVEN
+		conv := outbox.NewConverter()
+
+		// WHEN
+		entity := conv.ToEntity(fixOutboxEntryModel(model.OutboxEntryStatusPending, 1))
+
+		// THEN
+		assert.Equal(t, fixOutboxEntryEntity(model.OutboxEntryStatusPending, 1), entity)
+	})
+	t.Run("success all nullable properties empty", func(t *testing.T) {
+		// GIVEN
+		conv := outbox.NewConverter()
+		entryModel := &model.OutboxEntry{
+			ID:             entryID,
+			IdempotencyKey: idempotencyKey,
+			Type:           model.FormationAssignmentNotificationsOutboxEntryType,
+			TenantID:       tenantID,
+			Payload:        json.RawMessage(payload),
+			Status:         model.OutboxEntryStatusPending,
+		}
+
+		// WHEN
+		entity := conv.ToEntity(entryModel)
+
+		// THEN
+		assert.Equal(t, &outbox.Entity{
+			ID:             entryID,
+			IdempotencyKey: idempotencyKey,
+			Type:           string(model.FormationAssignmentNotificationsOutboxEntryType),
+			TenantID:       tenantID,
+			CorrelationID:  sql.NullString{},
+			Payload:        payload,
+			Status:         string(model.OutboxEntryStatusPending),
+			LastError:      sql.NullString{},
+		}, entity)
+	})
+	t.Run("returns nil for nil model", func(t *testing.T) {
+		// WHEN
+		entity := outbox.NewConverter().ToEntity(nil)
+
+		// THEN
+		assert.Nil(t, entity)
+	})
+}
+
+func TestConverter_FromEntity(t *testing.T) {
+	t.Run("success all nullable properties filled", func(t *testing.T) {
+		// GIVEN
+		conv := outbox.NewConverter()
+
+		// WHEN
+		entryModel := conv.FromEntity(fixOutboxEntryEntity(model.OutboxEntryStatusFailed, 3))
+
+		// THEN
+		assert.Equal(t, fixOutboxEntryModel(model.OutboxEntryStatusFailed, 3), entryModel)
+	})
+	t.Run("returns nil for nil entity", func(t *testing.T) {
+		// WHEN
+		entryModel := outbox.NewConverter().FromEntity(nil)
+
+		// THEN
+		assert.Nil(t, entryModel)
+	})
+}
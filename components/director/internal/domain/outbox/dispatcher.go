@@ -0,0 +1,200 @@
+package outbox
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+)
+
+// OutboxService is responsible for the lifecycle of the outbox entries on the dispatcher side
+//
+//go:generate mockery --name=OutboxService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type OutboxService interface {
+	ClaimNextDue(ctx context.Context, lease time.Duration) (*model.OutboxEntry, error)
+	MarkAsDelivered(ctx context.Context, id, claimToken string) error
+	ScheduleRetry(ctx context.Context, id, claimToken, errorMsg string, nextAttemptAt time.Time) error
+	MarkAsFailed(ctx context.Context, id, claimToken, errorMsg string) error
+	DeleteDeliveredOlderThan(ctx context.Context, retention time.Duration) error
+}
+
+// EntryProcessor is responsible for the actual delivery of the notifications a claimed outbox entry stands for
+//
+//go:generate mockery --name=EntryProcessor --output=automock --outpkg=automock --case=underscore --disable-version-string
+type EntryProcessor interface {
+	Process(ctx context.Context, entry *model.OutboxEntry) error
+}
+
+// DispatcherConfig contains configuration for the notifications outbox dispatcher
+type DispatcherConfig struct {
+	// Workers is the number of outbox entries dispatched in parallel
+	Workers int `envconfig:"APP_NOTIFICATIONS_OUTBOX_WORKERS,default=5"`
+	// PollInterval is the time a worker waits before checking again for due outbox entries once the outbox is drained
+	PollInterval time.Duration `envconfig:"APP_NOTIFICATIONS_OUTBOX_POLL_INTERVAL,default=5s"`
+	// ProcessingTimeout is the maximum duration of a single dispatch attempt. An entry which is not dispatched in that time becomes due again.
+	ProcessingTimeout time.Duration `envconfig:"APP_NOTIFICATIONS_OUTBOX_PROCESSING_TIMEOUT,default=5m"`
+	// MaxAttempts is the number of dispatch attempts after which an entry is moved to FAILED status
+	MaxAttempts int `envconfig:"APP_NOTIFICATIONS_OUTBOX_MAX_ATTEMPTS,default=10"`
+	// InitialBackoff is the time before the first retry. It is doubled on every following attempt up to MaxBackoff.
+	InitialBackoff time.Duration `envconfig:"APP_NOTIFICATIONS_OUTBOX_INITIAL_BACKOFF,default=10s"`
+	MaxBackoff     time.Duration `envconfig:"APP_NOTIFICATIONS_OUTBOX_MAX_BACKOFF,default=30m"`
+	// RetentionPeriod is the time delivered entries are kept before they are deleted
+	RetentionPeriod time.Duration `envconfig:"APP_NOTIFICATIONS_OUTBOX_RETENTION_PERIOD,default=168h"`
+	CleanupInterval time.Duration `envconfig:"APP_NOTIFICATIONS_OUTBOX_CLEANUP_INTERVAL,default=1h"`
+}
+
+// Dispatcher claims due outbox entries and delivers them with the configured EntryProcessor, retrying failed deliveries with exponential backoff
+type Dispatcher struct {
+	transact  persistence.Transactioner
+	svc       OutboxService
+	processor EntryProcessor
+	cfg       DispatcherConfig
+}
+
+// NewDispatcher creates a new notifications outbox Dispatcher
+func NewDispatcher(transact persistence.Transactioner, svc OutboxService, processor EntryProcessor, cfg DispatcherConfig) *Dispatcher {
+	return &Dispatcher{
+		transact:  transact,
+		svc:       svc,
+		processor: processor,
+		cfg:       cfg,
+	}
+}
+
+// Run starts the configured number of dispatching workers and periodically deletes the delivered outbox entries. It blocks until the context is done.
+func (d *Dispatcher) Run(ctx context.Context) {
+	log.C(ctx).Infof("Starting %d notifications outbox workers...", d.cfg.Workers)
+
+	wg := &sync.WaitGroup{}
+	for i := 0; i < d.cfg.Workers; i++ {
+		wg.Add(1)
+		go func(id int) {
+			defer wg.Done()
+			d.work(ctx, id)
+		}(i)
+	}
+
+	ticker := time.NewTicker(d.cfg.CleanupInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			log.C(ctx).Info("Context is done. Waiting for the notifications outbox workers to stop...")
+			wg.Wait()
+			return
+		case <-ticker.C:
+			if err := d.DeleteDelivered(ctx); err != nil {
+				log.C(ctx).WithError(err).Errorf("Failed to delete delivered outbox entries: %v", err)
+			}
+		}
+	}
+}
+
+// DispatchNext claims the next due outbox entry and tries to deliver it. The outcome of the delivery is stored in the entry:
+// it is either DELIVERED, scheduled for a retry or FAILED when the maximum number of attempts is reached.
+// If there are no due outbox entries a NotFound error is returned.
+func (d *Dispatcher) DispatchNext(ctx context.Context) error {
+	var entry *model.OutboxEntry
+	err := d.inTx(ctx, func(ctx context.Context) error {
+		var err error
+		entry, err = d.svc.ClaimNextDue(ctx, d.cfg.ProcessingTimeout)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	entryLogger := log.C(ctx).WithField("outbox_entry_id", entry.ID).WithField(log.FieldRequestID, entry.CorrelationID)
+	ctx = log.ContextWithLogger(ctx, entryLogger)
+
+	log.C(ctx).Infof("Dispatching outbox entry of type %s, attempt %d of %d...", entry.Type, entry.Attempts, d.cfg.MaxAttempts)
+	processCtx, cancel := context.WithTimeout(ctx, d.cfg.ProcessingTimeout)
+	processErr := d.processor.Process(processCtx, entry)
+	cancel()
+
+	if processErr == nil {
+		log.C(ctx).Infof("Successfully dispatched outbox entry of type %s", entry.Type)
+		return d.inTx(ctx, func(ctx context.Context) error {
+			return d.svc.MarkAsDelivered(ctx, entry.ID, entry.ClaimToken)
+		})
+	}
+
+	if entry.Attempts >= d.cfg.MaxAttempts {
+		log.C(ctx).WithError(processErr).Errorf("Failed to dispatch outbox entry after %d attempts. It won't be retried anymore: %v", entry.Attempts, processErr)
+		return d.inTx(ctx, func(ctx context.Context) error {
+			return d.svc.MarkAsFailed(ctx, entry.ID, entry.ClaimToken, processErr.Error())
+		})
+	}
+
+	backoff := d.backoff(entry.Attempts)
+	log.C(ctx).WithError(processErr).Warnf("Failed to dispatch outbox entry. It will be retried in %s: %v", backoff, processErr)
+	return d.inTx(ctx, func(ctx context.Context) error {
+		return d.svc.ScheduleRetry(ctx, entry.ID, entry.ClaimToken, processErr.Error(), time.Now().Add(backoff))
+	})
+}
+
+// DeleteDelivered deletes the delivered outbox entries older than the configured retention period
+func (d *Dispatcher) DeleteDelivered(ctx context.Context) error {
+	return d.inTx(ctx, func(ctx context.Context) error {
+		return d.svc.DeleteDeliveredOlderThan(ctx, d.cfg.RetentionPeriod)
+	})
+}
+
+func (d *Dispatcher) work(ctx context.Context, id int) {
+	for {
+		select {
+		case <-ctx.Done():
+			log.C(ctx).Infof("Context is done. Stopping notifications outbox worker %d...", id)
+			return
+		default:
+		}
+
+		err := d.DispatchNext(ctx)
+		if err == nil {
+			continue
+		}
+
+		if apperrors.IsNotFoundError(err) {
+			log.C(ctx).Debugf("Notifications outbox worker %d found no due entries. Waiting %s before next attempt...", id, d.cfg.PollInterval)
+		} else {
+			log.C(ctx).WithError(err).Errorf("Notifications outbox worker %d failed to dispatch outbox entry: %v", id, err)
+		}
+
+		select {
+		case <-ctx.Done():
+		case <-time.After(d.cfg.PollInterval):
+		}
+	}
+}
+
+func (d *Dispatcher) backoff(attempts int) time.Duration {
+	backoff := d.cfg.InitialBackoff
+	for i := 1; i < attempts && backoff < d.cfg.MaxBackoff; i++ {
+		backoff *= 2
+	}
+
+	if backoff > d.cfg.MaxBackoff {
+		return d.cfg.MaxBackoff
+	}
+
+	return backoff
+}
+
+func (d *Dispatcher) inTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	tx, err := d.transact.Begin()
+	if err != nil {
+		return err
+	}
+	defer d.transact.RollbackUnlessCommitted(ctx, tx)
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	if err := fn(ctx); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
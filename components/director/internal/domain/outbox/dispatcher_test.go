@@ -0,0 +1,234 @@
+package outbox_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/outbox"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/outbox/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	persistenceautomock "github.com/kyma-incubator/compass/components/director/pkg/persistence/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDispatcher_DispatchNext(t *testing.T) {
+	// GIVEN
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+	notFoundErr := apperrors.NewNotFoundErrorWithType(resource.OutboxEntry)
+
+	cfg := outbox.DispatcherConfig{
+		ProcessingTimeout: time.Minute,
+		MaxAttempts:       3,
+		InitialBackoff:    10 * time.Second,
+		MaxBackoff:        time.Minute,
+	}
+
+	retryAtMatcher := func(backoff time.Duration) interface{} {
+		return mock.MatchedBy(func(nextAttemptAt time.Time) bool {
+			return nextAttemptAt.After(time.Now().Add(backoff-time.Second)) && !nextAttemptAt.After(time.Now().Add(backoff))
+		})
+	}
+
+	testCases := []struct {
+		Name            string
+		TransactionerFn func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		OutboxSvcFn     func() *automock.OutboxService
+		ProcessorFn     func() *automock.EntryProcessor
+		ExpectedErr     error
+	}{
+		{
+			Name:            "Success marking entry as delivered",
+			TransactionerFn: txGen.ThatSucceedsTwice,
+			OutboxSvcFn: func() *automock.OutboxService {
+				svc := &automock.OutboxService{}
+				svc.On("ClaimNextDue", txtest.CtxWithDBMatcher(), cfg.ProcessingTimeout).Return(fixOutboxEntryModel(model.OutboxEntryStatusPending, 1), nil).Once()
+				svc.On("MarkAsDelivered", txtest.CtxWithDBMatcher(), entryID, claimToken).Return(nil).Once()
+				return svc
+			},
+			ProcessorFn: func() *automock.EntryProcessor {
+				processor := &automock.EntryProcessor{}
+				processor.On("Process", mock.Anything, fixOutboxEntryModel(model.OutboxEntryStatusPending, 1)).Return(nil).Once()
+				return processor
+			},
+		},
+		{
+			Name:            "Success scheduling retry with backoff when processing fails",
+			TransactionerFn: txGen.ThatSucceedsTwice,
+			OutboxSvcFn: func() *automock.OutboxService {
+				svc := &automock.OutboxService{}
+				svc.On("ClaimNextDue", txtest.CtxWithDBMatcher(), cfg.ProcessingTimeout).Return(fixOutboxEntryModel(model.OutboxEntryStatusPending, 2), nil).Once()
+				svc.On("ScheduleRetry", txtest.CtxWithDBMatcher(), entryID, claimToken, testErr.Error(), retryAtMatcher(20*time.Second)).Return(nil).Once()
+				return svc
+			},
+			ProcessorFn: func() *automock.EntryProcessor {
+				processor := &automock.EntryProcessor{}
+				processor.On("Process", mock.Anything, fixOutboxEntryModel(model.OutboxEntryStatusPending, 2)).Return(testErr).Once()
+				return processor
+			},
+		},
+		{
+			Name:            "Success marking entry as failed when processing fails on the last attempt",
+			TransactionerFn: txGen.ThatSucceedsTwice,
+			OutboxSvcFn: func() *automock.OutboxService {
+				svc := &automock.OutboxService{}
+				svc.On("ClaimNextDue", txtest.CtxWithDBMatcher(), cfg.ProcessingTimeout).Return(fixOutboxEntryModel(model.OutboxEntryStatusPending, 3), nil).Once()
+				svc.On("MarkAsFailed", txtest.CtxWithDBMatcher(), entryID, claimToken, testErr.Error()).Return(nil).Once()
+				return svc
+			},
+			ProcessorFn: func() *automock.EntryProcessor {
+				processor := &automock.EntryProcessor{}
+				processor.On("Process", mock.Anything, fixOutboxEntryModel(model.OutboxEntryStatusPending, 3)).Return(testErr).Once()
+				return processor
+			},
+		},
+		{
+			Name:            "Error when there are no due entries",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			OutboxSvcFn: func() *automock.OutboxService {
+				svc := &automock.OutboxService{}
+				svc.On("ClaimNextDue", txtest.CtxWithDBMatcher(), cfg.ProcessingTimeout).Return(nil, notFoundErr).Once()
+				return svc
+			},
+			ProcessorFn: func() *automock.EntryProcessor {
+				return &automock.EntryProcessor{}
+			},
+			ExpectedErr: notFoundErr,
+		},
+		{
+			Name:            "Error when beginning transaction fails",
+			TransactionerFn: txGen.ThatFailsOnBegin,
+			OutboxSvcFn: func() *automock.OutboxService {
+				return &automock.OutboxService{}
+			},
+			ProcessorFn: func() *automock.EntryProcessor {
+				return &automock.EntryProcessor{}
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name:            "Error when committing claim transaction fails",
+			TransactionerFn: txGen.ThatFailsOnCommit,
+			OutboxSvcFn: func() *automock.OutboxService {
+				svc := &automock.OutboxService{}
+				svc.On("ClaimNextDue", txtest.CtxWithDBMatcher(), cfg.ProcessingTimeout).Return(fixOutboxEntryModel(model.OutboxEntryStatusPending, 1), nil).Once()
+				return svc
+			},
+			ProcessorFn: func() *automock.EntryProcessor {
+				return &automock.EntryProcessor{}
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name: "Error when marking entry as delivered fails",
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimesAndCommitsMultipleTimes(2, 1)
+			},
+			OutboxSvcFn: func() *automock.OutboxService {
+				svc := &automock.OutboxService{}
+				svc.On("ClaimNextDue", txtest.CtxWithDBMatcher(), cfg.ProcessingTimeout).Return(fixOutboxEntryModel(model.OutboxEntryStatusPending, 1), nil).Once()
+				svc.On("MarkAsDelivered", txtest.CtxWithDBMatcher(), entryID, claimToken).Return(testErr).Once()
+				return svc
+			},
+			ProcessorFn: func() *automock.EntryProcessor {
+				processor := &automock.EntryProcessor{}
+				processor.On("Process", mock.Anything, fixOutboxEntryModel(model.OutboxEntryStatusPending, 1)).Return(nil).Once()
+				return processor
+			},
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			persist, transact := testCase.TransactionerFn()
+			outboxSvc := testCase.OutboxSvcFn()
+			processor := testCase.ProcessorFn()
+
+			dispatcher := outbox.NewDispatcher(transact, outboxSvc, processor, cfg)
+
+			// WHEN
+			err := dispatcher.DispatchNext(context.TODO())
+
+			// THEN
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+
+			mock.AssertExpectationsForObjects(t, persist, transact, outboxSvc, processor)
+		})
+	}
+}
+
+func TestDispatcher_DeleteDelivered(t *testing.T) {
+	// GIVEN
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+	cfg := outbox.DispatcherConfig{RetentionPeriod: time.Hour}
+
+	testCases := []struct {
+		Name            string
+		TransactionerFn func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		OutboxSvcFn     func() *automock.OutboxService
+		ExpectedErr     error
+	}{
+		{
+			Name:            "Success",
+			TransactionerFn: txGen.ThatSucceeds,
+			OutboxSvcFn: func() *automock.OutboxService {
+				svc := &automock.OutboxService{}
+				svc.On("DeleteDeliveredOlderThan", txtest.CtxWithDBMatcher(), cfg.RetentionPeriod).Return(nil).Once()
+				return svc
+			},
+		},
+		{
+			Name:            "Error when deleting entries fails",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			OutboxSvcFn: func() *automock.OutboxService {
+				svc := &automock.OutboxService{}
+				svc.On("DeleteDeliveredOlderThan", txtest.CtxWithDBMatcher(), cfg.RetentionPeriod).Return(testErr).Once()
+				return svc
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name:            "Error when beginning transaction fails",
+			TransactionerFn: txGen.ThatFailsOnBegin,
+			OutboxSvcFn: func() *automock.OutboxService {
+				return &automock.OutboxService{}
+			},
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			persist, transact := testCase.TransactionerFn()
+			outboxSvc := testCase.OutboxSvcFn()
+
+			dispatcher := outbox.NewDispatcher(transact, outboxSvc, nil, cfg)
+
+			// WHEN
+			err := dispatcher.DeleteDelivered(context.TODO())
+
+			// THEN
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+
+			mock.AssertExpectationsForObjects(t, persist, transact, outboxSvc)
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package outbox
+
+import (
+	"database/sql"
+	"time"
+)
+
+// Entity is a representation of a notifications outbox entry in the database.
+type Entity struct {
+	ID             string         `db:"id"`
+	IdempotencyKey string         `db:"idempotency_key"`
+	Type           string         `db:"type"`
+	TenantID       string         `db:"tenant_id"`
+	CorrelationID  sql.NullString `db:"correlation_id"`
+	Payload        string         `db:"payload"`
+	Status         string         `db:"status"`
+	Attempts       int            `db:"attempts"`
+	NextAttemptAt  *time.Time     `db:"next_attempt_at"`
+	LastError      sql.NullString `db:"last_error"`
+	CreatedAt      *time.Time     `db:"created_at"`
+	UpdatedAt      *time.Time     `db:"updated_at"`
+	ClaimToken     sql.NullString `db:"claim_token"`
+}
+
+// EntityCollection is a collection of notifications outbox entities.
+type EntityCollection []Entity
+
+// Len is implementation of a repo.Collection interface
+func (s EntityCollection) Len() int {
+	return len(s)
+}
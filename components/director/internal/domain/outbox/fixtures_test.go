@@ -0,0 +1,89 @@
+package outbox_test
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/outbox"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/pkg/errors"
+)
+
+const (
+	entryID        = "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa"
+	idempotencyKey = "FORMATION_NOTIFICATIONS:formation-id:correlation-id"
+	tenantID       = "bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb"
+	correlationID  = "correlation-id"
+	payload        = `{"ID":"formation-id"}`
+	lastError      = "test error"
+	claimToken     = "cccccccc-cccc-cccc-cccc-cccccccccccc"
+)
+
+var (
+	testErr   = errors.New("test error")
+	timestamp = time.Date(2023, 10, 24, 9, 0, 0, 0, time.UTC)
+)
+
+func fixOutboxEntryInput() *model.OutboxEntryInput {
+	return &model.OutboxEntryInput{
+		IdempotencyKey: idempotencyKey,
+		Type:           model.FormationNotificationsOutboxEntryType,
+		TenantID:       tenantID,
+		CorrelationID:  correlationID,
+		Payload:        json.RawMessage(payload),
+	}
+}
+
+func fixOutboxEntryModel(status model.OutboxEntryStatus, attempts int) *model.OutboxEntry {
+	return &model.OutboxEntry{
+		ID:             entryID,
+		IdempotencyKey: idempotencyKey,
+		Type:           model.FormationNotificationsOutboxEntryType,
+		TenantID:       tenantID,
+		CorrelationID:  correlationID,
+		Payload:        json.RawMessage(payload),
+		Status:         status,
+		Attempts:       attempts,
+		NextAttemptAt:  &timestamp,
+		LastError:      lastError,
+		CreatedAt:      &timestamp,
+		UpdatedAt:      &timestamp,
+		ClaimToken:     claimToken,
+	}
+}
+
+func fixOutboxEntryEntity(status model.OutboxEntryStatus, attempts int) *outbox.Entity {
+	return &outbox.Entity{
+		ID:             entryID,
+		IdempotencyKey: idempotencyKey,
+		Type:           string(model.FormationNotificationsOutboxEntryType),
+		TenantID:       tenantID,
+		CorrelationID:  repo.NewValidNullableString(correlationID),
+		Payload:        payload,
+		Status:         string(status),
+		Attempts:       attempts,
+		NextAttemptAt:  &timestamp,
+		LastError:      repo.NewValidNullableString(lastError),
+		CreatedAt:      &timestamp,
+		UpdatedAt:      &timestamp,
+		ClaimToken:     repo.NewValidNullableString(claimToken),
+	}
+}
+
+func fixOutboxEntryCreateArgs(entity *outbox.Entity) []driver.Value {
+	return []driver.Value{entity.ID, entity.IdempotencyKey, entity.Type, entity.TenantID, entity.CorrelationID, entity.Payload, entity.Status, entity.Attempts, entity.NextAttemptAt, entity.LastError, entity.CreatedAt, entity.UpdatedAt, entity.ClaimToken}
+}
+
+func fixOutboxEntryUpdateArgs(entity *outbox.Entity) []driver.Value {
+	return []driver.Value{entity.Status, entity.Attempts, entity.NextAttemptAt, entity.LastError, entity.UpdatedAt, entity.ClaimToken, entity.ID}
+}
+
+func fixColumns() []string {
+	return []string{"id", "idempotency_key", "type", "tenant_id", "correlation_id", "payload", "status", "attempts", "next_attempt_at", "last_error", "created_at", "updated_at", "claim_token"}
+}
+
+func fixRow(entity *outbox.Entity) []driver.Value {
+	return []driver.Value{entity.ID, entity.IdempotencyKey, entity.Type, entity.TenantID, entity.CorrelationID, entity.Payload, entity.Status, entity.Attempts, entity.NextAttemptAt, entity.LastError, entity.CreatedAt, entity.UpdatedAt, entity.ClaimToken}
+}
@@ -0,0 +1,161 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/pkg/errors"
+)
+
+const outboxTable = `public.notifications_outbox`
+
+var (
+	idColumn             = "id"
+	idempotencyKeyColumn = "idempotency_key"
+	statusColumn         = "status"
+	nextAttemptAtColumn  = "next_attempt_at"
+	updatedAtColumn      = "updated_at"
+	outboxColumns        = []string{idColumn, idempotencyKeyColumn, "type", "tenant_id", "correlation_id", "payload", statusColumn, "attempts", nextAttemptAtColumn, "last_error", "created_at", updatedAtColumn, "claim_token"}
+	updatableColumns     = []string{statusColumn, "attempts", nextAttemptAtColumn, "last_error", updatedAtColumn, "claim_token"}
+	idColumns            = []string{idColumn}
+)
+
+// EntityConverter converts between the service-layer and repository-layer representations of an outbox entry
+//
+//go:generate mockery --name=EntityConverter --output=automock --outpkg=automock --case=underscore --disable-version-string
+type EntityConverter interface {
+	ToEntity(in *model.OutboxEntry) *Entity
+	FromEntity(entity *Entity) *model.OutboxEntry
+}
+
+type pgRepository struct {
+	globalGetter  repo.SingleGetterGlobal
+	globalUpdater repo.UpdaterGlobal
+	globalDeleter repo.DeleterGlobal
+	conv          EntityConverter
+}
+
+// NewRepository creates new notifications outbox repository
+func NewRepository(conv EntityConverter) *pgRepository {
+	return &pgRepository{
+		globalGetter:  repo.NewSingleGetterGlobal(resource.OutboxEntry, outboxTable, outboxColumns),
+		globalUpdater: repo.NewUpdaterGlobal(resource.OutboxEntry, outboxTable, updatableColumns, idColumns),
+		globalDeleter: repo.NewDeleterGlobal(resource.OutboxEntry, outboxTable),
+		conv:          conv,
+	}
+}
+
+// Create persists the provided outbox entry. If an entry with the same idempotency key already exists, nothing is stored.
+func (r *pgRepository) Create(ctx context.Context, item *model.OutboxEntry) error {
+	if item == nil {
+		return apperrors.NewInternalError("item can not be empty")
+	}
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return err
+	}
+
+	values := make([]string, 0, len(outboxColumns))
+	for _, c := range outboxColumns {
+		values = append(values, fmt.Sprintf(":%s", c))
+	}
+
+	stmt := fmt.Sprintf("INSERT INTO %s ( %s ) VALUES ( %s ) ON CONFLICT ( %s ) DO NOTHING", outboxTable, strings.Join(outboxColumns, ", "), strings.Join(values, ", "), idempotencyKeyColumn)
+
+	log.C(ctx).Debugf("Executing DB query: %s", stmt)
+	res, err := persist.NamedExecContext(ctx, stmt, r.conv.ToEntity(item))
+	if err != nil {
+		return persistence.MapSQLError(ctx, err, resource.OutboxEntry, resource.Create, "while inserting row to '%s' table", outboxTable)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "while checking affected rows")
+	}
+
+	if affected == 0 {
+		log.C(ctx).Infof("Outbox entry with idempotency key %q already exists. Skipping its creation...", item.IdempotencyKey)
+	}
+
+	return nil
+}
+
+// Get fetches the outbox entry with the provided id
+func (r *pgRepository) Get(ctx context.Context, id string) (*model.OutboxEntry, error) {
+	var entity Entity
+	if err := r.globalGetter.GetGlobal(ctx, repo.Conditions{repo.NewEqualCondition(idColumn, id)}, repo.NoOrderBy, &entity); err != nil {
+		return nil, err
+	}
+
+	return r.conv.FromEntity(&entity), nil
+}
+
+// GetForUpdate fetches the outbox entry with the provided id and locks it until the end of the transaction
+func (r *pgRepository) GetForUpdate(ctx context.Context, id string) (*model.OutboxEntry, error) {
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := fmt.Sprintf(`SELECT %s FROM %s WHERE %s = $1 FOR UPDATE`, strings.Join(outboxColumns, ", "), outboxTable, idColumn)
+
+	log.C(ctx).Debugf("Executing DB query: %s", query)
+	var entity Entity
+	if err := persist.GetContext(ctx, &entity, query, id); err != nil {
+		return nil, persistence.MapSQLError(ctx, err, resource.OutboxEntry, resource.Get, "while getting outbox entry with id %s for update", id)
+	}
+
+	return r.conv.FromEntity(&entity), nil
+}
+
+// GetNextDueForUpdate fetches the PENDING outbox entry with the earliest next attempt time which is not after `now` and locks it until the end of the transaction.
+// Entries already locked by other transactions are skipped, which allows multiple dispatchers to claim entries concurrently. If there are no due entries a NotFound error is returned.
+func (r *pgRepository) GetNextDueForUpdate(ctx context.Context, now time.Time) (*model.OutboxEntry, error) {
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := fmt.Sprintf(`SELECT %s FROM %s WHERE %s = $1 AND %s <= $2 ORDER BY %s ASC LIMIT 1 FOR UPDATE SKIP LOCKED`,
+		strings.Join(outboxColumns, ", "), outboxTable, statusColumn, nextAttemptAtColumn, nextAttemptAtColumn)
+
+	log.C(ctx).Debugf("Executing DB query: %s", query)
+	var entities EntityCollection
+	if err := persist.SelectContext(ctx, &entities, query, string(model.OutboxEntryStatusPending), now); err != nil {
+		return nil, persistence.MapSQLError(ctx, err, resource.OutboxEntry, resource.List, "while getting next due outbox entry")
+	}
+
+	if len(entities) == 0 {
+		return nil, apperrors.NewNotFoundErrorWithType(resource.OutboxEntry)
+	}
+
+	return r.conv.FromEntity(&entities[0]), nil
+}
+
+// Update updates the status, attempts, next attempt time, last error and update time of the provided outbox entry
+func (r *pgRepository) Update(ctx context.Context, item *model.OutboxEntry) error {
+	if item == nil {
+		return apperrors.NewInternalError("item can not be empty")
+	}
+
+	log.C(ctx).Debugf("Updating outbox entry with id %s and status %s", item.ID, item.Status)
+	return r.globalUpdater.UpdateSingleGlobal(ctx, r.conv.ToEntity(item))
+}
+
+// DeleteDeliveredOlderThan deletes all DELIVERED outbox entries which were last updated before `date`
+func (r *pgRepository) DeleteDeliveredOlderThan(ctx context.Context, date time.Time) error {
+	log.C(ctx).Infof("Deleting all delivered outbox entries older than %v", date)
+	return r.globalDeleter.DeleteManyGlobal(ctx, repo.Conditions{
+		repo.NewEqualCondition(statusColumn, string(model.OutboxEntryStatusDelivered)),
+		repo.NewLessThanCondition(updatedAtColumn, date),
+	})
+}
@@ -0,0 +1,221 @@
+package outbox_test
+
+import (
+	"context"
+	"database/sql/driver"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/outbox"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/outbox/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo/testdb"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPgRepository_Create(t *testing.T) {
+	// GIVEN
+	var nilOutboxEntryModel *model.OutboxEntry
+	outboxEntryModel := fixOutboxEntryModel(model.OutboxEntryStatusPending, 0)
+	outboxEntryEntity := fixOutboxEntryEntity(model.OutboxEntryStatusPending, 0)
+
+	suite := testdb.RepoCreateTestSuite{
+		Name: "Create Outbox Entry",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:       `^INSERT INTO public.notifications_outbox \(.+\) VALUES \(.+\) ON CONFLICT \( idempotency_key \) DO NOTHING$`,
+				Args:        fixOutboxEntryCreateArgs(outboxEntryEntity),
+				ValidResult: sqlmock.NewResult(-1, 1),
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		RepoConstructorFunc:       outbox.NewRepository,
+		ModelEntity:               outboxEntryModel,
+		DBEntity:                  outboxEntryEntity,
+		NilModelEntity:            nilOutboxEntryModel,
+		DisableConverterErrorTest: true,
+		IsGlobal:                  true,
+	}
+
+	suite.Run(t)
+
+	t.Run("Success when entry with the same idempotency key already exists", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectExec(`^INSERT INTO public.notifications_outbox \(.+\) VALUES \(.+\) ON CONFLICT \( idempotency_key \) DO NOTHING$`).
+			WithArgs(fixOutboxEntryCreateArgs(outboxEntryEntity)...).
+			WillReturnResult(sqlmock.NewResult(-1, 0))
+
+		conv := &automock.EntityConverter{}
+		conv.On("ToEntity", outboxEntryModel).Return(outboxEntryEntity).Once()
+		defer conv.AssertExpectations(t)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repository := outbox.NewRepository(conv)
+
+		// WHEN
+		err := repository.Create(ctx, outboxEntryModel)
+
+		// THEN
+		require.NoError(t, err)
+	})
+}
+
+func TestPgRepository_Get(t *testing.T) {
+	outboxEntryModel := fixOutboxEntryModel(model.OutboxEntryStatusPending, 1)
+	outboxEntryEntity := fixOutboxEntryEntity(model.OutboxEntryStatusPending, 1)
+
+	suite := testdb.RepoGetTestSuite{
+		Name:       "Get Outbox Entry",
+		MethodName: "Get",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:    regexp.QuoteMeta(`SELECT id, idempotency_key, type, tenant_id, correlation_id, payload, status, attempts, next_attempt_at, last_error, created_at, updated_at, claim_token FROM public.notifications_outbox WHERE id = $1`),
+				Args:     []driver.Value{entryID},
+				IsSelect: true,
+				ValidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixColumns()).AddRow(fixRow(outboxEntryEntity)...)}
+				},
+				InvalidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixColumns())}
+				},
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		RepoConstructorFunc:       outbox.NewRepository,
+		ExpectedModelEntity:       outboxEntryModel,
+		ExpectedDBEntity:          outboxEntryEntity,
+		MethodArgs:                []interface{}{entryID},
+		DisableConverterErrorTest: true,
+	}
+
+	suite.Run(t)
+}
+
+func TestPgRepository_GetForUpdate(t *testing.T) {
+	outboxEntryModel := fixOutboxEntryModel(model.OutboxEntryStatusPending, 1)
+	outboxEntryEntity := fixOutboxEntryEntity(model.OutboxEntryStatusPending, 1)
+
+	suite := testdb.RepoGetTestSuite{
+		Name:       "Get Outbox Entry for update",
+		MethodName: "GetForUpdate",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:    regexp.QuoteMeta(`SELECT id, idempotency_key, type, tenant_id, correlation_id, payload, status, attempts, next_attempt_at, last_error, created_at, updated_at, claim_token FROM public.notifications_outbox WHERE id = $1 FOR UPDATE`),
+				Args:     []driver.Value{entryID},
+				IsSelect: true,
+				ValidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixColumns()).AddRow(fixRow(outboxEntryEntity)...)}
+				},
+				InvalidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixColumns())}
+				},
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		RepoConstructorFunc:       outbox.NewRepository,
+		ExpectedModelEntity:       outboxEntryModel,
+		ExpectedDBEntity:          outboxEntryEntity,
+		MethodArgs:                []interface{}{entryID},
+		DisableConverterErrorTest: true,
+	}
+
+	suite.Run(t)
+}
+
+func TestPgRepository_GetNextDueForUpdate(t *testing.T) {
+	outboxEntryModel := fixOutboxEntryModel(model.OutboxEntryStatusPending, 1)
+	outboxEntryEntity := fixOutboxEntryEntity(model.OutboxEntryStatusPending, 1)
+
+	suite := testdb.RepoGetTestSuite{
+		Name:       "Get next due Outbox Entry for update",
+		MethodName: "GetNextDueForUpdate",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:    regexp.QuoteMeta(`SELECT id, idempotency_key, type, tenant_id, correlation_id, payload, status, attempts, next_attempt_at, last_error, created_at, updated_at, claim_token FROM public.notifications_outbox WHERE status = $1 AND next_attempt_at <= $2 ORDER BY next_attempt_at ASC LIMIT 1 FOR UPDATE SKIP LOCKED`),
+				Args:     []driver.Value{string(model.OutboxEntryStatusPending), timestamp},
+				IsSelect: true,
+				ValidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixColumns()).AddRow(fixRow(outboxEntryEntity)...)}
+				},
+				InvalidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixColumns())}
+				},
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		RepoConstructorFunc:       outbox.NewRepository,
+		ExpectedModelEntity:       outboxEntryModel,
+		ExpectedDBEntity:          outboxEntryEntity,
+		MethodArgs:                []interface{}{timestamp},
+		DisableConverterErrorTest: true,
+	}
+
+	suite.Run(t)
+}
+
+func TestPgRepository_Update(t *testing.T) {
+	var nilOutboxEntryModel *model.OutboxEntry
+	outboxEntryModel := fixOutboxEntryModel(model.OutboxEntryStatusDelivered, 2)
+	outboxEntryEntity := fixOutboxEntryEntity(model.OutboxEntryStatusDelivered, 2)
+
+	suite := testdb.RepoUpdateTestSuite{
+		Name: "Update Outbox Entry",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:         regexp.QuoteMeta(`UPDATE public.notifications_outbox SET status = ?, attempts = ?, next_attempt_at = ?, last_error = ?, updated_at = ?, claim_token = ? WHERE id = ?`),
+				Args:          fixOutboxEntryUpdateArgs(outboxEntryEntity),
+				ValidResult:   sqlmock.NewResult(-1, 1),
+				InvalidResult: sqlmock.NewResult(-1, 0),
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		RepoConstructorFunc:       outbox.NewRepository,
+		ModelEntity:               outboxEntryModel,
+		DBEntity:                  outboxEntryEntity,
+		NilModelEntity:            nilOutboxEntryModel,
+		DisableConverterErrorTest: true,
+		IsGlobal:                  true,
+	}
+
+	suite.Run(t)
+}
+
+func TestPgRepository_DeleteDeliveredOlderThan(t *testing.T) {
+	suite := testdb.RepoDeleteTestSuite{
+		Name: "DeleteDeliveredOlderThan Outbox Entries",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:         regexp.QuoteMeta(`DELETE FROM public.notifications_outbox WHERE status = $1 AND updated_at < $2`),
+				Args:          []driver.Value{string(model.OutboxEntryStatusDelivered), time.Time{}},
+				ValidResult:   sqlmock.NewResult(-1, 1),
+				InvalidResult: sqlmock.NewResult(-1, 2),
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		RepoConstructorFunc: outbox.NewRepository,
+		MethodName:          "DeleteDeliveredOlderThan",
+		MethodArgs:          []interface{}{time.Time{}},
+		IsDeleteMany:        true,
+		IsGlobal:            true,
+	}
+
+	suite.Run(t)
+}
@@ -0,0 +1,145 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/pkg/errors"
+)
+
+// OutboxRepository is responsible for repository-layer outbox entry operations
+//
+//go:generate mockery --name=OutboxRepository --output=automock --outpkg=automock --case=underscore --disable-version-string
+type OutboxRepository interface {
+	Create(ctx context.Context, item *model.OutboxEntry) error
+	Get(ctx context.Context, id string) (*model.OutboxEntry, error)
+	GetForUpdate(ctx context.Context, id string) (*model.OutboxEntry, error)
+	GetNextDueForUpdate(ctx context.Context, now time.Time) (*model.OutboxEntry, error)
+	Update(ctx context.Context, item *model.OutboxEntry) error
+	DeleteDeliveredOlderThan(ctx context.Context, date time.Time) error
+}
+
+// UIDService is responsible for service-layer uid operations
+//
+//go:generate mockery --name=UIDService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type UIDService interface {
+	Generate() string
+}
+
+type service struct {
+	repo       OutboxRepository
+	uidService UIDService
+}
+
+// NewService creates notifications outbox service
+func NewService(repo OutboxRepository, uidService UIDService) *service {
+	return &service{
+		repo:       repo,
+		uidService: uidService,
+	}
+}
+
+// Enqueue stores a new PENDING outbox entry which is due immediately. It has to be called in the transaction of the state change
+// which caused the notifications, so that the entry is persisted if and only if the state change is.
+func (s *service) Enqueue(ctx context.Context, in *model.OutboxEntryInput) error {
+	if in == nil {
+		return nil
+	}
+
+	entry := in.ToOutboxEntry(s.uidService.Generate(), time.Now())
+	if err := s.repo.Create(ctx, entry); err != nil {
+		return errors.Wrapf(err, "while enqueueing outbox entry of type %s with idempotency key %q", entry.Type, entry.IdempotencyKey)
+	}
+
+	log.C(ctx).Infof("Enqueued outbox entry of type %s with idempotency key %q", entry.Type, entry.IdempotencyKey)
+	return nil
+}
+
+// ClaimNextDue takes the due PENDING outbox entry with the earliest next attempt time, increases its attempts and postpones its next attempt with `lease`,
+// so that the entry is picked up again if its dispatching does not finish in time. The claimed entry gets a new claim token which has to be provided
+// when the outcome of its dispatching is stored. It has to be called in a transaction in order for the claim to be exclusive among concurrent dispatchers.
+func (s *service) ClaimNextDue(ctx context.Context, lease time.Duration) (*model.OutboxEntry, error) {
+	now := time.Now()
+	entry, err := s.repo.GetNextDueForUpdate(ctx, now)
+	if err != nil {
+		return nil, err
+	}
+
+	nextAttemptAt := now.Add(lease)
+	entry.Attempts++
+	entry.ClaimToken = s.uidService.Generate()
+	entry.NextAttemptAt = &nextAttemptAt
+	entry.UpdatedAt = &now
+
+	if err := s.repo.Update(ctx, entry); err != nil {
+		return nil, errors.Wrapf(err, "while claiming outbox entry with id %s", entry.ID)
+	}
+
+	log.C(ctx).Infof("Claimed outbox entry with id %s and type %s for attempt %d", entry.ID, entry.Type, entry.Attempts)
+	return entry, nil
+}
+
+// MarkAsDelivered moves the outbox entry with the provided id to DELIVERED status if it is still claimed with the provided claim token
+func (s *service) MarkAsDelivered(ctx context.Context, id, claimToken string) error {
+	return s.update(ctx, id, claimToken, func(entry *model.OutboxEntry) {
+		entry.Status = model.OutboxEntryStatusDelivered
+		entry.LastError = ""
+	})
+}
+
+// ScheduleRetry keeps the outbox entry with the provided id in PENDING status, stores the provided error message and schedules its next attempt at `nextAttemptAt`
+// if it is still claimed with the provided claim token
+func (s *service) ScheduleRetry(ctx context.Context, id, claimToken, errorMsg string, nextAttemptAt time.Time) error {
+	return s.update(ctx, id, claimToken, func(entry *model.OutboxEntry) {
+		entry.Status = model.OutboxEntryStatusPending
+		entry.LastError = errorMsg
+		entry.NextAttemptAt = &nextAttemptAt
+	})
+}
+
+// MarkAsFailed moves the outbox entry with the provided id to FAILED status and stores the provided error message if it is still claimed with the provided claim token.
+// Failed entries are not retried anymore.
+func (s *service) MarkAsFailed(ctx context.Context, id, claimToken, errorMsg string) error {
+	return s.update(ctx, id, claimToken, func(entry *model.OutboxEntry) {
+		entry.Status = model.OutboxEntryStatusFailed
+		entry.LastError = errorMsg
+	})
+}
+
+// DeleteDeliveredOlderThan deletes all DELIVERED outbox entries which were last updated more than `retention` ago
+func (s *service) DeleteDeliveredOlderThan(ctx context.Context, retention time.Duration) error {
+	if err := s.repo.DeleteDeliveredOlderThan(ctx, time.Now().Add(-1*retention)); err != nil {
+		return errors.Wrap(err, "while deleting delivered outbox entries")
+	}
+
+	return nil
+}
+
+// update applies `mutate` to the outbox entry with the provided id and releases its claim. The entry is locked until the end of the transaction.
+// It fails if the entry is not PENDING or is claimed with a different token, which happens when the lease of the caller expired
+// and the entry was claimed by another dispatcher.
+func (s *service) update(ctx context.Context, id, claimToken string, mutate func(entry *model.OutboxEntry)) error {
+	entry, err := s.repo.GetForUpdate(ctx, id)
+	if err != nil {
+		return errors.Wrapf(err, "while getting outbox entry with id %s", id)
+	}
+
+	if entry.Status != model.OutboxEntryStatusPending || entry.ClaimToken != claimToken {
+		return apperrors.NewInvalidOperationError(fmt.Sprintf("outbox entry with id %s is in status %s and is no longer claimed by the caller", id, entry.Status))
+	}
+
+	now := time.Now()
+	mutate(entry)
+	entry.UpdatedAt = &now
+	entry.ClaimToken = ""
+
+	if err := s.repo.Update(ctx, entry); err != nil {
+		return errors.Wrapf(err, "while updating outbox entry with id %s to status %s", id, entry.Status)
+	}
+
+	return nil
+}
@@ -0,0 +1,354 @@
+package outbox_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/outbox"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/outbox/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_Enqueue(t *testing.T) {
+	// GIVEN
+	ctx := context.TODO()
+	input := fixOutboxEntryInput()
+
+	entryMatcher := mock.MatchedBy(func(entry *model.OutboxEntry) bool {
+		return entry.ID == entryID && entry.IdempotencyKey == input.IdempotencyKey && entry.Type == input.Type &&
+			entry.TenantID == input.TenantID && entry.CorrelationID == input.CorrelationID && string(entry.Payload) == string(input.Payload) &&
+			entry.Status == model.OutboxEntryStatusPending && entry.Attempts == 0 && entry.NextAttemptAt != nil
+	})
+
+	testCases := []struct {
+		Name         string
+		RepositoryFn func() *automock.OutboxRepository
+		UIDServiceFn func() *automock.UIDService
+		Input        *model.OutboxEntryInput
+		ExpectedErr  error
+	}{
+		{
+			Name: "Success",
+			RepositoryFn: func() *automock.OutboxRepository {
+				repo := &automock.OutboxRepository{}
+				repo.On("Create", ctx, entryMatcher).Return(nil).Once()
+				return repo
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				svc := &automock.UIDService{}
+				svc.On("Generate").Return(entryID).Once()
+				return svc
+			},
+			Input: input,
+		},
+		{
+			Name:         "Success when input is nil",
+			RepositoryFn: func() *automock.OutboxRepository { return &automock.OutboxRepository{} },
+			UIDServiceFn: func() *automock.UIDService { return &automock.UIDService{} },
+			Input:        nil,
+		},
+		{
+			Name: "Error when creating outbox entry fails",
+			RepositoryFn: func() *automock.OutboxRepository {
+				repo := &automock.OutboxRepository{}
+				repo.On("Create", ctx, entryMatcher).Return(testErr).Once()
+				return repo
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				svc := &automock.UIDService{}
+				svc.On("Generate").Return(entryID).Once()
+				return svc
+			},
+			Input:       input,
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			repo := testCase.RepositoryFn()
+			uidService := testCase.UIDServiceFn()
+
+			svc := outbox.NewService(repo, uidService)
+
+			// WHEN
+			err := svc.Enqueue(ctx, testCase.Input)
+
+			// THEN
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+
+			mock.AssertExpectationsForObjects(t, repo, uidService)
+		})
+	}
+}
+
+func TestService_ClaimNextDue(t *testing.T) {
+	// GIVEN
+	ctx := context.TODO()
+	lease := time.Minute
+	notFoundErr := apperrors.NewNotFoundErrorWithType(resource.OutboxEntry)
+	newClaimToken := "dddddddd-dddd-dddd-dddd-dddddddddddd"
+
+	claimedEntryMatcher := mock.MatchedBy(func(entry *model.OutboxEntry) bool {
+		return entry.ID == entryID && entry.Attempts == 2 && entry.ClaimToken == newClaimToken && entry.NextAttemptAt.After(time.Now().Add(lease-time.Second))
+	})
+
+	uidServiceFn := func() *automock.UIDService {
+		svc := &automock.UIDService{}
+		svc.On("Generate").Return(newClaimToken).Once()
+		return svc
+	}
+
+	testCases := []struct {
+		Name             string
+		RepositoryFn     func() *automock.OutboxRepository
+		UIDServiceFn     func() *automock.UIDService
+		ExpectedAttempts int
+		ExpectedErr      error
+	}{
+		{
+			Name: "Success",
+			RepositoryFn: func() *automock.OutboxRepository {
+				repo := &automock.OutboxRepository{}
+				repo.On("GetNextDueForUpdate", ctx, mock.AnythingOfType("time.Time")).Return(fixOutboxEntryModel(model.OutboxEntryStatusPending, 1), nil).Once()
+				repo.On("Update", ctx, claimedEntryMatcher).Return(nil).Once()
+				return repo
+			},
+			UIDServiceFn:     uidServiceFn,
+			ExpectedAttempts: 2,
+		},
+		{
+			Name: "Error when there are no due outbox entries",
+			RepositoryFn: func() *automock.OutboxRepository {
+				repo := &automock.OutboxRepository{}
+				repo.On("GetNextDueForUpdate", ctx, mock.AnythingOfType("time.Time")).Return(nil, notFoundErr).Once()
+				return repo
+			},
+			UIDServiceFn: func() *automock.UIDService { return &automock.UIDService{} },
+			ExpectedErr:  notFoundErr,
+		},
+		{
+			Name: "Error when updating outbox entry fails",
+			RepositoryFn: func() *automock.OutboxRepository {
+				repo := &automock.OutboxRepository{}
+				repo.On("GetNextDueForUpdate", ctx, mock.AnythingOfType("time.Time")).Return(fixOutboxEntryModel(model.OutboxEntryStatusPending, 1), nil).Once()
+				repo.On("Update", ctx, claimedEntryMatcher).Return(testErr).Once()
+				return repo
+			},
+			UIDServiceFn: uidServiceFn,
+			ExpectedErr:  testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			repo := testCase.RepositoryFn()
+			uidService := testCase.UIDServiceFn()
+
+			svc := outbox.NewService(repo, uidService)
+
+			// WHEN
+			entry, err := svc.ClaimNextDue(ctx, lease)
+
+			// THEN
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+				assert.Nil(t, entry)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedAttempts, entry.Attempts)
+				assert.Equal(t, newClaimToken, entry.ClaimToken)
+			}
+
+			mock.AssertExpectationsForObjects(t, repo, uidService)
+		})
+	}
+}
+
+func TestService_UpdateStatus(t *testing.T) {
+	// GIVEN
+	ctx := context.TODO()
+	nextAttemptAt := time.Now().Add(time.Hour)
+
+	testCases := []struct {
+		Name                  string
+		UpdateFn              func(svc outbox.OutboxService) error
+		Entry                 *model.OutboxEntry
+		GetErr                error
+		UpdateErr             error
+		ExpectedStatus        model.OutboxEntryStatus
+		ExpectedLastError     string
+		ExpectedNextAttemptAt *time.Time
+		ExpectedErr           error
+	}{
+		{
+			Name: "Success marking as delivered",
+			UpdateFn: func(svc outbox.OutboxService) error {
+				return svc.MarkAsDelivered(ctx, entryID, claimToken)
+			},
+			ExpectedStatus:        model.OutboxEntryStatusDelivered,
+			ExpectedNextAttemptAt: &timestamp,
+		},
+		{
+			Name: "Success scheduling retry",
+			UpdateFn: func(svc outbox.OutboxService) error {
+				return svc.ScheduleRetry(ctx, entryID, claimToken, "retry error", nextAttemptAt)
+			},
+			ExpectedStatus:        model.OutboxEntryStatusPending,
+			ExpectedLastError:     "retry error",
+			ExpectedNextAttemptAt: &nextAttemptAt,
+		},
+		{
+			Name: "Success marking as failed",
+			UpdateFn: func(svc outbox.OutboxService) error {
+				return svc.MarkAsFailed(ctx, entryID, claimToken, "fatal error")
+			},
+			ExpectedStatus:        model.OutboxEntryStatusFailed,
+			ExpectedLastError:     "fatal error",
+			ExpectedNextAttemptAt: &timestamp,
+		},
+		{
+			Name: "Error when getting outbox entry fails",
+			UpdateFn: func(svc outbox.OutboxService) error {
+				return svc.MarkAsDelivered(ctx, entryID, claimToken)
+			},
+			GetErr:      testErr,
+			ExpectedErr: testErr,
+		},
+		{
+			Name: "Error when outbox entry is claimed with a different token",
+			UpdateFn: func(svc outbox.OutboxService) error {
+				return svc.MarkAsDelivered(ctx, entryID, "eeeeeeee-eeee-eeee-eeee-eeeeeeeeeeee")
+			},
+			ExpectedErr: errors.New("is no longer claimed by the caller"),
+		},
+		{
+			Name: "Error when outbox entry is no longer pending",
+			UpdateFn: func(svc outbox.OutboxService) error {
+				return svc.ScheduleRetry(ctx, entryID, claimToken, "retry error", nextAttemptAt)
+			},
+			Entry:       fixOutboxEntryModel(model.OutboxEntryStatusDelivered, 1),
+			ExpectedErr: errors.New("is no longer claimed by the caller"),
+		},
+		{
+			Name: "Error when updating outbox entry fails",
+			UpdateFn: func(svc outbox.OutboxService) error {
+				return svc.MarkAsFailed(ctx, entryID, claimToken, "fatal error")
+			},
+			UpdateErr:         testErr,
+			ExpectedStatus:    model.OutboxEntryStatusFailed,
+			ExpectedLastError: "fatal error",
+			ExpectedErr:       testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			entry := testCase.Entry
+			if entry == nil {
+				entry = fixOutboxEntryModel(model.OutboxEntryStatusPending, 1)
+			}
+
+			repo := &automock.OutboxRepository{}
+			if testCase.GetErr != nil {
+				repo.On("GetForUpdate", ctx, entryID).Return(nil, testCase.GetErr).Once()
+			} else {
+				repo.On("GetForUpdate", ctx, entryID).Return(entry, nil).Once()
+			}
+			if testCase.ExpectedStatus != "" {
+				repo.On("Update", ctx, mock.MatchedBy(func(entry *model.OutboxEntry) bool {
+					return entry.Status == testCase.ExpectedStatus && entry.LastError == testCase.ExpectedLastError &&
+						(testCase.ExpectedNextAttemptAt == nil || entry.NextAttemptAt.Equal(*testCase.ExpectedNextAttemptAt)) &&
+						entry.UpdatedAt.After(timestamp) && entry.ClaimToken == ""
+				})).Return(testCase.UpdateErr).Once()
+			}
+
+			svc := outbox.NewService(repo, nil)
+
+			// WHEN
+			err := testCase.UpdateFn(svc)
+
+			// THEN
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+
+			mock.AssertExpectationsForObjects(t, repo)
+		})
+	}
+}
+
+func TestService_DeleteDeliveredOlderThan(t *testing.T) {
+	// GIVEN
+	ctx := context.TODO()
+	retention := 24 * time.Hour
+
+	dateMatcher := mock.MatchedBy(func(date time.Time) bool {
+		return date.Before(time.Now().Add(-1 * retention))
+	})
+
+	testCases := []struct {
+		Name         string
+		RepositoryFn func() *automock.OutboxRepository
+		ExpectedErr  error
+	}{
+		{
+			Name: "Success",
+			RepositoryFn: func() *automock.OutboxRepository {
+				repo := &automock.OutboxRepository{}
+				repo.On("DeleteDeliveredOlderThan", ctx, dateMatcher).Return(nil).Once()
+				return repo
+			},
+		},
+		{
+			Name: "Error when deleting outbox entries fails",
+			RepositoryFn: func() *automock.OutboxRepository {
+				repo := &automock.OutboxRepository{}
+				repo.On("DeleteDeliveredOlderThan", ctx, dateMatcher).Return(testErr).Once()
+				return repo
+			},
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			repo := testCase.RepositoryFn()
+
+			svc := outbox.NewService(repo, nil)
+
+			// WHEN
+			err := svc.DeleteDeliveredOlderThan(ctx, retention)
+
+			// THEN
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+
+			mock.AssertExpectationsForObjects(t, repo)
+		})
+	}
+}
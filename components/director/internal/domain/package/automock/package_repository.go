@@ -139,6 +139,29 @@ func (_m *PackageRepository) GetByIDGlobal(ctx context.Context, id string) (*mod
 	return r0, r1
 }
 
+// ListByApplicationIDs provides a mock function with given fields: ctx, tenantID, appIDs
+func (_m *PackageRepository) ListByApplicationIDs(ctx context.Context, tenantID string, appIDs []string) ([]*model.Package, error) {
+	ret := _m.Called(ctx, tenantID, appIDs)
+
+	var r0 []*model.Package
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) []*model.Package); ok {
+		r0 = rf(ctx, tenantID, appIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Package)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, []string) error); ok {
+		r1 = rf(ctx, tenantID, appIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListByResourceID provides a mock function with given fields: ctx, tenantID, resourceID, resourceType
 func (_m *PackageRepository) ListByResourceID(ctx context.Context, tenantID string, resourceID string, resourceType resource.Type) ([]*model.Package, error) {
 	ret := _m.Called(ctx, tenantID, resourceID, resourceType)
@@ -3,6 +3,7 @@ package ordpackage
 import (
 	"github.com/kyma-incubator/compass/components/director/internal/repo"
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 )
@@ -84,3 +85,35 @@ func (c *converter) FromEntity(entity *Entity) (*model.Package, error) {
 
 	return output, nil
 }
+
+// ToGraphQL converts the provided service-layer representation of a Package to the graphql-layer one.
+func (c *converter) ToGraphQL(in *model.Package) *graphql.Package {
+	if in == nil {
+		return nil
+	}
+
+	return &graphql.Package{
+		ID:                in.ID,
+		OrdID:             in.OrdID,
+		Vendor:            in.Vendor,
+		Title:             in.Title,
+		ShortDescription:  in.ShortDescription,
+		Description:       in.Description,
+		Version:           in.Version,
+		PolicyLevel:       in.PolicyLevel,
+		CustomPolicyLevel: in.CustomPolicyLevel,
+	}
+}
+
+// MultipleToGraphQL converts the provided service-layer representations of Packages to the graphql-layer ones.
+func (c *converter) MultipleToGraphQL(in []*model.Package) []*graphql.Package {
+	packages := make([]*graphql.Package, 0, len(in))
+	for _, pkg := range in {
+		if pkg == nil {
+			continue
+		}
+		packages = append(packages, c.ToGraphQL(pkg))
+	}
+
+	return packages
+}
@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	ordpackage "github.com/kyma-incubator/compass/components/director/internal/domain/package"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -47,3 +49,39 @@ func TestEntityConverter_FromEntity(t *testing.T) {
 		require.Error(t, err)
 	})
 }
+
+func TestConverter_ToGraphQL(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		conv := ordpackage.NewConverter()
+
+		gqlPackage := conv.ToGraphQL(fixPackageModelForApp())
+
+		assert.Equal(t, fixGQLPackage(), gqlPackage)
+	})
+
+	t.Run("Returns nil if package model is nil", func(t *testing.T) {
+		conv := ordpackage.NewConverter()
+
+		gqlPackage := conv.ToGraphQL(nil)
+
+		require.Nil(t, gqlPackage)
+	})
+}
+
+func TestConverter_MultipleToGraphQL(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		conv := ordpackage.NewConverter()
+
+		gqlPackages := conv.MultipleToGraphQL([]*model.Package{fixPackageModelForApp(), nil})
+
+		assert.Equal(t, []*graphql.Package{fixGQLPackage()}, gqlPackages)
+	})
+
+	t.Run("Returns empty slice if input is empty", func(t *testing.T) {
+		conv := ordpackage.NewConverter()
+
+		gqlPackages := conv.MultipleToGraphQL(nil)
+
+		assert.Empty(t, gqlPackages)
+	})
+}
@@ -11,6 +11,7 @@ import (
 
 	ordpackage "github.com/kyma-incubator/compass/components/director/internal/domain/package"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 )
 
 const (
@@ -124,6 +125,20 @@ func fixPackageModelWithTitle(title string) *model.Package {
 	}
 }
 
+func fixGQLPackage() *graphql.Package {
+	vendorID := "vendorID"
+	return &graphql.Package{
+		ID:               packageID,
+		OrdID:            ordID,
+		Vendor:           &vendorID,
+		Title:            "title",
+		ShortDescription: "short desc",
+		Description:      "desc",
+		Version:          "v1.0.5",
+		PolicyLevel:      "test",
+	}
+}
+
 func fixPackageModelInput() *model.PackageInput {
 	vendorID := "vendorID"
 	licenceType := "test"
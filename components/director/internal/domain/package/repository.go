@@ -181,6 +181,24 @@ func (r *pgRepository) ListByResourceID(ctx context.Context, tenantID, resourceI
 	return pkgs, nil
 }
 
+// ListByApplicationIDs lists the Packages of the given Applications
+func (r *pgRepository) ListByApplicationIDs(ctx context.Context, tenantID string, appIDs []string) ([]*model.Package, error) {
+	pkgCollection := pkgCollection{}
+	if err := r.lister.List(ctx, resource.Package, tenantID, &pkgCollection, repo.NewInConditionForStringValues(appIDColumn, appIDs)); err != nil {
+		return nil, err
+	}
+
+	pkgs := make([]*model.Package, 0, pkgCollection.Len())
+	for _, pkg := range pkgCollection {
+		pkgModel, err := r.conv.FromEntity(&pkg)
+		if err != nil {
+			return nil, err
+		}
+		pkgs = append(pkgs, pkgModel)
+	}
+	return pkgs, nil
+}
+
 type pkgCollection []Entity
 
 // Len missing godoc
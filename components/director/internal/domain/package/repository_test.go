@@ -315,3 +315,34 @@ func TestPgRepository_ListByResourceID(t *testing.T) {
 	suiteForApp.Run(t)
 	suiteForAppTemplateVersion.Run(t)
 }
+
+func TestPgRepository_ListByApplicationIDs(t *testing.T) {
+	secondAppID := "secondAppID"
+
+	suite := testdb.RepoListTestSuite{
+		Name: "List Packages For Applications",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:    regexp.QuoteMeta(`SELECT id, app_id, app_template_version_id, ord_id, vendor, title, short_description, description, version, package_links, links, licence_type, tags, countries, labels, policy_level, custom_policy_level, part_of_products, line_of_business, industry, resource_hash, documentation_labels, support_info FROM public.packages WHERE app_id IN ($1, $2) AND (id IN (SELECT id FROM packages_tenants WHERE tenant_id = $3))`),
+				Args:     []driver.Value{appID, secondAppID, tenantID},
+				IsSelect: true,
+				ValidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixPackageColumns()).AddRow(fixPackageRowWithTitleForApp("title1")...).AddRow(fixPackageRowWithTitleForApp("title2")...)}
+				},
+				InvalidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixPackageColumns())}
+				},
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		RepoConstructorFunc:   ordpackage.NewRepository,
+		ExpectedModelEntities: []interface{}{fixPackageModelWithTitleForApp("title1"), fixPackageModelWithTitleForApp("title2")},
+		ExpectedDBEntities:    []interface{}{fixEntityPackageWithTitleForApp("title1"), fixEntityPackageWithTitleForApp("title2")},
+		MethodArgs:            []interface{}{tenantID, []string{appID, secondAppID}},
+		MethodName:            "ListByApplicationIDs",
+	}
+
+	suite.Run(t)
+}
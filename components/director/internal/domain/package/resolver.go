@@ -0,0 +1,154 @@
+package ordpackage
+
+import (
+	"context"
+
+	dataloader "github.com/kyma-incubator/compass/components/director/internal/dataloaders"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/pkg/errors"
+)
+
+// EntityTypeService is responsible for the service-layer Entity Type operations.
+//
+//go:generate mockery --name=EntityTypeService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type EntityTypeService interface {
+	ListByPackageIDs(ctx context.Context, packageIDs []string) ([][]*model.EntityType, error)
+}
+
+// EntityTypeConverter converts Entity Types between the model.EntityType service-layer representation and the graphql-layer representation graphql.EntityType.
+//
+//go:generate mockery --name=EntityTypeConverter --output=automock --outpkg=automock --case=underscore --disable-version-string
+type EntityTypeConverter interface {
+	MultipleToGraphQL(in []*model.EntityType) []*graphql.EntityType
+}
+
+// CapabilityService is responsible for the service-layer Capability operations.
+//
+//go:generate mockery --name=CapabilityService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type CapabilityService interface {
+	ListByPackageIDs(ctx context.Context, packageIDs []string) ([][]*model.Capability, error)
+}
+
+// CapabilityConverter converts Capabilities between the model.Capability service-layer representation and the graphql-layer representation graphql.Capability.
+//
+//go:generate mockery --name=CapabilityConverter --output=automock --outpkg=automock --case=underscore --disable-version-string
+type CapabilityConverter interface {
+	MultipleToGraphQL(in []*model.Capability) []*graphql.Capability
+}
+
+// Resolver is an object responsible for resolver-layer Package operations.
+type Resolver struct {
+	transact       persistence.Transactioner
+	entityTypeSvc  EntityTypeService
+	entityTypeConv EntityTypeConverter
+	capabilitySvc  CapabilityService
+	capabilityConv CapabilityConverter
+}
+
+// NewResolver returns a new object responsible for resolver-layer Package operations.
+func NewResolver(transact persistence.Transactioner, entityTypeSvc EntityTypeService, entityTypeConv EntityTypeConverter, capabilitySvc CapabilityService, capabilityConv CapabilityConverter) *Resolver {
+	return &Resolver{
+		transact:       transact,
+		entityTypeSvc:  entityTypeSvc,
+		entityTypeConv: entityTypeConv,
+		capabilitySvc:  capabilitySvc,
+		capabilityConv: capabilityConv,
+	}
+}
+
+// EntityTypes retrieves all Entity Types which are part of the given Package
+func (r *Resolver) EntityTypes(ctx context.Context, obj *graphql.Package) ([]*graphql.EntityType, error) {
+	if obj == nil {
+		return nil, apperrors.NewInternalError("Package cannot be empty")
+	}
+
+	param := dataloader.ParamEntityType{ID: obj.ID, Ctx: ctx}
+	return dataloader.EntityTypesFor(ctx).EntityTypeByPackageID.Load(param)
+}
+
+// EntityTypesDataLoader lists the Entity Types which are part of the Packages requested in a batch
+func (r *Resolver) EntityTypesDataLoader(keys []dataloader.ParamEntityType) ([][]*graphql.EntityType, []error) {
+	if len(keys) == 0 {
+		return nil, []error{apperrors.NewInternalError("No Packages found")}
+	}
+
+	ctx := keys[0].Ctx
+	packageIDs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		packageIDs = append(packageIDs, key.ID)
+	}
+
+	tx, err := persistence.BeginForCtx(ctx, r.transact)
+	if err != nil {
+		return nil, []error{err}
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	entityTypesPerPackage, err := r.entityTypeSvc.ListByPackageIDs(ctx, packageIDs)
+	if err != nil {
+		return nil, []error{errors.Wrapf(err, "while listing entity types for packages with ids %v", packageIDs)}
+	}
+
+	gqlEntityTypes := make([][]*graphql.EntityType, 0, len(entityTypesPerPackage))
+	for _, entityTypes := range entityTypesPerPackage {
+		gqlEntityTypes = append(gqlEntityTypes, r.entityTypeConv.MultipleToGraphQL(entityTypes))
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, []error{err}
+	}
+
+	return gqlEntityTypes, nil
+}
+
+// Capabilities retrieves all Capabilities which are part of the given Package
+func (r *Resolver) Capabilities(ctx context.Context, obj *graphql.Package) ([]*graphql.Capability, error) {
+	if obj == nil {
+		return nil, apperrors.NewInternalError("Package cannot be empty")
+	}
+
+	param := dataloader.ParamCapability{ID: obj.ID, Ctx: ctx}
+	return dataloader.CapabilitiesFor(ctx).CapabilityByPackageID.Load(param)
+}
+
+// CapabilitiesDataLoader lists the Capabilities which are part of the Packages requested in a batch
+func (r *Resolver) CapabilitiesDataLoader(keys []dataloader.ParamCapability) ([][]*graphql.Capability, []error) {
+	if len(keys) == 0 {
+		return nil, []error{apperrors.NewInternalError("No Packages found")}
+	}
+
+	ctx := keys[0].Ctx
+	packageIDs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		packageIDs = append(packageIDs, key.ID)
+	}
+
+	tx, err := persistence.BeginForCtx(ctx, r.transact)
+	if err != nil {
+		return nil, []error{err}
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	capabilitiesPerPackage, err := r.capabilitySvc.ListByPackageIDs(ctx, packageIDs)
+	if err != nil {
+		return nil, []error{errors.Wrapf(err, "while listing capabilities for packages with ids %v", packageIDs)}
+	}
+
+	gqlCapabilities := make([][]*graphql.Capability, 0, len(capabilitiesPerPackage))
+	for _, capabilities := range capabilitiesPerPackage {
+		gqlCapabilities = append(gqlCapabilities, r.capabilityConv.MultipleToGraphQL(capabilities))
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, []error{err}
+	}
+
+	return gqlCapabilities, nil
+}
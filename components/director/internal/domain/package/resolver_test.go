@@ -0,0 +1,273 @@
+package ordpackage_test
+
+import (
+	"context"
+	"testing"
+
+	dataloader "github.com/kyma-incubator/compass/components/director/internal/dataloaders"
+	ordpackage "github.com/kyma-incubator/compass/components/director/internal/domain/package"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/package/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	persistenceautomock "github.com/kyma-incubator/compass/components/director/pkg/persistence/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolver_EntityTypes(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("test error")
+
+	firstPackageID := "packageID"
+	secondPackageID := "packageID2"
+	packageIDs := []string{firstPackageID, secondPackageID}
+
+	entityTypeModels := []*model.EntityType{{ID: "entityTypeID", PackageID: firstPackageID, OrdID: "ns:entityType:ENTITY_TYPE_ID:v1", Title: "title"}}
+	gqlEntityTypes := []*graphql.EntityType{{ID: "entityTypeID", OrdID: "ns:entityType:ENTITY_TYPE_ID:v1", Title: "title"}}
+
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	testCases := []struct {
+		Name            string
+		TransactionerFn func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ServiceFn       func() *automock.EntityTypeService
+		ConverterFn     func() *automock.EntityTypeConverter
+		ExpectedResult  [][]*graphql.EntityType
+		ExpectedErr     error
+	}{
+		{
+			Name:            "Success",
+			TransactionerFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.EntityTypeService {
+				svc := &automock.EntityTypeService{}
+				svc.On("ListByPackageIDs", txtest.CtxWithDBMatcher(), packageIDs).Return([][]*model.EntityType{entityTypeModels, nil}, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.EntityTypeConverter {
+				conv := &automock.EntityTypeConverter{}
+				conv.On("MultipleToGraphQL", entityTypeModels).Return(gqlEntityTypes).Once()
+				conv.On("MultipleToGraphQL", []*model.EntityType(nil)).Return([]*graphql.EntityType{}).Once()
+				return conv
+			},
+			ExpectedResult: [][]*graphql.EntityType{gqlEntityTypes, {}},
+		},
+		{
+			Name:            "Returns error when transaction begin failed",
+			TransactionerFn: txGen.ThatFailsOnBegin,
+			ServiceFn: func() *automock.EntityTypeService {
+				return &automock.EntityTypeService{}
+			},
+			ConverterFn: func() *automock.EntityTypeConverter {
+				return &automock.EntityTypeConverter{}
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name:            "Returns error when Entity Types listing failed",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.EntityTypeService {
+				svc := &automock.EntityTypeService{}
+				svc.On("ListByPackageIDs", txtest.CtxWithDBMatcher(), packageIDs).Return(nil, testErr).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.EntityTypeConverter {
+				return &automock.EntityTypeConverter{}
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name:            "Returns error when transaction commit failed",
+			TransactionerFn: txGen.ThatFailsOnCommit,
+			ServiceFn: func() *automock.EntityTypeService {
+				svc := &automock.EntityTypeService{}
+				svc.On("ListByPackageIDs", txtest.CtxWithDBMatcher(), packageIDs).Return([][]*model.EntityType{entityTypeModels, nil}, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.EntityTypeConverter {
+				conv := &automock.EntityTypeConverter{}
+				conv.On("MultipleToGraphQL", entityTypeModels).Return(gqlEntityTypes).Once()
+				conv.On("MultipleToGraphQL", []*model.EntityType(nil)).Return([]*graphql.EntityType{}).Once()
+				return conv
+			},
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			persist, transact := testCase.TransactionerFn()
+			svc := testCase.ServiceFn()
+			converter := testCase.ConverterFn()
+
+			resolver := ordpackage.NewResolver(transact, svc, converter, nil, nil)
+
+			firstPackageParams := dataloader.ParamEntityType{ID: firstPackageID, Ctx: context.TODO()}
+			secondPackageParams := dataloader.ParamEntityType{ID: secondPackageID, Ctx: context.TODO()}
+			keys := []dataloader.ParamEntityType{firstPackageParams, secondPackageParams}
+
+			// WHEN
+			result, errs := resolver.EntityTypesDataLoader(keys)
+
+			// THEN
+			if testCase.ExpectedErr != nil {
+				require.Len(t, errs, 1)
+				assert.Contains(t, errs[0].Error(), testCase.ExpectedErr.Error())
+			} else {
+				require.Nil(t, errs)
+			}
+			assert.Equal(t, testCase.ExpectedResult, result)
+
+			mock.AssertExpectationsForObjects(t, persist, transact, svc, converter)
+		})
+	}
+
+	t.Run("Returns error when there are no Packages", func(t *testing.T) {
+		resolver := ordpackage.NewResolver(nil, nil, nil, nil, nil)
+		// WHEN
+		_, errs := resolver.EntityTypesDataLoader([]dataloader.ParamEntityType{})
+		// THEN
+		require.Error(t, errs[0])
+		assert.EqualError(t, errs[0], apperrors.NewInternalError("No Packages found").Error())
+	})
+
+	t.Run("Returns error when package is nil", func(t *testing.T) {
+		resolver := ordpackage.NewResolver(nil, nil, nil, nil, nil)
+		// WHEN
+		_, err := resolver.EntityTypes(context.TODO(), nil)
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Package cannot be empty")
+	})
+}
+
+func TestResolver_Capabilities(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("test error")
+
+	firstPackageID := "packageID"
+	secondPackageID := "packageID2"
+	packageIDs := []string{firstPackageID, secondPackageID}
+
+	capabilityModels := []*model.Capability{{ID: "capabilityID", PackageID: firstPackageID, OrdID: "ns:capability:CAPABILITY_ID:v1", Title: "title"}}
+	gqlCapabilities := []*graphql.Capability{{ID: "capabilityID", OrdID: "ns:capability:CAPABILITY_ID:v1", Title: "title"}}
+
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	testCases := []struct {
+		Name            string
+		TransactionerFn func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ServiceFn       func() *automock.CapabilityService
+		ConverterFn     func() *automock.CapabilityConverter
+		ExpectedResult  [][]*graphql.Capability
+		ExpectedErr     error
+	}{
+		{
+			Name:            "Success",
+			TransactionerFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.CapabilityService {
+				svc := &automock.CapabilityService{}
+				svc.On("ListByPackageIDs", txtest.CtxWithDBMatcher(), packageIDs).Return([][]*model.Capability{capabilityModels, nil}, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.CapabilityConverter {
+				conv := &automock.CapabilityConverter{}
+				conv.On("MultipleToGraphQL", capabilityModels).Return(gqlCapabilities).Once()
+				conv.On("MultipleToGraphQL", []*model.Capability(nil)).Return([]*graphql.Capability{}).Once()
+				return conv
+			},
+			ExpectedResult: [][]*graphql.Capability{gqlCapabilities, {}},
+		},
+		{
+			Name:            "Returns error when transaction begin failed",
+			TransactionerFn: txGen.ThatFailsOnBegin,
+			ServiceFn: func() *automock.CapabilityService {
+				return &automock.CapabilityService{}
+			},
+			ConverterFn: func() *automock.CapabilityConverter {
+				return &automock.CapabilityConverter{}
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name:            "Returns error when Capabilities listing failed",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.CapabilityService {
+				svc := &automock.CapabilityService{}
+				svc.On("ListByPackageIDs", txtest.CtxWithDBMatcher(), packageIDs).Return(nil, testErr).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.CapabilityConverter {
+				return &automock.CapabilityConverter{}
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name:            "Returns error when transaction commit failed",
+			TransactionerFn: txGen.ThatFailsOnCommit,
+			ServiceFn: func() *automock.CapabilityService {
+				svc := &automock.CapabilityService{}
+				svc.On("ListByPackageIDs", txtest.CtxWithDBMatcher(), packageIDs).Return([][]*model.Capability{capabilityModels, nil}, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.CapabilityConverter {
+				conv := &automock.CapabilityConverter{}
+				conv.On("MultipleToGraphQL", capabilityModels).Return(gqlCapabilities).Once()
+				conv.On("MultipleToGraphQL", []*model.Capability(nil)).Return([]*graphql.Capability{}).Once()
+				return conv
+			},
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			persist, transact := testCase.TransactionerFn()
+			svc := testCase.ServiceFn()
+			converter := testCase.ConverterFn()
+
+			resolver := ordpackage.NewResolver(transact, nil, nil, svc, converter)
+
+			firstPackageParams := dataloader.ParamCapability{ID: firstPackageID, Ctx: context.TODO()}
+			secondPackageParams := dataloader.ParamCapability{ID: secondPackageID, Ctx: context.TODO()}
+			keys := []dataloader.ParamCapability{firstPackageParams, secondPackageParams}
+
+			// WHEN
+			result, errs := resolver.CapabilitiesDataLoader(keys)
+
+			// THEN
+			if testCase.ExpectedErr != nil {
+				require.Len(t, errs, 1)
+				assert.Contains(t, errs[0].Error(), testCase.ExpectedErr.Error())
+			} else {
+				require.Nil(t, errs)
+			}
+			assert.Equal(t, testCase.ExpectedResult, result)
+
+			mock.AssertExpectationsForObjects(t, persist, transact, svc, converter)
+		})
+	}
+
+	t.Run("Returns error when there are no Packages", func(t *testing.T) {
+		resolver := ordpackage.NewResolver(nil, nil, nil, nil, nil)
+		// WHEN
+		_, errs := resolver.CapabilitiesDataLoader([]dataloader.ParamCapability{})
+		// THEN
+		require.Error(t, errs[0])
+		assert.EqualError(t, errs[0], apperrors.NewInternalError("No Packages found").Error())
+	})
+
+	t.Run("Returns error when package is nil", func(t *testing.T) {
+		resolver := ordpackage.NewResolver(nil, nil, nil, nil, nil)
+		// WHEN
+		_, err := resolver.Capabilities(context.TODO(), nil)
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Package cannot be empty")
+	})
+}
@@ -25,6 +25,7 @@ type PackageRepository interface {
 	GetByID(ctx context.Context, tenant, id string) (*model.Package, error)
 	GetByIDGlobal(ctx context.Context, id string) (*model.Package, error)
 	ListByResourceID(ctx context.Context, tenantID, resourceID string, resourceType resource.Type) ([]*model.Package, error)
+	ListByApplicationIDs(ctx context.Context, tenantID string, appIDs []string) ([]*model.Package, error)
 }
 
 // UIDService missing godoc
@@ -129,6 +130,33 @@ func (s *service) ListByApplicationID(ctx context.Context, appID string) ([]*mod
 	return s.pkgRepo.ListByResourceID(ctx, tnt, appID, resource.Application)
 }
 
+// ListByApplicationIDs lists the Packages of each of the given Applications, in the order of the Application IDs
+func (s *service) ListByApplicationIDs(ctx context.Context, appIDs []string) ([][]*model.Package, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pkgs, err := s.pkgRepo.ListByApplicationIDs(ctx, tnt, appIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	pkgsPerApplication := make(map[string][]*model.Package, len(appIDs))
+	for _, pkg := range pkgs {
+		if pkg.ApplicationID != nil {
+			pkgsPerApplication[*pkg.ApplicationID] = append(pkgsPerApplication[*pkg.ApplicationID], pkg)
+		}
+	}
+
+	result := make([][]*model.Package, 0, len(appIDs))
+	for _, appID := range appIDs {
+		result = append(result, pkgsPerApplication[appID])
+	}
+
+	return result, nil
+}
+
 // ListByApplicationTemplateVersionID lists packages by Application Template Version ID without tenant isolation
 func (s *service) ListByApplicationTemplateVersionID(ctx context.Context, appTemplateVersionID string) ([]*model.Package, error) {
 	return s.pkgRepo.ListByResourceID(ctx, "", appTemplateVersionID, resource.ApplicationTemplateVersion)
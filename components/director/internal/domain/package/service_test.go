@@ -572,6 +572,78 @@ func TestService_ListByApplicationID(t *testing.T) {
 	})
 }
 
+func TestService_ListByApplicationIDs(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("Test error")
+	secondAppID := "secondAppID"
+
+	pkgs := []*model.Package{
+		fixPackageModelWithTitleForApp("title1"),
+		fixPackageModelWithTitleForApp("title2"),
+	}
+
+	ctx := context.TODO()
+	ctx = tenant.SaveToContext(ctx, tenantID, externalTenantID)
+
+	testCases := []struct {
+		Name               string
+		RepositoryFn       func() *automock.PackageRepository
+		ExpectedResult     [][]*model.Package
+		ExpectedErrMessage string
+	}{
+		{
+			Name: "Success",
+			RepositoryFn: func() *automock.PackageRepository {
+				repo := &automock.PackageRepository{}
+				repo.On("ListByApplicationIDs", ctx, tenantID, []string{appID, secondAppID}).Return(pkgs, nil).Once()
+				return repo
+			},
+			ExpectedResult:     [][]*model.Package{pkgs, nil},
+			ExpectedErrMessage: "",
+		},
+		{
+			Name: "Returns error when Package listing failed",
+			RepositoryFn: func() *automock.PackageRepository {
+				repo := &automock.PackageRepository{}
+				repo.On("ListByApplicationIDs", ctx, tenantID, []string{appID, secondAppID}).Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedResult:     nil,
+			ExpectedErrMessage: testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepositoryFn()
+
+			svc := ordpackage.NewService(repo, nil)
+
+			// WHEN
+			result, err := svc.ListByApplicationIDs(ctx, []string{appID, secondAppID})
+
+			// THEN
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedResult, result)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+	t.Run("Error when tenant not in context", func(t *testing.T) {
+		svc := ordpackage.NewService(nil, nil)
+		// WHEN
+		_, err := svc.ListByApplicationIDs(context.TODO(), []string{appID})
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot read tenant from context")
+	})
+}
+
 func TestService_ListByApplicationTemplateVersionID(t *testing.T) {
 	// GIVEN
 	testErr := errors.New("Test error")
@@ -41,17 +41,22 @@ import (
 	bundleutil "github.com/kyma-incubator/compass/components/director/internal/domain/bundle"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/bundleinstanceauth"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/bundlereferences"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/capability"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/document"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/entitytype"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/eventdef"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/eventing"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/fetchrequest"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/formation"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/healthcheck"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/integrationdependency"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/integrationsystem"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/label"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/labeldef"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/oauth20"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/onetimetoken"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/operation"
+	ordpackage "github.com/kyma-incubator/compass/components/director/internal/domain/package"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/runtime"
 	runtimectx "github.com/kyma-incubator/compass/components/director/internal/domain/runtime_context"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/scenarioassignment"
@@ -67,6 +72,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/pkg/accessstrategy"
 	"github.com/kyma-incubator/compass/components/director/pkg/config"
 	"github.com/kyma-incubator/compass/components/director/pkg/consumer"
+	"github.com/kyma-incubator/compass/components/director/pkg/encryption"
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 	httputil "github.com/kyma-incubator/compass/components/director/pkg/http"
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
@@ -91,6 +97,7 @@ type RootResolver struct {
 	runtime             *runtime.Resolver
 	runtimeContext      *runtimectx.Resolver
 	healthCheck         *healthcheck.Resolver
+	integrationDep      *integrationdependency.Resolver
 	webhook             *webhook.Resolver
 	labelDef            *labeldef.Resolver
 	token               *onetimetoken.Resolver
@@ -107,6 +114,8 @@ type RootResolver struct {
 	formationConstraint *formationconstraint.Resolver
 	constraintReference *formationtemplateconstraintreferences.Resolver
 	certSubjectMapping  *certsubjectmapping.Resolver
+	operation           *operation.Resolver
+	ordPackage          *ordpackage.Resolver
 }
 
 // NewRootResolver missing godoc
@@ -132,6 +141,7 @@ func NewRootResolver(
 	callbackURL string,
 	appTemplateProductLabel string,
 	destinationCreatorConfig *destinationcreator.Config,
+	credentialsEncryptor encryption.Encryptor,
 ) (*RootResolver, error) {
 	timeService := time.NewService()
 
@@ -148,23 +158,23 @@ func NewRootResolver(
 	tokenConverter := onetimetoken.NewConverter(oneTimeTokenCfg.LegacyConnectorURL)
 	authConverter := auth.NewConverterWithOTT(tokenConverter)
 	runtimeContextConverter := runtimectx.NewConverter()
-	frConverter := fetchrequest.NewConverter(authConverter)
+	frConverter := fetchrequest.NewConverter(authConverter, credentialsEncryptor)
 	versionConverter := version.NewConverter()
 	docConverter := document.NewConverter(frConverter)
-	webhookConverter := webhook.NewConverter(authConverter)
+	webhookConverter := webhook.NewConverter(authConverter, credentialsEncryptor)
 	specConverter := spec.NewConverter(frConverter)
 	apiConverter := api.NewConverter(versionConverter, specConverter)
 	eventAPIConverter := eventdef.NewConverter(versionConverter, specConverter)
 	labelDefConverter := labeldef.NewConverter()
 	labelConverter := label.NewConverter()
-	systemAuthConverter := systemauth.NewConverter(authConverter)
+	systemAuthConverter := systemauth.NewConverter(authConverter, credentialsEncryptor)
 	intSysConverter := integrationsystem.NewConverter()
 	tenantConverter := tenant.NewConverter()
-	bundleConverter := bundleutil.NewConverter(authConverter, apiConverter, eventAPIConverter, docConverter)
+	bundleConverter := bundleutil.NewConverter(authConverter, apiConverter, eventAPIConverter, docConverter, credentialsEncryptor)
 	appConverter := application.NewConverter(webhookConverter, bundleConverter)
 	appTemplateConverter := apptemplate.NewConverter(appConverter, webhookConverter)
 	tenantBusinessTypeConverter := tenantbusinesstype.NewConverter()
-	bundleInstanceAuthConv := bundleinstanceauth.NewConverter(authConverter)
+	bundleInstanceAuthConv := bundleinstanceauth.NewConverter(authConverter, credentialsEncryptor)
 	assignmentConv := scenarioassignment.NewConverter()
 	bundleReferenceConv := bundlereferences.NewConverter()
 	formationConv := formation.NewConverter()
@@ -176,8 +186,14 @@ func NewRootResolver(
 	constraintReferencesConverter := formationtemplateconstraintreferences.NewConverter()
 	certSubjectMappingConv := certsubjectmapping.NewConverter()
 	destinationConv := destination.NewConverter()
-
-	healthcheckRepo := healthcheck.NewRepository()
+	operationConv := operation.NewConverter()
+	healthCheckConv := healthcheck.NewConverter()
+	packageConv := ordpackage.NewConverter()
+	entityTypeConv := entitytype.NewConverter()
+	integrationDependencyConv := integrationdependency.NewConverter()
+	capabilityConv := capability.NewConverter()
+
+	healthcheckRepo := healthcheck.NewRepository(healthCheckConv)
 	runtimeRepo := runtime.NewRepository(runtimeConverter)
 	runtimeContextRepo := runtimectx.NewRepository(runtimectx.NewConverter())
 	applicationRepo := application.NewRepository(appConverter)
@@ -205,10 +221,15 @@ func NewRootResolver(
 	constraintReferencesRepo := formationtemplateconstraintreferences.NewRepository(constraintReferencesConverter)
 	certSubjectMappingRepo := certsubjectmapping.NewRepository(certSubjectMappingConv)
 	destinationRepo := destination.NewRepository(destinationConv)
+	operationRepo := operation.NewRepository(operationConv)
+	packageRepo := ordpackage.NewRepository(packageConv)
+	entityTypeRepo := entitytype.NewRepository(entityTypeConv)
+	integrationDependencyRepo := integrationdependency.NewRepository(integrationDependencyConv)
+	capabilityRepo := capability.NewRepository(capabilityConv)
 
 	uidSvc := uid.NewService()
 	labelSvc := label.NewLabelService(labelRepo, labelDefRepo, uidSvc)
-	appTemplateSvc := apptemplate.NewService(appTemplateRepo, webhookRepo, uidSvc, labelSvc, labelRepo, applicationRepo)
+	appTemplateSvc := apptemplate.NewService(appTemplateRepo, webhookRepo, uidSvc, labelSvc, labelRepo, applicationRepo, credentialsEncryptor)
 	tenantBusinessTypeSvc := tenantbusinesstype.NewService(tenantBusinessTypeRepo, uidSvc)
 	labelDefSvc := labeldef.NewService(labelDefRepo, labelRepo, scenarioAssignmentRepo, tenantRepo, uidSvc)
 	fetchRequestSvc := fetchrequest.NewServiceWithRetry(fetchRequestRepo, httpClient, accessStrategyExecutorProvider, retryHTTPExecutor)
@@ -220,7 +241,7 @@ func NewRootResolver(
 	webhookSvc := webhook.NewService(webhookRepo, applicationRepo, uidSvc, tenantSvc, tenantMappingConfig, callbackURL)
 	docSvc := document.NewService(docRepo, fetchRequestRepo, uidSvc)
 	scenarioAssignmentSvc := scenarioassignment.NewService(scenarioAssignmentRepo, labelDefSvc)
-	healthCheckSvc := healthcheck.NewService(healthcheckRepo)
+	healthCheckSvc := healthcheck.NewService(healthcheckRepo, uidSvc)
 	systemAuthSvc := systemauth.NewService(systemAuthRepo, uidSvc)
 	oAuth20Svc := oauth20.NewService(cfgProvider, uidSvc, oAuth20Cfg.PublicAccessTokenEndpoint, hydra.Admin)
 	intSysSvc := integrationsystem.NewService(intSysRepo, uidSvc)
@@ -239,7 +260,7 @@ func NewRootResolver(
 	notificationsGenerator := formation.NewNotificationsGenerator(applicationRepo, appTemplateRepo, runtimeRepo, runtimeContextRepo, labelRepo, webhookRepo, webhookDataInputBuilder, notificationsBuilder)
 	notificationSvc := formation.NewNotificationService(tenantRepo, webhookClient, notificationsGenerator, constraintEngine, webhookConverter, formationTemplateRepo)
 	faNotificationSvc := formationassignment.NewFormationAssignmentNotificationService(formationAssignmentRepo, webhookConverter, webhookRepo, tenantRepo, webhookDataInputBuilder, formationRepo, notificationsBuilder, runtimeContextRepo, labelSvc, featuresConfig.RuntimeTypeLabelKey, featuresConfig.ApplicationTypeLabelKey)
-	formationAssignmentStatusSvc := formationassignment.NewFormationAssignmentStatusService(formationAssignmentRepo, constraintEngine, faNotificationSvc)
+	formationAssignmentStatusSvc := formationassignment.NewFormationAssignmentStatusService(formationAssignmentRepo, uidSvc, constraintEngine, faNotificationSvc)
 	formationAssignmentSvc := formationassignment.NewService(formationAssignmentRepo, uidSvc, applicationRepo, runtimeRepo, runtimeContextRepo, notificationSvc, faNotificationSvc, labelSvc, formationRepo, formationAssignmentStatusSvc, featuresConfig.RuntimeTypeLabelKey, featuresConfig.ApplicationTypeLabelKey)
 	formationStatusSvc := formation.NewFormationStatusService(formationRepo, labelDefRepo, labelDefSvc, notificationSvc, constraintEngine)
 	formationSvc := formation.NewService(transact, applicationRepo, labelDefRepo, labelRepo, formationRepo, formationTemplateRepo, labelSvc, uidSvc, labelDefSvc, scenarioAssignmentRepo, scenarioAssignmentSvc, tenantSvc, runtimeRepo, runtimeContextRepo, formationAssignmentSvc, faNotificationSvc, notificationSvc, constraintEngine, webhookRepo, formationStatusSvc, featuresConfig.RuntimeTypeLabelKey, featuresConfig.ApplicationTypeLabelKey)
@@ -252,6 +273,11 @@ func NewRootResolver(
 	formationTemplateSvc := formationtemplate.NewService(formationTemplateRepo, uidSvc, formationTemplateConverter, tenantSvc, webhookRepo, webhookSvc)
 	constraintReferenceSvc := formationtemplateconstraintreferences.NewService(constraintReferencesRepo, constraintReferencesConverter)
 	certSubjectMappingSvc := certsubjectmapping.NewService(certSubjectMappingRepo)
+	operationSvc := operation.NewService(operationRepo, uidSvc)
+	packageSvc := ordpackage.NewService(packageRepo, uidSvc)
+	entityTypeSvc := entitytype.NewService(entityTypeRepo, uidSvc)
+	integrationDependencySvc := integrationdependency.NewService(integrationDependencyRepo, apiRepo, eventAPIRepo, uidSvc)
+	capabilitySvc := capability.NewService(capabilityRepo, uidSvc)
 
 	selfRegisterManager, err := selfregmanager.NewSelfRegisterManager(selfRegConfig, &selfregmanager.CallerProvider{})
 	if err != nil {
@@ -260,7 +286,7 @@ func NewRootResolver(
 
 	return &RootResolver{
 		appNameNormalizer:   appNameNormalizer,
-		app:                 application.NewResolver(transact, appSvc, webhookSvc, oAuth20Svc, systemAuthSvc, appConverter, webhookConverter, systemAuthConverter, eventingSvc, bundleSvc, bundleConverter, specSvc, apiSvc, eventAPISvc, apiConverter, eventAPIConverter, appTemplateSvc, appTemplateConverter, tenantBusinessTypeSvc, tenantBusinessTypeConverter, selfRegConfig.SelfRegisterDistinguishLabelKey, featuresConfig.TokenPrefix),
+		app:                 application.NewResolver(transact, appSvc, webhookSvc, oAuth20Svc, systemAuthSvc, appConverter, webhookConverter, systemAuthConverter, eventingSvc, bundleSvc, bundleConverter, specSvc, apiSvc, eventAPISvc, apiConverter, eventAPIConverter, appTemplateSvc, appTemplateConverter, tenantBusinessTypeSvc, tenantBusinessTypeConverter, packageSvc, packageConv, entityTypeSvc, entityTypeConv, capabilitySvc, capabilityConv, selfRegConfig.SelfRegisterDistinguishLabelKey, featuresConfig.TokenPrefix),
 		appTemplate:         apptemplate.NewResolver(transact, appSvc, appConverter, appTemplateSvc, appTemplateConverter, webhookSvc, webhookConverter, selfRegisterManager, uidSvc, certSubjectMappingSvc, appTemplateProductLabel),
 		api:                 api.NewResolver(transact, apiSvc, runtimeSvc, bundleSvc, bundleReferenceSvc, apiConverter, frConverter, specSvc, specConverter, appSvc),
 		eventAPI:            eventdef.NewResolver(transact, eventAPISvc, bundleSvc, bundleReferenceSvc, eventAPIConverter, frConverter, specSvc, specConverter),
@@ -269,7 +295,8 @@ func NewRootResolver(
 		formation:           formation.NewResolver(transact, formationSvc, formationConv, formationAssignmentSvc, formationAssignmentConv, tenantOnDemandSvc),
 		runtime:             runtime.NewResolver(transact, runtimeSvc, scenarioAssignmentSvc, systemAuthSvc, oAuth20Svc, runtimeConverter, systemAuthConverter, eventingSvc, bundleInstanceAuthSvc, selfRegisterManager, uidSvc, subscriptionSvc, runtimeContextSvc, runtimeContextConverter, webhookSvc, webhookConverter, tenantOnDemandSvc, formationSvc),
 		runtimeContext:      runtimectx.NewResolver(transact, runtimeContextSvc, runtimeContextConverter),
-		healthCheck:         healthcheck.NewResolver(healthCheckSvc),
+		healthCheck:         healthcheck.NewResolver(transact, healthCheckSvc, healthCheckConv),
+		integrationDep:      integrationdependency.NewResolver(transact, integrationDependencySvc, integrationDependencyConv),
 		webhook:             webhook.NewResolver(transact, webhookSvc, appSvc, appTemplateSvc, runtimeSvc, formationTemplateSvc, webhookConverter),
 		labelDef:            labeldef.NewResolver(transact, labelDefSvc, formationSvc, labelDefConverter),
 		token:               onetimetoken.NewTokenResolver(transact, tokenSvc, tokenConverter, oneTimeTokenCfg.SuggestTokenHeaderKey),
@@ -283,9 +310,11 @@ func NewRootResolver(
 		scenarioAssignment:  scenarioassignment.NewResolver(transact, scenarioAssignmentSvc, assignmentConv, tenantSvc),
 		subscription:        subscription.NewResolver(transact, subscriptionSvc),
 		formationTemplate:   formationtemplate.NewResolver(transact, formationTemplateConverter, formationTemplateSvc, webhookConverter, formationConstraintSvc, formationConstraintConverter),
-		formationConstraint: formationconstraint.NewResolver(transact, formationConstraintConverter, formationConstraintSvc),
+		formationConstraint: formationconstraint.NewResolver(transact, formationConstraintConverter, formationConstraintSvc, constraintEngine),
 		constraintReference: formationtemplateconstraintreferences.NewResolver(transact, constraintReferencesConverter, constraintReferenceSvc),
 		certSubjectMapping:  certsubjectmapping.NewResolver(transact, certSubjectMappingConv, certSubjectMappingSvc, uidSvc),
+		operation:           operation.NewResolver(transact, operationSvc, operationConv),
+		ordPackage:          ordpackage.NewResolver(transact, entityTypeSvc, entityTypeConv, capabilitySvc, capabilityConv),
 	}, nil
 }
 
@@ -344,6 +373,31 @@ func (r *RootResolver) FormationConstraintsDataLoader(ids []dataloader.ParamForm
 	return r.formationTemplate.FormationConstraintsDataLoader(ids)
 }
 
+// PackagesDataLoader is the Package dataloader used in the graphql API router
+func (r *RootResolver) PackagesDataLoader(ids []dataloader.ParamPackage) ([][]*graphql.Package, []error) {
+	return r.app.PackagesDataLoader(ids)
+}
+
+// EntityTypesByApplicationIDDataLoader is the dataloader for the Entity Types of Applications used in the graphql API router
+func (r *RootResolver) EntityTypesByApplicationIDDataLoader(ids []dataloader.ParamEntityType) ([][]*graphql.EntityType, []error) {
+	return r.app.EntityTypesDataLoader(ids)
+}
+
+// EntityTypesByPackageIDDataLoader is the dataloader for the Entity Types of Packages used in the graphql API router
+func (r *RootResolver) EntityTypesByPackageIDDataLoader(ids []dataloader.ParamEntityType) ([][]*graphql.EntityType, []error) {
+	return r.ordPackage.EntityTypesDataLoader(ids)
+}
+
+// CapabilitiesByApplicationIDDataLoader is the dataloader for the Capabilities of Applications used in the graphql API router
+func (r *RootResolver) CapabilitiesByApplicationIDDataLoader(ids []dataloader.ParamCapability) ([][]*graphql.Capability, []error) {
+	return r.app.CapabilitiesDataLoader(ids)
+}
+
+// CapabilitiesByPackageIDDataLoader is the dataloader for the Capabilities of Packages used in the graphql API router
+func (r *RootResolver) CapabilitiesByPackageIDDataLoader(ids []dataloader.ParamCapability) ([][]*graphql.Capability, []error) {
+	return r.ordPackage.CapabilitiesDataLoader(ids)
+}
+
 // Mutation missing godoc
 func (r *RootResolver) Mutation() graphql.MutationResolver {
 	return &mutationResolver{r}
@@ -379,6 +433,11 @@ func (r *RootResolver) Formation() graphql.FormationResolver {
 	return &formationResolver{r}
 }
 
+// FormationAssignment returns the resolver for the fields of a FormationAssignment
+func (r *RootResolver) FormationAssignment() graphql.FormationAssignmentResolver {
+	return &formationAssignmentResolver{r}
+}
+
 // APISpec missing godoc
 func (r *RootResolver) APISpec() graphql.APISpecResolver {
 	return &apiSpecResolver{r}
@@ -435,6 +494,10 @@ func (r *queryResolver) FormationConstraintsByFormationType(ctx context.Context,
 	return r.formationConstraint.FormationConstraintsByFormationType(ctx, formationTemplateID)
 }
 
+func (r *queryResolver) EvaluateFormationConstraints(ctx context.Context, formationTemplateID string, location graphql.FormationConstraintJoinPointLocationInput, objectID string, objectType graphql.ResourceType) ([]*graphql.FormationConstraintEvaluation, error) {
+	return r.formationConstraint.EvaluateFormationConstraints(ctx, formationTemplateID, location, objectID, objectType)
+}
+
 func (r *queryResolver) Formation(ctx context.Context, id string) (*graphql.Formation, error) {
 	return r.formation.Formation(ctx, id)
 }
@@ -578,6 +641,11 @@ func (r *queryResolver) BundleInstanceAuth(ctx context.Context, id string) (*gra
 	return r.bundleInstanceAuth.BundleInstanceAuth(ctx, id)
 }
 
+// IntegrationDependencyProviders resolves the providers which satisfy the Integration Dependencies of the given Application
+func (r *queryResolver) IntegrationDependencyProviders(ctx context.Context, applicationID string) ([]*graphql.IntegrationDependencyProvider, error) {
+	return r.integrationDep.IntegrationDependencyProviders(ctx, applicationID)
+}
+
 // HealthChecks missing godoc
 func (r *queryResolver) HealthChecks(ctx context.Context, types []graphql.HealthCheckType, origin *string, first *int, after *graphql.PageCursor) (*graphql.HealthCheckPage, error) {
 	return r.healthCheck.HealthChecks(ctx, types, origin, first, after)
@@ -631,6 +699,16 @@ func (r *queryResolver) CertificateSubjectMappings(ctx context.Context, first *i
 	return r.certSubjectMapping.CertificateSubjectMappings(ctx, first, after)
 }
 
+// Operations lists the operations matching the provided filter
+func (r *queryResolver) Operations(ctx context.Context, filter *graphql.OperationFilter, first *int, after *graphql.PageCursor) (*graphql.OperationPage, error) {
+	return r.operation.Operations(ctx, filter, first, after)
+}
+
+// Operation fetches the operation with the provided id
+func (r *queryResolver) Operation(ctx context.Context, id string) (*graphql.Operation, error) {
+	return r.operation.Operation(ctx, id)
+}
+
 type mutationResolver struct {
 	*RootResolver
 }
@@ -651,6 +729,10 @@ func (r *mutationResolver) ResynchronizeFormationNotifications(ctx context.Conte
 	return r.formation.ResynchronizeFormationNotifications(ctx, formationID, reset)
 }
 
+func (r *mutationResolver) FinalizeFormation(ctx context.Context, formationID string) (*graphql.Formation, error) {
+	return r.formation.FinalizeFormation(ctx, formationID)
+}
+
 func (r *mutationResolver) AttachConstraintToFormationTemplate(ctx context.Context, constraintID string, formationTemplateID string) (*graphql.ConstraintReference, error) {
 	return r.constraintReference.AttachConstraintToFormationTemplate(ctx, constraintID, formationTemplateID)
 }
@@ -683,12 +765,16 @@ func (r *mutationResolver) AssignFormation(ctx context.Context, objectID string,
 	return r.formation.AssignFormation(ctx, objectID, objectType, formation)
 }
 
+func (r *mutationResolver) AssignFormationBulk(ctx context.Context, objects []*graphql.FormationObjectInput, formation graphql.FormationInput) ([]*graphql.FormationObjectAssignmentResult, error) {
+	return r.formation.AssignFormationBulk(ctx, objects, formation)
+}
+
 func (r *mutationResolver) UnassignFormation(ctx context.Context, objectID string, objectType graphql.FormationObjectType, formation graphql.FormationInput) (*graphql.Formation, error) {
 	return r.formation.UnassignFormation(ctx, objectID, objectType, formation)
 }
 
-func (r *mutationResolver) CreateFormation(ctx context.Context, formationInput graphql.FormationInput) (*graphql.Formation, error) {
-	return r.formation.CreateFormation(ctx, formationInput)
+func (r *mutationResolver) CreateFormation(ctx context.Context, formationInput graphql.FormationInput, draft *bool) (*graphql.Formation, error) {
+	return r.formation.CreateFormation(ctx, formationInput, draft)
 }
 
 func (r *mutationResolver) DeleteFormation(ctx context.Context, formation graphql.FormationInput) (*graphql.Formation, error) {
@@ -741,6 +827,11 @@ func (r *mutationResolver) DeleteApplicationTemplate(ctx context.Context, id str
 	return r.appTemplate.DeleteApplicationTemplate(ctx, id)
 }
 
+// UpgradeApplicationsFromTemplate upgrades the applications created from the given application template
+func (r *mutationResolver) UpgradeApplicationsFromTemplate(ctx context.Context, templateID string, dryRun *bool) (*graphql.ApplicationTemplateUpgradeReport, error) {
+	return r.appTemplate.UpgradeApplicationsFromTemplate(ctx, templateID, dryRun)
+}
+
 // AddWebhook missing godoc
 func (r *mutationResolver) AddWebhook(ctx context.Context, applicationID *string, applicationTemplateID *string, runtimeID *string, formationTemplateID *string, in graphql.WebhookInput) (*graphql.Webhook, error) {
 	return r.webhook.AddWebhook(ctx, applicationID, applicationTemplateID, runtimeID, formationTemplateID, in)
@@ -1050,6 +1141,16 @@ func (r *mutationResolver) DeleteCertificateSubjectMapping(ctx context.Context,
 	return r.certSubjectMapping.DeleteCertificateSubjectMapping(ctx, id)
 }
 
+// RescheduleOperation moves the operation with the provided id back to SCHEDULED status
+func (r *mutationResolver) RescheduleOperation(ctx context.Context, id string, priority *int) (*graphql.Operation, error) {
+	return r.operation.RescheduleOperation(ctx, id, priority)
+}
+
+// ScheduleORDAggregation schedules ORD aggregation for an application and/or application template
+func (r *mutationResolver) ScheduleORDAggregation(ctx context.Context, appID *string, appTemplateID *string) (*graphql.Operation, error) {
+	return r.operation.ScheduleORDAggregation(ctx, appID, appTemplateID)
+}
+
 type applicationResolver struct {
 	*RootResolver
 }
@@ -1104,6 +1205,21 @@ func (r *applicationResolver) TenantBusinessType(ctx context.Context, obj *graph
 	return r.app.TenantBusinessType(ctx, obj)
 }
 
+// Packages resolves the ORD packages of the application object
+func (r *applicationResolver) Packages(ctx context.Context, obj *graphql.Application) ([]*graphql.Package, error) {
+	return r.app.Packages(ctx, obj)
+}
+
+// EntityTypes resolves the ORD entity types of the application object
+func (r *applicationResolver) EntityTypes(ctx context.Context, obj *graphql.Application) ([]*graphql.EntityType, error) {
+	return r.app.EntityTypes(ctx, obj)
+}
+
+// Capabilities resolves the ORD capabilities of the application object
+func (r *applicationResolver) Capabilities(ctx context.Context, obj *graphql.Application) ([]*graphql.Capability, error) {
+	return r.app.Capabilities(ctx, obj)
+}
+
 type applicationTemplateResolver struct {
 	*RootResolver
 }
@@ -1118,6 +1234,25 @@ func (r applicationTemplateResolver) Labels(ctx context.Context, obj *graphql.Ap
 	return r.appTemplate.Labels(ctx, obj, key)
 }
 
+type packageResolver struct {
+	*RootResolver
+}
+
+// Package represents the resolver for ORD Package
+func (r *RootResolver) Package() graphql.PackageResolver {
+	return &packageResolver{r}
+}
+
+// EntityTypes resolves the entity types which are part of the package object
+func (r *packageResolver) EntityTypes(ctx context.Context, obj *graphql.Package) ([]*graphql.EntityType, error) {
+	return r.ordPackage.EntityTypes(ctx, obj)
+}
+
+// Capabilities resolves the capabilities which are part of the package object
+func (r *packageResolver) Capabilities(ctx context.Context, obj *graphql.Package) ([]*graphql.Capability, error) {
+	return r.ordPackage.Capabilities(ctx, obj)
+}
+
 type formationTemplateResolver struct {
 	*RootResolver
 }
@@ -1250,6 +1385,15 @@ func (r *formationResolver) Status(ctx context.Context, obj *graphql.Formation)
 	return r.formation.Status(ctx, obj)
 }
 
+type formationAssignmentResolver struct {
+	*RootResolver
+}
+
+// History returns the recorded state transitions of the FormationAssignment
+func (r *formationAssignmentResolver) History(ctx context.Context, obj *graphql.FormationAssignment) ([]*graphql.FormationAssignmentTransition, error) {
+	return r.formation.FormationAssignmentHistory(ctx, obj)
+}
+
 // BundleResolver missing godoc
 type BundleResolver struct{ *RootResolver }
 
@@ -223,7 +223,7 @@ func (r *Resolver) Runtimes(ctx context.Context, filter []*graphql.LabelFilter,
 		cursor = string(*after)
 	}
 
-	tx, err := r.transact.Begin()
+	tx, err := r.transact.BeginReadOnly()
 	if err != nil {
 		return nil, err
 	}
@@ -259,7 +259,7 @@ func (r *Resolver) Runtimes(ctx context.Context, filter []*graphql.LabelFilter,
 
 // Runtime missing godoc
 func (r *Resolver) Runtime(ctx context.Context, id string) (*graphql.Runtime, error) {
-	tx, err := r.transact.Begin()
+	tx, err := r.transact.BeginReadOnly()
 	if err != nil {
 		return nil, err
 	}
@@ -284,7 +284,7 @@ func (r *Resolver) Runtime(ctx context.Context, id string) (*graphql.Runtime, er
 
 // RuntimeByTokenIssuer returns a Runtime by a token issuer
 func (r *Resolver) RuntimeByTokenIssuer(ctx context.Context, issuer string) (*graphql.Runtime, error) {
-	tx, err := r.transact.Begin()
+	tx, err := r.transact.BeginReadOnly()
 	if err != nil {
 		return nil, err
 	}
@@ -714,7 +714,7 @@ func (r *Resolver) RuntimeContextsDataLoader(keys []dataloader.ParamRuntimeConte
 		return nil, []error{apperrors.NewInvalidDataError("missing required parameter 'first'")}
 	}
 
-	tx, err := r.transact.Begin()
+	tx, err := persistence.BeginForCtx(ctx, r.transact)
 	if err != nil {
 		return nil, []error{err}
 	}
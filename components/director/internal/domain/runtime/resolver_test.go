@@ -1764,7 +1764,7 @@ func TestResolver_Runtime(t *testing.T) {
 				persistTx.On("Commit").Return(nil).Once()
 				return persistTx
 			},
-			TransactionerFn: txtest.TransactionerThatSucceeds,
+			TransactionerFn: txtest.ReadOnlyTransactionerThatSucceeds,
 			ServiceFn: func() *automock.RuntimeService {
 				svc := &automock.RuntimeService{}
 				svc.On("Get", contextParam, "foo").Return(modelRuntime, nil).Once()
@@ -1788,7 +1788,7 @@ func TestResolver_Runtime(t *testing.T) {
 				persistTx.On("Commit").Return(nil).Once()
 				return persistTx
 			},
-			TransactionerFn: txtest.TransactionerThatSucceeds,
+			TransactionerFn: txtest.ReadOnlyTransactionerThatSucceeds,
 			ServiceFn: func() *automock.RuntimeService {
 				svc := &automock.RuntimeService{}
 				svc.On("Get", contextParam, "foo").Return(modelRuntime, apperrors.NewNotFoundError(resource.Runtime, "foo")).Once()
@@ -1807,7 +1807,7 @@ func TestResolver_Runtime(t *testing.T) {
 				persistTx := &persistenceautomock.PersistenceTx{}
 				return persistTx
 			},
-			TransactionerFn: txtest.TransactionerThatDoesARollback,
+			TransactionerFn: txtest.ReadOnlyTransactionerThatDoesARollback,
 			ServiceFn: func() *automock.RuntimeService {
 				svc := &automock.RuntimeService{}
 				svc.On("Get", contextParam, "foo").Return(nil, testErr).Once()
@@ -1884,7 +1884,7 @@ func TestResolver_Runtimes(t *testing.T) {
 				persistTx.On("Commit").Return(nil).Once()
 				return persistTx
 			},
-			TransactionerFn: txtest.TransactionerThatSucceeds,
+			TransactionerFn: txtest.ReadOnlyTransactionerThatSucceeds,
 			ServiceFn: func() *automock.RuntimeService {
 				svc := &automock.RuntimeService{}
 				svc.On("List", contextParam, filter, first, after).Return(fixRuntimePage(modelRuntimes), nil).Once()
@@ -1908,7 +1908,7 @@ func TestResolver_Runtimes(t *testing.T) {
 				persistTx := &persistenceautomock.PersistenceTx{}
 				return persistTx
 			},
-			TransactionerFn: txtest.TransactionerThatDoesARollback,
+			TransactionerFn: txtest.ReadOnlyTransactionerThatDoesARollback,
 			ServiceFn: func() *automock.RuntimeService {
 				svc := &automock.RuntimeService{}
 				svc.On("List", contextParam, filter, first, after).Return(nil, testErr).Once()
@@ -1971,7 +1971,7 @@ func TestResolver_RuntimeByTokenIssuer(t *testing.T) {
 				persistTx.On("Commit").Return(nil).Once()
 				return persistTx
 			},
-			TransactionerFn: txtest.TransactionerThatSucceeds,
+			TransactionerFn: txtest.ReadOnlyTransactionerThatSucceeds,
 			ServiceFn: func() *automock.RuntimeService {
 				svc := &automock.RuntimeService{}
 				svc.On("GetByTokenIssuer", contextParam, "foo").Return(modelRuntime, nil).Once()
@@ -1995,7 +1995,7 @@ func TestResolver_RuntimeByTokenIssuer(t *testing.T) {
 				persistTx.On("Commit").Return(nil).Once()
 				return persistTx
 			},
-			TransactionerFn: txtest.TransactionerThatSucceeds,
+			TransactionerFn: txtest.ReadOnlyTransactionerThatSucceeds,
 			ServiceFn: func() *automock.RuntimeService {
 				svc := &automock.RuntimeService{}
 				svc.On("GetByTokenIssuer", contextParam, "foo").Return(modelRuntime, apperrors.NewNotFoundError(resource.Runtime, "foo")).Once()
@@ -2014,7 +2014,7 @@ func TestResolver_RuntimeByTokenIssuer(t *testing.T) {
 				persistTx := &persistenceautomock.PersistenceTx{}
 				return persistTx
 			},
-			TransactionerFn: txtest.TransactionerThatDoesARollback,
+			TransactionerFn: txtest.ReadOnlyTransactionerThatDoesARollback,
 			ServiceFn: func() *automock.RuntimeService {
 				svc := &automock.RuntimeService{}
 				svc.On("GetByTokenIssuer", contextParam, "foo").Return(nil, testErr).Once()
@@ -289,7 +289,11 @@ func (s *service) RefetchSpec(ctx context.Context, id string, objectType model.S
 	}
 
 	if fetchRequest != nil {
-		spec.Data = s.fetchRequestService.HandleSpec(ctx, fetchRequest)
+		data := s.fetchRequestService.HandleSpec(ctx, fetchRequest)
+		// nil data with a succeeded status means that the spec is not modified since it was last fetched
+		if data != nil || fetchRequest.Status == nil || fetchRequest.Status.Condition != model.FetchRequestStatusConditionSucceeded {
+			spec.Data = data
+		}
 	}
 
 	if err = s.repo.Update(ctx, tnt, spec); err != nil {
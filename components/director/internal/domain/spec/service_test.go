@@ -1645,6 +1645,12 @@ func TestService_RefetchSpec(t *testing.T) {
 			Timestamp: timestamp,
 		},
 	}
+	notModifiedFr := &model.FetchRequest{
+		Status: &model.FetchRequestStatus{
+			Condition: model.FetchRequestStatusConditionSucceeded,
+			Timestamp: timestamp,
+		},
+	}
 
 	testCases := []struct {
 		Name               string
@@ -1694,6 +1700,27 @@ func TestService_RefetchSpec(t *testing.T) {
 			ExpectedAPISpec: modelSpec,
 			ExpectedErr:     nil,
 		},
+		{
+			Name: "Success - API Spec is not modified",
+			RepositoryFn: func() *automock.SpecRepository {
+				repo := &automock.SpecRepository{}
+				repo.On("GetByID", ctx, tenant, specID, model.APISpecReference).Return(modelSpec, nil).Once()
+				repo.On("Update", ctx, tenant, modelSpec).Return(nil).Once()
+				return repo
+			},
+			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("GetByReferenceObjectID", ctx, tenant, model.APISpecFetchRequestReference, specID).Return(notModifiedFr, nil)
+				return repo
+			},
+			FetchRequestSvcFn: func() *automock.FetchRequestService {
+				svc := &automock.FetchRequestService{}
+				svc.On("HandleSpec", ctx, notModifiedFr).Return(nil)
+				return svc
+			},
+			ExpectedAPISpec: &model.Spec{Data: &dataBytes},
+			ExpectedErr:     nil,
+		},
 		{
 			Name: "Get from repository error",
 			RepositoryFn: func() *automock.SpecRepository {
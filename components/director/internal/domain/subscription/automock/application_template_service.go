@@ -81,6 +81,20 @@ func (_m *ApplicationTemplateService) PrepareApplicationCreateInputJSON(appTempl
 	return r0, r1
 }
 
+// StoreApplicationTemplateValues provides a mock function with given fields: ctx, appID, values
+func (_m *ApplicationTemplateService) StoreApplicationTemplateValues(ctx context.Context, appID string, values model.ApplicationFromTemplateInputValues) error {
+	ret := _m.Called(ctx, appID, values)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.ApplicationFromTemplateInputValues) error); ok {
+		r0 = rf(ctx, appID, values)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 type mockConstructorTestingTNewApplicationTemplateService interface {
 	mock.TestingT
 	Cleanup(func())
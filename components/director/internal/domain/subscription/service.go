@@ -88,6 +88,7 @@ type ApplicationTemplateService interface {
 	Exists(ctx context.Context, id string) (bool, error)
 	GetByFilters(ctx context.Context, filter []*labelfilter.LabelFilter) (*model.ApplicationTemplate, error)
 	PrepareApplicationCreateInputJSON(appTemplate *model.ApplicationTemplate, values model.ApplicationFromTemplateInputValues) (string, error)
+	StoreApplicationTemplateValues(ctx context.Context, appID string, values model.ApplicationFromTemplateInputValues) error
 }
 
 // ApplicationTemplateConverter missing godoc
@@ -482,6 +483,10 @@ func (s *service) createApplicationFromTemplate(ctx context.Context, appTemplate
 		return errors.Wrapf(err, "while creating an Application with name %s from Application Template with name %s", subscribedAppName, appTemplate.Name)
 	}
 
+	if err := s.appTemplateSvc.StoreApplicationTemplateValues(ctx, appID, values); err != nil {
+		return errors.Wrapf(err, "while storing the values of Application with id %s", appID)
+	}
+
 	log.C(ctx).Infof("Successfully created an Application with id %q and name %q from Application Template with name %q", appID, subscribedAppName, appTemplate.Name)
 	return nil
 }
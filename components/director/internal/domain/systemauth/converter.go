@@ -4,8 +4,10 @@ import (
 	"database/sql"
 	"encoding/json"
 
+	"github.com/kyma-incubator/compass/components/director/internal/domain/auth"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 
+	"github.com/kyma-incubator/compass/components/director/pkg/encryption"
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 	pkgmodel "github.com/kyma-incubator/compass/components/director/pkg/model"
 
@@ -22,13 +24,15 @@ type AuthConverter interface {
 }
 
 type converter struct {
-	authConverter AuthConverter
+	authConverter        AuthConverter
+	credentialsEncryptor encryption.Encryptor
 }
 
 // NewConverter missing godoc
-func NewConverter(authConverter AuthConverter) *converter {
+func NewConverter(authConverter AuthConverter, credentialsEncryptor encryption.Encryptor) *converter {
 	return &converter{
-		authConverter: authConverter,
+		authConverter:        authConverter,
+		credentialsEncryptor: credentialsEncryptor,
 	}
 }
 
@@ -85,7 +89,12 @@ func (c *converter) ToGraphQL(in *pkgmodel.SystemAuth) (graphql.SystemAuth, erro
 func (c *converter) ToEntity(in pkgmodel.SystemAuth) (Entity, error) {
 	value := sql.NullString{}
 	if in.Value != nil {
-		valueMarshalled, err := json.Marshal(in.Value)
+		encryptedValue, err := auth.EncryptCredentials(c.credentialsEncryptor, in.Value)
+		if err != nil {
+			return Entity{}, err
+		}
+
+		valueMarshalled, err := json.Marshal(encryptedValue)
 		if err != nil {
 			return Entity{}, errors.Wrap(err, "while marshalling Value")
 		}
@@ -112,7 +121,10 @@ func (c *converter) FromEntity(in Entity) (pkgmodel.SystemAuth, error) {
 		if err != nil {
 			return pkgmodel.SystemAuth{}, err
 		}
-		value = &tmpAuth
+
+		if value, err = auth.DecryptCredentials(c.credentialsEncryptor, &tmpAuth); err != nil {
+			return pkgmodel.SystemAuth{}, err
+		}
 	}
 
 	return pkgmodel.SystemAuth{
@@ -8,6 +8,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/domain/systemauth/automock"
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/systemauth"
+	"github.com/kyma-incubator/compass/components/director/pkg/encryption"
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 
 	"github.com/stretchr/testify/assert"
@@ -75,7 +76,7 @@ func TestConverter_ToGraphQL(t *testing.T) {
 	for _, testCase := range testCases {
 		t.Run(testCase.Name, func(t *testing.T) {
 			authConv := testCase.AuthConvFn()
-			conv := systemauth.NewConverter(authConv)
+			conv := systemauth.NewConverter(authConv, encryption.NewNoopEncryptor())
 
 			// WHEN
 			result, err := conv.ToGraphQL(testCase.Input)
@@ -130,7 +131,7 @@ func TestConverter_ToEntity(t *testing.T) {
 
 	for _, testCase := range testCases {
 		t.Run(testCase.Name, func(t *testing.T) {
-			conv := systemauth.NewConverter(nil)
+			conv := systemauth.NewConverter(nil, encryption.NewNoopEncryptor())
 
 			// WHEN
 			result, err := conv.ToEntity(testCase.Input)
@@ -189,7 +190,7 @@ func TestConverter_FromEntity(t *testing.T) {
 
 	for _, testCase := range testCases {
 		t.Run(testCase.Name, func(t *testing.T) {
-			conv := systemauth.NewConverter(nil)
+			conv := systemauth.NewConverter(nil, encryption.NewNoopEncryptor())
 
 			// WHEN
 			result, err := conv.FromEntity(testCase.Input)
@@ -8,11 +8,14 @@ import (
 
 	"github.com/kyma-incubator/compass/components/director/pkg/auth"
 
+	authdomain "github.com/kyma-incubator/compass/components/director/internal/domain/auth"
+
 	"github.com/kyma-incubator/compass/components/director/internal/repo"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/encryption"
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 	"github.com/pkg/errors"
 )
@@ -26,12 +29,13 @@ type AuthConverter interface {
 }
 
 type converter struct {
-	authConverter AuthConverter
+	authConverter        AuthConverter
+	credentialsEncryptor encryption.Encryptor
 }
 
 // NewConverter missing godoc
-func NewConverter(authConverter AuthConverter) *converter {
-	return &converter{authConverter: authConverter}
+func NewConverter(authConverter AuthConverter, credentialsEncryptor encryption.Encryptor) *converter {
+	return &converter{authConverter: authConverter, credentialsEncryptor: credentialsEncryptor}
 }
 
 // ToGraphQL missing godoc
@@ -286,7 +290,12 @@ func (c *converter) toAuthEntity(in model.Webhook) (sql.NullString, error) {
 		return optionalAuth, nil
 	}
 
-	b, err := json.Marshal(in.Auth)
+	encryptedAuth, err := authdomain.EncryptCredentials(c.credentialsEncryptor, in.Auth)
+	if err != nil {
+		return sql.NullString{}, err
+	}
+
+	b, err := json.Marshal(encryptedAuth)
 	if err != nil {
 		return sql.NullString{}, errors.Wrap(err, "while marshalling Auth")
 	}
@@ -354,7 +363,7 @@ func (c *converter) fromEntityAuth(in Entity) (*model.Auth, error) {
 		return nil, errors.Wrap(err, "while unmarshaling Auth")
 	}
 
-	return auth, nil
+	return authdomain.DecryptCredentials(c.credentialsEncryptor, auth)
 }
 
 func (c *converter) objectReferenceFromEntity(in Entity) (string, model.WebhookReferenceObjectType, error) {
@@ -7,6 +7,8 @@ import (
 	"time"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/auth"
+	"github.com/kyma-incubator/compass/components/director/pkg/encryption"
+	encryptionautomock "github.com/kyma-incubator/compass/components/director/pkg/encryption/automock"
 
 	"github.com/kyma-incubator/compass/components/director/internal/repo"
 
@@ -18,6 +20,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 var (
@@ -56,7 +59,7 @@ func TestConverter_ToGraphQL(t *testing.T) {
 			if testCase.Input != nil {
 				authConv.On("ToGraphQL", testCase.Input.Auth).Return(testCase.Expected.Auth, nil)
 			}
-			converter := webhook.NewConverter(authConv)
+			converter := webhook.NewConverter(authConv, encryption.NewNoopEncryptor())
 
 			// WHEN
 			res, err := converter.ToGraphQL(testCase.Input)
@@ -147,7 +150,7 @@ func TestConverter_ToModel(t *testing.T) {
 
 	for _, testCase := range testCases {
 		t.Run(testCase.Name, func(t *testing.T) {
-			converter := webhook.NewConverter(&automock.AuthConverter{})
+			converter := webhook.NewConverter(&automock.AuthConverter{}, encryption.NewNoopEncryptor())
 
 			// WHEN
 			res, err := converter.ToModel(testCase.Input)
@@ -175,7 +178,7 @@ func TestConverter_MultipleToGraphQL(t *testing.T) {
 	authConv := &automock.AuthConverter{}
 	authConv.On("ToGraphQL", input[0].Auth).Return(expected[0].Auth, nil)
 	authConv.On("ToGraphQL", (*model.Auth)(nil)).Return(nil, nil)
-	converter := webhook.NewConverter(authConv)
+	converter := webhook.NewConverter(authConv, encryption.NewNoopEncryptor())
 
 	// WHEN
 	res, err := converter.MultipleToGraphQL(input)
@@ -220,7 +223,7 @@ func TestConverter_InputFromGraphQL(t *testing.T) {
 			if testCase.Input != nil && testCase.Error == nil {
 				authConv.On("InputFromGraphQL", testCase.Input.Auth).Return(testCase.Expected.Auth, nil)
 			}
-			converter := webhook.NewConverter(authConv)
+			converter := webhook.NewConverter(authConv, encryption.NewNoopEncryptor())
 
 			// WHEN
 			res, err := converter.InputFromGraphQL(testCase.Input)
@@ -250,7 +253,7 @@ func TestConverter_MultipleInputFromGraphQL(t *testing.T) {
 	}
 	authConv := &automock.AuthConverter{}
 	authConv.On("InputFromGraphQL", input[0].Auth).Return(expected[0].Auth, nil)
-	converter := webhook.NewConverter(authConv)
+	converter := webhook.NewConverter(authConv, encryption.NewNoopEncryptor())
 
 	// WHEN
 	res, err := converter.MultipleInputFromGraphQL(input)
@@ -262,7 +265,7 @@ func TestConverter_MultipleInputFromGraphQL(t *testing.T) {
 }
 
 func TestConverter_ToEntity(t *testing.T) {
-	sut := webhook.NewConverter(nil)
+	sut := webhook.NewConverter(nil, encryption.NewNoopEncryptor())
 
 	b, err := json.Marshal(fixBasicAuth())
 	require.NoError(t, err)
@@ -321,7 +324,7 @@ func TestConverter_ToEntity(t *testing.T) {
 
 func TestConverter_FromEntity(t *testing.T) {
 	// GIVEN
-	sut := webhook.NewConverter(nil)
+	sut := webhook.NewConverter(nil, encryption.NewNoopEncryptor())
 	b, err := json.Marshal(fixBasicAuth())
 	require.NoError(t, err)
 
@@ -395,3 +398,26 @@ func TestConverter_FromEntity(t *testing.T) {
 		})
 	}
 }
+
+func TestConverter_EntityConversionEncryptsCredentials(t *testing.T) {
+	// GIVEN
+	encryptor := &encryptionautomock.Encryptor{}
+	encryptor.On("Encrypt", "bbb").Return("encrypted-bbb", nil).Once()
+	encryptor.On("Decrypt", "encrypted-bbb").Return("bbb", nil).Once()
+
+	sut := webhook.NewConverter(nil, encryptor)
+	in := &model.Webhook{ObjectID: givenAppID, ObjectType: model.ApplicationWebhookReference, Auth: fixBasicAuth()}
+
+	// WHEN
+	entity, err := sut.ToEntity(in)
+	require.NoError(t, err)
+	result, err := sut.FromEntity(entity)
+	require.NoError(t, err)
+
+	// THEN
+	assert.Contains(t, entity.Auth.String, "encrypted-bbb")
+	assert.NotContains(t, entity.Auth.String, `"bbb"`)
+	assert.Equal(t, fixBasicAuth(), in.Auth)
+	assert.Equal(t, fixBasicAuth(), result.Auth)
+	mock.AssertExpectationsForObjects(t, encryptor)
+}
@@ -3,9 +3,13 @@ package formationmapping_test
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
+	"strings"
 	"testing"
 
+	"github.com/kyma-incubator/compass/components/director/pkg/correlation"
+
 	tenantpkg "github.com/kyma-incubator/compass/components/director/pkg/tenant"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
@@ -113,6 +117,22 @@ func fixFormationAssignmentModelWithStateAndConfig(testFormationAssignmentID, te
 	}
 }
 
+func fixOutboxEntry(t *testing.T, entryType model.OutboxEntryType, obj interface{}) *model.OutboxEntry {
+	payload, err := json.Marshal(obj)
+	require.NoError(t, err)
+
+	return &model.OutboxEntry{
+		ID:             "entryID",
+		IdempotencyKey: "idempotencyKey",
+		Type:           entryType,
+		TenantID:       internalTntID,
+		CorrelationID:  "correlationID",
+		Payload:        payload,
+		Status:         model.OutboxEntryStatusPending,
+		Attempts:       1,
+	}
+}
+
 func fixBusinessTenantMapping() *model.BusinessTenantMapping {
 	return &model.BusinessTenantMapping{
 		ID:             internalTntID,
@@ -149,6 +169,39 @@ func contextThatHasTenant(expectedTenant string) interface{} {
 	})
 }
 
+func outboxEntryInputThatMatches(expectedType model.OutboxEntryType, expectedTenant string, expectedPayload interface{}) interface{} {
+	return mock.MatchedBy(func(actual *model.OutboxEntryInput) bool {
+		payload, err := json.Marshal(expectedPayload)
+		if err != nil {
+			return false
+		}
+
+		var keyPrefix string
+		switch obj := expectedPayload.(type) {
+		case *model.FormationAssignment:
+			keyPrefix = fmt.Sprintf("%s:%s:%s:", expectedType, obj.ID, obj.State)
+		case *model.Formation:
+			keyPrefix = fmt.Sprintf("%s:%s:%s:", expectedType, obj.ID, obj.State)
+		default:
+			return false
+		}
+
+		return actual.Type == expectedType && actual.TenantID == expectedTenant && string(actual.Payload) == string(payload) &&
+			strings.HasPrefix(actual.IdempotencyKey, keyPrefix) && len(actual.IdempotencyKey) > len(keyPrefix)
+	})
+}
+
+func contextThatHasNotificationHeaders(expectedTenant, expectedCorrelationID, expectedIdempotencyKey string) interface{} {
+	return mock.MatchedBy(func(actual context.Context) bool {
+		actualTenant, err := tenant.LoadFromContext(actual)
+		if err != nil || actualTenant != expectedTenant {
+			return false
+		}
+		ctxHeaders := correlation.HeadersFromContext(actual)
+		return ctxHeaders[correlation.RequestIDHeaderKey] == expectedCorrelationID && ctxHeaders[fm.IdempotencyKeyHeader] == expectedIdempotencyKey
+	})
+}
+
 func contextThatHasConsumer(expectedConsumerID string) interface{} {
 	return mock.MatchedBy(func(actual context.Context) bool {
 		consumer, err := consumer.LoadFromContext(actual)
@@ -182,6 +235,10 @@ func fixUnusedFormationSvc() *automock.FormationService {
 	return &automock.FormationService{}
 }
 
+func fixUnusedNotificationsOutboxSvc() *automock.NotificationsOutboxService {
+	return &automock.NotificationsOutboxService{}
+}
+
 func fixUnusedRuntimeRepo() *automock.RuntimeRepository {
 	return &automock.RuntimeRepository{}
 }
@@ -213,40 +270,3 @@ func fixUnusedFormationRepo() *automock.FormationRepository {
 func fixUnusedFormationTemplateRepo() *automock.FormationTemplateRepository {
 	return &automock.FormationTemplateRepository{}
 }
-
-func ThatDoesNotCommitInGoRoutine() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
-	persistTx := &persistenceautomock.PersistenceTx{}
-	persistTx.On("Commit").Return(nil).Once()
-
-	transact := &persistenceautomock.Transactioner{}
-	transact.On("Begin").Return(persistTx, nil).Twice()
-	transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true).Once()
-	transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Once()
-
-	return persistTx, transact
-}
-
-func ThatFailsOnCommitInGoRoutine() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
-	persistTx := &persistenceautomock.PersistenceTx{}
-	persistTx.On("Commit").Return(nil).Once()
-	persistTx.On("Commit").Return(testErr).Once()
-
-	transact := &persistenceautomock.Transactioner{}
-	transact.On("Begin").Return(persistTx, nil).Twice()
-	transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true).Once()
-	transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Once()
-
-	return persistTx, transact
-}
-
-func ThatFailsOnBeginInGoRoutine() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
-	persistTx := &persistenceautomock.PersistenceTx{}
-	persistTx.On("Commit").Return(nil).Once()
-
-	transact := &persistenceautomock.Transactioner{}
-	transact.On("Begin").Return(persistTx, nil).Once()
-	transact.On("Begin").Return(persistTx, testErr).Once()
-	transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Once()
-
-	return persistTx, transact
-}
@@ -25,6 +25,7 @@ import (
 
 	"github.com/go-openapi/runtime/middleware/header"
 	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/google/uuid"
 	"github.com/gorilla/mux"
 	"github.com/kyma-incubator/compass/components/director/pkg/httputils"
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
@@ -48,25 +49,32 @@ type FormationRequestBody struct {
 	Error string               `json:"error,omitempty"`
 }
 
+// NotificationsOutboxService is responsible for storing the notifications, which have to be sent after a status update, in the notifications outbox
+//
+//go:generate mockery --name=NotificationsOutboxService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type NotificationsOutboxService interface {
+	Enqueue(ctx context.Context, in *model.OutboxEntryInput) error
+}
+
 // Handler is the base struct definition of the FormationMappingHandler
 type Handler struct {
 	transact               persistence.Transactioner
 	faService              FormationAssignmentService
 	faStatusService        formationAssignmentStatusService
-	faNotificationService  FormationAssignmentNotificationService
 	formationService       formationService
 	formationStatusService formationStatusService
+	outboxService          NotificationsOutboxService
 }
 
 // NewFormationMappingHandler creates a formation mapping Handler
-func NewFormationMappingHandler(transact persistence.Transactioner, faService FormationAssignmentService, faStatusService formationAssignmentStatusService, faNotificationService FormationAssignmentNotificationService, formationService formationService, formationStatusService formationStatusService) *Handler {
+func NewFormationMappingHandler(transact persistence.Transactioner, faService FormationAssignmentService, faStatusService formationAssignmentStatusService, formationService formationService, formationStatusService formationStatusService, outboxService NotificationsOutboxService) *Handler {
 	return &Handler{
 		transact:               transact,
 		faService:              faService,
 		faStatusService:        faStatusService,
-		faNotificationService:  faNotificationService,
 		formationService:       formationService,
 		formationStatusService: formationStatusService,
+		outboxService:          outboxService,
 	}
 }
 
@@ -124,6 +132,7 @@ func (h *Handler) UpdateFormationAssignmentStatus(w http.ResponseWriter, r *http
 	}
 
 	ctx = tenant.SaveToContext(ctx, fa.TenantID, "")
+	ctx = formationassignment.SaveTransitionActorToContext(ctx, model.StatusAPITransitionActor)
 
 	formation, err := h.formationService.Get(ctx, formationID)
 	if err != nil {
@@ -181,6 +190,17 @@ func (h *Handler) UpdateFormationAssignmentStatus(w http.ResponseWriter, r *http
 		return
 	}
 
+	if shouldProcessNotifications {
+		if len(reqBody.Configuration) == 0 { // do not generate formation assignment notifications when configuration is not provided
+			log.C(ctx).Info("No configuration is provided in the request body. Formation assignment notification won't be generated")
+		} else if err = h.enqueueNotifications(ctx, model.FormationAssignmentNotificationsOutboxEntryType, fa.ID, fa.State, fa.TenantID, correlationID, fa); err != nil {
+			// The formation assignment notifications are stored in the same transaction as the status update and are sent asynchronously by the notifications outbox dispatcher
+			log.C(ctx).WithError(err).Errorf("An error occurred while enqueueing formation assignment notifications for ID: %q and formation ID: %q", fa.ID, fa.FormationID)
+			respondWithError(ctx, w, http.StatusInternalServerError, errResp)
+			return
+		}
+	}
+
 	if err = tx.Commit(); err != nil {
 		log.C(ctx).WithError(err).Error("An error occurred while closing database transaction")
 		respondWithError(ctx, w, http.StatusInternalServerError, errResp)
@@ -188,18 +208,6 @@ func (h *Handler) UpdateFormationAssignmentStatus(w http.ResponseWriter, r *http
 	}
 	log.C(ctx).Infof("The formation assignment with ID: %q and formation ID: %q was successfully updated with state: %q", formationAssignmentID, formationID, fa.State)
 
-	if len(reqBody.Configuration) == 0 { // do not generate formation assignment notifications when configuration is not provided
-		log.C(ctx).Info("No configuration is provided in the request body. Formation assignment notification won't be generated")
-		httputils.Respond(w, http.StatusOK)
-		return
-	}
-
-	if shouldProcessNotifications {
-		// The formation assignment notifications processing is independent of the status update request handling.
-		// That's why we're executing it in a go routine and in parallel to this returning a response to the client
-		go h.processFormationAssignmentNotifications(fa, correlationID)
-	}
-
 	httputils.Respond(w, http.StatusOK)
 }
 
@@ -284,6 +292,15 @@ func (h *Handler) UpdateFormationStatus(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	if shouldResync {
+		// The formation notifications are stored in the same transaction as the status update and are sent asynchronously by the notifications outbox dispatcher
+		if err = h.enqueueNotifications(ctx, model.FormationNotificationsOutboxEntryType, f.ID, string(f.State), f.TenantID, correlationID, f); err != nil {
+			log.C(ctx).WithError(err).Errorf("An error occurred while enqueueing formation notifications for formation with ID: %q", f.ID)
+			respondWithError(ctx, w, http.StatusInternalServerError, errResp)
+			return
+		}
+	}
+
 	if err = tx.Commit(); err != nil {
 		log.C(ctx).WithError(err).Error("An error occurred while closing database transaction")
 		respondWithError(ctx, w, http.StatusInternalServerError, errResp)
@@ -291,12 +308,6 @@ func (h *Handler) UpdateFormationStatus(w http.ResponseWriter, r *http.Request)
 	}
 	log.C(ctx).Infof("The status update for formation with ID: %q was successfully processed for %q operation", formationID, model.CreateFormation)
 
-	if shouldResync {
-		// The formation notifications processing is independent of the status update request handling.
-		// That's why we're executing it in a go routine and in parallel to this returning a response to the client
-		go h.processFormationNotifications(f, correlationID)
-	}
-
 	httputils.Respond(w, http.StatusOK)
 }
 
@@ -486,117 +497,23 @@ func (h *Handler) processFormationCreateStatusUpdate(ctx context.Context, format
 	return true, nil
 }
 
-func (h *Handler) processFormationAssignmentNotifications(fa *model.FormationAssignment, correlationID string) {
-	ctx, cancel := context.WithCancel(context.TODO())
-	defer cancel()
-
-	ctx = tenant.SaveToContext(ctx, fa.TenantID, "")
-
-	correlationIDKey := correlation.RequestIDHeaderKey
-	ctx = correlation.SaveCorrelationIDHeaderToContext(ctx, &correlationIDKey, &correlationID)
-
-	logger := log.C(ctx).WithField(correlationIDKey, correlationID)
-	ctx = log.ContextWithLogger(ctx, logger)
-
-	log.C(ctx).Info("Configuration is provided in the request body. Starting formation assignment asynchronous notifications processing...")
-
-	tx, err := h.transact.Begin()
-	if err != nil {
-		log.C(ctx).WithError(err).Error("unable to establish connection with database")
-		return
-	}
-	defer h.transact.RollbackUnlessCommitted(ctx, tx)
-	ctx = persistence.SaveToContext(ctx, tx)
-
-	log.C(ctx).Infof("Generating formation assignment notifications for ID: %q and formation ID: %q", fa.ID, fa.FormationID)
-	notificationReq, err := h.faNotificationService.GenerateFormationAssignmentNotification(ctx, fa, model.AssignFormation)
-	if err != nil {
-		log.C(ctx).WithError(err).Errorf("An error occurred while generating formation assignment notifications for ID: %q and formation ID: %q", fa.ID, fa.FormationID)
-		return
-	}
-	if notificationReq == nil {
-		log.C(ctx).Info("No formation assignment notification is generated. Returning...")
-		return
-	}
-
-	reverseFA, err := h.faService.GetReverseBySourceAndTarget(ctx, fa.FormationID, fa.Source, fa.Target)
+// enqueueNotifications stores an outbox entry for the notifications caused by the update of the resource with ID `resourceID` to `state`.
+// The idempotency key is generated for every state change and does not depend on values provided by the caller, such as the x-request-id header,
+// so the notifications of consecutive status updates are never deduplicated with each other. The key is sent to the receivers of the notifications
+// in the IdempotencyKeyHeader, which allows them to recognize the retries of the same notifications by the outbox dispatcher.
+func (h *Handler) enqueueNotifications(ctx context.Context, entryType model.OutboxEntryType, resourceID, state, tenantID, correlationID string, obj interface{}) error {
+	payload, err := json.Marshal(obj)
 	if err != nil {
-		log.C(ctx).WithError(err).Errorf("An error occurred while getting reverse formation assignment by source: %q and target: %q", fa.Source, fa.Target)
-		return
-	}
-
-	log.C(ctx).Infof("Generating reverse formation assignment notifications for ID: %q and formation ID: %q", reverseFA.ID, reverseFA.FormationID)
-	reverseNotificationReq, err := h.faNotificationService.GenerateFormationAssignmentNotification(ctx, reverseFA, model.AssignFormation)
-	if err != nil {
-		log.C(ctx).WithError(err).Errorf("An error occurred while generating reverse formation assignment notifications for ID: %q and formation ID: %q", fa.ID, fa.FormationID)
-		return
-	}
-
-	faReqMapping := formationassignment.FormationAssignmentRequestMapping{
-		Request:             notificationReq,
-		FormationAssignment: fa,
-	}
-
-	reverseFAReqMapping := formationassignment.FormationAssignmentRequestMapping{
-		Request:             reverseNotificationReq,
-		FormationAssignment: reverseFA,
-	}
-
-	assignmentPair := formationassignment.AssignmentMappingPairWithOperation{
-		AssignmentMappingPair: &formationassignment.AssignmentMappingPair{
-			Assignment:        &reverseFAReqMapping, // the status update call is a response to the original notification that's why here we switch the assignment and reverse assignment
-			ReverseAssignment: &faReqMapping,
-		},
-		Operation: model.AssignFormation,
-	}
-
-	log.C(ctx).Infof("Processing formation assignment pair and its notifications")
-	_, err = h.faService.ProcessFormationAssignmentPair(ctx, &assignmentPair)
-	if err != nil {
-		log.C(ctx).WithError(err).Error("An error occurred while processing formation assignment pair and its notifications")
-		return
-	}
-
-	if err = tx.Commit(); err != nil {
-		log.C(ctx).WithError(err).Error("An error occurred while closing database transaction")
-		return
-	}
-
-	log.C(ctx).Info("Finished formation assignment asynchronous notifications processing")
-}
-
-func (h *Handler) processFormationNotifications(f *model.Formation, correlationID string) {
-	ctx, cancel := context.WithCancel(context.TODO())
-	defer cancel()
-
-	ctx = tenant.SaveToContext(ctx, f.TenantID, "")
-
-	correlationIDKey := correlation.RequestIDHeaderKey
-	ctx = correlation.SaveCorrelationIDHeaderToContext(ctx, &correlationIDKey, &correlationID)
-
-	logger := log.C(ctx).WithField(correlationIDKey, correlationID)
-	ctx = log.ContextWithLogger(ctx, logger)
-
-	tx, err := h.transact.Begin()
-	if err != nil {
-		log.C(ctx).WithError(err).Error("unable to establish connection with database")
-		return
-	}
-	defer h.transact.RollbackUnlessCommitted(ctx, tx)
-	ctx = persistence.SaveToContext(ctx, tx)
-
-	log.C(ctx).Infof("Starting asynchronous resynchronization for formation with ID: %q and name: %q...", f.ID, f.Name)
-	if _, err := h.formationService.ResynchronizeFormationNotifications(ctx, f.ID, false); err != nil {
-		log.C(ctx).WithError(err).Errorf("while resynchronize formation notifications for formation with ID: %q", f.ID)
-		return
-	}
-
-	if err = tx.Commit(); err != nil {
-		log.C(ctx).WithError(err).Error("An error occurred while closing database transaction")
-		return
+		return errors.Wrapf(err, "while marshalling payload for outbox entry of type %q", entryType)
 	}
 
-	log.C(ctx).Infof("Finished asynchronous formation resynchronization processing for formation with ID: %q and name: %q", f.ID, f.Name)
+	return h.outboxService.Enqueue(ctx, &model.OutboxEntryInput{
+		IdempotencyKey: fmt.Sprintf("%s:%s:%s:%s", entryType, resourceID, state, uuid.New().String()),
+		Type:           entryType,
+		TenantID:       tenantID,
+		CorrelationID:  correlationID,
+		Payload:        payload,
+	})
 }
 
 func decodeJSONBody(w http.ResponseWriter, r *http.Request, dst interface{}) error {
@@ -8,7 +8,6 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
-	"time"
 
 	"github.com/gorilla/mux"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/formationassignment"
@@ -37,28 +36,10 @@ func TestHandler_UpdateFormationAssignmentStatus(t *testing.T) {
 
 	// formation assignment fixtures with ASSIGN operation
 	faWithSourceAppAndTargetRuntime := fixFormationAssignmentModelWithStateAndConfig(testFormationAssignmentID, testFormationID, internalTntID, faSourceID, faTargetID, model.FormationAssignmentTypeApplication, model.FormationAssignmentTypeRuntime, model.ReadyAssignmentState, testValidConfig)
-	reverseFAWithSourceRuntimeAndTargetApp := fixFormationAssignmentModelWithStateAndConfig(testFormationAssignmentID, testFormationID, internalTntID, faTargetID, faSourceID, model.FormationAssignmentTypeRuntime, model.FormationAssignmentTypeApplication, model.ReadyAssignmentState, testValidConfig)
 
+	faWithSourceAppAndTargetRuntimeWithoutConfig := fixFormationAssignmentModelWithStateAndConfig(testFormationAssignmentID, testFormationID, internalTntID, faSourceID, faTargetID, model.FormationAssignmentTypeApplication, model.FormationAssignmentTypeRuntime, model.ReadyAssignmentState, "")
 	faWithSourceAppAndTargetRuntimeWithCreateErrorState := fixFormationAssignmentModelWithStateAndConfig(testFormationAssignmentID, testFormationID, internalTntID, faSourceID, faTargetID, model.FormationAssignmentTypeApplication, model.FormationAssignmentTypeRuntime, model.CreateErrorAssignmentState, "")
 
-	testFAReqMapping := formationassignment.FormationAssignmentRequestMapping{
-		Request:             fixEmptyNotificationRequest(),
-		FormationAssignment: faWithSourceAppAndTargetRuntime,
-	}
-
-	testReverseFAReqMapping := formationassignment.FormationAssignmentRequestMapping{
-		Request:             fixEmptyNotificationRequest(),
-		FormationAssignment: reverseFAWithSourceRuntimeAndTargetApp,
-	}
-
-	testAssignmentPair := &formationassignment.AssignmentMappingPairWithOperation{
-		AssignmentMappingPair: &formationassignment.AssignmentMappingPair{
-			Assignment:        &testReverseFAReqMapping,
-			ReverseAssignment: &testFAReqMapping,
-		},
-		Operation: model.AssignFormation,
-	}
-
 	// formation assignment fixtures with UNASSIGN operation
 	faWithSourceAppAndTargetRuntimeForUnassingOp := fixFormationAssignmentModelWithStateAndConfig(testFormationAssignmentID, testFormationID, internalTntID, faSourceID, faTargetID, model.FormationAssignmentTypeApplication, model.FormationAssignmentTypeRuntime, model.DeletingAssignmentState, testValidConfig)
 	faWithSourceAppAndTargetRuntimeForUnassingOpWithDeleteErrorState := fixFormationAssignmentModelWithStateAndConfig(testFormationAssignmentID, testFormationID, internalTntID, faSourceID, faTargetID, model.FormationAssignmentTypeApplication, model.FormationAssignmentTypeRuntime, model.DeletingAssignmentState, "")
@@ -83,15 +64,14 @@ func TestHandler_UpdateFormationAssignmentStatus(t *testing.T) {
 		name                string
 		transactFn          func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
 		faServiceFn         func() *automock.FormationAssignmentService
-		faNotificationSvcFn func() *automock.FormationAssignmentNotificationService
 		formationSvcFn      func() *automock.FormationService
 		faStatusSvcFn       func() *automock.FormationAssignmentStatusService
+		outboxSvcFn         func() *automock.NotificationsOutboxService
 		reqBody             fm.FormationAssignmentRequestBody
 		hasURLVars          bool
 		headers             map[string][]string
 		expectedStatusCode  int
 		expectedErrOutput   string
-		shouldSleep         bool // set to true for the test cases where we enter the go routine so that we give it some extra time to complete and then be able to assert the mocks
 	}{
 		// Request(+metadata) validation checks
 		{
@@ -132,20 +112,12 @@ func TestHandler_UpdateFormationAssignmentStatus(t *testing.T) {
 		// Business logic unit tests for assign operation
 		{
 			name:       "Success when operation is assign",
-			transactFn: txGen.ThatSucceedsTwice,
+			transactFn: txGen.ThatSucceeds,
 			faServiceFn: func() *automock.FormationAssignmentService {
 				faSvc := &automock.FormationAssignmentService{}
 				faSvc.On("GetGlobalByIDAndFormationID", txtest.CtxWithDBMatcher(), testFormationAssignmentID, testFormationID).Return(faWithSourceAppAndTargetRuntime, nil).Once()
-				faSvc.On("GetReverseBySourceAndTarget", contextThatHasTenant(internalTntID), testFormationID, faSourceID, faTargetID).Return(reverseFAWithSourceRuntimeAndTargetApp, nil).Once()
-				faSvc.On("ProcessFormationAssignmentPair", contextThatHasTenant(internalTntID), testAssignmentPair).Return(false, nil).Once()
 				return faSvc
 			},
-			faNotificationSvcFn: func() *automock.FormationAssignmentNotificationService {
-				faNotificationSvc := &automock.FormationAssignmentNotificationService{}
-				faNotificationSvc.On("GenerateFormationAssignmentNotification", contextThatHasTenant(internalTntID), faWithSourceAppAndTargetRuntime, model.AssignFormation).Return(fixEmptyNotificationRequest(), nil).Once()
-				faNotificationSvc.On("GenerateFormationAssignmentNotification", contextThatHasTenant(internalTntID), reverseFAWithSourceRuntimeAndTargetApp, model.AssignFormation).Return(fixEmptyNotificationRequest(), nil).Once()
-				return faNotificationSvc
-			},
 			formationSvcFn: func() *automock.FormationService {
 				formationSvc := &automock.FormationService{}
 				formationSvc.On("Get", txtest.CtxWithDBMatcher(), testFormationID).Return(testFormationWithReadyState, nil).Once()
@@ -156,6 +128,11 @@ func TestHandler_UpdateFormationAssignmentStatus(t *testing.T) {
 				updater.On("UpdateWithConstraints", txtest.CtxWithDBMatcher(), faWithSourceAppAndTargetRuntime, model.AssignFormation).Return(nil).Once()
 				return updater
 			},
+			outboxSvcFn: func() *automock.NotificationsOutboxService {
+				outboxSvc := &automock.NotificationsOutboxService{}
+				outboxSvc.On("Enqueue", txtest.CtxWithDBMatcher(), outboxEntryInputThatMatches(model.FormationAssignmentNotificationsOutboxEntryType, internalTntID, faWithSourceAppAndTargetRuntime)).Return(nil).Once()
+				return outboxSvc
+			},
 			reqBody: fm.FormationAssignmentRequestBody{
 				State:         model.ReadyAssignmentState,
 				Configuration: json.RawMessage(testValidConfig),
@@ -163,24 +140,15 @@ func TestHandler_UpdateFormationAssignmentStatus(t *testing.T) {
 			hasURLVars:         true,
 			expectedStatusCode: http.StatusOK,
 			expectedErrOutput:  "",
-			shouldSleep:        true,
 		},
 		{
 			name:       "Success when state is not changed - only configuration is provided",
-			transactFn: txGen.ThatSucceedsTwice,
+			transactFn: txGen.ThatSucceeds,
 			faServiceFn: func() *automock.FormationAssignmentService {
 				faSvc := &automock.FormationAssignmentService{}
 				faSvc.On("GetGlobalByIDAndFormationID", txtest.CtxWithDBMatcher(), testFormationAssignmentID, testFormationID).Return(faWithSourceAppAndTargetRuntime, nil).Once()
-				faSvc.On("GetReverseBySourceAndTarget", contextThatHasTenant(internalTntID), testFormationID, faSourceID, faTargetID).Return(reverseFAWithSourceRuntimeAndTargetApp, nil).Once()
-				faSvc.On("ProcessFormationAssignmentPair", contextThatHasTenant(internalTntID), testAssignmentPair).Return(false, nil).Once()
 				return faSvc
 			},
-			faNotificationSvcFn: func() *automock.FormationAssignmentNotificationService {
-				faNotificationSvc := &automock.FormationAssignmentNotificationService{}
-				faNotificationSvc.On("GenerateFormationAssignmentNotification", contextThatHasTenant(internalTntID), faWithSourceAppAndTargetRuntime, model.AssignFormation).Return(fixEmptyNotificationRequest(), nil).Once()
-				faNotificationSvc.On("GenerateFormationAssignmentNotification", contextThatHasTenant(internalTntID), reverseFAWithSourceRuntimeAndTargetApp, model.AssignFormation).Return(fixEmptyNotificationRequest(), nil).Once()
-				return faNotificationSvc
-			},
 			formationSvcFn: func() *automock.FormationService {
 				formationSvc := &automock.FormationService{}
 				formationSvc.On("Get", txtest.CtxWithDBMatcher(), testFormationID).Return(testFormationWithInitialState, nil).Once()
@@ -191,36 +159,10 @@ func TestHandler_UpdateFormationAssignmentStatus(t *testing.T) {
 				updater.On("UpdateWithConstraints", txtest.CtxWithDBMatcher(), faWithSourceAppAndTargetRuntime, model.AssignFormation).Return(nil).Once()
 				return updater
 			},
-			reqBody: fm.FormationAssignmentRequestBody{
-				Configuration: json.RawMessage(testValidConfig),
-			},
-			hasURLVars:         true,
-			expectedStatusCode: http.StatusOK,
-			expectedErrOutput:  "",
-			shouldSleep:        true,
-		},
-		{
-			name:       "Returning when no formation assignment notification is generated",
-			transactFn: ThatDoesNotCommitInGoRoutine,
-			faServiceFn: func() *automock.FormationAssignmentService {
-				faSvc := &automock.FormationAssignmentService{}
-				faSvc.On("GetGlobalByIDAndFormationID", txtest.CtxWithDBMatcher(), testFormationAssignmentID, testFormationID).Return(faWithSourceAppAndTargetRuntime, nil).Once()
-				return faSvc
-			},
-			faNotificationSvcFn: func() *automock.FormationAssignmentNotificationService {
-				faNotificationSvc := &automock.FormationAssignmentNotificationService{}
-				faNotificationSvc.On("GenerateFormationAssignmentNotification", contextThatHasTenant(internalTntID), faWithSourceAppAndTargetRuntime, model.AssignFormation).Return(nil, nil).Once()
-				return faNotificationSvc
-			},
-			formationSvcFn: func() *automock.FormationService {
-				formationSvc := &automock.FormationService{}
-				formationSvc.On("Get", txtest.CtxWithDBMatcher(), testFormationID).Return(testFormationWithInitialState, nil).Once()
-				return formationSvc
-			},
-			faStatusSvcFn: func() *automock.FormationAssignmentStatusService {
-				updater := &automock.FormationAssignmentStatusService{}
-				updater.On("UpdateWithConstraints", txtest.CtxWithDBMatcher(), faWithSourceAppAndTargetRuntime, model.AssignFormation).Return(nil).Once()
-				return updater
+			outboxSvcFn: func() *automock.NotificationsOutboxService {
+				outboxSvc := &automock.NotificationsOutboxService{}
+				outboxSvc.On("Enqueue", txtest.CtxWithDBMatcher(), outboxEntryInputThatMatches(model.FormationAssignmentNotificationsOutboxEntryType, internalTntID, faWithSourceAppAndTargetRuntime)).Return(nil).Once()
+				return outboxSvc
 			},
 			reqBody: fm.FormationAssignmentRequestBody{
 				Configuration: json.RawMessage(testValidConfig),
@@ -228,7 +170,6 @@ func TestHandler_UpdateFormationAssignmentStatus(t *testing.T) {
 			hasURLVars:         true,
 			expectedStatusCode: http.StatusOK,
 			expectedErrOutput:  "",
-			shouldSleep:        true,
 		},
 		{
 			name:       "Error when transaction fails to begin",
@@ -241,36 +182,6 @@ func TestHandler_UpdateFormationAssignmentStatus(t *testing.T) {
 			expectedStatusCode: http.StatusInternalServerError,
 			expectedErrOutput:  "An unexpected error occurred while processing the request. X-Request-Id:",
 		},
-		{
-			name:       "Error when transaction fails to begin in go routine when operation is assign",
-			transactFn: ThatFailsOnBeginInGoRoutine,
-			faServiceFn: func() *automock.FormationAssignmentService {
-				faSvc := &automock.FormationAssignmentService{}
-				faSvc.On("GetGlobalByIDAndFormationID", txtest.CtxWithDBMatcher(), testFormationAssignmentID, testFormationID).Return(faWithSourceAppAndTargetRuntime, nil).Once()
-				return faSvc
-			},
-			faNotificationSvcFn: func() *automock.FormationAssignmentNotificationService {
-				return &automock.FormationAssignmentNotificationService{}
-			},
-			formationSvcFn: func() *automock.FormationService {
-				formationSvc := &automock.FormationService{}
-				formationSvc.On("Get", txtest.CtxWithDBMatcher(), testFormationID).Return(testFormationWithReadyState, nil).Once()
-				return formationSvc
-			},
-			faStatusSvcFn: func() *automock.FormationAssignmentStatusService {
-				updater := &automock.FormationAssignmentStatusService{}
-				updater.On("UpdateWithConstraints", txtest.CtxWithDBMatcher(), faWithSourceAppAndTargetRuntime, model.AssignFormation).Return(nil).Once()
-				return updater
-			},
-			reqBody: fm.FormationAssignmentRequestBody{
-				State:         model.ReadyAssignmentState,
-				Configuration: json.RawMessage(testValidConfig),
-			},
-			hasURLVars:         true,
-			expectedStatusCode: http.StatusOK,
-			expectedErrOutput:  "",
-			shouldSleep:        true,
-		},
 		{
 			name:       "Error when getting formation assignment globally",
 			transactFn: txGen.ThatDoesntExpectCommit,
@@ -459,44 +370,11 @@ func TestHandler_UpdateFormationAssignmentStatus(t *testing.T) {
 			expectedErrOutput:  "An unexpected error occurred while processing the request. X-Request-Id:",
 		},
 		{
-			name:       "Error in go routine when generating notifications for assignment fail",
-			transactFn: ThatDoesNotCommitInGoRoutine,
-			faServiceFn: func() *automock.FormationAssignmentService {
-				faSvc := &automock.FormationAssignmentService{}
-				faSvc.On("GetGlobalByIDAndFormationID", txtest.CtxWithDBMatcher(), testFormationAssignmentID, testFormationID).Return(faWithSourceAppAndTargetRuntime, nil).Once()
-				return faSvc
-			},
-			formationSvcFn: func() *automock.FormationService {
-				formationSvc := &automock.FormationService{}
-				formationSvc.On("Get", txtest.CtxWithDBMatcher(), testFormationID).Return(testFormationWithReadyState, nil).Once()
-				return formationSvc
-			},
-			faNotificationSvcFn: func() *automock.FormationAssignmentNotificationService {
-				faNotificationSvc := &automock.FormationAssignmentNotificationService{}
-				faNotificationSvc.On("GenerateFormationAssignmentNotification", contextThatHasTenant(internalTntID), faWithSourceAppAndTargetRuntime, model.AssignFormation).Return(nil, testErr).Once()
-				return faNotificationSvc
-			},
-			faStatusSvcFn: func() *automock.FormationAssignmentStatusService {
-				updater := &automock.FormationAssignmentStatusService{}
-				updater.On("UpdateWithConstraints", contextThatHasTenant(internalTntID), faWithSourceAppAndTargetRuntime, model.AssignFormation).Return(nil).Once()
-				return updater
-			},
-			reqBody: fm.FormationAssignmentRequestBody{
-				State:         model.ReadyAssignmentState,
-				Configuration: json.RawMessage(testValidConfig),
-			},
-			hasURLVars:         true,
-			expectedStatusCode: http.StatusOK,
-			expectedErrOutput:  "",
-			shouldSleep:        true,
-		},
-		{
-			name:       "Error in go routine when getting reverse formation assignment fail",
-			transactFn: ThatDoesNotCommitInGoRoutine,
+			name:       "Error when committing transaction fail after enqueueing formation assignment notifications",
+			transactFn: txGen.ThatFailsOnCommit,
 			faServiceFn: func() *automock.FormationAssignmentService {
 				faSvc := &automock.FormationAssignmentService{}
 				faSvc.On("GetGlobalByIDAndFormationID", txtest.CtxWithDBMatcher(), testFormationAssignmentID, testFormationID).Return(faWithSourceAppAndTargetRuntime, nil).Once()
-				faSvc.On("GetReverseBySourceAndTarget", contextThatHasTenant(internalTntID), testFormationID, faSourceID, faTargetID).Return(nil, testErr).Once()
 				return faSvc
 			},
 			formationSvcFn: func() *automock.FormationService {
@@ -504,67 +382,30 @@ func TestHandler_UpdateFormationAssignmentStatus(t *testing.T) {
 				formationSvc.On("Get", txtest.CtxWithDBMatcher(), testFormationID).Return(testFormationWithReadyState, nil).Once()
 				return formationSvc
 			},
-			faNotificationSvcFn: func() *automock.FormationAssignmentNotificationService {
-				faNotificationSvc := &automock.FormationAssignmentNotificationService{}
-				faNotificationSvc.On("GenerateFormationAssignmentNotification", contextThatHasTenant(internalTntID), faWithSourceAppAndTargetRuntime, model.AssignFormation).Return(fixEmptyNotificationRequest(), nil).Once()
-				return faNotificationSvc
-			},
 			faStatusSvcFn: func() *automock.FormationAssignmentStatusService {
 				updater := &automock.FormationAssignmentStatusService{}
 				updater.On("UpdateWithConstraints", contextThatHasTenant(internalTntID), faWithSourceAppAndTargetRuntime, model.AssignFormation).Return(nil).Once()
 				return updater
 			},
-			reqBody: fm.FormationAssignmentRequestBody{
-				State:         model.ReadyAssignmentState,
-				Configuration: json.RawMessage(testValidConfig),
-			},
-			hasURLVars:         true,
-			expectedStatusCode: http.StatusOK,
-			expectedErrOutput:  "",
-			shouldSleep:        true,
-		},
-		{
-			name:       "Error in go routine when generating reverse notifications for assignment fail",
-			transactFn: ThatDoesNotCommitInGoRoutine,
-			faServiceFn: func() *automock.FormationAssignmentService {
-				faSvc := &automock.FormationAssignmentService{}
-				faSvc.On("GetGlobalByIDAndFormationID", txtest.CtxWithDBMatcher(), testFormationAssignmentID, testFormationID).Return(faWithSourceAppAndTargetRuntime, nil).Once()
-				faSvc.On("GetReverseBySourceAndTarget", contextThatHasTenant(internalTntID), testFormationID, faSourceID, faTargetID).Return(reverseFAWithSourceRuntimeAndTargetApp, nil).Once()
-				return faSvc
-			},
-			formationSvcFn: func() *automock.FormationService {
-				formationSvc := &automock.FormationService{}
-				formationSvc.On("Get", txtest.CtxWithDBMatcher(), testFormationID).Return(testFormationWithReadyState, nil).Once()
-				return formationSvc
-			},
-			faNotificationSvcFn: func() *automock.FormationAssignmentNotificationService {
-				faNotificationSvc := &automock.FormationAssignmentNotificationService{}
-				faNotificationSvc.On("GenerateFormationAssignmentNotification", contextThatHasTenant(internalTntID), faWithSourceAppAndTargetRuntime, model.AssignFormation).Return(fixEmptyNotificationRequest(), nil).Once()
-				faNotificationSvc.On("GenerateFormationAssignmentNotification", contextThatHasTenant(internalTntID), reverseFAWithSourceRuntimeAndTargetApp, model.AssignFormation).Return(nil, testErr).Once()
-				return faNotificationSvc
-			},
-			faStatusSvcFn: func() *automock.FormationAssignmentStatusService {
-				updater := &automock.FormationAssignmentStatusService{}
-				updater.On("UpdateWithConstraints", contextThatHasTenant(internalTntID), faWithSourceAppAndTargetRuntime, model.AssignFormation).Return(nil).Once()
-				return updater
+			outboxSvcFn: func() *automock.NotificationsOutboxService {
+				outboxSvc := &automock.NotificationsOutboxService{}
+				outboxSvc.On("Enqueue", txtest.CtxWithDBMatcher(), outboxEntryInputThatMatches(model.FormationAssignmentNotificationsOutboxEntryType, internalTntID, faWithSourceAppAndTargetRuntime)).Return(nil).Once()
+				return outboxSvc
 			},
 			reqBody: fm.FormationAssignmentRequestBody{
 				State:         model.ReadyAssignmentState,
 				Configuration: json.RawMessage(testValidConfig),
 			},
 			hasURLVars:         true,
-			expectedStatusCode: http.StatusOK,
-			expectedErrOutput:  "",
-			shouldSleep:        true,
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedErrOutput:  "An unexpected error occurred while processing the request. X-Request-Id:",
 		},
 		{
-			name:       "Error in go routine when processing formation assignment pairs",
-			transactFn: ThatDoesNotCommitInGoRoutine,
+			name:       "Error when enqueueing formation assignment notifications fail",
+			transactFn: txGen.ThatDoesntExpectCommit,
 			faServiceFn: func() *automock.FormationAssignmentService {
 				faSvc := &automock.FormationAssignmentService{}
 				faSvc.On("GetGlobalByIDAndFormationID", txtest.CtxWithDBMatcher(), testFormationAssignmentID, testFormationID).Return(faWithSourceAppAndTargetRuntime, nil).Once()
-				faSvc.On("GetReverseBySourceAndTarget", contextThatHasTenant(internalTntID), testFormationID, faSourceID, faTargetID).Return(reverseFAWithSourceRuntimeAndTargetApp, nil).Once()
-				faSvc.On("ProcessFormationAssignmentPair", contextThatHasTenant(internalTntID), testAssignmentPair).Return(false, testErr).Once()
 				return faSvc
 			},
 			formationSvcFn: func() *automock.FormationService {
@@ -572,46 +413,15 @@ func TestHandler_UpdateFormationAssignmentStatus(t *testing.T) {
 				formationSvc.On("Get", txtest.CtxWithDBMatcher(), testFormationID).Return(testFormationWithReadyState, nil).Once()
 				return formationSvc
 			},
-			faNotificationSvcFn: func() *automock.FormationAssignmentNotificationService {
-				faNotificationSvc := &automock.FormationAssignmentNotificationService{}
-				faNotificationSvc.On("GenerateFormationAssignmentNotification", contextThatHasTenant(internalTntID), faWithSourceAppAndTargetRuntime, model.AssignFormation).Return(fixEmptyNotificationRequest(), nil).Once()
-				faNotificationSvc.On("GenerateFormationAssignmentNotification", contextThatHasTenant(internalTntID), reverseFAWithSourceRuntimeAndTargetApp, model.AssignFormation).Return(fixEmptyNotificationRequest(), nil).Once()
-				return faNotificationSvc
-			},
 			faStatusSvcFn: func() *automock.FormationAssignmentStatusService {
 				updater := &automock.FormationAssignmentStatusService{}
 				updater.On("UpdateWithConstraints", contextThatHasTenant(internalTntID), faWithSourceAppAndTargetRuntime, model.AssignFormation).Return(nil).Once()
 				return updater
 			},
-			reqBody: fm.FormationAssignmentRequestBody{
-				State:         model.ReadyAssignmentState,
-				Configuration: json.RawMessage(testValidConfig),
-			},
-			hasURLVars:         true,
-			expectedStatusCode: http.StatusOK,
-			expectedErrOutput:  "",
-			shouldSleep:        true,
-		},
-		{
-			name:       "Error when committing transaction fail before go routine",
-			transactFn: txGen.ThatFailsOnCommit,
-			faServiceFn: func() *automock.FormationAssignmentService {
-				faSvc := &automock.FormationAssignmentService{}
-				faSvc.On("GetGlobalByIDAndFormationID", txtest.CtxWithDBMatcher(), testFormationAssignmentID, testFormationID).Return(faWithSourceAppAndTargetRuntime, nil).Once()
-				return faSvc
-			},
-			formationSvcFn: func() *automock.FormationService {
-				formationSvc := &automock.FormationService{}
-				formationSvc.On("Get", txtest.CtxWithDBMatcher(), testFormationID).Return(testFormationWithReadyState, nil).Once()
-				return formationSvc
-			},
-			faNotificationSvcFn: func() *automock.FormationAssignmentNotificationService {
-				return &automock.FormationAssignmentNotificationService{}
-			},
-			faStatusSvcFn: func() *automock.FormationAssignmentStatusService {
-				updater := &automock.FormationAssignmentStatusService{}
-				updater.On("UpdateWithConstraints", contextThatHasTenant(internalTntID), faWithSourceAppAndTargetRuntime, model.AssignFormation).Return(nil).Once()
-				return updater
+			outboxSvcFn: func() *automock.NotificationsOutboxService {
+				outboxSvc := &automock.NotificationsOutboxService{}
+				outboxSvc.On("Enqueue", txtest.CtxWithDBMatcher(), outboxEntryInputThatMatches(model.FormationAssignmentNotificationsOutboxEntryType, internalTntID, faWithSourceAppAndTargetRuntime)).Return(testErr).Once()
+				return outboxSvc
 			},
 			reqBody: fm.FormationAssignmentRequestBody{
 				State:         model.ReadyAssignmentState,
@@ -622,13 +432,11 @@ func TestHandler_UpdateFormationAssignmentStatus(t *testing.T) {
 			expectedErrOutput:  "An unexpected error occurred while processing the request. X-Request-Id:",
 		},
 		{
-			name:       "Error when committing transaction fail in the go routine",
-			transactFn: ThatFailsOnCommitInGoRoutine,
+			name:       "Success without enqueueing notifications when configuration is not provided",
+			transactFn: txGen.ThatSucceeds,
 			faServiceFn: func() *automock.FormationAssignmentService {
 				faSvc := &automock.FormationAssignmentService{}
-				faSvc.On("GetGlobalByIDAndFormationID", txtest.CtxWithDBMatcher(), testFormationAssignmentID, testFormationID).Return(faWithSourceAppAndTargetRuntime, nil).Once()
-				faSvc.On("GetReverseBySourceAndTarget", contextThatHasTenant(internalTntID), testFormationID, faSourceID, faTargetID).Return(reverseFAWithSourceRuntimeAndTargetApp, nil).Once()
-				faSvc.On("ProcessFormationAssignmentPair", contextThatHasTenant(internalTntID), testAssignmentPair).Return(false, nil).Once()
+				faSvc.On("GetGlobalByIDAndFormationID", txtest.CtxWithDBMatcher(), testFormationAssignmentID, testFormationID).Return(faWithSourceAppAndTargetRuntimeWithoutConfig, nil).Once()
 				return faSvc
 			},
 			formationSvcFn: func() *automock.FormationService {
@@ -636,25 +444,16 @@ func TestHandler_UpdateFormationAssignmentStatus(t *testing.T) {
 				formationSvc.On("Get", txtest.CtxWithDBMatcher(), testFormationID).Return(testFormationWithReadyState, nil).Once()
 				return formationSvc
 			},
-			faNotificationSvcFn: func() *automock.FormationAssignmentNotificationService {
-				faNotificationSvc := &automock.FormationAssignmentNotificationService{}
-				faNotificationSvc.On("GenerateFormationAssignmentNotification", contextThatHasTenant(internalTntID), faWithSourceAppAndTargetRuntime, model.AssignFormation).Return(fixEmptyNotificationRequest(), nil).Once()
-				faNotificationSvc.On("GenerateFormationAssignmentNotification", contextThatHasTenant(internalTntID), reverseFAWithSourceRuntimeAndTargetApp, model.AssignFormation).Return(fixEmptyNotificationRequest(), nil).Once()
-				return faNotificationSvc
-			},
 			faStatusSvcFn: func() *automock.FormationAssignmentStatusService {
 				updater := &automock.FormationAssignmentStatusService{}
-				updater.On("UpdateWithConstraints", contextThatHasTenant(internalTntID), faWithSourceAppAndTargetRuntime, model.AssignFormation).Return(nil).Once()
+				updater.On("UpdateWithConstraints", contextThatHasTenant(internalTntID), faWithSourceAppAndTargetRuntimeWithoutConfig, model.AssignFormation).Return(nil).Once()
 				return updater
 			},
 			reqBody: fm.FormationAssignmentRequestBody{
-				State:         model.ReadyAssignmentState,
-				Configuration: json.RawMessage(testValidConfig),
+				State: model.ReadyAssignmentState,
 			},
 			hasURLVars:         true,
 			expectedStatusCode: http.StatusOK,
-			expectedErrOutput:  "",
-			shouldSleep:        true,
 		},
 		// Business logic unit tests for unassign operation
 		{
@@ -1157,11 +956,6 @@ func TestHandler_UpdateFormationAssignmentStatus(t *testing.T) {
 				faSvc = tCase.faServiceFn()
 			}
 
-			faNotificationSvc := fixUnusedFormationAssignmentNotificationSvc()
-			if tCase.faNotificationSvcFn != nil {
-				faNotificationSvc = tCase.faNotificationSvcFn()
-			}
-
 			formationSvc := fixUnusedFormationSvc()
 			if tCase.formationSvcFn != nil {
 				formationSvc = tCase.formationSvcFn()
@@ -1172,17 +966,18 @@ func TestHandler_UpdateFormationAssignmentStatus(t *testing.T) {
 				faStatusSvcFn = tCase.faStatusSvcFn()
 			}
 
-			defer mock.AssertExpectationsForObjects(t, persist, transact, faSvc, faNotificationSvc, formationSvc, faStatusSvcFn)
+			outboxSvc := fixUnusedNotificationsOutboxSvc()
+			if tCase.outboxSvcFn != nil {
+				outboxSvc = tCase.outboxSvcFn()
+			}
+
+			defer mock.AssertExpectationsForObjects(t, persist, transact, faSvc, formationSvc, faStatusSvcFn, outboxSvc)
 
-			handler := fm.NewFormationMappingHandler(transact, faSvc, faStatusSvcFn, faNotificationSvc, formationSvc, nil)
+			handler := fm.NewFormationMappingHandler(transact, faSvc, faStatusSvcFn, formationSvc, nil, outboxSvc)
 
 			// WHEN
 			handler.UpdateFormationAssignmentStatus(w, httpReq)
 
-			if tCase.shouldSleep {
-				time.Sleep(500 * time.Millisecond)
-			}
-
 			// THEN
 			resp := w.Result()
 			body, err := io.ReadAll(resp.Body)
@@ -1214,12 +1009,12 @@ func TestHandler_UpdateFormationStatus(t *testing.T) {
 		formationSvcFn       func() *automock.FormationService
 		faStatusSvcFn        func() *automock.FormationAssignmentStatusService
 		formationStatusSvcFn func() *automock.FormationStatusService
+		outboxSvcFn          func() *automock.NotificationsOutboxService
 		reqBody              fm.FormationRequestBody
 		hasURLVars           bool
 		headers              map[string][]string
 		expectedStatusCode   int
 		expectedErrOutput    string
-		shouldSleep          bool // set to true for the test cases where we enter the go routine so that we give it some extra time to complete and then be able to assert the mocks
 	}{
 		// Request(+metadata) validation checks
 		{
@@ -1399,11 +1194,10 @@ func TestHandler_UpdateFormationStatus(t *testing.T) {
 		// Business logic unit tests for create formation operation
 		{
 			name:       "Successfully update formation status when operation is create formation",
-			transactFn: txGen.ThatSucceedsTwice,
+			transactFn: txGen.ThatSucceeds,
 			formationSvcFn: func() *automock.FormationService {
 				formationSvc := &automock.FormationService{}
 				formationSvc.On("GetGlobalByID", txtest.CtxWithDBMatcher(), testFormationID).Return(formationWithInitialState, nil).Once()
-				formationSvc.On("ResynchronizeFormationNotifications", contextThatHasTenant(internalTntID), testFormationID, false).Return(nil, nil).Once()
 				return formationSvc
 			},
 			formationStatusSvcFn: func() *automock.FormationStatusService {
@@ -1411,12 +1205,16 @@ func TestHandler_UpdateFormationStatus(t *testing.T) {
 				formationStatusSvc.On("UpdateWithConstraints", contextThatHasTenant(internalTntID), formationWithReadyState, model.CreateFormation).Return(nil).Once()
 				return formationStatusSvc
 			},
+			outboxSvcFn: func() *automock.NotificationsOutboxService {
+				outboxSvc := &automock.NotificationsOutboxService{}
+				outboxSvc.On("Enqueue", txtest.CtxWithDBMatcher(), outboxEntryInputThatMatches(model.FormationNotificationsOutboxEntryType, internalTntID, formationWithReadyState)).Return(nil).Once()
+				return outboxSvc
+			},
 			reqBody: fm.FormationRequestBody{
 				State: model.ReadyFormationState,
 			},
 			hasURLVars:         true,
 			expectedStatusCode: http.StatusOK,
-			shouldSleep:        true,
 		},
 		{
 			name:       "Successfully update formation status when operation is create formation and state is CREATE_ERROR",
@@ -1437,27 +1235,6 @@ func TestHandler_UpdateFormationStatus(t *testing.T) {
 			},
 			hasURLVars:         true,
 			expectedStatusCode: http.StatusOK,
-			shouldSleep:        true,
-		},
-		{
-			name:       "Error on begin transaction in go routine",
-			transactFn: ThatFailsOnBeginInGoRoutine,
-			formationSvcFn: func() *automock.FormationService {
-				formationSvc := &automock.FormationService{}
-				formationSvc.On("GetGlobalByID", txtest.CtxWithDBMatcher(), testFormationID).Return(formationWithInitialState, nil).Once()
-				return formationSvc
-			},
-			formationStatusSvcFn: func() *automock.FormationStatusService {
-				formationStatusSvc := &automock.FormationStatusService{}
-				formationStatusSvc.On("UpdateWithConstraints", contextThatHasTenant(internalTntID), formationWithReadyState, model.CreateFormation).Return(nil).Once()
-				return formationStatusSvc
-			},
-			reqBody: fm.FormationRequestBody{
-				State: model.ReadyFormationState,
-			},
-			hasURLVars:         true,
-			expectedStatusCode: http.StatusOK,
-			shouldSleep:        true,
 		},
 		{
 			name:       "Error when request body state is not correct for create formation operation",
@@ -1515,28 +1292,6 @@ func TestHandler_UpdateFormationStatus(t *testing.T) {
 			expectedStatusCode: http.StatusInternalServerError,
 			expectedErrOutput:  "An unexpected error occurred while processing the request. X-Request-Id:",
 		},
-		{
-			name:       "Error when resynchronize formation notifications fails",
-			transactFn: ThatDoesNotCommitInGoRoutine,
-			formationSvcFn: func() *automock.FormationService {
-				formationSvc := &automock.FormationService{}
-				formationSvc.On("GetGlobalByID", txtest.CtxWithDBMatcher(), testFormationID).Return(formationWithInitialState, nil).Once()
-				formationSvc.On("ResynchronizeFormationNotifications", contextThatHasTenant(internalTntID), testFormationID, false).Return(nil, testErr).Once()
-				return formationSvc
-			},
-			formationStatusSvcFn: func() *automock.FormationStatusService {
-				formationStatusSvc := &automock.FormationStatusService{}
-				formationStatusSvc.On("UpdateWithConstraints", contextThatHasTenant(internalTntID), formationWithReadyState, model.CreateFormation).Return(nil).Once()
-				return formationStatusSvc
-			},
-			reqBody: fm.FormationRequestBody{
-				State: model.ReadyFormationState,
-			},
-			hasURLVars:         true,
-			expectedStatusCode: http.StatusOK,
-			expectedErrOutput:  "",
-			shouldSleep:        true,
-		},
 		{
 			name:       "Error when transaction fails to commit after successful formation status update for create operation",
 			transactFn: txGen.ThatFailsOnCommit,
@@ -1550,6 +1305,11 @@ func TestHandler_UpdateFormationStatus(t *testing.T) {
 				formationStatusSvc.On("UpdateWithConstraints", contextThatHasTenant(internalTntID), formationWithReadyState, model.CreateFormation).Return(nil).Once()
 				return formationStatusSvc
 			},
+			outboxSvcFn: func() *automock.NotificationsOutboxService {
+				outboxSvc := &automock.NotificationsOutboxService{}
+				outboxSvc.On("Enqueue", txtest.CtxWithDBMatcher(), outboxEntryInputThatMatches(model.FormationNotificationsOutboxEntryType, internalTntID, formationWithReadyState)).Return(nil).Once()
+				return outboxSvc
+			},
 			reqBody: fm.FormationRequestBody{
 				State: model.ReadyFormationState,
 			},
@@ -1558,12 +1318,11 @@ func TestHandler_UpdateFormationStatus(t *testing.T) {
 			expectedErrOutput:  "An unexpected error occurred while processing the request. X-Request-Id:",
 		},
 		{
-			name:       "Error when transaction fails to commit in go routine after successful formation status update for create operation",
-			transactFn: ThatFailsOnCommitInGoRoutine,
+			name:       "Error when enqueueing formation notifications fails",
+			transactFn: txGen.ThatDoesntExpectCommit,
 			formationSvcFn: func() *automock.FormationService {
 				formationSvc := &automock.FormationService{}
 				formationSvc.On("GetGlobalByID", txtest.CtxWithDBMatcher(), testFormationID).Return(formationWithInitialState, nil).Once()
-				formationSvc.On("ResynchronizeFormationNotifications", contextThatHasTenant(internalTntID), testFormationID, false).Return(nil, nil).Once()
 				return formationSvc
 			},
 			formationStatusSvcFn: func() *automock.FormationStatusService {
@@ -1571,13 +1330,17 @@ func TestHandler_UpdateFormationStatus(t *testing.T) {
 				formationStatusSvc.On("UpdateWithConstraints", contextThatHasTenant(internalTntID), formationWithReadyState, model.CreateFormation).Return(nil).Once()
 				return formationStatusSvc
 			},
+			outboxSvcFn: func() *automock.NotificationsOutboxService {
+				outboxSvc := &automock.NotificationsOutboxService{}
+				outboxSvc.On("Enqueue", txtest.CtxWithDBMatcher(), outboxEntryInputThatMatches(model.FormationNotificationsOutboxEntryType, internalTntID, formationWithReadyState)).Return(testErr).Once()
+				return outboxSvc
+			},
 			reqBody: fm.FormationRequestBody{
 				State: model.ReadyFormationState,
 			},
 			hasURLVars:         true,
-			expectedStatusCode: http.StatusOK,
-			expectedErrOutput:  "",
-			shouldSleep:        true,
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedErrOutput:  "An unexpected error occurred while processing the request. X-Request-Id:",
 		},
 	}
 
@@ -1617,17 +1380,18 @@ func TestHandler_UpdateFormationStatus(t *testing.T) {
 				formationStatusSvc = tCase.formationStatusSvcFn()
 			}
 
-			defer mock.AssertExpectationsForObjects(t, persist, transact, formationSvc, faUpdater, formationStatusSvc)
+			outboxSvc := fixUnusedNotificationsOutboxSvc()
+			if tCase.outboxSvcFn != nil {
+				outboxSvc = tCase.outboxSvcFn()
+			}
+
+			defer mock.AssertExpectationsForObjects(t, persist, transact, formationSvc, faUpdater, formationStatusSvc, outboxSvc)
 
-			handler := fm.NewFormationMappingHandler(transact, nil, faUpdater, nil, formationSvc, formationStatusSvc)
+			handler := fm.NewFormationMappingHandler(transact, nil, faUpdater, formationSvc, formationStatusSvc, outboxSvc)
 
 			// WHEN
 			handler.UpdateFormationStatus(w, httpReq)
 
-			if tCase.shouldSleep {
-				time.Sleep(500 * time.Millisecond)
-			}
-
 			// THEN
 			resp := w.Result()
 			body, err := io.ReadAll(resp.Body)
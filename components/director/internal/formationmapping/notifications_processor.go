@@ -0,0 +1,147 @@
+package formationmapping
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/formationassignment"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/correlation"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/pkg/errors"
+)
+
+// IdempotencyKeyHeader is the header containing the idempotency key of the outbox entry the notifications are sent for.
+// It is the same for all delivery attempts of the entry.
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// NotificationsProcessor delivers the formation and formation assignment notifications stored in the notifications outbox by the status update handlers
+type NotificationsProcessor struct {
+	transact              persistence.Transactioner
+	faService             FormationAssignmentService
+	faNotificationService FormationAssignmentNotificationService
+	formationService      formationService
+}
+
+// NewNotificationsProcessor creates a formation mapping NotificationsProcessor
+func NewNotificationsProcessor(transact persistence.Transactioner, faService FormationAssignmentService, faNotificationService FormationAssignmentNotificationService, formationService formationService) *NotificationsProcessor {
+	return &NotificationsProcessor{
+		transact:              transact,
+		faService:             faService,
+		faNotificationService: faNotificationService,
+		formationService:      formationService,
+	}
+}
+
+// Process generates and sends the notifications the provided outbox entry stands for
+func (p *NotificationsProcessor) Process(ctx context.Context, entry *model.OutboxEntry) error {
+	ctx = tenant.SaveToContext(ctx, entry.TenantID, "")
+
+	// The headers are attached to the notification requests sent while processing the entry
+	ctx = correlation.SaveToContext(ctx, correlation.Headers{
+		correlation.RequestIDHeaderKey: entry.CorrelationID,
+		IdempotencyKeyHeader:           entry.IdempotencyKey,
+	})
+
+	switch entry.Type {
+	case model.FormationAssignmentNotificationsOutboxEntryType:
+		var fa model.FormationAssignment
+		if err := json.Unmarshal(entry.Payload, &fa); err != nil {
+			return errors.Wrapf(err, "while unmarshalling formation assignment from outbox entry with ID: %q", entry.ID)
+		}
+		return p.processFormationAssignmentNotifications(ctx, &fa)
+	case model.FormationNotificationsOutboxEntryType:
+		var f model.Formation
+		if err := json.Unmarshal(entry.Payload, &f); err != nil {
+			return errors.Wrapf(err, "while unmarshalling formation from outbox entry with ID: %q", entry.ID)
+		}
+		return p.processFormationNotifications(ctx, &f)
+	default:
+		return errors.Errorf("unsupported outbox entry type: %q", entry.Type)
+	}
+}
+
+func (p *NotificationsProcessor) processFormationAssignmentNotifications(ctx context.Context, fa *model.FormationAssignment) error {
+	log.C(ctx).Info("Starting formation assignment asynchronous notifications processing...")
+
+	tx, err := p.transact.Begin()
+	if err != nil {
+		return errors.Wrap(err, "unable to establish connection with database")
+	}
+	defer p.transact.RollbackUnlessCommitted(ctx, tx)
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	log.C(ctx).Infof("Generating formation assignment notifications for ID: %q and formation ID: %q", fa.ID, fa.FormationID)
+	notificationReq, err := p.faNotificationService.GenerateFormationAssignmentNotification(ctx, fa, model.AssignFormation)
+	if err != nil {
+		return errors.Wrapf(err, "while generating formation assignment notifications for ID: %q and formation ID: %q", fa.ID, fa.FormationID)
+	}
+	if notificationReq == nil {
+		log.C(ctx).Info("No formation assignment notification is generated. Returning...")
+		return nil
+	}
+
+	reverseFA, err := p.faService.GetReverseBySourceAndTarget(ctx, fa.FormationID, fa.Source, fa.Target)
+	if err != nil {
+		return errors.Wrapf(err, "while getting reverse formation assignment by source: %q and target: %q", fa.Source, fa.Target)
+	}
+
+	log.C(ctx).Infof("Generating reverse formation assignment notifications for ID: %q and formation ID: %q", reverseFA.ID, reverseFA.FormationID)
+	reverseNotificationReq, err := p.faNotificationService.GenerateFormationAssignmentNotification(ctx, reverseFA, model.AssignFormation)
+	if err != nil {
+		return errors.Wrapf(err, "while generating reverse formation assignment notifications for ID: %q and formation ID: %q", reverseFA.ID, reverseFA.FormationID)
+	}
+
+	faReqMapping := formationassignment.FormationAssignmentRequestMapping{
+		Request:             notificationReq,
+		FormationAssignment: fa,
+	}
+
+	reverseFAReqMapping := formationassignment.FormationAssignmentRequestMapping{
+		Request:             reverseNotificationReq,
+		FormationAssignment: reverseFA,
+	}
+
+	assignmentPair := formationassignment.AssignmentMappingPairWithOperation{
+		AssignmentMappingPair: &formationassignment.AssignmentMappingPair{
+			Assignment:        &reverseFAReqMapping, // the status update call is a response to the original notification that's why here we switch the assignment and reverse assignment
+			ReverseAssignment: &faReqMapping,
+		},
+		Operation: model.AssignFormation,
+	}
+
+	log.C(ctx).Infof("Processing formation assignment pair and its notifications")
+	if _, err = p.faService.ProcessFormationAssignmentPair(ctx, &assignmentPair); err != nil {
+		return errors.Wrap(err, "while processing formation assignment pair and its notifications")
+	}
+
+	if err = tx.Commit(); err != nil {
+		return errors.Wrap(err, "while closing database transaction")
+	}
+
+	log.C(ctx).Info("Finished formation assignment asynchronous notifications processing")
+	return nil
+}
+
+func (p *NotificationsProcessor) processFormationNotifications(ctx context.Context, f *model.Formation) error {
+	tx, err := p.transact.Begin()
+	if err != nil {
+		return errors.Wrap(err, "unable to establish connection with database")
+	}
+	defer p.transact.RollbackUnlessCommitted(ctx, tx)
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	log.C(ctx).Infof("Starting asynchronous resynchronization for formation with ID: %q and name: %q...", f.ID, f.Name)
+	if _, err := p.formationService.ResynchronizeFormationNotifications(ctx, f.ID, false); err != nil {
+		return errors.Wrapf(err, "while resynchronize formation notifications for formation with ID: %q", f.ID)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return errors.Wrap(err, "while closing database transaction")
+	}
+
+	log.C(ctx).Infof("Finished asynchronous formation resynchronization processing for formation with ID: %q and name: %q", f.ID, f.Name)
+	return nil
+}
@@ -0,0 +1,265 @@
+package formationmapping_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/formationassignment"
+	fm "github.com/kyma-incubator/compass/components/director/internal/formationmapping"
+	"github.com/kyma-incubator/compass/components/director/internal/formationmapping/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	persistenceautomock "github.com/kyma-incubator/compass/components/director/pkg/persistence/automock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotificationsProcessor_Process(t *testing.T) {
+	testValidConfig := `{"testK":"testV"}`
+
+	faEntry := fixOutboxEntry(t, model.FormationAssignmentNotificationsOutboxEntryType, fixFormationAssignmentModelWithStateAndConfig(testFormationAssignmentID, testFormationID, internalTntID, faSourceID, faTargetID, model.FormationAssignmentTypeApplication, model.FormationAssignmentTypeRuntime, model.ReadyAssignmentState, testValidConfig))
+	formationEntry := fixOutboxEntry(t, model.FormationNotificationsOutboxEntryType, fixFormationWithState(model.ReadyFormationState))
+
+	fa := &model.FormationAssignment{}
+	require.NoError(t, json.Unmarshal(faEntry.Payload, fa))
+	reverseFA := fixFormationAssignmentModelWithStateAndConfig(testFormationAssignmentID, testFormationID, internalTntID, faTargetID, faSourceID, model.FormationAssignmentTypeRuntime, model.FormationAssignmentTypeApplication, model.ReadyAssignmentState, testValidConfig)
+
+	assignmentPair := &formationassignment.AssignmentMappingPairWithOperation{
+		AssignmentMappingPair: &formationassignment.AssignmentMappingPair{
+			Assignment: &formationassignment.FormationAssignmentRequestMapping{
+				Request:             fixEmptyNotificationRequest(),
+				FormationAssignment: reverseFA,
+			},
+			ReverseAssignment: &formationassignment.FormationAssignmentRequestMapping{
+				Request:             fixEmptyNotificationRequest(),
+				FormationAssignment: fa,
+			},
+		},
+		Operation: model.AssignFormation,
+	}
+
+	invalidEntry := &model.OutboxEntry{
+		ID:       "entryID",
+		Type:     model.FormationAssignmentNotificationsOutboxEntryType,
+		TenantID: internalTntID,
+		Payload:  json.RawMessage(`[]`),
+	}
+
+	unsupportedEntry := &model.OutboxEntry{
+		ID:       "entryID",
+		Type:     "UNSUPPORTED",
+		TenantID: internalTntID,
+		Payload:  json.RawMessage(`{}`),
+	}
+
+	testCases := []struct {
+		name                string
+		entry               *model.OutboxEntry
+		transactFn          func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		faServiceFn         func() *automock.FormationAssignmentService
+		faNotificationSvcFn func() *automock.FormationAssignmentNotificationService
+		formationSvcFn      func() *automock.FormationService
+		expectedErrMsg      string
+	}{
+		{
+			name:       "Success for formation assignment notifications",
+			entry:      faEntry,
+			transactFn: txGen.ThatSucceeds,
+			faServiceFn: func() *automock.FormationAssignmentService {
+				faSvc := &automock.FormationAssignmentService{}
+				faSvc.On("GetReverseBySourceAndTarget", contextThatHasTenant(internalTntID), testFormationID, faSourceID, faTargetID).Return(reverseFA, nil).Once()
+				faSvc.On("ProcessFormationAssignmentPair", contextThatHasNotificationHeaders(internalTntID, faEntry.CorrelationID, faEntry.IdempotencyKey), assignmentPair).Return(false, nil).Once()
+				return faSvc
+			},
+			faNotificationSvcFn: func() *automock.FormationAssignmentNotificationService {
+				faNotificationSvc := &automock.FormationAssignmentNotificationService{}
+				faNotificationSvc.On("GenerateFormationAssignmentNotification", contextThatHasTenant(internalTntID), fa, model.AssignFormation).Return(fixEmptyNotificationRequest(), nil).Once()
+				faNotificationSvc.On("GenerateFormationAssignmentNotification", contextThatHasTenant(internalTntID), reverseFA, model.AssignFormation).Return(fixEmptyNotificationRequest(), nil).Once()
+				return faNotificationSvc
+			},
+		},
+		{
+			name:       "Success when no formation assignment notification is generated",
+			entry:      faEntry,
+			transactFn: txGen.ThatDoesntExpectCommit,
+			faNotificationSvcFn: func() *automock.FormationAssignmentNotificationService {
+				faNotificationSvc := &automock.FormationAssignmentNotificationService{}
+				faNotificationSvc.On("GenerateFormationAssignmentNotification", contextThatHasTenant(internalTntID), fa, model.AssignFormation).Return(nil, nil).Once()
+				return faNotificationSvc
+			},
+		},
+		{
+			name:           "Error when formation assignment payload is invalid",
+			entry:          invalidEntry,
+			expectedErrMsg: "while unmarshalling formation assignment from outbox entry",
+		},
+		{
+			name:           "Error when transaction fails to begin for formation assignment notifications",
+			entry:          faEntry,
+			transactFn:     txGen.ThatFailsOnBegin,
+			expectedErrMsg: "unable to establish connection with database",
+		},
+		{
+			name:       "Error when generating formation assignment notifications fails",
+			entry:      faEntry,
+			transactFn: txGen.ThatDoesntExpectCommit,
+			faNotificationSvcFn: func() *automock.FormationAssignmentNotificationService {
+				faNotificationSvc := &automock.FormationAssignmentNotificationService{}
+				faNotificationSvc.On("GenerateFormationAssignmentNotification", contextThatHasTenant(internalTntID), fa, model.AssignFormation).Return(nil, testErr).Once()
+				return faNotificationSvc
+			},
+			expectedErrMsg: "while generating formation assignment notifications",
+		},
+		{
+			name:       "Error when getting reverse formation assignment fails",
+			entry:      faEntry,
+			transactFn: txGen.ThatDoesntExpectCommit,
+			faServiceFn: func() *automock.FormationAssignmentService {
+				faSvc := &automock.FormationAssignmentService{}
+				faSvc.On("GetReverseBySourceAndTarget", contextThatHasTenant(internalTntID), testFormationID, faSourceID, faTargetID).Return(nil, testErr).Once()
+				return faSvc
+			},
+			faNotificationSvcFn: func() *automock.FormationAssignmentNotificationService {
+				faNotificationSvc := &automock.FormationAssignmentNotificationService{}
+				faNotificationSvc.On("GenerateFormationAssignmentNotification", contextThatHasTenant(internalTntID), fa, model.AssignFormation).Return(fixEmptyNotificationRequest(), nil).Once()
+				return faNotificationSvc
+			},
+			expectedErrMsg: "while getting reverse formation assignment",
+		},
+		{
+			name:       "Error when generating reverse formation assignment notifications fails",
+			entry:      faEntry,
+			transactFn: txGen.ThatDoesntExpectCommit,
+			faServiceFn: func() *automock.FormationAssignmentService {
+				faSvc := &automock.FormationAssignmentService{}
+				faSvc.On("GetReverseBySourceAndTarget", contextThatHasTenant(internalTntID), testFormationID, faSourceID, faTargetID).Return(reverseFA, nil).Once()
+				return faSvc
+			},
+			faNotificationSvcFn: func() *automock.FormationAssignmentNotificationService {
+				faNotificationSvc := &automock.FormationAssignmentNotificationService{}
+				faNotificationSvc.On("GenerateFormationAssignmentNotification", contextThatHasTenant(internalTntID), fa, model.AssignFormation).Return(fixEmptyNotificationRequest(), nil).Once()
+				faNotificationSvc.On("GenerateFormationAssignmentNotification", contextThatHasTenant(internalTntID), reverseFA, model.AssignFormation).Return(nil, testErr).Once()
+				return faNotificationSvc
+			},
+			expectedErrMsg: "while generating reverse formation assignment notifications",
+		},
+		{
+			name:       "Error when processing formation assignment pair fails",
+			entry:      faEntry,
+			transactFn: txGen.ThatDoesntExpectCommit,
+			faServiceFn: func() *automock.FormationAssignmentService {
+				faSvc := &automock.FormationAssignmentService{}
+				faSvc.On("GetReverseBySourceAndTarget", contextThatHasTenant(internalTntID), testFormationID, faSourceID, faTargetID).Return(reverseFA, nil).Once()
+				faSvc.On("ProcessFormationAssignmentPair", contextThatHasTenant(internalTntID), assignmentPair).Return(false, testErr).Once()
+				return faSvc
+			},
+			faNotificationSvcFn: func() *automock.FormationAssignmentNotificationService {
+				faNotificationSvc := &automock.FormationAssignmentNotificationService{}
+				faNotificationSvc.On("GenerateFormationAssignmentNotification", contextThatHasTenant(internalTntID), fa, model.AssignFormation).Return(fixEmptyNotificationRequest(), nil).Once()
+				faNotificationSvc.On("GenerateFormationAssignmentNotification", contextThatHasTenant(internalTntID), reverseFA, model.AssignFormation).Return(fixEmptyNotificationRequest(), nil).Once()
+				return faNotificationSvc
+			},
+			expectedErrMsg: "while processing formation assignment pair and its notifications",
+		},
+		{
+			name:       "Error when transaction fails to commit for formation assignment notifications",
+			entry:      faEntry,
+			transactFn: txGen.ThatFailsOnCommit,
+			faServiceFn: func() *automock.FormationAssignmentService {
+				faSvc := &automock.FormationAssignmentService{}
+				faSvc.On("GetReverseBySourceAndTarget", contextThatHasTenant(internalTntID), testFormationID, faSourceID, faTargetID).Return(reverseFA, nil).Once()
+				faSvc.On("ProcessFormationAssignmentPair", contextThatHasTenant(internalTntID), assignmentPair).Return(false, nil).Once()
+				return faSvc
+			},
+			faNotificationSvcFn: func() *automock.FormationAssignmentNotificationService {
+				faNotificationSvc := &automock.FormationAssignmentNotificationService{}
+				faNotificationSvc.On("GenerateFormationAssignmentNotification", contextThatHasTenant(internalTntID), fa, model.AssignFormation).Return(fixEmptyNotificationRequest(), nil).Once()
+				faNotificationSvc.On("GenerateFormationAssignmentNotification", contextThatHasTenant(internalTntID), reverseFA, model.AssignFormation).Return(fixEmptyNotificationRequest(), nil).Once()
+				return faNotificationSvc
+			},
+			expectedErrMsg: "while closing database transaction",
+		},
+		{
+			name:       "Success for formation notifications",
+			entry:      formationEntry,
+			transactFn: txGen.ThatSucceeds,
+			formationSvcFn: func() *automock.FormationService {
+				formationSvc := &automock.FormationService{}
+				formationSvc.On("ResynchronizeFormationNotifications", contextThatHasNotificationHeaders(internalTntID, formationEntry.CorrelationID, formationEntry.IdempotencyKey), testFormationID, false).Return(nil, nil).Once()
+				return formationSvc
+			},
+		},
+		{
+			name:           "Error when transaction fails to begin for formation notifications",
+			entry:          formationEntry,
+			transactFn:     txGen.ThatFailsOnBegin,
+			expectedErrMsg: "unable to establish connection with database",
+		},
+		{
+			name:       "Error when resynchronizing formation notifications fails",
+			entry:      formationEntry,
+			transactFn: txGen.ThatDoesntExpectCommit,
+			formationSvcFn: func() *automock.FormationService {
+				formationSvc := &automock.FormationService{}
+				formationSvc.On("ResynchronizeFormationNotifications", contextThatHasTenant(internalTntID), testFormationID, false).Return(nil, testErr).Once()
+				return formationSvc
+			},
+			expectedErrMsg: "while resynchronize formation notifications",
+		},
+		{
+			name:       "Error when transaction fails to commit for formation notifications",
+			entry:      formationEntry,
+			transactFn: txGen.ThatFailsOnCommit,
+			formationSvcFn: func() *automock.FormationService {
+				formationSvc := &automock.FormationService{}
+				formationSvc.On("ResynchronizeFormationNotifications", contextThatHasTenant(internalTntID), testFormationID, false).Return(nil, nil).Once()
+				return formationSvc
+			},
+			expectedErrMsg: "while closing database transaction",
+		},
+		{
+			name:           "Error when outbox entry type is not supported",
+			entry:          unsupportedEntry,
+			expectedErrMsg: "unsupported outbox entry type",
+		},
+	}
+
+	for _, tCase := range testCases {
+		t.Run(tCase.name, func(t *testing.T) {
+			// GIVEN
+			persist, transact := fixUnusedTransactioner()
+			if tCase.transactFn != nil {
+				persist, transact = tCase.transactFn()
+			}
+
+			faSvc := fixUnusedFormationAssignmentSvc()
+			if tCase.faServiceFn != nil {
+				faSvc = tCase.faServiceFn()
+			}
+
+			faNotificationSvc := fixUnusedFormationAssignmentNotificationSvc()
+			if tCase.faNotificationSvcFn != nil {
+				faNotificationSvc = tCase.faNotificationSvcFn()
+			}
+
+			formationSvc := fixUnusedFormationSvc()
+			if tCase.formationSvcFn != nil {
+				formationSvc = tCase.formationSvcFn()
+			}
+
+			defer mock.AssertExpectationsForObjects(t, persist, transact, faSvc, faNotificationSvc, formationSvc)
+
+			processor := fm.NewNotificationsProcessor(transact, faSvc, faNotificationSvc, formationSvc)
+
+			// WHEN
+			err := processor.Process(emptyCtx, tCase.entry)
+
+			// THEN
+			if tCase.expectedErrMsg != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tCase.expectedErrMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
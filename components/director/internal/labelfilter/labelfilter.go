@@ -2,17 +2,52 @@ package labelfilter
 
 import "github.com/kyma-incubator/compass/components/director/pkg/graphql"
 
+// Operator defines how a label filter Condition compares the label value
+type Operator string
+
+const (
+	// EqOperator matches labels with value equal to the single condition value
+	EqOperator Operator = "EQ"
+	// InOperator matches labels with value equal to any of the condition values
+	InOperator Operator = "IN"
+	// ExistsOperator matches objects which have the label regardless of its value
+	ExistsOperator Operator = "EXISTS"
+	// StartsWithOperator matches string labels with value starting with the single condition value
+	StartsWithOperator Operator = "STARTS_WITH"
+	// GtOperator matches numeric labels with value greater than the single condition value
+	GtOperator Operator = "GT"
+	// LtOperator matches numeric labels with value lower than the single condition value
+	LtOperator Operator = "LT"
+)
+
 // LabelFilter missing godoc
 type LabelFilter struct {
-	Key   string
-	Query *string
+	Key        string
+	Query      *string
+	Expression *Expression
+}
+
+// Expression is a boolean expression over the labels of an object. Exactly one of its fields is expected to be set.
+type Expression struct {
+	And       []*Expression
+	Or        []*Expression
+	Not       *Expression
+	Condition *Condition
+}
+
+// Condition is a single comparison of a label value. Empty Key means the key of the enclosing LabelFilter.
+type Condition struct {
+	Key      string
+	Operator Operator
+	Values   []string
 }
 
 // FromGraphQL missing godoc
 func FromGraphQL(in *graphql.LabelFilter) *LabelFilter {
 	return &LabelFilter{
-		Key:   in.Key,
-		Query: in.Query,
+		Key:        in.Key,
+		Query:      in.Query,
+		Expression: expressionFromGraphQL(in.Expression),
 	}
 }
 
@@ -29,10 +64,46 @@ func MultipleFromGraphQL(in []*graphql.LabelFilter) []*LabelFilter {
 
 // NewForKey missing godoc
 func NewForKey(key string) *LabelFilter {
-	return &LabelFilter{key, nil}
+	return &LabelFilter{Key: key}
 }
 
 // NewForKeyWithQuery missing godoc
 func NewForKeyWithQuery(key, query string) *LabelFilter {
-	return &LabelFilter{key, &query}
+	return &LabelFilter{Key: key, Query: &query}
+}
+
+// NewForKeyWithExpression creates a LabelFilter for the given key and structured expression
+func NewForKeyWithExpression(key string, expression *Expression) *LabelFilter {
+	return &LabelFilter{Key: key, Expression: expression}
+}
+
+func expressionFromGraphQL(in *graphql.LabelFilterExpression) *Expression {
+	if in == nil {
+		return nil
+	}
+
+	expression := &Expression{
+		Not: expressionFromGraphQL(in.Not),
+	}
+
+	for _, e := range in.And {
+		expression.And = append(expression.And, expressionFromGraphQL(e))
+	}
+	for _, e := range in.Or {
+		expression.Or = append(expression.Or, expressionFromGraphQL(e))
+	}
+
+	if in.Condition != nil {
+		var key string
+		if in.Condition.Key != nil {
+			key = *in.Condition.Key
+		}
+		expression.Condition = &Condition{
+			Key:      key,
+			Operator: Operator(in.Condition.Operator),
+			Values:   in.Condition.Values,
+		}
+	}
+
+	return expression
 }
@@ -41,6 +41,33 @@ func TestFromGraphQL(t *testing.T) {
 
 		assert.Equal(t, expected, result)
 	})
+
+	t.Run("With expression", func(t *testing.T) {
+		deprecatedKey := "deprecated"
+		in := &graphql.LabelFilter{
+			Key: "region",
+			Expression: &graphql.LabelFilterExpression{
+				And: []*graphql.LabelFilterExpression{
+					{Condition: &graphql.LabelFilterCondition{Operator: graphql.LabelFilterOperatorIn, Values: []string{"eu10", "eu20"}}},
+					{Not: &graphql.LabelFilterExpression{Condition: &graphql.LabelFilterCondition{Key: &deprecatedKey, Operator: graphql.LabelFilterOperatorExists}}},
+				},
+			},
+		}
+
+		expected := &labelfilter.LabelFilter{
+			Key: "region",
+			Expression: &labelfilter.Expression{
+				And: []*labelfilter.Expression{
+					{Condition: &labelfilter.Condition{Operator: labelfilter.InOperator, Values: []string{"eu10", "eu20"}}},
+					{Not: &labelfilter.Expression{Condition: &labelfilter.Condition{Key: deprecatedKey, Operator: labelfilter.ExistsOperator}}},
+				},
+			},
+		}
+
+		result := labelfilter.FromGraphQL(in)
+
+		assert.Equal(t, expected, result)
+	})
 }
 
 func TestMultipleFromGraphQL(t *testing.T) {
@@ -71,12 +71,34 @@ func (in ApplicationFromTemplateInputValues) FindPlaceholderValue(name string) (
 	return "", fmt.Errorf("value for placeholder name '%s' not found", name)
 }
 
+// ApplicationTemplatePlaceholderType is the type of the values accepted by an application template placeholder
+type ApplicationTemplatePlaceholderType string
+
+const (
+	// StringPlaceholderType accepts any string value
+	StringPlaceholderType ApplicationTemplatePlaceholderType = "STRING"
+	// NumberPlaceholderType accepts JSON numbers
+	NumberPlaceholderType ApplicationTemplatePlaceholderType = "NUMBER"
+	// BooleanPlaceholderType accepts "true" and "false"
+	BooleanPlaceholderType ApplicationTemplatePlaceholderType = "BOOLEAN"
+	// EnumPlaceholderType accepts one of the enum values of the placeholder
+	EnumPlaceholderType ApplicationTemplatePlaceholderType = "ENUM"
+	// URLPlaceholderType accepts absolute HTTP and HTTPS URLs
+	URLPlaceholderType ApplicationTemplatePlaceholderType = "URL"
+)
+
 // ApplicationTemplatePlaceholder missing godoc
 type ApplicationTemplatePlaceholder struct {
-	Name        string
-	Description *string
-	JSONPath    *string
-	Optional    *bool
+	Name         string
+	Description  *string
+	JSONPath     *string
+	Optional     *bool
+	Type         *ApplicationTemplatePlaceholderType `json:",omitempty"`
+	EnumValues   []string                            `json:",omitempty"`
+	Pattern      *string                             `json:",omitempty"`
+	MinLength    *int                                `json:",omitempty"`
+	MaxLength    *int                                `json:",omitempty"`
+	DefaultValue *string                             `json:",omitempty"`
 }
 
 // ApplicationTemplateValueInput missing godoc
@@ -147,3 +169,17 @@ func (a *ApplicationTemplateUpdateInput) ToApplicationTemplate(id string) Applic
 		Webhooks:             webhooks,
 	}
 }
+
+// ApplicationUpgradeDiff represents the changes needed to bring an application in line with the current state of its application template
+type ApplicationUpgradeDiff struct {
+	Input              ApplicationRegisterInput
+	Fields             []string
+	Labels             map[string]interface{}
+	Webhooks           []*WebhookInput
+	ReplacedWebhookIDs []string
+}
+
+// IsEmpty returns true if the application is already up to date with its application template
+func (d *ApplicationUpgradeDiff) IsEmpty() bool {
+	return len(d.Fields) == 0 && len(d.Labels) == 0 && len(d.Webhooks) == 0
+}
@@ -29,6 +29,7 @@ type CredentialData struct {
 	Basic            *BasicCredentialData
 	Oauth            *OAuthCredentialData
 	CertificateOAuth *CertificateOAuthCredentialData
+	HMAC             *HMACCredentialData `json:",omitempty"`
 }
 
 // BasicCredentialData missing godoc
@@ -51,6 +52,13 @@ type CertificateOAuthCredentialData struct {
 	URL         string
 }
 
+// HMACCredentialData represents the secrets used for signing outgoing webhook requests.
+// SecondarySecret is set only during key rotation when both secrets are active.
+type HMACCredentialData struct {
+	PrimarySecret   string
+	SecondarySecret string
+}
+
 // AuthInput missing godoc
 type AuthInput struct {
 	Credential            *CredentialDataInput
@@ -92,6 +100,7 @@ type CredentialDataInput struct {
 	Basic            *BasicCredentialDataInput
 	Oauth            *OAuthCredentialDataInput
 	CertificateOAuth *CertificateOAuthCredentialDataInput
+	HMAC             *HMACCredentialDataInput
 }
 
 // ToCredentialData missing godoc
@@ -103,6 +112,7 @@ func (i *CredentialDataInput) ToCredentialData() *CredentialData {
 	var basic *BasicCredentialData
 	var oauth *OAuthCredentialData
 	var certOAuth *CertificateOAuthCredentialData
+	var hmac *HMACCredentialData
 
 	if i.Basic != nil {
 		basic = i.Basic.ToBasicCredentialData()
@@ -116,10 +126,15 @@ func (i *CredentialDataInput) ToCredentialData() *CredentialData {
 		certOAuth = i.CertificateOAuth.ToCertificateOAuthCredentialData()
 	}
 
+	if i.HMAC != nil {
+		hmac = i.HMAC.ToHMACCredentialData()
+	}
+
 	return &CredentialData{
 		Basic:            basic,
 		Oauth:            oauth,
 		CertificateOAuth: certOAuth,
+		HMAC:             hmac,
 	}
 }
 
@@ -181,6 +196,24 @@ func (i *CertificateOAuthCredentialDataInput) ToCertificateOAuthCredentialData()
 	}
 }
 
+// HMACCredentialDataInput represents an input structure for the secrets used for signing outgoing webhook requests
+type HMACCredentialDataInput struct {
+	PrimarySecret   string
+	SecondarySecret string
+}
+
+// ToHMACCredentialData converts a HMACCredentialDataInput into HMACCredentialData
+func (i *HMACCredentialDataInput) ToHMACCredentialData() *HMACCredentialData {
+	if i == nil {
+		return nil
+	}
+
+	return &HMACCredentialData{
+		PrimarySecret:   i.PrimarySecret,
+		SecondarySecret: i.SecondarySecret,
+	}
+}
+
 // CredentialRequestAuthInput missing godoc
 type CredentialRequestAuthInput struct {
 	Csrf *CSRFTokenCredentialRequestAuthInput
@@ -0,0 +1,127 @@
+package model
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+)
+
+// Capability represents an ORD Capability - a feature or functionality of an Application which is not exposed as an API or Event
+type Capability struct {
+	ID                           string
+	ApplicationID                *string
+	ApplicationTemplateVersionID *string
+	PackageID                    string
+	OrdID                        string
+	LocalID                      *string
+	Title                        string
+	ShortDescription             *string
+	Description                  *string
+	Type                         string
+	CustomType                   *string
+	Version                      string
+	Visibility                   string
+	ReleaseStatus                string
+	Definitions                  json.RawMessage
+	RelatedEntityTypes           json.RawMessage
+	Links                        json.RawMessage
+	Tags                         json.RawMessage
+	Labels                       json.RawMessage
+	DocumentationLabels          json.RawMessage
+	LastUpdate                   *string
+	ResourceHash                 *string
+}
+
+// CapabilityInput represents an ORD Capability as described in an ORD document
+type CapabilityInput struct {
+	OrdID               string          `json:"ordId"`
+	LocalID             *string         `json:"localId"`
+	Title               string          `json:"title"`
+	ShortDescription    *string         `json:"shortDescription"`
+	Description         *string         `json:"description"`
+	Type                string          `json:"type"`
+	CustomType          *string         `json:"customType"`
+	OrdPackageID        string          `json:"partOfPackage"`
+	Version             string          `json:"version" hash:"ignore"`
+	Visibility          string          `json:"visibility"`
+	ReleaseStatus       string          `json:"releaseStatus"`
+	Definitions         json.RawMessage `json:"definitions"`
+	RelatedEntityTypes  json.RawMessage `json:"relatedEntityTypes"`
+	Links               json.RawMessage `json:"links"`
+	Tags                json.RawMessage `json:"tags"`
+	Labels              json.RawMessage `json:"labels"`
+	DocumentationLabels json.RawMessage `json:"documentationLabels"`
+	LastUpdate          *string         `json:"lastUpdate" hash:"ignore"`
+}
+
+// ToCapability converts CapabilityInput to Capability
+func (i *CapabilityInput) ToCapability(id string, resourceType resource.Type, resourceID, packageID string, capabilityHash uint64) *Capability {
+	if i == nil {
+		return nil
+	}
+
+	var hash *string
+	if capabilityHash != 0 {
+		hash = str.Ptr(strconv.FormatUint(capabilityHash, 10))
+	}
+
+	capability := &Capability{
+		ID:                  id,
+		PackageID:           packageID,
+		OrdID:               i.OrdID,
+		LocalID:             i.LocalID,
+		Title:               i.Title,
+		ShortDescription:    i.ShortDescription,
+		Description:         i.Description,
+		Type:                i.Type,
+		CustomType:          i.CustomType,
+		Version:             i.Version,
+		Visibility:          i.Visibility,
+		ReleaseStatus:       i.ReleaseStatus,
+		Definitions:         i.Definitions,
+		RelatedEntityTypes:  i.RelatedEntityTypes,
+		Links:               i.Links,
+		Tags:                i.Tags,
+		Labels:              i.Labels,
+		DocumentationLabels: i.DocumentationLabels,
+		LastUpdate:          i.LastUpdate,
+		ResourceHash:        hash,
+	}
+
+	if resourceType.IsTenantIgnorable() {
+		capability.ApplicationTemplateVersionID = &resourceID
+	} else if resourceType == resource.Application {
+		capability.ApplicationID = &resourceID
+	}
+
+	return capability
+}
+
+// SetFromUpdateInput sets the Capability fields from the given CapabilityInput
+func (c *Capability) SetFromUpdateInput(update CapabilityInput, packageID string, capabilityHash uint64) {
+	var hash *string
+	if capabilityHash != 0 {
+		hash = str.Ptr(strconv.FormatUint(capabilityHash, 10))
+	}
+
+	c.PackageID = packageID
+	c.LocalID = update.LocalID
+	c.Title = update.Title
+	c.ShortDescription = update.ShortDescription
+	c.Description = update.Description
+	c.Type = update.Type
+	c.CustomType = update.CustomType
+	c.Version = update.Version
+	c.Visibility = update.Visibility
+	c.ReleaseStatus = update.ReleaseStatus
+	c.Definitions = update.Definitions
+	c.RelatedEntityTypes = update.RelatedEntityTypes
+	c.Links = update.Links
+	c.Tags = update.Tags
+	c.Labels = update.Labels
+	c.DocumentationLabels = update.DocumentationLabels
+	c.LastUpdate = update.LastUpdate
+	c.ResourceHash = hash
+}
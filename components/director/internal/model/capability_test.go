@@ -0,0 +1,76 @@
+package model_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCapabilityInput_ToCapability(t *testing.T) {
+	// GIVEN
+	id := "foo"
+	appID := "bar"
+	appTemplateVersionID := "naz"
+	packageID := "pkg"
+	title := "sample"
+	labels := json.RawMessage("{}")
+
+	testCases := []struct {
+		Name         string
+		Input        *model.CapabilityInput
+		ResourceType resource.Type
+		ResourceID   string
+		Expected     *model.Capability
+	}{
+		{
+			Name: "All properties given for App",
+			Input: &model.CapabilityInput{
+				Title:  title,
+				Labels: labels,
+			},
+			Expected: &model.Capability{
+				ID:            id,
+				ApplicationID: &appID,
+				PackageID:     packageID,
+				Title:         title,
+				Labels:        labels,
+			},
+			ResourceType: resource.Application,
+			ResourceID:   appID,
+		},
+		{
+			Name: "All properties given for App Template Version",
+			Input: &model.CapabilityInput{
+				Title:  title,
+				Labels: labels,
+			},
+			Expected: &model.Capability{
+				ID:                           id,
+				ApplicationTemplateVersionID: &appTemplateVersionID,
+				PackageID:                    packageID,
+				Title:                        title,
+				Labels:                       labels,
+			},
+			ResourceType: resource.ApplicationTemplateVersion,
+			ResourceID:   appTemplateVersionID,
+		},
+		{
+			Name:     "Nil",
+			Input:    nil,
+			Expected: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// WHEN
+			result := testCase.Input.ToCapability(id, testCase.ResourceType, testCase.ResourceID, packageID, 0)
+
+			// THEN
+			assert.Equal(t, testCase.Expected, result)
+		})
+	}
+}
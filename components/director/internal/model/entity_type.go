@@ -0,0 +1,147 @@
+package model
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+)
+
+// EntityType represents an ORD Entity Type - a business object exposed by an Application
+type EntityType struct {
+	ID                           string
+	ApplicationID                *string
+	ApplicationTemplateVersionID *string
+	PackageID                    string
+	OrdID                        string
+	LocalID                      string
+	CorrelationIDs               json.RawMessage
+	Level                        string
+	Title                        string
+	ShortDescription             *string
+	Description                  *string
+	Version                      string
+	ChangeLogEntries             json.RawMessage
+	Visibility                   string
+	Links                        json.RawMessage
+	PartOfProducts               json.RawMessage
+	PolicyLevel                  *string
+	CustomPolicyLevel            *string
+	ReleaseStatus                string
+	SunsetDate                   *string
+	Successors                   json.RawMessage
+	Extensible                   json.RawMessage
+	Tags                         json.RawMessage
+	Labels                       json.RawMessage
+	DocumentationLabels          json.RawMessage
+	LastUpdate                   *string
+	ResourceHash                 *string
+}
+
+// EntityTypeInput represents an ORD Entity Type as described in an ORD document
+type EntityTypeInput struct {
+	OrdID               string          `json:"ordId"`
+	LocalID             string          `json:"localId"`
+	CorrelationIDs      json.RawMessage `json:"correlationIds"`
+	Level               string          `json:"level"`
+	Title               string          `json:"title"`
+	ShortDescription    *string         `json:"shortDescription"`
+	Description         *string         `json:"description"`
+	OrdPackageID        string          `json:"partOfPackage"`
+	Version             string          `json:"version" hash:"ignore"`
+	ChangeLogEntries    json.RawMessage `json:"changelogEntries"`
+	Visibility          string          `json:"visibility"`
+	Links               json.RawMessage `json:"links"`
+	PartOfProducts      json.RawMessage `json:"partOfProducts"`
+	PolicyLevel         *string         `json:"policyLevel"`
+	CustomPolicyLevel   *string         `json:"customPolicyLevel"`
+	ReleaseStatus       string          `json:"releaseStatus"`
+	SunsetDate          *string         `json:"sunsetDate"`
+	Successors          json.RawMessage `json:"successors"`
+	Extensible          json.RawMessage `json:"extensible"`
+	Tags                json.RawMessage `json:"tags"`
+	Labels              json.RawMessage `json:"labels"`
+	DocumentationLabels json.RawMessage `json:"documentationLabels"`
+	LastUpdate          *string         `json:"lastUpdate" hash:"ignore"`
+}
+
+// ToEntityType converts EntityTypeInput to EntityType
+func (i *EntityTypeInput) ToEntityType(id string, resourceType resource.Type, resourceID, packageID string, entityTypeHash uint64) *EntityType {
+	if i == nil {
+		return nil
+	}
+
+	var hash *string
+	if entityTypeHash != 0 {
+		hash = str.Ptr(strconv.FormatUint(entityTypeHash, 10))
+	}
+
+	entityType := &EntityType{
+		ID:                  id,
+		PackageID:           packageID,
+		OrdID:               i.OrdID,
+		LocalID:             i.LocalID,
+		CorrelationIDs:      i.CorrelationIDs,
+		Level:               i.Level,
+		Title:               i.Title,
+		ShortDescription:    i.ShortDescription,
+		Description:         i.Description,
+		Version:             i.Version,
+		ChangeLogEntries:    i.ChangeLogEntries,
+		Visibility:          i.Visibility,
+		Links:               i.Links,
+		PartOfProducts:      i.PartOfProducts,
+		PolicyLevel:         i.PolicyLevel,
+		CustomPolicyLevel:   i.CustomPolicyLevel,
+		ReleaseStatus:       i.ReleaseStatus,
+		SunsetDate:          i.SunsetDate,
+		Successors:          i.Successors,
+		Extensible:          i.Extensible,
+		Tags:                i.Tags,
+		Labels:              i.Labels,
+		DocumentationLabels: i.DocumentationLabels,
+		LastUpdate:          i.LastUpdate,
+		ResourceHash:        hash,
+	}
+
+	if resourceType.IsTenantIgnorable() {
+		entityType.ApplicationTemplateVersionID = &resourceID
+	} else if resourceType == resource.Application {
+		entityType.ApplicationID = &resourceID
+	}
+
+	return entityType
+}
+
+// SetFromUpdateInput sets the EntityType fields from the given EntityTypeInput
+func (e *EntityType) SetFromUpdateInput(update EntityTypeInput, packageID string, entityTypeHash uint64) {
+	var hash *string
+	if entityTypeHash != 0 {
+		hash = str.Ptr(strconv.FormatUint(entityTypeHash, 10))
+	}
+
+	e.PackageID = packageID
+	e.LocalID = update.LocalID
+	e.CorrelationIDs = update.CorrelationIDs
+	e.Level = update.Level
+	e.Title = update.Title
+	e.ShortDescription = update.ShortDescription
+	e.Description = update.Description
+	e.Version = update.Version
+	e.ChangeLogEntries = update.ChangeLogEntries
+	e.Visibility = update.Visibility
+	e.Links = update.Links
+	e.PartOfProducts = update.PartOfProducts
+	e.PolicyLevel = update.PolicyLevel
+	e.CustomPolicyLevel = update.CustomPolicyLevel
+	e.ReleaseStatus = update.ReleaseStatus
+	e.SunsetDate = update.SunsetDate
+	e.Successors = update.Successors
+	e.Extensible = update.Extensible
+	e.Tags = update.Tags
+	e.Labels = update.Labels
+	e.DocumentationLabels = update.DocumentationLabels
+	e.LastUpdate = update.LastUpdate
+	e.ResourceHash = hash
+}
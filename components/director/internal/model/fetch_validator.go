@@ -0,0 +1,39 @@
+package model
+
+// FetchValidator holds the HTTP validators received with a resource fetched from URL as part of the processing of a webhook for a given Application or Application Template.
+// They are used to make conditional requests when the resource is fetched again.
+type FetchValidator struct {
+	ID           string
+	WebhookID    string
+	ResourceID   string
+	URL          string
+	ETag         *string
+	LastModified *string
+	// Content is the last fetched body. It is stored only for resources which are needed even when they are not modified, e.g. the ORD well-known configuration.
+	Content *string
+}
+
+// FetchValidatorInput represents the input for storing a FetchValidator
+type FetchValidatorInput struct {
+	URL          string
+	ETag         *string
+	LastModified *string
+	Content      *string
+}
+
+// ToFetchValidator converts FetchValidatorInput to FetchValidator
+func (i *FetchValidatorInput) ToFetchValidator(id, webhookID, resourceID string) *FetchValidator {
+	if i == nil {
+		return nil
+	}
+
+	return &FetchValidator{
+		ID:           id,
+		WebhookID:    webhookID,
+		ResourceID:   resourceID,
+		URL:          i.URL,
+		ETag:         i.ETag,
+		LastModified: i.LastModified,
+		Content:      i.Content,
+	}
+}
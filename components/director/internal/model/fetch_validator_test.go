@@ -0,0 +1,56 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFetchValidatorInput_ToFetchValidator(t *testing.T) {
+	// GIVEN
+	id := "foo"
+	webhookID := "webhook-id"
+	resourceID := "resource-id"
+
+	testCases := []struct {
+		Name     string
+		Input    *model.FetchValidatorInput
+		Expected *model.FetchValidator
+	}{
+		{
+			Name: "All properties given",
+			Input: &model.FetchValidatorInput{
+				URL:          "http://test.com/.well-known/open-resource-discovery",
+				ETag:         str.Ptr("etag"),
+				LastModified: str.Ptr("last-modified"),
+				Content:      str.Ptr("{}"),
+			},
+			Expected: &model.FetchValidator{
+				ID:           id,
+				WebhookID:    webhookID,
+				ResourceID:   resourceID,
+				URL:          "http://test.com/.well-known/open-resource-discovery",
+				ETag:         str.Ptr("etag"),
+				LastModified: str.Ptr("last-modified"),
+				Content:      str.Ptr("{}"),
+			},
+		},
+		{
+			Name:     "Nil",
+			Input:    nil,
+			Expected: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// WHEN
+			result := testCase.Input.ToFetchValidator(id, webhookID, resourceID)
+
+			// THEN
+			assert.Equal(t, testCase.Expected, result)
+		})
+	}
+}
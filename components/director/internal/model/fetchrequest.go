@@ -16,6 +16,9 @@ type FetchRequest struct {
 	Status     *FetchRequestStatus
 	ObjectType FetchRequestReferenceObjectType
 	ObjectID   string
+	// ETag and LastModified are the validators of the last successfully fetched specification. They are used for conditional fetching.
+	ETag         *string
+	LastModified *string
 }
 
 // FetchRequestReferenceObjectType represents the type of the object that the fetch request is referencing.
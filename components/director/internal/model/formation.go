@@ -23,6 +23,8 @@ const (
 	DeleteErrorFormationState FormationState = "DELETE_ERROR"
 	// DeletingFormationState indicates that the formation is in deleting state
 	DeletingFormationState FormationState = "DELETING"
+	// DraftFormationState indicates that the formation changes are only recorded and no assignments or notifications are generated until the formation is finalized
+	DraftFormationState FormationState = "DRAFT"
 )
 
 // FormationOperation defines the kind of operation done on a given formation
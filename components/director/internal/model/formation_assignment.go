@@ -2,6 +2,7 @@ package model
 
 import (
 	"encoding/json"
+	"time"
 	"unsafe"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
@@ -45,6 +46,37 @@ type FormationAssignmentInput struct {
 	Error       json.RawMessage         `json:"error"`
 }
 
+// FormationAssignmentTransitionActor represents who triggered a formation assignment transition
+type FormationAssignmentTransitionActor string
+
+const (
+	// NotificationResponseTransitionActor indicates that the transition is triggered by the response of a formation assignment notification
+	NotificationResponseTransitionActor FormationAssignmentTransitionActor = "NOTIFICATION_RESPONSE"
+	// StatusAPITransitionActor indicates that the transition is reported by the notification receiver on the status API
+	StatusAPITransitionActor FormationAssignmentTransitionActor = "STATUS_API"
+	// ResetTransitionActor indicates that the transition is caused by resetting the formation assignments of a formation
+	ResetTransitionActor FormationAssignmentTransitionActor = "RESET"
+	// SystemTransitionActor indicates that the transition is done internally, without an external trigger
+	SystemTransitionActor FormationAssignmentTransitionActor = "SYSTEM"
+)
+
+// FormationAssignmentTransition represents a single entry in the append-only state history of a formation assignment
+type FormationAssignmentTransition struct {
+	ID                    string
+	FormationAssignmentID string
+	FormationID           string
+	TenantID              string
+	Source                string
+	SourceType            FormationAssignmentType
+	Target                string
+	TargetType            FormationAssignmentType
+	OldState              string
+	NewState              string
+	Actor                 FormationAssignmentTransitionActor
+	ConfigDiff            *string
+	Timestamp             time.Time
+}
+
 // FormationAssignmentPage missing godoc
 type FormationAssignmentPage struct {
 	Data       []*FormationAssignment
@@ -82,3 +82,13 @@ type FormationConstraint struct {
 	InputTemplate   string
 	ConstraintScope FormationConstraintScope
 }
+
+// FormationConstraintEvaluation represents the result of a dry-run evaluation of a FormationConstraint
+type FormationConstraintEvaluation struct {
+	Constraint *FormationConstraint
+	Satisfied  bool
+	// Skipped denotes that the operator was not executed because it has side effects
+	Skipped       bool
+	Reason        string
+	RenderedInput string
+}
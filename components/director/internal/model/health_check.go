@@ -0,0 +1,67 @@
+package model
+
+import (
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
+)
+
+// HealthCheckType defines the type of health check
+type HealthCheckType string
+
+const (
+	// HealthCheckTypeManagementPlaneApplication represents a health check of an application performed by the management plane
+	HealthCheckTypeManagementPlaneApplication HealthCheckType = "MANAGEMENT_PLANE_APPLICATION_HEALTHCHECK"
+)
+
+// HealthCheckStatusCondition defines the outcome of a health check
+type HealthCheckStatusCondition string
+
+const (
+	// HealthCheckStatusConditionSucceeded represents a successful health check
+	HealthCheckStatusConditionSucceeded HealthCheckStatusCondition = "SUCCEEDED"
+	// HealthCheckStatusConditionFailed represents a failed health check
+	HealthCheckStatusConditionFailed HealthCheckStatusCondition = "FAILED"
+)
+
+// HealthCheck represents the result of a single health check of the Origin object
+type HealthCheck struct {
+	ID        string
+	Type      HealthCheckType
+	Condition HealthCheckStatusCondition
+	Origin    string
+	Message   *string
+	Timestamp time.Time
+}
+
+// HealthCheckPage represents a page of HealthChecks
+type HealthCheckPage struct {
+	Data       []*HealthCheck
+	PageInfo   *pagination.Page
+	TotalCount int
+}
+
+// HealthCheckInput represents the input for recording a HealthCheck
+type HealthCheckInput struct {
+	Type      HealthCheckType
+	Condition HealthCheckStatusCondition
+	Origin    string
+	Message   *string
+	Timestamp time.Time
+}
+
+// ToHealthCheck converts HealthCheckInput to HealthCheck
+func (i *HealthCheckInput) ToHealthCheck(id string) *HealthCheck {
+	if i == nil {
+		return nil
+	}
+
+	return &HealthCheck{
+		ID:        id,
+		Type:      i.Type,
+		Condition: i.Condition,
+		Origin:    i.Origin,
+		Message:   i.Message,
+		Timestamp: i.Timestamp,
+	}
+}
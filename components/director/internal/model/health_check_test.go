@@ -0,0 +1,56 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHealthCheckInput_ToHealthCheck(t *testing.T) {
+	// GIVEN
+	id := "foo"
+	message := "message"
+	timestamp := time.Now()
+
+	testCases := []struct {
+		Name     string
+		Input    *model.HealthCheckInput
+		Expected *model.HealthCheck
+	}{
+		{
+			Name: "All properties given",
+			Input: &model.HealthCheckInput{
+				Type:      model.HealthCheckTypeManagementPlaneApplication,
+				Condition: model.HealthCheckStatusConditionFailed,
+				Origin:    "origin",
+				Message:   &message,
+				Timestamp: timestamp,
+			},
+			Expected: &model.HealthCheck{
+				ID:        id,
+				Type:      model.HealthCheckTypeManagementPlaneApplication,
+				Condition: model.HealthCheckStatusConditionFailed,
+				Origin:    "origin",
+				Message:   &message,
+				Timestamp: timestamp,
+			},
+		},
+		{
+			Name:     "Nil",
+			Input:    nil,
+			Expected: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// WHEN
+			result := testCase.Input.ToHealthCheck(id)
+
+			// THEN
+			assert.Equal(t, testCase.Expected, result)
+		})
+	}
+}
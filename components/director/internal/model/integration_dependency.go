@@ -0,0 +1,179 @@
+package model
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+)
+
+// IntegrationDependency represents an ORD Integration Dependency - a declaration of the APIs and Events an Application needs from other systems
+type IntegrationDependency struct {
+	ID                             string
+	ApplicationID                  *string
+	ApplicationTemplateVersionID   *string
+	PackageID                      string
+	OrdID                          string
+	LocalID                        *string
+	CorrelationIDs                 json.RawMessage
+	Title                          string
+	ShortDescription               *string
+	Description                    *string
+	Version                        string
+	Visibility                     string
+	ReleaseStatus                  string
+	SunsetDate                     *string
+	Successors                     json.RawMessage
+	Mandatory                      bool
+	Aspects                        json.RawMessage
+	RelatedIntegrationDependencies json.RawMessage
+	Links                          json.RawMessage
+	Tags                           json.RawMessage
+	Labels                         json.RawMessage
+	DocumentationLabels            json.RawMessage
+	LastUpdate                     *string
+	ResourceHash                   *string
+}
+
+// IntegrationDependencyInput represents an ORD Integration Dependency as described in an ORD document
+type IntegrationDependencyInput struct {
+	OrdID                          string          `json:"ordId"`
+	LocalID                        *string         `json:"localId"`
+	CorrelationIDs                 json.RawMessage `json:"correlationIds"`
+	Title                          string          `json:"title"`
+	ShortDescription               *string         `json:"shortDescription"`
+	Description                    *string         `json:"description"`
+	OrdPackageID                   string          `json:"partOfPackage"`
+	Version                        string          `json:"version" hash:"ignore"`
+	Visibility                     string          `json:"visibility"`
+	ReleaseStatus                  string          `json:"releaseStatus"`
+	SunsetDate                     *string         `json:"sunsetDate"`
+	Successors                     json.RawMessage `json:"successors"`
+	Mandatory                      *bool           `json:"mandatory"`
+	Aspects                        json.RawMessage `json:"aspects"`
+	RelatedIntegrationDependencies json.RawMessage `json:"relatedIntegrationDependencies"`
+	Links                          json.RawMessage `json:"links"`
+	Tags                           json.RawMessage `json:"tags"`
+	Labels                         json.RawMessage `json:"labels"`
+	DocumentationLabels            json.RawMessage `json:"documentationLabels"`
+	LastUpdate                     *string         `json:"lastUpdate" hash:"ignore"`
+}
+
+// IntegrationDependencyAspect represents a single aspect of an Integration Dependency - a set of APIs and Events which together fulfil one integration need
+type IntegrationDependencyAspect struct {
+	Title                    string                                 `json:"title"`
+	Description              *string                                `json:"description"`
+	Mandatory                bool                                   `json:"mandatory"`
+	SupportMultipleProviders *bool                                  `json:"supportMultipleProviders"`
+	APIResources             []*IntegrationDependencyAspectResource `json:"apiResources"`
+	EventResources           []*IntegrationDependencyAspectResource `json:"eventResources"`
+}
+
+// IntegrationDependencyAspectResource represents an API or Event resource referenced by an Integration Dependency aspect
+type IntegrationDependencyAspectResource struct {
+	OrdID      string  `json:"ordId"`
+	MinVersion *string `json:"minVersion"`
+}
+
+// ToIntegrationDependency converts IntegrationDependencyInput to IntegrationDependency
+func (i *IntegrationDependencyInput) ToIntegrationDependency(id string, resourceType resource.Type, resourceID, packageID string, integrationDependencyHash uint64) *IntegrationDependency {
+	if i == nil {
+		return nil
+	}
+
+	var hash *string
+	if integrationDependencyHash != 0 {
+		hash = str.Ptr(strconv.FormatUint(integrationDependencyHash, 10))
+	}
+
+	integrationDependency := &IntegrationDependency{
+		ID:                             id,
+		PackageID:                      packageID,
+		OrdID:                          i.OrdID,
+		LocalID:                        i.LocalID,
+		CorrelationIDs:                 i.CorrelationIDs,
+		Title:                          i.Title,
+		ShortDescription:               i.ShortDescription,
+		Description:                    i.Description,
+		Version:                        i.Version,
+		Visibility:                     i.Visibility,
+		ReleaseStatus:                  i.ReleaseStatus,
+		SunsetDate:                     i.SunsetDate,
+		Successors:                     i.Successors,
+		Mandatory:                      i.Mandatory != nil && *i.Mandatory,
+		Aspects:                        i.Aspects,
+		RelatedIntegrationDependencies: i.RelatedIntegrationDependencies,
+		Links:                          i.Links,
+		Tags:                           i.Tags,
+		Labels:                         i.Labels,
+		DocumentationLabels:            i.DocumentationLabels,
+		LastUpdate:                     i.LastUpdate,
+		ResourceHash:                   hash,
+	}
+
+	if resourceType.IsTenantIgnorable() {
+		integrationDependency.ApplicationTemplateVersionID = &resourceID
+	} else if resourceType == resource.Application {
+		integrationDependency.ApplicationID = &resourceID
+	}
+
+	return integrationDependency
+}
+
+// SetFromUpdateInput sets the IntegrationDependency fields from the given IntegrationDependencyInput
+func (i *IntegrationDependency) SetFromUpdateInput(update IntegrationDependencyInput, packageID string, integrationDependencyHash uint64) {
+	var hash *string
+	if integrationDependencyHash != 0 {
+		hash = str.Ptr(strconv.FormatUint(integrationDependencyHash, 10))
+	}
+
+	i.PackageID = packageID
+	i.LocalID = update.LocalID
+	i.CorrelationIDs = update.CorrelationIDs
+	i.Title = update.Title
+	i.ShortDescription = update.ShortDescription
+	i.Description = update.Description
+	i.Version = update.Version
+	i.Visibility = update.Visibility
+	i.ReleaseStatus = update.ReleaseStatus
+	i.SunsetDate = update.SunsetDate
+	i.Successors = update.Successors
+	i.Mandatory = update.Mandatory != nil && *update.Mandatory
+	i.Aspects = update.Aspects
+	i.RelatedIntegrationDependencies = update.RelatedIntegrationDependencies
+	i.Links = update.Links
+	i.Tags = update.Tags
+	i.Labels = update.Labels
+	i.DocumentationLabels = update.DocumentationLabels
+	i.LastUpdate = update.LastUpdate
+	i.ResourceHash = hash
+}
+
+// GetAspects unmarshals the aspects of the Integration Dependency
+func (i *IntegrationDependency) GetAspects() ([]*IntegrationDependencyAspect, error) {
+	aspects := make([]*IntegrationDependencyAspect, 0)
+	if len(i.Aspects) == 0 {
+		return aspects, nil
+	}
+
+	if err := json.Unmarshal(i.Aspects, &aspects); err != nil {
+		return nil, err
+	}
+
+	return aspects, nil
+}
+
+// IntegrationDependencyProvider represents an API or Event Definition of another Application which satisfies an aspect of an Integration Dependency.
+// Exactly one of the API and Event Definition fields is set.
+type IntegrationDependencyProvider struct {
+	IntegrationDependencyID    string
+	IntegrationDependencyOrdID string
+	AspectTitle                string
+	Mandatory                  bool
+	APIDefinitionID            *string
+	APIDefinitionOrdID         *string
+	EventDefinitionID          *string
+	EventDefinitionOrdID       *string
+	ApplicationID              string
+}
@@ -0,0 +1,113 @@
+package model_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIntegrationDependencyInput_ToIntegrationDependency(t *testing.T) {
+	// GIVEN
+	id := "foo"
+	appID := "bar"
+	appTemplateVersionID := "naz"
+	packageID := "pkg"
+	title := "sample"
+	mandatory := true
+	labels := json.RawMessage("{}")
+
+	testCases := []struct {
+		Name         string
+		Input        *model.IntegrationDependencyInput
+		ResourceType resource.Type
+		ResourceID   string
+		Expected     *model.IntegrationDependency
+	}{
+		{
+			Name: "All properties given for App",
+			Input: &model.IntegrationDependencyInput{
+				Title:     title,
+				Mandatory: &mandatory,
+				Labels:    labels,
+			},
+			Expected: &model.IntegrationDependency{
+				ID:            id,
+				ApplicationID: &appID,
+				PackageID:     packageID,
+				Title:         title,
+				Mandatory:     true,
+				Labels:        labels,
+			},
+			ResourceType: resource.Application,
+			ResourceID:   appID,
+		},
+		{
+			Name: "All properties given for App Template Version",
+			Input: &model.IntegrationDependencyInput{
+				Title:  title,
+				Labels: labels,
+			},
+			Expected: &model.IntegrationDependency{
+				ID:                           id,
+				ApplicationTemplateVersionID: &appTemplateVersionID,
+				PackageID:                    packageID,
+				Title:                        title,
+				Labels:                       labels,
+			},
+			ResourceType: resource.ApplicationTemplateVersion,
+			ResourceID:   appTemplateVersionID,
+		},
+		{
+			Name:     "Nil",
+			Input:    nil,
+			Expected: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// WHEN
+			result := testCase.Input.ToIntegrationDependency(id, testCase.ResourceType, testCase.ResourceID, packageID, 0)
+
+			// THEN
+			assert.Equal(t, testCase.Expected, result)
+		})
+	}
+}
+
+func TestIntegrationDependency_GetAspects(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		integrationDependency := &model.IntegrationDependency{
+			Aspects: json.RawMessage(`[{"title":"aspect","mandatory":true,"apiResources":[{"ordId":"ns:apiResource:API_ID:v1","minVersion":"1.0.0"}]}]`),
+		}
+
+		// WHEN
+		aspects, err := integrationDependency.GetAspects()
+
+		// THEN
+		require.NoError(t, err)
+		require.Len(t, aspects, 1)
+		assert.Equal(t, "aspect", aspects[0].Title)
+		assert.True(t, aspects[0].Mandatory)
+		require.Len(t, aspects[0].APIResources, 1)
+		assert.Equal(t, "ns:apiResource:API_ID:v1", aspects[0].APIResources[0].OrdID)
+	})
+
+	t.Run("Returns empty slice when there are no aspects", func(t *testing.T) {
+		aspects, err := (&model.IntegrationDependency{}).GetAspects()
+
+		require.NoError(t, err)
+		assert.Empty(t, aspects)
+	})
+
+	t.Run("Returns error when aspects are not valid JSON", func(t *testing.T) {
+		_, err := (&model.IntegrationDependency{Aspects: json.RawMessage(`{`)}).GetAspects()
+
+		require.Error(t, err)
+	})
+}
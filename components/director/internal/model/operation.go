@@ -3,6 +3,8 @@ package model
 import (
 	"encoding/json"
 	"time"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
 )
 
 // OperationStatus defines operation status
@@ -28,7 +30,19 @@ type Operation struct {
 	Error      json.RawMessage
 	Priority   int
 	CreatedAt  *time.Time
+	UpdatedAt  *time.Time
 	FinishedAt *time.Time
+	// ClaimToken identifies the consumer which currently holds the lease of an IN_PROGRESS operation
+	ClaimToken string
+	// Attempts is the number of times the operation was claimed for processing
+	Attempts int
+}
+
+// OperationPage represents a page of Operations
+type OperationPage struct {
+	Data       []*Operation
+	PageInfo   *pagination.Page
+	TotalCount int
 }
 
 // OperationInput represents an OperationInput
@@ -56,6 +70,7 @@ func (i *OperationInput) ToOperation(id string) *Operation {
 		Error:      i.Error,
 		Priority:   i.Priority,
 		CreatedAt:  i.CreatedAt,
+		UpdatedAt:  i.CreatedAt,
 		FinishedAt: i.FinishedAt,
 	}
 }
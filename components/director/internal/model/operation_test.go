@@ -37,6 +37,7 @@ func TestOperationInput_ToOperation(t *testing.T) {
 				Error:      json.RawMessage("{}"),
 				Priority:   1,
 				CreatedAt:  &time.Time{},
+				UpdatedAt:  &time.Time{},
 				FinishedAt: &time.Time{},
 			},
 		},
@@ -0,0 +1,75 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// OutboxEntryType defines the kind of notifications an outbox entry stands for
+type OutboxEntryType string
+
+const (
+	// FormationAssignmentNotificationsOutboxEntryType is the type of the outbox entries for notifications generated after a formation assignment status update
+	FormationAssignmentNotificationsOutboxEntryType OutboxEntryType = "FORMATION_ASSIGNMENT_NOTIFICATIONS"
+	// FormationNotificationsOutboxEntryType is the type of the outbox entries for notifications generated after a formation status update
+	FormationNotificationsOutboxEntryType OutboxEntryType = "FORMATION_NOTIFICATIONS"
+)
+
+// OutboxEntryStatus defines outbox entry status
+type OutboxEntryStatus string
+
+const (
+	// OutboxEntryStatusPending pending outbox entry status
+	OutboxEntryStatusPending OutboxEntryStatus = "PENDING"
+	// OutboxEntryStatusDelivered delivered outbox entry status
+	OutboxEntryStatusDelivered OutboxEntryStatus = "DELIVERED"
+	// OutboxEntryStatusFailed failed outbox entry status
+	OutboxEntryStatusFailed OutboxEntryStatus = "FAILED"
+)
+
+// OutboxEntry represents a notification which is stored in the same transaction as the state change that caused it and is delivered asynchronously
+type OutboxEntry struct {
+	ID             string
+	IdempotencyKey string
+	Type           OutboxEntryType
+	TenantID       string
+	CorrelationID  string
+	Payload        json.RawMessage
+	Status         OutboxEntryStatus
+	Attempts       int
+	NextAttemptAt  *time.Time
+	LastError      string
+	CreatedAt      *time.Time
+	UpdatedAt      *time.Time
+	// ClaimToken identifies the dispatcher which currently holds the lease of the outbox entry
+	ClaimToken string
+}
+
+// OutboxEntryInput represents an OutboxEntryInput
+type OutboxEntryInput struct {
+	IdempotencyKey string
+	Type           OutboxEntryType
+	TenantID       string
+	CorrelationID  string
+	Payload        json.RawMessage
+}
+
+// ToOutboxEntry converts OutboxEntryInput to a PENDING OutboxEntry which is due immediately
+func (i *OutboxEntryInput) ToOutboxEntry(id string, now time.Time) *OutboxEntry {
+	if i == nil {
+		return nil
+	}
+
+	return &OutboxEntry{
+		ID:             id,
+		IdempotencyKey: i.IdempotencyKey,
+		Type:           i.Type,
+		TenantID:       i.TenantID,
+		CorrelationID:  i.CorrelationID,
+		Payload:        i.Payload,
+		Status:         OutboxEntryStatusPending,
+		NextAttemptAt:  &now,
+		CreatedAt:      &now,
+		UpdatedAt:      &now,
+	}
+}
@@ -0,0 +1,62 @@
+package model_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOutboxEntryInput_ToOutboxEntry(t *testing.T) {
+	// GIVEN
+	id := "foo"
+	now := time.Now()
+	payload := json.RawMessage(`{"id":"bar"}`)
+
+	testCases := []struct {
+		Name     string
+		Input    *model.OutboxEntryInput
+		Expected *model.OutboxEntry
+	}{
+		{
+			Name: "All properties given",
+			Input: &model.OutboxEntryInput{
+				IdempotencyKey: "key",
+				Type:           model.FormationNotificationsOutboxEntryType,
+				TenantID:       "tenant",
+				CorrelationID:  "correlation",
+				Payload:        payload,
+			},
+			Expected: &model.OutboxEntry{
+				ID:             id,
+				IdempotencyKey: "key",
+				Type:           model.FormationNotificationsOutboxEntryType,
+				TenantID:       "tenant",
+				CorrelationID:  "correlation",
+				Payload:        payload,
+				Status:         model.OutboxEntryStatusPending,
+				Attempts:       0,
+				NextAttemptAt:  &now,
+				CreatedAt:      &now,
+				UpdatedAt:      &now,
+			},
+		},
+		{
+			Name:     "Nil",
+			Input:    nil,
+			Expected: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// WHEN
+			result := testCase.Input.ToOutboxEntry(id, now)
+
+			// THEN
+			assert.Equal(t, testCase.Expected, result)
+		})
+	}
+}
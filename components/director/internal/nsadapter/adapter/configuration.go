@@ -4,9 +4,11 @@ import (
 	"time"
 
 	"github.com/kyma-incubator/compass/components/director/internal/healthz"
+	operationsmanager "github.com/kyma-incubator/compass/components/director/internal/operations_manager"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/certloader"
 
+	"github.com/kyma-incubator/compass/components/director/pkg/encryption"
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
 	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
 )
@@ -21,7 +23,8 @@ type Configuration struct {
 
 	CertLoaderConfig certloader.Config
 
-	Database persistence.DatabaseConfig
+	Database              persistence.DatabaseConfig
+	CredentialsEncryption encryption.Config
 
 	SystemToTemplateMappings string `envconfig:"APP_SYSTEM_TO_TEMPLATE_MAPPINGS,default='{}'"`
 	AllowJWTSigningNone      bool   `envconfig:"APP_ALLOW_JWT_SIGNING_NONE,default=false"`
@@ -37,4 +40,7 @@ type Configuration struct {
 
 	ExternalClientCertSecretName string `envconfig:"APP_EXTERNAL_CLIENT_CERT_SECRET_NAME"`
 	ExtSvcClientCertSecretName   string `envconfig:"APP_EXT_SVC_CLIENT_CERT_SECRET_NAME"`
+
+	ReportDeduplicationPeriod time.Duration `envconfig:"APP_REPORT_DEDUPLICATION_PERIOD,default=1h"`
+	OperationsWorkerConfig    operationsmanager.WorkerConfig
 }
@@ -2,23 +2,25 @@ package handler
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
-	"fmt"
 	"net/http"
-	"strings"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/gorilla/mux"
 
-	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
-
-	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/operation"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/internal/nsadapter/httputil"
 	"github.com/kyma-incubator/compass/components/director/internal/nsadapter/nsmodel"
+	operationsmanager "github.com/kyma-incubator/compass/components/director/internal/operations_manager"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 	"github.com/kyma-incubator/compass/components/director/pkg/httputils"
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
 	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
-	"github.com/kyma-incubator/compass/components/director/pkg/str"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
 	"github.com/pkg/errors"
 )
 
@@ -26,66 +28,45 @@ const (
 	deltaReportType      = "delta"
 	fullReportType       = "full"
 	reportTypeQueryParam = "reportType"
+	jobIDPathParam       = "jobID"
 	notSubaccountMarker  = "not subaccount"
-)
-
-//go:generate mockery --exported --name=applicationService --output=automock --outpkg=automock --case=underscore --disable-version-string
-type applicationService interface {
-	CreateFromTemplate(ctx context.Context, in model.ApplicationRegisterInput, appTemplateID *string) (string, error)
-	Upsert(ctx context.Context, in model.ApplicationRegisterInput) error
-	Update(ctx context.Context, id string, in model.ApplicationUpdateInput) error
-	GetSccSystem(ctx context.Context, sccSubaccount, locationID, virtualHost string) (*model.Application, error)
-	ListBySCC(ctx context.Context, filter *labelfilter.LabelFilter) ([]*model.ApplicationWithLabel, error)
-	SetLabel(ctx context.Context, label *model.LabelInput) error
-	GetLabel(ctx context.Context, applicationID string, key string) (*model.Label, error)
-	ListSCCs(ctx context.Context) ([]*model.SccMetadata, error)
-}
-
-//go:generate mockery --exported --name=applicationConverter --output=automock --outpkg=automock --case=underscore --disable-version-string
-type applicationConverter interface {
-	CreateInputJSONToModel(ctx context.Context, in string) (model.ApplicationRegisterInput, error)
-}
 
-//go:generate mockery --exported --name=applicationTemplateService --output=automock --outpkg=automock --case=underscore --disable-version-string
-type applicationTemplateService interface {
-	Get(ctx context.Context, id string) (*model.ApplicationTemplate, error)
-	PrepareApplicationCreateInputJSON(appTemplate *model.ApplicationTemplate, values model.ApplicationFromTemplateInputValues) (string, error)
-}
+	reportOperationPriority = 1
+)
 
-//go:generate mockery --exported --name=tenantService --output=automock --outpkg=automock --case=underscore --disable-version-string
-type tenantService interface {
-	ListsByExternalIDs(ctx context.Context, ids []string) ([]*model.BusinessTenantMapping, error)
+//go:generate mockery --exported --name=operationService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type operationService interface {
+	Create(ctx context.Context, in *model.OperationInput) (string, error)
+	Get(ctx context.Context, id string) (*model.Operation, error)
+	GetLatest(ctx context.Context, opType string) (*model.Operation, error)
+	LockType(ctx context.Context, opType string) error
+	SetData(ctx context.Context, id, claimToken string, data json.RawMessage) error
 }
 
 // NewHandler returns new ns-adapter handler
-func NewHandler(appSvc applicationService, appConverter applicationConverter, appTemplateSvc applicationTemplateService, tntSvc tenantService, transact persistence.Transactioner) *Handler {
-	return &Handler{appSvc: appSvc, appConverter: appConverter, appTemplateSvc: appTemplateSvc, tntSvc: tntSvc, transact: transact}
+func NewHandler(opSvc operationService, transact persistence.Transactioner, deduplicationPeriod time.Duration) *Handler {
+	return &Handler{opSvc: opSvc, transact: transact, deduplicationPeriod: deduplicationPeriod}
 }
 
 // Handler implements handler interface
 type Handler struct {
-	appSvc         applicationService
-	appConverter   applicationConverter
-	appTemplateSvc applicationTemplateService
-	tntSvc         tenantService
-	transact       persistence.Transactioner
+	opSvc               operationService
+	transact            persistence.Transactioner
+	deduplicationPeriod time.Duration
 }
 
 // Description - Bulk create-or-update operation on exposed on-premise systems. This handler supports two types of reports - full and delta.
-// This handler takes a list of fully described SCCs together with the exposed systems.
-// It creates new application for every exposed system for which CMP isn't aware of, and updates the metadata for the ones it is.
-// - In case of full report: If there is SCC which was not reported, all exposed systems of this SCC are marked as unreachable.
-// - In case of delta report: If there are missing exposed systems for a particular SCC, these systems are marked as unreachable.
+// This handler takes a list of fully described SCCs together with the exposed systems and schedules a job which processes them asynchronously.
+// The jobs are processed one at a time in the order in which the reports were received. If the report is the same as the last received one
+// and its job is pending, in progress, or was completed during the deduplication period, the id of the existing job is returned
+// and the report is not processed again.
 // URL          - /api/v1/notifications
 // Query Params - reportType=[full, delta]
 // HTTP Method  - PUT
 // Content-Type - application/json
 // HTTP Codes:
-// 204 No Content:
-// - In case of delta report: if all systems are processed successfully
-// - In case of full report: if the request was processed
-// 200 OK:
-// - In case of delta report: if update/create failed for some on-premise systems
+// 202 Accepted:
+// - the report is scheduled for processing. The body contains the id of the job which can be used to get its status
 // 400 Bad Request:
 // - missing or invalid required report type query parameter
 // - failed to parse request body
@@ -102,16 +83,10 @@ func (a *Handler) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 		}
 	}()
 
-	reportHandlers := map[string]func(context.Context, []*nsmodel.SCC, []httputil.Detail, nsmodel.Report, http.ResponseWriter){
-		fullReportType:  a.fullReportHandler,
-		deltaReportType: a.deltaReportHandler,
-	}
-
 	reportType := req.URL.Query().Get(reportTypeQueryParam)
 	logger.Infof("New report of type %q received", reportType)
 
-	reportHandler, found := reportHandlers[reportType]
-	if !found {
+	if reportType != fullReportType && reportType != deltaReportType {
 		httputil.RespondWithError(ctx, rw, http.StatusBadRequest, httputil.Error{
 			Code:    http.StatusBadRequest,
 			Message: "the query parameter 'reportType' is missing or invalid",
@@ -139,480 +114,205 @@ func (a *Handler) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 		return
 	}
 
-	sccs := make([]*nsmodel.SCC, 0, len(reportData.Value))
-	externalIDs := make([]string, 0, len(reportData.Value))
-	for _, scc := range reportData.Value {
-		// New object with the same data is created and added to the sccs slice instead of adding &scc to the slice
-		// because otherwise the slice is populated with copies of the last scc`s address
-		s := &nsmodel.SCC{
-			ExternalSubaccountID: scc.ExternalSubaccountID,
-			InternalSubaccountID: scc.InternalSubaccountID,
-			LocationID:           scc.LocationID,
-			ExposedSystems:       scc.ExposedSystems,
-		}
-		externalIDs = append(externalIDs, scc.ExternalSubaccountID)
-		sccs = append(sccs, s)
+	job := nsmodel.ReportJob{
+		ReportType: reportType,
+		Report:     reportData,
 	}
-
-	tenants, err := a.listTenantsByExternalIDs(ctx, externalIDs)
+	jobID, err := a.scheduleReport(ctx, job)
 	if err != nil {
-		logger.Warnf("Got error while listing subaccounts: %v\n", err)
+		logger.Warnf("Got error while scheduling report: %v\n", err)
 		httputil.RespondWithError(ctx, rw, http.StatusInternalServerError, httputil.Error{
 			Code:    http.StatusInternalServerError,
 			Message: "Update failed",
 		})
 		return
 	}
-	mapExternalToInternal(ctx, tenants, sccs)
-	details := make([]httputil.Detail, 0)
-	filteredSccs := filterSccsByInternalID(ctx, sccs, &details)
-
-	logger.Infof("Starting processing of %q report.", reportType)
-	reportHandler(ctx, filteredSccs, details, reportData, rw)
-}
-
-func (a *Handler) deltaReportHandler(ctx context.Context, filteredSccs []*nsmodel.SCC, details []httputil.Detail, _ nsmodel.Report, rw http.ResponseWriter) {
-	a.processDelta(ctx, filteredSccs, &details)
-	if len(details) == 0 {
-		httputils.RespondWithBody(ctx, rw, http.StatusNoContent, struct{}{})
-		return
-	}
-	httputil.RespondWithError(ctx, rw, http.StatusOK, httputil.DetailedError{
-		Code:    http.StatusOK,
-		Message: "Update/create failed for some on-premise systems",
-		Details: details,
-	})
-}
-
-func (a *Handler) fullReportHandler(ctx context.Context, filteredSccs []*nsmodel.SCC, details []httputil.Detail, reportData nsmodel.Report, rw http.ResponseWriter) {
-	a.processDelta(ctx, filteredSccs, &details)
-	a.handleUnreachableScc(ctx, reportData)
-	httputils.RespondWithBody(ctx, rw, http.StatusNoContent, struct{}{})
-}
-
-func (a *Handler) listTenantsByExternalIDs(ctx context.Context, ids []string) ([]*model.BusinessTenantMapping, error) {
-	if len(ids) == 0 {
-		return make([]*model.BusinessTenantMapping, 0), nil
-	}
-
-	tx, err := a.transact.Begin()
-	if err != nil {
-		log.C(ctx).Warn(errors.Wrapf(err, "while openning transaction"))
-		return nil, err
-	}
-	defer a.transact.RollbackUnlessCommitted(ctx, tx)
-
-	ctxWithTransaction := persistence.SaveToContext(ctx, tx)
-	tenants, err := a.tntSvc.ListsByExternalIDs(ctxWithTransaction, ids)
-	if err != nil {
-		log.C(ctx).Warn(errors.Wrapf(err, "while listing tenants by external ids"))
-		return nil, err
-	}
 
-	if err := tx.Commit(); err != nil {
-		log.C(ctx).Warn(errors.Wrapf(err, "while committing transaction"))
-		return nil, err
-	}
-
-	return tenants, nil
+	logger.Infof("Report of type %q is scheduled for processing with job id %q", reportType, jobID)
+	httputils.RespondWithBody(ctx, rw, http.StatusAccepted, httputil.JobAccepted{JobID: jobID})
 }
 
-func (a *Handler) listSCCs(ctx context.Context) ([]*model.SccMetadata, error) {
-	tx, err := a.transact.Begin()
-	if err != nil {
-		log.C(ctx).Warn(errors.Wrapf(err, "while openning transaction"))
-		return nil, err
-	}
-	defer a.transact.RollbackUnlessCommitted(ctx, tx)
-
-	ctxWithTransaction := persistence.SaveToContext(ctx, tx)
-	sccs, err := a.appSvc.ListSCCs(ctxWithTransaction)
-	if err != nil {
-		log.C(ctx).Warn(errors.Wrapf(err, "while listing all sccs"))
-		return nil, err
-	}
-
-	if err := tx.Commit(); err != nil {
-		log.C(ctx).Warn(errors.Wrapf(err, "while committing transaction"))
-		return nil, err
-	}
-
-	return sccs, nil
-}
+// JobStatus returns the processing status of a report job together with the details for the SCCs which could not be processed.
+// URL          - /api/v1/notifications/{jobID}
+// HTTP Method  - GET
+// HTTP Codes:
+// 200 OK:
+// - the status of the job is returned
+// 404 Not Found:
+// - there is no report job with the provided id
+// 500 Internal Server Error:
+// - In case internal issue occurred. Example: db communication failed
+func (a *Handler) JobStatus(rw http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	jobID := mux.Vars(req)[jobIDPathParam]
 
-func (a *Handler) handleUnreachableScc(ctx context.Context, reportData nsmodel.Report) {
-	sccs, err := a.listSCCs(ctx)
+	op, err := a.getReportOperation(ctx, jobID)
 	if err != nil {
-		log.C(ctx).Warn(errors.Wrapf(err, "while listing sccs"))
-		return
-	}
+		if apperrors.IsNotFoundError(err) {
+			httputil.RespondWithError(ctx, rw, http.StatusNotFound, httputil.Error{
+				Code:    http.StatusNotFound,
+				Message: "job not found",
+			})
+			return
+		}
 
-	if len(sccs) == len(reportData.Value) {
+		log.C(ctx).Warnf("Got error while getting job with id %q: %v\n", jobID, err)
+		httputil.RespondWithError(ctx, rw, http.StatusInternalServerError, httputil.Error{
+			Code:    http.StatusInternalServerError,
+			Message: "Getting job status failed",
+		})
 		return
 	}
 
-	sccsFromNs := make([]*model.SccMetadata, 0, len(reportData.Value))
-	for _, scc := range reportData.Value {
-		sccsFromNs = append(sccsFromNs, &model.SccMetadata{
-			Subaccount: scc.ExternalSubaccountID,
-			LocationID: scc.LocationID,
+	var job nsmodel.ReportJob
+	if err := json.Unmarshal(op.Data, &job); err != nil {
+		log.C(ctx).Warnf("Got error while unmarshalling data of job with id %q: %v\n", jobID, err)
+		httputil.RespondWithError(ctx, rw, http.StatusInternalServerError, httputil.Error{
+			Code:    http.StatusInternalServerError,
+			Message: "Getting job status failed",
 		})
-	}
-
-	sccsToMarkAsUnreachable := difference(sccs, sccsFromNs)
-
-	externalSubaccounts := make([]string, 0, len(sccsToMarkAsUnreachable))
-	for _, scc := range sccsToMarkAsUnreachable {
-		externalSubaccounts = append(externalSubaccounts, scc.Subaccount)
-	}
-
-	internalSubaccounts, err := a.listTenantsByExternalIDs(ctx, externalSubaccounts)
-	if err != nil {
-		log.C(ctx).Warn(errors.Wrapf(err, "while listing subaccounts"))
 		return
 	}
 
-	externalToInternalSub := make(map[string]string, len(internalSubaccounts))
-	for _, subaccount := range internalSubaccounts {
-		externalToInternalSub[subaccount.ExternalTenant] = subaccount.ID
-	}
-
-	for _, scc := range sccsToMarkAsUnreachable {
-		scc.InternalSubaccountID = externalToInternalSub[scc.Subaccount]
+	status := httputil.JobStatus{
+		JobID:      op.ID,
+		ReportType: job.ReportType,
+		Status:     string(op.Status),
 	}
 
-	for _, scc := range sccsToMarkAsUnreachable {
-		ctxWithSubaccount := tenant.SaveToContext(ctx, scc.InternalSubaccountID, scc.Subaccount)
-		appsWithLabels, ok := a.listAppsByScc(ctxWithSubaccount, scc.Subaccount, scc.LocationID)
-		if ok {
-			for _, appWithLabels := range appsWithLabels {
-				a.markSystemAsUnreachable(ctxWithSubaccount, appWithLabels.App)
-			}
-		}
-	}
-}
-
-func (a *Handler) processDelta(ctx context.Context, sccs []*nsmodel.SCC, details *[]httputil.Detail) {
-	for _, scc := range sccs {
-		ctxWithTenant := tenant.SaveToContext(ctx, scc.InternalSubaccountID, scc.ExternalSubaccountID)
-		if ok := a.handleSccSystems(ctxWithTenant, *scc); !ok {
-			addErrorDetailsMsg(details, scc, "Creation failed")
-		}
+	switch op.Status {
+	case model.OperationStatusCompleted:
+		status.Details = job.Details
+	case model.OperationStatusFailed:
+		status.Error = operationErrorMessage(op.Error)
 	}
-}
-
-func (a *Handler) handleSccSystems(ctx context.Context, scc nsmodel.SCC) bool {
-	successfulUpsert := a.upsertSccSystems(ctx, scc)
-	successfulMark := a.markAsUnreachable(ctx, scc)
-	return successfulUpsert && successfulMark
-}
-
-func (a *Handler) upsertSccSystems(ctx context.Context, scc nsmodel.SCC) bool {
-	success := true
-	for _, system := range scc.ExposedSystems {
-		if len(system.TemplateID) == 0 {
-			log.C(ctx).Infof("Skipping processing of system with unsupported type %s", system.SystemType)
-			continue
-		}
-
-		tx, err := a.transact.Begin()
-		if err != nil {
-			log.C(ctx).Warn(errors.Wrapf(err, "while openning transaction"))
-			return false
-		}
-		ctxWithTransaction := persistence.SaveToContext(ctx, tx)
-
-		var txSucceeded bool
-		if system.SystemNumber != "" {
-			txSucceeded = a.upsertWithSystemNumber(ctxWithTransaction, scc, system)
-		} else {
-			txSucceeded = a.upsert(ctxWithTransaction, scc, system)
-		}
 
-		if txSucceeded {
-			if err := tx.Commit(); err != nil {
-				txSucceeded = false
-				log.C(ctx).Warn(errors.Wrapf(err, "while committing transaction"))
-			}
-		}
-
-		a.transact.RollbackUnlessCommitted(ctx, tx)
-		success = success && txSucceeded
-	}
-	return success
+	httputils.RespondWithBody(ctx, rw, http.StatusOK, status)
 }
 
-func (a *Handler) prepareAppInput(ctx context.Context, scc nsmodel.SCC, system nsmodel.System) (*model.ApplicationRegisterInput, error) {
-	template, err := a.appTemplateSvc.Get(ctx, system.TemplateID)
-	if err != nil {
-		return nil, errors.Wrapf(err, "while getting application template with id: %s", system.TemplateID)
-	}
-
-	values := model.ApplicationFromTemplateInputValues{
-		{
-			Placeholder: "name",
-			Value:       "on-premise-system" + uuid.New().String(),
-		},
-		{
-			Placeholder: "description",
-			Value:       system.Description,
-		},
-		{
-			Placeholder: "subaccount",
-			Value:       scc.ExternalSubaccountID,
-		},
-		{
-			Placeholder: "location-id",
-			Value:       scc.LocationID,
-		},
-		{
-			Placeholder: "system-type",
-			Value:       system.SystemType,
-		},
-		{
-			Placeholder: "host",
-			Value:       system.Host,
-		},
-		{
-			Placeholder: "protocol",
-			Value:       system.Protocol,
-		},
-		{
-			Placeholder: "system-number",
-			Value:       system.SystemNumber,
-		},
-		{
-			Placeholder: "system-status",
-			Value:       system.Status,
-		},
-	}
-
-	appInputJSON, err := a.appTemplateSvc.PrepareApplicationCreateInputJSON(template, values)
+func (a *Handler) scheduleReport(ctx context.Context, job nsmodel.ReportJob) (string, error) {
+	contentHash, err := reportContentHash(job)
 	if err != nil {
-		return nil, errors.Wrapf(err, "while preparing application create input from template with id:%s", system.TemplateID)
+		return "", err
 	}
+	job.ContentHash = contentHash
 
-	appInput, err := a.appConverter.CreateInputJSONToModel(ctx, appInputJSON)
+	tx, err := a.transact.Begin()
 	if err != nil {
-		return nil, errors.Wrapf(err, "while preparing application create input from json")
-	}
-
-	if appInput.SystemNumber != nil && *appInput.SystemNumber == "" {
-		appInput.SystemNumber = nil
+		return "", errors.Wrap(err, "while opening transaction")
 	}
+	defer a.transact.RollbackUnlessCommitted(ctx, tx)
 
-	return &appInput, nil
-}
+	ctx = persistence.SaveToContext(ctx, tx)
 
-func (a *Handler) upsertWithSystemNumber(ctx context.Context, scc nsmodel.SCC, system nsmodel.System) bool {
-	appInput, err := a.prepareAppInput(ctx, scc, system)
-	if err != nil {
-		log.C(ctx).Warn(errors.Wrapf(err, "while upserting Application"))
-		return false
+	// The lock makes the concurrently received reports wait for each other, so that a duplicate report is detected even if
+	// the report it duplicates is received at the same time
+	if err := a.opSvc.LockType(ctx, operationsmanager.NsAdapterReportOpType); err != nil {
+		return "", err
 	}
 
-	if err := a.appSvc.Upsert(ctx, *appInput); err != nil {
-		log.C(ctx).Warn(errors.Wrapf(err, "while upserting Application"))
-		return false
+	latestOp, err := a.opSvc.GetLatest(ctx, operationsmanager.NsAdapterReportOpType)
+	if err != nil && !apperrors.IsNotFoundError(err) {
+		return "", err
 	}
 
-	return true
-}
-
-func (a *Handler) upsert(ctx context.Context, scc nsmodel.SCC, system nsmodel.System) bool {
-	app, err := a.appSvc.GetSccSystem(ctx, scc.ExternalSubaccountID, scc.LocationID, system.Host)
-
-	if err != nil && isNotFoundError(err) {
-		return a.createAppFromTemplate(ctx, scc, system)
+	var jobID string
+	if latestOp != nil && a.isDuplicateOf(ctx, latestOp, contentHash) {
+		log.C(ctx).Infof("Report is a duplicate of the last received report with job id %q in status %s and will not be processed again", latestOp.ID, latestOp.Status)
+		jobID = latestOp.ID
+	} else if jobID, err = a.createReportOperation(ctx, job); err != nil {
+		return "", err
 	}
 
-	if err != nil {
-		log.C(ctx).Warn(errors.Wrapf(err, "while getting Application"))
-		return false
+	if err := tx.Commit(); err != nil {
+		return "", errors.Wrap(err, "while committing transaction")
 	}
 
-	return a.updateSystem(ctx, system, app)
+	return jobID, nil
 }
 
-func (a *Handler) createAppFromTemplate(ctx context.Context, scc nsmodel.SCC, system nsmodel.System) bool {
-	appInput, err := a.prepareAppInput(ctx, scc, system)
+func (a *Handler) createReportOperation(ctx context.Context, job nsmodel.ReportJob) (string, error) {
+	data, err := json.Marshal(job)
 	if err != nil {
-		log.C(ctx).Warn(errors.Wrapf(err, "while creating Application"))
-		return false
+		return "", errors.Wrap(err, "while marshalling report job")
 	}
 
-	if _, err := a.appSvc.CreateFromTemplate(ctx, *appInput, str.Ptr(system.TemplateID)); err != nil {
-		log.C(ctx).Warn(errors.Wrapf(err, "while creating Application"))
-		return false
-	}
-	return true
+	now := time.Now()
+	return a.opSvc.Create(ctx, &model.OperationInput{
+		OpType:    operationsmanager.NsAdapterReportOpType,
+		Status:    model.OperationStatusScheduled,
+		Data:      data,
+		Priority:  reportOperationPriority,
+		CreatedAt: &now,
+	})
 }
 
-func (a *Handler) updateSystem(ctx context.Context, system nsmodel.System, app *model.Application) bool {
-	if err := a.appSvc.Update(ctx, app.ID, nsmodel.ToAppUpdateInput(system)); err != nil {
-		log.C(ctx).Warn(errors.Wrapf(err, "while updating Application with id %s", app.ID))
+// isDuplicateOf checks whether a report with the provided content hash is already handled by the job of the last received report.
+// Only the last report is taken into account, because an older report sent again has to be applied over the ones received after it.
+func (a *Handler) isDuplicateOf(ctx context.Context, latestOp *model.Operation, contentHash string) bool {
+	var latestJob nsmodel.ReportJob
+	if err := json.Unmarshal(latestOp.Data, &latestJob); err != nil {
+		log.C(ctx).Warnf("Got error while unmarshalling data of job with id %q: %v", latestOp.ID, err)
 		return false
 	}
 
-	if err := a.appSvc.SetLabel(ctx, &model.LabelInput{
-		Key:        "systemType",
-		Value:      system.SystemType,
-		ObjectID:   app.ID,
-		ObjectType: model.ApplicationLabelableObject,
-	}); err != nil {
-		log.C(ctx).Warn(errors.Wrapf(err, "while setting 'systemType' label for Application with id %s", app.ID))
+	if latestJob.ContentHash != contentHash {
 		return false
 	}
 
-	if err := a.appSvc.SetLabel(ctx, &model.LabelInput{
-		Key:        "systemProtocol",
-		Value:      system.Protocol,
-		ObjectID:   app.ID,
-		ObjectType: model.ApplicationLabelableObject,
-	}); err != nil {
-		log.C(ctx).Warn(errors.Wrapf(err, "while setting 'systemProtocol' label for Application with id %s", app.ID))
-		return false
-	}
-
-	return true
-}
-
-func (a *Handler) markAsUnreachable(ctx context.Context, scc nsmodel.SCC) bool {
-	apps, ok := a.listAppsByScc(ctx, scc.ExternalSubaccountID, scc.LocationID)
-	if !ok {
+	switch latestOp.Status {
+	case model.OperationStatusScheduled, model.OperationStatusInProgress:
+		return true
+	case model.OperationStatusCompleted:
+		return latestOp.FinishedAt != nil && time.Since(*latestOp.FinishedAt) <= a.deduplicationPeriod
+	default:
 		return false
 	}
-
-	success := true
-	unreachable := filterUnreachable(apps, scc.ExposedSystems)
-	for _, system := range unreachable {
-		success = a.markSystemAsUnreachable(ctx, system) && success
-	}
-	return success
 }
 
-func (a *Handler) markSystemAsUnreachable(ctx context.Context, system *model.Application) bool {
-	tx, err := a.transact.Begin()
-	if err != nil {
-		log.C(ctx).Warn(errors.Wrapf(err, "while openning transaction"))
-		return false
-	}
-	defer a.transact.RollbackUnlessCommitted(ctx, tx)
-
-	ctxWithTransaction := persistence.SaveToContext(ctx, tx)
-
-	if err := a.appSvc.Update(ctxWithTransaction, system.ID, model.ApplicationUpdateInput{SystemStatus: str.Ptr("unreachable")}); err != nil {
-		log.C(ctx).Warn(errors.Wrapf(err, "while marking application with id %s as unreachable", system.ID))
-		return false
-	}
-
-	if err := tx.Commit(); err != nil {
-		log.C(ctx).Warn(errors.Wrapf(err, "while committing transaction"))
-		return false
+func (a *Handler) getReportOperation(ctx context.Context, jobID string) (*model.Operation, error) {
+	if _, err := uuid.Parse(jobID); err != nil {
+		return nil, apperrors.NewNotFoundErrorWithType(resource.Operation)
 	}
 
-	return true
-}
-
-func (a *Handler) listAppsByScc(ctx context.Context, subaccount, locationID string) ([]*model.ApplicationWithLabel, bool) {
 	tx, err := a.transact.Begin()
 	if err != nil {
-		log.C(ctx).Warn(errors.Wrapf(err, "while openning transaction"))
-		return nil, false
+		return nil, errors.Wrap(err, "while opening transaction")
 	}
 	defer a.transact.RollbackUnlessCommitted(ctx, tx)
 
 	ctxWithTransaction := persistence.SaveToContext(ctx, tx)
-	apps, err := a.appSvc.ListBySCC(ctxWithTransaction, labelfilter.NewForKeyWithQuery("scc", fmt.Sprintf("{\"LocationID\":\"%s\", \"Subaccount\":\"%s\"}", locationID, subaccount)))
+	op, err := a.opSvc.Get(ctxWithTransaction, jobID)
 	if err != nil {
-		log.C(ctx).Warn(errors.Wrapf(err, "while listing all applications for scc with subaccount %s and location id %s", subaccount, locationID))
-		return nil, false
+		return nil, err
 	}
 
 	if err := tx.Commit(); err != nil {
-		log.C(ctx).Warn(errors.Wrapf(err, "while committing transaction"))
-		return nil, false
+		return nil, errors.Wrap(err, "while committing transaction")
 	}
 
-	return apps, true
-}
-
-func filterUnreachable(apps []*model.ApplicationWithLabel, systems []nsmodel.System) []*model.Application {
-	hostToSystem := make(map[string]interface{}, len(systems))
-
-	for _, s := range systems {
-		hostToSystem[s.Host] = struct{}{}
+	if op.OpType != operationsmanager.NsAdapterReportOpType {
+		return nil, apperrors.NewNotFoundErrorWithType(resource.Operation)
 	}
-	unreachable := make([]*model.Application, 0, len(apps))
 
-	for _, a := range apps {
-		result := a.SccLabel.Value.(map[string]interface{})["Host"]
-		_, ok := hostToSystem[result.(string)]
-		if !ok {
-			unreachable = append(unreachable, a.App)
-		}
-	}
-	return unreachable
+	return op, nil
 }
 
-func difference(a, b []*model.SccMetadata) (diff []*model.SccMetadata) {
-	m := make(map[model.SccMetadata]bool)
-
-	for _, item := range b {
-		m[*item] = true
-	}
-
-	for _, item := range a {
-		if _, ok := m[*item]; !ok {
-			diff = append(diff, item)
-		}
+func reportContentHash(job nsmodel.ReportJob) (string, error) {
+	content, err := json.Marshal(job)
+	if err != nil {
+		return "", errors.Wrap(err, "while marshalling report")
 	}
-	return
-}
 
-func addErrorDetailsMsg(details *[]httputil.Detail, scc *nsmodel.SCC, message string) {
-	*details = append(*details, httputil.Detail{
-		Message:    message,
-		Subaccount: scc.ExternalSubaccountID,
-		LocationID: scc.LocationID,
-	})
+	hash := sha256.Sum256(content)
+	return hex.EncodeToString(hash[:]), nil
 }
 
-func mapExternalToInternal(ctx context.Context, tenants []*model.BusinessTenantMapping, sccs []*nsmodel.SCC) {
-	externalToInternalTenants := make(map[string]*model.BusinessTenantMapping, len(tenants))
-	for _, t := range tenants {
-		externalToInternalTenants[t.ExternalTenant] = t
-	}
-
-	for _, scc := range sccs {
-		t, exist := externalToInternalTenants[scc.ExternalSubaccountID]
-		if !exist {
-			continue
-		}
-		if t.Type == "subaccount" {
-			scc.InternalSubaccountID = t.ID
-		} else {
-			log.C(ctx).Warnf("Got tenant with id: %s which is not a subaccount", t.ID)
-			scc.InternalSubaccountID = notSubaccountMarker
-		}
+func operationErrorMessage(opErr json.RawMessage) string {
+	var parsedErr operation.OperationError
+	if err := json.Unmarshal(opErr, &parsedErr); err != nil || parsedErr.ErrorMsg == "" {
+		return string(opErr)
 	}
-}
-
-func isNotFoundError(err error) bool {
-	return strings.Contains(err.Error(), "Object not found")
-}
 
-func filterSccsByInternalID(ctx context.Context, sccs []*nsmodel.SCC, details *[]httputil.Detail) []*nsmodel.SCC {
-	filteredSccs := make([]*nsmodel.SCC, 0, len(sccs))
-	for _, scc := range sccs {
-		if scc.InternalSubaccountID == "" {
-			log.C(ctx).Warnf("Got SCC with external subaccount id: %s which has not associated internal tenant id", scc.ExternalSubaccountID)
-			addErrorDetailsMsg(details, scc, "Subaccount not found")
-		} else if scc.InternalSubaccountID == notSubaccountMarker {
-			addErrorDetailsMsg(details, scc, "Provided id is not subaccount")
-		} else {
-			filteredSccs = append(filteredSccs, scc)
-		}
-	}
-	return filteredSccs
+	return parsedErr.ErrorMsg
 }
@@ -1,663 +1,496 @@
 package handler_test
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
-	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
-	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
-	"github.com/kyma-incubator/compass/components/director/internal/nsadapter/handler/automock"
-	txautomock "github.com/kyma-incubator/compass/components/director/pkg/persistence/automock"
-	"github.com/kyma-incubator/compass/components/director/pkg/str"
-	"github.com/stretchr/testify/mock"
-
 	"github.com/kyma-incubator/compass/components/director/internal/nsadapter/handler"
+	"github.com/kyma-incubator/compass/components/director/internal/nsadapter/handler/automock"
 	"github.com/kyma-incubator/compass/components/director/internal/nsadapter/httputil"
 	"github.com/kyma-incubator/compass/components/director/internal/nsadapter/nsmodel"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 	"github.com/kyma-incubator/compass/components/director/pkg/httputils"
+	persistenceautomock "github.com/kyma-incubator/compass/components/director/pkg/persistence/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
 )
 
 const (
-	testSubaccount  = "fd4f2041-fa83-48e0-b292-ff515bb776f0"
-	deltaReportType = "delta"
-	fullReportType  = "full"
+	testSubaccount        = "fd4f2041-fa83-48e0-b292-ff515bb776f0"
+	deltaReportType       = "delta"
+	fullReportType        = "full"
+	operationID           = "7a1a2f33-8e23-4e36-9b53-4d0e3b8f5c2d"
+	claimToken            = "b6b1a7c4-2f0e-4c1e-8d0a-6a3f1e5c9d27"
+	latestOperationID     = "4b0f0c1e-5d1a-4f3e-8a47-2f6f8c1d9e10"
+	nsAdapterReportOpType = "NS_ADAPTER_REPORT"
+	deduplicationPeriod   = time.Hour
 )
 
 func TestHandler_ServeHTTP(t *testing.T) {
-	appWithLabel := model.ApplicationWithLabel{
-		App: &model.Application{
-			BaseEntity: &model.BaseEntity{ID: "id"},
+	testErr := errors.New("test error")
+	notFoundErr := apperrors.NewNotFoundErrorWithType(resource.Operation)
+	finishedRecently := time.Now().Add(-time.Minute)
+	finishedLongAgo := time.Now().Add(-2 * deduplicationPeriod)
+
+	bodyWithoutSubaccount := "{\n  \"type\": \"notification-service\",\n  \"value\": [\n    {\n      \"locationId\": \"loc-id\",\n      \"exposedSystems\": [\n        {\n          \"protocol\": \"HTTP\",\n          \"host\": \"127.0.0.1:8080\",\n          \"type\": \"otherSAPsys\",\n          \"status\": \"disabled\",\n          \"description\": \"des\"\n        }\n      ]\n    }\n  ]\n}"
+
+	isReportOperationInput := mock.MatchedBy(func(in *model.OperationInput) bool {
+		return in.OpType == nsAdapterReportOpType && in.Status == model.OperationStatusScheduled && len(in.Data) > 0
+	})
+
+	testCases := []struct {
+		Name               string
+		ReportType         string
+		Body               io.Reader
+		TransactionerFn    func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		OpSvcFn            func() *automock.OperationService
+		ExpectedStatusCode int
+		ExpectedErrMessage string
+		ExpectedJobID      string
+	}{
+		{
+			Name:               "Error when request body cannot be parsed",
+			ReportType:         deltaReportType,
+			ExpectedStatusCode: http.StatusBadRequest,
+			ExpectedErrMessage: "failed to parse request body",
 		},
-		SccLabel: &model.Label{
-			Value: map[string]interface{}{"LocationID": "loc-id", "Host": "127.0.0.1:8080"},
+		{
+			Name:               "Error when report type is missing",
+			Body:               strings.NewReader(reportBody),
+			ExpectedStatusCode: http.StatusBadRequest,
+			ExpectedErrMessage: "the query parameter 'reportType' is missing or invalid",
 		},
-	}
-
-	appWithLabel2 := model.ApplicationWithLabel{
-		App: &model.Application{
-			BaseEntity: &model.BaseEntity{ID: "id"},
+		{
+			Name:               "Error when report type is unknown",
+			ReportType:         "unknown",
+			Body:               strings.NewReader(reportBody),
+			ExpectedStatusCode: http.StatusBadRequest,
+			ExpectedErrMessage: "the query parameter 'reportType' is missing or invalid",
 		},
-		SccLabel: &model.Label{
-			Value: map[string]interface{}{"LocationID": "loc-id-2", "Host": "127.0.0.1:8080"},
+		{
+			Name:               "Error when request body is invalid",
+			ReportType:         deltaReportType,
+			Body:               strings.NewReader(bodyWithoutSubaccount),
+			ExpectedStatusCode: http.StatusBadRequest,
+			ExpectedErrMessage: "value: (subaccount: cannot be blank.).",
 		},
-	}
-
-	application := model.Application{
-		BaseEntity: &model.BaseEntity{
-			ID: "id",
+		{
+			Name:       "Error when opening transaction fails",
+			ReportType: deltaReportType,
+			Body:       strings.NewReader(reportBody),
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txtest.NewTransactionContextGenerator(testErr).ThatFailsOnBegin()
+			},
+			ExpectedStatusCode: http.StatusInternalServerError,
+			ExpectedErrMessage: "Update failed",
 		},
-	}
-	system := nsmodel.System{
-		SystemBase: nsmodel.SystemBase{
-			Protocol:     "HTTP",
-			Host:         "127.0.0.1:8080",
-			SystemType:   "otherSAPsys",
-			Description:  "description",
-			Status:       "disabled",
-			SystemNumber: "number",
+		{
+			Name:       "Error when locking the jobs fails",
+			ReportType: deltaReportType,
+			Body:       strings.NewReader(reportBody),
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txtest.NewTransactionContextGenerator(testErr).ThatDoesntExpectCommit()
+			},
+			OpSvcFn: func() *automock.OperationService {
+				opSvc := &automock.OperationService{}
+				opSvc.On("LockType", txtest.CtxWithDBMatcher(), nsAdapterReportOpType).Return(testErr).Once()
+				return opSvc
+			},
+			ExpectedStatusCode: http.StatusInternalServerError,
+			ExpectedErrMessage: "Update failed",
 		},
-		TemplateID: "",
-	}
-	label := &model.LabelInput{
-		Key:        "systemType",
-		Value:      system.SystemType,
-		ObjectID:   application.ID,
-		ObjectType: model.ApplicationLabelableObject,
-	}
-
-	labelFilter := labelfilter.NewForKeyWithQuery("scc", fmt.Sprintf("{\"LocationID\":\"%s\", \"Subaccount\":\"%s\"}", "loc-id", testSubaccount))
-	labelFilter2 := labelfilter.NewForKeyWithQuery("scc", fmt.Sprintf("{\"LocationID\":\"%s\", \"Subaccount\":\"%s\"}", "loc-id-2", testSubaccount))
-
-	protocolLabel := &model.LabelInput{
-		Key:        "systemProtocol",
-		Value:      system.Protocol,
-		ObjectID:   application.ID,
-		ObjectType: model.ApplicationLabelableObject,
-	}
-
-	body := "{" +
-		"\"type\": \"notification-service\"," +
-		"\"value\": [{" +
-		"	\"subaccount\": \"fd4f2041-fa83-48e0-b292-ff515bb776f0\"," +
-		"	\"locationId\": \"loc-id\"," +
-		"	\"exposedSystems\": [{" +
-		"		\"protocol\": \"HTTP\"," +
-		"		\"host\": \"127.0.0.1:8080\"," +
-		"		\"type\": \"otherSAPsys\"," +
-		"		\"status\": \"disabled\"," +
-		"		\"description\": \"description\"" +
-		"	}]\n    " +
-		"}]}"
-	bodyWithSystemNumber := "{" +
-		"\"type\": \"notification-service\"," +
-		"\"value\": [{" +
-		"	\"subaccount\": \"fd4f2041-fa83-48e0-b292-ff515bb776f0\"," +
-		"   \"locationId\": \"loc-id\"," +
-		"   \"exposedSystems\": [{" +
-		"		\"protocol\": \"HTTP\"," +
-		"       \"host\": \"127.0.0.1:8080\"," +
-		"       \"type\": \"otherSAPsys\"," +
-		"       \"status\": \"disabled\"," +
-		"       \"description\": \"description\"," +
-		"       \"systemNumber\": \"number\"" +
-		"    }]" +
-		"}]}"
-	bodyWithoutExposedSystems := "{" +
-		"\"type\": \"notification-service\"," +
-		"\"value\": [{" +
-		"	\"subaccount\": \"fd4f2041-fa83-48e0-b292-ff515bb776f0\"," +
-		"	\"locationId\": \"loc-id\"," +
-		"	\"exposedSystems\": []" +
-		"}]}"
-
-	t.Run("failed to parse request body", func(t *testing.T) {
-		endpoint := handler.NewHandler(nil, nil, nil, nil, nil)
-
-		req := createReportSystemsRequest(nil, deltaReportType)
-		rec := httptest.NewRecorder()
-
-		endpoint.ServeHTTP(rec, req)
-
-		resp := rec.Result()
-		expectedBody, err := json.Marshal(httputil.ErrorResponse{
-			Error: httputil.Error{
-				Code:    http.StatusBadRequest,
-				Message: "failed to parse request body",
+		{
+			Name:       "Error when getting the latest job fails",
+			ReportType: deltaReportType,
+			Body:       strings.NewReader(reportBody),
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txtest.NewTransactionContextGenerator(testErr).ThatDoesntExpectCommit()
 			},
-		})
-		require.NoError(t, err)
-		Verify(t, resp, http.StatusBadRequest, httputils.ContentTypeApplicationJSON, string(expectedBody))
-	})
-
-	t.Run("failed due to missing report type", func(t *testing.T) {
-		endpoint := handler.NewHandler(nil, nil, nil, nil, nil)
-
-		req := httptest.NewRequest(http.MethodPut, "/v1", nil)
-		rec := httptest.NewRecorder()
-
-		endpoint.ServeHTTP(rec, req)
-
-		resp := rec.Result()
-		expectedBody, err := json.Marshal(httputil.ErrorResponse{
-			Error: httputil.Error{
-				Code:    http.StatusBadRequest,
-				Message: "the query parameter 'reportType' is missing or invalid",
+			OpSvcFn: func() *automock.OperationService {
+				opSvc := &automock.OperationService{}
+				opSvc.On("LockType", txtest.CtxWithDBMatcher(), nsAdapterReportOpType).Return(nil).Once()
+				opSvc.On("GetLatest", txtest.CtxWithDBMatcher(), nsAdapterReportOpType).Return(nil, testErr).Once()
+				return opSvc
 			},
-		})
-		require.NoError(t, err)
-		Verify(t, resp, http.StatusBadRequest, httputils.ContentTypeApplicationJSON, string(expectedBody))
-	})
-
-	t.Run("failed due to unknown report type", func(t *testing.T) {
-		endpoint := handler.NewHandler(nil, nil, nil, nil, nil)
-
-		req := createReportSystemsRequest(nil, "unknown")
-		rec := httptest.NewRecorder()
-
-		endpoint.ServeHTTP(rec, req)
-
-		resp := rec.Result()
-		expectedBody, err := json.Marshal(httputil.ErrorResponse{
-			Error: httputil.Error{
-				Code:    http.StatusBadRequest,
-				Message: "the query parameter 'reportType' is missing or invalid",
+			ExpectedStatusCode: http.StatusInternalServerError,
+			ExpectedErrMessage: "Update failed",
+		},
+		{
+			Name:       "Error when creating the job fails",
+			ReportType: deltaReportType,
+			Body:       strings.NewReader(reportBody),
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txtest.NewTransactionContextGenerator(testErr).ThatDoesntExpectCommit()
 			},
-		})
-		require.NoError(t, err)
-		Verify(t, resp, http.StatusBadRequest, httputils.ContentTypeApplicationJSON, string(expectedBody))
-	})
-
-	t.Run("failed while validating request body", func(t *testing.T) {
-		bodyWithoutSubaccount := "{\n  \"type\": \"notification-service\",\n  \"value\": [\n    {\n      \"locationId\": \"loc-id\",\n      \"exposedSystems\": [\n        {\n          \"protocol\": \"HTTP\",\n          \"host\": \"127.0.0.1:8080\",\n          \"type\": \"otherSAPsys\",\n          \"status\": \"disabled\",\n          \"description\": \"des\"\n        }\n      ]\n    }\n  ]\n}"
-
-		endpoint := handler.NewHandler(nil, nil, nil, nil, nil)
-
-		req := createReportSystemsRequest(strings.NewReader(bodyWithoutSubaccount), deltaReportType)
-		rec := httptest.NewRecorder()
-
-		endpoint.ServeHTTP(rec, req)
-
-		resp := rec.Result()
-		expectedBody, err := json.Marshal(httputil.ErrorResponse{
-			Error: httputil.Error{
-				Code:    http.StatusBadRequest,
-				Message: "value: (subaccount: cannot be blank.).",
+			OpSvcFn: func() *automock.OperationService {
+				opSvc := &automock.OperationService{}
+				opSvc.On("LockType", txtest.CtxWithDBMatcher(), nsAdapterReportOpType).Return(nil).Once()
+				opSvc.On("GetLatest", txtest.CtxWithDBMatcher(), nsAdapterReportOpType).Return(nil, notFoundErr).Once()
+				opSvc.On("Create", txtest.CtxWithDBMatcher(), isReportOperationInput).Return("", testErr).Once()
+				return opSvc
 			},
-		})
-		require.NoError(t, err)
-		Verify(t, resp, http.StatusBadRequest, httputils.ContentTypeApplicationJSON, string(expectedBody))
-	})
-
-	t.Run("failed while opening transaction", func(t *testing.T) {
-		transact := txautomock.Transactioner{}
-		transact.Mock.On("Begin").Return(nil, errors.New("test"))
-		defer transact.AssertExpectations(t)
-
-		endpoint := handler.NewHandler(nil, nil, nil, nil, &transact)
-
-		req := createReportSystemsRequest(strings.NewReader(body), deltaReportType)
-		rec := httptest.NewRecorder()
-
-		endpoint.ServeHTTP(rec, req)
-
-		resp := rec.Result()
-
-		expectedBody, err := json.Marshal(httputil.ErrorResponse{
-			Error: httputil.Error{
-				Code:    http.StatusInternalServerError,
-				Message: "Update failed",
+			ExpectedStatusCode: http.StatusInternalServerError,
+			ExpectedErrMessage: "Update failed",
+		},
+		{
+			Name:       "Error when committing transaction fails",
+			ReportType: deltaReportType,
+			Body:       strings.NewReader(reportBody),
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txtest.NewTransactionContextGenerator(testErr).ThatFailsOnCommit()
 			},
-		})
-		require.NoError(t, err)
-		Verify(t, resp, http.StatusInternalServerError, httputils.ContentTypeApplicationJSON, string(expectedBody))
-	})
-
-	t.Run("failed while listing tenants", func(t *testing.T) {
-		tx := &txautomock.PersistenceTx{}
-
-		transact := txautomock.Transactioner{}
-		transact.Mock.On("Begin").Return(tx, nil)
-		transact.Mock.On("RollbackUnlessCommitted", mock.Anything, tx).Return(true)
-
-		ids := []string{testSubaccount}
-		tntSvc := automock.TenantService{}
-		tntSvc.Mock.On("ListsByExternalIDs", mock.Anything, ids).Return(nil, errors.New("test"))
-		defer mock.AssertExpectationsForObjects(t, tx, &transact, &tntSvc)
-
-		endpoint := handler.NewHandler(nil, nil, nil, &tntSvc, &transact)
-
-		req := createReportSystemsRequest(strings.NewReader(body), deltaReportType)
-		rec := httptest.NewRecorder()
-
-		endpoint.ServeHTTP(rec, req)
-
-		resp := rec.Result()
-		expectedBody, err := json.Marshal(httputil.ErrorResponse{
-			Error: httputil.Error{
-				Code:    http.StatusInternalServerError,
-				Message: "Update failed",
+			OpSvcFn: func() *automock.OperationService {
+				opSvc := &automock.OperationService{}
+				opSvc.On("LockType", txtest.CtxWithDBMatcher(), nsAdapterReportOpType).Return(nil).Once()
+				opSvc.On("GetLatest", txtest.CtxWithDBMatcher(), nsAdapterReportOpType).Return(nil, notFoundErr).Once()
+				opSvc.On("Create", txtest.CtxWithDBMatcher(), isReportOperationInput).Return(operationID, nil).Once()
+				return opSvc
 			},
-		})
-		require.NoError(t, err)
-		Verify(t, resp, http.StatusInternalServerError, httputils.ContentTypeApplicationJSON, string(expectedBody))
-	})
-
-	t.Run("got error details when provided id is not a subaccount", func(t *testing.T) {
-		tx := &txautomock.PersistenceTx{}
-		tx.Mock.On("Commit").Return(nil)
-
-		transact := txautomock.Transactioner{}
-		transact.Mock.On("Begin").Return(tx, nil)
-		transact.Mock.On("RollbackUnlessCommitted", mock.Anything, tx).Return(true)
-
-		ids := []string{testSubaccount}
-		tntSvc := automock.TenantService{}
-		tntSvc.Mock.On("ListsByExternalIDs", mock.Anything, ids).Return([]*model.BusinessTenantMapping{{ExternalTenant: testSubaccount, Type: "customer"}}, nil)
-		defer mock.AssertExpectationsForObjects(t, tx, &transact, &tntSvc)
-
-		endpoint := handler.NewHandler(nil, nil, nil, &tntSvc, &transact)
-
-		req := createReportSystemsRequest(strings.NewReader(body), deltaReportType)
-		rec := httptest.NewRecorder()
-
-		endpoint.ServeHTTP(rec, req)
-
-		resp := rec.Result()
-		expectedBody, err := json.Marshal(httputil.ErrorResponse{
-			Error: httputil.DetailedError{
-				Code:    http.StatusOK,
-				Message: "Update/create failed for some on-premise systems",
-				Details: []httputil.Detail{
-					{
-						Message:    "Provided id is not subaccount",
-						Subaccount: testSubaccount,
-						LocationID: "loc-id",
-					},
-				},
+			ExpectedStatusCode: http.StatusInternalServerError,
+			ExpectedErrMessage: "Update failed",
+		},
+		{
+			Name:       "Success when there are no previous jobs",
+			ReportType: deltaReportType,
+			Body:       strings.NewReader(reportBody),
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txtest.NewTransactionContextGenerator(testErr).ThatSucceeds()
 			},
-		})
-		require.NoError(t, err)
-		Verify(t, resp, http.StatusOK, httputils.ContentTypeApplicationJSON, string(expectedBody))
-	})
-
-	t.Run("got error details when subaccount is not found", func(t *testing.T) {
-		tx := &txautomock.PersistenceTx{}
-		tx.Mock.On("Commit").Return(nil)
-
-		transact := txautomock.Transactioner{}
-		transact.Mock.On("Begin").Return(tx, nil)
-		transact.Mock.On("RollbackUnlessCommitted", mock.Anything, tx).Return(true)
-
-		ids := []string{testSubaccount}
-		tntSvc := automock.TenantService{}
-		tntSvc.Mock.On("ListsByExternalIDs", mock.Anything, ids).Return([]*model.BusinessTenantMapping{}, nil)
-		defer mock.AssertExpectationsForObjects(t, tx, &transact, &tntSvc)
-
-		endpoint := handler.NewHandler(nil, nil, nil, &tntSvc, &transact)
-
-		req := createReportSystemsRequest(strings.NewReader(body), deltaReportType)
-		rec := httptest.NewRecorder()
-
-		endpoint.ServeHTTP(rec, req)
-
-		resp := rec.Result()
-		expectedBody, err := json.Marshal(httputil.ErrorResponse{
-			Error: httputil.DetailedError{
-				Code:    http.StatusOK,
-				Message: "Update/create failed for some on-premise systems",
-				Details: []httputil.Detail{
-					{
-						Message:    "Subaccount not found",
-						Subaccount: testSubaccount,
-						LocationID: "loc-id",
-					},
-				},
+			OpSvcFn: func() *automock.OperationService {
+				opSvc := &automock.OperationService{}
+				opSvc.On("LockType", txtest.CtxWithDBMatcher(), nsAdapterReportOpType).Return(nil).Once()
+				opSvc.On("GetLatest", txtest.CtxWithDBMatcher(), nsAdapterReportOpType).Return(nil, notFoundErr).Once()
+				opSvc.On("Create", txtest.CtxWithDBMatcher(), isReportOperationInput).Return(operationID, nil).Once()
+				return opSvc
 			},
-		})
-		require.NoError(t, err)
-		Verify(t, resp, http.StatusOK, httputils.ContentTypeApplicationJSON, string(expectedBody))
-	})
-
-	//Delta report tests
-	t.Run("got error while upserting application", func(t *testing.T) {
-		tx := &txautomock.PersistenceTx{}
-		tx.Mock.On("Commit").Return(nil)
-
-		listSccsTx := &txautomock.PersistenceTx{}
-		listSccsTx.Mock.On("Commit").Return(nil)
-
-		transact := txautomock.Transactioner{}
-		transact.Mock.On("Begin").Return(tx, nil).Once() // used for list tenants
-		transact.Mock.On("Begin").Return(tx, nil).Once() // used for upsert
-		transact.Mock.On("RollbackUnlessCommitted", mock.Anything, tx).Return(true).Twice()
-		transact.Mock.On("Begin").Return(listSccsTx, nil).Once() //list for mark unreachable
-		transact.Mock.On("RollbackUnlessCommitted", mock.Anything, listSccsTx).Return(true).Once()
-
-		ids := []string{testSubaccount}
-		tntSvc := automock.TenantService{}
-		tntSvc.Mock.On("ListsByExternalIDs", mock.Anything, ids).Return([]*model.BusinessTenantMapping{{ID: "id", ExternalTenant: testSubaccount, Type: "subaccount"}}, nil)
-
-		setMappings()
-		defer clearMappings()
-
-		appInputJSON := "app-input-json"
-		applicationTemplate := &model.ApplicationTemplate{}
-
-		appTemplateSvc := automock.ApplicationTemplateService{}
-		appTemplateSvc.Mock.On("Get", mock.Anything, "ss").Return(applicationTemplate, nil)
-		appTemplateSvc.Mock.On("PrepareApplicationCreateInputJSON", applicationTemplate, mock.Anything).Return(appInputJSON, nil)
-
-		input := model.ApplicationRegisterInput{}
-		appConverterSvc := automock.ApplicationConverter{}
-		appConverterSvc.Mock.On("CreateInputJSONToModel", mock.Anything, appInputJSON).Return(input, nil)
-
-		appSvc := automock.ApplicationService{}
-		appSvc.Mock.On("Upsert", mock.Anything, input).Return(errors.New("error"))
-
-		appSvc.Mock.On("ListBySCC", mock.Anything, labelFilter).Return([]*model.ApplicationWithLabel{&appWithLabel}, nil)
-		defer mock.AssertExpectationsForObjects(t, tx, listSccsTx, &transact, &appTemplateSvc, &appConverterSvc, &appSvc, &tntSvc)
-
-		endpoint := handler.NewHandler(&appSvc, &appConverterSvc, &appTemplateSvc, &tntSvc, &transact)
-
-		req := createReportSystemsRequest(strings.NewReader(bodyWithSystemNumber), deltaReportType)
-		rec := httptest.NewRecorder()
-
-		endpoint.ServeHTTP(rec, req)
-
-		resp := rec.Result()
-		expectedBody, err := json.Marshal(httputil.ErrorResponse{
-			Error: httputil.DetailedError{
-				Code:    http.StatusOK,
-				Message: "Update/create failed for some on-premise systems",
-				Details: []httputil.Detail{
-					{
-						Message:    "Creation failed",
-						Subaccount: testSubaccount,
-						LocationID: "loc-id",
-					},
-				},
+			ExpectedStatusCode: http.StatusAccepted,
+			ExpectedJobID:      operationID,
+		},
+		{
+			Name:       "Success when the latest job is for a different report",
+			ReportType: deltaReportType,
+			Body:       strings.NewReader(reportBody),
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txtest.NewTransactionContextGenerator(testErr).ThatSucceeds()
 			},
-		})
-		require.NoError(t, err)
-		Verify(t, resp, http.StatusOK, httputils.ContentTypeApplicationJSON, string(expectedBody))
-	})
-
-	t.Run("successfully upsert application", func(t *testing.T) {
-		tx := &txautomock.PersistenceTx{}
-		tx.Mock.On("Commit").Return(nil)
-
-		listSccsTx := &txautomock.PersistenceTx{}
-		listSccsTx.Mock.On("Commit").Return(nil)
-
-		transact := txautomock.Transactioner{}
-		transact.Mock.On("Begin").Return(tx, nil).Once() // used for list tenants
-		transact.Mock.On("Begin").Return(tx, nil).Once() // used for upsert
-		transact.Mock.On("RollbackUnlessCommitted", mock.Anything, tx).Return(true).Twice()
-		transact.Mock.On("RollbackUnlessCommitted", mock.Anything, listSccsTx).Return(true).Once()
-		transact.Mock.On("Begin").Return(listSccsTx, nil).Once() //list for mark unreachable
-
-		ids := []string{testSubaccount}
-		tntSvc := automock.TenantService{}
-		tntSvc.Mock.On("ListsByExternalIDs", mock.Anything, ids).Return([]*model.BusinessTenantMapping{{ID: "id", ExternalTenant: testSubaccount, Type: "subaccount"}}, nil)
-
-		setMappings()
-		defer clearMappings()
-
-		appInputJSON := "app-input-json"
-		applicationTemplate := &model.ApplicationTemplate{}
-
-		appTemplateSvc := automock.ApplicationTemplateService{}
-		appTemplateSvc.Mock.On("Get", mock.Anything, "ss").Return(applicationTemplate, nil)
-		appTemplateSvc.Mock.On("PrepareApplicationCreateInputJSON", applicationTemplate, mock.Anything).Return(appInputJSON, nil)
-
-		input := model.ApplicationRegisterInput{}
-		appConverterSvc := automock.ApplicationConverter{}
-		appConverterSvc.Mock.On("CreateInputJSONToModel", mock.Anything, appInputJSON).Return(input, nil)
-
-		appSvc := automock.ApplicationService{}
-		appSvc.Mock.On("Upsert", mock.Anything, input).Return(nil)
-
-		appSvc.Mock.On("ListBySCC", mock.Anything, labelFilter).Return([]*model.ApplicationWithLabel{&appWithLabel}, nil)
-		defer mock.AssertExpectationsForObjects(t, tx, listSccsTx, &transact, &appTemplateSvc, &appConverterSvc, &appSvc, &tntSvc)
-
-		endpoint := handler.NewHandler(&appSvc, &appConverterSvc, &appTemplateSvc, &tntSvc, &transact)
-
-		req := createReportSystemsRequest(strings.NewReader(bodyWithSystemNumber), deltaReportType)
-		rec := httptest.NewRecorder()
-
-		endpoint.ServeHTTP(rec, req)
-
-		resp := rec.Result()
-		Verify(t, resp, http.StatusNoContent, httputils.ContentTypeApplicationJSON, "{}")
-	})
-
-	t.Run("failed to get application by subaccount, location ID and virtual host", func(t *testing.T) {
-		tx := &txautomock.PersistenceTx{}
-		tx.Mock.On("Commit").Return(nil)
-
-		listSccsTx := &txautomock.PersistenceTx{}
-		listSccsTx.Mock.On("Commit").Return(nil)
-
-		transact := txautomock.Transactioner{}
-		transact.Mock.On("Begin").Return(tx, nil).Once() // used for list tenants
-		transact.Mock.On("Begin").Return(tx, nil).Once() // used for upsertSccSystems
-		transact.Mock.On("RollbackUnlessCommitted", mock.Anything, tx).Return(true).Twice()
-		transact.Mock.On("Begin").Return(listSccsTx, nil).Once() //list for mark unreachable
-		transact.Mock.On("RollbackUnlessCommitted", mock.Anything, listSccsTx).Return(true).Once()
-
-		ids := []string{testSubaccount}
-		tntSvc := automock.TenantService{}
-		tntSvc.Mock.On("ListsByExternalIDs", mock.Anything, ids).Return([]*model.BusinessTenantMapping{{ID: "id", ExternalTenant: testSubaccount, Type: "subaccount"}}, nil)
-
-		setMappings()
-		defer clearMappings()
-
-		appSvc := automock.ApplicationService{}
-		appSvc.Mock.On("GetSccSystem", mock.Anything, testSubaccount, "loc-id", "127.0.0.1:8080").Return(nil, errors.New("error"))
-		appSvc.Mock.On("ListBySCC", mock.Anything, labelFilter).Return([]*model.ApplicationWithLabel{&appWithLabel}, nil)
-		defer mock.AssertExpectationsForObjects(t, tx, listSccsTx, &transact, &appSvc, &tntSvc)
-
-		endpoint := handler.NewHandler(&appSvc, nil, nil, &tntSvc, &transact)
-
-		req := createReportSystemsRequest(strings.NewReader(body), deltaReportType)
-		rec := httptest.NewRecorder()
-
-		endpoint.ServeHTTP(rec, req)
-
-		resp := rec.Result()
-		expectedBody, err := json.Marshal(httputil.ErrorResponse{
-			Error: httputil.DetailedError{
-				Code:    http.StatusOK,
-				Message: "Update/create failed for some on-premise systems",
-				Details: []httputil.Detail{
-					{
-						Message:    "Creation failed",
-						Subaccount: testSubaccount,
-						LocationID: "loc-id",
-					},
-				},
+			OpSvcFn: func() *automock.OperationService {
+				opSvc := &automock.OperationService{}
+				opSvc.On("LockType", txtest.CtxWithDBMatcher(), nsAdapterReportOpType).Return(nil).Once()
+				opSvc.On("GetLatest", txtest.CtxWithDBMatcher(), nsAdapterReportOpType).Return(fixLatestReportOperation(t, latestOperationID, fullReportType, model.OperationStatusScheduled, nil), nil).Once()
+				opSvc.On("Create", txtest.CtxWithDBMatcher(), isReportOperationInput).Return(operationID, nil).Once()
+				return opSvc
 			},
-		})
-		require.NoError(t, err)
-		Verify(t, resp, http.StatusOK, httputils.ContentTypeApplicationJSON, string(expectedBody))
-	})
-
-	t.Run("failed to register application from template", func(t *testing.T) {
-		tx := &txautomock.PersistenceTx{}
-		tx.Mock.On("Commit").Return(nil)
-
-		listSccsTx := &txautomock.PersistenceTx{}
-		listSccsTx.Mock.On("Commit").Return(nil)
-
-		transact := txautomock.Transactioner{}
-		transact.Mock.On("Begin").Return(tx, nil).Once() // used for list tenants
-		transact.Mock.On("Begin").Return(tx, nil).Once() // used for upsertSccSystems
-		transact.Mock.On("RollbackUnlessCommitted", mock.Anything, tx).Return(true).Twice()
-		transact.Mock.On("Begin").Return(listSccsTx, nil).Once() //list for mark unreachable
-		transact.Mock.On("RollbackUnlessCommitted", mock.Anything, listSccsTx).Return(true).Once()
-
-		ids := []string{testSubaccount}
-		tntSvc := automock.TenantService{}
-		tntSvc.Mock.On("ListsByExternalIDs", mock.Anything, ids).Return([]*model.BusinessTenantMapping{{ID: "id", ExternalTenant: testSubaccount, Type: "subaccount"}}, nil)
-
-		setMappings()
-		defer clearMappings()
-
-		appInputJSON := "app-input-json"
-		applicationTemplate := &model.ApplicationTemplate{}
-
-		appTemplateSvc := automock.ApplicationTemplateService{}
-		appTemplateSvc.Mock.On("Get", mock.Anything, "ss").Return(applicationTemplate, nil)
-		appTemplateSvc.Mock.On("PrepareApplicationCreateInputJSON", applicationTemplate, mock.Anything).Return(appInputJSON, nil)
-
-		input := model.ApplicationRegisterInput{}
-		appConverterSvc := automock.ApplicationConverter{}
-		appConverterSvc.Mock.On("CreateInputJSONToModel", mock.Anything, appInputJSON).Return(input, nil)
-
-		appSvc := automock.ApplicationService{}
-		appSvc.Mock.On("GetSccSystem", mock.Anything, testSubaccount, "loc-id", "127.0.0.1:8080").
-			Return(nil, errors.New("Object not found"))
-		appSvc.Mock.On("CreateFromTemplate", mock.Anything, input, str.Ptr("ss")).Return("", errors.New("error"))
-
-		appSvc.Mock.On("ListBySCC", mock.Anything, labelFilter).Return([]*model.ApplicationWithLabel{&appWithLabel}, nil)
-		defer mock.AssertExpectationsForObjects(t, tx, listSccsTx, &transact, &appTemplateSvc, &appConverterSvc, &appSvc, &tntSvc)
-
-		endpoint := handler.NewHandler(&appSvc, &appConverterSvc, &appTemplateSvc, &tntSvc, &transact)
-
-		req := createReportSystemsRequest(strings.NewReader(body), deltaReportType)
-		rec := httptest.NewRecorder()
-
-		endpoint.ServeHTTP(rec, req)
+			ExpectedStatusCode: http.StatusAccepted,
+			ExpectedJobID:      operationID,
+		},
+		{
+			Name:       "Success without creating a job when the same report is still scheduled",
+			ReportType: deltaReportType,
+			Body:       strings.NewReader(reportBody),
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txtest.NewTransactionContextGenerator(testErr).ThatSucceeds()
+			},
+			OpSvcFn: func() *automock.OperationService {
+				opSvc := &automock.OperationService{}
+				opSvc.On("LockType", txtest.CtxWithDBMatcher(), nsAdapterReportOpType).Return(nil).Once()
+				opSvc.On("GetLatest", txtest.CtxWithDBMatcher(), nsAdapterReportOpType).Return(fixLatestReportOperation(t, latestOperationID, deltaReportType, model.OperationStatusScheduled, nil), nil).Once()
+				return opSvc
+			},
+			ExpectedStatusCode: http.StatusAccepted,
+			ExpectedJobID:      latestOperationID,
+		},
+		{
+			Name:       "Success without creating a job when the same report is in progress",
+			ReportType: deltaReportType,
+			Body:       strings.NewReader(reportBody),
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txtest.NewTransactionContextGenerator(testErr).ThatSucceeds()
+			},
+			OpSvcFn: func() *automock.OperationService {
+				opSvc := &automock.OperationService{}
+				opSvc.On("LockType", txtest.CtxWithDBMatcher(), nsAdapterReportOpType).Return(nil).Once()
+				opSvc.On("GetLatest", txtest.CtxWithDBMatcher(), nsAdapterReportOpType).Return(fixLatestReportOperation(t, latestOperationID, deltaReportType, model.OperationStatusInProgress, nil), nil).Once()
+				return opSvc
+			},
+			ExpectedStatusCode: http.StatusAccepted,
+			ExpectedJobID:      latestOperationID,
+		},
+		{
+			Name:       "Success without creating a job when the same report was completed during the deduplication period",
+			ReportType: deltaReportType,
+			Body:       strings.NewReader(reportBody),
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txtest.NewTransactionContextGenerator(testErr).ThatSucceeds()
+			},
+			OpSvcFn: func() *automock.OperationService {
+				opSvc := &automock.OperationService{}
+				opSvc.On("LockType", txtest.CtxWithDBMatcher(), nsAdapterReportOpType).Return(nil).Once()
+				opSvc.On("GetLatest", txtest.CtxWithDBMatcher(), nsAdapterReportOpType).Return(fixLatestReportOperation(t, latestOperationID, deltaReportType, model.OperationStatusCompleted, &finishedRecently), nil).Once()
+				return opSvc
+			},
+			ExpectedStatusCode: http.StatusAccepted,
+			ExpectedJobID:      latestOperationID,
+		},
+		{
+			Name:       "Success when the same report was completed before the deduplication period",
+			ReportType: deltaReportType,
+			Body:       strings.NewReader(reportBody),
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txtest.NewTransactionContextGenerator(testErr).ThatSucceeds()
+			},
+			OpSvcFn: func() *automock.OperationService {
+				opSvc := &automock.OperationService{}
+				opSvc.On("LockType", txtest.CtxWithDBMatcher(), nsAdapterReportOpType).Return(nil).Once()
+				opSvc.On("GetLatest", txtest.CtxWithDBMatcher(), nsAdapterReportOpType).Return(fixLatestReportOperation(t, latestOperationID, deltaReportType, model.OperationStatusCompleted, &finishedLongAgo), nil).Once()
+				opSvc.On("Create", txtest.CtxWithDBMatcher(), isReportOperationInput).Return(operationID, nil).Once()
+				return opSvc
+			},
+			ExpectedStatusCode: http.StatusAccepted,
+			ExpectedJobID:      operationID,
+		},
+		{
+			Name:       "Success when the same report failed",
+			ReportType: deltaReportType,
+			Body:       strings.NewReader(reportBody),
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txtest.NewTransactionContextGenerator(testErr).ThatSucceeds()
+			},
+			OpSvcFn: func() *automock.OperationService {
+				opSvc := &automock.OperationService{}
+				opSvc.On("LockType", txtest.CtxWithDBMatcher(), nsAdapterReportOpType).Return(nil).Once()
+				opSvc.On("GetLatest", txtest.CtxWithDBMatcher(), nsAdapterReportOpType).Return(fixLatestReportOperation(t, latestOperationID, deltaReportType, model.OperationStatusFailed, &finishedRecently), nil).Once()
+				opSvc.On("Create", txtest.CtxWithDBMatcher(), isReportOperationInput).Return(operationID, nil).Once()
+				return opSvc
+			},
+			ExpectedStatusCode: http.StatusAccepted,
+			ExpectedJobID:      operationID,
+		},
+	}
 
-		resp := rec.Result()
-		expectedBody, err := json.Marshal(httputil.ErrorResponse{
-			Error: httputil.DetailedError{
-				Code:    http.StatusOK,
-				Message: "Update/create failed for some on-premise systems",
-				Details: []httputil.Detail{
-					{
-						Message:    "Creation failed",
-						Subaccount: testSubaccount,
-						LocationID: "loc-id",
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persistTx := &persistenceautomock.PersistenceTx{}
+			transact := &persistenceautomock.Transactioner{}
+			if testCase.TransactionerFn != nil {
+				persistTx, transact = testCase.TransactionerFn()
+			}
+			opSvc := &automock.OperationService{}
+			if testCase.OpSvcFn != nil {
+				opSvc = testCase.OpSvcFn()
+			}
+
+			endpoint := handler.NewHandler(opSvc, transact, deduplicationPeriod)
+
+			req := httptest.NewRequest(http.MethodPut, "/v1", testCase.Body)
+			if testCase.ReportType != "" {
+				req = createReportSystemsRequest(testCase.Body, testCase.ReportType)
+			}
+			rec := httptest.NewRecorder()
+
+			// WHEN
+			endpoint.ServeHTTP(rec, req)
+
+			// THEN
+			resp := rec.Result()
+			if testCase.ExpectedErrMessage != "" {
+				expectedBody, err := json.Marshal(httputil.ErrorResponse{
+					Error: httputil.Error{
+						Code:    testCase.ExpectedStatusCode,
+						Message: testCase.ExpectedErrMessage,
 					},
-				},
-			},
+				})
+				require.NoError(t, err)
+				Verify(t, resp, testCase.ExpectedStatusCode, httputils.ContentTypeApplicationJSON, string(expectedBody))
+			} else {
+				require.Equal(t, testCase.ExpectedStatusCode, resp.StatusCode)
+
+				var accepted httputil.JobAccepted
+				require.NoError(t, json.NewDecoder(resp.Body).Decode(&accepted))
+				require.Equal(t, testCase.ExpectedJobID, accepted.JobID)
+			}
+
+			mock.AssertExpectationsForObjects(t, persistTx, transact, opSvc)
 		})
-		require.NoError(t, err)
-		Verify(t, resp, http.StatusOK, httputils.ContentTypeApplicationJSON, string(expectedBody))
-	})
-
-	t.Run("successfully create application", func(t *testing.T) {
-		tx := &txautomock.PersistenceTx{}
-		tx.Mock.On("Commit").Return(nil)
-
-		listSccsTx := &txautomock.PersistenceTx{}
-		listSccsTx.Mock.On("Commit").Return(nil)
-
-		transact := txautomock.Transactioner{}
-		transact.Mock.On("Begin").Return(tx, nil).Once() // used for list tenants
-		transact.Mock.On("Begin").Return(tx, nil).Once() // used for upsertSccSystems
-		transact.Mock.On("RollbackUnlessCommitted", mock.Anything, tx).Return(true).Twice()
-		transact.Mock.On("Begin").Return(listSccsTx, nil).Once() //list for mark unreachable
-		transact.Mock.On("RollbackUnlessCommitted", mock.Anything, listSccsTx).Return(true).Once()
-
-		ids := []string{testSubaccount}
-		tntSvc := automock.TenantService{}
-		tntSvc.Mock.On("ListsByExternalIDs", mock.Anything, ids).Return([]*model.BusinessTenantMapping{{ID: "id", ExternalTenant: testSubaccount, Type: "subaccount"}}, nil)
-
-		setMappings()
-		defer clearMappings()
-
-		appInputJSON := "app-input-json"
-		applicationTemplate := &model.ApplicationTemplate{}
-
-		appTemplateSvc := automock.ApplicationTemplateService{}
-		appTemplateSvc.Mock.On("Get", mock.Anything, "ss").Return(applicationTemplate, nil)
-		appTemplateSvc.Mock.On("PrepareApplicationCreateInputJSON", applicationTemplate, mock.Anything).Return(appInputJSON, nil)
-
-		input := model.ApplicationRegisterInput{}
-		appConverterSvc := automock.ApplicationConverter{}
-		appConverterSvc.Mock.On("CreateInputJSONToModel", mock.Anything, appInputJSON).Return(input, nil)
-
-		appSvc := automock.ApplicationService{}
-		appSvc.Mock.On("GetSccSystem", mock.Anything, testSubaccount, "loc-id", "127.0.0.1:8080").
-			Return(nil, errors.New("Object not found"))
-		appSvc.Mock.On("CreateFromTemplate", mock.Anything, input, str.Ptr("ss")).Return("success", nil)
-
-		appSvc.Mock.On("ListBySCC", mock.Anything, labelFilter).Return([]*model.ApplicationWithLabel{&appWithLabel}, nil)
-		defer mock.AssertExpectationsForObjects(t, tx, listSccsTx, &transact, &appTemplateSvc, &appConverterSvc, &appSvc, &tntSvc)
-
-		endpoint := handler.NewHandler(&appSvc, &appConverterSvc, &appTemplateSvc, &tntSvc, &transact)
-
-		req := createReportSystemsRequest(strings.NewReader(body), deltaReportType)
-		rec := httptest.NewRecorder()
+	}
 
-		endpoint.ServeHTTP(rec, req)
+	t.Run("Report sent again after a different report is scheduled as a new job", func(t *testing.T) {
+		var latestOp *model.Operation
+		createdIDs := []string{"d3a3ef0e-0d6a-4d6c-9a3f-1a0c9e2f3b01", "d3a3ef0e-0d6a-4d6c-9a3f-1a0c9e2f3b02", "d3a3ef0e-0d6a-4d6c-9a3f-1a0c9e2f3b03"}
+		var jobIDs []string
+
+		persistTx, transact := txtest.NewTransactionContextGenerator(testErr).ThatSucceedsMultipleTimes(3)
+		opSvc := &automock.OperationService{}
+		opSvc.On("LockType", txtest.CtxWithDBMatcher(), nsAdapterReportOpType).Return(nil).Times(3)
+		opSvc.On("GetLatest", txtest.CtxWithDBMatcher(), nsAdapterReportOpType).Return(func(_ context.Context, _ string) *model.Operation {
+			return latestOp
+		}, func(_ context.Context, _ string) error {
+			if latestOp == nil {
+				return notFoundErr
+			}
+			return nil
+		}).Times(3)
+		opSvc.On("Create", txtest.CtxWithDBMatcher(), isReportOperationInput).Return(func(_ context.Context, in *model.OperationInput) string {
+			latestOp = in.ToOperation(createdIDs[0])
+			createdIDs = createdIDs[1:]
+			return latestOp.ID
+		}, nil).Times(3)
+
+		endpoint := handler.NewHandler(opSvc, transact, deduplicationPeriod)
+		for _, reportType := range []string{deltaReportType, fullReportType, deltaReportType} {
+			rec := httptest.NewRecorder()
+			endpoint.ServeHTTP(rec, createReportSystemsRequest(strings.NewReader(reportBody), reportType))
+			require.Equal(t, http.StatusAccepted, rec.Result().StatusCode)
+
+			var accepted httputil.JobAccepted
+			require.NoError(t, json.NewDecoder(rec.Result().Body).Decode(&accepted))
+			jobIDs = append(jobIDs, accepted.JobID)
+		}
 
-		resp := rec.Result()
-		Verify(t, resp, http.StatusNoContent, httputils.ContentTypeApplicationJSON, "{}")
+		require.Len(t, jobIDs, 3)
+		assert.NotEqual(t, jobIDs[0], jobIDs[2])
+		mock.AssertExpectationsForObjects(t, persistTx, transact, opSvc)
 	})
+}
 
-	t.Run("failed to update application", func(t *testing.T) {
-		setMappings()
-		defer clearMappings()
-
-		tx := &txautomock.PersistenceTx{}
-		tx.Mock.On("Commit").Return(nil)
-
-		listSccsTx := &txautomock.PersistenceTx{}
-		listSccsTx.Mock.On("Commit").Return(nil)
-
-		transact := txautomock.Transactioner{}
-		transact.Mock.On("Begin").Return(tx, nil).Once() // used for list tenants
-		transact.Mock.On("Begin").Return(tx, nil).Once() // used for upsertSccSystems
-		transact.Mock.On("RollbackUnlessCommitted", mock.Anything, tx).Return(true).Twice()
-		transact.Mock.On("Begin").Return(listSccsTx, nil).Once() //list for mark unreachable
-		transact.Mock.On("RollbackUnlessCommitted", mock.Anything, listSccsTx).Return(true).Once()
-
-		ids := []string{testSubaccount}
-		tntSvc := automock.TenantService{}
-		tntSvc.Mock.On("ListsByExternalIDs", mock.Anything, ids).Return([]*model.BusinessTenantMapping{{ID: "id", ExternalTenant: testSubaccount, Type: "subaccount"}}, nil)
-
-		input := nsmodel.ToAppUpdateInput(nsmodel.System{
-			SystemBase: nsmodel.SystemBase{
-				Protocol:     "HTTP",
-				Host:         "127.0.0.1:8080",
-				SystemType:   "otherSAPsys",
-				Description:  "description",
-				Status:       "disabled",
-				SystemNumber: "number",
+func TestHandler_JobStatus(t *testing.T) {
+	testErr := errors.New("test error")
+	finishedAt := time.Now()
+
+	completedOp := fixOperation(model.OperationStatusCompleted, &finishedAt)
+	completedOp.Data = json.RawMessage(`{"reportType":"delta","report":{},"details":[{"message":"Creation failed","subaccount":"` + testSubaccount + `","locationId":"loc-id"}]}`)
+	failedOp := fixOperation(model.OperationStatusFailed, &finishedAt)
+	failedOp.Error = json.RawMessage(`{"error":"while listing tenants: test error"}`)
+	otherOp := fixOperation(model.OperationStatusScheduled, nil)
+	otherOp.OpType = "ORD_AGGREGATION"
+
+	testCases := []struct {
+		Name               string
+		JobID              string
+		TransactionerFn    func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		OpSvcFn            func() *automock.OperationService
+		ExpectedStatusCode int
+		ExpectedBody       interface{}
+	}{
+		{
+			Name:               "Not found when job id is not a valid UUID",
+			JobID:              "invalid",
+			ExpectedStatusCode: http.StatusNotFound,
+			ExpectedBody:       fixErrorResponse(http.StatusNotFound, "job not found"),
+		},
+		{
+			Name:  "Not found when job does not exist",
+			JobID: operationID,
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txtest.NewTransactionContextGenerator(testErr).ThatDoesntExpectCommit()
 			},
-			TemplateID: "",
-		})
-
-		appSvc := automock.ApplicationService{}
-		appSvc.Mock.On("GetSccSystem", mock.Anything, testSubaccount, "loc-id", "127.0.0.1:8080").Return(&application, nil)
-		appSvc.Mock.On("Update", mock.Anything, application.ID, input).Return(errors.New("error"))
-		appSvc.Mock.On("ListBySCC", mock.Anything, labelFilter).Return([]*model.ApplicationWithLabel{&appWithLabel}, nil)
-		defer mock.AssertExpectationsForObjects(t, tx, listSccsTx, &transact, &appSvc, &tntSvc)
-
-		endpoint := handler.NewHandler(&appSvc, nil, nil, &tntSvc, &transact)
-
-		req := createReportSystemsRequest(strings.NewReader(body), deltaReportType)
-		rec := httptest.NewRecorder()
-
-		endpoint.ServeHTTP(rec, req)
-
-		resp := rec.Result()
-
-		expectedBody, err := json.Marshal(httputil.ErrorResponse{
-			Error: httputil.DetailedError{
-				Code:    http.StatusOK,
-				Message: "Update/create failed for some on-premise systems",
+			OpSvcFn: func() *automock.OperationService {
+				opSvc := &automock.OperationService{}
+				opSvc.On("Get", txtest.CtxWithDBMatcher(), operationID).Return(nil, apperrors.NewNotFoundErrorWithType(resource.Operation)).Once()
+				return opSvc
+			},
+			ExpectedStatusCode: http.StatusNotFound,
+			ExpectedBody:       fixErrorResponse(http.StatusNotFound, "job not found"),
+		},
+		{
+			Name:  "Not found when operation is not a report job",
+			JobID: operationID,
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txtest.NewTransactionContextGenerator(testErr).ThatSucceeds()
+			},
+			OpSvcFn: func() *automock.OperationService {
+				opSvc := &automock.OperationService{}
+				opSvc.On("Get", txtest.CtxWithDBMatcher(), operationID).Return(otherOp, nil).Once()
+				return opSvc
+			},
+			ExpectedStatusCode: http.StatusNotFound,
+			ExpectedBody:       fixErrorResponse(http.StatusNotFound, "job not found"),
+		},
+		{
+			Name:  "Error when opening transaction fails",
+			JobID: operationID,
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txtest.NewTransactionContextGenerator(testErr).ThatFailsOnBegin()
+			},
+			ExpectedStatusCode: http.StatusInternalServerError,
+			ExpectedBody:       fixErrorResponse(http.StatusInternalServerError, "Getting job status failed"),
+		},
+		{
+			Name:  "Error when getting the job fails",
+			JobID: operationID,
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txtest.NewTransactionContextGenerator(testErr).ThatDoesntExpectCommit()
+			},
+			OpSvcFn: func() *automock.OperationService {
+				opSvc := &automock.OperationService{}
+				opSvc.On("Get", txtest.CtxWithDBMatcher(), operationID).Return(nil, testErr).Once()
+				return opSvc
+			},
+			ExpectedStatusCode: http.StatusInternalServerError,
+			ExpectedBody:       fixErrorResponse(http.StatusInternalServerError, "Getting job status failed"),
+		},
+		{
+			Name:  "Error when committing transaction fails",
+			JobID: operationID,
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txtest.NewTransactionContextGenerator(testErr).ThatFailsOnCommit()
+			},
+			OpSvcFn: func() *automock.OperationService {
+				opSvc := &automock.OperationService{}
+				opSvc.On("Get", txtest.CtxWithDBMatcher(), operationID).Return(completedOp, nil).Once()
+				return opSvc
+			},
+			ExpectedStatusCode: http.StatusInternalServerError,
+			ExpectedBody:       fixErrorResponse(http.StatusInternalServerError, "Getting job status failed"),
+		},
+		{
+			Name:  "Success for scheduled job",
+			JobID: operationID,
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txtest.NewTransactionContextGenerator(testErr).ThatSucceeds()
+			},
+			OpSvcFn: func() *automock.OperationService {
+				opSvc := &automock.OperationService{}
+				opSvc.On("Get", txtest.CtxWithDBMatcher(), operationID).Return(fixOperation(model.OperationStatusScheduled, nil), nil).Once()
+				return opSvc
+			},
+			ExpectedStatusCode: http.StatusOK,
+			ExpectedBody: httputil.JobStatus{
+				JobID:      operationID,
+				ReportType: deltaReportType,
+				Status:     string(model.OperationStatusScheduled),
+			},
+		},
+		{
+			Name:  "Success for completed job with details",
+			JobID: operationID,
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txtest.NewTransactionContextGenerator(testErr).ThatSucceeds()
+			},
+			OpSvcFn: func() *automock.OperationService {
+				opSvc := &automock.OperationService{}
+				opSvc.On("Get", txtest.CtxWithDBMatcher(), operationID).Return(completedOp, nil).Once()
+				return opSvc
+			},
+			ExpectedStatusCode: http.StatusOK,
+			ExpectedBody: httputil.JobStatus{
+				JobID:      operationID,
+				ReportType: deltaReportType,
+				Status:     string(model.OperationStatusCompleted),
 				Details: []httputil.Detail{
 					{
 						Message:    "Creation failed",
@@ -666,1044 +499,98 @@ func TestHandler_ServeHTTP(t *testing.T) {
 					},
 				},
 			},
-		})
-		require.NoError(t, err)
-		Verify(t, resp, http.StatusOK, httputils.ContentTypeApplicationJSON, string(expectedBody))
-	})
-
-	t.Run("failed to set label systemType", func(t *testing.T) {
-		setMappings()
-		defer clearMappings()
-
-		tx := &txautomock.PersistenceTx{}
-		tx.Mock.On("Commit").Return(nil)
-
-		listSccsTx := &txautomock.PersistenceTx{}
-		listSccsTx.Mock.On("Commit").Return(nil)
-
-		transact := txautomock.Transactioner{}
-		transact.Mock.On("Begin").Return(tx, nil).Once() // used for list tenants
-		transact.Mock.On("Begin").Return(tx, nil).Once() // used for upsertSccSystems
-		transact.Mock.On("RollbackUnlessCommitted", mock.Anything, tx).Return(true).Twice()
-		transact.Mock.On("Begin").Return(listSccsTx, nil).Once() //list for mark unreachable
-		transact.Mock.On("RollbackUnlessCommitted", mock.Anything, listSccsTx).Return(true).Once()
+		},
+		{
+			Name:  "Success for failed job with error",
+			JobID: operationID,
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txtest.NewTransactionContextGenerator(testErr).ThatSucceeds()
+			},
+			OpSvcFn: func() *automock.OperationService {
+				opSvc := &automock.OperationService{}
+				opSvc.On("Get", txtest.CtxWithDBMatcher(), operationID).Return(failedOp, nil).Once()
+				return opSvc
+			},
+			ExpectedStatusCode: http.StatusOK,
+			ExpectedBody: httputil.JobStatus{
+				JobID:      operationID,
+				ReportType: deltaReportType,
+				Status:     string(model.OperationStatusFailed),
+				Error:      "while listing tenants: test error",
+			},
+		},
+	}
 
-		ids := []string{testSubaccount}
-		tntSvc := automock.TenantService{}
-		tntSvc.Mock.On("ListsByExternalIDs", mock.Anything, ids).Return([]*model.BusinessTenantMapping{{ID: "id", ExternalTenant: testSubaccount, Type: "subaccount"}}, nil)
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persistTx := &persistenceautomock.PersistenceTx{}
+			transact := &persistenceautomock.Transactioner{}
+			if testCase.TransactionerFn != nil {
+				persistTx, transact = testCase.TransactionerFn()
+			}
+			opSvc := &automock.OperationService{}
+			if testCase.OpSvcFn != nil {
+				opSvc = testCase.OpSvcFn()
+			}
+
+			endpoint := handler.NewHandler(opSvc, transact, deduplicationPeriod)
+
+			req := httptest.NewRequest(http.MethodGet, "/v1/notifications/"+testCase.JobID, nil)
+			req = mux.SetURLVars(req, map[string]string{"jobID": testCase.JobID})
+			rec := httptest.NewRecorder()
+
+			// WHEN
+			endpoint.JobStatus(rec, req)
+
+			// THEN
+			expectedBody, err := json.Marshal(testCase.ExpectedBody)
+			require.NoError(t, err)
+			Verify(t, rec.Result(), testCase.ExpectedStatusCode, httputils.ContentTypeApplicationJSON, string(expectedBody))
+
+			mock.AssertExpectationsForObjects(t, persistTx, transact, opSvc)
+		})
+	}
+}
 
-		input := nsmodel.ToAppUpdateInput(system)
+func fixLatestReportOperation(t *testing.T, id, reportType string, status model.OperationStatus, finishedAt *time.Time) *model.Operation {
+	var report nsmodel.Report
+	require.NoError(t, json.Unmarshal([]byte(reportBody), &report))
+
+	job := nsmodel.ReportJob{ReportType: reportType, Report: report}
+	content, err := json.Marshal(job)
+	require.NoError(t, err)
+
+	hash := sha256.Sum256(content)
+	job.ContentHash = hex.EncodeToString(hash[:])
+	data, err := json.Marshal(job)
+	require.NoError(t, err)
+
+	return &model.Operation{
+		ID:         id,
+		OpType:     nsAdapterReportOpType,
+		Status:     status,
+		Data:       data,
+		FinishedAt: finishedAt,
+	}
+}
 
-		appSvc := automock.ApplicationService{}
-		appSvc.Mock.On("GetSccSystem", mock.Anything, testSubaccount, "loc-id", "127.0.0.1:8080").Return(&application, nil)
-		appSvc.Mock.On("Update", mock.Anything, application.ID, input).Return(nil)
-		appSvc.Mock.On("SetLabel", mock.Anything, label).Return(errors.New("error"))
-		appSvc.Mock.On("ListBySCC", mock.Anything, labelFilter).Return([]*model.ApplicationWithLabel{&appWithLabel}, nil)
-		defer mock.AssertExpectationsForObjects(t, tx, listSccsTx, &transact, &appSvc, &tntSvc)
+func fixOperation(status model.OperationStatus, finishedAt *time.Time) *model.Operation {
+	return &model.Operation{
+		ID:         operationID,
+		OpType:     nsAdapterReportOpType,
+		Status:     status,
+		Data:       json.RawMessage(`{"reportType":"delta","report":{}}`),
+		FinishedAt: finishedAt,
+	}
+}
 
-		endpoint := handler.NewHandler(&appSvc, nil, nil, &tntSvc, &transact)
-
-		req := createReportSystemsRequest(strings.NewReader(body), deltaReportType)
-		rec := httptest.NewRecorder()
-
-		endpoint.ServeHTTP(rec, req)
-
-		resp := rec.Result()
-
-		expectedBody, err := json.Marshal(httputil.ErrorResponse{
-			Error: httputil.DetailedError{
-				Code:    http.StatusOK,
-				Message: "Update/create failed for some on-premise systems",
-				Details: []httputil.Detail{
-					{
-						Message:    "Creation failed",
-						Subaccount: testSubaccount,
-						LocationID: "loc-id",
-					},
-				},
-			},
-		})
-		require.NoError(t, err)
-		Verify(t, resp, http.StatusOK, httputils.ContentTypeApplicationJSON, string(expectedBody))
-	})
-
-	t.Run("failed to set label systemProtocol", func(t *testing.T) {
-		setMappings()
-		defer clearMappings()
-
-		tx := &txautomock.PersistenceTx{}
-		tx.Mock.On("Commit").Return(nil)
-
-		listSccsTx := &txautomock.PersistenceTx{}
-		listSccsTx.Mock.On("Commit").Return(nil)
-
-		transact := txautomock.Transactioner{}
-		transact.Mock.On("Begin").Return(tx, nil).Once() // used for list tenants
-		transact.Mock.On("Begin").Return(tx, nil).Once() // used for upsertSccSystems
-		transact.Mock.On("RollbackUnlessCommitted", mock.Anything, tx).Return(true).Twice()
-		transact.Mock.On("Begin").Return(listSccsTx, nil).Once() //list for mark unreachable
-		transact.Mock.On("RollbackUnlessCommitted", mock.Anything, listSccsTx).Return(true).Once()
-
-		ids := []string{testSubaccount}
-		tntSvc := automock.TenantService{}
-		tntSvc.Mock.On("ListsByExternalIDs", mock.Anything, ids).Return([]*model.BusinessTenantMapping{{ID: "id", ExternalTenant: testSubaccount, Type: "subaccount"}}, nil)
-
-		input := nsmodel.ToAppUpdateInput(system)
-		protocolLabel := &model.LabelInput{
-			Key:        "systemProtocol",
-			Value:      system.Protocol,
-			ObjectID:   application.ID,
-			ObjectType: model.ApplicationLabelableObject,
-		}
-
-		appSvc := automock.ApplicationService{}
-		appSvc.Mock.On("GetSccSystem", mock.Anything, testSubaccount, "loc-id", "127.0.0.1:8080").Return(&application, nil)
-		appSvc.Mock.On("Update", mock.Anything, application.ID, input).Return(nil)
-		appSvc.Mock.On("SetLabel", mock.Anything, label).Return(nil).Once()
-		appSvc.Mock.On("SetLabel", mock.Anything, protocolLabel).Return(errors.New("error")).Once()
-		appSvc.Mock.On("ListBySCC", mock.Anything, labelFilter).Return([]*model.ApplicationWithLabel{&appWithLabel}, nil)
-		defer mock.AssertExpectationsForObjects(t, tx, listSccsTx, &transact, &appSvc, &tntSvc)
-
-		endpoint := handler.NewHandler(&appSvc, nil, nil, &tntSvc, &transact)
-
-		req := createReportSystemsRequest(strings.NewReader(body), deltaReportType)
-		rec := httptest.NewRecorder()
-
-		endpoint.ServeHTTP(rec, req)
-
-		resp := rec.Result()
-		expectedBody, err := json.Marshal(httputil.ErrorResponse{
-			Error: httputil.DetailedError{
-				Code:    http.StatusOK,
-				Message: "Update/create failed for some on-premise systems",
-				Details: []httputil.Detail{
-					{
-						Message:    "Creation failed",
-						Subaccount: testSubaccount,
-						LocationID: "loc-id",
-					},
-				},
-			},
-		})
-		require.NoError(t, err)
-		Verify(t, resp, http.StatusOK, httputils.ContentTypeApplicationJSON, string(expectedBody))
-	})
-
-	t.Run("successfully update system", func(t *testing.T) {
-		setMappings()
-		defer clearMappings()
-
-		tx := &txautomock.PersistenceTx{}
-		tx.Mock.On("Commit").Return(nil)
-
-		listSccsTx := &txautomock.PersistenceTx{}
-		listSccsTx.Mock.On("Commit").Return(nil)
-
-		transact := txautomock.Transactioner{}
-		transact.Mock.On("Begin").Return(tx, nil).Once() // used for list tenants
-		transact.Mock.On("Begin").Return(tx, nil).Once() // used for upsertSccSystems
-		transact.Mock.On("RollbackUnlessCommitted", mock.Anything, tx).Return(true).Twice()
-		transact.Mock.On("Begin").Return(listSccsTx, nil).Once() //list for mark unreachable
-		transact.Mock.On("RollbackUnlessCommitted", mock.Anything, listSccsTx).Return(true).Once()
-
-		ids := []string{testSubaccount}
-		tntSvc := automock.TenantService{}
-		tntSvc.Mock.On("ListsByExternalIDs", mock.Anything, ids).Return([]*model.BusinessTenantMapping{{ID: "id", ExternalTenant: testSubaccount, Type: "subaccount"}}, nil)
-
-		input := nsmodel.ToAppUpdateInput(system)
-		protocolLabel := &model.LabelInput{
-			Key:        "systemProtocol",
-			Value:      system.Protocol,
-			ObjectID:   application.ID,
-			ObjectType: model.ApplicationLabelableObject,
-		}
-
-		appSvc := automock.ApplicationService{}
-		appSvc.Mock.On("GetSccSystem", mock.Anything, testSubaccount, "loc-id", "127.0.0.1:8080").Return(&application, nil)
-		appSvc.Mock.On("Update", mock.Anything, application.ID, input).Return(nil)
-		appSvc.Mock.On("SetLabel", mock.Anything, label).Return(nil).Once()
-		appSvc.Mock.On("SetLabel", mock.Anything, protocolLabel).Return(nil).Once()
-		appSvc.Mock.On("ListBySCC", mock.Anything, labelFilter).Return([]*model.ApplicationWithLabel{&appWithLabel}, nil)
-		defer mock.AssertExpectationsForObjects(t, tx, listSccsTx, &transact, &appSvc, &tntSvc)
-
-		endpoint := handler.NewHandler(&appSvc, nil, nil, &tntSvc, &transact)
-
-		req := createReportSystemsRequest(strings.NewReader(body), deltaReportType)
-		rec := httptest.NewRecorder()
-
-		endpoint.ServeHTTP(rec, req)
-
-		resp := rec.Result()
-		Verify(t, resp, http.StatusNoContent, httputils.ContentTypeApplicationJSON, "{}")
-	})
-
-	t.Run("failed to list by SCC", func(t *testing.T) {
-		tx := &txautomock.PersistenceTx{}
-		tx.Mock.On("Commit").Return(nil)
-
-		listSccsTx := &txautomock.PersistenceTx{}
-
-		transact := txautomock.Transactioner{}
-		transact.Mock.On("Begin").Return(tx, nil).Once()         // used for list tenants
-		transact.Mock.On("Begin").Return(listSccsTx, nil).Once() //list for mark unreachable
-		transact.Mock.On("RollbackUnlessCommitted", mock.Anything, tx).Return(true).Once()
-		transact.Mock.On("RollbackUnlessCommitted", mock.Anything, listSccsTx).Return(true).Once()
-
-		ids := []string{testSubaccount}
-		tntSvc := automock.TenantService{}
-		tntSvc.Mock.On("ListsByExternalIDs", mock.Anything, ids).Return([]*model.BusinessTenantMapping{{ID: "id", ExternalTenant: testSubaccount, Type: "subaccount"}}, nil)
-
-		appSvc := automock.ApplicationService{}
-		appSvc.Mock.On("ListBySCC", mock.Anything, labelFilter).Return(nil, errors.New("error"))
-		defer mock.AssertExpectationsForObjects(t, tx, listSccsTx, &transact, &appSvc, &tntSvc)
-
-		endpoint := handler.NewHandler(&appSvc, nil, nil, &tntSvc, &transact)
-
-		req := createReportSystemsRequest(strings.NewReader(bodyWithoutExposedSystems), deltaReportType)
-		rec := httptest.NewRecorder()
-
-		endpoint.ServeHTTP(rec, req)
-
-		resp := rec.Result()
-		expectedBody, err := json.Marshal(httputil.ErrorResponse{
-			Error: httputil.DetailedError{
-				Code:    http.StatusOK,
-				Message: "Update/create failed for some on-premise systems",
-				Details: []httputil.Detail{
-					{
-						Message:    "Creation failed",
-						Subaccount: testSubaccount,
-						LocationID: "loc-id",
-					},
-				},
-			},
-		})
-		require.NoError(t, err)
-		Verify(t, resp, http.StatusOK, httputils.ContentTypeApplicationJSON, string(expectedBody))
-	})
-
-	t.Run("fail to mark system as unreachable", func(t *testing.T) {
-		tx := &txautomock.PersistenceTx{}
-		tx.Mock.On("Commit").Return(nil)
-
-		listSccsTx := &txautomock.PersistenceTx{}
-		listSccsTx.Mock.On("Commit").Return(nil)
-
-		transact := txautomock.Transactioner{}
-		transact.Mock.On("Begin").Return(tx, nil).Once()         // used for list tenants
-		transact.Mock.On("Begin").Return(listSccsTx, nil).Once() //list by scc
-		transact.Mock.On("Begin").Return(tx, nil).Once()         // used for mark as unreachable
-		transact.Mock.On("RollbackUnlessCommitted", mock.Anything, tx).Return(true).Twice()
-		transact.Mock.On("RollbackUnlessCommitted", mock.Anything, listSccsTx).Return(true).Once()
-
-		ids := []string{testSubaccount}
-		tntSvc := automock.TenantService{}
-		tntSvc.Mock.On("ListsByExternalIDs", mock.Anything, ids).Return([]*model.BusinessTenantMapping{{ID: "id", ExternalTenant: testSubaccount, Type: "subaccount"}}, nil)
-
-		unreachableInput := model.ApplicationUpdateInput{SystemStatus: str.Ptr("unreachable")}
-
-		appSvc := automock.ApplicationService{}
-		appSvc.Mock.On("ListBySCC", mock.Anything, labelFilter).Return([]*model.ApplicationWithLabel{&appWithLabel}, nil)
-		appSvc.Mock.On("Update", mock.Anything, appWithLabel.App.ID, unreachableInput).Return(errors.New("error"))
-		defer mock.AssertExpectationsForObjects(t, tx, listSccsTx, &transact, &appSvc, &tntSvc)
-
-		endpoint := handler.NewHandler(&appSvc, nil, nil, &tntSvc, &transact)
-
-		req := createReportSystemsRequest(strings.NewReader(bodyWithoutExposedSystems), deltaReportType)
-		rec := httptest.NewRecorder()
-
-		endpoint.ServeHTTP(rec, req)
-
-		resp := rec.Result()
-		expectedBody, err := json.Marshal(httputil.ErrorResponse{
-			Error: httputil.DetailedError{
-				Code:    http.StatusOK,
-				Message: "Update/create failed for some on-premise systems",
-				Details: []httputil.Detail{
-					{
-						Message:    "Creation failed",
-						Subaccount: testSubaccount,
-						LocationID: "loc-id",
-					},
-				},
-			},
-		})
-		require.NoError(t, err)
-		Verify(t, resp, http.StatusOK, httputils.ContentTypeApplicationJSON, string(expectedBody))
-	})
-
-	t.Run("successfully mark system as unreachable", func(t *testing.T) {
-		tx := &txautomock.PersistenceTx{}
-		tx.Mock.On("Commit").Return(nil)
-
-		listSccsTx := &txautomock.PersistenceTx{}
-		listSccsTx.Mock.On("Commit").Return(nil)
-
-		transact := txautomock.Transactioner{}
-		transact.Mock.On("Begin").Return(tx, nil).Once()         // used for list tenants
-		transact.Mock.On("Begin").Return(listSccsTx, nil).Once() //list by scc
-		transact.Mock.On("Begin").Return(tx, nil).Once()         // used for mark as unreachable
-		transact.Mock.On("RollbackUnlessCommitted", mock.Anything, tx).Return(true).Twice()
-		transact.Mock.On("RollbackUnlessCommitted", mock.Anything, listSccsTx).Return(true).Once()
-
-		ids := []string{testSubaccount}
-		tntSvc := automock.TenantService{}
-		tntSvc.Mock.On("ListsByExternalIDs", mock.Anything, ids).Return([]*model.BusinessTenantMapping{{ID: "id", ExternalTenant: testSubaccount, Type: "subaccount"}}, nil)
-
-		unreachableInput := model.ApplicationUpdateInput{SystemStatus: str.Ptr("unreachable")}
-
-		appSvc := automock.ApplicationService{}
-		appSvc.Mock.On("ListBySCC", mock.Anything, labelFilter).Return([]*model.ApplicationWithLabel{&appWithLabel}, nil)
-		appSvc.Mock.On("Update", mock.Anything, appWithLabel.App.ID, unreachableInput).Return(nil)
-		defer mock.AssertExpectationsForObjects(t, tx, listSccsTx, &transact, &appSvc, &tntSvc)
-
-		endpoint := handler.NewHandler(&appSvc, nil, nil, &tntSvc, &transact)
-
-		req := createReportSystemsRequest(strings.NewReader(bodyWithoutExposedSystems), deltaReportType)
-		rec := httptest.NewRecorder()
-
-		endpoint.ServeHTTP(rec, req)
-
-		resp := rec.Result()
-		Verify(t, resp, http.StatusNoContent, httputils.ContentTypeApplicationJSON, "{}")
-	})
-
-	t.Run("successful successfully mark system as unreachable with two sccs connected to one subaccount", func(t *testing.T) {
-		body := strings.NewReader("{\n  \"type\": \"notification-service\",\n  \"value\": [\n    {\n      \"subaccount\": \"fd4f2041-fa83-48e0-b292-ff515bb776f0\",\n      \"locationId\": \"loc-id\",\n      \"exposedSystems\": []\n    },{\n      \"subaccount\": \"fd4f2041-fa83-48e0-b292-ff515bb776f0\",\n      \"locationId\": \"loc-id-2\",\n      \"exposedSystems\": []\n    }\n  ]\n}")
-
-		tx := &txautomock.PersistenceTx{}
-		tx.Mock.On("Commit").Return(nil)
-
-		listSccsTx := &txautomock.PersistenceTx{}
-		listSccsTx.Mock.On("Commit").Return(nil)
-
-		transact := txautomock.Transactioner{}
-		transact.Mock.On("Begin").Return(tx, nil).Once()         // used for list tenants
-		transact.Mock.On("Begin").Return(listSccsTx, nil).Once() //list by scc
-		transact.Mock.On("Begin").Return(listSccsTx, nil).Once() //list by scc
-		transact.Mock.On("Begin").Return(tx, nil).Once()         // used for mark as unreachable
-		transact.Mock.On("Begin").Return(tx, nil).Once()         // used for mark as unreachable
-		transact.Mock.On("RollbackUnlessCommitted", mock.Anything, tx).Return(true).Times(3)
-		transact.Mock.On("RollbackUnlessCommitted", mock.Anything, listSccsTx).Return(true).Twice()
-
-		ids := []string{testSubaccount, testSubaccount}
-		tntSvc := automock.TenantService{}
-		tntSvc.Mock.On("ListsByExternalIDs", mock.Anything, ids).Return([]*model.BusinessTenantMapping{{ID: "id", ExternalTenant: testSubaccount, Type: "subaccount"}}, nil)
-
-		unreachableInput := model.ApplicationUpdateInput{SystemStatus: str.Ptr("unreachable")}
-
-		appSvc := automock.ApplicationService{}
-		appSvc.Mock.On("ListBySCC", mock.Anything, labelFilter).Return([]*model.ApplicationWithLabel{&appWithLabel}, nil)
-		appSvc.Mock.On("ListBySCC", mock.Anything, labelFilter2).Return([]*model.ApplicationWithLabel{&appWithLabel2}, nil)
-		appSvc.Mock.On("Update", mock.Anything, appWithLabel.App.ID, unreachableInput).Return(nil)
-		appSvc.Mock.On("Update", mock.Anything, appWithLabel2.App.ID, unreachableInput).Return(nil)
-		defer mock.AssertExpectationsForObjects(t, tx, listSccsTx, &transact, &appSvc, &tntSvc)
-
-		endpoint := handler.NewHandler(&appSvc, nil, nil, &tntSvc, &transact)
-
-		req := createReportSystemsRequest(body, deltaReportType)
-		rec := httptest.NewRecorder()
-
-		endpoint.ServeHTTP(rec, req)
-
-		resp := rec.Result()
-		Verify(t, resp, http.StatusNoContent, httputils.ContentTypeApplicationJSON, "{}")
-	})
-
-	t.Run("success when report type is delta and value is empty", func(t *testing.T) {
-		body := strings.NewReader("{\n  \"type\": \"notification-service\",\n  \"value\": []\n}")
-
-		endpoint := handler.NewHandler(nil, nil, nil, nil, nil)
-
-		req := createReportSystemsRequest(body, deltaReportType)
-		rec := httptest.NewRecorder()
-
-		endpoint.ServeHTTP(rec, req)
-
-		resp := rec.Result()
-		Verify(t, resp, http.StatusNoContent, httputils.ContentTypeApplicationJSON, "{}")
-	})
-
-	//Full report tests
-	t.Run("got error while upserting application", func(t *testing.T) {
-		tx := &txautomock.PersistenceTx{}
-		tx.Mock.On("Commit").Return(nil)
-
-		listSccsTx := &txautomock.PersistenceTx{}
-		listSccsTx.Mock.On("Commit").Return(nil)
-
-		transact := txautomock.Transactioner{}
-		transact.Mock.On("Begin").Return(tx, nil).Once() // used for list tenants
-		transact.Mock.On("Begin").Return(tx, nil).Once() // used for upsert
-		transact.Mock.On("RollbackUnlessCommitted", mock.Anything, tx).Return(true).Twice()
-		transact.Mock.On("Begin").Return(listSccsTx, nil).Once() //list for mark unreachable
-		transact.Mock.On("Begin").Return(listSccsTx, nil).Once() //used in listSCCs
-		transact.Mock.On("RollbackUnlessCommitted", mock.Anything, listSccsTx).Return(true).Twice()
-
-		ids := []string{testSubaccount}
-		tntSvc := automock.TenantService{}
-		tntSvc.Mock.On("ListsByExternalIDs", mock.Anything, ids).Return([]*model.BusinessTenantMapping{{ID: "id", ExternalTenant: testSubaccount, Type: "subaccount"}}, nil)
-
-		setMappings()
-		defer clearMappings()
-
-		appInputJSON := "app-input-json"
-		applicationTemplate := &model.ApplicationTemplate{}
-
-		appTemplateSvc := automock.ApplicationTemplateService{}
-		appTemplateSvc.Mock.On("Get", mock.Anything, "ss").Return(applicationTemplate, nil)
-		appTemplateSvc.Mock.On("PrepareApplicationCreateInputJSON", applicationTemplate, mock.Anything).Return(appInputJSON, nil)
-
-		input := model.ApplicationRegisterInput{}
-		appConverterSvc := automock.ApplicationConverter{}
-		appConverterSvc.Mock.On("CreateInputJSONToModel", mock.Anything, appInputJSON).Return(input, nil)
-
-		appSvc := automock.ApplicationService{}
-		appSvc.Mock.On("Upsert", mock.Anything, input).Return(errors.New("error"))
-
-		appSvc.Mock.On("ListBySCC", mock.Anything, labelFilter).Return([]*model.ApplicationWithLabel{&appWithLabel}, nil)
-		appSvc.Mock.On("ListSCCs", mock.Anything).Return(nil, errors.New("error"))
-		defer mock.AssertExpectationsForObjects(t, tx, listSccsTx, &transact, &appTemplateSvc, &appConverterSvc, &appSvc, &tntSvc)
-
-		endpoint := handler.NewHandler(&appSvc, &appConverterSvc, &appTemplateSvc, &tntSvc, &transact)
-
-		req := createReportSystemsRequest(strings.NewReader(bodyWithSystemNumber), fullReportType)
-		rec := httptest.NewRecorder()
-
-		endpoint.ServeHTTP(rec, req)
-
-		resp := rec.Result()
-		Verify(t, resp, http.StatusNoContent, httputils.ContentTypeApplicationJSON, "{}")
-	})
-
-	t.Run("successfully upsert application", func(t *testing.T) {
-		tx := &txautomock.PersistenceTx{}
-		tx.Mock.On("Commit").Return(nil)
-
-		listSccsTx := &txautomock.PersistenceTx{}
-		listSccsTx.Mock.On("Commit").Return(nil)
-
-		transact := txautomock.Transactioner{}
-		transact.Mock.On("Begin").Return(tx, nil).Once() // used for list tenants
-		transact.Mock.On("Begin").Return(tx, nil).Once() // used for upsert
-		transact.Mock.On("RollbackUnlessCommitted", mock.Anything, tx).Return(true).Twice()
-		transact.Mock.On("Begin").Return(listSccsTx, nil).Once() //list for mark unreachable
-		transact.Mock.On("Begin").Return(listSccsTx, nil).Once() //used in listSCCs
-		transact.Mock.On("RollbackUnlessCommitted", mock.Anything, listSccsTx).Return(true).Twice()
-
-		ids := []string{testSubaccount}
-		tntSvc := automock.TenantService{}
-		tntSvc.Mock.On("ListsByExternalIDs", mock.Anything, ids).Return([]*model.BusinessTenantMapping{{ID: "id", ExternalTenant: testSubaccount, Type: "subaccount"}}, nil)
-
-		setMappings()
-		defer clearMappings()
-
-		appInputJSON := "app-input-json"
-		applicationTemplate := &model.ApplicationTemplate{}
-
-		appTemplateSvc := automock.ApplicationTemplateService{}
-		appTemplateSvc.Mock.On("Get", mock.Anything, "ss").Return(applicationTemplate, nil)
-		appTemplateSvc.Mock.On("PrepareApplicationCreateInputJSON", applicationTemplate, mock.Anything).Return(appInputJSON, nil)
-
-		input := model.ApplicationRegisterInput{}
-		appConverterSvc := automock.ApplicationConverter{}
-		appConverterSvc.Mock.On("CreateInputJSONToModel", mock.Anything, appInputJSON).Return(input, nil)
-
-		appSvc := automock.ApplicationService{}
-		appSvc.Mock.On("Upsert", mock.Anything, input).Return(nil)
-
-		appSvc.Mock.On("ListBySCC", mock.Anything, labelFilter).Return([]*model.ApplicationWithLabel{&appWithLabel}, nil)
-		appSvc.Mock.On("ListSCCs", mock.Anything).Return(nil, errors.New("error"))
-		defer mock.AssertExpectationsForObjects(t, tx, listSccsTx, &transact, &appTemplateSvc, &appConverterSvc, &appSvc, &tntSvc)
-
-		endpoint := handler.NewHandler(&appSvc, &appConverterSvc, &appTemplateSvc, &tntSvc, &transact)
-
-		req := createReportSystemsRequest(strings.NewReader(bodyWithSystemNumber), fullReportType)
-		rec := httptest.NewRecorder()
-
-		endpoint.ServeHTTP(rec, req)
-
-		resp := rec.Result()
-		Verify(t, resp, http.StatusNoContent, httputils.ContentTypeApplicationJSON, "{}")
-	})
-
-	t.Run("failed to get application by subaccount, location ID and virtual host", func(t *testing.T) {
-		tx := &txautomock.PersistenceTx{}
-		tx.Mock.On("Commit").Return(nil)
-
-		listSccsTx := &txautomock.PersistenceTx{}
-		listSccsTx.Mock.On("Commit").Return(nil)
-
-		transact := txautomock.Transactioner{}
-		transact.Mock.On("Begin").Return(tx, nil).Once() // used for list tenants
-		transact.Mock.On("Begin").Return(tx, nil).Once() // used for upsertSccSystems
-		transact.Mock.On("RollbackUnlessCommitted", mock.Anything, tx).Return(true).Twice()
-		transact.Mock.On("Begin").Return(listSccsTx, nil).Once() //list for mark unreachable
-		transact.Mock.On("Begin").Return(listSccsTx, nil).Once() //used in listSCCs
-		transact.Mock.On("RollbackUnlessCommitted", mock.Anything, listSccsTx).Return(true).Twice()
-
-		ids := []string{testSubaccount}
-		tntSvc := automock.TenantService{}
-		tntSvc.Mock.On("ListsByExternalIDs", mock.Anything, ids).Return([]*model.BusinessTenantMapping{{ID: "id", ExternalTenant: testSubaccount, Type: "subaccount"}}, nil)
-
-		setMappings()
-		defer clearMappings()
-
-		appSvc := automock.ApplicationService{}
-		appSvc.Mock.On("GetSccSystem", mock.Anything, testSubaccount, "loc-id", "127.0.0.1:8080").Return(nil, errors.New("error"))
-		appSvc.Mock.On("ListBySCC", mock.Anything, labelFilter).Return([]*model.ApplicationWithLabel{&appWithLabel}, nil)
-		appSvc.Mock.On("ListSCCs", mock.Anything).Return(nil, errors.New("error"))
-		defer mock.AssertExpectationsForObjects(t, tx, listSccsTx, &transact, &appSvc, &tntSvc)
-
-		endpoint := handler.NewHandler(&appSvc, nil, nil, &tntSvc, &transact)
-
-		req := createReportSystemsRequest(strings.NewReader(body), fullReportType)
-		rec := httptest.NewRecorder()
-
-		endpoint.ServeHTTP(rec, req)
-
-		resp := rec.Result()
-		Verify(t, resp, http.StatusNoContent, httputils.ContentTypeApplicationJSON, "{}")
-	})
-
-	t.Run("failed to register application from template", func(t *testing.T) {
-		tx := &txautomock.PersistenceTx{}
-		tx.Mock.On("Commit").Return(nil)
-
-		listSccsTx := &txautomock.PersistenceTx{}
-		listSccsTx.Mock.On("Commit").Return(nil)
-
-		transact := txautomock.Transactioner{}
-		transact.Mock.On("Begin").Return(tx, nil).Once() // used for list tenants
-		transact.Mock.On("Begin").Return(tx, nil).Once() // used for upsertSccSystems
-		transact.Mock.On("RollbackUnlessCommitted", mock.Anything, tx).Return(true).Twice()
-		transact.Mock.On("Begin").Return(listSccsTx, nil).Once() //list for mark unreachable
-		transact.Mock.On("Begin").Return(listSccsTx, nil).Once() //used in listSCCs
-		transact.Mock.On("RollbackUnlessCommitted", mock.Anything, listSccsTx).Return(true).Twice()
-
-		ids := []string{testSubaccount}
-		tntSvc := automock.TenantService{}
-		tntSvc.Mock.On("ListsByExternalIDs", mock.Anything, ids).Return([]*model.BusinessTenantMapping{{ID: "id", ExternalTenant: testSubaccount, Type: "subaccount"}}, nil)
-
-		setMappings()
-		defer clearMappings()
-
-		appInputJSON := "app-input-json"
-		applicationTemplate := &model.ApplicationTemplate{}
-
-		appTemplateSvc := automock.ApplicationTemplateService{}
-		appTemplateSvc.Mock.On("Get", mock.Anything, "ss").Return(applicationTemplate, nil)
-		appTemplateSvc.Mock.On("PrepareApplicationCreateInputJSON", applicationTemplate, mock.Anything).Return(appInputJSON, nil)
-
-		input := model.ApplicationRegisterInput{}
-		appConverterSvc := automock.ApplicationConverter{}
-		appConverterSvc.Mock.On("CreateInputJSONToModel", mock.Anything, appInputJSON).Return(input, nil)
-
-		appSvc := automock.ApplicationService{}
-		appSvc.Mock.On("GetSccSystem", mock.Anything, testSubaccount, "loc-id", "127.0.0.1:8080").
-			Return(nil, errors.New("Object not found"))
-		appSvc.Mock.On("CreateFromTemplate", mock.Anything, input, str.Ptr("ss")).Return("", errors.New("error"))
-		appSvc.Mock.On("ListBySCC", mock.Anything, labelFilter).Return([]*model.ApplicationWithLabel{&appWithLabel}, nil)
-		appSvc.Mock.On("ListSCCs", mock.Anything).Return(nil, errors.New("error"))
-		defer mock.AssertExpectationsForObjects(t, tx, listSccsTx, &transact, &appTemplateSvc, &appConverterSvc, &appSvc, &tntSvc)
-
-		endpoint := handler.NewHandler(&appSvc, &appConverterSvc, &appTemplateSvc, &tntSvc, &transact)
-
-		req := createReportSystemsRequest(strings.NewReader(body), fullReportType)
-		rec := httptest.NewRecorder()
-
-		endpoint.ServeHTTP(rec, req)
-
-		resp := rec.Result()
-		Verify(t, resp, http.StatusNoContent, httputils.ContentTypeApplicationJSON, "{}")
-	})
-
-	t.Run("successfully create application", func(t *testing.T) {
-		tx := &txautomock.PersistenceTx{}
-		tx.Mock.On("Commit").Return(nil)
-
-		listSccsTx := &txautomock.PersistenceTx{}
-		listSccsTx.Mock.On("Commit").Return(nil)
-
-		transact := txautomock.Transactioner{}
-		transact.Mock.On("Begin").Return(tx, nil).Once() // used for list tenants
-		transact.Mock.On("Begin").Return(tx, nil).Once() // used for upsertSccSystems
-		transact.Mock.On("RollbackUnlessCommitted", mock.Anything, tx).Return(true).Twice()
-		transact.Mock.On("Begin").Return(listSccsTx, nil).Once() //list for mark unreachable
-		transact.Mock.On("Begin").Return(listSccsTx, nil).Once() //used in listSCCs
-		transact.Mock.On("RollbackUnlessCommitted", mock.Anything, listSccsTx).Return(true).Twice()
-
-		ids := []string{testSubaccount}
-		tntSvc := automock.TenantService{}
-		tntSvc.Mock.On("ListsByExternalIDs", mock.Anything, ids).Return([]*model.BusinessTenantMapping{{ID: "id", ExternalTenant: testSubaccount, Type: "subaccount"}}, nil)
-
-		nsmodel.Mappings = append(nsmodel.Mappings, nsmodel.TemplateMapping{
-			Name:        "",
-			ID:          "ss",
-			SourceKey:   []string{"description"},
-			SourceValue: []string{"description"},
-		})
-		defer clearMappings()
-
-		setMappings()
-		defer clearMappings()
-
-		appInputJSON := "app-input-json"
-		applicationTemplate := &model.ApplicationTemplate{}
-
-		appTemplateSvc := automock.ApplicationTemplateService{}
-		appTemplateSvc.Mock.On("Get", mock.Anything, "ss").Return(applicationTemplate, nil)
-		appTemplateSvc.Mock.On("PrepareApplicationCreateInputJSON", applicationTemplate, mock.Anything).Return(appInputJSON, nil)
-
-		input := model.ApplicationRegisterInput{}
-		appConverterSvc := automock.ApplicationConverter{}
-		appConverterSvc.Mock.On("CreateInputJSONToModel", mock.Anything, appInputJSON).Return(input, nil)
-
-		appSvc := automock.ApplicationService{}
-		appSvc.Mock.On("GetSccSystem", mock.Anything, testSubaccount, "loc-id", "127.0.0.1:8080").
-			Return(nil, errors.New("Object not found"))
-		appSvc.Mock.On("CreateFromTemplate", mock.Anything, input, str.Ptr("ss")).Return("success", nil)
-		appSvc.Mock.On("ListBySCC", mock.Anything, labelFilter).Return([]*model.ApplicationWithLabel{&appWithLabel}, nil)
-		appSvc.Mock.On("ListSCCs", mock.Anything).Return(nil, errors.New("error"))
-		defer mock.AssertExpectationsForObjects(t, tx, listSccsTx, &transact, &appTemplateSvc, &appConverterSvc, &appSvc, &tntSvc)
-
-		endpoint := handler.NewHandler(&appSvc, &appConverterSvc, &appTemplateSvc, &tntSvc, &transact)
-
-		req := createReportSystemsRequest(strings.NewReader(body), fullReportType)
-		rec := httptest.NewRecorder()
-
-		endpoint.ServeHTTP(rec, req)
-
-		resp := rec.Result()
-		Verify(t, resp, http.StatusNoContent, httputils.ContentTypeApplicationJSON, "{}")
-	})
-
-	t.Run("failed to update application", func(t *testing.T) {
-		setMappings()
-		defer clearMappings()
-
-		tx := &txautomock.PersistenceTx{}
-		tx.Mock.On("Commit").Return(nil)
-
-		listSccsTx := &txautomock.PersistenceTx{}
-		listSccsTx.Mock.On("Commit").Return(nil)
-
-		transact := txautomock.Transactioner{}
-		transact.Mock.On("Begin").Return(tx, nil).Once() // used for list tenants
-		transact.Mock.On("Begin").Return(tx, nil).Once() // used for upsertSccSystems
-		transact.Mock.On("RollbackUnlessCommitted", mock.Anything, tx).Return(true).Twice()
-		transact.Mock.On("Begin").Return(listSccsTx, nil).Once() //list for mark unreachable
-		transact.Mock.On("Begin").Return(listSccsTx, nil).Once() //used in listSCCs
-		transact.Mock.On("RollbackUnlessCommitted", mock.Anything, listSccsTx).Return(true).Twice()
-
-		ids := []string{testSubaccount}
-		tntSvc := automock.TenantService{}
-		tntSvc.Mock.On("ListsByExternalIDs", mock.Anything, ids).Return([]*model.BusinessTenantMapping{{ID: "id", ExternalTenant: testSubaccount, Type: "subaccount"}}, nil)
-
-		input := nsmodel.ToAppUpdateInput(nsmodel.System{
-			SystemBase: nsmodel.SystemBase{
-				Protocol:     "HTTP",
-				Host:         "127.0.0.1:8080",
-				SystemType:   "otherSAPsys",
-				Description:  "description",
-				Status:       "disabled",
-				SystemNumber: "number",
-			},
-			TemplateID: "",
-		})
-
-		appSvc := automock.ApplicationService{}
-		appSvc.Mock.On("GetSccSystem", mock.Anything, testSubaccount, "loc-id", "127.0.0.1:8080").Return(&application, nil)
-		appSvc.Mock.On("Update", mock.Anything, application.ID, input).Return(errors.New("error"))
-		appSvc.Mock.On("ListBySCC", mock.Anything, labelFilter).Return([]*model.ApplicationWithLabel{&appWithLabel}, nil)
-		appSvc.Mock.On("ListSCCs", mock.Anything).Return(nil, errors.New("error"))
-		defer mock.AssertExpectationsForObjects(t, tx, listSccsTx, &transact, &appSvc, &tntSvc)
-
-		endpoint := handler.NewHandler(&appSvc, nil, nil, &tntSvc, &transact)
-
-		req := createReportSystemsRequest(strings.NewReader(body), fullReportType)
-		rec := httptest.NewRecorder()
-
-		endpoint.ServeHTTP(rec, req)
-
-		resp := rec.Result()
-		Verify(t, resp, http.StatusNoContent, httputils.ContentTypeApplicationJSON, "{}")
-	})
-
-	t.Run("failed to set label systemType", func(t *testing.T) {
-		setMappings()
-		defer clearMappings()
-
-		tx := &txautomock.PersistenceTx{}
-		tx.Mock.On("Commit").Return(nil)
-
-		listSccsTx := &txautomock.PersistenceTx{}
-		listSccsTx.Mock.On("Commit").Return(nil)
-
-		transact := txautomock.Transactioner{}
-		transact.Mock.On("Begin").Return(tx, nil).Once() // used for list tenants
-		transact.Mock.On("Begin").Return(tx, nil).Once() // used for upsertSccSystems
-		transact.Mock.On("RollbackUnlessCommitted", mock.Anything, tx).Return(true).Twice()
-		transact.Mock.On("Begin").Return(listSccsTx, nil).Once() //list for mark unreachable
-		transact.Mock.On("Begin").Return(listSccsTx, nil).Once() //used in listSCCs
-		transact.Mock.On("RollbackUnlessCommitted", mock.Anything, listSccsTx).Return(true).Twice()
-
-		ids := []string{testSubaccount}
-		tntSvc := automock.TenantService{}
-		tntSvc.Mock.On("ListsByExternalIDs", mock.Anything, ids).Return([]*model.BusinessTenantMapping{{ID: "id", ExternalTenant: testSubaccount, Type: "subaccount"}}, nil)
-
-		input := nsmodel.ToAppUpdateInput(system)
-
-		appSvc := automock.ApplicationService{}
-		appSvc.Mock.On("GetSccSystem", mock.Anything, testSubaccount, "loc-id", "127.0.0.1:8080").Return(&application, nil)
-		appSvc.Mock.On("Update", mock.Anything, application.ID, input).Return(nil)
-		appSvc.Mock.On("SetLabel", mock.Anything, label).Return(errors.New("error"))
-		appSvc.Mock.On("ListBySCC", mock.Anything, labelFilter).Return([]*model.ApplicationWithLabel{&appWithLabel}, nil)
-		appSvc.Mock.On("ListSCCs", mock.Anything).Return(nil, errors.New("error"))
-		defer mock.AssertExpectationsForObjects(t, tx, listSccsTx, &transact, &appSvc, &tntSvc)
-
-		endpoint := handler.NewHandler(&appSvc, nil, nil, &tntSvc, &transact)
-
-		req := createReportSystemsRequest(strings.NewReader(body), fullReportType)
-		rec := httptest.NewRecorder()
-
-		endpoint.ServeHTTP(rec, req)
-
-		resp := rec.Result()
-		Verify(t, resp, http.StatusNoContent, httputils.ContentTypeApplicationJSON, "{}")
-	})
-
-	t.Run("failed to set label systemProtocol", func(t *testing.T) {
-		setMappings()
-		defer clearMappings()
-
-		tx := &txautomock.PersistenceTx{}
-		tx.Mock.On("Commit").Return(nil)
-
-		listSccsTx := &txautomock.PersistenceTx{}
-		listSccsTx.Mock.On("Commit").Return(nil)
-
-		transact := txautomock.Transactioner{}
-		transact.Mock.On("Begin").Return(tx, nil).Once() // used for list tenants
-		transact.Mock.On("Begin").Return(tx, nil).Once() // used for upsertSccSystems
-		transact.Mock.On("RollbackUnlessCommitted", mock.Anything, tx).Return(true).Twice()
-		transact.Mock.On("Begin").Return(listSccsTx, nil).Once() //list for mark unreachable
-		transact.Mock.On("Begin").Return(listSccsTx, nil).Once() //used in listSCCs
-		transact.Mock.On("RollbackUnlessCommitted", mock.Anything, listSccsTx).Return(true).Twice()
-
-		ids := []string{testSubaccount}
-		tntSvc := automock.TenantService{}
-		tntSvc.Mock.On("ListsByExternalIDs", mock.Anything, ids).Return([]*model.BusinessTenantMapping{{ID: "id", ExternalTenant: testSubaccount, Type: "subaccount"}}, nil)
-
-		input := nsmodel.ToAppUpdateInput(system)
-
-		appSvc := automock.ApplicationService{}
-		appSvc.Mock.On("GetSccSystem", mock.Anything, testSubaccount, "loc-id", "127.0.0.1:8080").Return(&application, nil)
-		appSvc.Mock.On("Update", mock.Anything, application.ID, input).Return(nil)
-		appSvc.Mock.On("SetLabel", mock.Anything, label).Return(nil).Once()
-		appSvc.Mock.On("SetLabel", mock.Anything, protocolLabel).Return(errors.New("error")).Once()
-		appSvc.Mock.On("ListBySCC", mock.Anything, labelFilter).Return([]*model.ApplicationWithLabel{&appWithLabel}, nil)
-		appSvc.Mock.On("ListSCCs", mock.Anything).Return(nil, errors.New("error"))
-		defer mock.AssertExpectationsForObjects(t, tx, listSccsTx, &transact, &appSvc, &tntSvc)
-
-		endpoint := handler.NewHandler(&appSvc, nil, nil, &tntSvc, &transact)
-
-		req := createReportSystemsRequest(strings.NewReader(body), fullReportType)
-		rec := httptest.NewRecorder()
-
-		endpoint.ServeHTTP(rec, req)
-
-		resp := rec.Result()
-		Verify(t, resp, http.StatusNoContent, httputils.ContentTypeApplicationJSON, "{}")
-	})
-
-	t.Run("successfully update system", func(t *testing.T) {
-		setMappings()
-		defer clearMappings()
-
-		tx := &txautomock.PersistenceTx{}
-		tx.Mock.On("Commit").Return(nil)
-
-		listSccsTx := &txautomock.PersistenceTx{}
-		listSccsTx.Mock.On("Commit").Return(nil)
-
-		transact := txautomock.Transactioner{}
-		transact.Mock.On("Begin").Return(tx, nil).Once() // used for list tenants
-		transact.Mock.On("Begin").Return(tx, nil).Once() // used for upsertSccSystems
-		transact.Mock.On("RollbackUnlessCommitted", mock.Anything, tx).Return(true).Twice()
-		transact.Mock.On("Begin").Return(listSccsTx, nil).Once() //list for mark unreachable
-		transact.Mock.On("Begin").Return(listSccsTx, nil).Once() //used in listSCCs
-		transact.Mock.On("RollbackUnlessCommitted", mock.Anything, listSccsTx).Return(true).Twice()
-
-		ids := []string{testSubaccount}
-		tntSvc := automock.TenantService{}
-		tntSvc.Mock.On("ListsByExternalIDs", mock.Anything, ids).Return([]*model.BusinessTenantMapping{{ID: "id", ExternalTenant: testSubaccount, Type: "subaccount"}}, nil)
-
-		input := nsmodel.ToAppUpdateInput(system)
-
-		appSvc := automock.ApplicationService{}
-		appSvc.Mock.On("GetSccSystem", mock.Anything, testSubaccount, "loc-id", "127.0.0.1:8080").Return(&application, nil)
-		appSvc.Mock.On("Update", mock.Anything, application.ID, input).Return(nil)
-		appSvc.Mock.On("SetLabel", mock.Anything, label).Return(nil).Once()
-		appSvc.Mock.On("SetLabel", mock.Anything, protocolLabel).Return(nil).Once()
-		appSvc.Mock.On("ListBySCC", mock.Anything, labelFilter).Return([]*model.ApplicationWithLabel{&appWithLabel}, nil)
-		appSvc.Mock.On("ListSCCs", mock.Anything).Return(nil, errors.New("error"))
-		defer mock.AssertExpectationsForObjects(t, tx, listSccsTx, &transact, &appSvc, &tntSvc)
-
-		endpoint := handler.NewHandler(&appSvc, nil, nil, &tntSvc, &transact)
-
-		req := createReportSystemsRequest(strings.NewReader(body), fullReportType)
-		rec := httptest.NewRecorder()
-
-		endpoint.ServeHTTP(rec, req)
-
-		resp := rec.Result()
-		Verify(t, resp, http.StatusNoContent, httputils.ContentTypeApplicationJSON, "{}")
-	})
-
-	t.Run("failed to list by SCC", func(t *testing.T) {
-		tx := &txautomock.PersistenceTx{}
-		tx.Mock.On("Commit").Return(nil)
-
-		listSccsTx := &txautomock.PersistenceTx{}
-
-		transact := txautomock.Transactioner{}
-		transact.Mock.On("Begin").Return(tx, nil).Once() // used for list tenants
-		transact.Mock.On("RollbackUnlessCommitted", mock.Anything, tx).Return(true).Once()
-		transact.Mock.On("Begin").Return(listSccsTx, nil).Once() //list for mark unreachable
-		transact.Mock.On("Begin").Return(listSccsTx, nil).Once() //used in listSCCs
-		transact.Mock.On("RollbackUnlessCommitted", mock.Anything, listSccsTx).Return(true).Twice()
-
-		ids := []string{testSubaccount}
-		tntSvc := automock.TenantService{}
-		tntSvc.Mock.On("ListsByExternalIDs", mock.Anything, ids).Return([]*model.BusinessTenantMapping{{ID: "id", ExternalTenant: testSubaccount, Type: "subaccount"}}, nil)
-
-		appSvc := automock.ApplicationService{}
-		appSvc.Mock.On("ListBySCC", mock.Anything, labelFilter).Return(nil, errors.New("error"))
-		appSvc.Mock.On("ListSCCs", mock.Anything).Return(nil, errors.New("error"))
-		defer mock.AssertExpectationsForObjects(t, tx, listSccsTx, &transact, &appSvc, &tntSvc)
-
-		endpoint := handler.NewHandler(&appSvc, nil, nil, &tntSvc, &transact)
-
-		req := createReportSystemsRequest(strings.NewReader(bodyWithoutExposedSystems), fullReportType)
-		rec := httptest.NewRecorder()
-
-		endpoint.ServeHTTP(rec, req)
-
-		resp := rec.Result()
-		Verify(t, resp, http.StatusNoContent, httputils.ContentTypeApplicationJSON, "{}")
-	})
-
-	t.Run("fail to mark system as unreachable", func(t *testing.T) {
-		tx := &txautomock.PersistenceTx{}
-		tx.Mock.On("Commit").Return(nil)
-
-		listSccsTx := &txautomock.PersistenceTx{}
-		listSccsTx.Mock.On("Commit").Return(nil)
-
-		transact := txautomock.Transactioner{}
-		transact.Mock.On("Begin").Return(tx, nil).Once()         // used for list tenants
-		transact.Mock.On("Begin").Return(listSccsTx, nil).Once() //list by scc
-		transact.Mock.On("Begin").Return(tx, nil).Once()         // used for mark as unreachable
-		transact.Mock.On("RollbackUnlessCommitted", mock.Anything, tx).Return(true).Twice()
-		transact.Mock.On("RollbackUnlessCommitted", mock.Anything, listSccsTx).Return(true).Twice()
-		transact.Mock.On("Begin").Return(listSccsTx, nil).Once() //used in listSCCs
-
-		ids := []string{testSubaccount}
-		tntSvc := automock.TenantService{}
-		tntSvc.Mock.On("ListsByExternalIDs", mock.Anything, ids).Return([]*model.BusinessTenantMapping{{ID: "id", ExternalTenant: testSubaccount, Type: "subaccount"}}, nil)
-
-		unreachableInput := model.ApplicationUpdateInput{SystemStatus: str.Ptr("unreachable")}
-
-		appSvc := automock.ApplicationService{}
-		appSvc.Mock.On("ListBySCC", mock.Anything, labelFilter).Return([]*model.ApplicationWithLabel{&appWithLabel}, nil)
-		appSvc.Mock.On("Update", mock.Anything, appWithLabel.App.ID, unreachableInput).Return(errors.New("error"))
-		appSvc.Mock.On("ListSCCs", mock.Anything).Return(nil, errors.New("error"))
-		defer mock.AssertExpectationsForObjects(t, tx, listSccsTx, &transact, &appSvc, &tntSvc)
-
-		endpoint := handler.NewHandler(&appSvc, nil, nil, &tntSvc, &transact)
-
-		req := createReportSystemsRequest(strings.NewReader(bodyWithoutExposedSystems), fullReportType)
-		rec := httptest.NewRecorder()
-
-		endpoint.ServeHTTP(rec, req)
-
-		resp := rec.Result()
-		Verify(t, resp, http.StatusNoContent, httputils.ContentTypeApplicationJSON, "{}")
-	})
-
-	t.Run("successfully mark system as unreachable", func(t *testing.T) {
-		tx := &txautomock.PersistenceTx{}
-		tx.Mock.On("Commit").Return(nil)
-
-		listSccsTx := &txautomock.PersistenceTx{}
-		listSccsTx.Mock.On("Commit").Return(nil)
-
-		transact := txautomock.Transactioner{}
-		transact.Mock.On("Begin").Return(tx, nil).Once()         // used for list tenants
-		transact.Mock.On("Begin").Return(listSccsTx, nil).Once() //list by scc
-		transact.Mock.On("Begin").Return(tx, nil).Once()         // used for mark as unreachable
-		transact.Mock.On("RollbackUnlessCommitted", mock.Anything, tx).Return(true).Twice()
-		transact.Mock.On("RollbackUnlessCommitted", mock.Anything, listSccsTx).Return(true).Twice()
-		transact.Mock.On("Begin").Return(listSccsTx, nil).Once() //used in listSCCs
-
-		ids := []string{testSubaccount}
-		tntSvc := automock.TenantService{}
-		tntSvc.Mock.On("ListsByExternalIDs", mock.Anything, ids).Return([]*model.BusinessTenantMapping{{ID: "id", ExternalTenant: testSubaccount, Type: "subaccount"}}, nil)
-
-		unreachableInput := model.ApplicationUpdateInput{SystemStatus: str.Ptr("unreachable")}
-
-		appSvc := automock.ApplicationService{}
-		appSvc.Mock.On("ListBySCC", mock.Anything, labelFilter).Return([]*model.ApplicationWithLabel{&appWithLabel}, nil)
-		appSvc.Mock.On("Update", mock.Anything, appWithLabel.App.ID, unreachableInput).Return(nil)
-		appSvc.Mock.On("ListSCCs", mock.Anything).Return(nil, errors.New("error"))
-		defer mock.AssertExpectationsForObjects(t, tx, listSccsTx, &transact, &appSvc, &tntSvc)
-
-		endpoint := handler.NewHandler(&appSvc, nil, nil, &tntSvc, &transact)
-
-		req := createReportSystemsRequest(strings.NewReader(bodyWithoutExposedSystems), fullReportType)
-		rec := httptest.NewRecorder()
-
-		endpoint.ServeHTTP(rec, req)
-
-		resp := rec.Result()
-		Verify(t, resp, http.StatusNoContent, httputils.ContentTypeApplicationJSON, "{}")
-	})
-
-	t.Run("successful successfully mark system as unreachable with two sccs connected to one subaccount", func(t *testing.T) {
-		body := strings.NewReader("{\n  \"type\": \"notification-service\",\n  \"value\": [\n    {\n      \"subaccount\": \"fd4f2041-fa83-48e0-b292-ff515bb776f0\",\n      \"locationId\": \"loc-id\",\n      \"exposedSystems\": []\n    },{\n      \"subaccount\": \"fd4f2041-fa83-48e0-b292-ff515bb776f0\",\n      \"locationId\": \"loc-id-2\",\n      \"exposedSystems\": []\n    }\n  ]\n}")
-
-		tx := &txautomock.PersistenceTx{}
-		tx.Mock.On("Commit").Return(nil)
-
-		listSccsTx := &txautomock.PersistenceTx{}
-		listSccsTx.Mock.On("Commit").Return(nil)
-
-		transact := txautomock.Transactioner{}
-		transact.Mock.On("Begin").Return(tx, nil).Once()         // used for list tenants
-		transact.Mock.On("Begin").Return(listSccsTx, nil).Once() //list by scc
-		transact.Mock.On("Begin").Return(listSccsTx, nil).Once() //list by scc
-		transact.Mock.On("Begin").Return(listSccsTx, nil).Once() //list by scc
-		transact.Mock.On("Begin").Return(tx, nil).Once()         // used for mark as unreachable
-		transact.Mock.On("Begin").Return(tx, nil).Once()         // used for mark as unreachable
-		transact.Mock.On("RollbackUnlessCommitted", mock.Anything, tx).Return(true).Times(3)
-		transact.Mock.On("RollbackUnlessCommitted", mock.Anything, listSccsTx).Return(true).Times(3)
-
-		ids := []string{testSubaccount, testSubaccount}
-		tntSvc := automock.TenantService{}
-		tntSvc.Mock.On("ListsByExternalIDs", mock.Anything, ids).Return([]*model.BusinessTenantMapping{{ID: "id", ExternalTenant: testSubaccount, Type: "subaccount"}}, nil)
-
-		unreachableInput := model.ApplicationUpdateInput{SystemStatus: str.Ptr("unreachable")}
-
-		appSvc := automock.ApplicationService{}
-		appSvc.Mock.On("ListBySCC", mock.Anything, labelFilter).Return([]*model.ApplicationWithLabel{&appWithLabel}, nil)
-		appSvc.Mock.On("ListBySCC", mock.Anything, labelFilter2).Return([]*model.ApplicationWithLabel{&appWithLabel2}, nil)
-		appSvc.Mock.On("Update", mock.Anything, appWithLabel.App.ID, unreachableInput).Return(nil)
-		appSvc.Mock.On("Update", mock.Anything, appWithLabel2.App.ID, unreachableInput).Return(nil)
-		appSvc.Mock.On("ListSCCs", mock.Anything).Return(nil, errors.New("error"))
-		defer mock.AssertExpectationsForObjects(t, tx, listSccsTx, &transact, &appSvc, &tntSvc)
-
-		endpoint := handler.NewHandler(&appSvc, nil, nil, &tntSvc, &transact)
-
-		req := createReportSystemsRequest(body, fullReportType)
-		rec := httptest.NewRecorder()
-
-		endpoint.ServeHTTP(rec, req)
-
-		resp := rec.Result()
-		Verify(t, resp, http.StatusNoContent, httputils.ContentTypeApplicationJSON, "{}")
-	})
-
-	t.Run("success when there no unreachable SCCs", func(t *testing.T) {
-		tx := &txautomock.PersistenceTx{}
-		tx.Mock.On("Commit").Return(nil)
-
-		listSccsTx := &txautomock.PersistenceTx{}
-		listSccsTx.Mock.On("Commit").Return(nil)
-
-		transact := txautomock.Transactioner{}
-		transact.Mock.On("Begin").Return(tx, nil).Once()         // used for list tenants
-		transact.Mock.On("Begin").Return(listSccsTx, nil).Once() //list for mark unreachable
-		transact.Mock.On("Begin").Return(listSccsTx, nil).Once() //used in listSCCs
-		transact.Mock.On("RollbackUnlessCommitted", mock.Anything, tx).Return(true).Once()
-		transact.Mock.On("RollbackUnlessCommitted", mock.Anything, listSccsTx).Return(true).Twice()
-
-		ids := []string{testSubaccount}
-		tntSvc := automock.TenantService{}
-		tntSvc.Mock.On("ListsByExternalIDs", mock.Anything, ids).Return([]*model.BusinessTenantMapping{{ID: "id", ExternalTenant: testSubaccount, Type: "subaccount"}}, nil)
-
-		appSvc := automock.ApplicationService{}
-		appSvc.Mock.On("ListBySCC", mock.Anything, labelFilter).Return(nil, errors.New("error"))
-		appSvc.Mock.On("ListSCCs", mock.Anything).Return([]*model.SccMetadata{&model.SccMetadata{
-			Subaccount: testSubaccount,
-			LocationID: "loc-id",
-		}}, nil)
-		defer mock.AssertExpectationsForObjects(t, tx, listSccsTx, &transact, &appSvc, &tntSvc)
-
-		endpoint := handler.NewHandler(&appSvc, nil, nil, &tntSvc, &transact)
-
-		req := createReportSystemsRequest(strings.NewReader(bodyWithoutExposedSystems), fullReportType)
-		rec := httptest.NewRecorder()
-
-		endpoint.ServeHTTP(rec, req)
-
-		resp := rec.Result()
-		Verify(t, resp, http.StatusNoContent, httputils.ContentTypeApplicationJSON, "{}")
-	})
-
-	t.Run("success when there no unreachable SCCs", func(t *testing.T) {
-		tx := &txautomock.PersistenceTx{}
-		tx.Mock.On("Commit").Return(nil)
-
-		listSccsTx := &txautomock.PersistenceTx{}
-		listSccsTx.Mock.On("Commit").Return(nil)
-
-		transact := txautomock.Transactioner{}
-		transact.Mock.On("Begin").Return(tx, nil).Once()                                     //used for list tenants
-		transact.Mock.On("Begin").Return(tx, nil).Once()                                     //used for getting template
-		transact.Mock.On("Begin").Return(tx, nil).Once()                                     //list for mark unreachable
-		transact.Mock.On("Begin").Return(listSccsTx, nil).Once()                             //used in listSCCs
-		transact.Mock.On("Begin").Return(tx, nil).Once()                                     //used in listAppsBySCC
-		transact.Mock.On("Begin").Return(tx, nil).Once()                                     //used in markAsUnreachable for unknown SCC
-		transact.Mock.On("RollbackUnlessCommitted", mock.Anything, tx).Return(true).Times(5) //used in markAsUnreachable for unknown SCC
-		transact.Mock.On("RollbackUnlessCommitted", mock.Anything, listSccsTx).Return(true).Once()
-
-		ids := []string{testSubaccount}
-		tntSvc := automock.TenantService{}
-		tntSvc.Mock.On("ListsByExternalIDs", mock.Anything, ids).Return([]*model.BusinessTenantMapping{{ID: "id", ExternalTenant: testSubaccount, Type: "subaccount"}}, nil).Once()
-		ids = []string{"marked-as-unreachable"}
-		tntSvc.Mock.On("ListsByExternalIDs", mock.Anything, ids).Return([]*model.BusinessTenantMapping{{ID: "id", ExternalTenant: "marked-as-unreachable", Type: "subaccount"}}, nil).Once()
-
-		unreachableInput := model.ApplicationUpdateInput{SystemStatus: str.Ptr("unreachable")}
-
-		labelFilter2 := labelfilter.NewForKeyWithQuery("scc", fmt.Sprintf("{\"LocationID\":\"%s\", \"Subaccount\":\"%s\"}", "other-loc-id", "marked-as-unreachable"))
-
-		appSvc := automock.ApplicationService{}
-		appSvc.Mock.On("ListBySCC", mock.Anything, labelFilter).Return(nil, errors.New("error")).Once()
-		appSvc.Mock.On("ListSCCs", mock.Anything).Return([]*model.SccMetadata{
-			{
-				Subaccount: testSubaccount,
-				LocationID: "loc-id",
-			},
-			{
-				Subaccount: "marked-as-unreachable",
-				LocationID: "other-loc-id",
-			},
-		}, nil)
-		appSvc.Mock.On("ListBySCC", mock.Anything, labelFilter2).Return([]*model.ApplicationWithLabel{&appWithLabel}, nil).Once()
-		appSvc.Mock.On("Update", mock.Anything, appWithLabel.App.ID, unreachableInput).Return(nil)
-		defer mock.AssertExpectationsForObjects(t, tx, listSccsTx, &transact, &appSvc, &tntSvc)
-
-		endpoint := handler.NewHandler(&appSvc, nil, nil, &tntSvc, &transact)
-
-		req := createReportSystemsRequest(strings.NewReader(bodyWithoutExposedSystems), fullReportType)
-		rec := httptest.NewRecorder()
-
-		endpoint.ServeHTTP(rec, req)
-
-		resp := rec.Result()
-		Verify(t, resp, http.StatusNoContent, httputils.ContentTypeApplicationJSON, "{}")
-	})
+func fixErrorResponse(code int, message string) httputil.ErrorResponse {
+	return httputil.ErrorResponse{
+		Error: httputil.Error{
+			Code:    code,
+			Message: message,
+		},
+	}
 }
 
 func Verify(t *testing.T, resp *http.Response, expectedStatusCode int, expectedContentType string, expectedBody string) {
@@ -0,0 +1,559 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
+	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/nsadapter/httputil"
+	"github.com/kyma-incubator/compass/components/director/internal/nsadapter/nsmodel"
+	operationsmanager "github.com/kyma-incubator/compass/components/director/internal/operations_manager"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+	"github.com/pkg/errors"
+)
+
+//go:generate mockery --exported --name=applicationService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type applicationService interface {
+	CreateFromTemplate(ctx context.Context, in model.ApplicationRegisterInput, appTemplateID *string) (string, error)
+	Upsert(ctx context.Context, in model.ApplicationRegisterInput) error
+	Update(ctx context.Context, id string, in model.ApplicationUpdateInput) error
+	GetSccSystem(ctx context.Context, sccSubaccount, locationID, virtualHost string) (*model.Application, error)
+	ListBySCC(ctx context.Context, filter *labelfilter.LabelFilter) ([]*model.ApplicationWithLabel, error)
+	SetLabel(ctx context.Context, label *model.LabelInput) error
+	GetLabel(ctx context.Context, applicationID string, key string) (*model.Label, error)
+	ListSCCs(ctx context.Context) ([]*model.SccMetadata, error)
+}
+
+//go:generate mockery --exported --name=applicationConverter --output=automock --outpkg=automock --case=underscore --disable-version-string
+type applicationConverter interface {
+	CreateInputJSONToModel(ctx context.Context, in string) (model.ApplicationRegisterInput, error)
+}
+
+//go:generate mockery --exported --name=applicationTemplateService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type applicationTemplateService interface {
+	Get(ctx context.Context, id string) (*model.ApplicationTemplate, error)
+	PrepareApplicationCreateInputJSON(appTemplate *model.ApplicationTemplate, values model.ApplicationFromTemplateInputValues) (string, error)
+}
+
+//go:generate mockery --exported --name=tenantService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type tenantService interface {
+	ListsByExternalIDs(ctx context.Context, ids []string) ([]*model.BusinessTenantMapping, error)
+}
+
+// NewReportProcessor returns new processor of the reports scheduled by the ns-adapter handler
+func NewReportProcessor(appSvc applicationService, appConverter applicationConverter, appTemplateSvc applicationTemplateService, tntSvc tenantService, opSvc operationService, transact persistence.Transactioner) *ReportProcessor {
+	return &ReportProcessor{appSvc: appSvc, appConverter: appConverter, appTemplateSvc: appTemplateSvc, tntSvc: tntSvc, opSvc: opSvc, transact: transact}
+}
+
+// ReportProcessor executes the operations created for the reports accepted by the Handler
+type ReportProcessor struct {
+	appSvc         applicationService
+	appConverter   applicationConverter
+	appTemplateSvc applicationTemplateService
+	tntSvc         tenantService
+	opSvc          operationService
+	transact       persistence.Transactioner
+}
+
+// Process creates new application for every exposed system of the report for which CMP isn't aware of, and updates the metadata for the ones it is.
+// The systems of each SCC are processed in a separate transaction.
+// - In case of full report: If there is SCC which was not reported, all exposed systems of this SCC are marked as unreachable.
+// - In case of delta report: If there are missing exposed systems for a particular SCC, these systems are marked as unreachable.
+// The SCCs which could not be processed are stored as details in the operation data.
+func (p *ReportProcessor) Process(ctx context.Context, operation *model.Operation) error {
+	if operation.OpType != operationsmanager.NsAdapterReportOpType {
+		return errors.Errorf("unsupported operation type %q", operation.OpType)
+	}
+
+	var job nsmodel.ReportJob
+	if err := json.Unmarshal(operation.Data, &job); err != nil {
+		return errors.Wrapf(err, "while unmarshalling data of operation with id %q", operation.ID)
+	}
+
+	log.C(ctx).Infof("Starting processing of %q report with id %q", job.ReportType, operation.ID)
+	details, err := p.processReport(ctx, job)
+	if err != nil {
+		return err
+	}
+
+	job.Details = details
+	data, err := json.Marshal(job)
+	if err != nil {
+		return errors.Wrapf(err, "while marshalling data of operation with id %q", operation.ID)
+	}
+
+	return p.storeResult(ctx, operation, data)
+}
+
+func (p *ReportProcessor) processReport(ctx context.Context, job nsmodel.ReportJob) ([]httputil.Detail, error) {
+	sccs := make([]*nsmodel.SCC, 0, len(job.Report.Value))
+	externalIDs := make([]string, 0, len(job.Report.Value))
+	for _, scc := range job.Report.Value {
+		// New object with the same data is created and added to the sccs slice instead of adding &scc to the slice
+		// because otherwise the slice is populated with copies of the last scc`s address
+		s := &nsmodel.SCC{
+			ExternalSubaccountID: scc.ExternalSubaccountID,
+			InternalSubaccountID: scc.InternalSubaccountID,
+			LocationID:           scc.LocationID,
+			ExposedSystems:       scc.ExposedSystems,
+		}
+		externalIDs = append(externalIDs, scc.ExternalSubaccountID)
+		sccs = append(sccs, s)
+	}
+
+	tenants, err := p.listTenantsByExternalIDs(ctx, externalIDs)
+	if err != nil {
+		return nil, errors.Wrap(err, "while listing subaccounts")
+	}
+	mapExternalToInternal(ctx, tenants, sccs)
+	details := make([]httputil.Detail, 0)
+	filteredSccs := filterSccsByInternalID(ctx, sccs, &details)
+
+	p.processDelta(ctx, filteredSccs, &details)
+	if job.ReportType == fullReportType {
+		p.handleUnreachableScc(ctx, job.Report)
+	}
+
+	return details, nil
+}
+
+func (p *ReportProcessor) storeResult(ctx context.Context, operation *model.Operation, data json.RawMessage) error {
+	tx, err := p.transact.Begin()
+	if err != nil {
+		return errors.Wrap(err, "while opening transaction")
+	}
+	defer p.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctxWithTransaction := persistence.SaveToContext(ctx, tx)
+	if err := p.opSvc.SetData(ctxWithTransaction, operation.ID, operation.ClaimToken, data); err != nil {
+		return errors.Wrapf(err, "while storing the result of operation with id %q", operation.ID)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return errors.Wrap(err, "while committing transaction")
+	}
+
+	return nil
+}
+
+func (p *ReportProcessor) listTenantsByExternalIDs(ctx context.Context, ids []string) ([]*model.BusinessTenantMapping, error) {
+	if len(ids) == 0 {
+		return make([]*model.BusinessTenantMapping, 0), nil
+	}
+
+	tx, err := p.transact.Begin()
+	if err != nil {
+		log.C(ctx).Warn(errors.Wrapf(err, "while openning transaction"))
+		return nil, err
+	}
+	defer p.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctxWithTransaction := persistence.SaveToContext(ctx, tx)
+	tenants, err := p.tntSvc.ListsByExternalIDs(ctxWithTransaction, ids)
+	if err != nil {
+		log.C(ctx).Warn(errors.Wrapf(err, "while listing tenants by external ids"))
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		log.C(ctx).Warn(errors.Wrapf(err, "while committing transaction"))
+		return nil, err
+	}
+
+	return tenants, nil
+}
+
+func (p *ReportProcessor) listSCCs(ctx context.Context) ([]*model.SccMetadata, error) {
+	tx, err := p.transact.Begin()
+	if err != nil {
+		log.C(ctx).Warn(errors.Wrapf(err, "while openning transaction"))
+		return nil, err
+	}
+	defer p.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctxWithTransaction := persistence.SaveToContext(ctx, tx)
+	sccs, err := p.appSvc.ListSCCs(ctxWithTransaction)
+	if err != nil {
+		log.C(ctx).Warn(errors.Wrapf(err, "while listing all sccs"))
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		log.C(ctx).Warn(errors.Wrapf(err, "while committing transaction"))
+		return nil, err
+	}
+
+	return sccs, nil
+}
+
+func (p *ReportProcessor) handleUnreachableScc(ctx context.Context, report nsmodel.Report) {
+	sccs, err := p.listSCCs(ctx)
+	if err != nil {
+		log.C(ctx).Warn(errors.Wrapf(err, "while listing sccs"))
+		return
+	}
+
+	if len(sccs) == len(report.Value) {
+		return
+	}
+
+	sccsFromNs := make([]*model.SccMetadata, 0, len(report.Value))
+	for _, scc := range report.Value {
+		sccsFromNs = append(sccsFromNs, &model.SccMetadata{
+			Subaccount: scc.ExternalSubaccountID,
+			LocationID: scc.LocationID,
+		})
+	}
+
+	sccsToMarkAsUnreachable := difference(sccs, sccsFromNs)
+
+	externalSubaccounts := make([]string, 0, len(sccsToMarkAsUnreachable))
+	for _, scc := range sccsToMarkAsUnreachable {
+		externalSubaccounts = append(externalSubaccounts, scc.Subaccount)
+	}
+
+	internalSubaccounts, err := p.listTenantsByExternalIDs(ctx, externalSubaccounts)
+	if err != nil {
+		log.C(ctx).Warn(errors.Wrapf(err, "while listing subaccounts"))
+		return
+	}
+
+	externalToInternalSub := make(map[string]string, len(internalSubaccounts))
+	for _, subaccount := range internalSubaccounts {
+		externalToInternalSub[subaccount.ExternalTenant] = subaccount.ID
+	}
+
+	for _, scc := range sccsToMarkAsUnreachable {
+		scc.InternalSubaccountID = externalToInternalSub[scc.Subaccount]
+	}
+
+	for _, scc := range sccsToMarkAsUnreachable {
+		ctxWithSubaccount := tenant.SaveToContext(ctx, scc.InternalSubaccountID, scc.Subaccount)
+		if err := p.markSccAsUnreachable(ctxWithSubaccount, scc); err != nil {
+			log.C(ctx).Warn(errors.Wrapf(err, "while marking systems of scc with subaccount %s and location id %s as unreachable", scc.Subaccount, scc.LocationID))
+		}
+	}
+}
+
+func (p *ReportProcessor) markSccAsUnreachable(ctx context.Context, scc *model.SccMetadata) error {
+	tx, err := p.transact.Begin()
+	if err != nil {
+		return errors.Wrap(err, "while opening transaction")
+	}
+	defer p.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctxWithTransaction := persistence.SaveToContext(ctx, tx)
+	appsWithLabels, err := p.listAppsByScc(ctxWithTransaction, scc.Subaccount, scc.LocationID)
+	if err != nil {
+		return err
+	}
+
+	for _, appWithLabels := range appsWithLabels {
+		if err := p.markSystemAsUnreachable(ctxWithTransaction, appWithLabels.App); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (p *ReportProcessor) processDelta(ctx context.Context, sccs []*nsmodel.SCC, details *[]httputil.Detail) {
+	for _, scc := range sccs {
+		ctxWithTenant := tenant.SaveToContext(ctx, scc.InternalSubaccountID, scc.ExternalSubaccountID)
+		if err := p.handleSccSystems(ctxWithTenant, *scc); err != nil {
+			log.C(ctx).Warn(errors.Wrapf(err, "while processing scc with subaccount %s and location id %s", scc.ExternalSubaccountID, scc.LocationID))
+			addErrorDetailsMsg(details, scc, "Creation failed")
+		}
+	}
+}
+
+func (p *ReportProcessor) handleSccSystems(ctx context.Context, scc nsmodel.SCC) error {
+	tx, err := p.transact.Begin()
+	if err != nil {
+		return errors.Wrap(err, "while opening transaction")
+	}
+	defer p.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctxWithTransaction := persistence.SaveToContext(ctx, tx)
+	if err := p.upsertSccSystems(ctxWithTransaction, scc); err != nil {
+		return err
+	}
+
+	if err := p.markAsUnreachable(ctxWithTransaction, scc); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return errors.Wrap(err, "while committing transaction")
+	}
+
+	return nil
+}
+
+func (p *ReportProcessor) upsertSccSystems(ctx context.Context, scc nsmodel.SCC) error {
+	for _, system := range scc.ExposedSystems {
+		if len(system.TemplateID) == 0 {
+			log.C(ctx).Infof("Skipping processing of system with unsupported type %s", system.SystemType)
+			continue
+		}
+
+		if system.SystemNumber != "" {
+			if err := p.upsertWithSystemNumber(ctx, scc, system); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := p.upsert(ctx, scc, system); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *ReportProcessor) prepareAppInput(ctx context.Context, scc nsmodel.SCC, system nsmodel.System) (*model.ApplicationRegisterInput, error) {
+	template, err := p.appTemplateSvc.Get(ctx, system.TemplateID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while getting application template with id: %s", system.TemplateID)
+	}
+
+	values := model.ApplicationFromTemplateInputValues{
+		{
+			Placeholder: "name",
+			Value:       "on-premise-system" + uuid.New().String(),
+		},
+		{
+			Placeholder: "description",
+			Value:       system.Description,
+		},
+		{
+			Placeholder: "subaccount",
+			Value:       scc.ExternalSubaccountID,
+		},
+		{
+			Placeholder: "location-id",
+			Value:       scc.LocationID,
+		},
+		{
+			Placeholder: "system-type",
+			Value:       system.SystemType,
+		},
+		{
+			Placeholder: "host",
+			Value:       system.Host,
+		},
+		{
+			Placeholder: "protocol",
+			Value:       system.Protocol,
+		},
+		{
+			Placeholder: "system-number",
+			Value:       system.SystemNumber,
+		},
+		{
+			Placeholder: "system-status",
+			Value:       system.Status,
+		},
+	}
+
+	appInputJSON, err := p.appTemplateSvc.PrepareApplicationCreateInputJSON(template, values)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while preparing application create input from template with id:%s", system.TemplateID)
+	}
+
+	appInput, err := p.appConverter.CreateInputJSONToModel(ctx, appInputJSON)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while preparing application create input from json")
+	}
+
+	if appInput.SystemNumber != nil && *appInput.SystemNumber == "" {
+		appInput.SystemNumber = nil
+	}
+
+	return &appInput, nil
+}
+
+func (p *ReportProcessor) upsertWithSystemNumber(ctx context.Context, scc nsmodel.SCC, system nsmodel.System) error {
+	appInput, err := p.prepareAppInput(ctx, scc, system)
+	if err != nil {
+		return errors.Wrapf(err, "while upserting Application")
+	}
+
+	if err := p.appSvc.Upsert(ctx, *appInput); err != nil {
+		return errors.Wrapf(err, "while upserting Application")
+	}
+
+	return nil
+}
+
+func (p *ReportProcessor) upsert(ctx context.Context, scc nsmodel.SCC, system nsmodel.System) error {
+	app, err := p.appSvc.GetSccSystem(ctx, scc.ExternalSubaccountID, scc.LocationID, system.Host)
+
+	if err != nil && isNotFoundError(err) {
+		return p.createAppFromTemplate(ctx, scc, system)
+	}
+
+	if err != nil {
+		return errors.Wrapf(err, "while getting Application")
+	}
+
+	return p.updateSystem(ctx, system, app)
+}
+
+func (p *ReportProcessor) createAppFromTemplate(ctx context.Context, scc nsmodel.SCC, system nsmodel.System) error {
+	appInput, err := p.prepareAppInput(ctx, scc, system)
+	if err != nil {
+		return errors.Wrapf(err, "while creating Application")
+	}
+
+	if _, err := p.appSvc.CreateFromTemplate(ctx, *appInput, str.Ptr(system.TemplateID)); err != nil {
+		return errors.Wrapf(err, "while creating Application")
+	}
+	return nil
+}
+
+func (p *ReportProcessor) updateSystem(ctx context.Context, system nsmodel.System, app *model.Application) error {
+	if err := p.appSvc.Update(ctx, app.ID, nsmodel.ToAppUpdateInput(system)); err != nil {
+		return errors.Wrapf(err, "while updating Application with id %s", app.ID)
+	}
+
+	if err := p.appSvc.SetLabel(ctx, &model.LabelInput{
+		Key:        "systemType",
+		Value:      system.SystemType,
+		ObjectID:   app.ID,
+		ObjectType: model.ApplicationLabelableObject,
+	}); err != nil {
+		return errors.Wrapf(err, "while setting 'systemType' label for Application with id %s", app.ID)
+	}
+
+	if err := p.appSvc.SetLabel(ctx, &model.LabelInput{
+		Key:        "systemProtocol",
+		Value:      system.Protocol,
+		ObjectID:   app.ID,
+		ObjectType: model.ApplicationLabelableObject,
+	}); err != nil {
+		return errors.Wrapf(err, "while setting 'systemProtocol' label for Application with id %s", app.ID)
+	}
+
+	return nil
+}
+
+func (p *ReportProcessor) markAsUnreachable(ctx context.Context, scc nsmodel.SCC) error {
+	apps, err := p.listAppsByScc(ctx, scc.ExternalSubaccountID, scc.LocationID)
+	if err != nil {
+		return err
+	}
+
+	unreachable := filterUnreachable(apps, scc.ExposedSystems)
+	for _, system := range unreachable {
+		if err := p.markSystemAsUnreachable(ctx, system); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *ReportProcessor) markSystemAsUnreachable(ctx context.Context, system *model.Application) error {
+	if err := p.appSvc.Update(ctx, system.ID, model.ApplicationUpdateInput{SystemStatus: str.Ptr("unreachable")}); err != nil {
+		return errors.Wrapf(err, "while marking application with id %s as unreachable", system.ID)
+	}
+
+	return nil
+}
+
+func (p *ReportProcessor) listAppsByScc(ctx context.Context, subaccount, locationID string) ([]*model.ApplicationWithLabel, error) {
+	apps, err := p.appSvc.ListBySCC(ctx, labelfilter.NewForKeyWithQuery("scc", fmt.Sprintf("{\"LocationID\":\"%s\", \"Subaccount\":\"%s\"}", locationID, subaccount)))
+	if err != nil {
+		return nil, errors.Wrapf(err, "while listing all applications for scc with subaccount %s and location id %s", subaccount, locationID)
+	}
+
+	return apps, nil
+}
+
+func filterUnreachable(apps []*model.ApplicationWithLabel, systems []nsmodel.System) []*model.Application {
+	hostToSystem := make(map[string]interface{}, len(systems))
+
+	for _, s := range systems {
+		hostToSystem[s.Host] = struct{}{}
+	}
+	unreachable := make([]*model.Application, 0, len(apps))
+
+	for _, a := range apps {
+		result := a.SccLabel.Value.(map[string]interface{})["Host"]
+		_, ok := hostToSystem[result.(string)]
+		if !ok {
+			unreachable = append(unreachable, a.App)
+		}
+	}
+	return unreachable
+}
+
+func difference(a, b []*model.SccMetadata) (diff []*model.SccMetadata) {
+	m := make(map[model.SccMetadata]bool)
+
+	for _, item := range b {
+		m[*item] = true
+	}
+
+	for _, item := range a {
+		if _, ok := m[*item]; !ok {
+			diff = append(diff, item)
+		}
+	}
+	return
+}
+
+func addErrorDetailsMsg(details *[]httputil.Detail, scc *nsmodel.SCC, message string) {
+	*details = append(*details, httputil.Detail{
+		Message:    message,
+		Subaccount: scc.ExternalSubaccountID,
+		LocationID: scc.LocationID,
+	})
+}
+
+func mapExternalToInternal(ctx context.Context, tenants []*model.BusinessTenantMapping, sccs []*nsmodel.SCC) {
+	externalToInternalTenants := make(map[string]*model.BusinessTenantMapping, len(tenants))
+	for _, t := range tenants {
+		externalToInternalTenants[t.ExternalTenant] = t
+	}
+
+	for _, scc := range sccs {
+		t, exist := externalToInternalTenants[scc.ExternalSubaccountID]
+		if !exist {
+			continue
+		}
+		if t.Type == "subaccount" {
+			scc.InternalSubaccountID = t.ID
+		} else {
+			log.C(ctx).Warnf("Got tenant with id: %s which is not a subaccount", t.ID)
+			scc.InternalSubaccountID = notSubaccountMarker
+		}
+	}
+}
+
+func isNotFoundError(err error) bool {
+	return strings.Contains(err.Error(), "Object not found")
+}
+
+func filterSccsByInternalID(ctx context.Context, sccs []*nsmodel.SCC, details *[]httputil.Detail) []*nsmodel.SCC {
+	filteredSccs := make([]*nsmodel.SCC, 0, len(sccs))
+	for _, scc := range sccs {
+		if scc.InternalSubaccountID == "" {
+			log.C(ctx).Warnf("Got SCC with external subaccount id: %s which has not associated internal tenant id", scc.ExternalSubaccountID)
+			addErrorDetailsMsg(details, scc, "Subaccount not found")
+		} else if scc.InternalSubaccountID == notSubaccountMarker {
+			addErrorDetailsMsg(details, scc, "Provided id is not subaccount")
+		} else {
+			filteredSccs = append(filteredSccs, scc)
+		}
+	}
+	return filteredSccs
+}
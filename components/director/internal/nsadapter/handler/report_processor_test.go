@@ -0,0 +1,650 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/nsadapter/handler"
+	"github.com/kyma-incubator/compass/components/director/internal/nsadapter/handler/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/nsadapter/httputil"
+	"github.com/kyma-incubator/compass/components/director/internal/nsadapter/nsmodel"
+	persistenceautomock "github.com/kyma-incubator/compass/components/director/pkg/persistence/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	reportBody = "{" +
+		"\"type\": \"notification-service\"," +
+		"\"value\": [{" +
+		"	\"subaccount\": \"fd4f2041-fa83-48e0-b292-ff515bb776f0\"," +
+		"	\"locationId\": \"loc-id\"," +
+		"	\"exposedSystems\": [{" +
+		"		\"protocol\": \"HTTP\"," +
+		"		\"host\": \"127.0.0.1:8080\"," +
+		"		\"type\": \"otherSAPsys\"," +
+		"		\"status\": \"disabled\"," +
+		"		\"description\": \"description\"" +
+		"	}]" +
+		"}]}"
+	reportBodyWithSystemNumber = "{" +
+		"\"type\": \"notification-service\"," +
+		"\"value\": [{" +
+		"	\"subaccount\": \"fd4f2041-fa83-48e0-b292-ff515bb776f0\"," +
+		"	\"locationId\": \"loc-id\"," +
+		"	\"exposedSystems\": [{" +
+		"		\"protocol\": \"HTTP\"," +
+		"		\"host\": \"127.0.0.1:8080\"," +
+		"		\"type\": \"otherSAPsys\"," +
+		"		\"status\": \"disabled\"," +
+		"		\"description\": \"description\"," +
+		"		\"systemNumber\": \"number\"" +
+		"	}]" +
+		"}]}"
+	reportBodyWithoutExposedSystems = "{" +
+		"\"type\": \"notification-service\"," +
+		"\"value\": [{" +
+		"	\"subaccount\": \"fd4f2041-fa83-48e0-b292-ff515bb776f0\"," +
+		"	\"locationId\": \"loc-id\"," +
+		"	\"exposedSystems\": []" +
+		"}]}"
+	reportBodyWithTwoSCCs = "{" +
+		"\"type\": \"notification-service\"," +
+		"\"value\": [{" +
+		"	\"subaccount\": \"fd4f2041-fa83-48e0-b292-ff515bb776f0\"," +
+		"	\"locationId\": \"loc-id\"," +
+		"	\"exposedSystems\": []" +
+		"},{" +
+		"	\"subaccount\": \"fd4f2041-fa83-48e0-b292-ff515bb776f0\"," +
+		"	\"locationId\": \"loc-id-2\"," +
+		"	\"exposedSystems\": []" +
+		"}]}"
+	emptyReportBody = "{\"type\": \"notification-service\", \"value\": []}"
+)
+
+func TestReportProcessor_Process(t *testing.T) {
+	testErr := errors.New("test error")
+	notFoundErr := errors.New("Object not found")
+
+	appWithLabel := &model.ApplicationWithLabel{
+		App: &model.Application{
+			BaseEntity: &model.BaseEntity{ID: "id"},
+		},
+		SccLabel: &model.Label{
+			Value: map[string]interface{}{"LocationID": "loc-id", "Host": "127.0.0.1:8080"},
+		},
+	}
+	appWithLabel2 := &model.ApplicationWithLabel{
+		App: &model.Application{
+			BaseEntity: &model.BaseEntity{ID: "id-2"},
+		},
+		SccLabel: &model.Label{
+			Value: map[string]interface{}{"LocationID": "loc-id-2", "Host": "127.0.0.1:8080"},
+		},
+	}
+	application := &model.Application{
+		BaseEntity: &model.BaseEntity{ID: "id"},
+	}
+	system := nsmodel.System{
+		SystemBase: nsmodel.SystemBase{
+			Protocol:    "HTTP",
+			Host:        "127.0.0.1:8080",
+			SystemType:  "otherSAPsys",
+			Description: "description",
+			Status:      "disabled",
+		},
+	}
+	systemTypeLabel := &model.LabelInput{
+		Key:        "systemType",
+		Value:      system.SystemType,
+		ObjectID:   application.ID,
+		ObjectType: model.ApplicationLabelableObject,
+	}
+	systemProtocolLabel := &model.LabelInput{
+		Key:        "systemProtocol",
+		Value:      system.Protocol,
+		ObjectID:   application.ID,
+		ObjectType: model.ApplicationLabelableObject,
+	}
+	unreachableInput := model.ApplicationUpdateInput{SystemStatus: str.Ptr("unreachable")}
+
+	labelFilter := labelfilter.NewForKeyWithQuery("scc", fmt.Sprintf("{\"LocationID\":\"%s\", \"Subaccount\":\"%s\"}", "loc-id", testSubaccount))
+	labelFilter2 := labelfilter.NewForKeyWithQuery("scc", fmt.Sprintf("{\"LocationID\":\"%s\", \"Subaccount\":\"%s\"}", "loc-id-2", testSubaccount))
+	unreachableLabelFilter := labelfilter.NewForKeyWithQuery("scc", fmt.Sprintf("{\"LocationID\":\"%s\", \"Subaccount\":\"%s\"}", "other-loc-id", "marked-as-unreachable"))
+
+	subaccounts := []*model.BusinessTenantMapping{{ID: "id", ExternalTenant: testSubaccount, Type: "subaccount"}}
+	appTemplate := &model.ApplicationTemplate{}
+	appInputJSON := "app-input-json"
+	appInput := model.ApplicationRegisterInput{}
+
+	creationFailedDetails := []httputil.Detail{
+		{
+			Message:    "Creation failed",
+			Subaccount: testSubaccount,
+			LocationID: "loc-id",
+		},
+	}
+
+	appTemplateSvcFn := func() *automock.ApplicationTemplateService {
+		appTemplateSvc := &automock.ApplicationTemplateService{}
+		appTemplateSvc.On("Get", mock.Anything, "ss").Return(appTemplate, nil).Once()
+		appTemplateSvc.On("PrepareApplicationCreateInputJSON", appTemplate, mock.Anything).Return(appInputJSON, nil).Once()
+		return appTemplateSvc
+	}
+	appConverterFn := func() *automock.ApplicationConverter {
+		appConverter := &automock.ApplicationConverter{}
+		appConverter.On("CreateInputJSONToModel", mock.Anything, appInputJSON).Return(appInput, nil).Once()
+		return appConverter
+	}
+	tntSvcFn := func() *automock.TenantService {
+		tntSvc := &automock.TenantService{}
+		tntSvc.On("ListsByExternalIDs", txtest.CtxWithDBMatcher(), []string{testSubaccount}).Return(subaccounts, nil).Once()
+		return tntSvc
+	}
+	opSvcFn := func(expectedDetails []httputil.Detail) func() *automock.OperationService {
+		return func() *automock.OperationService {
+			opSvc := &automock.OperationService{}
+			opSvc.On("SetData", txtest.CtxWithDBMatcher(), operationID, claimToken, mock.MatchedBy(hasDetails(expectedDetails))).Return(nil).Once()
+			return opSvc
+		}
+	}
+
+	testCases := []struct {
+		Name             string
+		OpType           string
+		ReportType       string
+		Body             string
+		TransactionerFn  func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		AppSvcFn         func() *automock.ApplicationService
+		AppConverterFn   func() *automock.ApplicationConverter
+		AppTemplateSvcFn func() *automock.ApplicationTemplateService
+		TntSvcFn         func() *automock.TenantService
+		OpSvcFn          func() *automock.OperationService
+		ExpectedErr      error
+	}{
+		{
+			Name:        "Error when operation type is not supported",
+			OpType:      "ORD_AGGREGATION",
+			ReportType:  deltaReportType,
+			Body:        reportBody,
+			ExpectedErr: errors.New("unsupported operation type"),
+		},
+		{
+			Name:        "Error when operation data is invalid",
+			ReportType:  deltaReportType,
+			Body:        "{",
+			ExpectedErr: errors.New("while unmarshalling data of operation"),
+		},
+		{
+			Name:       "Error when listing tenants fails",
+			ReportType: deltaReportType,
+			Body:       reportBody,
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txtest.NewTransactionContextGenerator(testErr).ThatDoesntExpectCommit()
+			},
+			TntSvcFn: func() *automock.TenantService {
+				tntSvc := &automock.TenantService{}
+				tntSvc.On("ListsByExternalIDs", txtest.CtxWithDBMatcher(), []string{testSubaccount}).Return(nil, testErr).Once()
+				return tntSvc
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name:       "Error when opening transaction for listing tenants fails",
+			ReportType: deltaReportType,
+			Body:       reportBody,
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txtest.NewTransactionContextGenerator(testErr).ThatFailsOnBegin()
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name:       "Stores details when provided id is not a subaccount",
+			ReportType: deltaReportType,
+			Body:       reportBody,
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txtest.NewTransactionContextGenerator(testErr).ThatSucceedsTwice()
+			},
+			TntSvcFn: func() *automock.TenantService {
+				tntSvc := &automock.TenantService{}
+				tntSvc.On("ListsByExternalIDs", txtest.CtxWithDBMatcher(), []string{testSubaccount}).Return([]*model.BusinessTenantMapping{{ExternalTenant: testSubaccount, Type: "customer"}}, nil).Once()
+				return tntSvc
+			},
+			OpSvcFn: opSvcFn([]httputil.Detail{
+				{
+					Message:    "Provided id is not subaccount",
+					Subaccount: testSubaccount,
+					LocationID: "loc-id",
+				},
+			}),
+		},
+		{
+			Name:       "Stores details when subaccount is not found",
+			ReportType: deltaReportType,
+			Body:       reportBody,
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txtest.NewTransactionContextGenerator(testErr).ThatSucceedsTwice()
+			},
+			TntSvcFn: func() *automock.TenantService {
+				tntSvc := &automock.TenantService{}
+				tntSvc.On("ListsByExternalIDs", txtest.CtxWithDBMatcher(), []string{testSubaccount}).Return([]*model.BusinessTenantMapping{}, nil).Once()
+				return tntSvc
+			},
+			OpSvcFn: opSvcFn([]httputil.Detail{
+				{
+					Message:    "Subaccount not found",
+					Subaccount: testSubaccount,
+					LocationID: "loc-id",
+				},
+			}),
+		},
+		{
+			Name:       "Stores details when upserting application fails",
+			ReportType: deltaReportType,
+			Body:       reportBodyWithSystemNumber,
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txtest.NewTransactionContextGenerator(testErr).ThatSucceedsMultipleTimesAndCommitsMultipleTimes(3, 2)
+			},
+			AppSvcFn: func() *automock.ApplicationService {
+				appSvc := &automock.ApplicationService{}
+				appSvc.On("Upsert", txtest.CtxWithDBMatcher(), appInput).Return(testErr).Once()
+				return appSvc
+			},
+			AppConverterFn:   appConverterFn,
+			AppTemplateSvcFn: appTemplateSvcFn,
+			TntSvcFn:         tntSvcFn,
+			OpSvcFn:          opSvcFn(creationFailedDetails),
+		},
+		{
+			Name:       "Success when application is upserted",
+			ReportType: deltaReportType,
+			Body:       reportBodyWithSystemNumber,
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txtest.NewTransactionContextGenerator(testErr).ThatSucceedsMultipleTimes(3)
+			},
+			AppSvcFn: func() *automock.ApplicationService {
+				appSvc := &automock.ApplicationService{}
+				appSvc.On("Upsert", txtest.CtxWithDBMatcher(), appInput).Return(nil).Once()
+				appSvc.On("ListBySCC", txtest.CtxWithDBMatcher(), labelFilter).Return([]*model.ApplicationWithLabel{appWithLabel}, nil).Once()
+				return appSvc
+			},
+			AppConverterFn:   appConverterFn,
+			AppTemplateSvcFn: appTemplateSvcFn,
+			TntSvcFn:         tntSvcFn,
+			OpSvcFn:          opSvcFn(nil),
+		},
+		{
+			Name:       "Stores details when getting application by subaccount, location ID and virtual host fails",
+			ReportType: deltaReportType,
+			Body:       reportBody,
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txtest.NewTransactionContextGenerator(testErr).ThatSucceedsMultipleTimesAndCommitsMultipleTimes(3, 2)
+			},
+			AppSvcFn: func() *automock.ApplicationService {
+				appSvc := &automock.ApplicationService{}
+				appSvc.On("GetSccSystem", txtest.CtxWithDBMatcher(), testSubaccount, "loc-id", "127.0.0.1:8080").Return(nil, testErr).Once()
+				return appSvc
+			},
+			TntSvcFn: tntSvcFn,
+			OpSvcFn:  opSvcFn(creationFailedDetails),
+		},
+		{
+			Name:       "Stores details when registering application from template fails",
+			ReportType: deltaReportType,
+			Body:       reportBody,
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txtest.NewTransactionContextGenerator(testErr).ThatSucceedsMultipleTimesAndCommitsMultipleTimes(3, 2)
+			},
+			AppSvcFn: func() *automock.ApplicationService {
+				appSvc := &automock.ApplicationService{}
+				appSvc.On("GetSccSystem", txtest.CtxWithDBMatcher(), testSubaccount, "loc-id", "127.0.0.1:8080").Return(nil, notFoundErr).Once()
+				appSvc.On("CreateFromTemplate", txtest.CtxWithDBMatcher(), appInput, str.Ptr("ss")).Return("", testErr).Once()
+				return appSvc
+			},
+			AppConverterFn:   appConverterFn,
+			AppTemplateSvcFn: appTemplateSvcFn,
+			TntSvcFn:         tntSvcFn,
+			OpSvcFn:          opSvcFn(creationFailedDetails),
+		},
+		{
+			Name:       "Success when application is registered from template",
+			ReportType: deltaReportType,
+			Body:       reportBody,
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txtest.NewTransactionContextGenerator(testErr).ThatSucceedsMultipleTimes(3)
+			},
+			AppSvcFn: func() *automock.ApplicationService {
+				appSvc := &automock.ApplicationService{}
+				appSvc.On("GetSccSystem", txtest.CtxWithDBMatcher(), testSubaccount, "loc-id", "127.0.0.1:8080").Return(nil, notFoundErr).Once()
+				appSvc.On("CreateFromTemplate", txtest.CtxWithDBMatcher(), appInput, str.Ptr("ss")).Return("id", nil).Once()
+				appSvc.On("ListBySCC", txtest.CtxWithDBMatcher(), labelFilter).Return([]*model.ApplicationWithLabel{appWithLabel}, nil).Once()
+				return appSvc
+			},
+			AppConverterFn:   appConverterFn,
+			AppTemplateSvcFn: appTemplateSvcFn,
+			TntSvcFn:         tntSvcFn,
+			OpSvcFn:          opSvcFn(nil),
+		},
+		{
+			Name:       "Stores details when updating application fails",
+			ReportType: deltaReportType,
+			Body:       reportBody,
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txtest.NewTransactionContextGenerator(testErr).ThatSucceedsMultipleTimesAndCommitsMultipleTimes(3, 2)
+			},
+			AppSvcFn: func() *automock.ApplicationService {
+				appSvc := &automock.ApplicationService{}
+				appSvc.On("GetSccSystem", txtest.CtxWithDBMatcher(), testSubaccount, "loc-id", "127.0.0.1:8080").Return(application, nil).Once()
+				appSvc.On("Update", txtest.CtxWithDBMatcher(), application.ID, nsmodel.ToAppUpdateInput(system)).Return(testErr).Once()
+				return appSvc
+			},
+			TntSvcFn: tntSvcFn,
+			OpSvcFn:  opSvcFn(creationFailedDetails),
+		},
+		{
+			Name:       "Stores details when setting systemType label fails",
+			ReportType: deltaReportType,
+			Body:       reportBody,
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txtest.NewTransactionContextGenerator(testErr).ThatSucceedsMultipleTimesAndCommitsMultipleTimes(3, 2)
+			},
+			AppSvcFn: func() *automock.ApplicationService {
+				appSvc := &automock.ApplicationService{}
+				appSvc.On("GetSccSystem", txtest.CtxWithDBMatcher(), testSubaccount, "loc-id", "127.0.0.1:8080").Return(application, nil).Once()
+				appSvc.On("Update", txtest.CtxWithDBMatcher(), application.ID, nsmodel.ToAppUpdateInput(system)).Return(nil).Once()
+				appSvc.On("SetLabel", txtest.CtxWithDBMatcher(), systemTypeLabel).Return(testErr).Once()
+				return appSvc
+			},
+			TntSvcFn: tntSvcFn,
+			OpSvcFn:  opSvcFn(creationFailedDetails),
+		},
+		{
+			Name:       "Stores details when setting systemProtocol label fails",
+			ReportType: deltaReportType,
+			Body:       reportBody,
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txtest.NewTransactionContextGenerator(testErr).ThatSucceedsMultipleTimesAndCommitsMultipleTimes(3, 2)
+			},
+			AppSvcFn: func() *automock.ApplicationService {
+				appSvc := &automock.ApplicationService{}
+				appSvc.On("GetSccSystem", txtest.CtxWithDBMatcher(), testSubaccount, "loc-id", "127.0.0.1:8080").Return(application, nil).Once()
+				appSvc.On("Update", txtest.CtxWithDBMatcher(), application.ID, nsmodel.ToAppUpdateInput(system)).Return(nil).Once()
+				appSvc.On("SetLabel", txtest.CtxWithDBMatcher(), systemTypeLabel).Return(nil).Once()
+				appSvc.On("SetLabel", txtest.CtxWithDBMatcher(), systemProtocolLabel).Return(testErr).Once()
+				return appSvc
+			},
+			TntSvcFn: tntSvcFn,
+			OpSvcFn:  opSvcFn(creationFailedDetails),
+		},
+		{
+			Name:       "Success when system is updated",
+			ReportType: deltaReportType,
+			Body:       reportBody,
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txtest.NewTransactionContextGenerator(testErr).ThatSucceedsMultipleTimes(3)
+			},
+			AppSvcFn: func() *automock.ApplicationService {
+				appSvc := &automock.ApplicationService{}
+				appSvc.On("GetSccSystem", txtest.CtxWithDBMatcher(), testSubaccount, "loc-id", "127.0.0.1:8080").Return(application, nil).Once()
+				appSvc.On("Update", txtest.CtxWithDBMatcher(), application.ID, nsmodel.ToAppUpdateInput(system)).Return(nil).Once()
+				appSvc.On("SetLabel", txtest.CtxWithDBMatcher(), systemTypeLabel).Return(nil).Once()
+				appSvc.On("SetLabel", txtest.CtxWithDBMatcher(), systemProtocolLabel).Return(nil).Once()
+				appSvc.On("ListBySCC", txtest.CtxWithDBMatcher(), labelFilter).Return([]*model.ApplicationWithLabel{appWithLabel}, nil).Once()
+				return appSvc
+			},
+			TntSvcFn: tntSvcFn,
+			OpSvcFn:  opSvcFn(nil),
+		},
+		{
+			Name:       "Stores details when listing applications by SCC fails",
+			ReportType: deltaReportType,
+			Body:       reportBodyWithoutExposedSystems,
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txtest.NewTransactionContextGenerator(testErr).ThatSucceedsMultipleTimesAndCommitsMultipleTimes(3, 2)
+			},
+			AppSvcFn: func() *automock.ApplicationService {
+				appSvc := &automock.ApplicationService{}
+				appSvc.On("ListBySCC", txtest.CtxWithDBMatcher(), labelFilter).Return(nil, testErr).Once()
+				return appSvc
+			},
+			TntSvcFn: tntSvcFn,
+			OpSvcFn:  opSvcFn(creationFailedDetails),
+		},
+		{
+			Name:       "Stores details when marking system as unreachable fails",
+			ReportType: deltaReportType,
+			Body:       reportBodyWithoutExposedSystems,
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txtest.NewTransactionContextGenerator(testErr).ThatSucceedsMultipleTimesAndCommitsMultipleTimes(3, 2)
+			},
+			AppSvcFn: func() *automock.ApplicationService {
+				appSvc := &automock.ApplicationService{}
+				appSvc.On("ListBySCC", txtest.CtxWithDBMatcher(), labelFilter).Return([]*model.ApplicationWithLabel{appWithLabel}, nil).Once()
+				appSvc.On("Update", txtest.CtxWithDBMatcher(), appWithLabel.App.ID, unreachableInput).Return(testErr).Once()
+				return appSvc
+			},
+			TntSvcFn: tntSvcFn,
+			OpSvcFn:  opSvcFn(creationFailedDetails),
+		},
+		{
+			Name:       "Success when system is marked as unreachable",
+			ReportType: deltaReportType,
+			Body:       reportBodyWithoutExposedSystems,
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txtest.NewTransactionContextGenerator(testErr).ThatSucceedsMultipleTimes(3)
+			},
+			AppSvcFn: func() *automock.ApplicationService {
+				appSvc := &automock.ApplicationService{}
+				appSvc.On("ListBySCC", txtest.CtxWithDBMatcher(), labelFilter).Return([]*model.ApplicationWithLabel{appWithLabel}, nil).Once()
+				appSvc.On("Update", txtest.CtxWithDBMatcher(), appWithLabel.App.ID, unreachableInput).Return(nil).Once()
+				return appSvc
+			},
+			TntSvcFn: tntSvcFn,
+			OpSvcFn:  opSvcFn(nil),
+		},
+		{
+			Name:       "Success when systems of two SCCs connected to one subaccount are marked as unreachable",
+			ReportType: deltaReportType,
+			Body:       reportBodyWithTwoSCCs,
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txtest.NewTransactionContextGenerator(testErr).ThatSucceedsMultipleTimes(4)
+			},
+			AppSvcFn: func() *automock.ApplicationService {
+				appSvc := &automock.ApplicationService{}
+				appSvc.On("ListBySCC", txtest.CtxWithDBMatcher(), labelFilter).Return([]*model.ApplicationWithLabel{appWithLabel}, nil).Once()
+				appSvc.On("ListBySCC", txtest.CtxWithDBMatcher(), labelFilter2).Return([]*model.ApplicationWithLabel{appWithLabel2}, nil).Once()
+				appSvc.On("Update", txtest.CtxWithDBMatcher(), appWithLabel.App.ID, unreachableInput).Return(nil).Once()
+				appSvc.On("Update", txtest.CtxWithDBMatcher(), appWithLabel2.App.ID, unreachableInput).Return(nil).Once()
+				return appSvc
+			},
+			TntSvcFn: func() *automock.TenantService {
+				tntSvc := &automock.TenantService{}
+				tntSvc.On("ListsByExternalIDs", txtest.CtxWithDBMatcher(), []string{testSubaccount, testSubaccount}).Return(subaccounts, nil).Once()
+				return tntSvc
+			},
+			OpSvcFn: opSvcFn(nil),
+		},
+		{
+			Name:       "Success when report is empty",
+			ReportType: deltaReportType,
+			Body:       emptyReportBody,
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txtest.NewTransactionContextGenerator(testErr).ThatSucceeds()
+			},
+			OpSvcFn: opSvcFn(nil),
+		},
+		{
+			Name:       "Error when storing the result fails",
+			ReportType: deltaReportType,
+			Body:       emptyReportBody,
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txtest.NewTransactionContextGenerator(testErr).ThatDoesntExpectCommit()
+			},
+			OpSvcFn: func() *automock.OperationService {
+				opSvc := &automock.OperationService{}
+				opSvc.On("SetData", txtest.CtxWithDBMatcher(), operationID, claimToken, mock.Anything).Return(testErr).Once()
+				return opSvc
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name:       "Error when committing the result fails",
+			ReportType: deltaReportType,
+			Body:       emptyReportBody,
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txtest.NewTransactionContextGenerator(testErr).ThatFailsOnCommit()
+			},
+			OpSvcFn:     opSvcFn(nil),
+			ExpectedErr: testErr,
+		},
+		{
+			Name:       "Success for full report when listing SCCs fails",
+			ReportType: fullReportType,
+			Body:       reportBodyWithoutExposedSystems,
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txtest.NewTransactionContextGenerator(testErr).ThatSucceedsMultipleTimesAndCommitsMultipleTimes(4, 2)
+			},
+			AppSvcFn: func() *automock.ApplicationService {
+				appSvc := &automock.ApplicationService{}
+				appSvc.On("ListBySCC", txtest.CtxWithDBMatcher(), labelFilter).Return(nil, testErr).Once()
+				appSvc.On("ListSCCs", txtest.CtxWithDBMatcher()).Return(nil, testErr).Once()
+				return appSvc
+			},
+			TntSvcFn: tntSvcFn,
+			OpSvcFn:  opSvcFn(creationFailedDetails),
+		},
+		{
+			Name:       "Success for full report when there are no unreachable SCCs",
+			ReportType: fullReportType,
+			Body:       reportBodyWithoutExposedSystems,
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txtest.NewTransactionContextGenerator(testErr).ThatSucceedsMultipleTimes(4)
+			},
+			AppSvcFn: func() *automock.ApplicationService {
+				appSvc := &automock.ApplicationService{}
+				appSvc.On("ListBySCC", txtest.CtxWithDBMatcher(), labelFilter).Return([]*model.ApplicationWithLabel{}, nil).Once()
+				appSvc.On("ListSCCs", txtest.CtxWithDBMatcher()).Return([]*model.SccMetadata{{Subaccount: testSubaccount, LocationID: "loc-id"}}, nil).Once()
+				return appSvc
+			},
+			TntSvcFn: tntSvcFn,
+			OpSvcFn:  opSvcFn(nil),
+		},
+		{
+			Name:       "Success for full report when systems of unreported SCC are marked as unreachable",
+			ReportType: fullReportType,
+			Body:       reportBodyWithoutExposedSystems,
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txtest.NewTransactionContextGenerator(testErr).ThatSucceedsMultipleTimes(6)
+			},
+			AppSvcFn: func() *automock.ApplicationService {
+				appSvc := &automock.ApplicationService{}
+				appSvc.On("ListBySCC", txtest.CtxWithDBMatcher(), labelFilter).Return([]*model.ApplicationWithLabel{}, nil).Once()
+				appSvc.On("ListSCCs", txtest.CtxWithDBMatcher()).Return([]*model.SccMetadata{
+					{
+						Subaccount: testSubaccount,
+						LocationID: "loc-id",
+					},
+					{
+						Subaccount: "marked-as-unreachable",
+						LocationID: "other-loc-id",
+					},
+				}, nil).Once()
+				appSvc.On("ListBySCC", txtest.CtxWithDBMatcher(), unreachableLabelFilter).Return([]*model.ApplicationWithLabel{appWithLabel}, nil).Once()
+				appSvc.On("Update", txtest.CtxWithDBMatcher(), appWithLabel.App.ID, unreachableInput).Return(nil).Once()
+				return appSvc
+			},
+			TntSvcFn: func() *automock.TenantService {
+				tntSvc := &automock.TenantService{}
+				tntSvc.On("ListsByExternalIDs", txtest.CtxWithDBMatcher(), []string{testSubaccount}).Return(subaccounts, nil).Once()
+				tntSvc.On("ListsByExternalIDs", txtest.CtxWithDBMatcher(), []string{"marked-as-unreachable"}).Return([]*model.BusinessTenantMapping{{ID: "id", ExternalTenant: "marked-as-unreachable", Type: "subaccount"}}, nil).Once()
+				return tntSvc
+			},
+			OpSvcFn: opSvcFn(nil),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			setMappings()
+			defer clearMappings()
+
+			persistTx := &persistenceautomock.PersistenceTx{}
+			transact := &persistenceautomock.Transactioner{}
+			if testCase.TransactionerFn != nil {
+				persistTx, transact = testCase.TransactionerFn()
+			}
+			appSvc := &automock.ApplicationService{}
+			if testCase.AppSvcFn != nil {
+				appSvc = testCase.AppSvcFn()
+			}
+			appConverter := &automock.ApplicationConverter{}
+			if testCase.AppConverterFn != nil {
+				appConverter = testCase.AppConverterFn()
+			}
+			appTemplateSvc := &automock.ApplicationTemplateService{}
+			if testCase.AppTemplateSvcFn != nil {
+				appTemplateSvc = testCase.AppTemplateSvcFn()
+			}
+			tntSvc := &automock.TenantService{}
+			if testCase.TntSvcFn != nil {
+				tntSvc = testCase.TntSvcFn()
+			}
+			opSvc := &automock.OperationService{}
+			if testCase.OpSvcFn != nil {
+				opSvc = testCase.OpSvcFn()
+			}
+			opType := testCase.OpType
+			if opType == "" {
+				opType = nsAdapterReportOpType
+			}
+
+			processor := handler.NewReportProcessor(appSvc, appConverter, appTemplateSvc, tntSvc, opSvc, transact)
+
+			// WHEN
+			err := processor.Process(context.TODO(), fixReportOperation(opType, testCase.ReportType, testCase.Body))
+
+			// THEN
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+
+			mock.AssertExpectationsForObjects(t, persistTx, transact, appSvc, appConverter, appTemplateSvc, tntSvc, opSvc)
+		})
+	}
+}
+
+func fixReportOperation(opType, reportType, body string) *model.Operation {
+	return &model.Operation{
+		ID:         operationID,
+		OpType:     opType,
+		Status:     model.OperationStatusInProgress,
+		ClaimToken: claimToken,
+		Data:       json.RawMessage(fmt.Sprintf(`{"reportType":%q,"report":%s}`, reportType, body)),
+	}
+}
+
+func hasDetails(expectedDetails []httputil.Detail) func(json.RawMessage) bool {
+	return func(data json.RawMessage) bool {
+		var job nsmodel.ReportJob
+		if err := json.Unmarshal(data, &job); err != nil {
+			return false
+		}
+
+		if len(expectedDetails) == 0 {
+			return len(job.Details) == 0
+		}
+		return reflect.DeepEqual(expectedDetails, job.Details)
+	}
+}
@@ -62,6 +62,20 @@ type Detail struct {
 	LocationID string `json:"locationId"`
 }
 
+// JobAccepted is returned when a report is accepted for asynchronous processing
+type JobAccepted struct {
+	JobID string `json:"jobID"`
+}
+
+// JobStatus describes the processing state of a report job
+type JobStatus struct {
+	JobID      string   `json:"jobID"`
+	ReportType string   `json:"reportType"`
+	Status     string   `json:"status"`
+	Error      string   `json:"error,omitempty"`
+	Details    []Detail `json:"details,omitempty"`
+}
+
 // GetTimeoutMessage returns ErrorResponse with status code 408 and message "timeout"
 func GetTimeoutMessage() []byte {
 	marshal, err := json.Marshal(ErrorResponse{Error: Error{
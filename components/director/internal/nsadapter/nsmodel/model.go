@@ -5,6 +5,7 @@ import (
 
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/nsadapter/httputil"
 	"github.com/kyma-incubator/compass/components/director/pkg/str"
 	"github.com/tidwall/gjson"
 )
@@ -128,6 +129,14 @@ func validateSCCs(value interface{}) error {
 	return nil
 }
 
+// ReportJob represents the data of an operation which processes a Notification Service report asynchronously
+type ReportJob struct {
+	ReportType  string            `json:"reportType"`
+	ContentHash string            `json:"contentHash,omitempty"`
+	Report      Report            `json:"report"`
+	Details     []httputil.Detail `json:"details,omitempty"`
+}
+
 // ToAppUpdateInput converts System to model.ApplicationUpdateInput
 func ToAppUpdateInput(system System) model.ApplicationUpdateInput {
 	return model.ApplicationUpdateInput{
@@ -16,13 +16,13 @@ type Client struct {
 	mock.Mock
 }
 
-// FetchOpenResourceDiscoveryDocuments provides a mock function with given fields: ctx, resource, webhook
-func (_m *Client) FetchOpenResourceDiscoveryDocuments(ctx context.Context, resource ord.Resource, webhook *model.Webhook) (ord.Documents, string, error) {
-	ret := _m.Called(ctx, resource, webhook)
+// FetchOpenResourceDiscoveryDocuments provides a mock function with given fields: ctx, resource, webhook, validators
+func (_m *Client) FetchOpenResourceDiscoveryDocuments(ctx context.Context, resource ord.Resource, webhook *model.Webhook, validators []*model.FetchValidator) (ord.Documents, string, []*model.FetchValidatorInput, error) {
+	ret := _m.Called(ctx, resource, webhook, validators)
 
 	var r0 ord.Documents
-	if rf, ok := ret.Get(0).(func(context.Context, ord.Resource, *model.Webhook) ord.Documents); ok {
-		r0 = rf(ctx, resource, webhook)
+	if rf, ok := ret.Get(0).(func(context.Context, ord.Resource, *model.Webhook, []*model.FetchValidator) ord.Documents); ok {
+		r0 = rf(ctx, resource, webhook, validators)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(ord.Documents)
@@ -30,20 +30,29 @@ func (_m *Client) FetchOpenResourceDiscoveryDocuments(ctx context.Context, resou
 	}
 
 	var r1 string
-	if rf, ok := ret.Get(1).(func(context.Context, ord.Resource, *model.Webhook) string); ok {
-		r1 = rf(ctx, resource, webhook)
+	if rf, ok := ret.Get(1).(func(context.Context, ord.Resource, *model.Webhook, []*model.FetchValidator) string); ok {
+		r1 = rf(ctx, resource, webhook, validators)
 	} else {
 		r1 = ret.Get(1).(string)
 	}
 
-	var r2 error
-	if rf, ok := ret.Get(2).(func(context.Context, ord.Resource, *model.Webhook) error); ok {
-		r2 = rf(ctx, resource, webhook)
+	var r2 []*model.FetchValidatorInput
+	if rf, ok := ret.Get(2).(func(context.Context, ord.Resource, *model.Webhook, []*model.FetchValidator) []*model.FetchValidatorInput); ok {
+		r2 = rf(ctx, resource, webhook, validators)
 	} else {
-		r2 = ret.Error(2)
+		if ret.Get(2) != nil {
+			r2 = ret.Get(2).([]*model.FetchValidatorInput)
+		}
+	}
+
+	var r3 error
+	if rf, ok := ret.Get(3).(func(context.Context, ord.Resource, *model.Webhook, []*model.FetchValidator) error); ok {
+		r3 = rf(ctx, resource, webhook, validators)
+	} else {
+		r3 = ret.Error(3)
 	}
 
-	return r0, r1, r2
+	return r0, r1, r2, r3
 }
 
 type mockConstructorTestingTNewClient interface {
@@ -14,6 +14,34 @@ type ORDService struct {
 	mock.Mock
 }
 
+// ProcessAppInAppTemplateContext provides a mock function with given fields: ctx, cfg, appTemplateID, appID
+func (_m *ORDService) ProcessAppInAppTemplateContext(ctx context.Context, cfg ord.MetricsConfig, appTemplateID string, appID string) error {
+	ret := _m.Called(ctx, cfg, appTemplateID, appID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, ord.MetricsConfig, string, string) error); ok {
+		r0 = rf(ctx, cfg, appTemplateID, appID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ProcessApplication provides a mock function with given fields: ctx, cfg, appID
+func (_m *ORDService) ProcessApplication(ctx context.Context, cfg ord.MetricsConfig, appID string) error {
+	ret := _m.Called(ctx, cfg, appID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, ord.MetricsConfig, string) error); ok {
+		r0 = rf(ctx, cfg, appID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // ProcessApplicationTemplates provides a mock function with given fields: ctx, cfg, appTemplateIDs
 func (_m *ORDService) ProcessApplicationTemplates(ctx context.Context, cfg ord.MetricsConfig, appTemplateIDs []string) error {
 	ret := _m.Called(ctx, cfg, appTemplateIDs)
@@ -19,8 +19,10 @@ import (
 
 	httputil "github.com/kyma-incubator/compass/components/director/pkg/http"
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/tracing"
 
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 // ClientConfig contains configuration for the ORD aggregator client
@@ -48,7 +50,7 @@ func NewClientConfig(maxParallelDocumentsPerApplication int) ClientConfig {
 //
 //go:generate mockery --name=Client --output=automock --outpkg=automock --case=underscore --disable-version-string
 type Client interface {
-	FetchOpenResourceDiscoveryDocuments(ctx context.Context, resource Resource, webhook *model.Webhook) (Documents, string, error)
+	FetchOpenResourceDiscoveryDocuments(ctx context.Context, resource Resource, webhook *model.Webhook, validators []*model.FetchValidator) (Documents, string, []*model.FetchValidatorInput, error)
 }
 
 type client struct {
@@ -66,42 +68,89 @@ func NewClient(config ClientConfig, httpClient *http.Client, accessStrategyExecu
 	}
 }
 
-// FetchOpenResourceDiscoveryDocuments fetches all the documents for a single ORD .well-known endpoint
-func (c *client) FetchOpenResourceDiscoveryDocuments(ctx context.Context, resource Resource, webhook *model.Webhook) (Documents, string, error) {
+// FetchOpenResourceDiscoveryDocuments fetches all the documents for a single ORD .well-known endpoint.
+// The provided validators from a previous aggregation are used to make conditional requests. If neither the configuration
+// nor any of the documents are modified, no documents are returned. Otherwise, all documents are returned together with their new validators.
+func (c *client) FetchOpenResourceDiscoveryDocuments(ctx context.Context, resource Resource, webhook *model.Webhook, validators []*model.FetchValidator) (Documents, string, []*model.FetchValidatorInput, error) {
+	ctx, span := tracing.StartSpan(ctx, "ord fetch documents",
+		attribute.String("ord.resource.type", string(resource.Type)),
+		attribute.String("ord.resource.id", resource.ID))
+	defer span.End()
+
+	docs, baseURL, fetchValidators, err := c.fetchOpenResourceDiscoveryDocuments(ctx, resource, webhook, validators)
+	tracing.RecordError(span, err)
+
+	return docs, baseURL, fetchValidators, err
+}
+
+func (c *client) fetchOpenResourceDiscoveryDocuments(ctx context.Context, resource Resource, webhook *model.Webhook, validators []*model.FetchValidator) (Documents, string, []*model.FetchValidatorInput, error) {
 	var tenantValue string
 
 	if needsTenantHeader := webhook.ObjectType == model.ApplicationTemplateWebhookReference && resource.Type != directorresource.ApplicationTemplate; needsTenantHeader {
 		tntFromCtx, err := tenant.LoadTenantPairFromContext(ctx)
 		if err != nil {
-			return nil, "", errors.Wrapf(err, "while loading tenant from context for application template webhook flow")
+			return nil, "", nil, errors.Wrapf(err, "while loading tenant from context for application template webhook flow")
 		}
 
 		tenantValue = tntFromCtx.ExternalID
 	}
 
-	config, err := c.fetchConfig(ctx, resource, webhook, tenantValue)
+	validatorsByURL := make(map[string]*model.FetchValidator, len(validators))
+	for _, validator := range validators {
+		validatorsByURL[validator.URL] = validator
+	}
+
+	config, configValidator, isConfigModified, err := c.fetchConfig(ctx, resource, webhook, tenantValue, validatorsByURL[*webhook.URL])
 	if err != nil {
-		return nil, "", err
+		return nil, "", nil, err
 	}
 
 	baseURL, err := calculateBaseURL(*webhook.URL, *config)
 	if err != nil {
-		return nil, "", errors.Wrap(err, "while calculating baseURL")
+		return nil, "", nil, errors.Wrap(err, "while calculating baseURL")
 	}
 
 	err = config.Validate(baseURL)
 	if err != nil {
-		return nil, "", errors.Wrap(err, "while validating ORD config")
+		return nil, "", nil, errors.Wrap(err, "while validating ORD config")
+	}
+
+	docs, docValidators, notModifiedDocs, err := c.fetchDocuments(ctx, config.OpenResourceDiscoveryV1.Documents, baseURL, tenantValue, validatorsByURL)
+	if err != nil {
+		return docs, baseURL, nil, err
+	}
+
+	if !isConfigModified && len(docs) == 0 {
+		log.C(ctx).Infof("ORD configuration and documents of %s %q (id = %q) are not modified since the last aggregation", resource.Type, resource.Name, resource.ID)
+		return nil, baseURL, nil, nil
+	}
+
+	if len(notModifiedDocs) > 0 {
+		log.C(ctx).Infof("%d ORD documents are not modified but will be fetched again since other ORD resources of %s %q (id = %q) are modified", len(notModifiedDocs), resource.Type, resource.Name, resource.ID)
+		refetchedDocs, refetchedDocValidators, _, err := c.fetchDocuments(ctx, notModifiedDocs, baseURL, tenantValue, nil)
+		docs = append(docs, refetchedDocs...)
+		docValidators = append(docValidators, refetchedDocValidators...)
+		if err != nil {
+			return docs, baseURL, nil, err
+		}
 	}
 
+	return docs, baseURL, append([]*model.FetchValidatorInput{configValidator}, docValidators...), nil
+}
+
+// fetchDocuments fetches the provided ORD documents in parallel. Conditional requests are made for the documents which have validators.
+// The details of the documents which are not modified are returned separately.
+func (c *client) fetchDocuments(ctx context.Context, docsDetails []DocumentDetails, baseURL, tenantValue string, validatorsByURL map[string]*model.FetchValidator) ([]*Document, []*model.FetchValidatorInput, []DocumentDetails, error) {
 	docs := make([]*Document, 0)
+	validators := make([]*model.FetchValidatorInput, 0)
+	notModifiedDocs := make([]DocumentDetails, 0)
 	docMutex := sync.Mutex{}
 	wg := sync.WaitGroup{}
 	workers := make(chan struct{}, c.config.maxParallelDocumentsPerApplication)
 	fetchDocErrors := make([]error, 0)
 	errMutex := sync.Mutex{}
 
-	for _, docDetails := range config.OpenResourceDiscoveryV1.Documents {
+	for _, docDetails := range docsDetails {
 		wg.Add(1)
 		workers <- struct{}{}
 		go func(docDetails DocumentDetails) {
@@ -120,19 +169,30 @@ func (c *client) FetchOpenResourceDiscoveryDocuments(ctx context.Context, resour
 			if !ok {
 				log.C(ctx).Warnf("Unsupported access strategies for ORD Document %q", documentURL)
 			}
-			doc, err := c.fetchOpenDiscoveryDocumentWithAccessStrategy(ctx, documentURL, strategy, tenantValue)
+			doc, validator, err := c.fetchOpenDiscoveryDocumentWithAccessStrategy(ctx, documentURL, strategy, tenantValue, validatorsByURL[documentURL])
 			if err != nil {
 				log.C(ctx).Warn(errors.Wrapf(err, "error fetching ORD document from: %s", documentURL).Error())
 				addError(&fetchDocErrors, err, &errMutex)
 				return
 			}
 
+			docMutex.Lock()
+			defer docMutex.Unlock()
+
+			if doc == nil {
+				notModifiedDocs = append(notModifiedDocs, docDetails)
+				return
+			}
+
 			if docDetails.Perspective == SystemVersionPerspective {
 				doc.Perspective = SystemVersionPerspective
 			} else {
 				doc.Perspective = SystemInstancePerspective
 			}
-			addDocument(&docs, doc, &docMutex)
+			docs = append(docs, doc)
+			if validator != nil {
+				validators = append(validators, validator)
+			}
 		}(docDetails)
 	}
 
@@ -143,7 +203,7 @@ func (c *client) FetchOpenResourceDiscoveryDocuments(ctx context.Context, resour
 		stringErrors := convertErrorsToStrings(fetchDocErrors)
 		fetchDocErr = errors.Errorf(strings.Join(stringErrors, "\n"))
 	}
-	return docs, baseURL, fetchDocErr
+	return docs, validators, notModifiedDocs, fetchDocErr
 }
 
 func convertErrorsToStrings(errors []error) (result []string) {
@@ -153,34 +213,58 @@ func convertErrorsToStrings(errors []error) (result []string) {
 	return result
 }
 
-func (c *client) fetchOpenDiscoveryDocumentWithAccessStrategy(ctx context.Context, documentURL string, accessStrategy accessstrategy.Type, tenantValue string) (*Document, error) {
+// fetchOpenDiscoveryDocumentWithAccessStrategy fetches a single ORD document. If a validator is provided a conditional request is made
+// and nil document is returned in case it is not modified. The returned validator is nil if the response does not contain any validators.
+func (c *client) fetchOpenDiscoveryDocumentWithAccessStrategy(ctx context.Context, documentURL string, accessStrategy accessstrategy.Type, tenantValue string, validator *model.FetchValidator) (*Document, *model.FetchValidatorInput, error) {
+	ctx, span := tracing.StartSpan(ctx, "ord fetch document", attribute.String("ord.document.url", documentURL))
+	defer span.End()
+
 	log.C(ctx).Infof("Fetching ORD Document %q with Access Strategy %q", documentURL, accessStrategy)
 	executor, err := c.accessStrategyExecutorProvider.Provide(accessStrategy)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	var conditionalHeaders http.Header
+	if validator != nil {
+		conditionalHeaders = httputil.ConditionalRequestHeaders(validator.ETag, validator.LastModified)
 	}
 
-	resp, err := executor.Execute(ctx, c.Client, documentURL, tenantValue)
+	resp, err := executor.Execute(ctx, c.Client, documentURL, tenantValue, conditionalHeaders)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	defer closeBody(ctx, resp.Body)
 
+	if resp.StatusCode == http.StatusNotModified && conditionalHeaders != nil {
+		log.C(ctx).Infof("ORD Document %q is not modified since the last aggregation", documentURL)
+		return nil, nil, nil
+	}
+
 	if resp.StatusCode != http.StatusOK {
-		return nil, errors.Errorf("error while fetching open resource discovery document %q: status code %d", documentURL, resp.StatusCode)
+		return nil, nil, errors.Errorf("error while fetching open resource discovery document %q: status code %d", documentURL, resp.StatusCode)
 	}
 
 	resp.Body = http.MaxBytesReader(nil, resp.Body, 2097152)
 	bodyBytes, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, errors.Wrap(err, "error reading document body")
+		return nil, nil, errors.Wrap(err, "error reading document body")
 	}
 	result := &Document{}
 	if err := json.Unmarshal(bodyBytes, &result); err != nil {
-		return nil, errors.Wrap(err, "error unmarshaling document")
+		return nil, nil, errors.Wrap(err, "error unmarshaling document")
+	}
+
+	var docValidator *model.FetchValidatorInput
+	if etag, lastModified := httputil.ResponseValidators(resp); etag != nil || lastModified != nil {
+		docValidator = &model.FetchValidatorInput{
+			URL:          documentURL,
+			ETag:         etag,
+			LastModified: lastModified,
+		}
 	}
-	return result, nil
+	return result, docValidator, nil
 }
 
 func closeBody(ctx context.Context, body io.ReadCloser) {
@@ -189,42 +273,44 @@ func closeBody(ctx context.Context, body io.ReadCloser) {
 	}
 }
 
-func addDocument(docs *[]*Document, doc *Document, mutex *sync.Mutex) {
-	mutex.Lock()
-	defer mutex.Unlock()
-	*docs = append(*docs, doc)
-}
-
 func addError(fetchDocErrors *[]error, err error, mutex *sync.Mutex) {
 	mutex.Lock()
 	defer mutex.Unlock()
 	*fetchDocErrors = append(*fetchDocErrors, err)
 }
 
-func (c *client) fetchConfig(ctx context.Context, resource Resource, webhook *model.Webhook, tenantValue string) (*WellKnownConfig, error) {
+// fetchConfig fetches the ORD well-known configuration. If the provided validator holds the previously fetched configuration,
+// a conditional request is made and the stored configuration is used in case it is not modified.
+// The returned validator holds the fetched configuration and the flag reports whether it differs from the previously fetched one.
+func (c *client) fetchConfig(ctx context.Context, resource Resource, webhook *model.Webhook, tenantValue string, validator *model.FetchValidator) (*WellKnownConfig, *model.FetchValidatorInput, bool, error) {
+	var conditionalHeaders http.Header
+	if validator != nil && validator.Content != nil {
+		conditionalHeaders = httputil.ConditionalRequestHeaders(validator.ETag, validator.LastModified)
+	}
+
 	var resp *http.Response
 	var err error
 	if webhook.Auth != nil && webhook.Auth.AccessStrategy != nil && len(*webhook.Auth.AccessStrategy) > 0 {
 		log.C(ctx).Infof("%s %q (id = %q) ORD webhook is configured with %q access strategy.", resource.Type, resource.Name, resource.ID, *webhook.Auth.AccessStrategy)
 		executor, err := c.accessStrategyExecutorProvider.Provide(accessstrategy.Type(*webhook.Auth.AccessStrategy))
 		if err != nil {
-			return nil, errors.Wrapf(err, "cannot find executor for access strategy %q as part of webhook processing", *webhook.Auth.AccessStrategy)
+			return nil, nil, false, errors.Wrapf(err, "cannot find executor for access strategy %q as part of webhook processing", *webhook.Auth.AccessStrategy)
 		}
-		resp, err = executor.Execute(ctx, c.Client, *webhook.URL, tenantValue)
+		resp, err = executor.Execute(ctx, c.Client, *webhook.URL, tenantValue, conditionalHeaders)
 		if err != nil {
-			return nil, errors.Wrapf(err, "error while fetching open resource discovery well-known configuration with access strategy %q", *webhook.Auth.AccessStrategy)
+			return nil, nil, false, errors.Wrapf(err, "error while fetching open resource discovery well-known configuration with access strategy %q", *webhook.Auth.AccessStrategy)
 		}
 	} else if webhook.Auth != nil {
 		log.C(ctx).Infof("%s %q (id = %q) configuration endpoint is secured and webhook credentials will be used", resource.Type, resource.Name, resource.ID)
-		resp, err = httputil.GetRequestWithCredentials(ctx, c.Client, *webhook.URL, tenantValue, webhook.Auth)
+		resp, err = httputil.GetRequestWithCredentials(ctx, c.Client, *webhook.URL, tenantValue, conditionalHeaders, webhook.Auth)
 		if err != nil {
-			return nil, errors.Wrap(err, "error while fetching open resource discovery well-known configuration with webhook credentials")
+			return nil, nil, false, errors.Wrap(err, "error while fetching open resource discovery well-known configuration with webhook credentials")
 		}
 	} else {
 		log.C(ctx).Infof("%s %q (id = %q) configuration endpoint is not secured", resource.Type, resource.Name, resource.ID)
-		resp, err = httputil.GetRequestWithoutCredentials(c.Client, *webhook.URL, tenantValue)
+		resp, err = httputil.GetRequestWithoutCredentials(c.Client, *webhook.URL, tenantValue, conditionalHeaders)
 		if err != nil {
-			return nil, errors.Wrap(err, "error while fetching open resource discovery well-known configuration")
+			return nil, nil, false, errors.Wrap(err, "error while fetching open resource discovery well-known configuration")
 		}
 	}
 
@@ -232,19 +318,30 @@ func (c *client) fetchConfig(ctx context.Context, resource Resource, webhook *mo
 
 	bodyBytes, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, errors.Wrap(err, "error reading response body")
+		return nil, nil, false, errors.Wrap(err, "error reading response body")
 	}
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, errors.Errorf("error while fetching open resource discovery well-known configuration: status code %d Body: %s", resp.StatusCode, string(bodyBytes))
+	configValidator := &model.FetchValidatorInput{URL: *webhook.URL}
+	if resp.StatusCode == http.StatusNotModified && conditionalHeaders != nil {
+		log.C(ctx).Infof("ORD well-known configuration %q is not modified since the last aggregation", *webhook.URL)
+		bodyBytes = []byte(*validator.Content)
+		configValidator.ETag, configValidator.LastModified = validator.ETag, validator.LastModified
+	} else if resp.StatusCode != http.StatusOK {
+		return nil, nil, false, errors.Errorf("error while fetching open resource discovery well-known configuration: status code %d Body: %s", resp.StatusCode, string(bodyBytes))
+	} else {
+		configValidator.ETag, configValidator.LastModified = httputil.ResponseValidators(resp)
 	}
 
 	config := WellKnownConfig{}
 	if err := json.Unmarshal(bodyBytes, &config); err != nil {
-		return nil, errors.Wrap(err, "error unmarshaling json body")
+		return nil, nil, false, errors.Wrap(err, "error unmarshaling json body")
 	}
 
-	return &config, nil
+	content := string(bodyBytes)
+	configValidator.Content = &content
+	isModified := validator == nil || validator.Content == nil || *validator.Content != content
+
+	return &config, configValidator, isModified, nil
 }
 
 func buildDocumentURL(docURL, baseURL string) (string, error) {
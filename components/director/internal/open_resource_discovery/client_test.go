@@ -18,6 +18,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/pkg/accessstrategy"
 	"github.com/kyma-incubator/compass/components/director/pkg/accessstrategy/automock"
 
+	httputil "github.com/kyma-incubator/compass/components/director/pkg/http"
 	"github.com/kyma-incubator/compass/components/director/pkg/str"
 
 	"github.com/stretchr/testify/mock"
@@ -27,7 +28,11 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
-const testAccessStrategy = "accessStrategy"
+const (
+	testAccessStrategy = "accessStrategy"
+	configETag         = "config-etag"
+	docETag            = "doc-etag"
+)
 
 type RoundTripFunc func(req *http.Request) *http.Response
 
@@ -94,9 +99,40 @@ var successfulRoundTripFunc = func(t *testing.T, bothBaseURLsProvided, noBaseURL
 	}
 }
 
+var conditionalRoundTripFunc = func(t *testing.T, notModifiedURLs ...string) func(req *http.Request) *http.Response {
+	return func(req *http.Request) *http.Response {
+		if len(req.Header.Get(httputil.IfNoneMatchHeader)) > 0 {
+			for _, url := range notModifiedURLs {
+				if req.URL.String() == url {
+					return &http.Response{
+						StatusCode: http.StatusNotModified,
+						Body:       io.NopCloser(bytes.NewBuffer(nil)),
+					}
+				}
+			}
+		}
+
+		resp := successfulRoundTripFunc(t, false, false)(req)
+		resp.Header = http.Header{}
+		if strings.Contains(req.URL.String(), ord.WellKnownEndpoint) {
+			resp.Header.Set(httputil.ETagHeader, configETag)
+		} else {
+			resp.Header.Set(httputil.ETagHeader, docETag)
+		}
+		return resp
+	}
+}
+
 func TestClient_FetchOpenResourceDiscoveryDocuments(t *testing.T) {
 	testErr := errors.New("test")
 
+	configData, err := json.Marshal(fixWellKnownConfig())
+	require.NoError(t, err)
+	configContent := string(configData)
+
+	configURL := baseURL + ord.WellKnownEndpoint
+	docURL := baseURL + ordDocURI
+
 	testCases := []struct {
 		Name                 string
 		Credentials          *model.Auth
@@ -107,6 +143,8 @@ func TestClient_FetchOpenResourceDiscoveryDocuments(t *testing.T) {
 		ExpectedBaseURL      string
 		ExpectedErr          error
 		WebhookURL           string
+		FetchValidators      []*model.FetchValidator
+		ExpectedValidators   []*model.FetchValidatorInput
 	}{
 		{
 			Name:          "Success when webhookURL contains /well-known suffix",
@@ -175,6 +213,47 @@ func TestClient_FetchOpenResourceDiscoveryDocuments(t *testing.T) {
 			},
 			ExpectedBaseURL: baseURL,
 		},
+		{
+			Name:          "Success when neither the configuration nor the documents are modified",
+			RoundTripFunc: conditionalRoundTripFunc(t, configURL, docURL),
+			FetchValidators: []*model.FetchValidator{
+				{URL: configURL, ETag: str.Ptr(configETag), Content: &configContent},
+				{URL: docURL, ETag: str.Ptr(docETag)},
+			},
+			ExpectedBaseURL: baseURL,
+		},
+		{
+			Name:          "Success when the configuration is not modified but a document is modified",
+			RoundTripFunc: conditionalRoundTripFunc(t, configURL),
+			FetchValidators: []*model.FetchValidator{
+				{URL: configURL, ETag: str.Ptr(configETag), Content: &configContent},
+				{URL: docURL, ETag: str.Ptr("old-doc-etag")},
+			},
+			ExpectedResult: ord.Documents{
+				fixORDDocument(),
+			},
+			ExpectedBaseURL: baseURL,
+			ExpectedValidators: []*model.FetchValidatorInput{
+				{URL: configURL, ETag: str.Ptr(configETag), Content: &configContent},
+				{URL: docURL, ETag: str.Ptr(docETag)},
+			},
+		},
+		{
+			Name:          "Success when the configuration is modified and the not modified documents are fetched again",
+			RoundTripFunc: conditionalRoundTripFunc(t, configURL, docURL),
+			FetchValidators: []*model.FetchValidator{
+				{URL: configURL, Content: str.Ptr("{}")},
+				{URL: docURL, ETag: str.Ptr(docETag)},
+			},
+			ExpectedResult: ord.Documents{
+				fixORDDocument(),
+			},
+			ExpectedBaseURL: baseURL,
+			ExpectedValidators: []*model.FetchValidatorInput{
+				{URL: configURL, ETag: str.Ptr(configETag), Content: &configContent},
+				{URL: docURL, ETag: str.Ptr(docETag)},
+			},
+		},
 		{
 			Name: "Well-known config success fetch with access strategy",
 			ExecutorProviderFunc: func() accessstrategy.ExecutorProvider {
@@ -182,7 +261,7 @@ func TestClient_FetchOpenResourceDiscoveryDocuments(t *testing.T) {
 				require.NoError(t, err)
 
 				executor := &automock.Executor{}
-				executor.On("Execute", context.TODO(), mock.Anything, baseURL+ord.WellKnownEndpoint, "").Return(&http.Response{
+				executor.On("Execute", mock.Anything, mock.Anything, baseURL+ord.WellKnownEndpoint, "", http.Header(nil)).Return(&http.Response{
 					StatusCode: http.StatusOK,
 					Body:       io.NopCloser(bytes.NewBuffer(data)),
 				}, nil).Once()
@@ -214,7 +293,7 @@ func TestClient_FetchOpenResourceDiscoveryDocuments(t *testing.T) {
 			Name: "Well-known config fetch with access strategy fails when access strategy executor returns error",
 			ExecutorProviderFunc: func() accessstrategy.ExecutorProvider {
 				executor := &automock.Executor{}
-				executor.On("Execute", context.TODO(), mock.Anything, baseURL+ord.WellKnownEndpoint, "").Return(nil, testErr).Once()
+				executor.On("Execute", mock.Anything, mock.Anything, baseURL+ord.WellKnownEndpoint, "", http.Header(nil)).Return(nil, testErr).Once()
 
 				executorProvider := &automock.ExecutorProvider{}
 				executorProvider.On("Provide", accessstrategy.Type(testAccessStrategy)).Return(executor, nil).Once()
@@ -400,7 +479,7 @@ func TestClient_FetchOpenResourceDiscoveryDocuments(t *testing.T) {
 				testWebhook.Auth.AccessStrategy = &test.AccessStrategy
 			}
 
-			docs, actualBaseURL, err := client.FetchOpenResourceDiscoveryDocuments(context.TODO(), testResource, testWebhook)
+			docs, actualBaseURL, validators, err := client.FetchOpenResourceDiscoveryDocuments(context.TODO(), testResource, testWebhook, test.FetchValidators)
 
 			if test.ExpectedErr != nil {
 				require.Error(t, err)
@@ -410,6 +489,9 @@ func TestClient_FetchOpenResourceDiscoveryDocuments(t *testing.T) {
 				require.Len(t, docs, len(test.ExpectedResult))
 				require.Equal(t, test.ExpectedBaseURL, actualBaseURL)
 				require.Equal(t, test.ExpectedResult, docs)
+				if test.FetchValidators != nil {
+					require.Equal(t, test.ExpectedValidators, validators)
+				}
 			}
 
 			if test.ExecutorProviderFunc != nil {
@@ -36,6 +36,9 @@ const (
 	api2ORDID              = "ns:apiResource:API_ID2:v1"
 	event1ORDID            = "ns:eventResource:EVENT_ID:v1"
 	event2ORDID            = "ns2:eventResource:EVENT_ID:v1"
+	entityTypeORDID        = "ns:entityType:ENTITY_TYPE_ID:v1"
+	capabilityORDID        = "ns:capability:CAPABILITY_ID:v1"
+	integrationDepORDID    = "ns:integrationDependency:INTEGRATION_DEPENDENCY_ID:v1"
 
 	whID             = "testWh"
 	tenantID         = "testTenant"
@@ -664,6 +667,67 @@ func fixORDStaticDocumentWithBaseURL(providedBaseURL string) *ord.Document {
 	return doc
 }
 
+func fixEntityTypeInput() *model.EntityTypeInput {
+	return &model.EntityTypeInput{
+		OrdID:               entityTypeORDID,
+		LocalID:             "ENTITY_TYPE_ID",
+		Level:               "aggregate",
+		Title:               "ENTITY TYPE TITLE",
+		ShortDescription:    str.Ptr("lorem ipsum"),
+		Description:         str.Ptr("lorem ipsum dolor sit amet"),
+		OrdPackageID:        packageORDID,
+		Version:             "1.0.0",
+		ChangeLogEntries:    json.RawMessage(changeLogEntries),
+		Visibility:          "public",
+		Links:               json.RawMessage(fmt.Sprintf(linksFormat, baseURL)),
+		PartOfProducts:      json.RawMessage(fmt.Sprintf(`["%s"]`, productORDID)),
+		ReleaseStatus:       "active",
+		Extensible:          json.RawMessage(`{"supported":"automatic","description":"Please find the extensibility documentation"}`),
+		Tags:                json.RawMessage(tags),
+		Labels:              json.RawMessage(labels),
+		DocumentationLabels: json.RawMessage(documentLabels),
+	}
+}
+
+func fixCapabilityInput() *model.CapabilityInput {
+	return &model.CapabilityInput{
+		OrdID:               capabilityORDID,
+		Title:               "CAPABILITY TITLE",
+		ShortDescription:    str.Ptr("lorem ipsum"),
+		Description:         str.Ptr("lorem ipsum dolor sit amet"),
+		Type:                "sap.mdo:mdi-capability:v1",
+		OrdPackageID:        packageORDID,
+		Version:             "1.0.0",
+		Visibility:          "public",
+		ReleaseStatus:       "active",
+		Definitions:         json.RawMessage(`[{"type":"sap.mdo:mdi-capability-definition:v1","mediaType":"application/json","url":"/capability/definition.json","accessStrategies":[{"type":"open"}]}]`),
+		RelatedEntityTypes:  json.RawMessage(fmt.Sprintf(`["%s"]`, entityTypeORDID)),
+		Links:               json.RawMessage(fmt.Sprintf(linksFormat, baseURL)),
+		Tags:                json.RawMessage(tags),
+		Labels:              json.RawMessage(labels),
+		DocumentationLabels: json.RawMessage(documentLabels),
+	}
+}
+
+func fixIntegrationDependencyInput() *model.IntegrationDependencyInput {
+	return &model.IntegrationDependencyInput{
+		OrdID:               integrationDepORDID,
+		Title:               "INTEGRATION DEPENDENCY TITLE",
+		ShortDescription:    str.Ptr("lorem ipsum"),
+		Description:         str.Ptr("lorem ipsum dolor sit amet"),
+		OrdPackageID:        packageORDID,
+		Version:             "1.0.0",
+		Visibility:          "public",
+		ReleaseStatus:       "active",
+		Mandatory:           &boolPtr,
+		Aspects:             json.RawMessage(fmt.Sprintf(`[{"title":"ASPECT TITLE","mandatory":true,"apiResources":[{"ordId":"%s","minVersion":"2.0.0"}],"eventResources":[{"ordId":"%s"}]}]`, api1ORDID, event1ORDID)),
+		Links:               json.RawMessage(fmt.Sprintf(linksFormat, baseURL)),
+		Tags:                json.RawMessage(tags),
+		Labels:              json.RawMessage(labels),
+		DocumentationLabels: json.RawMessage(documentLabels),
+	}
+}
+
 func fixApplicationPage() *model.ApplicationPage {
 	return &model.ApplicationPage{
 		Data: []*model.Application{
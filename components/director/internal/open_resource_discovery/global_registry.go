@@ -56,10 +56,10 @@ func (s *globalRegistryService) SyncGlobalResources(ctx context.Context) (map[st
 		Name: "global-registry",
 		Type: directorresource.Application,
 	}
-	documents, _, err := s.ordClient.FetchOpenResourceDiscoveryDocuments(ctx, resource, &model.Webhook{
+	documents, _, _, err := s.ordClient.FetchOpenResourceDiscoveryDocuments(ctx, resource, &model.Webhook{
 		Type: model.WebhookTypeOpenResourceDiscovery,
 		URL:  &s.config.URL,
-	})
+	}, nil)
 	if err != nil {
 		return nil, errors.Wrapf(err, "while fetching global registry documents from %s", s.config.URL)
 	}
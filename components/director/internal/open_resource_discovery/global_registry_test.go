@@ -68,7 +68,7 @@ func TestService_SyncGlobalResources(t *testing.T) {
 
 	successfulClientFn := func() *automock.Client {
 		client := &automock.Client{}
-		client.On("FetchOpenResourceDiscoveryDocuments", context.TODO(), resource, testWebhook).Return(ord.Documents{fixGlobalRegistryORDDocument()}, baseURL, nil)
+		client.On("FetchOpenResourceDiscoveryDocuments", context.TODO(), resource, testWebhook, []*model.FetchValidator(nil)).Return(ord.Documents{fixGlobalRegistryORDDocument()}, baseURL, nil, nil)
 		return client
 	}
 
@@ -124,7 +124,7 @@ func TestService_SyncGlobalResources(t *testing.T) {
 			TransactionerFn: txGen.ThatDoesntStartTransaction,
 			clientFn: func() *automock.Client {
 				client := &automock.Client{}
-				client.On("FetchOpenResourceDiscoveryDocuments", context.TODO(), resource, testWebhook).Return(nil, "", testErr)
+				client.On("FetchOpenResourceDiscoveryDocuments", context.TODO(), resource, testWebhook, []*model.FetchValidator(nil)).Return(nil, "", nil, testErr)
 				return client
 			},
 			ExpectedErr: testErr,
@@ -136,7 +136,7 @@ func TestService_SyncGlobalResources(t *testing.T) {
 				client := &automock.Client{}
 				doc := fixGlobalRegistryORDDocument()
 				doc.Vendors[0].OrdID = "invalid-ord-id"
-				client.On("FetchOpenResourceDiscoveryDocuments", context.TODO(), resource, testWebhook).Return(ord.Documents{doc}, baseURL, nil)
+				client.On("FetchOpenResourceDiscoveryDocuments", context.TODO(), resource, testWebhook, []*model.FetchValidator(nil)).Return(ord.Documents{doc}, baseURL, nil, nil)
 				return client
 			},
 			ExpectedErr: errors.New("ordId: must be in a valid format."),
@@ -148,7 +148,7 @@ func TestService_SyncGlobalResources(t *testing.T) {
 				client := &automock.Client{}
 				doc := fixGlobalRegistryORDDocument()
 				doc.ConsumptionBundles = fixORDDocument().ConsumptionBundles
-				client.On("FetchOpenResourceDiscoveryDocuments", context.TODO(), resource, testWebhook).Return(ord.Documents{doc}, baseURL, nil)
+				client.On("FetchOpenResourceDiscoveryDocuments", context.TODO(), resource, testWebhook, []*model.FetchValidator(nil)).Return(ord.Documents{doc}, baseURL, nil, nil)
 				return client
 			},
 			ExpectedErr: errors.New("global registry supports only vendors and products"),
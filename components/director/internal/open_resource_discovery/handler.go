@@ -24,6 +24,8 @@ type handler struct {
 type ORDService interface {
 	ProcessApplications(ctx context.Context, cfg MetricsConfig, appIDs []string) error
 	ProcessApplicationTemplates(ctx context.Context, cfg MetricsConfig, appTemplateIDs []string) error
+	ProcessApplication(ctx context.Context, cfg MetricsConfig, appID string) error
+	ProcessAppInAppTemplateContext(ctx context.Context, cfg MetricsConfig, appTemplateID, appID string) error
 }
 
 // NewORDAggregatorHTTPHandler returns a new HTTP handler, responsible for handling HTTP requests
@@ -72,6 +72,39 @@ type EventService interface {
 	ListByApplicationTemplateVersionID(ctx context.Context, appTemplateVersionID string) ([]*model.EventDefinition, error)
 }
 
+// EntityTypeService is responsible for the service-layer Entity Type operations.
+//
+//go:generate mockery --name=EntityTypeService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type EntityTypeService interface {
+	Create(ctx context.Context, resourceType resource.Type, resourceID, packageID string, in model.EntityTypeInput, entityTypeHash uint64) (string, error)
+	Update(ctx context.Context, resourceType resource.Type, id, packageID string, in model.EntityTypeInput, entityTypeHash uint64) error
+	Delete(ctx context.Context, resourceType resource.Type, id string) error
+	ListByApplicationID(ctx context.Context, appID string) ([]*model.EntityType, error)
+	ListByApplicationTemplateVersionID(ctx context.Context, appTemplateVersionID string) ([]*model.EntityType, error)
+}
+
+// CapabilityService is responsible for the service-layer Capability operations.
+//
+//go:generate mockery --name=CapabilityService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type CapabilityService interface {
+	Create(ctx context.Context, resourceType resource.Type, resourceID, packageID string, in model.CapabilityInput, capabilityHash uint64) (string, error)
+	Update(ctx context.Context, resourceType resource.Type, id, packageID string, in model.CapabilityInput, capabilityHash uint64) error
+	Delete(ctx context.Context, resourceType resource.Type, id string) error
+	ListByApplicationID(ctx context.Context, appID string) ([]*model.Capability, error)
+	ListByApplicationTemplateVersionID(ctx context.Context, appTemplateVersionID string) ([]*model.Capability, error)
+}
+
+// IntegrationDependencyService is responsible for the service-layer Integration Dependency operations.
+//
+//go:generate mockery --name=IntegrationDependencyService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type IntegrationDependencyService interface {
+	Create(ctx context.Context, resourceType resource.Type, resourceID, packageID string, in model.IntegrationDependencyInput, integrationDependencyHash uint64) (string, error)
+	Update(ctx context.Context, resourceType resource.Type, id, packageID string, in model.IntegrationDependencyInput, integrationDependencyHash uint64) error
+	Delete(ctx context.Context, resourceType resource.Type, id string) error
+	ListByApplicationID(ctx context.Context, appID string) ([]*model.IntegrationDependency, error)
+	ListByApplicationTemplateVersionID(ctx context.Context, appTemplateVersionID string) ([]*model.IntegrationDependency, error)
+}
+
 // SpecService is responsible for the service-layer Specification operations.
 //
 //go:generate mockery --name=SpecService --output=automock --outpkg=automock --case=underscore --disable-version-string
@@ -185,6 +218,14 @@ type ApplicationTemplateService interface {
 	Get(ctx context.Context, id string) (*model.ApplicationTemplate, error)
 }
 
+// FetchValidatorService is responsible for the service-layer operations of the validators used for conditional fetching of ORD documents
+//
+//go:generate mockery --name=FetchValidatorService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type FetchValidatorService interface {
+	ListByWebhookIDAndResourceID(ctx context.Context, webhookID, resourceID string) ([]*model.FetchValidator, error)
+	Replace(ctx context.Context, webhookID, resourceID string, in []*model.FetchValidatorInput) error
+}
+
 // WebhookConverter is responsible for converting webhook structs
 //
 //go:generate mockery --name=WebhookConverter --output=automock --outpkg=automock --case=underscore --disable-version-string
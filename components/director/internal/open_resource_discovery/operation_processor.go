@@ -0,0 +1,54 @@
+package ord
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	operationsmanager "github.com/kyma-incubator/compass/components/director/internal/operations_manager"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/pkg/errors"
+)
+
+// OperationsProcessor executes ORD aggregation operations
+type OperationsProcessor struct {
+	ordSvc ORDService
+	cfg    MetricsConfig
+}
+
+// NewOperationsProcessor creates a new OperationsProcessor
+func NewOperationsProcessor(ordSvc ORDService, cfg MetricsConfig) *OperationsProcessor {
+	return &OperationsProcessor{
+		ordSvc: ordSvc,
+		cfg:    cfg,
+	}
+}
+
+// Process performs ORD aggregation for the application and/or application template described by the operation data
+func (p *OperationsProcessor) Process(ctx context.Context, operation *model.Operation) error {
+	if operation.OpType != operationsmanager.OrdAggregationOpType {
+		return errors.Errorf("unsupported operation type %q", operation.OpType)
+	}
+
+	var opData operationsmanager.OrdOperationData
+	if err := json.Unmarshal(operation.Data, &opData); err != nil {
+		return errors.Wrapf(err, "while unmarshalling data of operation with id %q", operation.ID)
+	}
+
+	if opData.ApplicationID == "" && opData.ApplicationTemplateID == "" {
+		return errors.Errorf("missing application and application template id in data of operation with id %q", operation.ID)
+	}
+
+	if opData.ApplicationID == "" {
+		log.C(ctx).Infof("Processing ORD data for application template with id %q", opData.ApplicationTemplateID)
+		return p.ordSvc.ProcessApplicationTemplates(ctx, p.cfg, []string{opData.ApplicationTemplateID})
+	}
+
+	if opData.ApplicationTemplateID != "" {
+		log.C(ctx).Infof("Processing ORD data for application with id %q in the context of application template with id %q", opData.ApplicationID, opData.ApplicationTemplateID)
+		return p.ordSvc.ProcessAppInAppTemplateContext(ctx, p.cfg, opData.ApplicationTemplateID, opData.ApplicationID)
+	}
+
+	log.C(ctx).Infof("Processing ORD data for application with id %q", opData.ApplicationID)
+	return p.ordSvc.ProcessApplication(ctx, p.cfg, opData.ApplicationID)
+}
@@ -0,0 +1,112 @@
+package ord_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	ord "github.com/kyma-incubator/compass/components/director/internal/open_resource_discovery"
+	"github.com/kyma-incubator/compass/components/director/internal/open_resource_discovery/automock"
+	operationsmanager "github.com/kyma-incubator/compass/components/director/internal/operations_manager"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOperationsProcessor_Process(t *testing.T) {
+	ctx := context.TODO()
+	metricsConfig := ord.MetricsConfig{}
+	testErr := errors.New("test error")
+
+	fixOperation := func(opType string, data json.RawMessage) *model.Operation {
+		return &model.Operation{
+			ID:     "op-id",
+			OpType: opType,
+			Status: model.OperationStatusInProgress,
+			Data:   data,
+		}
+	}
+
+	testCases := []struct {
+		Name        string
+		Operation   *model.Operation
+		ORDService  func() *automock.ORDService
+		ExpectedErr error
+	}{
+		{
+			Name:      "Success for application",
+			Operation: fixOperation(operationsmanager.OrdAggregationOpType, json.RawMessage(`{"applicationID":"testApp"}`)),
+			ORDService: func() *automock.ORDService {
+				svc := &automock.ORDService{}
+				svc.On("ProcessApplication", ctx, metricsConfig, appID).Return(nil).Once()
+				return svc
+			},
+		},
+		{
+			Name:      "Success for application in application template context",
+			Operation: fixOperation(operationsmanager.OrdAggregationOpType, json.RawMessage(`{"applicationID":"testApp","applicationTemplateID":"testAppTemplate"}`)),
+			ORDService: func() *automock.ORDService {
+				svc := &automock.ORDService{}
+				svc.On("ProcessAppInAppTemplateContext", ctx, metricsConfig, appTemplateID, appID).Return(nil).Once()
+				return svc
+			},
+		},
+		{
+			Name:      "Error while processing application",
+			Operation: fixOperation(operationsmanager.OrdAggregationOpType, json.RawMessage(`{"applicationID":"testApp"}`)),
+			ORDService: func() *automock.ORDService {
+				svc := &automock.ORDService{}
+				svc.On("ProcessApplication", ctx, metricsConfig, appID).Return(testErr).Once()
+				return svc
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name:        "Error for unsupported operation type",
+			Operation:   fixOperation("UNKNOWN", json.RawMessage(`{"applicationID":"testApp"}`)),
+			ORDService:  func() *automock.ORDService { return &automock.ORDService{} },
+			ExpectedErr: errors.New("unsupported operation type"),
+		},
+		{
+			Name:        "Error for invalid operation data",
+			Operation:   fixOperation(operationsmanager.OrdAggregationOpType, json.RawMessage(`{`)),
+			ORDService:  func() *automock.ORDService { return &automock.ORDService{} },
+			ExpectedErr: errors.New("while unmarshalling data of operation"),
+		},
+		{
+			Name:      "Success for application template",
+			Operation: fixOperation(operationsmanager.OrdAggregationOpType, json.RawMessage(`{"applicationID":"","applicationTemplateID":"testAppTemplate"}`)),
+			ORDService: func() *automock.ORDService {
+				svc := &automock.ORDService{}
+				svc.On("ProcessApplicationTemplates", ctx, metricsConfig, []string{appTemplateID}).Return(nil).Once()
+				return svc
+			},
+		},
+		{
+			Name:        "Error for missing application and application template id",
+			Operation:   fixOperation(operationsmanager.OrdAggregationOpType, json.RawMessage(`{"applicationID":""}`)),
+			ORDService:  func() *automock.ORDService { return &automock.ORDService{} },
+			ExpectedErr: errors.New("missing application and application template id"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			svc := testCase.ORDService()
+			processor := ord.NewOperationsProcessor(svc, metricsConfig)
+
+			err := processor.Process(ctx, testCase.Operation)
+
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+
+			mock.AssertExpectationsForObjects(t, svc)
+		})
+	}
+}
@@ -70,13 +70,16 @@ type Document struct {
 
 	Perspective DocumentPerspective `json:"-"`
 
-	Packages           []*model.PackageInput         `json:"packages"`
-	ConsumptionBundles []*model.BundleCreateInput    `json:"consumptionBundles"`
-	Products           []*model.ProductInput         `json:"products"`
-	APIResources       []*model.APIDefinitionInput   `json:"apiResources"`
-	EventResources     []*model.EventDefinitionInput `json:"eventResources"`
-	Tombstones         []*model.TombstoneInput       `json:"tombstones"`
-	Vendors            []*model.VendorInput          `json:"vendors"`
+	Packages                []*model.PackageInput               `json:"packages"`
+	ConsumptionBundles      []*model.BundleCreateInput          `json:"consumptionBundles"`
+	Products                []*model.ProductInput               `json:"products"`
+	APIResources            []*model.APIDefinitionInput         `json:"apiResources"`
+	EventResources          []*model.EventDefinitionInput       `json:"eventResources"`
+	EntityTypes             []*model.EntityTypeInput            `json:"entityTypes"`
+	Capabilities            []*model.CapabilityInput            `json:"capabilities"`
+	IntegrationDependencies []*model.IntegrationDependencyInput `json:"integrationDependencies"`
+	Tombstones              []*model.TombstoneInput             `json:"tombstones"`
+	Vendors                 []*model.VendorInput                `json:"vendors"`
 }
 
 // Validate validates if the Config object complies with the spec requirements
@@ -112,21 +115,27 @@ type Documents []*Document
 
 // ResourcesFromDB holds some of the ORD data from the database
 type ResourcesFromDB struct {
-	APIs     map[string]*model.APIDefinition
-	Events   map[string]*model.EventDefinition
-	Packages map[string]*model.Package
-	Bundles  map[string]*model.Bundle
+	APIs                    map[string]*model.APIDefinition
+	Events                  map[string]*model.EventDefinition
+	EntityTypes             map[string]*model.EntityType
+	Capabilities            map[string]*model.Capability
+	Packages                map[string]*model.Package
+	Bundles                 map[string]*model.Bundle
+	IntegrationDependencies map[string]*model.IntegrationDependency
 }
 
 // ResourceIDs holds some of the ORD entities' IDs
 type ResourceIDs struct {
-	PackageIDs          map[string]bool
-	PackagePolicyLevels map[string]string
-	BundleIDs           map[string]bool
-	ProductIDs          map[string]bool
-	APIIDs              map[string]bool
-	EventIDs            map[string]bool
-	VendorIDs           map[string]bool
+	PackageIDs               map[string]bool
+	PackagePolicyLevels      map[string]string
+	BundleIDs                map[string]bool
+	ProductIDs               map[string]bool
+	APIIDs                   map[string]bool
+	EventIDs                 map[string]bool
+	EntityTypeIDs            map[string]bool
+	CapabilityIDs            map[string]bool
+	VendorIDs                map[string]bool
+	IntegrationDependencyIDs map[string]bool
 }
 
 // Validate validates all the documents for a system instance
@@ -164,13 +173,16 @@ func (docs Documents) Validate(calculatedBaseURL string, resourcesFromDB Resourc
 	}
 
 	resourceIDs := ResourceIDs{
-		PackageIDs:          make(map[string]bool),
-		PackagePolicyLevels: make(map[string]string),
-		BundleIDs:           make(map[string]bool),
-		ProductIDs:          make(map[string]bool),
-		APIIDs:              make(map[string]bool),
-		EventIDs:            make(map[string]bool),
-		VendorIDs:           make(map[string]bool),
+		PackageIDs:               make(map[string]bool),
+		PackagePolicyLevels:      make(map[string]string),
+		BundleIDs:                make(map[string]bool),
+		ProductIDs:               make(map[string]bool),
+		APIIDs:                   make(map[string]bool),
+		EventIDs:                 make(map[string]bool),
+		EntityTypeIDs:            make(map[string]bool),
+		CapabilityIDs:            make(map[string]bool),
+		VendorIDs:                make(map[string]bool),
+		IntegrationDependencyIDs: make(map[string]bool),
 	}
 
 	for _, doc := range docs {
@@ -186,6 +198,9 @@ func (docs Documents) Validate(calculatedBaseURL string, resourcesFromDB Resourc
 
 	invalidApisIndices := make([]int, 0)
 	invalidEventsIndices := make([]int, 0)
+	invalidEntityTypesIndices := make([]int, 0)
+	invalidCapabilitiesIndices := make([]int, 0)
+	invalidIntegrationDependenciesIndices := make([]int, 0)
 
 	r1, e1 := docs.validateAndCheckForDuplications(SystemVersionPerspective, true, resourcesFromDB, resourceIDs, resourceHashes, credentialExchangeStrategyTenantMappings)
 	r2, e2 := docs.validateAndCheckForDuplications(SystemInstancePerspective, true, resourcesFromDB, resourceIDs, resourceHashes, credentialExchangeStrategyTenantMappings)
@@ -264,10 +279,44 @@ func (docs Documents) Validate(calculatedBaseURL string, resourcesFromDB Resourc
 			}
 		}
 
+		for i, entityType := range doc.EntityTypes {
+			if !resourceIDs.PackageIDs[entityType.OrdPackageID] {
+				errs = multierror.Append(errs, errors.Errorf("entity type with id %q has a reference to unknown package %q", entityType.OrdID, entityType.OrdPackageID))
+				invalidEntityTypesIndices = append(invalidEntityTypesIndices, i)
+			}
+
+			ordIDs := gjson.ParseBytes(entityType.PartOfProducts).Array()
+			for _, productID := range ordIDs {
+				if !resourceIDs.ProductIDs[productID.String()] && !globalResourcesOrdIDs[productID.String()] {
+					errs = multierror.Append(errs, errors.Errorf("entity type with id %q has a reference to unknown product %q", entityType.OrdID, productID.String()))
+				}
+			}
+		}
+
+		for i, capability := range doc.Capabilities {
+			if !resourceIDs.PackageIDs[capability.OrdPackageID] {
+				errs = multierror.Append(errs, errors.Errorf("capability with id %q has a reference to unknown package %q", capability.OrdID, capability.OrdPackageID))
+				invalidCapabilitiesIndices = append(invalidCapabilitiesIndices, i)
+			}
+		}
+
+		for i, integrationDependency := range doc.IntegrationDependencies {
+			if !resourceIDs.PackageIDs[integrationDependency.OrdPackageID] {
+				errs = multierror.Append(errs, errors.Errorf("integration dependency with id %q has a reference to unknown package %q", integrationDependency.OrdID, integrationDependency.OrdPackageID))
+				invalidIntegrationDependenciesIndices = append(invalidIntegrationDependenciesIndices, i)
+			}
+		}
+
 		doc.APIResources = deleteInvalidInputObjects(invalidApisIndices, doc.APIResources)
 		doc.EventResources = deleteInvalidInputObjects(invalidEventsIndices, doc.EventResources)
+		doc.EntityTypes = deleteInvalidInputObjects(invalidEntityTypesIndices, doc.EntityTypes)
+		doc.Capabilities = deleteInvalidInputObjects(invalidCapabilitiesIndices, doc.Capabilities)
+		doc.IntegrationDependencies = deleteInvalidInputObjects(invalidIntegrationDependenciesIndices, doc.IntegrationDependencies)
 		invalidApisIndices = nil
 		invalidEventsIndices = nil
+		invalidEntityTypesIndices = nil
+		invalidCapabilitiesIndices = nil
+		invalidIntegrationDependenciesIndices = nil
 	}
 
 	return errs.ErrorOrNil()
@@ -277,13 +326,16 @@ func (docs Documents) validateAndCheckForDuplications(perspectiveConstraint Docu
 	errs := &multierror.Error{}
 
 	resourceIDs := ResourceIDs{
-		PackageIDs:          make(map[string]bool),
-		PackagePolicyLevels: resourceID.PackagePolicyLevels,
-		BundleIDs:           make(map[string]bool),
-		ProductIDs:          make(map[string]bool),
-		APIIDs:              make(map[string]bool),
-		EventIDs:            make(map[string]bool),
-		VendorIDs:           make(map[string]bool),
+		PackageIDs:               make(map[string]bool),
+		PackagePolicyLevels:      resourceID.PackagePolicyLevels,
+		BundleIDs:                make(map[string]bool),
+		ProductIDs:               make(map[string]bool),
+		APIIDs:                   make(map[string]bool),
+		EventIDs:                 make(map[string]bool),
+		EntityTypeIDs:            make(map[string]bool),
+		CapabilityIDs:            make(map[string]bool),
+		VendorIDs:                make(map[string]bool),
+		IntegrationDependencyIDs: make(map[string]bool),
 	}
 	for _, doc := range docs {
 		if doc.Perspective == perspectiveConstraint {
@@ -296,6 +348,9 @@ func (docs Documents) validateAndCheckForDuplications(perspectiveConstraint Docu
 		invalidTombstonesIndices := make([]int, 0)
 		invalidApisIndices := make([]int, 0)
 		invalidEventsIndices := make([]int, 0)
+		invalidEntityTypesIndices := make([]int, 0)
+		invalidCapabilitiesIndices := make([]int, 0)
+		invalidIntegrationDependenciesIndices := make([]int, 0)
 
 		if err := validateDocumentInput(doc); err != nil {
 			errs = multierror.Append(errs, errors.Wrap(err, "error validating document"))
@@ -365,6 +420,42 @@ func (docs Documents) validateAndCheckForDuplications(perspectiveConstraint Docu
 			}
 		}
 
+		for i, entityType := range doc.EntityTypes {
+			if err := validateEntityTypeInput(entityType, resourceIDs.PackagePolicyLevels, resourcesFromDB.EntityTypes, resourceHashes); err != nil {
+				errs = multierror.Append(errs, errors.Wrapf(err, "error validating entity type with ord id %q", entityType.OrdID))
+				invalidEntityTypesIndices = append(invalidEntityTypesIndices, i)
+				continue
+			}
+			if _, ok := resourceIDs.EntityTypeIDs[entityType.OrdID]; ok && forbidDuplications {
+				errs = multierror.Append(errs, errors.Errorf("found duplicate entity type with ord id %q", entityType.OrdID))
+			}
+			resourceIDs.EntityTypeIDs[entityType.OrdID] = true
+		}
+
+		for i, capability := range doc.Capabilities {
+			if err := validateCapabilityInput(capability, resourceIDs.PackagePolicyLevels, resourcesFromDB.Capabilities, resourceHashes); err != nil {
+				errs = multierror.Append(errs, errors.Wrapf(err, "error validating capability with ord id %q", capability.OrdID))
+				invalidCapabilitiesIndices = append(invalidCapabilitiesIndices, i)
+				continue
+			}
+			if _, ok := resourceIDs.CapabilityIDs[capability.OrdID]; ok && forbidDuplications {
+				errs = multierror.Append(errs, errors.Errorf("found duplicate capability with ord id %q", capability.OrdID))
+			}
+			resourceIDs.CapabilityIDs[capability.OrdID] = true
+		}
+
+		for i, integrationDependency := range doc.IntegrationDependencies {
+			if err := validateIntegrationDependencyInput(integrationDependency, resourcesFromDB.IntegrationDependencies, resourceHashes); err != nil {
+				errs = multierror.Append(errs, errors.Wrapf(err, "error validating integration dependency with ord id %q", integrationDependency.OrdID))
+				invalidIntegrationDependenciesIndices = append(invalidIntegrationDependenciesIndices, i)
+				continue
+			}
+			if _, ok := resourceIDs.IntegrationDependencyIDs[integrationDependency.OrdID]; ok && forbidDuplications {
+				errs = multierror.Append(errs, errors.Errorf("found duplicate integration dependency with ord id %q", integrationDependency.OrdID))
+			}
+			resourceIDs.IntegrationDependencyIDs[integrationDependency.OrdID] = true
+		}
+
 		for i, vendor := range doc.Vendors {
 			if err := validateVendorInput(vendor); err != nil {
 				errs = multierror.Append(errs, errors.Wrapf(err, "error validating vendor with ord id %q", vendor.OrdID))
@@ -389,18 +480,24 @@ func (docs Documents) validateAndCheckForDuplications(perspectiveConstraint Docu
 		doc.Products = deleteInvalidInputObjects(invalidProductsIndices, doc.Products)
 		doc.APIResources = deleteInvalidInputObjects(invalidApisIndices, doc.APIResources)
 		doc.EventResources = deleteInvalidInputObjects(invalidEventsIndices, doc.EventResources)
+		doc.EntityTypes = deleteInvalidInputObjects(invalidEntityTypesIndices, doc.EntityTypes)
+		doc.Capabilities = deleteInvalidInputObjects(invalidCapabilitiesIndices, doc.Capabilities)
+		doc.IntegrationDependencies = deleteInvalidInputObjects(invalidIntegrationDependenciesIndices, doc.IntegrationDependencies)
 		doc.Vendors = deleteInvalidInputObjects(invalidVendorsIndices, doc.Vendors)
 		doc.Tombstones = deleteInvalidInputObjects(invalidTombstonesIndices, doc.Tombstones)
 	}
 
 	return ResourceIDs{
-		PackageIDs:          resourceIDs.PackageIDs,
-		ProductIDs:          resourceIDs.ProductIDs,
-		APIIDs:              resourceIDs.APIIDs,
-		EventIDs:            resourceIDs.EventIDs,
-		VendorIDs:           resourceIDs.VendorIDs,
-		BundleIDs:           resourceIDs.BundleIDs,
-		PackagePolicyLevels: resourceIDs.PackagePolicyLevels,
+		PackageIDs:               resourceIDs.PackageIDs,
+		ProductIDs:               resourceIDs.ProductIDs,
+		APIIDs:                   resourceIDs.APIIDs,
+		EventIDs:                 resourceIDs.EventIDs,
+		EntityTypeIDs:            resourceIDs.EntityTypeIDs,
+		CapabilityIDs:            resourceIDs.CapabilityIDs,
+		VendorIDs:                resourceIDs.VendorIDs,
+		BundleIDs:                resourceIDs.BundleIDs,
+		PackagePolicyLevels:      resourceIDs.PackagePolicyLevels,
+		IntegrationDependencyIDs: resourceIDs.IntegrationDependencyIDs,
 	}, errs
 }
 
@@ -465,6 +562,30 @@ func (docs Documents) Sanitize(baseURL string) error {
 				}
 			}
 		}
+
+		for _, entityType := range doc.EntityTypes {
+			if entityType.ChangeLogEntries, err = rewriteRelativeURIsInJSON(entityType.ChangeLogEntries, baseURL, "url"); err != nil {
+				return err
+			}
+			if entityType.Links, err = rewriteRelativeURIsInJSON(entityType.Links, baseURL, "url"); err != nil {
+				return err
+			}
+		}
+
+		for _, capability := range doc.Capabilities {
+			if capability.Links, err = rewriteRelativeURIsInJSON(capability.Links, baseURL, "url"); err != nil {
+				return err
+			}
+			if capability.Definitions, err = rewriteRelativeURIsInJSON(capability.Definitions, baseURL, "url"); err != nil {
+				return err
+			}
+		}
+
+		for _, integrationDependency := range doc.IntegrationDependencies {
+			if integrationDependency.Links, err = rewriteRelativeURIsInJSON(integrationDependency.Links, baseURL, "url"); err != nil {
+				return err
+			}
+		}
 	}
 
 	// Package properties inheritance
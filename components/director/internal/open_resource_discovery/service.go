@@ -99,6 +99,9 @@ type Service struct {
 	bundleReferenceSvc    BundleReferenceService
 	apiSvc                APIService
 	eventSvc              EventService
+	entityTypeSvc         EntityTypeService
+	capabilitySvc         CapabilityService
+	integrationDepSvc     IntegrationDependencyService
 	specSvc               SpecService
 	fetchReqSvc           FetchRequestService
 	packageSvc            PackageService
@@ -108,6 +111,7 @@ type Service struct {
 	tenantSvc             TenantService
 	appTemplateVersionSvc ApplicationTemplateVersionService
 	appTemplateSvc        ApplicationTemplateService
+	fetchValidatorSvc     FetchValidatorService
 
 	webhookConverter WebhookConverter
 
@@ -116,7 +120,7 @@ type Service struct {
 }
 
 // NewAggregatorService returns a new object responsible for service-layer ORD operations.
-func NewAggregatorService(config ServiceConfig, transact persistence.Transactioner, appSvc ApplicationService, webhookSvc WebhookService, bundleSvc BundleService, bundleReferenceSvc BundleReferenceService, apiSvc APIService, eventSvc EventService, specSvc SpecService, fetchReqSvc FetchRequestService, packageSvc PackageService, productSvc ProductService, vendorSvc VendorService, tombstoneSvc TombstoneService, tenantSvc TenantService, globalRegistrySvc GlobalRegistryService, client Client, webhookConverter WebhookConverter, appTemplateVersionSvc ApplicationTemplateVersionService, appTemplateSvc ApplicationTemplateService) *Service {
+func NewAggregatorService(config ServiceConfig, transact persistence.Transactioner, appSvc ApplicationService, webhookSvc WebhookService, bundleSvc BundleService, bundleReferenceSvc BundleReferenceService, apiSvc APIService, eventSvc EventService, entityTypeSvc EntityTypeService, capabilitySvc CapabilityService, integrationDepSvc IntegrationDependencyService, specSvc SpecService, fetchReqSvc FetchRequestService, packageSvc PackageService, productSvc ProductService, vendorSvc VendorService, tombstoneSvc TombstoneService, tenantSvc TenantService, globalRegistrySvc GlobalRegistryService, client Client, webhookConverter WebhookConverter, appTemplateVersionSvc ApplicationTemplateVersionService, appTemplateSvc ApplicationTemplateService, fetchValidatorSvc FetchValidatorService) *Service {
 	return &Service{
 		config:                config,
 		transact:              transact,
@@ -126,6 +130,9 @@ func NewAggregatorService(config ServiceConfig, transact persistence.Transaction
 		bundleReferenceSvc:    bundleReferenceSvc,
 		apiSvc:                apiSvc,
 		eventSvc:              eventSvc,
+		entityTypeSvc:         entityTypeSvc,
+		capabilitySvc:         capabilitySvc,
+		integrationDepSvc:     integrationDepSvc,
 		specSvc:               specSvc,
 		fetchReqSvc:           fetchReqSvc,
 		packageSvc:            packageSvc,
@@ -138,6 +145,7 @@ func NewAggregatorService(config ServiceConfig, transact persistence.Transaction
 		webhookConverter:      webhookConverter,
 		appTemplateVersionSvc: appTemplateVersionSvc,
 		appTemplateSvc:        appTemplateSvc,
+		fetchValidatorSvc:     fetchValidatorSvc,
 	}
 }
 
@@ -213,6 +221,38 @@ func (s *Service) ProcessApplications(ctx context.Context, cfg MetricsConfig, ap
 	return nil
 }
 
+// ProcessApplication performs resync of ORD information provided via ORD documents for a single application
+func (s *Service) ProcessApplication(ctx context.Context, cfg MetricsConfig, appID string) error {
+	globalResourcesOrdIDs := s.retrieveGlobalResources(ctx)
+	if err := s.processApplication(ctx, cfg, globalResourcesOrdIDs, appID); err != nil {
+		return errors.Wrapf(err, "processing of ORD data for application with id %q failed", appID)
+	}
+	return nil
+}
+
+// ProcessAppInAppTemplateContext performs resync of ORD information provided via the ORD webhooks of an application template
+// for a single application created from that template
+func (s *Service) ProcessAppInAppTemplateContext(ctx context.Context, cfg MetricsConfig, appTemplateID, appID string) error {
+	webhooks, err := s.getWebhooksForApplicationTemplate(ctx, appTemplateID)
+	if err != nil {
+		return errors.Wrapf(err, "retrieving of webhooks for application template with id %q failed", appTemplateID)
+	}
+
+	globalResourcesOrdIDs := s.retrieveGlobalResources(ctx)
+	for _, wh := range webhooks {
+		if wh.Type == model.WebhookTypeOpenResourceDiscovery && wh.URL != nil {
+			if err := s.processApplicationTemplateWebhook(ctx, cfg, wh, appTemplateID, globalResourcesOrdIDs); err != nil {
+				return errors.Wrapf(err, "processing of ORD webhook for application template with id %q failed", appTemplateID)
+			}
+
+			if err := s.processApplicationWebhook(ctx, cfg, wh, appID, globalResourcesOrdIDs); err != nil {
+				return errors.Wrapf(err, "processing of ORD webhook for application with id %q failed", appID)
+			}
+		}
+	}
+	return nil
+}
+
 func (s *Service) processApplication(ctx context.Context, cfg MetricsConfig, globalResourcesOrdIDs map[string]bool, appID string) error {
 	webhooks, err := s.getWebhooksForApplication(ctx, appID)
 	if err != nil {
@@ -462,13 +502,28 @@ func (s *Service) processDocuments(ctx context.Context, resource Resource, baseU
 			return err
 		}
 
+		entityTypesFromDB, err := s.processEntityTypes(ctx, resourceToAggregate.Type, resourceToAggregate.ID, packagesFromDB, doc.EntityTypes, resourceHashes)
+		if err != nil {
+			return err
+		}
+
+		capabilitiesFromDB, err := s.processCapabilities(ctx, resourceToAggregate.Type, resourceToAggregate.ID, packagesFromDB, doc.Capabilities, resourceHashes)
+		if err != nil {
+			return err
+		}
+
+		integrationDependenciesFromDB, err := s.processIntegrationDependencies(ctx, resourceToAggregate.Type, resourceToAggregate.ID, packagesFromDB, doc.IntegrationDependencies, resourceHashes)
+		if err != nil {
+			return err
+		}
+
 		tombstonesFromDB, err := s.processTombstones(ctx, resourceToAggregate.Type, resourceToAggregate.ID, doc.Tombstones)
 		if err != nil {
 			return err
 		}
 
 		fetchRequests := append(apiFetchRequests, eventFetchRequests...)
-		fetchRequests, err = s.deleteTombstonedResources(ctx, resourceToAggregate.Type, vendorsFromDB, productsFromDB, packagesFromDB, bundlesFromDB, apisFromDB, eventsFromDB, tombstonesFromDB, fetchRequests)
+		fetchRequests, err = s.deleteTombstonedResources(ctx, resourceToAggregate.Type, vendorsFromDB, productsFromDB, packagesFromDB, bundlesFromDB, apisFromDB, eventsFromDB, entityTypesFromDB, capabilitiesFromDB, integrationDependenciesFromDB, tombstonesFromDB, fetchRequests)
 		if err != nil {
 			return err
 		}
@@ -573,7 +628,8 @@ func (s *Service) processFetchRequestResult(ctx context.Context, result *fetchRe
 		specReferenceType = model.EventSpecReference
 	}
 
-	if result.status.Condition == model.FetchRequestStatusConditionSucceeded {
+	// a succeeded fetch request without data means that the specification is not modified since the last fetch
+	if result.status.Condition == model.FetchRequestStatusConditionSucceeded && result.data != nil {
 		spec, err := s.specSvc.GetByID(ctx, result.fetchRequest.ObjectID, specReferenceType)
 		if err != nil {
 			return err
@@ -591,7 +647,7 @@ func (s *Service) processFetchRequestResult(ctx context.Context, result *fetchRe
 }
 
 func (s *Service) processFetchRequestResultGlobal(ctx context.Context, result *fetchRequestResult) error {
-	if result.status.Condition == model.FetchRequestStatusConditionSucceeded {
+	if result.status.Condition == model.FetchRequestStatusConditionSucceeded && result.data != nil {
 		spec, err := s.specSvc.GetByIDGlobal(ctx, result.fetchRequest.ObjectID)
 		if err != nil {
 			return err
@@ -608,7 +664,7 @@ func (s *Service) processFetchRequestResultGlobal(ctx context.Context, result *f
 	return s.fetchReqSvc.UpdateGlobal(ctx, result.fetchRequest)
 }
 
-func (s *Service) deleteTombstonedResources(ctx context.Context, resourceType directorresource.Type, vendorsFromDB []*model.Vendor, productsFromDB []*model.Product, packagesFromDB []*model.Package, bundlesFromDB []*model.Bundle, apisFromDB []*model.APIDefinition, eventsFromDB []*model.EventDefinition, tombstonesFromDB []*model.Tombstone, fetchRequests []*ordFetchRequest) ([]*ordFetchRequest, error) {
+func (s *Service) deleteTombstonedResources(ctx context.Context, resourceType directorresource.Type, vendorsFromDB []*model.Vendor, productsFromDB []*model.Product, packagesFromDB []*model.Package, bundlesFromDB []*model.Bundle, apisFromDB []*model.APIDefinition, eventsFromDB []*model.EventDefinition, entityTypesFromDB []*model.EntityType, capabilitiesFromDB []*model.Capability, integrationDependenciesFromDB []*model.IntegrationDependency, tombstonesFromDB []*model.Tombstone, fetchRequests []*ordFetchRequest) ([]*ordFetchRequest, error) {
 	tx, err := s.transact.Begin()
 	if err != nil {
 		return nil, err
@@ -640,6 +696,27 @@ func (s *Service) deleteTombstonedResources(ctx context.Context, resourceType di
 				return nil, errors.Wrapf(err, "error while deleting resource with ORD ID %q based on its tombstone", ts.OrdID)
 			}
 		}
+		if i, found := searchInSlice(len(entityTypesFromDB), func(i int) bool {
+			return entityTypesFromDB[i].OrdID == ts.OrdID
+		}); found {
+			if err := s.entityTypeSvc.Delete(ctx, resourceType, entityTypesFromDB[i].ID); err != nil {
+				return nil, errors.Wrapf(err, "error while deleting resource with ORD ID %q based on its tombstone", ts.OrdID)
+			}
+		}
+		if i, found := searchInSlice(len(capabilitiesFromDB), func(i int) bool {
+			return capabilitiesFromDB[i].OrdID == ts.OrdID
+		}); found {
+			if err := s.capabilitySvc.Delete(ctx, resourceType, capabilitiesFromDB[i].ID); err != nil {
+				return nil, errors.Wrapf(err, "error while deleting resource with ORD ID %q based on its tombstone", ts.OrdID)
+			}
+		}
+		if i, found := searchInSlice(len(integrationDependenciesFromDB), func(i int) bool {
+			return integrationDependenciesFromDB[i].OrdID == ts.OrdID
+		}); found {
+			if err := s.integrationDepSvc.Delete(ctx, resourceType, integrationDependenciesFromDB[i].ID); err != nil {
+				return nil, errors.Wrapf(err, "error while deleting resource with ORD ID %q based on its tombstone", ts.OrdID)
+			}
+		}
 		if i, found := searchInSlice(len(bundlesFromDB), func(i int) bool {
 			return equalStrings(bundlesFromDB[i].OrdID, &ts.OrdID)
 		}); found {
@@ -1250,6 +1327,171 @@ func (s *Service) resyncEventInTx(ctx context.Context, resourceType directorreso
 	return fetchRequests, tx.Commit()
 }
 
+func (s *Service) processEntityTypes(ctx context.Context, resourceType directorresource.Type, resourceID string, packagesFromDB []*model.Package, entityTypes []*model.EntityTypeInput, resourceHashes map[string]uint64) ([]*model.EntityType, error) {
+	entityTypesFromDB, err := s.listEntityTypesInTx(ctx, resourceType, resourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entityType := range entityTypes {
+		entityTypeHash := resourceHashes[entityType.OrdID]
+		if err := s.resyncEntityTypeInTx(ctx, resourceType, resourceID, entityTypesFromDB, packagesFromDB, entityType, entityTypeHash); err != nil {
+			return nil, err
+		}
+	}
+
+	entityTypesFromDB, err = s.listEntityTypesInTx(ctx, resourceType, resourceID)
+	if err != nil {
+		return nil, err
+	}
+	return entityTypesFromDB, nil
+}
+
+func (s *Service) listEntityTypesInTx(ctx context.Context, resourceType directorresource.Type, resourceID string) ([]*model.EntityType, error) {
+	tx, err := s.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer s.transact.RollbackUnlessCommitted(ctx, tx)
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	var entityTypesFromDB []*model.EntityType
+	if resourceType == directorresource.Application {
+		entityTypesFromDB, err = s.entityTypeSvc.ListByApplicationID(ctx, resourceID)
+	} else if resourceType == directorresource.ApplicationTemplateVersion {
+		entityTypesFromDB, err = s.entityTypeSvc.ListByApplicationTemplateVersionID(ctx, resourceID)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "error while listing entity types for %s with id %q", resourceType, resourceID)
+	}
+
+	return entityTypesFromDB, tx.Commit()
+}
+
+func (s *Service) resyncEntityTypeInTx(ctx context.Context, resourceType directorresource.Type, resourceID string, entityTypesFromDB []*model.EntityType, packagesFromDB []*model.Package, entityType *model.EntityTypeInput, entityTypeHash uint64) error {
+	tx, err := s.transact.Begin()
+	if err != nil {
+		return err
+	}
+	defer s.transact.RollbackUnlessCommitted(ctx, tx)
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	if err := s.resyncEntityType(ctx, resourceType, resourceID, entityTypesFromDB, packagesFromDB, *entityType, entityTypeHash); err != nil {
+		return errors.Wrapf(err, "error while resyncing entity type with ORD ID %q", entityType.OrdID)
+	}
+	return tx.Commit()
+}
+
+func (s *Service) processCapabilities(ctx context.Context, resourceType directorresource.Type, resourceID string, packagesFromDB []*model.Package, capabilities []*model.CapabilityInput, resourceHashes map[string]uint64) ([]*model.Capability, error) {
+	capabilitiesFromDB, err := s.listCapabilitiesInTx(ctx, resourceType, resourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, capability := range capabilities {
+		capabilityHash := resourceHashes[capability.OrdID]
+		if err := s.resyncCapabilityInTx(ctx, resourceType, resourceID, capabilitiesFromDB, packagesFromDB, capability, capabilityHash); err != nil {
+			return nil, err
+		}
+	}
+
+	capabilitiesFromDB, err = s.listCapabilitiesInTx(ctx, resourceType, resourceID)
+	if err != nil {
+		return nil, err
+	}
+	return capabilitiesFromDB, nil
+}
+
+func (s *Service) listCapabilitiesInTx(ctx context.Context, resourceType directorresource.Type, resourceID string) ([]*model.Capability, error) {
+	tx, err := s.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer s.transact.RollbackUnlessCommitted(ctx, tx)
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	var capabilitiesFromDB []*model.Capability
+	if resourceType == directorresource.Application {
+		capabilitiesFromDB, err = s.capabilitySvc.ListByApplicationID(ctx, resourceID)
+	} else if resourceType == directorresource.ApplicationTemplateVersion {
+		capabilitiesFromDB, err = s.capabilitySvc.ListByApplicationTemplateVersionID(ctx, resourceID)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "error while listing capabilities for %s with id %q", resourceType, resourceID)
+	}
+
+	return capabilitiesFromDB, tx.Commit()
+}
+
+func (s *Service) resyncCapabilityInTx(ctx context.Context, resourceType directorresource.Type, resourceID string, capabilitiesFromDB []*model.Capability, packagesFromDB []*model.Package, capability *model.CapabilityInput, capabilityHash uint64) error {
+	tx, err := s.transact.Begin()
+	if err != nil {
+		return err
+	}
+	defer s.transact.RollbackUnlessCommitted(ctx, tx)
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	if err := s.resyncCapability(ctx, resourceType, resourceID, capabilitiesFromDB, packagesFromDB, *capability, capabilityHash); err != nil {
+		return errors.Wrapf(err, "error while resyncing capability with ORD ID %q", capability.OrdID)
+	}
+	return tx.Commit()
+}
+
+func (s *Service) processIntegrationDependencies(ctx context.Context, resourceType directorresource.Type, resourceID string, packagesFromDB []*model.Package, integrationDependencies []*model.IntegrationDependencyInput, resourceHashes map[string]uint64) ([]*model.IntegrationDependency, error) {
+	integrationDependenciesFromDB, err := s.listIntegrationDependenciesInTx(ctx, resourceType, resourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, integrationDependency := range integrationDependencies {
+		integrationDependencyHash := resourceHashes[integrationDependency.OrdID]
+		if err := s.resyncIntegrationDependencyInTx(ctx, resourceType, resourceID, integrationDependenciesFromDB, packagesFromDB, integrationDependency, integrationDependencyHash); err != nil {
+			return nil, err
+		}
+	}
+
+	integrationDependenciesFromDB, err = s.listIntegrationDependenciesInTx(ctx, resourceType, resourceID)
+	if err != nil {
+		return nil, err
+	}
+	return integrationDependenciesFromDB, nil
+}
+
+func (s *Service) listIntegrationDependenciesInTx(ctx context.Context, resourceType directorresource.Type, resourceID string) ([]*model.IntegrationDependency, error) {
+	tx, err := s.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer s.transact.RollbackUnlessCommitted(ctx, tx)
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	var integrationDependenciesFromDB []*model.IntegrationDependency
+	if resourceType == directorresource.Application {
+		integrationDependenciesFromDB, err = s.integrationDepSvc.ListByApplicationID(ctx, resourceID)
+	} else if resourceType == directorresource.ApplicationTemplateVersion {
+		integrationDependenciesFromDB, err = s.integrationDepSvc.ListByApplicationTemplateVersionID(ctx, resourceID)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "error while listing integration dependencies for %s with id %q", resourceType, resourceID)
+	}
+
+	return integrationDependenciesFromDB, tx.Commit()
+}
+
+func (s *Service) resyncIntegrationDependencyInTx(ctx context.Context, resourceType directorresource.Type, resourceID string, integrationDependenciesFromDB []*model.IntegrationDependency, packagesFromDB []*model.Package, integrationDependency *model.IntegrationDependencyInput, integrationDependencyHash uint64) error {
+	tx, err := s.transact.Begin()
+	if err != nil {
+		return err
+	}
+	defer s.transact.RollbackUnlessCommitted(ctx, tx)
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	if err := s.resyncIntegrationDependency(ctx, resourceType, resourceID, integrationDependenciesFromDB, packagesFromDB, *integrationDependency, integrationDependencyHash); err != nil {
+		return errors.Wrapf(err, "error while resyncing integration dependency with ORD ID %q", integrationDependency.OrdID)
+	}
+	return tx.Commit()
+}
+
 func (s *Service) processTombstones(ctx context.Context, resourceType directorresource.Type, resourceID string, tombstones []*model.TombstoneInput) ([]*model.Tombstone, error) {
 	tombstonesFromDB, err := s.listTombstonesInTx(ctx, resourceType, resourceID)
 	if err != nil {
@@ -1567,6 +1809,66 @@ func (s *Service) refetchFailedSpecs(ctx context.Context, resourceType directorr
 	return fetchRequests, nil
 }
 
+func (s *Service) resyncEntityType(ctx context.Context, resourceType directorresource.Type, resourceID string, entityTypesFromDB []*model.EntityType, packagesFromDB []*model.Package, entityType model.EntityTypeInput, entityTypeHash uint64) error {
+	ctx = addFieldToLogger(ctx, "entity_type_ord_id", entityType.OrdID)
+	j, found := searchInSlice(len(packagesFromDB), func(i int) bool {
+		return packagesFromDB[i].OrdID == entityType.OrdPackageID
+	})
+	if !found {
+		return errors.Errorf("package with ORD ID %q referenced by entity type with ORD ID %q was not found", entityType.OrdPackageID, entityType.OrdID)
+	}
+	packageID := packagesFromDB[j].ID
+
+	if i, found := searchInSlice(len(entityTypesFromDB), func(i int) bool {
+		return entityTypesFromDB[i].OrdID == entityType.OrdID
+	}); found {
+		return s.entityTypeSvc.Update(ctx, resourceType, entityTypesFromDB[i].ID, packageID, entityType, entityTypeHash)
+	}
+
+	_, err := s.entityTypeSvc.Create(ctx, resourceType, resourceID, packageID, entityType, entityTypeHash)
+	return err
+}
+
+func (s *Service) resyncCapability(ctx context.Context, resourceType directorresource.Type, resourceID string, capabilitiesFromDB []*model.Capability, packagesFromDB []*model.Package, capability model.CapabilityInput, capabilityHash uint64) error {
+	ctx = addFieldToLogger(ctx, "capability_ord_id", capability.OrdID)
+	j, found := searchInSlice(len(packagesFromDB), func(i int) bool {
+		return packagesFromDB[i].OrdID == capability.OrdPackageID
+	})
+	if !found {
+		return errors.Errorf("package with ORD ID %q referenced by capability with ORD ID %q was not found", capability.OrdPackageID, capability.OrdID)
+	}
+	packageID := packagesFromDB[j].ID
+
+	if i, found := searchInSlice(len(capabilitiesFromDB), func(i int) bool {
+		return capabilitiesFromDB[i].OrdID == capability.OrdID
+	}); found {
+		return s.capabilitySvc.Update(ctx, resourceType, capabilitiesFromDB[i].ID, packageID, capability, capabilityHash)
+	}
+
+	_, err := s.capabilitySvc.Create(ctx, resourceType, resourceID, packageID, capability, capabilityHash)
+	return err
+}
+
+func (s *Service) resyncIntegrationDependency(ctx context.Context, resourceType directorresource.Type, resourceID string, integrationDependenciesFromDB []*model.IntegrationDependency, packagesFromDB []*model.Package, integrationDependency model.IntegrationDependencyInput, integrationDependencyHash uint64) error {
+	ctx = addFieldToLogger(ctx, "integration_dependency_ord_id", integrationDependency.OrdID)
+	j, found := searchInSlice(len(packagesFromDB), func(i int) bool {
+		return packagesFromDB[i].OrdID == integrationDependency.OrdPackageID
+	})
+	if !found {
+		return errors.Errorf("package with ORD ID %q referenced by integration dependency with ORD ID %q was not found", integrationDependency.OrdPackageID, integrationDependency.OrdID)
+	}
+	packageID := packagesFromDB[j].ID
+
+	if i, found := searchInSlice(len(integrationDependenciesFromDB), func(i int) bool {
+		return integrationDependenciesFromDB[i].OrdID == integrationDependency.OrdID
+	}); found {
+		return s.integrationDepSvc.Update(ctx, resourceType, integrationDependenciesFromDB[i].ID, packageID, integrationDependency, integrationDependencyHash)
+	}
+
+	_, err := s.integrationDepSvc.Create(ctx, resourceType, resourceID, packageID, integrationDependency, integrationDependencyHash)
+	return err
+}
+
 func (s *Service) resyncTombstone(ctx context.Context, resourceType directorresource.Type, resourceID string, tombstonesFromDB []*model.Tombstone, tombstone model.TombstoneInput) error {
 	if i, found := searchInSlice(len(tombstonesFromDB), func(i int) bool {
 		return tombstonesFromDB[i].OrdID == tombstone.OrdID
@@ -1652,6 +1954,78 @@ func (s *Service) fetchEventDefFromDB(ctx context.Context, resourceType director
 	return eventDataFromDB, nil
 }
 
+func (s *Service) fetchEntityTypesFromDB(ctx context.Context, resourceType directorresource.Type, resourceID string) (map[string]*model.EntityType, error) {
+	var (
+		entityTypesFromDB []*model.EntityType
+		err               error
+	)
+
+	if resourceType == directorresource.ApplicationTemplateVersion {
+		entityTypesFromDB, err = s.entityTypeSvc.ListByApplicationTemplateVersionID(ctx, resourceID)
+	} else {
+		entityTypesFromDB, err = s.entityTypeSvc.ListByApplicationID(ctx, resourceID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	entityTypeDataFromDB := make(map[string]*model.EntityType)
+
+	for _, entityType := range entityTypesFromDB {
+		entityTypeDataFromDB[entityType.OrdID] = entityType
+	}
+
+	return entityTypeDataFromDB, nil
+}
+
+func (s *Service) fetchCapabilitiesFromDB(ctx context.Context, resourceType directorresource.Type, resourceID string) (map[string]*model.Capability, error) {
+	var (
+		capabilitiesFromDB []*model.Capability
+		err                error
+	)
+
+	if resourceType == directorresource.ApplicationTemplateVersion {
+		capabilitiesFromDB, err = s.capabilitySvc.ListByApplicationTemplateVersionID(ctx, resourceID)
+	} else {
+		capabilitiesFromDB, err = s.capabilitySvc.ListByApplicationID(ctx, resourceID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	capabilityDataFromDB := make(map[string]*model.Capability)
+
+	for _, capability := range capabilitiesFromDB {
+		capabilityDataFromDB[capability.OrdID] = capability
+	}
+
+	return capabilityDataFromDB, nil
+}
+
+func (s *Service) fetchIntegrationDependenciesFromDB(ctx context.Context, resourceType directorresource.Type, resourceID string) (map[string]*model.IntegrationDependency, error) {
+	var (
+		integrationDependenciesFromDB []*model.IntegrationDependency
+		err                           error
+	)
+
+	if resourceType == directorresource.ApplicationTemplateVersion {
+		integrationDependenciesFromDB, err = s.integrationDepSvc.ListByApplicationTemplateVersionID(ctx, resourceID)
+	} else {
+		integrationDependenciesFromDB, err = s.integrationDepSvc.ListByApplicationID(ctx, resourceID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	integrationDependencyDataFromDB := make(map[string]*model.IntegrationDependency)
+
+	for _, integrationDependency := range integrationDependenciesFromDB {
+		integrationDependencyDataFromDB[integrationDependency.OrdID] = integrationDependency
+	}
+
+	return integrationDependencyDataFromDB, nil
+}
+
 func (s *Service) fetchBundlesFromDB(ctx context.Context, resourceType directorresource.Type, resourceID string) (map[string]*model.Bundle, error) {
 	var (
 		bundlesFromDB []*model.Bundle
@@ -1709,6 +2083,9 @@ func (s *Service) fetchResources(ctx context.Context, resource Resource, documen
 
 	apiDataFromDB := make(map[string]*model.APIDefinition)
 	eventDataFromDB := make(map[string]*model.EventDefinition)
+	entityTypeDataFromDB := make(map[string]*model.EntityType)
+	capabilityDataFromDB := make(map[string]*model.Capability)
+	integrationDependencyDataFromDB := make(map[string]*model.IntegrationDependency)
 	packageDataFromDB := make(map[string]*model.Package)
 	bundleDataFromDB := make(map[string]*model.Bundle)
 
@@ -1723,6 +2100,21 @@ func (s *Service) fetchResources(ctx context.Context, resource Resource, documen
 			return ResourcesFromDB{}, errors.Wrapf(err, "while fetching events for %s with id %s", resourceType, resourceID)
 		}
 
+		entityTypeData, err := s.fetchEntityTypesFromDB(ctx, resourceType, resourceID)
+		if err != nil {
+			return ResourcesFromDB{}, errors.Wrapf(err, "while fetching entity types for %s with id %s", resourceType, resourceID)
+		}
+
+		capabilityData, err := s.fetchCapabilitiesFromDB(ctx, resourceType, resourceID)
+		if err != nil {
+			return ResourcesFromDB{}, errors.Wrapf(err, "while fetching capabilities for %s with id %s", resourceType, resourceID)
+		}
+
+		integrationDependencyData, err := s.fetchIntegrationDependenciesFromDB(ctx, resourceType, resourceID)
+		if err != nil {
+			return ResourcesFromDB{}, errors.Wrapf(err, "while fetching integration dependencies for %s with id %s", resourceType, resourceID)
+		}
+
 		packageData, err := s.fetchPackagesFromDB(ctx, resourceType, resourceID)
 		if err != nil {
 			return ResourcesFromDB{}, errors.Wrapf(err, "while fetching packages for %s with id %s", resourceType, resourceID)
@@ -1739,6 +2131,15 @@ func (s *Service) fetchResources(ctx context.Context, resource Resource, documen
 		if err = mergo.Merge(&eventDataFromDB, eventData); err != nil {
 			return ResourcesFromDB{}, err
 		}
+		if err = mergo.Merge(&entityTypeDataFromDB, entityTypeData); err != nil {
+			return ResourcesFromDB{}, err
+		}
+		if err = mergo.Merge(&capabilityDataFromDB, capabilityData); err != nil {
+			return ResourcesFromDB{}, err
+		}
+		if err = mergo.Merge(&integrationDependencyDataFromDB, integrationDependencyData); err != nil {
+			return ResourcesFromDB{}, err
+		}
 		if err = mergo.Merge(&packageDataFromDB, packageData); err != nil {
 			return ResourcesFromDB{}, err
 		}
@@ -1748,18 +2149,22 @@ func (s *Service) fetchResources(ctx context.Context, resource Resource, documen
 	}
 
 	return ResourcesFromDB{
-		APIs:     apiDataFromDB,
-		Events:   eventDataFromDB,
-		Packages: packageDataFromDB,
-		Bundles:  bundleDataFromDB,
+		APIs:                    apiDataFromDB,
+		Events:                  eventDataFromDB,
+		EntityTypes:             entityTypeDataFromDB,
+		Capabilities:            capabilityDataFromDB,
+		Packages:                packageDataFromDB,
+		Bundles:                 bundleDataFromDB,
+		IntegrationDependencies: integrationDependencyDataFromDB,
 	}, tx.Commit()
 }
 
 func (s *Service) processWebhookAndDocuments(ctx context.Context, cfg MetricsConfig, webhook *model.Webhook, resource Resource, globalResourcesOrdIDs map[string]bool) error {
 	var (
-		documents Documents
-		baseURL   string
-		err       error
+		documents          Documents
+		baseURL            string
+		newFetchValidators []*model.FetchValidatorInput
+		err                error
 	)
 
 	metricsCfg := metrics.PusherConfig{
@@ -1775,7 +2180,12 @@ func (s *Service) processWebhookAndDocuments(ctx context.Context, cfg MetricsCon
 	ctx = addFieldToLogger(ctx, "resource_type", string(resource.Type))
 
 	if webhook.Type == model.WebhookTypeOpenResourceDiscovery && webhook.URL != nil {
-		documents, baseURL, err = s.ordClient.FetchOpenResourceDiscoveryDocuments(ctx, resource, webhook)
+		fetchValidators, err := s.listFetchValidators(ctx, webhook.ID, resource.ID)
+		if err != nil {
+			return err
+		}
+
+		documents, baseURL, newFetchValidators, err = s.ordClient.FetchOpenResourceDiscoveryDocuments(ctx, resource, webhook, fetchValidators)
 		if err != nil {
 			metricsPusher := metrics.NewAggregationFailurePusher(metricsCfg)
 			metricsPusher.ReportAggregationFailureORD(ctx, err.Error())
@@ -1813,10 +2223,46 @@ func (s *Service) processWebhookAndDocuments(ctx context.Context, cfg MetricsCon
 			return errors.Wrapf(ordValidationError.Err, "error processing ORD documents")
 		}
 		log.C(ctx).Info("Successfully processed ORD documents")
+
+		if err = s.replaceFetchValidators(ctx, webhook.ID, resource.ID, newFetchValidators); err != nil {
+			log.C(ctx).WithError(err).Errorf("error while storing fetch validators: %v", err)
+			return err
+		}
 	}
 	return nil
 }
 
+func (s *Service) listFetchValidators(ctx context.Context, webhookID, resourceID string) ([]*model.FetchValidator, error) {
+	tx, err := s.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer s.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+	fetchValidators, err := s.fetchValidatorSvc.ListByWebhookIDAndResourceID(ctx, webhookID, resourceID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error while listing fetch validators for webhook with id %q", webhookID)
+	}
+
+	return fetchValidators, tx.Commit()
+}
+
+func (s *Service) replaceFetchValidators(ctx context.Context, webhookID, resourceID string, in []*model.FetchValidatorInput) error {
+	tx, err := s.transact.Begin()
+	if err != nil {
+		return err
+	}
+	defer s.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+	if err = s.fetchValidatorSvc.Replace(ctx, webhookID, resourceID, in); err != nil {
+		return errors.Wrapf(err, "error while storing fetch validators for webhook with id %q", webhookID)
+	}
+
+	return tx.Commit()
+}
+
 func (s *Service) getWebhooksWithOrdType(ctx context.Context) ([]*model.Webhook, error) {
 	tx, err := s.transact.Begin()
 	if err != nil {
@@ -2017,6 +2463,48 @@ func hashResources(docs Documents) (map[string]uint64, error) {
 			resourceHashes[str.PtrStrToStr(eventInput.OrdID)] = hash
 		}
 
+		for _, entityTypeInput := range doc.EntityTypes {
+			normalizedEntityType, err := normalizeEntityType(entityTypeInput)
+			if err != nil {
+				return nil, err
+			}
+
+			hash, err := HashObject(normalizedEntityType)
+			if err != nil {
+				return nil, errors.Wrapf(err, "while hashing entity type with ORD ID: %s", normalizedEntityType.OrdID)
+			}
+
+			resourceHashes[entityTypeInput.OrdID] = hash
+		}
+
+		for _, capabilityInput := range doc.Capabilities {
+			normalizedCapability, err := normalizeCapability(capabilityInput)
+			if err != nil {
+				return nil, err
+			}
+
+			hash, err := HashObject(normalizedCapability)
+			if err != nil {
+				return nil, errors.Wrapf(err, "while hashing capability with ORD ID: %s", normalizedCapability.OrdID)
+			}
+
+			resourceHashes[capabilityInput.OrdID] = hash
+		}
+
+		for _, integrationDependencyInput := range doc.IntegrationDependencies {
+			normalizedIntegrationDependency, err := normalizeIntegrationDependency(integrationDependencyInput)
+			if err != nil {
+				return nil, err
+			}
+
+			hash, err := HashObject(normalizedIntegrationDependency)
+			if err != nil {
+				return nil, errors.Wrapf(err, "while hashing integration dependency with ORD ID: %s", normalizedIntegrationDependency.OrdID)
+			}
+
+			resourceHashes[integrationDependencyInput.OrdID] = hash
+		}
+
 		for _, packageInput := range doc.Packages {
 			normalizedPkg, err := normalizePackage(packageInput)
 			if err != nil {
@@ -328,6 +328,99 @@ func TestService_SyncORDDocuments(t *testing.T) {
 		return packagesSvc
 	}
 
+	successfulEntityTypeFetch := func() *automock.EntityTypeService {
+		entityTypeSvc := &automock.EntityTypeService{}
+		entityTypeSvc.On("ListByApplicationID", txtest.CtxWithDBMatcher(), appID).Return(nil, nil).Once()
+		return entityTypeSvc
+	}
+
+	successfulEntityTypeList := func() *automock.EntityTypeService {
+		entityTypeSvc := &automock.EntityTypeService{}
+		entityTypeSvc.On("ListByApplicationID", txtest.CtxWithDBMatcher(), appID).Return(nil, nil).Times(3)
+		return entityTypeSvc
+	}
+
+	successfulEntityTypeFetchForStaticDoc := func() *automock.EntityTypeService {
+		entityTypeSvc := &automock.EntityTypeService{}
+		entityTypeSvc.On("ListByApplicationTemplateVersionID", txtest.CtxWithDBMatcher(), appTemplateVersionID).Return(nil, nil).Once()
+		return entityTypeSvc
+	}
+
+	successfulEntityTypeListForStaticDoc := func() *automock.EntityTypeService {
+		entityTypeSvc := &automock.EntityTypeService{}
+		entityTypeSvc.On("ListByApplicationTemplateVersionID", txtest.CtxWithDBMatcher(), appTemplateVersionID).Return(nil, nil).Times(3)
+		return entityTypeSvc
+	}
+
+	successfulEntityTypeListForStaticDocWithApplication := func() *automock.EntityTypeService {
+		entityTypeSvc := &automock.EntityTypeService{}
+		entityTypeSvc.On("ListByApplicationID", txtest.CtxWithDBMatcher(), appID).Return(nil, nil).Once()
+		entityTypeSvc.On("ListByApplicationTemplateVersionID", txtest.CtxWithDBMatcher(), appTemplateVersionID).Return(nil, nil).Times(6)
+		return entityTypeSvc
+	}
+
+	successfulCapabilityFetch := func() *automock.CapabilityService {
+		capabilitySvc := &automock.CapabilityService{}
+		capabilitySvc.On("ListByApplicationID", txtest.CtxWithDBMatcher(), appID).Return(nil, nil).Once()
+		return capabilitySvc
+	}
+
+	successfulCapabilityList := func() *automock.CapabilityService {
+		capabilitySvc := &automock.CapabilityService{}
+		capabilitySvc.On("ListByApplicationID", txtest.CtxWithDBMatcher(), appID).Return(nil, nil).Times(3)
+		return capabilitySvc
+	}
+
+	successfulCapabilityFetchForStaticDoc := func() *automock.CapabilityService {
+		capabilitySvc := &automock.CapabilityService{}
+		capabilitySvc.On("ListByApplicationTemplateVersionID", txtest.CtxWithDBMatcher(), appTemplateVersionID).Return(nil, nil).Once()
+		return capabilitySvc
+	}
+
+	successfulCapabilityListForStaticDoc := func() *automock.CapabilityService {
+		capabilitySvc := &automock.CapabilityService{}
+		capabilitySvc.On("ListByApplicationTemplateVersionID", txtest.CtxWithDBMatcher(), appTemplateVersionID).Return(nil, nil).Times(3)
+		return capabilitySvc
+	}
+
+	successfulCapabilityListForStaticDocWithApplication := func() *automock.CapabilityService {
+		capabilitySvc := &automock.CapabilityService{}
+		capabilitySvc.On("ListByApplicationID", txtest.CtxWithDBMatcher(), appID).Return(nil, nil).Once()
+		capabilitySvc.On("ListByApplicationTemplateVersionID", txtest.CtxWithDBMatcher(), appTemplateVersionID).Return(nil, nil).Times(6)
+		return capabilitySvc
+	}
+
+	successfulIntegrationDependencyFetch := func() *automock.IntegrationDependencyService {
+		integrationDepSvc := &automock.IntegrationDependencyService{}
+		integrationDepSvc.On("ListByApplicationID", txtest.CtxWithDBMatcher(), appID).Return(nil, nil).Once()
+		return integrationDepSvc
+	}
+
+	successfulIntegrationDependencyList := func() *automock.IntegrationDependencyService {
+		integrationDepSvc := &automock.IntegrationDependencyService{}
+		integrationDepSvc.On("ListByApplicationID", txtest.CtxWithDBMatcher(), appID).Return(nil, nil).Times(3)
+		return integrationDepSvc
+	}
+
+	successfulIntegrationDependencyFetchForStaticDoc := func() *automock.IntegrationDependencyService {
+		integrationDepSvc := &automock.IntegrationDependencyService{}
+		integrationDepSvc.On("ListByApplicationTemplateVersionID", txtest.CtxWithDBMatcher(), appTemplateVersionID).Return(nil, nil).Once()
+		return integrationDepSvc
+	}
+
+	successfulIntegrationDependencyListForStaticDoc := func() *automock.IntegrationDependencyService {
+		integrationDepSvc := &automock.IntegrationDependencyService{}
+		integrationDepSvc.On("ListByApplicationTemplateVersionID", txtest.CtxWithDBMatcher(), appTemplateVersionID).Return(nil, nil).Times(3)
+		return integrationDepSvc
+	}
+
+	successfulIntegrationDependencyListForStaticDocWithApplication := func() *automock.IntegrationDependencyService {
+		integrationDepSvc := &automock.IntegrationDependencyService{}
+		integrationDepSvc.On("ListByApplicationID", txtest.CtxWithDBMatcher(), appID).Return(nil, nil).Once()
+		integrationDepSvc.On("ListByApplicationTemplateVersionID", txtest.CtxWithDBMatcher(), appTemplateVersionID).Return(nil, nil).Times(6)
+		return integrationDepSvc
+	}
+
 	successfulPackageUpdateForStaticDoc := func() *automock.PackageService {
 		packagesSvc := &automock.PackageService{}
 		packagesSvc.On("ListByApplicationTemplateVersionID", txtest.CtxWithDBMatcher(), appTemplateVersionID).Return(fixPackagesWithHash(), nil).Once()
@@ -851,20 +944,20 @@ func TestService_SyncORDDocuments(t *testing.T) {
 
 	successfulClientFetch := func() *automock.Client {
 		client := &automock.Client{}
-		client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication).Return(ord.Documents{fixORDDocument()}, baseURL, nil)
+		client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication, []*model.FetchValidator(nil)).Return(ord.Documents{fixORDDocument()}, baseURL, nil, nil)
 		return client
 	}
 
 	successfulClientFetchForStaticDoc := func() *automock.Client {
 		client := &automock.Client{}
-		client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResourceForAppTemplate, testWebhookForAppTemplate).Return(ord.Documents{fixORDStaticDocument()}, baseURL, nil)
+		client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResourceForAppTemplate, testWebhookForAppTemplate, []*model.FetchValidator(nil)).Return(ord.Documents{fixORDStaticDocument()}, baseURL, nil, nil)
 		return client
 	}
 
 	successfulClientFetchForStaticDocOnAppTemplateWithApplications := func() *automock.Client {
 		client := &automock.Client{}
-		client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResourceForAppTemplate, testWebhookForAppTemplate).Return(ord.Documents{fixORDStaticDocument()}, baseURL, nil).Once()
-		client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForAppTemplate).Return(ord.Documents{fixORDStaticDocument()}, baseURL, nil).Once()
+		client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResourceForAppTemplate, testWebhookForAppTemplate, []*model.FetchValidator(nil)).Return(ord.Documents{fixORDStaticDocument()}, baseURL, nil, nil).Once()
+		client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForAppTemplate, []*model.FetchValidator(nil)).Return(ord.Documents{fixORDStaticDocument()}, baseURL, nil, nil).Once()
 		return client
 	}
 
@@ -905,6 +998,62 @@ func TestService_SyncORDDocuments(t *testing.T) {
 		return svc
 	}
 
+	fetchValidators := []*model.FetchValidator{
+		{
+			ID:         "fetch-validator-id",
+			WebhookID:  testWebhookForApplication.ID,
+			ResourceID: appID,
+			URL:        baseURL + ord.WellKnownEndpoint,
+			ETag:       str.Ptr("etag"),
+			Content:    str.Ptr("{}"),
+		},
+	}
+	fetchValidatorInputs := []*model.FetchValidatorInput{
+		{
+			URL:     baseURL + ord.WellKnownEndpoint,
+			ETag:    str.Ptr("new-etag"),
+			Content: str.Ptr("{}"),
+		},
+	}
+
+	successfulFetchValidatorListAndReplace := func() *automock.FetchValidatorService {
+		svc := &automock.FetchValidatorService{}
+		svc.On("ListByWebhookIDAndResourceID", txtest.CtxWithDBMatcher(), testWebhookForApplication.ID, appID).Return(fetchValidators, nil).Once()
+		svc.On("Replace", txtest.CtxWithDBMatcher(), testWebhookForApplication.ID, appID, fetchValidatorInputs).Return(nil).Once()
+		return svc
+	}
+
+	successfulFetchValidatorList := func() *automock.FetchValidatorService {
+		svc := &automock.FetchValidatorService{}
+		svc.On("ListByWebhookIDAndResourceID", txtest.CtxWithDBMatcher(), testWebhookForApplication.ID, appID).Return(nil, nil).Once()
+		return svc
+	}
+
+	successfulFetchValidatorListAndReplaceWithoutValidators := func() *automock.FetchValidatorService {
+		svc := successfulFetchValidatorList()
+		svc.On("Replace", txtest.CtxWithDBMatcher(), testWebhookForApplication.ID, appID, []*model.FetchValidatorInput(nil)).Return(nil).Once()
+		return svc
+	}
+
+	successfulFetchValidatorListForAppTemplate := func() *automock.FetchValidatorService {
+		svc := &automock.FetchValidatorService{}
+		svc.On("ListByWebhookIDAndResourceID", txtest.CtxWithDBMatcher(), testWebhookForAppTemplate.ID, appTemplateID).Return(nil, nil).Once()
+		return svc
+	}
+
+	successfulFetchValidatorListAndReplaceForAppTemplate := func() *automock.FetchValidatorService {
+		svc := successfulFetchValidatorListForAppTemplate()
+		svc.On("Replace", txtest.CtxWithDBMatcher(), testWebhookForAppTemplate.ID, appTemplateID, []*model.FetchValidatorInput(nil)).Return(nil).Once()
+		return svc
+	}
+
+	successfulFetchValidatorListAndReplaceForAppTemplateAndApp := func() *automock.FetchValidatorService {
+		svc := successfulFetchValidatorListAndReplaceForAppTemplate()
+		svc.On("ListByWebhookIDAndResourceID", txtest.CtxWithDBMatcher(), testWebhookForAppTemplate.ID, appID).Return(nil, nil).Once()
+		svc.On("Replace", txtest.CtxWithDBMatcher(), testWebhookForAppTemplate.ID, appID, []*model.FetchValidatorInput(nil)).Return(nil).Once()
+		return svc
+	}
+
 	testCases := []struct {
 		Name                    string
 		TransactionerFn         func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
@@ -915,6 +1064,9 @@ func TestService_SyncORDDocuments(t *testing.T) {
 		bundleRefSvcFn          func() *automock.BundleReferenceService
 		apiSvcFn                func() *automock.APIService
 		eventSvcFn              func() *automock.EventService
+		entityTypeSvcFn         func() *automock.EntityTypeService
+		capabilitySvcFn         func() *automock.CapabilityService
+		integrationDepSvcFn     func() *automock.IntegrationDependencyService
 		specSvcFn               func() *automock.SpecService
 		fetchReqFn              func() *automock.FetchRequestService
 		packageSvcFn            func() *automock.PackageService
@@ -925,13 +1077,14 @@ func TestService_SyncORDDocuments(t *testing.T) {
 		globalRegistrySvcFn     func() *automock.GlobalRegistryService
 		appTemplateVersionSvcFn func() *automock.ApplicationTemplateVersionService
 		appTemplateSvcFn        func() *automock.ApplicationTemplateService
+		fetchValidatorSvcFn     func() *automock.FetchValidatorService
 		clientFn                func() *automock.Client
 		ExpectedErr             error
 	}{
 		{
 			Name: "Success for Application Template webhook with Static ORD data when resources are already in db and APIs/Events versions are incremented should Update them and resync API/Event specs",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
-				return txGen.ThatSucceedsMultipleTimes(35)
+				return txGen.ThatSucceedsMultipleTimes(43)
 			},
 			appSvcFn:       successfulAppTemplateNoAppsAppSvc,
 			webhookSvcFn:   successfulWebhookListAppTemplate,
@@ -956,12 +1109,16 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			appTemplateVersionSvcFn: successfulAppTemplateVersionListAndUpdate,
 			appTemplateSvcFn:        successAppTemplateGetSvc,
 			globalRegistrySvcFn:     successfulGlobalRegistrySvc,
+			fetchValidatorSvcFn:     successfulFetchValidatorListAndReplaceForAppTemplate,
 			clientFn:                successfulClientFetchForStaticDoc,
+			entityTypeSvcFn:         successfulEntityTypeListForStaticDoc,
+			capabilitySvcFn:         successfulCapabilityListForStaticDoc,
+			integrationDepSvcFn:     successfulIntegrationDependencyListForStaticDoc,
 		},
 		{
 			Name: "Success for Application Template and Applications webhook with Static ORD data when resources are already in db and APIs/Events versions are incremented should Update them and resync API/Event specs",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
-				return txGen.ThatSucceedsMultipleTimes(68)
+				return txGen.ThatSucceedsMultipleTimes(84)
 			},
 			tenantSvcFn:    successfulTenantSvc,
 			appSvcFn:       successfulAppTemplateAppSvc,
@@ -989,12 +1146,16 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			appTemplateVersionSvcFn: successfulAppTemplateVersionListAndUpdateForApplication,
 			appTemplateSvcFn:        successAppTemplateGetSvc,
 			globalRegistrySvcFn:     successfulGlobalRegistrySvc,
+			fetchValidatorSvcFn:     successfulFetchValidatorListAndReplaceForAppTemplateAndApp,
 			clientFn:                successfulClientFetchForStaticDocOnAppTemplateWithApplications,
+			entityTypeSvcFn:         successfulEntityTypeListForStaticDocWithApplication,
+			capabilitySvcFn:         successfulCapabilityListForStaticDocWithApplication,
+			integrationDepSvcFn:     successfulIntegrationDependencyListForStaticDocWithApplication,
 		},
 		{
 			Name: "Success when resources are already in db and APIs/Events versions are incremented should Update them and resync API/Event specs",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
-				return txGen.ThatSucceedsMultipleTimes(32)
+				return txGen.ThatSucceedsMultipleTimes(40)
 			},
 			appSvcFn:       successfulAppGet,
 			tenantSvcFn:    successfulTenantSvc,
@@ -1026,12 +1187,16 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			},
 			appTemplateVersionSvcFn: successfulAppTemplateVersionList,
 			globalRegistrySvcFn:     successfulGlobalRegistrySvc,
+			fetchValidatorSvcFn:     successfulFetchValidatorListAndReplaceWithoutValidators,
 			clientFn:                successfulClientFetch,
+			entityTypeSvcFn:         successfulEntityTypeList,
+			capabilitySvcFn:         successfulCapabilityList,
+			integrationDepSvcFn:     successfulIntegrationDependencyList,
 		},
 		{
 			Name: "Success when resources are already in db and APIs/Events versions are NOT incremented should Update them and refetch only failed API/Event specs",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
-				return txGen.ThatSucceedsMultipleTimes(32)
+				return txGen.ThatSucceedsMultipleTimes(40)
 			},
 			appSvcFn:       successfulAppGet,
 			tenantSvcFn:    successfulTenantSvc,
@@ -1070,12 +1235,16 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			},
 			appTemplateVersionSvcFn: successfulAppTemplateVersionList,
 			globalRegistrySvcFn:     successfulGlobalRegistrySvc,
+			fetchValidatorSvcFn:     successfulFetchValidatorListAndReplaceWithoutValidators,
 			clientFn:                successfulClientFetch,
+			entityTypeSvcFn:         successfulEntityTypeList,
+			capabilitySvcFn:         successfulCapabilityList,
+			integrationDepSvcFn:     successfulIntegrationDependencyList,
 		},
 		{
 			Name: "Success when resources are not in db should Create them",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
-				return txGen.ThatSucceedsMultipleTimes(32)
+				return txGen.ThatSucceedsMultipleTimes(40)
 			},
 			appSvcFn:                successfulAppGet,
 			tenantSvcFn:             successfulTenantSvc,
@@ -1092,12 +1261,66 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			tombstoneSvcFn:          successfulTombstoneCreate,
 			appTemplateVersionSvcFn: successfulAppTemplateVersionList,
 			globalRegistrySvcFn:     successfulGlobalRegistrySvc,
+			fetchValidatorSvcFn:     successfulFetchValidatorListAndReplaceWithoutValidators,
 			clientFn:                successfulClientFetch,
+			entityTypeSvcFn:         successfulEntityTypeList,
+			capabilitySvcFn:         successfulCapabilityList,
+			integrationDepSvcFn:     successfulIntegrationDependencyList,
+		},
+		{
+			Name: "Success when resources are not in db should Create them and store the new fetch validators",
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimes(40)
+			},
+			appSvcFn:                successfulAppGet,
+			tenantSvcFn:             successfulTenantSvc,
+			webhookSvcFn:            successfulTenantMappingOnlyCreation,
+			webhookConvFn:           successfulWebhookConversion,
+			bundleSvcFn:             successfulBundleCreate,
+			apiSvcFn:                successfulAPICreateAndDelete,
+			eventSvcFn:              successfulEventCreate,
+			specSvcFn:               successfulSpecCreateAndUpdate,
+			fetchReqFn:              successfulFetchRequestFetchAndUpdate,
+			packageSvcFn:            successfulPackageCreate,
+			productSvcFn:            successfulProductCreate,
+			vendorSvcFn:             successfulVendorCreate,
+			tombstoneSvcFn:          successfulTombstoneCreate,
+			appTemplateVersionSvcFn: successfulAppTemplateVersionList,
+			globalRegistrySvcFn:     successfulGlobalRegistrySvc,
+			fetchValidatorSvcFn:     successfulFetchValidatorListAndReplace,
+			clientFn: func() *automock.Client {
+				client := &automock.Client{}
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication, fetchValidators).Return(ord.Documents{fixORDDocument()}, baseURL, fetchValidatorInputs, nil)
+				return client
+			},
+			entityTypeSvcFn:     successfulEntityTypeList,
+			capabilitySvcFn:     successfulCapabilityList,
+			integrationDepSvcFn: successfulIntegrationDependencyList,
+		},
+		{
+			Name: "Success when ORD documents are not modified should not process them",
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimes(3)
+			},
+			appSvcFn:     successfulAppGet,
+			tenantSvcFn:  successfulTenantSvc,
+			webhookSvcFn: successfulWebhookList,
+			fetchValidatorSvcFn: func() *automock.FetchValidatorService {
+				svc := &automock.FetchValidatorService{}
+				svc.On("ListByWebhookIDAndResourceID", txtest.CtxWithDBMatcher(), testWebhookForApplication.ID, appID).Return(fetchValidators, nil).Once()
+				return svc
+			},
+			clientFn: func() *automock.Client {
+				client := &automock.Client{}
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication, fetchValidators).Return(nil, baseURL, nil, nil)
+				return client
+			},
+			globalRegistrySvcFn: successfulGlobalRegistrySvc,
 		},
 		{
 			Name: "Success when resources are not in db should Create them for a Static document",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
-				return txGen.ThatSucceedsMultipleTimes(35)
+				return txGen.ThatSucceedsMultipleTimes(43)
 			},
 			appSvcFn:     successfulAppTemplateNoAppsAppSvc,
 			webhookSvcFn: successfulWebhookListAppTemplate,
@@ -1123,12 +1346,16 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			appTemplateVersionSvcFn: successfulAppTemplateVersionForCreation,
 			appTemplateSvcFn:        successAppTemplateGetSvc,
 			globalRegistrySvcFn:     successfulGlobalRegistrySvc,
+			fetchValidatorSvcFn:     successfulFetchValidatorListAndReplaceForAppTemplate,
 			clientFn:                successfulClientFetchForStaticDoc,
+			entityTypeSvcFn:         successfulEntityTypeListForStaticDoc,
+			capabilitySvcFn:         successfulCapabilityListForStaticDoc,
+			integrationDepSvcFn:     successfulIntegrationDependencyListForStaticDoc,
 		},
 		{
 			Name: "Error when creating Application Template Version based on the doc",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
-				return txGen.ThatSucceedsMultipleTimesAndCommitsMultipleTimes(4, 3)
+				return txGen.ThatSucceedsMultipleTimesAndCommitsMultipleTimes(5, 4)
 			},
 			webhookSvcFn: successfulWebhookListAppTemplate,
 			appTemplateVersionSvcFn: func() *automock.ApplicationTemplateVersionService {
@@ -1139,6 +1366,7 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			},
 			appTemplateSvcFn:    successAppTemplateGetSvc,
 			globalRegistrySvcFn: successfulGlobalRegistrySvc,
+			fetchValidatorSvcFn: successfulFetchValidatorListForAppTemplate,
 			clientFn:            successfulClientFetchForStaticDoc,
 		},
 		{
@@ -1157,7 +1385,7 @@ func TestService_SyncORDDocuments(t *testing.T) {
 		{
 			Name: "Error when listing Application Template Version by app template ID",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
-				return txGen.ThatSucceedsMultipleTimesAndCommitsMultipleTimes(3, 2)
+				return txGen.ThatSucceedsMultipleTimesAndCommitsMultipleTimes(4, 3)
 			},
 			webhookSvcFn: successfulWebhookListAppTemplate,
 			appTemplateVersionSvcFn: func() *automock.ApplicationTemplateVersionService {
@@ -1167,17 +1395,18 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			},
 			appTemplateSvcFn:    successAppTemplateGetSvc,
 			globalRegistrySvcFn: successfulGlobalRegistrySvc,
+			fetchValidatorSvcFn: successfulFetchValidatorListForAppTemplate,
 			clientFn:            successfulClientFetchForStaticDoc,
 		},
 		{
 			Name: "Error when fetching the Application Template for the given dynamic doc",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
 				persistTx := &persistenceautomock.PersistenceTx{}
-				persistTx.On("Commit").Return(nil).Times(5)
+				persistTx.On("Commit").Return(nil).Times(6)
 
 				transact := &persistenceautomock.Transactioner{}
-				transact.On("Begin").Return(persistTx, nil).Times(6)
-				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(5)
+				transact.On("Begin").Return(persistTx, nil).Times(7)
+				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(6)
 				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true).Once()
 				return persistTx, transact
 			},
@@ -1192,17 +1421,18 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			},
 			appTemplateSvcFn:    successAppTemplateGetSvc,
 			globalRegistrySvcFn: successfulGlobalRegistrySvc,
+			fetchValidatorSvcFn: successfulFetchValidatorListForAppTemplate,
 			clientFn:            successfulClientFetchForStaticDoc,
 		},
 		{
 			Name: "Error when fetching the Application Template for the given dynamic doc for a second time",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
 				persistTx := &persistenceautomock.PersistenceTx{}
-				persistTx.On("Commit").Return(nil).Times(7)
+				persistTx.On("Commit").Return(nil).Times(8)
 
 				transact := &persistenceautomock.Transactioner{}
-				transact.On("Begin").Return(persistTx, nil).Times(8)
-				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(7)
+				transact.On("Begin").Return(persistTx, nil).Times(9)
+				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(8)
 				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true).Once()
 				return persistTx, transact
 			},
@@ -1239,17 +1469,21 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			},
 			appTemplateSvcFn:    successAppTemplateGetSvc,
 			globalRegistrySvcFn: successfulGlobalRegistrySvc,
+			fetchValidatorSvcFn: successfulFetchValidatorListForAppTemplate,
 			clientFn:            successfulClientFetchForStaticDoc,
+			entityTypeSvcFn:     successfulEntityTypeFetchForStaticDoc,
+			capabilitySvcFn:     successfulCapabilityFetchForStaticDoc,
+			integrationDepSvcFn: successfulIntegrationDependencyFetchForStaticDoc,
 		},
 		{
 			Name: "Error when fetching the packages from the DB",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
 				persistTx := &persistenceautomock.PersistenceTx{}
-				persistTx.On("Commit").Return(nil).Times(6)
+				persistTx.On("Commit").Return(nil).Times(7)
 
 				transact := &persistenceautomock.Transactioner{}
-				transact.On("Begin").Return(persistTx, nil).Times(7)
-				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(6)
+				transact.On("Begin").Return(persistTx, nil).Times(8)
+				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(7)
 				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true).Once()
 				return persistTx, transact
 			},
@@ -1280,17 +1514,21 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			},
 			appTemplateSvcFn:    successAppTemplateGetSvc,
 			globalRegistrySvcFn: successfulGlobalRegistrySvc,
+			fetchValidatorSvcFn: successfulFetchValidatorListForAppTemplate,
 			clientFn:            successfulClientFetchForStaticDoc,
+			entityTypeSvcFn:     successfulEntityTypeFetchForStaticDoc,
+			capabilitySvcFn:     successfulCapabilityFetchForStaticDoc,
+			integrationDepSvcFn: successfulIntegrationDependencyFetchForStaticDoc,
 		},
 		{
 			Name: "Error when fetching the bundles from the DB",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
 				persistTx := &persistenceautomock.PersistenceTx{}
-				persistTx.On("Commit").Return(nil).Times(6)
+				persistTx.On("Commit").Return(nil).Times(7)
 
 				transact := &persistenceautomock.Transactioner{}
-				transact.On("Begin").Return(persistTx, nil).Times(7)
-				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(6)
+				transact.On("Begin").Return(persistTx, nil).Times(8)
+				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(7)
 				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true).Once()
 				return persistTx, transact
 			},
@@ -1325,12 +1563,16 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			},
 			appTemplateSvcFn:    successAppTemplateGetSvc,
 			globalRegistrySvcFn: successfulGlobalRegistrySvc,
+			fetchValidatorSvcFn: successfulFetchValidatorListForAppTemplate,
 			clientFn:            successfulClientFetchForStaticDoc,
+			entityTypeSvcFn:     successfulEntityTypeFetchForStaticDoc,
+			capabilitySvcFn:     successfulCapabilityFetchForStaticDoc,
+			integrationDepSvcFn: successfulIntegrationDependencyFetchForStaticDoc,
 		},
 		{
 			Name: "Success when there is ORD webhook on app template",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
-				return txGen.ThatSucceedsMultipleTimes(37)
+				return txGen.ThatSucceedsMultipleTimes(47)
 			},
 			appSvcFn: func() *automock.ApplicationService {
 				appSvc := &automock.ApplicationService{}
@@ -1368,6 +1610,7 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			appTemplateSvcFn:        successAppTemplateGetSvc,
 			appTemplateVersionSvcFn: successfulAppTemplateVersionListForAppTemplateFlow,
 			globalRegistrySvcFn:     successfulGlobalRegistrySvc,
+			fetchValidatorSvcFn:     successfulFetchValidatorListAndReplaceForAppTemplateAndApp,
 			clientFn: func() *automock.Client {
 				client := &automock.Client{}
 				testResources := ord.Resource{
@@ -1376,15 +1619,18 @@ func TestService_SyncORDDocuments(t *testing.T) {
 					Name:     testApplication.Name,
 					ParentID: &appTemplateID,
 				}
-				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResourceForAppTemplate, testWebhookForAppTemplate).Return(ord.Documents{fixORDDocument()}, baseURL, nil).Once()
-				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResources, testWebhookForAppTemplate).Return(ord.Documents{fixORDDocument()}, baseURL, nil).Once()
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResourceForAppTemplate, testWebhookForAppTemplate, []*model.FetchValidator(nil)).Return(ord.Documents{fixORDDocument()}, baseURL, nil, nil).Once()
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResources, testWebhookForAppTemplate, []*model.FetchValidator(nil)).Return(ord.Documents{fixORDDocument()}, baseURL, nil, nil).Once()
 				return client
 			},
+			entityTypeSvcFn:     successfulEntityTypeList,
+			capabilitySvcFn:     successfulCapabilityList,
+			integrationDepSvcFn: successfulIntegrationDependencyList,
 		},
 		{
 			Name: "Error when synchronizing global resources from global registry should get them from DB and proceed with the rest of the sync",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
-				return txGen.ThatSucceedsMultipleTimes(32)
+				return txGen.ThatSucceedsMultipleTimes(40)
 			},
 			appSvcFn:                successfulAppGet,
 			tenantSvcFn:             successfulTenantSvc,
@@ -1406,12 +1652,16 @@ func TestService_SyncORDDocuments(t *testing.T) {
 				globalRegistrySvcFn.On("ListGlobalResources", context.TODO()).Return(map[string]bool{ord.SapVendor: true}, nil).Once()
 				return globalRegistrySvcFn
 			},
-			clientFn: successfulClientFetch,
+			fetchValidatorSvcFn: successfulFetchValidatorListAndReplaceWithoutValidators,
+			clientFn:            successfulClientFetch,
+			entityTypeSvcFn:     successfulEntityTypeList,
+			capabilitySvcFn:     successfulCapabilityList,
+			integrationDepSvcFn: successfulIntegrationDependencyList,
 		},
 		{
 			Name: "Error when synchronizing global resources from global registry and get them from DB should proceed with the rest of the sync",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
-				return txGen.ThatSucceedsMultipleTimes(32)
+				return txGen.ThatSucceedsMultipleTimes(40)
 			},
 			appSvcFn:                successfulAppGet,
 			tenantSvcFn:             successfulTenantSvc,
@@ -1433,7 +1683,11 @@ func TestService_SyncORDDocuments(t *testing.T) {
 				globalRegistrySvcFn.On("ListGlobalResources", context.TODO()).Return(nil, errors.New("error")).Once()
 				return globalRegistrySvcFn
 			},
-			clientFn: successfulClientFetch,
+			fetchValidatorSvcFn: successfulFetchValidatorListAndReplaceWithoutValidators,
+			clientFn:            successfulClientFetch,
+			entityTypeSvcFn:     successfulEntityTypeList,
+			capabilitySvcFn:     successfulCapabilityList,
+			integrationDepSvcFn: successfulIntegrationDependencyList,
 		},
 		{
 			Name:            "Returns error when list by webhook type fails",
@@ -1576,18 +1830,19 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			Name: "Does not resync resources when event list fails",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
 				persistTx := &persistenceautomock.PersistenceTx{}
-				persistTx.On("Commit").Return(nil).Times(5)
+				persistTx.On("Commit").Return(nil).Times(6)
 
 				transact := &persistenceautomock.Transactioner{}
-				transact.On("Begin").Return(persistTx, nil).Times(5)
-				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(4)
+				transact.On("Begin").Return(persistTx, nil).Times(6)
+				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(5)
 				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true).Once()
 				return persistTx, transact
 			},
-			webhookSvcFn: successfulWebhookList,
-			clientFn:     successfulClientFetch,
-			appSvcFn:     successfulAppGet,
-			tenantSvcFn:  successfulTenantSvc,
+			webhookSvcFn:        successfulWebhookList,
+			fetchValidatorSvcFn: successfulFetchValidatorList,
+			clientFn:            successfulClientFetch,
+			appSvcFn:            successfulAppGet,
+			tenantSvcFn:         successfulTenantSvc,
 			apiSvcFn: func() *automock.APIService {
 				apiSvc := &automock.APIService{}
 				apiSvc.On("ListByApplicationID", txtest.CtxWithDBMatcher(), appID).Return(nil, nil).Once()
@@ -1605,18 +1860,19 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			Name: "Does not resync resources when api list fails",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
 				persistTx := &persistenceautomock.PersistenceTx{}
-				persistTx.On("Commit").Return(nil).Times(5)
+				persistTx.On("Commit").Return(nil).Times(6)
 
 				transact := &persistenceautomock.Transactioner{}
-				transact.On("Begin").Return(persistTx, nil).Times(5)
-				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(4)
+				transact.On("Begin").Return(persistTx, nil).Times(6)
+				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(5)
 				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true).Once()
 				return persistTx, transact
 			},
-			webhookSvcFn: successfulWebhookList,
-			clientFn:     successfulClientFetch,
-			appSvcFn:     successfulAppGet,
-			tenantSvcFn:  successfulTenantSvc,
+			webhookSvcFn:        successfulWebhookList,
+			fetchValidatorSvcFn: successfulFetchValidatorList,
+			clientFn:            successfulClientFetch,
+			appSvcFn:            successfulAppGet,
+			tenantSvcFn:         successfulTenantSvc,
 			apiSvcFn: func() *automock.APIService {
 				apiSvc := &automock.APIService{}
 				apiSvc.On("ListByApplicationID", txtest.CtxWithDBMatcher(), appID).Return(nil, testErr).Once()
@@ -1629,11 +1885,11 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			Name: "Returns error when list all applications by app template id fails",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
 				persistTx := &persistenceautomock.PersistenceTx{}
-				persistTx.On("Commit").Return(nil).Times(5)
+				persistTx.On("Commit").Return(nil).Times(7)
 
 				transact := &persistenceautomock.Transactioner{}
-				transact.On("Begin").Return(persistTx, nil).Times(6)
-				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(5)
+				transact.On("Begin").Return(persistTx, nil).Times(8)
+				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(7)
 				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true).Once()
 				return persistTx, transact
 			},
@@ -1647,10 +1903,11 @@ func TestService_SyncORDDocuments(t *testing.T) {
 				whSvc.On("ListByWebhookType", txtest.CtxWithDBMatcher(), model.WebhookTypeOpenResourceDiscovery).Return(fixOrdWebhooksForAppTemplate(), nil).Once()
 				return whSvc
 			},
-			appTemplateSvcFn: successAppTemplateGetSvc,
+			appTemplateSvcFn:    successAppTemplateGetSvc,
+			fetchValidatorSvcFn: successfulFetchValidatorListAndReplaceForAppTemplate,
 			clientFn: func() *automock.Client {
 				client := &automock.Client{}
-				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResourceForAppTemplate, testWebhookForAppTemplate).Return(ord.Documents{fixORDDocument()}, baseURL, nil)
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResourceForAppTemplate, testWebhookForAppTemplate, []*model.FetchValidator(nil)).Return(ord.Documents{fixORDDocument()}, baseURL, nil, nil)
 				return client
 			},
 			appTemplateVersionSvcFn: successfulAppTemplateVersionList,
@@ -1660,11 +1917,11 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			Name: "Returns error when get internal tenant id fails for ORD webhook for app template",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
 				persistTx := &persistenceautomock.PersistenceTx{}
-				persistTx.On("Commit").Return(nil).Times(6)
+				persistTx.On("Commit").Return(nil).Times(8)
 
 				transact := &persistenceautomock.Transactioner{}
-				transact.On("Begin").Return(persistTx, nil).Times(7)
-				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(6)
+				transact.On("Begin").Return(persistTx, nil).Times(9)
+				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(8)
 				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true).Once()
 				return persistTx, transact
 			},
@@ -1683,10 +1940,11 @@ func TestService_SyncORDDocuments(t *testing.T) {
 				whSvc.On("ListByWebhookType", txtest.CtxWithDBMatcher(), model.WebhookTypeOpenResourceDiscovery).Return(fixOrdWebhooksForAppTemplate(), nil).Once()
 				return whSvc
 			},
-			appTemplateSvcFn: successAppTemplateGetSvc,
+			appTemplateSvcFn:    successAppTemplateGetSvc,
+			fetchValidatorSvcFn: successfulFetchValidatorListAndReplaceForAppTemplate,
 			clientFn: func() *automock.Client {
 				client := &automock.Client{}
-				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResourceForAppTemplate, testWebhookForAppTemplate).Return(ord.Documents{fixORDDocument()}, baseURL, nil)
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResourceForAppTemplate, testWebhookForAppTemplate, []*model.FetchValidator(nil)).Return(ord.Documents{fixORDDocument()}, baseURL, nil, nil)
 				return client
 			},
 			appTemplateVersionSvcFn: successfulAppTemplateVersionList,
@@ -1696,11 +1954,11 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			Name: "Returns error when get tenant id fails for ORD webhook for app template",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
 				persistTx := &persistenceautomock.PersistenceTx{}
-				persistTx.On("Commit").Return(nil).Times(6)
+				persistTx.On("Commit").Return(nil).Times(8)
 
 				transact := &persistenceautomock.Transactioner{}
-				transact.On("Begin").Return(persistTx, nil).Times(7)
-				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(6)
+				transact.On("Begin").Return(persistTx, nil).Times(9)
+				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(8)
 				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true).Once()
 				return persistTx, transact
 			},
@@ -1720,10 +1978,11 @@ func TestService_SyncORDDocuments(t *testing.T) {
 				whSvc.On("ListByWebhookType", txtest.CtxWithDBMatcher(), model.WebhookTypeOpenResourceDiscovery).Return(fixOrdWebhooksForAppTemplate(), nil).Once()
 				return whSvc
 			},
-			appTemplateSvcFn: successAppTemplateGetSvc,
+			appTemplateSvcFn:    successAppTemplateGetSvc,
+			fetchValidatorSvcFn: successfulFetchValidatorListAndReplaceForAppTemplate,
 			clientFn: func() *automock.Client {
 				client := &automock.Client{}
-				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResourceForAppTemplate, testWebhookForAppTemplate).Return(ord.Documents{fixORDDocument()}, baseURL, nil)
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResourceForAppTemplate, testWebhookForAppTemplate, []*model.FetchValidator(nil)).Return(ord.Documents{fixORDDocument()}, baseURL, nil, nil)
 				return client
 			},
 			appTemplateVersionSvcFn: successfulAppTemplateVersionList,
@@ -1733,11 +1992,11 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			Name: "Returns error when application locking fails for ORD webhook for app template",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
 				persistTx := &persistenceautomock.PersistenceTx{}
-				persistTx.On("Commit").Return(nil).Times(6)
+				persistTx.On("Commit").Return(nil).Times(8)
 
 				transact := &persistenceautomock.Transactioner{}
-				transact.On("Begin").Return(persistTx, nil).Times(7)
-				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(6)
+				transact.On("Begin").Return(persistTx, nil).Times(9)
+				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(8)
 				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true).Once()
 				return persistTx, transact
 			},
@@ -1753,10 +2012,11 @@ func TestService_SyncORDDocuments(t *testing.T) {
 				whSvc.On("ListByWebhookType", txtest.CtxWithDBMatcher(), model.WebhookTypeOpenResourceDiscovery).Return(fixOrdWebhooksForAppTemplate(), nil).Once()
 				return whSvc
 			},
-			appTemplateSvcFn: successAppTemplateGetSvc,
+			appTemplateSvcFn:    successAppTemplateGetSvc,
+			fetchValidatorSvcFn: successfulFetchValidatorListAndReplaceForAppTemplate,
 			clientFn: func() *automock.Client {
 				client := &automock.Client{}
-				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResourceForAppTemplate, testWebhookForAppTemplate).Return(ord.Documents{fixORDDocument()}, baseURL, nil)
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResourceForAppTemplate, testWebhookForAppTemplate, []*model.FetchValidator(nil)).Return(ord.Documents{fixORDDocument()}, baseURL, nil, nil)
 				return client
 			},
 			appTemplateVersionSvcFn: successfulAppTemplateVersionList,
@@ -1766,20 +2026,21 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			Name: "Skips webhook when ORD documents fetch fails",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
 				persistTx := &persistenceautomock.PersistenceTx{}
-				persistTx.On("Commit").Return(nil).Twice()
+				persistTx.On("Commit").Return(nil).Times(3)
 
 				transact := &persistenceautomock.Transactioner{}
-				transact.On("Begin").Return(persistTx, nil).Twice()
-				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Once()
+				transact.On("Begin").Return(persistTx, nil).Times(3)
+				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(2)
 				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true).Once()
 				return persistTx, transact
 			},
-			appSvcFn:     successfulAppGet,
-			tenantSvcFn:  successfulTenantSvc,
-			webhookSvcFn: successfulWebhookList,
+			appSvcFn:            successfulAppGet,
+			tenantSvcFn:         successfulTenantSvc,
+			webhookSvcFn:        successfulWebhookList,
+			fetchValidatorSvcFn: successfulFetchValidatorList,
 			clientFn: func() *automock.Client {
 				client := &automock.Client{}
-				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication).Return(nil, "", testErr)
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication, []*model.FetchValidator(nil)).Return(nil, "", nil, testErr)
 				return client
 			},
 			globalRegistrySvcFn: successfulGlobalRegistrySvc,
@@ -1787,7 +2048,7 @@ func TestService_SyncORDDocuments(t *testing.T) {
 		{
 			Name: "Update application local tenant id when ord local id is unique and application does not have local tenant id",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
-				return txGen.ThatSucceedsMultipleTimes(32)
+				return txGen.ThatSucceedsMultipleTimes(40)
 			},
 			appSvcFn: func() *automock.ApplicationService {
 				appSvc := &automock.ApplicationService{}
@@ -1823,24 +2084,28 @@ func TestService_SyncORDDocuments(t *testing.T) {
 				return tombstoneSvc
 			},
 			globalRegistrySvcFn: successfulGlobalRegistrySvc,
+			fetchValidatorSvcFn: successfulFetchValidatorListAndReplaceWithoutValidators,
 			clientFn: func() *automock.Client {
 				client := &automock.Client{}
 				doc := fixORDDocument()
 				doc.DescribedSystemInstance.LocalTenantID = str.Ptr("ordLocalID")
-				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication).Return(ord.Documents{doc}, baseURL, nil)
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication, []*model.FetchValidator(nil)).Return(ord.Documents{doc}, baseURL, nil, nil)
 				return client
 			},
 			appTemplateVersionSvcFn: successfulAppTemplateVersionList,
+			entityTypeSvcFn:         successfulEntityTypeList,
+			capabilitySvcFn:         successfulCapabilityList,
+			integrationDepSvcFn:     successfulIntegrationDependencyList,
 		},
 		{
 			Name: "Fails to update application local tenant id when ord local id is unique and application does not have local tenant id",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
 				persistTx := &persistenceautomock.PersistenceTx{}
-				persistTx.On("Commit").Return(nil).Times(5)
+				persistTx.On("Commit").Return(nil).Times(6)
 
 				transact := &persistenceautomock.Transactioner{}
-				transact.On("Begin").Return(persistTx, nil).Times(5)
-				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(4)
+				transact.On("Begin").Return(persistTx, nil).Times(6)
+				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(5)
 				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true).Once()
 				return persistTx, transact
 			},
@@ -1874,24 +2139,28 @@ func TestService_SyncORDDocuments(t *testing.T) {
 				return packagesSvc
 			},
 			globalRegistrySvcFn: successfulGlobalRegistrySvc,
+			fetchValidatorSvcFn: successfulFetchValidatorList,
 			clientFn: func() *automock.Client {
 				client := &automock.Client{}
 				doc := fixORDDocument()
 				doc.DescribedSystemInstance.LocalTenantID = str.Ptr("ordLocalID")
-				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication).Return(ord.Documents{doc}, baseURL, nil)
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication, []*model.FetchValidator(nil)).Return(ord.Documents{doc}, baseURL, nil, nil)
 				return client
 			},
 			appTemplateVersionSvcFn: successfulAppTemplateVersionList,
+			entityTypeSvcFn:         successfulEntityTypeFetch,
+			capabilitySvcFn:         successfulCapabilityFetch,
+			integrationDepSvcFn:     successfulIntegrationDependencyFetch,
 		},
 		{
 			Name: "Resync resources for invalid ORD documents when event resource name is empty",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
 				persistTx := &persistenceautomock.PersistenceTx{}
-				persistTx.On("Commit").Return(nil).Times(32)
+				persistTx.On("Commit").Return(nil).Times(39)
 
 				transact := &persistenceautomock.Transactioner{}
-				transact.On("Begin").Return(persistTx, nil).Times(31)
-				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(31)
+				transact.On("Begin").Return(persistTx, nil).Times(38)
+				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(38)
 
 				return persistTx, transact
 			},
@@ -1909,24 +2178,28 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			vendorSvcFn:         successfulVendorCreate,
 			tombstoneSvcFn:      successfulTombstoneCreate,
 			globalRegistrySvcFn: successfulGlobalRegistrySvc,
+			fetchValidatorSvcFn: successfulFetchValidatorList,
 			clientFn: func() *automock.Client {
 				client := &automock.Client{}
 				doc := fixORDDocument()
 				doc.EventResources[0].Name = "" // invalid document
-				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication).Return(ord.Documents{doc}, *doc.DescribedSystemInstance.BaseURL, nil)
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication, []*model.FetchValidator(nil)).Return(ord.Documents{doc}, *doc.DescribedSystemInstance.BaseURL, nil, nil)
 				return client
 			},
 			appTemplateVersionSvcFn: successfulAppTemplateVersionList,
+			entityTypeSvcFn:         successfulEntityTypeList,
+			capabilitySvcFn:         successfulCapabilityList,
+			integrationDepSvcFn:     successfulIntegrationDependencyList,
 		},
 		{
 			Name: "Resync resources for invalid ORD documents when bundle name is empty",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
 				persistTx := &persistenceautomock.PersistenceTx{}
-				persistTx.On("Commit").Return(nil).Times(31)
+				persistTx.On("Commit").Return(nil).Times(38)
 
 				transact := &persistenceautomock.Transactioner{}
-				transact.On("Begin").Return(persistTx, nil).Times(30)
-				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(30)
+				transact.On("Begin").Return(persistTx, nil).Times(37)
+				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(37)
 
 				return persistTx, transact
 			},
@@ -1963,24 +2236,28 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			vendorSvcFn:         successfulVendorCreate,
 			tombstoneSvcFn:      successfulTombstoneCreate,
 			globalRegistrySvcFn: successfulGlobalRegistrySvc,
+			fetchValidatorSvcFn: successfulFetchValidatorList,
 			clientFn: func() *automock.Client {
 				client := &automock.Client{}
 				doc := fixORDDocument()
 				doc.ConsumptionBundles[0].Name = ""
-				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication).Return(ord.Documents{doc}, *doc.DescribedSystemInstance.BaseURL, nil)
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication, []*model.FetchValidator(nil)).Return(ord.Documents{doc}, *doc.DescribedSystemInstance.BaseURL, nil, nil)
 				return client
 			},
 			appTemplateVersionSvcFn: successfulAppTemplateVersionList,
+			entityTypeSvcFn:         successfulEntityTypeList,
+			capabilitySvcFn:         successfulCapabilityList,
+			integrationDepSvcFn:     successfulIntegrationDependencyList,
 		},
 		{
 			Name: "Resync resources for invalid ORD documents when vendor ordID is empty",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
 				persistTx := &persistenceautomock.PersistenceTx{}
-				persistTx.On("Commit").Return(nil).Times(32)
+				persistTx.On("Commit").Return(nil).Times(39)
 
 				transact := &persistenceautomock.Transactioner{}
-				transact.On("Begin").Return(persistTx, nil).Times(31)
-				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(31)
+				transact.On("Begin").Return(persistTx, nil).Times(38)
+				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(38)
 
 				return persistTx, transact
 			},
@@ -2004,24 +2281,28 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			},
 			tombstoneSvcFn:      successfulTombstoneCreate,
 			globalRegistrySvcFn: successfulGlobalRegistrySvc,
+			fetchValidatorSvcFn: successfulFetchValidatorList,
 			clientFn: func() *automock.Client {
 				client := &automock.Client{}
 				doc := fixORDDocument()
 				doc.Vendors[0].OrdID = ""
-				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication).Return(ord.Documents{doc}, *doc.DescribedSystemInstance.BaseURL, nil)
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication, []*model.FetchValidator(nil)).Return(ord.Documents{doc}, *doc.DescribedSystemInstance.BaseURL, nil, nil)
 				return client
 			},
 			appTemplateVersionSvcFn: successfulAppTemplateVersionList,
+			entityTypeSvcFn:         successfulEntityTypeList,
+			capabilitySvcFn:         successfulCapabilityList,
+			integrationDepSvcFn:     successfulIntegrationDependencyList,
 		},
 		{
 			Name: "Resync resources for invalid ORD documents when product title is empty",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
 				persistTx := &persistenceautomock.PersistenceTx{}
-				persistTx.On("Commit").Return(nil).Times(32)
+				persistTx.On("Commit").Return(nil).Times(39)
 
 				transact := &persistenceautomock.Transactioner{}
-				transact.On("Begin").Return(persistTx, nil).Times(31)
-				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(31)
+				transact.On("Begin").Return(persistTx, nil).Times(38)
+				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(38)
 
 				return persistTx, transact
 			},
@@ -2043,24 +2324,28 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			vendorSvcFn:         successfulVendorCreate,
 			tombstoneSvcFn:      successfulTombstoneCreate,
 			globalRegistrySvcFn: successfulGlobalRegistrySvc,
+			fetchValidatorSvcFn: successfulFetchValidatorList,
 			clientFn: func() *automock.Client {
 				client := &automock.Client{}
 				doc := fixORDDocument()
 				doc.Products[0].Title = ""
-				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication).Return(ord.Documents{doc}, *doc.DescribedSystemInstance.BaseURL, nil)
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication, []*model.FetchValidator(nil)).Return(ord.Documents{doc}, *doc.DescribedSystemInstance.BaseURL, nil, nil)
 				return client
 			},
 			appTemplateVersionSvcFn: successfulAppTemplateVersionList,
+			entityTypeSvcFn:         successfulEntityTypeList,
+			capabilitySvcFn:         successfulCapabilityList,
+			integrationDepSvcFn:     successfulIntegrationDependencyList,
 		},
 		{
 			Name: "Resync resources for invalid ORD documents when package title is empty",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
 				persistTx := &persistenceautomock.PersistenceTx{}
-				persistTx.On("Commit").Return(nil).Times(5)
+				persistTx.On("Commit").Return(nil).Times(6)
 
 				transact := &persistenceautomock.Transactioner{}
-				transact.On("Begin").Return(persistTx, nil).Times(5)
-				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(5)
+				transact.On("Begin").Return(persistTx, nil).Times(6)
+				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(6)
 
 				return persistTx, transact
 			},
@@ -2098,24 +2383,28 @@ func TestService_SyncORDDocuments(t *testing.T) {
 				return tombstoneSvc
 			},
 			globalRegistrySvcFn: successfulGlobalRegistrySvc,
+			fetchValidatorSvcFn: successfulFetchValidatorList,
 			clientFn: func() *automock.Client {
 				client := &automock.Client{}
 				doc := fixORDDocument()
 				doc.Packages[0].Title = ""
-				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication).Return(ord.Documents{doc}, *doc.DescribedSystemInstance.BaseURL, nil)
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication, []*model.FetchValidator(nil)).Return(ord.Documents{doc}, *doc.DescribedSystemInstance.BaseURL, nil, nil)
 				return client
 			},
 			appTemplateVersionSvcFn: successfulAppTemplateVersionList,
+			entityTypeSvcFn:         successfulEntityTypeFetch,
+			capabilitySvcFn:         successfulCapabilityFetch,
+			integrationDepSvcFn:     successfulIntegrationDependencyFetch,
 		},
 		{
 			Name: "Does not resync resources if vendor list fails",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
 				persistTx := &persistenceautomock.PersistenceTx{}
-				persistTx.On("Commit").Return(nil).Times(6)
+				persistTx.On("Commit").Return(nil).Times(7)
 
 				transact := &persistenceautomock.Transactioner{}
-				transact.On("Begin").Return(persistTx, nil).Times(6)
-				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(5)
+				transact.On("Begin").Return(persistTx, nil).Times(7)
+				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(6)
 				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true).Once()
 				return persistTx, transact
 			},
@@ -2128,22 +2417,26 @@ func TestService_SyncORDDocuments(t *testing.T) {
 				vendorSvc.On("ListByApplicationID", txtest.CtxWithDBMatcher(), appID).Return(nil, testErr).Once()
 				return vendorSvc
 			},
+			fetchValidatorSvcFn:     successfulFetchValidatorList,
 			clientFn:                successfulClientFetch,
 			apiSvcFn:                successfulEmptyAPIList,
 			eventSvcFn:              successfulEmptyEventList,
 			packageSvcFn:            successfulEmptyPackageList,
 			globalRegistrySvcFn:     successfulGlobalRegistrySvc,
 			appTemplateVersionSvcFn: successfulAppTemplateVersionList,
+			entityTypeSvcFn:         successfulEntityTypeFetch,
+			capabilitySvcFn:         successfulCapabilityFetch,
+			integrationDepSvcFn:     successfulIntegrationDependencyFetch,
 		},
 		{
 			Name: "Fails to list vendors after resync",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
 				persistTx := &persistenceautomock.PersistenceTx{}
-				persistTx.On("Commit").Return(nil).Times(9)
+				persistTx.On("Commit").Return(nil).Times(10)
 
 				transact := &persistenceautomock.Transactioner{}
-				transact.On("Begin").Return(persistTx, nil).Times(9)
-				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(8)
+				transact.On("Begin").Return(persistTx, nil).Times(10)
+				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(9)
 				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true).Once()
 				return persistTx, transact
 			},
@@ -2159,22 +2452,26 @@ func TestService_SyncORDDocuments(t *testing.T) {
 				vendorSvc.On("ListByApplicationID", txtest.CtxWithDBMatcher(), appID).Return(nil, testErr).Once()
 				return vendorSvc
 			},
+			fetchValidatorSvcFn:     successfulFetchValidatorList,
 			clientFn:                successfulClientFetch,
 			apiSvcFn:                successfulEmptyAPIList,
 			eventSvcFn:              successfulEmptyEventList,
 			packageSvcFn:            successfulEmptyPackageList,
 			globalRegistrySvcFn:     successfulGlobalRegistrySvc,
 			appTemplateVersionSvcFn: successfulAppTemplateVersionList,
+			entityTypeSvcFn:         successfulEntityTypeFetch,
+			capabilitySvcFn:         successfulCapabilityFetch,
+			integrationDepSvcFn:     successfulIntegrationDependencyFetch,
 		},
 		{
 			Name: "Does not resync resources if vendor update fails",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
 				persistTx := &persistenceautomock.PersistenceTx{}
-				persistTx.On("Commit").Return(nil).Times(6)
+				persistTx.On("Commit").Return(nil).Times(7)
 
 				transact := &persistenceautomock.Transactioner{}
-				transact.On("Begin").Return(persistTx, nil).Times(7)
-				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(6)
+				transact.On("Begin").Return(persistTx, nil).Times(8)
+				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(7)
 				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true).Once()
 				return persistTx, transact
 			},
@@ -2188,22 +2485,26 @@ func TestService_SyncORDDocuments(t *testing.T) {
 				vendorSvc.On("Update", txtest.CtxWithDBMatcher(), resource.Application, vendorID, *sanitizedDoc.Vendors[0]).Return(testErr).Once()
 				return vendorSvc
 			},
+			fetchValidatorSvcFn:     successfulFetchValidatorList,
 			clientFn:                successfulClientFetch,
 			apiSvcFn:                successfulEmptyAPIList,
 			eventSvcFn:              successfulEmptyEventList,
 			packageSvcFn:            successfulEmptyPackageList,
 			globalRegistrySvcFn:     successfulGlobalRegistrySvc,
 			appTemplateVersionSvcFn: successfulAppTemplateVersionList,
+			entityTypeSvcFn:         successfulEntityTypeFetch,
+			capabilitySvcFn:         successfulCapabilityFetch,
+			integrationDepSvcFn:     successfulIntegrationDependencyFetch,
 		},
 		{
 			Name: "Does not resync resources if vendor create fails",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
 				persistTx := &persistenceautomock.PersistenceTx{}
-				persistTx.On("Commit").Return(nil).Times(6)
+				persistTx.On("Commit").Return(nil).Times(7)
 
 				transact := &persistenceautomock.Transactioner{}
-				transact.On("Begin").Return(persistTx, nil).Times(7)
-				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(6)
+				transact.On("Begin").Return(persistTx, nil).Times(8)
+				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(7)
 				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true).Once()
 				return persistTx, transact
 			},
@@ -2217,22 +2518,26 @@ func TestService_SyncORDDocuments(t *testing.T) {
 				vendorSvc.On("Create", txtest.CtxWithDBMatcher(), resource.Application, appID, *sanitizedDoc.Vendors[0]).Return("", testErr).Once()
 				return vendorSvc
 			},
+			fetchValidatorSvcFn:     successfulFetchValidatorList,
 			clientFn:                successfulClientFetch,
 			apiSvcFn:                successfulEmptyAPIList,
 			eventSvcFn:              successfulEmptyEventList,
 			packageSvcFn:            successfulEmptyPackageList,
 			globalRegistrySvcFn:     successfulGlobalRegistrySvc,
 			appTemplateVersionSvcFn: successfulAppTemplateVersionList,
+			entityTypeSvcFn:         successfulEntityTypeFetch,
+			capabilitySvcFn:         successfulCapabilityFetch,
+			integrationDepSvcFn:     successfulIntegrationDependencyFetch,
 		},
 		{
 			Name: "Does not resync resources if product list fails",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
 				persistTx := &persistenceautomock.PersistenceTx{}
-				persistTx.On("Commit").Return(nil).Times(10)
+				persistTx.On("Commit").Return(nil).Times(11)
 
 				transact := &persistenceautomock.Transactioner{}
-				transact.On("Begin").Return(persistTx, nil).Times(10)
-				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(9)
+				transact.On("Begin").Return(persistTx, nil).Times(11)
+				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(10)
 				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true).Once()
 				return persistTx, transact
 			},
@@ -2246,22 +2551,26 @@ func TestService_SyncORDDocuments(t *testing.T) {
 				productSvc.On("ListByApplicationID", txtest.CtxWithDBMatcher(), appID).Return(nil, testErr).Once()
 				return productSvc
 			},
+			fetchValidatorSvcFn:     successfulFetchValidatorList,
 			clientFn:                successfulClientFetch,
 			apiSvcFn:                successfulEmptyAPIList,
 			eventSvcFn:              successfulEmptyEventList,
 			packageSvcFn:            successfulEmptyPackageList,
 			globalRegistrySvcFn:     successfulGlobalRegistrySvc,
 			appTemplateVersionSvcFn: successfulAppTemplateVersionList,
+			entityTypeSvcFn:         successfulEntityTypeFetch,
+			capabilitySvcFn:         successfulCapabilityFetch,
+			integrationDepSvcFn:     successfulIntegrationDependencyFetch,
 		},
 		{
 			Name: "Fails to list products after resync",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
 				persistTx := &persistenceautomock.PersistenceTx{}
-				persistTx.On("Commit").Return(nil).Times(12)
+				persistTx.On("Commit").Return(nil).Times(13)
 
 				transact := &persistenceautomock.Transactioner{}
-				transact.On("Begin").Return(persistTx, nil).Times(12)
-				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(11)
+				transact.On("Begin").Return(persistTx, nil).Times(13)
+				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(12)
 				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true).Once()
 				return persistTx, transact
 			},
@@ -2278,22 +2587,26 @@ func TestService_SyncORDDocuments(t *testing.T) {
 
 				return productSvc
 			},
+			fetchValidatorSvcFn:     successfulFetchValidatorList,
 			clientFn:                successfulClientFetch,
 			apiSvcFn:                successfulEmptyAPIList,
 			eventSvcFn:              successfulEmptyEventList,
 			packageSvcFn:            successfulEmptyPackageList,
 			globalRegistrySvcFn:     successfulGlobalRegistrySvc,
 			appTemplateVersionSvcFn: successfulAppTemplateVersionList,
+			entityTypeSvcFn:         successfulEntityTypeFetch,
+			capabilitySvcFn:         successfulCapabilityFetch,
+			integrationDepSvcFn:     successfulIntegrationDependencyFetch,
 		},
 		{
 			Name: "Does not resync resources if product update fails",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
 				persistTx := &persistenceautomock.PersistenceTx{}
-				persistTx.On("Commit").Return(nil).Times(10)
+				persistTx.On("Commit").Return(nil).Times(11)
 
 				transact := &persistenceautomock.Transactioner{}
-				transact.On("Begin").Return(persistTx, nil).Times(11)
-				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(10)
+				transact.On("Begin").Return(persistTx, nil).Times(12)
+				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(11)
 				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true).Once()
 				return persistTx, transact
 			},
@@ -2308,22 +2621,26 @@ func TestService_SyncORDDocuments(t *testing.T) {
 				productSvc.On("Update", txtest.CtxWithDBMatcher(), resource.Application, productID, *sanitizedDoc.Products[0]).Return(testErr).Once()
 				return productSvc
 			},
+			fetchValidatorSvcFn:     successfulFetchValidatorList,
 			clientFn:                successfulClientFetch,
 			apiSvcFn:                successfulEmptyAPIList,
 			eventSvcFn:              successfulEmptyEventList,
 			packageSvcFn:            successfulEmptyPackageList,
 			globalRegistrySvcFn:     successfulGlobalRegistrySvc,
 			appTemplateVersionSvcFn: successfulAppTemplateVersionList,
+			entityTypeSvcFn:         successfulEntityTypeFetch,
+			capabilitySvcFn:         successfulCapabilityFetch,
+			integrationDepSvcFn:     successfulIntegrationDependencyFetch,
 		},
 		{
 			Name: "Does not resync resources if product create fails",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
 				persistTx := &persistenceautomock.PersistenceTx{}
-				persistTx.On("Commit").Return(nil).Times(10)
+				persistTx.On("Commit").Return(nil).Times(11)
 
 				transact := &persistenceautomock.Transactioner{}
-				transact.On("Begin").Return(persistTx, nil).Times(11)
-				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(10)
+				transact.On("Begin").Return(persistTx, nil).Times(12)
+				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(11)
 				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true).Once()
 				return persistTx, transact
 			},
@@ -2338,22 +2655,26 @@ func TestService_SyncORDDocuments(t *testing.T) {
 				productSvc.On("Create", txtest.CtxWithDBMatcher(), resource.Application, appID, *sanitizedDoc.Products[0]).Return("", testErr).Once()
 				return productSvc
 			},
+			fetchValidatorSvcFn:     successfulFetchValidatorList,
 			clientFn:                successfulClientFetch,
 			apiSvcFn:                successfulEmptyAPIList,
 			eventSvcFn:              successfulEmptyEventList,
 			packageSvcFn:            successfulEmptyPackageList,
 			globalRegistrySvcFn:     successfulGlobalRegistrySvc,
 			appTemplateVersionSvcFn: successfulAppTemplateVersionList,
+			entityTypeSvcFn:         successfulEntityTypeFetch,
+			capabilitySvcFn:         successfulCapabilityFetch,
+			integrationDepSvcFn:     successfulIntegrationDependencyFetch,
 		},
 		{
 			Name: "Does not resync resources if package list fails",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
 				persistTx := &persistenceautomock.PersistenceTx{}
-				persistTx.On("Commit").Return(nil).Times(13)
+				persistTx.On("Commit").Return(nil).Times(14)
 
 				transact := &persistenceautomock.Transactioner{}
-				transact.On("Begin").Return(persistTx, nil).Times(13)
-				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(12)
+				transact.On("Begin").Return(persistTx, nil).Times(14)
+				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(13)
 				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true).Once()
 				return persistTx, transact
 			},
@@ -2369,21 +2690,25 @@ func TestService_SyncORDDocuments(t *testing.T) {
 				packagesSvc.On("ListByApplicationID", txtest.CtxWithDBMatcher(), appID).Return(nil, testErr).Once()
 				return packagesSvc
 			},
+			fetchValidatorSvcFn:     successfulFetchValidatorList,
 			clientFn:                successfulClientFetch,
 			apiSvcFn:                successfulEmptyAPIList,
 			eventSvcFn:              successfulEmptyEventList,
 			globalRegistrySvcFn:     successfulGlobalRegistrySvc,
 			appTemplateVersionSvcFn: successfulAppTemplateVersionList,
+			entityTypeSvcFn:         successfulEntityTypeFetch,
+			capabilitySvcFn:         successfulCapabilityFetch,
+			integrationDepSvcFn:     successfulIntegrationDependencyFetch,
 		},
 		{
 			Name: "Fails to list packages after resync",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
 				persistTx := &persistenceautomock.PersistenceTx{}
-				persistTx.On("Commit").Return(nil).Times(15)
+				persistTx.On("Commit").Return(nil).Times(16)
 
 				transact := &persistenceautomock.Transactioner{}
-				transact.On("Begin").Return(persistTx, nil).Times(15)
-				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(14)
+				transact.On("Begin").Return(persistTx, nil).Times(16)
+				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(15)
 				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true).Once()
 				return persistTx, transact
 			},
@@ -2401,21 +2726,25 @@ func TestService_SyncORDDocuments(t *testing.T) {
 				packagesSvc.On("ListByApplicationID", txtest.CtxWithDBMatcher(), appID).Return(nil, testErr).Once()
 				return packagesSvc
 			},
+			fetchValidatorSvcFn:     successfulFetchValidatorList,
 			clientFn:                successfulClientFetch,
 			apiSvcFn:                successfulEmptyAPIList,
 			eventSvcFn:              successfulEmptyEventList,
 			globalRegistrySvcFn:     successfulGlobalRegistrySvc,
 			appTemplateVersionSvcFn: successfulAppTemplateVersionList,
+			entityTypeSvcFn:         successfulEntityTypeFetch,
+			capabilitySvcFn:         successfulCapabilityFetch,
+			integrationDepSvcFn:     successfulIntegrationDependencyFetch,
 		},
 		{
 			Name: "Does not resync resources if package update fails",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
 				persistTx := &persistenceautomock.PersistenceTx{}
-				persistTx.On("Commit").Return(nil).Times(13)
+				persistTx.On("Commit").Return(nil).Times(14)
 
 				transact := &persistenceautomock.Transactioner{}
-				transact.On("Begin").Return(persistTx, nil).Times(14)
-				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(13)
+				transact.On("Begin").Return(persistTx, nil).Times(15)
+				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(14)
 				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true).Once()
 				return persistTx, transact
 			},
@@ -2432,21 +2761,25 @@ func TestService_SyncORDDocuments(t *testing.T) {
 				packagesSvc.On("Update", txtest.CtxWithDBMatcher(), resource.Application, packageID, *sanitizedDoc.Packages[0], packagePreSanitizedHash).Return(testErr).Once()
 				return packagesSvc
 			},
+			fetchValidatorSvcFn:     successfulFetchValidatorList,
 			clientFn:                successfulClientFetch,
 			apiSvcFn:                successfulEmptyAPIList,
 			eventSvcFn:              successfulEmptyEventList,
 			globalRegistrySvcFn:     successfulGlobalRegistrySvc,
 			appTemplateVersionSvcFn: successfulAppTemplateVersionList,
+			entityTypeSvcFn:         successfulEntityTypeFetch,
+			capabilitySvcFn:         successfulCapabilityFetch,
+			integrationDepSvcFn:     successfulIntegrationDependencyFetch,
 		},
 		{
 			Name: "Does not resync resources if package create fails",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
 				persistTx := &persistenceautomock.PersistenceTx{}
-				persistTx.On("Commit").Return(nil).Times(13)
+				persistTx.On("Commit").Return(nil).Times(14)
 
 				transact := &persistenceautomock.Transactioner{}
-				transact.On("Begin").Return(persistTx, nil).Times(14)
-				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(13)
+				transact.On("Begin").Return(persistTx, nil).Times(15)
+				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(14)
 				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true).Once()
 				return persistTx, transact
 			},
@@ -2463,21 +2796,25 @@ func TestService_SyncORDDocuments(t *testing.T) {
 				packagesSvc.On("Create", txtest.CtxWithDBMatcher(), resource.Application, appID, *sanitizedDoc.Packages[0], mock.Anything).Return("", testErr).Once()
 				return packagesSvc
 			},
+			fetchValidatorSvcFn:     successfulFetchValidatorList,
 			clientFn:                successfulClientFetch,
 			apiSvcFn:                successfulEmptyAPIList,
 			eventSvcFn:              successfulEmptyEventList,
 			globalRegistrySvcFn:     successfulGlobalRegistrySvc,
 			appTemplateVersionSvcFn: successfulAppTemplateVersionList,
+			entityTypeSvcFn:         successfulEntityTypeFetch,
+			capabilitySvcFn:         successfulCapabilityFetch,
+			integrationDepSvcFn:     successfulIntegrationDependencyFetch,
 		},
 		{
 			Name: "Does not resync resources if bundle list fails",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
 				persistTx := &persistenceautomock.PersistenceTx{}
-				persistTx.On("Commit").Return(nil).Times(15)
+				persistTx.On("Commit").Return(nil).Times(16)
 
 				transact := &persistenceautomock.Transactioner{}
-				transact.On("Begin").Return(persistTx, nil).Times(16)
-				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(15)
+				transact.On("Begin").Return(persistTx, nil).Times(17)
+				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(16)
 				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true).Once()
 				return persistTx, transact
 			},
@@ -2493,21 +2830,25 @@ func TestService_SyncORDDocuments(t *testing.T) {
 				bundlesSvc.On("ListByApplicationIDNoPaging", txtest.CtxWithDBMatcher(), appID).Return(nil, testErr).Once()
 				return bundlesSvc
 			},
+			fetchValidatorSvcFn:     successfulFetchValidatorList,
 			clientFn:                successfulClientFetch,
 			apiSvcFn:                successfulEmptyAPIList,
 			eventSvcFn:              successfulEmptyEventList,
 			globalRegistrySvcFn:     successfulGlobalRegistrySvc,
 			appTemplateVersionSvcFn: successfulAppTemplateVersionList,
+			entityTypeSvcFn:         successfulEntityTypeFetch,
+			capabilitySvcFn:         successfulCapabilityFetch,
+			integrationDepSvcFn:     successfulIntegrationDependencyFetch,
 		},
 		{
 			Name: "Fails to list bundles after resync",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
 				persistTx := &persistenceautomock.PersistenceTx{}
-				persistTx.On("Commit").Return(nil).Times(18)
+				persistTx.On("Commit").Return(nil).Times(19)
 
 				transact := &persistenceautomock.Transactioner{}
-				transact.On("Begin").Return(persistTx, nil).Times(19)
-				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(18)
+				transact.On("Begin").Return(persistTx, nil).Times(20)
+				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(19)
 				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true).Once()
 				return persistTx, transact
 			},
@@ -2525,21 +2866,25 @@ func TestService_SyncORDDocuments(t *testing.T) {
 				bundlesSvc.On("ListByApplicationIDNoPaging", txtest.CtxWithDBMatcher(), appID).Return(nil, testErr).Once()
 				return bundlesSvc
 			},
+			fetchValidatorSvcFn:     successfulFetchValidatorList,
 			clientFn:                successfulClientFetch,
 			apiSvcFn:                successfulEmptyAPIList,
 			eventSvcFn:              successfulEmptyEventList,
 			globalRegistrySvcFn:     successfulGlobalRegistrySvc,
 			appTemplateVersionSvcFn: successfulAppTemplateVersionList,
+			entityTypeSvcFn:         successfulEntityTypeFetch,
+			capabilitySvcFn:         successfulCapabilityFetch,
+			integrationDepSvcFn:     successfulIntegrationDependencyFetch,
 		},
 		{
 			Name: "Does not resync resources if bundle update fails",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
 				persistTx := &persistenceautomock.PersistenceTx{}
-				persistTx.On("Commit").Return(nil).Times(16)
+				persistTx.On("Commit").Return(nil).Times(17)
 
 				transact := &persistenceautomock.Transactioner{}
-				transact.On("Begin").Return(persistTx, nil).Times(17)
-				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(16)
+				transact.On("Begin").Return(persistTx, nil).Times(18)
+				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(17)
 				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true).Once()
 				return persistTx, transact
 			},
@@ -2556,21 +2901,25 @@ func TestService_SyncORDDocuments(t *testing.T) {
 				bundlesSvc.On("UpdateBundle", txtest.CtxWithDBMatcher(), resource.Application, bundleID, bundleUpdateInputFromCreateInput(*sanitizedDoc.ConsumptionBundles[0]), bundlePreSanitizedHash).Return(testErr).Once()
 				return bundlesSvc
 			},
+			fetchValidatorSvcFn:     successfulFetchValidatorList,
 			clientFn:                successfulClientFetch,
 			apiSvcFn:                successfulEmptyAPIList,
 			eventSvcFn:              successfulEmptyEventList,
 			globalRegistrySvcFn:     successfulGlobalRegistrySvc,
 			appTemplateVersionSvcFn: successfulAppTemplateVersionList,
+			entityTypeSvcFn:         successfulEntityTypeFetch,
+			capabilitySvcFn:         successfulCapabilityFetch,
+			integrationDepSvcFn:     successfulIntegrationDependencyFetch,
 		},
 		{
 			Name: "Does not resync resources if bundle create fails",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
 				persistTx := &persistenceautomock.PersistenceTx{}
-				persistTx.On("Commit").Return(nil).Times(16)
+				persistTx.On("Commit").Return(nil).Times(17)
 
 				transact := &persistenceautomock.Transactioner{}
-				transact.On("Begin").Return(persistTx, nil).Times(17)
-				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(16)
+				transact.On("Begin").Return(persistTx, nil).Times(18)
+				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(17)
 				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true).Once()
 				return persistTx, transact
 			},
@@ -2587,36 +2936,41 @@ func TestService_SyncORDDocuments(t *testing.T) {
 				bundlesSvc.On("CreateBundle", txtest.CtxWithDBMatcher(), resource.Application, appID, *sanitizedDoc.ConsumptionBundles[0], mock.Anything).Return("", testErr).Once()
 				return bundlesSvc
 			},
+			fetchValidatorSvcFn:     successfulFetchValidatorList,
 			clientFn:                successfulClientFetch,
 			apiSvcFn:                successfulEmptyAPIList,
 			eventSvcFn:              successfulEmptyEventList,
 			globalRegistrySvcFn:     successfulGlobalRegistrySvc,
 			appTemplateVersionSvcFn: successfulAppTemplateVersionList,
+			entityTypeSvcFn:         successfulEntityTypeFetch,
+			capabilitySvcFn:         successfulCapabilityFetch,
+			integrationDepSvcFn:     successfulIntegrationDependencyFetch,
 		},
 		{
 			Name: "Does not resync resources if bundle have different tenant mapping configuration",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
 				persistTx := &persistenceautomock.PersistenceTx{}
-				persistTx.On("Commit").Return(nil).Times(17)
+				persistTx.On("Commit").Return(nil).Times(18)
 
 				transact := &persistenceautomock.Transactioner{}
-				transact.On("Begin").Return(persistTx, nil).Times(17)
+				transact.On("Begin").Return(persistTx, nil).Times(18)
 				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false)
 				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true)
 				return persistTx, transact
 			},
-			appSvcFn:     successfulAppGet,
-			tenantSvcFn:  successfulTenantSvc,
-			webhookSvcFn: successfulWebhookList,
-			productSvcFn: successfulProductCreate,
-			vendorSvcFn:  successfulVendorCreate,
-			packageSvcFn: successfulPackageCreate,
-			bundleSvcFn:  successfulListTwiceAndCreateBundle,
+			appSvcFn:            successfulAppGet,
+			tenantSvcFn:         successfulTenantSvc,
+			webhookSvcFn:        successfulWebhookList,
+			productSvcFn:        successfulProductCreate,
+			vendorSvcFn:         successfulVendorCreate,
+			packageSvcFn:        successfulPackageCreate,
+			bundleSvcFn:         successfulListTwiceAndCreateBundle,
+			fetchValidatorSvcFn: successfulFetchValidatorList,
 			clientFn: func() *automock.Client {
 				client := &automock.Client{}
 				doc := fixORDDocument()
 				doc.ConsumptionBundles[0].CredentialExchangeStrategies = json.RawMessage(fmt.Sprintf(credentialExchangeStrategiesWithMultipleSameTypesFormat, credentialExchangeStrategyType, credentialExchangeStrategyType))
-				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication).Return(ord.Documents{doc}, baseURL, nil)
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication, []*model.FetchValidator(nil)).Return(ord.Documents{doc}, baseURL, nil, nil)
 				return client
 			},
 			apiSvcFn: func() *automock.APIService {
@@ -2631,15 +2985,18 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			},
 			globalRegistrySvcFn:     successfulGlobalRegistrySvc,
 			appTemplateVersionSvcFn: successfulAppTemplateVersionList,
+			entityTypeSvcFn:         successfulEntityTypeFetch,
+			capabilitySvcFn:         successfulCapabilityFetch,
+			integrationDepSvcFn:     successfulIntegrationDependencyFetch,
 		},
 		{
 			Name: "Does not resync resources if webhooks could not be enriched",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
 				persistTx := &persistenceautomock.PersistenceTx{}
-				persistTx.On("Commit").Return(nil).Times(17)
+				persistTx.On("Commit").Return(nil).Times(18)
 
 				transact := &persistenceautomock.Transactioner{}
-				transact.On("Begin").Return(persistTx, nil).Times(17)
+				transact.On("Begin").Return(persistTx, nil).Times(18)
 				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false)
 				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true)
 				return persistTx, transact
@@ -2653,15 +3010,16 @@ func TestService_SyncORDDocuments(t *testing.T) {
 				whSvc.On("EnrichWebhooksWithTenantMappingWebhooks", whInputs).Return(nil, testErr).Once()
 				return whSvc
 			},
-			productSvcFn: successfulProductCreate,
-			vendorSvcFn:  successfulVendorCreate,
-			packageSvcFn: successfulPackageCreate,
-			bundleSvcFn:  successfulListTwiceAndCreateBundle,
+			productSvcFn:        successfulProductCreate,
+			vendorSvcFn:         successfulVendorCreate,
+			packageSvcFn:        successfulPackageCreate,
+			bundleSvcFn:         successfulListTwiceAndCreateBundle,
+			fetchValidatorSvcFn: successfulFetchValidatorList,
 			clientFn: func() *automock.Client {
 				client := &automock.Client{}
 				doc := fixORDDocument()
 				doc.ConsumptionBundles[0].CredentialExchangeStrategies = json.RawMessage(fmt.Sprintf(credentialExchangeStrategiesWithCustomTypeFormat, credentialExchangeStrategyType))
-				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication).Return(ord.Documents{doc}, baseURL, nil)
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication, []*model.FetchValidator(nil)).Return(ord.Documents{doc}, baseURL, nil, nil)
 				return client
 			},
 			apiSvcFn: func() *automock.APIService {
@@ -2676,15 +3034,18 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			},
 			globalRegistrySvcFn:     successfulGlobalRegistrySvc,
 			appTemplateVersionSvcFn: successfulAppTemplateVersionList,
+			entityTypeSvcFn:         successfulEntityTypeFetch,
+			capabilitySvcFn:         successfulCapabilityFetch,
+			integrationDepSvcFn:     successfulIntegrationDependencyFetch,
 		},
 		{
 			Name: "Does not resync resources if webhooks cannot be listed for application",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
 				persistTx := &persistenceautomock.PersistenceTx{}
-				persistTx.On("Commit").Return(nil).Times(17)
+				persistTx.On("Commit").Return(nil).Times(18)
 
 				transact := &persistenceautomock.Transactioner{}
-				transact.On("Begin").Return(persistTx, nil).Times(18)
+				transact.On("Begin").Return(persistTx, nil).Times(19)
 				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false)
 				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true)
 				return persistTx, transact
@@ -2700,15 +3061,16 @@ func TestService_SyncORDDocuments(t *testing.T) {
 
 				return whSvc
 			},
-			productSvcFn: successfulProductCreate,
-			vendorSvcFn:  successfulVendorCreate,
-			packageSvcFn: successfulPackageCreate,
-			bundleSvcFn:  successfulListTwiceAndCreateBundle,
+			productSvcFn:        successfulProductCreate,
+			vendorSvcFn:         successfulVendorCreate,
+			packageSvcFn:        successfulPackageCreate,
+			bundleSvcFn:         successfulListTwiceAndCreateBundle,
+			fetchValidatorSvcFn: successfulFetchValidatorList,
 			clientFn: func() *automock.Client {
 				client := &automock.Client{}
 				doc := fixORDDocument()
 				doc.ConsumptionBundles[0].CredentialExchangeStrategies = json.RawMessage(fmt.Sprintf(credentialExchangeStrategiesWithCustomTypeFormat, credentialExchangeStrategyType))
-				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication).Return(ord.Documents{doc}, baseURL, nil)
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication, []*model.FetchValidator(nil)).Return(ord.Documents{doc}, baseURL, nil, nil)
 				return client
 			},
 			apiSvcFn: func() *automock.APIService {
@@ -2723,15 +3085,18 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			},
 			globalRegistrySvcFn:     successfulGlobalRegistrySvc,
 			appTemplateVersionSvcFn: successfulAppTemplateVersionList,
+			entityTypeSvcFn:         successfulEntityTypeFetch,
+			capabilitySvcFn:         successfulCapabilityFetch,
+			integrationDepSvcFn:     successfulIntegrationDependencyFetch,
 		},
 		{
 			Name: "Does not resync resources if webhooks cannot be converted from graphql input to model input",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
 				persistTx := &persistenceautomock.PersistenceTx{}
-				persistTx.On("Commit").Return(nil).Times(17)
+				persistTx.On("Commit").Return(nil).Times(18)
 
 				transact := &persistenceautomock.Transactioner{}
-				transact.On("Begin").Return(persistTx, nil).Times(18)
+				transact.On("Begin").Return(persistTx, nil).Times(19)
 				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false)
 				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true)
 				return persistTx, transact
@@ -2752,15 +3117,16 @@ func TestService_SyncORDDocuments(t *testing.T) {
 				whConv.On("InputFromGraphQL", fixTenantMappingWebhookGraphQLInput()).Return(nil, testErr).Once()
 				return whConv
 			},
-			productSvcFn: successfulProductCreate,
-			vendorSvcFn:  successfulVendorCreate,
-			packageSvcFn: successfulPackageCreate,
-			bundleSvcFn:  successfulListTwiceAndCreateBundle,
+			productSvcFn:        successfulProductCreate,
+			vendorSvcFn:         successfulVendorCreate,
+			packageSvcFn:        successfulPackageCreate,
+			bundleSvcFn:         successfulListTwiceAndCreateBundle,
+			fetchValidatorSvcFn: successfulFetchValidatorList,
 			clientFn: func() *automock.Client {
 				client := &automock.Client{}
 				doc := fixORDDocument()
 				doc.ConsumptionBundles[0].CredentialExchangeStrategies = json.RawMessage(fmt.Sprintf(credentialExchangeStrategiesWithCustomTypeFormat, credentialExchangeStrategyType))
-				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication).Return(ord.Documents{doc}, baseURL, nil)
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication, []*model.FetchValidator(nil)).Return(ord.Documents{doc}, baseURL, nil, nil)
 				return client
 			},
 			apiSvcFn: func() *automock.APIService {
@@ -2775,15 +3141,18 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			},
 			appTemplateVersionSvcFn: successfulAppTemplateVersionList,
 			globalRegistrySvcFn:     successfulGlobalRegistrySvc,
+			entityTypeSvcFn:         successfulEntityTypeFetch,
+			capabilitySvcFn:         successfulCapabilityFetch,
+			integrationDepSvcFn:     successfulIntegrationDependencyFetch,
 		},
 		{
 			Name: "Does not resync resources if webhooks cannot be created",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
 				persistTx := &persistenceautomock.PersistenceTx{}
-				persistTx.On("Commit").Return(nil).Times(17)
+				persistTx.On("Commit").Return(nil).Times(18)
 
 				transact := &persistenceautomock.Transactioner{}
-				transact.On("Begin").Return(persistTx, nil).Times(18)
+				transact.On("Begin").Return(persistTx, nil).Times(19)
 				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false)
 				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true)
 				return persistTx, transact
@@ -2800,16 +3169,17 @@ func TestService_SyncORDDocuments(t *testing.T) {
 
 				return whSvc
 			},
-			webhookConvFn: successfulWebhookConversion,
-			productSvcFn:  successfulProductCreate,
-			vendorSvcFn:   successfulVendorCreate,
-			packageSvcFn:  successfulPackageCreate,
-			bundleSvcFn:   successfulListTwiceAndCreateBundle,
+			webhookConvFn:       successfulWebhookConversion,
+			productSvcFn:        successfulProductCreate,
+			vendorSvcFn:         successfulVendorCreate,
+			packageSvcFn:        successfulPackageCreate,
+			bundleSvcFn:         successfulListTwiceAndCreateBundle,
+			fetchValidatorSvcFn: successfulFetchValidatorList,
 			clientFn: func() *automock.Client {
 				client := &automock.Client{}
 				doc := fixORDDocument()
 				doc.ConsumptionBundles[0].CredentialExchangeStrategies = json.RawMessage(fmt.Sprintf(credentialExchangeStrategiesWithCustomTypeFormat, credentialExchangeStrategyType))
-				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication).Return(ord.Documents{doc}, baseURL, nil)
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication, []*model.FetchValidator(nil)).Return(ord.Documents{doc}, baseURL, nil, nil)
 				return client
 			},
 			apiSvcFn: func() *automock.APIService {
@@ -2824,15 +3194,18 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			},
 			appTemplateVersionSvcFn: successfulAppTemplateVersionList,
 			globalRegistrySvcFn:     successfulGlobalRegistrySvc,
+			entityTypeSvcFn:         successfulEntityTypeFetch,
+			capabilitySvcFn:         successfulCapabilityFetch,
+			integrationDepSvcFn:     successfulIntegrationDependencyFetch,
 		},
 		{
 			Name: "Resync resources if webhooks can be created successfully",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
 				persistTx := &persistenceautomock.PersistenceTx{}
-				persistTx.On("Commit").Return(nil).Times(33)
+				persistTx.On("Commit").Return(nil).Times(40)
 
 				transact := &persistenceautomock.Transactioner{}
-				transact.On("Begin").Return(persistTx, nil).Times(31)
+				transact.On("Begin").Return(persistTx, nil).Times(38)
 				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false)
 				return persistTx, transact
 			},
@@ -2848,16 +3221,17 @@ func TestService_SyncORDDocuments(t *testing.T) {
 
 				return whSvc
 			},
-			webhookConvFn: successfulWebhookConversion,
-			productSvcFn:  successfulProductCreate,
-			vendorSvcFn:   successfulVendorCreate,
-			packageSvcFn:  successfulPackageCreate,
-			bundleSvcFn:   successfulBundleCreateWithGenericParam,
+			webhookConvFn:       successfulWebhookConversion,
+			productSvcFn:        successfulProductCreate,
+			vendorSvcFn:         successfulVendorCreate,
+			packageSvcFn:        successfulPackageCreate,
+			bundleSvcFn:         successfulBundleCreateWithGenericParam,
+			fetchValidatorSvcFn: successfulFetchValidatorList,
 			clientFn: func() *automock.Client {
 				client := &automock.Client{}
 				doc := fixORDDocument()
 				doc.ConsumptionBundles[0].CredentialExchangeStrategies = json.RawMessage(fmt.Sprintf(credentialExchangeStrategiesWithCustomTypeFormat, credentialExchangeStrategyType))
-				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication).Return(ord.Documents{doc}, baseURL, nil)
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication, []*model.FetchValidator(nil)).Return(ord.Documents{doc}, baseURL, nil, nil)
 				return client
 			},
 			apiSvcFn: func() *automock.APIService {
@@ -2875,15 +3249,18 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			specSvcFn:               successfulSpecCreate,
 			appTemplateVersionSvcFn: successfulAppTemplateVersionList,
 			globalRegistrySvcFn:     successfulGlobalRegistrySvc,
+			entityTypeSvcFn:         successfulEntityTypeList,
+			capabilitySvcFn:         successfulCapabilityList,
+			integrationDepSvcFn:     successfulIntegrationDependencyList,
 		},
 		{
 			Name: "Does not recreate tenant mapping webhooks if there are no differences",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
 				persistTx := &persistenceautomock.PersistenceTx{}
-				persistTx.On("Commit").Return(nil).Times(33)
+				persistTx.On("Commit").Return(nil).Times(40)
 
 				transact := &persistenceautomock.Transactioner{}
-				transact.On("Begin").Return(persistTx, nil).Times(31)
+				transact.On("Begin").Return(persistTx, nil).Times(38)
 				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false)
 				return persistTx, transact
 			},
@@ -2898,16 +3275,17 @@ func TestService_SyncORDDocuments(t *testing.T) {
 
 				return whSvc
 			},
-			webhookConvFn: successfulWebhookConversion,
-			productSvcFn:  successfulProductCreate,
-			vendorSvcFn:   successfulVendorCreate,
-			packageSvcFn:  successfulPackageCreate,
-			bundleSvcFn:   successfulBundleCreateWithGenericParam,
+			webhookConvFn:       successfulWebhookConversion,
+			productSvcFn:        successfulProductCreate,
+			vendorSvcFn:         successfulVendorCreate,
+			packageSvcFn:        successfulPackageCreate,
+			bundleSvcFn:         successfulBundleCreateWithGenericParam,
+			fetchValidatorSvcFn: successfulFetchValidatorList,
 			clientFn: func() *automock.Client {
 				client := &automock.Client{}
 				doc := fixORDDocument()
 				doc.ConsumptionBundles[0].CredentialExchangeStrategies = json.RawMessage(fmt.Sprintf(credentialExchangeStrategiesWithCustomTypeFormat, credentialExchangeStrategyType))
-				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication).Return(ord.Documents{doc}, baseURL, nil)
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication, []*model.FetchValidator(nil)).Return(ord.Documents{doc}, baseURL, nil, nil)
 				return client
 			},
 			apiSvcFn: func() *automock.APIService {
@@ -2925,15 +3303,18 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			specSvcFn:               successfulSpecCreate,
 			appTemplateVersionSvcFn: successfulAppTemplateVersionList,
 			globalRegistrySvcFn:     successfulGlobalRegistrySvc,
+			entityTypeSvcFn:         successfulEntityTypeList,
+			capabilitySvcFn:         successfulCapabilityList,
+			integrationDepSvcFn:     successfulIntegrationDependencyList,
 		},
 		{
 			Name: "Does recreate of tenant mapping webhooks when there are differences",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
 				persistTx := &persistenceautomock.PersistenceTx{}
-				persistTx.On("Commit").Return(nil).Times(33)
+				persistTx.On("Commit").Return(nil).Times(41)
 
 				transact := &persistenceautomock.Transactioner{}
-				transact.On("Begin").Return(persistTx, nil).Times(32)
+				transact.On("Begin").Return(persistTx, nil).Times(40)
 				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false)
 				return persistTx, transact
 			},
@@ -2952,16 +3333,17 @@ func TestService_SyncORDDocuments(t *testing.T) {
 
 				return whSvc
 			},
-			webhookConvFn: successfulWebhookConversion,
-			productSvcFn:  successfulProductCreate,
-			vendorSvcFn:   successfulVendorCreate,
-			packageSvcFn:  successfulPackageCreate,
-			bundleSvcFn:   successfulBundleCreateWithGenericParam,
+			webhookConvFn:       successfulWebhookConversion,
+			productSvcFn:        successfulProductCreate,
+			vendorSvcFn:         successfulVendorCreate,
+			packageSvcFn:        successfulPackageCreate,
+			bundleSvcFn:         successfulBundleCreateWithGenericParam,
+			fetchValidatorSvcFn: successfulFetchValidatorListAndReplaceWithoutValidators,
 			clientFn: func() *automock.Client {
 				client := &automock.Client{}
 				doc := fixORDDocument()
 				doc.ConsumptionBundles[0].CredentialExchangeStrategies = json.RawMessage(fmt.Sprintf(credentialExchangeStrategiesWithCustomTypeFormat, credentialExchangeStrategyType))
-				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication).Return(ord.Documents{doc}, baseURL, nil)
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication, []*model.FetchValidator(nil)).Return(ord.Documents{doc}, baseURL, nil, nil)
 				return client
 			},
 			apiSvcFn: func() *automock.APIService {
@@ -2980,12 +3362,15 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			globalRegistrySvcFn:     successfulGlobalRegistrySvc,
 			appTemplateVersionSvcFn: successfulAppTemplateVersionList,
 			fetchReqFn:              successfulFetchRequestFetchAndUpdate,
+			entityTypeSvcFn:         successfulEntityTypeList,
+			capabilitySvcFn:         successfulCapabilityList,
+			integrationDepSvcFn:     successfulIntegrationDependencyList,
 		},
 		{
 			Name: "Does not recreate of tenant mapping webhooks when there are differences but deletion fails",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
 				persistTx := &persistenceautomock.PersistenceTx{}
-				persistTx.On("Commit").Return(nil).Times(32)
+				persistTx.On("Commit").Return(nil).Times(33)
 
 				transact := &persistenceautomock.Transactioner{}
 				transact.On("Begin").Return(persistTx, nil)
@@ -3017,11 +3402,12 @@ func TestService_SyncORDDocuments(t *testing.T) {
 				bundlesSvc.On("CreateBundle", txtest.CtxWithDBMatcher(), resource.Application, appID, mock.Anything, mock.Anything).Return("", nil).Once()
 				return bundlesSvc
 			},
+			fetchValidatorSvcFn: successfulFetchValidatorList,
 			clientFn: func() *automock.Client {
 				client := &automock.Client{}
 				doc := fixORDDocument()
 				doc.ConsumptionBundles[0].CredentialExchangeStrategies = json.RawMessage(fmt.Sprintf(credentialExchangeStrategiesWithCustomTypeFormat, credentialExchangeStrategyType))
-				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication).Return(ord.Documents{doc}, baseURL, nil)
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication, []*model.FetchValidator(nil)).Return(ord.Documents{doc}, baseURL, nil, nil)
 				return client
 			},
 			apiSvcFn: func() *automock.APIService {
@@ -3043,16 +3429,19 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			},
 			appTemplateVersionSvcFn: successfulAppTemplateVersionList,
 			globalRegistrySvcFn:     successfulGlobalRegistrySvc,
+			entityTypeSvcFn:         successfulEntityTypeFetch,
+			capabilitySvcFn:         successfulCapabilityFetch,
+			integrationDepSvcFn:     successfulIntegrationDependencyFetch,
 		},
 		{
 			Name: "Does not resync resources if api list fails",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
 				persistTx := &persistenceautomock.PersistenceTx{}
-				persistTx.On("Commit").Return(nil).Times(20)
+				persistTx.On("Commit").Return(nil).Times(21)
 
 				transact := &persistenceautomock.Transactioner{}
-				transact.On("Begin").Return(persistTx, nil).Times(20)
-				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(19)
+				transact.On("Begin").Return(persistTx, nil).Times(21)
+				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(20)
 				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true).Once()
 				return persistTx, transact
 			},
@@ -3069,20 +3458,24 @@ func TestService_SyncORDDocuments(t *testing.T) {
 				apiSvc.On("ListByApplicationID", txtest.CtxWithDBMatcher(), appID).Return(nil, testErr).Once()
 				return apiSvc
 			},
+			fetchValidatorSvcFn:     successfulFetchValidatorList,
 			clientFn:                successfulClientFetch,
 			eventSvcFn:              successfulEmptyEventList,
 			appTemplateVersionSvcFn: successfulAppTemplateVersionList,
 			globalRegistrySvcFn:     successfulGlobalRegistrySvc,
+			entityTypeSvcFn:         successfulEntityTypeFetch,
+			capabilitySvcFn:         successfulCapabilityFetch,
+			integrationDepSvcFn:     successfulIntegrationDependencyFetch,
 		},
 		{
 			Name: "Fails to list apis after resync",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
 				persistTx := &persistenceautomock.PersistenceTx{}
-				persistTx.On("Commit").Return(nil).Times(23)
+				persistTx.On("Commit").Return(nil).Times(24)
 
 				transact := &persistenceautomock.Transactioner{}
-				transact.On("Begin").Return(persistTx, nil).Times(23)
-				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(22)
+				transact.On("Begin").Return(persistTx, nil).Times(24)
+				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(23)
 				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true).Once()
 				return persistTx, transact
 			},
@@ -3104,20 +3497,24 @@ func TestService_SyncORDDocuments(t *testing.T) {
 				apiSvc.On("ListByApplicationID", txtest.CtxWithDBMatcher(), appID).Return(nil, testErr).Once()
 				return apiSvc
 			},
+			fetchValidatorSvcFn:     successfulFetchValidatorList,
 			clientFn:                successfulClientFetch,
 			eventSvcFn:              successfulEmptyEventList,
 			appTemplateVersionSvcFn: successfulAppTemplateVersionList,
 			globalRegistrySvcFn:     successfulGlobalRegistrySvc,
+			entityTypeSvcFn:         successfulEntityTypeFetch,
+			capabilitySvcFn:         successfulCapabilityFetch,
+			integrationDepSvcFn:     successfulIntegrationDependencyFetch,
 		},
 		{
 			Name: "Does not resync resources if fetching bundle ids for api fails",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
 				persistTx := &persistenceautomock.PersistenceTx{}
-				persistTx.On("Commit").Return(nil).Times(20)
+				persistTx.On("Commit").Return(nil).Times(21)
 
 				transact := &persistenceautomock.Transactioner{}
-				transact.On("Begin").Return(persistTx, nil).Times(21)
-				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(20)
+				transact.On("Begin").Return(persistTx, nil).Times(22)
+				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(21)
 				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true).Once()
 				return persistTx, transact
 			},
@@ -3143,18 +3540,22 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			},
 			eventSvcFn:              successfulEmptyEventList,
 			globalRegistrySvcFn:     successfulGlobalRegistrySvc,
+			fetchValidatorSvcFn:     successfulFetchValidatorList,
 			clientFn:                successfulClientFetch,
 			appTemplateVersionSvcFn: successfulAppTemplateVersionList,
+			entityTypeSvcFn:         successfulEntityTypeFetch,
+			capabilitySvcFn:         successfulCapabilityFetch,
+			integrationDepSvcFn:     successfulIntegrationDependencyFetch,
 		},
 		{
 			Name: "Does not resync resources if api update fails",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
 				persistTx := &persistenceautomock.PersistenceTx{}
-				persistTx.On("Commit").Return(nil).Times(20)
+				persistTx.On("Commit").Return(nil).Times(21)
 
 				transact := &persistenceautomock.Transactioner{}
-				transact.On("Begin").Return(persistTx, nil).Times(21)
-				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(20)
+				transact.On("Begin").Return(persistTx, nil).Times(22)
+				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(21)
 				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true).Once()
 				return persistTx, transact
 			},
@@ -3175,18 +3576,22 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			},
 			eventSvcFn:              successfulEmptyEventList,
 			globalRegistrySvcFn:     successfulGlobalRegistrySvc,
+			fetchValidatorSvcFn:     successfulFetchValidatorList,
 			clientFn:                successfulClientFetch,
 			appTemplateVersionSvcFn: successfulAppTemplateVersionList,
+			entityTypeSvcFn:         successfulEntityTypeFetch,
+			capabilitySvcFn:         successfulCapabilityFetch,
+			integrationDepSvcFn:     successfulIntegrationDependencyFetch,
 		},
 		{
 			Name: "Does not resync resources if api create fails",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
 				persistTx := &persistenceautomock.PersistenceTx{}
-				persistTx.On("Commit").Return(nil).Times(20)
+				persistTx.On("Commit").Return(nil).Times(21)
 
 				transact := &persistenceautomock.Transactioner{}
-				transact.On("Begin").Return(persistTx, nil).Times(21)
-				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(20)
+				transact.On("Begin").Return(persistTx, nil).Times(22)
+				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(21)
 				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true).Once()
 				return persistTx, transact
 			},
@@ -3206,18 +3611,22 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			},
 			eventSvcFn:              successfulEmptyEventList,
 			globalRegistrySvcFn:     successfulGlobalRegistrySvc,
+			fetchValidatorSvcFn:     successfulFetchValidatorList,
 			clientFn:                successfulClientFetch,
 			appTemplateVersionSvcFn: successfulAppTemplateVersionList,
+			entityTypeSvcFn:         successfulEntityTypeFetch,
+			capabilitySvcFn:         successfulCapabilityFetch,
+			integrationDepSvcFn:     successfulIntegrationDependencyFetch,
 		},
 		{
 			Name: "Does not resync resources if api spec delete fails",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
 				persistTx := &persistenceautomock.PersistenceTx{}
-				persistTx.On("Commit").Return(nil).Times(20)
+				persistTx.On("Commit").Return(nil).Times(21)
 
 				transact := &persistenceautomock.Transactioner{}
-				transact.On("Begin").Return(persistTx, nil).Times(21)
-				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(20)
+				transact.On("Begin").Return(persistTx, nil).Times(22)
+				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(21)
 				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true).Once()
 				return persistTx, transact
 			},
@@ -3242,19 +3651,23 @@ func TestService_SyncORDDocuments(t *testing.T) {
 				return specSvc
 			},
 			globalRegistrySvcFn:     successfulGlobalRegistrySvc,
+			fetchValidatorSvcFn:     successfulFetchValidatorList,
 			clientFn:                successfulClientFetch,
 			eventSvcFn:              successfulEmptyEventList,
 			appTemplateVersionSvcFn: successfulAppTemplateVersionList,
+			entityTypeSvcFn:         successfulEntityTypeFetch,
+			capabilitySvcFn:         successfulCapabilityFetch,
+			integrationDepSvcFn:     successfulIntegrationDependencyFetch,
 		},
 		{
 			Name: "Does not resync resources if api spec create fails",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
 				persistTx := &persistenceautomock.PersistenceTx{}
-				persistTx.On("Commit").Return(nil).Times(20)
+				persistTx.On("Commit").Return(nil).Times(21)
 
 				transact := &persistenceautomock.Transactioner{}
-				transact.On("Begin").Return(persistTx, nil).Times(21)
-				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(20)
+				transact.On("Begin").Return(persistTx, nil).Times(22)
+				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(21)
 				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true).Once()
 				return persistTx, transact
 			},
@@ -3280,19 +3693,23 @@ func TestService_SyncORDDocuments(t *testing.T) {
 				return specSvc
 			},
 			globalRegistrySvcFn:     successfulGlobalRegistrySvc,
+			fetchValidatorSvcFn:     successfulFetchValidatorList,
 			clientFn:                successfulClientFetch,
 			eventSvcFn:              successfulEmptyEventList,
 			appTemplateVersionSvcFn: successfulAppTemplateVersionList,
+			entityTypeSvcFn:         successfulEntityTypeFetch,
+			capabilitySvcFn:         successfulCapabilityFetch,
+			integrationDepSvcFn:     successfulIntegrationDependencyFetch,
 		},
 		{
 			Name: "Does not resync resources if api spec list fails",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
 				persistTx := &persistenceautomock.PersistenceTx{}
-				persistTx.On("Commit").Return(nil).Times(20)
+				persistTx.On("Commit").Return(nil).Times(21)
 
 				transact := &persistenceautomock.Transactioner{}
-				transact.On("Begin").Return(persistTx, nil).Times(21)
-				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(20)
+				transact.On("Begin").Return(persistTx, nil).Times(22)
+				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(21)
 				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true).Once()
 				return persistTx, transact
 			},
@@ -3317,18 +3734,22 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			},
 			eventSvcFn:              successfulEmptyEventList,
 			globalRegistrySvcFn:     successfulGlobalRegistrySvc,
+			fetchValidatorSvcFn:     successfulFetchValidatorList,
 			clientFn:                successfulClientFetch,
 			appTemplateVersionSvcFn: successfulAppTemplateVersionList,
+			entityTypeSvcFn:         successfulEntityTypeFetch,
+			capabilitySvcFn:         successfulCapabilityFetch,
+			integrationDepSvcFn:     successfulIntegrationDependencyFetch,
 		},
 		{
 			Name: "Does not resync resources if api spec get fetch request fails",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
 				persistTx := &persistenceautomock.PersistenceTx{}
-				persistTx.On("Commit").Return(nil).Times(20)
+				persistTx.On("Commit").Return(nil).Times(21)
 
 				transact := &persistenceautomock.Transactioner{}
-				transact.On("Begin").Return(persistTx, nil).Times(21)
-				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(20)
+				transact.On("Begin").Return(persistTx, nil).Times(22)
+				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(21)
 				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true).Once()
 				return persistTx, transact
 			},
@@ -3355,18 +3776,22 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			},
 			eventSvcFn:              successfulEmptyEventList,
 			globalRegistrySvcFn:     successfulGlobalRegistrySvc,
+			fetchValidatorSvcFn:     successfulFetchValidatorList,
 			clientFn:                successfulClientFetch,
 			appTemplateVersionSvcFn: successfulAppTemplateVersionList,
+			entityTypeSvcFn:         successfulEntityTypeFetch,
+			capabilitySvcFn:         successfulCapabilityFetch,
+			integrationDepSvcFn:     successfulIntegrationDependencyFetch,
 		},
 		{
 			Name: "Resync resources returns error if api spec refetch fails",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
 				persistTx := &persistenceautomock.PersistenceTx{}
-				persistTx.On("Commit").Return(nil).Times(32)
+				persistTx.On("Commit").Return(nil).Times(39)
 
 				transact := &persistenceautomock.Transactioner{}
-				transact.On("Begin").Return(persistTx, nil).Times(32)
-				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(32)
+				transact.On("Begin").Return(persistTx, nil).Times(39)
+				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(39)
 				return persistTx, transact
 			},
 			appSvcFn:      successfulAppGet,
@@ -3444,18 +3869,22 @@ func TestService_SyncORDDocuments(t *testing.T) {
 				return tombstoneSvc
 			},
 			globalRegistrySvcFn:     successfulGlobalRegistrySvc,
+			fetchValidatorSvcFn:     successfulFetchValidatorList,
 			clientFn:                successfulClientFetch,
 			appTemplateVersionSvcFn: successfulAppTemplateVersionList,
+			entityTypeSvcFn:         successfulEntityTypeList,
+			capabilitySvcFn:         successfulCapabilityList,
+			integrationDepSvcFn:     successfulIntegrationDependencyList,
 		},
 		{
 			Name: "Does not resync resources if event list fails",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
 				persistTx := &persistenceautomock.PersistenceTx{}
-				persistTx.On("Commit").Return(nil).Times(24)
+				persistTx.On("Commit").Return(nil).Times(25)
 
 				transact := &persistenceautomock.Transactioner{}
-				transact.On("Begin").Return(persistTx, nil).Times(24)
-				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(23)
+				transact.On("Begin").Return(persistTx, nil).Times(25)
+				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(24)
 				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true).Once()
 				return persistTx, transact
 			},
@@ -3476,18 +3905,22 @@ func TestService_SyncORDDocuments(t *testing.T) {
 				return eventSvc
 			},
 			globalRegistrySvcFn:     successfulGlobalRegistrySvc,
+			fetchValidatorSvcFn:     successfulFetchValidatorList,
 			clientFn:                successfulClientFetch,
 			appTemplateVersionSvcFn: successfulAppTemplateVersionList,
+			entityTypeSvcFn:         successfulEntityTypeFetch,
+			capabilitySvcFn:         successfulCapabilityFetch,
+			integrationDepSvcFn:     successfulIntegrationDependencyFetch,
 		},
 		{
 			Name: "Fails to list events after resync",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
 				persistTx := &persistenceautomock.PersistenceTx{}
-				persistTx.On("Commit").Return(nil).Times(26)
+				persistTx.On("Commit").Return(nil).Times(27)
 
 				transact := &persistenceautomock.Transactioner{}
-				transact.On("Begin").Return(persistTx, nil).Times(27)
-				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(26)
+				transact.On("Begin").Return(persistTx, nil).Times(28)
+				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(27)
 				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true).Once()
 				return persistTx, transact
 			},
@@ -3528,18 +3961,22 @@ func TestService_SyncORDDocuments(t *testing.T) {
 				return eventSvc
 			},
 			globalRegistrySvcFn:     successfulGlobalRegistrySvc,
+			fetchValidatorSvcFn:     successfulFetchValidatorList,
 			clientFn:                successfulClientFetch,
 			appTemplateVersionSvcFn: successfulAppTemplateVersionList,
+			entityTypeSvcFn:         successfulEntityTypeFetch,
+			capabilitySvcFn:         successfulCapabilityFetch,
+			integrationDepSvcFn:     successfulIntegrationDependencyFetch,
 		},
 		{
 			Name: "Does not resync resources if fetching bundle ids for event fails",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
 				persistTx := &persistenceautomock.PersistenceTx{}
-				persistTx.On("Commit").Return(nil).Times(24)
+				persistTx.On("Commit").Return(nil).Times(25)
 
 				transact := &persistenceautomock.Transactioner{}
-				transact.On("Begin").Return(persistTx, nil).Times(25)
-				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(24)
+				transact.On("Begin").Return(persistTx, nil).Times(26)
+				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(25)
 				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true).Once()
 				return persistTx, transact
 			},
@@ -3569,18 +4006,22 @@ func TestService_SyncORDDocuments(t *testing.T) {
 				return eventSvc
 			},
 			globalRegistrySvcFn:     successfulGlobalRegistrySvc,
+			fetchValidatorSvcFn:     successfulFetchValidatorList,
 			clientFn:                successfulClientFetch,
 			appTemplateVersionSvcFn: successfulAppTemplateVersionList,
+			entityTypeSvcFn:         successfulEntityTypeFetch,
+			capabilitySvcFn:         successfulCapabilityFetch,
+			integrationDepSvcFn:     successfulIntegrationDependencyFetch,
 		},
 		{
 			Name: "Does not resync resources if event update fails",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
 				persistTx := &persistenceautomock.PersistenceTx{}
-				persistTx.On("Commit").Return(nil).Times(24)
+				persistTx.On("Commit").Return(nil).Times(25)
 
 				transact := &persistenceautomock.Transactioner{}
-				transact.On("Begin").Return(persistTx, nil).Times(25)
-				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(24)
+				transact.On("Begin").Return(persistTx, nil).Times(26)
+				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(25)
 				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true).Once()
 				return persistTx, transact
 			},
@@ -3602,18 +4043,22 @@ func TestService_SyncORDDocuments(t *testing.T) {
 				return eventSvc
 			},
 			globalRegistrySvcFn:     successfulGlobalRegistrySvc,
+			fetchValidatorSvcFn:     successfulFetchValidatorList,
 			clientFn:                successfulClientFetch,
 			appTemplateVersionSvcFn: successfulAppTemplateVersionList,
+			entityTypeSvcFn:         successfulEntityTypeFetch,
+			capabilitySvcFn:         successfulCapabilityFetch,
+			integrationDepSvcFn:     successfulIntegrationDependencyFetch,
 		},
 		{
 			Name: "Does not resync specification resources if event create fails",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
 				persistTx := &persistenceautomock.PersistenceTx{}
-				persistTx.On("Commit").Return(nil).Times(24)
+				persistTx.On("Commit").Return(nil).Times(25)
 
 				transact := &persistenceautomock.Transactioner{}
-				transact.On("Begin").Return(persistTx, nil).Times(25)
-				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(24)
+				transact.On("Begin").Return(persistTx, nil).Times(26)
+				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(25)
 				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true).Once()
 				return persistTx, transact
 			},
@@ -3666,17 +4111,21 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			},
 			globalRegistrySvcFn:     successfulGlobalRegistrySvc,
 			appTemplateVersionSvcFn: successfulAppTemplateVersionList,
+			fetchValidatorSvcFn:     successfulFetchValidatorList,
 			clientFn:                successfulClientFetch,
+			entityTypeSvcFn:         successfulEntityTypeFetch,
+			capabilitySvcFn:         successfulCapabilityFetch,
+			integrationDepSvcFn:     successfulIntegrationDependencyFetch,
 		},
 		{
 			Name: "Does not resync resources if event spec delete fails",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
 				persistTx := &persistenceautomock.PersistenceTx{}
-				persistTx.On("Commit").Return(nil).Times(24)
+				persistTx.On("Commit").Return(nil).Times(25)
 
 				transact := &persistenceautomock.Transactioner{}
-				transact.On("Begin").Return(persistTx, nil).Times(25)
-				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(24)
+				transact.On("Begin").Return(persistTx, nil).Times(26)
+				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(25)
 				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true).Once()
 				return persistTx, transact
 			},
@@ -3710,18 +4159,22 @@ func TestService_SyncORDDocuments(t *testing.T) {
 				return eventSvc
 			},
 			globalRegistrySvcFn:     successfulGlobalRegistrySvc,
+			fetchValidatorSvcFn:     successfulFetchValidatorList,
 			clientFn:                successfulClientFetch,
 			appTemplateVersionSvcFn: successfulAppTemplateVersionList,
+			entityTypeSvcFn:         successfulEntityTypeFetch,
+			capabilitySvcFn:         successfulCapabilityFetch,
+			integrationDepSvcFn:     successfulIntegrationDependencyFetch,
 		},
 		{
 			Name: "Does not resync resources if event spec create fails",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
 				persistTx := &persistenceautomock.PersistenceTx{}
-				persistTx.On("Commit").Return(nil).Times(24)
+				persistTx.On("Commit").Return(nil).Times(25)
 
 				transact := &persistenceautomock.Transactioner{}
-				transact.On("Begin").Return(persistTx, nil).Times(25)
-				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(24)
+				transact.On("Begin").Return(persistTx, nil).Times(26)
+				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(25)
 				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true).Once()
 				return persistTx, transact
 			},
@@ -3758,18 +4211,22 @@ func TestService_SyncORDDocuments(t *testing.T) {
 				return eventSvc
 			},
 			globalRegistrySvcFn:     successfulGlobalRegistrySvc,
+			fetchValidatorSvcFn:     successfulFetchValidatorList,
 			clientFn:                successfulClientFetch,
 			appTemplateVersionSvcFn: successfulAppTemplateVersionList,
+			entityTypeSvcFn:         successfulEntityTypeFetch,
+			capabilitySvcFn:         successfulCapabilityFetch,
+			integrationDepSvcFn:     successfulIntegrationDependencyFetch,
 		},
 		{
 			Name: "Does not resync resources if event spec list fails",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
 				persistTx := &persistenceautomock.PersistenceTx{}
-				persistTx.On("Commit").Return(nil).Times(24)
+				persistTx.On("Commit").Return(nil).Times(25)
 
 				transact := &persistenceautomock.Transactioner{}
-				transact.On("Begin").Return(persistTx, nil).Times(25)
-				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(24)
+				transact.On("Begin").Return(persistTx, nil).Times(26)
+				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(25)
 				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true).Once()
 				return persistTx, transact
 			},
@@ -3803,18 +4260,22 @@ func TestService_SyncORDDocuments(t *testing.T) {
 				return eventSvc
 			},
 			globalRegistrySvcFn:     successfulGlobalRegistrySvc,
+			fetchValidatorSvcFn:     successfulFetchValidatorList,
 			clientFn:                successfulClientFetch,
 			appTemplateVersionSvcFn: successfulAppTemplateVersionList,
+			entityTypeSvcFn:         successfulEntityTypeFetch,
+			capabilitySvcFn:         successfulCapabilityFetch,
+			integrationDepSvcFn:     successfulIntegrationDependencyFetch,
 		},
 		{
 			Name: "Does not resync resources if event spec get fetch request fails",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
 				persistTx := &persistenceautomock.PersistenceTx{}
-				persistTx.On("Commit").Return(nil).Times(24)
+				persistTx.On("Commit").Return(nil).Times(25)
 
 				transact := &persistenceautomock.Transactioner{}
-				transact.On("Begin").Return(persistTx, nil).Times(25)
-				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(24)
+				transact.On("Begin").Return(persistTx, nil).Times(26)
+				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(25)
 				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true).Once()
 				return persistTx, transact
 			},
@@ -3849,18 +4310,22 @@ func TestService_SyncORDDocuments(t *testing.T) {
 				return eventSvc
 			},
 			globalRegistrySvcFn:     successfulGlobalRegistrySvc,
+			fetchValidatorSvcFn:     successfulFetchValidatorList,
 			clientFn:                successfulClientFetch,
 			appTemplateVersionSvcFn: successfulAppTemplateVersionList,
+			entityTypeSvcFn:         successfulEntityTypeFetch,
+			capabilitySvcFn:         successfulCapabilityFetch,
+			integrationDepSvcFn:     successfulIntegrationDependencyFetch,
 		},
 		{
 			Name: "Resync resources returns error if event spec refetch fails",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
 				persistTx := &persistenceautomock.PersistenceTx{}
-				persistTx.On("Commit").Return(nil).Times(32)
+				persistTx.On("Commit").Return(nil).Times(39)
 
 				transact := &persistenceautomock.Transactioner{}
-				transact.On("Begin").Return(persistTx, nil).Times(32)
-				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(32)
+				transact.On("Begin").Return(persistTx, nil).Times(39)
+				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(39)
 				return persistTx, transact
 			},
 			appSvcFn:      successfulAppGet,
@@ -3948,18 +4413,22 @@ func TestService_SyncORDDocuments(t *testing.T) {
 				return tombstoneSvc
 			},
 			globalRegistrySvcFn:     successfulGlobalRegistrySvc,
+			fetchValidatorSvcFn:     successfulFetchValidatorList,
 			clientFn:                successfulClientFetch,
 			appTemplateVersionSvcFn: successfulAppTemplateVersionList,
+			entityTypeSvcFn:         successfulEntityTypeList,
+			capabilitySvcFn:         successfulCapabilityList,
+			integrationDepSvcFn:     successfulIntegrationDependencyList,
 		},
 		{
 			Name: "Does not resync resources if tombstone list fails",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
 				persistTx := &persistenceautomock.PersistenceTx{}
-				persistTx.On("Commit").Return(nil).Times(28)
+				persistTx.On("Commit").Return(nil).Times(35)
 
 				transact := &persistenceautomock.Transactioner{}
-				transact.On("Begin").Return(persistTx, nil).Times(28)
-				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(27)
+				transact.On("Begin").Return(persistTx, nil).Times(35)
+				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(34)
 				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true).Once()
 				return persistTx, transact
 			},
@@ -3980,18 +4449,22 @@ func TestService_SyncORDDocuments(t *testing.T) {
 				return tombstoneSvc
 			},
 			globalRegistrySvcFn:     successfulGlobalRegistrySvc,
+			fetchValidatorSvcFn:     successfulFetchValidatorList,
 			clientFn:                successfulClientFetch,
 			appTemplateVersionSvcFn: successfulAppTemplateVersionList,
+			entityTypeSvcFn:         successfulEntityTypeList,
+			capabilitySvcFn:         successfulCapabilityList,
+			integrationDepSvcFn:     successfulIntegrationDependencyList,
 		},
 		{
 			Name: "Fails to list tombstones after resync",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
 				persistTx := &persistenceautomock.PersistenceTx{}
-				persistTx.On("Commit").Return(nil).Times(30)
+				persistTx.On("Commit").Return(nil).Times(37)
 
 				transact := &persistenceautomock.Transactioner{}
-				transact.On("Begin").Return(persistTx, nil).Times(30)
-				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(29)
+				transact.On("Begin").Return(persistTx, nil).Times(37)
+				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(36)
 				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true).Once()
 				return persistTx, transact
 			},
@@ -4014,18 +4487,22 @@ func TestService_SyncORDDocuments(t *testing.T) {
 				return tombstoneSvc
 			},
 			globalRegistrySvcFn:     successfulGlobalRegistrySvc,
+			fetchValidatorSvcFn:     successfulFetchValidatorList,
 			clientFn:                successfulClientFetch,
 			appTemplateVersionSvcFn: successfulAppTemplateVersionList,
+			entityTypeSvcFn:         successfulEntityTypeList,
+			capabilitySvcFn:         successfulCapabilityList,
+			integrationDepSvcFn:     successfulIntegrationDependencyList,
 		},
 		{
 			Name: "Does not resync resources if tombstone update fails",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
 				persistTx := &persistenceautomock.PersistenceTx{}
-				persistTx.On("Commit").Return(nil).Times(28)
+				persistTx.On("Commit").Return(nil).Times(35)
 
 				transact := &persistenceautomock.Transactioner{}
-				transact.On("Begin").Return(persistTx, nil).Times(29)
-				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(28)
+				transact.On("Begin").Return(persistTx, nil).Times(36)
+				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(35)
 				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true).Once()
 				return persistTx, transact
 			},
@@ -4047,18 +4524,22 @@ func TestService_SyncORDDocuments(t *testing.T) {
 				return tombstoneSvc
 			},
 			globalRegistrySvcFn:     successfulGlobalRegistrySvc,
+			fetchValidatorSvcFn:     successfulFetchValidatorList,
 			clientFn:                successfulClientFetch,
 			appTemplateVersionSvcFn: successfulAppTemplateVersionList,
+			entityTypeSvcFn:         successfulEntityTypeList,
+			capabilitySvcFn:         successfulCapabilityList,
+			integrationDepSvcFn:     successfulIntegrationDependencyList,
 		},
 		{
 			Name: "Does not resync resources if tombstone create fails",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
 				persistTx := &persistenceautomock.PersistenceTx{}
-				persistTx.On("Commit").Return(nil).Times(28)
+				persistTx.On("Commit").Return(nil).Times(35)
 
 				transact := &persistenceautomock.Transactioner{}
-				transact.On("Begin").Return(persistTx, nil).Times(29)
-				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(28)
+				transact.On("Begin").Return(persistTx, nil).Times(36)
+				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(35)
 				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true).Once()
 				return persistTx, transact
 			},
@@ -4079,18 +4560,22 @@ func TestService_SyncORDDocuments(t *testing.T) {
 				return tombstoneSvc
 			},
 			globalRegistrySvcFn:     successfulGlobalRegistrySvc,
+			fetchValidatorSvcFn:     successfulFetchValidatorList,
 			clientFn:                successfulClientFetch,
 			appTemplateVersionSvcFn: successfulAppTemplateVersionList,
+			entityTypeSvcFn:         successfulEntityTypeList,
+			capabilitySvcFn:         successfulCapabilityList,
+			integrationDepSvcFn:     successfulIntegrationDependencyList,
 		},
 		{
 			Name: "Does not resync resources if api resource deletion due to tombstone fails",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
 				persistTx := &persistenceautomock.PersistenceTx{}
-				persistTx.On("Commit").Return(nil).Times(30)
+				persistTx.On("Commit").Return(nil).Times(37)
 
 				transact := &persistenceautomock.Transactioner{}
-				transact.On("Begin").Return(persistTx, nil).Times(31)
-				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(30)
+				transact.On("Begin").Return(persistTx, nil).Times(38)
+				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(37)
 				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true).Once()
 				return persistTx, transact
 			},
@@ -4115,18 +4600,22 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			vendorSvcFn:             successfulVendorCreate,
 			tombstoneSvcFn:          successfulTombstoneCreate,
 			globalRegistrySvcFn:     successfulGlobalRegistrySvc,
+			fetchValidatorSvcFn:     successfulFetchValidatorList,
 			clientFn:                successfulClientFetch,
 			appTemplateVersionSvcFn: successfulAppTemplateVersionList,
+			entityTypeSvcFn:         successfulEntityTypeList,
+			capabilitySvcFn:         successfulCapabilityList,
+			integrationDepSvcFn:     successfulIntegrationDependencyList,
 		},
 		{
 			Name: "Does not resync resources if package resource deletion due to tombstone fails",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
 				persistTx := &persistenceautomock.PersistenceTx{}
-				persistTx.On("Commit").Return(nil).Times(30)
+				persistTx.On("Commit").Return(nil).Times(37)
 
 				transact := &persistenceautomock.Transactioner{}
-				transact.On("Begin").Return(persistTx, nil).Times(31)
-				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(30)
+				transact.On("Begin").Return(persistTx, nil).Times(38)
+				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(37)
 				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true).Once()
 				return persistTx, transact
 			},
@@ -4160,24 +4649,28 @@ func TestService_SyncORDDocuments(t *testing.T) {
 				return tombstoneSvc
 			},
 			globalRegistrySvcFn: successfulGlobalRegistrySvc,
+			fetchValidatorSvcFn: successfulFetchValidatorList,
 			clientFn: func() *automock.Client {
 				client := &automock.Client{}
 				doc := fixORDDocument()
 				doc.Tombstones[0].OrdID = packageORDID
-				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication).Return(ord.Documents{doc}, *doc.DescribedSystemInstance.BaseURL, nil)
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication, []*model.FetchValidator(nil)).Return(ord.Documents{doc}, *doc.DescribedSystemInstance.BaseURL, nil, nil)
 				return client
 			},
 			appTemplateVersionSvcFn: successfulAppTemplateVersionList,
+			entityTypeSvcFn:         successfulEntityTypeList,
+			capabilitySvcFn:         successfulCapabilityList,
+			integrationDepSvcFn:     successfulIntegrationDependencyList,
 		},
 		{
 			Name: "Does not resync resources if event resource deletion due to tombstone fails",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
 				persistTx := &persistenceautomock.PersistenceTx{}
-				persistTx.On("Commit").Return(nil).Times(30)
+				persistTx.On("Commit").Return(nil).Times(37)
 
 				transact := &persistenceautomock.Transactioner{}
-				transact.On("Begin").Return(persistTx, nil).Times(31)
-				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(30)
+				transact.On("Begin").Return(persistTx, nil).Times(38)
+				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(37)
 				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true).Once()
 				return persistTx, transact
 			},
@@ -4212,24 +4705,28 @@ func TestService_SyncORDDocuments(t *testing.T) {
 				return tombstoneSvc
 			},
 			globalRegistrySvcFn: successfulGlobalRegistrySvc,
+			fetchValidatorSvcFn: successfulFetchValidatorList,
 			clientFn: func() *automock.Client {
 				client := &automock.Client{}
 				doc := fixORDDocument()
 				doc.Tombstones[0].OrdID = event1ORDID
-				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication).Return(ord.Documents{doc}, *doc.DescribedSystemInstance.BaseURL, nil)
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication, []*model.FetchValidator(nil)).Return(ord.Documents{doc}, *doc.DescribedSystemInstance.BaseURL, nil, nil)
 				return client
 			},
 			appTemplateVersionSvcFn: successfulAppTemplateVersionList,
+			entityTypeSvcFn:         successfulEntityTypeList,
+			capabilitySvcFn:         successfulCapabilityList,
+			integrationDepSvcFn:     successfulIntegrationDependencyList,
 		},
 		{
 			Name: "Does not resync resources if vendor resource deletion due to tombstone fails",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
 				persistTx := &persistenceautomock.PersistenceTx{}
-				persistTx.On("Commit").Return(nil).Times(30)
+				persistTx.On("Commit").Return(nil).Times(37)
 
 				transact := &persistenceautomock.Transactioner{}
-				transact.On("Begin").Return(persistTx, nil).Times(31)
-				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(30)
+				transact.On("Begin").Return(persistTx, nil).Times(38)
+				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(37)
 				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true).Once()
 				return persistTx, transact
 			},
@@ -4263,24 +4760,28 @@ func TestService_SyncORDDocuments(t *testing.T) {
 				return tombstoneSvc
 			},
 			globalRegistrySvcFn: successfulGlobalRegistrySvc,
+			fetchValidatorSvcFn: successfulFetchValidatorList,
 			clientFn: func() *automock.Client {
 				client := &automock.Client{}
 				doc := fixORDDocument()
 				doc.Tombstones[0].OrdID = vendorORDID
-				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication).Return(ord.Documents{doc}, *doc.DescribedSystemInstance.BaseURL, nil)
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication, []*model.FetchValidator(nil)).Return(ord.Documents{doc}, *doc.DescribedSystemInstance.BaseURL, nil, nil)
 				return client
 			},
 			appTemplateVersionSvcFn: successfulAppTemplateVersionList,
+			entityTypeSvcFn:         successfulEntityTypeList,
+			capabilitySvcFn:         successfulCapabilityList,
+			integrationDepSvcFn:     successfulIntegrationDependencyList,
 		},
 		{
 			Name: "Does not resync resources if product resource deletion due to tombstone fails",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
 				persistTx := &persistenceautomock.PersistenceTx{}
-				persistTx.On("Commit").Return(nil).Times(30)
+				persistTx.On("Commit").Return(nil).Times(37)
 
 				transact := &persistenceautomock.Transactioner{}
-				transact.On("Begin").Return(persistTx, nil).Times(31)
-				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(30)
+				transact.On("Begin").Return(persistTx, nil).Times(38)
+				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(37)
 				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true).Once()
 				return persistTx, transact
 			},
@@ -4313,24 +4814,28 @@ func TestService_SyncORDDocuments(t *testing.T) {
 				return tombstoneSvc
 			},
 			globalRegistrySvcFn: successfulGlobalRegistrySvc,
+			fetchValidatorSvcFn: successfulFetchValidatorList,
 			clientFn: func() *automock.Client {
 				client := &automock.Client{}
 				doc := fixORDDocument()
 				doc.Tombstones[0].OrdID = productORDID
-				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication).Return(ord.Documents{doc}, *doc.DescribedSystemInstance.BaseURL, nil)
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication, []*model.FetchValidator(nil)).Return(ord.Documents{doc}, *doc.DescribedSystemInstance.BaseURL, nil, nil)
 				return client
 			},
 			appTemplateVersionSvcFn: successfulAppTemplateVersionList,
+			entityTypeSvcFn:         successfulEntityTypeList,
+			capabilitySvcFn:         successfulCapabilityList,
+			integrationDepSvcFn:     successfulIntegrationDependencyList,
 		},
 		{
 			Name: "Does not resync resources if bundle resource deletion due to tombstone fails",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
 				persistTx := &persistenceautomock.PersistenceTx{}
-				persistTx.On("Commit").Return(nil).Times(30)
+				persistTx.On("Commit").Return(nil).Times(37)
 
 				transact := &persistenceautomock.Transactioner{}
-				transact.On("Begin").Return(persistTx, nil).Times(31)
-				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(30)
+				transact.On("Begin").Return(persistTx, nil).Times(38)
+				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(37)
 				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true).Once()
 				return persistTx, transact
 			},
@@ -4363,19 +4868,23 @@ func TestService_SyncORDDocuments(t *testing.T) {
 				return tombstoneSvc
 			},
 			globalRegistrySvcFn: successfulGlobalRegistrySvc,
+			fetchValidatorSvcFn: successfulFetchValidatorList,
 			clientFn: func() *automock.Client {
 				client := &automock.Client{}
 				doc := fixORDDocument()
 				doc.Tombstones[0].OrdID = bundleORDID
-				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication).Return(ord.Documents{doc}, *doc.DescribedSystemInstance.BaseURL, nil)
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication, []*model.FetchValidator(nil)).Return(ord.Documents{doc}, *doc.DescribedSystemInstance.BaseURL, nil, nil)
 				return client
 			},
 			appTemplateVersionSvcFn: successfulAppTemplateVersionList,
+			entityTypeSvcFn:         successfulEntityTypeList,
+			capabilitySvcFn:         successfulCapabilityList,
+			integrationDepSvcFn:     successfulIntegrationDependencyList,
 		},
 		{
 			Name: "Returns error when failing to open final transaction to commit fetched specs",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
-				persistTx, transact := txGen.ThatSucceedsMultipleTimes(31)
+				persistTx, transact := txGen.ThatSucceedsMultipleTimes(38)
 				transact.On("Begin").Return(persistTx, testErr).Once()
 				return persistTx, transact
 			},
@@ -4401,13 +4910,17 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			vendorSvcFn:             successfulVendorCreate,
 			tombstoneSvcFn:          successfulTombstoneCreate,
 			globalRegistrySvcFn:     successfulGlobalRegistrySvc,
+			fetchValidatorSvcFn:     successfulFetchValidatorList,
 			clientFn:                successfulClientFetch,
 			appTemplateVersionSvcFn: successfulAppTemplateVersionList,
+			entityTypeSvcFn:         successfulEntityTypeList,
+			capabilitySvcFn:         successfulCapabilityList,
+			integrationDepSvcFn:     successfulIntegrationDependencyList,
 		},
 		{
 			Name: "Returns error when failing to find spec in final transaction when trying to update and persist fetched specs",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
-				persistTx, transact := txGen.ThatSucceedsMultipleTimes(31)
+				persistTx, transact := txGen.ThatSucceedsMultipleTimes(38)
 				transact.On("Begin").Return(persistTx, nil).Once()
 				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true).Once()
 				return persistTx, transact
@@ -4460,13 +4973,17 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			vendorSvcFn:             successfulVendorCreate,
 			tombstoneSvcFn:          successfulTombstoneCreate,
 			globalRegistrySvcFn:     successfulGlobalRegistrySvc,
+			fetchValidatorSvcFn:     successfulFetchValidatorList,
 			clientFn:                successfulClientFetch,
 			appTemplateVersionSvcFn: successfulAppTemplateVersionList,
+			entityTypeSvcFn:         successfulEntityTypeList,
+			capabilitySvcFn:         successfulCapabilityList,
+			integrationDepSvcFn:     successfulIntegrationDependencyList,
 		},
 		{
 			Name: "Returns error when failing to update spec in final transaction when trying to update and persist fetched specs",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
-				persistTx, transact := txGen.ThatSucceedsMultipleTimes(31)
+				persistTx, transact := txGen.ThatSucceedsMultipleTimes(38)
 				transact.On("Begin").Return(persistTx, nil).Once()
 				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true).Once()
 				return persistTx, transact
@@ -4523,13 +5040,17 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			vendorSvcFn:             successfulVendorCreate,
 			tombstoneSvcFn:          successfulTombstoneCreate,
 			globalRegistrySvcFn:     successfulGlobalRegistrySvc,
+			fetchValidatorSvcFn:     successfulFetchValidatorList,
 			clientFn:                successfulClientFetch,
 			appTemplateVersionSvcFn: successfulAppTemplateVersionList,
+			entityTypeSvcFn:         successfulEntityTypeList,
+			capabilitySvcFn:         successfulCapabilityList,
+			integrationDepSvcFn:     successfulIntegrationDependencyList,
 		},
 		{
 			Name: "Returns error when failing to update fetch request in final transaction when trying to update and persist fetched specs",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
-				persistTx, transact := txGen.ThatSucceedsMultipleTimes(31)
+				persistTx, transact := txGen.ThatSucceedsMultipleTimes(38)
 				transact.On("Begin").Return(persistTx, nil).Once()
 				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true).Once()
 				return persistTx, transact
@@ -4596,13 +5117,17 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			vendorSvcFn:             successfulVendorCreate,
 			tombstoneSvcFn:          successfulTombstoneCreate,
 			globalRegistrySvcFn:     successfulGlobalRegistrySvc,
+			fetchValidatorSvcFn:     successfulFetchValidatorList,
 			clientFn:                successfulClientFetch,
 			appTemplateVersionSvcFn: successfulAppTemplateVersionList,
+			entityTypeSvcFn:         successfulEntityTypeList,
+			capabilitySvcFn:         successfulCapabilityList,
+			integrationDepSvcFn:     successfulIntegrationDependencyList,
 		},
 		{
 			Name: "Success when resources are not in db and no SAP Vendor is declared in Documents should Create them as SAP Vendor is coming from the Global Registry",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
-				return txGen.ThatSucceedsMultipleTimes(30)
+				return txGen.ThatSucceedsMultipleTimes(38)
 			},
 			appSvcFn:      successfulAppGet,
 			tenantSvcFn:   successfulTenantSvc,
@@ -4626,19 +5151,23 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			vendorSvcFn:         successfulEmptyVendorList,
 			tombstoneSvcFn:      successfulTombstoneCreate,
 			globalRegistrySvcFn: successfulGlobalRegistrySvc,
+			fetchValidatorSvcFn: successfulFetchValidatorListAndReplaceWithoutValidators,
 			clientFn: func() *automock.Client {
 				client := &automock.Client{}
 				doc := fixORDDocument()
 				doc.Vendors = nil
-				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication).Return(ord.Documents{doc}, *doc.DescribedSystemInstance.BaseURL, nil)
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication, []*model.FetchValidator(nil)).Return(ord.Documents{doc}, *doc.DescribedSystemInstance.BaseURL, nil, nil)
 				return client
 			},
 			appTemplateVersionSvcFn: successfulAppTemplateVersionList,
+			entityTypeSvcFn:         successfulEntityTypeList,
+			capabilitySvcFn:         successfulCapabilityList,
+			integrationDepSvcFn:     successfulIntegrationDependencyList,
 		},
 		{
 			Name: "Success when resources are already in db and no SAP Vendor is declared in Documents should Update them as SAP Vendor is coming from the Global Registry",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
-				return txGen.ThatSucceedsMultipleTimes(30)
+				return txGen.ThatSucceedsMultipleTimes(38)
 			},
 			appSvcFn:      successfulAppGet,
 			tenantSvcFn:   successfulTenantSvc,
@@ -4669,14 +5198,18 @@ func TestService_SyncORDDocuments(t *testing.T) {
 				return tombstoneSvc
 			},
 			globalRegistrySvcFn: successfulGlobalRegistrySvc,
+			fetchValidatorSvcFn: successfulFetchValidatorListAndReplaceWithoutValidators,
 			clientFn: func() *automock.Client {
 				client := &automock.Client{}
 				doc := fixORDDocument()
 				doc.Vendors = nil
-				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication).Return(ord.Documents{doc}, *doc.DescribedSystemInstance.BaseURL, nil)
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication, []*model.FetchValidator(nil)).Return(ord.Documents{doc}, *doc.DescribedSystemInstance.BaseURL, nil, nil)
 				return client
 			},
 			appTemplateVersionSvcFn: successfulAppTemplateVersionList,
+			entityTypeSvcFn:         successfulEntityTypeList,
+			capabilitySvcFn:         successfulCapabilityList,
+			integrationDepSvcFn:     successfulIntegrationDependencyList,
 		},
 	}
 
@@ -4707,6 +5240,18 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			if test.eventSvcFn != nil {
 				eventSvc = test.eventSvcFn()
 			}
+			entityTypeSvc := &automock.EntityTypeService{}
+			if test.entityTypeSvcFn != nil {
+				entityTypeSvc = test.entityTypeSvcFn()
+			}
+			capabilitySvc := &automock.CapabilityService{}
+			if test.capabilitySvcFn != nil {
+				capabilitySvc = test.capabilitySvcFn()
+			}
+			integrationDepSvc := &automock.IntegrationDependencyService{}
+			if test.integrationDepSvcFn != nil {
+				integrationDepSvc = test.integrationDepSvcFn()
+			}
 			specSvc := &automock.SpecService{}
 			if test.specSvcFn != nil {
 				specSvc = test.specSvcFn()
@@ -4755,9 +5300,13 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			if test.appTemplateSvcFn != nil {
 				appTemplateSvc = test.appTemplateSvcFn()
 			}
+			fetchValidatorSvc := &automock.FetchValidatorService{}
+			if test.fetchValidatorSvcFn != nil {
+				fetchValidatorSvc = test.fetchValidatorSvcFn()
+			}
 
 			ordCfg := ord.NewServiceConfig(4, 100, 0, "", false, credentialExchangeStrategyTenantMappings)
-			svc := ord.NewAggregatorService(ordCfg, tx, appSvc, whSvc, bndlSvc, bndlRefSvc, apiSvc, eventSvc, specSvc, fetchReqSvc, packageSvc, productSvc, vendorSvc, tombstoneSvc, tenantSvc, globalRegistrySvcFn, client, whConverter, appTemplateVersionSvc, appTemplateSvc)
+			svc := ord.NewAggregatorService(ordCfg, tx, appSvc, whSvc, bndlSvc, bndlRefSvc, apiSvc, eventSvc, entityTypeSvc, capabilitySvc, integrationDepSvc, specSvc, fetchReqSvc, packageSvc, productSvc, vendorSvc, tombstoneSvc, tenantSvc, globalRegistrySvcFn, client, whConverter, appTemplateVersionSvc, appTemplateSvc, fetchValidatorSvc)
 			err := svc.SyncORDDocuments(context.TODO(), ord.MetricsConfig{})
 			if test.ExpectedErr != nil {
 				require.Error(t, err)
@@ -4766,7 +5315,7 @@ func TestService_SyncORDDocuments(t *testing.T) {
 				require.NoError(t, err)
 			}
 
-			mock.AssertExpectationsForObjects(t, tx, appSvc, whSvc, bndlSvc, apiSvc, eventSvc, specSvc, packageSvc, productSvc, vendorSvc, tombstoneSvc, tenantSvc, globalRegistrySvcFn, client)
+			mock.AssertExpectationsForObjects(t, tx, appSvc, whSvc, bndlSvc, apiSvc, eventSvc, entityTypeSvc, capabilitySvc, integrationDepSvc, specSvc, packageSvc, productSvc, vendorSvc, tombstoneSvc, tenantSvc, globalRegistrySvcFn, client, fetchValidatorSvc)
 		})
 	}
 }
@@ -4787,10 +5336,16 @@ func TestService_ProcessApplications(t *testing.T) {
 
 	successfulClientFetch := func() *automock.Client {
 		client := &automock.Client{}
-		client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication).Return(ord.Documents{}, baseURL, nil)
+		client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResource, testWebhookForApplication, []*model.FetchValidator(nil)).Return(ord.Documents{}, baseURL, nil, nil)
 		return client
 	}
 
+	successfulFetchValidatorList := func() *automock.FetchValidatorService {
+		svc := &automock.FetchValidatorService{}
+		svc.On("ListByWebhookIDAndResourceID", txtest.CtxWithDBMatcher(), testWebhookForApplication.ID, appID).Return(nil, nil).Once()
+		return svc
+	}
+
 	testCases := []struct {
 		Name                string
 		TransactionerFn     func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
@@ -4799,6 +5354,7 @@ func TestService_ProcessApplications(t *testing.T) {
 		webhookConvFn       func() *automock.WebhookConverter
 		tenantSvcFn         func() *automock.TenantService
 		globalRegistrySvcFn func() *automock.GlobalRegistryService
+		fetchValidatorSvcFn func() *automock.FetchValidatorService
 		clientFn            func() *automock.Client
 		appIDs              func() []string
 		ExpectedErr         error
@@ -4830,7 +5386,7 @@ func TestService_ProcessApplications(t *testing.T) {
 		{
 			Name: "Success",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
-				return txGen.ThatSucceedsMultipleTimes(2)
+				return txGen.ThatSucceedsMultipleTimes(3)
 			},
 			appSvcFn:    successfulAppGet,
 			tenantSvcFn: successfulTenantSvc,
@@ -4840,6 +5396,7 @@ func TestService_ProcessApplications(t *testing.T) {
 				return whSvc
 			},
 			globalRegistrySvcFn: successfulGlobalRegistrySvc,
+			fetchValidatorSvcFn: successfulFetchValidatorList,
 			clientFn:            successfulClientFetch,
 			appIDs: func() []string {
 				return []string{appID}
@@ -4943,6 +5500,9 @@ func TestService_ProcessApplications(t *testing.T) {
 			bndlRefSvc := &automock.BundleReferenceService{}
 			apiSvc := &automock.APIService{}
 			eventSvc := &automock.EventService{}
+			entityTypeSvc := &automock.EntityTypeService{}
+			capabilitySvc := &automock.CapabilityService{}
+			integrationDepSvc := &automock.IntegrationDependencyService{}
 			specSvc := &automock.SpecService{}
 			fetchReqSvc := &automock.FetchRequestService{}
 			packageSvc := &automock.PackageService{}
@@ -4963,9 +5523,13 @@ func TestService_ProcessApplications(t *testing.T) {
 			if test.clientFn != nil {
 				client = test.clientFn()
 			}
+			fetchValidatorSvc := &automock.FetchValidatorService{}
+			if test.fetchValidatorSvcFn != nil {
+				fetchValidatorSvc = test.fetchValidatorSvcFn()
+			}
 
 			ordCfg := ord.NewServiceConfig(4, 100, 0, "", false, credentialExchangeStrategyTenantMappings)
-			svc := ord.NewAggregatorService(ordCfg, tx, appSvc, whSvc, bndlSvc, bndlRefSvc, apiSvc, eventSvc, specSvc, fetchReqSvc, packageSvc, productSvc, vendorSvc, tombstoneSvc, tenantSvc, globalRegistrySvcFn, client, whConverter, appTemplateVersionSvc, appTemplateSvc)
+			svc := ord.NewAggregatorService(ordCfg, tx, appSvc, whSvc, bndlSvc, bndlRefSvc, apiSvc, eventSvc, entityTypeSvc, capabilitySvc, integrationDepSvc, specSvc, fetchReqSvc, packageSvc, productSvc, vendorSvc, tombstoneSvc, tenantSvc, globalRegistrySvcFn, client, whConverter, appTemplateVersionSvc, appTemplateSvc, fetchValidatorSvc)
 			err := svc.ProcessApplications(context.TODO(), ord.MetricsConfig{}, test.appIDs())
 			if test.ExpectedErr != nil {
 				require.Error(t, err)
@@ -4974,7 +5538,7 @@ func TestService_ProcessApplications(t *testing.T) {
 				require.NoError(t, err)
 			}
 
-			mock.AssertExpectationsForObjects(t, tx, appSvc, whSvc, bndlSvc, apiSvc, eventSvc, specSvc, packageSvc, productSvc, vendorSvc, tombstoneSvc, tenantSvc, globalRegistrySvcFn, client)
+			mock.AssertExpectationsForObjects(t, tx, appSvc, whSvc, bndlSvc, apiSvc, eventSvc, entityTypeSvc, capabilitySvc, integrationDepSvc, specSvc, packageSvc, productSvc, vendorSvc, tombstoneSvc, tenantSvc, globalRegistrySvcFn, client, fetchValidatorSvc)
 		})
 	}
 }
@@ -5000,17 +5564,29 @@ func TestService_ProcessApplicationTemplates(t *testing.T) {
 
 	successfulClientFetchForAppTemplate := func() *automock.Client {
 		client := &automock.Client{}
-		client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResourceAppTemplate, testWebhookForAppTemplate).Return(ord.Documents{}, baseURL, nil).Once()
-		client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResourceApp, testWebhookForAppTemplate).Return(ord.Documents{}, baseURL, nil).Once()
+		client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResourceAppTemplate, testWebhookForAppTemplate, []*model.FetchValidator(nil)).Return(ord.Documents{}, baseURL, nil, nil).Once()
+		client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResourceApp, testWebhookForAppTemplate, []*model.FetchValidator(nil)).Return(ord.Documents{}, baseURL, nil, nil).Once()
 		return client
 	}
 
 	successfulClientFetchForOnlyAppTemplate := func() *automock.Client {
 		client := &automock.Client{}
-		client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResourceAppTemplate, testWebhookForAppTemplate).Return(ord.Documents{}, baseURL, nil).Once()
+		client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testResourceAppTemplate, testWebhookForAppTemplate, []*model.FetchValidator(nil)).Return(ord.Documents{}, baseURL, nil, nil).Once()
 		return client
 	}
 
+	successfulFetchValidatorListForAppTemplate := func() *automock.FetchValidatorService {
+		svc := &automock.FetchValidatorService{}
+		svc.On("ListByWebhookIDAndResourceID", txtest.CtxWithDBMatcher(), testWebhookForAppTemplate.ID, appTemplateID).Return(nil, nil).Once()
+		return svc
+	}
+
+	successfulFetchValidatorListForAppTemplateAndApp := func() *automock.FetchValidatorService {
+		svc := successfulFetchValidatorListForAppTemplate()
+		svc.On("ListByWebhookIDAndResourceID", txtest.CtxWithDBMatcher(), testWebhookForAppTemplate.ID, appID).Return(nil, nil).Once()
+		return svc
+	}
+
 	testCases := []struct {
 		Name                    string
 		TransactionerFn         func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
@@ -5021,6 +5597,7 @@ func TestService_ProcessApplicationTemplates(t *testing.T) {
 		globalRegistrySvcFn     func() *automock.GlobalRegistryService
 		appTemplateSvcFn        func() *automock.ApplicationTemplateService
 		appTemplateVersionSvcFn func() *automock.ApplicationTemplateVersionService
+		fetchValidatorSvcFn     func() *automock.FetchValidatorService
 		clientFn                func() *automock.Client
 		appTemplateIDs          func() []string
 		ExpectedErr             error
@@ -5052,7 +5629,7 @@ func TestService_ProcessApplicationTemplates(t *testing.T) {
 		{
 			Name: "Success",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
-				return txGen.ThatSucceedsMultipleTimes(4)
+				return txGen.ThatSucceedsMultipleTimes(6)
 			},
 			appSvcFn:    successfulAppTemplateAppSvc,
 			tenantSvcFn: successfulTenantSvc,
@@ -5062,6 +5639,7 @@ func TestService_ProcessApplicationTemplates(t *testing.T) {
 				return whSvc
 			},
 			globalRegistrySvcFn: successfulGlobalRegistrySvc,
+			fetchValidatorSvcFn: successfulFetchValidatorListForAppTemplateAndApp,
 			clientFn:            successfulClientFetchForAppTemplate,
 			appTemplateIDs: func() []string {
 				return []string{appTemplateID}
@@ -5096,7 +5674,7 @@ func TestService_ProcessApplicationTemplates(t *testing.T) {
 		{
 			Name: "Error while listing applications by application template id",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
-				return txGen.ThatSucceedsMultipleTimes(3)
+				return txGen.ThatSucceedsMultipleTimes(4)
 			},
 			appSvcFn: func() *automock.ApplicationService {
 				appSvc := &automock.ApplicationService{}
@@ -5112,6 +5690,7 @@ func TestService_ProcessApplicationTemplates(t *testing.T) {
 				return whSvc
 			},
 			globalRegistrySvcFn: successfulGlobalRegistrySvc,
+			fetchValidatorSvcFn: successfulFetchValidatorListForAppTemplate,
 			clientFn:            successfulClientFetchForOnlyAppTemplate,
 			appTemplateIDs: func() []string {
 				return []string{appTemplateID}
@@ -5122,7 +5701,7 @@ func TestService_ProcessApplicationTemplates(t *testing.T) {
 		{
 			Name: "Error while getting application",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
-				return txGen.ThatSucceedsMultipleTimes(4)
+				return txGen.ThatSucceedsMultipleTimes(5)
 			},
 			appSvcFn: func() *automock.ApplicationService {
 				appSvc := &automock.ApplicationService{}
@@ -5137,6 +5716,7 @@ func TestService_ProcessApplicationTemplates(t *testing.T) {
 				return whSvc
 			},
 			globalRegistrySvcFn: successfulGlobalRegistrySvc,
+			fetchValidatorSvcFn: successfulFetchValidatorListForAppTemplate,
 			clientFn:            successfulClientFetchForOnlyAppTemplate,
 			appTemplateIDs: func() []string {
 				return []string{appTemplateID}
@@ -5147,7 +5727,7 @@ func TestService_ProcessApplicationTemplates(t *testing.T) {
 		{
 			Name: "Error while getting lowest owner of resource",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
-				return txGen.ThatSucceedsMultipleTimes(4)
+				return txGen.ThatSucceedsMultipleTimes(5)
 			},
 			appSvcFn: func() *automock.ApplicationService {
 				appSvc := &automock.ApplicationService{}
@@ -5165,6 +5745,7 @@ func TestService_ProcessApplicationTemplates(t *testing.T) {
 				return whSvc
 			},
 			globalRegistrySvcFn: successfulGlobalRegistrySvc,
+			fetchValidatorSvcFn: successfulFetchValidatorListForAppTemplate,
 			clientFn:            successfulClientFetchForOnlyAppTemplate,
 			appTemplateIDs: func() []string {
 				return []string{appTemplateID}
@@ -5193,6 +5774,9 @@ func TestService_ProcessApplicationTemplates(t *testing.T) {
 			bndlRefSvc := &automock.BundleReferenceService{}
 			apiSvc := &automock.APIService{}
 			eventSvc := &automock.EventService{}
+			entityTypeSvc := &automock.EntityTypeService{}
+			capabilitySvc := &automock.CapabilityService{}
+			integrationDepSvc := &automock.IntegrationDependencyService{}
 			specSvc := &automock.SpecService{}
 			fetchReqSvc := &automock.FetchRequestService{}
 			packageSvc := &automock.PackageService{}
@@ -5211,6 +5795,10 @@ func TestService_ProcessApplicationTemplates(t *testing.T) {
 			if test.clientFn != nil {
 				client = test.clientFn()
 			}
+			fetchValidatorSvc := &automock.FetchValidatorService{}
+			if test.fetchValidatorSvcFn != nil {
+				fetchValidatorSvc = test.fetchValidatorSvcFn()
+			}
 			appTemplateSvc := &automock.ApplicationTemplateService{}
 			if test.appTemplateSvcFn != nil {
 				appTemplateSvc = test.appTemplateSvcFn()
@@ -5221,7 +5809,7 @@ func TestService_ProcessApplicationTemplates(t *testing.T) {
 			}
 
 			ordCfg := ord.NewServiceConfig(4, 100, 0, "", false, credentialExchangeStrategyTenantMappings)
-			svc := ord.NewAggregatorService(ordCfg, tx, appSvc, whSvc, bndlSvc, bndlRefSvc, apiSvc, eventSvc, specSvc, fetchReqSvc, packageSvc, productSvc, vendorSvc, tombstoneSvc, tenantSvc, globalRegistrySvcFn, client, whConv, appTemplateVersionSvc, appTemplateSvc)
+			svc := ord.NewAggregatorService(ordCfg, tx, appSvc, whSvc, bndlSvc, bndlRefSvc, apiSvc, eventSvc, entityTypeSvc, capabilitySvc, integrationDepSvc, specSvc, fetchReqSvc, packageSvc, productSvc, vendorSvc, tombstoneSvc, tenantSvc, globalRegistrySvcFn, client, whConv, appTemplateVersionSvc, appTemplateSvc, fetchValidatorSvc)
 			err := svc.ProcessApplicationTemplates(context.TODO(), ord.MetricsConfig{}, test.appTemplateIDs())
 			if test.ExpectedErr != nil {
 				require.Error(t, err)
@@ -5230,7 +5818,7 @@ func TestService_ProcessApplicationTemplates(t *testing.T) {
 				require.NoError(t, err)
 			}
 
-			mock.AssertExpectationsForObjects(t, tx, appSvc, whSvc, bndlSvc, apiSvc, eventSvc, specSvc, packageSvc, productSvc, vendorSvc, tombstoneSvc, tenantSvc, globalRegistrySvcFn, client)
+			mock.AssertExpectationsForObjects(t, tx, appSvc, whSvc, bndlSvc, apiSvc, eventSvc, entityTypeSvc, capabilitySvc, integrationDepSvc, specSvc, packageSvc, productSvc, vendorSvc, tombstoneSvc, tenantSvc, globalRegistrySvcFn, client, fetchValidatorSvc)
 		})
 	}
 }
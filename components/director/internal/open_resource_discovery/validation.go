@@ -36,7 +36,7 @@ const (
 	// BundleOrdIDRegex represents the valid structure of the ordID of the ConsumptionBundle
 	BundleOrdIDRegex = "^([a-z0-9-]+(?:[.][a-z0-9-]+)*):(consumptionBundle):([a-zA-Z0-9._\\-]+):(alpha|beta|v[0-9]+)$"
 	// TombstoneOrdIDRegex represents the valid structure of the ordID of the Tombstone
-	TombstoneOrdIDRegex = "^([a-z0-9-]+(?:[.][a-z0-9-]+)*):(package|consumptionBundle|product|vendor|apiResource|eventResource):([a-zA-Z0-9._\\-]+):(alpha|beta|v[0-9]+|)$"
+	TombstoneOrdIDRegex = "^([a-z0-9-]+(?:[.][a-z0-9-]+)*):(package|consumptionBundle|product|vendor|apiResource|eventResource|entityType|capability|integrationDependency):([a-zA-Z0-9._\\-]+):(alpha|beta|v[0-9]+|)$"
 	// SystemInstanceBaseURLRegex represents the valid structure of the field
 	SystemInstanceBaseURLRegex = "^http[s]?:\\/\\/[^:\\/\\s]+\\.[^:\\/\\s\\.]+(:\\d+)?(\\/[a-zA-Z0-9-\\._~]+)?$"
 	// ConfigBaseURLRegex represents the valid structure of the field
@@ -49,6 +49,16 @@ const (
 	APIOrdIDRegex = "^([a-z0-9-]+(?:[.][a-z0-9-]+)*):(apiResource):([a-zA-Z0-9._\\-]+):(alpha|beta|v[0-9]+)$"
 	// EventOrdIDRegex represents the valid structure of the ordID of the Event
 	EventOrdIDRegex = "^([a-z0-9-]+(?:[.][a-z0-9-]+)*):(eventResource):([a-zA-Z0-9._\\-]+):(alpha|beta|v[0-9]+)$"
+	// EntityTypeOrdIDRegex represents the valid structure of the ordID of the Entity Type
+	EntityTypeOrdIDRegex = "^([a-z0-9-]+(?:[.][a-z0-9-]+)*):(entityType):([a-zA-Z0-9._\\-]+):(alpha|beta|v[0-9]+)$"
+	// CapabilityOrdIDRegex represents the valid structure of the ordID of the Capability
+	CapabilityOrdIDRegex = "^([a-z0-9-]+(?:[.][a-z0-9-]+)*):(capability):([a-zA-Z0-9._\\-]+):(alpha|beta|v[0-9]+)$"
+	// IntegrationDependencyOrdIDRegex represents the valid structure of the ordID of the Integration Dependency
+	IntegrationDependencyOrdIDRegex = "^([a-z0-9-]+(?:[.][a-z0-9-]+)*):(integrationDependency):([a-zA-Z0-9._\\-]+):(alpha|beta|v[0-9]+)$"
+	// EntityTypeLocalIDRegex represents the valid structure of the localID of the Entity Type
+	EntityTypeLocalIDRegex = "^([a-zA-Z0-9._\\-]+)$"
+	// CustomCapabilityTypeRegex represents the valid structure of the custom type of the Capability
+	CustomCapabilityTypeRegex = "^([a-z0-9-]+(?:[.][a-z0-9-]+)*):([a-zA-Z0-9._\\-]+):v([0-9]+)$"
 	// CorrelationIDsRegex represents the valid structure of the field
 	CorrelationIDsRegex = "^([a-z0-9-]+(?:[.][a-z0-9-]+)*):([a-zA-Z0-9._\\-\\/]+):([a-zA-Z0-9._\\-\\/]+)$"
 	// LabelsKeyRegex represents the valid structure of the field
@@ -139,6 +149,23 @@ const (
 	// APIImplementationStandardCustom is one of the available api implementation standard options
 	APIImplementationStandardCustom = custom
 
+	// EntityTypeLevelAggregate is one of the available entity type level options
+	EntityTypeLevelAggregate string = "aggregate"
+	// EntityTypeLevelRootEntity is one of the available entity type level options
+	EntityTypeLevelRootEntity string = "root-entity"
+	// EntityTypeLevelSubEntity is one of the available entity type level options
+	EntityTypeLevelSubEntity string = "sub-entity"
+
+	// CapabilityTypeMDICapabilityV1 is one of the available capability type options
+	CapabilityTypeMDICapabilityV1 string = "sap.mdo:mdi-capability:v1"
+	// CapabilityTypeCustom is one of the available capability type options
+	CapabilityTypeCustom = custom
+
+	// CapabilityDefinitionTypeMDICapabilityDefinitionV1 is one of the available capability definition type options
+	CapabilityDefinitionTypeMDICapabilityDefinitionV1 string = "sap.mdo:mdi-capability-definition:v1"
+	// CapabilityDefinitionTypeCustom is one of the available capability definition type options
+	CapabilityDefinitionTypeCustom = custom
+
 	// SapVendor is a valid Vendor ordID
 	SapVendor = "sap:vendor:SAP:"
 	// PartnerVendor is a valid partner Vendor ordID
@@ -475,6 +502,107 @@ func validateEventInput(event *model.EventDefinitionInput, packagePolicyLevels m
 	)
 }
 
+func validateEntityTypeInput(entityType *model.EntityTypeInput, packagePolicyLevels map[string]string, entityTypesFromDB map[string]*model.EntityType, resourceHashes map[string]uint64) error {
+	return validation.ValidateStruct(entityType,
+		validation.Field(&entityType.OrdID, validation.Required, validation.Match(regexp.MustCompile(EntityTypeOrdIDRegex))),
+		validation.Field(&entityType.LocalID, validation.Required, validation.Length(MinLocalTenantIDLength, MaxLocalTenantIDLength), validation.Match(regexp.MustCompile(EntityTypeLocalIDRegex))),
+		validation.Field(&entityType.CorrelationIDs, validation.By(func(value interface{}) error {
+			return validateJSONArrayOfStringsMatchPattern(value, regexp.MustCompile(CorrelationIDsRegex))
+		})),
+		validation.Field(&entityType.Level, validation.Required, validation.In(EntityTypeLevelAggregate, EntityTypeLevelRootEntity, EntityTypeLevelSubEntity)),
+		validation.Field(&entityType.Title, validation.Required),
+		validation.Field(&entityType.ShortDescription, optionalShortDescriptionRules...),
+		validation.Field(&entityType.Description, validation.NilOrNotEmpty, validation.Length(MinDescriptionLength, MaxDescriptionLength)),
+		validation.Field(&entityType.OrdPackageID, validation.Required, validation.Match(regexp.MustCompile(PackageOrdIDRegex))),
+		validation.Field(&entityType.Version, validation.Required, validation.Match(regexp.MustCompile(SemVerRegex)), validation.By(func(value interface{}) error {
+			return validateEntityTypeVersionInput(value, *entityType, entityTypesFromDB, resourceHashes)
+		})),
+		validation.Field(&entityType.ChangeLogEntries, validation.By(validateORDChangeLogEntries)),
+		validation.Field(&entityType.Visibility, validation.Required, validation.In(APIVisibilityPublic, APIVisibilityInternal, APIVisibilityPrivate)),
+		validation.Field(&entityType.Links, validation.By(validateORDLinks)),
+		validation.Field(&entityType.PartOfProducts, validation.By(func(value interface{}) error {
+			return validateJSONArrayOfStringsMatchPattern(value, regexp.MustCompile(ProductOrdIDRegex))
+		})),
+		validation.Field(&entityType.PolicyLevel, validation.In(PolicyLevelSap, PolicyLevelSapPartner, PolicyLevelCustom, PolicyLevelNone), validation.When(entityType.CustomPolicyLevel != nil, validation.In(PolicyLevelCustom))),
+		validation.Field(&entityType.CustomPolicyLevel, validation.When(entityType.PolicyLevel != nil && *entityType.PolicyLevel != PolicyLevelCustom, validation.Empty), validation.Match(regexp.MustCompile(CustomPolicyLevelRegex))),
+		validation.Field(&entityType.ReleaseStatus, validation.Required, validation.In(ReleaseStatusBeta, ReleaseStatusActive, ReleaseStatusDeprecated)),
+		validation.Field(&entityType.SunsetDate, validation.When(entityType.ReleaseStatus == ReleaseStatusDeprecated, validation.Required), validation.When(entityType.SunsetDate != nil, validation.By(isValidDate))),
+		validation.Field(&entityType.Successors, validation.By(func(value interface{}) error {
+			return validateJSONArrayOfStringsMatchPattern(value, regexp.MustCompile(EntityTypeOrdIDRegex))
+		})),
+		validation.Field(&entityType.Extensible, validation.By(func(value interface{}) error {
+			return validateExtensibleField(value, &entityType.OrdPackageID, packagePolicyLevels)
+		})),
+		validation.Field(&entityType.Tags, validation.By(func(value interface{}) error {
+			return validateJSONArrayOfStringsMatchPattern(value, regexp.MustCompile(StringArrayElementRegex))
+		})),
+		validation.Field(&entityType.Labels, validation.By(validateORDLabels)),
+		validation.Field(&entityType.DocumentationLabels, validation.By(validateDocumentationLabels)),
+	)
+}
+
+func validateCapabilityInput(capability *model.CapabilityInput, packagePolicyLevels map[string]string, capabilitiesFromDB map[string]*model.Capability, resourceHashes map[string]uint64) error {
+	return validation.ValidateStruct(capability,
+		validation.Field(&capability.OrdID, validation.Required, validation.Match(regexp.MustCompile(CapabilityOrdIDRegex))),
+		validation.Field(&capability.LocalID, validation.NilOrNotEmpty, validation.Length(MinLocalTenantIDLength, MaxLocalTenantIDLength)),
+		validation.Field(&capability.Title, validation.Required),
+		validation.Field(&capability.ShortDescription, optionalShortDescriptionRules...),
+		validation.Field(&capability.Description, validation.NilOrNotEmpty, validation.Length(MinDescriptionLength, MaxDescriptionLength)),
+		validation.Field(&capability.Type, validation.Required, validation.In(CapabilityTypeMDICapabilityV1, CapabilityTypeCustom), validation.When(capability.CustomType != nil, validation.In(CapabilityTypeCustom))),
+		validation.Field(&capability.CustomType, validation.When(capability.Type != CapabilityTypeCustom, validation.Empty), validation.Match(regexp.MustCompile(CustomCapabilityTypeRegex))),
+		validation.Field(&capability.OrdPackageID, validation.Required, validation.Match(regexp.MustCompile(PackageOrdIDRegex))),
+		validation.Field(&capability.Version, validation.Required, validation.Match(regexp.MustCompile(SemVerRegex)), validation.By(func(value interface{}) error {
+			return validateCapabilityVersionInput(value, *capability, capabilitiesFromDB, resourceHashes)
+		})),
+		validation.Field(&capability.Visibility, validation.Required, validation.In(APIVisibilityPublic, APIVisibilityInternal, APIVisibilityPrivate)),
+		validation.Field(&capability.ReleaseStatus, validation.Required, validation.In(ReleaseStatusBeta, ReleaseStatusActive, ReleaseStatusDeprecated)),
+		validation.Field(&capability.Definitions, validation.By(validateCapabilityDefinitions)),
+		validation.Field(&capability.RelatedEntityTypes, validation.By(func(value interface{}) error {
+			return validateJSONArrayOfStringsMatchPattern(value, regexp.MustCompile(EntityTypeOrdIDRegex))
+		})),
+		validation.Field(&capability.Links, validation.By(validateORDLinks)),
+		validation.Field(&capability.Tags, validation.By(func(value interface{}) error {
+			return validateJSONArrayOfStringsMatchPattern(value, regexp.MustCompile(StringArrayElementRegex))
+		})),
+		validation.Field(&capability.Labels, validation.By(validateORDLabels)),
+		validation.Field(&capability.DocumentationLabels, validation.By(validateDocumentationLabels)),
+	)
+}
+
+func validateIntegrationDependencyInput(integrationDependency *model.IntegrationDependencyInput, integrationDependenciesFromDB map[string]*model.IntegrationDependency, resourceHashes map[string]uint64) error {
+	return validation.ValidateStruct(integrationDependency,
+		validation.Field(&integrationDependency.OrdID, validation.Required, validation.Match(regexp.MustCompile(IntegrationDependencyOrdIDRegex))),
+		validation.Field(&integrationDependency.LocalID, validation.NilOrNotEmpty, validation.Length(MinLocalTenantIDLength, MaxLocalTenantIDLength)),
+		validation.Field(&integrationDependency.CorrelationIDs, validation.By(func(value interface{}) error {
+			return validateJSONArrayOfStringsMatchPattern(value, regexp.MustCompile(CorrelationIDsRegex))
+		})),
+		validation.Field(&integrationDependency.Title, validation.Required),
+		validation.Field(&integrationDependency.ShortDescription, optionalShortDescriptionRules...),
+		validation.Field(&integrationDependency.Description, validation.NilOrNotEmpty, validation.Length(MinDescriptionLength, MaxDescriptionLength)),
+		validation.Field(&integrationDependency.OrdPackageID, validation.Required, validation.Match(regexp.MustCompile(PackageOrdIDRegex))),
+		validation.Field(&integrationDependency.Version, validation.Required, validation.Match(regexp.MustCompile(SemVerRegex)), validation.By(func(value interface{}) error {
+			return validateIntegrationDependencyVersionInput(value, *integrationDependency, integrationDependenciesFromDB, resourceHashes)
+		})),
+		validation.Field(&integrationDependency.Visibility, validation.Required, validation.In(APIVisibilityPublic, APIVisibilityInternal, APIVisibilityPrivate)),
+		validation.Field(&integrationDependency.ReleaseStatus, validation.Required, validation.In(ReleaseStatusBeta, ReleaseStatusActive, ReleaseStatusDeprecated)),
+		validation.Field(&integrationDependency.SunsetDate, validation.When(integrationDependency.ReleaseStatus == ReleaseStatusDeprecated, validation.Required), validation.When(integrationDependency.SunsetDate != nil, validation.By(isValidDate))),
+		validation.Field(&integrationDependency.Successors, validation.By(func(value interface{}) error {
+			return validateJSONArrayOfStringsMatchPattern(value, regexp.MustCompile(IntegrationDependencyOrdIDRegex))
+		})),
+		validation.Field(&integrationDependency.Mandatory, validation.NotNil),
+		validation.Field(&integrationDependency.Aspects, validation.Required, validation.By(validateIntegrationDependencyAspects)),
+		validation.Field(&integrationDependency.RelatedIntegrationDependencies, validation.By(func(value interface{}) error {
+			return validateJSONArrayOfStringsMatchPattern(value, regexp.MustCompile(IntegrationDependencyOrdIDRegex))
+		})),
+		validation.Field(&integrationDependency.Links, validation.By(validateORDLinks)),
+		validation.Field(&integrationDependency.Tags, validation.By(func(value interface{}) error {
+			return validateJSONArrayOfStringsMatchPattern(value, regexp.MustCompile(StringArrayElementRegex))
+		})),
+		validation.Field(&integrationDependency.Labels, validation.By(validateORDLabels)),
+		validation.Field(&integrationDependency.DocumentationLabels, validation.By(validateDocumentationLabels)),
+	)
+}
+
 func validateProductInput(product *model.ProductInput) error {
 	productOrdIDNamespace := strings.Split(product.OrdID, ":")[0]
 
@@ -783,6 +911,75 @@ func validateEventResourceDefinition(value interface{}, event model.EventDefinit
 	return nil
 }
 
+func validateCapabilityDefinitions(value interface{}) error {
+	return validateJSONArrayOfObjects(value, map[string][]validation.Rule{
+		"type": {
+			validation.Required,
+			validation.In(CapabilityDefinitionTypeMDICapabilityDefinitionV1, CapabilityDefinitionTypeCustom),
+		},
+		"customType": {},
+		"mediaType": {
+			validation.Required,
+		},
+		"url": {
+			validation.Required,
+			is.RequestURI,
+		},
+		"accessStrategies": {},
+	}, validateCapabilityDefinitionCustomType)
+}
+
+func validateCapabilityDefinitionCustomType(el gjson.Result) error {
+	if el.Get("customType").Exists() && el.Get("type").String() != custom {
+		return errors.New("if customType is provided, type should be set to 'custom'")
+	}
+
+	return validation.Validate(el.Get("customType").String(), validation.Match(regexp.MustCompile(CustomCapabilityTypeRegex)))
+}
+
+func validateIntegrationDependencyAspects(value interface{}) error {
+	return validateJSONArrayOfObjects(value, map[string][]validation.Rule{
+		"title": {
+			validation.Required,
+		},
+		"description": {
+			validation.NilOrNotEmpty,
+		},
+		"mandatory": {
+			validation.NotNil,
+		},
+		"supportMultipleProviders": {},
+		"apiResources":             {},
+		"eventResources":           {},
+	}, validateIntegrationDependencyAspectResources)
+}
+
+func validateIntegrationDependencyAspectResources(el gjson.Result) error {
+	for _, apiResource := range el.Get("apiResources").Array() {
+		if err := validation.Validate(apiResource.Get("ordId").String(), validation.Required, validation.Match(regexp.MustCompile(APIOrdIDRegex))); err != nil {
+			return errors.Wrap(err, "error validating field apiResources.ordId")
+		}
+		if minVersion := apiResource.Get("minVersion"); minVersion.Exists() {
+			if err := validation.Validate(minVersion.String(), validation.Match(regexp.MustCompile(SemVerRegex))); err != nil {
+				return errors.Wrap(err, "error validating field apiResources.minVersion")
+			}
+		}
+	}
+
+	for _, eventResource := range el.Get("eventResources").Array() {
+		if err := validation.Validate(eventResource.Get("ordId").String(), validation.Required, validation.Match(regexp.MustCompile(EventOrdIDRegex))); err != nil {
+			return errors.Wrap(err, "error validating field eventResources.ordId")
+		}
+		if minVersion := eventResource.Get("minVersion"); minVersion.Exists() {
+			if err := validation.Validate(minVersion.String(), validation.Match(regexp.MustCompile(SemVerRegex))); err != nil {
+				return errors.Wrap(err, "error validating field eventResources.minVersion")
+			}
+		}
+	}
+
+	return nil
+}
+
 func validatePackageVersionInput(value interface{}, pkg model.PackageInput, pkgsFromDB map[string]*model.Package, resourceHashes map[string]uint64) error {
 	if value == nil {
 		return nil
@@ -830,6 +1027,66 @@ func validateBundleVersionInput(value interface{}, bndl model.BundleCreateInput,
 	return nil
 }
 
+func validateEntityTypeVersionInput(value interface{}, entityType model.EntityTypeInput, entityTypesFromDB map[string]*model.EntityType, resourceHashes map[string]uint64) error {
+	if value == nil {
+		return nil
+	}
+
+	if len(entityTypesFromDB) == 0 {
+		return nil
+	}
+
+	entityTypeFromDB, ok := entityTypesFromDB[entityType.OrdID]
+	if !ok || isResourceHashMissing(entityTypeFromDB.ResourceHash) {
+		return nil
+	}
+
+	hashDB := str.PtrStrToStr(entityTypeFromDB.ResourceHash)
+	hashDoc := strconv.FormatUint(resourceHashes[entityType.OrdID], 10)
+
+	return checkHashEquality(entityTypeFromDB.Version, entityType.Version, hashDB, hashDoc)
+}
+
+func validateCapabilityVersionInput(value interface{}, capability model.CapabilityInput, capabilitiesFromDB map[string]*model.Capability, resourceHashes map[string]uint64) error {
+	if value == nil {
+		return nil
+	}
+
+	if len(capabilitiesFromDB) == 0 {
+		return nil
+	}
+
+	capabilityFromDB, ok := capabilitiesFromDB[capability.OrdID]
+	if !ok || isResourceHashMissing(capabilityFromDB.ResourceHash) {
+		return nil
+	}
+
+	hashDB := str.PtrStrToStr(capabilityFromDB.ResourceHash)
+	hashDoc := strconv.FormatUint(resourceHashes[capability.OrdID], 10)
+
+	return checkHashEquality(capabilityFromDB.Version, capability.Version, hashDB, hashDoc)
+}
+
+func validateIntegrationDependencyVersionInput(value interface{}, integrationDependency model.IntegrationDependencyInput, integrationDependenciesFromDB map[string]*model.IntegrationDependency, resourceHashes map[string]uint64) error {
+	if value == nil {
+		return nil
+	}
+
+	if len(integrationDependenciesFromDB) == 0 {
+		return nil
+	}
+
+	integrationDependencyFromDB, ok := integrationDependenciesFromDB[integrationDependency.OrdID]
+	if !ok || isResourceHashMissing(integrationDependencyFromDB.ResourceHash) {
+		return nil
+	}
+
+	hashDB := str.PtrStrToStr(integrationDependencyFromDB.ResourceHash)
+	hashDoc := strconv.FormatUint(resourceHashes[integrationDependency.OrdID], 10)
+
+	return checkHashEquality(integrationDependencyFromDB.Version, integrationDependency.Version, hashDB, hashDoc)
+}
+
 func validateEventDefinitionVersionInput(value interface{}, event model.EventDefinitionInput, eventsFromDB map[string]*model.EventDefinition, eventHashes map[string]uint64) error {
 	if value == nil {
 		return nil
@@ -898,6 +1155,48 @@ func normalizeEventDefinition(event *model.EventDefinitionInput) (model.EventDef
 	return normalizedEventDefinition, nil
 }
 
+func normalizeEntityType(entityType *model.EntityTypeInput) (model.EntityTypeInput, error) {
+	bytes, err := json.Marshal(entityType)
+	if err != nil {
+		return model.EntityTypeInput{}, errors.Wrapf(err, "error while marshalling entity type with ID %s", entityType.OrdID)
+	}
+
+	var normalizedEntityType model.EntityTypeInput
+	if err := json.Unmarshal(bytes, &normalizedEntityType); err != nil {
+		return model.EntityTypeInput{}, errors.Wrapf(err, "error while unmarshalling entity type with ID %s", entityType.OrdID)
+	}
+
+	return normalizedEntityType, nil
+}
+
+func normalizeCapability(capability *model.CapabilityInput) (model.CapabilityInput, error) {
+	bytes, err := json.Marshal(capability)
+	if err != nil {
+		return model.CapabilityInput{}, errors.Wrapf(err, "error while marshalling capability with ID %s", capability.OrdID)
+	}
+
+	var normalizedCapability model.CapabilityInput
+	if err := json.Unmarshal(bytes, &normalizedCapability); err != nil {
+		return model.CapabilityInput{}, errors.Wrapf(err, "error while unmarshalling capability with ID %s", capability.OrdID)
+	}
+
+	return normalizedCapability, nil
+}
+
+func normalizeIntegrationDependency(integrationDependency *model.IntegrationDependencyInput) (model.IntegrationDependencyInput, error) {
+	bytes, err := json.Marshal(integrationDependency)
+	if err != nil {
+		return model.IntegrationDependencyInput{}, errors.Wrapf(err, "error while marshalling integration dependency with ID %s", integrationDependency.OrdID)
+	}
+
+	var normalizedIntegrationDependency model.IntegrationDependencyInput
+	if err := json.Unmarshal(bytes, &normalizedIntegrationDependency); err != nil {
+		return model.IntegrationDependencyInput{}, errors.Wrapf(err, "error while unmarshalling integration dependency with ID %s", integrationDependency.OrdID)
+	}
+
+	return normalizedIntegrationDependency, nil
+}
+
 func normalizePackage(pkg *model.PackageInput) (model.PackageInput, error) {
 	bytes, err := json.Marshal(pkg)
 	if err != nil {
@@ -5704,6 +5704,467 @@ func TestDocuments_ValidateTombstone(t *testing.T) {
 	}
 }
 
+func TestDocuments_ValidateEntityType(t *testing.T) {
+	var tests = []struct {
+		Name              string
+		DocumentProvider  func() []*ord.Document
+		ExpectedToBeValid bool
+	}{
+		{
+			Name: "Valid Entity Type",
+			DocumentProvider: func() []*ord.Document {
+				doc := fixORDDocument()
+				doc.EntityTypes = []*model.EntityTypeInput{fixEntityTypeInput()}
+				doc.Capabilities = []*model.CapabilityInput{fixCapabilityInput()}
+
+				return []*ord.Document{doc}
+			},
+			ExpectedToBeValid: true,
+		}, {
+			Name: "Missing `ordId` field for Entity Type",
+			DocumentProvider: func() []*ord.Document {
+				doc := fixORDDocument()
+				doc.EntityTypes = []*model.EntityTypeInput{fixEntityTypeInput()}
+				doc.Capabilities = []*model.CapabilityInput{fixCapabilityInput()}
+				doc.EntityTypes[0].OrdID = ""
+
+				return []*ord.Document{doc}
+			},
+		}, {
+			Name: "Invalid `ordId` field for Entity Type",
+			DocumentProvider: func() []*ord.Document {
+				doc := fixORDDocument()
+				doc.EntityTypes = []*model.EntityTypeInput{fixEntityTypeInput()}
+				doc.Capabilities = []*model.CapabilityInput{fixCapabilityInput()}
+				doc.EntityTypes[0].OrdID = invalidOrdID
+
+				return []*ord.Document{doc}
+			},
+		}, {
+			Name: "Missing `localId` field for Entity Type",
+			DocumentProvider: func() []*ord.Document {
+				doc := fixORDDocument()
+				doc.EntityTypes = []*model.EntityTypeInput{fixEntityTypeInput()}
+				doc.Capabilities = []*model.CapabilityInput{fixCapabilityInput()}
+				doc.EntityTypes[0].LocalID = ""
+
+				return []*ord.Document{doc}
+			},
+		}, {
+			Name: "Invalid `level` field for Entity Type",
+			DocumentProvider: func() []*ord.Document {
+				doc := fixORDDocument()
+				doc.EntityTypes = []*model.EntityTypeInput{fixEntityTypeInput()}
+				doc.Capabilities = []*model.CapabilityInput{fixCapabilityInput()}
+				doc.EntityTypes[0].Level = "invalid-level"
+
+				return []*ord.Document{doc}
+			},
+		}, {
+			Name: "Missing `title` field for Entity Type",
+			DocumentProvider: func() []*ord.Document {
+				doc := fixORDDocument()
+				doc.EntityTypes = []*model.EntityTypeInput{fixEntityTypeInput()}
+				doc.Capabilities = []*model.CapabilityInput{fixCapabilityInput()}
+				doc.EntityTypes[0].Title = ""
+
+				return []*ord.Document{doc}
+			},
+		}, {
+			Name: "Invalid `version` field for Entity Type",
+			DocumentProvider: func() []*ord.Document {
+				doc := fixORDDocument()
+				doc.EntityTypes = []*model.EntityTypeInput{fixEntityTypeInput()}
+				doc.Capabilities = []*model.CapabilityInput{fixCapabilityInput()}
+				doc.EntityTypes[0].Version = "invalidVersion"
+
+				return []*ord.Document{doc}
+			},
+		}, {
+			Name: "Invalid `visibility` field for Entity Type",
+			DocumentProvider: func() []*ord.Document {
+				doc := fixORDDocument()
+				doc.EntityTypes = []*model.EntityTypeInput{fixEntityTypeInput()}
+				doc.Capabilities = []*model.CapabilityInput{fixCapabilityInput()}
+				doc.EntityTypes[0].Visibility = "wrong"
+
+				return []*ord.Document{doc}
+			},
+		}, {
+			Name: "Missing `sunsetDate` field when `releaseStatus` is deprecated for Entity Type",
+			DocumentProvider: func() []*ord.Document {
+				doc := fixORDDocument()
+				doc.EntityTypes = []*model.EntityTypeInput{fixEntityTypeInput()}
+				doc.Capabilities = []*model.CapabilityInput{fixCapabilityInput()}
+				doc.EntityTypes[0].ReleaseStatus = "deprecated"
+
+				return []*ord.Document{doc}
+			},
+		}, {
+			Name: "Invalid element of `successors` field for Entity Type",
+			DocumentProvider: func() []*ord.Document {
+				doc := fixORDDocument()
+				doc.EntityTypes = []*model.EntityTypeInput{fixEntityTypeInput()}
+				doc.Capabilities = []*model.CapabilityInput{fixCapabilityInput()}
+				doc.EntityTypes[0].Successors = json.RawMessage(`["invalidValue"]`)
+
+				return []*ord.Document{doc}
+			},
+		}, {
+			Name: "`partOfPackage` field for Entity Type references unknown package",
+			DocumentProvider: func() []*ord.Document {
+				doc := fixORDDocument()
+				doc.EntityTypes = []*model.EntityTypeInput{fixEntityTypeInput()}
+				doc.Capabilities = []*model.CapabilityInput{fixCapabilityInput()}
+				doc.EntityTypes[0].OrdPackageID = "ns:package:UNKNOWN_PACKAGE_ID:v1"
+
+				return []*ord.Document{doc}
+			},
+		}, {
+			Name: "`partOfProducts` field for Entity Type references unknown product",
+			DocumentProvider: func() []*ord.Document {
+				doc := fixORDDocument()
+				doc.EntityTypes = []*model.EntityTypeInput{fixEntityTypeInput()}
+				doc.Capabilities = []*model.CapabilityInput{fixCapabilityInput()}
+				doc.EntityTypes[0].PartOfProducts = json.RawMessage(`["ns:product:UNKNOWN_PRODUCT_ID:"]`)
+
+				return []*ord.Document{doc}
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			docs := ord.Documents{test.DocumentProvider()[0]}
+			resourcesFromDB := ord.ResourcesFromDB{
+				APIs:     apisFromDB,
+				Events:   eventsFromDB,
+				Packages: pkgsFromDB,
+				Bundles:  bndlsFromDB,
+			}
+			err := docs.Validate(baseURL, resourcesFromDB, resourceHashes, nil, credentialExchangeStrategyTenantMappings)
+			if test.ExpectedToBeValid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestDocuments_ValidateCapability(t *testing.T) {
+	var tests = []struct {
+		Name              string
+		DocumentProvider  func() []*ord.Document
+		ExpectedToBeValid bool
+	}{
+		{
+			Name: "Valid Capability",
+			DocumentProvider: func() []*ord.Document {
+				doc := fixORDDocument()
+				doc.EntityTypes = []*model.EntityTypeInput{fixEntityTypeInput()}
+				doc.Capabilities = []*model.CapabilityInput{fixCapabilityInput()}
+
+				return []*ord.Document{doc}
+			},
+			ExpectedToBeValid: true,
+		}, {
+			Name: "Valid Capability with custom `type`",
+			DocumentProvider: func() []*ord.Document {
+				doc := fixORDDocument()
+				doc.EntityTypes = []*model.EntityTypeInput{fixEntityTypeInput()}
+				doc.Capabilities = []*model.CapabilityInput{fixCapabilityInput()}
+				doc.Capabilities[0].Type = "custom"
+				doc.Capabilities[0].CustomType = str.Ptr("ns:custom:v1")
+
+				return []*ord.Document{doc}
+			},
+			ExpectedToBeValid: true,
+		}, {
+			Name: "Missing `ordId` field for Capability",
+			DocumentProvider: func() []*ord.Document {
+				doc := fixORDDocument()
+				doc.EntityTypes = []*model.EntityTypeInput{fixEntityTypeInput()}
+				doc.Capabilities = []*model.CapabilityInput{fixCapabilityInput()}
+				doc.Capabilities[0].OrdID = ""
+
+				return []*ord.Document{doc}
+			},
+		}, {
+			Name: "Invalid `ordId` field for Capability",
+			DocumentProvider: func() []*ord.Document {
+				doc := fixORDDocument()
+				doc.EntityTypes = []*model.EntityTypeInput{fixEntityTypeInput()}
+				doc.Capabilities = []*model.CapabilityInput{fixCapabilityInput()}
+				doc.Capabilities[0].OrdID = invalidOrdID
+
+				return []*ord.Document{doc}
+			},
+		}, {
+			Name: "Missing `title` field for Capability",
+			DocumentProvider: func() []*ord.Document {
+				doc := fixORDDocument()
+				doc.EntityTypes = []*model.EntityTypeInput{fixEntityTypeInput()}
+				doc.Capabilities = []*model.CapabilityInput{fixCapabilityInput()}
+				doc.Capabilities[0].Title = ""
+
+				return []*ord.Document{doc}
+			},
+		}, {
+			Name: "Invalid `type` field for Capability",
+			DocumentProvider: func() []*ord.Document {
+				doc := fixORDDocument()
+				doc.EntityTypes = []*model.EntityTypeInput{fixEntityTypeInput()}
+				doc.Capabilities = []*model.CapabilityInput{fixCapabilityInput()}
+				doc.Capabilities[0].Type = "invalid-type"
+
+				return []*ord.Document{doc}
+			},
+		}, {
+			Name: "`customType` field is set when `type` is not custom for Capability",
+			DocumentProvider: func() []*ord.Document {
+				doc := fixORDDocument()
+				doc.EntityTypes = []*model.EntityTypeInput{fixEntityTypeInput()}
+				doc.Capabilities = []*model.CapabilityInput{fixCapabilityInput()}
+				doc.Capabilities[0].CustomType = str.Ptr("ns:custom:v1")
+
+				return []*ord.Document{doc}
+			},
+		}, {
+			Name: "Invalid `version` field for Capability",
+			DocumentProvider: func() []*ord.Document {
+				doc := fixORDDocument()
+				doc.EntityTypes = []*model.EntityTypeInput{fixEntityTypeInput()}
+				doc.Capabilities = []*model.CapabilityInput{fixCapabilityInput()}
+				doc.Capabilities[0].Version = "invalidVersion"
+
+				return []*ord.Document{doc}
+			},
+		}, {
+			Name: "Missing `mediaType` field in `definitions` for Capability",
+			DocumentProvider: func() []*ord.Document {
+				doc := fixORDDocument()
+				doc.EntityTypes = []*model.EntityTypeInput{fixEntityTypeInput()}
+				doc.Capabilities = []*model.CapabilityInput{fixCapabilityInput()}
+				doc.Capabilities[0].Definitions = json.RawMessage(`[{"type":"sap.mdo:mdi-capability-definition:v1","url":"/capability/definition.json"}]`)
+
+				return []*ord.Document{doc}
+			},
+		}, {
+			Name: "Invalid `type` field in `definitions` for Capability",
+			DocumentProvider: func() []*ord.Document {
+				doc := fixORDDocument()
+				doc.EntityTypes = []*model.EntityTypeInput{fixEntityTypeInput()}
+				doc.Capabilities = []*model.CapabilityInput{fixCapabilityInput()}
+				doc.Capabilities[0].Definitions = json.RawMessage(`[{"type":"invalid","mediaType":"application/json","url":"/capability/definition.json"}]`)
+
+				return []*ord.Document{doc}
+			},
+		}, {
+			Name: "Invalid element of `relatedEntityTypes` field for Capability",
+			DocumentProvider: func() []*ord.Document {
+				doc := fixORDDocument()
+				doc.EntityTypes = []*model.EntityTypeInput{fixEntityTypeInput()}
+				doc.Capabilities = []*model.CapabilityInput{fixCapabilityInput()}
+				doc.Capabilities[0].RelatedEntityTypes = json.RawMessage(`["invalidValue"]`)
+
+				return []*ord.Document{doc}
+			},
+		}, {
+			Name: "`partOfPackage` field for Capability references unknown package",
+			DocumentProvider: func() []*ord.Document {
+				doc := fixORDDocument()
+				doc.EntityTypes = []*model.EntityTypeInput{fixEntityTypeInput()}
+				doc.Capabilities = []*model.CapabilityInput{fixCapabilityInput()}
+				doc.Capabilities[0].OrdPackageID = "ns:package:UNKNOWN_PACKAGE_ID:v1"
+
+				return []*ord.Document{doc}
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			docs := ord.Documents{test.DocumentProvider()[0]}
+			resourcesFromDB := ord.ResourcesFromDB{
+				APIs:     apisFromDB,
+				Events:   eventsFromDB,
+				Packages: pkgsFromDB,
+				Bundles:  bndlsFromDB,
+			}
+			err := docs.Validate(baseURL, resourcesFromDB, resourceHashes, nil, credentialExchangeStrategyTenantMappings)
+			if test.ExpectedToBeValid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestDocuments_ValidateIntegrationDependency(t *testing.T) {
+	var tests = []struct {
+		Name              string
+		DocumentProvider  func() []*ord.Document
+		ExpectedToBeValid bool
+	}{
+		{
+			Name: "Valid Integration Dependency",
+			DocumentProvider: func() []*ord.Document {
+				doc := fixORDDocument()
+				doc.IntegrationDependencies = []*model.IntegrationDependencyInput{fixIntegrationDependencyInput()}
+
+				return []*ord.Document{doc}
+			},
+			ExpectedToBeValid: true,
+		}, {
+			Name: "Missing `ordId` field for Integration Dependency",
+			DocumentProvider: func() []*ord.Document {
+				doc := fixORDDocument()
+				doc.IntegrationDependencies = []*model.IntegrationDependencyInput{fixIntegrationDependencyInput()}
+				doc.IntegrationDependencies[0].OrdID = ""
+
+				return []*ord.Document{doc}
+			},
+		}, {
+			Name: "Invalid `ordId` field for Integration Dependency",
+			DocumentProvider: func() []*ord.Document {
+				doc := fixORDDocument()
+				doc.IntegrationDependencies = []*model.IntegrationDependencyInput{fixIntegrationDependencyInput()}
+				doc.IntegrationDependencies[0].OrdID = invalidOrdID
+
+				return []*ord.Document{doc}
+			},
+		}, {
+			Name: "Missing `title` field for Integration Dependency",
+			DocumentProvider: func() []*ord.Document {
+				doc := fixORDDocument()
+				doc.IntegrationDependencies = []*model.IntegrationDependencyInput{fixIntegrationDependencyInput()}
+				doc.IntegrationDependencies[0].Title = ""
+
+				return []*ord.Document{doc}
+			},
+		}, {
+			Name: "Invalid `version` field for Integration Dependency",
+			DocumentProvider: func() []*ord.Document {
+				doc := fixORDDocument()
+				doc.IntegrationDependencies = []*model.IntegrationDependencyInput{fixIntegrationDependencyInput()}
+				doc.IntegrationDependencies[0].Version = "invalidVersion"
+
+				return []*ord.Document{doc}
+			},
+		}, {
+			Name: "Missing `mandatory` field for Integration Dependency",
+			DocumentProvider: func() []*ord.Document {
+				doc := fixORDDocument()
+				doc.IntegrationDependencies = []*model.IntegrationDependencyInput{fixIntegrationDependencyInput()}
+				doc.IntegrationDependencies[0].Mandatory = nil
+
+				return []*ord.Document{doc}
+			},
+		}, {
+			Name: "Missing `sunsetDate` field when `releaseStatus` is deprecated for Integration Dependency",
+			DocumentProvider: func() []*ord.Document {
+				doc := fixORDDocument()
+				doc.IntegrationDependencies = []*model.IntegrationDependencyInput{fixIntegrationDependencyInput()}
+				doc.IntegrationDependencies[0].ReleaseStatus = "deprecated"
+
+				return []*ord.Document{doc}
+			},
+		}, {
+			Name: "Missing `aspects` field for Integration Dependency",
+			DocumentProvider: func() []*ord.Document {
+				doc := fixORDDocument()
+				doc.IntegrationDependencies = []*model.IntegrationDependencyInput{fixIntegrationDependencyInput()}
+				doc.IntegrationDependencies[0].Aspects = nil
+
+				return []*ord.Document{doc}
+			},
+		}, {
+			Name: "Missing `title` field in `aspects` for Integration Dependency",
+			DocumentProvider: func() []*ord.Document {
+				doc := fixORDDocument()
+				doc.IntegrationDependencies = []*model.IntegrationDependencyInput{fixIntegrationDependencyInput()}
+				doc.IntegrationDependencies[0].Aspects = json.RawMessage(`[{"mandatory":true}]`)
+
+				return []*ord.Document{doc}
+			},
+		}, {
+			Name: "Missing `mandatory` field in `aspects` for Integration Dependency",
+			DocumentProvider: func() []*ord.Document {
+				doc := fixORDDocument()
+				doc.IntegrationDependencies = []*model.IntegrationDependencyInput{fixIntegrationDependencyInput()}
+				doc.IntegrationDependencies[0].Aspects = json.RawMessage(`[{"title":"ASPECT TITLE"}]`)
+
+				return []*ord.Document{doc}
+			},
+		}, {
+			Name: "Invalid `ordId` of `apiResources` in `aspects` for Integration Dependency",
+			DocumentProvider: func() []*ord.Document {
+				doc := fixORDDocument()
+				doc.IntegrationDependencies = []*model.IntegrationDependencyInput{fixIntegrationDependencyInput()}
+				doc.IntegrationDependencies[0].Aspects = json.RawMessage(`[{"title":"ASPECT TITLE","mandatory":true,"apiResources":[{"ordId":"invalidValue"}]}]`)
+
+				return []*ord.Document{doc}
+			},
+		}, {
+			Name: "Invalid `minVersion` of `apiResources` in `aspects` for Integration Dependency",
+			DocumentProvider: func() []*ord.Document {
+				doc := fixORDDocument()
+				doc.IntegrationDependencies = []*model.IntegrationDependencyInput{fixIntegrationDependencyInput()}
+				doc.IntegrationDependencies[0].Aspects = json.RawMessage(fmt.Sprintf(`[{"title":"ASPECT TITLE","mandatory":true,"apiResources":[{"ordId":"%s","minVersion":"invalidVersion"}]}]`, api1ORDID))
+
+				return []*ord.Document{doc}
+			},
+		}, {
+			Name: "Invalid `ordId` of `eventResources` in `aspects` for Integration Dependency",
+			DocumentProvider: func() []*ord.Document {
+				doc := fixORDDocument()
+				doc.IntegrationDependencies = []*model.IntegrationDependencyInput{fixIntegrationDependencyInput()}
+				doc.IntegrationDependencies[0].Aspects = json.RawMessage(`[{"title":"ASPECT TITLE","mandatory":true,"eventResources":[{"ordId":"invalidValue"}]}]`)
+
+				return []*ord.Document{doc}
+			},
+		}, {
+			Name: "Invalid element of `relatedIntegrationDependencies` field for Integration Dependency",
+			DocumentProvider: func() []*ord.Document {
+				doc := fixORDDocument()
+				doc.IntegrationDependencies = []*model.IntegrationDependencyInput{fixIntegrationDependencyInput()}
+				doc.IntegrationDependencies[0].RelatedIntegrationDependencies = json.RawMessage(`["invalidValue"]`)
+
+				return []*ord.Document{doc}
+			},
+		}, {
+			Name: "`partOfPackage` field for Integration Dependency references unknown package",
+			DocumentProvider: func() []*ord.Document {
+				doc := fixORDDocument()
+				doc.IntegrationDependencies = []*model.IntegrationDependencyInput{fixIntegrationDependencyInput()}
+				doc.IntegrationDependencies[0].OrdPackageID = "ns:package:UNKNOWN_PACKAGE_ID:v1"
+
+				return []*ord.Document{doc}
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			docs := ord.Documents{test.DocumentProvider()[0]}
+			resourcesFromDB := ord.ResourcesFromDB{
+				APIs:     apisFromDB,
+				Events:   eventsFromDB,
+				Packages: pkgsFromDB,
+				Bundles:  bndlsFromDB,
+			}
+			err := docs.Validate(baseURL, resourcesFromDB, resourceHashes, nil, credentialExchangeStrategyTenantMappings)
+			if test.ExpectedToBeValid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
 func TestDocuments_ValidateMultipleErrors(t *testing.T) {
 	var tests = []struct {
 		Name                   string
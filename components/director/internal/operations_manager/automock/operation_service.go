@@ -7,6 +7,8 @@ import (
 
 	model "github.com/kyma-incubator/compass/components/director/internal/model"
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // OperationService is an autogenerated mock type for the OperationService type
@@ -14,6 +16,52 @@ type OperationService struct {
 	mock.Mock
 }
 
+// ClaimNextInOrder provides a mock function with given fields: ctx, opType, maxAttempts
+func (_m *OperationService) ClaimNextInOrder(ctx context.Context, opType string, maxAttempts int) (*model.Operation, error) {
+	ret := _m.Called(ctx, opType, maxAttempts)
+
+	var r0 *model.Operation
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) *model.Operation); ok {
+		r0 = rf(ctx, opType, maxAttempts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Operation)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = rf(ctx, opType, maxAttempts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ClaimNextScheduled provides a mock function with given fields: ctx, opType, maxAttempts
+func (_m *OperationService) ClaimNextScheduled(ctx context.Context, opType string, maxAttempts int) (*model.Operation, error) {
+	ret := _m.Called(ctx, opType, maxAttempts)
+
+	var r0 *model.Operation
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) *model.Operation); ok {
+		r0 = rf(ctx, opType, maxAttempts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Operation)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = rf(ctx, opType, maxAttempts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateMultiple provides a mock function with given fields: ctx, in
 func (_m *OperationService) CreateMultiple(ctx context.Context, in []*model.OperationInput) error {
 	ret := _m.Called(ctx, in)
@@ -42,6 +90,62 @@ func (_m *OperationService) DeleteOlderThan(ctx context.Context, opType string,
 	return r0
 }
 
+// Heartbeat provides a mock function with given fields: ctx, id, claimToken
+func (_m *OperationService) Heartbeat(ctx context.Context, id string, claimToken string) error {
+	ret := _m.Called(ctx, id, claimToken)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, id, claimToken)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MarkAsCompleted provides a mock function with given fields: ctx, id, claimToken
+func (_m *OperationService) MarkAsCompleted(ctx context.Context, id string, claimToken string) error {
+	ret := _m.Called(ctx, id, claimToken)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, id, claimToken)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MarkAsFailed provides a mock function with given fields: ctx, id, claimToken, errorMsg
+func (_m *OperationService) MarkAsFailed(ctx context.Context, id string, claimToken string, errorMsg string) error {
+	ret := _m.Called(ctx, id, claimToken, errorMsg)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = rf(ctx, id, claimToken, errorMsg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RescheduleHangedOperations provides a mock function with given fields: ctx, opType, hangPeriod
+func (_m *OperationService) RescheduleHangedOperations(ctx context.Context, opType string, hangPeriod time.Duration) error {
+	ret := _m.Called(ctx, opType, hangPeriod)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Duration) error); ok {
+		r0 = rf(ctx, opType, hangPeriod)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 type mockConstructorTestingTNewOperationService interface {
 	mock.TestingT
 	Cleanup(func())
@@ -2,6 +2,7 @@ package operationsmanager
 
 import (
 	"context"
+	"time"
 
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 )
@@ -11,6 +12,12 @@ import (
 //go:generate mockery --name=OperationService --output=automock --outpkg=automock --case=underscore --disable-version-string
 type OperationService interface {
 	CreateMultiple(ctx context.Context, in []*model.OperationInput) error
+	ClaimNextScheduled(ctx context.Context, opType string, maxAttempts int) (*model.Operation, error)
+	ClaimNextInOrder(ctx context.Context, opType string, maxAttempts int) (*model.Operation, error)
+	Heartbeat(ctx context.Context, id, claimToken string) error
+	MarkAsCompleted(ctx context.Context, id, claimToken string) error
+	MarkAsFailed(ctx context.Context, id, claimToken, errorMsg string) error
+	RescheduleHangedOperations(ctx context.Context, opType string, hangPeriod time.Duration) error
 	DeleteOlderThan(ctx context.Context, opType string, status model.OperationStatus, days int) error
 }
 
@@ -16,6 +16,10 @@ const (
 	OrdCreatorType = "ORD"
 	// OrdAggregationOpType specifies open resource discovery operation type
 	OrdAggregationOpType = "ORD_AGGREGATION"
+	// NsAdapterReportOpType specifies ns-adapter exposed systems report operation type
+	NsAdapterReportOpType = "NS_ADAPTER_REPORT"
+
+	defaultOperationPriority = 1
 )
 
 // OperationCreator is responsible for creation of different types of operations.
@@ -73,7 +77,7 @@ func (oc *ORDOperationCreator) buildOperationInputs(ctx context.Context) ([]*mod
 			if err != nil {
 				return nil, err
 			}
-			operations = append(operations, buildORDOperationInput(data))
+			operations = append(operations, NewORDOperationInput(data, defaultOperationPriority))
 		}
 	}
 
@@ -97,7 +101,7 @@ func (oc *ORDOperationCreator) appTemplateWebhookToOperations(ctx context.Contex
 		if err != nil {
 			return nil, err
 		}
-		operations = append(operations, buildORDOperationInput(data))
+		operations = append(operations, NewORDOperationInput(data, defaultOperationPriority))
 	}
 
 	return operations, nil
@@ -144,14 +148,15 @@ func (oc *ORDOperationCreator) getApplicationsForAppTemplate(ctx context.Context
 	return apps, err
 }
 
-func buildORDOperationInput(data string) *model.OperationInput {
+// NewORDOperationInput builds the input for a SCHEDULED ORD aggregation operation with the provided data and priority
+func NewORDOperationInput(data string, priority int) *model.OperationInput {
 	now := time.Now()
 	return &model.OperationInput{
 		OpType:     OrdAggregationOpType,
 		Status:     scheduledOpStatus,
 		Data:       json.RawMessage(data),
 		Error:      nil,
-		Priority:   1,
+		Priority:   priority,
 		CreatedAt:  &now,
 		FinishedAt: nil,
 	}
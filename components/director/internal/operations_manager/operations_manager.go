@@ -0,0 +1,98 @@
+package operationsmanager
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+)
+
+type operationsManager struct {
+	opType      string
+	maxAttempts int
+	inOrder     bool
+	transact    persistence.Transactioner
+	opSvc       OperationService
+}
+
+// NewOperationsManager creates an OperationsManager responsible for the lifecycle of operations of type `opType` on the consumer side.
+// Operations which were claimed `maxAttempts` times without being finished are failed instead of being claimed again.
+func NewOperationsManager(transact persistence.Transactioner, opSvc OperationService, opType string, maxAttempts int) *operationsManager {
+	return &operationsManager{
+		opType:      opType,
+		maxAttempts: maxAttempts,
+		transact:    transact,
+		opSvc:       opSvc,
+	}
+}
+
+// NewSequentialOperationsManager creates an OperationsManager which hands out the operations of type `opType` one at a time and in the order of their creation.
+// The next operation is claimed only after the previous one is finished, regardless of the number of consumers.
+func NewSequentialOperationsManager(transact persistence.Transactioner, opSvc OperationService, opType string, maxAttempts int) *operationsManager {
+	om := NewOperationsManager(transact, opSvc, opType, maxAttempts)
+	om.inOrder = true
+	return om
+}
+
+// GetOperation claims the next scheduled operation and moves it to IN_PROGRESS status.
+// If there are no operations ready to be claimed a NotFound error is returned.
+func (om *operationsManager) GetOperation(ctx context.Context) (*model.Operation, error) {
+	tx, err := om.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer om.transact.RollbackUnlessCommitted(ctx, tx)
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	claimFn := om.opSvc.ClaimNextScheduled
+	if om.inOrder {
+		claimFn = om.opSvc.ClaimNextInOrder
+	}
+
+	op, err := claimFn(ctx, om.opType, om.maxAttempts)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return op, nil
+}
+
+// Heartbeat extends the lease of the claimed IN_PROGRESS operation
+func (om *operationsManager) Heartbeat(ctx context.Context, op *model.Operation) error {
+	return om.inTx(ctx, func(ctx context.Context) error {
+		return om.opSvc.Heartbeat(ctx, op.ID, op.ClaimToken)
+	})
+}
+
+// MarkOperationCompleted moves the claimed operation to COMPLETED status
+func (om *operationsManager) MarkOperationCompleted(ctx context.Context, op *model.Operation) error {
+	return om.inTx(ctx, func(ctx context.Context) error {
+		return om.opSvc.MarkAsCompleted(ctx, op.ID, op.ClaimToken)
+	})
+}
+
+// MarkOperationFailed moves the claimed operation to FAILED status with the provided error message
+func (om *operationsManager) MarkOperationFailed(ctx context.Context, op *model.Operation, errorMsg string) error {
+	return om.inTx(ctx, func(ctx context.Context) error {
+		return om.opSvc.MarkAsFailed(ctx, op.ID, op.ClaimToken, errorMsg)
+	})
+}
+
+func (om *operationsManager) inTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	tx, err := om.transact.Begin()
+	if err != nil {
+		return err
+	}
+	defer om.transact.RollbackUnlessCommitted(ctx, tx)
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	if err := fn(ctx); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
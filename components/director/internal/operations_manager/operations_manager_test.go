@@ -0,0 +1,262 @@
+package operationsmanager_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	operationsmanager "github.com/kyma-incubator/compass/components/director/internal/operations_manager"
+	"github.com/kyma-incubator/compass/components/director/internal/operations_manager/automock"
+	persistenceautomock "github.com/kyma-incubator/compass/components/director/pkg/persistence/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	operationID = "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa"
+	claimToken  = "bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb"
+	maxAttempts = 5
+)
+
+func TestOperationsManager_GetOperation(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("Test error")
+	ctx := context.TODO()
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+	op := &model.Operation{
+		ID:     operationID,
+		OpType: ordOpType,
+		Status: model.OperationStatusInProgress,
+	}
+
+	testCases := []struct {
+		Name            string
+		TransactionerFn func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		OpSvcFn         func() *automock.OperationService
+		ExpectedOp      *model.Operation
+		ExpectedErr     error
+	}{
+		{
+			Name: "Success",
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceeds()
+			},
+			OpSvcFn: func() *automock.OperationService {
+				opSvc := &automock.OperationService{}
+				opSvc.On("ClaimNextScheduled", txtest.CtxWithDBMatcher(), ordOpType, maxAttempts).Return(op, nil).Once()
+				return opSvc
+			},
+			ExpectedOp: op,
+		},
+		{
+			Name: "Error while claiming next scheduled operation",
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatDoesntExpectCommit()
+			},
+			OpSvcFn: func() *automock.OperationService {
+				opSvc := &automock.OperationService{}
+				opSvc.On("ClaimNextScheduled", txtest.CtxWithDBMatcher(), ordOpType, maxAttempts).Return(nil, testErr).Once()
+				return opSvc
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name: "Error while beginning transaction",
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatFailsOnBegin()
+			},
+			OpSvcFn: func() *automock.OperationService {
+				return &automock.OperationService{}
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name: "Error while committing transaction",
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatFailsOnCommit()
+			},
+			OpSvcFn: func() *automock.OperationService {
+				opSvc := &automock.OperationService{}
+				opSvc.On("ClaimNextScheduled", txtest.CtxWithDBMatcher(), ordOpType, maxAttempts).Return(op, nil).Once()
+				return opSvc
+			},
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			persist, tx := testCase.TransactionerFn()
+			opSvc := testCase.OpSvcFn()
+
+			opManager := operationsmanager.NewOperationsManager(tx, opSvc, ordOpType, maxAttempts)
+
+			// WHEN
+			result, err := opManager.GetOperation(ctx)
+
+			// THEN
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+				assert.Nil(t, result)
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, testCase.ExpectedOp, result)
+			}
+
+			mock.AssertExpectationsForObjects(t, persist, tx, opSvc)
+		})
+	}
+}
+
+func TestSequentialOperationsManager_GetOperation(t *testing.T) {
+	// GIVEN
+	ctx := context.TODO()
+	op := &model.Operation{
+		ID:     operationID,
+		OpType: ordOpType,
+		Status: model.OperationStatusInProgress,
+	}
+
+	persist, tx := txtest.NewTransactionContextGenerator(nil).ThatSucceeds()
+	opSvc := &automock.OperationService{}
+	opSvc.On("ClaimNextInOrder", txtest.CtxWithDBMatcher(), ordOpType, maxAttempts).Return(op, nil).Once()
+
+	opManager := operationsmanager.NewSequentialOperationsManager(tx, opSvc, ordOpType, maxAttempts)
+
+	// WHEN
+	result, err := opManager.GetOperation(ctx)
+
+	// THEN
+	require.NoError(t, err)
+	assert.Equal(t, op, result)
+	mock.AssertExpectationsForObjects(t, persist, tx, opSvc)
+}
+
+func TestOperationsManager_UpdateOperation(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("Test error")
+	errorMsg := "processing failed"
+	ctx := context.TODO()
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+	op := &model.Operation{
+		ID:         operationID,
+		OpType:     ordOpType,
+		Status:     model.OperationStatusInProgress,
+		ClaimToken: claimToken,
+	}
+
+	testCases := []struct {
+		Name            string
+		SvcMethod       string
+		SvcArgs         []interface{}
+		ManagerFn       func(opManager operationsmanager.OperationsManager) error
+		TransactionerFn func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		SvcErr          error
+		ExpectedErr     error
+	}{
+		{
+			Name:      "Success for heartbeat",
+			SvcMethod: "Heartbeat",
+			SvcArgs:   []interface{}{txtest.CtxWithDBMatcher(), operationID, claimToken},
+			ManagerFn: func(opManager operationsmanager.OperationsManager) error {
+				return opManager.Heartbeat(ctx, op)
+			},
+			TransactionerFn: txGen.ThatSucceeds,
+		},
+		{
+			Name:      "Error while heartbeating",
+			SvcMethod: "Heartbeat",
+			SvcArgs:   []interface{}{txtest.CtxWithDBMatcher(), operationID, claimToken},
+			ManagerFn: func(opManager operationsmanager.OperationsManager) error {
+				return opManager.Heartbeat(ctx, op)
+			},
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			SvcErr:          testErr,
+			ExpectedErr:     testErr,
+		},
+		{
+			Name:      "Success for marking operation as completed",
+			SvcMethod: "MarkAsCompleted",
+			SvcArgs:   []interface{}{txtest.CtxWithDBMatcher(), operationID, claimToken},
+			ManagerFn: func(opManager operationsmanager.OperationsManager) error {
+				return opManager.MarkOperationCompleted(ctx, op)
+			},
+			TransactionerFn: txGen.ThatSucceeds,
+		},
+		{
+			Name:      "Error while committing transaction when marking operation as completed",
+			SvcMethod: "MarkAsCompleted",
+			SvcArgs:   []interface{}{txtest.CtxWithDBMatcher(), operationID, claimToken},
+			ManagerFn: func(opManager operationsmanager.OperationsManager) error {
+				return opManager.MarkOperationCompleted(ctx, op)
+			},
+			TransactionerFn: txGen.ThatFailsOnCommit,
+			ExpectedErr:     testErr,
+		},
+		{
+			Name:      "Success for marking operation as failed",
+			SvcMethod: "MarkAsFailed",
+			SvcArgs:   []interface{}{txtest.CtxWithDBMatcher(), operationID, claimToken, errorMsg},
+			ManagerFn: func(opManager operationsmanager.OperationsManager) error {
+				return opManager.MarkOperationFailed(ctx, op, errorMsg)
+			},
+			TransactionerFn: txGen.ThatSucceeds,
+		},
+		{
+			Name:      "Error while marking operation as failed",
+			SvcMethod: "MarkAsFailed",
+			SvcArgs:   []interface{}{txtest.CtxWithDBMatcher(), operationID, claimToken, errorMsg},
+			ManagerFn: func(opManager operationsmanager.OperationsManager) error {
+				return opManager.MarkOperationFailed(ctx, op, errorMsg)
+			},
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			SvcErr:          testErr,
+			ExpectedErr:     testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			persist, tx := testCase.TransactionerFn()
+			opSvc := &automock.OperationService{}
+			opSvc.On(testCase.SvcMethod, testCase.SvcArgs...).Return(testCase.SvcErr).Once()
+
+			opManager := operationsmanager.NewOperationsManager(tx, opSvc, ordOpType, maxAttempts)
+
+			// WHEN
+			err := testCase.ManagerFn(opManager)
+
+			// THEN
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				assert.Nil(t, err)
+			}
+
+			mock.AssertExpectationsForObjects(t, persist, tx, opSvc)
+		})
+	}
+
+	t.Run("Error while beginning transaction", func(t *testing.T) {
+		// GIVEN
+		persist, tx := txGen.ThatFailsOnBegin()
+		opSvc := &automock.OperationService{}
+
+		opManager := operationsmanager.NewOperationsManager(tx, opSvc, ordOpType, maxAttempts)
+
+		// WHEN
+		err := opManager.Heartbeat(ctx, op)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), testErr.Error())
+		mock.AssertExpectationsForObjects(t, persist, tx, opSvc)
+	})
+}
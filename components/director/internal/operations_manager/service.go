@@ -2,6 +2,7 @@ package operationsmanager
 
 import (
 	"context"
+	"time"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
 	"github.com/pkg/errors"
@@ -56,3 +57,20 @@ func (s *Service) DeleteOldOperations(ctx context.Context, opType string, comple
 
 	return tx.Commit()
 }
+
+// RescheduleHangedOperations moves all operations of type `opType` which are IN_PROGRESS,
+// but were not updated by their consumer during the last `hangPeriod`, back to SCHEDULED status.
+func (s *Service) RescheduleHangedOperations(ctx context.Context, opType string, hangPeriod time.Duration) error {
+	tx, err := s.transact.Begin()
+	if err != nil {
+		return err
+	}
+	defer s.transact.RollbackUnlessCommitted(ctx, tx)
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	if err := s.opSvc.RescheduleHangedOperations(ctx, opType, hangPeriod); err != nil {
+		return errors.Wrap(err, "while rescheduling hanged operations")
+	}
+
+	return tx.Commit()
+}
@@ -3,6 +3,7 @@ package operationsmanager_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	operationsmanager "github.com/kyma-incubator/compass/components/director/internal/operations_manager"
@@ -156,3 +157,86 @@ func TestService_DeleteOldOperations(t *testing.T) {
 		})
 	}
 }
+
+func TestService_RescheduleHangedOperations(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("Test error")
+	hangPeriod := 15 * time.Minute
+	ctx := context.TODO()
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+	testCases := []struct {
+		Name            string
+		TransactionerFn func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		OpSvcFn         func() *automock.OperationService
+		ExpectedErr     error
+	}{
+		{
+			Name: "Success",
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceeds()
+			},
+			OpSvcFn: func() *automock.OperationService {
+				opSvc := &automock.OperationService{}
+				opSvc.On("RescheduleHangedOperations", txtest.CtxWithDBMatcher(), ordOpType, hangPeriod).Return(nil).Once()
+				return opSvc
+			},
+		},
+		{
+			Name: "Error while rescheduling hanged operations",
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatDoesntExpectCommit()
+			},
+			OpSvcFn: func() *automock.OperationService {
+				opSvc := &automock.OperationService{}
+				opSvc.On("RescheduleHangedOperations", txtest.CtxWithDBMatcher(), ordOpType, hangPeriod).Return(testErr).Once()
+				return opSvc
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name: "Error while beginning transaction",
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatFailsOnBegin()
+			},
+			OpSvcFn: func() *automock.OperationService {
+				return &automock.OperationService{}
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name: "Error while committing transaction",
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatFailsOnCommit()
+			},
+			OpSvcFn: func() *automock.OperationService {
+				opSvc := &automock.OperationService{}
+				opSvc.On("RescheduleHangedOperations", txtest.CtxWithDBMatcher(), ordOpType, hangPeriod).Return(nil).Once()
+				return opSvc
+			},
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			persist, tx := testCase.TransactionerFn()
+			opSvc := testCase.OpSvcFn()
+
+			svc := operationsmanager.NewOperationService(tx, opSvc, nil)
+
+			// WHEN
+			err := svc.RescheduleHangedOperations(ctx, ordOpType, hangPeriod)
+
+			// THEN
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				assert.Nil(t, err)
+			}
+
+			mock.AssertExpectationsForObjects(t, persist, tx, opSvc)
+		})
+	}
+}
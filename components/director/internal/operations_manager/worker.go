@@ -0,0 +1,137 @@
+package operationsmanager
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+)
+
+// OperationsManager is responsible for claiming operations and reporting their outcome on the consumer side.
+//
+//go:generate mockery --name=OperationsManager --output=automock --outpkg=automock --case=underscore --disable-version-string
+type OperationsManager interface {
+	GetOperation(ctx context.Context) (*model.Operation, error)
+	Heartbeat(ctx context.Context, op *model.Operation) error
+	MarkOperationCompleted(ctx context.Context, op *model.Operation) error
+	MarkOperationFailed(ctx context.Context, op *model.Operation, errorMsg string) error
+}
+
+// OperationProcessor is responsible for the actual execution of a claimed operation.
+//
+//go:generate mockery --name=OperationProcessor --output=automock --outpkg=automock --case=underscore --disable-version-string
+type OperationProcessor interface {
+	Process(ctx context.Context, operation *model.Operation) error
+}
+
+// WorkerConfig contains configuration for the operations workers
+type WorkerConfig struct {
+	// PollInterval is the time a worker waits before checking again for scheduled operations once the queue is drained
+	PollInterval time.Duration `envconfig:"APP_OPERATIONS_WORKER_POLL_INTERVAL,default=1m"`
+	// HeartbeatInterval is how often a worker extends the lease of the operation it processes. It should be considerably lower than the hang period of the operations manager.
+	HeartbeatInterval time.Duration `envconfig:"APP_OPERATIONS_WORKER_HEARTBEAT_INTERVAL,default=1m"`
+	// MaxAttempts is the number of times an operation is claimed before it is failed. Operations which are rescheduled after their lease expired count as attempts.
+	MaxAttempts int `envconfig:"APP_OPERATIONS_WORKER_MAX_ATTEMPTS,default=5"`
+}
+
+// Worker claims scheduled operations one by one and executes them with the configured OperationProcessor
+type Worker struct {
+	id        int
+	cfg       WorkerConfig
+	opManager OperationsManager
+	processor OperationProcessor
+}
+
+// NewWorker creates a new operations Worker
+func NewWorker(id int, cfg WorkerConfig, opManager OperationsManager, processor OperationProcessor) *Worker {
+	return &Worker{
+		id:        id,
+		cfg:       cfg,
+		opManager: opManager,
+		processor: processor,
+	}
+}
+
+// Run starts the processing loop of the worker. It blocks until the context is done.
+func (w *Worker) Run(ctx context.Context) {
+	log.C(ctx).Infof("Starting operations worker %d...", w.id)
+	for {
+		select {
+		case <-ctx.Done():
+			log.C(ctx).Infof("Context is done. Stopping operations worker %d...", w.id)
+			return
+		default:
+		}
+
+		op, err := w.opManager.GetOperation(ctx)
+		if err != nil {
+			if apperrors.IsNotFoundError(err) {
+				log.C(ctx).Debugf("Operations worker %d found no scheduled operations. Waiting %s before next attempt...", w.id, w.cfg.PollInterval)
+			} else {
+				log.C(ctx).WithError(err).Errorf("Operations worker %d failed to get scheduled operation: %v", w.id, err)
+			}
+
+			select {
+			case <-ctx.Done():
+			case <-time.After(w.cfg.PollInterval):
+			}
+			continue
+		}
+
+		w.ProcessOperation(ctx, op)
+	}
+}
+
+// ProcessOperation executes a claimed operation while periodically extending its lease and stores the result of the execution
+func (w *Worker) ProcessOperation(ctx context.Context, op *model.Operation) {
+	entry := log.C(ctx).WithField(log.FieldRequestID, uuid.New().String()).WithField("operation_id", op.ID)
+	ctx = log.ContextWithLogger(ctx, entry)
+
+	log.C(ctx).Infof("Operations worker %d started processing operation with id %s and type %s", w.id, op.ID, op.OpType)
+
+	heartbeatCtx, stopHeartbeat := context.WithCancel(ctx)
+	heartbeatDone := make(chan struct{})
+	go func() {
+		defer close(heartbeatDone)
+		w.heartbeat(heartbeatCtx, op)
+	}()
+
+	processErr := w.processor.Process(ctx, op)
+
+	stopHeartbeat()
+	<-heartbeatDone
+
+	if processErr != nil {
+		log.C(ctx).WithError(processErr).Errorf("Operations worker %d failed to process operation with id %s: %v", w.id, op.ID, processErr)
+		if err := w.opManager.MarkOperationFailed(ctx, op, processErr.Error()); err != nil {
+			log.C(ctx).WithError(err).Errorf("Failed to mark operation with id %s as failed: %v", op.ID, err)
+		}
+		return
+	}
+
+	if err := w.opManager.MarkOperationCompleted(ctx, op); err != nil {
+		log.C(ctx).WithError(err).Errorf("Failed to mark operation with id %s as completed: %v", op.ID, err)
+		return
+	}
+
+	log.C(ctx).Infof("Operations worker %d successfully processed operation with id %s", w.id, op.ID)
+}
+
+func (w *Worker) heartbeat(ctx context.Context, op *model.Operation) {
+	ticker := time.NewTicker(w.cfg.HeartbeatInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := w.opManager.Heartbeat(ctx, op); err != nil {
+				log.C(ctx).WithError(err).Errorf("Failed to update heartbeat of operation with id %s: %v", op.ID, err)
+			}
+		}
+	}
+}
@@ -0,0 +1,121 @@
+package operationsmanager_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	operationsmanager "github.com/kyma-incubator/compass/components/director/internal/operations_manager"
+	"github.com/kyma-incubator/compass/components/director/internal/operations_manager/automock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestWorker_ProcessOperation(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("Test error")
+	ctx := context.TODO()
+	cfg := operationsmanager.WorkerConfig{
+		PollInterval:      time.Minute,
+		HeartbeatInterval: time.Minute,
+	}
+	op := &model.Operation{
+		ID:     operationID,
+		OpType: ordOpType,
+		Status: model.OperationStatusInProgress,
+	}
+
+	testCases := []struct {
+		Name        string
+		OpManagerFn func() *automock.OperationsManager
+		ProcessorFn func() *automock.OperationProcessor
+	}{
+		{
+			Name: "Success",
+			OpManagerFn: func() *automock.OperationsManager {
+				opManager := &automock.OperationsManager{}
+				opManager.On("MarkOperationCompleted", mock.Anything, op).Return(nil).Once()
+				return opManager
+			},
+			ProcessorFn: func() *automock.OperationProcessor {
+				processor := &automock.OperationProcessor{}
+				processor.On("Process", mock.Anything, op).Return(nil).Once()
+				return processor
+			},
+		},
+		{
+			Name: "Operation is marked as failed when processing fails",
+			OpManagerFn: func() *automock.OperationsManager {
+				opManager := &automock.OperationsManager{}
+				opManager.On("MarkOperationFailed", mock.Anything, op, testErr.Error()).Return(nil).Once()
+				return opManager
+			},
+			ProcessorFn: func() *automock.OperationProcessor {
+				processor := &automock.OperationProcessor{}
+				processor.On("Process", mock.Anything, op).Return(testErr).Once()
+				return processor
+			},
+		},
+		{
+			Name: "Error while marking operation as completed is not propagated",
+			OpManagerFn: func() *automock.OperationsManager {
+				opManager := &automock.OperationsManager{}
+				opManager.On("MarkOperationCompleted", mock.Anything, op).Return(testErr).Once()
+				return opManager
+			},
+			ProcessorFn: func() *automock.OperationProcessor {
+				processor := &automock.OperationProcessor{}
+				processor.On("Process", mock.Anything, op).Return(nil).Once()
+				return processor
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			opManager := testCase.OpManagerFn()
+			processor := testCase.ProcessorFn()
+
+			worker := operationsmanager.NewWorker(1, cfg, opManager, processor)
+
+			// WHEN
+			worker.ProcessOperation(ctx, op)
+
+			// THEN
+			mock.AssertExpectationsForObjects(t, opManager, processor)
+		})
+	}
+
+	t.Run("Heartbeat is sent while the operation is processed", func(t *testing.T) {
+		// GIVEN
+		heartbeatCfg := operationsmanager.WorkerConfig{
+			PollInterval:      time.Minute,
+			HeartbeatInterval: 10 * time.Millisecond,
+		}
+
+		heartbeatSent := make(chan struct{})
+		opManager := &automock.OperationsManager{}
+		opManager.On("Heartbeat", mock.Anything, op).Return(nil).Run(func(args mock.Arguments) {
+			select {
+			case heartbeatSent <- struct{}{}:
+			default:
+			}
+		})
+		opManager.On("MarkOperationCompleted", mock.Anything, op).Return(nil).Once()
+
+		processor := &automock.OperationProcessor{}
+		processor.On("Process", mock.Anything, op).Return(nil).Run(func(args mock.Arguments) {
+			<-heartbeatSent
+		}).Once()
+
+		worker := operationsmanager.NewWorker(1, heartbeatCfg, opManager, processor)
+
+		// WHEN
+		worker.ProcessOperation(ctx, op)
+
+		// THEN
+		mock.AssertExpectationsForObjects(t, opManager, processor)
+	})
+}
@@ -23,6 +23,7 @@ type SingleGetter interface {
 // SingleGetterGlobal is an interface for getting global entities.
 type SingleGetterGlobal interface {
 	GetGlobal(ctx context.Context, conditions Conditions, orderByParams OrderByParams, dest interface{}) error
+	GetGlobalForUpdate(ctx context.Context, conditions Conditions, orderByParams OrderByParams, dest interface{}) error
 }
 
 type universalSingleGetter struct {
@@ -79,7 +80,7 @@ func (g *universalSingleGetter) getWithTenantIsolation(ctx context.Context, reso
 
 	if g.tenantColumn != nil {
 		conditions = append(Conditions{NewEqualCondition(*g.tenantColumn, tenant)}, conditions...)
-		return g.get(ctx, resourceType, conditions, orderByParams, dest, NoLock)
+		return g.get(ctx, resourceType, conditions, orderByParams, dest, lockClause)
 	}
 
 	tenantIsolation, err := NewTenantIsolationCondition(resourceType, tenant, false)
@@ -97,6 +98,11 @@ func (g *universalSingleGetter) GetGlobal(ctx context.Context, conditions Condit
 	return g.get(ctx, g.resourceType, conditions, orderByParams, dest, NoLock)
 }
 
+// GetGlobalForUpdate gets global entities without tenant isolation and locks them explicitly until the transaction is finished.
+func (g *universalSingleGetter) GetGlobalForUpdate(ctx context.Context, conditions Conditions, orderByParams OrderByParams, dest interface{}) error {
+	return g.get(ctx, g.resourceType, conditions, orderByParams, dest, ForUpdateLock)
+}
+
 func (g *universalSingleGetter) get(ctx context.Context, resourceType resource.Type, conditions Conditions, orderByParams OrderByParams, dest interface{}, lockClause string) error {
 	if dest == nil {
 		return apperrors.NewInternalError("item cannot be nil")
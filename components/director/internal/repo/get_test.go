@@ -272,6 +272,45 @@ func TestGetSingleForUpdate(t *testing.T) {
 		assert.Equal(t, appName, dest.Name)
 		assert.Equal(t, appDescription, dest.Description)
 	})
+
+	t.Run("success with embedded tenant", func(t *testing.T) {
+		// GIVEN
+		givenID := "id"
+		sut := repo.NewSingleGetterWithEmbeddedTenant(userTableName, "tenant_id", []string{"id", "tenant_id", "first_name", "last_name", "age"})
+		expectedQuery := regexp.QuoteMeta("SELECT id, tenant_id, first_name, last_name, age FROM users WHERE tenant_id = $1 AND id = $2 FOR UPDATE")
+		db, mock := testdb.MockDatabase(t)
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		defer mock.AssertExpectations(t)
+		rows := sqlmock.NewRows([]string{"id", "first_name", "last_name", "age"}).AddRow(givenID, "givenFirstName", "givenLastName", 18)
+		mock.ExpectQuery(expectedQuery).WithArgs(tenantID, givenID).WillReturnRows(rows)
+		dest := User{}
+		// WHEN
+		err := sut.GetForUpdate(ctx, UserType, tenantID, repo.Conditions{repo.NewEqualCondition("id", givenID)}, repo.NoOrderBy, &dest)
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, givenID, dest.ID)
+	})
+}
+
+func TestGetSingleGlobalForUpdate(t *testing.T) {
+	givenID := "id"
+	sut := repo.NewSingleGetterGlobal(UserType, "users", []string{"id", "tenant_id", "first_name", "last_name", "age"})
+
+	t.Run("success", func(t *testing.T) {
+		// GIVEN
+		expectedQuery := regexp.QuoteMeta("SELECT id, tenant_id, first_name, last_name, age FROM users WHERE id = $1 FOR UPDATE")
+		db, mock := testdb.MockDatabase(t)
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		defer mock.AssertExpectations(t)
+		rows := sqlmock.NewRows([]string{"id", "first_name", "last_name", "age"}).AddRow(givenID, "givenFirstName", "givenLastName", 18)
+		mock.ExpectQuery(expectedQuery).WithArgs(givenID).WillReturnRows(rows)
+		dest := User{}
+		// WHEN
+		err := sut.GetGlobalForUpdate(ctx, repo.Conditions{repo.NewEqualCondition("id", givenID)}, repo.NoOrderBy, &dest)
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, givenID, dest.ID)
+	})
 }
 
 func TestGetSingleWithEmbeddedTenant(t *testing.T) {
@@ -60,6 +60,16 @@ func NewListerWithEmbeddedTenant(tableName string, tenantColumn string, selected
 	}
 }
 
+// NewListerWithEmbeddedTenantAndOrderBy is a constructor for Lister about entities with tenant embedded in them with additional order by clause.
+func NewListerWithEmbeddedTenantAndOrderBy(tableName string, tenantColumn string, selectedColumns []string, orderByParams OrderByParams) Lister {
+	return &universalLister{
+		tableName:       tableName,
+		selectedColumns: strings.Join(selectedColumns, ", "),
+		tenantColumn:    &tenantColumn,
+		orderByParams:   orderByParams,
+	}
+}
+
 // NewLister is a constructor for Lister about entities with externally managed tenant accesses (m2m table or view)
 func NewLister(tableName string, selectedColumns []string) Lister {
 	return &universalLister{
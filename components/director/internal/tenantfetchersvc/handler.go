@@ -11,6 +11,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 
 	"github.com/gorilla/mux"
+	"github.com/kyma-incubator/compass/components/director/pkg/encryption"
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
 	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
 	"github.com/tidwall/gjson"
@@ -51,7 +52,8 @@ type HandlerConfig struct {
 	OmitDependenciesCallbackParam              string `envconfig:"APP_TENANT_FETCHER_OMIT_PARAM_NAME"`
 	OmitDependenciesCallbackParamValue         string `envconfig:"APP_TENANT_FETCHER_OMIT_PARAM_VALUE"`
 
-	Database persistence.DatabaseConfig
+	Database              persistence.DatabaseConfig
+	CredentialsEncryption encryption.Config
 
 	DirectorGraphQLEndpoint     string        `envconfig:"APP_DIRECTOR_GRAPHQL_ENDPOINT"`
 	ClientTimeout               time.Duration `envconfig:"default=60s"`
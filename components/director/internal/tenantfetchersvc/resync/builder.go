@@ -27,6 +27,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/domain/webhook"
 	"github.com/kyma-incubator/compass/components/director/internal/features"
 	"github.com/kyma-incubator/compass/components/director/internal/uid"
+	"github.com/kyma-incubator/compass/components/director/pkg/encryption"
 	graphqlclient "github.com/kyma-incubator/compass/components/director/pkg/graphql_client"
 	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
 	tenantpkg "github.com/kyma-incubator/compass/components/director/pkg/tenant"
@@ -39,16 +40,18 @@ type synchronizerBuilder struct {
 	transact                 persistence.Transactioner
 	directorClient           *graphqlclient.Director
 	aggregationFailurePusher AggregationFailurePusher
+	credentialsEncryptor     encryption.Encryptor
 }
 
 // NewSynchronizerBuilder returns an entity that will use the provided configuration to create a tenant synchronizer.
-func NewSynchronizerBuilder(jobConfig JobConfig, featuresConfig features.Config, transact persistence.Transactioner, directorClient *graphqlclient.Director, aggregationFailurePusher AggregationFailurePusher) *synchronizerBuilder {
+func NewSynchronizerBuilder(jobConfig JobConfig, featuresConfig features.Config, transact persistence.Transactioner, directorClient *graphqlclient.Director, aggregationFailurePusher AggregationFailurePusher, credentialsEncryptor encryption.Encryptor) *synchronizerBuilder {
 	return &synchronizerBuilder{
 		jobConfig:                jobConfig,
 		featuresConfig:           featuresConfig,
 		transact:                 transact,
 		directorClient:           directorClient,
 		aggregationFailurePusher: aggregationFailurePusher,
+		credentialsEncryptor:     credentialsEncryptor,
 	}
 }
 
@@ -118,14 +121,14 @@ func (b *synchronizerBuilder) domainServices(featuresConfig features.Config) (Te
 	tenantStorageConverter := tenant.NewConverter()
 	labelConverter := label.NewConverter()
 	authConverter := auth.NewConverter()
-	webhookConverter := webhook.NewConverter(authConverter)
-	frConverter := fetchrequest.NewConverter(authConverter)
+	webhookConverter := webhook.NewConverter(authConverter, b.credentialsEncryptor)
+	frConverter := fetchrequest.NewConverter(authConverter, b.credentialsEncryptor)
 	versionConverter := version.NewConverter()
 	specConverter := spec.NewConverter(frConverter)
 	docConverter := document.NewConverter(frConverter)
 	apiConverter := api.NewConverter(versionConverter, specConverter)
 	eventAPIConverter := eventdef.NewConverter(versionConverter, specConverter)
-	bundleConverter := bundleutil.NewConverter(authConverter, apiConverter, eventAPIConverter, docConverter)
+	bundleConverter := bundleutil.NewConverter(authConverter, apiConverter, eventAPIConverter, docConverter, b.credentialsEncryptor)
 	appConverter := application.NewConverter(webhookConverter, bundleConverter)
 	runtimeConverter := runtime.NewConverter(webhookConverter)
 	scenarioAssignConverter := scenarioassignment.NewConverter()
@@ -162,7 +165,7 @@ func (b *synchronizerBuilder) domainServices(featuresConfig features.Config) (Te
 	constraintEngine := operators.NewConstraintEngine(b.transact, formationConstraintSvc, tenantSvc, scenarioAssignmentSvc, nil, nil, formationRepo, labelRepo, labelSvc, applicationRepo, runtimeContextRepo, formationTemplateRepo, formationAssignmentRepo, featuresConfig.RuntimeTypeLabelKey, featuresConfig.ApplicationTypeLabelKey)
 	notificationsBuilder := formation.NewNotificationsBuilder(webhookConverter, constraintEngine, featuresConfig.RuntimeTypeLabelKey, featuresConfig.ApplicationTypeLabelKey)
 	faNotificationSvc := formationassignment.NewFormationAssignmentNotificationService(formationAssignmentRepo, webhookConverter, webhookRepo, tenantRepo, nil, formationRepo, notificationsBuilder, runtimeContextRepo, labelSvc, featuresConfig.RuntimeTypeLabelKey, featuresConfig.ApplicationTypeLabelKey)
-	formationAssignmentStatusSvc := formationassignment.NewFormationAssignmentStatusService(formationAssignmentRepo, constraintEngine, faNotificationSvc)
+	formationAssignmentStatusSvc := formationassignment.NewFormationAssignmentStatusService(formationAssignmentRepo, uidSvc, constraintEngine, faNotificationSvc)
 	formationAssignmentSvc := formationassignment.NewService(formationAssignmentRepo, uidSvc, applicationRepo, runtimeRepo, runtimeContextRepo, nil, faNotificationSvc, labelSvc, formationRepo, formationAssignmentStatusSvc, featuresConfig.RuntimeTypeLabelKey, featuresConfig.ApplicationTypeLabelKey)
 	formationSvc := formation.NewService(b.transact, applicationRepo, labelDefRepo, labelRepo, formationRepo, formationTemplateRepo, labelSvc, uidSvc, labelDefSvc, scenarioAssignmentRepo, scenarioAssignmentSvc, tenantSvc, runtimeRepo, runtimeContextRepo, formationAssignmentSvc, faNotificationSvc, nil, constraintEngine, webhookRepo, nil, featuresConfig.RuntimeTypeLabelKey, featuresConfig.ApplicationTypeLabelKey)
 	runtimeContextSvc := runtimectx.NewService(runtimeContextRepo, labelRepo, runtimeRepo, labelSvc, formationSvc, tenantSvc, uidSvc)
@@ -79,5 +79,13 @@ func (a Type) isSupported() bool {
 // Executor defines an interface for execution of different access strategies
 //go:generate mockery --name=Executor --output=automock --outpkg=automock --case=underscore --disable-version-string
 type Executor interface {
-	Execute(ctx context.Context, client *http.Client, url, tnt string) (*http.Response, error)
+	Execute(ctx context.Context, client *http.Client, url, tnt string, additionalHeaders http.Header) (*http.Response, error)
+}
+
+func addHeaders(req *http.Request, headers http.Header) {
+	for key, values := range headers {
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
 }
@@ -14,13 +14,13 @@ type Executor struct {
 	mock.Mock
 }
 
-// Execute provides a mock function with given fields: ctx, client, url, tnt
-func (_m *Executor) Execute(ctx context.Context, client *http.Client, url string, tnt string) (*http.Response, error) {
-	ret := _m.Called(ctx, client, url, tnt)
+// Execute provides a mock function with given fields: ctx, client, url, tnt, additionalHeaders
+func (_m *Executor) Execute(ctx context.Context, client *http.Client, url string, tnt string, additionalHeaders http.Header) (*http.Response, error) {
+	ret := _m.Called(ctx, client, url, tnt, additionalHeaders)
 
 	var r0 *http.Response
-	if rf, ok := ret.Get(0).(func(context.Context, *http.Client, string, string) *http.Response); ok {
-		r0 = rf(ctx, client, url, tnt)
+	if rf, ok := ret.Get(0).(func(context.Context, *http.Client, string, string, http.Header) *http.Response); ok {
+		r0 = rf(ctx, client, url, tnt, additionalHeaders)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*http.Response)
@@ -28,8 +28,8 @@ func (_m *Executor) Execute(ctx context.Context, client *http.Client, url string
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *http.Client, string, string) error); ok {
-		r1 = rf(ctx, client, url, tnt)
+	if rf, ok := ret.Get(1).(func(context.Context, *http.Client, string, string, http.Header) error); ok {
+		r1 = rf(ctx, client, url, tnt, additionalHeaders)
 	} else {
 		r1 = ret.Error(1)
 	}
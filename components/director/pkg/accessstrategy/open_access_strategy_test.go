@@ -23,6 +23,7 @@ func TestOpenAccessStrategy(t *testing.T) {
 	client := newTestClient(func(req *http.Request) (*http.Response, error) {
 		require.Equal(t, req.Method, http.MethodGet)
 		require.Equal(t, req.URL.String(), testURL)
+		require.Equal(t, "etag", req.Header.Get("If-None-Match"))
 		return expectedResp, nil
 	})
 
@@ -31,7 +32,7 @@ func TestOpenAccessStrategy(t *testing.T) {
 	executor, err := provider.Provide(accessstrategy.OpenAccessStrategy)
 	require.NoError(t, err)
 
-	resp, err := executor.Execute(context.TODO(), client, testURL, "")
+	resp, err := executor.Execute(context.TODO(), client, testURL, "", http.Header{"If-None-Match": []string{"etag"}})
 	require.NoError(t, err)
 	require.Equal(t, expectedResp, resp)
 }
@@ -43,6 +43,8 @@ const (
 	ConcurrentUpdate ErrorType = 35
 	// EmptyParentID is the error code for EmptyParentID errors.
 	EmptyParentID ErrorType = 36
+	// QueryLimitExceeded is the error code for QueryLimitExceeded errors.
+	QueryLimitExceeded ErrorType = 37
 	// BadRequest is the error code for BadRequest errors.
 	BadRequest ErrorType = 400
 	// Conflict is the error code for Conflict errors.
@@ -54,6 +56,8 @@ const (
 	NotFoundMsg = "Object not found"
 	// NotFoundMsgF is the error message format for NotFound errors.
 	NotFoundMsgF = "Object not found: %s"
+	// QueryLimitExceededMsg is the error message for QueryLimitExceeded errors.
+	QueryLimitExceededMsg = "Query limit exceeded"
 	// InvalidDataMsg is the error message for InvalidData errors.
 	InvalidDataMsg = "Invalid data"
 	// InternalServerErrMsgF is the error message format for InternalServer errors.
@@ -153,6 +153,15 @@ func NewInvalidDataError(msg string, args ...interface{}) error {
 	}
 }
 
+// NewQueryLimitExceededError returns an error for GraphQL queries exceeding the configured cost or depth limits
+func NewQueryLimitExceededError(msg string, args ...interface{}) error {
+	return Error{
+		errorCode: QueryLimitExceeded,
+		Message:   QueryLimitExceededMsg,
+		arguments: map[string]string{"reason": fmt.Sprintf(msg, args...)},
+	}
+}
+
 // NewInvalidDataErrorWithFields missing godoc
 func NewInvalidDataErrorWithFields(fields map[string]error, objType string) error {
 	if len(fields) == 0 {
@@ -26,20 +26,22 @@ func _() {
 	_ = x[InvalidStatusCondition-33]
 	_ = x[CannotUpdateObjectInManyBundles-34]
 	_ = x[ConcurrentUpdate-35]
+	_ = x[EmptyParentID-36]
+	_ = x[QueryLimitExceeded-37]
 	_ = x[BadRequest-400]
 	_ = x[Conflict-409]
 }
 
 const (
 	_ErrorType_name_0 = "InternalErrorUnknownError"
-	_ErrorType_name_1 = "NotFoundNotUniqueInvalidDataInsufficientScopesTenantRequiredTenantNotFoundUnauthorizedInvalidOperationOperationTimeoutEmptyDataInconsistentDataNotUniqueNameConcurrentOperationInvalidStatusConditionCannotUpdateObjectInManyBundlesConcurrentUpdate"
+	_ErrorType_name_1 = "NotFoundNotUniqueInvalidDataInsufficientScopesTenantRequiredTenantNotFoundUnauthorizedInvalidOperationOperationTimeoutEmptyDataInconsistentDataNotUniqueNameConcurrentOperationInvalidStatusConditionCannotUpdateObjectInManyBundlesConcurrentUpdateEmptyParentIDQueryLimitExceeded"
 	_ErrorType_name_2 = "BadRequest"
 	_ErrorType_name_3 = "Conflict"
 )
 
 var (
 	_ErrorType_index_0 = [...]uint8{0, 13, 25}
-	_ErrorType_index_1 = [...]uint8{0, 8, 17, 28, 46, 60, 74, 86, 102, 118, 127, 143, 156, 175, 197, 228, 244}
+	_ErrorType_index_1 = [...]uint16{0, 8, 17, 28, 46, 60, 74, 86, 102, 118, 127, 143, 156, 175, 197, 228, 244, 257, 275}
 )
 
 func (i ErrorType) String() string {
@@ -47,7 +49,7 @@ func (i ErrorType) String() string {
 	case 10 <= i && i <= 11:
 		i -= 10
 		return _ErrorType_name_0[_ErrorType_index_0[i]:_ErrorType_index_0[i+1]]
-	case 20 <= i && i <= 35:
+	case 20 <= i && i <= 37:
 		i -= 20
 		return _ErrorType_name_1[_ErrorType_index_1[i]:_ErrorType_index_1[i+1]]
 	case i == 400:
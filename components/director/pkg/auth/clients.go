@@ -22,7 +22,7 @@ func PrepareMTLSClientWithSSLValidation(timeout time.Duration, cache certloader.
 		return cache.Get()[secretName], nil
 	}
 	roundTripper := httputil.NewHTTPTransportWrapper(basicTransport)
-	httpTransport := httputil.NewCorrelationIDTransport(roundTripper)
+	httpTransport := httputil.NewTracingTransport(httputil.NewCorrelationIDTransport(roundTripper))
 
 	return &http.Client{
 		Timeout:   timeout,
@@ -59,7 +59,7 @@ func PrepareHTTPClientWithSSLValidation(timeout time.Duration, skipSSLValidation
 	basicProvider := NewBasicAuthorizationProvider()
 	tokenProvider := NewTokenAuthorizationProvider(unsecuredClient)
 
-	securedTransport := httputil.NewSecuredTransport(httputil.NewCorrelationIDTransport(roundTripper), basicProvider, tokenProvider)
+	securedTransport := httputil.NewSecuredTransport(httputil.NewTracingTransport(httputil.NewCorrelationIDTransport(roundTripper)), basicProvider, tokenProvider)
 	securedClient := &http.Client{
 		Timeout:   timeout,
 		Transport: securedTransport,
@@ -0,0 +1,8 @@
+package encryption
+
+// Config contains the configuration of the credentials encryption
+type Config struct {
+	Enabled bool `envconfig:"default=false,APP_CREDENTIALS_ENCRYPTION_ENABLED"`
+	// KeysFilePath is the path to the file holding the versioned key encryption keys. It is required if the encryption is enabled.
+	KeysFilePath string `envconfig:"optional,APP_CREDENTIALS_ENCRYPTION_KEYS_FILE_PATH"`
+}
@@ -0,0 +1,22 @@
+package encryption
+
+import "github.com/pkg/errors"
+
+// NewCredentialsEncryptor creates the Encryptor used for the credentials persisted by the current process according to the provided configuration.
+// If the encryption is disabled, credentials are stored unencrypted.
+func NewCredentialsEncryptor(cfg Config) (Encryptor, error) {
+	if !cfg.Enabled {
+		return NewNoopEncryptor(), nil
+	}
+
+	if cfg.KeysFilePath == "" {
+		return nil, errors.New("keys file path is required when credentials encryption is enabled")
+	}
+
+	keyProvider, err := NewFileKeyProvider(cfg.KeysFilePath)
+	if err != nil {
+		return nil, errors.Wrap(err, "while creating file key provider")
+	}
+
+	return NewEnvelopeEncryptor(keyProvider), nil
+}
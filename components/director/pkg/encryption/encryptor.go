@@ -0,0 +1,150 @@
+package encryption
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	envelopePrefix    = "enc:v1:"
+	envelopeSeparator = ":"
+)
+
+// Encryptor encrypts and decrypts single credential values
+//
+//go:generate mockery --name=Encryptor --output=automock --outpkg=automock --case=underscore --disable-version-string
+type Encryptor interface {
+	Encrypt(value string) (string, error)
+	Decrypt(value string) (string, error)
+	NeedsReEncryption(value string) bool
+}
+
+type envelopeEncryptor struct {
+	keyProvider KeyProvider
+}
+
+// NewEnvelopeEncryptor creates an Encryptor which encrypts every value with a new random data encryption key and stores the data encryption key,
+// wrapped with the active key encryption key of the KeyProvider, together with the ciphertext. The encrypted values have the format
+// "enc:v1:<key version>:<base64 wrapped key>:<base64 ciphertext>".
+func NewEnvelopeEncryptor(keyProvider KeyProvider) Encryptor {
+	return &envelopeEncryptor{
+		keyProvider: keyProvider,
+	}
+}
+
+// Encrypt encrypts the provided value. Empty and already encrypted values are returned unchanged.
+func (e *envelopeEncryptor) Encrypt(value string) (string, error) {
+	if value == "" || IsEncrypted(value) {
+		return value, nil
+	}
+
+	dek := make([]byte, keySize)
+	if _, err := rand.Read(dek); err != nil {
+		return "", errors.Wrap(err, "while generating data encryption key")
+	}
+
+	ciphertext, err := seal(dek, []byte(value))
+	if err != nil {
+		return "", errors.Wrap(err, "while encrypting value")
+	}
+
+	keyVersion := e.keyProvider.ActiveKeyVersion()
+	wrappedKey, err := e.keyProvider.WrapKey(keyVersion, dek)
+	if err != nil {
+		return "", errors.Wrapf(err, "while wrapping data encryption key with key version %q", keyVersion)
+	}
+
+	return envelopePrefix + strings.Join([]string{keyVersion, base64.StdEncoding.EncodeToString(wrappedKey), base64.StdEncoding.EncodeToString(ciphertext)}, envelopeSeparator), nil
+}
+
+// Decrypt decrypts the provided value. Values which are not encrypted are returned unchanged, so that credentials stored before the encryption was enabled remain readable.
+func (e *envelopeEncryptor) Decrypt(value string) (string, error) {
+	if !IsEncrypted(value) {
+		return value, nil
+	}
+
+	keyVersion, wrappedKey, ciphertext, err := parseEnvelope(value)
+	if err != nil {
+		return "", err
+	}
+
+	dek, err := e.keyProvider.UnwrapKey(keyVersion, wrappedKey)
+	if err != nil {
+		return "", errors.Wrapf(err, "while unwrapping data encryption key with key version %q", keyVersion)
+	}
+
+	plaintext, err := open(dek, ciphertext)
+	if err != nil {
+		return "", errors.Wrap(err, "while decrypting value")
+	}
+
+	return string(plaintext), nil
+}
+
+// NeedsReEncryption returns true if the provided non-empty value is either not encrypted or encrypted with a key version different from the active one
+func (e *envelopeEncryptor) NeedsReEncryption(value string) bool {
+	if value == "" {
+		return false
+	}
+
+	if !IsEncrypted(value) {
+		return true
+	}
+
+	keyVersion, _, _, err := parseEnvelope(value)
+	return err != nil || keyVersion != e.keyProvider.ActiveKeyVersion()
+}
+
+type noopEncryptor struct{}
+
+// NewNoopEncryptor creates an Encryptor which is used when the credentials encryption is disabled.
+// It stores the values as they are and fails on decryption of encrypted values instead of returning the ciphertext as a credential.
+func NewNoopEncryptor() Encryptor {
+	return &noopEncryptor{}
+}
+
+// Encrypt returns the provided value unchanged
+func (e *noopEncryptor) Encrypt(value string) (string, error) {
+	return value, nil
+}
+
+// Decrypt returns the provided value unchanged if it is not encrypted
+func (e *noopEncryptor) Decrypt(value string) (string, error) {
+	if IsEncrypted(value) {
+		return "", errors.New("value is encrypted, but credentials encryption is not enabled")
+	}
+
+	return value, nil
+}
+
+// NeedsReEncryption always returns false as there is no key to encrypt with
+func (e *noopEncryptor) NeedsReEncryption(_ string) bool {
+	return false
+}
+
+// IsEncrypted checks whether the provided value is in the format produced by the envelope Encryptor
+func IsEncrypted(value string) bool {
+	return strings.HasPrefix(value, envelopePrefix)
+}
+
+func parseEnvelope(value string) (string, []byte, []byte, error) {
+	parts := strings.Split(strings.TrimPrefix(value, envelopePrefix), envelopeSeparator)
+	if len(parts) != 3 {
+		return "", nil, nil, errors.New("invalid encrypted value format")
+	}
+
+	wrappedKey, err := base64.StdEncoding.DecodeString(parts[1])
+	if err != nil {
+		return "", nil, nil, errors.Wrap(err, "while decoding wrapped data encryption key")
+	}
+
+	ciphertext, err := base64.StdEncoding.DecodeString(parts[2])
+	if err != nil {
+		return "", nil, nil, errors.Wrap(err, "while decoding ciphertext")
+	}
+
+	return parts[0], wrappedKey, ciphertext, nil
+}
@@ -0,0 +1,185 @@
+package encryption_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/encryption"
+	"github.com/kyma-incubator/compass/components/director/pkg/encryption/automock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnvelopeEncryptor(t *testing.T) {
+	// GIVEN
+	keyProvider, err := encryption.NewFileKeyProvider(fixKeysFile(t, activeKeyVersion, fixKeys()))
+	require.NoError(t, err)
+	encryptor := encryption.NewEnvelopeEncryptor(keyProvider)
+
+	oldKeyProvider, err := encryption.NewFileKeyProvider(fixKeysFile(t, oldKeyVersion, fixKeys()))
+	require.NoError(t, err)
+	encryptedWithOldKey, err := encryption.NewEnvelopeEncryptor(oldKeyProvider).Encrypt(plaintext)
+	require.NoError(t, err)
+
+	t.Run("Success encrypting and decrypting value", func(t *testing.T) {
+		// WHEN
+		encrypted, err := encryptor.Encrypt(plaintext)
+		require.NoError(t, err)
+		decrypted, err := encryptor.Decrypt(encrypted)
+
+		// THEN
+		require.NoError(t, err)
+		assert.True(t, encryption.IsEncrypted(encrypted))
+		assert.True(t, strings.HasPrefix(encrypted, "enc:v1:2:"))
+		assert.NotContains(t, encrypted, plaintext)
+		assert.Equal(t, plaintext, decrypted)
+		assert.False(t, encryptor.NeedsReEncryption(encrypted))
+	})
+
+	t.Run("Success encrypting the same value differently every time", func(t *testing.T) {
+		// WHEN
+		first, err := encryptor.Encrypt(plaintext)
+		require.NoError(t, err)
+		second, err := encryptor.Encrypt(plaintext)
+		require.NoError(t, err)
+
+		// THEN
+		assert.NotEqual(t, first, second)
+	})
+
+	t.Run("Success does not encrypt empty and already encrypted values", func(t *testing.T) {
+		// WHEN
+		empty, err := encryptor.Encrypt("")
+		require.NoError(t, err)
+		encrypted, err := encryptor.Encrypt(encryptedWithOldKey)
+		require.NoError(t, err)
+
+		// THEN
+		assert.Empty(t, empty)
+		assert.Equal(t, encryptedWithOldKey, encrypted)
+	})
+
+	t.Run("Success decrypting value encrypted with old key version", func(t *testing.T) {
+		// WHEN
+		decrypted, err := encryptor.Decrypt(encryptedWithOldKey)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, plaintext, decrypted)
+		assert.True(t, encryptor.NeedsReEncryption(encryptedWithOldKey))
+	})
+
+	t.Run("Success decrypting not encrypted value", func(t *testing.T) {
+		// WHEN
+		decrypted, err := encryptor.Decrypt(plaintext)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, plaintext, decrypted)
+		assert.True(t, encryptor.NeedsReEncryption(plaintext))
+		assert.False(t, encryptor.NeedsReEncryption(""))
+	})
+
+	t.Run("Error when encrypted value has invalid format", func(t *testing.T) {
+		// WHEN
+		_, err := encryptor.Decrypt("enc:v1:2:invalid")
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid encrypted value format")
+	})
+
+	t.Run("Error when encrypted value is tampered", func(t *testing.T) {
+		// GIVEN
+		encrypted, err := encryptor.Encrypt(plaintext)
+		require.NoError(t, err)
+		parts := strings.Split(encrypted, ":")
+		otherEncrypted, err := encryptor.Encrypt("other")
+		require.NoError(t, err)
+		parts[4] = strings.Split(otherEncrypted, ":")[4]
+
+		// WHEN
+		_, err = encryptor.Decrypt(strings.Join(parts, ":"))
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while decrypting value")
+	})
+
+	t.Run("Error when wrapping key fails", func(t *testing.T) {
+		// GIVEN
+		keyProviderMock := &automock.KeyProvider{}
+		keyProviderMock.On("ActiveKeyVersion").Return(activeKeyVersion).Once()
+		keyProviderMock.On("WrapKey", activeKeyVersion, mock.Anything).Return(nil, errors.New("test error")).Once()
+		defer keyProviderMock.AssertExpectations(t)
+
+		// WHEN
+		_, err := encryption.NewEnvelopeEncryptor(keyProviderMock).Encrypt(plaintext)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while wrapping data encryption key with key version \"2\"")
+	})
+}
+
+func TestNoopEncryptor(t *testing.T) {
+	// GIVEN
+	encryptor := encryption.NewNoopEncryptor()
+
+	t.Run("Success returning values unchanged", func(t *testing.T) {
+		// WHEN
+		encrypted, err := encryptor.Encrypt(plaintext)
+		require.NoError(t, err)
+		decrypted, err := encryptor.Decrypt(encrypted)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, plaintext, encrypted)
+		assert.Equal(t, plaintext, decrypted)
+		assert.False(t, encryptor.NeedsReEncryption(plaintext))
+	})
+
+	t.Run("Error when decrypting encrypted value", func(t *testing.T) {
+		// WHEN
+		_, err := encryptor.Decrypt("enc:v1:2:a:b")
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "credentials encryption is not enabled")
+	})
+}
+
+func TestNewCredentialsEncryptor(t *testing.T) {
+	t.Run("Success creating envelope encryptor", func(t *testing.T) {
+		// WHEN
+		encryptor, err := encryption.NewCredentialsEncryptor(encryption.Config{Enabled: true, KeysFilePath: fixKeysFile(t, activeKeyVersion, fixKeys())})
+
+		// THEN
+		require.NoError(t, err)
+		encrypted, err := encryptor.Encrypt(plaintext)
+		require.NoError(t, err)
+		assert.True(t, encryption.IsEncrypted(encrypted))
+	})
+
+	t.Run("Success creating noop encryptor when encryption is disabled", func(t *testing.T) {
+		// WHEN
+		encryptor, err := encryption.NewCredentialsEncryptor(encryption.Config{Enabled: false})
+
+		// THEN
+		require.NoError(t, err)
+		encrypted, err := encryptor.Encrypt(plaintext)
+		require.NoError(t, err)
+		assert.Equal(t, plaintext, encrypted)
+	})
+
+	t.Run("Error when keys file path is missing", func(t *testing.T) {
+		// WHEN
+		_, err := encryption.NewCredentialsEncryptor(encryption.Config{Enabled: true})
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "keys file path is required")
+	})
+}
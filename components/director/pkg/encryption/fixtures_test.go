@@ -0,0 +1,43 @@
+package encryption_test
+
+import (
+	"encoding/base64"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	oldKeyVersion    = "1"
+	activeKeyVersion = "2"
+	plaintext        = "secret"
+)
+
+var (
+	oldKey    = strings.Repeat("a", 32)
+	activeKey = strings.Repeat("b", 32)
+)
+
+func fixKeysFile(t *testing.T, activeVersion string, keys map[string]string) string {
+	encodedKeys := make([]string, 0, len(keys))
+	for version, key := range keys {
+		encodedKeys = append(encodedKeys, fmt.Sprintf("%q: %q", version, base64.StdEncoding.EncodeToString([]byte(key))))
+	}
+
+	path := filepath.Join(t.TempDir(), "keys.json")
+	content := fmt.Sprintf(`{"activeKeyVersion": %q, "keys": {%s}}`, activeVersion, strings.Join(encodedKeys, ", "))
+	require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+
+	return path
+}
+
+func fixKeys() map[string]string {
+	return map[string]string{
+		oldKeyVersion:    oldKey,
+		activeKeyVersion: activeKey,
+	}
+}
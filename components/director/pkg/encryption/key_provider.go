@@ -0,0 +1,159 @@
+package encryption
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const keySize = 32
+
+// KeyProvider wraps and unwraps data encryption keys with versioned key encryption keys.
+// It follows the KMS envelope encryption model, so that implementations backed by an external key management system never have to expose the key encryption keys.
+//
+//go:generate mockery --name=KeyProvider --output=automock --outpkg=automock --case=underscore --disable-version-string
+type KeyProvider interface {
+	ActiveKeyVersion() string
+	WrapKey(keyVersion string, key []byte) ([]byte, error)
+	UnwrapKey(keyVersion string, wrappedKey []byte) ([]byte, error)
+}
+
+type keysFile struct {
+	ActiveKeyVersion string            `json:"activeKeyVersion"`
+	Keys             map[string]string `json:"keys"`
+}
+
+type fileKeyProvider struct {
+	activeKeyVersion string
+	keys             map[string][]byte
+}
+
+// NewFileKeyProvider creates a KeyProvider holding the key encryption keys from the provided file. The file contains the base64 encoded 256-bit keys by their version
+// and the version of the key which is used for wrapping new data encryption keys. Old key versions have to be kept in the file until all credentials are re-encrypted with the active one.
+func NewFileKeyProvider(path string) (KeyProvider, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while reading keys file %q", path)
+	}
+
+	var file keysFile
+	if err := json.Unmarshal(content, &file); err != nil {
+		return nil, errors.Wrapf(err, "while unmarshalling keys file %q", path)
+	}
+
+	keys := make(map[string][]byte, len(file.Keys))
+	for version, encodedKey := range file.Keys {
+		if version == "" || strings.Contains(version, envelopeSeparator) {
+			return nil, errors.Errorf("invalid key version %q", version)
+		}
+
+		key, err := base64.StdEncoding.DecodeString(encodedKey)
+		if err != nil {
+			return nil, errors.Wrapf(err, "while decoding key with version %q", version)
+		}
+
+		if len(key) != keySize {
+			return nil, errors.Errorf("key with version %q must be %d bytes long, but it is %d", version, keySize, len(key))
+		}
+
+		keys[version] = key
+	}
+
+	if _, ok := keys[file.ActiveKeyVersion]; !ok {
+		return nil, errors.Errorf("active key version %q is not present in keys file %q", file.ActiveKeyVersion, path)
+	}
+
+	return &fileKeyProvider{
+		activeKeyVersion: file.ActiveKeyVersion,
+		keys:             keys,
+	}, nil
+}
+
+// ActiveKeyVersion returns the version of the key which should be used for wrapping new data encryption keys
+func (p *fileKeyProvider) ActiveKeyVersion() string {
+	return p.activeKeyVersion
+}
+
+// WrapKey encrypts the provided data encryption key with the key encryption key with the provided version
+func (p *fileKeyProvider) WrapKey(keyVersion string, key []byte) ([]byte, error) {
+	kek, err := p.key(keyVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	return seal(kek, key)
+}
+
+// UnwrapKey decrypts the provided data encryption key with the key encryption key with the provided version
+func (p *fileKeyProvider) UnwrapKey(keyVersion string, wrappedKey []byte) ([]byte, error) {
+	kek, err := p.key(keyVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	return open(kek, wrappedKey)
+}
+
+func (p *fileKeyProvider) key(keyVersion string) ([]byte, error) {
+	key, ok := p.keys[keyVersion]
+	if !ok {
+		return nil, errors.Errorf("key with version %q is not found", keyVersion)
+	}
+
+	return key, nil
+}
+
+// seal encrypts the plaintext with AES-GCM and prepends the random nonce to the ciphertext
+func seal(key, plaintext []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, errors.Wrap(err, "while generating nonce")
+	}
+
+	return gcm.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+// open decrypts a ciphertext produced by seal
+func open(key, ciphertext []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(ciphertext) < gcm.NonceSize() {
+		return nil, errors.New("ciphertext is too short")
+	}
+
+	nonce, sealed := ciphertext[:gcm.NonceSize()], ciphertext[gcm.NonceSize():]
+	plaintext, err := gcm.Open(nil, nonce, sealed, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "while decrypting ciphertext")
+	}
+
+	return plaintext, nil
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, errors.Wrap(err, "while creating cipher")
+	}
+
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, errors.Wrap(err, "while creating GCM")
+	}
+
+	return gcm, nil
+}
@@ -0,0 +1,123 @@
+package encryption_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/encryption"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewFileKeyProvider(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		PathFn        func(t *testing.T) string
+		ExpectedErr   string
+		ExpectedKeyID string
+	}{
+		{
+			Name: "Success",
+			PathFn: func(t *testing.T) string {
+				return fixKeysFile(t, activeKeyVersion, fixKeys())
+			},
+			ExpectedKeyID: activeKeyVersion,
+		},
+		{
+			Name: "Error when file does not exist",
+			PathFn: func(t *testing.T) string {
+				return filepath.Join(t.TempDir(), "missing.json")
+			},
+			ExpectedErr: "while reading keys file",
+		},
+		{
+			Name: "Error when file is not a valid JSON",
+			PathFn: func(t *testing.T) string {
+				path := filepath.Join(t.TempDir(), "keys.json")
+				require.NoError(t, os.WriteFile(path, []byte("invalid"), 0600))
+				return path
+			},
+			ExpectedErr: "while unmarshalling keys file",
+		},
+		{
+			Name: "Error when key has invalid length",
+			PathFn: func(t *testing.T) string {
+				return fixKeysFile(t, activeKeyVersion, map[string]string{activeKeyVersion: "short"})
+			},
+			ExpectedErr: "must be 32 bytes long",
+		},
+		{
+			Name: "Error when key version contains separator",
+			PathFn: func(t *testing.T) string {
+				return fixKeysFile(t, "a:b", map[string]string{"a:b": activeKey})
+			},
+			ExpectedErr: "invalid key version",
+		},
+		{
+			Name: "Error when active key is missing",
+			PathFn: func(t *testing.T) string {
+				return fixKeysFile(t, "3", fixKeys())
+			},
+			ExpectedErr: "active key version \"3\" is not present",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// WHEN
+			keyProvider, err := encryption.NewFileKeyProvider(testCase.PathFn(t))
+
+			// THEN
+			if testCase.ExpectedErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr)
+				assert.Nil(t, keyProvider)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedKeyID, keyProvider.ActiveKeyVersion())
+			}
+		})
+	}
+}
+
+func TestFileKeyProvider_WrapKey(t *testing.T) {
+	// GIVEN
+	keyProvider, err := encryption.NewFileKeyProvider(fixKeysFile(t, activeKeyVersion, fixKeys()))
+	require.NoError(t, err)
+	dek := []byte("data-encryption-key")
+
+	t.Run("Success wrapping and unwrapping key", func(t *testing.T) {
+		// WHEN
+		wrappedKey, err := keyProvider.WrapKey(oldKeyVersion, dek)
+		require.NoError(t, err)
+		unwrappedKey, err := keyProvider.UnwrapKey(oldKeyVersion, wrappedKey)
+
+		// THEN
+		require.NoError(t, err)
+		assert.NotEqual(t, dek, wrappedKey)
+		assert.Equal(t, dek, unwrappedKey)
+	})
+
+	t.Run("Error when unwrapping with different key version", func(t *testing.T) {
+		// GIVEN
+		wrappedKey, err := keyProvider.WrapKey(oldKeyVersion, dek)
+		require.NoError(t, err)
+
+		// WHEN
+		_, err = keyProvider.UnwrapKey(activeKeyVersion, wrappedKey)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while decrypting ciphertext")
+	})
+
+	t.Run("Error when key version is unknown", func(t *testing.T) {
+		// WHEN
+		_, err := keyProvider.WrapKey("unknown", dek)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "key with version \"unknown\" is not found")
+	})
+}
@@ -45,3 +45,39 @@ type DestinationCreatorInput struct {
 	JoinPointDetailsReverseFAMemoryAddress uintptr                  `json:"details_reverse_formation_assignment_memory_address"` // contains the memory address of the join point details' reverse formation assignment in form of an integer
 	Location                               JoinPointLocation        `json:"join_point_location"`
 }
+
+// ContainsAtMostNResourcesOfSubtypeInput input for ContainsAtMostNResourcesOfSubtype operator
+type ContainsAtMostNResourcesOfSubtypeInput struct {
+	FormationName   string             `json:"formation_name"`
+	ResourceType    model.ResourceType `json:"resource_type"`
+	ResourceSubtype string             `json:"resource_subtype"`
+	ResourceID      string             `json:"resource_id"`
+	Tenant          string             `json:"tenant"`
+	MaxCount        int                `json:"max_count"`
+}
+
+// HasLabelWithValueInput input for HasLabelWithValue operator
+type HasLabelWithValueInput struct {
+	ResourceType model.ResourceType `json:"resource_type"`
+	ResourceID   string             `json:"resource_id"`
+	Tenant       string             `json:"tenant"`
+	LabelKey     string             `json:"label_key"`
+	LabelValue   string             `json:"label_value"`
+}
+
+// HaveSameLabelValueInput input for HaveSameLabelValue operator
+type HaveSameLabelValueInput struct {
+	ResourceType       model.ResourceType `json:"resource_type"`
+	ResourceID         string             `json:"resource_id"`
+	SourceResourceType model.ResourceType `json:"source_resource_type"`
+	SourceResourceID   string             `json:"source_resource_id"`
+	Tenant             string             `json:"tenant"`
+	LabelKey           string             `json:"label_key"`
+}
+
+// IsTenantOfTypeInput input for IsTenantOfType operator
+type IsTenantOfTypeInput struct {
+	ResourceType model.ResourceType `json:"resource_type"`
+	ResourceID   string             `json:"resource_id"`
+	TenantTypes  []string           `json:"tenant_types"`
+}
@@ -3,6 +3,7 @@ package graphql
 import (
 	"encoding/json"
 	"fmt"
+	"regexp"
 	"strings"
 
 	"github.com/go-ozzo/ozzo-validation/v4/is"
@@ -46,9 +47,64 @@ func (i PlaceholderDefinitionInput) Validate() error {
 		validation.Field(&i.Name, validation.Required, inputvalidation.DNSName),
 		validation.Field(&i.Description, validation.RuneLength(0, descriptionStringLengthLimit)),
 		validation.Field(&i.JSONPath, validation.RuneLength(0, jsonPathStringLengthLimit)),
+		validation.Field(&i.Type, validation.In(PlaceholderTypeString, PlaceholderTypeNumber, PlaceholderTypeBoolean, PlaceholderTypeEnum, PlaceholderTypeURL)),
+		validation.Field(&i.EnumValues, validation.When(i.Type != nil && *i.Type == PlaceholderTypeEnum, validation.Required).Else(validation.Empty)),
+		validation.Field(&i.Pattern, validation.By(validRegexRuleFunc)),
+		validation.Field(&i.MinLength, validation.Min(0)),
+		validation.Field(&i.MaxLength, validation.Min(0), validation.When(i.MinLength != nil, validation.By(i.ensureMaxLengthNotLessThanMinLength))),
+		validation.Field(&i.DefaultValue, validation.RuneLength(0, longStringLengthLimit), validation.By(i.ensureDefaultValueMatchesConstraints)),
 	)
 }
 
+func (i PlaceholderDefinitionInput) ensureMaxLengthNotLessThanMinLength(value interface{}) error {
+	maxLength, ok := value.(*int)
+	if !ok || maxLength == nil {
+		return nil
+	}
+
+	if *maxLength < *i.MinLength {
+		return errors.Errorf("must be greater than or equal to minLength %d", *i.MinLength)
+	}
+	return nil
+}
+
+func (i PlaceholderDefinitionInput) ensureDefaultValueMatchesConstraints(value interface{}) error {
+	defaultValue, ok := value.(*string)
+	if !ok || defaultValue == nil {
+		return nil
+	}
+
+	if i.Pattern != nil {
+		// an invalid pattern is reported by the pattern field validation
+		if _, err := regexp.Compile(*i.Pattern); err != nil {
+			return nil
+		}
+	}
+
+	constraints := inputvalidation.PlaceholderConstraints{
+		EnumValues: i.EnumValues,
+		Pattern:    i.Pattern,
+		MinLength:  i.MinLength,
+		MaxLength:  i.MaxLength,
+	}
+	if i.Type != nil {
+		constraints.Type = i.Type.String()
+	}
+	return inputvalidation.ValidatePlaceholderValue(constraints, *defaultValue)
+}
+
+func validRegexRuleFunc(value interface{}) error {
+	pattern, ok := value.(*string)
+	if !ok || pattern == nil {
+		return nil
+	}
+
+	if _, err := regexp.Compile(*pattern); err != nil {
+		return errors.Wrap(err, "must be a valid regular expression")
+	}
+	return nil
+}
+
 // Validate missing godoc
 func (i ApplicationFromTemplateInput) Validate() error {
 	return validation.Errors{
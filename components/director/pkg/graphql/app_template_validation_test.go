@@ -754,6 +754,169 @@ func TestPlaceholderDefinitionInput_Validate_Description(t *testing.T) {
 	}
 }
 
+func TestPlaceholderDefinitionInput_Validate_TypeConstraints(t *testing.T) {
+	enumType := graphql.PlaceholderTypeEnum
+	numberType := graphql.PlaceholderTypeNumber
+	invalidType := graphql.PlaceholderType("DATE")
+	zero := 0
+	five := 5
+	negative := -1
+
+	testCases := []struct {
+		Name  string
+		Value graphql.PlaceholderDefinitionInput
+		Valid bool
+	}{
+		{
+			Name:  "Valid - Enum with values",
+			Value: graphql.PlaceholderDefinitionInput{Name: "valid", Type: &enumType, EnumValues: []string{"a", "b"}},
+			Valid: true,
+		},
+		{
+			Name:  "Valid - Length constraints and pattern",
+			Value: graphql.PlaceholderDefinitionInput{Name: "valid", Type: &numberType, MinLength: &zero, MaxLength: &five, Pattern: str.Ptr("^[0-9]+$")},
+			Valid: true,
+		},
+		{
+			Name:  "Invalid - Unknown type",
+			Value: graphql.PlaceholderDefinitionInput{Name: "valid", Type: &invalidType},
+			Valid: false,
+		},
+		{
+			Name:  "Invalid - Enum without values",
+			Value: graphql.PlaceholderDefinitionInput{Name: "valid", Type: &enumType},
+			Valid: false,
+		},
+		{
+			Name:  "Invalid - Enum values for non enum type",
+			Value: graphql.PlaceholderDefinitionInput{Name: "valid", Type: &numberType, EnumValues: []string{"1"}},
+			Valid: false,
+		},
+		{
+			Name:  "Invalid - Pattern is not a regular expression",
+			Value: graphql.PlaceholderDefinitionInput{Name: "valid", Pattern: str.Ptr("[a-z")},
+			Valid: false,
+		},
+		{
+			Name:  "Invalid - Negative min length",
+			Value: graphql.PlaceholderDefinitionInput{Name: "valid", MinLength: &negative},
+			Valid: false,
+		},
+		{
+			Name:  "Invalid - Max length less than min length",
+			Value: graphql.PlaceholderDefinitionInput{Name: "valid", MinLength: &five, MaxLength: &zero},
+			Valid: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// WHEN
+			err := testCase.Value.Validate()
+			// THEN
+			if testCase.Valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestPlaceholderDefinitionInput_Validate_DefaultValue(t *testing.T) {
+	stringType := graphql.PlaceholderTypeString
+	numberType := graphql.PlaceholderTypeNumber
+	booleanType := graphql.PlaceholderTypeBoolean
+	enumType := graphql.PlaceholderTypeEnum
+	urlType := graphql.PlaceholderTypeURL
+	three := 3
+
+	testCases := []struct {
+		Name  string
+		Value graphql.PlaceholderDefinitionInput
+		Valid bool
+	}{
+		{
+			Name:  "Valid - Untyped",
+			Value: graphql.PlaceholderDefinitionInput{Name: "valid", DefaultValue: str.Ptr("any value")},
+			Valid: true,
+		},
+		{
+			Name:  "Valid - Number",
+			Value: graphql.PlaceholderDefinitionInput{Name: "valid", Type: &numberType, DefaultValue: str.Ptr("-1.5e3")},
+			Valid: true,
+		},
+		{
+			Name:  "Valid - Boolean",
+			Value: graphql.PlaceholderDefinitionInput{Name: "valid", Type: &booleanType, DefaultValue: str.Ptr("false")},
+			Valid: true,
+		},
+		{
+			Name:  "Valid - Enum value",
+			Value: graphql.PlaceholderDefinitionInput{Name: "valid", Type: &enumType, EnumValues: []string{"a", "b"}, DefaultValue: str.Ptr("b")},
+			Valid: true,
+		},
+		{
+			Name:  "Valid - URL",
+			Value: graphql.PlaceholderDefinitionInput{Name: "valid", Type: &urlType, DefaultValue: str.Ptr("https://example.com/path")},
+			Valid: true,
+		},
+		{
+			Name:  "Valid - Matches pattern and length constraints",
+			Value: graphql.PlaceholderDefinitionInput{Name: "valid", Type: &stringType, Pattern: str.Ptr("^[a-z]+$"), MinLength: &three, MaxLength: &three, DefaultValue: str.Ptr("abc")},
+			Valid: true,
+		},
+		{
+			Name:  "Invalid - Not a number",
+			Value: graphql.PlaceholderDefinitionInput{Name: "valid", Type: &numberType, DefaultValue: str.Ptr("one")},
+			Valid: false,
+		},
+		{
+			Name:  "Invalid - Not a boolean",
+			Value: graphql.PlaceholderDefinitionInput{Name: "valid", Type: &booleanType, DefaultValue: str.Ptr("yes")},
+			Valid: false,
+		},
+		{
+			Name:  "Invalid - Not an enum value",
+			Value: graphql.PlaceholderDefinitionInput{Name: "valid", Type: &enumType, EnumValues: []string{"a", "b"}, DefaultValue: str.Ptr("c")},
+			Valid: false,
+		},
+		{
+			Name:  "Invalid - Not an absolute URL",
+			Value: graphql.PlaceholderDefinitionInput{Name: "valid", Type: &urlType, DefaultValue: str.Ptr("/path")},
+			Valid: false,
+		},
+		{
+			Name:  "Invalid - Does not match pattern",
+			Value: graphql.PlaceholderDefinitionInput{Name: "valid", Pattern: str.Ptr("^[a-z]+$"), DefaultValue: str.Ptr("ABC")},
+			Valid: false,
+		},
+		{
+			Name:  "Invalid - Shorter than min length",
+			Value: graphql.PlaceholderDefinitionInput{Name: "valid", MinLength: &three, DefaultValue: str.Ptr("ab")},
+			Valid: false,
+		},
+		{
+			Name:  "Invalid - Longer than max length",
+			Value: graphql.PlaceholderDefinitionInput{Name: "valid", MaxLength: &three, DefaultValue: str.Ptr("abcd")},
+			Valid: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// WHEN
+			err := testCase.Value.Validate()
+			// THEN
+			if testCase.Valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
 func TestApplicationFromTemplateInput_Validate_Rule_EitherPlaceholdersOrPlaceholdersPayloadExists(t *testing.T) {
 	testPlaceholderName := "test"
 	testPlacehoderPayload := "{\"a\":\"b\"}"
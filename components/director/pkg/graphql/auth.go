@@ -9,6 +9,7 @@ type credential struct {
 	*BasicCredentialData
 	*OAuthCredentialData
 	*CertificateOAuthCredentialData
+	*HMACCredentialData
 }
 
 // OneTimeTokenDTO this a model for transportation of one-time tokens, because the json marshaller cannot unmarshal to either of the types OTTForApp or OTTForRuntime
@@ -80,7 +81,7 @@ func (csrf *CSRFTokenCredentialRequestAuth) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
-// retrieveCredential checks if any of the structs BasicCredentialData, OAuthCredentialData or CertificateOAuthCredentialData
+// retrieveCredential checks if any of the structs BasicCredentialData, OAuthCredentialData, CertificateOAuthCredentialData or HMACCredentialData
 // has all the data after unmarshalling. The resulting CredentialData is the one struct that has all it's fields full of data
 // This is done because CredentialData is an interface and the structs that implement it have conflicting json tag names, so
 // they could not be marshalled properly
@@ -118,6 +119,18 @@ func retrieveCredential(data []byte) (CredentialData, error) {
 		return &certOAuthCredential.CertificateOAuthCredentialData, nil
 	}
 
+	// The secondary secret is optional, so only the primary one is required for the HMAC credential
+	var hmacCredential struct {
+		HMACCredentialData `json:"credential"`
+	}
+	if err := json.Unmarshal(data, &hmacCredential); err != nil {
+		return nil, err
+	}
+
+	if hmacCredential.PrimarySecret != "" {
+		return &hmacCredential.HMACCredentialData, nil
+	}
+
 	return nil, nil
 }
 
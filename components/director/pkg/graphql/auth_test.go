@@ -91,6 +91,23 @@ func TestUnmarshalCertificateOAuth(t *testing.T) {
 	assert.Equal(t, "certificate-data", oauth.Certificate)
 }
 
+func TestUnmarshalHMAC(t *testing.T) {
+	// GIVEN
+	a := &graphql.Auth{}
+	// WHEN
+	err := a.UnmarshalJSON([]byte(`{
+  		"credential": {
+			"primarySecret": "primary-secret"
+		}
+	}`))
+	// THEN
+	require.NoError(t, err)
+	hmac, ok := a.Credential.(*graphql.HMACCredentialData)
+	require.True(t, ok)
+	assert.Equal(t, "primary-secret", hmac.PrimarySecret)
+	assert.Nil(t, hmac.SecondarySecret)
+}
+
 func TestUnmarshalCSRFBasicAuth(t *testing.T) {
 	// GIVEN
 	a := &graphql.CSRFTokenCredentialRequestAuth{}
@@ -1,11 +1,15 @@
 package graphql
 
 import (
+	"errors"
+
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 	"github.com/go-ozzo/ozzo-validation/v4/is"
 	"github.com/kyma-incubator/compass/components/director/pkg/inputvalidation"
 )
 
+const hmacSecretMinLength = 32
+
 // Validate missing godoc
 func (i AuthInput) Validate() error {
 	return validation.ValidateStruct(&i,
@@ -27,11 +31,12 @@ func (i CredentialDataInput) Validate() error {
 	return validation.Errors{
 		"Rule.ExactlyOneNotNil": inputvalidation.ValidateExactlyOneNotNil(
 			"exactly one credential input has to be specified",
-			i.Basic, i.Oauth, i.CertificateOAuth,
+			i.Basic, i.Oauth, i.CertificateOAuth, i.Hmac,
 		),
 		"Basic":            validation.Validate(i.Basic),
 		"Oauth":            validation.Validate(i.Oauth),
 		"CertificateOAuth": validation.Validate(i.CertificateOAuth),
+		"Hmac":             validation.Validate(i.Hmac),
 	}.Filter()
 }
 
@@ -61,6 +66,32 @@ func (i CertificateOAuthCredentialDataInput) Validate() error {
 	)
 }
 
+// Validate validates the signing secrets of HMACCredentialDataInput
+func (i HMACCredentialDataInput) Validate() error {
+	return validation.ValidateStruct(&i,
+		validation.Field(&i.PrimarySecret, validation.Required, validation.RuneLength(hmacSecretMinLength, 0)),
+		validation.Field(&i.SecondarySecret, validation.NilOrNotEmpty, validation.RuneLength(hmacSecretMinLength, 0)),
+	)
+}
+
+// notHMACAuthRuleFunc rejects HMAC credentials, as they are used only for signing the requests sent to webhooks
+func notHMACAuthRuleFunc(value interface{}) error {
+	var credential *CredentialDataInput
+	switch v := value.(type) {
+	case *AuthInput:
+		if v != nil {
+			credential = v.Credential
+		}
+	case *CredentialDataInput:
+		credential = v
+	}
+
+	if credential != nil && credential.Hmac != nil {
+		return errors.New("HMAC credentials are supported only for webhooks")
+	}
+	return nil
+}
+
 // Validate missing godoc
 func (i CredentialRequestAuthInput) Validate() error {
 	return validation.ValidateStruct(&i,
@@ -80,6 +111,6 @@ func (i CSRFTokenCredentialRequestAuthInput) Validate() error {
 			inputvalidation.Each(validation.Required, inputvalidation.Each(validation.Required)), // value
 		),
 		validation.Field(&i.TokenEndpointURL, validation.Required, is.URL),
-		validation.Field(&i.Credential, validation.NilOrNotEmpty),
+		validation.Field(&i.Credential, validation.NilOrNotEmpty, validation.By(notHMACAuthRuleFunc)),
 	)
 }
@@ -222,6 +222,7 @@ func TestCredentialDataInput_Validate(t *testing.T) {
 	credential := fixValidCredentialDataInput()
 	basic := fixValidBasicCredentialDataInput()
 	oauth := fixValidOAuthCredentialDataInput()
+	hmac := fixValidHMACCredentialDataInput()
 
 	testCases := []struct {
 		Name          string
@@ -263,6 +264,20 @@ func TestCredentialDataInput_Validate(t *testing.T) {
 			},
 			ExpectedValid: false,
 		},
+		{
+			Name: "ExpectedValid - HMAC",
+			Value: &graphql.CredentialDataInput{
+				Hmac: &hmac,
+			},
+			ExpectedValid: true,
+		},
+		{
+			Name: "Invalid - nested validation error in HMAC",
+			Value: &graphql.CredentialDataInput{
+				Hmac: &graphql.HMACCredentialDataInput{},
+			},
+			ExpectedValid: false,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -462,6 +477,71 @@ func TestOAuthCredentialDataInput_Validate_URL(t *testing.T) {
 	}
 }
 
+func TestHMACCredentialDataInput_Validate(t *testing.T) {
+	shortSecret := "short-secret"
+	emptySecret := inputvalidationtest.EmptyString
+	validSecondarySecret := "secondary-secret-secondary-secret-secondary"
+
+	testCases := []struct {
+		Name            string
+		PrimarySecret   string
+		SecondarySecret *string
+		ExpectedValid   bool
+	}{
+		{
+			Name:          "ExpectedValid",
+			PrimarySecret: fixValidHMACCredentialDataInput().PrimarySecret,
+			ExpectedValid: true,
+		},
+		{
+			Name:            "ExpectedValid - with secondary secret",
+			PrimarySecret:   fixValidHMACCredentialDataInput().PrimarySecret,
+			SecondarySecret: &validSecondarySecret,
+			ExpectedValid:   true,
+		},
+		{
+			Name:          "Invalid - Empty primary secret",
+			PrimarySecret: inputvalidationtest.EmptyString,
+			ExpectedValid: false,
+		},
+		{
+			Name:          "Invalid - Too short primary secret",
+			PrimarySecret: shortSecret,
+			ExpectedValid: false,
+		},
+		{
+			Name:            "Invalid - Empty secondary secret",
+			PrimarySecret:   fixValidHMACCredentialDataInput().PrimarySecret,
+			SecondarySecret: &emptySecret,
+			ExpectedValid:   false,
+		},
+		{
+			Name:            "Invalid - Too short secondary secret",
+			PrimarySecret:   fixValidHMACCredentialDataInput().PrimarySecret,
+			SecondarySecret: &shortSecret,
+			ExpectedValid:   false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			//GIVEN
+			sut := graphql.HMACCredentialDataInput{
+				PrimarySecret:   testCase.PrimarySecret,
+				SecondarySecret: testCase.SecondarySecret,
+			}
+			// WHEN
+			err := sut.Validate()
+			// THEN
+			if testCase.ExpectedValid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
 func TestCredentialRequestAuthInput_Validate(t *testing.T) {
 	csrf := fixValidCSRFTokenCredentialRequestAuthInput()
 	testCases := []struct {
@@ -510,6 +590,7 @@ func TestCredentialRequestAuthInput_Validate(t *testing.T) {
 
 func TestCSRFTokenCredentialRequestAuthInput_Validate_Credential(t *testing.T) {
 	credential := fixValidCredentialDataInput()
+	hmacCredential := fixValidHMACCredentialDataInput()
 
 	testCases := []struct {
 		Name          string
@@ -534,6 +615,11 @@ func TestCSRFTokenCredentialRequestAuthInput_Validate_Credential(t *testing.T) {
 			},
 			ExpectedValid: false,
 		},
+		{
+			Name:          "Invalid - HMAC credential",
+			Value:         &graphql.CredentialDataInput{Hmac: &hmacCredential},
+			ExpectedValid: false,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -741,6 +827,12 @@ func fixValidOAuthCredentialDataInput() graphql.OAuthCredentialDataInput {
 	}
 }
 
+func fixValidHMACCredentialDataInput() graphql.HMACCredentialDataInput {
+	return graphql.HMACCredentialDataInput{
+		PrimarySecret: "primary-secret-primary-secret-primary",
+	}
+}
+
 func fixValidCredentialRequestAuthInput() graphql.CredentialRequestAuthInput {
 	csrf := fixValidCSRFTokenCredentialRequestAuthInput()
 	return graphql.CredentialRequestAuthInput{
@@ -15,7 +15,7 @@ func (i BundleCreateInput) Validate() error {
 	return validation.ValidateStruct(&i,
 		validation.Field(&i.Name, validation.Required, is.PrintableASCII, validation.Length(1, 100)),
 		validation.Field(&i.Description, validation.RuneLength(0, descriptionStringLengthLimit)),
-		validation.Field(&i.DefaultInstanceAuth, validation.NilOrNotEmpty),
+		validation.Field(&i.DefaultInstanceAuth, validation.NilOrNotEmpty, validation.By(notHMACAuthRuleFunc)),
 		validation.Field(&i.InstanceAuthRequestInputSchema, validation.NilOrNotEmpty),
 		validation.Field(&i.APIDefinitions, inputvalidation.Each(validation.Required)),
 		validation.Field(&i.EventDefinitions, inputvalidation.Each(validation.Required)),
@@ -28,7 +28,7 @@ func (i BundleUpdateInput) Validate() error {
 	return validation.ValidateStruct(&i,
 		validation.Field(&i.Name, validation.Required, is.PrintableASCII, validation.Length(1, 100)),
 		validation.Field(&i.Description, validation.RuneLength(0, descriptionStringLengthLimit)),
-		validation.Field(&i.DefaultInstanceAuth, validation.NilOrNotEmpty),
+		validation.Field(&i.DefaultInstanceAuth, validation.NilOrNotEmpty, validation.By(notHMACAuthRuleFunc)),
 		validation.Field(&i.InstanceAuthRequestInputSchema, validation.NilOrNotEmpty),
 	)
 }
@@ -61,7 +61,7 @@ func (i BundleInstanceAuthSetInput) Validate() error {
 
 	return validation.ValidateStruct(&i,
 		validation.Field(&i.Status, validation.NilOrNotEmpty),
-		validation.Field(&i.Auth, validation.NilOrNotEmpty),
+		validation.Field(&i.Auth, validation.NilOrNotEmpty, validation.By(notHMACAuthRuleFunc)),
 	)
 }
 
@@ -90,5 +90,13 @@ func (i BundleInstanceAuthCreateInput) Validate() error {
 	return validation.ValidateStruct(&i,
 		validation.Field(&i.Context, validation.NilOrNotEmpty),
 		validation.Field(&i.InputParams, validation.NilOrNotEmpty),
+		validation.Field(&i.Auth, validation.By(notHMACAuthRuleFunc)),
+	)
+}
+
+// Validate validates BundleInstanceAuthUpdateInput
+func (i BundleInstanceAuthUpdateInput) Validate() error {
+	return validation.ValidateStruct(&i,
+		validation.Field(&i.Auth, validation.By(notHMACAuthRuleFunc)),
 	)
 }
@@ -106,6 +106,7 @@ func TestBundleCreateInput_Validate_Description(t *testing.T) {
 
 func TestBundleCreateInput_Validate_DefaultInstanceAuth(t *testing.T) {
 	validObj := fixValidAuthInput()
+	hmacCredential := fixValidHMACCredentialDataInput()
 
 	testCases := []struct {
 		Name          string
@@ -127,6 +128,11 @@ func TestBundleCreateInput_Validate_DefaultInstanceAuth(t *testing.T) {
 			Value:         &graphql.AuthInput{Credential: &graphql.CredentialDataInput{}},
 			ExpectedValid: false,
 		},
+		{
+			Name:          "Invalid - HMAC credential",
+			Value:         &graphql.AuthInput{Credential: &graphql.CredentialDataInput{Hmac: &hmacCredential}},
+			ExpectedValid: false,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -411,6 +417,7 @@ func TestBundleUpdateInput_Validate_Description(t *testing.T) {
 
 func TestBundleUpdateInput_Validate_DefaultInstanceAuth(t *testing.T) {
 	validObj := fixValidAuthInput()
+	hmacCredential := fixValidHMACCredentialDataInput()
 
 	testCases := []struct {
 		Name          string
@@ -432,6 +439,11 @@ func TestBundleUpdateInput_Validate_DefaultInstanceAuth(t *testing.T) {
 			Value:         &graphql.AuthInput{Credential: &graphql.CredentialDataInput{}},
 			ExpectedValid: false,
 		},
+		{
+			Name:          "Invalid - HMAC credential",
+			Value:         &graphql.AuthInput{Credential: &graphql.CredentialDataInput{Hmac: &hmacCredential}},
+			ExpectedValid: false,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -533,6 +545,7 @@ func TestBundleInstanceAuthRequestInput_Validate(t *testing.T) {
 func TestBundleInstanceAuthSetInput_Validate(t *testing.T) {
 	//GIVEN
 	authInput := fixValidAuthInput()
+	hmacCredential := fixValidHMACCredentialDataInput()
 	str := "foo"
 	testCases := []struct {
 		Name          string
@@ -546,6 +559,13 @@ func TestBundleInstanceAuthSetInput_Validate(t *testing.T) {
 			},
 			ExpectedValid: true,
 		},
+		{
+			Name: "HMAC credential",
+			Value: graphql.BundleInstanceAuthSetInput{
+				Auth: &graphql.AuthInput{Credential: &graphql.CredentialDataInput{Hmac: &hmacCredential}},
+			},
+			ExpectedValid: false,
+		},
 		{
 			Name: "Failed Status",
 			Value: graphql.BundleInstanceAuthSetInput{
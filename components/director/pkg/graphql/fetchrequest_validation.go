@@ -9,7 +9,7 @@ import (
 func (i FetchRequestInput) Validate() error {
 	return validation.ValidateStruct(&i,
 		validation.Field(&i.URL, validation.Required, is.URL, validation.RuneLength(1, longStringLengthLimit)),
-		validation.Field(&i.Auth, validation.NilOrNotEmpty),
+		validation.Field(&i.Auth, validation.NilOrNotEmpty, validation.By(notHMACAuthRuleFunc)),
 		validation.Field(&i.Mode, validation.NilOrNotEmpty, validation.In(FetchModeSingle, FetchModeBundle, FetchModeIndex)),
 		validation.Field(&i.Filter, validation.NilOrNotEmpty, validation.RuneLength(1, longStringLengthLimit)),
 	)
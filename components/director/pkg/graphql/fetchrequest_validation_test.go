@@ -53,6 +53,7 @@ func TestFetchRequestInput_Validate_URL(t *testing.T) {
 
 func TestFetchRequestInput_Validate_Auth(t *testing.T) {
 	validObj := fixValidAuthInput()
+	hmacCredential := fixValidHMACCredentialDataInput()
 	testCases := []struct {
 		Name          string
 		Value         *graphql.AuthInput
@@ -73,6 +74,11 @@ func TestFetchRequestInput_Validate_Auth(t *testing.T) {
 			Value:         &graphql.AuthInput{Credential: &graphql.CredentialDataInput{}},
 			ExpectedValid: false,
 		},
+		{
+			Name:          "Invalid - HMAC credential",
+			Value:         &graphql.AuthInput{Credential: &graphql.CredentialDataInput{Hmac: &hmacCredential}},
+			ExpectedValid: false,
+		},
 	}
 
 	for _, testCase := range testCases {
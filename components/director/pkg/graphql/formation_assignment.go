@@ -0,0 +1,20 @@
+package graphql
+
+// FormationAssignment represents a formation assignment
+type FormationAssignment struct {
+	ID            string                  `json:"id"`
+	Source        string                  `json:"source"`
+	SourceType    FormationAssignmentType `json:"sourceType"`
+	Target        string                  `json:"target"`
+	TargetType    FormationAssignmentType `json:"targetType"`
+	State         string                  `json:"state"`
+	Value         *string                 `json:"value"`
+	Configuration *string                 `json:"configuration"`
+	Error         *string                 `json:"error"`
+}
+
+// FormationAssignmentExt is an extended type used by external API
+type FormationAssignmentExt struct {
+	FormationAssignment
+	History []*FormationAssignmentTransition `json:"history"`
+}
@@ -23,6 +23,18 @@ const DoNotGenerateFormationAssignmentNotificationOperator = "DoNotGenerateForma
 // DestinationCreator contains the name of the DestinationCreator operator
 const DestinationCreator = "DestinationCreator"
 
+// ContainsAtMostNResourcesOfSubtype contains the name of the ContainsAtMostNResourcesOfSubtype operator
+const ContainsAtMostNResourcesOfSubtype = "ContainsAtMostNResourcesOfSubtype"
+
+// HasLabelWithValue contains the name of the HasLabelWithValue operator
+const HasLabelWithValue = "HasLabelWithValue"
+
+// HaveSameLabelValue contains the name of the HaveSameLabelValue operator
+const HaveSameLabelValue = "HaveSameLabelValue"
+
+// IsTenantOfType contains the name of the IsTenantOfType operator
+const IsTenantOfType = "IsTenantOfType"
+
 // OperatorInput represent the input needed by the operators
 type OperatorInput interface{}
 
@@ -32,6 +44,10 @@ var FormationConstraintInputByOperator = map[string]OperatorInput{
 	DoesNotContainResourceOfSubtype:                      &formationconstraint.DoesNotContainResourceOfSubtypeInput{},
 	DoNotGenerateFormationAssignmentNotificationOperator: &formationconstraint.DoNotGenerateFormationAssignmentNotificationInput{},
 	DestinationCreator:                                   &formationconstraint.DestinationCreatorInput{},
+	ContainsAtMostNResourcesOfSubtype:                    &formationconstraint.ContainsAtMostNResourcesOfSubtypeInput{},
+	HasLabelWithValue:                                    &formationconstraint.HasLabelWithValueInput{},
+	HaveSameLabelValue:                                   &formationconstraint.HaveSameLabelValueInput{},
+	IsTenantOfType:                                       &formationconstraint.IsTenantOfTypeInput{},
 }
 
 // JoinPointDetailsByLocation represents a mapping between JoinPointLocation and JoinPointDetails
@@ -175,6 +191,7 @@ func emptySendNotificationOperationDetails() *formationconstraint.SendNotificati
 							Basic:            &model.BasicCredentialData{},
 							Oauth:            &model.OAuthCredentialData{},
 							CertificateOAuth: &model.CertificateOAuthCredentialData{},
+							HMAC:             &model.HMACCredentialData{},
 						},
 					},
 				},
@@ -197,6 +197,24 @@ func (fp *GqlFieldsProvider) ForFormationAssignment() string {
 	`
 }
 
+// ForFormationAssignmentWithHistory returns the formation assignment fields along with its state transitions history
+func (fp *GqlFieldsProvider) ForFormationAssignmentWithHistory() string {
+	return fmt.Sprintf(`%s
+			history {%s}
+	`, fp.ForFormationAssignment(), fp.ForFormationAssignmentTransition())
+}
+
+// ForFormationAssignmentTransition returns the fields of a formation assignment state transition
+func (fp *GqlFieldsProvider) ForFormationAssignmentTransition() string {
+	return `
+			oldState
+			newState
+			actor
+			configDiff
+			timestamp
+	`
+}
+
 // ForFormationStatus missing godoc
 func (fp *GqlFieldsProvider) ForFormationStatus() string {
 	return fmt.Sprintf(`
@@ -487,6 +505,10 @@ func (fp *GqlFieldsProvider) ForAuth() string {
 					certificate
 					url
 				}
+				...  on HMACCredentialData {
+					primarySecret
+					secondarySecret
+				}
    				...  on OAuthCredentialData {
 					clientId
 					clientSecret
@@ -264,6 +264,14 @@ func (g *Graphqlizer) CredentialDataInputToGQL(in *graphql.CredentialDataInput)
 				url: "{{ .CertificateOAuth.URL }}",
 			},
 			{{- end }}
+			{{- if .Hmac }}
+			hmac: {
+				primarySecret: "{{ .Hmac.PrimarySecret }}",
+				{{- if .Hmac.SecondarySecret }}
+				secondarySecret: "{{ .Hmac.SecondarySecret }}",
+				{{- end }}
+			},
+			{{- end }}
 	}`)
 }
 
@@ -600,6 +608,24 @@ func (g *Graphqlizer) PlaceholderDefinitionInputToGQL(in graphql.PlaceholderDefi
 		{{- if .JSONPath }}
 		jsonPath: "{{.JSONPath}}",
 		{{- end }}
+		{{- if .Type }}
+		type: {{.Type}},
+		{{- end }}
+		{{- if .EnumValues }}
+		enumValues: [
+			{{- range $i, $e := .EnumValues }}
+				{{- if $i}}, {{- end}} "{{ $e }}"
+			{{- end }} ],
+		{{- end }}
+		{{- if .MinLength }}
+		minLength: {{.MinLength}},
+		{{- end }}
+		{{- if .MaxLength }}
+		maxLength: {{.MaxLength}},
+		{{- end }}
+		{{- if .DefaultValue }}
+		defaultValue: "{{.DefaultValue}}",
+		{{- end }}
 	}`)
 }
 
@@ -176,6 +176,12 @@ type ApplicationTemplateUpdateInput struct {
 	ApplicationNamespace *string                        `json:"applicationNamespace"`
 }
 
+type ApplicationTemplateUpgradeReport struct {
+	TemplateID string                      `json:"templateID"`
+	DryRun     bool                        `json:"dryRun"`
+	Results    []*ApplicationUpgradeResult `json:"results"`
+}
+
 type ApplicationUpdateInput struct {
 	// **Validation:** max=256
 	ProviderName *string `json:"providerName"`
@@ -190,6 +196,15 @@ type ApplicationUpdateInput struct {
 	LocalTenantID        *string                     `json:"localTenantID"`
 }
 
+type ApplicationUpgradeResult struct {
+	ApplicationID   string                   `json:"applicationID"`
+	Status          ApplicationUpgradeStatus `json:"status"`
+	ChangedFields   []string                 `json:"changedFields"`
+	ChangedLabels   []string                 `json:"changedLabels"`
+	ChangedWebhooks []WebhookType            `json:"changedWebhooks"`
+	Message         *string                  `json:"message"`
+}
+
 type Auth struct {
 	Credential                      CredentialData         `json:"credential"`
 	AccessStrategy                  *string                `json:"accessStrategy"`
@@ -376,6 +391,24 @@ type CSRFTokenCredentialRequestAuthInput struct {
 	AdditionalQueryParamsSerialized *QueryParamsSerialized `json:"additionalQueryParamsSerialized"`
 }
 
+type Capability struct {
+	ID                 string  `json:"id"`
+	OrdID              string  `json:"ordID"`
+	LocalID            *string `json:"localID"`
+	PackageID          string  `json:"packageID"`
+	Title              string  `json:"title"`
+	ShortDescription   *string `json:"shortDescription"`
+	Description        *string `json:"description"`
+	Type               string  `json:"type"`
+	CustomType         *string `json:"customType"`
+	Version            string  `json:"version"`
+	Visibility         string  `json:"visibility"`
+	ReleaseStatus      string  `json:"releaseStatus"`
+	Definitions        *JSON   `json:"definitions"`
+	RelatedEntityTypes *JSON   `json:"relatedEntityTypes"`
+	LastUpdate         *string `json:"lastUpdate"`
+}
+
 type CertificateOAuthCredentialData struct {
 	ClientID    string `json:"clientId"`
 	Certificate string `json:"certificate"`
@@ -419,11 +452,13 @@ type ConstraintReference struct {
 	FormationTemplateID string `json:"formationTemplateID"`
 }
 
-// **Validation:** basic or oauth or certificateOAuth field required
+// **Validation:** basic or oauth or certificateOAuth or hmac field required
 type CredentialDataInput struct {
 	Basic            *BasicCredentialDataInput            `json:"basic"`
 	Oauth            *OAuthCredentialDataInput            `json:"oauth"`
 	CertificateOAuth *CertificateOAuthCredentialDataInput `json:"certificateOAuth"`
+	// Signing secrets used to sign the outgoing webhook requests. Supported only in webhooks.
+	Hmac *HMACCredentialDataInput `json:"hmac"`
 }
 
 type CredentialRequestAuth struct {
@@ -457,6 +492,24 @@ type DocumentPage struct {
 
 func (DocumentPage) IsPageable() {}
 
+type EntityType struct {
+	ID                string  `json:"id"`
+	OrdID             string  `json:"ordID"`
+	LocalID           string  `json:"localID"`
+	PackageID         string  `json:"packageID"`
+	Level             string  `json:"level"`
+	Title             string  `json:"title"`
+	ShortDescription  *string `json:"shortDescription"`
+	Description       *string `json:"description"`
+	Version           string  `json:"version"`
+	Visibility        string  `json:"visibility"`
+	ReleaseStatus     string  `json:"releaseStatus"`
+	SunsetDate        *string `json:"sunsetDate"`
+	PolicyLevel       *string `json:"policyLevel"`
+	CustomPolicyLevel *string `json:"customPolicyLevel"`
+	LastUpdate        *string `json:"lastUpdate"`
+}
+
 type EventDefinitionInput struct {
 	// **Validation:** ASCII printable characters, max=100
 	Name string `json:"name"`
@@ -513,18 +566,6 @@ type FetchRequestStatus struct {
 	Timestamp Timestamp                   `json:"timestamp"`
 }
 
-type FormationAssignment struct {
-	ID            string                  `json:"id"`
-	Source        string                  `json:"source"`
-	SourceType    FormationAssignmentType `json:"sourceType"`
-	Target        string                  `json:"target"`
-	TargetType    FormationAssignmentType `json:"targetType"`
-	State         string                  `json:"state"`
-	Value         *string                 `json:"value"`
-	Configuration *string                 `json:"configuration"`
-	Error         *string                 `json:"error"`
-}
-
 type FormationAssignmentPage struct {
 	Data       []*FormationAssignment `json:"data"`
 	PageInfo   *PageInfo              `json:"pageInfo"`
@@ -533,6 +574,15 @@ type FormationAssignmentPage struct {
 
 func (FormationAssignmentPage) IsPageable() {}
 
+type FormationAssignmentTransition struct {
+	OldState string                             `json:"oldState"`
+	NewState string                             `json:"newState"`
+	Actor    FormationAssignmentTransitionActor `json:"actor"`
+	// Top-level configuration keys that were added, removed or changed by the transition, truncated if too long
+	ConfigDiff *string   `json:"configDiff"`
+	Timestamp  Timestamp `json:"timestamp"`
+}
+
 type FormationConstraint struct {
 	ID              string `json:"id"`
 	Name            string `json:"name"`
@@ -545,6 +595,16 @@ type FormationConstraint struct {
 	ConstraintScope string `json:"constraintScope"`
 }
 
+type FormationConstraintEvaluation struct {
+	Constraint *FormationConstraint `json:"constraint"`
+	Satisfied  bool                 `json:"satisfied"`
+	// Operators with side effects, such as the creation of destinations, are not executed during evaluation
+	Skipped bool    `json:"skipped"`
+	Reason  *string `json:"reason"`
+	// The operator input rendered from the input template of the constraint
+	RenderedInput *string `json:"renderedInput"`
+}
+
 type FormationConstraintInput struct {
 	Name            string          `json:"name"`
 	ConstraintType  ConstraintType  `json:"constraintType"`
@@ -556,6 +616,11 @@ type FormationConstraintInput struct {
 	ConstraintScope ConstraintScope `json:"constraintScope"`
 }
 
+type FormationConstraintJoinPointLocationInput struct {
+	ConstraintType  ConstraintType  `json:"constraintType"`
+	TargetOperation TargetOperation `json:"targetOperation"`
+}
+
 type FormationConstraintUpdateInput struct {
 	InputTemplate string `json:"inputTemplate"`
 }
@@ -573,6 +638,18 @@ type FormationInput struct {
 	State *string `json:"state"`
 }
 
+type FormationObjectAssignmentResult struct {
+	ObjectID   string              `json:"objectID"`
+	ObjectType FormationObjectType `json:"objectType"`
+	// The reason why the object can not be assigned to the formation. If any of the objects can not be assigned, none of them is assigned and the error of the other objects states that they are not assigned because the batch is rolled back.
+	Error *string `json:"error"`
+}
+
+type FormationObjectInput struct {
+	ObjectID   string              `json:"objectID"`
+	ObjectType FormationObjectType `json:"objectType"`
+}
+
 type FormationPage struct {
 	Data       []*Formation `json:"data"`
 	PageInfo   *PageInfo    `json:"pageInfo"`
@@ -611,6 +688,22 @@ type FormationTemplatePage struct {
 
 func (FormationTemplatePage) IsPageable() {}
 
+type HMACCredentialData struct {
+	PrimarySecret   string  `json:"primarySecret"`
+	SecondarySecret *string `json:"secondarySecret"`
+}
+
+func (HMACCredentialData) IsCredentialData() {}
+
+type HMACCredentialDataInput struct {
+	// Secret used for the signature of the outgoing requests.
+	// **Validation:** at least 32 characters
+	PrimarySecret string `json:"primarySecret"`
+	// Optional second active secret used during key rotation. If provided, the requests are signed with both secrets.
+	// **Validation:** at least 32 characters
+	SecondarySecret *string `json:"secondarySecret"`
+}
+
 type HealthCheck struct {
 	Type      HealthCheckType            `json:"type"`
 	Condition HealthCheckStatusCondition `json:"condition"`
@@ -637,6 +730,19 @@ type IntSysSystemAuth struct {
 
 func (IntSysSystemAuth) IsSystemAuth() {}
 
+// Describes an API or Event Definition of another Application which satisfies an aspect of an ORD Integration Dependency. Exactly one of the API and Event Definition fields is set.
+type IntegrationDependencyProvider struct {
+	IntegrationDependencyID    string  `json:"integrationDependencyID"`
+	IntegrationDependencyOrdID string  `json:"integrationDependencyOrdID"`
+	AspectTitle                string  `json:"aspectTitle"`
+	Mandatory                  bool    `json:"mandatory"`
+	APIDefinitionID            *string `json:"apiDefinitionID"`
+	APIDefinitionOrdID         *string `json:"apiDefinitionOrdID"`
+	EventDefinitionID          *string `json:"eventDefinitionID"`
+	EventDefinitionOrdID       *string `json:"eventDefinitionOrdID"`
+	ApplicationID              string  `json:"applicationID"`
+}
+
 type IntegrationSystemInput struct {
 	// **Validation:**  Up to 36 characters long. Cannot start with a digit. The characters allowed in names are: digits (0-9), lower case letters (a-z),-, and .
 	Name string `json:"name"`
@@ -674,6 +780,27 @@ type LabelFilter struct {
 	// Optional SQL/JSON Path expression. If query is not provided, returns every object with given label key regardless of its value.
 	// Currently only a limited subset of expressions is supported.
 	Query *string `json:"query"`
+	// Optional structured expression over the object labels. It can not be combined with query.
+	// Conditions without key are applied to the label with the key of the filter.
+	Expression *LabelFilterExpression `json:"expression"`
+}
+
+type LabelFilterCondition struct {
+	// Label key. If not provided, the key of the enclosing label filter is used.
+	Key      *string             `json:"key"`
+	Operator LabelFilterOperator `json:"operator"`
+	// EQ, STARTS_WITH, GT and LT require exactly one value, IN requires at least one value and EXISTS does not accept values.
+	// GT and LT compare numerically and match only numeric label values.
+	// EQ and IN match string values and string elements of array values, and numeric and boolean values by their JSON representation, e.g. "42" or "true".
+	Values []string `json:"values"`
+}
+
+// Exactly one of the fields has to be provided.
+type LabelFilterExpression struct {
+	And       []*LabelFilterExpression `json:"and"`
+	Or        []*LabelFilterExpression `json:"or"`
+	Not       *LabelFilterExpression   `json:"not"`
+	Condition *LabelFilterCondition    `json:"condition"`
 }
 
 type LabelInput struct {
@@ -715,6 +842,31 @@ type OneTimeTokenInput struct {
 	Type         *OneTimeTokenType `json:"type"`
 }
 
+type Operation struct {
+	ID         string                 `json:"id"`
+	Type       ScheduledOperationType `json:"type"`
+	Status     OperationStatus        `json:"status"`
+	Data       *JSON                  `json:"data"`
+	Error      *string                `json:"error"`
+	Priority   int                    `json:"priority"`
+	CreatedAt  *Timestamp             `json:"createdAt"`
+	UpdatedAt  *Timestamp             `json:"updatedAt"`
+	FinishedAt *Timestamp             `json:"finishedAt"`
+}
+
+type OperationFilter struct {
+	Type   *ScheduledOperationType `json:"type"`
+	Status *OperationStatus        `json:"status"`
+}
+
+type OperationPage struct {
+	Data       []*Operation `json:"data"`
+	PageInfo   *PageInfo    `json:"pageInfo"`
+	TotalCount int          `json:"totalCount"`
+}
+
+func (OperationPage) IsPageable() {}
+
 type PageInfo struct {
 	StartCursor PageCursor `json:"startCursor"`
 	EndCursor   PageCursor `json:"endCursor"`
@@ -722,10 +874,16 @@ type PageInfo struct {
 }
 
 type PlaceholderDefinition struct {
-	Name        string  `json:"name"`
-	Description *string `json:"description"`
-	JSONPath    *string `json:"jsonPath"`
-	Optional    *bool   `json:"optional"`
+	Name         string           `json:"name"`
+	Description  *string          `json:"description"`
+	JSONPath     *string          `json:"jsonPath"`
+	Optional     *bool            `json:"optional"`
+	Type         *PlaceholderType `json:"type"`
+	EnumValues   []string         `json:"enumValues"`
+	Pattern      *string          `json:"pattern"`
+	MinLength    *int             `json:"minLength"`
+	MaxLength    *int             `json:"maxLength"`
+	DefaultValue *string          `json:"defaultValue"`
 }
 
 type PlaceholderDefinitionInput struct {
@@ -736,6 +894,20 @@ type PlaceholderDefinitionInput struct {
 	// **Validation:**  max=2000
 	JSONPath *string `json:"jsonPath"`
 	Optional *bool   `json:"optional"`
+	// The type of the placeholder value. Placeholders without a type accept any string value.
+	Type *PlaceholderType `json:"type"`
+	// **Validation:** required for placeholders of type ENUM and not allowed for the other types
+	EnumValues []string `json:"enumValues"`
+	// Regular expression which the whole placeholder value must match, as if it was enclosed in ^ and $.
+	// **Validation:** valid regular expression
+	Pattern *string `json:"pattern"`
+	// **Validation:** min=0
+	MinLength *int `json:"minLength"`
+	// **Validation:** min=0, greater than or equal to minLength
+	MaxLength *int `json:"maxLength"`
+	// Value used when no value is provided for the placeholder.
+	// **Validation:** max=256, matches the type, enumValues, pattern, minLength and maxLength of the placeholder
+	DefaultValue *string `json:"defaultValue"`
 }
 
 type RuntimeContextInput struct {
@@ -1052,6 +1224,53 @@ func (e ApplicationTemplateAccessLevel) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type ApplicationUpgradeStatus string
+
+const (
+	ApplicationUpgradeStatusUpgraded         ApplicationUpgradeStatus = "UPGRADED"
+	ApplicationUpgradeStatusUpgradeAvailable ApplicationUpgradeStatus = "UPGRADE_AVAILABLE"
+	ApplicationUpgradeStatusUpToDate         ApplicationUpgradeStatus = "UP_TO_DATE"
+	ApplicationUpgradeStatusSkipped          ApplicationUpgradeStatus = "SKIPPED"
+	ApplicationUpgradeStatusFailed           ApplicationUpgradeStatus = "FAILED"
+)
+
+var AllApplicationUpgradeStatus = []ApplicationUpgradeStatus{
+	ApplicationUpgradeStatusUpgraded,
+	ApplicationUpgradeStatusUpgradeAvailable,
+	ApplicationUpgradeStatusUpToDate,
+	ApplicationUpgradeStatusSkipped,
+	ApplicationUpgradeStatusFailed,
+}
+
+func (e ApplicationUpgradeStatus) IsValid() bool {
+	switch e {
+	case ApplicationUpgradeStatusUpgraded, ApplicationUpgradeStatusUpgradeAvailable, ApplicationUpgradeStatusUpToDate, ApplicationUpgradeStatusSkipped, ApplicationUpgradeStatusFailed:
+		return true
+	}
+	return false
+}
+
+func (e ApplicationUpgradeStatus) String() string {
+	return string(e)
+}
+
+func (e *ApplicationUpgradeStatus) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ApplicationUpgradeStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ApplicationUpgradeStatus", str)
+	}
+	return nil
+}
+
+func (e ApplicationUpgradeStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type ArtifactType string
 
 const (
@@ -1474,6 +1693,51 @@ func (e FetchRequestStatusCondition) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type FormationAssignmentTransitionActor string
+
+const (
+	FormationAssignmentTransitionActorNotificationResponse FormationAssignmentTransitionActor = "NOTIFICATION_RESPONSE"
+	FormationAssignmentTransitionActorStatusAPI            FormationAssignmentTransitionActor = "STATUS_API"
+	FormationAssignmentTransitionActorReset                FormationAssignmentTransitionActor = "RESET"
+	FormationAssignmentTransitionActorSystem               FormationAssignmentTransitionActor = "SYSTEM"
+)
+
+var AllFormationAssignmentTransitionActor = []FormationAssignmentTransitionActor{
+	FormationAssignmentTransitionActorNotificationResponse,
+	FormationAssignmentTransitionActorStatusAPI,
+	FormationAssignmentTransitionActorReset,
+	FormationAssignmentTransitionActorSystem,
+}
+
+func (e FormationAssignmentTransitionActor) IsValid() bool {
+	switch e {
+	case FormationAssignmentTransitionActorNotificationResponse, FormationAssignmentTransitionActorStatusAPI, FormationAssignmentTransitionActorReset, FormationAssignmentTransitionActorSystem:
+		return true
+	}
+	return false
+}
+
+func (e FormationAssignmentTransitionActor) String() string {
+	return string(e)
+}
+
+func (e *FormationAssignmentTransitionActor) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = FormationAssignmentTransitionActor(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid FormationAssignmentTransitionActor", str)
+	}
+	return nil
+}
+
+func (e FormationAssignmentTransitionActor) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type FormationAssignmentType string
 
 const (
@@ -1568,17 +1832,19 @@ const (
 	FormationStatusConditionInProgress FormationStatusCondition = "IN_PROGRESS"
 	FormationStatusConditionError      FormationStatusCondition = "ERROR"
 	FormationStatusConditionReady      FormationStatusCondition = "READY"
+	FormationStatusConditionDraft      FormationStatusCondition = "DRAFT"
 )
 
 var AllFormationStatusCondition = []FormationStatusCondition{
 	FormationStatusConditionInProgress,
 	FormationStatusConditionError,
 	FormationStatusConditionReady,
+	FormationStatusConditionDraft,
 }
 
 func (e FormationStatusCondition) IsValid() bool {
 	switch e {
-	case FormationStatusConditionInProgress, FormationStatusConditionError, FormationStatusConditionReady:
+	case FormationStatusConditionInProgress, FormationStatusConditionError, FormationStatusConditionReady, FormationStatusConditionDraft:
 		return true
 	}
 	return false
@@ -1685,6 +1951,55 @@ func (e HealthCheckType) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type LabelFilterOperator string
+
+const (
+	LabelFilterOperatorEq         LabelFilterOperator = "EQ"
+	LabelFilterOperatorIn         LabelFilterOperator = "IN"
+	LabelFilterOperatorExists     LabelFilterOperator = "EXISTS"
+	LabelFilterOperatorStartsWith LabelFilterOperator = "STARTS_WITH"
+	LabelFilterOperatorGt         LabelFilterOperator = "GT"
+	LabelFilterOperatorLt         LabelFilterOperator = "LT"
+)
+
+var AllLabelFilterOperator = []LabelFilterOperator{
+	LabelFilterOperatorEq,
+	LabelFilterOperatorIn,
+	LabelFilterOperatorExists,
+	LabelFilterOperatorStartsWith,
+	LabelFilterOperatorGt,
+	LabelFilterOperatorLt,
+}
+
+func (e LabelFilterOperator) IsValid() bool {
+	switch e {
+	case LabelFilterOperatorEq, LabelFilterOperatorIn, LabelFilterOperatorExists, LabelFilterOperatorStartsWith, LabelFilterOperatorGt, LabelFilterOperatorLt:
+		return true
+	}
+	return false
+}
+
+func (e LabelFilterOperator) String() string {
+	return string(e)
+}
+
+func (e *LabelFilterOperator) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = LabelFilterOperator(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid LabelFilterOperator", str)
+	}
+	return nil
+}
+
+func (e LabelFilterOperator) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type OneTimeTokenType string
 
 const (
@@ -1767,6 +2082,51 @@ func (e OperationMode) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type OperationStatus string
+
+const (
+	OperationStatusScheduled  OperationStatus = "SCHEDULED"
+	OperationStatusInProgress OperationStatus = "IN_PROGRESS"
+	OperationStatusCompleted  OperationStatus = "COMPLETED"
+	OperationStatusFailed     OperationStatus = "FAILED"
+)
+
+var AllOperationStatus = []OperationStatus{
+	OperationStatusScheduled,
+	OperationStatusInProgress,
+	OperationStatusCompleted,
+	OperationStatusFailed,
+}
+
+func (e OperationStatus) IsValid() bool {
+	switch e {
+	case OperationStatusScheduled, OperationStatusInProgress, OperationStatusCompleted, OperationStatusFailed:
+		return true
+	}
+	return false
+}
+
+func (e OperationStatus) String() string {
+	return string(e)
+}
+
+func (e *OperationStatus) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = OperationStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid OperationStatus", str)
+	}
+	return nil
+}
+
+func (e OperationStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type OperationType string
 
 const (
@@ -1810,6 +2170,53 @@ func (e OperationType) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type PlaceholderType string
+
+const (
+	PlaceholderTypeString  PlaceholderType = "STRING"
+	PlaceholderTypeNumber  PlaceholderType = "NUMBER"
+	PlaceholderTypeBoolean PlaceholderType = "BOOLEAN"
+	PlaceholderTypeEnum    PlaceholderType = "ENUM"
+	PlaceholderTypeURL     PlaceholderType = "URL"
+)
+
+var AllPlaceholderType = []PlaceholderType{
+	PlaceholderTypeString,
+	PlaceholderTypeNumber,
+	PlaceholderTypeBoolean,
+	PlaceholderTypeEnum,
+	PlaceholderTypeURL,
+}
+
+func (e PlaceholderType) IsValid() bool {
+	switch e {
+	case PlaceholderTypeString, PlaceholderTypeNumber, PlaceholderTypeBoolean, PlaceholderTypeEnum, PlaceholderTypeURL:
+		return true
+	}
+	return false
+}
+
+func (e PlaceholderType) String() string {
+	return string(e)
+}
+
+func (e *PlaceholderType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = PlaceholderType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid PlaceholderType", str)
+	}
+	return nil
+}
+
+func (e PlaceholderType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type ResourceType string
 
 const (
@@ -1902,6 +2309,47 @@ func (e RuntimeStatusCondition) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type ScheduledOperationType string
+
+const (
+	ScheduledOperationTypeOrdAggregation  ScheduledOperationType = "ORD_AGGREGATION"
+	ScheduledOperationTypeNsAdapterReport ScheduledOperationType = "NS_ADAPTER_REPORT"
+)
+
+var AllScheduledOperationType = []ScheduledOperationType{
+	ScheduledOperationTypeOrdAggregation,
+	ScheduledOperationTypeNsAdapterReport,
+}
+
+func (e ScheduledOperationType) IsValid() bool {
+	switch e {
+	case ScheduledOperationTypeOrdAggregation, ScheduledOperationTypeNsAdapterReport:
+		return true
+	}
+	return false
+}
+
+func (e ScheduledOperationType) String() string {
+	return string(e)
+}
+
+func (e *ScheduledOperationType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ScheduledOperationType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ScheduledOperationType", str)
+	}
+	return nil
+}
+
+func (e ScheduledOperationType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type SpecFormat string
 
 const (
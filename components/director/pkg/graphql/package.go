@@ -0,0 +1,14 @@
+package graphql
+
+// Package represents an ORD package of an Application
+type Package struct {
+	ID                string  `json:"id"`
+	OrdID             string  `json:"ordID"`
+	Vendor            *string `json:"vendor"`
+	Title             string  `json:"title"`
+	ShortDescription  string  `json:"shortDescription"`
+	Description       string  `json:"description"`
+	Version           string  `json:"version"`
+	PolicyLevel       string  `json:"policyLevel"`
+	CustomPolicyLevel *string `json:"customPolicyLevel"`
+}
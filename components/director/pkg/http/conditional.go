@@ -0,0 +1,44 @@
+package http
+
+import (
+	"net/http"
+)
+
+const (
+	// ETagHeader is the response header holding the entity tag of the returned resource
+	ETagHeader = "ETag"
+	// LastModifiedHeader is the response header holding the last modification date of the returned resource
+	LastModifiedHeader = "Last-Modified"
+	// IfNoneMatchHeader is the conditional request header carrying a previously received entity tag
+	IfNoneMatchHeader = "If-None-Match"
+	// IfModifiedSinceHeader is the conditional request header carrying a previously received last modification date
+	IfModifiedSinceHeader = "If-Modified-Since"
+)
+
+// ConditionalRequestHeaders builds the headers of a conditional GET request from the validators of a previously fetched resource.
+// Nil is returned if no validators are provided.
+func ConditionalRequestHeaders(etag, lastModified *string) http.Header {
+	headers := http.Header{}
+	if etag != nil && len(*etag) > 0 {
+		headers.Set(IfNoneMatchHeader, *etag)
+	}
+	if lastModified != nil && len(*lastModified) > 0 {
+		headers.Set(IfModifiedSinceHeader, *lastModified)
+	}
+
+	if len(headers) == 0 {
+		return nil
+	}
+	return headers
+}
+
+// ResponseValidators returns the validators of the response which can be used for subsequent conditional requests
+func ResponseValidators(resp *http.Response) (etag *string, lastModified *string) {
+	if value := resp.Header.Get(ETagHeader); len(value) > 0 {
+		etag = &value
+	}
+	if value := resp.Header.Get(LastModifiedHeader); len(value) > 0 {
+		lastModified = &value
+	}
+	return etag, lastModified
+}
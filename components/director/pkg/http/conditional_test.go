@@ -0,0 +1,82 @@
+package http_test
+
+import (
+	"net/http"
+	"testing"
+
+	httputil "github.com/kyma-incubator/compass/components/director/pkg/http"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConditionalRequestHeaders(t *testing.T) {
+	etag := `"33a64df551425fcc55e4d42a148795d9f25f89d4"`
+	lastModified := "Wed, 21 Oct 2015 07:28:00 GMT"
+
+	testCases := []struct {
+		Name         string
+		ETag         *string
+		LastModified *string
+		Expected     http.Header
+	}{
+		{
+			Name:         "Both validators",
+			ETag:         &etag,
+			LastModified: &lastModified,
+			Expected: http.Header{
+				httputil.IfNoneMatchHeader:     []string{etag},
+				httputil.IfModifiedSinceHeader: []string{lastModified},
+			},
+		},
+		{
+			Name: "Only ETag",
+			ETag: &etag,
+			Expected: http.Header{
+				httputil.IfNoneMatchHeader: []string{etag},
+			},
+		},
+		{
+			Name:         "Only Last-Modified",
+			LastModified: &lastModified,
+			Expected: http.Header{
+				httputil.IfModifiedSinceHeader: []string{lastModified},
+			},
+		},
+		{
+			Name:         "Empty validators",
+			ETag:         str.Ptr(""),
+			LastModified: str.Ptr(""),
+			Expected:     nil,
+		},
+		{
+			Name:     "No validators",
+			Expected: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			assert.Equal(t, testCase.Expected, httputil.ConditionalRequestHeaders(testCase.ETag, testCase.LastModified))
+		})
+	}
+}
+
+func TestResponseValidators(t *testing.T) {
+	t.Run("Returns the validators of the response", func(t *testing.T) {
+		resp := &http.Response{Header: http.Header{}}
+		resp.Header.Set(httputil.ETagHeader, "etag")
+		resp.Header.Set(httputil.LastModifiedHeader, "last-modified")
+
+		etag, lastModified := httputil.ResponseValidators(resp)
+
+		assert.Equal(t, str.Ptr("etag"), etag)
+		assert.Equal(t, str.Ptr("last-modified"), lastModified)
+	})
+
+	t.Run("Returns nil when the response has no validators", func(t *testing.T) {
+		etag, lastModified := httputil.ResponseValidators(&http.Response{Header: http.Header{}})
+
+		assert.Nil(t, etag)
+		assert.Nil(t, lastModified)
+	})
+}
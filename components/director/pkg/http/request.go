@@ -14,8 +14,8 @@ import (
 
 const tenantHeader = "Tenant"
 
-// GetRequestWithCredentials executes a GET http request to the given url with the provided auth credentials
-func GetRequestWithCredentials(ctx context.Context, client *http.Client, url, tnt string, auth *model.Auth) (*http.Response, error) {
+// GetRequestWithCredentials executes a GET http request to the given url with the provided auth credentials and additional headers
+func GetRequestWithCredentials(ctx context.Context, client *http.Client, url, tnt string, headers http.Header, auth *model.Auth) (*http.Response, error) {
 	if auth == nil || (auth.Credential.Basic == nil && auth.Credential.Oauth == nil) {
 		return nil, apperrors.NewInvalidDataError("Credentials not provided")
 	}
@@ -25,6 +25,7 @@ func GetRequestWithCredentials(ctx context.Context, client *http.Client, url, tn
 		return nil, err
 	}
 
+	AddHeaders(req, headers)
 	if len(tnt) > 0 {
 		req.Header.Set(tenantHeader, tnt)
 	}
@@ -35,7 +36,7 @@ func GetRequestWithCredentials(ctx context.Context, client *http.Client, url, tn
 
 		resp, err = client.Do(req)
 
-		if err == nil && resp.StatusCode == http.StatusOK {
+		if err == nil && (resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusNotModified) {
 			return resp, nil
 		}
 	}
@@ -60,16 +61,26 @@ func secureClient(ctx context.Context, client *http.Client, auth *model.Auth) *h
 	return securedClient
 }
 
-// GetRequestWithoutCredentials executes a GET http request to the given url
-func GetRequestWithoutCredentials(client *http.Client, url, tnt string) (*http.Response, error) {
+// GetRequestWithoutCredentials executes a GET http request to the given url with the provided additional headers
+func GetRequestWithoutCredentials(client *http.Client, url, tnt string, headers http.Header) (*http.Response, error) {
 	req, err := http.NewRequest(http.MethodGet, url, nil)
 	if err != nil {
 		return nil, err
 	}
 
+	AddHeaders(req, headers)
 	if len(tnt) > 0 {
 		req.Header.Set(tenantHeader, tnt)
 	}
 
 	return client.Do(req)
 }
+
+// AddHeaders adds all values of the provided headers to the request
+func AddHeaders(req *http.Request, headers http.Header) {
+	for key, values := range headers {
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
+}
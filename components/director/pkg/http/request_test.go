@@ -50,7 +50,19 @@ func TestRequestWithoutCredentials_Success(t *testing.T) {
 		return expectedResp
 	})
 
-	resp, err := httputil.GetRequestWithoutCredentials(client, testURL, testTenant)
+	resp, err := httputil.GetRequestWithoutCredentials(client, testURL, testTenant, nil)
+	require.NoError(t, err)
+	require.Equal(t, resp, expectedResp)
+}
+
+func TestRequestWithoutCredentials_SuccessWithAdditionalHeaders(t *testing.T) {
+	client := newTestClient(func(req *http.Request) *http.Response {
+		require.Equal(t, "etag", req.Header.Get("If-None-Match"))
+		require.Equal(t, testTenant, req.Header.Get("Tenant"))
+		return expectedResp
+	})
+
+	resp, err := httputil.GetRequestWithoutCredentials(client, testURL, testTenant, http.Header{"If-None-Match": []string{"etag"}})
 	require.NoError(t, err)
 	require.Equal(t, resp, expectedResp)
 }
@@ -60,7 +72,7 @@ func TestRequestWithoutCredentials_FailedRequest(t *testing.T) {
 		return nil
 	})
 
-	_, err := httputil.GetRequestWithoutCredentials(client, testURL, testTenant)
+	_, err := httputil.GetRequestWithoutCredentials(client, testURL, testTenant, nil)
 	require.ErrorIs(t, err, testErr)
 }
 
@@ -74,7 +86,7 @@ func TestRequestWithCredentials_SuccessWithBasicAuth(t *testing.T) {
 		return expectedResp
 	})
 
-	resp, err := httputil.GetRequestWithCredentials(context.Background(), client, testURL, testTenant, &model.Auth{
+	resp, err := httputil.GetRequestWithCredentials(context.Background(), client, testURL, testTenant, nil, &model.Auth{
 		Credential: model.CredentialData{
 			Basic: &model.BasicCredentialData{
 				Username: user,
@@ -91,7 +103,7 @@ func TestRequestWithCredentials_FailedWithBasicAuth(t *testing.T) {
 		return nil
 	})
 
-	_, err := httputil.GetRequestWithCredentials(context.Background(), client, testURL, testTenant, &model.Auth{
+	_, err := httputil.GetRequestWithCredentials(context.Background(), client, testURL, testTenant, nil, &model.Auth{
 		Credential: model.CredentialData{
 			Basic: &model.BasicCredentialData{
 				Username: "user",
@@ -136,7 +148,7 @@ func TestRequestWithCredentials_SuccessWithOAuth(t *testing.T) {
 		}
 	})
 
-	resp, err := httputil.GetRequestWithCredentials(context.Background(), client, testURL, testTenant, &model.Auth{
+	resp, err := httputil.GetRequestWithCredentials(context.Background(), client, testURL, testTenant, nil, &model.Auth{
 		Credential: model.CredentialData{
 			Oauth: &model.OAuthCredentialData{
 				ClientID:     clientID,
@@ -156,7 +168,7 @@ func TestRequestWithCredentials_FailedWithOAuthDueToInvalidCredentials(t *testin
 		}
 	})
 
-	_, err := httputil.GetRequestWithCredentials(context.Background(), client, testURL, testTenant, &model.Auth{
+	_, err := httputil.GetRequestWithCredentials(context.Background(), client, testURL, testTenant, nil, &model.Auth{
 		Credential: model.CredentialData{
 			Oauth: &model.OAuthCredentialData{},
 		},
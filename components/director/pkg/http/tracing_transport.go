@@ -0,0 +1,60 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/tracing"
+	"go.opentelemetry.io/otel"
+	semconv "go.opentelemetry.io/otel/semconv/v1.12.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/kyma-incubator/compass/components/director/pkg/http"
+
+// NewTracingTransport returns a transport that records a client span for the outgoing request and propagates the trace context in its headers.
+func NewTracingTransport(roundTripper HTTPRoundTripper) *TracingTransport {
+	return &TracingTransport{
+		roundTripper: roundTripper,
+	}
+}
+
+// TracingTransport is a transport that records a client span for the outgoing request and propagates the trace context in its headers.
+type TracingTransport struct {
+	roundTripper HTTPRoundTripper
+}
+
+// RoundTrip records a client span for the request and injects the W3C trace context headers.
+func (t *TracingTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	ctx, span := otel.Tracer(tracerName).Start(r.Context(), "HTTP "+r.Method,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(semconv.HTTPClientAttributesFromHTTPRequest(r)...))
+	defer span.End()
+
+	r = r.Clone(ctx)
+	tracing.InjectHeaders(r)
+
+	resp, err := t.roundTripper.RoundTrip(r)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return resp, err
+	}
+
+	if resp != nil {
+		span.SetAttributes(semconv.HTTPAttributesFromHTTPStatusCode(resp.StatusCode)...)
+		span.SetStatus(semconv.SpanStatusFromHTTPStatusCodeAndSpanKind(resp.StatusCode, trace.SpanKindClient))
+	}
+
+	return resp, nil
+}
+
+// Clone clones the underlying transport.
+func (t *TracingTransport) Clone() HTTPRoundTripper {
+	return &TracingTransport{
+		roundTripper: t.roundTripper.Clone(),
+	}
+}
+
+// GetTransport returns the underlying transport.
+func (t *TracingTransport) GetTransport() *http.Transport {
+	return t.roundTripper.GetTransport()
+}
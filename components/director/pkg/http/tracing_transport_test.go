@@ -0,0 +1,84 @@
+package http_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	httputil "github.com/kyma-incubator/compass/components/director/pkg/http"
+	"github.com/kyma-incubator/compass/components/director/pkg/http/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/tracing"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestTracingTransport_RoundTrip(t *testing.T) {
+	const testURL = "http://localhost:8080"
+
+	_, err := tracing.Configure(context.TODO(), &tracing.Config{Enabled: false}, "test-service")
+	require.NoError(t, err)
+
+	recorder := tracetest.NewSpanRecorder()
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	defer otel.SetTracerProvider(previous)
+
+	ctx, parentSpan := otel.Tracer("test").Start(context.TODO(), "parent")
+	defer parentSpan.End()
+
+	t.Run("propagates the trace context in the request headers", func(t *testing.T) {
+		// GIVEN
+		rt := &automock.HTTPRoundTripper{}
+		rt.On("RoundTrip", mock.Anything).Return(&http.Response{StatusCode: http.StatusOK}, nil).Run(func(args mock.Arguments) {
+			req, ok := args.Get(0).(*http.Request)
+			require.True(t, ok)
+
+			traceParent := req.Header.Get("traceparent")
+			assert.Contains(t, traceParent, parentSpan.SpanContext().TraceID().String())
+		}).Once()
+
+		request, err := http.NewRequestWithContext(ctx, http.MethodGet, testURL, nil)
+		require.NoError(t, err)
+
+		// WHEN
+		_, err = httputil.NewTracingTransport(rt).RoundTrip(request)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Empty(t, request.Header.Get("traceparent"))
+
+		ended := recorder.Ended()
+		require.NotEmpty(t, ended)
+		clientSpan := ended[len(ended)-1]
+		assert.Equal(t, trace.SpanKindClient, clientSpan.SpanKind())
+		assert.Equal(t, parentSpan.SpanContext().SpanID(), clientSpan.Parent().SpanID())
+		mock.AssertExpectationsForObjects(t, rt)
+	})
+
+	t.Run("records the error of the request", func(t *testing.T) {
+		// GIVEN
+		rt := &automock.HTTPRoundTripper{}
+		rt.On("RoundTrip", mock.Anything).Return(nil, errors.New("test error")).Once()
+
+		request, err := http.NewRequestWithContext(ctx, http.MethodGet, testURL, nil)
+		require.NoError(t, err)
+
+		// WHEN
+		_, err = httputil.NewTracingTransport(rt).RoundTrip(request)
+
+		// THEN
+		require.Error(t, err)
+
+		ended := recorder.Ended()
+		require.NotEmpty(t, ended)
+		assert.Equal(t, codes.Error, ended[len(ended)-1].Status().Code)
+		mock.AssertExpectationsForObjects(t, rt)
+	})
+}
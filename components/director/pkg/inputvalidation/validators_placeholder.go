@@ -0,0 +1,71 @@
+package inputvalidation
+
+import (
+	"net/url"
+	"regexp"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	numberPlaceholderType  = "NUMBER"
+	booleanPlaceholderType = "BOOLEAN"
+	enumPlaceholderType    = "ENUM"
+	urlPlaceholderType     = "URL"
+)
+
+var jsonNumberRegex = regexp.MustCompile(`^-?(0|[1-9][0-9]*)(\.[0-9]+)?([eE][+-]?[0-9]+)?$`)
+
+// PlaceholderConstraints are the constraints which the values of an application template placeholder must satisfy
+type PlaceholderConstraints struct {
+	Type       string
+	EnumValues []string
+	Pattern    *string
+	MinLength  *int
+	MaxLength  *int
+}
+
+// ValidatePlaceholderValue checks that the value satisfies the constraints of the placeholder.
+// The pattern must match the whole value, as if it was enclosed in ^ and $.
+func ValidatePlaceholderValue(constraints PlaceholderConstraints, value string) error {
+	if constraints.MinLength != nil && utf8.RuneCountInString(value) < *constraints.MinLength {
+		return errors.Errorf("must be at least %d characters long", *constraints.MinLength)
+	}
+	if constraints.MaxLength != nil && utf8.RuneCountInString(value) > *constraints.MaxLength {
+		return errors.Errorf("must be at most %d characters long", *constraints.MaxLength)
+	}
+	if constraints.Pattern != nil {
+		matched, err := regexp.MatchString(`^(?:`+*constraints.Pattern+`)$`, value)
+		if err != nil {
+			return errors.Wrapf(err, "while matching pattern %q", *constraints.Pattern)
+		}
+		if !matched {
+			return errors.Errorf("must match pattern %q", *constraints.Pattern)
+		}
+	}
+
+	switch constraints.Type {
+	case numberPlaceholderType:
+		if !jsonNumberRegex.MatchString(value) {
+			return errors.New("must be a number")
+		}
+	case booleanPlaceholderType:
+		if value != "true" && value != "false" {
+			return errors.New("must be either \"true\" or \"false\"")
+		}
+	case enumPlaceholderType:
+		for _, enumValue := range constraints.EnumValues {
+			if value == enumValue {
+				return nil
+			}
+		}
+		return errors.Errorf("must be one of %v", constraints.EnumValues)
+	case urlPlaceholderType:
+		u, err := url.ParseRequestURI(value)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return errors.New("must be an absolute HTTP or HTTPS URL")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,105 @@
+package inputvalidation_test
+
+import (
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/inputvalidation"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatePlaceholderValue(t *testing.T) {
+	three := 3
+
+	testCases := []struct {
+		Name          string
+		Constraints   inputvalidation.PlaceholderConstraints
+		Value         string
+		ExpectedError string
+	}{
+		{
+			Name:        "Valid without constraints",
+			Constraints: inputvalidation.PlaceholderConstraints{},
+			Value:       "any value",
+		},
+		{
+			Name:        "Valid when the pattern matches the whole value",
+			Constraints: inputvalidation.PlaceholderConstraints{Pattern: str.Ptr("[a-z]+|[0-9]+")},
+			Value:       "123",
+		},
+		{
+			Name:          "Invalid when the pattern matches only a part of the value",
+			Constraints:   inputvalidation.PlaceholderConstraints{Pattern: str.Ptr("[a-z]+")},
+			Value:         "abc1",
+			ExpectedError: "must match pattern \"[a-z]+\"",
+		},
+		{
+			Name:          "Invalid pattern",
+			Constraints:   inputvalidation.PlaceholderConstraints{Pattern: str.Ptr("[a-z")},
+			Value:         "abc",
+			ExpectedError: "while matching pattern \"[a-z\"",
+		},
+		{
+			Name:          "Invalid when shorter than the min length",
+			Constraints:   inputvalidation.PlaceholderConstraints{MinLength: &three},
+			Value:         "ab",
+			ExpectedError: "must be at least 3 characters long",
+		},
+		{
+			Name:          "Invalid when longer than the max length",
+			Constraints:   inputvalidation.PlaceholderConstraints{MaxLength: &three},
+			Value:         "abcd",
+			ExpectedError: "must be at most 3 characters long",
+		},
+		{
+			Name:        "Valid number",
+			Constraints: inputvalidation.PlaceholderConstraints{Type: "NUMBER"},
+			Value:       "-1.5e10",
+		},
+		{
+			Name:          "Invalid number",
+			Constraints:   inputvalidation.PlaceholderConstraints{Type: "NUMBER"},
+			Value:         "01",
+			ExpectedError: "must be a number",
+		},
+		{
+			Name:          "Invalid boolean",
+			Constraints:   inputvalidation.PlaceholderConstraints{Type: "BOOLEAN"},
+			Value:         "yes",
+			ExpectedError: "must be either \"true\" or \"false\"",
+		},
+		{
+			Name:        "Valid enum value",
+			Constraints: inputvalidation.PlaceholderConstraints{Type: "ENUM", EnumValues: []string{"a", "b"}},
+			Value:       "b",
+		},
+		{
+			Name:          "Invalid enum value",
+			Constraints:   inputvalidation.PlaceholderConstraints{Type: "ENUM", EnumValues: []string{"a", "b"}},
+			Value:         "c",
+			ExpectedError: "must be one of [a b]",
+		},
+		{
+			Name:          "Invalid URL",
+			Constraints:   inputvalidation.PlaceholderConstraints{Type: "URL"},
+			Value:         "ftp://kyma-project.io",
+			ExpectedError: "must be an absolute HTTP or HTTPS URL",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// WHEN
+			err := inputvalidation.ValidatePlaceholderValue(testCase.Constraints, testCase.Value)
+
+			// THEN
+			if testCase.ExpectedError == "" {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedError)
+			}
+		})
+	}
+}
@@ -39,6 +39,29 @@ func (_m *Transactioner) Begin() (persistence.PersistenceTx, error) {
 	return r0, r1
 }
 
+// BeginReadOnly provides a mock function with given fields:
+func (_m *Transactioner) BeginReadOnly() (persistence.PersistenceTx, error) {
+	ret := _m.Called()
+
+	var r0 persistence.PersistenceTx
+	if rf, ok := ret.Get(0).(func() persistence.PersistenceTx); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(persistence.PersistenceTx)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // PingContext provides a mock function with given fields: ctx
 func (_m *Transactioner) PingContext(ctx context.Context) error {
 	ret := _m.Called(ctx)
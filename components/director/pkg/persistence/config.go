@@ -24,3 +24,22 @@ type DatabaseConfig struct {
 func (cfg DatabaseConfig) GetConnString() string {
 	return fmt.Sprintf(connStringf, cfg.Host, cfg.Port, cfg.User, cfg.Password, cfg.Name, cfg.SSLMode)
 }
+
+// ReplicaConfig configures the optional read-only replica of the database.
+// Connection credentials and database name are shared with the primary DatabaseConfig.
+type ReplicaConfig struct {
+	Enabled                    bool          `envconfig:"default=false,APP_DB_REPLICA_ENABLED"`
+	Host                       string        `envconfig:"optional,APP_DB_REPLICA_HOST"`
+	Port                       string        `envconfig:"default=5432,APP_DB_REPLICA_PORT"`
+	MaxOpenConnections         int           `envconfig:"default=5,APP_DB_REPLICA_MAX_OPEN_CONNECTIONS"`
+	MaxIdleConnections         int           `envconfig:"default=5,APP_DB_REPLICA_MAX_IDLE_CONNECTIONS"`
+	ConnMaxLifetime            time.Duration `envconfig:"default=30m,APP_DB_REPLICA_CONNECTION_MAX_LIFETIME"`
+	MaxReplicationLag          time.Duration `envconfig:"default=5s,APP_DB_REPLICA_MAX_REPLICATION_LAG"`
+	ReplicationLagCheckCycle   time.Duration `envconfig:"default=10s,APP_DB_REPLICA_REPLICATION_LAG_CHECK_CYCLE"`
+	ReplicationLagCheckTimeout time.Duration `envconfig:"default=2s,APP_DB_REPLICA_REPLICATION_LAG_CHECK_TIMEOUT"`
+}
+
+// GetConnString returns the connection string of the replica based on the primary database configuration
+func (cfg ReplicaConfig) GetConnString(primary DatabaseConfig) string {
+	return fmt.Sprintf(connStringf, cfg.Host, cfg.Port, primary.User, primary.Password, primary.Name, primary.SSLMode)
+}
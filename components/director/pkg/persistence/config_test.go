@@ -23,3 +23,25 @@ func TestGetConnString(t *testing.T) {
 		require.Equal(t, expectedConnStr, connStr)
 	})
 }
+
+func TestReplicaConfig_GetConnString(t *testing.T) {
+	t.Run("should generate replica connection string based on the replica and primary configuration", func(t *testing.T) {
+		expectedConnStr := "host=replicahost port=54321 user=dbuser password=dbpass dbname=dbname sslmode=enable"
+		dbCfg := DatabaseConfig{
+			User:     "dbuser",
+			Password: "dbpass",
+			Host:     "dbhost",
+			Port:     "12345",
+			Name:     "dbname",
+			SSLMode:  "enable",
+		}
+		replicaCfg := ReplicaConfig{
+			Host: "replicahost",
+			Port: "54321",
+		}
+
+		connStr := replicaCfg.GetConnString(dbCfg)
+
+		require.Equal(t, expectedConnStr, connStr)
+	})
+}
@@ -39,13 +39,20 @@ func FromCtx(ctx context.Context) (PersistenceOp, error) {
 //go:generate mockery --name=Transactioner --output=automock --outpkg=automock --case=underscore --disable-version-string
 type Transactioner interface {
 	Begin() (PersistenceTx, error)
+	BeginReadOnly() (PersistenceTx, error)
 	RollbackUnlessCommitted(ctx context.Context, tx PersistenceTx) (didRollback bool)
 	PingContext(ctx context.Context) error
 	Stats() sql.DBStats
 }
 
+// StatsGetter provides the connection pool statistics of a database
+type StatsGetter interface {
+	Stats() sql.DBStats
+}
+
 type db struct {
-	sqlDB *sqlx.DB
+	sqlDB   *sqlx.DB
+	replica *replica
 }
 
 // PingContext missing godoc
@@ -68,6 +75,24 @@ func (db *db) Begin() (PersistenceTx, error) {
 	return PersistenceTx(customTx), err
 }
 
+// BeginReadOnly starts a read-only transaction. If a replica is configured and its replication lag is within the allowed limit
+// the transaction is started on the replica, otherwise it falls back to the primary.
+func (db *db) BeginReadOnly() (PersistenceTx, error) {
+	ctx := context.Background()
+
+	sqlDB := db.sqlDB
+	if db.replica != nil && db.replica.isUsable() {
+		sqlDB = db.replica.sqlDB
+	}
+
+	tx, err := sqlDB.BeginTxx(ctx, &sql.TxOptions{ReadOnly: true})
+	customTx := &Transaction{
+		Tx:        tx,
+		committed: false,
+	}
+	return PersistenceTx(customTx), err
+}
+
 // RollbackUnlessCommitted missing godoc
 func (db *db) RollbackUnlessCommitted(ctx context.Context, tx PersistenceTx) (didRollback bool) {
 	customTx, ok := tx.(*Transaction)
@@ -134,7 +159,56 @@ func Configure(context context.Context, conf DatabaseConfig) (Transactioner, fun
 	return db, closeFunc, err
 }
 
-func waitForPersistance(ctx context.Context, conf DatabaseConfig, retryCount int) (Transactioner, func() error, error) {
+// ConfigureWithReplica returns the instance of the database which routes read-only transactions to the configured replica.
+// The returned StatsGetter provides the connection pool statistics of the replica and is nil if the replica is disabled.
+func ConfigureWithReplica(ctx context.Context, conf DatabaseConfig, replicaConf ReplicaConfig) (Transactioner, StatsGetter, func() error, error) {
+	primary, closeFunc, err := waitForPersistance(ctx, conf, RetryCount)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	if !replicaConf.Enabled {
+		return primary, nil, closeFunc, nil
+	}
+
+	log.C(ctx).Infof("Configuring read-only replica with host %s", replicaConf.Host)
+	replicaDB, err := connect(ctx, replicaConf.GetConnString(conf), RetryCount)
+	if err != nil {
+		if closeErr := closeFunc(); closeErr != nil {
+			log.C(ctx).WithError(closeErr).Error("Failed to close the connection to the database")
+		}
+		return nil, nil, nil, errors.Wrap(err, "while connecting to the database replica")
+	}
+	configurePool(ctx, replicaDB, replicaConf.MaxOpenConnections, replicaConf.MaxIdleConnections, replicaConf.ConnMaxLifetime)
+
+	primary.replica = newReplica(replicaDB, replicaConf)
+
+	lagCheckCtx, stopLagCheck := context.WithCancel(ctx)
+	primary.replica.start(lagCheckCtx)
+
+	closeAll := func() error {
+		stopLagCheck()
+		replicaErr := replicaDB.Close()
+		if err := closeFunc(); err != nil {
+			return err
+		}
+		return replicaErr
+	}
+
+	return primary, replicaDB, closeAll, nil
+}
+
+func waitForPersistance(ctx context.Context, conf DatabaseConfig, retryCount int) (*db, func() error, error) {
+	sqlxDB, err := connect(ctx, conf.GetConnString(), retryCount)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	configurePool(ctx, sqlxDB, conf.MaxOpenConnections, conf.MaxIdleConnections, conf.ConnMaxLifetime)
+	return &db{sqlDB: sqlxDB}, sqlxDB.Close, nil
+}
+
+func connect(ctx context.Context, connString string, retryCount int) (*sqlx.DB, error) {
 	var sqlxDB *sqlx.DB
 	var err error
 
@@ -144,9 +218,9 @@ func waitForPersistance(ctx context.Context, conf DatabaseConfig, retryCount int
 		}
 		log.C(ctx).Info("Trying to connect to DB...")
 
-		sqlxDB, err = sqlx.Open("postgres", conf.GetConnString())
+		sqlxDB, err = sqlx.Open("postgres", connString)
 		if err != nil {
-			return nil, nil, err
+			return nil, err
 		}
 		ctxWithTimeout, cancelFunc := context.WithTimeout(ctx, time.Second)
 		err = sqlxDB.PingContext(ctxWithTimeout)
@@ -156,12 +230,15 @@ func waitForPersistance(ctx context.Context, conf DatabaseConfig, retryCount int
 			continue
 		}
 
-		log.C(ctx).Infof("Configuring MaxOpenConnections: [%d], MaxIdleConnections: [%d], ConnectionMaxLifetime: [%s]", conf.MaxOpenConnections, conf.MaxIdleConnections, conf.ConnMaxLifetime.String())
-		sqlxDB.SetMaxOpenConns(conf.MaxOpenConnections)
-		sqlxDB.SetMaxIdleConns(conf.MaxIdleConnections)
-		sqlxDB.SetConnMaxLifetime(conf.ConnMaxLifetime)
-		return &db{sqlDB: sqlxDB}, sqlxDB.Close, nil
+		return sqlxDB, nil
 	}
 
-	return nil, nil, err
+	return nil, err
+}
+
+func configurePool(ctx context.Context, sqlxDB *sqlx.DB, maxOpenConnections, maxIdleConnections int, connMaxLifetime time.Duration) {
+	log.C(ctx).Infof("Configuring MaxOpenConnections: [%d], MaxIdleConnections: [%d], ConnectionMaxLifetime: [%s]", maxOpenConnections, maxIdleConnections, connMaxLifetime.String())
+	sqlxDB.SetMaxOpenConns(maxOpenConnections)
+	sqlxDB.SetMaxIdleConns(maxIdleConnections)
+	sqlxDB.SetConnMaxLifetime(connMaxLifetime)
 }
@@ -0,0 +1,56 @@
+package persistence
+
+import (
+	"context"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+type readOnlyCtxKey struct{}
+
+// SaveReadOnlyToContext marks the context as one in which only read operations are performed
+func SaveReadOnlyToContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, readOnlyCtxKey{}, true)
+}
+
+// IsReadOnlyFromCtx returns whether the context is marked as one in which only read operations are performed
+func IsReadOnlyFromCtx(ctx context.Context) bool {
+	readOnly, ok := ctx.Value(readOnlyCtxKey{}).(bool)
+	return ok && readOnly
+}
+
+// BeginForCtx starts a read-only transaction if the context is marked as read-only and a regular transaction otherwise.
+// It is meant for resolvers which are shared between GraphQL queries and mutations, e.g. field resolvers and dataloaders.
+func BeginForCtx(ctx context.Context, transact Transactioner) (PersistenceTx, error) {
+	if IsReadOnlyFromCtx(ctx) {
+		return transact.BeginReadOnly()
+	}
+	return transact.Begin()
+}
+
+// NewReadOnlyQueryInterceptor returns a GraphQL operation interceptor which marks the context of query operations as read-only
+func NewReadOnlyQueryInterceptor() *readOnlyQueryInterceptor {
+	return &readOnlyQueryInterceptor{}
+}
+
+type readOnlyQueryInterceptor struct{}
+
+// ExtensionName returns the name of the interceptor
+func (i *readOnlyQueryInterceptor) ExtensionName() string {
+	return "Read-Only Query Interceptor"
+}
+
+// Validate is a no-op as the interceptor does not depend on the schema
+func (i *readOnlyQueryInterceptor) Validate(_ graphql.ExecutableSchema) error {
+	return nil
+}
+
+// InterceptOperation marks the context as read-only if the operation is a query
+func (i *readOnlyQueryInterceptor) InterceptOperation(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+	if opCtx := graphql.GetOperationContext(ctx); opCtx != nil && opCtx.Operation != nil && opCtx.Operation.Operation == ast.Query {
+		ctx = SaveReadOnlyToContext(ctx)
+	}
+
+	return next(ctx)
+}
@@ -0,0 +1,89 @@
+package persistence
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+)
+
+// replicationLagQuery returns the replication lag of the replica in seconds. If the replica has replayed everything it has received, the lag is zero
+// regardless of the last replay timestamp, as the primary might simply have no writes.
+const replicationLagQuery = `SELECT CASE WHEN pg_last_wal_receive_lsn() = pg_last_wal_replay_lsn() THEN 0 ELSE COALESCE(EXTRACT(EPOCH FROM now() - pg_last_xact_replay_timestamp()), 0) END`
+
+type replica struct {
+	sqlDB *sqlx.DB
+
+	maxLag       time.Duration
+	checkCycle   time.Duration
+	checkTimeout time.Duration
+
+	// usable is accessed atomically as it is updated by the background lag check while transactions are being started
+	usable int32
+}
+
+func newReplica(sqlDB *sqlx.DB, cfg ReplicaConfig) *replica {
+	return &replica{
+		sqlDB:        sqlDB,
+		maxLag:       cfg.MaxReplicationLag,
+		checkCycle:   cfg.ReplicationLagCheckCycle,
+		checkTimeout: cfg.ReplicationLagCheckTimeout,
+	}
+}
+
+// isUsable returns the result of the last replication lag check. The replica is not usable until it is checked for the first time.
+func (r *replica) isUsable() bool {
+	return atomic.LoadInt32(&r.usable) == 1
+}
+
+// start checks the replication lag of the replica once and then keeps checking it every check cycle in the background until the context is done.
+func (r *replica) start(ctx context.Context) {
+	r.checkLag(ctx)
+
+	go func() {
+		ticker := time.NewTicker(r.checkCycle)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				log.C(ctx).Info("Stopping the replication lag check of the database replica")
+				return
+			case <-ticker.C:
+				r.checkLag(ctx)
+			}
+		}
+	}()
+}
+
+// checkLag checks whether the replica is reachable and its replication lag is within the configured limit, and stores the result.
+func (r *replica) checkLag(ctx context.Context) {
+	usable := r.queryLag(ctx)
+
+	var value int32
+	if usable {
+		value = 1
+	}
+	atomic.StoreInt32(&r.usable, value)
+}
+
+func (r *replica) queryLag(ctx context.Context) bool {
+	ctx, cancel := context.WithTimeout(ctx, r.checkTimeout)
+	defer cancel()
+
+	var lagSeconds float64
+	if err := r.sqlDB.GetContext(ctx, &lagSeconds, replicationLagQuery); err != nil {
+		log.C(ctx).WithError(err).Warn("Unable to determine the replication lag of the database replica, falling back to the primary")
+		return false
+	}
+
+	lag := time.Duration(lagSeconds * float64(time.Second))
+	if lag > r.maxLag {
+		log.C(ctx).Warnf("Replication lag of the database replica is %s which exceeds the allowed %s, falling back to the primary", lag.String(), r.maxLag.String())
+		return false
+	}
+
+	return true
+}
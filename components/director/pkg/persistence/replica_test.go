@@ -0,0 +1,146 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplica_IsUsable(t *testing.T) {
+	testErr := errors.New("test error")
+
+	testCases := []struct {
+		Name           string
+		MockFn         func(sqlMock sqlmock.Sqlmock)
+		ExpectedUsable bool
+	}{
+		{
+			Name: "Success when the replication lag is within the limit",
+			MockFn: func(sqlMock sqlmock.Sqlmock) {
+				sqlMock.ExpectQuery(regexp.QuoteMeta(replicationLagQuery)).WillReturnRows(sqlmock.NewRows([]string{"lag"}).AddRow(1.5))
+			},
+			ExpectedUsable: true,
+		},
+		{
+			Name: "Not usable when the replication lag exceeds the limit",
+			MockFn: func(sqlMock sqlmock.Sqlmock) {
+				sqlMock.ExpectQuery(regexp.QuoteMeta(replicationLagQuery)).WillReturnRows(sqlmock.NewRows([]string{"lag"}).AddRow(7.0))
+			},
+			ExpectedUsable: false,
+		},
+		{
+			Name: "Not usable when the replication lag cannot be determined",
+			MockFn: func(sqlMock sqlmock.Sqlmock) {
+				sqlMock.ExpectQuery(regexp.QuoteMeta(replicationLagQuery)).WillReturnError(testErr)
+			},
+			ExpectedUsable: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			sqlDB, sqlMock := newSQLXMock(t)
+			testCase.MockFn(sqlMock)
+
+			r := newReplica(sqlDB, ReplicaConfig{MaxReplicationLag: 5 * time.Second, ReplicationLagCheckCycle: time.Minute, ReplicationLagCheckTimeout: time.Second})
+			require.False(t, r.isUsable())
+
+			// WHEN
+			r.checkLag(context.TODO())
+
+			// THEN
+			assert.Equal(t, testCase.ExpectedUsable, r.isUsable())
+			require.NoError(t, sqlMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestReplica_Start(t *testing.T) {
+	t.Run("Success when the replication lag is checked again after the check cycle", func(t *testing.T) {
+		sqlDB, sqlMock := newSQLXMock(t)
+		sqlMock.ExpectQuery(regexp.QuoteMeta(replicationLagQuery)).WillReturnRows(sqlmock.NewRows([]string{"lag"}).AddRow(0))
+		sqlMock.ExpectQuery(regexp.QuoteMeta(replicationLagQuery)).WillReturnRows(sqlmock.NewRows([]string{"lag"}).AddRow(10))
+
+		r := newReplica(sqlDB, ReplicaConfig{MaxReplicationLag: time.Second, ReplicationLagCheckCycle: 10 * time.Millisecond, ReplicationLagCheckTimeout: time.Second})
+		ctx, cancel := context.WithCancel(context.TODO())
+		defer cancel()
+
+		// WHEN
+		r.start(ctx)
+
+		// THEN
+		assert.True(t, r.isUsable())
+		assert.Eventually(t, func() bool {
+			return !r.isUsable()
+		}, time.Second, 10*time.Millisecond)
+		cancel()
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+}
+
+func TestDB_BeginReadOnly(t *testing.T) {
+	t.Run("Success when the transaction is started on the replica", func(t *testing.T) {
+		primaryDB, primaryMock := newSQLXMock(t)
+		replicaDB, replicaMock := newSQLXMock(t)
+
+		replicaMock.ExpectQuery(regexp.QuoteMeta(replicationLagQuery)).WillReturnRows(sqlmock.NewRows([]string{"lag"}).AddRow(0))
+		replicaMock.ExpectBegin()
+
+		transact := &db{sqlDB: primaryDB, replica: newReplica(replicaDB, ReplicaConfig{MaxReplicationLag: time.Second, ReplicationLagCheckCycle: time.Minute, ReplicationLagCheckTimeout: time.Second})}
+		transact.replica.checkLag(context.TODO())
+
+		// WHEN
+		_, err := transact.BeginReadOnly()
+
+		// THEN
+		require.NoError(t, err)
+		require.NoError(t, primaryMock.ExpectationsWereMet())
+		require.NoError(t, replicaMock.ExpectationsWereMet())
+	})
+
+	t.Run("Success when the transaction falls back to the primary due to replication lag", func(t *testing.T) {
+		primaryDB, primaryMock := newSQLXMock(t)
+		replicaDB, replicaMock := newSQLXMock(t)
+
+		replicaMock.ExpectQuery(regexp.QuoteMeta(replicationLagQuery)).WillReturnRows(sqlmock.NewRows([]string{"lag"}).AddRow(10))
+		primaryMock.ExpectBegin()
+
+		transact := &db{sqlDB: primaryDB, replica: newReplica(replicaDB, ReplicaConfig{MaxReplicationLag: time.Second, ReplicationLagCheckCycle: time.Minute, ReplicationLagCheckTimeout: time.Second})}
+		transact.replica.checkLag(context.TODO())
+
+		// WHEN
+		_, err := transact.BeginReadOnly()
+
+		// THEN
+		require.NoError(t, err)
+		require.NoError(t, primaryMock.ExpectationsWereMet())
+		require.NoError(t, replicaMock.ExpectationsWereMet())
+	})
+
+	t.Run("Success when the transaction is started on the primary if no replica is configured", func(t *testing.T) {
+		primaryDB, primaryMock := newSQLXMock(t)
+		primaryMock.ExpectBegin()
+
+		transact := &db{sqlDB: primaryDB}
+
+		// WHEN
+		_, err := transact.BeginReadOnly()
+
+		// THEN
+		require.NoError(t, err)
+		require.NoError(t, primaryMock.ExpectationsWereMet())
+	})
+}
+
+func newSQLXMock(t *testing.T) (*sqlx.DB, sqlmock.Sqlmock) {
+	sqlDB, sqlMock, err := sqlmock.New()
+	require.NoError(t, err)
+	return sqlx.NewDb(sqlDB, "sqlmock"), sqlMock
+}
@@ -0,0 +1,65 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/tracing"
+	semconv "go.opentelemetry.io/otel/semconv/v1.12.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// GetContext executes the query within the transaction and records a span for it
+func (db *Transaction) GetContext(ctx context.Context, dest interface{}, query string, args ...interface{}) error {
+	ctx, span := startQuerySpan(ctx, query)
+	defer span.End()
+
+	err := db.Tx.GetContext(ctx, dest, query, args...)
+	if err != nil && err != sql.ErrNoRows {
+		tracing.RecordError(span, err)
+	}
+	return err
+}
+
+// SelectContext executes the query within the transaction and records a span for it
+func (db *Transaction) SelectContext(ctx context.Context, dest interface{}, query string, args ...interface{}) error {
+	ctx, span := startQuerySpan(ctx, query)
+	defer span.End()
+
+	err := db.Tx.SelectContext(ctx, dest, query, args...)
+	tracing.RecordError(span, err)
+	return err
+}
+
+// NamedExecContext executes the query within the transaction and records a span for it
+func (db *Transaction) NamedExecContext(ctx context.Context, query string, arg interface{}) (sql.Result, error) {
+	ctx, span := startQuerySpan(ctx, query)
+	defer span.End()
+
+	res, err := db.Tx.NamedExecContext(ctx, query, arg)
+	tracing.RecordError(span, err)
+	return res, err
+}
+
+// ExecContext executes the query within the transaction and records a span for it
+func (db *Transaction) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	ctx, span := startQuerySpan(ctx, query)
+	defer span.End()
+
+	res, err := db.Tx.ExecContext(ctx, query, args...)
+	tracing.RecordError(span, err)
+	return res, err
+}
+
+func startQuerySpan(ctx context.Context, query string) (context.Context, trace.Span) {
+	operation := "QUERY"
+	if fields := strings.Fields(query); len(fields) > 0 {
+		operation = strings.ToUpper(fields[0])
+	}
+
+	return tracing.StartSpan(ctx, "db "+operation,
+		semconv.DBSystemPostgreSQL,
+		semconv.DBOperationKey.String(operation),
+		semconv.DBStatementKey.String(query))
+}
@@ -53,6 +53,22 @@ func TransactionerThatDoesARollbackTwice(persistTx *automock.PersistenceTx) *aut
 	return transact
 }
 
+// ReadOnlyTransactionerThatSucceeds returns a transactioner mock which expects a read-only transaction to be started and committed
+func ReadOnlyTransactionerThatSucceeds(persistTx *automock.PersistenceTx) *automock.Transactioner {
+	transact := &automock.Transactioner{}
+	transact.On("BeginReadOnly").Return(persistTx, nil).Once()
+	transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Once()
+	return transact
+}
+
+// ReadOnlyTransactionerThatDoesARollback returns a transactioner mock which expects a read-only transaction to be started and rolled back
+func ReadOnlyTransactionerThatDoesARollback(persistTx *automock.PersistenceTx) *automock.Transactioner {
+	transact := &automock.Transactioner{}
+	transact.On("BeginReadOnly").Return(persistTx, nil).Once()
+	transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true).Once()
+	return transact
+}
+
 // NoopTransactioner missing godoc
 func NoopTransactioner(_ *automock.PersistenceTx) *automock.Transactioner {
 	return &automock.Transactioner{}
@@ -68,11 +84,17 @@ func CtxWithDBMatcher() interface{} {
 
 type txCtxGenerator struct {
 	returnedError error
+	beginMethod   string
 }
 
 // NewTransactionContextGenerator missing godoc
 func NewTransactionContextGenerator(potentialError error) *txCtxGenerator {
-	return &txCtxGenerator{returnedError: potentialError}
+	return &txCtxGenerator{returnedError: potentialError, beginMethod: "Begin"}
+}
+
+// NewReadOnlyTransactionContextGenerator returns a generator of transaction mocks which expect read-only transactions to be started
+func NewReadOnlyTransactionContextGenerator(potentialError error) *txCtxGenerator {
+	return &txCtxGenerator{returnedError: potentialError, beginMethod: "BeginReadOnly"}
 }
 
 // ThatSucceeds missing godoc
@@ -90,7 +112,7 @@ func (g txCtxGenerator) ThatSucceedsMultipleTimes(times int) (*automock.Persiste
 	persistTx.On("Commit").Return(nil).Times(times)
 
 	transact := &automock.Transactioner{}
-	transact.On("Begin").Return(persistTx, nil).Times(times)
+	transact.On(g.beginMethod).Return(persistTx, nil).Times(times)
 	transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(times)
 
 	return persistTx, transact
@@ -102,7 +124,7 @@ func (g txCtxGenerator) ThatSucceedsMultipleTimesAndCommitsMultipleTimes(begins,
 	persistTx.On("Commit").Return(nil).Times(commits)
 
 	transact := &automock.Transactioner{}
-	transact.On("Begin").Return(persistTx, nil).Times(begins)
+	transact.On(g.beginMethod).Return(persistTx, nil).Times(begins)
 	transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(begins)
 
 	return persistTx, transact
@@ -113,7 +135,7 @@ func (g txCtxGenerator) ThatDoesntExpectCommit() (*automock.PersistenceTx, *auto
 	persistTx := &automock.PersistenceTx{}
 
 	transact := &automock.Transactioner{}
-	transact.On("Begin").Return(persistTx, nil).Once()
+	transact.On(g.beginMethod).Return(persistTx, nil).Once()
 	transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true).Once()
 
 	return persistTx, transact
@@ -125,7 +147,7 @@ func (g txCtxGenerator) ThatFailsOnCommit() (*automock.PersistenceTx, *automock.
 	persistTx.On("Commit").Return(g.returnedError).Once()
 
 	transact := &automock.Transactioner{}
-	transact.On("Begin").Return(persistTx, nil).Once()
+	transact.On(g.beginMethod).Return(persistTx, nil).Once()
 	transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true).Once()
 
 	return persistTx, transact
@@ -136,7 +158,7 @@ func (g txCtxGenerator) ThatFailsOnBegin() (*automock.PersistenceTx, *automock.T
 	persistTx := &automock.PersistenceTx{}
 
 	transact := &automock.Transactioner{}
-	transact.On("Begin").Return(persistTx, g.returnedError).Once()
+	transact.On(g.beginMethod).Return(persistTx, g.returnedError).Once()
 
 	return persistTx, transact
 }
@@ -0,0 +1,46 @@
+package querycost
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+)
+
+// Config configures the cost and depth limits applied to the GraphQL operations
+type Config struct {
+	Enabled  bool `envconfig:"default=false,APP_QUERY_COST_LIMIT_ENABLED"`
+	MaxDepth int  `envconfig:"default=12,APP_QUERY_MAX_DEPTH"`
+	// DefaultBudget is the maximum cost of an operation for consumers without dedicated budget
+	DefaultBudget int `envconfig:"default=100000,APP_QUERY_COST_DEFAULT_BUDGET"`
+	// ConsumerBudgets is a JSON object mapping consumer types to their maximum operation cost, e.g. {"Runtime": 20000}
+	ConsumerBudgets string `envconfig:"optional,APP_QUERY_COST_CONSUMER_BUDGETS"`
+	// FieldWeights is a JSON object mapping fields in the "<Type>.<field>" format to their cost, e.g. {"APISpec.fetchRequest": 10}. Fields without weight cost 1
+	FieldWeights string `envconfig:"optional,APP_QUERY_COST_FIELD_WEIGHTS"`
+}
+
+func (c Config) parseConsumerBudgets() (map[string]int, error) {
+	return parseIntMap(c.ConsumerBudgets)
+}
+
+func (c Config) parseFieldWeights() (map[string]int, error) {
+	return parseIntMap(c.FieldWeights)
+}
+
+func parseIntMap(in string) (map[string]int, error) {
+	result := make(map[string]int)
+	if in == "" {
+		return result, nil
+	}
+
+	if err := json.Unmarshal([]byte(in), &result); err != nil {
+		return nil, errors.Wrapf(err, "while unmarshalling %q", in)
+	}
+
+	for k, v := range result {
+		if v < 0 {
+			return nil, errors.Errorf("value for %q can not be negative", k)
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,201 @@
+package querycost
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/99designs/gqlgen/complexity"
+	gqlgen "github.com/99designs/gqlgen/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/consumer"
+	"github.com/pkg/errors"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+const (
+	extensionName        = "Query Cost Limiter"
+	paginationArgument   = "first"
+	introspectionPrefix  = "__"
+	defaultFieldWeight   = 1
+	maxInt               = int(^uint(0) >> 1)
+	fieldWeightSeparator = "."
+)
+
+var _ interface {
+	gqlgen.OperationContextMutator
+	gqlgen.HandlerExtension
+} = &limiter{}
+
+type limiter struct {
+	maxDepth        int
+	defaultBudget   int
+	consumerBudgets map[string]int
+	fieldWeights    map[string]int
+	errorPresenter  gqlgen.ErrorPresenterFunc
+
+	schema gqlgen.ExecutableSchema
+}
+
+// NewLimiter creates a GraphQL handler extension which rejects operations exceeding the configured depth or the cost budget of the calling consumer.
+// The rejection errors are presented with the provided errorPresenter before execution.
+func NewLimiter(cfg Config, errorPresenter gqlgen.ErrorPresenterFunc) (*limiter, error) {
+	consumerBudgets, err := cfg.parseConsumerBudgets()
+	if err != nil {
+		return nil, errors.Wrap(err, "while parsing consumer budgets")
+	}
+
+	fieldWeights, err := cfg.parseFieldWeights()
+	if err != nil {
+		return nil, errors.Wrap(err, "while parsing field weights")
+	}
+
+	return &limiter{
+		maxDepth:        cfg.MaxDepth,
+		defaultBudget:   cfg.DefaultBudget,
+		consumerBudgets: consumerBudgets,
+		fieldWeights:    fieldWeights,
+		errorPresenter:  errorPresenter,
+	}, nil
+}
+
+// ExtensionName returns the name of the extension
+func (l *limiter) ExtensionName() string {
+	return extensionName
+}
+
+// Validate checks the configured field weights against the schema and stores it for the cost calculation
+func (l *limiter) Validate(schema gqlgen.ExecutableSchema) error {
+	for field := range l.fieldWeights {
+		parts := strings.Split(field, fieldWeightSeparator)
+		if len(parts) != 2 {
+			return errors.Errorf("field weight key %q is not in the <Type>.<field> format", field)
+		}
+
+		def, ok := schema.Schema().Types[parts[0]]
+		if !ok || def.Fields.ForName(parts[1]) == nil {
+			return errors.Errorf("field %q from field weights does not exist in the schema", field)
+		}
+	}
+
+	l.schema = &weightedSchema{
+		ExecutableSchema: schema,
+		fieldWeights:     l.fieldWeights,
+	}
+	return nil
+}
+
+// MutateOperationContext calculates the depth and the cost of the operation and rejects it if any of them exceeds the limits
+func (l *limiter) MutateOperationContext(ctx context.Context, rc *gqlgen.OperationContext) *gqlerror.Error {
+	op := rc.Doc.Operations.ForName(rc.OperationName)
+	if op == nil {
+		return nil
+	}
+
+	if depth := selectionSetDepth(op.SelectionSet); depth > l.maxDepth {
+		return l.errorPresenter(ctx, apperrors.NewQueryLimitExceededError("operation has depth %d, which exceeds the limit of %d", depth, l.maxDepth))
+	}
+
+	budget := l.budget(ctx)
+	if cost := complexity.Calculate(l.schema, op, rc.Variables); cost > budget {
+		return l.errorPresenter(ctx, apperrors.NewQueryLimitExceededError("operation has cost %d, which exceeds the budget of %d", cost, budget))
+	}
+
+	return nil
+}
+
+func (l *limiter) budget(ctx context.Context) int {
+	c, err := consumer.LoadFromContext(ctx)
+	if err != nil {
+		return l.defaultBudget
+	}
+
+	if budget, ok := l.consumerBudgets[string(c.ConsumerType)]; ok {
+		return budget
+	}
+
+	return l.defaultBudget
+}
+
+// weightedSchema overrides the field complexity of the executable schema with the configured field weights.
+// The cost of the nested selection is multiplied by the requested page size of paginated fields.
+type weightedSchema struct {
+	gqlgen.ExecutableSchema
+	fieldWeights map[string]int
+}
+
+// Complexity returns the cost of the given field
+func (s *weightedSchema) Complexity(typeName, field string, childComplexity int, args map[string]interface{}) (int, bool) {
+	weight, ok := s.fieldWeights[typeName+fieldWeightSeparator+field]
+	if !ok {
+		weight = defaultFieldWeight
+	}
+
+	return safeAdd(weight, safeMultiply(pageSize(args), childComplexity)), true
+}
+
+func pageSize(args map[string]interface{}) int {
+	var size int
+	switch first := args[paginationArgument].(type) {
+	case int:
+		size = first
+	case int64:
+		size = int(first)
+	case float64:
+		size = int(first)
+	case json.Number:
+		parsed, err := first.Int64()
+		if err != nil {
+			return 1
+		}
+		size = int(parsed)
+	}
+
+	if size < 1 {
+		return 1
+	}
+	return size
+}
+
+func selectionSetDepth(selectionSet ast.SelectionSet) int {
+	var depth int
+	for _, selection := range selectionSet {
+		var selectionDepth int
+		switch s := selection.(type) {
+		case *ast.Field:
+			if strings.HasPrefix(s.Name, introspectionPrefix) {
+				continue
+			}
+			selectionDepth = 1 + selectionSetDepth(s.SelectionSet)
+		case *ast.FragmentSpread:
+			if s.Definition != nil {
+				selectionDepth = selectionSetDepth(s.Definition.SelectionSet)
+			}
+		case *ast.InlineFragment:
+			selectionDepth = selectionSetDepth(s.SelectionSet)
+		}
+
+		if selectionDepth > depth {
+			depth = selectionDepth
+		}
+	}
+	return depth
+}
+
+func safeAdd(a, b int) int {
+	if c := a + b; c >= a {
+		return c
+	}
+	return maxInt
+}
+
+func safeMultiply(a, b int) int {
+	if a == 0 || b == 0 {
+		return 0
+	}
+	if c := a * b; c/b == a {
+		return c
+	}
+	return maxInt
+}
@@ -0,0 +1,168 @@
+package querycost_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	gqlgen "github.com/99designs/gqlgen/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/consumer"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/querycost"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+func TestLimiter_MutateOperationContext(t *testing.T) {
+	// GIVEN
+	// applications: 1 + 200 * (data: 1 + 1 * (id: 1)) = 401
+	defaultPageQuery := `{ applications { data { id } } }`
+	// applications: 1 + 10 * (data: 1 + 1 * (name: 10)) = 111
+	weightedQuery := `{ applications(first: 10) { data { name } } }`
+	// applications: 1 + 2 * (data: 1 + 1 * (id: 1)) = 5
+	variablesQuery := `query ($first: Int) { applications(first: $first) { data { id } } }`
+	fragmentQuery := `{ applications(first: 1) { ...page } } fragment page on ApplicationPage { data { bundles { data { id } } } }`
+
+	cfg := querycost.Config{
+		Enabled:         true,
+		MaxDepth:        10,
+		DefaultBudget:   401,
+		ConsumerBudgets: `{"Runtime": 400}`,
+		FieldWeights:    `{"Application.name": 10}`,
+	}
+
+	testCases := []struct {
+		Name           string
+		Config         querycost.Config
+		Query          string
+		Variables      map[string]interface{}
+		Consumer       *consumer.Consumer
+		ExpectedErrMsg string
+	}{
+		{
+			Name:   "Success when cost is within the default budget",
+			Config: cfg,
+			Query:  defaultPageQuery,
+		},
+		{
+			Name:           "Error when cost exceeds the budget of the consumer type",
+			Config:         cfg,
+			Query:          defaultPageQuery,
+			Consumer:       &consumer.Consumer{ConsumerType: consumer.Runtime},
+			ExpectedErrMsg: "operation has cost 401, which exceeds the budget of 400",
+		},
+		{
+			Name:     "Success when consumer type has no dedicated budget",
+			Config:   cfg,
+			Query:    defaultPageQuery,
+			Consumer: &consumer.Consumer{ConsumerType: consumer.Application},
+		},
+		{
+			Name: "Error when weighted field cost exceeds the budget",
+			Config: querycost.Config{
+				MaxDepth:      10,
+				DefaultBudget: 110,
+				FieldWeights:  cfg.FieldWeights,
+			},
+			Query:          weightedQuery,
+			ExpectedErrMsg: "operation has cost 111, which exceeds the budget of 110",
+		},
+		{
+			Name: "Success when page size is provided with variables",
+			Config: querycost.Config{
+				MaxDepth:      10,
+				DefaultBudget: 5,
+			},
+			Query:     variablesQuery,
+			Variables: map[string]interface{}{"first": json.Number("2")},
+		},
+		{
+			Name: "Error when depth exceeds the limit",
+			Config: querycost.Config{
+				MaxDepth:      4,
+				DefaultBudget: cfg.DefaultBudget,
+			},
+			Query:          fragmentQuery,
+			ExpectedErrMsg: "operation has depth 5, which exceeds the limit of 4",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			ctx := context.TODO()
+			if testCase.Consumer != nil {
+				ctx = consumer.SaveToContext(ctx, *testCase.Consumer)
+			}
+
+			limiter, err := querycost.NewLimiter(testCase.Config, fixErrorPresenter)
+			require.NoError(t, err)
+
+			schema := graphql.NewExecutableSchema(graphql.Config{})
+			require.NoError(t, limiter.Validate(schema))
+
+			doc, gqlErrs := gqlparser.LoadQuery(schema.Schema(), testCase.Query)
+			require.Empty(t, gqlErrs)
+
+			// WHEN
+			gqlErr := limiter.MutateOperationContext(ctx, &gqlgen.OperationContext{Doc: doc, Variables: testCase.Variables})
+
+			// THEN
+			if testCase.ExpectedErrMsg != "" {
+				require.NotNil(t, gqlErr)
+				assert.Contains(t, gqlErr.Message, testCase.ExpectedErrMsg)
+				assert.Equal(t, apperrors.QueryLimitExceeded, gqlErr.Extensions["error_code"])
+			} else {
+				assert.Nil(t, gqlErr)
+			}
+		})
+	}
+}
+
+func TestNewLimiter(t *testing.T) {
+	t.Run("Error when consumer budgets are not valid JSON", func(t *testing.T) {
+		_, err := querycost.NewLimiter(querycost.Config{ConsumerBudgets: "{"}, fixErrorPresenter)
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while parsing consumer budgets")
+	})
+
+	t.Run("Error when field weight is negative", func(t *testing.T) {
+		_, err := querycost.NewLimiter(querycost.Config{FieldWeights: `{"Application.name": -1}`}, fixErrorPresenter)
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "can not be negative")
+	})
+}
+
+func TestLimiter_Validate(t *testing.T) {
+	t.Run("Error when weighted field does not exist in the schema", func(t *testing.T) {
+		limiter, err := querycost.NewLimiter(querycost.Config{FieldWeights: `{"Application.unknown": 1}`}, fixErrorPresenter)
+		require.NoError(t, err)
+
+		err = limiter.Validate(graphql.NewExecutableSchema(graphql.Config{}))
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `field "Application.unknown" from field weights does not exist in the schema`)
+	})
+
+	t.Run("Error when weighted field is not in the expected format", func(t *testing.T) {
+		limiter, err := querycost.NewLimiter(querycost.Config{FieldWeights: `{"name": 1}`}, fixErrorPresenter)
+		require.NoError(t, err)
+
+		err = limiter.Validate(graphql.NewExecutableSchema(graphql.Config{}))
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "is not in the <Type>.<field> format")
+	})
+}
+
+func fixErrorPresenter(_ context.Context, err error) *gqlerror.Error {
+	errCode := apperrors.ErrorCode(err)
+	return &gqlerror.Error{
+		Message:    err.Error(),
+		Extensions: map[string]interface{}{"error_code": errCode, "error": errCode.String()},
+	}
+}
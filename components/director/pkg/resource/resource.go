@@ -76,6 +76,8 @@ const (
 	FormationTemplate Type = "formationTemplate"
 	// FormationAssignment type represents formation assignment resource.
 	FormationAssignment Type = "formationAssignment"
+	// FormationAssignmentTransition type represents formation assignment transition resource.
+	FormationAssignmentTransition Type = "formationAssignmentTransition"
 	// FormationConstraint type represents formation constraint resource.
 	FormationConstraint Type = "formationConstraint"
 	// FormationTemplateConstraintReference type represents formationTemplate-constraint reference resource.
@@ -100,6 +102,20 @@ const (
 	Schema Type = "schemaMigration"
 	// SystemsSync type represents systems synchronization timestamps resource
 	SystemsSync Type = "systemsSync"
+	// HealthCheck type represents health check resource.
+	HealthCheck Type = "healthCheck"
+	// OutboxEntry type represents notifications outbox entry resource.
+	OutboxEntry Type = "outboxEntry"
+	// Credentials type represents credentials stored in any of the resources holding an auth.
+	Credentials Type = "credentials"
+	// FetchValidator type represents fetch validator resource.
+	FetchValidator Type = "fetchValidator"
+	// EntityType type represents ORD entity type resource.
+	EntityType Type = "entityType"
+	// Capability type represents ORD capability resource.
+	Capability Type = "capability"
+	// IntegrationDependency type represents ORD integration dependency resource.
+	IntegrationDependency Type = "integrationDependency"
 )
 
 var ignoredTenantAccessTable = map[Type]string{
@@ -137,6 +153,10 @@ var tenantAccessTable = map[Type]string{
 	AppWebhook:               "application_webhooks_tenants",
 	RuntimeWebhook:           "runtime_webhooks_tenants",
 	FormationTemplateWebhook: "formation_templates_webhooks_tenants",
+	HealthCheck:              "health_checks_tenants",
+	EntityType:               "entity_types_tenants",
+	Capability:               "capabilities_tenants",
+	IntegrationDependency:    "integration_dependencies_tenants",
 }
 
 var tablesWithEmbeddedTenant = map[Type]string{
@@ -0,0 +1,26 @@
+package signature
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+)
+
+const (
+	// Header is the header containing the timestamped HMAC signatures of the outgoing webhook requests.
+	// Its value has the format "t=<unix timestamp>,v1=<signature>[,v1=<signature>]", with one signature for each active secret.
+	Header = "X-Compass-Signature"
+	// TimestampKey is the key of the signing timestamp in the Header
+	TimestampKey = "t"
+	// V1Key is the key of each signature computed with Compute in the Header
+	V1Key = "v1"
+)
+
+// Compute returns the hex encoded HMAC-SHA256 of the timestamp, the HTTP method, the request URI (path and query) and the body, separated by new lines
+func Compute(secret string, timestamp int64, method, requestURI string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10) + "\n" + method + "\n" + requestURI + "\n"))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,27 @@
+package signature_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/signature"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompute(t *testing.T) {
+	// GIVEN
+	secret := "0123456789abcdef0123456789abcdef"
+	body := []byte(`{"key":"value"}`)
+
+	// WHEN
+	sig := signature.Compute(secret, 1700000000, http.MethodPatch, "/path?mode=sync", body)
+
+	// THEN
+	require.Len(t, sig, 64)
+	require.Equal(t, sig, signature.Compute(secret, 1700000000, http.MethodPatch, "/path?mode=sync", body))
+	require.NotEqual(t, sig, signature.Compute(secret, 1700000001, http.MethodPatch, "/path?mode=sync", body))
+	require.NotEqual(t, sig, signature.Compute(secret, 1700000000, http.MethodPost, "/path?mode=sync", body))
+	require.NotEqual(t, sig, signature.Compute(secret, 1700000000, http.MethodPatch, "/path", body))
+	require.NotEqual(t, sig, signature.Compute(secret, 1700000000, http.MethodPatch, "/path?mode=sync", []byte(`{}`)))
+	require.NotEqual(t, sig, signature.Compute("fedcba9876543210fedcba9876543210", 1700000000, http.MethodPatch, "/path?mode=sync", body))
+}
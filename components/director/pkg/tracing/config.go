@@ -0,0 +1,51 @@
+package tracing
+
+import (
+	"fmt"
+)
+
+const (
+	// OTLPExporter exports the spans to an OpenTelemetry collector using the OTLP/HTTP protocol
+	OTLPExporter = "otlp"
+	// StdoutExporter writes the spans to the standard output
+	StdoutExporter = "stdout"
+	// FileExporter writes the spans to a local file
+	FileExporter = "file"
+)
+
+// Config configures the tracing of a component
+type Config struct {
+	Enabled       bool    `envconfig:"default=false,APP_TRACING_ENABLED"`
+	Exporter      string  `envconfig:"default=otlp,APP_TRACING_EXPORTER"`
+	OTLPEndpoint  string  `envconfig:"default=localhost:4318,APP_TRACING_OTLP_ENDPOINT"`
+	OTLPInsecure  bool    `envconfig:"default=true,APP_TRACING_OTLP_INSECURE"`
+	FilePath      string  `envconfig:"optional,APP_TRACING_FILE_PATH"`
+	SamplingRatio float64 `envconfig:"default=1,APP_TRACING_SAMPLING_RATIO"`
+}
+
+// Validate validates the tracing settings
+func (c *Config) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	switch c.Exporter {
+	case OTLPExporter:
+		if len(c.OTLPEndpoint) == 0 {
+			return fmt.Errorf("validate Config: OTLP endpoint missing")
+		}
+	case FileExporter:
+		if len(c.FilePath) == 0 {
+			return fmt.Errorf("validate Config: file path missing for the %s exporter", FileExporter)
+		}
+	case StdoutExporter:
+	default:
+		return fmt.Errorf("validate Config: exporter %s is invalid", c.Exporter)
+	}
+
+	if c.SamplingRatio < 0 || c.SamplingRatio > 1 {
+		return fmt.Errorf("validate Config: sampling ratio %v must be between 0 and 1", c.SamplingRatio)
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package tracing_test
+
+import (
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/tracing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfig_Validate(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Config        tracing.Config
+		ExpectedError string
+	}{
+		{
+			Name:   "Success when tracing is disabled",
+			Config: tracing.Config{Enabled: false, Exporter: "invalid"},
+		},
+		{
+			Name:   "Success for OTLP exporter",
+			Config: tracing.Config{Enabled: true, Exporter: tracing.OTLPExporter, OTLPEndpoint: "localhost:4318", SamplingRatio: 1},
+		},
+		{
+			Name:   "Success for stdout exporter",
+			Config: tracing.Config{Enabled: true, Exporter: tracing.StdoutExporter, SamplingRatio: 0.5},
+		},
+		{
+			Name:          "Error when OTLP endpoint is missing",
+			Config:        tracing.Config{Enabled: true, Exporter: tracing.OTLPExporter, SamplingRatio: 1},
+			ExpectedError: "OTLP endpoint missing",
+		},
+		{
+			Name:          "Error when file path is missing for the file exporter",
+			Config:        tracing.Config{Enabled: true, Exporter: tracing.FileExporter, SamplingRatio: 1},
+			ExpectedError: "file path missing",
+		},
+		{
+			Name:          "Error when exporter is unknown",
+			Config:        tracing.Config{Enabled: true, Exporter: "zipkin", SamplingRatio: 1},
+			ExpectedError: "exporter zipkin is invalid",
+		},
+		{
+			Name:          "Error when sampling ratio is out of range",
+			Config:        tracing.Config{Enabled: true, Exporter: tracing.StdoutExporter, SamplingRatio: 2},
+			ExpectedError: "sampling ratio 2 must be between 0 and 1",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			err := testCase.Config.Validate()
+
+			if testCase.ExpectedError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedError)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/99designs/gqlgen/graphql"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+const (
+	attributeGraphQLOperationType = attribute.Key("graphql.operation.type")
+	attributeGraphQLOperationName = attribute.Key("graphql.operation.name")
+	attributeGraphQLField         = attribute.Key("graphql.field")
+)
+
+// NewGqlTracingInterceptor returns a GraphQL interceptor which records a span for each operation and each resolver call
+func NewGqlTracingInterceptor() *gqlInterceptor {
+	return &gqlInterceptor{}
+}
+
+type gqlInterceptor struct{}
+
+// ExtensionName returns the name of the interceptor
+func (i *gqlInterceptor) ExtensionName() string {
+	return "Tracing Interceptor"
+}
+
+// Validate is a no-op as the interceptor does not depend on the schema
+func (i *gqlInterceptor) Validate(_ graphql.ExecutableSchema) error {
+	return nil
+}
+
+// InterceptOperation records a span which lasts until the response of the operation is produced
+func (i *gqlInterceptor) InterceptOperation(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+	opCtx := graphql.GetOperationContext(ctx)
+	if opCtx == nil || opCtx.Operation == nil {
+		return next(ctx)
+	}
+
+	ctx, span := StartSpan(ctx, fmt.Sprintf("graphql %s %s", opCtx.Operation.Operation, opCtx.OperationName),
+		attributeGraphQLOperationType.String(string(opCtx.Operation.Operation)),
+		attributeGraphQLOperationName.String(opCtx.OperationName))
+
+	responseHandler := next(ctx)
+
+	return func(ctx context.Context) *graphql.Response {
+		defer span.End()
+
+		resp := responseHandler(ctx)
+		if resp != nil && len(resp.Errors) > 0 {
+			span.SetStatus(codes.Error, resp.Errors.Error())
+		}
+		return resp
+	}
+}
+
+// InterceptField records a span for fields which are resolved by a resolver method
+func (i *gqlInterceptor) InterceptField(ctx context.Context, next graphql.Resolver) (interface{}, error) {
+	fieldCtx := graphql.GetFieldContext(ctx)
+	if fieldCtx == nil || !fieldCtx.IsMethod {
+		return next(ctx)
+	}
+
+	fieldName := fmt.Sprintf("%s.%s", fieldCtx.Object, fieldCtx.Field.Name)
+	ctx, span := StartSpan(ctx, "graphql resolve "+fieldName, attributeGraphQLField.String(fieldName))
+	defer span.End()
+
+	res, err := next(ctx)
+	RecordError(span, err)
+
+	return res, err
+}
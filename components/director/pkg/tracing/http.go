@@ -0,0 +1,88 @@
+package tracing
+
+import (
+	"net/http"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.12.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// NewHTTPMiddleware returns middleware that continues the trace of the incoming request, if any, and records a server span for it
+func NewHTTPMiddleware(serverName string) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+			ctx := otel.GetTextMapPropagator().Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+
+			ctx, span := otel.Tracer(instrumentationName).Start(ctx, serverName+" "+r.Method,
+				trace.WithSpanKind(trace.SpanKindServer),
+				trace.WithAttributes(semconv.HTTPServerAttributesFromHTTPRequest(serverName, "", r)...))
+			defer span.End()
+
+			srw := newStatusResponseWriter(rw)
+			next.ServeHTTP(srw, r.WithContext(ctx))
+
+			span.SetAttributes(semconv.HTTPAttributesFromHTTPStatusCode(srw.statusCode)...)
+			span.SetStatus(semconv.SpanStatusFromHTTPStatusCodeAndSpanKind(srw.statusCode, trace.SpanKindServer))
+		})
+	}
+}
+
+// InjectHeaders writes the trace context of the request context to the request headers
+func InjectHeaders(r *http.Request) {
+	otel.GetTextMapPropagator().Inject(r.Context(), propagation.HeaderCarrier(r.Header))
+}
+
+// NewTransport returns a transport which records a client span for each outgoing request and forwards the trace context to the called component
+func NewTransport(roundTripper http.RoundTripper) http.RoundTripper {
+	return &transport{roundTripper: roundTripper}
+}
+
+type transport struct {
+	roundTripper http.RoundTripper
+}
+
+// RoundTrip executes the request as part of a client span
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx, span := otel.Tracer(instrumentationName).Start(req.Context(), "HTTP "+req.Method,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(semconv.HTTPClientAttributesFromHTTPRequest(req)...))
+	defer span.End()
+
+	req = req.Clone(ctx)
+	InjectHeaders(req)
+
+	resp, err := t.roundTripper.RoundTrip(req)
+	if err != nil {
+		RecordError(span, err)
+		return resp, err
+	}
+
+	span.SetAttributes(semconv.HTTPAttributesFromHTTPStatusCode(resp.StatusCode)...)
+	span.SetStatus(semconv.SpanStatusFromHTTPStatusCodeAndSpanKind(resp.StatusCode, trace.SpanKindClient))
+
+	return resp, nil
+}
+
+type statusResponseWriter struct {
+	http.ResponseWriter
+	statusCode  int
+	wroteHeader bool
+}
+
+func newStatusResponseWriter(w http.ResponseWriter) *statusResponseWriter {
+	return &statusResponseWriter{
+		ResponseWriter: w,
+		statusCode:     http.StatusOK,
+	}
+}
+
+// WriteHeader records the status code of the response
+func (w *statusResponseWriter) WriteHeader(code int) {
+	if !w.wroteHeader {
+		w.statusCode = code
+		w.ResponseWriter.WriteHeader(code)
+		w.wroteHeader = true
+	}
+}
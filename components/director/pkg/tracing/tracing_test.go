@@ -0,0 +1,140 @@
+package tracing_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/tracing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	traceID     = "4bf92f3577b34da6a3ce929d0e0e4736"
+	traceParent = "00-" + traceID + "-00f067aa0ba902b7-01"
+)
+
+func TestConfigure(t *testing.T) {
+	t.Run("exports spans to a file", func(t *testing.T) {
+		filePath := filepath.Join(t.TempDir(), "spans.json")
+		cfg := &tracing.Config{Enabled: true, Exporter: tracing.FileExporter, FilePath: filePath, SamplingRatio: 1}
+
+		shutdown, err := tracing.Configure(context.TODO(), cfg, "test-service")
+		require.NoError(t, err)
+
+		_, span := tracing.StartSpan(context.TODO(), "test-span")
+		span.End()
+
+		require.NoError(t, shutdown(context.TODO()))
+
+		content, err := os.ReadFile(filePath)
+		require.NoError(t, err)
+		assert.Contains(t, string(content), `"Name":"test-span"`)
+		assert.Contains(t, string(content), "test-service")
+	})
+
+	t.Run("returns error when the configuration is invalid", func(t *testing.T) {
+		cfg := &tracing.Config{Enabled: true, Exporter: tracing.FileExporter, SamplingRatio: 1}
+
+		_, err := tracing.Configure(context.TODO(), cfg, "test-service")
+		require.Error(t, err)
+	})
+
+	t.Run("does not export spans when tracing is disabled", func(t *testing.T) {
+		shutdown, err := tracing.Configure(context.TODO(), &tracing.Config{Enabled: false}, "test-service")
+		require.NoError(t, err)
+		require.NoError(t, shutdown(context.TODO()))
+	})
+}
+
+func TestRecordError(t *testing.T) {
+	recorder := setupSpanRecorder(t)
+
+	_, span := tracing.StartSpan(context.TODO(), "failing-span")
+	tracing.RecordError(span, errors.New("test error"))
+	span.End()
+
+	ended := recorder.Ended()
+	require.Len(t, ended, 1)
+	assert.Equal(t, codes.Error, ended[0].Status().Code)
+	assert.Equal(t, "test error", ended[0].Status().Description)
+}
+
+func TestNewHTTPMiddleware(t *testing.T) {
+	recorder := setupSpanRecorder(t)
+
+	var spanCtx trace.SpanContext
+	handler := tracing.NewHTTPMiddleware("test-server")(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		spanCtx = trace.SpanContextFromContext(r.Context())
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/graphql", nil)
+	req.Header.Set("traceparent", traceParent)
+
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	assert.Equal(t, traceID, spanCtx.TraceID().String())
+
+	ended := recorder.Ended()
+	require.Len(t, ended, 1)
+	assert.Equal(t, "test-server GET", ended[0].Name())
+	assert.Equal(t, trace.SpanKindServer, ended[0].SpanKind())
+	assert.Equal(t, traceID, ended[0].Parent().TraceID().String())
+	assert.Equal(t, codes.Error, ended[0].Status().Code)
+}
+
+func TestNewTransport(t *testing.T) {
+	recorder := setupSpanRecorder(t)
+
+	var forwardedTraceParent string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		forwardedTraceParent = r.Header.Get("traceparent")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	ctx, parent := tracing.StartSpan(context.TODO(), "parent-span")
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, server.URL, nil)
+	require.NoError(t, err)
+
+	resp, err := tracing.NewTransport(http.DefaultTransport).RoundTrip(req)
+	parent.End()
+
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+
+	ended := recorder.Ended()
+	require.Len(t, ended, 2)
+	assert.Equal(t, "HTTP POST", ended[0].Name())
+	assert.Equal(t, trace.SpanKindClient, ended[0].SpanKind())
+	assert.Equal(t, parent.SpanContext().SpanID(), ended[0].Parent().SpanID())
+	assert.Contains(t, forwardedTraceParent, parent.SpanContext().TraceID().String())
+	assert.Contains(t, forwardedTraceParent, ended[0].SpanContext().SpanID().String())
+}
+
+func setupSpanRecorder(t *testing.T) *tracetest.SpanRecorder {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	_, err := tracing.Configure(context.TODO(), &tracing.Config{Enabled: false}, "test-service")
+	require.NoError(t, err)
+
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(provider)
+	t.Cleanup(func() {
+		otel.SetTracerProvider(previous)
+	})
+
+	return recorder
+}
@@ -23,10 +23,14 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"time"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/tracing"
 	"github.com/kyma-incubator/compass/components/director/pkg/webhook"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/str"
 
@@ -55,7 +59,29 @@ func NewClient(httpClient *http.Client, mtlsClient, extSvcMtlsClient *http.Clien
 	}
 }
 
+// Do executes the webhook and records a span for it
 func (c *client) Do(ctx context.Context, request WebhookRequest) (*webhook.Response, error) {
+	ctx, span := startWebhookSpan(ctx, "webhook execute", request.GetWebhook())
+	defer span.End()
+
+	response, err := c.do(ctx, request)
+	tracing.RecordError(span, err)
+
+	return response, err
+}
+
+// Poll polls the status of an async webhook and records a span for it
+func (c *client) Poll(ctx context.Context, request *PollRequest) (*webhook.ResponseStatus, error) {
+	ctx, span := startWebhookSpan(ctx, "webhook poll", request.Webhook)
+	defer span.End()
+
+	response, err := c.poll(ctx, request)
+	tracing.RecordError(span, err)
+
+	return response, err
+}
+
+func (c *client) do(ctx context.Context, request WebhookRequest) (*webhook.Response, error) {
 	var err error
 	webhook := request.GetWebhook()
 
@@ -141,7 +167,7 @@ func (c *client) Do(ctx context.Context, request WebhookRequest) (*webhook.Respo
 	return response, checkForErr(resp, response.SuccessStatusCode, response.IncompleteStatusCode, response.Error)
 }
 
-func (c *client) Poll(ctx context.Context, request *PollRequest) (*webhook.ResponseStatus, error) {
+func (c *client) poll(ctx context.Context, request *PollRequest) (*webhook.ResponseStatus, error) {
 	var err error
 	webhook := request.Webhook
 
@@ -193,6 +219,8 @@ func (c *client) Poll(ctx context.Context, request *PollRequest) (*webhook.Respo
 }
 
 func (c *client) executeRequestWithCorrectClient(ctx context.Context, req *http.Request, webhook graphql.Webhook) (*http.Response, error) {
+	tracing.InjectHeaders(req)
+
 	if webhook.Auth != nil {
 		log.C(ctx).Infof("Authentication configuration is available in the webhook with ID: %q", webhook.ID)
 		if str.PtrStrToStr(webhook.Auth.AccessStrategy) == string(accessstrategy.CMPmTLSAccessStrategy) {
@@ -205,6 +233,12 @@ func (c *client) executeRequestWithCorrectClient(ctx context.Context, req *http.
 		} else if str.PtrStrToStr(webhook.Auth.AccessStrategy) == string(accessstrategy.OpenAccessStrategy) {
 			log.C(ctx).Infof("Access strategy: %q is used in the webhook authentication configuration", accessstrategy.OpenAccessStrategy)
 			return c.httpClient.Do(req)
+		} else if hmacCredential, ok := webhook.Auth.Credential.(graphql.HMACCredentialData); ok {
+			log.C(ctx).Info("HMAC credentials are used for signing the webhook request")
+			if err := signRequest(req, time.Now(), hmacCredential.PrimarySecret, str.PtrStrToStr(hmacCredential.SecondarySecret)); err != nil {
+				return nil, errors.Wrap(err, "while signing webhook request")
+			}
+			return c.httpClient.Do(req)
 		} else if webhook.Auth.Credential != nil {
 			log.C(ctx).Info("Credentials data is used in the webhook authentication configuration")
 			ctx = saveToContext(ctx, webhook.Auth.Credential)
@@ -219,6 +253,12 @@ func (c *client) executeRequestWithCorrectClient(ctx context.Context, req *http.
 	}
 }
 
+func startWebhookSpan(ctx context.Context, name string, webhook graphql.Webhook) (context.Context, trace.Span) {
+	return tracing.StartSpan(ctx, name,
+		attribute.String("webhook.id", webhook.ID),
+		attribute.String("webhook.type", string(webhook.Type)))
+}
+
 func parseResponseObject(resp *http.Response) (*webhook.ResponseObject, error) {
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
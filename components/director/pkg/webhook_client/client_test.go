@@ -23,11 +23,14 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strconv"
+	"strings"
 	"testing"
 
 	webhookclient "github.com/kyma-incubator/compass/components/director/pkg/webhook_client"
 
 	accessstrategy2 "github.com/kyma-incubator/compass/components/director/pkg/accessstrategy"
+	"github.com/kyma-incubator/compass/components/director/pkg/signature"
 	"github.com/kyma-incubator/compass/components/director/pkg/str"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/auth"
@@ -580,6 +583,63 @@ func TestClient_Do_WhenSuccessfulOpenStrategyWebhook_ShouldBeSuccessful(t *testi
 	require.Equal(t, http.StatusAccepted, *resp.ActualStatusCode)
 }
 
+func TestClient_Do_WhenSuccessfulHMACWebhook_ShouldSignRequest(t *testing.T) {
+	URLTemplate := "{\"method\": \"PATCH\",\"path\":\"https://test-domain.com/api/v1/applications/{{.Application.ID}}?mode=sync\"}"
+	inputTemplate := "{\"application_id\": \"{{.Application.ID}}\"}"
+	outputTemplate := "{\"location\":\"{{.Headers.Location}}\",\"success_status_code\": 202,\"incomplete_status_code\": 204,\"error\": \"{{.Body.error}}\"}"
+	primarySecret := "primary-secret"
+	secondarySecret := "secondary-secret"
+	signatureVerified := false
+	app := &graphql.Application{BaseEntity: &graphql.BaseEntity{ID: "appID"}}
+	webhookReq := &webhookclient.Request{
+		Webhook: graphql.Webhook{
+			URLTemplate:    &URLTemplate,
+			InputTemplate:  &inputTemplate,
+			OutputTemplate: &outputTemplate,
+			Mode:           &webhookAsyncMode,
+			Auth: &graphql.Auth{
+				Credential: graphql.HMACCredentialData{
+					PrimarySecret:   primarySecret,
+					SecondarySecret: &secondarySecret,
+				},
+			},
+		},
+		Object: &webhook.ApplicationLifecycleWebhookRequestObject{Application: app},
+	}
+
+	httpClient := &http.Client{
+		Transport: mockedTransport{
+			resp: &http.Response{
+				Body:       io.NopCloser(bytes.NewReader([]byte("{}"))),
+				Header:     http.Header{"Location": []string{mockedLocationURL}},
+				StatusCode: http.StatusAccepted,
+			},
+			roundTripExpectations: func(r *http.Request) {
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+
+				parts := strings.Split(r.Header.Get(signature.Header), ",")
+				require.Len(t, parts, 3)
+				require.True(t, strings.HasPrefix(parts[0], "t="))
+				timestamp, err := strconv.ParseInt(strings.TrimPrefix(parts[0], "t="), 10, 64)
+				require.NoError(t, err)
+
+				require.Equal(t, "v1="+signature.Compute(primarySecret, timestamp, http.MethodPatch, "/api/v1/applications/appID?mode=sync", body), parts[1])
+				require.Equal(t, "v1="+signature.Compute(secondarySecret, timestamp, http.MethodPatch, "/api/v1/applications/appID?mode=sync", body), parts[2])
+				signatureVerified = true
+			},
+		},
+	}
+
+	client := webhookclient.NewClient(httpClient, nil, nil)
+
+	resp, err := client.Do(context.Background(), webhookReq)
+
+	require.NoError(t, err)
+	require.True(t, signatureVerified)
+	require.Equal(t, http.StatusAccepted, *resp.ActualStatusCode)
+}
+
 func TestClient_Do_WhenMissingCorrelationID_ShouldBeSuccessful(t *testing.T) {
 	URLTemplate := "{\"method\": \"DELETE\",\"path\":\"https://test-domain.com/api/v1/applications/{{.Application.ID}}\"}"
 	inputTemplate := "{\"application_id\": \"{{.Application.ID}}\",\"name\": \"{{.Application.Name}}\"}"
@@ -659,16 +719,15 @@ func TestClient_Poll_WhenCreatingRequestFails_ShouldReturnError(t *testing.T) {
 			},
 			Object: &webhook.ApplicationLifecycleWebhookRequestObject{Application: app},
 		},
-		PollURL: mockedLocationURL,
+		PollURL: "https://test-domain.com/operation\x7f",
 	}
 
 	client := webhookclient.NewClient(http.DefaultClient, nil, nil)
-	var ctx context.Context
 
-	_, err := client.Poll(ctx, webhookReq)
+	_, err := client.Poll(context.Background(), webhookReq)
 
 	require.Error(t, err)
-	require.Contains(t, err.Error(), "nil Context")
+	require.Contains(t, err.Error(), "invalid control character in URL")
 }
 
 func TestClient_Poll_WhenAuthFlowCannotBeDetermined_ShouldReturnError(t *testing.T) {
@@ -0,0 +1,56 @@
+package webhookclient
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/signature"
+	"github.com/pkg/errors"
+)
+
+// signRequest adds the signature.Header to the request, computed with each of the non-empty secrets
+func signRequest(req *http.Request, timestamp time.Time, secrets ...string) error {
+	body, err := readRequestBody(req)
+	if err != nil {
+		return errors.Wrap(err, "while reading request body for signing")
+	}
+
+	unixTimestamp := timestamp.Unix()
+	parts := []string{fmt.Sprintf("%s=%d", signature.TimestampKey, unixTimestamp)}
+	for _, secret := range secrets {
+		if secret == "" {
+			continue
+		}
+		parts = append(parts, fmt.Sprintf("%s=%s", signature.V1Key, signature.Compute(secret, unixTimestamp, req.Method, req.URL.RequestURI(), body)))
+	}
+
+	if len(parts) == 1 {
+		return errors.New("no signing secret provided")
+	}
+
+	if req.Header == nil {
+		req.Header = http.Header{}
+	}
+	req.Header.Set(signature.Header, strings.Join(parts, ","))
+
+	return nil
+}
+
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.GetBody == nil {
+		return []byte{}, nil
+	}
+
+	bodyReader, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = bodyReader.Close()
+	}()
+
+	return io.ReadAll(bodyReader)
+}
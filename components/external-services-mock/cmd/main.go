@@ -343,6 +343,8 @@ func initDefaultCertServer(cfg config, key *rsa.PrivateKey, staticMappingClaims
 	// formation assignment notifications sync handlers
 	router.HandleFunc("/formation-callback/{tenantId}", notificationHandler.Patch).Methods(http.MethodPatch)
 	router.HandleFunc("/formation-callback/{tenantId}/{applicationId}", notificationHandler.Delete).Methods(http.MethodDelete)
+	router.HandleFunc("/formation-callback/signed/{tenantId}", notificationHandler.SignedPatch).Methods(http.MethodPatch)
+	router.HandleFunc("/formation-callback/signed/{tenantId}/{applicationId}", notificationHandler.SignedDelete).Methods(http.MethodDelete)
 	router.HandleFunc("/formation-callback/configuration/{tenantId}", notificationHandler.RespondWithIncomplete).Methods(http.MethodPatch)
 	router.HandleFunc("/formation-callback/configuration/{tenantId}/{applicationId}", notificationHandler.Delete).Methods(http.MethodDelete)
 	router.HandleFunc("/formation-callback/with-state/{tenantId}", notificationHandler.PatchWithState).Methods(http.MethodPatch)
@@ -1,7 +1,11 @@
 package formationnotification_test
 
 import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
+	"strconv"
 
 	"github.com/kyma-incubator/compass/components/external-services-mock/internal/formationnotification"
 )
@@ -41,3 +45,9 @@ func fixMappings(operation formationnotification.Operation, objectID, reqBody st
 		},
 	}
 }
+
+func fixSignature(secret string, timestamp int64, method, requestURI, body string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10) + "\n" + method + "\n" + requestURI + "\n" + body))
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -58,6 +58,8 @@ type Configuration struct {
 	DirectorExternalCertFAAsyncStatusURL        string `envconfig:"APP_DIRECTOR_EXTERNAL_CERT_FORMATION_ASSIGNMENT_ASYNC_STATUS_URL"`
 	DirectorExternalCertFormationAsyncStatusURL string `envconfig:"APP_DIRECTOR_EXTERNAL_CERT_FORMATION_ASYNC_STATUS_URL"`
 	FormationMappingAsyncResponseDelay          int64  `envconfig:"APP_FORMATION_MAPPING_ASYNC_RESPONSE_DELAY"`
+	// SigningSecrets are the HMAC secrets with which the signed notification requests are verified
+	SigningSecrets []string `envconfig:"optional,APP_FORMATION_NOTIFICATION_SIGNING_SECRETS"`
 }
 
 // FormationAssignmentRequestBody contains the request input of the formation assignment async status request
@@ -279,6 +281,39 @@ func (h *Handler) Delete(writer http.ResponseWriter, r *http.Request) {
 	h.syncFAResponse(ctx, writer, r, responseFunc)
 }
 
+// SignedPatch verifies the HMAC signature of the formation assignment notification request for Assign operation before handling it as Patch
+func (h *Handler) SignedPatch(writer http.ResponseWriter, r *http.Request) {
+	if h.verifySignedRequest(writer, r) {
+		h.Patch(writer, r)
+	}
+}
+
+// SignedDelete verifies the HMAC signature of the formation assignment notification request for Unassign operation before handling it as Delete
+func (h *Handler) SignedDelete(writer http.ResponseWriter, r *http.Request) {
+	if h.verifySignedRequest(writer, r) {
+		h.Delete(writer, r)
+	}
+}
+
+func (h *Handler) verifySignedRequest(writer http.ResponseWriter, r *http.Request) bool {
+	ctx := r.Context()
+	correlationID := correlation.CorrelationIDFromContext(ctx)
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		httphelpers.RespondWithError(ctx, writer, errors.Wrap(err, "An error occurred while reading request body"), respErrorMsg, correlationID, http.StatusInternalServerError)
+		return false
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	if err := verifySignature(r, body, h.config.SigningSecrets, time.Now()); err != nil {
+		httphelpers.RespondWithError(ctx, writer, errors.Wrap(err, "An error occurred while verifying request signature"), "Invalid request signature", correlationID, http.StatusUnauthorized)
+		return false
+	}
+
+	return true
+}
+
 // DestinationDelete handles synchronous formation assignment notification requests for destination deletion during Unassign operation
 func (h *Handler) DestinationDelete(writer http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
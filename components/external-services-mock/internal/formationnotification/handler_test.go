@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/kyma-incubator/compass/components/external-services-mock/internal/formationnotification"
 
@@ -80,6 +81,76 @@ func TestHandler_Patch(t *testing.T) {
 	}
 }
 
+func TestHandler_SignedPatch(t *testing.T) {
+	apiPath := fmt.Sprintf("/formation-callback/signed/%s", testTenantID)
+	secret := "primary-secret-which-is-long-enough"
+	rotatedSecret := "secondary-secret-which-is-long-enough"
+	now := time.Now().Unix()
+
+	testCases := []struct {
+		Name                 string
+		SignatureHeader      string
+		ExpectedResponseCode int
+		ExpectedMappings     map[string][]formationnotification.Response
+	}{
+		{
+			Name:                 "success",
+			SignatureHeader:      fmt.Sprintf("t=%d,v1=%s", now, fixSignature(secret, now, http.MethodPatch, apiPath, formationAssignmentReqBody)),
+			ExpectedResponseCode: http.StatusOK,
+			ExpectedMappings:     assignMappingsWithoutConfig,
+		},
+		{
+			Name:                 "success when one of the signatures is issued with rotated secret",
+			SignatureHeader:      fmt.Sprintf("t=%d,v1=%s,v1=%s", now, fixSignature("unknown", now, http.MethodPatch, apiPath, formationAssignmentReqBody), fixSignature(rotatedSecret, now, http.MethodPatch, apiPath, formationAssignmentReqBody)),
+			ExpectedResponseCode: http.StatusOK,
+			ExpectedMappings:     assignMappingsWithoutConfig,
+		},
+		{
+			Name:                 "Error when signature header is missing",
+			ExpectedResponseCode: http.StatusUnauthorized,
+			ExpectedMappings:     map[string][]formationnotification.Response{},
+		},
+		{
+			Name:                 "Error when signature does not match",
+			SignatureHeader:      fmt.Sprintf("t=%d,v1=%s", now, fixSignature(secret, now, http.MethodPatch, apiPath, "{}")),
+			ExpectedResponseCode: http.StatusUnauthorized,
+			ExpectedMappings:     map[string][]formationnotification.Response{},
+		},
+		{
+			Name:                 "Error when signature timestamp is too old",
+			SignatureHeader:      fmt.Sprintf("t=%d,v1=%s", now-3600, fixSignature(secret, now-3600, http.MethodPatch, apiPath, formationAssignmentReqBody)),
+			ExpectedResponseCode: http.StatusUnauthorized,
+			ExpectedMappings:     map[string][]formationnotification.Response{},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			//GIVEN
+			req, err := http.NewRequest(http.MethodPatch, url+apiPath, bytes.NewBuffer([]byte(formationAssignmentReqBody)))
+			require.NoError(t, err)
+			req = mux.SetURLVars(req, map[string]string{formationnotification.TenantIDParam: testTenantID})
+			if testCase.SignatureHeader != "" {
+				req.Header.Set(formationnotification.SignatureHeader, testCase.SignatureHeader)
+			}
+
+			h := formationnotification.NewHandler(formationnotification.Configuration{SigningSecrets: []string{secret, rotatedSecret}})
+			r := httptest.NewRecorder()
+
+			//WHEN
+			h.SignedPatch(r, req)
+			resp := r.Result()
+
+			body, err := ioutil.ReadAll(resp.Body)
+			require.NoError(t, err)
+
+			//THEN
+			require.Equal(t, testCase.ExpectedResponseCode, resp.StatusCode, string(body))
+			require.Equal(t, testCase.ExpectedMappings, h.Mappings)
+		})
+	}
+}
+
 func TestHandler_PatchWithState(t *testing.T) {
 	apiPath := fmt.Sprintf("/formation-callback/%s", testTenantID)
 
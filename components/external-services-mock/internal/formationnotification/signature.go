@@ -0,0 +1,79 @@
+package formationnotification
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// SignatureHeader is the header in which Compass sends the timestamped HMAC signatures of the notification requests
+	SignatureHeader = "X-Compass-Signature"
+
+	signatureTimestampKey = "t"
+	signatureV1Key        = "v1"
+	signatureTolerance    = 5 * time.Minute
+)
+
+// verifySignature checks that the signature header of the request is issued less than signatureTolerance ago
+// and that at least one of its signatures matches any of the provided secrets
+func verifySignature(r *http.Request, body []byte, secrets []string, now time.Time) error {
+	header := r.Header.Get(SignatureHeader)
+	if header == "" {
+		return errors.Errorf("missing %s header", SignatureHeader)
+	}
+
+	var timestamp int64
+	var signatures []string
+	for _, part := range strings.Split(header, ",") {
+		kv := strings.SplitN(strings.TrimSpace(part), "=", 2)
+		if len(kv) != 2 {
+			return errors.Errorf("invalid %s header part %q", SignatureHeader, part)
+		}
+
+		switch kv[0] {
+		case signatureTimestampKey:
+			parsed, err := strconv.ParseInt(kv[1], 10, 64)
+			if err != nil {
+				return errors.Wrapf(err, "while parsing signature timestamp %q", kv[1])
+			}
+			timestamp = parsed
+		case signatureV1Key:
+			signatures = append(signatures, kv[1])
+		}
+	}
+
+	if timestamp == 0 || len(signatures) == 0 {
+		return errors.Errorf("%s header must contain timestamp and at least one signature", SignatureHeader)
+	}
+
+	if age := now.Sub(time.Unix(timestamp, 0)); age > signatureTolerance || age < -signatureTolerance {
+		return errors.Errorf("signature timestamp %d is outside of the tolerance of %s", timestamp, signatureTolerance)
+	}
+
+	for _, secret := range secrets {
+		expected := computeSignature(secret, timestamp, r.Method, r.URL.RequestURI(), body)
+		for _, signature := range signatures {
+			if hmac.Equal([]byte(expected), []byte(signature)) {
+				return nil
+			}
+		}
+	}
+
+	return errors.New("none of the request signatures matches the configured secrets")
+}
+
+// computeSignature is a copy of the director pkg/signature Compute function, which is not part of the director version pinned in go.mod.
+// Use the director package instead once the pin is bumped to a version containing it.
+func computeSignature(secret string, timestamp int64, method, requestURI string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10) + "\n" + method + "\n" + requestURI + "\n"))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/pkg/signal"
 	"github.com/kyma-incubator/compass/components/gateway/internal/auditlog"
 	timeservices "github.com/kyma-incubator/compass/components/gateway/internal/time"
+	"github.com/kyma-incubator/compass/components/gateway/internal/tracing"
 	"github.com/kyma-incubator/compass/components/gateway/internal/uuid"
 	"github.com/kyma-incubator/compass/components/gateway/pkg/proxy"
 	"github.com/pkg/errors"
@@ -39,6 +40,8 @@ type config struct {
 
 	Log log.Config
 
+	Tracing tracing.Config
+
 	DirectorOrigin  string `envconfig:"default=http://127.0.0.1:3001"`
 	ConnectorOrigin string `envconfig:"default=http://127.0.0.1:3002"`
 	NsadapterOrigin string `envconfig:"default=http://127.0.0.1:3005"`
@@ -61,7 +64,7 @@ func main() {
 
 	const healthzEndpoint = "/healthz"
 	router := mux.NewRouter()
-	router.Use(correlation.AttachCorrelationIDToContext(), log.RequestLogger(healthzEndpoint))
+	router.Use(correlation.AttachCorrelationIDToContext(), tracing.NewHTTPMiddleware(), log.RequestLogger(healthzEndpoint))
 	metricsCollector := metrics.NewAuditlogMetricCollector()
 	prometheus.MustRegister(metricsCollector)
 
@@ -69,6 +72,14 @@ func main() {
 	exitOnError(err, "Failed to configure Logger")
 	logger := log.C(ctx)
 
+	shutdownTracing, err := tracing.Configure(ctx, cfg.Tracing)
+	exitOnError(err, "Failed to configure tracing")
+	defer func() {
+		if err := shutdownTracing(context.Background()); err != nil {
+			logger.WithError(err).Error("An error has occurred while shutting down tracing")
+		}
+	}()
+
 	var auditlogSink proxy.AuditlogService
 	var auditlogSvc proxy.PreAuditlogService
 	if cfg.AuditlogEnabled {
@@ -81,7 +92,7 @@ func main() {
 		auditlogSvc = &auditlog.NoOpService{}
 	}
 
-	correlationTr := httputil.NewCorrelationIDTransport(httputil.NewHTTPTransportWrapper(http.DefaultTransport.(*http.Transport)))
+	correlationTr := tracing.NewTransport(httputil.NewCorrelationIDTransport(httputil.NewHTTPTransportWrapper(http.DefaultTransport.(*http.Transport))))
 	tr := proxy.NewTransport(auditlogSink, auditlogSvc, correlationTr)
 	adapterCfg := proxy.AdapterConfig{
 		MsgBodySizeLimit: cfg.AuditLogMessageBodySizeLimit,
@@ -289,6 +300,23 @@ func initAuditLogs(ctx context.Context, collector *metrics.AuditlogCollector) (p
 	}
 
 	auditlogSvc := auditlog.NewService(auditlogClient, msgFactory)
+
+	spoolCfg := auditlog.SpoolConfig{}
+	if err := envconfig.InitWithPrefix(&spoolCfg, "APP"); err != nil {
+		return nil, nil, errors.Wrap(err, "while loading auditlog spool configuration")
+	}
+
+	if spoolCfg.Enabled {
+		spool, err := auditlog.NewSpool(spoolCfg, auditlogSvc, collector)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "while opening auditlog spool")
+		}
+		go spool.Start(ctx)
+
+		log.C(ctx).Infof("Auditlog configured successfully with spool in %s, auth mode: %s", spoolCfg.Dir, cfg.AuthMode)
+		return spool, auditlogSvc, nil
+	}
+
 	msgChannel := make(chan proxy.AuditlogMessage, cfg.MsgChannelSize)
 	workers := make(chan bool, cfg.WriteWorkers)
 	initWorkers(ctx, workers, auditlogSvc, msgChannel, collector)
@@ -26,6 +26,21 @@ type Config struct {
 	WriteWorkers      int           `envconfig:"APP_AUDITLOG_WRITE_WORKERS,default=5"`
 }
 
+// SpoolConfig configures the durable on-disk queue of the auditlog messages
+type SpoolConfig struct {
+	Enabled bool   `envconfig:"APP_AUDITLOG_SPOOL_ENABLED,default=false"`
+	Dir     string `envconfig:"APP_AUDITLOG_SPOOL_DIR,default=/var/spool/auditlog"`
+	// SegmentSize is the size in bytes after which a new segment file is started
+	SegmentSize int64 `envconfig:"APP_AUDITLOG_SPOOL_SEGMENT_SIZE,default=8388608"`
+	// MaxSize is the size in bytes of the undelivered messages after which new messages are rejected
+	MaxSize       int64         `envconfig:"APP_AUDITLOG_SPOOL_MAX_SIZE,default=536870912"`
+	FsyncPolicy   FsyncPolicy   `envconfig:"APP_AUDITLOG_SPOOL_FSYNC_POLICY,default=always"`
+	FsyncInterval time.Duration `envconfig:"APP_AUDITLOG_SPOOL_FSYNC_INTERVAL,default=1s"`
+	// MaxAttempts is the number of delivery attempts after which a message is moved to the dead-letter directory
+	MaxAttempts   int           `envconfig:"APP_AUDITLOG_SPOOL_MAX_ATTEMPTS,default=360"`
+	RetryInterval time.Duration `envconfig:"APP_AUDITLOG_SPOOL_RETRY_INTERVAL,default=10s"`
+}
+
 type BasicAuthConfig struct {
 	User     string `envconfig:"APP_AUDITLOG_USER"`
 	Password string `envconfig:"APP_AUDITLOG_PASSWORD"`
@@ -0,0 +1,622 @@
+package auditlog
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/correlation"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/gateway/pkg/proxy"
+	"github.com/pkg/errors"
+)
+
+// FsyncPolicy defines when the messages appended to the spool are flushed to the disk
+type FsyncPolicy string
+
+const (
+	// FsyncAlways flushes every appended message before it is acknowledged
+	FsyncAlways FsyncPolicy = "always"
+	// FsyncInterval flushes the appended messages periodically
+	FsyncInterval FsyncPolicy = "interval"
+	// FsyncNever leaves the flushing to the operating system
+	FsyncNever FsyncPolicy = "never"
+
+	segmentsDirName    = "segments"
+	deadLetterDirName  = "dead-letter"
+	checkpointFileName = "checkpoint"
+	lockFileName       = "lock"
+	segmentFileExt     = ".log"
+	segmentFileFormat  = "%020d" + segmentFileExt
+	deadLetterFormat   = "%020d-%020d.json"
+	spoolFileMode      = 0600
+	spoolDirMode       = 0700
+)
+
+// spoolCheckpoint points to the first message in the spool which is not yet delivered
+type spoolCheckpoint struct {
+	Segment uint64 `json:"segment"`
+	Offset  int64  `json:"offset"`
+}
+
+// Spool is a durable proxy.AuditlogService which appends the messages to segment files on the disk
+// and delivers them to the underlying service in order. The messages are delivered at least once -
+// the ones which were not acknowledged before a restart are replayed on startup.
+// The spool directory is locked while the spool is open, so it cannot be shared between processes.
+type Spool struct {
+	cfg           SpoolConfig
+	svc           proxy.AuditlogService
+	collector     MetricCollector
+	segmentsDir   string
+	deadLetterDir string
+	lockFile      *os.File
+
+	mu         sync.Mutex
+	active     *os.File
+	activeSeq  uint64
+	activeSize int64
+	totalSize  int64
+	pending    int
+	closed     bool
+	notify     chan struct{}
+
+	checkpoint spoolCheckpoint
+	reader     *bufio.Reader
+	readerFile *os.File
+	readerPos  int64
+}
+
+// NewSpool locks and opens the spool in the configured directory, removes the already delivered segments
+// and starts a new segment for the incoming messages. The number of undelivered messages is reported as the channel size of the collector.
+func NewSpool(cfg SpoolConfig, svc proxy.AuditlogService, collector MetricCollector) (*Spool, error) {
+	switch cfg.FsyncPolicy {
+	case FsyncAlways, FsyncInterval, FsyncNever:
+	default:
+		return nil, errors.Errorf("invalid spool fsync policy: %s", cfg.FsyncPolicy)
+	}
+
+	s := &Spool{
+		cfg:           cfg,
+		svc:           svc,
+		collector:     collector,
+		segmentsDir:   filepath.Join(cfg.Dir, segmentsDirName),
+		deadLetterDir: filepath.Join(cfg.Dir, deadLetterDirName),
+		notify:        make(chan struct{}, 1),
+	}
+
+	if err := s.lock(); err != nil {
+		return nil, err
+	}
+
+	if err := s.open(); err != nil {
+		_ = s.lockFile.Close()
+		return nil, err
+	}
+
+	s.collector.SetChannelSize(s.pending)
+	return s, nil
+}
+
+func (s *Spool) open() error {
+	for _, dir := range []string{s.segmentsDir, s.deadLetterDir} {
+		if err := os.MkdirAll(dir, spoolDirMode); err != nil {
+			return errors.Wrapf(err, "while creating spool directory %s", dir)
+		}
+	}
+
+	if err := s.loadCheckpoint(); err != nil {
+		return err
+	}
+
+	segments, err := s.listSegments()
+	if err != nil {
+		return err
+	}
+
+	var lastSeq uint64
+	for _, seq := range segments {
+		lastSeq = seq
+		if seq < s.checkpoint.Segment {
+			if err := os.Remove(s.segmentPath(seq)); err != nil {
+				return errors.Wrapf(err, "while removing delivered segment %d", seq)
+			}
+			continue
+		}
+
+		info, err := os.Stat(s.segmentPath(seq))
+		if err != nil {
+			return errors.Wrapf(err, "while reading size of segment %d", seq)
+		}
+		s.totalSize += info.Size()
+
+		var offset int64
+		if seq == s.checkpoint.Segment {
+			offset = s.checkpoint.Offset
+		}
+		count, err := s.countRecords(seq, offset)
+		if err != nil {
+			return err
+		}
+		s.pending += count
+	}
+
+	if err := s.openSegment(lastSeq + 1); err != nil {
+		return err
+	}
+
+	first := firstSegmentFrom(segments, s.checkpoint.Segment)
+	if first == 0 {
+		first = s.activeSeq
+	}
+	if first != s.checkpoint.Segment {
+		s.checkpoint = spoolCheckpoint{Segment: first}
+	}
+
+	return nil
+}
+
+// lock takes an exclusive lock on the spool directory, which is released when the spool is closed or the process exits
+func (s *Spool) lock() error {
+	if err := os.MkdirAll(s.cfg.Dir, spoolDirMode); err != nil {
+		return errors.Wrapf(err, "while creating spool directory %s", s.cfg.Dir)
+	}
+
+	file, err := os.OpenFile(filepath.Join(s.cfg.Dir, lockFileName), os.O_CREATE|os.O_RDWR, spoolFileMode)
+	if err != nil {
+		return errors.Wrapf(err, "while opening lock file of spool directory %s", s.cfg.Dir)
+	}
+
+	if err := syscall.Flock(int(file.Fd()), syscall.LOCK_EX|syscall.LOCK_NB); err != nil {
+		_ = file.Close()
+		if err == syscall.EWOULDBLOCK {
+			return errors.Errorf("spool directory %s is used by another process", s.cfg.Dir)
+		}
+		return errors.Wrapf(err, "while locking spool directory %s", s.cfg.Dir)
+	}
+
+	s.lockFile = file
+	return nil
+}
+
+// Log appends the message to the active segment. It fails if the spool reached its maximum size.
+func (s *Spool) Log(ctx context.Context, msg proxy.AuditlogMessage) error {
+	record, err := json.Marshal(msg)
+	if err != nil {
+		return errors.Wrap(err, "while marshalling auditlog message")
+	}
+	record = append(record, '\n')
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return errors.New("auditlog spool is closed")
+	}
+
+	recordSize := int64(len(record))
+	if s.totalSize+recordSize > s.cfg.MaxSize {
+		return errors.Errorf("auditlog spool is full (size=%d, max size=%d)", s.totalSize, s.cfg.MaxSize)
+	}
+
+	if s.activeSize > 0 && s.activeSize+recordSize > s.cfg.SegmentSize {
+		if err := s.rotate(); err != nil {
+			return err
+		}
+	}
+
+	if _, err := s.active.Write(record); err != nil {
+		return errors.Wrapf(err, "while appending auditlog message to segment %d", s.activeSeq)
+	}
+	if s.cfg.FsyncPolicy == FsyncAlways {
+		if err := s.active.Sync(); err != nil {
+			return errors.Wrapf(err, "while syncing segment %d", s.activeSeq)
+		}
+	}
+	s.activeSize += recordSize
+	s.totalSize += recordSize
+	s.pending++
+	s.collector.SetChannelSize(s.pending)
+
+	select {
+	case s.notify <- struct{}{}:
+	default:
+	}
+
+	log.C(ctx).Debugf("Successfully appended auditlog message to the spool (size=%d, max size=%d)", s.totalSize, s.cfg.MaxSize)
+	return nil
+}
+
+// Start delivers the spooled messages to the underlying service until the context is done, after which the spool is closed
+func (s *Spool) Start(ctx context.Context) {
+	logger := log.C(ctx)
+	defer func() {
+		if err := s.Close(); err != nil {
+			logger.WithError(err).Error("An error occurred while closing the auditlog spool")
+		}
+	}()
+
+	if s.cfg.FsyncPolicy == FsyncInterval {
+		go s.syncPeriodically(ctx)
+	}
+
+	for {
+		record, err := s.next()
+		if err != nil {
+			logger.WithError(err).Errorf("An error occurred while reading from the auditlog spool: %v", err)
+			if !s.wait(ctx, s.cfg.RetryInterval) {
+				return
+			}
+			continue
+		}
+
+		if record == nil {
+			if !s.wait(ctx, s.cfg.RetryInterval) {
+				logger.Infoln("Auditlog spool processing has finished")
+				return
+			}
+			continue
+		}
+
+		if !s.deliver(ctx, record) {
+			logger.Infoln("Auditlog spool processing has finished")
+			return
+		}
+
+		if err := s.commit(); err != nil {
+			logger.WithError(err).Errorf("An error occurred while saving the auditlog spool checkpoint: %v", err)
+		}
+
+		s.mu.Lock()
+		s.pending--
+		s.collector.SetChannelSize(s.pending)
+		s.mu.Unlock()
+	}
+}
+
+// Close flushes and closes the active segment and releases the lock of the spool directory. Messages logged afterwards are rejected.
+func (s *Spool) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return nil
+	}
+	s.closed = true
+
+	defer func() {
+		_ = s.lockFile.Close()
+	}()
+
+	if s.readerFile != nil {
+		_ = s.readerFile.Close()
+	}
+
+	if err := s.active.Sync(); err != nil {
+		return errors.Wrapf(err, "while syncing segment %d", s.activeSeq)
+	}
+	return s.active.Close()
+}
+
+// next returns the next spooled message or nil if all of them are read
+func (s *Spool) next() ([]byte, error) {
+	for {
+		if s.reader == nil {
+			if err := s.openReader(); err != nil {
+				return nil, err
+			}
+		}
+
+		// The segment must be checked before reading, as it can be rotated in the meantime
+		s.mu.Lock()
+		sealed := s.checkpoint.Segment < s.activeSeq
+		s.mu.Unlock()
+
+		record, err := s.reader.ReadBytes('\n')
+		if err == nil {
+			s.readerPos += int64(len(record))
+			return record, nil
+		}
+		if err != io.EOF {
+			return nil, errors.Wrapf(err, "while reading segment %d", s.checkpoint.Segment)
+		}
+
+		if !sealed {
+			// The active segment may contain a message which is not completely written yet
+			s.closeReader()
+			return nil, nil
+		}
+
+		if len(record) > 0 {
+			if err := s.deadLetter(record); err != nil {
+				return nil, err
+			}
+		}
+
+		if err := s.removeDelivered(); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (s *Spool) deliver(ctx context.Context, record []byte) bool {
+	logger := log.C(ctx)
+
+	var msg proxy.AuditlogMessage
+	if err := json.Unmarshal(record, &msg); err != nil {
+		logger.WithError(err).Errorf("Auditlog message at segment %d is corrupted, moving it to the dead-letter directory", s.checkpoint.Segment)
+		if err := s.deadLetter(record); err != nil {
+			logger.WithError(err).Errorf("An error occurred while moving auditlog message to the dead-letter directory: %v", err)
+		}
+		return true
+	}
+
+	msgCtx := context.WithValue(ctx, correlation.HeadersContextKey, msg.CorrelationIDHeaders)
+	for attempt := 1; ; attempt++ {
+		err := s.svc.Log(msgCtx, msg)
+		if err == nil {
+			return true
+		}
+
+		if attempt >= s.cfg.MaxAttempts {
+			logger.WithError(err).Errorf("Auditlog message could not be sent after %d attempts, moving it to the dead-letter directory: %v", attempt, err)
+			if err := s.deadLetter(record); err != nil {
+				logger.WithError(err).Errorf("An error occurred while moving auditlog message to the dead-letter directory: %v", err)
+			}
+			return true
+		}
+
+		logger.WithError(err).Warnf("Attempt %d to send auditlog message failed: %v", attempt, err)
+		if !s.wait(ctx, s.cfg.RetryInterval) {
+			return false
+		}
+	}
+}
+
+func (s *Spool) commit() error {
+	s.checkpoint.Offset = s.readerPos
+	return s.saveCheckpoint()
+}
+
+func (s *Spool) deadLetter(record []byte) error {
+	path := filepath.Join(s.deadLetterDir, fmt.Sprintf(deadLetterFormat, s.checkpoint.Segment, s.readerPos))
+	return s.writeFile(path, record)
+}
+
+func (s *Spool) removeDelivered() error {
+	seq := s.checkpoint.Segment
+	s.closeReader()
+
+	info, err := os.Stat(s.segmentPath(seq))
+	if err != nil {
+		return errors.Wrapf(err, "while reading size of segment %d", seq)
+	}
+
+	s.checkpoint = spoolCheckpoint{Segment: seq + 1}
+	if err := s.saveCheckpoint(); err != nil {
+		return err
+	}
+
+	if err := os.Remove(s.segmentPath(seq)); err != nil {
+		return errors.Wrapf(err, "while removing delivered segment %d", seq)
+	}
+
+	s.mu.Lock()
+	s.totalSize -= info.Size()
+	s.mu.Unlock()
+
+	return nil
+}
+
+func (s *Spool) openReader() error {
+	file, err := os.Open(s.segmentPath(s.checkpoint.Segment))
+	if err != nil {
+		return errors.Wrapf(err, "while opening segment %d", s.checkpoint.Segment)
+	}
+
+	if _, err := file.Seek(s.checkpoint.Offset, io.SeekStart); err != nil {
+		_ = file.Close()
+		return errors.Wrapf(err, "while seeking segment %d to offset %d", s.checkpoint.Segment, s.checkpoint.Offset)
+	}
+
+	s.readerFile = file
+	s.reader = bufio.NewReader(file)
+	s.readerPos = s.checkpoint.Offset
+	return nil
+}
+
+func (s *Spool) closeReader() {
+	if s.readerFile != nil {
+		_ = s.readerFile.Close()
+	}
+	s.readerFile = nil
+	s.reader = nil
+}
+
+func (s *Spool) rotate() error {
+	if err := s.active.Sync(); err != nil {
+		return errors.Wrapf(err, "while syncing segment %d", s.activeSeq)
+	}
+	if err := s.active.Close(); err != nil {
+		return errors.Wrapf(err, "while closing segment %d", s.activeSeq)
+	}
+	return s.openSegment(s.activeSeq + 1)
+}
+
+func (s *Spool) openSegment(seq uint64) error {
+	file, err := os.OpenFile(s.segmentPath(seq), os.O_CREATE|os.O_WRONLY|os.O_APPEND, spoolFileMode)
+	if err != nil {
+		return errors.Wrapf(err, "while opening segment %d", seq)
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return errors.Wrapf(err, "while reading size of segment %d", seq)
+	}
+
+	s.active = file
+	s.activeSeq = seq
+	s.activeSize = info.Size()
+	return nil
+}
+
+func (s *Spool) syncPeriodically(ctx context.Context) {
+	ticker := time.NewTicker(s.cfg.FsyncInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.mu.Lock()
+			if !s.closed {
+				if err := s.active.Sync(); err != nil {
+					log.C(ctx).WithError(err).Errorf("An error occurred while syncing segment %d: %v", s.activeSeq, err)
+				}
+			}
+			s.mu.Unlock()
+		}
+	}
+}
+
+func (s *Spool) wait(ctx context.Context, timeout time.Duration) bool {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-s.notify:
+	case <-timer.C:
+	}
+	return true
+}
+
+func (s *Spool) loadCheckpoint() error {
+	data, err := os.ReadFile(filepath.Join(s.cfg.Dir, checkpointFileName))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "while reading spool checkpoint")
+	}
+
+	if err := json.Unmarshal(data, &s.checkpoint); err != nil {
+		return errors.Wrap(err, "while unmarshalling spool checkpoint")
+	}
+	return nil
+}
+
+func (s *Spool) saveCheckpoint() error {
+	data, err := json.Marshal(s.checkpoint)
+	if err != nil {
+		return errors.Wrap(err, "while marshalling spool checkpoint")
+	}
+
+	path := filepath.Join(s.cfg.Dir, checkpointFileName)
+	tmpPath := path + ".tmp"
+	if err := s.writeFile(tmpPath, data); err != nil {
+		return err
+	}
+
+	return errors.Wrap(os.Rename(tmpPath, path), "while replacing spool checkpoint")
+}
+
+func (s *Spool) writeFile(path string, data []byte) error {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, spoolFileMode)
+	if err != nil {
+		return errors.Wrapf(err, "while opening file %s", path)
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	if _, err := file.Write(data); err != nil {
+		return errors.Wrapf(err, "while writing file %s", path)
+	}
+
+	if s.cfg.FsyncPolicy != FsyncNever {
+		return errors.Wrapf(file.Sync(), "while syncing file %s", path)
+	}
+	return nil
+}
+
+func (s *Spool) listSegments() ([]uint64, error) {
+	entries, err := os.ReadDir(s.segmentsDir)
+	if err != nil {
+		return nil, errors.Wrap(err, "while listing spool segments")
+	}
+
+	segments := make([]uint64, 0, len(entries))
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, segmentFileExt) {
+			continue
+		}
+
+		seq, err := strconv.ParseUint(strings.TrimSuffix(name, segmentFileExt), 10, 64)
+		if err != nil {
+			continue
+		}
+		segments = append(segments, seq)
+	}
+
+	sort.Slice(segments, func(i, j int) bool { return segments[i] < segments[j] })
+	return segments, nil
+}
+
+// countRecords returns the number of complete messages in the segment after the offset
+func (s *Spool) countRecords(seq uint64, offset int64) (int, error) {
+	file, err := os.Open(s.segmentPath(seq))
+	if err != nil {
+		return 0, errors.Wrapf(err, "while opening segment %d", seq)
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	if _, err := file.Seek(offset, io.SeekStart); err != nil {
+		return 0, errors.Wrapf(err, "while seeking segment %d to offset %d", seq, offset)
+	}
+
+	count := 0
+	reader := bufio.NewReader(file)
+	for {
+		_, err := reader.ReadSlice('\n')
+		switch {
+		case err == nil:
+			count++
+		case err == bufio.ErrBufferFull:
+			continue
+		case err == io.EOF:
+			return count, nil
+		default:
+			return 0, errors.Wrapf(err, "while reading segment %d", seq)
+		}
+	}
+}
+
+func (s *Spool) segmentPath(seq uint64) string {
+	return filepath.Join(s.segmentsDir, fmt.Sprintf(segmentFileFormat, seq))
+}
+
+// firstSegmentFrom returns the first of the sorted segments which is not before seq, or 0 if there is no such segment
+func firstSegmentFrom(segments []uint64, seq uint64) uint64 {
+	for _, segment := range segments {
+		if segment >= seq {
+			return segment
+		}
+	}
+	return 0
+}
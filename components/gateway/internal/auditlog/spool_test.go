@@ -0,0 +1,300 @@
+package auditlog_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/gateway/internal/auditlog"
+	auditlogautomock "github.com/kyma-incubator/compass/components/gateway/internal/auditlog/automock"
+	"github.com/kyma-incubator/compass/components/gateway/pkg/proxy"
+	"github.com/kyma-incubator/compass/components/gateway/pkg/proxy/automock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const spoolTestTimeout = 5 * time.Second
+
+func TestSpool_Log(t *testing.T) {
+	t.Run("Success when messages are replayed after restart", func(t *testing.T) {
+		//GIVEN
+		cfg := fixSpoolConfig(t.TempDir())
+		msgs := []proxy.AuditlogMessage{fixSpoolMessage("first"), fixSpoolMessage("second"), fixSpoolMessage("third")}
+
+		spool, err := auditlog.NewSpool(cfg, &automock.AuditlogService{}, fixSpoolMetricCollector())
+		require.NoError(t, err)
+		for _, msg := range msgs {
+			require.NoError(t, spool.Log(context.TODO(), msg))
+		}
+		require.NoError(t, spool.Close())
+
+		delivered := make(chan proxy.AuditlogMessage, len(msgs))
+		svc := &automock.AuditlogService{}
+		svc.On("Log", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+			delivered <- args.Get(1).(proxy.AuditlogMessage)
+		})
+
+		//WHEN
+		restarted, err := auditlog.NewSpool(cfg, svc, fixSpoolMetricCollector())
+		require.NoError(t, err)
+		stop := startSpool(restarted)
+
+		//THEN
+		for _, msg := range msgs {
+			assert.Equal(t, msg, receive(t, delivered))
+		}
+		stop()
+		mock.AssertExpectationsForObjects(t, svc)
+	})
+
+	t.Run("Success when delivered messages are not replayed after restart", func(t *testing.T) {
+		//GIVEN
+		cfg := fixSpoolConfig(t.TempDir())
+		delivered := make(chan proxy.AuditlogMessage, 2)
+		svc := &automock.AuditlogService{}
+		svc.On("Log", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+			delivered <- args.Get(1).(proxy.AuditlogMessage)
+		})
+
+		spool, err := auditlog.NewSpool(cfg, svc, fixSpoolMetricCollector())
+		require.NoError(t, err)
+		stop := startSpool(spool)
+		require.NoError(t, spool.Log(context.TODO(), fixSpoolMessage("first")))
+		assert.Equal(t, fixSpoolMessage("first"), receive(t, delivered))
+		stop()
+
+		//WHEN
+		restarted, err := auditlog.NewSpool(cfg, svc, fixSpoolMetricCollector())
+		require.NoError(t, err)
+		stop = startSpool(restarted)
+		require.NoError(t, restarted.Log(context.TODO(), fixSpoolMessage("second")))
+
+		//THEN
+		assert.Equal(t, fixSpoolMessage("second"), receive(t, delivered))
+		stop()
+		svc.AssertNumberOfCalls(t, "Log", 2)
+	})
+
+	t.Run("Success when delivered segments are removed", func(t *testing.T) {
+		//GIVEN
+		cfg := fixSpoolConfig(t.TempDir())
+		cfg.SegmentSize = 1
+		delivered := make(chan proxy.AuditlogMessage, 3)
+		svc := &automock.AuditlogService{}
+		svc.On("Log", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+			delivered <- args.Get(1).(proxy.AuditlogMessage)
+		})
+
+		spool, err := auditlog.NewSpool(cfg, svc, fixSpoolMetricCollector())
+		require.NoError(t, err)
+
+		//WHEN
+		for _, name := range []string{"first", "second", "third"} {
+			require.NoError(t, spool.Log(context.TODO(), fixSpoolMessage(name)))
+		}
+		stop := startSpool(spool)
+		for i := 0; i < 3; i++ {
+			receive(t, delivered)
+		}
+
+		//THEN
+		require.Eventually(t, func() bool {
+			entries, err := os.ReadDir(filepath.Join(cfg.Dir, "segments"))
+			return err == nil && len(entries) == 1
+		}, spoolTestTimeout, 10*time.Millisecond)
+		stop()
+	})
+
+	t.Run("Success when message is moved to dead-letter directory after max attempts", func(t *testing.T) {
+		//GIVEN
+		cfg := fixSpoolConfig(t.TempDir())
+		delivered := make(chan proxy.AuditlogMessage, 1)
+		svc := &automock.AuditlogService{}
+		svc.On("Log", mock.Anything, fixSpoolMessage("poison")).Return(errors.New("test")).Times(cfg.MaxAttempts)
+		svc.On("Log", mock.Anything, fixSpoolMessage("valid")).Return(nil).Run(func(args mock.Arguments) {
+			delivered <- args.Get(1).(proxy.AuditlogMessage)
+		}).Once()
+
+		spool, err := auditlog.NewSpool(cfg, svc, fixSpoolMetricCollector())
+		require.NoError(t, err)
+		require.NoError(t, spool.Log(context.TODO(), fixSpoolMessage("poison")))
+		require.NoError(t, spool.Log(context.TODO(), fixSpoolMessage("valid")))
+
+		//WHEN
+		stop := startSpool(spool)
+
+		//THEN
+		assert.Equal(t, fixSpoolMessage("valid"), receive(t, delivered))
+		stop()
+
+		entries, err := os.ReadDir(filepath.Join(cfg.Dir, "dead-letter"))
+		require.NoError(t, err)
+		assert.Len(t, entries, 1)
+		mock.AssertExpectationsForObjects(t, svc)
+	})
+
+	t.Run("Success when undelivered messages are reported as channel size", func(t *testing.T) {
+		//GIVEN
+		cfg := fixSpoolConfig(t.TempDir())
+		spool, err := auditlog.NewSpool(cfg, &automock.AuditlogService{}, fixSpoolMetricCollector())
+		require.NoError(t, err)
+		require.NoError(t, spool.Log(context.TODO(), fixSpoolMessage("first")))
+		require.NoError(t, spool.Log(context.TODO(), fixSpoolMessage("second")))
+		require.NoError(t, spool.Close())
+
+		delivered := make(chan proxy.AuditlogMessage, 2)
+		svc := &automock.AuditlogService{}
+		svc.On("Log", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+			delivered <- args.Get(1).(proxy.AuditlogMessage)
+		})
+		drained := make(chan struct{})
+		collector := &auditlogautomock.MetricCollector{}
+		collector.On("SetChannelSize", 2).Once()
+		collector.On("SetChannelSize", 1).Once()
+		collector.On("SetChannelSize", 0).Once().Run(func(mock.Arguments) {
+			close(drained)
+		})
+
+		//WHEN
+		restarted, err := auditlog.NewSpool(cfg, svc, collector)
+		require.NoError(t, err)
+		stop := startSpool(restarted)
+		receive(t, delivered)
+		receive(t, delivered)
+
+		//THEN
+		select {
+		case <-drained:
+		case <-time.After(spoolTestTimeout):
+			require.FailNow(t, "timed out waiting for the spool to report that it is drained")
+		}
+		stop()
+		mock.AssertExpectationsForObjects(t, collector)
+	})
+
+	t.Run("Error when spool is full", func(t *testing.T) {
+		//GIVEN
+		record, err := json.Marshal(fixSpoolMessage("first"))
+		require.NoError(t, err)
+
+		cfg := fixSpoolConfig(t.TempDir())
+		cfg.MaxSize = int64(len(record)) + 1
+
+		spool, err := auditlog.NewSpool(cfg, &automock.AuditlogService{}, fixSpoolMetricCollector())
+		require.NoError(t, err)
+		defer func() {
+			require.NoError(t, spool.Close())
+		}()
+		require.NoError(t, spool.Log(context.TODO(), fixSpoolMessage("first")))
+
+		//WHEN
+		err = spool.Log(context.TODO(), fixSpoolMessage("second"))
+
+		//THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "auditlog spool is full")
+	})
+
+	t.Run("Error when spool is closed", func(t *testing.T) {
+		//GIVEN
+		spool, err := auditlog.NewSpool(fixSpoolConfig(t.TempDir()), &automock.AuditlogService{}, fixSpoolMetricCollector())
+		require.NoError(t, err)
+		require.NoError(t, spool.Close())
+
+		//WHEN
+		err = spool.Log(context.TODO(), fixSpoolMessage("first"))
+
+		//THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "auditlog spool is closed")
+	})
+}
+
+func TestNewSpool(t *testing.T) {
+	t.Run("Error when fsync policy is invalid", func(t *testing.T) {
+		//GIVEN
+		cfg := fixSpoolConfig(t.TempDir())
+		cfg.FsyncPolicy = "sometimes"
+
+		//WHEN
+		_, err := auditlog.NewSpool(cfg, &automock.AuditlogService{}, fixSpoolMetricCollector())
+
+		//THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid spool fsync policy")
+	})
+
+	t.Run("Error when spool directory is used by another spool", func(t *testing.T) {
+		//GIVEN
+		cfg := fixSpoolConfig(t.TempDir())
+		spool, err := auditlog.NewSpool(cfg, &automock.AuditlogService{}, fixSpoolMetricCollector())
+		require.NoError(t, err)
+
+		//WHEN
+		_, err = auditlog.NewSpool(cfg, &automock.AuditlogService{}, fixSpoolMetricCollector())
+
+		//THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "is used by another process")
+
+		require.NoError(t, spool.Close())
+		reopened, err := auditlog.NewSpool(cfg, &automock.AuditlogService{}, fixSpoolMetricCollector())
+		require.NoError(t, err)
+		require.NoError(t, reopened.Close())
+	})
+}
+
+func fixSpoolConfig(dir string) auditlog.SpoolConfig {
+	return auditlog.SpoolConfig{
+		Dir:           dir,
+		SegmentSize:   1024,
+		MaxSize:       1024 * 1024,
+		FsyncPolicy:   auditlog.FsyncAlways,
+		MaxAttempts:   3,
+		RetryInterval: time.Millisecond,
+	}
+}
+
+func fixSpoolMessage(request string) proxy.AuditlogMessage {
+	return proxy.AuditlogMessage{
+		CorrelationIDHeaders: fixCorrelationID(),
+		Request:              request,
+		Response:             "response",
+		Claims:               fixClaims(),
+	}
+}
+
+func fixSpoolMetricCollector() *auditlogautomock.MetricCollector {
+	collector := &auditlogautomock.MetricCollector{}
+	collector.On("SetChannelSize", mock.Anything)
+	return collector
+}
+
+func startSpool(spool *auditlog.Spool) func() {
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		spool.Start(ctx)
+		close(done)
+	}()
+
+	return func() {
+		cancel()
+		<-done
+	}
+}
+
+func receive(t *testing.T, delivered chan proxy.AuditlogMessage) proxy.AuditlogMessage {
+	select {
+	case msg := <-delivered:
+		return msg
+	case <-time.After(spoolTestTimeout):
+		require.FailNow(t, "timed out waiting for auditlog message delivery")
+	}
+	return proxy.AuditlogMessage{}
+}
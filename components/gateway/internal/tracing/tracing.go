@@ -0,0 +1,139 @@
+// Package tracing configures the tracing of the gateway. It mirrors github.com/kyma-incubator/compass/components/director/pkg/tracing,
+// which is not part of the director version pinned in go.mod. Switch to it once the pin is bumped to a version containing it.
+package tracing
+
+import (
+	"context"
+	"net/http"
+	"os"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.12.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	tracerName  = "github.com/kyma-incubator/compass/components/gateway"
+	serviceName = "gateway"
+
+	otlpExporter   = "otlp"
+	stdoutExporter = "stdout"
+	fileExporter   = "file"
+)
+
+// Config holds the tracing configuration of the gateway
+type Config struct {
+	Enabled       bool    `envconfig:"default=false,APP_TRACING_ENABLED"`
+	Exporter      string  `envconfig:"default=otlp,APP_TRACING_EXPORTER"`
+	OTLPEndpoint  string  `envconfig:"default=localhost:4318,APP_TRACING_OTLP_ENDPOINT"`
+	OTLPInsecure  bool    `envconfig:"default=true,APP_TRACING_OTLP_INSECURE"`
+	FilePath      string  `envconfig:"optional,APP_TRACING_FILE_PATH"`
+	SamplingRatio float64 `envconfig:"default=1,APP_TRACING_SAMPLING_RATIO"`
+}
+
+// Configure registers the W3C trace context propagator and, if tracing is enabled, a tracer provider with the configured exporter.
+// The returned function flushes the pending spans.
+func Configure(ctx context.Context, cfg Config) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	if !cfg.Enabled {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	var exporter sdktrace.SpanExporter
+	var file *os.File
+	var err error
+	switch cfg.Exporter {
+	case otlpExporter:
+		opts := []otlptracehttp.Option{otlptracehttp.WithEndpoint(cfg.OTLPEndpoint)}
+		if cfg.OTLPInsecure {
+			opts = append(opts, otlptracehttp.WithInsecure())
+		}
+		exporter, err = otlptracehttp.New(ctx, opts...)
+	case stdoutExporter:
+		exporter, err = stdouttrace.New(stdouttrace.WithWriter(os.Stdout))
+	case fileExporter:
+		if file, err = os.OpenFile(cfg.FilePath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644); err != nil {
+			return nil, errors.Wrapf(err, "while opening file %s", cfg.FilePath)
+		}
+		exporter, err = stdouttrace.New(stdouttrace.WithWriter(file))
+	default:
+		return nil, errors.Errorf("unsupported tracing exporter %q", cfg.Exporter)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "while creating %s span exporter", cfg.Exporter)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceNameKey.String(serviceName))),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SamplingRatio))),
+	)
+	otel.SetTracerProvider(provider)
+
+	log.C(ctx).Infof("Tracing is enabled with %s exporter", cfg.Exporter)
+
+	return func(ctx context.Context) error {
+		if err := provider.Shutdown(ctx); err != nil {
+			return err
+		}
+		if file != nil {
+			return file.Close()
+		}
+		return nil
+	}, nil
+}
+
+// NewHTTPMiddleware returns middleware which records a server span for each incoming request as part of the caller's trace
+func NewHTTPMiddleware() func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+			ctx := otel.GetTextMapPropagator().Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+			ctx, span := otel.Tracer(tracerName).Start(ctx, serviceName+" "+r.Method,
+				trace.WithSpanKind(trace.SpanKindServer),
+				trace.WithAttributes(semconv.HTTPServerAttributesFromHTTPRequest(serviceName, "", r)...))
+			defer span.End()
+
+			next.ServeHTTP(rw, r.WithContext(ctx))
+		})
+	}
+}
+
+// NewTransport returns a transport which records a client span for each proxied request and forwards the trace context to the target component
+func NewTransport(roundTripper http.RoundTripper) http.RoundTripper {
+	return &transport{roundTripper: roundTripper}
+}
+
+type transport struct {
+	roundTripper http.RoundTripper
+}
+
+// RoundTrip executes the request as part of a client span
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx, span := otel.Tracer(tracerName).Start(req.Context(), "proxy "+req.Method,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(semconv.HTTPClientAttributesFromHTTPRequest(req)...))
+	defer span.End()
+
+	req = req.Clone(ctx)
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(req.Header))
+
+	resp, err := t.roundTripper.RoundTrip(req)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(semconv.SpanStatusFromHTTPStatusCodeAndSpanKind(http.StatusBadGateway, trace.SpanKindClient))
+		return resp, err
+	}
+
+	span.SetAttributes(semconv.HTTPAttributesFromHTTPStatusCode(resp.StatusCode)...)
+	span.SetStatus(semconv.SpanStatusFromHTTPStatusCodeAndSpanKind(resp.StatusCode, trace.SpanKindClient))
+
+	return resp, nil
+}
@@ -0,0 +1,71 @@
+package tracing_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/gateway/internal/tracing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+const traceParent = "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+
+func TestConfigure(t *testing.T) {
+	t.Run("Returns error for unsupported exporter", func(t *testing.T) {
+		_, err := tracing.Configure(context.TODO(), tracing.Config{Enabled: true, Exporter: "zipkin"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "unsupported tracing exporter")
+	})
+
+	t.Run("Succeeds when tracing is disabled", func(t *testing.T) {
+		shutdown, err := tracing.Configure(context.TODO(), tracing.Config{})
+		require.NoError(t, err)
+		require.NoError(t, shutdown(context.TODO()))
+	})
+}
+
+func TestTracing_PropagatesTraceContext(t *testing.T) {
+	// GIVEN
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	_, err := tracing.Configure(context.TODO(), tracing.Config{})
+	require.NoError(t, err)
+
+	var forwardedTraceParent string
+	target := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		forwardedTraceParent = r.Header.Get("traceparent")
+		rw.WriteHeader(http.StatusOK)
+	}))
+	defer target.Close()
+
+	client := &http.Client{Transport: tracing.NewTransport(http.DefaultTransport)}
+	handler := tracing.NewHTTPMiddleware()(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		req, err := http.NewRequestWithContext(r.Context(), http.MethodGet, target.URL, nil)
+		require.NoError(t, err)
+		resp, err := client.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		rw.WriteHeader(resp.StatusCode)
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/director/graphql", nil)
+	req.Header.Set("traceparent", traceParent)
+
+	// WHEN
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	// THEN
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+	for _, span := range spans {
+		assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", span.SpanContext().TraceID().String())
+	}
+	assert.Contains(t, forwardedTraceParent, "4bf92f3577b34da6a3ce929d0e0e4736")
+	assert.NotContains(t, forwardedTraceParent, "00f067aa0ba902b7")
+}
@@ -46,6 +46,7 @@ import (
 	"github.com/kyma-incubator/compass/components/hydrator/internal/director"
 	"github.com/kyma-incubator/compass/components/hydrator/internal/runtimemapping"
 	"github.com/kyma-incubator/compass/components/hydrator/internal/tenantmapping"
+	"github.com/kyma-incubator/compass/components/hydrator/internal/tracing"
 	"github.com/kyma-incubator/compass/components/hydrator/pkg/oathkeeper"
 	tenantmappingconst "github.com/kyma-incubator/compass/components/hydrator/pkg/tenantmapping"
 
@@ -96,6 +97,8 @@ type config struct {
 	ConsumerClaimsKeys cfg.ConsumerClaimsKeysConfig
 
 	Log log.Config
+
+	Tracing tracing.Config
 }
 
 func main() {
@@ -122,6 +125,14 @@ func main() {
 
 	logger := log.C(ctx)
 
+	shutdownTracing, err := tracing.Configure(ctx, cfg.Tracing)
+	exitOnError(err, "Failed to configure tracing")
+	defer func() {
+		if err := shutdownTracing(context.Background()); err != nil {
+			logger.WithError(err).Error("An error has occurred while shutting down tracing")
+		}
+	}()
+
 	logger.Infof("Registering metrics collectors...")
 	metricsCollector := metrics.NewCollector(cfg.MetricsConfig)
 	prometheus.MustRegister(metricsCollector)
@@ -151,7 +162,7 @@ func initAPIHandlers(ctx context.Context, logger *logrus.Entry, authenticators [
 		readyzEndpoint  = "/readyz"
 	)
 	mainRouter := mux.NewRouter()
-	mainRouter.Use(correlation.AttachCorrelationIDToContext(), log.RequestLogger(healthzEndpoint, readyzEndpoint))
+	mainRouter.Use(correlation.AttachCorrelationIDToContext(), tracing.NewHTTPMiddleware(), log.RequestLogger(healthzEndpoint, readyzEndpoint))
 
 	router := mainRouter.PathPrefix(cfg.RootAPI).Subrouter()
 	healthCheckRouter := mainRouter.NewRoute().Subrouter()
@@ -172,7 +183,7 @@ func registerHydratorHandlers(ctx context.Context, router *mux.Router, authentic
 
 	httpClient := &http.Client{
 		Timeout:   cfg.ClientTimeout,
-		Transport: httputil.NewCorrelationIDTransport(httputil.NewHTTPTransportWrapper(http.DefaultTransport.(*http.Transport))),
+		Transport: tracing.NewTransport(httputil.NewCorrelationIDTransport(httputil.NewHTTPTransportWrapper(http.DefaultTransport.(*http.Transport)))),
 	}
 
 	internalDirectorClientProvider := director.NewClientProvider(cfg.Director.InternalURL, cfg.Director.ClientTimeout, cfg.Director.SkipSSLValidation)
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	httputil "github.com/kyma-incubator/compass/components/director/pkg/http"
+	"github.com/kyma-incubator/compass/components/hydrator/internal/tracing"
 	gcli "github.com/machinebox/graphql"
 )
 
@@ -43,7 +44,7 @@ func newAuthorizedHTTPClient(timeout time.Duration, skipSSLValidation bool) *htt
 	}
 
 	return &http.Client{
-		Transport: httputil.NewCorrelationIDTransport(httputil.NewServiceAccountTokenTransportWithHeader(httputil.NewHTTPTransportWrapper(tr), "Authorization")),
+		Transport: tracing.NewTransport(httputil.NewCorrelationIDTransport(httputil.NewServiceAccountTokenTransportWithHeader(httputil.NewHTTPTransportWrapper(tr), "Authorization"))),
 		Timeout:   timeout,
 		CheckRedirect: func(req *http.Request, via []*http.Request) error {
 			return http.ErrUseLastResponse
@@ -0,0 +1,51 @@
+package tracing_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/hydrator/internal/tracing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestNewTransport(t *testing.T) {
+	// GIVEN
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	_, err := tracing.Configure(context.TODO(), tracing.Config{})
+	require.NoError(t, err)
+
+	var traceParent string
+	director := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		traceParent = r.Header.Get("traceparent")
+		rw.WriteHeader(http.StatusOK)
+	}))
+	defer director.Close()
+
+	ctx, parent := otel.Tracer("test").Start(context.TODO(), "tenant-mapping")
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, director.URL, nil)
+	require.NoError(t, err)
+
+	// WHEN
+	resp, err := tracing.NewTransport(http.DefaultTransport).RoundTrip(req)
+	parent.End()
+
+	// THEN
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+	assert.Equal(t, "HTTP POST", spans[0].Name())
+	assert.Equal(t, trace.SpanKindClient, spans[0].SpanKind())
+	assert.Equal(t, parent.SpanContext().SpanID(), spans[0].Parent().SpanID())
+	assert.Contains(t, traceParent, parent.SpanContext().TraceID().String())
+	assert.Contains(t, traceParent, spans[0].SpanContext().SpanID().String())
+}
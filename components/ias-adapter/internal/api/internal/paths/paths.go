@@ -3,5 +3,6 @@ package paths
 const (
 	HealthPath         = "/healthz"
 	ReadyPath          = "/readyz"
+	MetricsPath        = "/metrics"
 	TenantMappingsPath = "/v1/tenantMappings/:tenantID"
 )
@@ -5,6 +5,7 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 
 	"github.com/kyma-incubator/compass/components/ias-adapter/internal/api/internal/handlers"
 	"github.com/kyma-incubator/compass/components/ias-adapter/internal/api/internal/paths"
@@ -33,6 +34,7 @@ func NewServer(ctx context.Context, cfg config.Config, services Services) (*http
 	routerGroup.Use(middlewares.Logging)
 	routerGroup.GET(paths.HealthPath, handlers.HealthHandler{Service: services.HealthService}.Health)
 	routerGroup.GET(paths.ReadyPath, handlers.ReadyHandler{}.Ready)
+	routerGroup.GET(paths.MetricsPath, gin.WrapH(promhttp.Handler()))
 
 	tenantMappingRouter := routerGroup.Group("")
 
@@ -40,9 +40,19 @@ func Start(cfg config.Config) {
 		log.Fatal().Msgf("Failed to create IAS HTTPS client: %s", err)
 	}
 
+	iasService := ias.NewService(cfg.IASConfig, iasClient)
 	tenantMappingsService := service.TenantMappingsService{
 		Storage:    postgresConnection,
-		IASService: ias.NewService(cfg.IASConfig, iasClient),
+		IASService: iasService,
+	}
+
+	if cfg.Reconciliation.Enabled {
+		reconciliationService := service.ReconciliationService{
+			Storage:    postgresConnection,
+			IASService: iasService,
+		}
+		go reconciliationService.Run(globalCtx, cfg.Reconciliation.Interval)
+		log.Info().Msgf("Started reconciliation with interval %s", cfg.Reconciliation.Interval)
 	}
 
 	server, err := api.NewServer(globalCtx, cfg, api.Services{
@@ -20,6 +20,7 @@ type Config struct {
 	IASConfig         IAS
 	Postgres          Postgres
 	JWKCache          JWKCache
+	Reconciliation    Reconciliation
 }
 
 type TenantInfo struct {
@@ -55,6 +56,11 @@ type JWKCache struct {
 	RequestTimeout time.Duration `envconfig:"APP_JWK_REQUEST_TIMEOUT,default=30s"`
 }
 
+type Reconciliation struct {
+	Enabled  bool          `envconfig:"APP_RECONCILIATION_ENABLED,default=true"`
+	Interval time.Duration `envconfig:"APP_RECONCILIATION_INTERVAL,default=10m"`
+}
+
 func New() (Config, error) {
 	cfg := Config{}
 	return cfg, envconfig.InitWithPrefix(&cfg, envPrefix)
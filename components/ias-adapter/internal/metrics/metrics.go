@@ -0,0 +1,50 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const (
+	namespace               = "ias_adapter"
+	reconciliationSubsystem = "reconciliation"
+)
+
+var (
+	ReconciliationRuns = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: reconciliationSubsystem,
+		Name:      "runs_total",
+		Help:      "Total number of reconciliation runs",
+	})
+	ReconciliationErrors = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: reconciliationSubsystem,
+		Name:      "errors_total",
+		Help:      "Total number of formations which could not be reconciled",
+	})
+	DriftedApplications = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: reconciliationSubsystem,
+		Name:      "drifted_applications_total",
+		Help:      "Total number of IAS applications whose consumed APIs differed from the stored tenant mappings",
+	})
+	RepairedApplications = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: reconciliationSubsystem,
+		Name:      "repaired_applications_total",
+		Help:      "Total number of IAS applications whose consumed APIs were repaired",
+	})
+	DriftedFormations = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: reconciliationSubsystem,
+		Name:      "drifted_formations",
+		Help:      "Number of formations with drifted IAS applications found during the last reconciliation run",
+	})
+	LastRunTimestamp = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: reconciliationSubsystem,
+		Name:      "last_run_timestamp_seconds",
+		Help:      "Unix timestamp of the last completed reconciliation run",
+	})
+)
@@ -50,8 +50,9 @@ type headerTransport struct {
 }
 
 func (t *headerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
-	requestID := req.Context().Value(logCtx.RequestIDCtxKey).(string)
-	if requestID != "" {
+	// Requests sent by background jobs, e.g. the reconciler, are not bound to an inbound request
+	requestID, ok := req.Context().Value(logCtx.RequestIDCtxKey).(string)
+	if ok && requestID != "" {
 		req.Header.Add(logCtx.RequestIDHeader, requestID)
 	}
 	req.Header.Add("Content-Type", "application/json")
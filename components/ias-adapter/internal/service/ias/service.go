@@ -66,6 +66,22 @@ func (s Service) UpdateApplicationConsumedAPIs(ctx context.Context, data UpdateD
 	return nil
 }
 
+// RepairApplicationConsumedAPIs makes sure that the IAS application consumes exactly the expected APIs of the provider application.
+// Consumed APIs of the provider application which are no longer expected are removed. Consumed APIs of other applications
+// are left untouched, as they are added by other formations. It returns whether the application has drifted from the expected state.
+func (s Service) RepairApplicationConsumedAPIs(ctx context.Context, iasHost string, application types.Application,
+	providerApplicationID string, expectedConsumedAPIs []types.ApplicationConsumedAPI) (bool, error) {
+	consumedAPIs, drifted := mergeConsumedAPIs(application.Authentication.ConsumedAPIs, expectedConsumedAPIs, providerApplicationID)
+	if !drifted {
+		return false, nil
+	}
+
+	if err := s.updateApplication(ctx, iasHost, application.ID, consumedAPIs); err != nil {
+		return true, errors.Newf("failed to repair consumed APIs of IAS application '%s': %w", application.ID, err)
+	}
+	return true, nil
+}
+
 func (s Service) GetApplication(ctx context.Context, iasHost, clientID, appTenantID string) (types.Application, error) {
 	log := logger.FromContext(ctx)
 
@@ -120,6 +136,44 @@ func addConsumedAPI(consumedAPIs *[]types.ApplicationConsumedAPI, consumedAPI ty
 	*consumedAPIs = append(*consumedAPIs, consumedAPI)
 }
 
+func mergeConsumedAPIs(consumedAPIs, expectedConsumedAPIs []types.ApplicationConsumedAPI,
+	providerApplicationID string) ([]types.ApplicationConsumedAPI, bool) {
+	expectedAPINames := make(map[string]bool, len(expectedConsumedAPIs))
+	for _, expected := range expectedConsumedAPIs {
+		expectedAPINames[expected.APIName] = true
+	}
+
+	drifted := false
+	merged := make([]types.ApplicationConsumedAPI, 0, len(consumedAPIs))
+	for _, consumedAPI := range consumedAPIs {
+		if consumedAPI.AppID == providerApplicationID && !expectedAPINames[consumedAPI.APIName] {
+			drifted = true
+			continue
+		}
+		merged = append(merged, consumedAPI)
+	}
+
+	for _, expected := range expectedConsumedAPIs {
+		found := false
+		for i := range merged {
+			if merged[i].APIName != expected.APIName {
+				continue
+			}
+			found = true
+			if merged[i].AppID != expected.AppID {
+				merged[i] = expected
+				drifted = true
+			}
+			break
+		}
+		if !found {
+			merged = append(merged, expected)
+			drifted = true
+		}
+	}
+	return merged, drifted
+}
+
 func removeConsumedAPI(consumedAPIs *[]types.ApplicationConsumedAPI, apiName string) {
 	found := false
 	i := -1
@@ -50,3 +50,72 @@ var _ = Describe("Removing consumed API", func() {
 		})
 	})
 })
+
+var _ = Describe("Merging consumed APIs", func() {
+	When("All expected consumed APIs are present", func() {
+		It("Shouldn't report drift", func() {
+			consumedAPIs := []types.ApplicationConsumedAPI{testConsumedAPI}
+			merged, drifted := mergeConsumedAPIs(consumedAPIs, []types.ApplicationConsumedAPI{testConsumedAPI}, "appId1")
+			Expect(drifted).To(BeFalse())
+			Expect(merged).To(Equal(consumedAPIs))
+		})
+	})
+	When("An expected consumed API is missing", func() {
+		It("Should add it and report drift", func() {
+			missingConsumedAPI := types.ApplicationConsumedAPI{
+				Name:    "name2",
+				APIName: "apiname2",
+				AppID:   "appId2",
+			}
+			merged, drifted := mergeConsumedAPIs([]types.ApplicationConsumedAPI{testConsumedAPI},
+				[]types.ApplicationConsumedAPI{missingConsumedAPI}, "appId2")
+			Expect(drifted).To(BeTrue())
+			Expect(merged).To(Equal([]types.ApplicationConsumedAPI{testConsumedAPI, missingConsumedAPI}))
+		})
+	})
+	When("An expected consumed API points to another application", func() {
+		It("Should replace it and report drift", func() {
+			consumedAPIs := []types.ApplicationConsumedAPI{testConsumedAPI}
+			expectedConsumedAPI := testConsumedAPI
+			expectedConsumedAPI.AppID = "appId2"
+			merged, drifted := mergeConsumedAPIs(consumedAPIs, []types.ApplicationConsumedAPI{expectedConsumedAPI}, "appId2")
+			Expect(drifted).To(BeTrue())
+			Expect(merged).To(Equal([]types.ApplicationConsumedAPI{expectedConsumedAPI}))
+			Expect(consumedAPIs[0].AppID).To(Equal("appId1"))
+		})
+	})
+	When("There are consumed APIs of other applications which are not expected", func() {
+		It("Should keep them", func() {
+			otherConsumedAPI := types.ApplicationConsumedAPI{
+				Name:    "other",
+				APIName: "other",
+				AppID:   "otherAppId",
+			}
+			consumedAPIs := []types.ApplicationConsumedAPI{otherConsumedAPI, testConsumedAPI}
+			merged, drifted := mergeConsumedAPIs(consumedAPIs, []types.ApplicationConsumedAPI{testConsumedAPI}, "appId1")
+			Expect(drifted).To(BeFalse())
+			Expect(merged).To(Equal(consumedAPIs))
+		})
+	})
+	When("There are consumed APIs of the provider application which are no longer expected", func() {
+		It("Should remove them and report drift", func() {
+			staleConsumedAPI := types.ApplicationConsumedAPI{
+				Name:    "stale",
+				APIName: "stale",
+				AppID:   "appId1",
+			}
+			consumedAPIs := []types.ApplicationConsumedAPI{staleConsumedAPI, testConsumedAPI}
+			merged, drifted := mergeConsumedAPIs(consumedAPIs, []types.ApplicationConsumedAPI{testConsumedAPI}, "appId1")
+			Expect(drifted).To(BeTrue())
+			Expect(merged).To(Equal([]types.ApplicationConsumedAPI{testConsumedAPI}))
+			Expect(consumedAPIs).To(HaveLen(2))
+		})
+	})
+	When("No consumed APIs of the provider application are expected", func() {
+		It("Should remove all of them", func() {
+			merged, drifted := mergeConsumedAPIs([]types.ApplicationConsumedAPI{testConsumedAPI}, nil, "appId1")
+			Expect(drifted).To(BeTrue())
+			Expect(merged).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,179 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/ias-adapter/internal/errors"
+	"github.com/kyma-incubator/compass/components/ias-adapter/internal/logger"
+	"github.com/kyma-incubator/compass/components/ias-adapter/internal/metrics"
+	"github.com/kyma-incubator/compass/components/ias-adapter/internal/storage/postgres"
+	"github.com/kyma-incubator/compass/components/ias-adapter/internal/types"
+)
+
+type ReconciliationStorage interface {
+	ListFormationIDs(ctx context.Context) ([]string, error)
+	ListTenantMappings(ctx context.Context, formationID string) (map[string]types.TenantMapping, error)
+	TryWithReconciliationLock(ctx context.Context, fn func(ctx context.Context) error) (bool, error)
+	TryWithFormationLock(ctx context.Context, formationID string, fn func(ctx context.Context) error) (bool, error)
+}
+
+type ReconciliationIASService interface {
+	GetApplication(ctx context.Context, iasHost, clientID, appTenantID string) (types.Application, error)
+	RepairApplicationConsumedAPIs(ctx context.Context, iasHost string, application types.Application,
+		providerApplicationID string, expectedConsumedAPIs []types.ApplicationConsumedAPI) (bool, error)
+}
+
+// ReconciliationService brings the consumed APIs of the IAS applications in line with the stored tenant mappings.
+// It repairs applications which were edited manually or were left behind by a failed update.
+// Only one replica reconciles at a time, and formations whose tenant mappings are being processed are skipped until the next run.
+type ReconciliationService struct {
+	Storage    ReconciliationStorage
+	IASService ReconciliationIASService
+}
+
+// Run reconciles all formations every interval until the context is cancelled
+func (s ReconciliationService) Run(ctx context.Context, interval time.Duration) {
+	log := logger.FromContext(ctx)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.Reconcile(ctx); err != nil {
+			log.Err(err).Msg("Reconciliation finished with errors")
+		}
+
+		select {
+		case <-ctx.Done():
+			log.Info().Msg("Stopped reconciliation")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s ReconciliationService) Reconcile(ctx context.Context) error {
+	log := logger.FromContext(ctx)
+
+	var reconcileErr error
+	acquired, err := s.Storage.TryWithReconciliationLock(ctx, func(ctx context.Context) error {
+		reconcileErr = s.reconcileFormations(ctx)
+		return nil
+	})
+	if err != nil {
+		return errors.Newf("failed to acquire reconciliation lock: %w", postgres.Error(err))
+	}
+	if !acquired {
+		log.Info().Msg("Skipping reconciliation as it is running in another replica")
+		return nil
+	}
+	return reconcileErr
+}
+
+func (s ReconciliationService) reconcileFormations(ctx context.Context) error {
+	log := logger.FromContext(ctx)
+	metrics.ReconciliationRuns.Inc()
+
+	formationIDs, err := s.Storage.ListFormationIDs(ctx)
+	if err != nil {
+		log.Err(err).Msg("Failed to list formations with tenant mappings")
+		return errors.Newf("failed to list formations with tenant mappings: %w", postgres.Error(err))
+	}
+
+	var (
+		reconcileErrs     error
+		driftedFormations int
+	)
+	for _, formationID := range formationIDs {
+		var drifted bool
+		acquired, err := s.Storage.TryWithFormationLock(ctx, formationID, func(ctx context.Context) error {
+			var err error
+			drifted, err = s.reconcileFormation(ctx, formationID)
+			return err
+		})
+		if !acquired && err == nil {
+			log.Info().Msgf("Skipping formation '%s' as its tenant mappings are being processed", formationID)
+			continue
+		}
+		if drifted {
+			driftedFormations++
+		}
+		if err != nil {
+			metrics.ReconciliationErrors.Inc()
+			reconcileErrs = errors.Join(reconcileErrs,
+				errors.Newf("failed to reconcile formation '%s': %w", formationID, err))
+		}
+	}
+
+	metrics.DriftedFormations.Set(float64(driftedFormations))
+	metrics.LastRunTimestamp.SetToCurrentTime()
+	log.Info().Msgf("Reconciled %d formations, %d of them had drifted", len(formationIDs), driftedFormations)
+
+	return reconcileErrs
+}
+
+func (s ReconciliationService) reconcileFormation(ctx context.Context, formationID string) (bool, error) {
+	log := logger.FromContext(ctx)
+
+	tenantMappingsFromDB, err := s.Storage.ListTenantMappings(ctx, formationID)
+	if err != nil {
+		return false, errors.Newf("failed to get tenant mappings: %w", postgres.Error(err))
+	}
+	if len(tenantMappingsFromDB) != 2 {
+		// Consumed APIs are set only after both applications in the formation are assigned
+		return false, nil
+	}
+
+	tenantMappings := toArray(tenantMappingsFromDB)
+	iasApps := make([]types.Application, 0, len(tenantMappings))
+	for _, tenantMapping := range tenantMappings {
+		assignedTenant := tenantMapping.AssignedTenants[0]
+		iasApp, err := s.IASService.GetApplication(ctx, tenantMapping.ReceiverTenant.ApplicationURL,
+			assignedTenant.Parameters.ClientID, assignedTenant.LocalTenantID)
+		if err != nil {
+			return false, errors.Newf("failed to get IAS application with UCL ID '%s': %w",
+				assignedTenant.UCLApplicationID, err)
+		}
+		iasApps = append(iasApps, iasApp)
+	}
+
+	formationDrifted := false
+	for idx, tenantMapping := range tenantMappings {
+		uclAppID := tenantMapping.AssignedTenants[0].UCLApplicationID
+		providerApplicationID := iasApps[abs(idx-1)].ID
+		expectedConsumedAPIs := expectedConsumedAPIs(tenantMapping, providerApplicationID)
+
+		drifted, err := s.IASService.RepairApplicationConsumedAPIs(ctx, tenantMapping.ReceiverTenant.ApplicationURL,
+			iasApps[idx], providerApplicationID, expectedConsumedAPIs)
+		if drifted {
+			formationDrifted = true
+			metrics.DriftedApplications.Inc()
+			log.Warn().Msgf("Consumed APIs of application with UCL ID '%s' in formation '%s' have drifted",
+				uclAppID, formationID)
+		}
+		if err != nil {
+			return formationDrifted, errors.Newf("failed to repair consumed APIs of application with UCL ID '%s': %w",
+				uclAppID, err)
+		}
+		if drifted {
+			metrics.RepairedApplications.Inc()
+			log.Info().Msgf("Repaired consumed APIs of application with UCL ID '%s' in formation '%s'",
+				uclAppID, formationID)
+		}
+	}
+	return formationDrifted, nil
+}
+
+func expectedConsumedAPIs(tenantMapping types.TenantMapping, providerApplicationID string) []types.ApplicationConsumedAPI {
+	consumedAPIs := tenantMapping.AssignedTenants[0].Configuration.ConsumedAPIs
+	expected := make([]types.ApplicationConsumedAPI, 0, len(consumedAPIs))
+	for _, consumedAPI := range consumedAPIs {
+		expected = append(expected, types.ApplicationConsumedAPI{
+			Name:    consumedAPI,
+			APIName: consumedAPI,
+			AppID:   providerApplicationID,
+		})
+	}
+	return expected
+}
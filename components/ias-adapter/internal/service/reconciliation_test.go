@@ -0,0 +1,216 @@
+package service
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/ias-adapter/internal/errors"
+	"github.com/kyma-incubator/compass/components/ias-adapter/internal/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+const (
+	reconciledFormationID = "formation-id"
+	consumerClientID      = "consumer-client-id"
+	providerClientID      = "provider-client-id"
+	consumerIASAppID      = "consumer-ias-app-id"
+	providerIASAppID      = "provider-ias-app-id"
+)
+
+var testErr = errors.New("test error")
+
+type fakeReconciliationStorage struct {
+	formationIDs       []string
+	listFormationIDErr error
+	tenantMappings     map[string]map[string]types.TenantMapping
+	reconciliationBusy bool
+	busyFormations     map[string]bool
+}
+
+func (s *fakeReconciliationStorage) ListFormationIDs(_ context.Context) ([]string, error) {
+	return s.formationIDs, s.listFormationIDErr
+}
+
+func (s *fakeReconciliationStorage) ListTenantMappings(_ context.Context, formationID string) (map[string]types.TenantMapping, error) {
+	return s.tenantMappings[formationID], nil
+}
+
+func (s *fakeReconciliationStorage) TryWithReconciliationLock(ctx context.Context, fn func(ctx context.Context) error) (bool, error) {
+	if s.reconciliationBusy {
+		return false, nil
+	}
+	return true, fn(ctx)
+}
+
+func (s *fakeReconciliationStorage) TryWithFormationLock(ctx context.Context, formationID string, fn func(ctx context.Context) error) (bool, error) {
+	if s.busyFormations[formationID] {
+		return false, nil
+	}
+	return true, fn(ctx)
+}
+
+type repairCall struct {
+	application           types.Application
+	providerApplicationID string
+	expectedConsumedAPIs  []types.ApplicationConsumedAPI
+}
+
+type fakeReconciliationIASService struct {
+	applications     map[string]types.Application
+	getErr           error
+	driftedByAppID   map[string]bool
+	repairErrByAppID map[string]error
+	repairCalls      []repairCall
+}
+
+func (s *fakeReconciliationIASService) GetApplication(_ context.Context, _, clientID, _ string) (types.Application, error) {
+	if s.getErr != nil {
+		return types.Application{}, s.getErr
+	}
+	return s.applications[clientID], nil
+}
+
+func (s *fakeReconciliationIASService) RepairApplicationConsumedAPIs(_ context.Context, _ string, application types.Application,
+	providerApplicationID string, expectedConsumedAPIs []types.ApplicationConsumedAPI) (bool, error) {
+	s.repairCalls = append(s.repairCalls, repairCall{
+		application:           application,
+		providerApplicationID: providerApplicationID,
+		expectedConsumedAPIs:  expectedConsumedAPIs,
+	})
+	return s.driftedByAppID[application.ID], s.repairErrByAppID[application.ID]
+}
+
+func fixReconciledTenantMapping(uclAppID, clientID string, consumedAPIs ...string) types.TenantMapping {
+	return types.TenantMapping{
+		FormationID:    reconciledFormationID,
+		ReceiverTenant: types.ReceiverTenant{ApplicationURL: "https://ias.example.com"},
+		AssignedTenants: []types.AssignedTenant{{
+			UCLApplicationID: uclAppID,
+			LocalTenantID:    "local-tenant-id",
+			Parameters:       types.AssignedTenantParameters{ClientID: clientID},
+			Configuration:    types.AssignedTenantConfiguration{ConsumedAPIs: consumedAPIs},
+		}},
+	}
+}
+
+var _ = Describe("Reconciling consumed APIs", func() {
+	var (
+		ctx        context.Context
+		storage    *fakeReconciliationStorage
+		iasService *fakeReconciliationIASService
+		reconciler ReconciliationService
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		storage = &fakeReconciliationStorage{
+			formationIDs: []string{reconciledFormationID},
+			tenantMappings: map[string]map[string]types.TenantMapping{
+				reconciledFormationID: {
+					"consumer": fixReconciledTenantMapping("consumer", consumerClientID, "api1", "api2"),
+					"provider": fixReconciledTenantMapping("provider", providerClientID),
+				},
+			},
+		}
+		iasService = &fakeReconciliationIASService{
+			applications: map[string]types.Application{
+				consumerClientID: {ID: consumerIASAppID},
+				providerClientID: {ID: providerIASAppID},
+			},
+		}
+		reconciler = ReconciliationService{Storage: storage, IASService: iasService}
+	})
+
+	repairCallFor := func(appID string) repairCall {
+		for _, call := range iasService.repairCalls {
+			if call.application.ID == appID {
+				return call
+			}
+		}
+		Fail("no repair call for application " + appID)
+		return repairCall{}
+	}
+
+	When("The applications are in sync", func() {
+		It("Should check both applications against the expected consumed APIs", func() {
+			Expect(reconciler.Reconcile(ctx)).To(Succeed())
+			Expect(iasService.repairCalls).To(HaveLen(2))
+
+			consumerCall := repairCallFor(consumerIASAppID)
+			Expect(consumerCall.providerApplicationID).To(Equal(providerIASAppID))
+			Expect(consumerCall.expectedConsumedAPIs).To(Equal([]types.ApplicationConsumedAPI{
+				{Name: "api1", APIName: "api1", AppID: providerIASAppID},
+				{Name: "api2", APIName: "api2", AppID: providerIASAppID},
+			}))
+
+			providerCall := repairCallFor(providerIASAppID)
+			Expect(providerCall.providerApplicationID).To(Equal(consumerIASAppID))
+			Expect(providerCall.expectedConsumedAPIs).To(BeEmpty())
+		})
+	})
+
+	When("An application has drifted", func() {
+		It("Should repair it", func() {
+			iasService.driftedByAppID = map[string]bool{consumerIASAppID: true}
+			Expect(reconciler.Reconcile(ctx)).To(Succeed())
+			Expect(iasService.repairCalls).To(HaveLen(2))
+		})
+	})
+
+	When("Only one application of the formation has a tenant mapping", func() {
+		It("Should not touch the IAS application", func() {
+			delete(storage.tenantMappings[reconciledFormationID], "provider")
+			Expect(reconciler.Reconcile(ctx)).To(Succeed())
+			Expect(iasService.repairCalls).To(BeEmpty())
+		})
+	})
+
+	When("Another replica is reconciling", func() {
+		It("Should skip the run", func() {
+			storage.reconciliationBusy = true
+			storage.listFormationIDErr = testErr
+			Expect(reconciler.Reconcile(ctx)).To(Succeed())
+			Expect(iasService.repairCalls).To(BeEmpty())
+		})
+	})
+
+	When("The tenant mappings of a formation are being processed", func() {
+		It("Should skip the formation", func() {
+			storage.busyFormations = map[string]bool{reconciledFormationID: true}
+			Expect(reconciler.Reconcile(ctx)).To(Succeed())
+			Expect(iasService.repairCalls).To(BeEmpty())
+		})
+	})
+
+	When("Listing the formations fails", func() {
+		It("Should return an error", func() {
+			storage.listFormationIDErr = testErr
+			Expect(reconciler.Reconcile(ctx)).To(MatchError(ContainSubstring("failed to list formations with tenant mappings")))
+		})
+	})
+
+	When("Getting an IAS application fails", func() {
+		It("Should return an error without repairing the formation", func() {
+			iasService.getErr = testErr
+			err := reconciler.Reconcile(ctx)
+			Expect(err).To(MatchError(ContainSubstring("failed to reconcile formation 'formation-id'")))
+			Expect(err).To(MatchError(ContainSubstring("failed to get IAS application")))
+			Expect(iasService.repairCalls).To(BeEmpty())
+		})
+	})
+
+	When("Repairing an application fails", func() {
+		It("Should return an error and continue with the other formations", func() {
+			otherFormationID := "other-formation-id"
+			storage.formationIDs = []string{reconciledFormationID, otherFormationID}
+			storage.tenantMappings[otherFormationID] = storage.tenantMappings[reconciledFormationID]
+			iasService.driftedByAppID = map[string]bool{consumerIASAppID: true}
+			iasService.repairErrByAppID = map[string]error{consumerIASAppID: testErr}
+
+			err := reconciler.Reconcile(ctx)
+			Expect(err).To(MatchError(ContainSubstring("failed to repair consumed APIs of application with UCL ID 'consumer'")))
+			Expect(err).To(MatchError(ContainSubstring("failed to reconcile formation 'other-formation-id'")))
+			Expect(len(iasService.repairCalls)).To(BeNumerically(">=", 2))
+		})
+	})
+})
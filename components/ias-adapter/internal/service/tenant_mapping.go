@@ -14,6 +14,7 @@ type TenantMappingsStorage interface {
 	UpsertTenantMapping(ctx context.Context, tenantMapping types.TenantMapping) error
 	ListTenantMappings(ctx context.Context, formationID string) (map[string]types.TenantMapping, error)
 	DeleteTenantMapping(ctx context.Context, formationID, applicationID string) error
+	WithFormationLock(ctx context.Context, formationID string, fn func(ctx context.Context) error) error
 }
 
 type IASService interface {
@@ -27,6 +28,14 @@ type TenantMappingsService struct {
 }
 
 func (s TenantMappingsService) ProcessTenantMapping(ctx context.Context, tenantMapping types.TenantMapping) error {
+	formationID := tenantMapping.FormationID
+	// The formation is locked so that its tenant mappings are not reconciled while they are processed
+	return s.Storage.WithFormationLock(ctx, formationID, func(ctx context.Context) error {
+		return s.processTenantMapping(ctx, tenantMapping)
+	})
+}
+
+func (s TenantMappingsService) processTenantMapping(ctx context.Context, tenantMapping types.TenantMapping) error {
 	formationID := tenantMapping.FormationID
 	tenantMappingsFromDB, err := s.Storage.ListTenantMappings(ctx, formationID)
 	if err != nil {
@@ -14,6 +14,8 @@ import (
 type pgxPool interface {
 	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
 	Exec(ctx context.Context, sql string, arguments ...any) (pgconn.CommandTag, error)
+	Begin(ctx context.Context) (pgx.Tx, error)
+	Acquire(ctx context.Context) (*pgxpool.Conn, error)
 	Ping(ctx context.Context) error
 	Close()
 }
@@ -42,15 +44,42 @@ func NewConnection(ctx context.Context, cfg config.Postgres) (Connection, error)
 	}, nil
 }
 
+// rowsWithCancel releases the timeout context of a query once its rows are closed.
+// Cancelling the context earlier would interrupt the reading of the rows and close the underlying connection.
+type rowsWithCancel struct {
+	pgx.Rows
+	cancel context.CancelFunc
+}
+
+func (r rowsWithCancel) Close() {
+	r.Rows.Close()
+	r.cancel()
+}
+
 func (c Connection) query(ctx context.Context, sql string, args ...any) (pgx.Rows, error) {
 	timeoutCtx, cancel := context.WithTimeout(ctx, c.cfg.RequestTimeout)
-	defer cancel()
-	return c.pool.Query(timeoutCtx, sql, args...)
+	var (
+		rows pgx.Rows
+		err  error
+	)
+	if tx, ok := txFromContext(ctx); ok {
+		rows, err = tx.Query(timeoutCtx, sql, args...)
+	} else {
+		rows, err = c.pool.Query(timeoutCtx, sql, args...)
+	}
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	return rowsWithCancel{Rows: rows, cancel: cancel}, nil
 }
 
 func (c Connection) exec(ctx context.Context, sql string, arguments ...any) (pgconn.CommandTag, error) {
 	timeoutCtx, cancel := context.WithTimeout(ctx, c.cfg.RequestTimeout)
 	defer cancel()
+	if tx, ok := txFromContext(ctx); ok {
+		return tx.Exec(timeoutCtx, sql, arguments...)
+	}
 	return c.pool.Exec(timeoutCtx, sql, arguments...)
 }
 
@@ -0,0 +1,119 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/kyma-incubator/compass/components/ias-adapter/internal/errors"
+)
+
+const (
+	formationLockKeyPrefix = "formation:"
+	reconciliationLockKey  = "reconciliation"
+)
+
+var (
+	advisoryLockQuery           = "SELECT pg_advisory_xact_lock(hashtext($1))"
+	tryAdvisoryLockQuery        = "SELECT pg_try_advisory_xact_lock(hashtext($1))"
+	trySessionAdvisoryLockQuery = "SELECT pg_try_advisory_lock(hashtext($1))"
+	sessionAdvisoryUnlockQuery  = "SELECT pg_advisory_unlock(hashtext($1))"
+)
+
+// txStatusFailed is the status of a transaction in which a statement has failed. Postgres rolls back such a transaction on commit.
+const txStatusFailed = 'E'
+
+type txCtxKey struct{}
+
+// WithFormationLock runs fn while holding the lock of the formation, waiting until the lock is free.
+// The lock serialises the processing of tenant mappings and the reconciliation of the formation across all replicas.
+func (c Connection) WithFormationLock(ctx context.Context, formationID string, fn func(ctx context.Context) error) error {
+	_, err := c.withAdvisoryLock(ctx, formationLockKeyPrefix+formationID, true, fn)
+	return err
+}
+
+// TryWithFormationLock runs fn only if the lock of the formation is free. It reports whether fn was run.
+func (c Connection) TryWithFormationLock(ctx context.Context, formationID string, fn func(ctx context.Context) error) (bool, error) {
+	return c.withAdvisoryLock(ctx, formationLockKeyPrefix+formationID, false, fn)
+}
+
+// TryWithReconciliationLock runs fn only if no other replica is reconciling. It reports whether fn was run.
+// The lock is held by a session on a dedicated connection instead of a transaction, as the reconciliation runs for a long time
+// and its queries are executed outside of the lock's connection.
+func (c Connection) TryWithReconciliationLock(ctx context.Context, fn func(ctx context.Context) error) (bool, error) {
+	return c.withSessionAdvisoryLock(ctx, reconciliationLockKey, fn)
+}
+
+// withAdvisoryLock runs fn in a transaction holding the transaction-scoped advisory lock with the given key.
+// The queries executed by fn through the connection use the same transaction, so that fn does not need a second
+// connection from the pool while the lock is held. The transaction is committed even when fn fails, so the changes
+// made by fn are kept the same way as they would be without the lock. If a query of fn has failed, the transaction
+// is aborted and none of the changes can be kept, so it is rolled back and reported as an error.
+func (c Connection) withAdvisoryLock(ctx context.Context, key string, wait bool,
+	fn func(ctx context.Context) error) (bool, error) {
+	tx, err := c.pool.Begin(ctx)
+	if err != nil {
+		return false, errors.Newf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		// Rolling back a committed transaction is a no-op
+		_ = tx.Rollback(context.Background())
+	}()
+
+	if wait {
+		if _, err := tx.Exec(ctx, advisoryLockQuery, key); err != nil {
+			return false, errors.Newf("failed to acquire lock '%s': %w", key, err)
+		}
+	} else {
+		acquired := false
+		if err := tx.QueryRow(ctx, tryAdvisoryLockQuery, key).Scan(&acquired); err != nil {
+			return false, errors.Newf("failed to acquire lock '%s': %w", key, err)
+		}
+		if !acquired {
+			return false, nil
+		}
+	}
+
+	fnErr := fn(context.WithValue(ctx, txCtxKey{}, tx))
+	if tx.Conn().PgConn().TxStatus() == txStatusFailed {
+		abortErr := errors.Newf("transaction holding lock '%s' was aborted, none of its changes are kept", key)
+		return true, errors.Join(abortErr, fnErr)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return true, errors.Join(errors.Newf("failed to commit transaction: %w", err), fnErr)
+	}
+	return true, fnErr
+}
+
+// withSessionAdvisoryLock runs fn only if the session-level advisory lock with the given key is free. The lock is held
+// by a dedicated connection from the pool until fn returns. The queries executed by fn use the other connections of the pool.
+func (c Connection) withSessionAdvisoryLock(ctx context.Context, key string, fn func(ctx context.Context) error) (bool, error) {
+	conn, err := c.pool.Acquire(ctx)
+	if err != nil {
+		return false, errors.Newf("failed to acquire connection: %w", err)
+	}
+	defer conn.Release()
+
+	acquired := false
+	if err := conn.QueryRow(ctx, trySessionAdvisoryLockQuery, key).Scan(&acquired); err != nil {
+		return false, errors.Newf("failed to acquire lock '%s': %w", key, err)
+	}
+	if !acquired {
+		return false, nil
+	}
+
+	fnErr := fn(ctx)
+
+	// The lock is released even if ctx is done. If it can not be released, the connection is closed, which ends
+	// the session and releases the lock, instead of returning the connection with the lock to the pool
+	if _, err := conn.Exec(context.Background(), sessionAdvisoryUnlockQuery, key); err != nil {
+		_ = conn.Conn().Close(context.Background())
+		return true, errors.Join(errors.Newf("failed to release lock '%s': %w", key, err), fnErr)
+	}
+	return true, fnErr
+}
+
+func txFromContext(ctx context.Context) (pgx.Tx, bool) {
+	tx, ok := ctx.Value(txCtxKey{}).(pgx.Tx)
+	return tx, ok
+}
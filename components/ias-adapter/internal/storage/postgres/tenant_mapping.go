@@ -14,6 +14,7 @@ const tenantMappingsTable = "tenant_mappings"
 var (
 	upsertTenantMappingQuery  = fmt.Sprintf("INSERT INTO %s (formation_id, ucl_application_id, value) VALUES ($1, $2, $3) ON CONFLICT ON CONSTRAINT pk DO UPDATE SET value=$3", tenantMappingsTable)
 	selectTenantMappingsQuery = fmt.Sprintf("SELECT value FROM %s WHERE formation_id=$1", tenantMappingsTable)
+	selectFormationIDsQuery   = fmt.Sprintf("SELECT DISTINCT formation_id FROM %s", tenantMappingsTable)
 	deleteTenantMappingQuery  = fmt.Sprintf("DELETE FROM %s WHERE formation_id=$1 AND ucl_application_id=$2", tenantMappingsTable)
 )
 
@@ -34,6 +35,7 @@ func (c Connection) ListTenantMappings(ctx context.Context, formationID string)
 	if err != nil {
 		return tenantMappings, errors.Newf("failed to execute query: %w", err)
 	}
+	defer rows.Close()
 	for rows.Next() {
 		var (
 			tenantMappingJSONString string
@@ -57,6 +59,27 @@ func (c Connection) ListTenantMappings(ctx context.Context, formationID string)
 	return tenantMappings, nil
 }
 
+func (c Connection) ListFormationIDs(ctx context.Context) ([]string, error) {
+	formationIDs := []string{}
+	rows, err := c.query(ctx, selectFormationIDsQuery)
+	if err != nil {
+		return formationIDs, errors.Newf("failed to execute query: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var formationID string
+		if err := rows.Err(); err != nil {
+			return formationIDs, errors.Newf("failed to read db row: %w", err)
+		}
+		if err := rows.Scan(&formationID); err != nil {
+			return formationIDs, errors.Newf("failed to scan row: %w", err)
+		}
+		formationIDs = append(formationIDs, formationID)
+	}
+
+	return formationIDs, nil
+}
+
 func (c Connection) DeleteTenantMapping(ctx context.Context, formationID, applicationID string) error {
 	if _, err := c.exec(ctx, deleteTenantMappingQuery, formationID, applicationID); err != nil {
 		return errors.Newf("failed to delete tenant mapping with formation_id '%s': %w", err)
@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP INDEX IF EXISTS operation_priority_queue_idx;
+
+ALTER TABLE operation DROP COLUMN updated_at;
+
+COMMIT;
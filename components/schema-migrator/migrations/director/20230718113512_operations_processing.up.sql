@@ -0,0 +1,10 @@
+BEGIN;
+
+ALTER TABLE operation ADD COLUMN updated_at TIMESTAMP;
+
+UPDATE operation
+SET updated_at = created_at;
+
+CREATE INDEX operation_priority_queue_idx ON operation (op_type, status, priority DESC, created_at);
+
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+
+DROP TABLE formation_assignment_transitions;
+
+COMMIT;
@@ -0,0 +1,33 @@
+BEGIN;
+
+-- The transitions are kept after the formation assignment is deleted, so there is no foreign key to the formation_assignments table.
+-- The formation and the objects of the assignment are stored in the row instead, and old transitions are removed by a retention job.
+CREATE TABLE formation_assignment_transitions (
+    id                      UUID PRIMARY KEY CHECK (id <> '00000000-0000-0000-0000-000000000000'),
+    formation_assignment_id UUID         NOT NULL CHECK (formation_assignment_id <> '00000000-0000-0000-0000-000000000000'),
+    formation_id            UUID         NOT NULL CHECK (formation_id <> '00000000-0000-0000-0000-000000000000'),
+    tenant_id               UUID         NOT NULL CHECK (tenant_id <> '00000000-0000-0000-0000-000000000000'),
+    source                  UUID         NOT NULL,
+    source_type             TEXT         NOT NULL CHECK ( source_type IN ('APPLICATION', 'RUNTIME', 'RUNTIME_CONTEXT')),
+    target                  UUID         NOT NULL,
+    target_type             TEXT         NOT NULL CHECK ( target_type IN ('APPLICATION', 'RUNTIME', 'RUNTIME_CONTEXT')),
+    old_state               TEXT         NOT NULL,
+    new_state               TEXT         NOT NULL,
+    actor                   VARCHAR(256) NOT NULL CHECK ( actor IN ('NOTIFICATION_RESPONSE', 'STATUS_API', 'RESET', 'SYSTEM')),
+    config_diff             TEXT,
+    created_at              TIMESTAMP    NOT NULL
+);
+
+CREATE INDEX idx_formation_assignment_transitions_formation_assignment_id
+    ON formation_assignment_transitions (formation_assignment_id, created_at);
+
+CREATE INDEX idx_formation_assignment_transitions_formation_id
+    ON formation_assignment_transitions (formation_id);
+
+CREATE INDEX idx_formation_assignment_transitions_tenant_id
+    ON formation_assignment_transitions (tenant_id);
+
+CREATE INDEX idx_formation_assignment_transitions_created_at
+    ON formation_assignment_transitions (created_at);
+
+COMMIT;
@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP VIEW health_checks_tenants;
+DROP TABLE health_checks;
+
+COMMIT;
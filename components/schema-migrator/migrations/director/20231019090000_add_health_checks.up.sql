@@ -0,0 +1,28 @@
+BEGIN;
+
+CREATE TABLE health_checks (
+    id        UUID PRIMARY KEY CHECK (id <> '00000000-0000-0000-0000-000000000000'),
+    app_id    UUID         NOT NULL REFERENCES applications (id) ON DELETE CASCADE,
+    type      VARCHAR(256) NOT NULL CHECK ( type IN ('MANAGEMENT_PLANE_APPLICATION_HEALTHCHECK')),
+    condition VARCHAR(256) NOT NULL CHECK ( condition IN ('SUCCEEDED', 'FAILED')),
+    message   TEXT,
+    timestamp TIMESTAMP    NOT NULL
+);
+
+CREATE INDEX idx_health_checks_app_id_timestamp ON health_checks (app_id, timestamp);
+CREATE INDEX idx_health_checks_timestamp ON health_checks (timestamp);
+
+CREATE OR REPLACE VIEW health_checks_tenants (id, app_id, type, condition, message, timestamp, tenant_id, owner)
+AS
+SELECT hc.id,
+       hc.app_id,
+       hc.type,
+       hc.condition,
+       hc.message,
+       hc.timestamp,
+       ta.tenant_id,
+       ta.owner
+FROM health_checks hc
+         JOIN tenant_applications ta ON hc.app_id = ta.id;
+
+COMMIT;
@@ -0,0 +1,21 @@
+BEGIN;
+
+CREATE TABLE notifications_outbox (
+    id              UUID PRIMARY KEY CHECK (id <> '00000000-0000-0000-0000-000000000000'),
+    idempotency_key VARCHAR(512) NOT NULL UNIQUE,
+    type            VARCHAR(256) NOT NULL CHECK ( type IN ('FORMATION_ASSIGNMENT_NOTIFICATIONS', 'FORMATION_NOTIFICATIONS')),
+    tenant_id       UUID         NOT NULL REFERENCES business_tenant_mappings (id) ON DELETE CASCADE,
+    correlation_id  VARCHAR(256),
+    payload         JSONB        NOT NULL,
+    status          VARCHAR(256) NOT NULL CHECK ( status IN ('PENDING', 'DELIVERED', 'FAILED')),
+    attempts        INTEGER      NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP    NOT NULL,
+    last_error      TEXT,
+    created_at      TIMESTAMP    NOT NULL,
+    updated_at      TIMESTAMP
+);
+
+CREATE INDEX idx_notifications_outbox_status_next_attempt_at ON notifications_outbox (status, next_attempt_at);
+CREATE INDEX idx_notifications_outbox_status_updated_at ON notifications_outbox (status, updated_at);
+
+COMMIT;
@@ -0,0 +1,9 @@
+BEGIN;
+
+DROP VIEW IF EXISTS capabilities_tenants;
+DROP VIEW IF EXISTS entity_types_tenants;
+
+DROP TABLE capabilities;
+DROP TABLE entity_types;
+
+COMMIT;
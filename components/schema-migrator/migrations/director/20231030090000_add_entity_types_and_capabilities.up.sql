@@ -0,0 +1,80 @@
+BEGIN;
+
+CREATE TABLE entity_types (
+    id                      UUID PRIMARY KEY CHECK (id <> '00000000-0000-0000-0000-000000000000'),
+    app_id                  UUID REFERENCES applications (id) ON DELETE CASCADE,
+    app_template_version_id UUID REFERENCES app_template_versions (id) ON DELETE CASCADE,
+    package_id              UUID NOT NULL REFERENCES packages (id) ON DELETE CASCADE,
+    ord_id                  VARCHAR(256) NOT NULL,
+    local_id                VARCHAR(256) NOT NULL,
+    correlation_ids         JSONB,
+    level                   VARCHAR(256) NOT NULL,
+    title                   VARCHAR(256) NOT NULL,
+    short_description       VARCHAR(256),
+    description             TEXT,
+    version                 VARCHAR(256) NOT NULL,
+    changelog_entries       JSONB,
+    visibility              VARCHAR(256) NOT NULL,
+    links                   JSONB,
+    part_of_products        JSONB,
+    policy_level            VARCHAR(256),
+    custom_policy_level     VARCHAR(256),
+    release_status          VARCHAR(256) NOT NULL,
+    sunset_date             VARCHAR(256),
+    successors              JSONB,
+    extensible              JSONB,
+    tags                    JSONB,
+    labels                  JSONB,
+    documentation_labels    JSONB,
+    last_update             VARCHAR(256),
+    resource_hash           VARCHAR(255),
+    CONSTRAINT entity_types_app_id_ord_id_unique UNIQUE (app_id, ord_id),
+    CONSTRAINT entity_types_app_template_version_id_ord_id_unique UNIQUE (app_template_version_id, ord_id),
+    CONSTRAINT entity_types_app_id_or_app_template_version_id_check CHECK ((app_id IS NULL) <> (app_template_version_id IS NULL))
+);
+
+CREATE INDEX entity_types_app_id ON entity_types (app_id);
+CREATE INDEX entity_types_app_template_version_id ON entity_types (app_template_version_id);
+CREATE INDEX entity_types_package_id ON entity_types (package_id);
+
+CREATE TABLE capabilities (
+    id                      UUID PRIMARY KEY CHECK (id <> '00000000-0000-0000-0000-000000000000'),
+    app_id                  UUID REFERENCES applications (id) ON DELETE CASCADE,
+    app_template_version_id UUID REFERENCES app_template_versions (id) ON DELETE CASCADE,
+    package_id              UUID NOT NULL REFERENCES packages (id) ON DELETE CASCADE,
+    ord_id                  VARCHAR(256) NOT NULL,
+    local_id                VARCHAR(256),
+    title                   VARCHAR(256) NOT NULL,
+    short_description       VARCHAR(256),
+    description             TEXT,
+    type                    VARCHAR(256) NOT NULL,
+    custom_type             VARCHAR(256),
+    version                 VARCHAR(256) NOT NULL,
+    visibility              VARCHAR(256) NOT NULL,
+    release_status          VARCHAR(256) NOT NULL,
+    definitions             JSONB,
+    related_entity_types    JSONB,
+    links                   JSONB,
+    tags                    JSONB,
+    labels                  JSONB,
+    documentation_labels    JSONB,
+    last_update             VARCHAR(256),
+    resource_hash           VARCHAR(255),
+    CONSTRAINT capabilities_app_id_ord_id_unique UNIQUE (app_id, ord_id),
+    CONSTRAINT capabilities_app_template_version_id_ord_id_unique UNIQUE (app_template_version_id, ord_id),
+    CONSTRAINT capabilities_app_id_or_app_template_version_id_check CHECK ((app_id IS NULL) <> (app_template_version_id IS NULL))
+);
+
+CREATE INDEX capabilities_app_id ON capabilities (app_id);
+CREATE INDEX capabilities_app_template_version_id ON capabilities (app_template_version_id);
+CREATE INDEX capabilities_package_id ON capabilities (package_id);
+
+CREATE OR REPLACE VIEW entity_types_tenants AS
+SELECT et.*, ta.tenant_id, ta.owner FROM entity_types AS et
+                                             INNER JOIN tenant_applications AS ta ON ta.id = et.app_id;
+
+CREATE OR REPLACE VIEW capabilities_tenants AS
+SELECT c.*, ta.tenant_id, ta.owner FROM capabilities AS c
+                                            INNER JOIN tenant_applications AS ta ON ta.id = c.app_id;
+
+COMMIT;
@@ -0,0 +1,8 @@
+BEGIN;
+
+DROP VIEW IF EXISTS integration_dependencies_tenants;
+
+DROP INDEX IF EXISTS api_definitions_ord_id;
+DROP TABLE integration_dependencies;
+
+COMMIT;
@@ -0,0 +1,42 @@
+BEGIN;
+
+CREATE TABLE integration_dependencies (
+    id                               UUID PRIMARY KEY CHECK (id <> '00000000-0000-0000-0000-000000000000'),
+    app_id                           UUID REFERENCES applications (id) ON DELETE CASCADE,
+    app_template_version_id          UUID REFERENCES app_template_versions (id) ON DELETE CASCADE,
+    package_id                       UUID NOT NULL REFERENCES packages (id) ON DELETE CASCADE,
+    ord_id                           VARCHAR(256) NOT NULL,
+    local_id                         VARCHAR(256),
+    correlation_ids                  JSONB,
+    title                            VARCHAR(256) NOT NULL,
+    short_description                VARCHAR(256),
+    description                      TEXT,
+    version                          VARCHAR(256) NOT NULL,
+    visibility                       VARCHAR(256) NOT NULL,
+    release_status                   VARCHAR(256) NOT NULL,
+    sunset_date                      VARCHAR(256),
+    successors                       JSONB,
+    mandatory                        BOOLEAN NOT NULL DEFAULT FALSE,
+    aspects                          JSONB,
+    related_integration_dependencies JSONB,
+    links                            JSONB,
+    tags                             JSONB,
+    labels                           JSONB,
+    documentation_labels             JSONB,
+    last_update                      VARCHAR(256),
+    resource_hash                    VARCHAR(255),
+    CONSTRAINT integration_dependencies_app_id_ord_id_unique UNIQUE (app_id, ord_id),
+    CONSTRAINT integration_dependencies_app_template_version_id_ord_id_unique UNIQUE (app_template_version_id, ord_id),
+    CONSTRAINT integration_dependencies_app_id_or_app_template_version_id_check CHECK ((app_id IS NULL) <> (app_template_version_id IS NULL))
+);
+
+CREATE INDEX integration_dependencies_app_id ON integration_dependencies (app_id);
+CREATE INDEX integration_dependencies_app_template_version_id ON integration_dependencies (app_template_version_id);
+CREATE INDEX integration_dependencies_package_id ON integration_dependencies (package_id);
+CREATE INDEX api_definitions_ord_id ON api_definitions (ord_id);
+
+CREATE OR REPLACE VIEW integration_dependencies_tenants AS
+SELECT i.*, ta.tenant_id, ta.owner FROM integration_dependencies AS i
+                                            INNER JOIN tenant_applications AS ta ON ta.id = i.app_id;
+
+COMMIT;
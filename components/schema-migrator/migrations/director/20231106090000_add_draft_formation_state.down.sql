@@ -0,0 +1,11 @@
+BEGIN;
+
+UPDATE formations SET state = 'INITIAL' WHERE state = 'DRAFT';
+
+ALTER TABLE formations
+    DROP CONSTRAINT formations_state_check;
+
+ALTER TABLE formations
+    ADD CONSTRAINT formations_state_check CHECK ( state IN ('INITIAL', 'READY', 'CREATE_ERROR', 'DELETE_ERROR', 'DELETING'));
+
+COMMIT;
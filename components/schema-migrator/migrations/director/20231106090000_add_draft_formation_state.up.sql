@@ -0,0 +1,9 @@
+BEGIN;
+
+ALTER TABLE formations
+    DROP CONSTRAINT formations_state_check;
+
+ALTER TABLE formations
+    ADD CONSTRAINT formations_state_check CHECK ( state IN ('INITIAL', 'READY', 'CREATE_ERROR', 'DELETE_ERROR', 'DELETING', 'DRAFT'));
+
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+
+ALTER TABLE applications DROP COLUMN template_values;
+
+COMMIT;
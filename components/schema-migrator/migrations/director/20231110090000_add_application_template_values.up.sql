@@ -0,0 +1,5 @@
+BEGIN;
+
+ALTER TABLE applications ADD COLUMN template_values JSONB;
+
+COMMIT;
@@ -0,0 +1,11 @@
+BEGIN;
+
+DELETE FROM operation WHERE op_type = 'NS_ADAPTER_REPORT';
+
+ALTER TABLE operation
+    DROP CONSTRAINT operation_op_type_check;
+
+ALTER TABLE operation
+    ADD CONSTRAINT operation_op_type_check CHECK ( op_type IN ('ORD_AGGREGATION') );
+
+COMMIT;
@@ -0,0 +1,9 @@
+BEGIN;
+
+ALTER TABLE operation
+    DROP CONSTRAINT operation_op_type_check;
+
+ALTER TABLE operation
+    ADD CONSTRAINT operation_op_type_check CHECK ( op_type IN ('ORD_AGGREGATION', 'NS_ADAPTER_REPORT') );
+
+COMMIT;
@@ -0,0 +1,6 @@
+BEGIN;
+
+ALTER TABLE operation DROP COLUMN attempts;
+ALTER TABLE operation DROP COLUMN claim_token;
+
+COMMIT;
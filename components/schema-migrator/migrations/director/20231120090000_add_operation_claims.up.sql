@@ -0,0 +1,6 @@
+BEGIN;
+
+ALTER TABLE operation ADD COLUMN claim_token UUID;
+ALTER TABLE operation ADD COLUMN attempts INT NOT NULL DEFAULT 0;
+
+COMMIT;
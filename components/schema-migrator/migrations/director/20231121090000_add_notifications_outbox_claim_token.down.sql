@@ -0,0 +1,5 @@
+BEGIN;
+
+ALTER TABLE notifications_outbox DROP COLUMN claim_token;
+
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+
+ALTER TABLE notifications_outbox ADD COLUMN claim_token UUID;
+
+COMMIT;
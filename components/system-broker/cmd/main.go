@@ -64,7 +64,11 @@ func main() {
 	directorGraphQLClient, err := graphql.PrepareGqlClient(cfg.GraphQLClient, cfg.HttpClient, oauth.NewTokenAuthorizationProviderFromHeader())
 	fatalOnError(err)
 
-	systemBroker := osb.NewSystemBroker(directorGraphQLClient, cfg.ORD.ServiceURL+cfg.ORD.StaticPath)
+	k8sClient, err := oauth.PrepareK8sClient(cfg.InstanceStore.WaitKubeMapperTimeout)
+	fatalOnError(err)
+
+	instanceStore := osb.NewSecretInstanceStore(k8sClient, cfg.InstanceStore.Namespace)
+	systemBroker := osb.NewSystemBroker(directorGraphQLClient, instanceStore, cfg.ORD.ServiceURL+cfg.ORD.StaticPath)
 	collector := metrics.NewCollector()
 	osbApi := osb.API(cfg.Server.RootAPI, systemBroker, sblog.NewDefaultLagerAdapter(), collector)
 	prometheus.MustRegister(collector)
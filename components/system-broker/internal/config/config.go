@@ -20,6 +20,7 @@ import (
 	"reflect"
 
 	"github.com/kyma-incubator/compass/components/system-broker/internal/metrics"
+	"github.com/kyma-incubator/compass/components/system-broker/internal/osb"
 
 	"github.com/kyma-incubator/compass/components/system-broker/pkg/ord"
 
@@ -38,13 +39,14 @@ type Validatable interface {
 }
 
 type Config struct {
-	Server        *server.Config  `mapstructure:"server"`
-	Log           *log.Config     `mapstructure:"log"`
-	HttpClient    *http.Config    `mapstructure:"http_client"`
-	GraphQLClient *graphql.Config `mapstructure:"graphql_client"`
-	OAuthProvider *oauth.Config   `mapstructure:"oauth_provider"`
-	ORD           *ord.Config     `mapstructure:"ord"`
-	Metrics       *metrics.Config `mapstructure:"metrics"`
+	Server        *server.Config           `mapstructure:"server"`
+	Log           *log.Config              `mapstructure:"log"`
+	HttpClient    *http.Config             `mapstructure:"http_client"`
+	GraphQLClient *graphql.Config          `mapstructure:"graphql_client"`
+	OAuthProvider *oauth.Config            `mapstructure:"oauth_provider"`
+	ORD           *ord.Config              `mapstructure:"ord"`
+	Metrics       *metrics.Config          `mapstructure:"metrics"`
+	InstanceStore *osb.InstanceStoreConfig `mapstructure:"instance_store"`
 }
 
 func AddPFlags(set *pflag.FlagSet) {
@@ -61,6 +63,7 @@ func DefaultConfig() *Config {
 		OAuthProvider: oauth.DefaultConfig(),
 		ORD:           ord.DefaultConfig(),
 		Metrics:       metrics.DefaultConfig(),
+		InstanceStore: osb.DefaultInstanceStoreConfig(),
 	}
 }
 
@@ -19,6 +19,7 @@ package osb
 import (
 	"context"
 	"encoding/json"
+	"net/http"
 
 	"github.com/kyma-incubator/compass/components/system-broker/pkg/types"
 
@@ -34,12 +35,14 @@ import (
 type BindEndpoint struct {
 	credentialsCreator types.BundleCredentialsCreateRequester
 	credentialsGetter  types.BundleCredentialsFetcher
+	instances          InstanceStore
 }
 
-func NewBindEndpoint(credentialsCreator types.BundleCredentialsCreateRequester, credentialsGetter types.BundleCredentialsFetcher) *BindEndpoint {
+func NewBindEndpoint(credentialsCreator types.BundleCredentialsCreateRequester, credentialsGetter types.BundleCredentialsFetcher, instances InstanceStore) *BindEndpoint {
 	return &BindEndpoint{
 		credentialsCreator: credentialsCreator,
 		credentialsGetter:  credentialsGetter,
+		instances:          instances,
 	}
 }
 
@@ -50,6 +53,18 @@ func (b *BindEndpoint) Bind(ctx context.Context, instanceID, bindingID string, d
 		return domain.Binding{}, apiresponses.ErrAsyncRequired
 	}
 
+	instance, err := b.instances.Get(ctx, instanceID)
+	if err != nil {
+		if IsNotFoundError(err) {
+			return domain.Binding{}, apiresponses.NewFailureResponse(err, http.StatusNotFound, "bind")
+		}
+		return domain.Binding{}, errors.Wrapf(err, "while getting service instance with id %s", instanceID)
+	}
+	if instance.ServiceID != details.ServiceID || instance.PlanID != details.PlanID {
+		err := errors.Errorf("service instance with id %s is provisioned for service %s and plan %s", instanceID, instance.ServiceID, instance.PlanID)
+		return domain.Binding{}, apiresponses.NewFailureResponse(err, http.StatusBadRequest, "bind")
+	}
+
 	appID := details.ServiceID
 	bundleID := details.PlanID
 	logger := log.C(ctx).WithFields(map[string]interface{}{
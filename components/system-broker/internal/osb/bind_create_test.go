@@ -2,14 +2,17 @@ package osb_test
 
 import (
 	"context"
+	"net/http"
 	"testing"
 
 	"github.com/kyma-incubator/compass/components/system-broker/pkg/types/typesfakes"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 	"github.com/kyma-incubator/compass/components/system-broker/internal/osb"
+	"github.com/kyma-incubator/compass/components/system-broker/internal/osb/osbfakes"
 	"github.com/kyma-incubator/compass/components/system-broker/pkg/director"
 	"github.com/pivotal-cf/brokerapi/v7/domain"
+	"github.com/pivotal-cf/brokerapi/v7/domain/apiresponses"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 )
@@ -21,6 +24,7 @@ func TestBindCreate(t *testing.T) {
 	var (
 		fakeCredentialsCreator *typesfakes.FakeBundleCredentialsCreateRequester
 		fakeCredentialsGetter  *typesfakes.FakeBundleCredentialsFetcher
+		fakeInstances          *osbfakes.FakeInstanceStore
 		be                     *osb.BindEndpoint
 		details                domain.BindDetails
 		bundleInstanceAuth     *director.BundleInstanceAuthOutput
@@ -30,13 +34,21 @@ func TestBindCreate(t *testing.T) {
 		fakeCredentialsCreator = &typesfakes.FakeBundleCredentialsCreateRequester{}
 		fakeCredentialsGetter = &typesfakes.FakeBundleCredentialsFetcher{}
 
-		be = osb.NewBindEndpoint(fakeCredentialsCreator, fakeCredentialsGetter)
+		fakeInstances = &osbfakes.FakeInstanceStore{}
+
+		be = osb.NewBindEndpoint(fakeCredentialsCreator, fakeCredentialsGetter, fakeInstances)
 
 		details = domain.BindDetails{
 			ServiceID: "serviceID",
 			PlanID:    "planID",
 		}
 
+		fakeInstances.GetReturns(osb.ServiceInstance{
+			ID:        instanceID,
+			ServiceID: "serviceID",
+			PlanID:    "planID",
+		}, nil)
+
 		bundleInstanceAuth = &director.BundleInstanceAuthOutput{
 			InstanceAuth: &graphql.BundleInstanceAuth{
 				ID: "instanceAuthID",
@@ -87,6 +99,34 @@ func TestBindCreate(t *testing.T) {
 		assert.Contains(t, err.Error(), "This service plan requires client support for asynchronous service operations")
 	})
 
+	t.Run("When service instance does not exist", func(t *testing.T) {
+		setup()
+		fakeInstances.GetReturns(osb.ServiceInstance{}, &NotFoundErr{})
+		_, err := be.Bind(context.TODO(), instanceID, bindingID, details, true)
+		assert.Error(t, err)
+		assert.Equal(t, http.StatusNotFound, err.(*apiresponses.FailureResponse).ValidatedStatusCode(nil))
+		assert.Equal(t, 0, fakeCredentialsGetter.FetchBundleInstanceAuthCallCount())
+	})
+
+	t.Run("When getting service instance fails", func(t *testing.T) {
+		setup()
+		fakeInstances.GetReturns(osb.ServiceInstance{}, errors.New("some error"))
+		_, err := be.Bind(context.TODO(), instanceID, bindingID, details, true)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "while getting service instance with id instanceID")
+		assert.Equal(t, 0, fakeCredentialsGetter.FetchBundleInstanceAuthCallCount())
+	})
+
+	t.Run("When service instance is provisioned for another plan", func(t *testing.T) {
+		setup()
+		details.PlanID = "anotherPlanID"
+		_, err := be.Bind(context.TODO(), instanceID, bindingID, details, true)
+		assert.Error(t, err)
+		assert.Equal(t, http.StatusBadRequest, err.(*apiresponses.FailureResponse).ValidatedStatusCode(nil))
+		assert.Contains(t, err.Error(), "is provisioned for service serviceID and plan planID")
+		assert.Equal(t, 0, fakeCredentialsGetter.FetchBundleInstanceAuthCallCount())
+	})
+
 	t.Run("When credentials getter returns an error", func(t *testing.T) {
 		setup()
 		fakeCredentialsGetter.FetchBundleInstanceAuthReturns(
@@ -19,6 +19,7 @@ package osb
 import "github.com/kyma-incubator/compass/components/system-broker/pkg/types"
 
 type GqlClientForBroker interface {
+	types.ViewerFetcher
 	types.ApplicationsLister
 	types.BundleCredentialsFetcher
 	types.BundleCredentialsFetcherForInstance
@@ -26,15 +27,16 @@ type GqlClientForBroker interface {
 	types.BundleCredentialsDeleteRequester
 }
 
-func NewSystemBroker(client GqlClientForBroker, ORDServiceURL string) *SystemBroker {
+func NewSystemBroker(client GqlClientForBroker, instances InstanceStore, ORDServiceURL string) *SystemBroker {
+	instances = NewTenantInstanceStore(instances, NewCallerTenantResolver(client))
 	return &SystemBroker{
 		CatalogEndpoint:               NewCatalogEndpoint(client, &CatalogConverter{ORDServiceURL: ORDServiceURL}),
-		ProvisionEndpoint:             NewProvisionEndpoint(),
-		DeprovisionEndpoint:           NewDeprovisionEndpoint(),
+		ProvisionEndpoint:             NewProvisionEndpoint(instances),
+		DeprovisionEndpoint:           NewDeprovisionEndpoint(instances),
 		UpdateInstanceEndpoint:        NewUpdateInstanceEndpoint(),
-		GetInstanceEndpoint:           NewGetInstanceEndpoint(),
-		InstanceLastOperationEndpoint: NewInstanceLastOperationEndpoint(),
-		BindEndpoint:                  NewBindEndpoint(client, client),
+		GetInstanceEndpoint:           NewGetInstanceEndpoint(instances),
+		InstanceLastOperationEndpoint: NewInstanceLastOperationEndpoint(instances),
+		BindEndpoint:                  NewBindEndpoint(client, client, instances),
 		UnbindEndpoint:                NewUnbindEndpoint(client, client),
 		GetBindingEndpoint:            NewGetBindingEndpoint(client),
 		BindLastOperationEndpoint:     NewBindLastOperationEndpoint(client),
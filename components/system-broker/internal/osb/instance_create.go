@@ -21,17 +21,50 @@ import (
 
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
 	"github.com/pivotal-cf/brokerapi/v7/domain"
+	"github.com/pivotal-cf/brokerapi/v7/domain/apiresponses"
+	"github.com/pkg/errors"
 )
 
 type ProvisionEndpoint struct {
+	instances InstanceStore
 }
 
-func NewProvisionEndpoint() *ProvisionEndpoint {
-	return &ProvisionEndpoint{}
+func NewProvisionEndpoint(instances InstanceStore) *ProvisionEndpoint {
+	return &ProvisionEndpoint{
+		instances: instances,
+	}
 }
 
 func (b *ProvisionEndpoint) Provision(ctx context.Context, instanceID string, details domain.ProvisionDetails, asyncAllowed bool) (domain.ProvisionedServiceSpec, error) {
 	log.C(ctx).Infof("Provision instance with instanceID: %s, serviceID: %s, planID: %s, parameters: %s context: %s asyncAllowed: %t", instanceID, details.ServiceID, details.PlanID, string(details.RawParameters), string(details.RawContext), asyncAllowed)
 
-	return domain.ProvisionedServiceSpec{}, nil
+	instance := ServiceInstance{
+		ID:            instanceID,
+		ServiceID:     details.ServiceID,
+		PlanID:        details.PlanID,
+		RawContext:    details.RawContext,
+		RawParameters: details.RawParameters,
+	}
+
+	stored, created, err := b.instances.CreateIfNotExists(ctx, instance)
+	if err != nil {
+		if IsAlreadyExistsError(err) {
+			log.C(ctx).Infof("Instance with instanceID: %s already exists for another tenant", instanceID)
+			return domain.ProvisionedServiceSpec{}, apiresponses.ErrInstanceAlreadyExists
+		}
+		return domain.ProvisionedServiceSpec{}, errors.Wrapf(err, "while storing service instance with id %s", instanceID)
+	}
+
+	if created {
+		log.C(ctx).Infof("Successfully provisioned instance with instanceID: %s", instanceID)
+		return domain.ProvisionedServiceSpec{}, nil
+	}
+
+	if !stored.Matches(instance) {
+		log.C(ctx).Infof("Instance with instanceID: %s already exists with serviceID: %s, planID: %s and different parameters", instanceID, stored.ServiceID, stored.PlanID)
+		return domain.ProvisionedServiceSpec{}, apiresponses.ErrInstanceAlreadyExists
+	}
+
+	log.C(ctx).Infof("Instance with instanceID: %s is already provisioned with the same attributes", instanceID)
+	return domain.ProvisionedServiceSpec{AlreadyExists: true}, nil
 }
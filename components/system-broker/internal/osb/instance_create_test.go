@@ -0,0 +1,108 @@
+package osb_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/system-broker/internal/osb"
+	"github.com/kyma-incubator/compass/components/system-broker/internal/osb/osbfakes"
+	"github.com/pivotal-cf/brokerapi/v7/domain"
+	"github.com/pivotal-cf/brokerapi/v7/domain/apiresponses"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProvision(t *testing.T) {
+	instanceID := "instanceID"
+
+	var (
+		fakeInstances *osbfakes.FakeInstanceStore
+		pe            *osb.ProvisionEndpoint
+		details       domain.ProvisionDetails
+		instance      osb.ServiceInstance
+	)
+
+	setup := func() {
+		fakeInstances = &osbfakes.FakeInstanceStore{}
+		pe = osb.NewProvisionEndpoint(fakeInstances)
+
+		details = domain.ProvisionDetails{
+			ServiceID:     "serviceID",
+			PlanID:        "planID",
+			RawContext:    []byte(`{"platform": "kubernetes"}`),
+			RawParameters: []byte(`{"a": "b", "c": "d"}`),
+		}
+
+		instance = osb.ServiceInstance{
+			ID:            instanceID,
+			ServiceID:     details.ServiceID,
+			PlanID:        details.PlanID,
+			RawContext:    details.RawContext,
+			RawParameters: details.RawParameters,
+		}
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		setup()
+		fakeInstances.CreateIfNotExistsReturns(instance, true, nil)
+
+		spec, err := pe.Provision(context.TODO(), instanceID, details, false)
+		assert.NoError(t, err)
+		assert.False(t, spec.AlreadyExists)
+		assert.Equal(t, 1, fakeInstances.CreateIfNotExistsCallCount())
+		_, storedInstance := fakeInstances.CreateIfNotExistsArgsForCall(0)
+		assert.Equal(t, instance, storedInstance)
+	})
+
+	t.Run("When instance with the same attributes already exists", func(t *testing.T) {
+		setup()
+		existing := instance
+		existing.RawContext = []byte(`{"platform": "cloudfoundry"}`)
+		existing.RawParameters = []byte(`{"c":"d","a":"b"}`)
+		fakeInstances.CreateIfNotExistsReturns(existing, false, nil)
+
+		spec, err := pe.Provision(context.TODO(), instanceID, details, false)
+		assert.NoError(t, err)
+		assert.True(t, spec.AlreadyExists)
+	})
+
+	t.Run("When instance with different plan already exists", func(t *testing.T) {
+		setup()
+		existing := instance
+		existing.PlanID = "anotherPlanID"
+		fakeInstances.CreateIfNotExistsReturns(existing, false, nil)
+
+		_, err := pe.Provision(context.TODO(), instanceID, details, false)
+		assert.Equal(t, apiresponses.ErrInstanceAlreadyExists, err)
+	})
+
+	t.Run("When instance with different parameters already exists", func(t *testing.T) {
+		setup()
+		existing := instance
+		existing.RawParameters = []byte(`{"a": "b"}`)
+		fakeInstances.CreateIfNotExistsReturns(existing, false, nil)
+
+		_, err := pe.Provision(context.TODO(), instanceID, details, false)
+		assert.Equal(t, apiresponses.ErrInstanceAlreadyExists, err)
+	})
+
+	t.Run("When instance of another tenant already exists", func(t *testing.T) {
+		setup()
+		instances := osb.NewInMemoryInstanceStore()
+		_, _, err := osb.NewTenantInstanceStore(instances, fixTenantResolver(ownerTenant)).CreateIfNotExists(context.TODO(), instance)
+		assert.NoError(t, err)
+		pe = osb.NewProvisionEndpoint(osb.NewTenantInstanceStore(instances, fixTenantResolver(foreignTenant)))
+
+		_, err = pe.Provision(context.TODO(), instanceID, details, false)
+		assert.Equal(t, apiresponses.ErrInstanceAlreadyExists, err)
+	})
+
+	t.Run("When storing the instance fails", func(t *testing.T) {
+		setup()
+		fakeInstances.CreateIfNotExistsReturns(osb.ServiceInstance{}, false, errors.New("some error"))
+
+		_, err := pe.Provision(context.TODO(), instanceID, details, false)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "while storing service instance with id instanceID")
+	})
+}
@@ -18,20 +18,48 @@ package osb
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
 	"github.com/pivotal-cf/brokerapi/v7/domain"
+	"github.com/pivotal-cf/brokerapi/v7/domain/apiresponses"
+	"github.com/pkg/errors"
 )
 
 type DeprovisionEndpoint struct {
+	instances InstanceStore
 }
 
-func NewDeprovisionEndpoint() *DeprovisionEndpoint {
-	return &DeprovisionEndpoint{}
+func NewDeprovisionEndpoint(instances InstanceStore) *DeprovisionEndpoint {
+	return &DeprovisionEndpoint{
+		instances: instances,
+	}
 }
 
 func (b *DeprovisionEndpoint) Deprovision(ctx context.Context, instanceID string, details domain.DeprovisionDetails, asyncAllowed bool) (domain.DeprovisionServiceSpec, error) {
 	log.C(ctx).Infof("Deprovision instance with instanceID: %s, serviceID: %s, planID %s, asyncAllowed: %t force: %t", instanceID, details.ServiceID, details.PlanID, asyncAllowed, details.Force)
 
+	instance, err := b.instances.Get(ctx, instanceID)
+	if err != nil {
+		if IsNotFoundError(err) {
+			log.C(ctx).Infof("Instance with instanceID: %s does not exist", instanceID)
+			return domain.DeprovisionServiceSpec{}, apiresponses.ErrInstanceDoesNotExist
+		}
+		return domain.DeprovisionServiceSpec{}, errors.Wrapf(err, "while getting service instance with id %s", instanceID)
+	}
+	if instance.ServiceID != details.ServiceID || instance.PlanID != details.PlanID {
+		err := errors.Errorf("service instance with id %s is provisioned for service %s and plan %s", instanceID, instance.ServiceID, instance.PlanID)
+		return domain.DeprovisionServiceSpec{}, apiresponses.NewFailureResponse(err, http.StatusBadRequest, "deprovision")
+	}
+
+	if err := b.instances.Delete(ctx, instanceID); err != nil {
+		if IsNotFoundError(err) {
+			log.C(ctx).Infof("Instance with instanceID: %s does not exist", instanceID)
+			return domain.DeprovisionServiceSpec{}, apiresponses.ErrInstanceDoesNotExist
+		}
+		return domain.DeprovisionServiceSpec{}, errors.Wrapf(err, "while deleting service instance with id %s", instanceID)
+	}
+
+	log.C(ctx).Infof("Successfully deprovisioned instance with instanceID: %s", instanceID)
 	return domain.DeprovisionServiceSpec{}, nil
 }
@@ -0,0 +1,95 @@
+package osb_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/system-broker/internal/osb"
+	"github.com/kyma-incubator/compass/components/system-broker/internal/osb/osbfakes"
+	"github.com/pivotal-cf/brokerapi/v7/domain"
+	"github.com/pivotal-cf/brokerapi/v7/domain/apiresponses"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeprovision(t *testing.T) {
+	instanceID := "instanceID"
+
+	var (
+		fakeInstances *osbfakes.FakeInstanceStore
+		de            *osb.DeprovisionEndpoint
+		details       domain.DeprovisionDetails
+	)
+
+	setup := func() {
+		fakeInstances = &osbfakes.FakeInstanceStore{}
+		de = osb.NewDeprovisionEndpoint(fakeInstances)
+
+		details = domain.DeprovisionDetails{
+			ServiceID: "serviceID",
+			PlanID:    "planID",
+		}
+		fakeInstances.GetReturns(osb.ServiceInstance{ID: instanceID, ServiceID: "serviceID", PlanID: "planID"}, nil)
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		setup()
+		_, err := de.Deprovision(context.TODO(), instanceID, details, false)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, fakeInstances.DeleteCallCount())
+		_, deletedID := fakeInstances.DeleteArgsForCall(0)
+		assert.Equal(t, instanceID, deletedID)
+	})
+
+	t.Run("When instance does not exist", func(t *testing.T) {
+		setup()
+		fakeInstances.GetReturns(osb.ServiceInstance{}, &NotFoundErr{})
+		_, err := de.Deprovision(context.TODO(), instanceID, details, false)
+		assert.Equal(t, apiresponses.ErrInstanceDoesNotExist, err)
+		assert.Equal(t, 0, fakeInstances.DeleteCallCount())
+	})
+
+	t.Run("When getting the instance fails", func(t *testing.T) {
+		setup()
+		fakeInstances.GetReturns(osb.ServiceInstance{}, errors.New("some error"))
+		_, err := de.Deprovision(context.TODO(), instanceID, details, false)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "while getting service instance with id instanceID")
+		assert.Equal(t, 0, fakeInstances.DeleteCallCount())
+	})
+
+	t.Run("When the service does not match the instance", func(t *testing.T) {
+		setup()
+		details.ServiceID = "anotherServiceID"
+		_, err := de.Deprovision(context.TODO(), instanceID, details, false)
+		assert.Error(t, err)
+		assert.Equal(t, http.StatusBadRequest, err.(*apiresponses.FailureResponse).ValidatedStatusCode(nil))
+		assert.Contains(t, err.Error(), "is provisioned for service serviceID and plan planID")
+		assert.Equal(t, 0, fakeInstances.DeleteCallCount())
+	})
+
+	t.Run("When the plan does not match the instance", func(t *testing.T) {
+		setup()
+		details.PlanID = "anotherPlanID"
+		_, err := de.Deprovision(context.TODO(), instanceID, details, false)
+		assert.Error(t, err)
+		assert.Equal(t, http.StatusBadRequest, err.(*apiresponses.FailureResponse).ValidatedStatusCode(nil))
+		assert.Equal(t, 0, fakeInstances.DeleteCallCount())
+	})
+
+	t.Run("When instance is deleted concurrently", func(t *testing.T) {
+		setup()
+		fakeInstances.DeleteReturns(&NotFoundErr{})
+		_, err := de.Deprovision(context.TODO(), instanceID, details, false)
+		assert.Equal(t, apiresponses.ErrInstanceDoesNotExist, err)
+	})
+
+	t.Run("When deleting the instance fails", func(t *testing.T) {
+		setup()
+		fakeInstances.DeleteReturns(errors.New("some error"))
+		_, err := de.Deprovision(context.TODO(), instanceID, details, false)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "while deleting service instance with id instanceID")
+	})
+}
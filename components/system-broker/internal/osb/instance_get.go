@@ -18,22 +18,46 @@ package osb
 
 import (
 	"context"
-	"errors"
+	"encoding/json"
+	"net/http"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
-
 	"github.com/pivotal-cf/brokerapi/v7/domain"
+	"github.com/pivotal-cf/brokerapi/v7/domain/apiresponses"
+	"github.com/pkg/errors"
 )
 
 type GetInstanceEndpoint struct {
+	instances InstanceStore
 }
 
-func NewGetInstanceEndpoint() *GetInstanceEndpoint {
-	return &GetInstanceEndpoint{}
+func NewGetInstanceEndpoint(instances InstanceStore) *GetInstanceEndpoint {
+	return &GetInstanceEndpoint{
+		instances: instances,
+	}
 }
 
 func (b *GetInstanceEndpoint) GetInstance(ctx context.Context, instanceID string) (domain.GetInstanceDetailsSpec, error) {
 	log.C(ctx).Infof("GetInstanceEndpoint instanceID: %s", instanceID)
 
-	return domain.GetInstanceDetailsSpec{}, errors.New("not supported")
+	instance, err := b.instances.Get(ctx, instanceID)
+	if err != nil {
+		if IsNotFoundError(err) {
+			return domain.GetInstanceDetailsSpec{}, apiresponses.NewFailureResponse(err, http.StatusNotFound, "get-instance")
+		}
+		return domain.GetInstanceDetailsSpec{}, errors.Wrapf(err, "while getting service instance with id %s", instanceID)
+	}
+
+	var parameters interface{}
+	if len(instance.RawParameters) > 0 {
+		if err := json.Unmarshal(instance.RawParameters, &parameters); err != nil {
+			return domain.GetInstanceDetailsSpec{}, errors.Wrap(err, "while unmarshaling instance parameters")
+		}
+	}
+
+	return domain.GetInstanceDetailsSpec{
+		ServiceID:  instance.ServiceID,
+		PlanID:     instance.PlanID,
+		Parameters: parameters,
+	}, nil
 }
@@ -0,0 +1,70 @@
+package osb_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/system-broker/internal/osb"
+	"github.com/kyma-incubator/compass/components/system-broker/internal/osb/osbfakes"
+	"github.com/pivotal-cf/brokerapi/v7/domain/apiresponses"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetInstance(t *testing.T) {
+	instanceID := "instanceID"
+
+	var (
+		fakeInstances *osbfakes.FakeInstanceStore
+		ge            *osb.GetInstanceEndpoint
+	)
+
+	setup := func() {
+		fakeInstances = &osbfakes.FakeInstanceStore{}
+		ge = osb.NewGetInstanceEndpoint(fakeInstances)
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		setup()
+		fakeInstances.GetReturns(osb.ServiceInstance{
+			ID:            instanceID,
+			ServiceID:     "serviceID",
+			PlanID:        "planID",
+			RawParameters: []byte(`{"a": "b"}`),
+		}, nil)
+
+		spec, err := ge.GetInstance(context.TODO(), instanceID)
+		assert.NoError(t, err)
+		assert.Equal(t, "serviceID", spec.ServiceID)
+		assert.Equal(t, "planID", spec.PlanID)
+		assert.Equal(t, map[string]interface{}{"a": "b"}, spec.Parameters)
+	})
+
+	t.Run("When instance has no parameters", func(t *testing.T) {
+		setup()
+		fakeInstances.GetReturns(osb.ServiceInstance{ID: instanceID, ServiceID: "serviceID", PlanID: "planID"}, nil)
+
+		spec, err := ge.GetInstance(context.TODO(), instanceID)
+		assert.NoError(t, err)
+		assert.Nil(t, spec.Parameters)
+	})
+
+	t.Run("When instance does not exist", func(t *testing.T) {
+		setup()
+		fakeInstances.GetReturns(osb.ServiceInstance{}, &NotFoundErr{})
+
+		_, err := ge.GetInstance(context.TODO(), instanceID)
+		assert.Error(t, err)
+		assert.Equal(t, http.StatusNotFound, err.(*apiresponses.FailureResponse).ValidatedStatusCode(nil))
+	})
+
+	t.Run("When getting the instance fails", func(t *testing.T) {
+		setup()
+		fakeInstances.GetReturns(osb.ServiceInstance{}, errors.New("some error"))
+
+		_, err := ge.GetInstance(context.TODO(), instanceID)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "while getting service instance with id instanceID")
+	})
+}
@@ -21,17 +21,31 @@ import (
 
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
 	"github.com/pivotal-cf/brokerapi/v7/domain"
+	"github.com/pivotal-cf/brokerapi/v7/domain/apiresponses"
+	"github.com/pkg/errors"
 )
 
 type InstanceLastOperationEndpoint struct {
+	instances InstanceStore
 }
 
-func NewInstanceLastOperationEndpoint() *InstanceLastOperationEndpoint {
-	return &InstanceLastOperationEndpoint{}
+func NewInstanceLastOperationEndpoint(instances InstanceStore) *InstanceLastOperationEndpoint {
+	return &InstanceLastOperationEndpoint{
+		instances: instances,
+	}
 }
 
 func (b *InstanceLastOperationEndpoint) LastOperation(ctx context.Context, instanceID string, details domain.PollDetails) (domain.LastOperation, error) {
 	log.C(ctx).Infof("LastInstanceOperation instanceID: %s details: %+v", instanceID, details)
 
-	return domain.LastOperation{}, nil
+	// Instance operations are synchronous, so an existing instance means the last operation has succeeded
+	// and a missing one means it was deprovisioned or never provisioned.
+	if _, err := b.instances.Get(ctx, instanceID); err != nil {
+		if IsNotFoundError(err) {
+			return domain.LastOperation{}, apiresponses.ErrInstanceDoesNotExist
+		}
+		return domain.LastOperation{}, errors.Wrapf(err, "while getting service instance with id %s", instanceID)
+	}
+
+	return domain.LastOperation{State: domain.Succeeded}, nil
 }
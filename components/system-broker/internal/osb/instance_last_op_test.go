@@ -0,0 +1,53 @@
+package osb_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/system-broker/internal/osb"
+	"github.com/kyma-incubator/compass/components/system-broker/internal/osb/osbfakes"
+	"github.com/pivotal-cf/brokerapi/v7/domain"
+	"github.com/pivotal-cf/brokerapi/v7/domain/apiresponses"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInstanceLastOperation(t *testing.T) {
+	instanceID := "instanceID"
+
+	var (
+		fakeInstances *osbfakes.FakeInstanceStore
+		le            *osb.InstanceLastOperationEndpoint
+	)
+
+	setup := func() {
+		fakeInstances = &osbfakes.FakeInstanceStore{}
+		le = osb.NewInstanceLastOperationEndpoint(fakeInstances)
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		setup()
+		fakeInstances.GetReturns(osb.ServiceInstance{ID: instanceID}, nil)
+
+		op, err := le.LastOperation(context.TODO(), instanceID, domain.PollDetails{})
+		assert.NoError(t, err)
+		assert.Equal(t, domain.Succeeded, op.State)
+	})
+
+	t.Run("When instance does not exist", func(t *testing.T) {
+		setup()
+		fakeInstances.GetReturns(osb.ServiceInstance{}, &NotFoundErr{})
+
+		_, err := le.LastOperation(context.TODO(), instanceID, domain.PollDetails{})
+		assert.Equal(t, apiresponses.ErrInstanceDoesNotExist, err)
+	})
+
+	t.Run("When getting the instance fails", func(t *testing.T) {
+		setup()
+		fakeInstances.GetReturns(osb.ServiceInstance{}, errors.New("some error"))
+
+		_, err := le.LastOperation(context.TODO(), instanceID, domain.PollDetails{})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "while getting service instance with id instanceID")
+	})
+}
@@ -0,0 +1,144 @@
+/*
+ * Copyright 2020 The Compass Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package osb
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"reflect"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// ServiceInstance is a service instance provisioned through the broker
+type ServiceInstance struct {
+	ID            string
+	Tenant        string
+	ServiceID     string
+	PlanID        string
+	RawContext    json.RawMessage
+	RawParameters json.RawMessage
+}
+
+// Matches reports whether the instance was provisioned with the same service, plan and parameters as the other one.
+// The OSB spec requires identical provision requests to be idempotent, while the platform context may differ between them.
+func (i ServiceInstance) Matches(other ServiceInstance) bool {
+	return i.ServiceID == other.ServiceID && i.PlanID == other.PlanID && jsonEqual(i.RawParameters, other.RawParameters)
+}
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . InstanceStore
+type InstanceStore interface {
+	Get(ctx context.Context, instanceID string) (ServiceInstance, error)
+	// CreateIfNotExists stores the instance unless one with the same ID already exists, in which case the existing one is returned
+	CreateIfNotExists(ctx context.Context, instance ServiceInstance) (ServiceInstance, bool, error)
+	Delete(ctx context.Context, instanceID string) error
+}
+
+type instanceNotFoundError struct {
+	instanceID string
+}
+
+func (e *instanceNotFoundError) Error() string {
+	return "service instance with id " + e.instanceID + " does not exist"
+}
+
+func (e *instanceNotFoundError) NotFound() bool {
+	return true
+}
+
+type instanceAlreadyExistsError struct {
+	instanceID string
+}
+
+func (e *instanceAlreadyExistsError) Error() string {
+	return "service instance with id " + e.instanceID + " already exists"
+}
+
+func (e *instanceAlreadyExistsError) AlreadyExists() bool {
+	return true
+}
+
+// InMemoryInstanceStore keeps the service instances in the memory of the broker.
+// The instances are lost on restart and are not shared between replicas, so it is meant to be used only in tests.
+type InMemoryInstanceStore struct {
+	mutex     sync.RWMutex
+	instances map[string]ServiceInstance
+}
+
+func NewInMemoryInstanceStore() *InMemoryInstanceStore {
+	return &InMemoryInstanceStore{
+		instances: make(map[string]ServiceInstance),
+	}
+}
+
+func (s *InMemoryInstanceStore) Get(_ context.Context, instanceID string) (ServiceInstance, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	instance, ok := s.instances[instanceID]
+	if !ok {
+		return ServiceInstance{}, &instanceNotFoundError{instanceID: instanceID}
+	}
+	return instance, nil
+}
+
+func (s *InMemoryInstanceStore) CreateIfNotExists(_ context.Context, instance ServiceInstance) (ServiceInstance, bool, error) {
+	if instance.ID == "" {
+		return ServiceInstance{}, false, errors.New("service instance id is required")
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if existing, ok := s.instances[instance.ID]; ok {
+		return existing, false, nil
+	}
+	s.instances[instance.ID] = instance
+	return instance, true, nil
+}
+
+func (s *InMemoryInstanceStore) Delete(_ context.Context, instanceID string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, ok := s.instances[instanceID]; !ok {
+		return &instanceNotFoundError{instanceID: instanceID}
+	}
+	delete(s.instances, instanceID)
+	return nil
+}
+
+func jsonEqual(a, b json.RawMessage) bool {
+	a, b = bytes.TrimSpace(a), bytes.TrimSpace(b)
+	if len(a) == 0 {
+		a = []byte("{}")
+	}
+	if len(b) == 0 {
+		b = []byte("{}")
+	}
+
+	var aValue, bValue interface{}
+	if err := json.Unmarshal(a, &aValue); err != nil {
+		return bytes.Equal(a, b)
+	}
+	if err := json.Unmarshal(b, &bValue); err != nil {
+		return false
+	}
+	return reflect.DeepEqual(aValue, bValue)
+}
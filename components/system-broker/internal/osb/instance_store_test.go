@@ -0,0 +1,109 @@
+package osb_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/system-broker/internal/osb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInMemoryInstanceStore(t *testing.T) {
+	ctx := context.TODO()
+	instance := osb.ServiceInstance{
+		ID:            "instanceID",
+		ServiceID:     "serviceID",
+		PlanID:        "planID",
+		RawParameters: []byte(`{"a": "b"}`),
+	}
+
+	t.Run("Create, get and delete an instance", func(t *testing.T) {
+		store := osb.NewInMemoryInstanceStore()
+
+		stored, created, err := store.CreateIfNotExists(ctx, instance)
+		assert.NoError(t, err)
+		assert.True(t, created)
+		assert.Equal(t, instance, stored)
+
+		got, err := store.Get(ctx, instance.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, instance, got)
+
+		assert.NoError(t, store.Delete(ctx, instance.ID))
+
+		_, err = store.Get(ctx, instance.ID)
+		assert.True(t, osb.IsNotFoundError(err))
+	})
+
+	t.Run("Create returns the existing instance", func(t *testing.T) {
+		store := osb.NewInMemoryInstanceStore()
+		_, _, err := store.CreateIfNotExists(ctx, instance)
+		assert.NoError(t, err)
+
+		other := instance
+		other.PlanID = "anotherPlanID"
+		stored, created, err := store.CreateIfNotExists(ctx, other)
+		assert.NoError(t, err)
+		assert.False(t, created)
+		assert.Equal(t, instance, stored)
+	})
+
+	t.Run("Create fails without instance id", func(t *testing.T) {
+		store := osb.NewInMemoryInstanceStore()
+		_, _, err := store.CreateIfNotExists(ctx, osb.ServiceInstance{})
+		assert.Error(t, err)
+	})
+
+	t.Run("Delete returns not found for unknown instance", func(t *testing.T) {
+		store := osb.NewInMemoryInstanceStore()
+		err := store.Delete(ctx, instance.ID)
+		assert.True(t, osb.IsNotFoundError(err))
+	})
+}
+
+func TestServiceInstance_Matches(t *testing.T) {
+	instance := osb.ServiceInstance{
+		ServiceID:     "serviceID",
+		PlanID:        "planID",
+		RawParameters: []byte(`{"a": "b", "c": 1}`),
+	}
+
+	testCases := []struct {
+		Name     string
+		Other    osb.ServiceInstance
+		Expected bool
+	}{
+		{
+			Name:     "Same parameters in different order",
+			Other:    osb.ServiceInstance{ServiceID: "serviceID", PlanID: "planID", RawParameters: []byte(`{"c":1,"a":"b"}`)},
+			Expected: true,
+		},
+		{
+			Name:     "Different parameters",
+			Other:    osb.ServiceInstance{ServiceID: "serviceID", PlanID: "planID", RawParameters: []byte(`{"a": "b"}`)},
+			Expected: false,
+		},
+		{
+			Name:     "Different service",
+			Other:    osb.ServiceInstance{ServiceID: "anotherServiceID", PlanID: "planID", RawParameters: instance.RawParameters},
+			Expected: false,
+		},
+		{
+			Name:     "Different plan",
+			Other:    osb.ServiceInstance{ServiceID: "serviceID", PlanID: "anotherPlanID", RawParameters: instance.RawParameters},
+			Expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			assert.Equal(t, testCase.Expected, instance.Matches(testCase.Other))
+		})
+	}
+
+	t.Run("Missing and empty parameters are equal", func(t *testing.T) {
+		withoutParams := osb.ServiceInstance{ServiceID: "serviceID", PlanID: "planID"}
+		withEmptyParams := osb.ServiceInstance{ServiceID: "serviceID", PlanID: "planID", RawParameters: []byte(`{}`)}
+		assert.True(t, withoutParams.Matches(withEmptyParams))
+	})
+}
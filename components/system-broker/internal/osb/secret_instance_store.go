@@ -0,0 +1,189 @@
+/*
+ * Copyright 2020 The Compass Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package osb
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+
+	"github.com/pkg/errors"
+	v1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	instanceSecretPrefix = "system-broker-instance-"
+	instanceLabelKey     = "system-broker.compass.kyma-project.io/instance"
+
+	instanceIDKey  = "instance_id"
+	tenantKey      = "tenant"
+	serviceIDKey   = "service_id"
+	planIDKey      = "plan_id"
+	contextKey     = "context"
+	parametersKey  = "parameters"
+	instanceMarker = "true"
+)
+
+type InstanceStoreConfig struct {
+	Namespace             string        `mapstructure:"namespace"`
+	WaitKubeMapperTimeout time.Duration `mapstructure:"wait_kube_mapper_timeout"`
+}
+
+func DefaultInstanceStoreConfig() *InstanceStoreConfig {
+	return &InstanceStoreConfig{
+		Namespace:             "compass-system-broker-instances",
+		WaitKubeMapperTimeout: time.Minute,
+	}
+}
+
+func (c *InstanceStoreConfig) Validate() error {
+	if c.Namespace == "" {
+		return errors.New("instance store namespace cannot be empty")
+	}
+
+	if c.WaitKubeMapperTimeout <= 0 {
+		return errors.New("instance store wait kube mapper timeout must be greater than zero")
+	}
+
+	return nil
+}
+
+// SecretInstanceStore keeps every service instance in its own Secret, so that the instances survive restarts
+// and are shared between the replicas of the broker. Secrets are used because the provisioning parameters and context
+// may contain credentials. The uniqueness of the instance IDs is guaranteed by the API server.
+type SecretInstanceStore struct {
+	k8sClient client.Client
+	namespace string
+}
+
+func NewSecretInstanceStore(k8sClient client.Client, namespace string) *SecretInstanceStore {
+	return &SecretInstanceStore{
+		k8sClient: k8sClient,
+		namespace: namespace,
+	}
+}
+
+func (s *SecretInstanceStore) Get(ctx context.Context, instanceID string) (ServiceInstance, error) {
+	secret := &v1.Secret{}
+	if err := s.k8sClient.Get(ctx, s.objectKey(instanceID), secret); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return ServiceInstance{}, &instanceNotFoundError{instanceID: instanceID}
+		}
+		return ServiceInstance{}, errors.Wrapf(err, "while getting service instance with id %s", instanceID)
+	}
+
+	if string(secret.Data[instanceIDKey]) != instanceID {
+		return ServiceInstance{}, errors.Errorf("secret %s does not belong to service instance with id %s", secret.Name, instanceID)
+	}
+
+	return instanceFromSecret(secret), nil
+}
+
+func (s *SecretInstanceStore) CreateIfNotExists(ctx context.Context, instance ServiceInstance) (ServiceInstance, bool, error) {
+	if instance.ID == "" {
+		return ServiceInstance{}, false, errors.New("service instance id is required")
+	}
+
+	if err := s.k8sClient.Create(ctx, s.instanceToSecret(instance)); err != nil {
+		if !k8serrors.IsAlreadyExists(err) {
+			return ServiceInstance{}, false, errors.Wrapf(err, "while creating service instance with id %s", instance.ID)
+		}
+
+		existing, err := s.Get(ctx, instance.ID)
+		if err != nil {
+			return ServiceInstance{}, false, err
+		}
+		return existing, false, nil
+	}
+
+	return instance, true, nil
+}
+
+func (s *SecretInstanceStore) Delete(ctx context.Context, instanceID string) error {
+	secret := &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      secretName(instanceID),
+			Namespace: s.namespace,
+		},
+	}
+
+	if err := s.k8sClient.Delete(ctx, secret); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return &instanceNotFoundError{instanceID: instanceID}
+		}
+		return errors.Wrapf(err, "while deleting service instance with id %s", instanceID)
+	}
+
+	return nil
+}
+
+func (s *SecretInstanceStore) objectKey(instanceID string) client.ObjectKey {
+	return client.ObjectKey{
+		Namespace: s.namespace,
+		Name:      secretName(instanceID),
+	}
+}
+
+func (s *SecretInstanceStore) instanceToSecret(instance ServiceInstance) *v1.Secret {
+	return &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      secretName(instance.ID),
+			Namespace: s.namespace,
+			Labels: map[string]string{
+				instanceLabelKey: instanceMarker,
+			},
+		},
+		Type: v1.SecretTypeOpaque,
+		Data: map[string][]byte{
+			instanceIDKey: []byte(instance.ID),
+			tenantKey:     []byte(instance.Tenant),
+			serviceIDKey:  []byte(instance.ServiceID),
+			planIDKey:     []byte(instance.PlanID),
+			contextKey:    instance.RawContext,
+			parametersKey: instance.RawParameters,
+		},
+	}
+}
+
+func instanceFromSecret(secret *v1.Secret) ServiceInstance {
+	return ServiceInstance{
+		ID:            string(secret.Data[instanceIDKey]),
+		Tenant:        string(secret.Data[tenantKey]),
+		ServiceID:     string(secret.Data[serviceIDKey]),
+		PlanID:        string(secret.Data[planIDKey]),
+		RawContext:    rawMessageOrNil(secret.Data[contextKey]),
+		RawParameters: rawMessageOrNil(secret.Data[parametersKey]),
+	}
+}
+
+// secretName derives a valid object name from the instance ID, which is chosen by the platform and is not guaranteed to be a DNS subdomain
+func secretName(instanceID string) string {
+	hash := sha256.Sum256([]byte(instanceID))
+	return instanceSecretPrefix + hex.EncodeToString(hash[:])[:40]
+}
+
+func rawMessageOrNil(value []byte) json.RawMessage {
+	if len(value) == 0 {
+		return nil
+	}
+	return json.RawMessage(value)
+}
@@ -0,0 +1,132 @@
+package osb_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/system-broker/internal/osb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const instanceStoreNamespace = "compass-system-broker-instances"
+
+func TestSecretInstanceStore(t *testing.T) {
+	ctx := context.TODO()
+	instance := osb.ServiceInstance{
+		ID:            "a4b4b4c8-1c0d-4a0e-9a6f-4e8b5c2d3f10",
+		Tenant:        "tenantID",
+		ServiceID:     "serviceID",
+		PlanID:        "planID",
+		RawContext:    json.RawMessage(`{"platform":"kubernetes"}`),
+		RawParameters: json.RawMessage(`{"a":"b"}`),
+	}
+
+	t.Run("Create, get and delete an instance", func(t *testing.T) {
+		store := osb.NewSecretInstanceStore(fake.NewClientBuilder().Build(), instanceStoreNamespace)
+
+		stored, created, err := store.CreateIfNotExists(ctx, instance)
+		require.NoError(t, err)
+		assert.True(t, created)
+		assert.Equal(t, instance, stored)
+
+		got, err := store.Get(ctx, instance.ID)
+		require.NoError(t, err)
+		assert.Equal(t, instance, got)
+
+		require.NoError(t, store.Delete(ctx, instance.ID))
+
+		_, err = store.Get(ctx, instance.ID)
+		assert.True(t, osb.IsNotFoundError(err))
+	})
+
+	t.Run("Instances are shared between stores using the same cluster", func(t *testing.T) {
+		k8sClient := fake.NewClientBuilder().Build()
+		_, _, err := osb.NewSecretInstanceStore(k8sClient, instanceStoreNamespace).CreateIfNotExists(ctx, instance)
+		require.NoError(t, err)
+
+		got, err := osb.NewSecretInstanceStore(k8sClient, instanceStoreNamespace).Get(ctx, instance.ID)
+		require.NoError(t, err)
+		assert.Equal(t, instance, got)
+	})
+
+	t.Run("Instance without context and parameters", func(t *testing.T) {
+		store := osb.NewSecretInstanceStore(fake.NewClientBuilder().Build(), instanceStoreNamespace)
+		withoutParams := osb.ServiceInstance{ID: "instance-without-params", ServiceID: "serviceID", PlanID: "planID"}
+
+		_, _, err := store.CreateIfNotExists(ctx, withoutParams)
+		require.NoError(t, err)
+
+		got, err := store.Get(ctx, withoutParams.ID)
+		require.NoError(t, err)
+		assert.Equal(t, withoutParams, got)
+	})
+
+	t.Run("Create returns the existing instance", func(t *testing.T) {
+		store := osb.NewSecretInstanceStore(fake.NewClientBuilder().Build(), instanceStoreNamespace)
+		_, _, err := store.CreateIfNotExists(ctx, instance)
+		require.NoError(t, err)
+
+		other := instance
+		other.PlanID = "anotherPlanID"
+		stored, created, err := store.CreateIfNotExists(ctx, other)
+		require.NoError(t, err)
+		assert.False(t, created)
+		assert.Equal(t, instance, stored)
+	})
+
+	t.Run("Create fails without instance id", func(t *testing.T) {
+		store := osb.NewSecretInstanceStore(fake.NewClientBuilder().Build(), instanceStoreNamespace)
+		_, _, err := store.CreateIfNotExists(ctx, osb.ServiceInstance{})
+		assert.Error(t, err)
+	})
+
+	t.Run("Create fails when the secret cannot be created", func(t *testing.T) {
+		store := osb.NewSecretInstanceStore(&failingClient{Client: fake.NewClientBuilder().Build()}, instanceStoreNamespace)
+		_, _, err := store.CreateIfNotExists(ctx, instance)
+		assert.Error(t, err)
+		assert.False(t, osb.IsNotFoundError(err))
+	})
+
+	t.Run("Get returns not found for unknown instance", func(t *testing.T) {
+		store := osb.NewSecretInstanceStore(fake.NewClientBuilder().Build(), instanceStoreNamespace)
+		_, err := store.Get(ctx, instance.ID)
+		assert.True(t, osb.IsNotFoundError(err))
+	})
+
+	t.Run("Delete returns not found for unknown instance", func(t *testing.T) {
+		store := osb.NewSecretInstanceStore(fake.NewClientBuilder().Build(), instanceStoreNamespace)
+		err := store.Delete(ctx, instance.ID)
+		assert.True(t, osb.IsNotFoundError(err))
+	})
+
+	t.Run("Instances are stored in the configured namespace", func(t *testing.T) {
+		k8sClient := fake.NewClientBuilder().Build()
+		store := osb.NewSecretInstanceStore(k8sClient, instanceStoreNamespace)
+		_, _, err := store.CreateIfNotExists(ctx, instance)
+		require.NoError(t, err)
+
+		secrets := &v1.SecretList{}
+		require.NoError(t, k8sClient.List(ctx, secrets, client.InNamespace(instanceStoreNamespace)))
+		require.Len(t, secrets.Items, 1)
+		assert.Equal(t, instance.ID, string(secrets.Items[0].Data["instance_id"]))
+		assert.Equal(t, instance.Tenant, string(secrets.Items[0].Data["tenant"]))
+
+		configMaps := &v1.ConfigMapList{}
+		require.NoError(t, k8sClient.List(ctx, configMaps, client.InNamespace(instanceStoreNamespace)))
+		assert.Empty(t, configMaps.Items)
+	})
+}
+
+type failingClient struct {
+	client.Client
+}
+
+func (c *failingClient) Create(_ context.Context, _ client.Object, _ ...client.CreateOption) error {
+	return errors.New("test error")
+}
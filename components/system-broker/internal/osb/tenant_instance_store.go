@@ -0,0 +1,95 @@
+package osb
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/system-broker/pkg/oauth"
+	"github.com/kyma-incubator/compass/components/system-broker/pkg/types"
+	"github.com/pkg/errors"
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . TenantResolver
+type TenantResolver interface {
+	CallerTenant(ctx context.Context) (string, error)
+}
+
+type callerTenantResolver struct {
+	viewerFetcher types.ViewerFetcher
+}
+
+// NewCallerTenantResolver returns a resolver of the consumer tenant of the token forwarded by the caller.
+// The broker does not validate the token signature itself, so the token is first sent to Director, which rejects forged tokens.
+func NewCallerTenantResolver(viewerFetcher types.ViewerFetcher) *callerTenantResolver {
+	return &callerTenantResolver{
+		viewerFetcher: viewerFetcher,
+	}
+}
+
+func (r *callerTenantResolver) CallerTenant(ctx context.Context) (string, error) {
+	if _, err := r.viewerFetcher.FetchViewer(ctx); err != nil {
+		return "", errors.Wrap(err, "while verifying the caller token in Director")
+	}
+
+	tenant, err := oauth.ConsumerTenantFromHeader(ctx)
+	if err != nil {
+		return "", errors.Wrap(err, "while getting the consumer tenant of the caller")
+	}
+	return tenant, nil
+}
+
+// TenantInstanceStore records the tenant of the caller in the provisioned instances and hides the instances of other tenants.
+// Instances owned by another tenant are reported as not found, so that their existence is not disclosed.
+type TenantInstanceStore struct {
+	instances InstanceStore
+	tenants   TenantResolver
+}
+
+func NewTenantInstanceStore(instances InstanceStore, tenants TenantResolver) *TenantInstanceStore {
+	return &TenantInstanceStore{
+		instances: instances,
+		tenants:   tenants,
+	}
+}
+
+func (s *TenantInstanceStore) Get(ctx context.Context, instanceID string) (ServiceInstance, error) {
+	tenant, err := s.tenants.CallerTenant(ctx)
+	if err != nil {
+		return ServiceInstance{}, err
+	}
+
+	instance, err := s.instances.Get(ctx, instanceID)
+	if err != nil {
+		return ServiceInstance{}, err
+	}
+	if instance.Tenant != tenant {
+		return ServiceInstance{}, &instanceNotFoundError{instanceID: instanceID}
+	}
+	return instance, nil
+}
+
+// CreateIfNotExists stores the instance as owned by the caller tenant. An already existing instance of another tenant is reported
+// as a conflict instead of being returned.
+func (s *TenantInstanceStore) CreateIfNotExists(ctx context.Context, instance ServiceInstance) (ServiceInstance, bool, error) {
+	tenant, err := s.tenants.CallerTenant(ctx)
+	if err != nil {
+		return ServiceInstance{}, false, err
+	}
+
+	instance.Tenant = tenant
+	stored, created, err := s.instances.CreateIfNotExists(ctx, instance)
+	if err != nil {
+		return ServiceInstance{}, false, err
+	}
+	if stored.Tenant != tenant {
+		return ServiceInstance{}, false, &instanceAlreadyExistsError{instanceID: instance.ID}
+	}
+	return stored, created, nil
+}
+
+// Delete verifies the caller again, as the instance may be deleted without being read first
+func (s *TenantInstanceStore) Delete(ctx context.Context, instanceID string) error {
+	if _, err := s.Get(ctx, instanceID); err != nil {
+		return err
+	}
+	return s.instances.Delete(ctx, instanceID)
+}
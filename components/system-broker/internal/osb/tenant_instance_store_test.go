@@ -0,0 +1,162 @@
+package osb_test
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"testing"
+
+	schema "github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/system-broker/internal/osb"
+	"github.com/kyma-incubator/compass/components/system-broker/internal/osb/osbfakes"
+	"github.com/kyma-incubator/compass/components/system-broker/pkg/director"
+	httputils "github.com/kyma-incubator/compass/components/system-broker/pkg/http"
+	"github.com/kyma-incubator/compass/components/system-broker/pkg/oauth"
+	"github.com/kyma-incubator/compass/components/system-broker/pkg/types/typesfakes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	ownerTenant   = "ownerTenantID"
+	foreignTenant = "foreignTenantID"
+)
+
+func TestTenantInstanceStore(t *testing.T) {
+	ctx := context.TODO()
+	instance := osb.ServiceInstance{
+		ID:        "instanceID",
+		ServiceID: "serviceID",
+		PlanID:    "planID",
+	}
+
+	t.Run("Create records the caller tenant", func(t *testing.T) {
+		instances := osb.NewInMemoryInstanceStore()
+		store := osb.NewTenantInstanceStore(instances, fixTenantResolver(ownerTenant))
+
+		stored, created, err := store.CreateIfNotExists(ctx, instance)
+		require.NoError(t, err)
+		assert.True(t, created)
+		assert.Equal(t, ownerTenant, stored.Tenant)
+
+		got, err := instances.Get(ctx, instance.ID)
+		require.NoError(t, err)
+		assert.Equal(t, ownerTenant, got.Tenant)
+	})
+
+	t.Run("Create returns the existing instance of the caller tenant", func(t *testing.T) {
+		store := osb.NewTenantInstanceStore(osb.NewInMemoryInstanceStore(), fixTenantResolver(ownerTenant))
+		_, _, err := store.CreateIfNotExists(ctx, instance)
+		require.NoError(t, err)
+
+		stored, created, err := store.CreateIfNotExists(ctx, instance)
+		require.NoError(t, err)
+		assert.False(t, created)
+		assert.True(t, stored.Matches(instance))
+	})
+
+	t.Run("Create returns already exists error for the instance of another tenant", func(t *testing.T) {
+		instances := osb.NewInMemoryInstanceStore()
+		_, _, err := osb.NewTenantInstanceStore(instances, fixTenantResolver(ownerTenant)).CreateIfNotExists(ctx, instance)
+		require.NoError(t, err)
+
+		_, created, err := osb.NewTenantInstanceStore(instances, fixTenantResolver(foreignTenant)).CreateIfNotExists(ctx, instance)
+		assert.True(t, osb.IsAlreadyExistsError(err))
+		assert.False(t, created)
+	})
+
+	t.Run("Get returns the instance of the caller tenant", func(t *testing.T) {
+		store := osb.NewTenantInstanceStore(osb.NewInMemoryInstanceStore(), fixTenantResolver(ownerTenant))
+		_, _, err := store.CreateIfNotExists(ctx, instance)
+		require.NoError(t, err)
+
+		got, err := store.Get(ctx, instance.ID)
+		require.NoError(t, err)
+		assert.Equal(t, instance.ID, got.ID)
+	})
+
+	t.Run("Get and delete return not found for the instance of another tenant", func(t *testing.T) {
+		instances := osb.NewInMemoryInstanceStore()
+		_, _, err := osb.NewTenantInstanceStore(instances, fixTenantResolver(ownerTenant)).CreateIfNotExists(ctx, instance)
+		require.NoError(t, err)
+
+		store := osb.NewTenantInstanceStore(instances, fixTenantResolver(foreignTenant))
+		_, err = store.Get(ctx, instance.ID)
+		assert.True(t, osb.IsNotFoundError(err))
+
+		err = store.Delete(ctx, instance.ID)
+		assert.True(t, osb.IsNotFoundError(err))
+
+		_, err = instances.Get(ctx, instance.ID)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Delete removes the instance of the caller tenant", func(t *testing.T) {
+		store := osb.NewTenantInstanceStore(osb.NewInMemoryInstanceStore(), fixTenantResolver(ownerTenant))
+		_, _, err := store.CreateIfNotExists(ctx, instance)
+		require.NoError(t, err)
+
+		require.NoError(t, store.Delete(ctx, instance.ID))
+
+		_, err = store.Get(ctx, instance.ID)
+		assert.True(t, osb.IsNotFoundError(err))
+	})
+
+	t.Run("Error when the caller tenant cannot be resolved", func(t *testing.T) {
+		resolver := &osbfakes.FakeTenantResolver{}
+		resolver.CallerTenantReturns("", errors.New("test error"))
+		store := osb.NewTenantInstanceStore(osb.NewInMemoryInstanceStore(), resolver)
+
+		_, _, err := store.CreateIfNotExists(ctx, instance)
+		assert.EqualError(t, err, "test error")
+
+		_, err = store.Get(ctx, instance.ID)
+		assert.EqualError(t, err, "test error")
+
+		err = store.Delete(ctx, instance.ID)
+		assert.EqualError(t, err, "test error")
+	})
+}
+
+func TestCallerTenantResolver(t *testing.T) {
+	token := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"none"}`)) + "." +
+		base64.RawURLEncoding.EncodeToString([]byte(`{"tenant":"{\"consumerTenant\":\"`+ownerTenant+`\"}"}`)) + ".signature"
+	ctx := httputils.SaveToContext(context.TODO(), oauth.AuthzHeader, "Bearer "+token)
+
+	t.Run("Success", func(t *testing.T) {
+		viewerFetcher := &typesfakes.FakeViewerFetcher{}
+		viewerFetcher.FetchViewerReturns(&director.ViewerOutput{Result: &schema.Viewer{ID: "viewerID"}}, nil)
+
+		tenant, err := osb.NewCallerTenantResolver(viewerFetcher).CallerTenant(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, ownerTenant, tenant)
+		assert.Equal(t, 1, viewerFetcher.FetchViewerCallCount())
+	})
+
+	t.Run("Error when Director rejects the token", func(t *testing.T) {
+		viewerFetcher := &typesfakes.FakeViewerFetcher{}
+		viewerFetcher.FetchViewerReturns(nil, errors.New("unauthorized"))
+
+		tenant, err := osb.NewCallerTenantResolver(viewerFetcher).CallerTenant(ctx)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "while verifying the caller token in Director")
+		assert.Empty(t, tenant)
+	})
+
+	t.Run("Error when the token has no tenant", func(t *testing.T) {
+		viewerFetcher := &typesfakes.FakeViewerFetcher{}
+		viewerFetcher.FetchViewerReturns(&director.ViewerOutput{Result: &schema.Viewer{ID: "viewerID"}}, nil)
+		ctx := httputils.SaveToContext(context.TODO(), oauth.AuthzHeader, "Bearer token")
+
+		tenant, err := osb.NewCallerTenantResolver(viewerFetcher).CallerTenant(ctx)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "while getting the consumer tenant of the caller")
+		assert.Empty(t, tenant)
+	})
+}
+
+func fixTenantResolver(tenant string) *osbfakes.FakeTenantResolver {
+	resolver := &osbfakes.FakeTenantResolver{}
+	resolver.CallerTenantReturns(tenant, nil)
+	return resolver
+}
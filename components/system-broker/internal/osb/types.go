@@ -43,6 +43,19 @@ func IsNotFoundError(err error) bool {
 	return ok && nfe.NotFound()
 }
 
+func IsAlreadyExistsError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	cause := errors.Cause(err)
+
+	aee, ok := cause.(interface {
+		AlreadyExists() bool
+	})
+	return ok && aee.AlreadyExists()
+}
+
 func IsSucceeded(status *schema.BundleInstanceAuthStatus) bool {
 	if status == nil {
 		return false
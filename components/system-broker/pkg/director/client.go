@@ -122,6 +122,26 @@ func (c *GraphQLClient) FetchApplications(ctx context.Context) (*ApplicationsOut
 	return &apps, nil
 }
 
+func (c *GraphQLClient) FetchViewer(ctx context.Context) (*ViewerOutput, error) {
+	query := `query {
+			result: viewer {
+					id
+					type
+			}
+	}`
+	viewer := ViewerOutput{}
+	req := gcli.NewRequest(query)
+
+	if err := c.gcli.Do(ctx, req, &viewer); err != nil {
+		return nil, errors.Wrap(err, "while fetching viewer in gqlclient")
+	}
+	if viewer.Result == nil {
+		return nil, errors.New("failed to fetch viewer")
+	}
+
+	return &viewer, nil
+}
+
 func (c *GraphQLClient) RequestBundleInstanceCredentialsCreation(ctx context.Context, in *BundleInstanceCredentialsInput) (*BundleInstanceAuthOutput, error) {
 	if _, err := govalidator.ValidateStruct(in); err != nil {
 		return nil, errors.Wrap(err, "while validating input")
@@ -185,6 +185,51 @@ func TestGraphQLClient_FetchApplications(t *testing.T) {
 	}
 }
 
+func TestGraphQLClient_FetchViewer(t *testing.T) {
+	const viewerID = "b91b59f7-2563-40b2-aba9-fef726037aa3"
+
+	tests := []struct {
+		name           string
+		GQLClient      *directorfakes.FakeClient
+		expectedErr    string
+		expectedViewer *director.ViewerOutput
+	}{
+		{
+			name:           "success",
+			GQLClient:      getGCLI(t, fmt.Sprintf(`{"result":{"id":"%s","type":"APPLICATION"}}`, viewerID), nil),
+			expectedViewer: &director.ViewerOutput{Result: &schema.Viewer{ID: viewerID, Type: schema.ViewerTypeApplication}},
+		},
+		{
+			name:        "when director returns no viewer",
+			GQLClient:   getGCLI(t, "", nil),
+			expectedErr: "failed to fetch viewer",
+		},
+		{
+			name:        "when gql client returns an error",
+			GQLClient:   getGCLI(t, "", errors.New("some error")),
+			expectedErr: "while fetching viewer in gqlclient: some error",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := director.NewGraphQLClient(
+				tt.GQLClient,
+				&graphqlizer.Graphqlizer{},
+				&graphqlizer.GqlFieldsProvider{},
+			)
+			viewer, err := c.FetchViewer(context.TODO())
+			if tt.expectedErr != "" {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedErr)
+				assert.Nil(t, viewer)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedViewer, viewer)
+			}
+		})
+	}
+}
+
 func TestGraphQLClient_RequestBundleInstanceCredentialsCreation(t *testing.T) {
 
 	type testCase struct {
@@ -32,6 +32,10 @@ type ApplicationOutput struct {
 	Result *schema.ApplicationExt `json:"result"`
 }
 
+type ViewerOutput struct {
+	Result *schema.Viewer `json:"result"`
+}
+
 type BundleInstanceCredentialsInput struct {
 	BundleID    string `valid:"required"`
 	AuthID      string `valid:"required"`
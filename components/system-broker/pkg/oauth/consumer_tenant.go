@@ -0,0 +1,57 @@
+package oauth
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/pkg/errors"
+)
+
+const (
+	tenantClaimKey    = "tenant"
+	consumerTenantKey = "consumerTenant"
+)
+
+// ConsumerTenantFromHeader returns the consumer tenant from the tenant claim of the bearer token forwarded in the Authorization header.
+// The signature of the token is not verified, so the caller has to make sure that the token is accepted by Director before trusting the tenant.
+func ConsumerTenantFromHeader(ctx context.Context) (string, error) {
+	authorization, err := getBearerAuthorizationValue(ctx)
+	if err != nil {
+		return "", errors.Wrapf(err, "while obtaining authorization from header %s", AuthzHeader)
+	}
+
+	tokenParts := strings.Split(strings.TrimSpace(authorization[len("bearer "):]), ".")
+	if len(tokenParts) != 3 {
+		return "", apperrors.NewUnauthorizedError("bearer token is not a JWT")
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(tokenParts[1], "="))
+	if err != nil {
+		return "", apperrors.NewUnauthorizedError("while decoding bearer token payload")
+	}
+
+	claims := make(map[string]interface{})
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return "", apperrors.NewUnauthorizedError("while unmarshaling bearer token payload")
+	}
+
+	tenantClaim, ok := claims[tenantClaimKey].(string)
+	if !ok || tenantClaim == "" {
+		return "", apperrors.NewUnauthorizedError("bearer token does not contain a tenant claim")
+	}
+
+	tenants := make(map[string]string)
+	if err := json.Unmarshal([]byte(tenantClaim), &tenants); err != nil {
+		return "", apperrors.NewUnauthorizedError("while unmarshaling tenant claim of bearer token")
+	}
+
+	consumerTenant := tenants[consumerTenantKey]
+	if consumerTenant == "" {
+		return "", apperrors.NewUnauthorizedError("bearer token does not contain a consumer tenant")
+	}
+
+	return consumerTenant, nil
+}
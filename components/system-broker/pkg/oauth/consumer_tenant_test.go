@@ -0,0 +1,69 @@
+package oauth_test
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+
+	httputils "github.com/kyma-incubator/compass/components/system-broker/pkg/http"
+	"github.com/kyma-incubator/compass/components/system-broker/pkg/oauth"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConsumerTenantFromHeader(t *testing.T) {
+	const consumerTenant = "3e64ebae-38b5-46a0-b1ed-9ccee153a0ae"
+
+	testCases := []struct {
+		Name           string
+		Authorization  string
+		ExpectedTenant string
+		ExpectedErr    string
+	}{
+		{
+			Name:           "Success",
+			Authorization:  "Bearer " + fixToken(`{"tenant":"{\"consumerTenant\":\"`+consumerTenant+`\",\"externalTenant\":\"external\"}"}`),
+			ExpectedTenant: consumerTenant,
+		},
+		{
+			Name:          "Error when header is missing",
+			Authorization: "",
+			ExpectedErr:   "missing bearer token",
+		},
+		{
+			Name:          "Error when token is not a JWT",
+			Authorization: "Bearer token",
+			ExpectedErr:   "bearer token is not a JWT",
+		},
+		{
+			Name:          "Error when token has no tenant claim",
+			Authorization: "Bearer " + fixToken(`{"scopes":"application:read"}`),
+			ExpectedErr:   "bearer token does not contain a tenant claim",
+		},
+		{
+			Name:          "Error when tenant claim has no consumer tenant",
+			Authorization: "Bearer " + fixToken(`{"tenant":"{\"externalTenant\":\"external\"}"}`),
+			ExpectedErr:   "bearer token does not contain a consumer tenant",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			ctx := httputils.SaveToContext(context.TODO(), oauth.AuthzHeader, testCase.Authorization)
+
+			tenant, err := oauth.ConsumerTenantFromHeader(ctx)
+			if testCase.ExpectedErr != "" {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr)
+				assert.Empty(t, tenant)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedTenant, tenant)
+			}
+		})
+	}
+}
+
+func fixToken(claims string) string {
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"none","typ":"JWT"}`))
+	return header + "." + base64.RawURLEncoding.EncodeToString([]byte(claims)) + ".signature"
+}
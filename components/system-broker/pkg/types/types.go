@@ -32,6 +32,11 @@ type ApplicationsLister interface {
 	FetchApplications(ctx context.Context) (*director.ApplicationsOutput, error)
 }
 
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . ViewerFetcher
+type ViewerFetcher interface {
+	FetchViewer(ctx context.Context) (*director.ViewerOutput, error)
+}
+
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . BundleCredentialsFetcher
 type BundleCredentialsFetcher interface {
 	FetchBundleInstanceAuth(ctx context.Context, in *director.BundleInstanceInput) (*director.BundleInstanceAuthOutput, error)
@@ -60,6 +60,7 @@ type BindCreateTestSuite struct {
 func (suite *BindCreateTestSuite) SetupSuite() {
 	suite.testContext = common.NewTestContextBuilder().Build(suite.T())
 	suite.mockedDirectorURL = suite.testContext.Servers[common.DirectorServer].URL()
+	suite.testContext.ProvisionInstance(suite.T(), instanceID, serviceID, planID)
 }
 
 func (suite *BindCreateTestSuite) SetupTest() {
@@ -90,7 +91,31 @@ func (suite *BindCreateTestSuite) TestBindWithoutAcceptsIncompleteHeaderShouldRe
 		Expect().Status(http.StatusUnprocessableEntity)
 }
 
+func (suite *BindCreateTestSuite) TestBindWhenInstanceDoesNotExistShouldReturnNotFound() {
+	suite.testContext.ConfigureViewer(suite.T())
+
+	suite.testContext.SystemBroker.PUT("/v2/service_instances/unknown-instance/service_bindings/"+bindingID).
+		WithQuery("accepts_incomplete", "true").
+		WithHeader("X-Broker-API-Version", brokerAPIVersion).
+		WithJSON(map[string]string{"service_id": serviceID, "plan_id": planID}).
+		Expect().Status(http.StatusNotFound)
+}
+
+func (suite *BindCreateTestSuite) TestBindWithDifferentPlanThanInstanceShouldReturnBadRequest() {
+	suite.testContext.ConfigureViewer(suite.T())
+
+	resp := suite.testContext.SystemBroker.PUT(bindingPath).
+		WithQuery("accepts_incomplete", "true").
+		WithHeader("X-Broker-API-Version", brokerAPIVersion).
+		WithJSON(map[string]string{"service_id": serviceID, "plan_id": "another-plan"}).
+		Expect().Status(http.StatusBadRequest)
+
+	resp.JSON().Path("$.description").String().Contains("is provisioned for service")
+}
+
 func (suite *BindCreateTestSuite) TestBindWhenDirectorReturnsErrorOnFindCredentialsShouldReturnError() {
+	suite.testContext.ConfigureViewer(suite.T())
+
 	err := suite.testContext.ConfigureResponse(suite.mockedDirectorURL+"/config", "query", "bundleInstanceAuth",
 		`{"error": "Test-error"}`)
 	assert.NoError(suite.T(), err)
@@ -103,6 +128,8 @@ func (suite *BindCreateTestSuite) TestBindWhenDirectorReturnsErrorOnFindCredenti
 }
 
 func (suite *BindCreateTestSuite) TestBindWhenDirectorReturnsInsufficientScopesOnFindCredentialsShouldReturnUnauthorized() {
+	suite.testContext.ConfigureViewer(suite.T())
+
 	err := suite.testContext.ConfigureResponse(suite.mockedDirectorURL+"/config", "query", "bundleInstanceAuth",
 		`{"error": "insufficient scopes provided"}`)
 	assert.NoError(suite.T(), err)
@@ -117,6 +144,8 @@ func (suite *BindCreateTestSuite) TestBindWhenDirectorReturnsInsufficientScopesO
 }
 
 func (suite *BindCreateTestSuite) TestBindWhenDirectorOnFindCredentialsReturnsCredentialsWithMismatchedContextShouldReturnError() {
+	suite.testContext.ConfigureViewer(suite.T())
+
 	err := suite.testContext.ConfigureResponse(suite.mockedDirectorURL+"/config", "query", "bundleInstanceAuth",
 		fmt.Sprintf(bundleInstanceAuthResponse, bindingID, schema.BundleInstanceAuthStatusConditionSucceeded, "mismatched-id", bindingID))
 	assert.NoError(suite.T(), err)
@@ -129,6 +158,8 @@ func (suite *BindCreateTestSuite) TestBindWhenDirectorOnFindCredentialsReturnsCr
 }
 
 func (suite *BindCreateTestSuite) TestBindWhenDirectorReturnsErrorOnBundleInstanceCreationShouldReturnError() {
+	suite.testContext.ConfigureViewer(suite.T())
+
 	err := suite.testContext.ConfigureResponse(suite.mockedDirectorURL+"/config", "query", "bundleInstanceAuth",
 		notFoundResponse)
 	assert.NoError(suite.T(), err)
@@ -144,6 +175,8 @@ func (suite *BindCreateTestSuite) TestBindWhenDirectorReturnsErrorOnBundleInstan
 }
 
 func (suite *BindCreateTestSuite) TestBindWhenDirectorReturnsUnauthorizedOnBundleInstanceCreationShouldReturnUnauthorized() {
+	suite.testContext.ConfigureViewer(suite.T())
+
 	err := suite.testContext.ConfigureResponse(suite.mockedDirectorURL+"/config", "query", "bundleInstanceAuth",
 		notFoundResponse)
 	assert.NoError(suite.T(), err)
@@ -161,6 +194,8 @@ func (suite *BindCreateTestSuite) TestBindWhenDirectorReturnsUnauthorizedOnBundl
 }
 
 func (suite *BindCreateTestSuite) TestBindWhenDirectorReturnsAuthWithFailedConditionOnBundleInstanceCreationShouldReturnError() {
+	suite.testContext.ConfigureViewer(suite.T())
+
 	err := suite.testContext.ConfigureResponse(suite.mockedDirectorURL+"/config", "query", "bundleInstanceAuth", notFoundResponse)
 	assert.NoError(suite.T(), err)
 
@@ -176,6 +211,8 @@ func (suite *BindCreateTestSuite) TestBindWhenDirectorReturnsAuthWithFailedCondi
 }
 
 func (suite *BindCreateTestSuite) TestBindWhenExistingCredentialIsFoundWithFailedAuthShouldReturnError() {
+	suite.testContext.ConfigureViewer(suite.T())
+
 	err := suite.testContext.ConfigureResponse(suite.mockedDirectorURL+"/config", "query", "bundleInstanceAuth",
 		fmt.Sprintf(bundleInstanceAuthResponse, bindingID, schema.BundleInstanceAuthStatusConditionFailed, instanceID, bindingID))
 	assert.NoError(suite.T(), err)
@@ -188,6 +225,8 @@ func (suite *BindCreateTestSuite) TestBindWhenExistingCredentialIsFoundWithFaile
 }
 
 func (suite *BindCreateTestSuite) TestBindWhenExistingCredentialIsFoundShouldReturnAccepted() {
+	suite.testContext.ConfigureViewer(suite.T())
+
 	err := suite.testContext.ConfigureResponse(suite.mockedDirectorURL+"/config", "query", "bundleInstanceAuth",
 		fmt.Sprintf(bundleInstanceAuthResponse, bindingID, schema.BundleInstanceAuthStatusConditionSucceeded, instanceID, bindingID))
 	assert.NoError(suite.T(), err)
@@ -202,6 +241,8 @@ func (suite *BindCreateTestSuite) TestBindWhenExistingCredentialIsFoundShouldRet
 }
 
 func (suite *BindCreateTestSuite) TestBindWhenNewCredentialsAreCreatedShouldReturnAccepted() {
+	suite.testContext.ConfigureViewer(suite.T())
+
 	err := suite.testContext.ConfigureResponse(suite.mockedDirectorURL+"/config", "query", "bundleInstanceAuth", notFoundResponse)
 	assert.NoError(suite.T(), err)
 
@@ -3,6 +3,7 @@ package common
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -38,6 +39,45 @@ import (
 const SystemBrokerServer = "system-broker-server"
 const DirectorServer = "director-server"
 
+// TestTenant is the consumer tenant of the token sent with every request to the system broker
+const TestTenant = "3e64ebae-38b5-46a0-b1ed-9ccee153a0ae"
+
+const viewerResponse = `{
+  "data": {
+    "result": {
+      "id": "a34ab3cf-0e8e-4ac6-b6b5-4a2b7d3e5f10",
+      "type": "INTEGRATION_SYSTEM"
+    }
+  }
+}`
+
+const applicationsResponse = `{
+  "data": {
+    "result": {
+      "data": [
+        {
+          "id": "%s",
+          "name": "varkes",
+          "bundles": {
+            "data": [
+              {
+                "id": "%s",
+                "name": "ac"
+              }
+            ]
+          }
+        }
+      ],
+      "pageInfo": {
+        "startCursor": "",
+        "endCursor": "",
+        "hasNextPage": false
+      },
+      "totalCount": 1
+    }
+  }
+}`
+
 type TestContext struct {
 	SystemBroker *httpexpect.Expect
 	HttpClient   *http.Client
@@ -128,19 +168,22 @@ func (tcb *TestContextBuilder) Build(t *testing.T) *TestContext {
 	sbServer := newSystemBrokerServer(tcb.Environment)
 	tcb.Servers[SystemBrokerServer] = sbServer
 
-	systemBroker := httpexpect.New(t, sbServer.URL()).Builder(func(request *httpexpect.Request) {
-		request.WithClient(tcb.HttpClient)
-	})
-
 	testContext := &TestContext{
-		SystemBroker: systemBroker,
-		Servers:      tcb.Servers,
-		HttpClient:   tcb.HttpClient,
+		Servers:    tcb.Servers,
+		HttpClient: tcb.HttpClient,
 	}
+	testContext.SystemBroker = testContext.SystemBrokerForTenant(t, TestTenant)
 
 	return testContext
 }
 
+// SystemBrokerForTenant returns a client of the system broker sending the token of the given consumer tenant
+func (tc *TestContext) SystemBrokerForTenant(t *testing.T, tenant string) *httpexpect.Expect {
+	return httpexpect.New(t, tc.Servers[SystemBrokerServer].URL()).Builder(func(request *httpexpect.Request) {
+		request.WithClient(tc.HttpClient).WithHeader("Authorization", "Bearer "+tokenForTenant(tenant))
+	})
+}
+
 func (tc *TestContext) ConfigureResponse(configURL, queryType, queryName, response string) error {
 	var applicationsResponse map[string]interface{}
 
@@ -168,6 +211,36 @@ func (tc *TestContext) ConfigureResponse(configURL, queryType, queryName, respon
 	return nil
 }
 
+// ConfigureViewer configures the response of the Director query used by the system broker to verify the token of the caller.
+// It has to be called before every request to an endpoint working with service instances.
+func (tc *TestContext) ConfigureViewer(t *testing.T) {
+	if err := tc.ConfigureResponse(tc.Servers[DirectorServer].URL()+"/config", "query", "viewer", viewerResponse); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// tokenForTenant returns an unsigned token with the tenant claim issued by the gateway for the given consumer tenant
+func tokenForTenant(tenant string) string {
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"none","typ":"JWT"}`))
+	claims := base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf(`{"tenant":"{\"consumerTenant\":\"%s\",\"externalTenant\":\"\"}"}`, tenant)))
+	return header + "." + claims + ".signature"
+}
+
+// ProvisionInstance provisions a service instance for the given application and bundle, so that tests can bind to it
+func (tc *TestContext) ProvisionInstance(t *testing.T, instanceID, serviceID, planID string) {
+	err := tc.ConfigureResponse(tc.Servers[DirectorServer].URL()+"/config", "query", "applications",
+		fmt.Sprintf(applicationsResponse, serviceID, planID))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tc.ConfigureViewer(t)
+
+	tc.SystemBroker.PUT("/v2/service_instances/"+instanceID).WithHeader("X-Broker-API-Version", "2.15").
+		WithJSON(map[string]string{"service_id": serviceID, "plan_id": planID}).
+		Expect().Status(http.StatusCreated)
+}
+
 func TestEnv() env.Environment {
 	env, err := env.Default(context.TODO())
 	if err != nil {
@@ -200,7 +273,7 @@ func newSystemBrokerServer(sbEnv env.Environment) FakeServer {
 	}
 
 	directorGraphQLClient, err := prepareGQLClient(cfg)
-	systemBroker := osb.NewSystemBroker(directorGraphQLClient, cfg.Server.SelfURL+cfg.Server.RootAPI)
+	systemBroker := osb.NewSystemBroker(directorGraphQLClient, osb.NewInMemoryInstanceStore(), cfg.Server.SelfURL+cfg.Server.RootAPI)
 	collector := metrics.NewCollector()
 	osbApi := osb.API(cfg.Server.RootAPI, systemBroker, sblog.NewDefaultLagerAdapter(), collector)
 
@@ -89,7 +89,42 @@ func (suite *InstanceProvisionTestSuite) TestProvision() {
 	err := suite.testContext.ConfigureResponse(suite.mockedDirectorURL+"/config", "query", "applications", appsMockResponse)
 	assert.NoError(suite.T(), err)
 
+	suite.testContext.ConfigureViewer(suite.T())
 	suite.testContext.SystemBroker.PUT("/v2/service_instances/123").WithHeader("X-Broker-API-Version", brokerAPIVersion).
 		WithJSON(map[string]string{"service_id": serviceID, "plan_id": planID}).
 		Expect().Status(http.StatusCreated).Body().Equal("{}\n")
 }
+
+func (suite *InstanceProvisionTestSuite) TestProvisionWithSameAttributesShouldReturnOK() {
+	err := suite.testContext.ConfigureResponse(suite.mockedDirectorURL+"/config", "query", "applications", appsMockResponse)
+	assert.NoError(suite.T(), err)
+	err = suite.testContext.ConfigureResponse(suite.mockedDirectorURL+"/config", "query", "applications", appsMockResponse)
+	assert.NoError(suite.T(), err)
+
+	suite.testContext.ConfigureViewer(suite.T())
+	suite.testContext.SystemBroker.PUT("/v2/service_instances/456").WithHeader("X-Broker-API-Version", brokerAPIVersion).
+		WithJSON(map[string]interface{}{"service_id": serviceID, "plan_id": planID, "parameters": map[string]string{"a": "b"}}).
+		Expect().Status(http.StatusCreated)
+
+	suite.testContext.ConfigureViewer(suite.T())
+	suite.testContext.SystemBroker.PUT("/v2/service_instances/456").WithHeader("X-Broker-API-Version", brokerAPIVersion).
+		WithJSON(map[string]interface{}{"service_id": serviceID, "plan_id": planID, "parameters": map[string]string{"a": "b"}}).
+		Expect().Status(http.StatusOK).Body().Equal("{}\n")
+}
+
+func (suite *InstanceProvisionTestSuite) TestProvisionWithDifferentAttributesShouldReturnConflict() {
+	err := suite.testContext.ConfigureResponse(suite.mockedDirectorURL+"/config", "query", "applications", appsMockResponse)
+	assert.NoError(suite.T(), err)
+	err = suite.testContext.ConfigureResponse(suite.mockedDirectorURL+"/config", "query", "applications", appsMockResponse)
+	assert.NoError(suite.T(), err)
+
+	suite.testContext.ConfigureViewer(suite.T())
+	suite.testContext.SystemBroker.PUT("/v2/service_instances/789").WithHeader("X-Broker-API-Version", brokerAPIVersion).
+		WithJSON(map[string]interface{}{"service_id": serviceID, "plan_id": planID, "parameters": map[string]string{"a": "b"}}).
+		Expect().Status(http.StatusCreated)
+
+	suite.testContext.ConfigureViewer(suite.T())
+	suite.testContext.SystemBroker.PUT("/v2/service_instances/789").WithHeader("X-Broker-API-Version", brokerAPIVersion).
+		WithJSON(map[string]interface{}{"service_id": serviceID, "plan_id": planID, "parameters": map[string]string{"a": "c"}}).
+		Expect().Status(http.StatusConflict)
+}
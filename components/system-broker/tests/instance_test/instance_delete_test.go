@@ -26,8 +26,44 @@ func (suite *InstanceDeprovisionTestSuite) TearDownSuite() {
 }
 
 func (suite *InstanceDeprovisionTestSuite) TestDeprovision() {
+	suite.testContext.ProvisionInstance(suite.T(), "123", serviceID, planID)
+
+	// the caller is verified both when the instance is read and when it is deleted
+	suite.testContext.ConfigureViewer(suite.T())
+	suite.testContext.ConfigureViewer(suite.T())
 	suite.testContext.SystemBroker.DELETE("/v2/service_instances/123").WithHeader("X-Broker-API-Version", brokerAPIVersion).
 		WithQuery("service_id", serviceID).
 		WithQuery("plan_id", planID).
 		Expect().Status(http.StatusOK).Body().Equal("{}\n")
+
+	suite.testContext.ConfigureViewer(suite.T())
+	suite.testContext.SystemBroker.DELETE("/v2/service_instances/123").WithHeader("X-Broker-API-Version", brokerAPIVersion).
+		WithQuery("service_id", serviceID).
+		WithQuery("plan_id", planID).
+		Expect().Status(http.StatusGone)
+}
+
+func (suite *InstanceDeprovisionTestSuite) TestDeprovisionWhenInstanceDoesNotExistShouldReturnGone() {
+	suite.testContext.ConfigureViewer(suite.T())
+	suite.testContext.SystemBroker.DELETE("/v2/service_instances/456").WithHeader("X-Broker-API-Version", brokerAPIVersion).
+		WithQuery("service_id", serviceID).
+		WithQuery("plan_id", planID).
+		Expect().Status(http.StatusGone).Body().Equal("{}\n")
+}
+
+func (suite *InstanceDeprovisionTestSuite) TestDeprovisionWithDifferentPlanShouldReturnBadRequest() {
+	suite.testContext.ProvisionInstance(suite.T(), "789", serviceID, planID)
+
+	suite.testContext.ConfigureViewer(suite.T())
+	suite.testContext.SystemBroker.DELETE("/v2/service_instances/789").WithHeader("X-Broker-API-Version", brokerAPIVersion).
+		WithQuery("service_id", serviceID).
+		WithQuery("plan_id", "anotherPlanID").
+		Expect().Status(http.StatusBadRequest)
+
+	suite.testContext.ConfigureViewer(suite.T())
+	suite.testContext.ConfigureViewer(suite.T())
+	suite.testContext.SystemBroker.DELETE("/v2/service_instances/789").WithHeader("X-Broker-API-Version", brokerAPIVersion).
+		WithQuery("service_id", serviceID).
+		WithQuery("plan_id", planID).
+		Expect().Status(http.StatusOK)
 }
@@ -26,6 +26,25 @@ func (suite *InstanceGetTestSuite) TearDownSuite() {
 }
 
 func (suite *InstanceGetTestSuite) TestGet() {
-	suite.testContext.SystemBroker.GET("/v2/service_instances/123").WithHeader("X-Broker-API-Version", brokerAPIVersion).
-		Expect().Status(http.StatusInternalServerError).Body().Equal("{\"description\":\"not supported\"}\n")
+	suite.testContext.ProvisionInstance(suite.T(), "123", serviceID, planID)
+
+	suite.testContext.ConfigureViewer(suite.T())
+	resp := suite.testContext.SystemBroker.GET("/v2/service_instances/123").WithHeader("X-Broker-API-Version", brokerAPIVersion).
+		Expect().Status(http.StatusOK).JSON()
+	resp.Path("$.service_id").String().Equal(serviceID)
+	resp.Path("$.plan_id").String().Equal(planID)
+}
+
+func (suite *InstanceGetTestSuite) TestGetWhenInstanceDoesNotExistShouldReturnNotFound() {
+	suite.testContext.ConfigureViewer(suite.T())
+	suite.testContext.SystemBroker.GET("/v2/service_instances/456").WithHeader("X-Broker-API-Version", brokerAPIVersion).
+		Expect().Status(http.StatusNotFound).Body().Equal("{\"description\":\"service instance with id 456 does not exist\"}\n")
+}
+
+func (suite *InstanceGetTestSuite) TestGetWhenInstanceBelongsToAnotherTenantShouldReturnNotFound() {
+	suite.testContext.ProvisionInstance(suite.T(), "789", serviceID, planID)
+
+	suite.testContext.ConfigureViewer(suite.T())
+	suite.testContext.SystemBrokerForTenant(suite.T(), "another-tenant").GET("/v2/service_instances/789").WithHeader("X-Broker-API-Version", brokerAPIVersion).
+		Expect().Status(http.StatusNotFound).Body().Equal("{\"description\":\"service instance with id 789 does not exist\"}\n")
 }
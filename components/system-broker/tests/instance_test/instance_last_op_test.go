@@ -26,8 +26,19 @@ func (suite *InstanceLastOpTestSuite) TearDownSuite() {
 }
 
 func (suite *InstanceLastOpTestSuite) TestLastOp() {
+	suite.testContext.ProvisionInstance(suite.T(), "123", serviceID, planID)
+
+	suite.testContext.ConfigureViewer(suite.T())
 	suite.testContext.SystemBroker.GET("/v2/service_instances/123/last_operation").WithHeader("X-Broker-API-Version", brokerAPIVersion).
 		WithQuery("service_id", serviceID).
 		WithQuery("plan_id", planID).
-		Expect().Status(http.StatusOK).Body().Equal("{\"state\":\"\"}\n")
+		Expect().Status(http.StatusOK).Body().Equal("{\"state\":\"succeeded\"}\n")
+}
+
+func (suite *InstanceLastOpTestSuite) TestLastOpWhenInstanceDoesNotExistShouldReturnGone() {
+	suite.testContext.ConfigureViewer(suite.T())
+	suite.testContext.SystemBroker.GET("/v2/service_instances/456/last_operation").WithHeader("X-Broker-API-Version", brokerAPIVersion).
+		WithQuery("service_id", serviceID).
+		WithQuery("plan_id", planID).
+		Expect().Status(http.StatusGone)
 }